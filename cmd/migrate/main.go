@@ -0,0 +1,123 @@
+// Command migrate applies and inspects the versioned database migrations,
+// for deployments that migrate before starting services with
+// DB_MIGRATE_ON_START=false.
+//
+//	migrate [-service name] up              apply pending migrations
+//	migrate [-service name] status          show versions; exits 1 if any are pending or dirty
+//	migrate [-service name] force SET VER   record SET at version VER after a failed migration
+//
+// -service limits the command to one service's migrations (user, task,
+// notification or org); by default all are used.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+
+	"github.com/chanduchitikam/task-management-system/migrations"
+	"github.com/chanduchitikam/task-management-system/pkg/config"
+	"github.com/chanduchitikam/task-management-system/pkg/database"
+)
+
+func main() {
+	service := flag.String("service", "", "only the migrations of this service")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s [-service name] up | status | force SET VERSION\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	sets, err := migrationSets(*service)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+	db, err := database.NewSQLConnection(cfg.Database.GetDSN())
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	switch flag.Arg(0) {
+	case "up":
+		if err := database.Migrate(db, sets...); err != nil {
+			log.Fatal(err)
+		}
+	case "status":
+		statuses, err := database.MigrationStatuses(db, sets...)
+		if err != nil {
+			log.Fatal(err)
+		}
+		behind := false
+		for _, st := range statuses {
+			state := "up to date"
+			switch {
+			case st.Dirty:
+				state = "dirty"
+				behind = true
+			case st.Pending():
+				state = "pending"
+				behind = true
+			case st.Version > st.Latest:
+				state = "ahead of this build"
+			}
+			fmt.Printf("%-14s version %3d of %3d  %s\n", st.Set, st.Version, st.Latest, state)
+		}
+		if behind {
+			os.Exit(1)
+		}
+	case "force":
+		if flag.NArg() != 3 {
+			flag.Usage()
+			os.Exit(2)
+		}
+		version, err := strconv.Atoi(flag.Arg(2))
+		if err != nil {
+			log.Fatalf("invalid version %q", flag.Arg(2))
+		}
+		for _, set := range sets {
+			if set.Name == flag.Arg(1) {
+				if err := database.ForceMigration(db, set, version); err != nil {
+					log.Fatal(err)
+				}
+				return
+			}
+		}
+		log.Fatalf("unknown migration set %q", flag.Arg(1))
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+}
+
+// migrationSets returns the sets of service, or of every service without
+// repeating the shared one
+func migrationSets(service string) ([]database.MigrationSet, error) {
+	if service != "" {
+		return migrations.ForService(service)
+	}
+	sets := []database.MigrationSet{migrations.Core}
+	for _, name := range migrations.Services {
+		own, err := migrations.ForService(name)
+		if err != nil {
+			return nil, err
+		}
+		for _, set := range own {
+			if set.Name != migrations.Core.Name {
+				sets = append(sets, set)
+			}
+		}
+	}
+	return sets, nil
+}
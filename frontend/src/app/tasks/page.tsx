@@ -109,10 +109,13 @@ export default function TasksPage() {
     enabled: isAuthenticated,
   });
 
+  // Updates must carry the version we last read so concurrent edits are rejected
+  const versionOf = (id: string) => tasksQuery.data?.tasks.find((t) => t.id === id)?.version ?? 0;
+
   const updateTaskMutation = useMutation({
     mutationFn: ({ id, data }: { id: string; data: UpdateTaskRequest }) => {
       console.log('Updating task:', { id, data });
-      return tasksAPI.updateTask(id, data);
+      return tasksAPI.updateTask(id, { ...data, version: data.version ?? versionOf(id) });
     },
     onSuccess: () => {
       queryClient.invalidateQueries({ queryKey: ['tasks'] });
@@ -128,7 +131,7 @@ export default function TasksPage() {
   const updateTaskStatusMutation = useMutation({
     mutationFn: ({ id, status }: { id: string; status: TaskStatus }) => {
       console.log('Updating task status:', { id, status });
-      return tasksAPI.updateTaskStatus(id, status);
+      return tasksAPI.updateTaskStatus(id, status, versionOf(id));
     },
     onSuccess: () => {
       queryClient.invalidateQueries({ queryKey: ['tasks'] });
@@ -72,8 +72,8 @@ export const tasksAPI = {
   updateTask: (id: string, data: UpdateTaskRequest) =>
     apiClient.put<{ task: Task }>(API_ENDPOINTS.TASKS.BY_ID(id), data).then((res) => transformTask(res.task)),
 
-  updateTaskStatus: (id: string, status: TaskStatus) =>
-    apiClient.patch<{ task: Task }>(API_ENDPOINTS.TASKS.BY_ID(id) + '/status', { status: status }).then((res) => transformTask(res.task)),
+  updateTaskStatus: (id: string, status: TaskStatus, version: number) =>
+    apiClient.patch<{ task: Task }>(API_ENDPOINTS.TASKS.BY_ID(id) + '/status', { status: status, version: version }).then((res) => transformTask(res.task)),
 
   deleteTask: (id: string) =>
     apiClient.delete(API_ENDPOINTS.TASKS.BY_ID(id)),
@@ -97,6 +97,7 @@ function transformTask(task: any): Task {
     due_date: task.due_date || task.dueDate,
     created_at: task.created_at || task.createdAt,
     updated_at: task.updated_at || task.updatedAt,
+    version: Number(task.version) || 0,
   };
 }
 
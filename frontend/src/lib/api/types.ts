@@ -57,6 +57,7 @@ export interface Task {
   due_date?: string;
   created_at: string;
   updated_at: string;
+  version: number; // Optimistic concurrency token; send back on updates
 }
 
 export interface CreateTaskRequest {
@@ -79,6 +80,7 @@ export interface UpdateTaskRequest {
   assigned_to?: string;
   tags?: string[];
   due_date?: string;
+  version?: number;
 }
 
 export interface AssignTaskRequest {
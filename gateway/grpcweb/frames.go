@@ -0,0 +1,120 @@
+package grpcweb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// maxMessageSize bounds a single message read from a browser
+const maxMessageSize = 4 << 20
+
+// Frame flags; a trailer frame carries the call's status as HTTP/1 headers
+const (
+	flagData    byte = 0x00
+	flagTrailer byte = 0x80
+)
+
+// rawCodec passes messages through as bytes, so the proxy needs no
+// knowledge of the services' message types. It keeps the "proto" name so
+// backends see ordinary application/grpc+proto calls.
+type rawCodec struct{}
+
+func (rawCodec) Marshal(v interface{}) ([]byte, error) {
+	b, ok := v.(*[]byte)
+	if !ok {
+		return nil, fmt.Errorf("grpcweb: cannot marshal %T", v)
+	}
+	return *b, nil
+}
+
+func (rawCodec) Unmarshal(data []byte, v interface{}) error {
+	b, ok := v.(*[]byte)
+	if !ok {
+		return fmt.Errorf("grpcweb: cannot unmarshal into %T", v)
+	}
+	*b = append((*b)[:0], data...)
+	return nil
+}
+
+func (rawCodec) Name() string { return "proto" }
+
+// readFrame reads one length-prefixed frame
+func readFrame(r io.Reader) (byte, []byte, error) {
+	var header [5]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return 0, nil, err
+	}
+	size := binary.BigEndian.Uint32(header[1:])
+	if size > maxMessageSize {
+		return 0, nil, fmt.Errorf("grpcweb: message of %d bytes exceeds the limit", size)
+	}
+	payload := make([]byte, size)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return 0, nil, io.ErrUnexpectedEOF
+	}
+	return header[0], payload, nil
+}
+
+func encodeFrame(flag byte, payload []byte) []byte {
+	frame := make([]byte, 5+len(payload))
+	frame[0] = flag
+	binary.BigEndian.PutUint32(frame[1:5], uint32(len(payload)))
+	copy(frame[5:], payload)
+	return frame
+}
+
+// trailerFrame encodes the call's outcome and trailing metadata
+func trailerFrame(err error, trailer metadata.MD) []byte {
+	st := status.Convert(err)
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "grpc-status: %d\r\n", st.Code())
+	if msg := st.Message(); msg != "" {
+		fmt.Fprintf(&buf, "grpc-message: %s\r\n", encodeGrpcMessage(msg))
+	}
+	keys := make([]string, 0, len(trailer))
+	for k := range trailer {
+		// trailers-only responses repeat the backend's content type
+		if k != "content-type" {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		for _, v := range trailer[k] {
+			fmt.Fprintf(&buf, "%s: %s\r\n", k, v)
+		}
+	}
+	return encodeFrame(flagTrailer, buf.Bytes())
+}
+
+// encodeGrpcMessage percent-encodes a status message as the gRPC protocol
+// requires
+func encodeGrpcMessage(msg string) string {
+	var b strings.Builder
+	for i := 0; i < len(msg); i++ {
+		c := msg[i]
+		if c >= ' ' && c <= '~' && c != '%' {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+// setHeaderMetadata copies response metadata to HTTP headers
+func setHeaderMetadata(h http.Header, md metadata.MD) {
+	for k, vs := range md {
+		for _, v := range vs {
+			h.Add(k, v)
+		}
+	}
+}
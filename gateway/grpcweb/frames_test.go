@@ -0,0 +1,140 @@
+package grpcweb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// frameHeader is the 5-byte prefix of a frame claiming size bytes
+func frameHeader(flag byte, size uint32) []byte {
+	return binary.BigEndian.AppendUint32([]byte{flag}, size)
+}
+
+func TestEncodeFrame(t *testing.T) {
+	assert.Equal(t, []byte{0x00, 0, 0, 0, 3, 'a', 'b', 'c'}, encodeFrame(flagData, []byte("abc")))
+	assert.Equal(t, []byte{0x80, 0, 0, 0, 0}, encodeFrame(flagTrailer, nil))
+}
+
+func TestReadFrame(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		flag    byte
+		payload []byte
+	}{
+		{"data frame", encodeFrame(flagData, []byte("hello")), flagData, []byte("hello")},
+		{"empty frame", encodeFrame(flagData, nil), flagData, []byte{}},
+		{"trailer frame", encodeFrame(flagTrailer, []byte("grpc-status: 0\r\n")), flagTrailer, []byte("grpc-status: 0\r\n")},
+		{"frame at the size limit", encodeFrame(flagData, make([]byte, maxMessageSize)), flagData, make([]byte, maxMessageSize)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := bytes.NewReader(tt.data)
+			flag, payload, err := readFrame(r)
+			require.NoError(t, err)
+			assert.Equal(t, tt.flag, flag)
+			assert.Equal(t, tt.payload, payload)
+			_, _, err = readFrame(r)
+			assert.Equal(t, io.EOF, err)
+		})
+	}
+}
+
+func TestReadFrameMalformed(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		want error
+	}{
+		{"truncated header", []byte{0x00, 0, 0}, io.ErrUnexpectedEOF},
+		{"truncated payload", append(frameHeader(flagData, 10), "short"...), io.ErrUnexpectedEOF},
+		{"oversized", frameHeader(flagData, maxMessageSize+1), nil},
+		{"oversized length", frameHeader(flagData, 0xffffffff), nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := readFrame(bytes.NewReader(tt.data))
+			require.Error(t, err)
+			if tt.want != nil {
+				assert.ErrorIs(t, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadFrameSequence(t *testing.T) {
+	var stream []byte
+	stream = append(stream, encodeFrame(flagData, []byte("one"))...)
+	stream = append(stream, encodeFrame(flagData, []byte("two"))...)
+	r := bytes.NewReader(stream)
+	for _, want := range []string{"one", "two"} {
+		_, payload, err := readFrame(r)
+		require.NoError(t, err)
+		assert.Equal(t, want, string(payload))
+	}
+}
+
+func TestTrailerFrame(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		trailer metadata.MD
+		want    string
+	}{
+		{"ok", nil, nil, "grpc-status: 0\r\n"},
+		{"error", status.Error(codes.NotFound, "task not found"), nil, "grpc-status: 5\r\ngrpc-message: task not found\r\n"},
+		{"message is percent-encoded", status.Error(codes.InvalidArgument, "100% bad\nline"), nil,
+			"grpc-status: 3\r\ngrpc-message: 100%25 bad%0Aline\r\n"},
+		{"non-ascii message", status.Error(codes.Internal, "é"), nil, "grpc-status: 13\r\ngrpc-message: %C3%A9\r\n"},
+		{"trailers are sorted", nil, metadata.Pairs("x-b", "2", "x-a", "1", "x-a", "3"),
+			"grpc-status: 0\r\nx-a: 1\r\nx-a: 3\r\nx-b: 2\r\n"},
+		{"content type is dropped", nil, metadata.Pairs("content-type", "application/grpc"), "grpc-status: 0\r\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flag, payload, err := readFrame(bytes.NewReader(trailerFrame(tt.err, tt.trailer)))
+			require.NoError(t, err)
+			assert.Equal(t, flagTrailer, flag)
+			assert.Equal(t, tt.want, string(payload))
+		})
+	}
+}
+
+func TestRawCodec(t *testing.T) {
+	var codec rawCodec
+	in := []byte{1, 2, 3}
+	out, err := codec.Marshal(&in)
+	require.NoError(t, err)
+	assert.Equal(t, in, out)
+
+	var got []byte
+	require.NoError(t, codec.Unmarshal([]byte{4, 5}, &got))
+	assert.Equal(t, []byte{4, 5}, got)
+
+	_, err = codec.Marshal("not bytes")
+	assert.Error(t, err)
+	assert.Error(t, codec.Unmarshal(nil, new(string)))
+}
+
+func TestParseHeaders(t *testing.T) {
+	h, err := parseHeaders([]byte("authorization: Bearer abc\r\nx-grpc-web: 1\r\ngrpc-timeout: 5S"))
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer abc", h.Get("Authorization"))
+	assert.Equal(t, "1", h.Get("X-Grpc-Web"))
+	assert.Equal(t, "5S", h.Get("Grpc-Timeout"))
+
+	h, err = parseHeaders([]byte("content-type: application/grpc-web+proto\r\n\r\n"))
+	require.NoError(t, err)
+	assert.Equal(t, "application/grpc-web+proto", h.Get("Content-Type"))
+
+	_, err = parseHeaders([]byte("no colon here\r\n"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,296 @@
+// Package grpcweb lets browsers call the services' gRPC methods directly,
+// streaming ones included. Unary and server-streaming calls use the
+// gRPC-Web protocol over HTTP/1.1; client and bidirectional streams, which
+// fetch cannot carry, use the grpc-websockets transport of the
+// @improbable-eng/grpc-web client.
+package grpcweb
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/gateway/middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// streamDesc lets one proxy path serve every kind of RPC; the backend
+// enforces the method's actual shape
+var streamDesc = &grpc.StreamDesc{ServerStreams: true, ClientStreams: true}
+
+var forceRawCodec = grpc.ForceCodec(rawCodec{})
+
+// Identity is a verified caller
+type Identity struct {
+	UserID string
+	Email  string
+	Role   string
+	OrgID  string
+}
+
+// TokenVerifier resolves a bearer token sent over the WebSocket transport,
+// where browsers cannot set headers on the upgrade request. It returns an
+// error for tokens that are malformed, expired or revoked, and for callers
+// who may not connect from where r came from.
+type TokenVerifier func(r *http.Request, token string) (*Identity, error)
+
+// Proxy forwards gRPC-Web calls to the backend serving each service
+type Proxy struct {
+	verify TokenVerifier
+
+	mu    sync.RWMutex
+	conns map[string]*grpc.ClientConn
+}
+
+// NewProxy creates a proxy; verify checks tokens sent over WebSockets
+func NewProxy(verify TokenVerifier) *Proxy {
+	return &Proxy{verify: verify, conns: make(map[string]*grpc.ClientConn)}
+}
+
+// Register routes calls to service, such as "notification.NotificationService",
+// over conn. Calls go through conn's interceptors, which must enforce
+// authentication.
+func (p *Proxy) Register(service string, conn *grpc.ClientConn) {
+	p.mu.Lock()
+	p.conns[service] = conn
+	p.mu.Unlock()
+}
+
+// Handler serves gRPC-Web requests and passes everything else to next. For
+// HTTP requests it must run after the caller's identity is in the request
+// context.
+func (p *Proxy) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case isGrpcWebRequest(r):
+			p.serveHTTP(w, r)
+		case isGrpcWebSocketRequest(r):
+			p.serveWebSocket(w, r)
+		default:
+			next.ServeHTTP(w, r)
+		}
+	})
+}
+
+func isGrpcWebRequest(r *http.Request) bool {
+	return r.Method == http.MethodPost && strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc-web")
+}
+
+func isGrpcWebSocketRequest(r *http.Request) bool {
+	if !strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+		return false
+	}
+	for _, proto := range strings.Split(r.Header.Get("Sec-WebSocket-Protocol"), ",") {
+		if strings.TrimSpace(proto) == "grpc-websockets" {
+			return true
+		}
+	}
+	return false
+}
+
+// lookup returns the connection serving a full method name like
+// "/notification.NotificationService/GetNotifications"
+func (p *Proxy) lookup(method string) (*grpc.ClientConn, bool) {
+	parts := strings.Split(strings.TrimPrefix(method, "/"), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, false
+	}
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	conn, ok := p.conns[parts[0]]
+	return conn, ok
+}
+
+// serveHTTP proxies a call over plain HTTP. The request body holds every
+// message the browser sends; responses are streamed back as they arrive.
+func (p *Proxy) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	text := strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc-web-text")
+	contentType := "application/grpc-web+proto"
+	var body io.Reader = r.Body
+	if text {
+		contentType = "application/grpc-web-text+proto"
+		body = base64.NewDecoder(base64.StdEncoding, r.Body)
+	}
+	out := &httpWriter{w: w, text: text}
+	// streams outlive the server's write timeout
+	_ = http.NewResponseController(w).SetWriteDeadline(time.Time{})
+
+	conn, ok := p.lookup(r.URL.Path)
+	if !ok {
+		out.finish(contentType, nil, status.Errorf(codes.Unimplemented, "unknown method %s", r.URL.Path), nil)
+		return
+	}
+	ctx, cancel := callContext(r.Context(), r.Header.Get("Grpc-Timeout"))
+	defer cancel()
+	ctx = metadata.NewOutgoingContext(ctx, outgoingMetadata(r.Context(), r.Header, middleware.ClientIP(r)))
+
+	stream, err := conn.NewStream(ctx, streamDesc, r.URL.Path, forceRawCodec)
+	if err != nil {
+		out.finish(contentType, nil, err, nil)
+		return
+	}
+	for {
+		_, payload, err := readFrame(body)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			cancel()
+			out.finish(contentType, nil, status.Error(codes.InvalidArgument, "malformed gRPC-Web request"), nil)
+			return
+		}
+		if err := stream.SendMsg(&payload); err != nil {
+			// the backend's reason surfaces from RecvMsg
+			break
+		}
+	}
+	_ = stream.CloseSend()
+
+	var msg []byte
+	for {
+		err := stream.RecvMsg(&msg)
+		if !out.started {
+			header, _ := stream.Header()
+			out.start(contentType, header)
+		}
+		if err == io.EOF {
+			out.finish(contentType, nil, nil, stream.Trailer())
+			return
+		}
+		if err != nil {
+			out.finish(contentType, nil, err, stream.Trailer())
+			return
+		}
+		out.write(encodeFrame(flagData, msg))
+	}
+}
+
+// httpWriter writes gRPC-Web frames to an HTTP response, base64-encoding
+// them for the text variant
+type httpWriter struct {
+	w       http.ResponseWriter
+	text    bool
+	started bool
+}
+
+func (h *httpWriter) start(contentType string, header metadata.MD) {
+	setHeaderMetadata(h.w.Header(), header)
+	h.w.Header().Set("Content-Type", contentType)
+	h.w.WriteHeader(http.StatusOK)
+	h.started = true
+}
+
+func (h *httpWriter) write(frame []byte) {
+	if h.text {
+		frame = []byte(base64.StdEncoding.EncodeToString(frame))
+	}
+	if _, err := h.w.Write(frame); err != nil {
+		return
+	}
+	if f, ok := h.w.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (h *httpWriter) finish(contentType string, header metadata.MD, err error, trailer metadata.MD) {
+	if !h.started {
+		h.start(contentType, header)
+	}
+	h.write(trailerFrame(err, trailer))
+}
+
+// callContext applies the browser's grpc-timeout, such as "10S" or "500m"
+func callContext(ctx context.Context, timeout string) (context.Context, context.CancelFunc) {
+	if d, ok := parseTimeout(timeout); ok {
+		return context.WithTimeout(ctx, d)
+	}
+	return context.WithCancel(ctx)
+}
+
+// parseTimeout decodes a grpc-timeout: at most 8 digits and a unit
+func parseTimeout(timeout string) (time.Duration, bool) {
+	if len(timeout) < 2 || len(timeout) > 9 {
+		return 0, false
+	}
+	n, err := strconv.ParseUint(timeout[:len(timeout)-1], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	unit := map[byte]time.Duration{
+		'H': time.Hour, 'M': time.Minute, 'S': time.Second,
+		'm': time.Millisecond, 'u': time.Microsecond, 'n': time.Nanosecond,
+	}[timeout[len(timeout)-1]]
+	if unit == 0 {
+		return 0, false
+	}
+	// large hour counts overflow a Duration
+	if n > uint64(math.MaxInt64/unit) {
+		return math.MaxInt64, true
+	}
+	return time.Duration(n) * unit, true
+}
+
+// outgoingMetadata forwards the browser's authorization and X- headers and
+// the verified caller in ctx, the same way the REST gateway does. Headers the
+// gateway owns are only ever set from ctx, never copied from what the browser
+// sent. clientIP is the caller's address as the gateway resolved it.
+func outgoingMetadata(ctx context.Context, header http.Header, clientIP string) metadata.MD {
+	md := metadata.MD{}
+	for k, vs := range header {
+		key := strings.ToLower(k)
+		if key == "authorization" || (strings.HasPrefix(key, "x-") && !middleware.GatewayOwnedHeaders[key] && key != "x-forwarded-for") {
+			md.Append(key, vs...)
+		}
+	}
+	if userID, _ := ctx.Value("user_id").(string); userID != "" {
+		md.Set("user_id", userID)
+		md.Set("user-id", userID)
+		md.Set("x-user-id", userID)
+	}
+	if orgID, _ := ctx.Value("org_id").(string); orgID != "" {
+		md.Set("org_id", orgID)
+		md.Set("org-id", orgID)
+		md.Set("x-org-id", orgID)
+	}
+	if role, _ := ctx.Value("role").(string); role != "" {
+		md.Set("role", role)
+		md.Set("x-role", role)
+	}
+	if method, _ := ctx.Value("auth_method").(string); method != "" {
+		md.Set("x-auth-method", method)
+	}
+	if keyID, _ := ctx.Value("api_key_id").(string); keyID != "" {
+		md.Set("x-api-key-id", keyID)
+	}
+	if clientIP != "" {
+		md.Set(middleware.ClientIPMetadata, clientIP)
+	}
+	return md
+}
+
+func withIdentity(ctx context.Context, id *Identity) context.Context {
+	ctx = context.WithValue(ctx, "user_id", id.UserID)
+	ctx = context.WithValue(ctx, "email", id.Email)
+	ctx = context.WithValue(ctx, "role", id.Role)
+	ctx = context.WithValue(ctx, "org_id", id.OrgID)
+	// whatever authenticated the upgrade request, this call is the token's
+	ctx = context.WithValue(ctx, "auth_method", "")
+	ctx = context.WithValue(ctx, "api_key_id", "")
+	return ctx
+}
+
+func logStreamError(method string, err error) {
+	if err != nil && err != io.EOF {
+		log.Printf("gRPC-Web stream %s ended: %v", method, err)
+	}
+}
@@ -0,0 +1,107 @@
+package grpcweb
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTimeout(t *testing.T) {
+	tests := []struct {
+		timeout string
+		want    time.Duration
+		ok      bool
+	}{
+		{"1H", time.Hour, true},
+		{"2M", 2 * time.Minute, true},
+		{"10S", 10 * time.Second, true},
+		{"500m", 500 * time.Millisecond, true},
+		{"250u", 250 * time.Microsecond, true},
+		{"100n", 100 * time.Nanosecond, true},
+		{"0S", 0, true},
+		{"99999999S", 99999999 * time.Second, true},
+		{"99999999H", math.MaxInt64, true},
+		{"", 0, false},
+		{"S", 0, false},
+		{"10", 0, false},
+		{"10s", 0, false},
+		{"10X", 0, false},
+		{"-1S", 0, false},
+		{"+1S", 0, false},
+		{"1.5S", 0, false},
+		{"123456789S", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.timeout, func(t *testing.T) {
+			got, ok := parseTimeout(tt.timeout)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCallContext(t *testing.T) {
+	ctx, cancel := callContext(context.Background(), "10S")
+	defer cancel()
+	deadline, ok := ctx.Deadline()
+	assert.True(t, ok)
+	assert.WithinDuration(t, time.Now().Add(10*time.Second), deadline, time.Second)
+
+	ctx, cancel = callContext(context.Background(), "bogus")
+	defer cancel()
+	_, ok = ctx.Deadline()
+	assert.False(t, ok)
+}
+
+func TestOutgoingMetadata(t *testing.T) {
+	header := http.Header{
+		"Authorization":          {"Bearer abc"},
+		"X-Request-Id":           {"req-1"},
+		"X-User-Id":              {"forged"},
+		"X-Org-Id":               {"forged"},
+		"X-Role":                 {"super_admin"},
+		"X-Auth-Method":          {"api_key"},
+		"X-Api-Key-Id":           {"forged"},
+		"X-Client-Ip":            {"1.2.3.4"},
+		"X-Forwarded-For":        {"1.2.3.4"},
+		"Grpc-Metadata-User_id":  {"forged"},
+		"Content-Type":           {"application/grpc-web+proto"},
+		"Sec-Websocket-Protocol": {"grpc-websockets"},
+	}
+
+	md := outgoingMetadata(context.Background(), header, "203.0.113.7")
+	assert.Equal(t, []string{"Bearer abc"}, md.Get("authorization"))
+	assert.Equal(t, []string{"req-1"}, md.Get("x-request-id"))
+	assert.Equal(t, []string{"203.0.113.7"}, md.Get("x-client-ip"))
+	for _, key := range []string{"x-user-id", "user_id", "x-org-id", "x-role", "x-auth-method", "x-api-key-id", "x-forwarded-for", "content-type", "grpc-metadata-user_id"} {
+		assert.Empty(t, md.Get(key), "%s", key)
+	}
+
+	ctx := withIdentity(context.Background(), &Identity{UserID: "u1", Role: "member", OrgID: "o1"})
+	md = outgoingMetadata(ctx, header, "")
+	for _, key := range []string{"user_id", "user-id", "x-user-id"} {
+		assert.Equal(t, []string{"u1"}, md.Get(key), "%s", key)
+	}
+	for _, key := range []string{"org_id", "org-id", "x-org-id"} {
+		assert.Equal(t, []string{"o1"}, md.Get(key), "%s", key)
+	}
+	assert.Equal(t, []string{"member"}, md.Get("x-role"))
+	assert.Empty(t, md.Get("x-client-ip"))
+}
+
+func TestOutgoingMetadataAPIKey(t *testing.T) {
+	ctx := context.WithValue(context.Background(), "auth_method", "api_key")
+	ctx = context.WithValue(ctx, "api_key_id", "key-1")
+	md := outgoingMetadata(ctx, http.Header{}, "")
+	assert.Equal(t, []string{"api_key"}, md.Get("x-auth-method"))
+	assert.Equal(t, []string{"key-1"}, md.Get("x-api-key-id"))
+
+	// a token sent in-band replaces whoever authenticated the upgrade
+	md = outgoingMetadata(withIdentity(ctx, &Identity{UserID: "u1"}), http.Header{}, "")
+	assert.Empty(t, md.Get("x-auth-method"))
+	assert.Empty(t, md.Get("x-api-key-id"))
+}
@@ -0,0 +1,175 @@
+package grpcweb
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"net/http"
+	"net/textproto"
+	"strings"
+
+	"github.com/chanduchitikam/task-management-system/gateway/middleware"
+	"github.com/gorilla/websocket"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Messages from the browser start with one of these bytes
+const (
+	wsData       byte = 0x00
+	wsFinishSend byte = 0x01
+)
+
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  4096,
+	WriteBufferSize: 4096,
+	Subprotocols:    []string{"grpc-websockets"},
+	// calls carry their token in-band rather than in cookies, so a foreign
+	// origin gains nothing by opening one
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+// serveWebSocket proxies a call over the grpc-websockets transport. The
+// browser's first message holds the request headers, authorization
+// included; later ones carry request frames until it finishes sending.
+// Every message to the browser is one response frame, headers first.
+func (p *Proxy) serveWebSocket(w http.ResponseWriter, r *http.Request) {
+	ws, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer ws.Close()
+	ws.SetReadLimit(maxMessageSize + 16)
+	method := r.URL.Path
+
+	_, first, err := ws.ReadMessage()
+	if err != nil {
+		return
+	}
+	header, err := parseHeaders(first)
+	if err != nil {
+		writeWS(ws, trailerFrame(status.Error(codes.InvalidArgument, "malformed request headers"), nil))
+		return
+	}
+
+	ctx, cancel := callContext(r.Context(), header.Get("Grpc-Timeout"))
+	defer cancel()
+	if token := strings.TrimSpace(strings.TrimPrefix(header.Get("Authorization"), "Bearer")); token != "" {
+		id, err := p.verify(r, token)
+		if err != nil {
+			if _, ok := status.FromError(err); !ok {
+				err = status.Error(codes.Unauthenticated, err.Error())
+			}
+			writeWS(ws, trailerFrame(err, nil))
+			return
+		}
+		ctx = withIdentity(ctx, id)
+	}
+
+	conn, ok := p.lookup(method)
+	if !ok {
+		writeWS(ws, trailerFrame(status.Errorf(codes.Unimplemented, "unknown method %s", method), nil))
+		return
+	}
+	outCtx := metadata.NewOutgoingContext(ctx, outgoingMetadata(ctx, header, middleware.ClientIP(r)))
+	stream, err := conn.NewStream(outCtx, streamDesc, method, forceRawCodec)
+	if err != nil {
+		writeWS(ws, trailerFrame(err, nil))
+		return
+	}
+
+	// request bytes may be split across messages arbitrarily
+	body, bodyWriter := io.Pipe()
+	go func() {
+		for {
+			_, msg, err := ws.ReadMessage()
+			if err != nil {
+				bodyWriter.CloseWithError(err)
+				cancel()
+				return
+			}
+			if len(msg) == 0 {
+				continue
+			}
+			switch msg[0] {
+			case wsData:
+				if _, err := bodyWriter.Write(msg[1:]); err != nil {
+					return
+				}
+			case wsFinishSend:
+				bodyWriter.Close()
+			}
+		}
+	}()
+	go func() {
+		defer body.Close()
+		for {
+			_, payload, err := readFrame(body)
+			if err != nil {
+				if err != io.EOF {
+					logStreamError(method, err)
+					cancel()
+				}
+				_ = stream.CloseSend()
+				return
+			}
+			if err := stream.SendMsg(&payload); err != nil {
+				_ = stream.CloseSend()
+				return
+			}
+		}
+	}()
+
+	sentHeader := false
+	var msg []byte
+	for {
+		err := stream.RecvMsg(&msg)
+		if !sentHeader {
+			md, _ := stream.Header()
+			if !writeWS(ws, headerFrame(md)) {
+				return
+			}
+			sentHeader = true
+		}
+		if err != nil {
+			if err == io.EOF {
+				err = nil
+			}
+			logStreamError(method, err)
+			writeWS(ws, trailerFrame(err, stream.Trailer()))
+			_ = ws.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+			return
+		}
+		if !writeWS(ws, encodeFrame(flagData, msg)) {
+			return
+		}
+	}
+}
+
+func writeWS(ws *websocket.Conn, frame []byte) bool {
+	return ws.WriteMessage(websocket.BinaryMessage, frame) == nil
+}
+
+// headerFrame encodes response headers the way the browser client expects
+// them on this transport: as a trailer-flagged frame sent first
+func headerFrame(md metadata.MD) []byte {
+	h := http.Header{}
+	setHeaderMetadata(h, md)
+	h.Set("Content-Type", "application/grpc-web+proto")
+	var buf bytes.Buffer
+	_ = h.Write(&buf)
+	return encodeFrame(flagTrailer, buf.Bytes())
+}
+
+// parseHeaders reads "Key: value" lines sent as the first message
+func parseHeaders(b []byte) (http.Header, error) {
+	if !bytes.HasSuffix(b, []byte("\r\n\r\n")) {
+		b = append(bytes.TrimRight(b, "\r\n"), "\r\n\r\n"...)
+	}
+	h, err := textproto.NewReader(bufio.NewReader(bytes.NewReader(b))).ReadMIMEHeader()
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	return http.Header(h), nil
+}
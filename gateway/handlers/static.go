@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/chanduchitikam/task-management-system/gateway/middleware"
+)
+
+// immutablePrefixes hold build assets whose names carry a content hash, so
+// they never change under the same URL
+var immutablePrefixes = []string{"/_next/static/", "/assets/", "/static/"}
+
+// StaticHandler serves a built single-page frontend alongside the API, so
+// one container can serve the whole app. Paths that are not files get
+// index.html, leaving routing to the frontend.
+type StaticHandler struct {
+	root string
+}
+
+// NewStaticHandler serves the frontend build in dir, which must contain
+// index.html
+func NewStaticHandler(dir string) (*StaticHandler, error) {
+	root, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	if info, err := os.Stat(filepath.Join(root, "index.html")); err != nil || info.IsDir() {
+		return nil, fmt.Errorf("%s has no index.html", dir)
+	}
+	return &StaticHandler{root: root}, nil
+}
+
+// Wrap serves the frontend for page loads and passes API, WebSocket,
+// gRPC-Web and metrics requests to next
+func (h *StaticHandler) Wrap(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !h.handles(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		h.serve(w, r)
+	})
+}
+
+func (h *StaticHandler) handles(r *http.Request) bool {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		return false
+	}
+	if r.Header.Get("Upgrade") != "" || strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc-web") {
+		return false
+	}
+	p := r.URL.Path
+	return !strings.HasPrefix(p, "/api/") && p != "/metrics" && p != "/ws" && !strings.HasPrefix(p, "/ws/")
+}
+
+func (h *StaticHandler) serve(w http.ResponseWriter, r *http.Request) {
+	name := path.Clean("/" + r.URL.Path)
+	if f, info, ok := h.open(name); ok {
+		defer f.Close()
+		if immutable(name) {
+			w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+		} else {
+			w.Header().Set("Cache-Control", "no-cache")
+		}
+		http.ServeContent(w, r, info.Name(), info.ModTime(), f)
+		return
+	}
+	// a missing asset is an error, not a page for the frontend to route
+	if path.Ext(name) != "" {
+		middleware.WriteProblem(w, r, http.StatusNotFound, middleware.CodeNotFound, "no such file")
+		return
+	}
+
+	f, info, ok := h.open("/index.html")
+	if !ok {
+		middleware.WriteProblem(w, r, http.StatusServiceUnavailable, middleware.CodeUnavailable, "frontend is unavailable")
+		return
+	}
+	defer f.Close()
+	// index.html names the current assets, so it must always be revalidated
+	w.Header().Set("Cache-Control", "no-cache")
+	http.ServeContent(w, r, "index.html", info.ModTime(), f)
+}
+
+// open returns the regular file at the cleaned URL path name, trying
+// name.html and name/index.html as static exports lay pages out
+func (h *StaticHandler) open(name string) (*os.File, os.FileInfo, bool) {
+	candidates := []string{name}
+	if name != "/" && path.Ext(name) == "" {
+		candidates = append(candidates, name+".html")
+	}
+	candidates = append(candidates, path.Join(name, "index.html"))
+	for _, c := range candidates {
+		f, err := os.Open(filepath.Join(h.root, filepath.FromSlash(c)))
+		if err != nil {
+			continue
+		}
+		info, err := f.Stat()
+		if err != nil || info.IsDir() {
+			f.Close()
+			continue
+		}
+		return f, info, true
+	}
+	return nil, nil, false
+}
+
+func immutable(name string) bool {
+	for _, prefix := range immutablePrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -1,17 +1,35 @@
 package handlers
 
 import (
+	"context"
+	"encoding/json"
 	"net/http"
 	"strings"
+	"time"
 
+	"github.com/chanduchitikam/task-management-system/gateway/middleware"
 	"github.com/chanduchitikam/task-management-system/gateway/websocket"
 	"github.com/chanduchitikam/task-management-system/pkg/auth"
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
 )
 
 // // // WebSocketHandler handles WebSocket connections
 type WebSocketHandler struct {
 	hub        *websocket.Hub
 	jwtManager *auth.JWTManager
+	sessions   SessionChecker
+	networks   NetworkChecker
+	limits     *middleware.ConcurrencyLimiter
+}
+
+// SessionChecker reports whether a user's token was revoked after issue
+type SessionChecker interface {
+	IsRevoked(ctx context.Context, userID string, issuedAt time.Time) bool
+}
+
+// NetworkChecker reports whether an org's IP allowlist admits a request
+type NetworkChecker interface {
+	Allows(r *http.Request, orgID, userID string) bool
 }
 
 // // // NewWebSocketHandler creates a new WebSocket handler
@@ -22,6 +40,23 @@ func NewWebSocketHandler(hub *websocket.Hub, jwtManager *auth.JWTManager) *WebSo
 	}
 }
 
+// SetSessionRevocations makes connections with revoked tokens fail
+func (h *WebSocketHandler) SetSessionRevocations(sessions SessionChecker) {
+	h.sessions = sessions
+}
+
+// SetIPAllowlists makes connections for an org fail from networks its
+// allowlist does not admit. The token is only read here, so the allowlist
+// middleware can't check it.
+func (h *WebSocketHandler) SetIPAllowlists(networks NetworkChecker) {
+	h.networks = networks
+}
+
+// SetConnectionLimits caps the connections each user and org may hold open
+func (h *WebSocketHandler) SetConnectionLimits(limits *middleware.ConcurrencyLimiter) {
+	h.limits = limits
+}
+
 // // // HandleConnection handles WebSocket connection requests
 func (h *WebSocketHandler) HandleConnection(w http.ResponseWriter, r *http.Request) {
 	// 	// 	// Extract JWT token from query parameter or Authorization header
@@ -34,33 +69,70 @@ func (h *WebSocketHandler) HandleConnection(w http.ResponseWriter, r *http.Reque
 	}
 
 	if token == "" {
-		http.Error(w, "Missing authentication token", http.StatusUnauthorized)
+		middleware.WriteProblem(w, r, http.StatusUnauthorized, middleware.CodeUnauthenticated, "missing authentication token")
 		return
 	}
 
 	// 	// 	// Verify JWT token
 	claims, err := h.jwtManager.Verify(token)
 	if err != nil {
-		http.Error(w, "Invalid authentication token", http.StatusUnauthorized)
+		middleware.WriteProblem(w, r, http.StatusUnauthorized, middleware.CodeInvalidToken, "invalid authentication token")
+		return
+	}
+	if h.sessions != nil && claims.IssuedAt != nil && h.sessions.IsRevoked(r.Context(), claims.UserID, claims.IssuedAt.Time) {
+		middleware.WriteProblem(w, r, http.StatusUnauthorized, middleware.CodeSessionRevoked, "session has been revoked")
+		return
+	}
+	if h.networks != nil && claims.OrgID != "" && !h.networks.Allows(r, claims.OrgID, claims.UserID) {
+		middleware.WriteProblem(w, r, http.StatusForbidden, middleware.CodeIPNotAllowed, "your organization does not allow access from this network")
 		return
 	}
 
+	// Tokens outlive org removal; drop the org scope if membership was revoked
+	orgID := claims.OrgID
+	if !h.hub.HasOrgMembership(orgID, claims.UserID) {
+		orgID = ""
+	}
+
+	release := func() {}
+	if h.limits != nil {
+		var ok bool
+		if release, ok = h.limits.Acquire(claims.UserID, orgID); !ok {
+			w.Header().Set("Retry-After", "30")
+			middleware.WriteProblem(w, r, http.StatusTooManyRequests, middleware.CodeTooManyConcurrent, "too many open connections")
+			return
+		}
+	}
+
 	// 	// 	// Upgrade connection and start client
-	websocket.ServeWs(h.hub, w, r, claims.UserID)
+	done := websocket.ServeWs(h.hub, w, r, claims.UserID, orgID, claims.Role)
+	if done == nil {
+		release()
+		return
+	}
+	go func() {
+		<-done
+		release()
+	}()
 }
 
-// // // HandleStats returns WebSocket hub statistics
+// // // HandleStats returns WebSocket hub statistics. Connection counts per
+// org are shown to platform admins for every org, and to other callers for
+// their own org only.
 func (h *WebSocketHandler) HandleStats(w http.ResponseWriter, r *http.Request) {
-	w.Header().Set("Content-Type", "application/json")
 	stats := h.hub.GetStats()
+	if role, _ := r.Context().Value("role").(string); !authz.IsPlatformAdmin(role) {
+		orgID, _ := r.Context().Value("org_id").(string)
+		own := make(map[string]int)
+		if n, ok := stats.OrgClients[orgID]; ok && orgID != "" {
+			own[orgID] = n
+		}
+		stats.OrgClients = own
+	}
 
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
-	// 	// 	// Simple JSON encoding without importing encoding/json again
-	w.Write([]byte(`{"total_users":`))
-	w.Write([]byte(string(rune(stats["total_users"].(int) + '0'))))
-	w.Write([]byte(`,"total_clients":`))
-	w.Write([]byte(string(rune(stats["total_clients"].(int) + '0'))))
-	w.Write([]byte(`}`))
+	json.NewEncoder(w).Encode(stats)
 }
 
 // // // HandleOnlineUsers returns list of online users
@@ -70,15 +142,5 @@ func (h *WebSocketHandler) HandleOnlineUsers(w http.ResponseWriter, r *http.Requ
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 
-	// 	// 	// Simple JSON array encoding
-	w.Write([]byte(`{"users":[`))
-	for i, user := range users {
-		if i > 0 {
-			w.Write([]byte(`,`))
-		}
-		w.Write([]byte(`"`))
-		w.Write([]byte(user))
-		w.Write([]byte(`"`))
-	}
-	w.Write([]byte(`]}`))
+	json.NewEncoder(w).Encode(map[string][]string{"users": users})
 }
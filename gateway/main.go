@@ -7,11 +7,18 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"sync/atomic"
 	"time"
 
+	"github.com/chanduchitikam/task-management-system/gateway/grpcweb"
+	"github.com/chanduchitikam/task-management-system/gateway/handlers"
 	"github.com/chanduchitikam/task-management-system/gateway/middleware"
+	"github.com/chanduchitikam/task-management-system/gateway/websocket"
 	"github.com/chanduchitikam/task-management-system/pkg/auth"
+	"github.com/chanduchitikam/task-management-system/pkg/cache"
+	"github.com/chanduchitikam/task-management-system/pkg/certs"
 	"github.com/chanduchitikam/task-management-system/pkg/config"
+	"github.com/chanduchitikam/task-management-system/pkg/discovery"
 	notificationpb "github.com/chanduchitikam/task-management-system/proto/notification"
 	organizationpb "github.com/chanduchitikam/task-management-system/proto/organization"
 	taskpb "github.com/chanduchitikam/task-management-system/proto/task"
@@ -20,15 +27,30 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
-// hasScheme reports whether the provided address already contains a URI scheme
-func hasScheme(addr string) bool {
-	// simple check for scheme like "dns:///host:port" or "http://"
-	return strings.Contains(addr, "://")
+// podID names this gateway replica in shared WebSocket presence
+func podID() string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "gateway"
+	}
+	return fmt.Sprintf("%s-%d", host, os.Getpid())
+}
+
+// splitList splits a comma-separated setting, dropping empty entries
+func splitList(value string) []string {
+	var out []string
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
 }
 
 func getEnvOrDefault(key, defaultValue string) string {
@@ -39,11 +61,27 @@ func getEnvOrDefault(key, defaultValue string) string {
 }
 
 func main() {
+	// Settings in GATEWAY_ENV_FILE override the environment, and can be
+	// changed there and reloaded without a restart
+	envFile := os.Getenv("GATEWAY_ENV_FILE")
+	if envFile != "" {
+		if err := config.LoadEnvFile(envFile); err != nil {
+			log.Fatalf("Failed to read GATEWAY_ENV_FILE: %v", err)
+		}
+	}
+
 	// 	// 	// Load configuration
 	cfg, err := config.LoadConfig()
 	if err != nil {
 		log.Fatalf("Failed to load config: %v", err)
 	}
+	if err := cfg.Validate(); err != nil {
+		log.Fatalf("Invalid config: %v", err)
+	}
+	settings, err := loadGatewaySettings()
+	if err != nil {
+		log.Fatalf("Invalid gateway config: %v", err)
+	}
 
 	// 	// 	// Create logger
 	logger, err := zap.NewProduction()
@@ -53,22 +91,22 @@ func main() {
 	defer logger.Sync()
 
 	// 	// 	// Create JWT manager
-	jwtManager := auth.NewJWTManager(
-		cfg.JWT.SecretKey,
-		cfg.JWT.AccessTokenDuration,
-		cfg.JWT.RefreshTokenDuration,
-	)
+	jwtManager, err := auth.NewJWTManagerFromConfig(cfg.JWT)
+	if err != nil {
+		log.Fatalf("Invalid JWT config: %v", err)
+	}
 
-	// 	// 	// Create middleware (for future HTTP-level implementation)
-	_ = middleware.NewAuthInterceptor(jwtManager)
-	rateLimiter := middleware.NewRateLimiter(100, 10) // 100 req/sec, burst of 10
-	_ = middleware.NewLoggingInterceptor(logger)
+	// 	// 	// Create middleware, applied to calls the gateway proxies
+	authInterceptor := middleware.NewAuthInterceptor(jwtManager)
 
-	// 	// 	// Start cleanup for rate limiter
-	rateLimiter.CleanupLimiters(5 * time.Minute)
+	// Browsers may keep their session in httpOnly cookies when
+	// SESSION_COOKIES=true; see sessionCookiesFromEnv
+	sessionCookies := sessionCookiesFromEnv(cfg, jwtManager)
 
 	// 	// 	// Create gRPC-Gateway mux with metadata forwarder
 	mux := runtime.NewServeMux(
+		runtime.WithErrorHandler(middleware.HTTPErrorHandler),
+		runtime.WithForwardResponseOption(sessionCookies.ForwardResponse),
 		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
 			MarshalOptions: protojson.MarshalOptions{
 				EmitDefaultValues: true, // Include false boolean values in JSON
@@ -96,62 +134,92 @@ func main() {
 				md.Set("org_id", val)
 				md.Set("org-id", val)
 			}
+			// The caller's address as resolved behind TRUSTED_PROXIES, for
+			// login throttling and history
+			md.Set(middleware.ClientIPMetadata, middleware.ClientIP(req))
+			// The browser's user agent, for login history
+			if val := req.UserAgent(); val != "" {
+				md.Set("x-client-user-agent", val)
+			}
+			// Browser hints that, with the user agent, tell devices apart
+			// for new-device sign-in alerts
+			if val := req.Header.Get("Accept-Language"); val != "" {
+				md.Set("x-client-accept-language", val)
+			}
+			if val := req.Header.Get("Sec-CH-UA-Platform"); val != "" {
+				md.Set("x-client-platform", val)
+			}
 			return md
 		}),
 	)
+	// Backends are dialed over TLS, presenting the gateway's client
+	// certificate, once a CA is configured
+	dialCreds, err := certs.DialCredentials(cfg.TLS)
+	if err != nil {
+		log.Fatalf("Invalid backend TLS config: %v", err)
+	}
 	opts := []grpc.DialOption{
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(dialCreds),
 	}
+	// Proxied calls are authenticated. The gateway's own clients (API key
+	// lookups, usage reports) dial with opts alone.
+	proxyOpts := append(opts[:len(opts):len(opts)],
+		grpc.WithChainUnaryInterceptor(authInterceptor.UnaryClient()),
+		grpc.WithChainStreamInterceptor(authInterceptor.StreamClient()),
+	)
 
-	ctx := context.Background()
-
-	// 	// 	// Register UserService with DNS-scheme fallback
-	userServiceAddr := getEnvOrDefault("USER_SERVICE_ADDR", fmt.Sprintf("localhost:%d", cfg.Server.GRPCPort))
-	if err := userpb.RegisterUserServiceHandlerFromEndpoint(ctx, mux, userServiceAddr, opts); err != nil {
-		// Retry using explicit DNS resolver scheme for Docker service names
-		dnsAddr := userServiceAddr
-		if !hasScheme(userServiceAddr) {
-			dnsAddr = "dns:///" + userServiceAddr
-		}
-		if err2 := userpb.RegisterUserServiceHandlerFromEndpoint(ctx, mux, dnsAddr, opts); err2 != nil {
-			log.Fatalf("Failed to register UserService (attempts: %v, %v): %v", err, err2, err2)
-		}
+	// Each backend gets a circuit breaker, so requests to one that is down
+	// fail fast with 503 instead of waiting out the HTTP timeout. Calls give
+	// up before that timeout so a hung backend trips its breaker, and reads
+	// are retried across backend restarts within it.
+	backendOpts := func(backend string) []grpc.DialOption {
+		breaker := middleware.NewCircuitBreaker(backend, 5, 30*time.Second)
+		return append(proxyOpts[:len(proxyOpts):len(proxyOpts)], grpc.WithChainUnaryInterceptor(
+			breaker.UnaryClient(),
+			middleware.CallTimeout(25*time.Second),
+			middleware.DefaultRetryPolicy.UnaryClient(),
+		))
 	}
 
-	// 	// 	// Register TaskService with DNS-scheme fallback
-	taskServiceAddr := getEnvOrDefault("TASK_SERVICE_ADDR", fmt.Sprintf("localhost:%d", cfg.Server.GRPCPort+1))
-	if err := taskpb.RegisterTaskServiceHandlerFromEndpoint(ctx, mux, taskServiceAddr, opts); err != nil {
-		dnsAddr := taskServiceAddr
-		if !hasScheme(taskServiceAddr) {
-			dnsAddr = "dns:///" + taskServiceAddr
-		}
-		if err2 := taskpb.RegisterTaskServiceHandlerFromEndpoint(ctx, mux, dnsAddr, opts); err2 != nil {
-			log.Fatalf("Failed to register TaskService (attempts: %v, %v): %v", err, err2, err2)
-		}
+	// Backends are found through SERVICE_DISCOVERY (dns, static, consul or
+	// kubernetes) from the *_SERVICE_ADDR variables
+	if err := discovery.Setup(cfg.Discovery); err != nil {
+		log.Fatalf("Invalid service discovery config: %v", err)
 	}
-
-	// 	// 	// Register NotificationService with DNS-scheme fallback
+	mode := cfg.Discovery.Mode
+	userServiceAddr := getEnvOrDefault("USER_SERVICE_ADDR", fmt.Sprintf("localhost:%d", cfg.Server.GRPCPort))
+	taskServiceAddr := getEnvOrDefault("TASK_SERVICE_ADDR", fmt.Sprintf("localhost:%d", cfg.Server.GRPCPort+1))
 	notificationServiceAddr := getEnvOrDefault("NOTIFICATION_SERVICE_ADDR", fmt.Sprintf("localhost:%d", cfg.Server.GRPCPort+2))
-	if err := notificationpb.RegisterNotificationServiceHandlerFromEndpoint(ctx, mux, notificationServiceAddr, opts); err != nil {
-		dnsAddr := notificationServiceAddr
-		if !hasScheme(notificationServiceAddr) {
-			dnsAddr = "dns:///" + notificationServiceAddr
-		}
-		if err2 := notificationpb.RegisterNotificationServiceHandlerFromEndpoint(ctx, mux, dnsAddr, opts); err2 != nil {
-			log.Fatalf("Failed to register NotificationService (attempts: %v, %v): %v", err, err2, err2)
+	orgServiceAddr := getEnvOrDefault("ORG_SERVICE_ADDR", fmt.Sprintf("localhost:%d", cfg.Server.GRPCPort+3))
+
+	ctx := context.Background()
+	dialBackend := func(name, addr string) *grpc.ClientConn {
+		conn, err := discovery.Dial(ctx, name, mode, addr, backendOpts(name)...)
+		if err != nil {
+			log.Fatalf("Failed to create %s client for %s: %v", name, addr, err)
 		}
+		return conn
 	}
+	userBackend := dialBackend("user", userServiceAddr)
+	defer userBackend.Close()
+	taskBackend := dialBackend("task", taskServiceAddr)
+	defer taskBackend.Close()
+	notificationBackend := dialBackend("notification", notificationServiceAddr)
+	defer notificationBackend.Close()
+	orgBackend := dialBackend("organization", orgServiceAddr)
+	defer orgBackend.Close()
 
-	// 	// 	// Register OrganizationService with DNS-scheme fallback
-	orgServiceAddr := getEnvOrDefault("ORG_SERVICE_ADDR", fmt.Sprintf("localhost:%d", cfg.Server.GRPCPort+3))
-	if err := organizationpb.RegisterOrganizationServiceHandlerFromEndpoint(ctx, mux, orgServiceAddr, opts); err != nil {
-		dnsAddr := orgServiceAddr
-		if !hasScheme(orgServiceAddr) {
-			dnsAddr = "dns:///" + orgServiceAddr
-		}
-		if err2 := organizationpb.RegisterOrganizationServiceHandlerFromEndpoint(ctx, mux, dnsAddr, opts); err2 != nil {
-			log.Fatalf("Failed to register OrganizationService (attempts: %v, %v): %v", err, err2, err2)
-		}
+	if err := userpb.RegisterUserServiceHandler(ctx, mux, userBackend); err != nil {
+		log.Fatalf("Failed to register UserService: %v", err)
+	}
+	if err := taskpb.RegisterTaskServiceHandler(ctx, mux, taskBackend); err != nil {
+		log.Fatalf("Failed to register TaskService: %v", err)
+	}
+	if err := notificationpb.RegisterNotificationServiceHandler(ctx, mux, notificationBackend); err != nil {
+		log.Fatalf("Failed to register NotificationService: %v", err)
+	}
+	if err := organizationpb.RegisterOrganizationServiceHandler(ctx, mux, orgBackend); err != nil {
+		log.Fatalf("Failed to register OrganizationService: %v", err)
 	}
 
 	// Register metrics endpoint
@@ -161,28 +229,203 @@ func main() {
 		log.Fatalf("Failed to register /metrics endpoint: %v", err)
 	}
 
+	// WebSocket hub for real-time events; membership and session revocation
+	// checks, and fan-out across gateway replicas, need Redis
+	hub := websocket.NewHub()
+	hub.SetCommandBackend(websocket.NewGRPCCommandBackend(taskBackend, orgBackend, notificationBackend))
+	go hub.Run()
+	var sessions *middleware.SessionRevocations
+	redisClient, err := cache.NewRedisClient(cfg.Redis.GetRedisAddr(), cfg.Redis.Password, cfg.Redis.DB)
+	if err != nil {
+		logger.Warn("Redis unavailable, WebSocket org membership checks disabled and rate limits counted per replica", zap.Error(err))
+	} else {
+		membership := websocket.NewMembershipCache(redisClient, time.Minute)
+		hub.SetMembershipChecker(membership)
+		go membership.Listen(ctx, hub)
+		go websocket.ListenNotificationEvents(ctx, redisClient, hub)
+		go websocket.ListenTaskEvents(ctx, redisClient, hub)
+		hub.EnableRelay(ctx, redisClient, podID())
+		sessions = middleware.NewSessionRevocations(redisClient, 10*time.Second)
+	}
+	wsHandler := handlers.NewWebSocketHandler(hub, jwtManager)
+	if sessions != nil {
+		wsHandler.SetSessionRevocations(sessions)
+	}
+	wsLimits := middleware.NewConcurrencyLimiter(settings.WSConnectionsPerUser, settings.WSConnectionsPerOrg)
+	wsHandler.SetConnectionLimits(wsLimits)
+	for path, h := range map[string]http.HandlerFunc{
+		"/ws":        wsHandler.HandleConnection,
+		"/ws/stats":  wsHandler.HandleStats,
+		"/ws/online": wsHandler.HandleOnlineUsers,
+	} {
+		if err := mux.HandlePath("GET", path, func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+			h(w, r)
+		}); err != nil {
+			log.Fatalf("Failed to register %s endpoint: %v", path, err)
+		}
+	}
+
+	// API keys are resolved through the user service
+	userConn, err := discovery.Dial(ctx, "user (internal)", mode, userServiceAddr, opts...)
+	if err != nil {
+		log.Fatalf("Failed to create UserService client: %v", err)
+	}
+	defer userConn.Close()
+	apiKeys := middleware.NewAPIKeyAuthenticator(userpb.NewUserServiceClient(userConn), 30*time.Second)
+	apiKeys.Cleanup(5 * time.Minute)
+
+	// Requests are counted per organization for usage reports
+	orgConn, err := discovery.Dial(ctx, "organization (internal)", mode, orgServiceAddr, opts...)
+	if err != nil {
+		log.Fatalf("Failed to create OrganizationService client: %v", err)
+	}
+	defer orgConn.Close()
+	usage := middleware.NewUsageRecorder(organizationpb.NewOrganizationServiceClient(orgConn), logger)
+	go usage.Run(ctx, time.Minute)
+
+	// Orgs may restrict the networks their members connect from; client
+	// addresses are taken from X-Forwarded-For only behind TRUSTED_PROXIES
+	if err := middleware.TrustProxies(splitList(os.Getenv("TRUSTED_PROXIES"))); err != nil {
+		log.Fatalf("Invalid TRUSTED_PROXIES: %v", err)
+	}
+	ipAllowlists := middleware.NewIPAllowlists(userpb.NewUserServiceClient(userConn), 30*time.Second)
+	wsHandler.SetIPAllowlists(ipAllowlists)
+
+	// Rate limits are shared across replicas through Redis
+	rateLimiter := middleware.NewRateLimiter(redisClient, middleware.DefaultRateLimitPolicies)
+	rateLimiter.CleanupLimiters(5 * time.Minute)
+
+	// A single client may only have so many requests in flight on this
+	// replica, however slowly it sends them
+	concurrency := middleware.NewConcurrencyLimiter(settings.RequestsPerUser, settings.RequestsPerOrg)
+
+	// One access log line per request; successful requests are sampled at
+	// ACCESS_LOG_SAMPLE_RATE (0-1)
+	accessLog := middleware.NewAccessLogger(logger, settings.AccessLogSampleRate)
+
+	// Bodies of the PAYLOAD_LOG_ROUTES prefixes are logged, with secrets
+	// redacted, in the PAYLOAD_LOG_ENVIRONMENTS only
+	var payloadLog *middleware.PayloadLogger
+	for _, env := range splitList(getEnvOrDefault("PAYLOAD_LOG_ENVIRONMENTS", "development")) {
+		if env == cfg.Server.Environment {
+			payloadLog = middleware.NewPayloadLogger(logger, splitList(os.Getenv("PAYLOAD_LOG_ROUTES")))
+		}
+	}
+
+	// Invalid or expired tokens on API routes are rejected unless
+	// GATEWAY_STRICT_TOKENS=false
+	var strictTokens atomic.Bool
+	strictTokens.Store(settings.StrictTokens)
+
+	// JWT keys and the settings above are reloaded on SIGHUP, or when a
+	// platform admin asks
+	reloads := &reloader{
+		envFile:      envFile,
+		jwtManager:   jwtManager,
+		accessLog:    accessLog,
+		strictTokens: &strictTokens,
+		requests:     concurrency,
+		wsConns:      wsLimits,
+		cfg:          cfg,
+		settings:     settings,
+	}
+	go reloads.onSIGHUP()
+	if sessionCookies != nil {
+		if err := mux.HandlePath("POST", "/api/v1/auth/logout", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+			sessionCookies.HandleLogout(w, r)
+		}); err != nil {
+			log.Fatalf("Failed to register logout endpoint: %v", err)
+		}
+	}
+	if err := mux.HandlePath("POST", "/api/v1/admin/gateway/reload", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		reloads.handleReload(w, r)
+	}); err != nil {
+		log.Fatalf("Failed to register reload endpoint: %v", err)
+	}
+	if err := mux.HandlePath("GET", "/api/v1/admin/gateway/config", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		reloads.handleConfig(w, r)
+	}); err != nil {
+		log.Fatalf("Failed to register config endpoint: %v", err)
+	}
+
+	// Browsers may call the services directly with gRPC-Web, streaming
+	// RPCs included. Over its WebSocket transport the token arrives in-band
+	// and is checked the same way corsMiddleware checks it.
+	grpcWeb := grpcweb.NewProxy(func(r *http.Request, token string) (*grpcweb.Identity, error) {
+		claims, err := jwtManager.ValidateToken(token)
+		if err != nil {
+			return nil, err
+		}
+		if sessions != nil && claims.IssuedAt != nil && sessions.IsRevoked(r.Context(), claims.UserID, claims.IssuedAt.Time) {
+			return nil, status.Error(codes.Unauthenticated, "session has been revoked")
+		}
+		if claims.OrgID != "" && !ipAllowlists.Allows(r, claims.OrgID, claims.UserID) {
+			return nil, status.Error(codes.PermissionDenied, "your organization does not allow access from this network")
+		}
+		return &grpcweb.Identity{UserID: claims.UserID, Email: claims.Email, Role: claims.Role, OrgID: claims.OrgID}, nil
+	})
+	// streams skip the backends' unary breaker and retry interceptors
+	for service, conn := range map[string]*grpc.ClientConn{
+		"user.UserService":                 userBackend,
+		"task.TaskService":                 taskBackend,
+		"notification.NotificationService": notificationBackend,
+		"organization.OrganizationService": orgBackend,
+	} {
+		grpcWeb.Register(service, conn)
+	}
+
 	// 	// 	// Add CORS middleware
-	handler := corsMiddleware(mux, jwtManager)
+	handler := corsMiddleware(ipAllowlists.Handler(rateLimiter.Handler(concurrency.Handler(usage.Handler(grpcWeb.Handler(mux))))), jwtManager, apiKeys, sessions, &strictTokens)
+	handler = sessionCookies.Handler(handler)
+
+	// The frontend build in FRONTEND_DIR, if set, is served from the same
+	// origin as the API
+	if dir := os.Getenv("FRONTEND_DIR"); dir != "" {
+		static, err := handlers.NewStaticHandler(dir)
+		if err != nil {
+			log.Fatalf("Invalid FRONTEND_DIR: %v", err)
+		}
+		handler = static.Wrap(handler)
+		logger.Info("Serving frontend", zap.String("dir", dir))
+	}
+	handler = accessLog.Handler(payloadLog.Handler(handler))
 
 	// 	// 	// Start HTTP server
 	addr := fmt.Sprintf(":%d", cfg.Server.HTTPPort)
 	logger.Info("API Gateway listening", zap.String("addr", addr))
 
+	// Browsers are not asked for client certificates
+	tlsConfig, err := certs.ServerConfig(cfg.TLS, false)
+	if err != nil {
+		log.Fatalf("Invalid TLS config: %v", err)
+	}
 	server := &http.Server{
 		Addr:         addr,
 		Handler:      handler,
 		ReadTimeout:  30 * time.Second,
 		WriteTimeout: 30 * time.Second,
+		TLSConfig:    tlsConfig,
 	}
 
-	if err := server.ListenAndServe(); err != nil {
+	if tlsConfig != nil {
+		// the certificate comes from TLSConfig, so it can be reloaded
+		err = server.ListenAndServeTLS("", "")
+	} else {
+		err = server.ListenAndServe()
+	}
+	if err != nil {
 		log.Fatalf("Failed to serve: %v", err)
 	}
 }
 
 // corsMiddleware validates JWT (when present), injects claims into the
 // request context and also adds CORS headers expected by the frontend.
-func corsMiddleware(next http.Handler, jwtManager *auth.JWTManager) http.Handler {
+// API keys (Authorization: Bearer tfk_... or X-API-Key) are accepted in place
+// of a JWT and limited to the routes their scopes cover. Tokens issued before
+// a user's sessions were revoked are rejected. In strict mode, so are
+// malformed or expired tokens on API routes other than the sign-in ones,
+// rather than being forwarded without an identity.
+func corsMiddleware(next http.Handler, jwtManager *auth.JWTManager, apiKeys *middleware.APIKeyAuthenticator, sessions *middleware.SessionRevocations, strictTokens *atomic.Bool) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		origin := r.Header.Get("Origin")
 		if origin == "" {
@@ -190,49 +433,137 @@ func corsMiddleware(next http.Handler, jwtManager *auth.JWTManager) http.Handler
 		}
 		w.Header().Set("Access-Control-Allow-Origin", origin)
 		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Requested-With")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Requested-With, X-API-Key, X-Request-Id, X-Grpc-Web, X-User-Agent, Grpc-Timeout, X-CSRF-Token, X-Session-Mode")
 		w.Header().Set("Access-Control-Allow-Credentials", "true")
 		w.Header().Set("Access-Control-Max-Age", "86400")
+		w.Header().Set("Access-Control-Expose-Headers", "X-RateLimit-Limit, X-RateLimit-Remaining, X-RateLimit-Reset, Retry-After, X-Request-Id, Grpc-Status, Grpc-Message")
 
 		if r.Method == "OPTIONS" {
 			w.WriteHeader(http.StatusOK)
 			return
 		}
 
+		// identity headers are only ever set from the verified caller below
+		middleware.StripIdentityHeaders(r.Header)
+
 		// If an Authorization header is present, try to validate and inject claims
 		authHeader := r.Header.Get("Authorization")
-		if authHeader != "" {
-			token := strings.TrimSpace(strings.TrimPrefix(authHeader, "Bearer"))
-			token = strings.TrimSpace(token)
-			if token != "" && jwtManager != nil {
-				if claims, err := jwtManager.ValidateToken(token); err == nil {
-					ctx := r.Context()
-					ctx = context.WithValue(ctx, "user_id", claims.UserID)
-					ctx = context.WithValue(ctx, "email", claims.Email)
-					ctx = context.WithValue(ctx, "role", claims.Role)
-					ctx = context.WithValue(ctx, "org_id", claims.OrgID)
-					r = r.WithContext(ctx)
-
-					// Also expose as HTTP headers so gRPC-gateway forwards them as metadata
-					// (headers become metadata keys like "x-user-id")
-					if claims.UserID != "" {
-						r.Header.Set("X-User-Id", claims.UserID)
-						r.Header.Set("Grpc-Metadata-user_id", claims.UserID)
-						r.Header.Set("Grpc-Metadata-user-id", claims.UserID)
-					}
-					if claims.OrgID != "" {
-						r.Header.Set("X-Org-Id", claims.OrgID)
-						r.Header.Set("Grpc-Metadata-org_id", claims.OrgID)
-						r.Header.Set("Grpc-Metadata-org-id", claims.OrgID)
-					}
-					if claims.Role != "" {
-						r.Header.Set("X-Role", claims.Role)
-						r.Header.Set("Grpc-Metadata-role", claims.Role)
-					}
+		token := strings.TrimSpace(strings.TrimPrefix(authHeader, "Bearer"))
+		if key := strings.TrimSpace(r.Header.Get("X-API-Key")); key != "" {
+			token = key
+		}
+
+		if auth.IsAPIKey(token) {
+			if apiKeys == nil {
+				middleware.WriteProblem(w, r, http.StatusUnauthorized, middleware.CodeInvalidAPIKey, "api keys are not supported")
+				return
+			}
+			identity, err := apiKeys.Authenticate(r.Context(), token)
+			if err != nil {
+				middleware.WriteProblem(w, r, http.StatusServiceUnavailable, middleware.CodeUnavailable, "unable to validate api key")
+				return
+			}
+			if identity == nil {
+				middleware.WriteProblem(w, r, http.StatusUnauthorized, middleware.CodeInvalidAPIKey, "invalid or expired api key")
+				return
+			}
+			if !auth.APIKeyAllows(identity.Scopes, r.Method, r.URL.Path) {
+				middleware.WriteProblem(w, r, http.StatusForbidden, middleware.CodeAPIKeyScope, "api key scope does not allow this request")
+				return
+			}
+			// the key must not reach the backends as if it were a JWT
+			r.Header.Del("Authorization")
+			r.Header.Del("X-API-Key")
+			r.Header.Set("X-Auth-Method", "api_key")
+			r.Header.Set("X-Api-Key-Id", identity.KeyID)
+			r = withIdentity(r, identity.UserID, identity.Email, identity.Role, identity.OrgID)
+			// for gRPC-Web, which rebuilds the metadata from the context
+			ctx := context.WithValue(r.Context(), "auth_method", "api_key")
+			r = r.WithContext(context.WithValue(ctx, "api_key_id", identity.KeyID))
+		} else if token != "" && jwtManager != nil {
+			if claims, err := jwtManager.ValidateToken(token); err == nil {
+				if sessions != nil && claims.IssuedAt != nil && sessions.IsRevoked(r.Context(), claims.UserID, claims.IssuedAt.Time) {
+					middleware.WriteProblem(w, r, http.StatusUnauthorized, middleware.CodeSessionRevoked, "session has been revoked")
+					return
 				}
+				r = withIdentity(r, claims.UserID, claims.Email, claims.Role, claims.OrgID)
+			} else if strictTokens.Load() && strings.HasPrefix(r.URL.Path, "/api/") && !publicRoute(r.URL.Path) {
+				w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+				middleware.WriteProblem(w, r, http.StatusUnauthorized, middleware.CodeInvalidToken, "invalid or expired token")
+				return
 			}
 		}
 
 		next.ServeHTTP(w, r)
 	})
 }
+
+// sessionCookiesFromEnv returns the cookie session mode when
+// SESSION_COOKIES=true, or nil. Cookies are Secure outside development,
+// SameSite SESSION_COOKIE_SAMESITE (lax, strict or none) and scoped to
+// SESSION_COOKIE_DOMAIN. Pages on SESSION_COOKIE_ORIGINS, besides the
+// gateway's own origin, may use them.
+func sessionCookiesFromEnv(cfg *config.Config, jwtManager *auth.JWTManager) *middleware.SessionCookies {
+	if getEnvOrDefault("SESSION_COOKIES", "false") != "true" {
+		return nil
+	}
+	sameSite := map[string]http.SameSite{
+		"lax":    http.SameSiteLaxMode,
+		"strict": http.SameSiteStrictMode,
+		"none":   http.SameSiteNoneMode,
+	}[strings.ToLower(getEnvOrDefault("SESSION_COOKIE_SAMESITE", "lax"))]
+	if sameSite == 0 {
+		log.Fatalf("Invalid SESSION_COOKIE_SAMESITE: must be lax, strict or none")
+	}
+	return middleware.NewSessionCookies(
+		cfg.Server.Environment != "development",
+		sameSite,
+		os.Getenv("SESSION_COOKIE_DOMAIN"),
+		splitList(os.Getenv("SESSION_COOKIE_ORIGINS")),
+		jwtManager.AccessTokenDuration(),
+		jwtManager.RefreshTokenDuration(),
+	)
+}
+
+// publicRoute reports whether path is reachable without signing in, so a
+// stale token sent along must not block it
+func publicRoute(path string) bool {
+	switch {
+	case strings.HasPrefix(path, "/api/v1/auth/"),
+		path == "/api/v1/invite/accept",
+		path == "/api/v1/organizations/register",
+		strings.HasSuffix(path, "/reset-password-questions"),
+		strings.HasPrefix(path, "/api/v1/calendar/") && strings.HasSuffix(path, "/feed.ics"):
+		return true
+	}
+	return false
+}
+
+// withIdentity injects the caller into the request context and also exposes
+// it as HTTP headers so gRPC-gateway forwards them as metadata (headers
+// become metadata keys like "x-user-id")
+func withIdentity(r *http.Request, userID, email, role, orgID string) *http.Request {
+	ctx := r.Context()
+	ctx = context.WithValue(ctx, "user_id", userID)
+	ctx = context.WithValue(ctx, "email", email)
+	ctx = context.WithValue(ctx, "role", role)
+	ctx = context.WithValue(ctx, "org_id", orgID)
+	r = r.WithContext(ctx)
+	middleware.AnnotateAccessLog(ctx, userID, orgID)
+
+	if userID != "" {
+		r.Header.Set("X-User-Id", userID)
+		r.Header.Set("Grpc-Metadata-user_id", userID)
+		r.Header.Set("Grpc-Metadata-user-id", userID)
+	}
+	if orgID != "" {
+		r.Header.Set("X-Org-Id", orgID)
+		r.Header.Set("Grpc-Metadata-org_id", orgID)
+		r.Header.Set("Grpc-Metadata-org-id", orgID)
+	}
+	if role != "" {
+		r.Header.Set("X-Role", role)
+		r.Header.Set("Grpc-Metadata-role", role)
+	}
+	return r
+}
@@ -0,0 +1,102 @@
+package middleware
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	userpb "github.com/chanduchitikam/task-management-system/proto/user"
+)
+
+// APIKeyIdentity is the owner of a validated API key
+type APIKeyIdentity struct {
+	KeyID  string
+	UserID string
+	OrgID  string
+	Role   string
+	Email  string
+	Scopes []string
+}
+
+type cachedAPIKey struct {
+	identity *APIKeyIdentity
+	expires  time.Time
+}
+
+// APIKeyAuthenticator validates API keys against the user service. Valid keys
+// are cached briefly, so a revoked key may keep working for up to ttl.
+type APIKeyAuthenticator struct {
+	client userpb.UserServiceClient
+	ttl    time.Duration
+
+	mu    sync.Mutex
+	cache map[string]cachedAPIKey
+}
+
+// NewAPIKeyAuthenticator creates an authenticator backed by the user service
+func NewAPIKeyAuthenticator(client userpb.UserServiceClient, ttl time.Duration) *APIKeyAuthenticator {
+	return &APIKeyAuthenticator{
+		client: client,
+		ttl:    ttl,
+		cache:  make(map[string]cachedAPIKey),
+	}
+}
+
+// Authenticate returns the key's owner, or nil if the key is not valid
+func (a *APIKeyAuthenticator) Authenticate(ctx context.Context, key string) (*APIKeyIdentity, error) {
+	sum := sha256.Sum256([]byte(key))
+	cacheKey := hex.EncodeToString(sum[:])
+	now := time.Now()
+
+	a.mu.Lock()
+	if entry, ok := a.cache[cacheKey]; ok {
+		if now.Before(entry.expires) {
+			a.mu.Unlock()
+			return entry.identity, nil
+		}
+		delete(a.cache, cacheKey)
+	}
+	a.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	resp, err := a.client.ValidateAPIKey(ctx, &userpb.ValidateAPIKeyRequest{Key: key})
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Valid {
+		return nil, nil
+	}
+
+	identity := &APIKeyIdentity{
+		KeyID:  resp.KeyId,
+		UserID: resp.UserId,
+		OrgID:  resp.OrgId,
+		Role:   resp.Role,
+		Email:  resp.Email,
+		Scopes: resp.Scopes,
+	}
+	a.mu.Lock()
+	a.cache[cacheKey] = cachedAPIKey{identity: identity, expires: now.Add(a.ttl)}
+	a.mu.Unlock()
+	return identity, nil
+}
+
+// Cleanup periodically drops expired cache entries
+func (a *APIKeyAuthenticator) Cleanup(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	go func() {
+		for range ticker.C {
+			now := time.Now()
+			a.mu.Lock()
+			for k, entry := range a.cache {
+				if !now.Before(entry.expires) {
+					delete(a.cache, k)
+				}
+			}
+			a.mu.Unlock()
+		}
+	}()
+}
@@ -0,0 +1,136 @@
+package middleware
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/pkg/metrics"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// CircuitBreaker fails calls to a backend fast once it has failed threshold
+// times in a row. After cooldown a single probe call is let through; its
+// success closes the breaker and its failure opens it again.
+type CircuitBreaker struct {
+	backend   string
+	threshold int
+	cooldown  time.Duration
+
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+// NewCircuitBreaker creates a closed breaker for the named backend
+func NewCircuitBreaker(backend string, threshold int, cooldown time.Duration) *CircuitBreaker {
+	metrics.CircuitBreakerOpen.WithLabelValues(backend).Set(0)
+	return &CircuitBreaker{
+		backend:   backend,
+		threshold: threshold,
+		cooldown:  cooldown,
+	}
+}
+
+// UnaryClient returns a client interceptor guarding calls to the backend.
+// Rejected calls fail with Unavailable carrying RetryInfo, which
+// HTTPErrorHandler turns into a Retry-After header.
+func (b *CircuitBreaker) UnaryClient() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		if wait, ok := b.allow(time.Now()); !ok {
+			return circuitOpenError(b.backend, wait)
+		}
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		b.record(time.Now(), err == nil || !backendFailure(ctx, err))
+		return err
+	}
+}
+
+// allow reports whether a call may go through, or how long until the next
+// one may
+func (b *CircuitBreaker) allow(now time.Time) (time.Duration, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.failures < b.threshold {
+		return 0, true
+	}
+	if now.Before(b.openUntil) {
+		return b.openUntil.Sub(now), false
+	}
+	if b.probing {
+		return b.cooldown, false
+	}
+	b.probing = true
+	return 0, true
+}
+
+func (b *CircuitBreaker) record(now time.Time, ok bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+	if ok {
+		if b.failures >= b.threshold {
+			metrics.CircuitBreakerOpen.WithLabelValues(b.backend).Set(0)
+		}
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.failures >= b.threshold {
+		b.openUntil = now.Add(b.cooldown)
+		metrics.CircuitBreakerOpen.WithLabelValues(b.backend).Set(1)
+	}
+}
+
+// backendFailure reports whether err means the backend is unreachable or
+// unresponsive, as opposed to rejecting the request or the caller giving up
+func backendFailure(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	}
+	return false
+}
+
+// CallTimeout bounds calls made without a deadline to d, so a hung backend
+// counts against its breaker before the HTTP request times out
+func CallTimeout(d time.Duration) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		if _, ok := ctx.Deadline(); !ok {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, d)
+			defer cancel()
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+func circuitOpenError(backend string, wait time.Duration) error {
+	st := status.New(codes.Unavailable, backend+" is unavailable, retry later")
+	if withInfo, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(wait)}); err == nil {
+		st = withInfo
+	}
+	return st.Err()
+}
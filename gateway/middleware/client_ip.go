@@ -0,0 +1,81 @@
+package middleware
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+	"sync"
+)
+
+var (
+	trustedProxiesMu sync.RWMutex
+	trustedProxies   []netip.Prefix
+)
+
+// TrustProxies sets the networks of proxies in front of the gateway, whose
+// X-Forwarded-For headers are believed. Without any, the header is ignored,
+// as clients could use it to pose as another address.
+func TrustProxies(cidrs []string) error {
+	prefixes := make([]netip.Prefix, 0, len(cidrs))
+	for _, c := range cidrs {
+		p, err := netip.ParsePrefix(c)
+		if err != nil {
+			addr, addrErr := netip.ParseAddr(c)
+			if addrErr != nil {
+				return fmt.Errorf("invalid trusted proxy %q", c)
+			}
+			p = netip.PrefixFrom(addr, addr.BitLen())
+		}
+		prefixes = append(prefixes, p)
+	}
+	trustedProxiesMu.Lock()
+	trustedProxies = prefixes
+	trustedProxiesMu.Unlock()
+	return nil
+}
+
+func trustedProxy(ip string) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	trustedProxiesMu.RLock()
+	defer trustedProxiesMu.RUnlock()
+	for _, p := range trustedProxies {
+		if p.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// ClientIPMetadata is the metadata key the gateway forwards ClientIP in.
+// Backends trust it, so the gateway never copies it from a request.
+const ClientIPMetadata = "x-client-ip"
+
+// ClientIP is the address the request came from. Behind trusted proxies it
+// is the last X-Forwarded-For hop not added by one of them.
+func ClientIP(r *http.Request) string {
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+	if !trustedProxy(ip) {
+		return ip
+	}
+	hops := strings.Split(r.Header.Get("X-Forwarded-For"), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if hop == "" {
+			continue
+		}
+		ip = hop
+		if !trustedProxy(hop) {
+			break
+		}
+	}
+	return ip
+}
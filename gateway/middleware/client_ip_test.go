@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrustProxies(t *testing.T) {
+	t.Cleanup(func() { _ = TrustProxies(nil) })
+
+	require.NoError(t, TrustProxies([]string{"10.0.0.0/8", "192.0.2.1", "2001:db8::/32"}))
+	assert.True(t, trustedProxy("10.1.2.3"))
+	assert.True(t, trustedProxy("::ffff:10.1.2.3"))
+	assert.True(t, trustedProxy("192.0.2.1"))
+	assert.False(t, trustedProxy("192.0.2.2"))
+	assert.True(t, trustedProxy("2001:db8::7"))
+	assert.False(t, trustedProxy("not an ip"))
+
+	for _, bad := range []string{"10.0.0.0/33", "proxy.internal", ""} {
+		assert.Error(t, TrustProxies([]string{bad}), "%q", bad)
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	t.Cleanup(func() { _ = TrustProxies(nil) })
+
+	tests := []struct {
+		name       string
+		trusted    []string
+		remoteAddr string
+		forwarded  string
+		want       string
+	}{
+		{"direct", nil, "203.0.113.7:51234", "", "203.0.113.7"},
+		{"forwarded-for ignored without trusted proxies", nil, "203.0.113.7:51234", "198.51.100.1", "203.0.113.7"},
+		{"forwarded-for ignored from an untrusted peer", []string{"10.0.0.0/8"}, "203.0.113.7:51234", "198.51.100.1", "203.0.113.7"},
+		{"one trusted proxy", []string{"10.0.0.0/8"}, "10.0.0.2:443", "198.51.100.1", "198.51.100.1"},
+		{"spoofed hops before the client are skipped", []string{"10.0.0.0/8"}, "10.0.0.2:443", "1.1.1.1, 198.51.100.1", "198.51.100.1"},
+		{"chain of trusted proxies", []string{"10.0.0.0/8"}, "10.0.0.2:443", "198.51.100.1, 10.0.0.9, 10.0.0.3", "198.51.100.1"},
+		{"blank hops are skipped", []string{"10.0.0.0/8"}, "10.0.0.2:443", "198.51.100.1, , ", "198.51.100.1"},
+		{"only trusted hops", []string{"10.0.0.0/8"}, "10.0.0.2:443", "10.0.0.9", "10.0.0.9"},
+		{"trusted proxy without the header", []string{"10.0.0.0/8"}, "10.0.0.2:443", "", "10.0.0.2"},
+		{"ipv6 peer", []string{"2001:db8::/32"}, "[2001:db8::1]:443", "2001:db8:ffff::5, 2606:4700::1111", "2606:4700::1111"},
+		{"remote address without a port", nil, "203.0.113.7", "", "203.0.113.7"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, TrustProxies(tt.trusted))
+			r := httptest.NewRequest("GET", "/api/v1/tasks", nil)
+			r.RemoteAddr = tt.remoteAddr
+			if tt.forwarded != "" {
+				r.Header.Set("X-Forwarded-For", tt.forwarded)
+			}
+			assert.Equal(t, tt.want, ClientIP(r))
+		})
+	}
+}
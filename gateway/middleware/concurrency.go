@@ -0,0 +1,100 @@
+package middleware
+
+import (
+	"net/http"
+	"sync"
+)
+
+// ConcurrencyLimiter caps how many requests, or connections, a single user
+// and a single org may have open on this replica at once. Unlike the rate
+// limiter it bounds work in progress, so a client stuck on slow calls can't
+// tie up the backends however few requests it sends.
+type ConcurrencyLimiter struct {
+	perUser int
+	perOrg  int
+
+	mu    sync.Mutex
+	users map[string]int
+	orgs  map[string]int
+}
+
+// NewConcurrencyLimiter allows perUser open requests per user and perOrg per
+// org; zero leaves that scope unlimited
+func NewConcurrencyLimiter(perUser, perOrg int) *ConcurrencyLimiter {
+	return &ConcurrencyLimiter{
+		perUser: perUser,
+		perOrg:  perOrg,
+		users:   make(map[string]int),
+		orgs:    make(map[string]int),
+	}
+}
+
+// SetLimits changes the limits. Slots already taken are kept, so callers
+// over a lowered limit are only refused new ones.
+func (l *ConcurrencyLimiter) SetLimits(perUser, perOrg int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.perUser, l.perOrg = perUser, perOrg
+}
+
+// Acquire takes a slot for userID and orgID, either of which may be empty.
+// If neither is at its limit it returns true and a func releasing the slot.
+func (l *ConcurrencyLimiter) Acquire(userID, orgID string) (func(), bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if userID != "" && l.perUser > 0 && l.users[userID] >= l.perUser {
+		return nil, false
+	}
+	if orgID != "" && l.perOrg > 0 && l.orgs[orgID] >= l.perOrg {
+		return nil, false
+	}
+	if userID != "" {
+		l.users[userID]++
+	}
+	if orgID != "" {
+		l.orgs[orgID]++
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { l.release(userID, orgID) })
+	}, true
+}
+
+func (l *ConcurrencyLimiter) release(userID, orgID string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if userID != "" {
+		if l.users[userID]--; l.users[userID] <= 0 {
+			delete(l.users, userID)
+		}
+	}
+	if orgID != "" {
+		if l.orgs[orgID]--; l.orgs[orgID] <= 0 {
+			delete(l.orgs, orgID)
+		}
+	}
+}
+
+// Handler rejects requests from callers at their limit with 429. Anonymous
+// requests are counted per client IP. WebSocket connections to /ws are
+// left to the WebSocket handler, which limits them separately. It must run
+// after the caller's identity is in the request context.
+func (l *ConcurrencyLimiter) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/ws" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		userID := rateLimitSubject(r, ScopeUser)
+		orgID := rateLimitSubject(r, ScopeOrg)
+		release, ok := l.Acquire(userID, orgID)
+		if !ok {
+			w.Header().Set("Retry-After", "1")
+			WriteProblem(w, r, http.StatusTooManyRequests, CodeTooManyConcurrent, "too many requests in progress")
+			return
+		}
+		defer release()
+		next.ServeHTTP(w, r)
+	})
+}
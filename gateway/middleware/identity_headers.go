@@ -0,0 +1,28 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+)
+
+// GatewayOwnedHeaders are the metadata keys the gateway derives for the
+// backends. Sent by callers as X- or Grpc-Metadata- headers, or in-band
+// over gRPC-Web, they would be forwarded as if verified.
+var GatewayOwnedHeaders = map[string]bool{
+	"user_id": true, "user-id": true, "x-user-id": true,
+	"org_id": true, "org-id": true, "x-org-id": true,
+	"role": true, "x-role": true,
+	"x-auth-method": true, "x-api-key-id": true,
+	ClientIPMetadata: true,
+}
+
+// StripIdentityHeaders removes the gateway-owned headers a caller sent
+func StripIdentityHeaders(h http.Header) {
+	for key := range h {
+		lower := strings.ToLower(key)
+		if GatewayOwnedHeaders[strings.TrimPrefix(lower, "grpc-metadata-")] {
+			// keys need not be canonical, so not h.Del
+			delete(h, key)
+		}
+	}
+}
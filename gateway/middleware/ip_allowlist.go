@@ -0,0 +1,151 @@
+package middleware
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"net/netip"
+	"sync"
+	"time"
+
+	userpb "github.com/chanduchitikam/task-management-system/proto/user"
+)
+
+type cachedAllowlist struct {
+	prefixes []netip.Prefix
+	expires  time.Time
+}
+
+// IPAllowlists rejects requests from members of an org that restricts the
+// networks they may connect from, when the request comes from elsewhere.
+// Allowlists are cached for ttl. Until an org's allowlist has been fetched
+// once, requests are let through if the user service is unreachable.
+type IPAllowlists struct {
+	client userpb.UserServiceClient
+	ttl    time.Duration
+
+	mu       sync.Mutex
+	cache    map[string]cachedAllowlist
+	reported map[string]time.Time
+}
+
+// NewIPAllowlists creates an allowlist check backed by the user service
+func NewIPAllowlists(client userpb.UserServiceClient, ttl time.Duration) *IPAllowlists {
+	return &IPAllowlists{
+		client:   client,
+		ttl:      ttl,
+		cache:    make(map[string]cachedAllowlist),
+		reported: make(map[string]time.Time),
+	}
+}
+
+// Handler enforces the caller's org allowlist. Blocked attempts are audited
+// once per user and address a minute. It must run after the caller's
+// identity is in the request context.
+func (a *IPAllowlists) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		orgID, _ := r.Context().Value("org_id").(string)
+		if orgID == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		userID, _ := r.Context().Value("user_id").(string)
+		if !a.Allows(r, orgID, userID) {
+			WriteProblem(w, r, http.StatusForbidden, CodeIPNotAllowed, "your organization does not allow access from this network")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Allows reports whether the org's allowlist admits r, auditing it if not.
+// It is for callers that learn who made a request after Handler has run.
+func (a *IPAllowlists) Allows(r *http.Request, orgID, userID string) bool {
+	ip := ClientIP(r)
+	if a.allowed(r.Context(), orgID, ip) {
+		return true
+	}
+	a.report(orgID, userID, ip, r.Method, r.URL.Path)
+	return false
+}
+
+func (a *IPAllowlists) allowed(ctx context.Context, orgID, ip string) bool {
+	prefixes := a.allowlist(ctx, orgID)
+	if len(prefixes) == 0 {
+		return true
+	}
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, p := range prefixes {
+		if p.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *IPAllowlists) allowlist(ctx context.Context, orgID string) []netip.Prefix {
+	now := time.Now()
+	a.mu.Lock()
+	entry, ok := a.cache[orgID]
+	a.mu.Unlock()
+	if ok && now.Before(entry.expires) {
+		return entry.prefixes
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	resp, err := a.client.GetOrgIPAllowlist(ctx, &userpb.GetOrgIPAllowlistRequest{OrgId: orgID})
+	if err != nil {
+		log.Printf("failed to fetch IP allowlist of org %s: %v", orgID, err)
+		// keep enforcing the last known allowlist
+		return entry.prefixes
+	}
+	prefixes := make([]netip.Prefix, 0, len(resp.Cidrs))
+	for _, c := range resp.Cidrs {
+		if p, err := netip.ParsePrefix(c); err == nil {
+			prefixes = append(prefixes, p)
+		}
+	}
+
+	a.mu.Lock()
+	a.cache[orgID] = cachedAllowlist{prefixes: prefixes, expires: now.Add(a.ttl)}
+	a.mu.Unlock()
+	return prefixes
+}
+
+func (a *IPAllowlists) report(orgID, userID, ip, method, path string) {
+	key := orgID + "|" + userID + "|" + ip
+	now := time.Now()
+	a.mu.Lock()
+	if last, ok := a.reported[key]; ok && now.Sub(last) < time.Minute {
+		a.mu.Unlock()
+		return
+	}
+	a.reported[key] = now
+	if len(a.reported) > 10000 {
+		for k, t := range a.reported {
+			if now.Sub(t) >= time.Minute {
+				delete(a.reported, k)
+			}
+		}
+	}
+	a.mu.Unlock()
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if _, err := a.client.RecordBlockedAccess(ctx, &userpb.RecordBlockedAccessRequest{
+			OrgId:     orgID,
+			UserId:    userID,
+			IpAddress: ip,
+			Method:    method,
+			Path:      path,
+		}); err != nil {
+			log.Printf("failed to audit blocked access by %s from %s: %v", userID, ip, err)
+		}
+	}()
+}
@@ -1,63 +1,128 @@
 package middleware
 
 import (
+	"bufio"
 	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"sync/atomic"
 	"time"
 
+	"github.com/google/uuid"
 	"go.uber.org/zap"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
-// // // LoggingInterceptor logs gRPC requests and responses
-type LoggingInterceptor struct {
+type accessLogKey struct{}
+
+// accessLogEntry collects what inner handlers learn about a request, such as
+// who made it, for the access log line written once it completes
+type accessLogEntry struct {
+	userID string
+	orgID  string
+}
+
+// AccessLogger writes one structured log line per HTTP request. Requests
+// that fail (4xx and 5xx) are always logged; the rest are sampled at
+// sampleRate, between 0 and 1.
+type AccessLogger struct {
 	logger *zap.Logger
+	// sampleRate holds the float64's bits, so it can change while serving
+	sampleRate atomic.Uint64
 }
 
-// // // NewLoggingInterceptor creates a new logging interceptor
-func NewLoggingInterceptor(logger *zap.Logger) *LoggingInterceptor {
-	return &LoggingInterceptor{
-		logger: logger,
-	}
+// NewAccessLogger creates an access logger
+func NewAccessLogger(logger *zap.Logger, sampleRate float64) *AccessLogger {
+	a := &AccessLogger{logger: logger}
+	a.SetSampleRate(sampleRate)
+	return a
 }
 
-// // // Unary returns a logging interceptor for unary RPCs
-func (l *LoggingInterceptor) Unary() grpc.UnaryServerInterceptor {
-	return func(
-		ctx context.Context,
-		req interface{},
-		info *grpc.UnaryServerInfo,
-		handler grpc.UnaryHandler,
-	) (interface{}, error) {
-		start := time.Now()
+// SetSampleRate changes the share of successful requests logged
+func (a *AccessLogger) SetSampleRate(rate float64) {
+	a.sampleRate.Store(math.Float64bits(rate))
+}
 
-		// 		// 		// Extract user info from context if available
-		userID := ""
-		if id, ok := ctx.Value("user_id").(string); ok {
-			userID = id
+// Handler logs every request made through next. It assigns a request ID
+// unless the client sent one in X-Request-Id, returns it in the response
+// and forwards it to the backends.
+func (a *AccessLogger) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		requestID := r.Header.Get("X-Request-Id")
+		if requestID == "" || len(requestID) > 128 {
+			requestID = uuid.NewString()
+			r.Header.Set("X-Request-Id", requestID)
 		}
+		w.Header().Set("X-Request-Id", requestID)
 
-		// 		// 		// Call handler
-		resp, err := handler(ctx, req)
+		entry := &accessLogEntry{}
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r.WithContext(context.WithValue(r.Context(), accessLogKey{}, entry)))
 
-		// 		// 		// Log the request
-		duration := time.Since(start)
-		code := codes.OK
-		if err != nil {
-			if st, ok := status.FromError(err); ok {
-				code = st.Code()
-			}
+		if rec.status < http.StatusBadRequest && rand.Float64() >= math.Float64frombits(a.sampleRate.Load()) {
+			return
 		}
-
-		l.logger.Info("gRPC request",
-			zap.String("method", info.FullMethod),
-			zap.String("user_id", userID),
-			zap.Duration("duration", duration),
-			zap.String("code", code.String()),
-			zap.Error(err),
+		a.logger.Info("http request",
+			zap.String("method", r.Method),
+			zap.String("path", r.URL.Path),
+			zap.Int("status", rec.status),
+			zap.Duration("latency", time.Since(start)),
+			zap.String("user_id", entry.userID),
+			zap.String("org_id", entry.orgID),
+			zap.String("request_id", requestID),
 		)
+	})
+}
+
+// AnnotateAccessLog records the authenticated caller for the request's
+// access log line
+func AnnotateAccessLog(ctx context.Context, userID, orgID string) {
+	if entry, ok := ctx.Value(accessLogKey{}).(*accessLogEntry); ok {
+		entry.userID = userID
+		entry.orgID = orgID
+	}
+}
 
-		return resp, err
+// statusRecorder remembers the status written to a response. It passes
+// through hijacking so WebSocket upgrades keep working.
+type statusRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+func (s *statusRecorder) WriteHeader(code int) {
+	if !s.wroteHeader {
+		s.status = code
+		s.wroteHeader = true
+	}
+	s.ResponseWriter.WriteHeader(code)
+}
+
+func (s *statusRecorder) Write(b []byte) (int, error) {
+	s.wroteHeader = true
+	return s.ResponseWriter.Write(b)
+}
+
+func (s *statusRecorder) Flush() {
+	if f, ok := s.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (s *statusRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	h, ok := s.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("response does not support hijacking")
 	}
+	s.status = http.StatusSwitchingProtocols
+	s.wroteHeader = true
+	return h.Hijack()
+}
+
+func (s *statusRecorder) Unwrap() http.ResponseWriter {
+	return s.ResponseWriter
 }
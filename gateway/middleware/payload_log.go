@@ -0,0 +1,189 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// maxLoggedPayload bounds how much of each body is logged
+const maxLoggedPayload = 8 << 10
+
+const redacted = "[REDACTED]"
+
+// sensitiveKeyParts mark JSON fields and query parameters whose values are
+// never logged, wherever they are nested
+var sensitiveKeyParts = []string{
+	"password", "token", "secret", "answer", "api_key", "apikey",
+	"authorization", "otp", "totp", "passcode", "recovery_code", "credential",
+	"private_key", "signature", "assertion",
+}
+
+// PayloadLogger logs request and response bodies of selected routes, for
+// debugging. Secrets are redacted before anything is written.
+type PayloadLogger struct {
+	logger   *zap.Logger
+	prefixes []string
+}
+
+// NewPayloadLogger logs payloads of routes under prefixes; "/" selects every
+// route. It returns nil, which logs nothing, when prefixes is empty.
+func NewPayloadLogger(logger *zap.Logger, prefixes []string) *PayloadLogger {
+	if len(prefixes) == 0 {
+		return nil
+	}
+	return &PayloadLogger{logger: logger, prefixes: prefixes}
+}
+
+// Handler logs the bodies of JSON requests to the selected routes. It must
+// run inside AccessLogger so lines share the request ID.
+func (p *PayloadLogger) Handler(next http.Handler) http.Handler {
+	if p == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !p.selected(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		var reqBody []byte
+		if r.Body != nil {
+			// read one byte past the limit to tell a truncated body apart
+			buf, err := io.ReadAll(io.LimitReader(r.Body, maxLoggedPayload+1))
+			if err != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+			reqBody = buf
+			r.Body = struct {
+				io.Reader
+				io.Closer
+			}{io.MultiReader(bytes.NewReader(buf), r.Body), r.Body}
+		}
+
+		rec := &payloadRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+
+		p.logger.Info("http payload",
+			zap.String("method", r.Method),
+			zap.String("path", r.URL.Path),
+			zap.String("query", redactQuery(r.URL.Query())),
+			zap.Int("status", rec.status),
+			zap.String("request_id", r.Header.Get("X-Request-Id")),
+			zap.String("request_body", redactBody(reqBody)),
+			zap.String("response_body", redactBody(rec.body.Bytes())),
+		)
+	})
+}
+
+func (p *PayloadLogger) selected(r *http.Request) bool {
+	// streams and upgrades are never buffered
+	if r.Header.Get("Upgrade") != "" {
+		return false
+	}
+	if ct := r.Header.Get("Content-Type"); ct != "" && !strings.HasPrefix(ct, "application/json") {
+		return false
+	}
+	for _, prefix := range p.prefixes {
+		if strings.HasPrefix(r.URL.Path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// payloadRecorder keeps the start of the response body
+type payloadRecorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (p *payloadRecorder) WriteHeader(code int) {
+	p.status = code
+	p.ResponseWriter.WriteHeader(code)
+}
+
+func (p *payloadRecorder) Write(b []byte) (int, error) {
+	if room := maxLoggedPayload + 1 - p.body.Len(); room > 0 {
+		if len(b) < room {
+			room = len(b)
+		}
+		p.body.Write(b[:room])
+	}
+	return p.ResponseWriter.Write(b)
+}
+
+func (p *payloadRecorder) Flush() {
+	if f, ok := p.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (p *payloadRecorder) Unwrap() http.ResponseWriter {
+	return p.ResponseWriter
+}
+
+func sensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, part := range sensitiveKeyParts {
+		if strings.Contains(key, part) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactBody returns a JSON body with sensitive fields masked. Bodies that
+// are truncated or not JSON cannot be redacted reliably, so only their size
+// is given.
+func redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	if len(body) > maxLoggedPayload {
+		return "[body over 8 KiB omitted]"
+	}
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return "[non-JSON body omitted]"
+	}
+	out, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return "[body omitted]"
+	}
+	return string(out)
+}
+
+func redactValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, child := range t {
+			if sensitiveKey(k) {
+				t[k] = redacted
+			} else {
+				t[k] = redactValue(child)
+			}
+		}
+	case []interface{}:
+		for i, child := range t {
+			t[i] = redactValue(child)
+		}
+	}
+	return v
+}
+
+func redactQuery(q url.Values) string {
+	for k := range q {
+		if sensitiveKey(k) {
+			q[k] = []string{redacted}
+		}
+	}
+	return q.Encode()
+}
@@ -0,0 +1,129 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Stable error codes for failures raised by the gateway itself. Errors from
+// the services use their ErrorInfo reason, or else the gRPC code's name.
+const (
+	CodeUnauthenticated = "UNAUTHENTICATED"
+	CodeInvalidToken    = "INVALID_TOKEN"
+	CodeSessionRevoked  = "SESSION_REVOKED"
+	CodeInvalidAPIKey   = "INVALID_API_KEY"
+	CodeAPIKeyScope     = "API_KEY_SCOPE"
+	CodeRateLimited     = "RATE_LIMITED"
+	CodeIPNotAllowed    = "IP_NOT_ALLOWED"
+	CodeNotFound        = "NOT_FOUND"
+	CodeUnavailable     = "UNAVAILABLE"
+
+	// CodeTooManyConcurrent means too many of the caller's requests or
+	// connections are already open
+	CodeTooManyConcurrent = "TOO_MANY_CONCURRENT"
+)
+
+// codeNames are the canonical names of gRPC codes, as Google APIs spell them
+var codeNames = map[codes.Code]string{
+	codes.Canceled:           "CANCELLED",
+	codes.Unknown:            "UNKNOWN",
+	codes.InvalidArgument:    "INVALID_ARGUMENT",
+	codes.DeadlineExceeded:   "DEADLINE_EXCEEDED",
+	codes.NotFound:           "NOT_FOUND",
+	codes.AlreadyExists:      "ALREADY_EXISTS",
+	codes.PermissionDenied:   "PERMISSION_DENIED",
+	codes.ResourceExhausted:  "RESOURCE_EXHAUSTED",
+	codes.FailedPrecondition: "FAILED_PRECONDITION",
+	codes.Aborted:            "ABORTED",
+	codes.OutOfRange:         "OUT_OF_RANGE",
+	codes.Unimplemented:      "UNIMPLEMENTED",
+	codes.Internal:           "INTERNAL",
+	codes.Unavailable:        "UNAVAILABLE",
+	codes.DataLoss:           "DATA_LOSS",
+	codes.Unauthenticated:    "UNAUTHENTICATED",
+}
+
+// Problem is an RFC 7807 error body. Every error response from the gateway
+// has this shape.
+type Problem struct {
+	Type   string `json:"type"`
+	Title  string `json:"title"`
+	Status int    `json:"status"`
+	Detail string `json:"detail,omitempty"`
+	// Instance is the request path
+	Instance string `json:"instance,omitempty"`
+	// Code identifies the error for clients to branch on; unlike Detail it
+	// does not change wording
+	Code      string `json:"code"`
+	RequestID string `json:"request_id,omitempty"`
+	// Metadata carries the service's ErrorInfo metadata, such as
+	// captcha_required on login failures
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// Message repeats Detail for clients written against the earlier
+	// {"code", "message"} bodies
+	Message string `json:"message,omitempty"`
+}
+
+// WriteProblem writes an error raised by the gateway
+func WriteProblem(w http.ResponseWriter, r *http.Request, httpStatus int, code, detail string) {
+	writeProblem(w, r, Problem{Status: httpStatus, Code: code, Detail: detail})
+}
+
+func writeProblem(w http.ResponseWriter, r *http.Request, p Problem) {
+	p.Type = "about:blank"
+	p.Title = http.StatusText(p.Status)
+	p.Instance = r.URL.Path
+	p.RequestID = r.Header.Get("X-Request-Id")
+	p.Message = p.Detail
+
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Del("Content-Length")
+	w.WriteHeader(p.Status)
+	_ = json.NewEncoder(w).Encode(p)
+}
+
+// HTTPErrorHandler writes errors from the services as problem+json, adding
+// a Retry-After header to errors that say when to retry
+func HTTPErrorHandler(ctx context.Context, _ *runtime.ServeMux, _ runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	st := status.Convert(err)
+	p := Problem{
+		Status: runtime.HTTPStatusFromCode(st.Code()),
+		Code:   codeNames[st.Code()],
+		Detail: st.Message(),
+	}
+	if p.Code == "" {
+		p.Code = codeNames[codes.Unknown]
+	}
+	for _, d := range st.Details() {
+		switch info := d.(type) {
+		case *errdetails.RetryInfo:
+			w.Header().Set("Retry-After", strconv.Itoa(ceilSeconds(info.GetRetryDelay().AsDuration())))
+		case *errdetails.ErrorInfo:
+			if info.GetReason() != "" {
+				p.Code = info.GetReason()
+			}
+			p.Metadata = info.GetMetadata()
+		}
+	}
+	// response metadata goes out as headers, as with grpc-gateway's own
+	// error handler
+	if md, ok := runtime.ServerMetadataFromContext(ctx); ok {
+		for k, vs := range md.HeaderMD {
+			for _, v := range vs {
+				w.Header().Add(runtime.MetadataHeaderPrefix+k, v)
+			}
+		}
+	}
+	if st.Code() == codes.Unauthenticated {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+	}
+	writeProblem(w, r, p)
+}
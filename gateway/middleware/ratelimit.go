@@ -2,83 +2,213 @@ package middleware
 
 import (
 	"context"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
-	"golang.org/x/time/rate"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"github.com/chanduchitikam/task-management-system/pkg/cache"
 )
 
-// // // RateLimiter implements rate limiting per IP or user
-type RateLimiter struct {
-	limiters map[string]*rate.Limiter
-	mu       sync.RWMutex
-	rate     rate.Limit
-	burst    int
+// RateLimitScope is what a rate limit policy counts requests by
+type RateLimitScope string
+
+const (
+	// ScopeUser counts per user, or per client IP for anonymous requests
+	ScopeUser RateLimitScope = "user"
+	// ScopeOrg counts per organization; requests without one are not counted
+	ScopeOrg RateLimitScope = "org"
+	// ScopeIP counts per client IP
+	ScopeIP RateLimitScope = "ip"
+)
+
+// RateLimitPolicy allows Limit requests per Window for each caller in Scope
+// on the routes it matches. Policies with the same Name share one counter.
+type RateLimitPolicy struct {
+	Name  string
+	Scope RateLimitScope
+	// Method and PathPrefix select the routes; empty matches every route
+	Method     string
+	PathPrefix string
+	Limit      int64
+	Window     time.Duration
 }
 
-// // // NewRateLimiter creates a new rate limiter
-func NewRateLimiter(requestsPerSecond int, burst int) *RateLimiter {
-	return &RateLimiter{
-		limiters: make(map[string]*rate.Limiter),
-		rate:     rate.Limit(requestsPerSecond),
-		burst:    burst,
+func (p RateLimitPolicy) matches(r *http.Request) bool {
+	if p.Method != "" && p.Method != r.Method {
+		return false
 	}
+	return strings.HasPrefix(r.URL.Path, p.PathPrefix)
 }
 
-// // // getLimiter gets or creates a limiter for a key
-func (rl *RateLimiter) getLimiter(key string) *rate.Limiter {
-	rl.mu.RLock()
-	limiter, exists := rl.limiters[key]
-	rl.mu.RUnlock()
-
-	if !exists {
-		rl.mu.Lock()
-		limiter = rate.NewLimiter(rl.rate, rl.burst)
-		rl.limiters[key] = limiter
-		rl.mu.Unlock()
+// grpcWebServices are the services browsers may call with gRPC-Web, at
+// paths like /user.UserService/Login
+var grpcWebServices = []string{
+	"/user.UserService/",
+	"/task.TaskService/",
+	"/notification.NotificationService/",
+	"/organization.OrganizationService/",
+}
+
+// DefaultRateLimitPolicies make sign-in and recovery endpoints much
+// stricter than ordinary API use. gRPC-Web calls count against the same
+// limits as the REST routes they match.
+var DefaultRateLimitPolicies = append([]RateLimitPolicy{
+	{Name: "login", Scope: ScopeIP, Method: http.MethodPost, PathPrefix: "/api/v1/auth/login", Limit: 10, Window: time.Minute},
+	{Name: "login", Scope: ScopeIP, Method: http.MethodPost, PathPrefix: "/user.UserService/Login", Limit: 10, Window: time.Minute},
+	{Name: "register", Scope: ScopeIP, Method: http.MethodPost, PathPrefix: "/api/v1/auth/register", Limit: 5, Window: time.Minute},
+	{Name: "register", Scope: ScopeIP, Method: http.MethodPost, PathPrefix: "/user.UserService/Register", Limit: 5, Window: time.Minute},
+	{Name: "password-reset", Scope: ScopeIP, Method: http.MethodPost, PathPrefix: "/api/v1/auth/forgot-password", Limit: 5, Window: time.Minute},
+	{Name: "password-reset", Scope: ScopeIP, Method: http.MethodPost, PathPrefix: "/user.UserService/ForgotPassword", Limit: 5, Window: time.Minute},
+	{Name: "user", Scope: ScopeUser, PathPrefix: "/api/", Limit: 600, Window: time.Minute},
+	{Name: "org", Scope: ScopeOrg, PathPrefix: "/api/", Limit: 6000, Window: time.Minute},
+}, grpcWebPolicies()...)
+
+// grpcWebPolicies apply the per-user and per-org API limits to gRPC-Web
+// calls
+func grpcWebPolicies() []RateLimitPolicy {
+	var policies []RateLimitPolicy
+	for _, prefix := range grpcWebServices {
+		policies = append(policies,
+			RateLimitPolicy{Name: "user", Scope: ScopeUser, PathPrefix: prefix, Limit: 600, Window: time.Minute},
+			RateLimitPolicy{Name: "org", Scope: ScopeOrg, PathPrefix: prefix, Limit: 6000, Window: time.Minute},
+		)
 	}
+	return policies
+}
 
-	return limiter
+// RateLimiter enforces rate limit policies over counters shared in Redis,
+// so limits hold across gateway replicas. Without Redis, or while it is
+// unreachable, each replica counts on its own.
+type RateLimiter struct {
+	redis    *cache.RedisClient
+	policies []RateLimitPolicy
+
+	mu    sync.Mutex
+	local map[string]*localWindow
 }
 
-// // // Unary returns a rate limiting interceptor for unary RPCs
-func (rl *RateLimiter) Unary() grpc.UnaryServerInterceptor {
-	return func(
-		ctx context.Context,
-		req interface{},
-		info *grpc.UnaryServerInfo,
-		handler grpc.UnaryHandler,
-	) (interface{}, error) {
-		// 		// 		// Use user_id from context if available, otherwise use method name
-		key := info.FullMethod
-		if userID, ok := ctx.Value("user_id").(string); ok {
-			key = userID
+type localWindow struct {
+	count   int64
+	resetAt time.Time
+}
+
+// NewRateLimiter creates a limiter for policies. redis may be nil.
+func NewRateLimiter(redis *cache.RedisClient, policies []RateLimitPolicy) *RateLimiter {
+	return &RateLimiter{
+		redis:    redis,
+		policies: policies,
+		local:    make(map[string]*localWindow),
+	}
+}
+
+// Handler rejects requests over any matching policy with 429. Responses
+// carry X-RateLimit-* headers for the policy closest to its limit. It must
+// run after the caller's identity is in the request context.
+func (rl *RateLimiter) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var (
+			tightest  *RateLimitPolicy
+			remaining int64
+			resetIn   time.Duration
+		)
+		for i := range rl.policies {
+			p := &rl.policies[i]
+			if !p.matches(r) {
+				continue
+			}
+			subject := rateLimitSubject(r, p.Scope)
+			if subject == "" {
+				continue
+			}
+			count, reset := rl.hit(r.Context(), "ratelimit:"+p.Name+":"+subject, p.Window)
+			left := p.Limit - count
+			if left < 0 {
+				left = 0
+			}
+			if tightest == nil || left < remaining {
+				tightest, remaining, resetIn = p, left, reset
+			}
+			if count > p.Limit {
+				setRateLimitHeaders(w, p.Limit, 0, reset)
+				w.Header().Set("Retry-After", strconv.Itoa(ceilSeconds(reset)))
+				WriteProblem(w, r, http.StatusTooManyRequests, CodeRateLimited, "rate limit exceeded")
+				return
+			}
+		}
+		if tightest != nil {
+			setRateLimitHeaders(w, tightest.Limit, remaining, resetIn)
 		}
+		next.ServeHTTP(w, r)
+	})
+}
 
-		limiter := rl.getLimiter(key)
-		if !limiter.Allow() {
-			return nil, status.Error(codes.ResourceExhausted, "rate limit exceeded")
+// hit counts a request under key and returns the count in the current
+// window and the time until it resets
+func (rl *RateLimiter) hit(ctx context.Context, key string, window time.Duration) (int64, time.Duration) {
+	if rl.redis != nil {
+		count, reset, err := rl.redis.FixedWindowIncr(ctx, key, window)
+		if err == nil {
+			return count, reset
 		}
+		log.Printf("rate limit counter unavailable, counting locally: %v", err)
+	}
 
-		return handler(ctx, req)
+	now := time.Now()
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	lw, ok := rl.local[key]
+	if !ok || !now.Before(lw.resetAt) {
+		lw = &localWindow{resetAt: now.Add(window)}
+		rl.local[key] = lw
 	}
+	lw.count++
+	return lw.count, lw.resetAt.Sub(now)
 }
 
-// // // CleanupLimiters periodically removes inactive limiters
+// CleanupLimiters periodically removes expired local counters
 func (rl *RateLimiter) CleanupLimiters(interval time.Duration) {
 	ticker := time.NewTicker(interval)
 	go func() {
-		for range ticker.C {
+		for now := range ticker.C {
 			rl.mu.Lock()
-			for key, limiter := range rl.limiters {
-				if limiter.Tokens() == float64(rl.burst) {
-					delete(rl.limiters, key)
+			for key, lw := range rl.local {
+				if !now.Before(lw.resetAt) {
+					delete(rl.local, key)
 				}
 			}
 			rl.mu.Unlock()
 		}
 	}()
 }
+
+func rateLimitSubject(r *http.Request, scope RateLimitScope) string {
+	switch scope {
+	case ScopeOrg:
+		if orgID, _ := r.Context().Value("org_id").(string); orgID != "" {
+			return orgID
+		}
+		return ""
+	case ScopeUser:
+		if userID, _ := r.Context().Value("user_id").(string); userID != "" {
+			return userID
+		}
+	}
+	return "ip:" + ClientIP(r)
+}
+
+func setRateLimitHeaders(w http.ResponseWriter, limit, remaining int64, reset time.Duration) {
+	w.Header().Set("X-RateLimit-Limit", strconv.FormatInt(limit, 10))
+	w.Header().Set("X-RateLimit-Remaining", strconv.FormatInt(remaining, 10))
+	w.Header().Set("X-RateLimit-Reset", strconv.Itoa(ceilSeconds(reset)))
+}
+
+func ceilSeconds(d time.Duration) int {
+	s := int((d + time.Second - 1) / time.Second)
+	if s < 1 {
+		s = 1
+	}
+	return s
+}
@@ -0,0 +1,79 @@
+package middleware
+
+import (
+	"context"
+	"math/rand"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// RetryPolicy retries read-only backend calls that fail while a backend is
+// restarting. Each attempt gets its own deadline, and attempts are spaced
+// by exponential backoff with jitter.
+type RetryPolicy struct {
+	MaxAttempts    int
+	AttemptTimeout time.Duration
+	BaseBackoff    time.Duration
+	MaxBackoff     time.Duration
+}
+
+// DefaultRetryPolicy rides out a backend pod being replaced
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    3,
+	AttemptTimeout: 10 * time.Second,
+	BaseBackoff:    100 * time.Millisecond,
+	MaxBackoff:     time.Second,
+}
+
+// UnaryClient returns a client interceptor retrying idempotent calls
+func (p RetryPolicy) UnaryClient() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		if !idempotentMethod(method) {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		var err error
+		for attempt := 0; attempt < p.MaxAttempts; attempt++ {
+			if attempt > 0 {
+				select {
+				case <-time.After(p.backoff(attempt)):
+				case <-ctx.Done():
+					return err
+				}
+			}
+			attemptCtx, cancel := context.WithTimeout(ctx, p.AttemptTimeout)
+			err = invoker(attemptCtx, method, req, reply, cc, opts...)
+			cancel()
+			// an attempt timing out is retried unless the call is out of time
+			if err == nil || !backendFailure(ctx, err) {
+				return err
+			}
+		}
+		return err
+	}
+}
+
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.BaseBackoff << (attempt - 1)
+	if d > p.MaxBackoff || d <= 0 {
+		d = p.MaxBackoff
+	}
+	// full jitter keeps retries from many gateways apart
+	return time.Duration(rand.Int63n(int64(d) + 1))
+}
+
+// idempotentMethod reports whether a full method name like
+// "/task.TaskService/ListTasks" only reads
+func idempotentMethod(fullMethod string) bool {
+	name := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	return strings.HasPrefix(name, "Get") || strings.HasPrefix(name, "List")
+}
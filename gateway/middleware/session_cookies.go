@@ -0,0 +1,249 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Cookies and headers of the cookie session mode
+const (
+	AccessCookie  = "tf_access"
+	RefreshCookie = "tf_refresh"
+	// CSRFCookie is readable by the page, which echoes it in CSRFHeader on
+	// every mutation
+	CSRFCookie = "tf_csrf"
+	CSRFHeader = "X-CSRF-Token"
+	// SessionModeHeader set to "cookie" on a sign-in request asks for the
+	// session in cookies rather than in the response body
+	SessionModeHeader = "X-Session-Mode"
+)
+
+// CodeCSRFFailed rejects a cookie-authenticated mutation without a valid
+// CSRF token, or from an origin not allowed to use the session cookies
+const CodeCSRFFailed = "CSRF_FAILED"
+
+// refreshCookiePath limits the refresh token to the sign-in endpoints
+const refreshCookiePath = "/api/v1/auth/"
+
+const maxRefreshBody = 64 << 10
+
+// signInPaths need no session, so cookies left from an earlier one are
+// ignored there rather than blocking a new sign-in
+var signInPaths = map[string]bool{
+	"/api/v1/auth/login":           true,
+	"/api/v1/auth/register":        true,
+	"/api/v1/auth/forgot-password": true,
+	"/api/v1/auth/reset-password":  true,
+	"/api/v1/auth/passkey/begin":   true,
+	"/api/v1/auth/passkey/finish":  true,
+}
+
+type cookieModeKey struct{}
+
+// SessionCookies lets browsers keep their session in httpOnly cookies
+// instead of storing tokens where scripts can read them. Sign-in responses
+// to requests asking for it set the tokens as cookies, and requests then
+// authenticate with them. Mutations must repeat the CSRF cookie in a
+// header, which other sites can't read.
+type SessionCookies struct {
+	secure     bool
+	sameSite   http.SameSite
+	domain     string
+	origins    map[string]bool
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+}
+
+// NewSessionCookies sets cookies for domain (empty for the gateway's own
+// host) that only origins, besides the gateway's own, may use. ttls are the
+// lifetimes of access and refresh tokens.
+func NewSessionCookies(secure bool, sameSite http.SameSite, domain string, origins []string, accessTTL, refreshTTL time.Duration) *SessionCookies {
+	allowed := make(map[string]bool, len(origins))
+	for _, o := range origins {
+		allowed[strings.TrimSuffix(o, "/")] = true
+	}
+	return &SessionCookies{
+		secure:     secure || sameSite == http.SameSiteNoneMode,
+		sameSite:   sameSite,
+		domain:     domain,
+		origins:    allowed,
+		accessTTL:  accessTTL,
+		refreshTTL: refreshTTL,
+	}
+}
+
+// Handler turns session cookies into the bearer token the rest of the
+// gateway expects, checking the CSRF token on mutations. Requests that send
+// their own Authorization header or API key are left alone. It must run
+// before the token is read.
+func (c *SessionCookies) Handler(next http.Handler) http.Handler {
+	if c == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodOptions || r.Header.Get("Authorization") != "" || r.Header.Get("X-API-Key") != "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		cookieMode := strings.EqualFold(r.Header.Get(SessionModeHeader), "cookie")
+
+		access, _ := r.Cookie(AccessCookie)
+		refresh, _ := r.Cookie(RefreshCookie)
+		if (access != nil || refresh != nil) && !signInPaths[r.URL.Path] {
+			if !c.allowedOrigin(r) {
+				WriteProblem(w, r, http.StatusForbidden, CodeCSRFFailed, "origin may not use session cookies")
+				return
+			}
+			if !safeMethod(r.Method) && !validCSRF(r) {
+				WriteProblem(w, r, http.StatusForbidden, CodeCSRFFailed, "missing or invalid CSRF token")
+				return
+			}
+			cookieMode = true
+			if access != nil {
+				r.Header.Set("Authorization", "Bearer "+access.Value)
+			}
+			if refresh != nil && r.Method == http.MethodPost && r.URL.Path == "/api/v1/auth/refresh" {
+				if err := withRefreshToken(r, refresh.Value); err != nil {
+					WriteProblem(w, r, http.StatusBadRequest, codeNames[codes.InvalidArgument], "invalid request body")
+					return
+				}
+			}
+		}
+		if cookieMode {
+			r = r.WithContext(context.WithValue(r.Context(), cookieModeKey{}, true))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// ForwardResponse moves the tokens of sign-in responses into cookies for
+// requests in cookie mode; use it with runtime.WithForwardResponseOption.
+// A nil SessionCookies leaves every response alone.
+func (c *SessionCookies) ForwardResponse(ctx context.Context, w http.ResponseWriter, m proto.Message) error {
+	if on, _ := ctx.Value(cookieModeKey{}).(bool); c == nil || !on {
+		return nil
+	}
+	msg := m.ProtoReflect()
+	access := takeStringField(msg, "access_token")
+	if access == "" {
+		return nil
+	}
+	refresh := takeStringField(msg, "refresh_token")
+
+	http.SetCookie(w, c.cookie(AccessCookie, access, "/", c.accessTTL, true))
+	if refresh != "" {
+		http.SetCookie(w, c.cookie(RefreshCookie, refresh, refreshCookiePath, c.refreshTTL, true))
+	}
+	csrf := make([]byte, 32)
+	if _, err := rand.Read(csrf); err != nil {
+		return err
+	}
+	http.SetCookie(w, c.cookie(CSRFCookie, base64.RawURLEncoding.EncodeToString(csrf), "/", c.refreshTTL, false))
+	return nil
+}
+
+// HandleLogout clears the session cookies
+func (c *SessionCookies) HandleLogout(w http.ResponseWriter, r *http.Request) {
+	if !c.allowedOrigin(r) || !validCSRF(r) {
+		WriteProblem(w, r, http.StatusForbidden, CodeCSRFFailed, "missing or invalid CSRF token")
+		return
+	}
+	http.SetCookie(w, c.cookie(AccessCookie, "", "/", -1, true))
+	http.SetCookie(w, c.cookie(RefreshCookie, "", refreshCookiePath, -1, true))
+	http.SetCookie(w, c.cookie(CSRFCookie, "", "/", -1, false))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (c *SessionCookies) cookie(name, value, path string, ttl time.Duration, httpOnly bool) *http.Cookie {
+	cookie := &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     path,
+		Domain:   c.domain,
+		Secure:   c.secure,
+		HttpOnly: httpOnly,
+		SameSite: c.sameSite,
+	}
+	if ttl < 0 {
+		cookie.MaxAge = -1
+	} else {
+		cookie.MaxAge = int(ttl / time.Second)
+	}
+	return cookie
+}
+
+// allowedOrigin reports whether r comes from the gateway's own origin or
+// one of the configured ones. Requests without an Origin header are
+// navigations or same-origin requests.
+func (c *SessionCookies) allowedOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" || c.origins[origin] {
+		return true
+	}
+	u, err := url.Parse(origin)
+	return err == nil && u.Host == r.Host
+}
+
+func safeMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead
+}
+
+// validCSRF checks the double-submitted CSRF token
+func validCSRF(r *http.Request) bool {
+	cookie, err := r.Cookie(CSRFCookie)
+	header := r.Header.Get(CSRFHeader)
+	return err == nil && cookie.Value != "" && header != "" &&
+		subtle.ConstantTimeCompare([]byte(cookie.Value), []byte(header)) == 1
+}
+
+// withRefreshToken fills in the refresh request's token from its cookie
+func withRefreshToken(r *http.Request, token string) error {
+	body := map[string]interface{}{}
+	if r.Body != nil {
+		raw, err := io.ReadAll(io.LimitReader(r.Body, maxRefreshBody))
+		if err != nil {
+			return err
+		}
+		if len(bytes.TrimSpace(raw)) > 0 {
+			if err := json.Unmarshal(raw, &body); err != nil {
+				return err
+			}
+		}
+	}
+	if v, _ := body["refresh_token"].(string); v == "" {
+		body["refresh_token"] = token
+	}
+	raw, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	r.Body = io.NopCloser(bytes.NewReader(raw))
+	r.ContentLength = int64(len(raw))
+	r.Header.Set("Content-Type", "application/json")
+	return nil
+}
+
+// takeStringField returns the string field name of msg and clears it, so
+// the token is not also in the body
+func takeStringField(msg protoreflect.Message, name protoreflect.Name) string {
+	fd := msg.Descriptor().Fields().ByName(name)
+	if fd == nil || fd.Kind() != protoreflect.StringKind {
+		return ""
+	}
+	value := msg.Get(fd).String()
+	msg.Clear(fd)
+	return value
+}
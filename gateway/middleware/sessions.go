@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/pkg/cache"
+)
+
+// SessionRevocations rejects tokens issued before a user's sessions were
+// revoked (e.g. on suspension). Lookups are memoized for ttl, so revocation
+// takes effect within ttl at the gateway.
+type SessionRevocations struct {
+	redis   *cache.RedisClient
+	ttl     time.Duration
+	mu      sync.RWMutex
+	entries map[string]revocationEntry
+}
+
+type revocationEntry struct {
+	revokedAt time.Time
+	expires   time.Time
+}
+
+// NewSessionRevocations creates a revocation checker backed by Redis
+func NewSessionRevocations(redis *cache.RedisClient, ttl time.Duration) *SessionRevocations {
+	return &SessionRevocations{
+		redis:   redis,
+		ttl:     ttl,
+		entries: make(map[string]revocationEntry),
+	}
+}
+
+// IsRevoked reports whether a token for userID issued at issuedAt has been
+// revoked. If Redis is unavailable the token is accepted.
+func (s *SessionRevocations) IsRevoked(ctx context.Context, userID string, issuedAt time.Time) bool {
+	now := time.Now()
+	s.mu.RLock()
+	entry, ok := s.entries[userID]
+	s.mu.RUnlock()
+	if ok && now.Before(entry.expires) {
+		return cache.TokenRevoked(issuedAt, entry.revokedAt)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 500*time.Millisecond)
+	defer cancel()
+	revokedAt, err := s.redis.SessionsRevokedAt(ctx, userID)
+	if err != nil {
+		log.Printf("session revocation lookup failed for userID=%s: %v", userID, err)
+		return false
+	}
+
+	s.mu.Lock()
+	// Opportunistically drop expired entries so the map doesn't grow unbounded
+	if len(s.entries) > 10000 {
+		for k, e := range s.entries {
+			if now.After(e.expires) {
+				delete(s.entries, k)
+			}
+		}
+	}
+	s.entries[userID] = revocationEntry{revokedAt: revokedAt, expires: now.Add(s.ttl)}
+	s.mu.Unlock()
+
+	return cache.TokenRevoked(issuedAt, revokedAt)
+}
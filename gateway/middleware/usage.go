@@ -0,0 +1,128 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	organizationpb "github.com/chanduchitikam/task-management-system/proto/organization"
+	"go.uber.org/zap"
+)
+
+type usageKey struct {
+	orgID string
+	day   string
+}
+
+type usageCounts struct {
+	requests int64
+	users    map[string]struct{}
+}
+
+// UsageRecorder counts API requests per organization and UTC day, and
+// periodically hands the counts to the organization service. Counts not yet
+// flushed are lost if the gateway stops.
+type UsageRecorder struct {
+	client organizationpb.OrganizationServiceClient
+	logger *zap.Logger
+
+	mu     sync.Mutex
+	counts map[usageKey]*usageCounts
+}
+
+// NewUsageRecorder creates a recorder that flushes to the organization service
+func NewUsageRecorder(client organizationpb.OrganizationServiceClient, logger *zap.Logger) *UsageRecorder {
+	return &UsageRecorder{
+		client: client,
+		logger: logger,
+		counts: make(map[usageKey]*usageCounts),
+	}
+}
+
+// Handler counts every request made on behalf of an organization. It must
+// run after the caller's identity is in the request context.
+func (u *UsageRecorder) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		orgID, _ := r.Context().Value("org_id").(string)
+		userID, _ := r.Context().Value("user_id").(string)
+		if orgID != "" {
+			u.record(orgID, userID, time.Now())
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (u *UsageRecorder) record(orgID, userID string, now time.Time) {
+	key := usageKey{orgID: orgID, day: now.UTC().Format("2006-01-02")}
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	c, ok := u.counts[key]
+	if !ok {
+		c = &usageCounts{users: make(map[string]struct{})}
+		u.counts[key] = c
+	}
+	c.requests++
+	if userID != "" {
+		c.users[userID] = struct{}{}
+	}
+}
+
+// Run flushes the counts every interval until ctx is done
+func (u *UsageRecorder) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			u.flush(ctx)
+		}
+	}
+}
+
+// flush sends the counts gathered so far. If the organization service cannot
+// take them they are kept for the next flush.
+func (u *UsageRecorder) flush(ctx context.Context) {
+	u.mu.Lock()
+	pending := u.counts
+	u.counts = make(map[usageKey]*usageCounts)
+	u.mu.Unlock()
+	if len(pending) == 0 {
+		return
+	}
+
+	samples := make([]*organizationpb.OrgUsageSample, 0, len(pending))
+	for key, c := range pending {
+		sample := &organizationpb.OrgUsageSample{OrgId: key.orgID, Day: key.day, Requests: c.requests}
+		for id := range c.users {
+			sample.UserIds = append(sample.UserIds, id)
+		}
+		samples = append(samples, sample)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	if _, err := u.client.RecordOrgUsage(ctx, &organizationpb.RecordOrgUsageRequest{Samples: samples}); err != nil {
+		u.logger.Warn("Failed to record org usage, retrying on next flush", zap.Int("samples", len(samples)), zap.Error(err))
+		u.restore(pending)
+	}
+}
+
+// restore merges counts that failed to flush back into the current ones
+func (u *UsageRecorder) restore(pending map[usageKey]*usageCounts) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	for key, old := range pending {
+		c, ok := u.counts[key]
+		if !ok {
+			u.counts[key] = old
+			continue
+		}
+		c.requests += old.requests
+		for id := range old.users {
+			c.users[id] = struct{}{}
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"syscall"
+
+	"github.com/chanduchitikam/task-management-system/gateway/middleware"
+	"github.com/chanduchitikam/task-management-system/pkg/auth"
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
+	"github.com/chanduchitikam/task-management-system/pkg/config"
+)
+
+// gatewaySettings are the settings the gateway can change without a restart
+type gatewaySettings struct {
+	AccessLogSampleRate  float64 `json:"access_log_sample_rate"`
+	StrictTokens         bool    `json:"strict_tokens"`
+	RequestsPerUser      int     `json:"max_concurrent_requests_per_user"`
+	RequestsPerOrg       int     `json:"max_concurrent_requests_per_org"`
+	WSConnectionsPerUser int     `json:"ws_max_connections_per_user"`
+	WSConnectionsPerOrg  int     `json:"ws_max_connections_per_org"`
+}
+
+// loadGatewaySettings reads the reloadable settings from the environment
+func loadGatewaySettings() (gatewaySettings, error) {
+	s := gatewaySettings{StrictTokens: getEnvOrDefault("GATEWAY_STRICT_TOKENS", "true") != "false"}
+	var err error
+	s.AccessLogSampleRate, err = strconv.ParseFloat(getEnvOrDefault("ACCESS_LOG_SAMPLE_RATE", "1"), 64)
+	if err != nil || s.AccessLogSampleRate < 0 || s.AccessLogSampleRate > 1 {
+		return s, fmt.Errorf("invalid ACCESS_LOG_SAMPLE_RATE: must be between 0 and 1")
+	}
+	for _, v := range []struct {
+		key string
+		def int
+		dst *int
+	}{
+		{"MAX_CONCURRENT_REQUESTS_PER_USER", 50, &s.RequestsPerUser},
+		{"MAX_CONCURRENT_REQUESTS_PER_ORG", 500, &s.RequestsPerOrg},
+		{"WS_MAX_CONNECTIONS_PER_USER", 10, &s.WSConnectionsPerUser},
+		{"WS_MAX_CONNECTIONS_PER_ORG", 1000, &s.WSConnectionsPerOrg},
+	} {
+		*v.dst = v.def
+		if raw := os.Getenv(v.key); raw != "" {
+			n, err := strconv.Atoi(raw)
+			if err != nil || n < 0 {
+				return s, fmt.Errorf("invalid %s: must be a non-negative integer", v.key)
+			}
+			*v.dst = n
+		}
+	}
+	return s, nil
+}
+
+// reloader re-reads the JWT keys and the gateway settings on SIGHUP or
+// through the admin endpoint. A reload that fails keeps the settings and
+// keys in force.
+type reloader struct {
+	// envFile, from GATEWAY_ENV_FILE, is read into the environment first,
+	// since the process's own environment can't change
+	envFile string
+
+	jwtManager   *auth.JWTManager
+	accessLog    *middleware.AccessLogger
+	strictTokens *atomic.Bool
+	requests     *middleware.ConcurrencyLimiter
+	wsConns      *middleware.ConcurrencyLimiter
+
+	mu sync.Mutex
+	// cfg and settings are those in force, shown by handleConfig
+	cfg      *config.Config
+	settings gatewaySettings
+}
+
+func (rl *reloader) apply(s gatewaySettings) {
+	rl.settings = s
+	rl.accessLog.SetSampleRate(s.AccessLogSampleRate)
+	rl.strictTokens.Store(s.StrictTokens)
+	rl.requests.SetLimits(s.RequestsPerUser, s.RequestsPerOrg)
+	rl.wsConns.SetLimits(s.WSConnectionsPerUser, s.WSConnectionsPerOrg)
+}
+
+func (rl *reloader) reload() (gatewaySettings, error) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	if rl.envFile != "" {
+		if err := config.LoadEnvFile(rl.envFile); err != nil {
+			return gatewaySettings{}, fmt.Errorf("reading GATEWAY_ENV_FILE: %w", err)
+		}
+	}
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return gatewaySettings{}, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return gatewaySettings{}, err
+	}
+	settings, err := loadGatewaySettings()
+	if err != nil {
+		return gatewaySettings{}, err
+	}
+	if err := rl.jwtManager.LoadKeys(cfg.JWT); err != nil {
+		return gatewaySettings{}, err
+	}
+	rl.cfg = cfg
+	rl.apply(settings)
+	return settings, nil
+}
+
+// onSIGHUP reloads whenever the process gets SIGHUP
+func (rl *reloader) onSIGHUP() {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	for range hup {
+		if _, err := rl.reload(); err != nil {
+			log.Printf("Gateway reload failed, keeping current settings: %v", err)
+			continue
+		}
+		log.Printf("Gateway settings and JWT keys reloaded")
+	}
+}
+
+// codeReloadFailed reports a reload rejected for invalid settings or keys
+const codeReloadFailed = "RELOAD_FAILED"
+
+// handleReload reloads on request of a platform admin and returns the
+// settings now in force
+func (rl *reloader) handleReload(w http.ResponseWriter, r *http.Request) {
+	if !requirePlatformAdmin(w, r, "only platform admins may reload the gateway") {
+		return
+	}
+	settings, err := rl.reload()
+	if err != nil {
+		log.Printf("Gateway reload failed, keeping current settings: %v", err)
+		middleware.WriteProblem(w, r, http.StatusUnprocessableEntity, codeReloadFailed, err.Error())
+		return
+	}
+	log.Printf("Gateway settings and JWT keys reloaded")
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(settings)
+}
+
+// handleConfig shows a platform admin the configuration and settings in
+// force, with secrets redacted
+func (rl *reloader) handleConfig(w http.ResponseWriter, r *http.Request) {
+	if !requirePlatformAdmin(w, r, "only platform admins may view the gateway config") {
+		return
+	}
+	rl.mu.Lock()
+	resp := struct {
+		Config   config.Config   `json:"config"`
+		Settings gatewaySettings `json:"settings"`
+	}{rl.cfg.Redacted(), rl.settings}
+	rl.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// requirePlatformAdmin rejects requests of anyone but a platform admin,
+// reporting whether r may go on
+func requirePlatformAdmin(w http.ResponseWriter, r *http.Request, denied string) bool {
+	if userID, _ := r.Context().Value("user_id").(string); userID == "" {
+		middleware.WriteProblem(w, r, http.StatusUnauthorized, middleware.CodeUnauthenticated, "authentication required")
+		return false
+	}
+	if role, _ := r.Context().Value("role").(string); !authz.IsPlatformAdmin(role) {
+		middleware.WriteProblem(w, r, http.StatusForbidden, "PERMISSION_DENIED", denied)
+		return false
+	}
+	return true
+}
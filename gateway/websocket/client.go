@@ -37,6 +37,7 @@ func (c *Client) readPump() {
 	defer func() {
 		c.hub.unregister <- c
 		c.conn.Close()
+		close(c.done)
 	}()
 
 	c.conn.SetReadDeadline(time.Now().Add(pongWait))
@@ -59,20 +60,15 @@ func (c *Client) readPump() {
 		}
 
 		// 		// 		// Handle incoming messages
+		c.hub.counters.received.Add(1)
 		var msg Message
 		if err := json.Unmarshal(message, &msg); err != nil {
 			log.Printf("Failed to unmarshal message: %v", err)
+			c.replyError(&Message{}, errInvalidCommand.Error())
 			continue
 		}
 
-		// 		// 		// Handle ping messages
-		if msg.Type == MessageTypePing {
-			c.send <- []byte(`{"type":"pong","timestamp":"` + time.Now().Format(time.RFC3339) + `"}`)
-			continue
-		}
-
-		// 		// 		// You can add more client-to-server message handling here
-		log.Printf("Received message from client %s: %s", c.userID, msg.Type)
+		c.handleCommand(&msg)
 	}
 }
 
@@ -120,12 +116,13 @@ func (c *Client) writePump() {
 	}
 }
 
-// // // ServeWs handles WebSocket requests from clients
-func ServeWs(hub *Hub, w http.ResponseWriter, r *http.Request, userID string) {
+// // // ServeWs handles WebSocket requests from clients. It returns a channel
+// closed once the connection ends, or nil if it could not be opened.
+func ServeWs(hub *Hub, w http.ResponseWriter, r *http.Request, userID, orgID, role string) <-chan struct{} {
 	conn, err := upgrader.Upgrade(w, r, nil)
 	if err != nil {
 		log.Printf("Failed to upgrade connection: %v", err)
-		return
+		return nil
 	}
 
 	client := &Client{
@@ -133,7 +130,10 @@ func ServeWs(hub *Hub, w http.ResponseWriter, r *http.Request, userID string) {
 		conn:     conn,
 		send:     make(chan []byte, 256),
 		userID:   userID,
+		orgID:    orgID,
+		role:     role,
 		lastPing: time.Now(),
+		done:     make(chan struct{}),
 	}
 
 	client.hub.register <- client
@@ -141,4 +141,5 @@ func ServeWs(hub *Hub, w http.ResponseWriter, r *http.Request, userID string) {
 	// 	// 	// Start read and write pumps in separate goroutines
 	go client.writePump()
 	go client.readPump()
+	return client.done
 }
@@ -0,0 +1,256 @@
+package websocket
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	notificationpb "github.com/chanduchitikam/task-management-system/proto/notification"
+	organizationpb "github.com/chanduchitikam/task-management-system/proto/organization"
+	taskpb "github.com/chanduchitikam/task-management-system/proto/task"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Client commands beyond ping and the room messages. Every command may
+// carry an id, which its ack or error repeats.
+const (
+	// MessageTypeSubscribeBoard joins the room of a board's tasks; its
+	// reply names the room, for a later unsubscribe
+	MessageTypeSubscribeBoard = "subscribe.board"
+	// MessageTypeNotificationAck marks data.notification_id as read
+	MessageTypeNotificationAck = "notification.ack"
+	// MessageTypePresenceHeartbeat keeps the connection, and the user's
+	// presence, alive where WebSocket pings don't reach the server
+	MessageTypePresenceHeartbeat = "presence.heartbeat"
+	MessageTypeAck               = "ack"
+)
+
+// commandTimeout bounds each backend call a command makes
+const commandTimeout = 5 * time.Second
+
+const maxCommandIDLength = 64
+
+var (
+	errUnknownCommand = errors.New("unknown command")
+	errInvalidCommand = errors.New("invalid command")
+)
+
+// CommandBackend checks and carries out client commands with the services.
+// Calls are made as the user in ctx, so the services apply their usual
+// access rules.
+type CommandBackend interface {
+	// CheckRoom returns nil if the user may see the events of room
+	CheckRoom(ctx context.Context, kind, id string) error
+	// BoardRoom returns the room of board's tasks
+	BoardRoom(ctx context.Context, boardID string) (string, error)
+	// MarkNotificationRead marks one of the user's notifications as read
+	MarkNotificationRead(ctx context.Context, notificationID string) error
+}
+
+// SetCommandBackend checks room subscriptions with the services and enables
+// the commands that need them. Without one, clients may join any team,
+// project or task room of their org.
+func (h *Hub) SetCommandBackend(b CommandBackend) {
+	h.commands = b
+}
+
+// handleCommand runs one message from the client and replies to it
+func (c *Client) handleCommand(msg *Message) {
+	if len(msg.ID) > maxCommandIDLength {
+		c.replyError(msg, errInvalidCommand.Error())
+		return
+	}
+
+	switch msg.Type {
+	case MessageTypePing:
+		c.reply(&Message{Type: MessageTypePong, ID: msg.ID})
+
+	case MessageTypeSubscribe:
+		if err := c.join(msg.Room); err != nil {
+			c.replyError(msg, err.Error())
+			return
+		}
+		c.reply(&Message{Type: MessageTypeSubscribed, ID: msg.ID, Room: msg.Room})
+
+	case MessageTypeSubscribeBoard:
+		boardID, _ := msg.Data["board_id"].(string)
+		if boardID == "" || c.hub.commands == nil {
+			c.replyError(msg, errInvalidCommand.Error())
+			return
+		}
+		if c.orgID == "" {
+			c.replyError(msg, errRoomDenied.Error())
+			return
+		}
+		ctx, cancel := c.commandContext()
+		room, err := c.hub.commands.BoardRoom(ctx, boardID)
+		cancel()
+		if err != nil {
+			c.replyError(msg, commandError(err))
+			return
+		}
+		if err := c.hub.subscribe(c, room); err != nil {
+			c.replyError(msg, err.Error())
+			return
+		}
+		c.reply(&Message{Type: MessageTypeSubscribed, ID: msg.ID, Room: room,
+			Data: map[string]interface{}{"board_id": boardID}})
+
+	case MessageTypeUnsubscribe:
+		c.hub.unsubscribe(c, msg.Room)
+		c.reply(&Message{Type: MessageTypeUnsubscribed, ID: msg.ID, Room: msg.Room})
+
+	case MessageTypeNotificationAck:
+		notificationID, _ := msg.Data["notification_id"].(string)
+		if notificationID == "" || c.hub.commands == nil {
+			c.replyError(msg, errInvalidCommand.Error())
+			return
+		}
+		ctx, cancel := c.commandContext()
+		err := c.hub.commands.MarkNotificationRead(ctx, notificationID)
+		cancel()
+		if err != nil {
+			c.replyError(msg, commandError(err))
+			return
+		}
+		c.reply(&Message{Type: MessageTypeAck, ID: msg.ID,
+			Data: map[string]interface{}{"request": msg.Type, "notification_id": notificationID}})
+
+	case MessageTypePresenceHeartbeat:
+		c.conn.SetReadDeadline(time.Now().Add(pongWait))
+		c.mu.Lock()
+		c.lastPing = time.Now()
+		c.mu.Unlock()
+		c.reply(&Message{Type: MessageTypeAck, ID: msg.ID,
+			Data: map[string]interface{}{"request": msg.Type}})
+
+	default:
+		log.Printf("Unknown message from client %s: %s", c.userID, msg.Type)
+		c.replyError(msg, errUnknownCommand.Error())
+	}
+}
+
+// join subscribes the client to room once the backend, if any, agrees the
+// user may see it
+func (c *Client) join(room string) error {
+	if err := roomAllowed(c, room); err != nil {
+		return err
+	}
+	// the hub alone decides on org rooms
+	if kind, id, _ := parseRoom(room); c.hub.commands != nil && kind != RoomOrg {
+		ctx, cancel := c.commandContext()
+		err := c.hub.commands.CheckRoom(ctx, kind, id)
+		cancel()
+		if err != nil {
+			if status.Code(err) == codes.Unavailable || status.Code(err) == codes.DeadlineExceeded {
+				return errors.New(commandError(err))
+			}
+			return errRoomDenied
+		}
+	}
+	return c.hub.subscribe(c, room)
+}
+
+// commandContext carries the client's identity the way the gateway's HTTP
+// layer does, for backend calls made on its behalf
+func (c *Client) commandContext() (context.Context, context.CancelFunc) {
+	ctx := context.WithValue(context.Background(), "user_id", c.userID)
+	ctx = context.WithValue(ctx, "org_id", c.orgID)
+	ctx = context.WithValue(ctx, "role", c.role)
+	md := metadata.Pairs("user_id", c.userID, "x-user-id", c.userID)
+	if c.orgID != "" {
+		md.Append("org_id", c.orgID)
+		md.Append("x-org-id", c.orgID)
+	}
+	if c.role != "" {
+		md.Append("role", c.role)
+		md.Append("x-role", c.role)
+	}
+	return context.WithTimeout(metadata.NewOutgoingContext(ctx, md), commandTimeout)
+}
+
+// commandError is the reason given to the client for a failed backend call.
+// Only the message of errors about the request itself is passed on.
+func commandError(err error) string {
+	st := status.Convert(err)
+	switch st.Code() {
+	case codes.InvalidArgument, codes.NotFound, codes.PermissionDenied, codes.FailedPrecondition:
+		return st.Message()
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return "service unavailable, try again"
+	}
+	return "command failed"
+}
+
+// GRPCCommandBackend implements CommandBackend with the services' public
+// APIs
+type GRPCCommandBackend struct {
+	tasks         taskpb.TaskServiceClient
+	orgs          organizationpb.OrganizationServiceClient
+	notifications notificationpb.NotificationServiceClient
+}
+
+// NewGRPCCommandBackend calls the services over the gateway's backend
+// connections
+func NewGRPCCommandBackend(task, org, notification grpc.ClientConnInterface) *GRPCCommandBackend {
+	return &GRPCCommandBackend{
+		tasks:         taskpb.NewTaskServiceClient(task),
+		orgs:          organizationpb.NewOrganizationServiceClient(org),
+		notifications: notificationpb.NewNotificationServiceClient(notification),
+	}
+}
+
+// CheckRoom implements CommandBackend. The task service only returns tasks
+// the caller may see; teams and projects must be in the caller's org.
+func (b *GRPCCommandBackend) CheckRoom(ctx context.Context, kind, id string) error {
+	orgID, _ := ctx.Value("org_id").(string)
+	switch kind {
+	case RoomTask:
+		_, err := b.tasks.GetTask(ctx, &taskpb.GetTaskRequest{TaskId: id})
+		return err
+	case RoomTeam:
+		resp, err := b.orgs.GetTeam(ctx, &organizationpb.GetTeamRequest{TeamId: id})
+		if err != nil {
+			return err
+		}
+		if resp.GetTeam().GetOrgId() != orgID {
+			return status.Error(codes.NotFound, "team not found")
+		}
+	case RoomProject:
+		resp, err := b.orgs.GetProject(ctx, &organizationpb.GetProjectRequest{ProjectId: id})
+		if err != nil {
+			return err
+		}
+		if resp.GetProject().GetOrgId() != orgID {
+			return status.Error(codes.NotFound, "project not found")
+		}
+	}
+	return nil
+}
+
+// BoardRoom implements CommandBackend
+func (b *GRPCCommandBackend) BoardRoom(ctx context.Context, boardID string) (string, error) {
+	resp, err := b.tasks.GetBoard(ctx, &taskpb.GetBoardRequest{BoardId: boardID})
+	if err != nil {
+		return "", err
+	}
+	board := resp.GetBoard()
+	if board.GetProjectId() != "" {
+		return Room(RoomProject, board.GetProjectId()), nil
+	}
+	if board.GetTeamId() != "" {
+		return Room(RoomTeam, board.GetTeamId()), nil
+	}
+	return "", status.Error(codes.FailedPrecondition, "board has no project or team")
+}
+
+// MarkNotificationRead implements CommandBackend
+func (b *GRPCCommandBackend) MarkNotificationRead(ctx context.Context, notificationID string) error {
+	userID, _ := ctx.Value("user_id").(string)
+	_, err := b.notifications.MarkAsRead(ctx, &notificationpb.MarkAsReadRequest{NotificationId: notificationID, UserId: userID})
+	return err
+}
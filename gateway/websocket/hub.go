@@ -1,11 +1,13 @@
 package websocket
 
 import (
+	"context"
 	"encoding/json"
 	"log"
 	"sync"
 	"time"
 
+	"github.com/chanduchitikam/task-management-system/pkg/cache"
 	"github.com/gorilla/websocket"
 )
 
@@ -16,16 +18,27 @@ const (
 	MessageTypeTaskDeleted  = "task.deleted"
 	MessageTypeTaskAssigned = "task.assigned"
 	MessageTypeNotification = "notification.new"
-	MessageTypeUserOnline   = "user.online"
-	MessageTypeUserOffline  = "user.offline"
-	MessageTypePing         = "ping"
-	MessageTypePong         = "pong"
+	// MessageTypeNotificationRead reports notifications read or removed on
+	// another device
+	MessageTypeNotificationRead = "notification.read"
+	MessageTypeAnnouncement     = "announcement.new"
+	MessageTypeUserOnline       = "user.online"
+	MessageTypeUserOffline      = "user.offline"
+	MessageTypePing             = "ping"
+	MessageTypePong             = "pong"
 )
 
 // // // Message represents a WebSocket message
 type Message struct {
-	Type      string                 `json:"type"`
-	UserID    string                 `json:"user_id,omitempty"`
+	Type string `json:"type"`
+	// ID is chosen by the client for a command and repeated on its reply
+	ID     string `json:"id,omitempty"`
+	UserID string `json:"user_id,omitempty"`
+	OrgID  string `json:"org_id,omitempty"`
+	// Room names the room a client subscribes to or leaves
+	Room string `json:"room,omitempty"`
+	// Rooms are the rooms a broadcast is for
+	Rooms     []string               `json:"rooms,omitempty"`
 	Timestamp time.Time              `json:"timestamp"`
 	Data      map[string]interface{} `json:"data"`
 }
@@ -36,31 +49,73 @@ type Client struct {
 	conn     *websocket.Conn
 	send     chan []byte
 	userID   string
+	orgID    string
+	role     string
 	mu       sync.Mutex
 	lastPing time.Time
+	// rooms the client is subscribed to, guarded by the hub's mu
+	rooms map[string]bool
+	// done is closed when the connection has ended
+	done chan struct{}
 }
 
 // // // Hub maintains active WebSocket clients and broadcasts messages
 type Hub struct {
 	clients    map[string]map[*Client]bool // userID -> clients
+	rooms      map[string]map[*Client]bool // room -> subscribed clients
 	broadcast  chan *Message
 	register   chan *Client
 	unregister chan *Client
-	mu         sync.RWMutex
+	// orgDeliveries queues org-scoped broadcasts for their membership check
+	orgDeliveries chan orgDelivery
+	membership    MembershipChecker
+	commands      CommandBackend
+	mu            sync.RWMutex
+	counters      hubCounters
+
+	// relay, when enabled, shares broadcasts and presence with the other
+	// gateway replicas
+	relayMu sync.RWMutex
+	relay   *cache.RedisClient
+	podID   string
+}
+
+// MembershipChecker reports whether a user still belongs to an org. The org
+// in a client's token may be stale if they were removed after it was issued.
+type MembershipChecker interface {
+	IsOrgMember(ctx context.Context, orgID, userID string) bool
 }
 
 // // // NewHub creates a new WebSocket hub
 func NewHub() *Hub {
 	return &Hub{
-		clients:    make(map[string]map[*Client]bool),
-		broadcast:  make(chan *Message, 256),
-		register:   make(chan *Client),
-		unregister: make(chan *Client),
+		clients:       make(map[string]map[*Client]bool),
+		rooms:         make(map[string]map[*Client]bool),
+		broadcast:     make(chan *Message, 256),
+		register:      make(chan *Client),
+		unregister:    make(chan *Client),
+		orgDeliveries: make(chan orgDelivery, 256),
+		counters:      hubCounters{started: time.Now()},
+	}
+}
+
+// SetMembershipChecker enables membership verification for org-scoped
+// broadcasts. Without one, the org in the client's token is trusted.
+func (h *Hub) SetMembershipChecker(m MembershipChecker) {
+	h.membership = m
+}
+
+// HasOrgMembership reports whether userID may receive orgID's events
+func (h *Hub) HasOrgMembership(orgID, userID string) bool {
+	if orgID == "" || h.membership == nil {
+		return true
 	}
+	return h.membership.IsOrgMember(context.Background(), orgID, userID)
 }
 
 // // // Run starts the hub's main loop
 func (h *Hub) Run() {
+	go h.runOrgDeliveries()
 	for {
 		select {
 		case client := <-h.register:
@@ -70,6 +125,7 @@ func (h *Hub) Run() {
 			h.unregisterClient(client)
 
 		case message := <-h.broadcast:
+			h.counters.broadcasts.Add(1)
 			h.broadcastMessage(message)
 		}
 	}
@@ -80,7 +136,8 @@ func (h *Hub) registerClient(client *Client) {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
-	if h.clients[client.userID] == nil {
+	first := h.clients[client.userID] == nil
+	if first {
 		h.clients[client.userID] = make(map[*Client]bool)
 	}
 	h.clients[client.userID][client] = true
@@ -88,13 +145,8 @@ func (h *Hub) registerClient(client *Client) {
 	log.Printf("Client registered: userID=%s, total_clients=%d", client.userID, h.getTotalClients())
 
 	// 	// 	// Broadcast user online status
-	h.broadcast <- &Message{
-		Type:      MessageTypeUserOnline,
-		UserID:    client.userID,
-		Timestamp: time.Now(),
-		Data: map[string]interface{}{
-			"user_id": client.userID,
-		},
+	if first {
+		go h.presenceChanged(client.userID, true)
 	}
 }
 
@@ -103,6 +155,7 @@ func (h *Hub) unregisterClient(client *Client) {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
+	h.leaveRooms(client)
 	if clients, ok := h.clients[client.userID]; ok {
 		if _, exists := clients[client]; exists {
 			delete(clients, client)
@@ -113,14 +166,7 @@ func (h *Hub) unregisterClient(client *Client) {
 				delete(h.clients, client.userID)
 
 				// 				// 				// Broadcast user offline status
-				h.broadcast <- &Message{
-					Type:      MessageTypeUserOffline,
-					UserID:    client.userID,
-					Timestamp: time.Now(),
-					Data: map[string]interface{}{
-						"user_id": client.userID,
-					},
-				}
+				go h.presenceChanged(client.userID, false)
 			}
 
 			log.Printf("Client unregistered: userID=%s, total_clients=%d", client.userID, h.getTotalClients())
@@ -130,65 +176,152 @@ func (h *Hub) unregisterClient(client *Client) {
 
 // // // broadcastMessage broadcasts a message to relevant clients
 func (h *Hub) broadcastMessage(message *Message) {
-	h.mu.RLock()
-	defer h.mu.RUnlock()
-
 	data, err := json.Marshal(message)
 	if err != nil {
 		log.Printf("Failed to marshal message: %v", err)
 		return
 	}
 
+	// Org-scoped messages go only to clients whose membership is still
+	// current. Checking may reach Redis, so it is left to the delivery
+	// goroutine rather than holding up the Run loop and the lock.
+	if len(message.Rooms) > 0 || message.OrgID != "" {
+		d := orgDelivery{orgID: message.OrgID, data: data, kind: "org"}
+		h.mu.RLock()
+		if len(message.Rooms) > 0 {
+			d.kind = "room"
+			d.clients = h.roomRecipients(message)
+		} else {
+			d.clients = h.orgRecipients(message.OrgID)
+		}
+		h.mu.RUnlock()
+		switch {
+		case len(d.clients) == 0:
+		case h.membership == nil:
+			h.deliverToOrg(d, nil)
+		default:
+			h.orgDeliveries <- d
+		}
+		return
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
 	// 	// 	// If message has a specific userID, send only to that user's clients
+	var slow []*Client
 	if message.UserID != "" {
-		if clients, ok := h.clients[message.UserID]; ok {
-			for client := range clients {
-				select {
-				case client.send <- data:
-				default:
-					log.Printf("Client send buffer full, closing connection: userID=%s", message.UserID)
-					close(client.send)
-					delete(clients, client)
-				}
+		for client := range h.clients[message.UserID] {
+			if !h.trySend(client, data) {
+				log.Printf("Client send buffer full, closing connection: userID=%s", message.UserID)
+				slow = append(slow, client)
 			}
 		}
 	} else {
 		// 		// 		// Broadcast to all clients
 		for _, clients := range h.clients {
 			for client := range clients {
-				select {
-				case client.send <- data:
-				default:
+				if !h.trySend(client, data) {
 					log.Printf("Client send buffer full, closing connection: userID=%s", client.userID)
-					close(client.send)
-					delete(clients, client)
+					slow = append(slow, client)
 				}
 			}
 		}
 	}
+	if len(slow) > 0 {
+		go h.evictClients(slow)
+	}
 }
 
-// // // BroadcastToUser sends a message to all connections of a specific user
+// // // BroadcastToUser sends a message to all connections of a specific user,
+// on every replica when the relay is enabled
 func (h *Hub) BroadcastToUser(userID string, messageType string, data map[string]interface{}) {
-	h.broadcast <- &Message{
+	h.publish(&Message{
 		Type:      messageType,
 		UserID:    userID,
 		Timestamp: time.Now(),
 		Data:      data,
+	})
+}
+
+// BroadcastToOrg sends a message to every connected member of an org
+func (h *Hub) BroadcastToOrg(orgID string, messageType string, data map[string]interface{}) {
+	h.publish(&Message{
+		Type:      messageType,
+		OrgID:     orgID,
+		Timestamp: time.Now(),
+		Data:      data,
+	})
+}
+
+// EvictOrgMember closes the user's connections that were authorized for orgID.
+// Called when a member is removed so their open sessions stop receiving org
+// events immediately.
+func (h *Hub) EvictOrgMember(orgID, userID string) {
+	h.mu.RLock()
+	var stale []*Client
+	for client := range h.clients[userID] {
+		if client.orgID == orgID {
+			stale = append(stale, client)
+		}
+	}
+	h.mu.RUnlock()
+
+	if len(stale) > 0 {
+		log.Printf("Evicting %d connection(s) for userID=%s removed from orgID=%s", len(stale), userID, orgID)
+		h.evictClients(stale)
+	}
+}
+
+// evictClients unregisters clients and closes their send channels, which
+// makes writePump send a close frame and end the connection.
+func (h *Hub) evictClients(stale []*Client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, client := range stale {
+		h.leaveRooms(client)
+		clients, ok := h.clients[client.userID]
+		if !ok {
+			continue
+		}
+		if _, exists := clients[client]; !exists {
+			continue
+		}
+		delete(clients, client)
+		close(client.send)
+		if len(clients) == 0 {
+			delete(h.clients, client.userID)
+		}
 	}
 }
 
 // // // BroadcastToAll sends a message to all connected clients
 func (h *Hub) BroadcastToAll(messageType string, data map[string]interface{}) {
-	h.broadcast <- &Message{
+	h.publish(&Message{
 		Type:      messageType,
 		Timestamp: time.Now(),
 		Data:      data,
-	}
+	})
 }
 
-// // // GetOnlineUsers returns a list of currently online user IDs
+// // // GetOnlineUsers returns a list of currently online user IDs, across
+// replicas when the relay is enabled
 func (h *Hub) GetOnlineUsers() []string {
+	if redis, _ := h.relayClient(); redis != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		users, err := redis.OnlineUsers(ctx, presenceTTL)
+		if err == nil {
+			return users
+		}
+		log.Printf("WebSocket presence unavailable, listing local users: %v", err)
+	}
+	return h.localUsers()
+}
+
+// localUsers returns the users connected to this replica
+func (h *Hub) localUsers() []string {
 	h.mu.RLock()
 	defer h.mu.RUnlock()
 
@@ -199,13 +332,26 @@ func (h *Hub) GetOnlineUsers() []string {
 	return users
 }
 
-// // // IsUserOnline checks if a user is currently online
+// // // IsUserOnline checks if a user is currently online on any replica
 func (h *Hub) IsUserOnline(userID string) bool {
 	h.mu.RLock()
-	defer h.mu.RUnlock()
-
 	clients, ok := h.clients[userID]
-	return ok && len(clients) > 0
+	h.mu.RUnlock()
+	if ok && len(clients) > 0 {
+		return true
+	}
+
+	redis, podID := h.relayClient()
+	if redis == nil {
+		return false
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	online, err := redis.UserOnlineElsewhere(ctx, podID, userID, presenceTTL)
+	if err != nil {
+		log.Printf("WebSocket presence unavailable: %v", err)
+	}
+	return online
 }
 
 // // // getTotalClients returns total number of connected clients
@@ -216,15 +362,3 @@ func (h *Hub) getTotalClients() int {
 	}
 	return total
 }
-
-// // // GetStats returns hub statistics
-func (h *Hub) GetStats() map[string]interface{} {
-	h.mu.RLock()
-	defer h.mu.RUnlock()
-
-	return map[string]interface{}{
-		"total_users":      len(h.clients),
-		"total_clients":    h.getTotalClients(),
-		"broadcast_buffer": len(h.broadcast),
-	}
-}
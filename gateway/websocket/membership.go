@@ -0,0 +1,110 @@
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/pkg/cache"
+)
+
+// MembershipCache answers org membership checks from the Redis revocation
+// keys maintained by the user service, memoizing answers locally for a short
+// TTL so broadcasts don't hit Redis per message.
+type MembershipCache struct {
+	redis   *cache.RedisClient
+	ttl     time.Duration
+	mu      sync.RWMutex
+	entries map[string]membershipEntry
+}
+
+type membershipEntry struct {
+	member  bool
+	expires time.Time
+}
+
+// NewMembershipCache creates a membership cache backed by Redis
+func NewMembershipCache(redis *cache.RedisClient, ttl time.Duration) *MembershipCache {
+	return &MembershipCache{
+		redis:   redis,
+		ttl:     ttl,
+		entries: make(map[string]membershipEntry),
+	}
+}
+
+func membershipCacheKey(orgID, userID string) string {
+	return orgID + ":" + userID
+}
+
+// IsOrgMember implements MembershipChecker. If Redis is unavailable the token's
+// org is trusted and the answer is not memoized.
+func (m *MembershipCache) IsOrgMember(ctx context.Context, orgID, userID string) bool {
+	key := membershipCacheKey(orgID, userID)
+
+	m.mu.RLock()
+	entry, ok := m.entries[key]
+	m.mu.RUnlock()
+	if ok && time.Now().Before(entry.expires) {
+		return entry.member
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 500*time.Millisecond)
+	defer cancel()
+	revoked, err := m.redis.IsMembershipRevoked(ctx, orgID, userID)
+	if err != nil {
+		log.Printf("membership cache lookup failed for userID=%s orgID=%s: %v", userID, orgID, err)
+		return true
+	}
+
+	m.set(key, !revoked)
+	return !revoked
+}
+
+func (m *MembershipCache) set(key string, member bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	// Opportunistically drop expired entries so the map doesn't grow unbounded
+	if len(m.entries) > 10000 {
+		for k, e := range m.entries {
+			if now.After(e.expires) {
+				delete(m.entries, k)
+			}
+		}
+	}
+	m.entries[key] = membershipEntry{member: member, expires: now.Add(m.ttl)}
+}
+
+// Listen applies membership events published by the user service until ctx
+// is cancelled, updating the cache and evicting removed members from the hub.
+func (m *MembershipCache) Listen(ctx context.Context, hub *Hub) {
+	pubsub := m.redis.Subscribe(ctx, cache.MembershipEventsChannel)
+	defer pubsub.Close()
+
+	ch := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-ch:
+			if !ok {
+				return
+			}
+			var ev cache.MembershipEvent
+			if err := json.Unmarshal([]byte(msg.Payload), &ev); err != nil {
+				log.Printf("invalid membership event: %v", err)
+				continue
+			}
+			switch ev.Type {
+			case cache.MembershipRemoved:
+				m.set(membershipCacheKey(ev.OrgID, ev.UserID), false)
+				hub.EvictOrgMember(ev.OrgID, ev.UserID)
+			case cache.MembershipAdded:
+				m.set(membershipCacheKey(ev.OrgID, ev.UserID), true)
+			}
+		}
+	}
+}
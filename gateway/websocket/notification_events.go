@@ -0,0 +1,71 @@
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/pkg/cache"
+)
+
+// ListenNotificationEvents forwards events published by the notification
+// service until ctx is cancelled: read-state changes go to the user's
+// connections so badge counts stay in step across devices, announcements go
+// to everyone. Every replica receives these events, so each delivers them
+// to its own connections only.
+func ListenNotificationEvents(ctx context.Context, redis *cache.RedisClient, hub *Hub) {
+	pubsub := redis.Subscribe(ctx, cache.ReadStateChannel, cache.AnnouncementsChannel)
+	defer pubsub.Close()
+
+	ch := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-ch:
+			if !ok {
+				return
+			}
+			switch msg.Channel {
+			case cache.ReadStateChannel:
+				var ev cache.ReadStateEvent
+				if err := json.Unmarshal([]byte(msg.Payload), &ev); err != nil {
+					log.Printf("invalid read state event: %v", err)
+					continue
+				}
+				if ev.UserID == "" {
+					continue
+				}
+				hub.deliver(&Message{
+					Type:      MessageTypeNotificationRead,
+					UserID:    ev.UserID,
+					Timestamp: time.Now(),
+					Data: map[string]interface{}{
+						"notification_ids": ev.NotificationIDs,
+						"bulk":             ev.Bulk,
+						"deleted":          ev.Deleted,
+						"unread_count":     ev.UnreadCount,
+					},
+				})
+			case cache.AnnouncementsChannel:
+				var ev cache.AnnouncementEvent
+				if err := json.Unmarshal([]byte(msg.Payload), &ev); err != nil {
+					log.Printf("invalid announcement event: %v", err)
+					continue
+				}
+				hub.deliver(&Message{
+					Type:      MessageTypeAnnouncement,
+					Timestamp: time.Now(),
+					Data: map[string]interface{}{
+						"id":         ev.ID,
+						"title":      ev.Title,
+						"body":       ev.Body,
+						"link":       ev.Link,
+						"expires_at": ev.ExpiresAt,
+					},
+				})
+			}
+		}
+	}
+}
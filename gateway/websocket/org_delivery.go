@@ -0,0 +1,97 @@
+package websocket
+
+import (
+	"context"
+	"log"
+	"sync"
+)
+
+// membershipLookups caps the membership checks run at once for a delivery
+const membershipLookups = 16
+
+// orgDelivery is an org-scoped broadcast waiting for the membership of its
+// recipients to be checked
+type orgDelivery struct {
+	orgID   string
+	clients []*Client
+	data    []byte
+	// kind names the message in logs: "org" or "room"
+	kind string
+}
+
+// orgRecipients returns the clients connected for orgID; h.mu must be
+// read-locked
+func (h *Hub) orgRecipients(orgID string) []*Client {
+	var recipients []*Client
+	for _, clients := range h.clients {
+		for client := range clients {
+			if client.orgID == orgID {
+				recipients = append(recipients, client)
+			}
+		}
+	}
+	return recipients
+}
+
+// runOrgDeliveries delivers queued org-scoped broadcasts in order, each once
+// its recipients' membership is known
+func (h *Hub) runOrgDeliveries() {
+	for d := range h.orgDeliveries {
+		h.deliverToOrg(d, h.resolveMembership(d))
+	}
+}
+
+// resolveMembership checks each recipient user of d once, a few at a time,
+// without holding h.mu
+func (h *Hub) resolveMembership(d orgDelivery) map[string]bool {
+	users := make(map[string]bool)
+	for _, client := range d.clients {
+		users[client.userID] = true
+	}
+
+	var (
+		mu  sync.Mutex
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, membershipLookups)
+	)
+	members := make(map[string]bool, len(users))
+	for userID := range users {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(userID string) {
+			defer wg.Done()
+			member := h.membership.IsOrgMember(context.Background(), d.orgID, userID)
+			<-sem
+			mu.Lock()
+			members[userID] = member
+			mu.Unlock()
+		}(userID)
+	}
+	wg.Wait()
+	return members
+}
+
+// deliverToOrg sends d to its recipients that are still connected, and
+// evicts those no longer in members. A nil members trusts every recipient.
+func (h *Hub) deliverToOrg(d orgDelivery, members map[string]bool) {
+	var revoked []*Client
+	h.mu.RLock()
+	for _, client := range d.clients {
+		// the connection may have closed while membership was checked
+		if !h.clients[client.userID][client] {
+			continue
+		}
+		if members != nil && !members[client.userID] {
+			revoked = append(revoked, client)
+			continue
+		}
+		if !h.trySend(client, d.data) {
+			log.Printf("Client send buffer full, dropping %s message: userID=%s", d.kind, client.userID)
+		}
+	}
+	h.mu.RUnlock()
+
+	if len(revoked) > 0 {
+		h.evictClients(revoked)
+	}
+}
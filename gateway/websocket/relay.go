@@ -0,0 +1,143 @@
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/pkg/cache"
+)
+
+// presenceTTL is how long a replica counts as live after its last heartbeat
+const presenceTTL = 45 * time.Second
+
+// EnableRelay shares the hub with the other gateway replicas through Redis
+// until ctx is cancelled: broadcasts reach connections on every replica,
+// and online presence covers users connected to any of them. podID must be
+// unique to this replica.
+func (h *Hub) EnableRelay(ctx context.Context, redis *cache.RedisClient, podID string) {
+	h.relayMu.Lock()
+	h.relay = redis
+	h.podID = podID
+	h.relayMu.Unlock()
+
+	go h.listenRelay(ctx, redis)
+	go h.heartbeat(ctx, redis, podID)
+}
+
+// publish sends a message to the matching connections on every replica,
+// or only this one without a relay
+func (h *Hub) publish(message *Message) {
+	redis, _ := h.relayClient()
+	if redis == nil {
+		h.deliver(message)
+		return
+	}
+	payload, err := json.Marshal(message)
+	if err == nil {
+		err = redis.Publish(context.Background(), cache.WebSocketChannel, payload)
+	}
+	if err != nil {
+		log.Printf("WebSocket relay unavailable, delivering locally: %v", err)
+		h.deliver(message)
+	}
+}
+
+// deliver sends a message to the matching connections on this replica.
+// Events every replica receives anyway, such as those the notification
+// service publishes to Redis, are delivered this way.
+func (h *Hub) deliver(message *Message) {
+	h.broadcast <- message
+}
+
+func (h *Hub) relayClient() (*cache.RedisClient, string) {
+	h.relayMu.RLock()
+	defer h.relayMu.RUnlock()
+	return h.relay, h.podID
+}
+
+func (h *Hub) listenRelay(ctx context.Context, redis *cache.RedisClient) {
+	pubsub := redis.Subscribe(ctx, cache.WebSocketChannel)
+	defer pubsub.Close()
+
+	ch := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-ch:
+			if !ok {
+				return
+			}
+			var message Message
+			if err := json.Unmarshal([]byte(msg.Payload), &message); err != nil {
+				log.Printf("invalid relayed WebSocket message: %v", err)
+				continue
+			}
+			h.deliver(&message)
+		}
+	}
+}
+
+// heartbeat keeps this replica's users in presence, repairing any drift
+// from failed updates, and withdraws them when ctx is cancelled
+func (h *Hub) heartbeat(ctx context.Context, redis *cache.RedisClient, podID string) {
+	ticker := time.NewTicker(presenceTTL / 3)
+	defer ticker.Stop()
+	for {
+		if err := redis.PresenceHeartbeat(ctx, podID, h.localUsers(), presenceTTL); err != nil && ctx.Err() == nil {
+			log.Printf("failed to refresh WebSocket presence: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			leaveCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			if err := redis.PresenceLeave(leaveCtx, podID); err != nil {
+				log.Printf("failed to withdraw WebSocket presence: %v", err)
+			}
+			cancel()
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// presenceChanged records a user's first connection to, or last
+// disconnection from, this replica, and broadcasts the change only if the
+// user came online or went offline across all replicas
+func (h *Hub) presenceChanged(userID string, online bool) {
+	messageType := MessageTypeUserOffline
+	if online {
+		messageType = MessageTypeUserOnline
+	}
+
+	if redis, podID := h.relayClient(); redis != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		elsewhere, err := redis.UserOnlineElsewhere(ctx, podID, userID, presenceTTL)
+		if err != nil {
+			log.Printf("failed to check WebSocket presence of %s: %v", userID, err)
+		}
+		if online {
+			err = redis.PresenceAdd(ctx, podID, userID)
+		} else {
+			err = redis.PresenceRemove(ctx, podID, userID)
+		}
+		if err != nil {
+			log.Printf("failed to update WebSocket presence of %s: %v", userID, err)
+		}
+		if elsewhere {
+			// the user's status did not change for anyone watching
+			return
+		}
+	}
+
+	h.publish(&Message{
+		Type:      messageType,
+		UserID:    userID,
+		Timestamp: time.Now(),
+		Data: map[string]interface{}{
+			"user_id": userID,
+		},
+	})
+}
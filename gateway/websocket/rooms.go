@@ -0,0 +1,178 @@
+package websocket
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
+)
+
+// Room message types. Clients send subscribe and unsubscribe with a room
+// like "task:<id>"; the hub answers with subscribed, unsubscribed or error.
+const (
+	MessageTypeSubscribe    = "subscribe"
+	MessageTypeUnsubscribe  = "unsubscribe"
+	MessageTypeSubscribed   = "subscribed"
+	MessageTypeUnsubscribed = "unsubscribed"
+	MessageTypeError        = "error"
+)
+
+// Room kinds; a room is "<kind>:<id>". A board's tasks are those of its
+// project, or of its team for team boards.
+const (
+	RoomOrg     = "org"
+	RoomTeam    = "team"
+	RoomProject = "project"
+	RoomTask    = "task"
+)
+
+// maxRoomsPerClient bounds how much a single connection can subscribe to
+const maxRoomsPerClient = 50
+
+var (
+	errInvalidRoom  = errors.New("invalid room")
+	errRoomDenied   = errors.New("not allowed to join this room")
+	errTooManyRooms = errors.New("too many rooms")
+)
+
+// Room names the room for kind and id, such as Room(RoomTask, taskID)
+func Room(kind, id string) string {
+	return kind + ":" + id
+}
+
+func parseRoom(room string) (kind, id string, ok bool) {
+	kind, id, found := strings.Cut(room, ":")
+	if !found || id == "" || len(id) > 64 || strings.ContainsAny(id, ": ") {
+		return "", "", false
+	}
+	switch kind {
+	case RoomOrg, RoomTeam, RoomProject, RoomTask:
+		return kind, id, true
+	}
+	return "", "", false
+}
+
+// subscribe adds client to room. Rooms belong to the client's org: org
+// rooms are only its own, and the other rooms only receive events of tasks
+// in that org. Guests, who may only see some of the org's tasks, can only
+// join task rooms.
+func (h *Hub) subscribe(client *Client, room string) error {
+	if err := roomAllowed(client, room); err != nil {
+		return err
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if client.rooms[room] {
+		return nil
+	}
+	if len(client.rooms) >= maxRoomsPerClient {
+		return errTooManyRooms
+	}
+	if client.rooms == nil {
+		client.rooms = make(map[string]bool)
+	}
+	client.rooms[room] = true
+	if h.rooms[room] == nil {
+		h.rooms[room] = make(map[*Client]bool)
+	}
+	h.rooms[room][client] = true
+	return nil
+}
+
+func roomAllowed(client *Client, room string) error {
+	kind, id, ok := parseRoom(room)
+	if !ok {
+		return errInvalidRoom
+	}
+	if client.orgID == "" || (kind == RoomOrg && id != client.orgID) {
+		return errRoomDenied
+	}
+	if authz.IsGuest(client.role) && kind != RoomTask {
+		return errRoomDenied
+	}
+	return nil
+}
+
+func (h *Hub) unsubscribe(client *Client, room string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.leaveRoom(client, room)
+}
+
+// leaveRooms removes client from all its rooms; h.mu must be held
+func (h *Hub) leaveRooms(client *Client) {
+	for room := range client.rooms {
+		h.leaveRoom(client, room)
+	}
+}
+
+// leaveRoom must be called with h.mu held
+func (h *Hub) leaveRoom(client *Client, room string) {
+	delete(client.rooms, room)
+	if members, ok := h.rooms[room]; ok {
+		delete(members, client)
+		if len(members) == 0 {
+			delete(h.rooms, room)
+		}
+	}
+}
+
+// BroadcastToRooms sends a message about orgID's data to everyone in any of
+// rooms, once per connection, on every replica when the relay is enabled
+func (h *Hub) BroadcastToRooms(orgID string, rooms []string, messageType string, data map[string]interface{}) {
+	h.publish(&Message{
+		Type:      messageType,
+		OrgID:     orgID,
+		Rooms:     rooms,
+		Timestamp: time.Now(),
+		Data:      data,
+	})
+}
+
+// roomRecipients returns the members of a room message's rooms that belong
+// to its org, once each; h.mu must be read-locked
+func (h *Hub) roomRecipients(message *Message) []*Client {
+	seen := make(map[*Client]bool)
+	var recipients []*Client
+	for _, room := range message.Rooms {
+		for client := range h.rooms[room] {
+			if seen[client] || client.orgID != message.OrgID {
+				continue
+			}
+			seen[client] = true
+			recipients = append(recipients, client)
+		}
+	}
+	return recipients
+}
+
+// reply sends a message to one connection only, unless the hub has
+// already closed it
+func (c *Client) reply(message *Message) {
+	message.Timestamp = time.Now()
+	data, err := json.Marshal(message)
+	if err != nil {
+		return
+	}
+	c.hub.mu.RLock()
+	defer c.hub.mu.RUnlock()
+	if !c.hub.clients[c.userID][c] {
+		return
+	}
+	c.hub.trySend(c, data)
+}
+
+// replyError reports a rejected client message
+func (c *Client) replyError(request *Message, reason string) {
+	c.reply(&Message{
+		Type: MessageTypeError,
+		ID:   request.ID,
+		Data: map[string]interface{}{
+			"request": request.Type,
+			"error":   reason,
+		},
+	})
+}
@@ -0,0 +1,80 @@
+package websocket
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// hubCounters count messages since the hub started
+type hubCounters struct {
+	started    time.Time
+	broadcasts atomic.Int64
+	sent       atomic.Int64
+	dropped    atomic.Int64
+	received   atomic.Int64
+}
+
+// Stats describes the connections on this replica and its message traffic
+type Stats struct {
+	TotalUsers   int `json:"total_users"`
+	TotalClients int `json:"total_clients"`
+	// OrgClients counts connections per org; connections without an org
+	// are left out
+	OrgClients      map[string]int `json:"org_clients"`
+	BroadcastBuffer int            `json:"broadcast_buffer"`
+	UptimeSeconds   int64          `json:"uptime_seconds"`
+	// Broadcasts counts messages handed to the hub, MessagesSent the copies
+	// queued for connections and MessagesDropped those lost to full send
+	// buffers
+	Broadcasts       int64 `json:"broadcasts"`
+	MessagesSent     int64 `json:"messages_sent"`
+	MessagesDropped  int64 `json:"messages_dropped"`
+	MessagesReceived int64 `json:"messages_received"`
+	// SentPerSecond and ReceivedPerSecond average over the uptime
+	SentPerSecond     float64 `json:"sent_per_second"`
+	ReceivedPerSecond float64 `json:"received_per_second"`
+}
+
+// trySend queues data for client without blocking, counting it as sent or
+// dropped
+func (h *Hub) trySend(client *Client, data []byte) bool {
+	select {
+	case client.send <- data:
+		h.counters.sent.Add(1)
+		return true
+	default:
+		h.counters.dropped.Add(1)
+		return false
+	}
+}
+
+// GetStats returns hub statistics
+func (h *Hub) GetStats() Stats {
+	h.mu.RLock()
+	stats := Stats{
+		TotalUsers:      len(h.clients),
+		TotalClients:    h.getTotalClients(),
+		OrgClients:      make(map[string]int),
+		BroadcastBuffer: len(h.broadcast),
+	}
+	for _, clients := range h.clients {
+		for client := range clients {
+			if client.orgID != "" {
+				stats.OrgClients[client.orgID]++
+			}
+		}
+	}
+	h.mu.RUnlock()
+
+	uptime := time.Since(h.counters.started)
+	stats.UptimeSeconds = int64(uptime.Seconds())
+	stats.Broadcasts = h.counters.broadcasts.Load()
+	stats.MessagesSent = h.counters.sent.Load()
+	stats.MessagesDropped = h.counters.dropped.Load()
+	stats.MessagesReceived = h.counters.received.Load()
+	if secs := uptime.Seconds(); secs > 0 {
+		stats.SentPerSecond = float64(stats.MessagesSent) / secs
+		stats.ReceivedPerSecond = float64(stats.MessagesReceived) / secs
+	}
+	return stats
+}
@@ -0,0 +1,62 @@
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/pkg/cache"
+)
+
+// ListenTaskEvents pushes task changes published by the task service to the
+// rooms watching the task, its team, its project and its org until ctx is cancelled.
+// Like notification events, every replica receives them and delivers to its
+// own connections only.
+func ListenTaskEvents(ctx context.Context, redis *cache.RedisClient, hub *Hub) {
+	pubsub := redis.Subscribe(ctx, cache.TaskEventsChannel)
+	defer pubsub.Close()
+
+	ch := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-ch:
+			if !ok {
+				return
+			}
+			var ev cache.TaskEvent
+			if err := json.Unmarshal([]byte(msg.Payload), &ev); err != nil {
+				log.Printf("invalid task event: %v", err)
+				continue
+			}
+			if ev.OrgID == "" || ev.TaskID == "" {
+				continue
+			}
+			rooms := []string{Room(RoomTask, ev.TaskID), Room(RoomOrg, ev.OrgID)}
+			if ev.TeamID != "" {
+				rooms = append(rooms, Room(RoomTeam, ev.TeamID))
+			}
+			if ev.ProjectID != "" {
+				rooms = append(rooms, Room(RoomProject, ev.ProjectID))
+			}
+			var task map[string]interface{}
+			if len(ev.Task) > 0 {
+				if err := json.Unmarshal(ev.Task, &task); err != nil {
+					log.Printf("invalid task in %s event: %v", ev.Type, err)
+				}
+			}
+			hub.deliver(&Message{
+				Type:      ev.Type,
+				OrgID:     ev.OrgID,
+				Rooms:     rooms,
+				Timestamp: time.Now(),
+				Data: map[string]interface{}{
+					"task_id": ev.TaskID,
+					"task":    task,
+				},
+			})
+		}
+	}
+}
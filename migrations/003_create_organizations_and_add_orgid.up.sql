@@ -9,6 +9,11 @@ CREATE TABLE IF NOT EXISTS organizations (
   created_at timestamptz DEFAULT now(),
   updated_at timestamptz DEFAULT now()
 );
+
+-- the base schema creates organizations without these
+ALTER TABLE organizations
+  ADD COLUMN IF NOT EXISTS domain text,
+  ADD COLUMN IF NOT EXISTS settings jsonb DEFAULT '{}'::jsonb;
 CREATE UNIQUE INDEX IF NOT EXISTS idx_organizations_domain ON organizations(domain);
 
 -- Add org_id to users
@@ -20,10 +20,10 @@ CREATE TABLE IF NOT EXISTS teams (
     CONSTRAINT unique_team_name_per_org UNIQUE(org_id, name)
 );
 
-CREATE INDEX idx_teams_org_id ON teams(org_id);
-CREATE INDEX idx_teams_team_lead ON teams(team_lead_id);
-CREATE INDEX idx_teams_status ON teams(status);
-CREATE INDEX idx_teams_parent ON teams(parent_team_id);
+CREATE INDEX IF NOT EXISTS idx_teams_org_id ON teams(org_id);
+CREATE INDEX IF NOT EXISTS idx_teams_team_lead ON teams(team_lead_id);
+CREATE INDEX IF NOT EXISTS idx_teams_status ON teams(status);
+CREATE INDEX IF NOT EXISTS idx_teams_parent ON teams(parent_team_id);
 
 -- ============================================================================
 -- TEAM MEMBERS: Users assigned to teams with roles
@@ -40,9 +40,9 @@ CREATE TABLE IF NOT EXISTS team_members (
     CONSTRAINT unique_team_member UNIQUE(team_id, user_id)
 );
 
-CREATE INDEX idx_team_members_team ON team_members(team_id);
-CREATE INDEX idx_team_members_user ON team_members(user_id);
-CREATE INDEX idx_team_members_active ON team_members(is_active);
+CREATE INDEX IF NOT EXISTS idx_team_members_team ON team_members(team_id);
+CREATE INDEX IF NOT EXISTS idx_team_members_user ON team_members(user_id);
+CREATE INDEX IF NOT EXISTS idx_team_members_active ON team_members(is_active);
 
 -- ============================================================================
 -- PROJECTS: Work initiatives with managers and timeline
@@ -67,11 +67,11 @@ CREATE TABLE IF NOT EXISTS projects (
     CONSTRAINT unique_project_name_per_org UNIQUE(org_id, name)
 );
 
-CREATE INDEX idx_projects_org_id ON projects(org_id);
-CREATE INDEX idx_projects_manager ON projects(project_manager_id);
-CREATE INDEX idx_projects_status ON projects(status);
-CREATE INDEX idx_projects_priority ON projects(priority);
-CREATE INDEX idx_projects_dates ON projects(start_date, end_date);
+CREATE INDEX IF NOT EXISTS idx_projects_org_id ON projects(org_id);
+CREATE INDEX IF NOT EXISTS idx_projects_manager ON projects(project_manager_id);
+CREATE INDEX IF NOT EXISTS idx_projects_status ON projects(status);
+CREATE INDEX IF NOT EXISTS idx_projects_priority ON projects(priority);
+CREATE INDEX IF NOT EXISTS idx_projects_dates ON projects(start_date, end_date);
 
 -- ============================================================================
 -- PROJECT TEAMS: Teams assigned to projects
@@ -86,8 +86,8 @@ CREATE TABLE IF NOT EXISTS project_teams (
     CONSTRAINT unique_project_team UNIQUE(project_id, team_id)
 );
 
-CREATE INDEX idx_project_teams_project ON project_teams(project_id);
-CREATE INDEX idx_project_teams_team ON project_teams(team_id);
+CREATE INDEX IF NOT EXISTS idx_project_teams_project ON project_teams(project_id);
+CREATE INDEX IF NOT EXISTS idx_project_teams_team ON project_teams(team_id);
 
 -- ============================================================================
 -- PROJECT MEMBERS: Individual users assigned to projects
@@ -105,9 +105,9 @@ CREATE TABLE IF NOT EXISTS project_members (
     CONSTRAINT unique_project_member UNIQUE(project_id, user_id)
 );
 
-CREATE INDEX idx_project_members_project ON project_members(project_id);
-CREATE INDEX idx_project_members_user ON project_members(user_id);
-CREATE INDEX idx_project_members_active ON project_members(is_active);
+CREATE INDEX IF NOT EXISTS idx_project_members_project ON project_members(project_id);
+CREATE INDEX IF NOT EXISTS idx_project_members_user ON project_members(user_id);
+CREATE INDEX IF NOT EXISTS idx_project_members_active ON project_members(is_active);
 
 -- ============================================================================
 -- GROUPS: Cross-functional groups spanning teams
@@ -128,10 +128,10 @@ CREATE TABLE IF NOT EXISTS groups (
     CONSTRAINT unique_group_name_per_org UNIQUE(org_id, name)
 );
 
-CREATE INDEX idx_groups_org_id ON groups(org_id);
-CREATE INDEX idx_groups_owner ON groups(owner_id);
-CREATE INDEX idx_groups_type ON groups(group_type);
-CREATE INDEX idx_groups_status ON groups(status);
+CREATE INDEX IF NOT EXISTS idx_groups_org_id ON groups(org_id);
+CREATE INDEX IF NOT EXISTS idx_groups_owner ON groups(owner_id);
+CREATE INDEX IF NOT EXISTS idx_groups_type ON groups(group_type);
+CREATE INDEX IF NOT EXISTS idx_groups_status ON groups(status);
 
 -- ============================================================================
 -- GROUP MEMBERS: Users in groups
@@ -147,8 +147,8 @@ CREATE TABLE IF NOT EXISTS group_members (
     CONSTRAINT unique_group_member UNIQUE(group_id, user_id)
 );
 
-CREATE INDEX idx_group_members_group ON group_members(group_id);
-CREATE INDEX idx_group_members_user ON group_members(user_id);
+CREATE INDEX IF NOT EXISTS idx_group_members_group ON group_members(group_id);
+CREATE INDEX IF NOT EXISTS idx_group_members_user ON group_members(user_id);
 
 -- ============================================================================
 -- WORKSPACES: Collaborative spaces for teams/projects
@@ -170,10 +170,10 @@ CREATE TABLE IF NOT EXISTS workspaces (
     CONSTRAINT unique_workspace_name_per_org UNIQUE(org_id, name)
 );
 
-CREATE INDEX idx_workspaces_org_id ON workspaces(org_id);
-CREATE INDEX idx_workspaces_team ON workspaces(team_id);
-CREATE INDEX idx_workspaces_project ON workspaces(project_id);
-CREATE INDEX idx_workspaces_type ON workspaces(workspace_type);
+CREATE INDEX IF NOT EXISTS idx_workspaces_org_id ON workspaces(org_id);
+CREATE INDEX IF NOT EXISTS idx_workspaces_team ON workspaces(team_id);
+CREATE INDEX IF NOT EXISTS idx_workspaces_project ON workspaces(project_id);
+CREATE INDEX IF NOT EXISTS idx_workspaces_type ON workspaces(workspace_type);
 
 -- ============================================================================
 -- Add team_id and project_id to existing tasks table
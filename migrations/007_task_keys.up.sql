@@ -0,0 +1,31 @@
+-- Human-friendly task keys (e.g. WEB-42), sequenced per org or per creator for personal tasks
+CREATE TABLE IF NOT EXISTS task_key_sequences (
+    scope_id TEXT NOT NULL,
+    prefix TEXT NOT NULL,
+    last_value BIGINT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT now(),
+    PRIMARY KEY (scope_id, prefix)
+);
+
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS task_key TEXT NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS idx_tasks_task_key ON tasks(task_key);
+
+-- Backfill keys for existing tasks in creation order
+WITH numbered AS (
+    SELECT id,
+           COALESCE(org_id::text, created_by::text) AS scope_id,
+           ROW_NUMBER() OVER (PARTITION BY COALESCE(org_id::text, created_by::text) ORDER BY created_at, id) AS n
+    FROM tasks
+    WHERE task_key = ''
+)
+UPDATE tasks t
+SET task_key = 'TASK-' || numbered.n
+FROM numbered
+WHERE t.id = numbered.id;
+
+INSERT INTO task_key_sequences (scope_id, prefix, last_value)
+SELECT COALESCE(org_id::text, created_by::text), 'TASK', COUNT(*)
+FROM tasks
+WHERE task_key LIKE 'TASK-%'
+GROUP BY COALESCE(org_id::text, created_by::text)
+ON CONFLICT (scope_id, prefix) DO UPDATE SET last_value = GREATEST(task_key_sequences.last_value, EXCLUDED.last_value);
@@ -0,0 +1,10 @@
+-- Derived overdue flag maintained by the task service's overdue job
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS is_overdue BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS overdue_notified_at TIMESTAMP WITH TIME ZONE;
+CREATE INDEX IF NOT EXISTS idx_tasks_is_overdue ON tasks(is_overdue);
+
+UPDATE tasks
+SET is_overdue = true
+WHERE due_date IS NOT NULL
+  AND due_date < now()
+  AND status NOT IN ('completed', 'cancelled');
@@ -0,0 +1,26 @@
+-- Project/team boards with status columns and optional WIP limits
+CREATE TABLE IF NOT EXISTS task_boards (
+    id UUID PRIMARY KEY,
+    org_id UUID NOT NULL,
+    project_id UUID,
+    team_id UUID,
+    name TEXT NOT NULL,
+    created_by UUID NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT now(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS idx_task_boards_org_id ON task_boards(org_id);
+CREATE INDEX IF NOT EXISTS idx_task_boards_project_id ON task_boards(project_id);
+CREATE INDEX IF NOT EXISTS idx_task_boards_team_id ON task_boards(team_id);
+
+CREATE TABLE IF NOT EXISTS task_board_columns (
+    id UUID PRIMARY KEY,
+    board_id UUID NOT NULL REFERENCES task_boards(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    status TEXT NOT NULL,
+    position INTEGER NOT NULL DEFAULT 0,
+    wip_limit INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT now(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS idx_task_board_columns_board_id ON task_board_columns(board_id);
@@ -0,0 +1,16 @@
+-- Personal task reminders, absolute or relative to the task's due date
+CREATE TABLE IF NOT EXISTS task_reminders (
+    id UUID PRIMARY KEY,
+    task_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    remind_at TIMESTAMP WITH TIME ZONE,
+    before_due_minutes INTEGER NOT NULL DEFAULT 0,
+    fire_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    sent_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT now(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS idx_task_reminders_task_id ON task_reminders(task_id);
+CREATE INDEX IF NOT EXISTS idx_task_reminders_user_id ON task_reminders(user_id);
+CREATE INDEX IF NOT EXISTS idx_task_reminders_fire_at ON task_reminders(fire_at);
+CREATE INDEX IF NOT EXISTS idx_task_reminders_sent_at ON task_reminders(sent_at);
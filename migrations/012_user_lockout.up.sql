@@ -0,0 +1,13 @@
+-- Migration: Temporary account lockout with exponential backoff
+-- Description: Replaces the permanent lock at 5 failed attempts with a timed
+-- lock; accounts locked under the old rule get a first 15 minute lock
+
+ALTER TABLE users
+ADD COLUMN IF NOT EXISTS locked_until TIMESTAMP,
+ADD COLUMN IF NOT EXISTS lockout_count INTEGER DEFAULT 0;
+
+UPDATE users
+SET locked_until = NOW() + INTERVAL '15 minutes',
+    lockout_count = 1,
+    failed_login_attempts = 0
+WHERE failed_login_attempts >= 5 AND locked_until IS NULL;
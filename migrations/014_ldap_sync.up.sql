@@ -0,0 +1,32 @@
+-- LDAP / Active Directory sync
+ALTER TABLE users
+ADD COLUMN IF NOT EXISTS is_active BOOLEAN NOT NULL DEFAULT true,
+ADD COLUMN IF NOT EXISTS deactivated_at TIMESTAMP,
+ADD COLUMN IF NOT EXISTS external_source TEXT,
+ADD COLUMN IF NOT EXISTS external_id TEXT;
+
+CREATE INDEX IF NOT EXISTS idx_users_is_active ON users(is_active);
+CREATE INDEX IF NOT EXISTS idx_users_external_source ON users(external_source);
+CREATE INDEX IF NOT EXISTS idx_users_external_id ON users(external_id);
+
+CREATE TABLE IF NOT EXISTS ldap_configs (
+    id UUID PRIMARY KEY,
+    org_id UUID NOT NULL UNIQUE,
+    enabled BOOLEAN NOT NULL DEFAULT false,
+    url TEXT NOT NULL,
+    start_tls BOOLEAN NOT NULL DEFAULT false,
+    bind_dn TEXT,
+    bind_password TEXT,
+    base_dn TEXT NOT NULL,
+    user_filter TEXT,
+    email_attribute TEXT,
+    username_attribute TEXT,
+    name_attribute TEXT,
+    group_teams TEXT,
+    sync_interval_minutes INTEGER NOT NULL DEFAULT 60,
+    last_sync_at TIMESTAMP,
+    last_sync_error TEXT,
+    last_sync_stats TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
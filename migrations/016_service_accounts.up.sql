@@ -0,0 +1,4 @@
+-- Non-human org members that authenticate only with API keys
+ALTER TABLE users ADD COLUMN IF NOT EXISTS is_service_account BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX IF NOT EXISTS idx_users_is_service_account ON users(is_service_account);
@@ -0,0 +1,21 @@
+-- Cross-service user erasure workflow (GDPR)
+CREATE TABLE IF NOT EXISTS data_erasure_requests (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    org_id UUID,
+    requested_by UUID,
+    mode TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    claimed_at TIMESTAMP,
+    tasks_erased_at TIMESTAMP,
+    notifications_erased_at TIMESTAMP,
+    completed_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_data_erasure_requests_user_id ON data_erasure_requests(user_id);
+CREATE INDEX IF NOT EXISTS idx_data_erasure_requests_status ON data_erasure_requests(status, next_attempt_at);
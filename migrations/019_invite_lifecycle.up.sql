@@ -0,0 +1,6 @@
+-- Invite revocation and resend tracking
+ALTER TABLE invites ADD COLUMN IF NOT EXISTS revoked_at TIMESTAMP;
+ALTER TABLE invites ADD COLUMN IF NOT EXISTS revoked_by TEXT;
+ALTER TABLE invites ADD COLUMN IF NOT EXISTS send_count INTEGER NOT NULL DEFAULT 1;
+
+CREATE INDEX IF NOT EXISTS idx_invites_expires_at ON invites(expires_at);
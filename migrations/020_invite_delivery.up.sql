@@ -0,0 +1,5 @@
+-- Invite email delivery state
+ALTER TABLE invites ADD COLUMN IF NOT EXISTS delivery_status TEXT;
+ALTER TABLE invites ADD COLUMN IF NOT EXISTS delivery_attempts INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE invites ADD COLUMN IF NOT EXISTS delivery_error TEXT;
+ALTER TABLE invites ADD COLUMN IF NOT EXISTS delivered_at TIMESTAMP;
@@ -0,0 +1,16 @@
+-- Login history, successful and failed attempts
+CREATE TABLE IF NOT EXISTS login_events (
+    id UUID PRIMARY KEY,
+    user_id UUID,
+    org_id UUID,
+    email TEXT,
+    success BOOLEAN NOT NULL,
+    failure_reason TEXT,
+    ip_address TEXT,
+    user_agent TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_login_events_user_created ON login_events(user_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_login_events_org_id ON login_events(org_id);
+CREATE INDEX IF NOT EXISTS idx_login_events_email ON login_events(email);
@@ -0,0 +1,27 @@
+-- Super admin grants and revocations go through an approval workflow
+CREATE TABLE IF NOT EXISTS super_admin_changes (
+    id UUID PRIMARY KEY,
+    action TEXT NOT NULL,
+    user_id UUID NOT NULL,
+    user_email TEXT,
+    previous_role TEXT,
+    requested_by UUID NOT NULL,
+    reason TEXT,
+    status TEXT NOT NULL DEFAULT 'pending',
+    approved_by TEXT,
+    required_approvals INTEGER NOT NULL DEFAULT 1,
+    decided_by TEXT,
+    decision_reason TEXT,
+    expires_at TIMESTAMP NOT NULL,
+    decided_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_super_admin_changes_user_id ON super_admin_changes(user_id);
+CREATE INDEX IF NOT EXISTS idx_super_admin_changes_status ON super_admin_changes(status);
+
+-- The seeded platform admin used to be an org-less "admin" recognised by its
+-- email; make it an explicit super admin
+UPDATE users SET role = 'super_admin'
+WHERE LOWER(email) = 'admin@taskflow.com' AND org_id IS NULL AND role = 'admin';
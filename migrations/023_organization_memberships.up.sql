@@ -0,0 +1,14 @@
+-- Users may belong to organizations beyond their home one (users.org_id),
+-- holding a separate role in each
+CREATE TABLE IF NOT EXISTS organization_memberships (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    org_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    role TEXT NOT NULL DEFAULT 'member',
+    invited_by TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_org_memberships_user_org ON organization_memberships(user_id, org_id);
+CREATE INDEX IF NOT EXISTS idx_organization_memberships_org_id ON organization_memberships(org_id);
@@ -0,0 +1,3 @@
+-- Values for organization-defined profile fields; the field definitions live
+-- in organizations.settings under "profile_fields"
+ALTER TABLE users ADD COLUMN IF NOT EXISTS profile_attributes JSONB;
@@ -0,0 +1,5 @@
+-- Device fingerprints on login history, for new-device sign-in alerts
+ALTER TABLE login_events ADD COLUMN IF NOT EXISTS device_id TEXT;
+ALTER TABLE login_events ADD COLUMN IF NOT EXISTS new_device BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX IF NOT EXISTS idx_login_events_user_device ON login_events(user_id, device_id);
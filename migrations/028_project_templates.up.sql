@@ -0,0 +1,17 @@
+-- Saved project layouts (teams, workspace, default tasks and milestones)
+-- that new projects are created from
+CREATE TABLE IF NOT EXISTS project_templates (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    org_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    source_project_id UUID REFERENCES projects(id) ON DELETE SET NULL,
+    definition JSONB NOT NULL DEFAULT '{}',
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT unique_project_template_name_per_org UNIQUE(org_id, name)
+);
+
+CREATE INDEX IF NOT EXISTS idx_project_templates_org_id ON project_templates(org_id);
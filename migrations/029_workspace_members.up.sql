@@ -0,0 +1,22 @@
+-- Users belonging to a workspace. Private workspaces are only visible to
+-- their members.
+CREATE TABLE IF NOT EXISTS workspace_members (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    workspace_id UUID NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role VARCHAR(100) DEFAULT 'member', -- owner, admin, member, viewer
+    joined_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    left_at TIMESTAMP WITH TIME ZONE,
+    is_active BOOLEAN DEFAULT true,
+    added_by UUID REFERENCES users(id) ON DELETE SET NULL,
+
+    CONSTRAINT unique_workspace_member UNIQUE(workspace_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_workspace_members_workspace ON workspace_members(workspace_id);
+CREATE INDEX IF NOT EXISTS idx_workspace_members_user ON workspace_members(user_id);
+
+-- Existing owners become the first member of their workspace
+INSERT INTO workspace_members (workspace_id, user_id, role)
+SELECT id, owner_id, 'owner' FROM workspaces WHERE owner_id IS NOT NULL
+ON CONFLICT (workspace_id, user_id) DO NOTHING;
@@ -0,0 +1,32 @@
+-- Roles an organization defines from the built-in permission set
+CREATE TABLE IF NOT EXISTS custom_roles (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    org_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    description TEXT,
+    permissions TEXT[] NOT NULL DEFAULT '{}',
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT unique_custom_role_name_per_org UNIQUE(org_id, name)
+);
+
+CREATE INDEX IF NOT EXISTS idx_custom_roles_org_id ON custom_roles(org_id);
+
+-- Custom roles held by users across the organization or on one team or
+-- project. scope_id is NULL for org-wide assignments.
+CREATE TABLE IF NOT EXISTS custom_role_assignments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    role_id UUID NOT NULL REFERENCES custom_roles(id) ON DELETE CASCADE,
+    org_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    scope_type VARCHAR(20) NOT NULL DEFAULT 'org', -- org, team, project
+    scope_id UUID,
+    assigned_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_custom_role_assignments_unique
+    ON custom_role_assignments(role_id, user_id, scope_type, COALESCE(scope_id, '00000000-0000-0000-0000-000000000000'));
+CREATE INDEX IF NOT EXISTS idx_custom_role_assignments_user ON custom_role_assignments(org_id, user_id);
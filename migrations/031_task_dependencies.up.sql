@@ -0,0 +1,9 @@
+-- Finish-to-start dependencies between tasks: task_id waits on depends_on_id
+CREATE TABLE IF NOT EXISTS task_dependencies (
+    task_id UUID NOT NULL,
+    depends_on_id UUID NOT NULL,
+    created_by UUID NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT now(),
+    PRIMARY KEY (task_id, depends_on_id)
+);
+CREATE INDEX IF NOT EXISTS idx_task_dependencies_depends_on_id ON task_dependencies(depends_on_id);
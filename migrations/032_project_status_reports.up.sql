@@ -0,0 +1,27 @@
+-- Weekly status snapshots project managers submit (RAG status, summary,
+-- risks); week_start is the Monday of the reported week
+CREATE TABLE IF NOT EXISTS project_status_reports (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    org_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    week_start DATE NOT NULL,
+    rag_status VARCHAR(10) NOT NULL CHECK (rag_status IN ('green', 'amber', 'red')),
+    summary TEXT NOT NULL DEFAULT '',
+    risks TEXT[] NOT NULL DEFAULT '{}',
+    submitted_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT unique_project_status_report_week UNIQUE(project_id, week_start)
+);
+
+CREATE INDEX IF NOT EXISTS idx_project_status_reports_org_id ON project_status_reports(org_id);
+
+-- Weeks a project's manager has already been reminded to report
+CREATE TABLE IF NOT EXISTS project_status_reminders (
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    week_start DATE NOT NULL,
+    sent_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (project_id, week_start)
+);
@@ -0,0 +1,7 @@
+-- Archived projects are hidden from default lists and take no new tasks;
+-- unarchiving clears archived_at and leaves status untouched
+ALTER TABLE projects
+  ADD COLUMN IF NOT EXISTS archived_at TIMESTAMP WITH TIME ZONE,
+  ADD COLUMN IF NOT EXISTS archived_by UUID REFERENCES users(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_projects_archived_at ON projects(archived_at);
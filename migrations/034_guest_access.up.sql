@@ -0,0 +1,23 @@
+-- Guest invites name the single project or workspace the guest may see and
+-- for how many days after accepting
+ALTER TABLE invites ADD COLUMN IF NOT EXISTS scope_type VARCHAR(20);
+ALTER TABLE invites ADD COLUMN IF NOT EXISTS scope_id UUID;
+ALTER TABLE invites ADD COLUMN IF NOT EXISTS access_days INTEGER NOT NULL DEFAULT 0;
+
+-- What each guest may reach within an organization. The org and task
+-- services deny guests everything outside an unexpired row.
+CREATE TABLE IF NOT EXISTS guest_access (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    org_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    scope_type VARCHAR(20) NOT NULL, -- project, workspace
+    scope_id UUID NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    invite_id UUID,
+    granted_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT unique_guest_access UNIQUE(user_id, scope_type, scope_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_guest_access_user_org ON guest_access(user_id, org_id);
@@ -0,0 +1,15 @@
+-- API requests per organization and day, as counted by the gateway
+CREATE TABLE IF NOT EXISTS org_usage_daily (
+    org_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    requests BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (org_id, day)
+);
+
+-- Users who made at least one API request in an organization on a day
+CREATE TABLE IF NOT EXISTS org_active_users_daily (
+    org_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    PRIMARY KEY (org_id, day, user_id)
+);
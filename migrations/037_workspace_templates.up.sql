@@ -0,0 +1,16 @@
+-- Saved workspace layouts (type, settings, boards and groups) new
+-- workspaces are created from
+CREATE TABLE IF NOT EXISTS workspace_templates (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    org_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    definition JSONB NOT NULL DEFAULT '{}',
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT unique_workspace_template_name_per_org UNIQUE(org_id, name)
+);
+
+CREATE INDEX IF NOT EXISTS idx_workspace_templates_org_id ON workspace_templates(org_id);
@@ -0,0 +1,23 @@
+-- Issued refresh tokens, one row per token of a sign-in's rotation family,
+-- so tokens can be revoked one session at a time and their use audited.
+-- id is the token's jti; only token hashes are stored.
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    org_id UUID,
+    family_id UUID NOT NULL,
+    token_hash TEXT NOT NULL,
+    device_id TEXT,
+    user_agent TEXT,
+    ip_address TEXT,
+    session_started_at TIMESTAMP NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    revoked_at TIMESTAMP,
+    revoked_reason TEXT,
+    replaced_by UUID,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_refresh_tokens_token_hash ON refresh_tokens(token_hash);
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_user_id ON refresh_tokens(user_id);
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_family_id ON refresh_tokens(family_id);
@@ -0,0 +1,9 @@
+-- Tasks in a project take their key prefix from the project and are
+-- sequenced per project (task_key_sequences.scope_id is the project id).
+-- The prefix is derived from the project name when its first task is created.
+ALTER TABLE projects ADD COLUMN IF NOT EXISTS task_key_prefix TEXT;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_projects_org_task_key_prefix
+    ON projects(org_id, task_key_prefix) WHERE task_key_prefix IS NOT NULL;
+
+-- A key names one task in an org
+CREATE UNIQUE INDEX IF NOT EXISTS idx_tasks_org_task_key ON tasks(org_id, task_key);
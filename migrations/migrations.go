@@ -0,0 +1,61 @@
+// Package migrations embeds the versioned SQL schema so services can apply it
+// at startup without shipping the files alongside the binary. File names
+// follow golang-migrate's NNN_name.up.sql convention.
+//
+// The files at the top level are the shared schema every service builds on,
+// tracked in schema_migrations. Each service's own tables and columns are in
+// the directory named after it, tracked in schema_migrations_<service>.
+package migrations
+
+import (
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+
+	"github.com/chanduchitikam/task-management-system/pkg/database"
+)
+
+//go:embed *.sql
+var FS embed.FS
+
+//go:embed user/*.sql task/*.sql notification/*.sql
+var serviceFS embed.FS
+
+// Core is the shared schema
+var Core = database.MigrationSet{Name: "core", Files: FS, Table: "schema_migrations"}
+
+// Services lists the services with their own migrations
+var Services = []string{"user", "task", "notification", "org"}
+
+// ForService returns the migration sets service applies at startup, in
+// order: the shared schema, then the service's own
+func ForService(service string) ([]database.MigrationSet, error) {
+	switch service {
+	case "org":
+		// the org service's tables are all in the shared schema
+		return []database.MigrationSet{Core}, nil
+	case "user", "task", "notification":
+		files, err := fs.Sub(serviceFS, service)
+		if err != nil {
+			return nil, err
+		}
+		return []database.MigrationSet{Core, {Name: service, Files: files, Table: "schema_migrations_" + service}}, nil
+	}
+	return nil, fmt.Errorf("unknown service %q", service)
+}
+
+// Prepare applies the pending migrations of service when apply is set, then
+// checks none is left pending or dirty
+func Prepare(db *sql.DB, service string, apply bool) error {
+	sets, err := ForService(service)
+	if err != nil {
+		return err
+	}
+	if apply {
+		if err := database.Migrate(db, sets...); err != nil {
+			return err
+		}
+	}
+	return database.CheckMigrations(db, sets...)
+}
@@ -0,0 +1,47 @@
+-- Task tables and columns that were only ever created by AutoMigrate
+
+ALTER TABLE tasks
+    ADD COLUMN IF NOT EXISTS started_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN IF NOT EXISTS completed_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+CREATE INDEX IF NOT EXISTS idx_tasks_completed_at ON tasks(completed_at);
+
+-- Org-scoped subscriptions to task events
+CREATE TABLE IF NOT EXISTS task_webhooks (
+    id UUID PRIMARY KEY,
+    org_id UUID NOT NULL,
+    url TEXT NOT NULL,
+    secret TEXT NOT NULL,
+    event_types TEXT,
+    active BOOLEAN NOT NULL DEFAULT true,
+    created_by UUID NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE,
+    updated_at TIMESTAMP WITH TIME ZONE
+);
+CREATE INDEX IF NOT EXISTS idx_task_webhooks_org_id ON task_webhooks(org_id);
+
+-- Queued webhook deliveries, retried until they succeed or give up
+CREATE TABLE IF NOT EXISTS task_webhook_deliveries (
+    id UUID PRIMARY KEY,
+    webhook_id UUID NOT NULL,
+    event_type TEXT NOT NULL,
+    payload TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending',
+    attempts BIGINT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_error TEXT,
+    response_code BIGINT,
+    created_at TIMESTAMP WITH TIME ZONE,
+    updated_at TIMESTAMP WITH TIME ZONE
+);
+CREATE INDEX IF NOT EXISTS idx_task_webhook_deliveries_webhook_id ON task_webhook_deliveries(webhook_id);
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_due ON task_webhook_deliveries(status, next_attempt_at);
+
+-- Users following tasks
+CREATE TABLE IF NOT EXISTS task_watchers (
+    task_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (task_id, user_id)
+);
+CREATE INDEX IF NOT EXISTS idx_task_watchers_user_id ON task_watchers(user_id);
@@ -0,0 +1,8 @@
+-- Per-user secrets signed into calendar feed tokens; replacing one revokes
+-- the user's feed URLs
+CREATE TABLE IF NOT EXISTS calendar_feed_secrets (
+    user_id UUID PRIMARY KEY,
+    secret TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE,
+    updated_at TIMESTAMP WITH TIME ZONE
+);
@@ -0,0 +1,26 @@
+-- The user service's models name two columns differently from the shared
+-- schema. Databases built by AutoMigrate already have the models' columns
+-- and hold the data there; otherwise they are added and filled in here.
+ALTER TABLE users ADD COLUMN IF NOT EXISTS password VARCHAR(255);
+
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.columns
+               WHERE table_schema = current_schema() AND table_name = 'users' AND column_name = 'password_hash') THEN
+        UPDATE users SET password = password_hash WHERE password IS NULL;
+        -- never written by the service, so it must not block inserts
+        ALTER TABLE users ALTER COLUMN password_hash DROP NOT NULL;
+    END IF;
+END $$;
+
+ALTER TABLE users ALTER COLUMN password SET NOT NULL;
+
+ALTER TABLE passkeys ADD COLUMN IF NOT EXISTS aa_guid TEXT;
+
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.columns
+               WHERE table_schema = current_schema() AND table_name = 'passkeys' AND column_name = 'aaguid') THEN
+        UPDATE passkeys SET aa_guid = aaguid WHERE aa_guid IS NULL;
+    END IF;
+END $$;
@@ -0,0 +1,79 @@
+package auth
+
+import (
+	"net/http"
+	"strings"
+)
+
+// APIKeyPrefix starts every personal API key so the gateway can tell keys
+// from JWTs without parsing them
+const APIKeyPrefix = "tfk_"
+
+// API key scopes are "<resource>:<access>"; write access implies read
+var APIKeyScopes = []string{
+	"tasks:read", "tasks:write",
+	"org:read", "org:write",
+	"users:read", "users:write",
+	"notifications:read", "notifications:write",
+}
+
+// IsAPIKey reports whether a bearer credential is an API key
+func IsAPIKey(token string) bool {
+	return strings.HasPrefix(token, APIKeyPrefix)
+}
+
+// ValidAPIKeyScope reports whether scope is a known API key scope
+func ValidAPIKeyScope(scope string) bool {
+	for _, s := range APIKeyScopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// apiKeyResource maps a REST path to the resource an API key scope covers.
+// Any path touching tasks (including /teams/{id}/tasks) counts as tasks.
+func apiKeyResource(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) < 3 || segments[0] != "api" {
+		return ""
+	}
+	for _, seg := range segments[2:] {
+		if seg == "tasks" {
+			return "tasks"
+		}
+	}
+	switch segments[2] {
+	case "boards", "analytics":
+		return "tasks"
+	case "notifications", "devices":
+		return "notifications"
+	case "users", "audit-logs":
+		return "users"
+	case "organizations", "orgs", "workspaces", "projects", "teams", "groups":
+		return "org"
+	}
+	return ""
+}
+
+// APIKeyAllows reports whether a key with the given scopes may call method
+// on path. Paths outside the scoped resources are always denied.
+func APIKeyAllows(scopes []string, method, path string) bool {
+	resource := apiKeyResource(path)
+	if resource == "" {
+		return false
+	}
+	read := method == http.MethodGet || method == http.MethodHead
+	for _, s := range scopes {
+		switch s {
+		case resource + ":write":
+			return true
+		case resource + ":read":
+			if read {
+				return true
+			}
+		}
+	}
+	return false
+}
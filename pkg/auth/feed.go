@@ -0,0 +1,47 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+)
+
+// GenerateFeedToken returns a long-lived token that authorizes read-only
+// access to a user's calendar feed. Calendar clients cannot send headers, so
+// the token travels in the feed URL and is bound to the user and org it was
+// issued for, and to the user's feed secret: replacing the secret revokes
+// every token issued with it.
+func (m *JWTManager) GenerateFeedToken(userID, orgID, feedSecret string) string {
+	scope := base64.RawURLEncoding.EncodeToString([]byte(orgID))
+	return scope + "." + feedSignature(m.secret(), userID, orgID, feedSecret)
+}
+
+// ValidateFeedToken checks a feed token for userID and the user's current
+// feed secret, and returns the org it was issued for. Users without a feed
+// secret have no valid tokens.
+func (m *JWTManager) ValidateFeedToken(userID, feedSecret, token string) (string, error) {
+	scope, sig, ok := strings.Cut(token, ".")
+	if !ok || userID == "" || feedSecret == "" {
+		return "", ErrInvalidToken
+	}
+	orgID, err := base64.RawURLEncoding.DecodeString(scope)
+	if err != nil {
+		return "", ErrInvalidToken
+	}
+	// feed URLs outlive key rotations as long as the old key is kept
+	for _, key := range m.secrets().Keys {
+		expected := feedSignature(key.([]byte), userID, string(orgID), feedSecret)
+		if hmac.Equal([]byte(sig), []byte(expected)) {
+			return string(orgID), nil
+		}
+	}
+	return "", ErrInvalidToken
+}
+
+// feedSignature signs the token fields
+func feedSignature(key []byte, userID, orgID, feedSecret string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte("feed|" + userID + "|" + orgID + "|" + feedSecret))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,314 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	// jwksMaxAge is how long fetched keys are used before the set is fetched
+	// again
+	jwksMaxAge = time.Hour
+	// jwksMinRefresh limits refetches for tokens signed with an unknown key
+	jwksMinRefresh = 30 * time.Second
+	jwksTimeout    = 5 * time.Second
+	maxJWKSSize    = 1 << 20
+)
+
+var ErrUnknownKey = errors.New("token signed with an unknown key")
+
+// JWK is a public key in JSON Web Key form (RFC 7517)
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid,omitempty"`
+	Use string `json:"use,omitempty"`
+	Alg string `json:"alg,omitempty"`
+	// RSA
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// EC
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+// JWKSet is the document served at /.well-known/jwks.json
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// publicJWK describes pub, signing with alg, as a JWK
+func publicJWK(kid, alg string, pub crypto.PublicKey) (JWK, error) {
+	switch k := pub.(type) {
+	case *rsa.PublicKey:
+		return JWK{
+			Kty: "RSA",
+			Kid: kid,
+			Use: "sig",
+			Alg: alg,
+			N:   b64(k.N.Bytes()),
+			E:   b64(big.NewInt(int64(k.E)).Bytes()),
+		}, nil
+	case *ecdsa.PublicKey:
+		if k.Curve != elliptic.P256() {
+			return JWK{}, errors.New("only P-256 EC keys are supported")
+		}
+		point, err := k.ECDH()
+		if err != nil {
+			return JWK{}, err
+		}
+		// uncompressed point: 0x04 || x || y
+		raw := point.Bytes()[1:]
+		return JWK{
+			Kty: "EC",
+			Kid: kid,
+			Use: "sig",
+			Alg: alg,
+			Crv: "P-256",
+			X:   b64(raw[:32]),
+			Y:   b64(raw[32:]),
+		}, nil
+	}
+	return JWK{}, fmt.Errorf("unsupported public key type %T", pub)
+}
+
+// PublicKey decodes the key
+func (k JWK) PublicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil || len(n) == 0 {
+			return nil, errors.New("invalid RSA modulus")
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil || len(e) == 0 || len(e) > 4 {
+			return nil, errors.New("invalid RSA exponent")
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		if k.Crv != "P-256" {
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, errX := base64.RawURLEncoding.DecodeString(k.X)
+		y, errY := base64.RawURLEncoding.DecodeString(k.Y)
+		if errX != nil || errY != nil || len(x) != 32 || len(y) != 32 {
+			return nil, errors.New("invalid EC point")
+		}
+		// parsing the uncompressed point checks it is on the curve
+		if _, err := ecdh.P256().NewPublicKey(append(append([]byte{4}, x...), y...)); err != nil {
+			return nil, errors.New("invalid EC point")
+		}
+		return &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+	}
+	return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+}
+
+// Thumbprint is the key's RFC 7638 thumbprint, used as its default key ID
+func (k JWK) Thumbprint() string {
+	var members string
+	switch k.Kty {
+	case "RSA":
+		members = fmt.Sprintf(`{"e":%q,"kty":"RSA","n":%q}`, k.E, k.N)
+	case "EC":
+		members = fmt.Sprintf(`{"crv":%q,"kty":"EC","x":%q,"y":%q}`, k.Crv, k.X, k.Y)
+	}
+	sum := sha256.Sum256([]byte(members))
+	return b64(sum[:])
+}
+
+func b64(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// PublicJWKS returns the public keys of the RS256 or ES256 private keys the
+// manager holds, including those not yet or no longer signing
+func (m *JWTManager) PublicJWKS() JWKSet {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	set := JWKSet{Keys: []JWK{}}
+	for _, key := range m.keys {
+		if jwk, err := publicJWK(key.id, m.algorithm, key.signer.Public()); err == nil {
+			set.Keys = append(set.Keys, jwk)
+		}
+	}
+	return set
+}
+
+// ServeJWKS serves PublicJWKS for the services that verify tokens
+func (m *JWTManager) ServeJWKS(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	// verifiers refetch for unknown keys, so a short cache is enough
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	json.NewEncoder(w).Encode(m.PublicJWKS())
+}
+
+// readPrivateKey reads a PEM private key for alg: an RSA key for RS256 or a
+// P-256 key for ES256, in PKCS #8, PKCS #1 or SEC 1 form
+func readPrivateKey(path, alg string) (crypto.Signer, *pem.Block, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, nil, errors.New("not PEM encoded")
+	}
+	var key interface{}
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		if alg == AlgorithmRS256 {
+			return k, block, nil
+		}
+	case *ecdsa.PrivateKey:
+		if alg == AlgorithmES256 && k.Curve == elliptic.P256() {
+			return k, block, nil
+		}
+	}
+	return nil, nil, fmt.Errorf("not a key for %s", alg)
+}
+
+// JWKS caches the public keys published at a JWKS URL. Keys are fetched
+// when first needed, again once they are an hour old, and whenever a token
+// names a key the cache doesn't have, so a new signing key is picked up
+// without a restart. If a fetch fails the keys already fetched stay in use.
+type JWKS struct {
+	url    string
+	client *http.Client
+
+	mu        sync.RWMutex
+	keys      map[string]crypto.PublicKey
+	fetchedAt time.Time
+	triedAt   time.Time
+
+	fetching sync.Mutex
+}
+
+var (
+	jwksMu    sync.Mutex
+	jwksByURL = map[string]*JWKS{}
+)
+
+// JWKSFor returns the cache of the keys at url, shared by every JWTManager
+// in the process
+func JWKSFor(url string) *JWKS {
+	jwksMu.Lock()
+	defer jwksMu.Unlock()
+	if k, ok := jwksByURL[url]; ok {
+		return k
+	}
+	k := &JWKS{url: url, client: &http.Client{Timeout: jwksTimeout}}
+	jwksByURL[url] = k
+	return k
+}
+
+// Key returns the public key with ID kid
+func (j *JWKS) Key(kid string) (crypto.PublicKey, error) {
+	j.mu.RLock()
+	key, ok := j.keys[kid]
+	stale := time.Since(j.fetchedAt) > jwksMaxAge
+	canRetry := time.Since(j.triedAt) > jwksMinRefresh
+	j.mu.RUnlock()
+	if ok && !stale {
+		return key, nil
+	}
+	if !canRetry {
+		if ok {
+			return key, nil
+		}
+		return nil, ErrUnknownKey
+	}
+
+	j.refresh()
+
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+	if key, ok := j.keys[kid]; ok {
+		return key, nil
+	}
+	return nil, ErrUnknownKey
+}
+
+// refresh fetches the key set, one caller at a time
+func (j *JWKS) refresh() {
+	j.fetching.Lock()
+	defer j.fetching.Unlock()
+
+	j.mu.RLock()
+	recent := time.Since(j.triedAt) <= jwksMinRefresh
+	j.mu.RUnlock()
+	if recent {
+		// another caller just fetched
+		return
+	}
+
+	keys, err := j.fetch()
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.triedAt = time.Now()
+	if err != nil {
+		return
+	}
+	j.keys = keys
+	j.fetchedAt = j.triedAt
+}
+
+func (j *JWKS) fetch() (map[string]crypto.PublicKey, error) {
+	resp, err := j.client.Get(j.url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching JWKS: %s", resp.Status)
+	}
+	var set JWKSet
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxJWKSSize)).Decode(&set); err != nil {
+		return nil, fmt.Errorf("decoding JWKS: %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		pub, err := k.PublicKey()
+		if err != nil {
+			// one key we can't read shouldn't hide the others
+			continue
+		}
+		kid := k.Kid
+		if kid == "" {
+			kid = k.Thumbprint()
+		}
+		keys[kid] = pub
+	}
+	return keys, nil
+}
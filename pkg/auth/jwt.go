@@ -2,19 +2,52 @@ package auth
 
 import (
 	"errors"
+	"fmt"
+	"sync"
 	"time"
 
+	"github.com/chanduchitikam/task-management-system/pkg/config"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
 var (
 	ErrInvalidToken = errors.New("invalid token")
 	ErrExpiredToken = errors.New("token has expired")
+	// ErrNoSigningKey is returned by services that verify asymmetric tokens
+	// but hold no private key to issue them
+	ErrNoSigningKey = errors.New("no JWT signing key configured")
+)
+
+// Signing algorithms. HS256 shares one secret between every service that
+// issues or checks tokens; with RS256 or ES256 only the user service holds
+// the private key and the others fetch its public keys.
+const (
+	AlgorithmHS256 = "HS256"
+	AlgorithmRS256 = "RS256"
+	AlgorithmES256 = "ES256"
+)
+
+// Token types carried in the token_type claim. Tokens issued before the claim
+// existed have no type; they still validate as access tokens but can't be
+// exchanged for new ones.
+const (
+	TokenTypeAccess  = "access"
+	TokenTypeRefresh = "refresh"
 )
 
 // // // JWTManager manages JWT tokens
 type JWTManager struct {
-	secretKey            string
+	mu sync.RWMutex
+	// secretKey signs new tokens; tokens signed with previousKeys still
+	// validate
+	secretKey    string
+	previousKeys []string
+	// algorithm is HS256 unless tokens are signed with one of keys, newest
+	// first, and checked with them or with the keys in jwks
+	algorithm            string
+	keys                 []signingKey
+	jwks                 *JWKS
 	accessTokenDuration  time.Duration
 	refreshTokenDuration time.Duration
 }
@@ -25,6 +58,10 @@ type Claims struct {
 	Email  string `json:"email"`
 	Role   string `json:"role"`
 	OrgID  string `json:"org_id"`
+	// TokenType distinguishes access tokens from refresh tokens
+	TokenType string `json:"token_type,omitempty"`
+	// FamilyID groups the refresh tokens one sign-in rotates through
+	FamilyID string `json:"fid,omitempty"`
 	jwt.RegisteredClaims
 }
 
@@ -32,18 +69,127 @@ type Claims struct {
 func NewJWTManager(secretKey string, accessTokenDuration, refreshTokenDuration time.Duration) *JWTManager {
 	return &JWTManager{
 		secretKey:            secretKey,
+		algorithm:            AlgorithmHS256,
 		accessTokenDuration:  accessTokenDuration,
 		refreshTokenDuration: refreshTokenDuration,
 	}
 }
 
+// NewJWTManagerFromConfig creates a JWT manager with the keys in cfg
+func NewJWTManagerFromConfig(cfg config.JWTConfig) (*JWTManager, error) {
+	m := NewJWTManager("", cfg.AccessTokenDuration, cfg.RefreshTokenDuration)
+	if err := m.LoadKeys(cfg); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// SetKeys makes secretKey the signing key. Tokens signed with the previous
+// keys stay valid until they expire, so the key can be rotated without
+// signing everyone out.
+func (m *JWTManager) SetKeys(secretKey string, previous ...string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.secretKey = secretKey
+	m.previousKeys = append([]string(nil), previous...)
+}
+
+// LoadKeys reads the keys in cfg, re-reading its secret and private key
+// files, and keeps the current keys if that fails. With RS256 or ES256 the
+// secret only signs feed tokens, and HMAC-signed tokens validate only with
+// the previous secrets, for the switch from HS256.
+func (m *JWTManager) LoadKeys(cfg config.JWTConfig) error {
+	current, previous, err := cfg.Keys()
+	if err != nil {
+		return err
+	}
+	algorithm := cfg.Algorithm
+	if algorithm == "" {
+		algorithm = AlgorithmHS256
+	}
+
+	var (
+		keys []signingKey
+		jwks *JWKS
+	)
+	switch algorithm {
+	case AlgorithmHS256:
+		if current == "" {
+			return errors.New("JWT signing key is empty")
+		}
+	case AlgorithmRS256, AlgorithmES256:
+		switch {
+		case cfg.KeyDir != "":
+			if keys, err = loadKeyDir(cfg.KeyDir, algorithm); err != nil {
+				return fmt.Errorf("reading JWT_KEY_DIR: %w", err)
+			}
+			if len(keys) == 0 {
+				return fmt.Errorf("JWT_KEY_DIR %s has no keys", cfg.KeyDir)
+			}
+		case cfg.PrivateKeyFile != "":
+			signer, _, err := readPrivateKey(cfg.PrivateKeyFile, algorithm)
+			if err != nil {
+				return fmt.Errorf("reading JWT_PRIVATE_KEY_FILE: %w", err)
+			}
+			key, err := newSigningKey(signer, algorithm, cfg.KeyID, time.Time{})
+			if err != nil {
+				return err
+			}
+			keys = []signingKey{key}
+		}
+		if cfg.JWKSURL != "" {
+			jwks = JWKSFor(cfg.JWKSURL)
+		}
+		if len(keys) == 0 && jwks == nil {
+			return fmt.Errorf("JWT_ALGORITHM %s needs JWT_PRIVATE_KEY_FILE, JWT_KEY_DIR or JWT_JWKS_URL", algorithm)
+		}
+	default:
+		return fmt.Errorf("unsupported JWT_ALGORITHM %q", algorithm)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.secretKey = current
+	m.previousKeys = append([]string(nil), previous...)
+	m.algorithm = algorithm
+	m.keys = keys
+	m.jwks = jwks
+	return nil
+}
+
+// secret is the shared secret, which also signs feed tokens
+func (m *JWTManager) secret() []byte {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return []byte(m.secretKey)
+}
+
+// sign signs claims with the current key, naming it in the kid header
+func (m *JWTManager) sign(claims *Claims) (string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.algorithm == AlgorithmHS256 || m.algorithm == "" {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+		token.Header["kid"] = secretKeyID(m.secretKey)
+		return token.SignedString([]byte(m.secretKey))
+	}
+	key, ok := activeKey(m.keys, time.Now())
+	if !ok {
+		return "", ErrNoSigningKey
+	}
+	token := jwt.NewWithClaims(jwt.GetSigningMethod(m.algorithm), claims)
+	token.Header["kid"] = key.id
+	return token.SignedString(key.signer)
+}
+
 // // // GenerateAccessToken generates a new access token
 func (m *JWTManager) GenerateAccessToken(userID, email, role, orgID string) (string, error) {
 	claims := &Claims{
-		UserID: userID,
-		Email:  email,
-		Role:   role,
-		OrgID:  orgID,
+		UserID:    userID,
+		Email:     email,
+		Role:      role,
+		OrgID:     orgID,
+		TokenType: TokenTypeAccess,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(m.accessTokenDuration)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
@@ -51,33 +197,86 @@ func (m *JWTManager) GenerateAccessToken(userID, email, role, orgID string) (str
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(m.secretKey))
+	return m.sign(claims)
 }
 
 // // // GenerateRefreshToken generates a new refresh token
 func (m *JWTManager) GenerateRefreshToken(userID string) (string, error) {
+	return m.GenerateRefreshTokenForOrg(userID, "")
+}
+
+// GenerateRefreshTokenForOrg generates a refresh token that remembers which
+// organization the session is scoped to, so refreshing keeps that org
+func (m *JWTManager) GenerateRefreshTokenForOrg(userID, orgID string) (string, error) {
+	return m.signRefreshToken(userID, orgID, "", "")
+}
+
+// GenerateSessionRefreshToken generates a single-use refresh token in the
+// rotation family familyID. It returns the token and its unique ID (jti).
+func (m *JWTManager) GenerateSessionRefreshToken(userID, orgID, familyID string) (string, string, error) {
+	tokenID := uuid.New().String()
+	token, err := m.signRefreshToken(userID, orgID, familyID, tokenID)
+	if err != nil {
+		return "", "", err
+	}
+	return token, tokenID, nil
+}
+
+func (m *JWTManager) signRefreshToken(userID, orgID, familyID, tokenID string) (string, error) {
 	claims := &Claims{
-		UserID: userID,
+		UserID:    userID,
+		OrgID:     orgID,
+		TokenType: TokenTypeRefresh,
+		FamilyID:  familyID,
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        tokenID,
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(m.refreshTokenDuration)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			NotBefore: jwt.NewNumericDate(time.Now()),
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(m.secretKey))
+	return m.sign(claims)
+}
+
+// AccessTokenDuration returns how long issued access tokens stay valid
+func (m *JWTManager) AccessTokenDuration() time.Duration {
+	return m.accessTokenDuration
+}
+
+// RefreshTokenDuration returns how long issued refresh tokens stay valid
+func (m *JWTManager) RefreshTokenDuration() time.Duration {
+	return m.refreshTokenDuration
 }
 
 // // // ValidateToken validates a JWT token and returns the claims
 func (m *JWTManager) ValidateToken(tokenString string) (*Claims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, ErrInvalidToken
-		}
-		return []byte(m.secretKey), nil
-	})
+	claims, err := m.parse(tokenString)
+	if err != nil {
+		return nil, err
+	}
+	// Refresh tokens carry no role and must not authorize requests
+	if claims.TokenType == TokenTypeRefresh {
+		return nil, ErrInvalidToken
+	}
+	return claims, nil
+}
+
+// ValidateRefreshToken validates a refresh token and returns its claims
+func (m *JWTManager) ValidateRefreshToken(tokenString string) (*Claims, error) {
+	claims, err := m.parse(tokenString)
+	if err != nil {
+		return nil, err
+	}
+	if claims.TokenType != TokenTypeRefresh {
+		return nil, ErrInvalidToken
+	}
+	return claims, nil
+}
+
+func (m *JWTManager) parse(tokenString string) (*Claims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, m.verificationKey,
+		jwt.WithValidMethods([]string{AlgorithmHS256, AlgorithmRS256, AlgorithmES256}))
 
 	if err != nil {
 		return nil, err
@@ -95,6 +294,62 @@ func (m *JWTManager) ValidateToken(tokenString string) (*Claims, error) {
 	return claims, nil
 }
 
+// verificationKey returns the keys that may have signed token
+func (m *JWTManager) verificationKey(token *jwt.Token) (interface{}, error) {
+	m.mu.RLock()
+	algorithm, keys, jwks := m.algorithm, m.keys, m.jwks
+	m.mu.RUnlock()
+	symmetric := algorithm == AlgorithmHS256 || algorithm == ""
+	kid, _ := token.Header["kid"].(string)
+
+	switch token.Method.(type) {
+	case *jwt.SigningMethodHMAC:
+		secrets := m.secrets()
+		if !symmetric {
+			// the current secret is no longer trusted to sign tokens
+			secrets.Keys = secrets.Keys[1:]
+		}
+		if kid == "" {
+			// tokens from before kid headers: try every secret
+			if len(secrets.Keys) == 0 {
+				return nil, ErrInvalidToken
+			}
+			return secrets, nil
+		}
+		for _, secret := range secrets.Keys {
+			if secretKeyID(string(secret.([]byte))) == kid {
+				return secret, nil
+			}
+		}
+		return nil, ErrUnknownKey
+	case *jwt.SigningMethodRSA, *jwt.SigningMethodECDSA:
+		if symmetric || token.Method.Alg() != algorithm {
+			return nil, ErrInvalidToken
+		}
+		for _, key := range keys {
+			if key.id == kid {
+				return key.signer.Public(), nil
+			}
+		}
+		if jwks != nil {
+			return jwks.Key(kid)
+		}
+		return nil, ErrUnknownKey
+	}
+	return nil, ErrInvalidToken
+}
+
+// secrets are the current secret, tried first, and the previous ones
+func (m *JWTManager) secrets() jwt.VerificationKeySet {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	keys := jwt.VerificationKeySet{Keys: []jwt.VerificationKey{[]byte(m.secretKey)}}
+	for _, k := range m.previousKeys {
+		keys.Keys = append(keys.Keys, []byte(k))
+	}
+	return keys
+}
+
 // // // Verify is an alias for ValidateToken for backward compatibility
 func (m *JWTManager) Verify(tokenString string) (*Claims, error) {
 	return m.ValidateToken(tokenString)
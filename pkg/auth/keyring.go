@@ -0,0 +1,186 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/pkg/config"
+)
+
+// keyActivationDelay is how long a new key is only published before it
+// signs, so every verifier, and every user service replica sharing the key
+// directory, has it by the time tokens use it
+const keyActivationDelay = 5 * time.Minute
+
+// createdHeader records in a key file when the key was made; keys without
+// it date from the file's modification time
+const createdHeader = "Created"
+
+// signingKey is one private key of the key ring
+type signingKey struct {
+	id      string
+	signer  crypto.Signer
+	created time.Time
+	// path is the key's file in the key directory
+	path string
+}
+
+// activeKey returns the newest key past its activation delay, or the oldest
+// when none is, as when the first key was just made. keys are newest first.
+func activeKey(keys []signingKey, now time.Time) (signingKey, bool) {
+	if len(keys) == 0 {
+		return signingKey{}, false
+	}
+	for _, k := range keys {
+		if !k.created.After(now.Add(-keyActivationDelay)) {
+			return k, true
+		}
+	}
+	return keys[len(keys)-1], true
+}
+
+// newSigningKey makes a key ring entry, named by its thumbprint unless
+// keyID is set
+func newSigningKey(signer crypto.Signer, algorithm, keyID string, created time.Time) (signingKey, error) {
+	if keyID == "" {
+		jwk, err := publicJWK("", algorithm, signer.Public())
+		if err != nil {
+			return signingKey{}, err
+		}
+		keyID = jwk.Thumbprint()
+	}
+	return signingKey{id: keyID, signer: signer, created: created}, nil
+}
+
+// loadKeyDir reads every .pem key in dir, newest first
+func loadKeyDir(dir, algorithm string) ([]signingKey, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, err
+	}
+	var keys []signingKey
+	for _, path := range paths {
+		signer, block, err := readPrivateKey(path, algorithm)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", filepath.Base(path), err)
+		}
+		created, err := time.Parse(time.RFC3339, block.Headers[createdHeader])
+		if err != nil {
+			info, err := os.Stat(path)
+			if err != nil {
+				return nil, err
+			}
+			created = info.ModTime()
+		}
+		key, err := newSigningKey(signer, algorithm, "", created)
+		if err != nil {
+			return nil, err
+		}
+		key.path = path
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].created.After(keys[j].created) })
+	return keys, nil
+}
+
+// RotateKeyDir keeps the key directory of cfg on its rotation schedule: it
+// adds a key once the newest is a RotationInterval old, and removes keys
+// retired longer ago than refresh tokens live, so none can have signed a
+// token still valid. It reports whether the directory changed; call LoadKeys
+// afterwards to use the new keys.
+func RotateKeyDir(cfg config.JWTConfig, now time.Time) (bool, error) {
+	if cfg.KeyDir == "" || cfg.RotationInterval <= 0 {
+		return false, nil
+	}
+	if cfg.Algorithm != AlgorithmRS256 && cfg.Algorithm != AlgorithmES256 {
+		return false, errors.New("JWT_KEY_DIR needs JWT_ALGORITHM RS256 or ES256")
+	}
+
+	keys, err := loadKeyDir(cfg.KeyDir, cfg.Algorithm)
+	if err != nil {
+		return false, err
+	}
+
+	changed := false
+	if len(keys) == 0 || now.Sub(keys[0].created) >= cfg.RotationInterval {
+		key, err := generateKey(cfg.KeyDir, cfg.Algorithm, now)
+		if err != nil {
+			return false, err
+		}
+		keys = append([]signingKey{key}, keys...)
+		changed = true
+	}
+
+	// a key retires when the next one activates
+	for i := 1; i < len(keys); i++ {
+		retired := keys[i-1].created.Add(keyActivationDelay)
+		if now.Sub(retired) <= cfg.RefreshTokenDuration {
+			continue
+		}
+		if err := os.Remove(keys[i].path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return changed, err
+		}
+		changed = true
+	}
+	return changed, nil
+}
+
+// generateKey writes a new private key for algorithm to dir, named by its
+// key ID
+func generateKey(dir, algorithm string, now time.Time) (signingKey, error) {
+	var signer crypto.Signer
+	var err error
+	if algorithm == AlgorithmRS256 {
+		signer, err = rsa.GenerateKey(rand.Reader, 2048)
+	} else {
+		signer, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	}
+	if err != nil {
+		return signingKey{}, err
+	}
+	key, err := newSigningKey(signer, algorithm, "", now)
+	if err != nil {
+		return signingKey{}, err
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(signer)
+	if err != nil {
+		return signingKey{}, err
+	}
+	data := pem.EncodeToMemory(&pem.Block{
+		Type:    "PRIVATE KEY",
+		Headers: map[string]string{createdHeader: now.UTC().Format(time.RFC3339)},
+		Bytes:   der,
+	})
+
+	// written under a temporary name so no reader sees half a key
+	path := filepath.Join(dir, key.id+".pem")
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return signingKey{}, err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return signingKey{}, err
+	}
+	key.path = path
+	return key, nil
+}
+
+// secretKeyID names an HMAC secret in the kid header without revealing it
+func secretKeyID(secret string) string {
+	sum := sha256.Sum256([]byte("kid|" + secret))
+	return "hs-" + hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,216 @@
+// Package authz maps roles to permissions so services ask what a caller may
+// do instead of comparing role strings.
+package authz
+
+import "sort"
+
+// Permission is a single capability such as "task.create"
+type Permission string
+
+// Task permissions
+const (
+	TaskCreate Permission = "task.create"
+	// TaskAssignAny allows creating tasks without a team, group or assignee
+	TaskAssignAny Permission = "task.assign_any"
+	// TaskViewAll allows seeing every task in the organization
+	TaskViewAll Permission = "task.view_all"
+	// TaskManageAll allows updating and deleting any task in the organization
+	TaskManageAll Permission = "task.manage_all"
+	// TaskViewOthers allows reading another member's workload and reports
+	TaskViewOthers Permission = "task.view_others"
+)
+
+// Organization permissions
+const (
+	MemberView   Permission = "member.view"
+	MemberInvite Permission = "member.invite"
+	// MemberManage covers creating, removing, unlocking and resetting members
+	// and changing their roles
+	MemberManage  Permission = "member.manage"
+	TeamManage    Permission = "team.manage"
+	ProjectCreate Permission = "project.create"
+	ProjectDelete Permission = "project.delete"
+	// OrgManage covers org settings, boards, webhooks, directory sync and
+	// service accounts
+	OrgManage Permission = "org.manage"
+	AuditRead Permission = "audit.read"
+)
+
+// PlatformManage allows acting on every organization
+const PlatformManage Permission = "platform.manage"
+
+// Built-in roles
+const (
+	RoleSuperAdmin = "super_admin"
+	RoleOrgAdmin   = "org_admin"
+	// RoleAdmin is the legacy name for an organization admin
+	RoleAdmin  = "admin"
+	RoleMember = "member"
+	// RoleGuest is an outside collaborator limited to the single project or
+	// workspace they were invited to; services look up that scope
+	RoleGuest = "guest"
+)
+
+var memberPermissions = []Permission{
+	TaskCreate,
+	MemberView,
+	ProjectCreate,
+}
+
+// Guests may add tasks to their project but see nothing else of the
+// organization
+var guestPermissions = []Permission{
+	TaskCreate,
+}
+
+var orgAdminPermissions = append([]Permission{
+	TaskAssignAny,
+	TaskViewAll,
+	TaskManageAll,
+	TaskViewOthers,
+	MemberInvite,
+	MemberManage,
+	TeamManage,
+	ProjectDelete,
+	OrgManage,
+	AuditRead,
+}, memberPermissions...)
+
+var rolePermissions = map[string]map[Permission]bool{
+	RoleSuperAdmin: permissionSet(append([]Permission{PlatformManage}, orgAdminPermissions...)),
+	RoleOrgAdmin:   permissionSet(orgAdminPermissions),
+	RoleAdmin:      permissionSet(orgAdminPermissions),
+	RoleMember:     permissionSet(memberPermissions),
+	RoleGuest:      permissionSet(guestPermissions),
+}
+
+// customRolePermissions are the permissions custom roles may grant: those
+// only the org service checks, and it resolves custom roles. Other services
+// check the built-in role alone, so a custom role granting their permissions
+// would not take effect.
+var customRolePermissions = permissionSet([]Permission{
+	MemberView,
+	TeamManage,
+	ProjectCreate,
+	ProjectDelete,
+})
+
+func permissionSet(perms []Permission) map[Permission]bool {
+	set := make(map[Permission]bool, len(perms))
+	for _, p := range perms {
+		set[p] = true
+	}
+	return set
+}
+
+// IsRole reports whether role is a known role
+func IsRole(role string) bool {
+	_, ok := rolePermissions[role]
+	return ok
+}
+
+// Can reports whether role grants perm. Unknown roles grant nothing.
+func Can(role string, perm Permission) bool {
+	return rolePermissions[role][perm]
+}
+
+// CanInOrg reports whether a caller with role in callerOrg may use perm on
+// orgID. Platform admins may act on any organization; everyone else only on
+// their own.
+func CanInOrg(role, callerOrg string, perm Permission, orgID string) bool {
+	if !Can(role, perm) {
+		return false
+	}
+	if Can(role, PlatformManage) {
+		return true
+	}
+	return orgID != "" && callerOrg == orgID
+}
+
+// IsGuest reports whether role is limited to a guest's project or workspace
+func IsGuest(role string) bool {
+	return role == RoleGuest
+}
+
+// IsPlatformAdmin reports whether role may act across organizations
+func IsPlatformAdmin(role string) bool {
+	return Can(role, PlatformManage)
+}
+
+// IsOrgAdmin reports whether a caller with role in callerOrg administers
+// orgID: an org admin, under either name, of that organization or a
+// platform admin
+func IsOrgAdmin(role, callerOrg, orgID string) bool {
+	return CanInOrg(role, callerOrg, OrgManage, orgID)
+}
+
+// Assignable reports whether role may be set directly on a user. Super
+// admin is only granted through the user service's approval workflow, and
+// guest only through an invite naming the guest's scope.
+func Assignable(role string) bool {
+	return IsRole(role) && role != RoleSuperAdmin && role != RoleGuest
+}
+
+// CanGrant reports whether a caller with role may give someone the target
+// role: nobody can hand out permissions they do not hold themselves.
+func CanGrant(role, target string) bool {
+	targetPerms, ok := rolePermissions[target]
+	if !ok {
+		return false
+	}
+	for p := range targetPerms {
+		if !Can(role, p) {
+			return false
+		}
+	}
+	return true
+}
+
+// Permissions returns the permissions role grants, sorted
+func Permissions(role string) []Permission {
+	perms := make([]Permission, 0, len(rolePermissions[role]))
+	for p := range rolePermissions[role] {
+		perms = append(perms, p)
+	}
+	sort.Slice(perms, func(i, j int) bool { return perms[i] < perms[j] })
+	return perms
+}
+
+// RolesWith returns the roles that grant perm, sorted
+func RolesWith(perm Permission) []string {
+	var roles []string
+	for role, perms := range rolePermissions {
+		if perms[perm] {
+			roles = append(roles, role)
+		}
+	}
+	sort.Strings(roles)
+	return roles
+}
+
+// OrgPermissions returns the permissions an organization may hand out
+// through custom roles, sorted. Platform permissions are not among them.
+func OrgPermissions() []Permission {
+	perms := make([]Permission, 0, len(customRolePermissions))
+	for p := range customRolePermissions {
+		perms = append(perms, p)
+	}
+	sort.Slice(perms, func(i, j int) bool { return perms[i] < perms[j] })
+	return perms
+}
+
+// IsOrgPermission reports whether perm can be granted by a custom role
+func IsOrgPermission(perm Permission) bool {
+	return customRolePermissions[perm]
+}
+
+// CanGrantPermissions reports whether a caller with role may hand out every
+// permission in perms; like CanGrant, nobody can give more than they hold
+func CanGrantPermissions(role string, perms []Permission) bool {
+	for _, p := range perms {
+		if !Can(role, p) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,158 @@
+package authz
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCan(t *testing.T) {
+	all := []Permission{
+		TaskCreate, TaskAssignAny, TaskViewAll, TaskManageAll, TaskViewOthers,
+		MemberView, MemberInvite, MemberManage, TeamManage, ProjectCreate, ProjectDelete,
+		OrgManage, AuditRead, PlatformManage,
+	}
+	orgAdmin := map[Permission]bool{
+		TaskCreate: true, TaskAssignAny: true, TaskViewAll: true, TaskManageAll: true, TaskViewOthers: true,
+		MemberView: true, MemberInvite: true, MemberManage: true, TeamManage: true, ProjectCreate: true,
+		ProjectDelete: true, OrgManage: true, AuditRead: true,
+	}
+	superAdmin := map[Permission]bool{PlatformManage: true}
+	for p := range orgAdmin {
+		superAdmin[p] = true
+	}
+	tests := []struct {
+		role  string
+		grant map[Permission]bool
+	}{
+		{RoleSuperAdmin, superAdmin},
+		{RoleOrgAdmin, orgAdmin},
+		{RoleAdmin, orgAdmin},
+		{RoleMember, map[Permission]bool{TaskCreate: true, MemberView: true, ProjectCreate: true}},
+		{RoleGuest, map[Permission]bool{TaskCreate: true}},
+		{"", nil},
+		{"owner", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.role, func(t *testing.T) {
+			for _, p := range all {
+				assert.Equal(t, tt.grant[p], Can(tt.role, p), "%s", p)
+			}
+		})
+	}
+}
+
+func TestCanInOrg(t *testing.T) {
+	tests := []struct {
+		name      string
+		role      string
+		callerOrg string
+		perm      Permission
+		orgID     string
+		want      bool
+	}{
+		{"admin in own org", RoleOrgAdmin, "org-1", MemberManage, "org-1", true},
+		{"admin in another org", RoleOrgAdmin, "org-1", MemberManage, "org-2", false},
+		{"admin without an org", RoleOrgAdmin, "", MemberManage, "", false},
+		{"member lacking the permission", RoleMember, "org-1", MemberManage, "org-1", false},
+		{"member holding the permission", RoleMember, "org-1", MemberView, "org-1", true},
+		{"super admin in any org", RoleSuperAdmin, "org-1", MemberManage, "org-2", true},
+		{"super admin without an org", RoleSuperAdmin, "", OrgManage, "org-2", true},
+		{"unknown role", "owner", "org-1", MemberView, "org-1", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, CanInOrg(tt.role, tt.callerOrg, tt.perm, tt.orgID))
+		})
+	}
+}
+
+func TestCanGrant(t *testing.T) {
+	tests := []struct {
+		role, target string
+		want         bool
+	}{
+		{RoleSuperAdmin, RoleSuperAdmin, true},
+		{RoleSuperAdmin, RoleOrgAdmin, true},
+		{RoleOrgAdmin, RoleAdmin, true},
+		{RoleOrgAdmin, RoleMember, true},
+		{RoleOrgAdmin, RoleGuest, true},
+		{RoleOrgAdmin, RoleSuperAdmin, false},
+		{RoleMember, RoleMember, true},
+		{RoleMember, RoleOrgAdmin, false},
+		{RoleGuest, RoleMember, false},
+		{RoleOrgAdmin, "owner", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.role+" grants "+tt.target, func(t *testing.T) {
+			assert.Equal(t, tt.want, CanGrant(tt.role, tt.target))
+		})
+	}
+}
+
+func TestAssignable(t *testing.T) {
+	tests := []struct {
+		role string
+		want bool
+	}{
+		{RoleOrgAdmin, true},
+		{RoleAdmin, true},
+		{RoleMember, true},
+		{RoleSuperAdmin, false},
+		{RoleGuest, false},
+		{"owner", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.role, func(t *testing.T) {
+			assert.Equal(t, tt.want, Assignable(tt.role))
+		})
+	}
+}
+
+func TestOrgPermissions(t *testing.T) {
+	assert.Equal(t, []Permission{MemberView, ProjectCreate, ProjectDelete, TeamManage}, OrgPermissions())
+	for _, p := range []Permission{TaskViewAll, TaskManageAll, MemberManage, AuditRead, OrgManage, PlatformManage} {
+		assert.False(t, IsOrgPermission(p), "%s", p)
+	}
+}
+
+func TestCanGrantPermissions(t *testing.T) {
+	assert.True(t, CanGrantPermissions(RoleOrgAdmin, []Permission{TeamManage, ProjectDelete}))
+	assert.True(t, CanGrantPermissions(RoleMember, []Permission{MemberView}))
+	assert.False(t, CanGrantPermissions(RoleMember, []Permission{MemberView, TeamManage}))
+	assert.True(t, CanGrantPermissions(RoleMember, nil))
+}
+
+func TestTaskAccess(t *testing.T) {
+	orgTask := TaskRef{OrgID: "org-1", CreatedBy: "bob"}
+	otherOrgTask := TaskRef{OrgID: "org-2", CreatedBy: "alice"}
+	ownTask := TaskRef{CreatedBy: "alice"}
+	assignedTask := TaskRef{CreatedBy: "bob", AssignedTo: "alice"}
+	strangerTask := TaskRef{CreatedBy: "bob"}
+
+	tests := []struct {
+		name   string
+		userID string
+		orgID  string
+		role   string
+		task   TaskRef
+		want   bool
+	}{
+		{"member sees own org's task", "alice", "org-1", RoleMember, orgTask, true},
+		{"member can't see another org's task", "alice", "org-1", RoleMember, otherOrgTask, false},
+		{"member sees own personal task", "alice", "org-1", RoleMember, ownTask, true},
+		{"member sees personal task assigned to them", "alice", "org-1", RoleMember, assignedTask, true},
+		{"member can't see a stranger's personal task", "alice", "org-1", RoleMember, strangerTask, false},
+		{"admin in an org can't see a stranger's personal task", "alice", "org-1", RoleOrgAdmin, strangerTask, false},
+		{"admin outside any org sees every personal task", "alice", "", RoleOrgAdmin, strangerTask, true},
+		{"admin outside any org can't see an org's task", "alice", "", RoleOrgAdmin, orgTask, false},
+		{"guest sees own org's task", "alice", "org-1", RoleGuest, orgTask, true},
+		{"guest can't see own personal task", "alice", "org-1", RoleGuest, ownTask, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, CanAccessTask(tt.userID, tt.orgID, tt.role, TaskViewAll, tt.task))
+		})
+	}
+	assert.True(t, TaskAccess("", "", RoleGuest, TaskViewAll).Empty())
+}
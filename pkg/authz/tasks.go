@@ -0,0 +1,59 @@
+package authz
+
+// TaskRef is what access to a single task depends on
+type TaskRef struct {
+	// OrgID is empty for personal tasks, created outside any organization
+	OrgID      string
+	CreatedBy  string
+	AssignedTo string
+}
+
+// TaskScope describes the tasks a caller may act on, for services to turn
+// into a query
+type TaskScope struct {
+	// OrgID, when set, allows every task of that organization
+	OrgID string
+	// PersonalOf, when set, allows the personal tasks that user created or
+	// is assigned
+	PersonalOf string
+	// AllPersonal allows every personal task
+	AllPersonal bool
+}
+
+// TaskAccess returns the tasks a caller may use perm on, TaskViewAll for
+// reading one or TaskManageAll for changing it. Members of an organization
+// may use any of its tasks, and everyone their own personal tasks. Callers
+// outside any organization whose role grants perm may use every personal
+// task. Guests get only their organization's tasks, which the task service
+// narrows to the projects they were invited to.
+func TaskAccess(userID, orgID, role string, perm Permission) TaskScope {
+	scope := TaskScope{OrgID: orgID}
+	if IsGuest(role) {
+		return scope
+	}
+	scope.PersonalOf = userID
+	scope.AllPersonal = orgID == "" && Can(role, perm)
+	return scope
+}
+
+// Empty reports whether the scope allows no task at all
+func (s TaskScope) Empty() bool {
+	return s.OrgID == "" && s.PersonalOf == "" && !s.AllPersonal
+}
+
+// Allows reports whether task is in the scope
+func (s TaskScope) Allows(task TaskRef) bool {
+	if task.OrgID != "" {
+		return task.OrgID == s.OrgID
+	}
+	if s.AllPersonal {
+		return true
+	}
+	return s.PersonalOf != "" && (task.CreatedBy == s.PersonalOf || task.AssignedTo == s.PersonalOf)
+}
+
+// CanAccessTask reports whether a caller may use perm on task; see
+// TaskAccess
+func CanAccessTask(userID, orgID, role string, perm Permission, task TaskRef) bool {
+	return TaskAccess(userID, orgID, role, perm).Allows(task)
+}
@@ -0,0 +1,29 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// AnnouncementsChannel carries platform-wide announcements to every
+// connected session
+const AnnouncementsChannel = "platform_announcements"
+
+// AnnouncementEvent is an announcement as broadcast to connected sessions
+type AnnouncementEvent struct {
+	ID        string    `json:"id"`
+	Title     string    `json:"title"`
+	Body      string    `json:"body"`
+	Link      string    `json:"link,omitempty"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// PublishAnnouncement broadcasts an announcement
+func (r *RedisClient) PublishAnnouncement(ctx context.Context, ev AnnouncementEvent) error {
+	payload, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	return r.client.Publish(ctx, AnnouncementsChannel, payload).Err()
+}
@@ -0,0 +1,35 @@
+package cache
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// popDueScript removes and returns up to ARGV[2] members scored at or below
+// ARGV[1], so each due member goes to exactly one caller
+var popDueScript = redis.NewScript(`
+local due = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[1], 'LIMIT', 0, ARGV[2])
+if #due > 0 then
+  redis.call('ZREM', KEYS[1], unpack(due))
+end
+return due
+`)
+
+// DelayedAdd schedules member in the delayed queue at key to become due at
+// at. Members are kept in a sorted set scored by due time.
+func (r *RedisClient) DelayedAdd(ctx context.Context, key, member string, at time.Time) error {
+	return r.client.ZAdd(ctx, key, redis.Z{Score: float64(at.UnixMilli()), Member: member}).Err()
+}
+
+// DelayedPopDue removes and returns up to count members of the delayed
+// queue at key that are due by now
+func (r *RedisClient) DelayedPopDue(ctx context.Context, key string, now time.Time, count int64) ([]string, error) {
+	due, err := popDueScript.Run(ctx, r.client, []string{key}, strconv.FormatInt(now.UnixMilli(), 10), count).StringSlice()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	return due, err
+}
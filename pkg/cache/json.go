@@ -0,0 +1,126 @@
+package cache
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// gzipMagic starts every gzip stream; JSON never starts with it, so
+// compressed values are recognised without a flag
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// JSONOption configures SetJSON and GetOrLoadJSON
+type JSONOption func(*jsonOptions)
+
+type jsonOptions struct {
+	compress bool
+}
+
+// WithCompression gzips the value before storing it, for large values that
+// are read less often than they take up memory. GetJSON reads compressed and
+// plain values alike.
+func WithCompression() JSONOption {
+	return func(o *jsonOptions) { o.compress = true }
+}
+
+// SetJSON stores v encoded as JSON under key for expiration
+func (r *RedisClient) SetJSON(ctx context.Context, key string, v interface{}, expiration time.Duration, opts ...JSONOption) error {
+	var o jsonOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("encoding %s: %w", key, err)
+	}
+	if o.compress {
+		var buf bytes.Buffer
+		zw := gzip.NewWriter(&buf)
+		if _, err := zw.Write(data); err != nil {
+			return err
+		}
+		if err := zw.Close(); err != nil {
+			return err
+		}
+		data = buf.Bytes()
+	}
+	return r.client.Set(ctx, key, data, expiration).Err()
+}
+
+// GetJSON decodes the JSON value under key into dst and reports whether the
+// key was set
+func (r *RedisClient) GetJSON(ctx context.Context, key string, dst interface{}) (bool, error) {
+	data, err := r.client.Get(ctx, key).Bytes()
+	if err == redis.Nil {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if bytes.HasPrefix(data, gzipMagic) {
+		zr, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return false, fmt.Errorf("decompressing %s: %w", key, err)
+		}
+		defer zr.Close()
+		if data, err = io.ReadAll(zr); err != nil {
+			return false, fmt.Errorf("decompressing %s: %w", key, err)
+		}
+	}
+	if err := json.Unmarshal(data, dst); err != nil {
+		return false, fmt.Errorf("decoding %s: %w", key, err)
+	}
+	return true, nil
+}
+
+// GetOrLoadJSON returns the value cached under key, or loads it and caches
+// it for expiration on a miss. Concurrent misses for a key in this process
+// share one load, so an expired hot key doesn't send every request to the
+// database at once. Redis errors fall back to load; a nil client always
+// loads.
+func GetOrLoadJSON[T any](ctx context.Context, r *RedisClient, key string, expiration time.Duration, load func(context.Context) (T, error), opts ...JSONOption) (T, error) {
+	if r == nil {
+		return load(ctx)
+	}
+	var cached T
+	found, err := r.GetJSON(ctx, key, &cached)
+	if err == nil && found {
+		return cached, nil
+	}
+	if err != nil {
+		log.Printf("cache: reading %s: %v", key, err)
+	}
+
+	v, err, _ := r.loads.Do(key, func() (interface{}, error) {
+		// the load outlives a caller that gives up, so the others still
+		// get its result
+		loadCtx := context.WithoutCancel(ctx)
+		v, err := load(loadCtx)
+		if err != nil {
+			return v, err
+		}
+		if err := r.SetJSON(loadCtx, key, v, expiration, opts...); err != nil {
+			log.Printf("cache: writing %s: %v", key, err)
+		}
+		return v, nil
+	})
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	value, ok := v.(T)
+	if !ok {
+		// another caller loaded key as a different type
+		var zero T
+		return zero, fmt.Errorf("cache: %s loaded as %T", key, v)
+	}
+	return value, nil
+}
@@ -0,0 +1,37 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// takeAllScript removes the list at KEYS[1] and returns what it held, so
+// each member goes to exactly one caller
+var takeAllScript = redis.NewScript(`
+local members = redis.call('LRANGE', KEYS[1], 0, -1)
+redis.call('DEL', KEYS[1])
+return members
+`)
+
+// ListAppend appends value to the list at key, keeping the list for ttl, and
+// returns the list's new length
+func (r *RedisClient) ListAppend(ctx context.Context, key, value string, ttl time.Duration) (int64, error) {
+	pipe := r.client.TxPipeline()
+	length := pipe.RPush(ctx, key, value)
+	pipe.PExpire(ctx, key, ttl)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return length.Val(), nil
+}
+
+// ListTakeAll removes the list at key and returns its members in order
+func (r *RedisClient) ListTakeAll(ctx context.Context, key string) ([]string, error) {
+	members, err := takeAllScript.Run(ctx, r.client, []string{key}).StringSlice()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	return members, err
+}
@@ -0,0 +1,69 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// MembershipEventsChannel carries org membership changes to interested
+// services (e.g. the gateway evicting WebSocket sessions).
+const MembershipEventsChannel = "org_membership:events"
+
+// Membership change types
+const (
+	MembershipAdded   = "added"
+	MembershipRemoved = "removed"
+)
+
+// MembershipEvent describes a user joining or leaving an org
+type MembershipEvent struct {
+	Type   string    `json:"type"`
+	OrgID  string    `json:"org_id"`
+	UserID string    `json:"user_id"`
+	At     time.Time `json:"at"`
+}
+
+func membershipRevokedKey(orgID, userID string) string {
+	return fmt.Sprintf("org_membership:revoked:%s:%s", orgID, userID)
+}
+
+// PublishMembershipEvent records the change in the membership cache and
+// broadcasts it. Removals are remembered for revokeTTL, which should cover the
+// lifetime of access tokens that still carry the old org_id.
+func (r *RedisClient) PublishMembershipEvent(ctx context.Context, ev MembershipEvent, revokeTTL time.Duration) error {
+	if ev.At.IsZero() {
+		ev.At = time.Now().UTC()
+	}
+
+	key := membershipRevokedKey(ev.OrgID, ev.UserID)
+	switch ev.Type {
+	case MembershipRemoved:
+		if err := r.client.Set(ctx, key, ev.At.Unix(), revokeTTL).Err(); err != nil {
+			return err
+		}
+	case MembershipAdded:
+		if err := r.client.Del(ctx, key).Err(); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown membership event type %q", ev.Type)
+	}
+
+	payload, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	return r.client.Publish(ctx, MembershipEventsChannel, payload).Err()
+}
+
+// IsMembershipRevoked reports whether userID was removed from orgID recently
+// enough that tokens issued before the removal may still be in circulation.
+func (r *RedisClient) IsMembershipRevoked(ctx context.Context, orgID, userID string) (bool, error) {
+	n, err := r.client.Exists(ctx, membershipRevokedKey(orgID, userID)).Result()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
@@ -0,0 +1,111 @@
+package cache
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// WebSocketChannel carries WebSocket messages between gateway replicas, so
+// a message reaches a user's connections whichever replica holds them
+const WebSocketChannel = "ws:broadcast"
+
+// Presence is tracked per gateway replica: a sorted set of replicas scored
+// by their last heartbeat, and a set of the users connected to each. A
+// replica that stops heartbeating drops out of presence on its own.
+const presencePodsKey = "ws:presence:pods"
+
+func presenceUsersKey(podID string) string {
+	return "ws:presence:users:" + podID
+}
+
+// PresenceAdd records userID as connected to podID
+func (r *RedisClient) PresenceAdd(ctx context.Context, podID, userID string) error {
+	return r.client.SAdd(ctx, presenceUsersKey(podID), userID).Err()
+}
+
+// PresenceRemove records userID as no longer connected to podID
+func (r *RedisClient) PresenceRemove(ctx context.Context, podID, userID string) error {
+	return r.client.SRem(ctx, presenceUsersKey(podID), userID).Err()
+}
+
+// PresenceHeartbeat replaces podID's connected users with users and keeps
+// the replica live for ttl
+func (r *RedisClient) PresenceHeartbeat(ctx context.Context, podID string, users []string, ttl time.Duration) error {
+	now := time.Now()
+	key := presenceUsersKey(podID)
+	pipe := r.client.TxPipeline()
+	pipe.Del(ctx, key)
+	if len(users) > 0 {
+		members := make([]interface{}, len(users))
+		for i, u := range users {
+			members[i] = u
+		}
+		pipe.SAdd(ctx, key, members...)
+		pipe.Expire(ctx, key, 2*ttl)
+	}
+	pipe.ZAdd(ctx, presencePodsKey, redis.Z{Score: float64(now.Unix()), Member: podID})
+	pipe.ZRemRangeByScore(ctx, presencePodsKey, "-inf", strconv.FormatInt(now.Add(-ttl).Unix(), 10))
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// PresenceLeave removes podID from presence, e.g. on shutdown
+func (r *RedisClient) PresenceLeave(ctx context.Context, podID string) error {
+	pipe := r.client.TxPipeline()
+	pipe.ZRem(ctx, presencePodsKey, podID)
+	pipe.Del(ctx, presenceUsersKey(podID))
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// OnlineUsers returns the users connected to any replica that heartbeated
+// within ttl
+func (r *RedisClient) OnlineUsers(ctx context.Context, ttl time.Duration) ([]string, error) {
+	pods, err := r.livePods(ctx, ttl)
+	if err != nil || len(pods) == 0 {
+		return nil, err
+	}
+	keys := make([]string, len(pods))
+	for i, pod := range pods {
+		keys[i] = presenceUsersKey(pod)
+	}
+	return r.client.SUnion(ctx, keys...).Result()
+}
+
+// UserOnlineElsewhere reports whether userID is connected to a live replica
+// other than podID
+func (r *RedisClient) UserOnlineElsewhere(ctx context.Context, podID, userID string, ttl time.Duration) (bool, error) {
+	pods, err := r.livePods(ctx, ttl)
+	if err != nil {
+		return false, err
+	}
+	pipe := r.client.Pipeline()
+	var cmds []*redis.BoolCmd
+	for _, pod := range pods {
+		if pod != podID {
+			cmds = append(cmds, pipe.SIsMember(ctx, presenceUsersKey(pod), userID))
+		}
+	}
+	if len(cmds) == 0 {
+		return false, nil
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return false, err
+	}
+	for _, cmd := range cmds {
+		if cmd.Val() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *RedisClient) livePods(ctx context.Context, ttl time.Duration) ([]string, error) {
+	return r.client.ZRangeByScore(ctx, presencePodsKey, &redis.ZRangeBy{
+		Min: strconv.FormatInt(time.Now().Add(-ttl).Unix(), 10),
+		Max: "+inf",
+	}).Result()
+}
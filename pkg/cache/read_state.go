@@ -0,0 +1,37 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// ReadStateChannel carries notification read-state changes to every session
+// of the user, over both the notification stream and WebSockets
+const ReadStateChannel = "notification_read_state"
+
+// ReadStateEvent describes notifications a user read or removed on one
+// device, and how many remain unread
+type ReadStateEvent struct {
+	UserID string `json:"user_id"`
+	// NotificationIDs lists the notifications read or removed. It is empty
+	// for bulk changes, after which clients should reload their inbox.
+	NotificationIDs []string `json:"notification_ids,omitempty"`
+	Bulk            bool     `json:"bulk,omitempty"`
+	// Deleted is set when the notifications were removed rather than read
+	Deleted     bool      `json:"deleted,omitempty"`
+	UnreadCount int64     `json:"unread_count"`
+	At          time.Time `json:"at"`
+}
+
+// PublishReadState broadcasts a read-state change
+func (r *RedisClient) PublishReadState(ctx context.Context, ev ReadStateEvent) error {
+	if ev.At.IsZero() {
+		ev.At = time.Now().UTC()
+	}
+	payload, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	return r.client.Publish(ctx, ReadStateChannel, payload).Err()
+}
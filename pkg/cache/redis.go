@@ -6,11 +6,14 @@ import (
 	"time"
 
 	"github.com/redis/go-redis/v9"
+	"golang.org/x/sync/singleflight"
 )
 
 // // // RedisClient wraps the Redis client
 type RedisClient struct {
 	client *redis.Client
+	// loads shares GetOrLoadJSON loads between concurrent misses
+	loads singleflight.Group
 }
 
 // // // NewRedisClient creates a new Redis client
@@ -37,6 +40,12 @@ func (r *RedisClient) Set(ctx context.Context, key string, value interface{}, ex
 	return r.client.Set(ctx, key, value, expiration).Err()
 }
 
+// SetNX stores a key-value pair with expiration unless the key exists, and
+// reports whether it was stored
+func (r *RedisClient) SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error) {
+	return r.client.SetNX(ctx, key, value, expiration).Result()
+}
+
 // // // Get retrieves a value by key
 func (r *RedisClient) Get(ctx context.Context, key string) (string, error) {
 	return r.client.Get(ctx, key).Result()
@@ -81,6 +90,24 @@ func (r *RedisClient) XAdd(ctx context.Context, stream string, values map[string
 	return r.client.XAdd(ctx, args).Result()
 }
 
+// XAddBatch appends entries to a Redis stream in one round trip and returns
+// how many were added
+func (r *RedisClient) XAddBatch(ctx context.Context, stream string, entries []map[string]interface{}) (int, error) {
+	cmds, err := r.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, values := range entries {
+			pipe.XAdd(ctx, &redis.XAddArgs{Stream: stream, Values: values})
+		}
+		return nil
+	})
+	added := 0
+	for _, cmd := range cmds {
+		if cmd.Err() == nil {
+			added++
+		}
+	}
+	return added, err
+}
+
 // XGroupCreateMkStream creates a consumer group for a stream (creates stream if missing)
 func (r *RedisClient) XGroupCreateMkStream(ctx context.Context, stream, group, start string) error {
 	return r.client.XGroupCreateMkStream(ctx, stream, group, start).Err()
@@ -109,6 +136,27 @@ func (r *RedisClient) XAck(ctx context.Context, stream, group string, ids ...str
 	return r.client.XAck(ctx, stream, group, ids...).Result()
 }
 
+// XRange returns up to count entries of a stream between start and end,
+// oldest first. Prefix start with "(" to exclude it.
+func (r *RedisClient) XRange(ctx context.Context, stream, start, end string, count int64) ([]redis.XMessage, error) {
+	return r.client.XRangeN(ctx, stream, start, end, count).Result()
+}
+
+// XDel removes entries from a stream
+func (r *RedisClient) XDel(ctx context.Context, stream string, ids ...string) (int64, error) {
+	return r.client.XDel(ctx, stream, ids...).Result()
+}
+
+// XLen returns the number of entries in a stream
+func (r *RedisClient) XLen(ctx context.Context, stream string) (int64, error) {
+	return r.client.XLen(ctx, stream).Result()
+}
+
+// XTrimBefore removes the stream entries added before t
+func (r *RedisClient) XTrimBefore(ctx context.Context, stream string, t time.Time) (int64, error) {
+	return r.client.XTrimMinID(ctx, stream, fmt.Sprintf("%d-0", t.UnixMilli())).Result()
+}
+
 // XPendingRange retrieves pending messages for the group
 func (r *RedisClient) XPendingRange(ctx context.Context, stream, group string, start, end string, count int64) ([]redis.XPendingExt, error) {
 	return r.client.XPendingExt(ctx, &redis.XPendingExtArgs{
@@ -119,3 +167,18 @@ func (r *RedisClient) XPendingRange(ctx context.Context, stream, group string, s
 		Count:  count,
 	}).Result()
 }
+
+// XAutoClaim transfers up to count pending messages of the group idle for at
+// least minIdle to consumer, scanning from start. It returns the claimed
+// messages and where the next scan should start, "0-0" once the whole
+// pending list was scanned.
+func (r *RedisClient) XAutoClaim(ctx context.Context, stream, group, consumer string, minIdle time.Duration, start string, count int64) ([]redis.XMessage, string, error) {
+	return r.client.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+		Stream:   stream,
+		Group:    group,
+		Consumer: consumer,
+		MinIdle:  minIdle,
+		Start:    start,
+		Count:    count,
+	}).Result()
+}
@@ -0,0 +1,73 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RefreshRotation is the outcome of presenting a refresh token
+type RefreshRotation string
+
+const (
+	// RefreshRotated means the token was current and has been replaced
+	RefreshRotated RefreshRotation = "rotated"
+	// RefreshConcurrent means the token was replaced moments ago, as when two
+	// tabs refresh at once; it is refused but the family stays valid
+	RefreshConcurrent RefreshRotation = "concurrent"
+	// RefreshReused means an already rotated token was presented again. The
+	// family has been revoked.
+	RefreshReused RefreshRotation = "reused"
+	// RefreshRevoked means the family was revoked earlier
+	RefreshRevoked RefreshRotation = "revoked"
+)
+
+func refreshFamilyKey(familyID string) string {
+	return fmt.Sprintf("refresh:family:%s", familyID)
+}
+
+// rotateRefreshScript replaces the family's current token with the next one
+// if the presented token is current. Families that are not found, such as
+// after Redis lost its data, restart from the presented token.
+var rotateRefreshScript = redis.NewScript(`
+local f = redis.call('HMGET', KEYS[1], 'current', 'previous', 'rotated_at', 'revoked')
+if f[4] then
+  return 'revoked'
+end
+if not f[1] or f[1] == ARGV[1] then
+  redis.call('HSET', KEYS[1], 'current', ARGV[2], 'previous', ARGV[1], 'rotated_at', ARGV[3])
+  redis.call('PEXPIRE', KEYS[1], ARGV[5])
+  return 'rotated'
+end
+if f[2] == ARGV[1] and f[3] and tonumber(ARGV[3]) - tonumber(f[3]) <= tonumber(ARGV[4]) then
+  return 'concurrent'
+end
+redis.call('HSET', KEYS[1], 'revoked', ARGV[3])
+return 'reused'
+`)
+
+// StartRefreshFamily records tokenID as the only valid refresh token of a new
+// family. The family is kept for ttl, which should cover the lifetime of
+// refresh tokens.
+func (r *RedisClient) StartRefreshFamily(ctx context.Context, familyID, tokenID string, ttl time.Duration) error {
+	key := refreshFamilyKey(familyID)
+	pipe := r.client.TxPipeline()
+	pipe.HSet(ctx, key, "current", tokenID)
+	pipe.PExpire(ctx, key, ttl)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// RotateRefreshToken atomically swaps presentedID for nextID in a family. A
+// token presented again after it was rotated revokes the family, unless it is
+// within grace of the rotation.
+func (r *RedisClient) RotateRefreshToken(ctx context.Context, familyID, presentedID, nextID string, grace, ttl time.Duration) (RefreshRotation, error) {
+	res, err := rotateRefreshScript.Run(ctx, r.client, []string{refreshFamilyKey(familyID)},
+		presentedID, nextID, time.Now().UnixMilli(), grace.Milliseconds(), ttl.Milliseconds()).Text()
+	if err != nil {
+		return "", err
+	}
+	return RefreshRotation(res), nil
+}
@@ -0,0 +1,44 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+func sessionsRevokedKey(userID string) string {
+	return fmt.Sprintf("sessions:revoked_before:%s", userID)
+}
+
+// RevokeUserSessions invalidates every token issued to userID up to now. The
+// marker is kept for ttl, which should cover the lifetime of refresh tokens.
+func (r *RedisClient) RevokeUserSessions(ctx context.Context, userID string, ttl time.Duration) error {
+	return r.client.Set(ctx, sessionsRevokedKey(userID), time.Now().Unix(), ttl).Err()
+}
+
+// SessionsRevokedAt returns when userID's sessions were last revoked, or the
+// zero time if they were not
+func (r *RedisClient) SessionsRevokedAt(ctx context.Context, userID string) (time.Time, error) {
+	val, err := r.client.Get(ctx, sessionsRevokedKey(userID)).Result()
+	if err == redis.Nil {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	unix, err := strconv.ParseInt(val, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid session revocation marker for %s: %w", userID, err)
+	}
+	return time.Unix(unix, 0), nil
+}
+
+// TokenRevoked reports whether a token issued at issuedAt predates a session
+// revocation. Token timestamps have second precision, so a token issued in
+// the same second as the revocation counts as revoked.
+func TokenRevoked(issuedAt, revokedAt time.Time) bool {
+	return !revokedAt.IsZero() && !issuedAt.After(revokedAt)
+}
@@ -0,0 +1,37 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// TaskEventsChannel carries org task changes to the gateways, which push
+// them to the WebSocket rooms watching the task, its team, its project and
+// its org
+const TaskEventsChannel = "task_events"
+
+// TaskEvent describes a task that was created, changed or deleted
+type TaskEvent struct {
+	Type   string `json:"type"`
+	TaskID string `json:"task_id"`
+	OrgID  string `json:"org_id"`
+	TeamID string `json:"team_id,omitempty"`
+	// ProjectID is the project, and so the boards, the task belongs to
+	ProjectID string `json:"project_id,omitempty"`
+	// Task is the task as the API returns it
+	Task json.RawMessage `json:"task"`
+	At   time.Time       `json:"at"`
+}
+
+// PublishTaskEvent broadcasts a task change
+func (r *RedisClient) PublishTaskEvent(ctx context.Context, ev TaskEvent) error {
+	if ev.At.IsZero() {
+		ev.At = time.Now().UTC()
+	}
+	payload, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	return r.client.Publish(ctx, TaskEventsChannel, payload).Err()
+}
@@ -0,0 +1,92 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// SlidingWindow summarises the events recorded under a key within a window
+type SlidingWindow struct {
+	Count  int64
+	Oldest time.Time
+	Newest time.Time
+}
+
+// SlidingWindowRecord adds an event at now to the window stored at key and
+// drops events older than window. Events are kept in a sorted set scored by
+// time so counts slide rather than reset on fixed boundaries.
+func (r *RedisClient) SlidingWindowRecord(ctx context.Context, key string, window time.Duration, now time.Time) error {
+	pipe := r.client.TxPipeline()
+	pipe.ZRemRangeByScore(ctx, key, "-inf", strconv.FormatInt(now.Add(-window).UnixNano(), 10))
+	pipe.ZAdd(ctx, key, redis.Z{
+		Score:  float64(now.UnixNano()),
+		Member: fmt.Sprintf("%d-%d", now.UnixNano(), windowMemberSeq()),
+	})
+	pipe.PExpire(ctx, key, window)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// SlidingWindowGet returns the events at key within window of now
+func (r *RedisClient) SlidingWindowGet(ctx context.Context, key string, window time.Duration, now time.Time) (SlidingWindow, error) {
+	min := strconv.FormatInt(now.Add(-window).UnixNano(), 10)
+	pipe := r.client.Pipeline()
+	count := pipe.ZCount(ctx, key, "("+min, "+inf")
+	oldest := pipe.ZRangeByScoreWithScores(ctx, key, &redis.ZRangeBy{Min: "(" + min, Max: "+inf", Count: 1})
+	newest := pipe.ZRevRangeWithScores(ctx, key, 0, 0)
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return SlidingWindow{}, err
+	}
+
+	w := SlidingWindow{Count: count.Val()}
+	if z := oldest.Val(); len(z) > 0 {
+		w.Oldest = time.Unix(0, int64(z[0].Score))
+	}
+	if z := newest.Val(); len(z) > 0 && w.Count > 0 {
+		w.Newest = time.Unix(0, int64(z[0].Score))
+	}
+	return w, nil
+}
+
+// SlidingWindowReset forgets every event recorded at key
+func (r *RedisClient) SlidingWindowReset(ctx context.Context, key string) error {
+	return r.client.Del(ctx, key).Err()
+}
+
+var fixedWindowScript = redis.NewScript(`
+local count = redis.call("INCR", KEYS[1])
+if count == 1 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+end
+local ttl = redis.call("PTTL", KEYS[1])
+if ttl < 0 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+	ttl = tonumber(ARGV[1])
+end
+return {count, ttl}
+`)
+
+// FixedWindowIncr counts an event in the window at key, starting a window
+// that lasts window when none is running. It returns the events counted in
+// the current window and how long until it resets. Unlike the sliding
+// window it costs one counter per key, for limits checked on every request.
+func (r *RedisClient) FixedWindowIncr(ctx context.Context, key string, window time.Duration) (int64, time.Duration, error) {
+	res, err := fixedWindowScript.Run(ctx, r.client, []string{key}, window.Milliseconds()).Int64Slice()
+	if err != nil {
+		return 0, 0, err
+	}
+	return res[0], time.Duration(res[1]) * time.Millisecond, nil
+}
+
+var windowSeq uint64
+
+// windowMemberSeq keeps sorted set members unique when two events share a
+// timestamp
+func windowMemberSeq() uint64 {
+	return atomic.AddUint64(&windowSeq, 1)
+}
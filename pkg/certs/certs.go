@@ -0,0 +1,185 @@
+// Package certs builds the TLS configuration for the gateway's HTTP listener,
+// the services' gRPC listeners and the connections between them.
+//
+// Certificates are read from disk and re-read when the files change, so
+// they can be rotated without restarting. CA bundles are read once at start.
+package certs
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/pkg/config"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// reloadInterval bounds how often the certificate files are checked for
+// changes
+const reloadInterval = 30 * time.Second
+
+// Reloader serves a certificate and key pair from disk, picking up replaced
+// files within reloadInterval. A failed reload keeps the previous pair.
+type Reloader struct {
+	certFile string
+	keyFile  string
+
+	mu      sync.RWMutex
+	cert    *tls.Certificate
+	modTime time.Time
+	checked time.Time
+}
+
+// NewReloader loads the pair at certFile and keyFile
+func NewReloader(certFile, keyFile string) (*Reloader, error) {
+	r := &Reloader{certFile: certFile, keyFile: keyFile}
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Reload reads the pair from disk now
+func (r *Reloader) Reload() error {
+	modTime, err := r.latestModTime()
+	if err != nil {
+		return err
+	}
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("load certificate %s: %w", r.certFile, err)
+	}
+	r.mu.Lock()
+	r.cert = &cert
+	r.modTime = modTime
+	r.checked = time.Now()
+	r.mu.Unlock()
+	return nil
+}
+
+// GetCertificate serves the pair to TLS clients
+func (r *Reloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return r.current(), nil
+}
+
+// GetClientCertificate presents the pair to servers requiring mutual TLS
+func (r *Reloader) GetClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	return r.current(), nil
+}
+
+func (r *Reloader) current() *tls.Certificate {
+	r.mu.RLock()
+	cert, modTime, due := r.cert, r.modTime, time.Since(r.checked) >= reloadInterval
+	r.mu.RUnlock()
+	if !due {
+		return cert
+	}
+
+	r.mu.Lock()
+	r.checked = time.Now()
+	r.mu.Unlock()
+	if latest, err := r.latestModTime(); err == nil && latest.After(modTime) {
+		if err := r.Reload(); err != nil {
+			log.Printf("keeping previous certificate: %v", err)
+		} else {
+			log.Printf("reloaded certificate %s", r.certFile)
+		}
+	}
+	return r.current()
+}
+
+func (r *Reloader) latestModTime() (time.Time, error) {
+	var latest time.Time
+	for _, name := range []string{r.certFile, r.keyFile} {
+		info, err := os.Stat(name)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("stat certificate: %w", err)
+		}
+		if info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+	return latest, nil
+}
+
+// ServerConfig returns the TLS configuration for a listener serving
+// cfg.CertFile, or nil when none is configured. With mutual set and a
+// ClientCAFile, clients must present a certificate signed by it.
+func ServerConfig(cfg config.TLSConfig, mutual bool) (*tls.Config, error) {
+	if cfg.CertFile == "" || cfg.KeyFile == "" {
+		return nil, nil
+	}
+	reloader, err := NewReloader(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig := &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: reloader.GetCertificate,
+	}
+	if mutual && cfg.ClientCAFile != "" {
+		pool, err := loadPool(cfg.ClientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return tlsConfig, nil
+}
+
+// ServerCredentials returns the transport credentials option for a gRPC
+// server, plaintext when no certificate is configured
+func ServerCredentials(cfg config.TLSConfig) (grpc.ServerOption, error) {
+	tlsConfig, err := ServerConfig(cfg, true)
+	if err != nil {
+		return nil, err
+	}
+	if tlsConfig == nil {
+		return grpc.Creds(insecure.NewCredentials()), nil
+	}
+	return grpc.Creds(credentials.NewTLS(tlsConfig)), nil
+}
+
+// DialCredentials returns the transport credentials for dialing the
+// services, plaintext when no CA is configured
+func DialCredentials(cfg config.TLSConfig) (credentials.TransportCredentials, error) {
+	if cfg.CAFile == "" {
+		return insecure.NewCredentials(), nil
+	}
+	pool, err := loadPool(cfg.CAFile)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		RootCAs:    pool,
+		ServerName: cfg.ServerName,
+	}
+	if cfg.ClientCertFile != "" && cfg.ClientKeyFile != "" {
+		reloader, err := NewReloader(cfg.ClientCertFile, cfg.ClientKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.GetClientCertificate = reloader.GetClientCertificate
+	}
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+func loadPool(caFile string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("read CA bundle: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificates found in %s", caFile)
+	}
+	return pool, nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -14,6 +15,12 @@ type Config struct {
 	Redis    RedisConfig
 	JWT      JWTConfig
 	Sentry   SentryConfig
+	SMTP     SMTPConfig
+
+	Encryption EncryptionConfig
+	WebAuthn   WebAuthnConfig
+	TLS        TLSConfig
+	Discovery  DiscoveryConfig
 }
 
 // // // ServerConfig holds server-specific configuration
@@ -31,6 +38,9 @@ type DatabaseConfig struct {
 	Password string
 	DBName   string
 	SSLMode  string
+	// MigrateOnStart applies pending migrations when a service starts; with
+	// it off, run cmd/migrate first and services only check the schema
+	MigrateOnStart bool
 }
 
 // // // RedisConfig holds Redis connection configuration
@@ -43,11 +53,54 @@ type RedisConfig struct {
 
 // // // JWTConfig holds JWT configuration
 type JWTConfig struct {
-	SecretKey            string
+	SecretKey string
+	// PreviousSecretKeys still validate tokens signed before the last
+	// rotation
+	PreviousSecretKeys []string
+	// SecretFile, when set, holds the keys instead, one per line with the
+	// current key first. It is re-read when the gateway reloads.
+	SecretFile string
+	// Algorithm is HS256, signing with the shared secret, or RS256 or ES256,
+	// signing with PrivateKeyFile. Services without the private key verify
+	// asymmetric tokens with the public keys at JWKSURL.
+	Algorithm      string
+	PrivateKeyFile string
+	// KeyID names the signing key in the JWKS; it defaults to the key's
+	// thumbprint
+	KeyID   string
+	JWKSURL string
+	// KeyDir holds several private keys instead of PrivateKeyFile, all of
+	// them published and valid, the newest signing. With a RotationInterval
+	// the user service adds a key that often and removes those no token
+	// still needs.
+	KeyDir               string
+	RotationInterval     time.Duration
 	AccessTokenDuration  time.Duration
 	RefreshTokenDuration time.Duration
 }
 
+// Keys returns the current signing key and the previous ones, reading
+// SecretFile if one is set
+func (c JWTConfig) Keys() (string, []string, error) {
+	if c.SecretFile == "" {
+		return c.SecretKey, c.PreviousSecretKeys, nil
+	}
+	data, err := os.ReadFile(c.SecretFile)
+	if err != nil {
+		return "", nil, fmt.Errorf("reading JWT_SECRET_FILE: %w", err)
+	}
+	var keys []string
+	for _, line := range strings.Split(string(data), "\n") {
+		if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "#") {
+			keys = append(keys, line)
+		}
+	}
+	if len(keys) == 0 {
+		return "", nil, fmt.Errorf("JWT_SECRET_FILE %s has no keys", c.SecretFile)
+	}
+	return keys[0], keys[1:], nil
+}
+
 // // // SentryConfig holds Sentry configuration
 type SentryConfig struct {
 	DSN                string
@@ -57,6 +110,85 @@ type SentryConfig struct {
 	GoVersion          string
 }
 
+// SMTPConfig holds outgoing mail configuration. Mail is disabled when Host
+// is empty.
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+	// InviteAcceptURL, when set, is linked from invite emails with the
+	// token appended as ?token=
+	InviteAcceptURL string
+	// PasswordResetURL, when set, is linked from password reset emails the
+	// same way
+	PasswordResetURL string
+}
+
+// EncryptionConfig holds the keys sealing sensitive columns. Keys are
+// base64-encoded 32-byte AES keys, normally injected from the deployment's
+// KMS-backed secret store. Encryption is disabled when Key is empty.
+type EncryptionConfig struct {
+	Key string
+	// PreviousKeys still open values sealed before the last rotation
+	PreviousKeys []string
+}
+
+// WebAuthnConfig identifies the site passkeys are registered to. Passkeys
+// are disabled when RPID is empty.
+type WebAuthnConfig struct {
+	// RPID is the site's domain, such as "taskflow.example.com"
+	RPID   string
+	RPName string
+	// Origins the frontend is served from; defaults to https://<RPID>
+	Origins []string
+}
+
+// TLSConfig holds the certificates securing traffic to the gateway and
+// between the gateway and the services. Listeners and dials stay plaintext
+// while their files are unset.
+type TLSConfig struct {
+	// CertFile and KeyFile are served by the gateway's HTTP listener and by
+	// each service's gRPC listener. Replaced files are picked up without a
+	// restart.
+	CertFile string
+	KeyFile  string
+	// ClientCAFile makes gRPC listeners require client certificates signed
+	// by it (mutual TLS)
+	ClientCAFile string
+	// CAFile verifies the services' certificates when dialing them
+	CAFile string
+	// ClientCertFile and ClientKeyFile are presented when dialing services
+	// that require mutual TLS
+	ClientCertFile string
+	ClientKeyFile  string
+	// ServerName overrides the name checked against services' certificates
+	ServerName string
+}
+
+// DiscoveryConfig selects how the gateway finds backend endpoints.
+// Addresses that already name a resolver scheme, like "dns:///host:port",
+// are dialed as given regardless of Mode.
+type DiscoveryConfig struct {
+	// Mode is "dns" (default), "static" (comma-separated host:port lists),
+	// "consul" or "kubernetes"
+	Mode string
+	// ConsulAddr and ConsulToken reach the Consul HTTP API
+	ConsulAddr  string
+	ConsulToken string
+	// Namespace holds the Kubernetes services; defaults to the pod's own
+	Namespace string
+	// RefreshInterval is how often Consul and Kubernetes endpoints are
+	// re-resolved
+	RefreshInterval time.Duration
+}
+
+// Enabled reports whether outgoing mail is configured
+func (c *SMTPConfig) Enabled() bool {
+	return c.Host != "" && c.Port != 0
+}
+
 // // // LoadConfig loads configuration from environment variables
 func LoadConfig() (*Config, error) {
 	config := &Config{
@@ -66,12 +198,13 @@ func LoadConfig() (*Config, error) {
 			Environment: getEnv("ENVIRONMENT", "development"),
 		},
 		Database: DatabaseConfig{
-			Host:     getEnv("DB_HOST", "localhost"),
-			Port:     getEnvAsInt("DB_PORT", 5432),
-			User:     getEnv("DB_USER", "postgres"),
-			Password: getEnv("DB_PASSWORD", "postgres"),
-			DBName:   getEnv("DB_NAME", "taskmanagement"),
-			SSLMode:  getEnv("DB_SSLMODE", "disable"),
+			Host:           getEnv("DB_HOST", "localhost"),
+			Port:           getEnvAsInt("DB_PORT", 5432),
+			User:           getEnv("DB_USER", "postgres"),
+			Password:       getEnv("DB_PASSWORD", "postgres"),
+			DBName:         getEnv("DB_NAME", "taskmanagement"),
+			SSLMode:        getEnv("DB_SSLMODE", "disable"),
+			MigrateOnStart: getEnv("DB_MIGRATE_ON_START", "true") != "false",
 		},
 		Redis: RedisConfig{
 			Host:     getEnv("REDIS_HOST", "localhost"),
@@ -80,7 +213,15 @@ func LoadConfig() (*Config, error) {
 			DB:       getEnvAsInt("REDIS_DB", 0),
 		},
 		JWT: JWTConfig{
-			SecretKey:            getEnv("JWT_SECRET", "your-secret-key-change-in-production"),
+			SecretKey:            getEnv("JWT_SECRET", defaultJWTSecret),
+			PreviousSecretKeys:   getEnvAsList("JWT_PREVIOUS_SECRETS"),
+			SecretFile:           getEnv("JWT_SECRET_FILE", ""),
+			Algorithm:            strings.ToUpper(getEnv("JWT_ALGORITHM", "HS256")),
+			PrivateKeyFile:       getEnv("JWT_PRIVATE_KEY_FILE", ""),
+			KeyID:                getEnv("JWT_KEY_ID", ""),
+			JWKSURL:              getEnv("JWT_JWKS_URL", ""),
+			KeyDir:               getEnv("JWT_KEY_DIR", ""),
+			RotationInterval:     getEnvAsDuration("JWT_KEY_ROTATION_INTERVAL", 0),
 			AccessTokenDuration:  time.Hour * 24,
 			RefreshTokenDuration: time.Hour * 24 * 7,
 		},
@@ -91,11 +232,77 @@ func LoadConfig() (*Config, error) {
 			ProfilesSampleRate: getEnvAsFloat("SENTRY_PROFILES_SAMPLE_RATE", 0.1),
 			GoVersion:          getEnv("GO_VERSION", "1.24"),
 		},
+		SMTP: SMTPConfig{
+			Host:             getEnv("SMTP_HOST", ""),
+			Port:             getEnvAsInt("SMTP_PORT", 0),
+			Username:         getEnv("SMTP_USER", ""),
+			Password:         getEnv("SMTP_PASS", ""),
+			From:             getEnv("SMTP_FROM", "TaskFlow <no-reply@taskflow.local>"),
+			InviteAcceptURL:  getEnv("INVITE_ACCEPT_URL", ""),
+			PasswordResetURL: getEnv("PASSWORD_RESET_URL", ""),
+		},
+		Encryption: EncryptionConfig{
+			Key:          getEnv("ENCRYPTION_KEY", ""),
+			PreviousKeys: getEnvAsList("ENCRYPTION_PREVIOUS_KEYS"),
+		},
+		WebAuthn: WebAuthnConfig{
+			RPID:    getEnv("WEBAUTHN_RP_ID", ""),
+			RPName:  getEnv("WEBAUTHN_RP_NAME", "TaskFlow"),
+			Origins: getEnvAsList("WEBAUTHN_ORIGINS"),
+		},
+		TLS: TLSConfig{
+			CertFile:       getEnv("TLS_CERT_FILE", ""),
+			KeyFile:        getEnv("TLS_KEY_FILE", ""),
+			ClientCAFile:   getEnv("TLS_CLIENT_CA_FILE", ""),
+			CAFile:         getEnv("TLS_CA_FILE", ""),
+			ClientCertFile: getEnv("TLS_CLIENT_CERT_FILE", ""),
+			ClientKeyFile:  getEnv("TLS_CLIENT_KEY_FILE", ""),
+			ServerName:     getEnv("TLS_SERVER_NAME", ""),
+		},
+		Discovery: DiscoveryConfig{
+			Mode:            getEnv("SERVICE_DISCOVERY", "dns"),
+			ConsulAddr:      getEnv("CONSUL_HTTP_ADDR", "http://127.0.0.1:8500"),
+			ConsulToken:     getEnv("CONSUL_HTTP_TOKEN", ""),
+			Namespace:       getEnv("KUBERNETES_NAMESPACE", ""),
+			RefreshInterval: getEnvAsDuration("DISCOVERY_REFRESH_INTERVAL", 30*time.Second),
+		},
 	}
 
 	return config, nil
 }
 
+// LoadEnvFile sets the KEY=VALUE lines of the file at path as environment
+// variables, overriding ones already set, so a later LoadConfig sees them.
+// Blank lines and lines starting with # are skipped.
+func LoadEnvFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	vars := make(map[string]string)
+	for i, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(strings.TrimPrefix(line, "export "), "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return fmt.Errorf("%s:%d: expected KEY=VALUE", path, i+1)
+		}
+		value = strings.TrimSpace(value)
+		if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			value = value[1 : len(value)-1]
+		}
+		vars[key] = value
+	}
+	// a malformed file changes nothing
+	for key, value := range vars {
+		os.Setenv(key, value)
+	}
+	return nil
+}
+
 // // // GetDSN returns the database connection string
 func (c *DatabaseConfig) GetDSN() string {
 	return fmt.Sprintf(
@@ -132,3 +339,20 @@ func getEnvAsFloat(key string, defaultValue float64) float64 {
 	}
 	return defaultValue
 }
+
+func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
+	if value, err := time.ParseDuration(getEnv(key, "")); err == nil && value > 0 {
+		return value
+	}
+	return defaultValue
+}
+
+func getEnvAsList(key string) []string {
+	var out []string
+	for _, v := range strings.Split(getEnv(key, ""), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
@@ -0,0 +1,196 @@
+package config
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/mail"
+	"sort"
+	"strings"
+)
+
+// defaultJWTSecret is the placeholder secret used when JWT_SECRET is unset
+const defaultJWTSecret = "your-secret-key-change-in-production"
+
+// redacted replaces secrets in Redacted
+const redacted = "[redacted]"
+
+// ValidationError lists every problem Validate found, so a misconfigured
+// deployment is fixed in one pass rather than one restart per setting
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%d configuration problem(s):\n  - %s", len(e.Problems), strings.Join(e.Problems, "\n  - "))
+}
+
+// Validate checks the settings every service depends on. It returns a
+// *ValidationError listing the problems, or nil. The placeholder JWT secret
+// is only accepted in the development environment.
+func (c *Config) Validate() error {
+	var problems []string
+	add := func(format string, args ...interface{}) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	// ports
+	for _, p := range []struct {
+		name string
+		port int
+	}{
+		{"GRPC_PORT", c.Server.GRPCPort},
+		{"HTTP_PORT", c.Server.HTTPPort},
+		{"DB_PORT", c.Database.Port},
+		{"REDIS_PORT", c.Redis.Port},
+	} {
+		if p.port < 1 || p.port > 65535 {
+			add("%s %d is not a valid port", p.name, p.port)
+		}
+	}
+	// the services listen on ports offset from GRPC_PORT and HTTP_PORT
+	listeners := map[int][]string{}
+	for _, l := range []struct {
+		name string
+		port int
+	}{
+		{"user service gRPC (GRPC_PORT)", c.Server.GRPCPort},
+		{"task service gRPC (GRPC_PORT+1)", c.Server.GRPCPort + 1},
+		{"notification service gRPC (GRPC_PORT+2)", c.Server.GRPCPort + 2},
+		{"org service gRPC (GRPC_PORT+3)", c.Server.GRPCPort + 3},
+		{"gateway HTTP (HTTP_PORT)", c.Server.HTTPPort},
+		{"notification service HTTP (HTTP_PORT+2)", c.Server.HTTPPort + 2},
+	} {
+		listeners[l.port] = append(listeners[l.port], l.name)
+	}
+	var ports []int
+	for port, names := range listeners {
+		if len(names) > 1 {
+			ports = append(ports, port)
+		}
+	}
+	sort.Ints(ports)
+	for _, port := range ports {
+		add("port %d is used by both %s", port, strings.Join(listeners[port], " and "))
+	}
+
+	// JWT
+	switch c.JWT.Algorithm {
+	case "HS256":
+		if c.JWT.SecretFile == "" {
+			if c.JWT.SecretKey == "" {
+				add("JWT_SECRET is required")
+			} else if c.JWT.SecretKey == defaultJWTSecret && c.Server.Environment != "development" {
+				add("JWT_SECRET is the placeholder default; set a secret of your own")
+			}
+		}
+	case "RS256", "ES256":
+		if c.JWT.PrivateKeyFile == "" && c.JWT.KeyDir == "" && c.JWT.JWKSURL == "" {
+			add("JWT_ALGORITHM %s needs JWT_PRIVATE_KEY_FILE, JWT_KEY_DIR or JWT_JWKS_URL", c.JWT.Algorithm)
+		}
+	default:
+		add("JWT_ALGORITHM %q is not HS256, RS256 or ES256", c.JWT.Algorithm)
+	}
+	if c.JWT.RotationInterval > 0 && c.JWT.KeyDir == "" {
+		add("JWT_KEY_ROTATION_INTERVAL needs JWT_KEY_DIR")
+	}
+	if c.JWT.AccessTokenDuration <= 0 || c.JWT.RefreshTokenDuration < c.JWT.AccessTokenDuration {
+		add("refresh tokens must outlive access tokens")
+	}
+
+	// SMTP is optional, but once any of it is set it must be complete
+	s := c.SMTP
+	if s.Host != "" || s.Port != 0 || s.Username != "" || s.Password != "" {
+		if s.Host == "" {
+			add("SMTP_HOST is required when SMTP is configured")
+		}
+		if s.Port < 1 || s.Port > 65535 {
+			add("SMTP_PORT is required when SMTP is configured")
+		}
+		if (s.Username == "") != (s.Password == "") {
+			add("SMTP_USER and SMTP_PASS must be set together")
+		}
+		if _, err := mail.ParseAddress(s.From); err != nil {
+			add("SMTP_FROM %q is not a valid address", s.From)
+		}
+	}
+
+	// encryption keys
+	for i, key := range append([]string{c.Encryption.Key}, c.Encryption.PreviousKeys...) {
+		if key == "" {
+			continue
+		}
+		name := "ENCRYPTION_KEY"
+		if i > 0 {
+			name = fmt.Sprintf("ENCRYPTION_PREVIOUS_KEYS entry %d", i)
+		}
+		if raw, err := base64.StdEncoding.DecodeString(strings.TrimSpace(key)); err != nil || len(raw) != 32 {
+			add("%s must be a base64-encoded 32-byte key", name)
+		}
+	}
+
+	// TLS files come in pairs
+	if (c.TLS.CertFile == "") != (c.TLS.KeyFile == "") {
+		add("TLS_CERT_FILE and TLS_KEY_FILE must be set together")
+	}
+	if (c.TLS.ClientCertFile == "") != (c.TLS.ClientKeyFile == "") {
+		add("TLS_CLIENT_CERT_FILE and TLS_CLIENT_KEY_FILE must be set together")
+	}
+
+	switch c.Discovery.Mode {
+	case "dns", "static", "consul", "kubernetes":
+	default:
+		add("SERVICE_DISCOVERY %q is not dns, static, consul or kubernetes", c.Discovery.Mode)
+	}
+
+	for _, r := range []struct {
+		name string
+		rate float64
+	}{
+		{"SENTRY_TRACES_SAMPLE_RATE", c.Sentry.TracesSampleRate},
+		{"SENTRY_PROFILES_SAMPLE_RATE", c.Sentry.ProfilesSampleRate},
+	} {
+		if r.rate < 0 || r.rate > 1 {
+			add("%s must be between 0 and 1", r.name)
+		}
+	}
+
+	if len(problems) > 0 {
+		return &ValidationError{Problems: problems}
+	}
+	return nil
+}
+
+// Redacted returns a copy of the configuration safe to show for debugging,
+// with passwords, secrets and keys replaced. Settings that are unset stay
+// empty, so it still shows whether they are.
+func (c Config) Redacted() Config {
+	hide := func(s *string) {
+		if *s != "" {
+			*s = redacted
+		}
+	}
+	hide(&c.Database.Password)
+	hide(&c.Redis.Password)
+	hide(&c.JWT.SecretKey)
+	hide(&c.SMTP.Password)
+	hide(&c.Encryption.Key)
+	hide(&c.Discovery.ConsulToken)
+	// the DSN embeds the project's key
+	hide(&c.Sentry.DSN)
+
+	// copied, so the original keys are left alone
+	c.JWT.PreviousSecretKeys = hideAll(c.JWT.PreviousSecretKeys)
+	c.Encryption.PreviousKeys = hideAll(c.Encryption.PreviousKeys)
+	return c
+}
+
+func hideAll(values []string) []string {
+	if values == nil {
+		return nil
+	}
+	out := make([]string, len(values))
+	for i := range out {
+		out[i] = redacted
+	}
+	return out
+}
@@ -0,0 +1,207 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"gorm.io/gorm"
+)
+
+// MigrationSet is one history of golang-migrate NNN_name.up.sql files. Each
+// set records its applied version in its own Table, so services can add
+// migrations independently.
+type MigrationSet struct {
+	Name  string
+	Files fs.FS
+	Table string
+}
+
+// MigrationStatus is where a set's migrations stand in the database
+type MigrationStatus struct {
+	Set string
+	// Version is the last applied migration, 0 if none is
+	Version uint
+	// Latest is the last migration of the set's files
+	Latest uint
+	// Dirty means migration Version failed part way and must be fixed by
+	// hand, then forced
+	Dirty bool
+}
+
+// Pending reports whether migrations of the set are still to be applied
+func (s MigrationStatus) Pending() bool {
+	return s.Version < s.Latest
+}
+
+// Migrate applies every pending up migration of sets to db, in order
+func Migrate(db *sql.DB, sets ...MigrationSet) error {
+	for _, set := range sets {
+		err := withMigrator(db, set, func(m *migrate.Migrate) error {
+			if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+				return fmt.Errorf("failed to apply %s migrations: %w", set.Name, err)
+			}
+			version, dirty, err := m.Version()
+			if err != nil && !errors.Is(err, migrate.ErrNilVersion) {
+				return fmt.Errorf("failed to read %s schema version: %w", set.Name, err)
+			}
+			log.Printf("Database %s schema at version %d (dirty: %v)", set.Name, version, dirty)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ForceMigration records version as the set's applied version without
+// running anything, to recover a dirty set once its schema was fixed by hand
+func ForceMigration(db *sql.DB, set MigrationSet, version int) error {
+	return withMigrator(db, set, func(m *migrate.Migrate) error {
+		return m.Force(version)
+	})
+}
+
+// MigrationStatuses reports where each set stands
+func MigrationStatuses(db *sql.DB, sets ...MigrationSet) ([]MigrationStatus, error) {
+	statuses := make([]MigrationStatus, 0, len(sets))
+	for _, set := range sets {
+		latest, err := latestMigration(set)
+		if err != nil {
+			return nil, err
+		}
+		st := MigrationStatus{Set: set.Name, Latest: latest}
+		err = withMigrator(db, set, func(m *migrate.Migrate) error {
+			var err error
+			st.Version, st.Dirty, err = m.Version()
+			if errors.Is(err, migrate.ErrNilVersion) {
+				return nil
+			}
+			return err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s schema version: %w", set.Name, err)
+		}
+		statuses = append(statuses, st)
+	}
+	return statuses, nil
+}
+
+// CheckMigrations fails if a set is dirty or has migrations not yet
+// applied. A database ahead of the files, as after rolling back a
+// deployment, is only logged since migrations add to the schema.
+func CheckMigrations(db *sql.DB, sets ...MigrationSet) error {
+	statuses, err := MigrationStatuses(db, sets...)
+	if err != nil {
+		return err
+	}
+	var problems []string
+	for _, st := range statuses {
+		switch {
+		case st.Dirty:
+			problems = append(problems, fmt.Sprintf("%s migration %d is dirty", st.Set, st.Version))
+		case st.Pending():
+			problems = append(problems, fmt.Sprintf("%s schema at version %d, migrations up to %d pending", st.Set, st.Version, st.Latest))
+		case st.Version > st.Latest:
+			log.Printf("Database %s schema at version %d is ahead of this build's %d", st.Set, st.Version, st.Latest)
+		}
+	}
+	if len(problems) > 0 {
+		return errors.New(strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// CheckModels compares the tables of models with the database, failing if
+// a table or a column a model uses is missing, so a schema that drifted
+// from the code stops the service at startup rather than failing queries
+func CheckModels(db *gorm.DB, models ...interface{}) error {
+	var problems []string
+	for _, model := range models {
+		stmt := &gorm.Statement{DB: db}
+		if err := stmt.Parse(model); err != nil {
+			return fmt.Errorf("failed to parse model %T: %w", model, err)
+		}
+		table := stmt.Schema.Table
+		if !db.Migrator().HasTable(table) {
+			problems = append(problems, fmt.Sprintf("table %s is missing", table))
+			continue
+		}
+		columns, err := db.Migrator().ColumnTypes(table)
+		if err != nil {
+			return fmt.Errorf("failed to read columns of %s: %w", table, err)
+		}
+		have := make(map[string]bool, len(columns))
+		for _, c := range columns {
+			have[c.Name()] = true
+		}
+		for _, name := range stmt.Schema.DBNames {
+			if !have[name] {
+				problems = append(problems, fmt.Sprintf("column %s.%s is missing", table, name))
+			}
+		}
+	}
+	if len(problems) > 0 {
+		return errors.New(strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+func withMigrator(db *sql.DB, set MigrationSet, fn func(*migrate.Migrate) error) error {
+	source, err := iofs.New(set.Files, ".")
+	if err != nil {
+		return fmt.Errorf("failed to read %s migrations: %w", set.Name, err)
+	}
+
+	// a dedicated connection, so closing the migrator leaves db open
+	ctx := context.Background()
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to prepare migrations: %w", err)
+	}
+	driver, err := postgres.WithConnection(ctx, conn, &postgres.Config{MigrationsTable: set.Table})
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to prepare migrations: %w", err)
+	}
+
+	m, err := migrate.NewWithInstance("iofs", source, "postgres", driver)
+	if err != nil {
+		driver.Close()
+		return fmt.Errorf("failed to prepare migrations: %w", err)
+	}
+	defer m.Close()
+	return fn(m)
+}
+
+// latestMigration returns the last version among the set's files
+func latestMigration(set MigrationSet) (uint, error) {
+	source, err := iofs.New(set.Files, ".")
+	if err != nil {
+		return 0, fmt.Errorf("failed to read %s migrations: %w", set.Name, err)
+	}
+	defer source.Close()
+	version, err := source.First()
+	if err != nil {
+		return 0, fmt.Errorf("failed to read %s migrations: %w", set.Name, err)
+	}
+	for {
+		next, err := source.Next(version)
+		if errors.Is(err, os.ErrNotExist) {
+			return version, nil
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to read %s migrations: %w", set.Name, err)
+		}
+		version = next
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"database/sql"
 	"fmt"
 	"log"
-	"os"
 
 	_ "github.com/lib/pq"
 	"gorm.io/driver/postgres"
@@ -34,22 +33,9 @@ func NewPostgresConnection(dsn string) (*gorm.DB, error) {
 	return db, nil
 }
 
-// // // AutoMigrate runs database migrations for the given models
-func AutoMigrate(db *gorm.DB, models ...interface{}) error {
-	return db.AutoMigrate(models...)
-}
-
-// Connect creates a raw sql.DB connection for services that don't use GORM
-func Connect() (*sql.DB, error) {
-	host := getEnv("DB_HOST", "localhost")
-	port := getEnv("DB_PORT", "5432")
-	user := getEnv("DB_USER", "postgres")
-	password := getEnv("DB_PASSWORD", "postgres")
-	dbname := getEnv("DB_NAME", "taskmanagement")
-
-	dsn := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
-		host, port, user, password, dbname)
-
+// NewSQLConnection creates a raw sql.DB connection for services that don't
+// use GORM
+func NewSQLConnection(dsn string) (*sql.DB, error) {
 	db, err := sql.Open("postgres", dsn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
@@ -65,10 +51,3 @@ func Connect() (*sql.DB, error) {
 	log.Println("Database connection established successfully")
 	return db, nil
 }
-
-func getEnv(key, defaultValue string) string {
-	if value := os.Getenv(key); value != "" {
-		return value
-	}
-	return defaultValue
-}
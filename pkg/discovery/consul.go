@@ -0,0 +1,76 @@
+package discovery
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/pkg/config"
+)
+
+type consulEntry struct {
+	Node struct {
+		Address string
+	}
+	Service struct {
+		Address string
+		Port    int
+	}
+}
+
+// newConsulLookup resolves "consul:///user-service" (a port, if given, is
+// ignored in favor of the registered one) to the service's instances that
+// pass their health checks
+func newConsulLookup(cfg config.DiscoveryConfig) lookupFunc {
+	client := &http.Client{Timeout: 10 * time.Second}
+	base := strings.TrimRight(cfg.ConsulAddr, "/")
+	if !strings.Contains(base, "://") {
+		base = "http://" + base
+	}
+
+	return func(ctx context.Context, endpoint string) ([]string, error) {
+		service := endpoint
+		if host, _, err := net.SplitHostPort(endpoint); err == nil {
+			service = host
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+			base+"/v1/health/service/"+url.PathEscape(service)+"?passing=true", nil)
+		if err != nil {
+			return nil, err
+		}
+		if cfg.ConsulToken != "" {
+			req.Header.Set("X-Consul-Token", cfg.ConsulToken)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("consul returned %s", resp.Status)
+		}
+
+		var entries []consulEntry
+		if err := json.NewDecoder(resp.Body).Decode(&entries); err != nil {
+			return nil, fmt.Errorf("invalid consul response: %w", err)
+		}
+		endpoints := make([]string, 0, len(entries))
+		for _, e := range entries {
+			host := e.Service.Address
+			if host == "" {
+				host = e.Node.Address
+			}
+			if host == "" || e.Service.Port == 0 {
+				continue
+			}
+			endpoints = append(endpoints, net.JoinHostPort(host, strconv.Itoa(e.Service.Port)))
+		}
+		return endpoints, nil
+	}
+}
@@ -0,0 +1,121 @@
+// Package discovery resolves backend addresses for gRPC clients, from a
+// static list, DNS, Consul or the Kubernetes Endpoints API, and keeps them
+// up to date as backends come and go.
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/chanduchitikam/task-management-system/pkg/config"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/resolver"
+)
+
+// Resolver schemes registered by Setup, next to gRPC's own dns and
+// passthrough
+const (
+	SchemeStatic     = "static"
+	SchemeConsul     = "consul"
+	SchemeKubernetes = "kubernetes"
+)
+
+var setupOnce sync.Once
+
+// Setup registers the resolvers for cfg with gRPC. It must run before the
+// first dial.
+func Setup(cfg config.DiscoveryConfig) error {
+	if _, err := Target(cfg.Mode, "localhost:0"); err != nil {
+		return err
+	}
+	// outside a cluster the kubernetes scheme is simply unavailable
+	k8s, err := newKubernetesLookup(cfg)
+	if err != nil && cfg.Mode == SchemeKubernetes {
+		return err
+	}
+	setupOnce.Do(func() {
+		resolver.Register(staticBuilder{})
+		resolver.Register(&pollBuilder{scheme: SchemeConsul, interval: cfg.RefreshInterval, lookup: newConsulLookup(cfg)})
+		if k8s != nil {
+			resolver.Register(&pollBuilder{scheme: SchemeKubernetes, interval: cfg.RefreshInterval, lookup: k8s})
+		}
+	})
+	return nil
+}
+
+// Target turns a configured address like "user-service:50051" into a dial
+// target for mode. Addresses that already carry a scheme are kept.
+func Target(mode, addr string) (string, error) {
+	if strings.Contains(addr, "://") || strings.HasPrefix(addr, "unix:") {
+		return addr, nil
+	}
+	switch mode {
+	case "", "dns":
+		return "dns:///" + addr, nil
+	case SchemeStatic, SchemeConsul, SchemeKubernetes:
+		return mode + ":///" + addr, nil
+	}
+	return "", fmt.Errorf("unknown service discovery mode %q", mode)
+}
+
+// DialOptions spread calls over every endpoint a target resolves to
+func DialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithDefaultServiceConfig(`{"loadBalancingConfig": [{"round_robin": {}}]}`),
+	}
+}
+
+// Dial creates a client for the backend at addr, logging its health as
+// it changes
+func Dial(ctx context.Context, name, mode, addr string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	target, err := Target(mode, addr)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := grpc.NewClient(target, append(DialOptions(), opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	go watchState(ctx, name, conn)
+	return conn, nil
+}
+
+// watchState logs a backend's connectivity transitions. Clients created
+// with NewClient stay idle until first used, so the watch connects early
+// to surface a misconfigured backend at startup.
+func watchState(ctx context.Context, name string, conn *grpc.ClientConn) {
+	conn.Connect()
+	state := conn.GetState()
+	for conn.WaitForStateChange(ctx, state) {
+		next := conn.GetState()
+		switch next {
+		case connectivity.Ready:
+			log.Printf("backend %s (%s) is ready", name, conn.Target())
+		case connectivity.TransientFailure:
+			log.Printf("backend %s (%s) is unreachable, retrying", name, conn.Target())
+		case connectivity.Shutdown:
+			return
+		}
+		if next == connectivity.Idle {
+			// idle after a lost connection; reconnect rather than wait for
+			// the next call
+			conn.Connect()
+		}
+		state = next
+	}
+}
+
+// splitHostPort treats a missing port as an error, since every backend is
+// dialed on an explicit one
+func splitHostPort(endpoint string) (string, string, error) {
+	host, port, err := net.SplitHostPort(endpoint)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid endpoint %q: %w", endpoint, err)
+	}
+	return host, port, nil
+}
@@ -0,0 +1,126 @@
+package discovery
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/pkg/config"
+)
+
+// serviceAccountDir holds the credentials Kubernetes mounts into every pod
+const serviceAccountDir = "/var/run/secrets/kubernetes.io/serviceaccount"
+
+type kubernetesEndpoints struct {
+	Subsets []struct {
+		Addresses []struct {
+			IP string `json:"ip"`
+		} `json:"addresses"`
+		Ports []struct {
+			Name string `json:"name"`
+			Port int    `json:"port"`
+		} `json:"ports"`
+	} `json:"subsets"`
+}
+
+// newKubernetesLookup resolves "kubernetes:///user-service:50051", or
+// "user-service.namespace:grpc" with a named port, to the service's ready
+// pods, read with the pod's service account. Going to pods directly, rather
+// than the service's virtual IP, lets calls spread over every replica.
+func newKubernetesLookup(cfg config.DiscoveryConfig) (lookupFunc, error) {
+	host, port := os.Getenv("KUBERNETES_SERVICE_HOST"), os.Getenv("KUBERNETES_SERVICE_PORT")
+	if host == "" || port == "" {
+		return nil, errors.New("not running in a Kubernetes cluster")
+	}
+	caPEM, err := os.ReadFile(serviceAccountDir + "/ca.crt")
+	if err != nil {
+		return nil, fmt.Errorf("reading cluster CA: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return nil, errors.New("no certificates in cluster CA")
+	}
+	namespace := cfg.Namespace
+	if namespace == "" {
+		ns, err := os.ReadFile(serviceAccountDir + "/namespace")
+		if err != nil {
+			return nil, fmt.Errorf("reading pod namespace: %w", err)
+		}
+		namespace = strings.TrimSpace(string(ns))
+	}
+
+	client := &http.Client{
+		Timeout:   10 * time.Second,
+		Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}},
+	}
+	base := "https://" + net.JoinHostPort(host, port)
+
+	return func(ctx context.Context, endpoint string) ([]string, error) {
+		name, wantPort, err := splitHostPort(endpoint)
+		if err != nil {
+			return nil, err
+		}
+		ns := namespace
+		// "service.namespace" and "service.namespace.svc.cluster.local"
+		if parts := strings.Split(name, "."); len(parts) > 1 {
+			name, ns = parts[0], parts[1]
+		}
+		// service account tokens are rotated, so read the current one each time
+		token, err := os.ReadFile(serviceAccountDir + "/token")
+		if err != nil {
+			return nil, fmt.Errorf("reading service account token: %w", err)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+			base+"/api/v1/namespaces/"+url.PathEscape(ns)+"/endpoints/"+url.PathEscape(name), nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(token)))
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("kubernetes API returned %s", resp.Status)
+		}
+
+		var eps kubernetesEndpoints
+		if err := json.NewDecoder(resp.Body).Decode(&eps); err != nil {
+			return nil, fmt.Errorf("invalid endpoints response: %w", err)
+		}
+		var endpoints []string
+		for _, subset := range eps.Subsets {
+			podPort := 0
+			for _, p := range subset.Ports {
+				if p.Name == wantPort || strconv.Itoa(p.Port) == wantPort {
+					podPort = p.Port
+					break
+				}
+			}
+			// a single-port service may map its port to a different one on
+			// the pods
+			if podPort == 0 && len(subset.Ports) == 1 {
+				podPort = subset.Ports[0].Port
+			}
+			if podPort == 0 {
+				continue
+			}
+			for _, a := range subset.Addresses {
+				endpoints = append(endpoints, net.JoinHostPort(a.IP, strconv.Itoa(podPort)))
+			}
+		}
+		return endpoints, nil
+	}, nil
+}
@@ -0,0 +1,157 @@
+package discovery
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/resolver"
+)
+
+// staticBuilder resolves "static:///host1:port,host2:port" to those
+// endpoints, for deployments that list their backends explicitly
+type staticBuilder struct{}
+
+func (staticBuilder) Scheme() string { return SchemeStatic }
+
+func (staticBuilder) Build(target resolver.Target, cc resolver.ClientConn, _ resolver.BuildOptions) (resolver.Resolver, error) {
+	var addrs []resolver.Address
+	for _, endpoint := range strings.Split(target.Endpoint(), ",") {
+		endpoint = strings.TrimSpace(endpoint)
+		if endpoint == "" {
+			continue
+		}
+		if _, _, err := splitHostPort(endpoint); err != nil {
+			return nil, err
+		}
+		addrs = append(addrs, resolver.Address{Addr: endpoint})
+	}
+	if len(addrs) == 0 {
+		return nil, errors.New("static target lists no endpoints")
+	}
+	if err := cc.UpdateState(resolver.State{Addresses: addrs}); err != nil {
+		return nil, err
+	}
+	return nopResolver{}, nil
+}
+
+type nopResolver struct{}
+
+func (nopResolver) ResolveNow(resolver.ResolveNowOptions) {}
+func (nopResolver) Close()                                {}
+
+// lookupFunc returns the current endpoints ("host:port") of a service
+type lookupFunc func(ctx context.Context, endpoint string) ([]string, error)
+
+// minResolveGap spaces out re-resolutions gRPC asks for when connections
+// fail, so a crashing backend does not flood the registry
+const minResolveGap = 5 * time.Second
+
+// pollBuilder resolves targets through a registry lookup, repeated every
+// interval and whenever gRPC loses a connection
+type pollBuilder struct {
+	scheme   string
+	interval time.Duration
+	lookup   lookupFunc
+}
+
+func (b *pollBuilder) Scheme() string { return b.scheme }
+
+func (b *pollBuilder) Build(target resolver.Target, cc resolver.ClientConn, _ resolver.BuildOptions) (resolver.Resolver, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &pollResolver{
+		builder:  b,
+		endpoint: target.Endpoint(),
+		cc:       cc,
+		ctx:      ctx,
+		cancel:   cancel,
+		now:      make(chan struct{}, 1),
+	}
+	r.wg.Add(1)
+	go r.run()
+	return r, nil
+}
+
+type pollResolver struct {
+	builder  *pollBuilder
+	endpoint string
+	cc       resolver.ClientConn
+	ctx      context.Context
+	cancel   context.CancelFunc
+	now      chan struct{}
+	wg       sync.WaitGroup
+	last     string
+}
+
+func (r *pollResolver) ResolveNow(resolver.ResolveNowOptions) {
+	select {
+	case r.now <- struct{}{}:
+	default:
+	}
+}
+
+func (r *pollResolver) Close() {
+	r.cancel()
+	r.wg.Wait()
+}
+
+func (r *pollResolver) run() {
+	defer r.wg.Done()
+	interval := r.builder.interval
+	if interval <= 0 {
+		interval = 30 * time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	var lastResolve time.Time
+	for {
+		r.resolve()
+		lastResolve = time.Now()
+		select {
+		case <-r.ctx.Done():
+			return
+		case <-ticker.C:
+		case <-r.now:
+			if wait := minResolveGap - time.Since(lastResolve); wait > 0 {
+				select {
+				case <-r.ctx.Done():
+					return
+				case <-time.After(wait):
+				}
+			}
+		}
+	}
+}
+
+func (r *pollResolver) resolve() {
+	ctx, cancel := context.WithTimeout(r.ctx, 10*time.Second)
+	defer cancel()
+	endpoints, err := r.builder.lookup(ctx, r.endpoint)
+	if err == nil && len(endpoints) == 0 {
+		err = errors.New("no healthy endpoints")
+	}
+	if err != nil {
+		if r.ctx.Err() != nil {
+			return
+		}
+		// keeps the previous endpoints, if any, in use
+		log.Printf("failed to resolve %s:///%s: %v", r.builder.scheme, r.endpoint, err)
+		r.cc.ReportError(err)
+		return
+	}
+
+	addrs := make([]resolver.Address, len(endpoints))
+	for i, e := range endpoints {
+		addrs[i] = resolver.Address{Addr: e}
+	}
+	if joined := strings.Join(endpoints, ","); joined != r.last {
+		log.Printf("%s:///%s resolved to %s", r.builder.scheme, r.endpoint, joined)
+		r.last = joined
+	}
+	if err := r.cc.UpdateState(resolver.State{Addresses: addrs}); err != nil {
+		log.Printf("failed to apply endpoints of %s:///%s: %v", r.builder.scheme, r.endpoint, err)
+	}
+}
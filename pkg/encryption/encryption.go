@@ -0,0 +1,147 @@
+// Package encryption seals sensitive column values with AES-256-GCM before
+// they are written to the database.
+//
+// Sealed values look like "enc:v1:<key id>:<base64 nonce+ciphertext>", so
+// rows written before encryption was enabled can be told apart and are
+// returned unchanged by Open until they are re-sealed.
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/chanduchitikam/task-management-system/pkg/config"
+)
+
+const sealedPrefix = "enc:v1:"
+
+// ErrUnknownKey is returned when a value was sealed with a key the keyring
+// does not hold
+var ErrUnknownKey = errors.New("encryption: value sealed with an unknown key")
+
+// Keyring seals values with its primary key and opens values sealed with the
+// primary or any previous key, so keys can be rotated without downtime. A nil
+// Keyring stores values in plaintext.
+type Keyring struct {
+	primary string
+	keys    map[string]cipher.AEAD
+}
+
+// NewKeyring builds a keyring from base64-encoded 32-byte keys. Previous keys
+// are only used to open values sealed before a rotation.
+func NewKeyring(primary string, previous ...string) (*Keyring, error) {
+	k := &Keyring{keys: make(map[string]cipher.AEAD, len(previous)+1)}
+	id, err := k.add(primary)
+	if err != nil {
+		return nil, fmt.Errorf("encryption key: %w", err)
+	}
+	k.primary = id
+	for i, p := range previous {
+		if strings.TrimSpace(p) == "" {
+			continue
+		}
+		if _, err := k.add(p); err != nil {
+			return nil, fmt.Errorf("previous encryption key %d: %w", i+1, err)
+		}
+	}
+	return k, nil
+}
+
+// FromConfig builds the keyring configured for the service. It returns nil
+// when no key is configured, leaving values in plaintext.
+func FromConfig(cfg config.EncryptionConfig) (*Keyring, error) {
+	if cfg.Key == "" {
+		return nil, nil
+	}
+	return NewKeyring(cfg.Key, cfg.PreviousKeys...)
+}
+
+func (k *Keyring) add(encoded string) (string, error) {
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return "", errors.New("not valid base64")
+	}
+	if len(raw) != 32 {
+		return "", fmt.Errorf("must be 32 bytes, got %d", len(raw))
+	}
+	block, err := aes.NewCipher(raw)
+	if err != nil {
+		return "", err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(raw)
+	id := hex.EncodeToString(sum[:4])
+	k.keys[id] = aead
+	return id, nil
+}
+
+// IsSealed reports whether value was produced by Seal
+func IsSealed(value string) bool {
+	return strings.HasPrefix(value, sealedPrefix)
+}
+
+// Seal encrypts plaintext with the primary key. binding ties the value to
+// where it is stored (such as the table, column and row id), so a sealed
+// value copied to another row fails to open. Empty values are left empty.
+func (k *Keyring) Seal(plaintext, binding string) (string, error) {
+	if k == nil || plaintext == "" {
+		return plaintext, nil
+	}
+	aead := k.keys[k.primary]
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := aead.Seal(nonce, nonce, []byte(plaintext), []byte(binding))
+	return sealedPrefix + k.primary + ":" + base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+// Open decrypts a value produced by Seal with the same binding. Values that
+// were never sealed are returned as they are.
+func (k *Keyring) Open(value, binding string) (string, error) {
+	if !IsSealed(value) {
+		return value, nil
+	}
+	if k == nil {
+		return "", errors.New("encryption: value is sealed but no key is configured")
+	}
+	id, data, ok := strings.Cut(strings.TrimPrefix(value, sealedPrefix), ":")
+	if !ok {
+		return "", errors.New("encryption: malformed sealed value")
+	}
+	aead, found := k.keys[id]
+	if !found {
+		return "", ErrUnknownKey
+	}
+	raw, err := base64.RawStdEncoding.DecodeString(data)
+	if err != nil || len(raw) < aead.NonceSize() {
+		return "", errors.New("encryption: malformed sealed value")
+	}
+	plaintext, err := aead.Open(nil, raw[:aead.NonceSize()], raw[aead.NonceSize():], []byte(binding))
+	if err != nil {
+		return "", errors.New("encryption: value failed authentication")
+	}
+	return string(plaintext), nil
+}
+
+// NeedsReseal reports whether value is plaintext or sealed with a key other
+// than the primary one
+func (k *Keyring) NeedsReseal(value string) bool {
+	if k == nil || value == "" {
+		return false
+	}
+	if !IsSealed(value) {
+		return true
+	}
+	return !strings.HasPrefix(value, sealedPrefix+k.primary+":")
+}
@@ -0,0 +1,80 @@
+package encryption
+
+import (
+	"context"
+	"log"
+
+	"gorm.io/gorm"
+)
+
+const resealBatchSize = 200
+
+// Column identifies a sealed column. Values are bound to
+// "<table>.<column>:<scope column value>".
+type Column struct {
+	Table  string
+	Column string
+	// Scope is the column binding each value to its row, usually "id"
+	Scope string
+}
+
+// Binding returns the binding a value in c is sealed with
+func (c Column) Binding(scope string) string {
+	return c.Table + "." + c.Column + ":" + scope
+}
+
+// Reseal seals every plaintext value in c, and re-seals values sealed with a
+// previous key, in batches. It is the migration path for rows written before
+// encryption was enabled or before a key rotation, and is safe to re-run or
+// to run on several replicas at once. It returns how many rows it changed.
+func (k *Keyring) Reseal(ctx context.Context, db *gorm.DB, c Column) (int, error) {
+	if k == nil {
+		return 0, nil
+	}
+	changed := 0
+	last := ""
+	for {
+		var rows []struct {
+			ID    string
+			Scope string
+			Value string
+		}
+		q := db.WithContext(ctx).Table(c.Table).
+			Select("id, " + c.Scope + " AS scope, " + c.Column + " AS value").
+			Where(c.Column + " IS NOT NULL AND " + c.Column + " <> ''")
+		if last != "" {
+			q = q.Where("id > ?", last)
+		}
+		if err := q.Order("id").Limit(resealBatchSize).Scan(&rows).Error; err != nil {
+			return changed, err
+		}
+
+		for _, r := range rows {
+			last = r.ID
+			if !k.NeedsReseal(r.Value) {
+				continue
+			}
+			binding := c.Binding(r.Scope)
+			plain, err := k.Open(r.Value, binding)
+			if err != nil {
+				log.Printf("warning: cannot reseal %s.%s for row %s: %v", c.Table, c.Column, r.ID, err)
+				continue
+			}
+			sealed, err := k.Seal(plain, binding)
+			if err != nil {
+				return changed, err
+			}
+			// only replace the value we read, in case it changed meanwhile
+			res := db.WithContext(ctx).Table(c.Table).
+				Where("id = ? AND "+c.Column+" = ?", r.ID, r.Value).
+				UpdateColumn(c.Column, sealed)
+			if res.Error != nil {
+				return changed, res.Error
+			}
+			changed += int(res.RowsAffected)
+		}
+		if len(rows) < resealBatchSize {
+			return changed, nil
+		}
+	}
+}
@@ -59,7 +59,15 @@ var (
 			Name: "notifications_sent_total",
 			Help: "Total number of notifications sent",
 		},
-		[]string{"type", "status"},
+		[]string{"type", "channel", "status"},
+	)
+
+	// NotificationDLQDepth tracks notifications waiting in the dead letter queue
+	NotificationDLQDepth = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "notification_dlq_depth",
+			Help: "Number of notifications in the dead letter queue",
+		},
 	)
 
 	// 	// 	// ActiveSubscribers tracks active notification subscribers
@@ -69,4 +77,13 @@ var (
 			Help: "Number of active notification subscribers",
 		},
 	)
+
+	// CircuitBreakerOpen is 1 while the gateway fails calls to a backend fast
+	CircuitBreakerOpen = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "gateway_circuit_breaker_open",
+			Help: "Whether the gateway's circuit breaker for a backend is open",
+		},
+		[]string{"backend"},
+	)
 )
@@ -0,0 +1,168 @@
+package webauthn
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// cborMap is a CBOR map whose entries are encoded in order, as
+// authenticators write them
+type cborMap []cborPair
+
+type cborPair struct {
+	key, value interface{}
+}
+
+// encodeCBOR encodes the values the tests build authenticator responses
+// from, with the shortest argument encoding
+func encodeCBOR(v interface{}) []byte {
+	switch v := v.(type) {
+	case int:
+		return encodeCBOR(int64(v))
+	case int64:
+		if v < 0 {
+			return cborHead(1, uint64(-1-v))
+		}
+		return cborHead(0, uint64(v))
+	case []byte:
+		return append(cborHead(2, uint64(len(v))), v...)
+	case string:
+		return append(cborHead(3, uint64(len(v))), v...)
+	case []interface{}:
+		out := cborHead(4, uint64(len(v)))
+		for _, item := range v {
+			out = append(out, encodeCBOR(item)...)
+		}
+		return out
+	case cborMap:
+		out := cborHead(5, uint64(len(v)))
+		for _, p := range v {
+			out = append(out, encodeCBOR(p.key)...)
+			out = append(out, encodeCBOR(p.value)...)
+		}
+		return out
+	}
+	panic("encodeCBOR: unsupported value")
+}
+
+func cborHead(major byte, n uint64) []byte {
+	switch {
+	case n < 24:
+		return []byte{major<<5 | byte(n)}
+	case n <= 0xff:
+		return []byte{major<<5 | 24, byte(n)}
+	case n <= 0xffff:
+		return binary.BigEndian.AppendUint16([]byte{major<<5 | 25}, uint16(n))
+	case n <= 0xffffffff:
+		return binary.BigEndian.AppendUint32([]byte{major<<5 | 26}, uint32(n))
+	}
+	return binary.BigEndian.AppendUint64([]byte{major<<5 | 27}, n)
+}
+
+// nestedArrays returns depth single-item arrays around a zero
+func nestedArrays(depth int) []byte {
+	return append(bytes.Repeat([]byte{0x81}, depth), 0x00)
+}
+
+func TestDecodeCBOR(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		want interface{}
+		rest []byte
+	}{
+		{"small uint", []byte{0x17}, int64(23), nil},
+		{"one byte uint", []byte{0x18, 0xff}, int64(255), nil},
+		{"two byte uint", []byte{0x19, 0x01, 0x00}, int64(256), nil},
+		{"four byte uint", []byte{0x1a, 0x00, 0x01, 0x00, 0x00}, int64(65536), nil},
+		{"eight byte uint", []byte{0x1b, 0x7f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, int64(1<<63 - 1), nil},
+		{"negative int", []byte{0x26}, int64(-7), nil},
+		{"two byte negative int", []byte{0x39, 0x01, 0x00}, int64(-257), nil},
+		{"byte string", []byte{0x43, 1, 2, 3}, []byte{1, 2, 3}, nil},
+		{"empty byte string", []byte{0x40}, []byte(nil), nil},
+		{"text string", []byte{0x64, 'n', 'o', 'n', 'e'}, "none", nil},
+		{"array", []byte{0x82, 0x01, 0x20}, []interface{}{int64(1), int64(-1)}, nil},
+		{"map with int and text keys", []byte{0xa2, 0x01, 0x02, 0x61, 'a', 0x41, 0x00},
+			map[interface{}]interface{}{int64(1): int64(2), "a": []byte{0}}, nil},
+		{"tag is skipped", []byte{0xc2, 0x41, 0x05}, []byte{5}, nil},
+		{"false", []byte{0xf4}, false, nil},
+		{"true", []byte{0xf5}, true, nil},
+		{"null", []byte{0xf6}, nil, nil},
+		{"float is skipped", []byte{0xfa, 0x3f, 0x80, 0x00, 0x00}, nil, nil},
+		{"trailing bytes are returned", []byte{0x01, 0x02, 0x03}, int64(1), []byte{0x02, 0x03}},
+		{"nesting at the limit", nestedArrays(maxCBORDepth), func() interface{} {
+			var v interface{} = int64(0)
+			for i := 0; i < maxCBORDepth; i++ {
+				v = []interface{}{v}
+			}
+			return v
+		}(), nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, rest, err := decodeCBOR(tt.data)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, len(tt.rest), len(rest))
+			if len(tt.rest) > 0 {
+				assert.Equal(t, tt.rest, rest)
+			}
+		})
+	}
+}
+
+func TestDecodeCBORMalformed(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"empty", nil},
+		{"truncated one byte argument", []byte{0x18}},
+		{"truncated two byte argument", []byte{0x19, 0x01}},
+		{"truncated four byte argument", []byte{0x1a, 0x00, 0x01}},
+		{"truncated eight byte argument", []byte{0x1b, 0x00, 0x00, 0x00, 0x00}},
+		{"reserved argument", []byte{0x1c}},
+		{"indefinite length byte string", []byte{0x5f, 0x41, 0x00, 0xff}},
+		{"uint overflows int64", []byte{0x1b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
+		{"negative int overflows int64", []byte{0x3b, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}},
+		{"byte string longer than data", []byte{0x45, 1, 2}},
+		{"byte string with oversized length", []byte{0x5b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00}},
+		{"text string longer than data", []byte{0x63, 'a'}},
+		{"array longer than data", []byte{0x83, 0x01}},
+		{"array with oversized length", []byte{0x9b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00}},
+		{"array item truncated", []byte{0x82, 0x01, 0x18}},
+		{"map with oversized length", []byte{0xba, 0xff, 0xff, 0xff, 0xfe, 0x01, 0x02}},
+		{"map missing value", []byte{0xa1, 0x01}},
+		{"map with byte string key", []byte{0xa1, 0x41, 0x00, 0x01}},
+		{"map with array key", []byte{0xa1, 0x80, 0x01}},
+		{"truncated float", []byte{0xfb, 0x00, 0x00}},
+		{"unassigned simple value", []byte{0xf0}},
+		{"tag without item", []byte{0xc2}},
+		{"nesting past the limit", nestedArrays(maxCBORDepth + 1)},
+		{"deeply nested maps", append(bytes.Repeat([]byte{0xa1, 0x01}, maxCBORDepth+1), 0x00)},
+		{"deeply nested tags", append(bytes.Repeat([]byte{0xc2}, maxCBORDepth+1), 0x00)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := decodeCBOR(tt.data)
+			assert.ErrorIs(t, err, errCBOR)
+		})
+	}
+}
+
+func TestEncodeCBORRoundTrip(t *testing.T) {
+	// the encoder the webauthn tests build fixtures with
+	value := cborMap{{int64(1), int64(2)}, {"key", []byte{1, 2, 3}}, {int64(-1), []interface{}{int64(300), "x"}}}
+	got, rest, err := decodeCBOR(encodeCBOR(value))
+	require.NoError(t, err)
+	assert.Empty(t, rest)
+	assert.Equal(t, map[interface{}]interface{}{
+		int64(1):  int64(2),
+		"key":     []byte{1, 2, 3},
+		int64(-1): []interface{}{int64(300), "x"},
+	}, got)
+}
@@ -0,0 +1,316 @@
+// Package webauthn verifies WebAuthn (passkey) registration and sign-in
+// ceremonies for a relying party.
+//
+// Only what a relying party needs to trust a credential is implemented.
+// Attestation statements are not verified, as with "none" attestation
+// conveyance, so any authenticator may register. Credentials with ES256,
+// EdDSA or RS256 keys are accepted.
+package webauthn
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/chanduchitikam/task-management-system/pkg/config"
+)
+
+// Authenticator data flags
+const (
+	flagUserPresent    = 0x01
+	flagUserVerified   = 0x04
+	flagBackupEligible = 0x08
+	flagAttested       = 0x40
+)
+
+var (
+	ErrMalformed        = errors.New("webauthn: malformed authenticator response")
+	ErrChallenge        = errors.New("webauthn: challenge mismatch")
+	ErrOrigin           = errors.New("webauthn: origin not allowed")
+	ErrRelyingParty     = errors.New("webauthn: credential is for another site")
+	ErrUserPresence     = errors.New("webauthn: user was not present")
+	ErrUserVerification = errors.New("webauthn: user was not verified")
+	ErrUnsupportedKey   = errors.New("webauthn: unsupported credential key")
+	ErrSignature        = errors.New("webauthn: signature verification failed")
+	// ErrSignCount means the authenticator's counter went backwards, which
+	// suggests the credential was cloned
+	ErrSignCount = errors.New("webauthn: signature counter did not increase")
+)
+
+// RelyingParty is the site credentials are registered to
+type RelyingParty struct {
+	// ID is the site's domain, such as "taskflow.example.com"
+	ID   string
+	Name string
+	// Origins the browser may report; defaults to https://<ID>
+	Origins []string
+}
+
+// FromConfig returns the configured relying party, or nil when passkeys
+// are not configured
+func FromConfig(cfg config.WebAuthnConfig) *RelyingParty {
+	if cfg.RPID == "" {
+		return nil
+	}
+	rp := &RelyingParty{ID: cfg.RPID, Name: cfg.RPName, Origins: cfg.Origins}
+	if rp.Name == "" {
+		rp.Name = rp.ID
+	}
+	if len(rp.Origins) == 0 {
+		rp.Origins = []string{"https://" + rp.ID}
+	}
+	return rp
+}
+
+// Credential is a newly registered public key credential
+type Credential struct {
+	ID []byte
+	// PublicKey is the credential's COSE key, as passed to VerifyAssertion
+	PublicKey      []byte
+	SignCount      uint32
+	AAGUID         []byte
+	BackupEligible bool
+}
+
+// Assertion is an authenticator's response to a sign-in request
+type Assertion struct {
+	ClientDataJSON    []byte
+	AuthenticatorData []byte
+	Signature         []byte
+}
+
+// NewChallenge returns a random ceremony challenge, base64url encoded
+func NewChallenge() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return Encode(b), nil
+}
+
+// Encode encodes binary values the way browsers serialise them
+func Encode(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// Decode accepts base64url with or without padding
+func Decode(s string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+}
+
+// VerifyRegistration checks a navigator.credentials.create() response to
+// challenge and returns the credential to store
+func (rp *RelyingParty) VerifyRegistration(challenge string, clientDataJSON, attestationObject []byte, requireUV bool) (*Credential, error) {
+	if err := rp.verifyClientData(clientDataJSON, "webauthn.create", challenge); err != nil {
+		return nil, err
+	}
+	obj, _, err := decodeCBOR(attestationObject)
+	if err != nil {
+		return nil, ErrMalformed
+	}
+	m, _ := obj.(map[interface{}]interface{})
+	authData, _ := m["authData"].([]byte)
+	if authData == nil {
+		return nil, ErrMalformed
+	}
+	flags, count, rest, err := rp.verifyAuthData(authData, requireUV)
+	if err != nil {
+		return nil, err
+	}
+	if flags&flagAttested == 0 || len(rest) < 18 {
+		return nil, ErrMalformed
+	}
+	aaguid := rest[:16]
+	idLen := int(binary.BigEndian.Uint16(rest[16:18]))
+	rest = rest[18:]
+	if idLen == 0 || idLen > 1023 || len(rest) < idLen {
+		return nil, ErrMalformed
+	}
+	id := rest[:idLen]
+	rest = rest[idLen:]
+	// the COSE key may be followed by extension data
+	_, extensions, err := decodeCBOR(rest)
+	if err != nil {
+		return nil, ErrMalformed
+	}
+	coseKey := rest[:len(rest)-len(extensions)]
+	if _, err := parsePublicKey(coseKey); err != nil {
+		return nil, err
+	}
+	return &Credential{
+		ID:             append([]byte(nil), id...),
+		PublicKey:      append([]byte(nil), coseKey...),
+		SignCount:      count,
+		AAGUID:         append([]byte(nil), aaguid...),
+		BackupEligible: flags&flagBackupEligible != 0,
+	}, nil
+}
+
+// VerifyAssertion checks a navigator.credentials.get() response to
+// challenge made with the credential whose COSE key is publicKey and whose
+// last seen counter is signCount. It returns the new counter to store.
+func (rp *RelyingParty) VerifyAssertion(challenge string, publicKey []byte, signCount uint32, a Assertion, requireUV bool) (uint32, error) {
+	if err := rp.verifyClientData(a.ClientDataJSON, "webauthn.get", challenge); err != nil {
+		return 0, err
+	}
+	_, count, _, err := rp.verifyAuthData(a.AuthenticatorData, requireUV)
+	if err != nil {
+		return 0, err
+	}
+	key, err := parsePublicKey(publicKey)
+	if err != nil {
+		return 0, err
+	}
+	clientHash := sha256.Sum256(a.ClientDataJSON)
+	signed := append(append([]byte(nil), a.AuthenticatorData...), clientHash[:]...)
+	if !key.verify(signed, a.Signature) {
+		return 0, ErrSignature
+	}
+	// authenticators without a counter always report zero
+	if (count != 0 || signCount != 0) && count <= signCount {
+		return 0, ErrSignCount
+	}
+	return count, nil
+}
+
+func (rp *RelyingParty) verifyClientData(raw []byte, ceremony, challenge string) error {
+	var cd struct {
+		Type        string `json:"type"`
+		Challenge   string `json:"challenge"`
+		Origin      string `json:"origin"`
+		CrossOrigin bool   `json:"crossOrigin"`
+	}
+	if err := json.Unmarshal(raw, &cd); err != nil || cd.Type != ceremony {
+		return ErrMalformed
+	}
+	got, err := Decode(cd.Challenge)
+	want, _ := Decode(challenge)
+	if err != nil || len(want) == 0 || subtle.ConstantTimeCompare(got, want) != 1 {
+		return ErrChallenge
+	}
+	if cd.CrossOrigin {
+		return ErrOrigin
+	}
+	for _, o := range rp.Origins {
+		if cd.Origin == o {
+			return nil
+		}
+	}
+	return ErrOrigin
+}
+
+// verifyAuthData checks the relying party and flags of authenticator data
+// and returns its flags, counter and the bytes after the fixed header
+func (rp *RelyingParty) verifyAuthData(data []byte, requireUV bool) (byte, uint32, []byte, error) {
+	if len(data) < 37 {
+		return 0, 0, nil, ErrMalformed
+	}
+	rpHash := sha256.Sum256([]byte(rp.ID))
+	if !bytes.Equal(data[:32], rpHash[:]) {
+		return 0, 0, nil, ErrRelyingParty
+	}
+	flags := data[32]
+	if flags&flagUserPresent == 0 {
+		return 0, 0, nil, ErrUserPresence
+	}
+	if requireUV && flags&flagUserVerified == 0 {
+		return 0, 0, nil, ErrUserVerification
+	}
+	return flags, binary.BigEndian.Uint32(data[33:37]), data[37:], nil
+}
+
+type publicKey struct {
+	verify func(signed, sig []byte) bool
+}
+
+// COSE key parameters (RFC 9053)
+const (
+	coseKty = 1
+	coseAlg = 3
+	coseCrv = -1
+	coseX   = -2
+	coseY   = -3
+	coseN   = -1
+	coseE   = -2
+
+	ktyOKP = 1
+	ktyEC2 = 2
+	ktyRSA = 3
+
+	algES256 = -7
+	algEdDSA = -8
+	algRS256 = -257
+)
+
+// parsePublicKey reads a COSE key the package can verify signatures with
+func parsePublicKey(cose []byte) (*publicKey, error) {
+	obj, rest, err := decodeCBOR(cose)
+	if err != nil || len(rest) != 0 {
+		return nil, ErrMalformed
+	}
+	m, ok := obj.(map[interface{}]interface{})
+	if !ok {
+		return nil, ErrMalformed
+	}
+	param := func(k int64) []byte {
+		b, _ := m[k].([]byte)
+		return b
+	}
+	kty, _ := m[int64(coseKty)].(int64)
+	alg, _ := m[int64(coseAlg)].(int64)
+	crv, _ := m[int64(coseCrv)].(int64)
+
+	switch {
+	case kty == ktyEC2 && alg == algES256 && crv == 1:
+		x, y := param(coseX), param(coseY)
+		if len(x) != 32 || len(y) != 32 {
+			return nil, ErrUnsupportedKey
+		}
+		// rejects points that are not on the curve
+		if _, err := ecdh.P256().NewPublicKey(append(append([]byte{4}, x...), y...)); err != nil {
+			return nil, ErrUnsupportedKey
+		}
+		pub := &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		return &publicKey{verify: func(signed, sig []byte) bool {
+			h := sha256.Sum256(signed)
+			return ecdsa.VerifyASN1(pub, h[:], sig)
+		}}, nil
+	case kty == ktyOKP && alg == algEdDSA && crv == 6:
+		x := param(coseX)
+		if len(x) != ed25519.PublicKeySize {
+			return nil, ErrUnsupportedKey
+		}
+		pub := ed25519.PublicKey(x)
+		return &publicKey{verify: func(signed, sig []byte) bool {
+			return ed25519.Verify(pub, signed, sig)
+		}}, nil
+	case kty == ktyRSA && alg == algRS256:
+		n, e := param(coseN), param(coseE)
+		if len(n) < 256 || len(e) == 0 || len(e) > 4 {
+			return nil, ErrUnsupportedKey
+		}
+		exp := 0
+		for _, b := range e {
+			exp = exp<<8 | int(b)
+		}
+		pub := &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: exp}
+		return &publicKey{verify: func(signed, sig []byte) bool {
+			h := sha256.Sum256(signed)
+			return rsa.VerifyPKCS1v15(pub, crypto.SHA256, h[:], sig) == nil
+		}}, nil
+	}
+	return nil, ErrUnsupportedKey
+}
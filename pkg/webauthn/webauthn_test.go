@@ -0,0 +1,472 @@
+package webauthn
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRPID      = "taskflow.example.com"
+	testOrigin    = "https://taskflow.example.com"
+	testChallenge = "dGFza2Zsb3ctdGVzdC1jaGFsbGVuZ2UtMzItYnl0ZXM"
+)
+
+var testRP = &RelyingParty{ID: testRPID, Name: "Taskflow", Origins: []string{testOrigin}}
+
+// testAuthenticator stands in for a passkey: it holds a real key pair and
+// builds the responses a browser would return
+type testAuthenticator struct {
+	credentialID []byte
+	aaguid       []byte
+	cose         []byte
+	sign         func(data []byte) []byte
+}
+
+var (
+	rsaKeyOnce sync.Once
+	rsaKey     *rsa.PrivateKey
+)
+
+func newTestAuthenticator(t *testing.T, alg int64) *testAuthenticator {
+	t.Helper()
+	a := &testAuthenticator{
+		credentialID: []byte("credential-" + algName(alg)),
+		aaguid:       []byte("0123456789abcdef"),
+	}
+	switch alg {
+	case algES256:
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		a.cose = encodeCBOR(cborMap{
+			{coseKty, ktyEC2}, {coseAlg, algES256}, {coseCrv, 1},
+			{coseX, key.X.FillBytes(make([]byte, 32))}, {coseY, key.Y.FillBytes(make([]byte, 32))},
+		})
+		a.sign = func(data []byte) []byte {
+			h := sha256.Sum256(data)
+			sig, err := ecdsa.SignASN1(rand.Reader, key, h[:])
+			require.NoError(t, err)
+			return sig
+		}
+	case algEdDSA:
+		pub, priv, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		a.cose = encodeCBOR(cborMap{{coseKty, ktyOKP}, {coseAlg, algEdDSA}, {coseCrv, 6}, {coseX, []byte(pub)}})
+		a.sign = func(data []byte) []byte {
+			return ed25519.Sign(priv, data)
+		}
+	case algRS256:
+		// generating RSA keys is slow; the tests share one
+		rsaKeyOnce.Do(func() {
+			var err error
+			rsaKey, err = rsa.GenerateKey(rand.Reader, 2048)
+			require.NoError(t, err)
+		})
+		e := binary.BigEndian.AppendUint32(nil, uint32(rsaKey.E))[1:]
+		a.cose = encodeCBOR(cborMap{{coseKty, ktyRSA}, {coseAlg, algRS256}, {coseN, rsaKey.N.Bytes()}, {coseE, e}})
+		a.sign = func(data []byte) []byte {
+			h := sha256.Sum256(data)
+			sig, err := rsa.SignPKCS1v15(rand.Reader, rsaKey, crypto.SHA256, h[:])
+			require.NoError(t, err)
+			return sig
+		}
+	default:
+		t.Fatalf("unsupported algorithm %d", alg)
+	}
+	return a
+}
+
+func algName(alg int64) string {
+	switch alg {
+	case algES256:
+		return "ES256"
+	case algEdDSA:
+		return "EdDSA"
+	case algRS256:
+		return "RS256"
+	}
+	return "unknown"
+}
+
+// clientData is the browser's collected client data
+type clientData struct {
+	Type        string `json:"type"`
+	Challenge   string `json:"challenge"`
+	Origin      string `json:"origin"`
+	CrossOrigin bool   `json:"crossOrigin,omitempty"`
+}
+
+func (cd clientData) json(t *testing.T) []byte {
+	t.Helper()
+	raw, err := json.Marshal(cd)
+	require.NoError(t, err)
+	return raw
+}
+
+// authData builds authenticator data for rpID; attested is appended after
+// the fixed header
+func authData(rpID string, flags byte, count uint32, attested []byte) []byte {
+	rpHash := sha256.Sum256([]byte(rpID))
+	data := append(rpHash[:], flags)
+	data = binary.BigEndian.AppendUint32(data, count)
+	return append(data, attested...)
+}
+
+// attestedCredential is the attested credential data of a registration
+func (a *testAuthenticator) attestedCredential() []byte {
+	data := append([]byte(nil), a.aaguid...)
+	data = binary.BigEndian.AppendUint16(data, uint16(len(a.credentialID)))
+	data = append(data, a.credentialID...)
+	return append(data, a.cose...)
+}
+
+// attestationObject wraps authenticator data in a "none" attestation
+func attestationObject(authData []byte) []byte {
+	return encodeCBOR(cborMap{{"fmt", "none"}, {"attStmt", cborMap{}}, {"authData", authData}})
+}
+
+// assert signs authenticator data and client data the way an authenticator
+// answers navigator.credentials.get()
+func (a *testAuthenticator) assert(t *testing.T, authenticatorData []byte, cd clientData) Assertion {
+	t.Helper()
+	raw := cd.json(t)
+	clientHash := sha256.Sum256(raw)
+	return Assertion{
+		ClientDataJSON:    raw,
+		AuthenticatorData: authenticatorData,
+		Signature:         a.sign(append(append([]byte(nil), authenticatorData...), clientHash[:]...)),
+	}
+}
+
+var testAlgorithms = []int64{algES256, algEdDSA, algRS256}
+
+func TestVerifyRegistration(t *testing.T) {
+	for _, alg := range testAlgorithms {
+		t.Run(algName(alg), func(t *testing.T) {
+			a := newTestAuthenticator(t, alg)
+			cd := clientData{Type: "webauthn.create", Challenge: testChallenge, Origin: testOrigin}
+			data := authData(testRPID, flagUserPresent|flagUserVerified|flagBackupEligible|flagAttested, 7, a.attestedCredential())
+
+			cred, err := testRP.VerifyRegistration(testChallenge, cd.json(t), attestationObject(data), true)
+			require.NoError(t, err)
+			assert.Equal(t, a.credentialID, cred.ID)
+			assert.Equal(t, a.cose, cred.PublicKey)
+			assert.Equal(t, uint32(7), cred.SignCount)
+			assert.Equal(t, a.aaguid, cred.AAGUID)
+			assert.True(t, cred.BackupEligible)
+
+			// the stored key verifies the credential's assertions
+			get := clientData{Type: "webauthn.get", Challenge: testChallenge, Origin: testOrigin}
+			count, err := testRP.VerifyAssertion(testChallenge, cred.PublicKey, cred.SignCount,
+				a.assert(t, authData(testRPID, flagUserPresent, 8, nil), get), false)
+			require.NoError(t, err)
+			assert.Equal(t, uint32(8), count)
+		})
+	}
+}
+
+func TestVerifyRegistrationRejects(t *testing.T) {
+	a := newTestAuthenticator(t, algES256)
+	valid := clientData{Type: "webauthn.create", Challenge: testChallenge, Origin: testOrigin}
+	const flags = flagUserPresent | flagAttested
+
+	withCOSE := func(cose []byte) []byte {
+		b := *a
+		b.cose = cose
+		return b.attestedCredential()
+	}
+
+	tests := []struct {
+		name              string
+		clientData        clientData
+		attestationObject []byte
+		requireUV         bool
+		wantErr           error
+	}{
+		{
+			name:              "assertion client data",
+			clientData:        clientData{Type: "webauthn.get", Challenge: testChallenge, Origin: testOrigin},
+			attestationObject: attestationObject(authData(testRPID, flags, 0, a.attestedCredential())),
+			wantErr:           ErrMalformed,
+		},
+		{
+			name:              "wrong challenge",
+			clientData:        clientData{Type: "webauthn.create", Challenge: Encode([]byte("another challenge")), Origin: testOrigin},
+			attestationObject: attestationObject(authData(testRPID, flags, 0, a.attestedCredential())),
+			wantErr:           ErrChallenge,
+		},
+		{
+			name:              "wrong origin",
+			clientData:        clientData{Type: "webauthn.create", Challenge: testChallenge, Origin: "https://evil.example.com"},
+			attestationObject: attestationObject(authData(testRPID, flags, 0, a.attestedCredential())),
+			wantErr:           ErrOrigin,
+		},
+		{
+			name:              "cross origin",
+			clientData:        clientData{Type: "webauthn.create", Challenge: testChallenge, Origin: testOrigin, CrossOrigin: true},
+			attestationObject: attestationObject(authData(testRPID, flags, 0, a.attestedCredential())),
+			wantErr:           ErrOrigin,
+		},
+		{
+			name:              "wrong rpIdHash",
+			clientData:        valid,
+			attestationObject: attestationObject(authData("evil.example.com", flags, 0, a.attestedCredential())),
+			wantErr:           ErrRelyingParty,
+		},
+		{
+			name:              "user not present",
+			clientData:        valid,
+			attestationObject: attestationObject(authData(testRPID, flagAttested, 0, a.attestedCredential())),
+			wantErr:           ErrUserPresence,
+		},
+		{
+			name:              "user not verified",
+			clientData:        valid,
+			attestationObject: attestationObject(authData(testRPID, flags, 0, a.attestedCredential())),
+			requireUV:         true,
+			wantErr:           ErrUserVerification,
+		},
+		{
+			name:              "no attested credential",
+			clientData:        valid,
+			attestationObject: attestationObject(authData(testRPID, flagUserPresent, 0, a.attestedCredential())),
+			wantErr:           ErrMalformed,
+		},
+		{
+			name:              "truncated authenticator data",
+			clientData:        valid,
+			attestationObject: attestationObject(authData(testRPID, flags, 0, nil)[:36]),
+			wantErr:           ErrMalformed,
+		},
+		{
+			name:              "credential id longer than data",
+			clientData:        valid,
+			attestationObject: attestationObject(authData(testRPID, flags, 0, append(append([]byte(nil), a.aaguid...), 0x03, 0xff))),
+			wantErr:           ErrMalformed,
+		},
+		{
+			name:              "truncated COSE key",
+			clientData:        valid,
+			attestationObject: attestationObject(authData(testRPID, flags, 0, withCOSE(a.cose[:len(a.cose)-1]))),
+			wantErr:           ErrMalformed,
+		},
+		{
+			name:              "unsupported COSE key",
+			clientData:        valid,
+			attestationObject: attestationObject(authData(testRPID, flags, 0, withCOSE(encodeCBOR(cborMap{{coseKty, ktyEC2}, {coseAlg, -35}})))),
+			wantErr:           ErrUnsupportedKey,
+		},
+		{
+			name:              "attestation object is not a map",
+			clientData:        valid,
+			attestationObject: encodeCBOR([]interface{}{authData(testRPID, flags, 0, a.attestedCredential())}),
+			wantErr:           ErrMalformed,
+		},
+		{
+			name:              "malformed attestation object",
+			clientData:        valid,
+			attestationObject: []byte{0xa1, 0x68},
+			wantErr:           ErrMalformed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := testRP.VerifyRegistration(testChallenge, tt.clientData.json(t), tt.attestationObject, tt.requireUV)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestVerifyRegistrationExtensions(t *testing.T) {
+	// extension data after the key is not part of the stored key
+	a := newTestAuthenticator(t, algEdDSA)
+	cd := clientData{Type: "webauthn.create", Challenge: testChallenge, Origin: testOrigin}
+	attested := append(a.attestedCredential(), encodeCBOR(cborMap{{"credProtect", 2}})...)
+
+	cred, err := testRP.VerifyRegistration(testChallenge, cd.json(t), attestationObject(authData(testRPID, flagUserPresent|flagAttested|0x80, 0, attested)), false)
+	require.NoError(t, err)
+	assert.Equal(t, a.cose, cred.PublicKey)
+}
+
+func TestVerifyAssertion(t *testing.T) {
+	valid := clientData{Type: "webauthn.get", Challenge: testChallenge, Origin: testOrigin}
+
+	for _, alg := range testAlgorithms {
+		t.Run(algName(alg), func(t *testing.T) {
+			a := newTestAuthenticator(t, alg)
+			other := newTestAuthenticator(t, algEdDSA)
+
+			tests := []struct {
+				name      string
+				assertion Assertion
+				publicKey []byte
+				signCount uint32
+				requireUV bool
+				wantCount uint32
+				wantErr   error
+			}{
+				{
+					name:      "valid",
+					assertion: a.assert(t, authData(testRPID, flagUserPresent|flagUserVerified, 42, nil), valid),
+					signCount: 41,
+					requireUV: true,
+					wantCount: 42,
+				},
+				{
+					name:      "authenticator without a counter",
+					assertion: a.assert(t, authData(testRPID, flagUserPresent, 0, nil), valid),
+				},
+				{
+					name:      "registration client data",
+					assertion: a.assert(t, authData(testRPID, flagUserPresent, 1, nil), clientData{Type: "webauthn.create", Challenge: testChallenge, Origin: testOrigin}),
+					wantErr:   ErrMalformed,
+				},
+				{
+					name:      "wrong challenge",
+					assertion: a.assert(t, authData(testRPID, flagUserPresent, 1, nil), clientData{Type: "webauthn.get", Challenge: Encode([]byte("stale challenge")), Origin: testOrigin}),
+					wantErr:   ErrChallenge,
+				},
+				{
+					name:      "wrong origin",
+					assertion: a.assert(t, authData(testRPID, flagUserPresent, 1, nil), clientData{Type: "webauthn.get", Challenge: testChallenge, Origin: "https://taskflow.example.com.evil.test"}),
+					wantErr:   ErrOrigin,
+				},
+				{
+					name:      "wrong rpIdHash",
+					assertion: a.assert(t, authData("example.com", flagUserPresent, 1, nil), valid),
+					wantErr:   ErrRelyingParty,
+				},
+				{
+					name:      "user not present",
+					assertion: a.assert(t, authData(testRPID, 0, 1, nil), valid),
+					wantErr:   ErrUserPresence,
+				},
+				{
+					name:      "user not verified",
+					assertion: a.assert(t, authData(testRPID, flagUserPresent, 1, nil), valid),
+					requireUV: true,
+					wantErr:   ErrUserVerification,
+				},
+				{
+					name:      "truncated authenticator data",
+					assertion: Assertion{ClientDataJSON: valid.json(t), AuthenticatorData: authData(testRPID, flagUserPresent, 1, nil)[:36]},
+					wantErr:   ErrMalformed,
+				},
+				{
+					name:      "signed by another key",
+					assertion: other.assert(t, authData(testRPID, flagUserPresent, 1, nil), valid),
+					wantErr:   ErrSignature,
+				},
+				{
+					name: "tampered authenticator data",
+					assertion: func() Assertion {
+						as := a.assert(t, authData(testRPID, flagUserPresent, 1, nil), valid)
+						as.AuthenticatorData = authData(testRPID, flagUserPresent, 2, nil)
+						return as
+					}(),
+					wantErr: ErrSignature,
+				},
+				{
+					name:      "sign counter went backwards",
+					assertion: a.assert(t, authData(testRPID, flagUserPresent, 5, nil), valid),
+					signCount: 10,
+					wantErr:   ErrSignCount,
+				},
+				{
+					name:      "sign counter repeated",
+					assertion: a.assert(t, authData(testRPID, flagUserPresent, 10, nil), valid),
+					signCount: 10,
+					wantErr:   ErrSignCount,
+				},
+				{
+					name:      "sign counter reset to zero",
+					assertion: a.assert(t, authData(testRPID, flagUserPresent, 0, nil), valid),
+					signCount: 10,
+					wantErr:   ErrSignCount,
+				},
+				{
+					name:      "malformed public key",
+					assertion: a.assert(t, authData(testRPID, flagUserPresent, 1, nil), valid),
+					publicKey: []byte{0xa5, 0x01},
+					wantErr:   ErrMalformed,
+				},
+			}
+			for _, tt := range tests {
+				t.Run(tt.name, func(t *testing.T) {
+					publicKey := tt.publicKey
+					if publicKey == nil {
+						publicKey = a.cose
+					}
+					count, err := testRP.VerifyAssertion(testChallenge, publicKey, tt.signCount, tt.assertion, tt.requireUV)
+					if tt.wantErr != nil {
+						assert.ErrorIs(t, err, tt.wantErr)
+						return
+					}
+					require.NoError(t, err)
+					assert.Equal(t, tt.wantCount, count)
+				})
+			}
+		})
+	}
+}
+
+func TestParsePublicKey(t *testing.T) {
+	ec, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	x, y := ec.X.FillBytes(make([]byte, 32)), ec.Y.FillBytes(make([]byte, 32))
+	edPub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	rsaN := make([]byte, 256)
+	rsaN[0] = 0xc1
+	rsaN[255] = 0x01
+
+	offCurve := append([]byte(nil), y...)
+	offCurve[31] ^= 0x01
+
+	tests := []struct {
+		name    string
+		cose    []byte
+		wantErr error
+	}{
+		{"ES256", encodeCBOR(cborMap{{coseKty, ktyEC2}, {coseAlg, algES256}, {coseCrv, 1}, {coseX, x}, {coseY, y}}), nil},
+		{"EdDSA", encodeCBOR(cborMap{{coseKty, ktyOKP}, {coseAlg, algEdDSA}, {coseCrv, 6}, {coseX, []byte(edPub)}}), nil},
+		{"RS256", encodeCBOR(cborMap{{coseKty, ktyRSA}, {coseAlg, algRS256}, {coseN, rsaN}, {coseE, []byte{1, 0, 1}}}), nil},
+		{"ES256 point off the curve", encodeCBOR(cborMap{{coseKty, ktyEC2}, {coseAlg, algES256}, {coseCrv, 1}, {coseX, x}, {coseY, offCurve}}), ErrUnsupportedKey},
+		{"ES256 short coordinate", encodeCBOR(cborMap{{coseKty, ktyEC2}, {coseAlg, algES256}, {coseCrv, 1}, {coseX, x[1:]}, {coseY, y}}), ErrUnsupportedKey},
+		{"ES256 on another curve", encodeCBOR(cborMap{{coseKty, ktyEC2}, {coseAlg, algES256}, {coseCrv, 2}, {coseX, x}, {coseY, y}}), ErrUnsupportedKey},
+		{"EdDSA on another curve", encodeCBOR(cborMap{{coseKty, ktyOKP}, {coseAlg, algEdDSA}, {coseCrv, 7}, {coseX, []byte(edPub)}}), ErrUnsupportedKey},
+		{"EdDSA short key", encodeCBOR(cborMap{{coseKty, ktyOKP}, {coseAlg, algEdDSA}, {coseCrv, 6}, {coseX, []byte(edPub)[:31]}}), ErrUnsupportedKey},
+		{"RS256 modulus under 2048 bits", encodeCBOR(cborMap{{coseKty, ktyRSA}, {coseAlg, algRS256}, {coseN, rsaN[:255]}, {coseE, []byte{1, 0, 1}}}), ErrUnsupportedKey},
+		{"RS256 without exponent", encodeCBOR(cborMap{{coseKty, ktyRSA}, {coseAlg, algRS256}, {coseN, rsaN}}), ErrUnsupportedKey},
+		{"RS256 oversized exponent", encodeCBOR(cborMap{{coseKty, ktyRSA}, {coseAlg, algRS256}, {coseN, rsaN}, {coseE, []byte{1, 0, 0, 0, 1}}}), ErrUnsupportedKey},
+		{"key type and algorithm mismatch", encodeCBOR(cborMap{{coseKty, ktyOKP}, {coseAlg, algES256}, {coseCrv, 6}, {coseX, []byte(edPub)}}), ErrUnsupportedKey},
+		{"unknown algorithm", encodeCBOR(cborMap{{coseKty, ktyEC2}, {coseAlg, -36}, {coseCrv, 3}}), ErrUnsupportedKey},
+		{"not a map", encodeCBOR([]interface{}{int64(coseKty), int64(ktyEC2)}), ErrMalformed},
+		{"trailing bytes", append(encodeCBOR(cborMap{{coseKty, ktyOKP}, {coseAlg, algEdDSA}, {coseCrv, 6}, {coseX, []byte(edPub)}}), 0x00), ErrMalformed},
+		{"truncated", encodeCBOR(cborMap{{coseKty, ktyOKP}, {coseAlg, algEdDSA}, {coseCrv, 6}, {coseX, []byte(edPub)}})[:20], ErrMalformed},
+		{"nested past the CBOR depth limit", append([]byte{0xa1, 0x01}, nestedArrays(maxCBORDepth)...), ErrMalformed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := parsePublicKey(tt.cose)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, key)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotNil(t, key)
+		})
+	}
+}
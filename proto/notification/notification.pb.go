@@ -27,34 +27,49 @@ const (
 type NotificationType int32
 
 const (
-	NotificationType_NOTIFICATION_TYPE_UNSPECIFIED    NotificationType = 0
-	NotificationType_NOTIFICATION_TYPE_TASK_ASSIGNED  NotificationType = 1
-	NotificationType_NOTIFICATION_TYPE_TASK_UPDATED   NotificationType = 2
-	NotificationType_NOTIFICATION_TYPE_TASK_COMPLETED NotificationType = 3
-	NotificationType_NOTIFICATION_TYPE_TASK_COMMENT   NotificationType = 4
-	NotificationType_NOTIFICATION_TYPE_TASK_DUE_SOON  NotificationType = 5
-	NotificationType_NOTIFICATION_TYPE_TASK_OVERDUE   NotificationType = 6
+	NotificationType_NOTIFICATION_TYPE_UNSPECIFIED       NotificationType = 0
+	NotificationType_NOTIFICATION_TYPE_TASK_ASSIGNED     NotificationType = 1
+	NotificationType_NOTIFICATION_TYPE_TASK_UPDATED      NotificationType = 2
+	NotificationType_NOTIFICATION_TYPE_TASK_COMPLETED    NotificationType = 3
+	NotificationType_NOTIFICATION_TYPE_TASK_COMMENT      NotificationType = 4
+	NotificationType_NOTIFICATION_TYPE_TASK_DUE_SOON     NotificationType = 5
+	NotificationType_NOTIFICATION_TYPE_TASK_OVERDUE      NotificationType = 6
+	NotificationType_NOTIFICATION_TYPE_TASK_MENTION      NotificationType = 7
+	NotificationType_NOTIFICATION_TYPE_ACCOUNT_LOCKED    NotificationType = 8
+	NotificationType_NOTIFICATION_TYPE_NEW_DEVICE_LOGIN  NotificationType = 9
+	NotificationType_NOTIFICATION_TYPE_SESSION_REVOKED   NotificationType = 10
+	NotificationType_NOTIFICATION_TYPE_STATUS_REPORT_DUE NotificationType = 11
 )
 
 // Enum value maps for NotificationType.
 var (
 	NotificationType_name = map[int32]string{
-		0: "NOTIFICATION_TYPE_UNSPECIFIED",
-		1: "NOTIFICATION_TYPE_TASK_ASSIGNED",
-		2: "NOTIFICATION_TYPE_TASK_UPDATED",
-		3: "NOTIFICATION_TYPE_TASK_COMPLETED",
-		4: "NOTIFICATION_TYPE_TASK_COMMENT",
-		5: "NOTIFICATION_TYPE_TASK_DUE_SOON",
-		6: "NOTIFICATION_TYPE_TASK_OVERDUE",
+		0:  "NOTIFICATION_TYPE_UNSPECIFIED",
+		1:  "NOTIFICATION_TYPE_TASK_ASSIGNED",
+		2:  "NOTIFICATION_TYPE_TASK_UPDATED",
+		3:  "NOTIFICATION_TYPE_TASK_COMPLETED",
+		4:  "NOTIFICATION_TYPE_TASK_COMMENT",
+		5:  "NOTIFICATION_TYPE_TASK_DUE_SOON",
+		6:  "NOTIFICATION_TYPE_TASK_OVERDUE",
+		7:  "NOTIFICATION_TYPE_TASK_MENTION",
+		8:  "NOTIFICATION_TYPE_ACCOUNT_LOCKED",
+		9:  "NOTIFICATION_TYPE_NEW_DEVICE_LOGIN",
+		10: "NOTIFICATION_TYPE_SESSION_REVOKED",
+		11: "NOTIFICATION_TYPE_STATUS_REPORT_DUE",
 	}
 	NotificationType_value = map[string]int32{
-		"NOTIFICATION_TYPE_UNSPECIFIED":    0,
-		"NOTIFICATION_TYPE_TASK_ASSIGNED":  1,
-		"NOTIFICATION_TYPE_TASK_UPDATED":   2,
-		"NOTIFICATION_TYPE_TASK_COMPLETED": 3,
-		"NOTIFICATION_TYPE_TASK_COMMENT":   4,
-		"NOTIFICATION_TYPE_TASK_DUE_SOON":  5,
-		"NOTIFICATION_TYPE_TASK_OVERDUE":   6,
+		"NOTIFICATION_TYPE_UNSPECIFIED":       0,
+		"NOTIFICATION_TYPE_TASK_ASSIGNED":     1,
+		"NOTIFICATION_TYPE_TASK_UPDATED":      2,
+		"NOTIFICATION_TYPE_TASK_COMPLETED":    3,
+		"NOTIFICATION_TYPE_TASK_COMMENT":      4,
+		"NOTIFICATION_TYPE_TASK_DUE_SOON":     5,
+		"NOTIFICATION_TYPE_TASK_OVERDUE":      6,
+		"NOTIFICATION_TYPE_TASK_MENTION":      7,
+		"NOTIFICATION_TYPE_ACCOUNT_LOCKED":    8,
+		"NOTIFICATION_TYPE_NEW_DEVICE_LOGIN":  9,
+		"NOTIFICATION_TYPE_SESSION_REVOKED":   10,
+		"NOTIFICATION_TYPE_STATUS_REPORT_DUE": 11,
 	}
 )
 
@@ -85,6 +100,113 @@ func (NotificationType) EnumDescriptor() ([]byte, []int) {
 	return file_notification_proto_rawDescGZIP(), []int{0}
 }
 
+// How urgent a notification is
+type NotificationSeverity int32
+
+const (
+	NotificationSeverity_NOTIFICATION_SEVERITY_UNSPECIFIED NotificationSeverity = 0
+	NotificationSeverity_NOTIFICATION_SEVERITY_INFO        NotificationSeverity = 1
+	NotificationSeverity_NOTIFICATION_SEVERITY_WARNING     NotificationSeverity = 2
+	NotificationSeverity_NOTIFICATION_SEVERITY_CRITICAL    NotificationSeverity = 3
+)
+
+// Enum value maps for NotificationSeverity.
+var (
+	NotificationSeverity_name = map[int32]string{
+		0: "NOTIFICATION_SEVERITY_UNSPECIFIED",
+		1: "NOTIFICATION_SEVERITY_INFO",
+		2: "NOTIFICATION_SEVERITY_WARNING",
+		3: "NOTIFICATION_SEVERITY_CRITICAL",
+	}
+	NotificationSeverity_value = map[string]int32{
+		"NOTIFICATION_SEVERITY_UNSPECIFIED": 0,
+		"NOTIFICATION_SEVERITY_INFO":        1,
+		"NOTIFICATION_SEVERITY_WARNING":     2,
+		"NOTIFICATION_SEVERITY_CRITICAL":    3,
+	}
+)
+
+func (x NotificationSeverity) Enum() *NotificationSeverity {
+	p := new(NotificationSeverity)
+	*p = x
+	return p
+}
+
+func (x NotificationSeverity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotificationSeverity) Descriptor() protoreflect.EnumDescriptor {
+	return file_notification_proto_enumTypes[1].Descriptor()
+}
+
+func (NotificationSeverity) Type() protoreflect.EnumType {
+	return &file_notification_proto_enumTypes[1]
+}
+
+func (x NotificationSeverity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationSeverity.Descriptor instead.
+func (NotificationSeverity) EnumDescriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{1}
+}
+
+// How a notification interrupts the user on their devices
+type NotificationPriority int32
+
+const (
+	NotificationPriority_NOTIFICATION_PRIORITY_UNSPECIFIED NotificationPriority = 0
+	NotificationPriority_NOTIFICATION_PRIORITY_LOW         NotificationPriority = 1
+	NotificationPriority_NOTIFICATION_PRIORITY_NORMAL      NotificationPriority = 2
+	// pushed even during the user's quiet hours
+	NotificationPriority_NOTIFICATION_PRIORITY_CRITICAL NotificationPriority = 3
+)
+
+// Enum value maps for NotificationPriority.
+var (
+	NotificationPriority_name = map[int32]string{
+		0: "NOTIFICATION_PRIORITY_UNSPECIFIED",
+		1: "NOTIFICATION_PRIORITY_LOW",
+		2: "NOTIFICATION_PRIORITY_NORMAL",
+		3: "NOTIFICATION_PRIORITY_CRITICAL",
+	}
+	NotificationPriority_value = map[string]int32{
+		"NOTIFICATION_PRIORITY_UNSPECIFIED": 0,
+		"NOTIFICATION_PRIORITY_LOW":         1,
+		"NOTIFICATION_PRIORITY_NORMAL":      2,
+		"NOTIFICATION_PRIORITY_CRITICAL":    3,
+	}
+)
+
+func (x NotificationPriority) Enum() *NotificationPriority {
+	p := new(NotificationPriority)
+	*p = x
+	return p
+}
+
+func (x NotificationPriority) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotificationPriority) Descriptor() protoreflect.EnumDescriptor {
+	return file_notification_proto_enumTypes[2].Descriptor()
+}
+
+func (NotificationPriority) Type() protoreflect.EnumType {
+	return &file_notification_proto_enumTypes[2]
+}
+
+func (x NotificationPriority) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationPriority.Descriptor instead.
+func (NotificationPriority) EnumDescriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{2}
+}
+
 // Notification event
 type NotificationEvent struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
@@ -98,8 +220,15 @@ type NotificationEvent struct {
 	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	Read           bool                   `protobuf:"varint,9,opt,name=read,proto3" json:"read,omitempty"`
 	Metadata       map[string]string      `protobuf:"bytes,10,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// inbox section, e.g. task, security, report or announcement
+	Category string               `protobuf:"bytes,11,opt,name=category,proto3" json:"category,omitempty"`
+	Severity NotificationSeverity `protobuf:"varint,12,opt,name=severity,proto3,enum=notification.NotificationSeverity" json:"severity,omitempty"`
+	// set instead of the fields above when the user read or removed
+	// notifications on another device
+	ReadState     *ReadStateUpdate     `protobuf:"bytes,13,opt,name=read_state,json=readState,proto3" json:"read_state,omitempty"`
+	Priority      NotificationPriority `protobuf:"varint,14,opt,name=priority,proto3,enum=notification.NotificationPriority" json:"priority,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *NotificationEvent) Reset() {
@@ -202,6 +331,105 @@ func (x *NotificationEvent) GetMetadata() map[string]string {
 	return nil
 }
 
+func (x *NotificationEvent) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *NotificationEvent) GetSeverity() NotificationSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return NotificationSeverity_NOTIFICATION_SEVERITY_UNSPECIFIED
+}
+
+func (x *NotificationEvent) GetReadState() *ReadStateUpdate {
+	if x != nil {
+		return x.ReadState
+	}
+	return nil
+}
+
+func (x *NotificationEvent) GetPriority() NotificationPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return NotificationPriority_NOTIFICATION_PRIORITY_UNSPECIFIED
+}
+
+// Read-state change to apply to badge counts and the inbox
+type ReadStateUpdate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// empty for bulk changes, after which the inbox should be reloaded
+	NotificationIds []string `protobuf:"bytes,1,rep,name=notification_ids,json=notificationIds,proto3" json:"notification_ids,omitempty"`
+	Bulk            bool     `protobuf:"varint,2,opt,name=bulk,proto3" json:"bulk,omitempty"`
+	// removed rather than read
+	Deleted       bool  `protobuf:"varint,3,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	UnreadCount   int32 `protobuf:"varint,4,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadStateUpdate) Reset() {
+	*x = ReadStateUpdate{}
+	mi := &file_notification_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadStateUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadStateUpdate) ProtoMessage() {}
+
+func (x *ReadStateUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadStateUpdate.ProtoReflect.Descriptor instead.
+func (*ReadStateUpdate) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReadStateUpdate) GetNotificationIds() []string {
+	if x != nil {
+		return x.NotificationIds
+	}
+	return nil
+}
+
+func (x *ReadStateUpdate) GetBulk() bool {
+	if x != nil {
+		return x.Bulk
+	}
+	return false
+}
+
+func (x *ReadStateUpdate) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *ReadStateUpdate) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
 // Subscribe request for streaming
 type SubscribeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -213,7 +441,7 @@ type SubscribeRequest struct {
 
 func (x *SubscribeRequest) Reset() {
 	*x = SubscribeRequest{}
-	mi := &file_notification_proto_msgTypes[1]
+	mi := &file_notification_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -225,7 +453,7 @@ func (x *SubscribeRequest) String() string {
 func (*SubscribeRequest) ProtoMessage() {}
 
 func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_proto_msgTypes[1]
+	mi := &file_notification_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -238,7 +466,7 @@ func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
 func (*SubscribeRequest) Descriptor() ([]byte, []int) {
-	return file_notification_proto_rawDescGZIP(), []int{1}
+	return file_notification_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *SubscribeRequest) GetUserId() string {
@@ -265,13 +493,19 @@ type SendNotificationRequest struct {
 	TaskId        string                 `protobuf:"bytes,5,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 	RelatedUserId string                 `protobuf:"bytes,6,opt,name=related_user_id,json=relatedUserId,proto3" json:"related_user_id,omitempty"`
 	Metadata      map[string]string      `protobuf:"bytes,7,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// derived from the type when empty
+	Category string `protobuf:"bytes,8,opt,name=category,proto3" json:"category,omitempty"`
+	// derived from the type when unspecified
+	Severity NotificationSeverity `protobuf:"varint,9,opt,name=severity,proto3,enum=notification.NotificationSeverity" json:"severity,omitempty"`
+	// critical for critical severity, normal otherwise, when unspecified
+	Priority      NotificationPriority `protobuf:"varint,10,opt,name=priority,proto3,enum=notification.NotificationPriority" json:"priority,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SendNotificationRequest) Reset() {
 	*x = SendNotificationRequest{}
-	mi := &file_notification_proto_msgTypes[2]
+	mi := &file_notification_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -283,7 +517,7 @@ func (x *SendNotificationRequest) String() string {
 func (*SendNotificationRequest) ProtoMessage() {}
 
 func (x *SendNotificationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_proto_msgTypes[2]
+	mi := &file_notification_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -296,7 +530,7 @@ func (x *SendNotificationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendNotificationRequest.ProtoReflect.Descriptor instead.
 func (*SendNotificationRequest) Descriptor() ([]byte, []int) {
-	return file_notification_proto_rawDescGZIP(), []int{2}
+	return file_notification_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *SendNotificationRequest) GetUserId() string {
@@ -348,6 +582,27 @@ func (x *SendNotificationRequest) GetMetadata() map[string]string {
 	return nil
 }
 
+func (x *SendNotificationRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SendNotificationRequest) GetSeverity() NotificationSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return NotificationSeverity_NOTIFICATION_SEVERITY_UNSPECIFIED
+}
+
+func (x *SendNotificationRequest) GetPriority() NotificationPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return NotificationPriority_NOTIFICATION_PRIORITY_UNSPECIFIED
+}
+
 // Send notification response
 type SendNotificationResponse struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
@@ -359,7 +614,7 @@ type SendNotificationResponse struct {
 
 func (x *SendNotificationResponse) Reset() {
 	*x = SendNotificationResponse{}
-	mi := &file_notification_proto_msgTypes[3]
+	mi := &file_notification_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -371,7 +626,7 @@ func (x *SendNotificationResponse) String() string {
 func (*SendNotificationResponse) ProtoMessage() {}
 
 func (x *SendNotificationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_proto_msgTypes[3]
+	mi := &file_notification_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -384,7 +639,7 @@ func (x *SendNotificationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendNotificationResponse.ProtoReflect.Descriptor instead.
 func (*SendNotificationResponse) Descriptor() ([]byte, []int) {
-	return file_notification_proto_rawDescGZIP(), []int{3}
+	return file_notification_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SendNotificationResponse) GetNotificationId() string {
@@ -401,32 +656,37 @@ func (x *SendNotificationResponse) GetMessage() string {
 	return ""
 }
 
-// Get notifications request
-type GetNotificationsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	UnreadOnly    bool                   `protobuf:"varint,2,opt,name=unread_only,json=unreadOnly,proto3" json:"unread_only,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+// Send org notification request
+type SendOrgNotificationRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	OrgId    string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Type     NotificationType       `protobuf:"varint,2,opt,name=type,proto3,enum=notification.NotificationType" json:"type,omitempty"`
+	Title    string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Message  string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Metadata map[string]string      `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// announcement when empty
+	Category      string               `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`
+	Severity      NotificationSeverity `protobuf:"varint,7,opt,name=severity,proto3,enum=notification.NotificationSeverity" json:"severity,omitempty"`
+	Priority      NotificationPriority `protobuf:"varint,8,opt,name=priority,proto3,enum=notification.NotificationPriority" json:"priority,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNotificationsRequest) Reset() {
-	*x = GetNotificationsRequest{}
-	mi := &file_notification_proto_msgTypes[4]
+func (x *SendOrgNotificationRequest) Reset() {
+	*x = SendOrgNotificationRequest{}
+	mi := &file_notification_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNotificationsRequest) String() string {
+func (x *SendOrgNotificationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNotificationsRequest) ProtoMessage() {}
+func (*SendOrgNotificationRequest) ProtoMessage() {}
 
-func (x *GetNotificationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_proto_msgTypes[4]
+func (x *SendOrgNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -437,123 +697,97 @@ func (x *GetNotificationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNotificationsRequest.ProtoReflect.Descriptor instead.
-func (*GetNotificationsRequest) Descriptor() ([]byte, []int) {
-	return file_notification_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use SendOrgNotificationRequest.ProtoReflect.Descriptor instead.
+func (*SendOrgNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetNotificationsRequest) GetUserId() string {
+func (x *SendOrgNotificationRequest) GetOrgId() string {
 	if x != nil {
-		return x.UserId
+		return x.OrgId
 	}
 	return ""
 }
 
-func (x *GetNotificationsRequest) GetUnreadOnly() bool {
+func (x *SendOrgNotificationRequest) GetType() NotificationType {
 	if x != nil {
-		return x.UnreadOnly
+		return x.Type
 	}
-	return false
+	return NotificationType_NOTIFICATION_TYPE_UNSPECIFIED
 }
 
-func (x *GetNotificationsRequest) GetPage() int32 {
+func (x *SendOrgNotificationRequest) GetTitle() string {
 	if x != nil {
-		return x.Page
+		return x.Title
 	}
-	return 0
+	return ""
 }
 
-func (x *GetNotificationsRequest) GetPageSize() int32 {
+func (x *SendOrgNotificationRequest) GetMessage() string {
 	if x != nil {
-		return x.PageSize
+		return x.Message
 	}
-	return 0
-}
-
-// Get notifications response
-type GetNotificationsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Notifications []*NotificationEvent   `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	UnreadCount   int32                  `protobuf:"varint,3,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetNotificationsResponse) Reset() {
-	*x = GetNotificationsResponse{}
-	mi := &file_notification_proto_msgTypes[5]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetNotificationsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*GetNotificationsResponse) ProtoMessage() {}
-
-func (x *GetNotificationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_proto_msgTypes[5]
+func (x *SendOrgNotificationRequest) GetMetadata() map[string]string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Metadata
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetNotificationsResponse.ProtoReflect.Descriptor instead.
-func (*GetNotificationsResponse) Descriptor() ([]byte, []int) {
-	return file_notification_proto_rawDescGZIP(), []int{5}
+	return nil
 }
 
-func (x *GetNotificationsResponse) GetNotifications() []*NotificationEvent {
+func (x *SendOrgNotificationRequest) GetCategory() string {
 	if x != nil {
-		return x.Notifications
+		return x.Category
 	}
-	return nil
+	return ""
 }
 
-func (x *GetNotificationsResponse) GetTotalCount() int32 {
+func (x *SendOrgNotificationRequest) GetSeverity() NotificationSeverity {
 	if x != nil {
-		return x.TotalCount
+		return x.Severity
 	}
-	return 0
+	return NotificationSeverity_NOTIFICATION_SEVERITY_UNSPECIFIED
 }
 
-func (x *GetNotificationsResponse) GetUnreadCount() int32 {
+func (x *SendOrgNotificationRequest) GetPriority() NotificationPriority {
 	if x != nil {
-		return x.UnreadCount
+		return x.Priority
 	}
-	return 0
-}
-
-// Mark as read request
-type MarkAsReadRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	NotificationId string                 `protobuf:"bytes,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
-	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	return NotificationPriority_NOTIFICATION_PRIORITY_UNSPECIFIED
+}
+
+// Send team notification request
+type SendTeamNotificationRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TeamId   string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Type     NotificationType       `protobuf:"varint,2,opt,name=type,proto3,enum=notification.NotificationType" json:"type,omitempty"`
+	Title    string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Message  string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Metadata map[string]string      `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// announcement when empty
+	Category      string               `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`
+	Severity      NotificationSeverity `protobuf:"varint,7,opt,name=severity,proto3,enum=notification.NotificationSeverity" json:"severity,omitempty"`
+	Priority      NotificationPriority `protobuf:"varint,8,opt,name=priority,proto3,enum=notification.NotificationPriority" json:"priority,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MarkAsReadRequest) Reset() {
-	*x = MarkAsReadRequest{}
+func (x *SendTeamNotificationRequest) Reset() {
+	*x = SendTeamNotificationRequest{}
 	mi := &file_notification_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MarkAsReadRequest) String() string {
+func (x *SendTeamNotificationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarkAsReadRequest) ProtoMessage() {}
+func (*SendTeamNotificationRequest) ProtoMessage() {}
 
-func (x *MarkAsReadRequest) ProtoReflect() protoreflect.Message {
+func (x *SendTeamNotificationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_notification_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -565,48 +799,1704 @@ func (x *MarkAsReadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarkAsReadRequest.ProtoReflect.Descriptor instead.
-func (*MarkAsReadRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SendTeamNotificationRequest.ProtoReflect.Descriptor instead.
+func (*SendTeamNotificationRequest) Descriptor() ([]byte, []int) {
 	return file_notification_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *MarkAsReadRequest) GetNotificationId() string {
+func (x *SendTeamNotificationRequest) GetTeamId() string {
 	if x != nil {
-		return x.NotificationId
+		return x.TeamId
 	}
 	return ""
 }
 
-func (x *MarkAsReadRequest) GetUserId() string {
+func (x *SendTeamNotificationRequest) GetType() NotificationType {
 	if x != nil {
-		return x.UserId
+		return x.Type
+	}
+	return NotificationType_NOTIFICATION_TYPE_UNSPECIFIED
+}
+
+func (x *SendTeamNotificationRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SendTeamNotificationRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SendTeamNotificationRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *SendTeamNotificationRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SendTeamNotificationRequest) GetSeverity() NotificationSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return NotificationSeverity_NOTIFICATION_SEVERITY_UNSPECIFIED
+}
+
+func (x *SendTeamNotificationRequest) GetPriority() NotificationPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return NotificationPriority_NOTIFICATION_PRIORITY_UNSPECIFIED
+}
+
+// Send org or team notification response
+type SendBroadcastResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// members notified
+	Recipients int32 `protobuf:"varint,1,opt,name=recipients,proto3" json:"recipients,omitempty"`
+	// members whose notification was queued for push delivery
+	Queued        int32 `protobuf:"varint,2,opt,name=queued,proto3" json:"queued,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendBroadcastResponse) Reset() {
+	*x = SendBroadcastResponse{}
+	mi := &file_notification_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendBroadcastResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendBroadcastResponse) ProtoMessage() {}
+
+func (x *SendBroadcastResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendBroadcastResponse.ProtoReflect.Descriptor instead.
+func (*SendBroadcastResponse) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SendBroadcastResponse) GetRecipients() int32 {
+	if x != nil {
+		return x.Recipients
+	}
+	return 0
+}
+
+func (x *SendBroadcastResponse) GetQueued() int32 {
+	if x != nil {
+		return x.Queued
+	}
+	return 0
+}
+
+// Get notifications request
+type GetNotificationsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	UserId     string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UnreadOnly bool                   `protobuf:"varint,2,opt,name=unread_only,json=unreadOnly,proto3" json:"unread_only,omitempty"`
+	Page       int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize   int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Type       NotificationType       `protobuf:"varint,5,opt,name=type,proto3,enum=notification.NotificationType" json:"type,omitempty"`
+	TaskId     string                 `protobuf:"bytes,6,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Category   string                 `protobuf:"bytes,7,opt,name=category,proto3" json:"category,omitempty"`
+	// only critical notifications not yet read
+	CriticalUnreadOnly bool `protobuf:"varint,8,opt,name=critical_unread_only,json=criticalUnreadOnly,proto3" json:"critical_unread_only,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetNotificationsRequest) Reset() {
+	*x = GetNotificationsRequest{}
+	mi := &file_notification_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationsRequest) ProtoMessage() {}
+
+func (x *GetNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetNotificationsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetNotificationsRequest) GetUnreadOnly() bool {
+	if x != nil {
+		return x.UnreadOnly
+	}
+	return false
+}
+
+func (x *GetNotificationsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetNotificationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetNotificationsRequest) GetType() NotificationType {
+	if x != nil {
+		return x.Type
+	}
+	return NotificationType_NOTIFICATION_TYPE_UNSPECIFIED
+}
+
+func (x *GetNotificationsRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *GetNotificationsRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *GetNotificationsRequest) GetCriticalUnreadOnly() bool {
+	if x != nil {
+		return x.CriticalUnreadOnly
+	}
+	return false
+}
+
+// Get notifications response
+type GetNotificationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notifications []*NotificationEvent   `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	UnreadCount   int32                  `protobuf:"varint,3,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	// unread notifications in each category
+	UnreadByCategory map[string]int32 `protobuf:"bytes,4,rep,name=unread_by_category,json=unreadByCategory,proto3" json:"unread_by_category,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetNotificationsResponse) Reset() {
+	*x = GetNotificationsResponse{}
+	mi := &file_notification_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationsResponse) ProtoMessage() {}
+
+func (x *GetNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*GetNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetNotificationsResponse) GetNotifications() []*NotificationEvent {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *GetNotificationsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetNotificationsResponse) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+func (x *GetNotificationsResponse) GetUnreadByCategory() map[string]int32 {
+	if x != nil {
+		return x.UnreadByCategory
+	}
+	return nil
+}
+
+// Mark as read request
+type MarkAsReadRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NotificationId string                 `protobuf:"bytes,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MarkAsReadRequest) Reset() {
+	*x = MarkAsReadRequest{}
+	mi := &file_notification_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAsReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAsReadRequest) ProtoMessage() {}
+
+func (x *MarkAsReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAsReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkAsReadRequest) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MarkAsReadRequest) GetNotificationId() string {
+	if x != nil {
+		return x.NotificationId
+	}
+	return ""
+}
+
+func (x *MarkAsReadRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// Mark as read response
+type MarkAsReadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkAsReadResponse) Reset() {
+	*x = MarkAsReadResponse{}
+	mi := &file_notification_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAsReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAsReadResponse) ProtoMessage() {}
+
+func (x *MarkAsReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAsReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkAsReadResponse) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MarkAsReadResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Mark all as read request
+type MarkAllAsReadRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// only notifications of this type, all types when unspecified
+	Type NotificationType `protobuf:"varint,2,opt,name=type,proto3,enum=notification.NotificationType" json:"type,omitempty"`
+	// only notifications created before this time
+	Before        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=before,proto3" json:"before,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkAllAsReadRequest) Reset() {
+	*x = MarkAllAsReadRequest{}
+	mi := &file_notification_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAllAsReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAllAsReadRequest) ProtoMessage() {}
+
+func (x *MarkAllAsReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAllAsReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkAllAsReadRequest) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MarkAllAsReadRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MarkAllAsReadRequest) GetType() NotificationType {
+	if x != nil {
+		return x.Type
+	}
+	return NotificationType_NOTIFICATION_TYPE_UNSPECIFIED
+}
+
+func (x *MarkAllAsReadRequest) GetBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+// Mark all as read response
+type MarkAllAsReadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Updated       int32                  `protobuf:"varint,1,opt,name=updated,proto3" json:"updated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkAllAsReadResponse) Reset() {
+	*x = MarkAllAsReadResponse{}
+	mi := &file_notification_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAllAsReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAllAsReadResponse) ProtoMessage() {}
+
+func (x *MarkAllAsReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAllAsReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkAllAsReadResponse) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *MarkAllAsReadResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+// Delete notification request
+type DeleteNotificationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NotificationId string                 `protobuf:"bytes,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteNotificationRequest) Reset() {
+	*x = DeleteNotificationRequest{}
+	mi := &file_notification_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNotificationRequest) ProtoMessage() {}
+
+func (x *DeleteNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNotificationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeleteNotificationRequest) GetNotificationId() string {
+	if x != nil {
+		return x.NotificationId
+	}
+	return ""
+}
+
+func (x *DeleteNotificationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// Delete notification response
+type DeleteNotificationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNotificationResponse) Reset() {
+	*x = DeleteNotificationResponse{}
+	mi := &file_notification_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNotificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNotificationResponse) ProtoMessage() {}
+
+func (x *DeleteNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNotificationResponse.ProtoReflect.Descriptor instead.
+func (*DeleteNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeleteNotificationResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Clear notifications request
+type ClearNotificationsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// keep unread notifications
+	ReadOnly      bool `protobuf:"varint,2,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearNotificationsRequest) Reset() {
+	*x = ClearNotificationsRequest{}
+	mi := &file_notification_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearNotificationsRequest) ProtoMessage() {}
+
+func (x *ClearNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*ClearNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ClearNotificationsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ClearNotificationsRequest) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+// Clear notifications response
+type ClearNotificationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deleted       int32                  `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearNotificationsResponse) Reset() {
+	*x = ClearNotificationsResponse{}
+	mi := &file_notification_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearNotificationsResponse) ProtoMessage() {}
+
+func (x *ClearNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*ClearNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ClearNotificationsResponse) GetDeleted() int32 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
+}
+
+// Export user data request (internal, used by the user service)
+type ExportUserDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserDataRequest) Reset() {
+	*x = ExportUserDataRequest{}
+	mi := &file_notification_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataRequest) ProtoMessage() {}
+
+func (x *ExportUserDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportUserDataRequest) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ExportUserDataRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// Export user data response
+type ExportUserDataResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// JSON document with everything this service holds about the user
+	Data          []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserDataResponse) Reset() {
+	*x = ExportUserDataResponse{}
+	mi := &file_notification_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataResponse) ProtoMessage() {}
+
+func (x *ExportUserDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataResponse.ProtoReflect.Descriptor instead.
+func (*ExportUserDataResponse) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ExportUserDataResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Erase user data request (internal, used by the user service)
+type EraseUserDataRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// "anonymize" keeps shared records but detaches them from the user;
+	// "delete" also removes records that only the user owns
+	Mode          string `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EraseUserDataRequest) Reset() {
+	*x = EraseUserDataRequest{}
+	mi := &file_notification_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EraseUserDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EraseUserDataRequest) ProtoMessage() {}
+
+func (x *EraseUserDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EraseUserDataRequest.ProtoReflect.Descriptor instead.
+func (*EraseUserDataRequest) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *EraseUserDataRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *EraseUserDataRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+// Erase user data response
+type EraseUserDataResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Rows affected per record type
+	Affected      map[string]int64 `protobuf:"bytes,1,rep,name=affected,proto3" json:"affected,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EraseUserDataResponse) Reset() {
+	*x = EraseUserDataResponse{}
+	mi := &file_notification_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EraseUserDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EraseUserDataResponse) ProtoMessage() {}
+
+func (x *EraseUserDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EraseUserDataResponse.ProtoReflect.Descriptor instead.
+func (*EraseUserDataResponse) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *EraseUserDataResponse) GetAffected() map[string]int64 {
+	if x != nil {
+		return x.Affected
+	}
+	return nil
+}
+
+// An organization's Microsoft Teams webhook. The URL itself is a credential
+// and is only ever shown by host.
+type TeamsIntegration struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	OrgId             string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	WebhookHost       string                 `protobuf:"bytes,2,opt,name=webhook_host,json=webhookHost,proto3" json:"webhook_host,omitempty"`
+	NotifyAssignments bool                   `protobuf:"varint,3,opt,name=notify_assignments,json=notifyAssignments,proto3" json:"notify_assignments,omitempty"` // post task assignments
+	NotifyDueDates    bool                   `protobuf:"varint,4,opt,name=notify_due_dates,json=notifyDueDates,proto3" json:"notify_due_dates,omitempty"`        // post due-soon and overdue reminders
+	Enabled           bool                   `protobuf:"varint,5,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *TeamsIntegration) Reset() {
+	*x = TeamsIntegration{}
+	mi := &file_notification_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamsIntegration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamsIntegration) ProtoMessage() {}
+
+func (x *TeamsIntegration) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamsIntegration.ProtoReflect.Descriptor instead.
+func (*TeamsIntegration) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *TeamsIntegration) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *TeamsIntegration) GetWebhookHost() string {
+	if x != nil {
+		return x.WebhookHost
+	}
+	return ""
+}
+
+func (x *TeamsIntegration) GetNotifyAssignments() bool {
+	if x != nil {
+		return x.NotifyAssignments
+	}
+	return false
+}
+
+func (x *TeamsIntegration) GetNotifyDueDates() bool {
+	if x != nil {
+		return x.NotifyDueDates
+	}
+	return false
+}
+
+func (x *TeamsIntegration) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *TeamsIntegration) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Set Teams integration request
+type SetTeamsIntegrationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	OrgId string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	// Incoming webhook URL of the Teams channel (Workflows or Office 365 connector)
+	WebhookUrl        string `protobuf:"bytes,2,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`
+	NotifyAssignments bool   `protobuf:"varint,3,opt,name=notify_assignments,json=notifyAssignments,proto3" json:"notify_assignments,omitempty"`
+	NotifyDueDates    bool   `protobuf:"varint,4,opt,name=notify_due_dates,json=notifyDueDates,proto3" json:"notify_due_dates,omitempty"`
+	Enabled           bool   `protobuf:"varint,5,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SetTeamsIntegrationRequest) Reset() {
+	*x = SetTeamsIntegrationRequest{}
+	mi := &file_notification_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTeamsIntegrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTeamsIntegrationRequest) ProtoMessage() {}
+
+func (x *SetTeamsIntegrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTeamsIntegrationRequest.ProtoReflect.Descriptor instead.
+func (*SetTeamsIntegrationRequest) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SetTeamsIntegrationRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *SetTeamsIntegrationRequest) GetWebhookUrl() string {
+	if x != nil {
+		return x.WebhookUrl
+	}
+	return ""
+}
+
+func (x *SetTeamsIntegrationRequest) GetNotifyAssignments() bool {
+	if x != nil {
+		return x.NotifyAssignments
+	}
+	return false
+}
+
+func (x *SetTeamsIntegrationRequest) GetNotifyDueDates() bool {
+	if x != nil {
+		return x.NotifyDueDates
+	}
+	return false
+}
+
+func (x *SetTeamsIntegrationRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+// Set Teams integration response
+type SetTeamsIntegrationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Integration   *TeamsIntegration      `protobuf:"bytes,1,opt,name=integration,proto3" json:"integration,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTeamsIntegrationResponse) Reset() {
+	*x = SetTeamsIntegrationResponse{}
+	mi := &file_notification_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTeamsIntegrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTeamsIntegrationResponse) ProtoMessage() {}
+
+func (x *SetTeamsIntegrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTeamsIntegrationResponse.ProtoReflect.Descriptor instead.
+func (*SetTeamsIntegrationResponse) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SetTeamsIntegrationResponse) GetIntegration() *TeamsIntegration {
+	if x != nil {
+		return x.Integration
+	}
+	return nil
+}
+
+func (x *SetTeamsIntegrationResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Get Teams integration request
+type GetTeamsIntegrationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamsIntegrationRequest) Reset() {
+	*x = GetTeamsIntegrationRequest{}
+	mi := &file_notification_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamsIntegrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamsIntegrationRequest) ProtoMessage() {}
+
+func (x *GetTeamsIntegrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamsIntegrationRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamsIntegrationRequest) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetTeamsIntegrationRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+// Get Teams integration response
+type GetTeamsIntegrationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Integration   *TeamsIntegration      `protobuf:"bytes,1,opt,name=integration,proto3" json:"integration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamsIntegrationResponse) Reset() {
+	*x = GetTeamsIntegrationResponse{}
+	mi := &file_notification_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamsIntegrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamsIntegrationResponse) ProtoMessage() {}
+
+func (x *GetTeamsIntegrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamsIntegrationResponse.ProtoReflect.Descriptor instead.
+func (*GetTeamsIntegrationResponse) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetTeamsIntegrationResponse) GetIntegration() *TeamsIntegration {
+	if x != nil {
+		return x.Integration
+	}
+	return nil
+}
+
+// Delete Teams integration request
+type DeleteTeamsIntegrationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTeamsIntegrationRequest) Reset() {
+	*x = DeleteTeamsIntegrationRequest{}
+	mi := &file_notification_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTeamsIntegrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTeamsIntegrationRequest) ProtoMessage() {}
+
+func (x *DeleteTeamsIntegrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTeamsIntegrationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTeamsIntegrationRequest) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeleteTeamsIntegrationRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+// Delete Teams integration response
+type DeleteTeamsIntegrationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTeamsIntegrationResponse) Reset() {
+	*x = DeleteTeamsIntegrationResponse{}
+	mi := &file_notification_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTeamsIntegrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTeamsIntegrationResponse) ProtoMessage() {}
+
+func (x *DeleteTeamsIntegrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTeamsIntegrationResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTeamsIntegrationResponse) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DeleteTeamsIntegrationResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// A notification the stream worker gave up on
+type Announcement struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Body  string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	// where the announcement leads, an http(s) URL or an app path
+	Link          string                 `protobuf:"bytes,4,opt,name=link,proto3" json:"link,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Announcement) Reset() {
+	*x = Announcement{}
+	mi := &file_notification_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Announcement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Announcement) ProtoMessage() {}
+
+func (x *Announcement) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Announcement.ProtoReflect.Descriptor instead.
+func (*Announcement) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Announcement) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Announcement) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Announcement) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Announcement) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *Announcement) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Announcement) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Announcement) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type PublishAnnouncementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Body          string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	Link          string                 `protobuf:"bytes,3,opt,name=link,proto3" json:"link,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishAnnouncementRequest) Reset() {
+	*x = PublishAnnouncementRequest{}
+	mi := &file_notification_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishAnnouncementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishAnnouncementRequest) ProtoMessage() {}
+
+func (x *PublishAnnouncementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishAnnouncementRequest.ProtoReflect.Descriptor instead.
+func (*PublishAnnouncementRequest) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *PublishAnnouncementRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PublishAnnouncementRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *PublishAnnouncementRequest) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *PublishAnnouncementRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type PublishAnnouncementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Announcement  *Announcement          `protobuf:"bytes,1,opt,name=announcement,proto3" json:"announcement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishAnnouncementResponse) Reset() {
+	*x = PublishAnnouncementResponse{}
+	mi := &file_notification_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishAnnouncementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishAnnouncementResponse) ProtoMessage() {}
+
+func (x *PublishAnnouncementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishAnnouncementResponse.ProtoReflect.Descriptor instead.
+func (*PublishAnnouncementResponse) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PublishAnnouncementResponse) GetAnnouncement() *Announcement {
+	if x != nil {
+		return x.Announcement
+	}
+	return nil
+}
+
+type GetActiveAnnouncementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetActiveAnnouncementsRequest) Reset() {
+	*x = GetActiveAnnouncementsRequest{}
+	mi := &file_notification_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActiveAnnouncementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActiveAnnouncementsRequest) ProtoMessage() {}
+
+func (x *GetActiveAnnouncementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActiveAnnouncementsRequest.ProtoReflect.Descriptor instead.
+func (*GetActiveAnnouncementsRequest) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{32}
+}
+
+type GetActiveAnnouncementsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Announcements []*Announcement        `protobuf:"bytes,1,rep,name=announcements,proto3" json:"announcements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetActiveAnnouncementsResponse) Reset() {
+	*x = GetActiveAnnouncementsResponse{}
+	mi := &file_notification_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActiveAnnouncementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActiveAnnouncementsResponse) ProtoMessage() {}
+
+func (x *GetActiveAnnouncementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActiveAnnouncementsResponse.ProtoReflect.Descriptor instead.
+func (*GetActiveAnnouncementsResponse) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetActiveAnnouncementsResponse) GetAnnouncements() []*Announcement {
+	if x != nil {
+		return x.Announcements
+	}
+	return nil
+}
+
+type DeadLetter struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                          // DLQ entry id
+	OriginalMessageId string                 `protobuf:"bytes,2,opt,name=original_message_id,json=originalMessageId,proto3" json:"original_message_id,omitempty"` // stream entry that failed
+	UserId            string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	NotificationId    string                 `protobuf:"bytes,4,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"` // empty when the payload could not be parsed
+	Title             string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Error             string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"` // last delivery or parse error
+	Attempts          int32                  `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	FailedAt          *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=failed_at,json=failedAt,proto3" json:"failed_at,omitempty"`
+	Payload           string                 `protobuf:"bytes,9,opt,name=payload,proto3" json:"payload,omitempty"` // raw event JSON
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *DeadLetter) Reset() {
+	*x = DeadLetter{}
+	mi := &file_notification_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeadLetter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetter) ProtoMessage() {}
+
+func (x *DeadLetter) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetter.ProtoReflect.Descriptor instead.
+func (*DeadLetter) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DeadLetter) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-// Mark as read response
-type MarkAsReadResponse struct {
+func (x *DeadLetter) GetOriginalMessageId() string {
+	if x != nil {
+		return x.OriginalMessageId
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetNotificationId() string {
+	if x != nil {
+		return x.NotificationId
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetFailedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FailedAt
+	}
+	return nil
+}
+
+func (x *DeadLetter) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+// List dead letters request
+type ListDeadLettersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // default 50, max 500
+	AfterId       string                 `protobuf:"bytes,2,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"`     // continue after this entry id
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MarkAsReadResponse) Reset() {
-	*x = MarkAsReadResponse{}
-	mi := &file_notification_proto_msgTypes[7]
+func (x *ListDeadLettersRequest) Reset() {
+	*x = ListDeadLettersRequest{}
+	mi := &file_notification_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MarkAsReadResponse) String() string {
+func (x *ListDeadLettersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarkAsReadResponse) ProtoMessage() {}
+func (*ListDeadLettersRequest) ProtoMessage() {}
 
-func (x *MarkAsReadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_proto_msgTypes[7]
+func (x *ListDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -617,23 +2507,280 @@ func (x *MarkAsReadResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarkAsReadResponse.ProtoReflect.Descriptor instead.
-func (*MarkAsReadResponse) Descriptor() ([]byte, []int) {
-	return file_notification_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ListDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*ListDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *MarkAsReadResponse) GetMessage() string {
+func (x *ListDeadLettersRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Message
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDeadLettersRequest) GetAfterId() string {
+	if x != nil {
+		return x.AfterId
+	}
+	return ""
+}
+
+// List dead letters response, oldest first
+type ListDeadLettersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*DeadLetter          `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`                                 // entries in the DLQ
+	NextAfterId   string                 `protobuf:"bytes,3,opt,name=next_after_id,json=nextAfterId,proto3" json:"next_after_id,omitempty"` // empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeadLettersResponse) Reset() {
+	*x = ListDeadLettersResponse{}
+	mi := &file_notification_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadLettersResponse) ProtoMessage() {}
+
+func (x *ListDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*ListDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListDeadLettersResponse) GetEntries() []*DeadLetter {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListDeadLettersResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListDeadLettersResponse) GetNextAfterId() string {
+	if x != nil {
+		return x.NextAfterId
 	}
 	return ""
 }
 
+// Requeue dead letters request
+type RequeueDeadLettersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequeueDeadLettersRequest) Reset() {
+	*x = RequeueDeadLettersRequest{}
+	mi := &file_notification_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequeueDeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequeueDeadLettersRequest) ProtoMessage() {}
+
+func (x *RequeueDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequeueDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*RequeueDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RequeueDeadLettersRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+// Requeue dead letters response
+type RequeueDeadLettersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Requeued      int32                  `protobuf:"varint,1,opt,name=requeued,proto3" json:"requeued,omitempty"`
+	NotFound      []string               `protobuf:"bytes,2,rep,name=not_found,json=notFound,proto3" json:"not_found,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequeueDeadLettersResponse) Reset() {
+	*x = RequeueDeadLettersResponse{}
+	mi := &file_notification_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequeueDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequeueDeadLettersResponse) ProtoMessage() {}
+
+func (x *RequeueDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequeueDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*RequeueDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RequeueDeadLettersResponse) GetRequeued() int32 {
+	if x != nil {
+		return x.Requeued
+	}
+	return 0
+}
+
+func (x *RequeueDeadLettersResponse) GetNotFound() []string {
+	if x != nil {
+		return x.NotFound
+	}
+	return nil
+}
+
+// Purge dead letters request
+type PurgeDeadLettersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Remove entries that reached the DLQ more than this many hours ago
+	OlderThanHours int32 `protobuf:"varint,1,opt,name=older_than_hours,json=olderThanHours,proto3" json:"older_than_hours,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PurgeDeadLettersRequest) Reset() {
+	*x = PurgeDeadLettersRequest{}
+	mi := &file_notification_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeDeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeDeadLettersRequest) ProtoMessage() {}
+
+func (x *PurgeDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*PurgeDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PurgeDeadLettersRequest) GetOlderThanHours() int32 {
+	if x != nil {
+		return x.OlderThanHours
+	}
+	return 0
+}
+
+// Purge dead letters response
+type PurgeDeadLettersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Purged        int64                  `protobuf:"varint,1,opt,name=purged,proto3" json:"purged,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeDeadLettersResponse) Reset() {
+	*x = PurgeDeadLettersResponse{}
+	mi := &file_notification_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeDeadLettersResponse) ProtoMessage() {}
+
+func (x *PurgeDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*PurgeDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PurgeDeadLettersResponse) GetPurged() int64 {
+	if x != nil {
+		return x.Purged
+	}
+	return 0
+}
+
 var File_notification_proto protoreflect.FileDescriptor
 
 const file_notification_proto_rawDesc = "" +
 	"\n" +
-	"\x12notification.proto\x12\fnotification\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd1\x03\n" +
+	"\x12notification.proto\x12\fnotification\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xab\x05\n" +
 	"\x11NotificationEvent\x12'\n" +
 	"\x0fnotification_id\x18\x01 \x01(\tR\x0enotificationId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x122\n" +
@@ -646,14 +2793,24 @@ const file_notification_proto_rawDesc = "" +
 	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x12\n" +
 	"\x04read\x18\t \x01(\bR\x04read\x12I\n" +
 	"\bmetadata\x18\n" +
-	" \x03(\v2-.notification.NotificationEvent.MetadataEntryR\bmetadata\x1a;\n" +
+	" \x03(\v2-.notification.NotificationEvent.MetadataEntryR\bmetadata\x12\x1a\n" +
+	"\bcategory\x18\v \x01(\tR\bcategory\x12>\n" +
+	"\bseverity\x18\f \x01(\x0e2\".notification.NotificationSeverityR\bseverity\x12<\n" +
+	"\n" +
+	"read_state\x18\r \x01(\v2\x1d.notification.ReadStateUpdateR\treadState\x12>\n" +
+	"\bpriority\x18\x0e \x01(\x0e2\".notification.NotificationPriorityR\bpriority\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"l\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x8d\x01\n" +
+	"\x0fReadStateUpdate\x12)\n" +
+	"\x10notification_ids\x18\x01 \x03(\tR\x0fnotificationIds\x12\x12\n" +
+	"\x04bulk\x18\x02 \x01(\bR\x04bulk\x12\x18\n" +
+	"\adeleted\x18\x03 \x01(\bR\adeleted\x12!\n" +
+	"\funread_count\x18\x04 \x01(\x05R\vunreadCount\"l\n" +
 	"\x10SubscribeRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12?\n" +
 	"\vevent_types\x18\x02 \x03(\x0e2\x1e.notification.NotificationTypeR\n" +
-	"eventTypes\"\xe5\x02\n" +
+	"eventTypes\"\x81\x04\n" +
 	"\x17SendNotificationRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x122\n" +
 	"\x04type\x18\x02 \x01(\x0e2\x1e.notification.NotificationTypeR\x04type\x12\x14\n" +
@@ -661,29 +2818,173 @@ const file_notification_proto_rawDesc = "" +
 	"\amessage\x18\x04 \x01(\tR\amessage\x12\x17\n" +
 	"\atask_id\x18\x05 \x01(\tR\x06taskId\x12&\n" +
 	"\x0frelated_user_id\x18\x06 \x01(\tR\rrelatedUserId\x12O\n" +
-	"\bmetadata\x18\a \x03(\v23.notification.SendNotificationRequest.MetadataEntryR\bmetadata\x1a;\n" +
+	"\bmetadata\x18\a \x03(\v23.notification.SendNotificationRequest.MetadataEntryR\bmetadata\x12\x1a\n" +
+	"\bcategory\x18\b \x01(\tR\bcategory\x12>\n" +
+	"\bseverity\x18\t \x01(\x0e2\".notification.NotificationSeverityR\bseverity\x12>\n" +
+	"\bpriority\x18\n" +
+	" \x01(\x0e2\".notification.NotificationPriorityR\bpriority\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"]\n" +
 	"\x18SendNotificationResponse\x12'\n" +
 	"\x0fnotification_id\x18\x01 \x01(\tR\x0enotificationId\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\x84\x01\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xc4\x03\n" +
+	"\x1aSendOrgNotificationRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x122\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1e.notification.NotificationTypeR\x04type\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x12R\n" +
+	"\bmetadata\x18\x05 \x03(\v26.notification.SendOrgNotificationRequest.MetadataEntryR\bmetadata\x12\x1a\n" +
+	"\bcategory\x18\x06 \x01(\tR\bcategory\x12>\n" +
+	"\bseverity\x18\a \x01(\x0e2\".notification.NotificationSeverityR\bseverity\x12>\n" +
+	"\bpriority\x18\b \x01(\x0e2\".notification.NotificationPriorityR\bpriority\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc8\x03\n" +
+	"\x1bSendTeamNotificationRequest\x12\x17\n" +
+	"\ateam_id\x18\x01 \x01(\tR\x06teamId\x122\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1e.notification.NotificationTypeR\x04type\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x12S\n" +
+	"\bmetadata\x18\x05 \x03(\v27.notification.SendTeamNotificationRequest.MetadataEntryR\bmetadata\x12\x1a\n" +
+	"\bcategory\x18\x06 \x01(\tR\bcategory\x12>\n" +
+	"\bseverity\x18\a \x01(\x0e2\".notification.NotificationSeverityR\bseverity\x12>\n" +
+	"\bpriority\x18\b \x01(\x0e2\".notification.NotificationPriorityR\bpriority\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"O\n" +
+	"\x15SendBroadcastResponse\x12\x1e\n" +
+	"\n" +
+	"recipients\x18\x01 \x01(\x05R\n" +
+	"recipients\x12\x16\n" +
+	"\x06queued\x18\x02 \x01(\x05R\x06queued\"\x9f\x02\n" +
 	"\x17GetNotificationsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
 	"\vunread_only\x18\x02 \x01(\bR\n" +
 	"unreadOnly\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xa5\x01\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x122\n" +
+	"\x04type\x18\x05 \x01(\x0e2\x1e.notification.NotificationTypeR\x04type\x12\x17\n" +
+	"\atask_id\x18\x06 \x01(\tR\x06taskId\x12\x1a\n" +
+	"\bcategory\x18\a \x01(\tR\bcategory\x120\n" +
+	"\x14critical_unread_only\x18\b \x01(\bR\x12criticalUnreadOnly\"\xd6\x02\n" +
 	"\x18GetNotificationsResponse\x12E\n" +
 	"\rnotifications\x18\x01 \x03(\v2\x1f.notification.NotificationEventR\rnotifications\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
 	"totalCount\x12!\n" +
-	"\funread_count\x18\x03 \x01(\x05R\vunreadCount\"U\n" +
+	"\funread_count\x18\x03 \x01(\x05R\vunreadCount\x12j\n" +
+	"\x12unread_by_category\x18\x04 \x03(\v2<.notification.GetNotificationsResponse.UnreadByCategoryEntryR\x10unreadByCategory\x1aC\n" +
+	"\x15UnreadByCategoryEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"U\n" +
 	"\x11MarkAsReadRequest\x12'\n" +
 	"\x0fnotification_id\x18\x01 \x01(\tR\x0enotificationId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\".\n" +
 	"\x12MarkAsReadResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage*\x91\x02\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\x97\x01\n" +
+	"\x14MarkAllAsReadRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x122\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1e.notification.NotificationTypeR\x04type\x122\n" +
+	"\x06before\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06before\"1\n" +
+	"\x15MarkAllAsReadResponse\x12\x18\n" +
+	"\aupdated\x18\x01 \x01(\x05R\aupdated\"]\n" +
+	"\x19DeleteNotificationRequest\x12'\n" +
+	"\x0fnotification_id\x18\x01 \x01(\tR\x0enotificationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"6\n" +
+	"\x1aDeleteNotificationResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"Q\n" +
+	"\x19ClearNotificationsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tread_only\x18\x02 \x01(\bR\breadOnly\"6\n" +
+	"\x1aClearNotificationsResponse\x12\x18\n" +
+	"\adeleted\x18\x01 \x01(\x05R\adeleted\"0\n" +
+	"\x15ExportUserDataRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\",\n" +
+	"\x16ExportUserDataResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"C\n" +
+	"\x14EraseUserDataRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04mode\x18\x02 \x01(\tR\x04mode\"\xa3\x01\n" +
+	"\x15EraseUserDataResponse\x12M\n" +
+	"\baffected\x18\x01 \x03(\v21.notification.EraseUserDataResponse.AffectedEntryR\baffected\x1a;\n" +
+	"\rAffectedEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\xfa\x01\n" +
+	"\x10TeamsIntegration\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12!\n" +
+	"\fwebhook_host\x18\x02 \x01(\tR\vwebhookHost\x12-\n" +
+	"\x12notify_assignments\x18\x03 \x01(\bR\x11notifyAssignments\x12(\n" +
+	"\x10notify_due_dates\x18\x04 \x01(\bR\x0enotifyDueDates\x12\x18\n" +
+	"\aenabled\x18\x05 \x01(\bR\aenabled\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xc7\x01\n" +
+	"\x1aSetTeamsIntegrationRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x1f\n" +
+	"\vwebhook_url\x18\x02 \x01(\tR\n" +
+	"webhookUrl\x12-\n" +
+	"\x12notify_assignments\x18\x03 \x01(\bR\x11notifyAssignments\x12(\n" +
+	"\x10notify_due_dates\x18\x04 \x01(\bR\x0enotifyDueDates\x12\x18\n" +
+	"\aenabled\x18\x05 \x01(\bR\aenabled\"y\n" +
+	"\x1bSetTeamsIntegrationResponse\x12@\n" +
+	"\vintegration\x18\x01 \x01(\v2\x1e.notification.TeamsIntegrationR\vintegration\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"3\n" +
+	"\x1aGetTeamsIntegrationRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\"_\n" +
+	"\x1bGetTeamsIntegrationResponse\x12@\n" +
+	"\vintegration\x18\x01 \x01(\v2\x1e.notification.TeamsIntegrationR\vintegration\"6\n" +
+	"\x1dDeleteTeamsIntegrationRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\":\n" +
+	"\x1eDeleteTeamsIntegrationResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\xf1\x01\n" +
+	"\fAnnouncement\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\x12\x12\n" +
+	"\x04link\x18\x04 \x01(\tR\x04link\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x06 \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x95\x01\n" +
+	"\x1aPublishAnnouncementRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x02 \x01(\tR\x04body\x12\x12\n" +
+	"\x04link\x18\x03 \x01(\tR\x04link\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"]\n" +
+	"\x1bPublishAnnouncementResponse\x12>\n" +
+	"\fannouncement\x18\x01 \x01(\v2\x1a.notification.AnnouncementR\fannouncement\"\x1f\n" +
+	"\x1dGetActiveAnnouncementsRequest\"b\n" +
+	"\x1eGetActiveAnnouncementsResponse\x12@\n" +
+	"\rannouncements\x18\x01 \x03(\v2\x1a.notification.AnnouncementR\rannouncements\"\xa9\x02\n" +
+	"\n" +
+	"DeadLetter\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\x13original_message_id\x18\x02 \x01(\tR\x11originalMessageId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12'\n" +
+	"\x0fnotification_id\x18\x04 \x01(\tR\x0enotificationId\x12\x14\n" +
+	"\x05title\x18\x05 \x01(\tR\x05title\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\x12\x1a\n" +
+	"\battempts\x18\a \x01(\x05R\battempts\x127\n" +
+	"\tfailed_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\bfailedAt\x12\x18\n" +
+	"\apayload\x18\t \x01(\tR\apayload\"P\n" +
+	"\x16ListDeadLettersRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x19\n" +
+	"\bafter_id\x18\x02 \x01(\tR\aafterId\"\x87\x01\n" +
+	"\x17ListDeadLettersResponse\x122\n" +
+	"\aentries\x18\x01 \x03(\v2\x18.notification.DeadLetterR\aentries\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\"\n" +
+	"\rnext_after_id\x18\x03 \x01(\tR\vnextAfterId\"-\n" +
+	"\x19RequeueDeadLettersRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"U\n" +
+	"\x1aRequeueDeadLettersResponse\x12\x1a\n" +
+	"\brequeued\x18\x01 \x01(\x05R\brequeued\x12\x1b\n" +
+	"\tnot_found\x18\x02 \x03(\tR\bnotFound\"C\n" +
+	"\x17PurgeDeadLettersRequest\x12(\n" +
+	"\x10older_than_hours\x18\x01 \x01(\x05R\x0eolderThanHours\"2\n" +
+	"\x18PurgeDeadLettersResponse\x12\x16\n" +
+	"\x06purged\x18\x01 \x01(\x03R\x06purged*\xd3\x03\n" +
 	"\x10NotificationType\x12!\n" +
 	"\x1dNOTIFICATION_TYPE_UNSPECIFIED\x10\x00\x12#\n" +
 	"\x1fNOTIFICATION_TYPE_TASK_ASSIGNED\x10\x01\x12\"\n" +
@@ -691,13 +2992,44 @@ const file_notification_proto_rawDesc = "" +
 	" NOTIFICATION_TYPE_TASK_COMPLETED\x10\x03\x12\"\n" +
 	"\x1eNOTIFICATION_TYPE_TASK_COMMENT\x10\x04\x12#\n" +
 	"\x1fNOTIFICATION_TYPE_TASK_DUE_SOON\x10\x05\x12\"\n" +
-	"\x1eNOTIFICATION_TYPE_TASK_OVERDUE\x10\x062\x8f\x04\n" +
+	"\x1eNOTIFICATION_TYPE_TASK_OVERDUE\x10\x06\x12\"\n" +
+	"\x1eNOTIFICATION_TYPE_TASK_MENTION\x10\a\x12$\n" +
+	" NOTIFICATION_TYPE_ACCOUNT_LOCKED\x10\b\x12&\n" +
+	"\"NOTIFICATION_TYPE_NEW_DEVICE_LOGIN\x10\t\x12%\n" +
+	"!NOTIFICATION_TYPE_SESSION_REVOKED\x10\n" +
+	"\x12'\n" +
+	"#NOTIFICATION_TYPE_STATUS_REPORT_DUE\x10\v*\xa4\x01\n" +
+	"\x14NotificationSeverity\x12%\n" +
+	"!NOTIFICATION_SEVERITY_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aNOTIFICATION_SEVERITY_INFO\x10\x01\x12!\n" +
+	"\x1dNOTIFICATION_SEVERITY_WARNING\x10\x02\x12\"\n" +
+	"\x1eNOTIFICATION_SEVERITY_CRITICAL\x10\x03*\xa2\x01\n" +
+	"\x14NotificationPriority\x12%\n" +
+	"!NOTIFICATION_PRIORITY_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19NOTIFICATION_PRIORITY_LOW\x10\x01\x12 \n" +
+	"\x1cNOTIFICATION_PRIORITY_NORMAL\x10\x02\x12\"\n" +
+	"\x1eNOTIFICATION_PRIORITY_CRITICAL\x10\x032\x8e\x15\n" +
 	"\x13NotificationService\x12_\n" +
 	"\x18SubscribeToNotifications\x12\x1e.notification.SubscribeRequest\x1a\x1f.notification.NotificationEvent(\x010\x01\x12\x88\x01\n" +
-	"\x10SendNotification\x12%.notification.SendNotificationRequest\x1a&.notification.SendNotificationResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/notifications/send\x12\x80\x01\n" +
+	"\x10SendNotification\x12%.notification.SendNotificationRequest\x1a&.notification.SendNotificationResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/notifications/send\x12\x9d\x01\n" +
+	"\x13SendOrgNotification\x12(.notification.SendOrgNotificationRequest\x1a#.notification.SendBroadcastResponse\"7\x82\xd3\xe4\x93\x021:\x01*\",/api/v1/organizations/{org_id}/notifications\x12\x98\x01\n" +
+	"\x14SendTeamNotification\x12).notification.SendTeamNotificationRequest\x1a#.notification.SendBroadcastResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/api/v1/teams/{team_id}/notifications\x12\x80\x01\n" +
 	"\x10GetNotifications\x12%.notification.GetNotificationsRequest\x1a&.notification.GetNotificationsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/notifications\x12\x88\x01\n" +
 	"\n" +
-	"MarkAsRead\x12\x1f.notification.MarkAsReadRequest\x1a .notification.MarkAsReadResponse\"7\x82\xd3\xe4\x93\x021:\x01*2,/api/v1/notifications/{notification_id}/readBRZPgithub.com/chanduchitikam/task-management-system/proto/notification;notificationb\x06proto3"
+	"MarkAsRead\x12\x1f.notification.MarkAsReadRequest\x1a .notification.MarkAsReadResponse\"7\x82\xd3\xe4\x93\x021:\x01*2,/api/v1/notifications/{notification_id}/read\x12\x83\x01\n" +
+	"\rMarkAllAsRead\x12\".notification.MarkAllAsReadRequest\x1a#.notification.MarkAllAsReadResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/notifications/read-all\x12\x98\x01\n" +
+	"\x12DeleteNotification\x12'.notification.DeleteNotificationRequest\x1a(.notification.DeleteNotificationResponse\"/\x82\xd3\xe4\x93\x02)*'/api/v1/notifications/{notification_id}\x12\x86\x01\n" +
+	"\x12ClearNotifications\x12'.notification.ClearNotificationsRequest\x1a(.notification.ClearNotificationsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17*\x15/api/v1/notifications\x12[\n" +
+	"\x0eExportUserData\x12#.notification.ExportUserDataRequest\x1a$.notification.ExportUserDataResponse\x12X\n" +
+	"\rEraseUserData\x12\".notification.EraseUserDataRequest\x1a#.notification.EraseUserDataResponse\x12\xa8\x01\n" +
+	"\x13SetTeamsIntegration\x12(.notification.SetTeamsIntegrationRequest\x1a).notification.SetTeamsIntegrationResponse\"<\x82\xd3\xe4\x93\x026:\x01*\x1a1/api/v1/organizations/{org_id}/integrations/teams\x12\xa5\x01\n" +
+	"\x13GetTeamsIntegration\x12(.notification.GetTeamsIntegrationRequest\x1a).notification.GetTeamsIntegrationResponse\"9\x82\xd3\xe4\x93\x023\x121/api/v1/organizations/{org_id}/integrations/teams\x12\xae\x01\n" +
+	"\x16DeleteTeamsIntegration\x12+.notification.DeleteTeamsIntegrationRequest\x1a,.notification.DeleteTeamsIntegrationResponse\"9\x82\xd3\xe4\x93\x023*1/api/v1/organizations/{org_id}/integrations/teams\x12\x92\x01\n" +
+	"\x13PublishAnnouncement\x12(.notification.PublishAnnouncementRequest\x1a).notification.PublishAnnouncementResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/admin/announcements\x12\x92\x01\n" +
+	"\x16GetActiveAnnouncements\x12+.notification.GetActiveAnnouncementsRequest\x1a,.notification.GetActiveAnnouncementsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/announcements\x12\x87\x01\n" +
+	"\x0fListDeadLetters\x12$.notification.ListDeadLettersRequest\x1a%.notification.ListDeadLettersResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/admin/notifications/dlq\x12\x9b\x01\n" +
+	"\x12RequeueDeadLetters\x12'.notification.RequeueDeadLettersRequest\x1a(.notification.RequeueDeadLettersResponse\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/api/v1/admin/notifications/dlq/requeue\x12\x8a\x01\n" +
+	"\x10PurgeDeadLetters\x12%.notification.PurgeDeadLettersRequest\x1a&.notification.PurgeDeadLettersResponse\"'\x82\xd3\xe4\x93\x02!*\x1f/api/v1/admin/notifications/dlqBRZPgithub.com/chanduchitikam/task-management-system/proto/notification;notificationb\x06proto3"
 
 var (
 	file_notification_proto_rawDescOnce sync.Once
@@ -711,43 +3043,140 @@ func file_notification_proto_rawDescGZIP() []byte {
 	return file_notification_proto_rawDescData
 }
 
-var file_notification_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_notification_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_notification_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_notification_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
 var file_notification_proto_goTypes = []any{
-	(NotificationType)(0),            // 0: notification.NotificationType
-	(*NotificationEvent)(nil),        // 1: notification.NotificationEvent
-	(*SubscribeRequest)(nil),         // 2: notification.SubscribeRequest
-	(*SendNotificationRequest)(nil),  // 3: notification.SendNotificationRequest
-	(*SendNotificationResponse)(nil), // 4: notification.SendNotificationResponse
-	(*GetNotificationsRequest)(nil),  // 5: notification.GetNotificationsRequest
-	(*GetNotificationsResponse)(nil), // 6: notification.GetNotificationsResponse
-	(*MarkAsReadRequest)(nil),        // 7: notification.MarkAsReadRequest
-	(*MarkAsReadResponse)(nil),       // 8: notification.MarkAsReadResponse
-	nil,                              // 9: notification.NotificationEvent.MetadataEntry
-	nil,                              // 10: notification.SendNotificationRequest.MetadataEntry
-	(*timestamppb.Timestamp)(nil),    // 11: google.protobuf.Timestamp
+	(NotificationType)(0),                  // 0: notification.NotificationType
+	(NotificationSeverity)(0),              // 1: notification.NotificationSeverity
+	(NotificationPriority)(0),              // 2: notification.NotificationPriority
+	(*NotificationEvent)(nil),              // 3: notification.NotificationEvent
+	(*ReadStateUpdate)(nil),                // 4: notification.ReadStateUpdate
+	(*SubscribeRequest)(nil),               // 5: notification.SubscribeRequest
+	(*SendNotificationRequest)(nil),        // 6: notification.SendNotificationRequest
+	(*SendNotificationResponse)(nil),       // 7: notification.SendNotificationResponse
+	(*SendOrgNotificationRequest)(nil),     // 8: notification.SendOrgNotificationRequest
+	(*SendTeamNotificationRequest)(nil),    // 9: notification.SendTeamNotificationRequest
+	(*SendBroadcastResponse)(nil),          // 10: notification.SendBroadcastResponse
+	(*GetNotificationsRequest)(nil),        // 11: notification.GetNotificationsRequest
+	(*GetNotificationsResponse)(nil),       // 12: notification.GetNotificationsResponse
+	(*MarkAsReadRequest)(nil),              // 13: notification.MarkAsReadRequest
+	(*MarkAsReadResponse)(nil),             // 14: notification.MarkAsReadResponse
+	(*MarkAllAsReadRequest)(nil),           // 15: notification.MarkAllAsReadRequest
+	(*MarkAllAsReadResponse)(nil),          // 16: notification.MarkAllAsReadResponse
+	(*DeleteNotificationRequest)(nil),      // 17: notification.DeleteNotificationRequest
+	(*DeleteNotificationResponse)(nil),     // 18: notification.DeleteNotificationResponse
+	(*ClearNotificationsRequest)(nil),      // 19: notification.ClearNotificationsRequest
+	(*ClearNotificationsResponse)(nil),     // 20: notification.ClearNotificationsResponse
+	(*ExportUserDataRequest)(nil),          // 21: notification.ExportUserDataRequest
+	(*ExportUserDataResponse)(nil),         // 22: notification.ExportUserDataResponse
+	(*EraseUserDataRequest)(nil),           // 23: notification.EraseUserDataRequest
+	(*EraseUserDataResponse)(nil),          // 24: notification.EraseUserDataResponse
+	(*TeamsIntegration)(nil),               // 25: notification.TeamsIntegration
+	(*SetTeamsIntegrationRequest)(nil),     // 26: notification.SetTeamsIntegrationRequest
+	(*SetTeamsIntegrationResponse)(nil),    // 27: notification.SetTeamsIntegrationResponse
+	(*GetTeamsIntegrationRequest)(nil),     // 28: notification.GetTeamsIntegrationRequest
+	(*GetTeamsIntegrationResponse)(nil),    // 29: notification.GetTeamsIntegrationResponse
+	(*DeleteTeamsIntegrationRequest)(nil),  // 30: notification.DeleteTeamsIntegrationRequest
+	(*DeleteTeamsIntegrationResponse)(nil), // 31: notification.DeleteTeamsIntegrationResponse
+	(*Announcement)(nil),                   // 32: notification.Announcement
+	(*PublishAnnouncementRequest)(nil),     // 33: notification.PublishAnnouncementRequest
+	(*PublishAnnouncementResponse)(nil),    // 34: notification.PublishAnnouncementResponse
+	(*GetActiveAnnouncementsRequest)(nil),  // 35: notification.GetActiveAnnouncementsRequest
+	(*GetActiveAnnouncementsResponse)(nil), // 36: notification.GetActiveAnnouncementsResponse
+	(*DeadLetter)(nil),                     // 37: notification.DeadLetter
+	(*ListDeadLettersRequest)(nil),         // 38: notification.ListDeadLettersRequest
+	(*ListDeadLettersResponse)(nil),        // 39: notification.ListDeadLettersResponse
+	(*RequeueDeadLettersRequest)(nil),      // 40: notification.RequeueDeadLettersRequest
+	(*RequeueDeadLettersResponse)(nil),     // 41: notification.RequeueDeadLettersResponse
+	(*PurgeDeadLettersRequest)(nil),        // 42: notification.PurgeDeadLettersRequest
+	(*PurgeDeadLettersResponse)(nil),       // 43: notification.PurgeDeadLettersResponse
+	nil,                                    // 44: notification.NotificationEvent.MetadataEntry
+	nil,                                    // 45: notification.SendNotificationRequest.MetadataEntry
+	nil,                                    // 46: notification.SendOrgNotificationRequest.MetadataEntry
+	nil,                                    // 47: notification.SendTeamNotificationRequest.MetadataEntry
+	nil,                                    // 48: notification.GetNotificationsResponse.UnreadByCategoryEntry
+	nil,                                    // 49: notification.EraseUserDataResponse.AffectedEntry
+	(*timestamppb.Timestamp)(nil),          // 50: google.protobuf.Timestamp
 }
 var file_notification_proto_depIdxs = []int32{
 	0,  // 0: notification.NotificationEvent.type:type_name -> notification.NotificationType
-	11, // 1: notification.NotificationEvent.created_at:type_name -> google.protobuf.Timestamp
-	9,  // 2: notification.NotificationEvent.metadata:type_name -> notification.NotificationEvent.MetadataEntry
-	0,  // 3: notification.SubscribeRequest.event_types:type_name -> notification.NotificationType
-	0,  // 4: notification.SendNotificationRequest.type:type_name -> notification.NotificationType
-	10, // 5: notification.SendNotificationRequest.metadata:type_name -> notification.SendNotificationRequest.MetadataEntry
-	1,  // 6: notification.GetNotificationsResponse.notifications:type_name -> notification.NotificationEvent
-	2,  // 7: notification.NotificationService.SubscribeToNotifications:input_type -> notification.SubscribeRequest
-	3,  // 8: notification.NotificationService.SendNotification:input_type -> notification.SendNotificationRequest
-	5,  // 9: notification.NotificationService.GetNotifications:input_type -> notification.GetNotificationsRequest
-	7,  // 10: notification.NotificationService.MarkAsRead:input_type -> notification.MarkAsReadRequest
-	1,  // 11: notification.NotificationService.SubscribeToNotifications:output_type -> notification.NotificationEvent
-	4,  // 12: notification.NotificationService.SendNotification:output_type -> notification.SendNotificationResponse
-	6,  // 13: notification.NotificationService.GetNotifications:output_type -> notification.GetNotificationsResponse
-	8,  // 14: notification.NotificationService.MarkAsRead:output_type -> notification.MarkAsReadResponse
-	11, // [11:15] is the sub-list for method output_type
-	7,  // [7:11] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	50, // 1: notification.NotificationEvent.created_at:type_name -> google.protobuf.Timestamp
+	44, // 2: notification.NotificationEvent.metadata:type_name -> notification.NotificationEvent.MetadataEntry
+	1,  // 3: notification.NotificationEvent.severity:type_name -> notification.NotificationSeverity
+	4,  // 4: notification.NotificationEvent.read_state:type_name -> notification.ReadStateUpdate
+	2,  // 5: notification.NotificationEvent.priority:type_name -> notification.NotificationPriority
+	0,  // 6: notification.SubscribeRequest.event_types:type_name -> notification.NotificationType
+	0,  // 7: notification.SendNotificationRequest.type:type_name -> notification.NotificationType
+	45, // 8: notification.SendNotificationRequest.metadata:type_name -> notification.SendNotificationRequest.MetadataEntry
+	1,  // 9: notification.SendNotificationRequest.severity:type_name -> notification.NotificationSeverity
+	2,  // 10: notification.SendNotificationRequest.priority:type_name -> notification.NotificationPriority
+	0,  // 11: notification.SendOrgNotificationRequest.type:type_name -> notification.NotificationType
+	46, // 12: notification.SendOrgNotificationRequest.metadata:type_name -> notification.SendOrgNotificationRequest.MetadataEntry
+	1,  // 13: notification.SendOrgNotificationRequest.severity:type_name -> notification.NotificationSeverity
+	2,  // 14: notification.SendOrgNotificationRequest.priority:type_name -> notification.NotificationPriority
+	0,  // 15: notification.SendTeamNotificationRequest.type:type_name -> notification.NotificationType
+	47, // 16: notification.SendTeamNotificationRequest.metadata:type_name -> notification.SendTeamNotificationRequest.MetadataEntry
+	1,  // 17: notification.SendTeamNotificationRequest.severity:type_name -> notification.NotificationSeverity
+	2,  // 18: notification.SendTeamNotificationRequest.priority:type_name -> notification.NotificationPriority
+	0,  // 19: notification.GetNotificationsRequest.type:type_name -> notification.NotificationType
+	3,  // 20: notification.GetNotificationsResponse.notifications:type_name -> notification.NotificationEvent
+	48, // 21: notification.GetNotificationsResponse.unread_by_category:type_name -> notification.GetNotificationsResponse.UnreadByCategoryEntry
+	0,  // 22: notification.MarkAllAsReadRequest.type:type_name -> notification.NotificationType
+	50, // 23: notification.MarkAllAsReadRequest.before:type_name -> google.protobuf.Timestamp
+	49, // 24: notification.EraseUserDataResponse.affected:type_name -> notification.EraseUserDataResponse.AffectedEntry
+	50, // 25: notification.TeamsIntegration.updated_at:type_name -> google.protobuf.Timestamp
+	25, // 26: notification.SetTeamsIntegrationResponse.integration:type_name -> notification.TeamsIntegration
+	25, // 27: notification.GetTeamsIntegrationResponse.integration:type_name -> notification.TeamsIntegration
+	50, // 28: notification.Announcement.expires_at:type_name -> google.protobuf.Timestamp
+	50, // 29: notification.Announcement.created_at:type_name -> google.protobuf.Timestamp
+	50, // 30: notification.PublishAnnouncementRequest.expires_at:type_name -> google.protobuf.Timestamp
+	32, // 31: notification.PublishAnnouncementResponse.announcement:type_name -> notification.Announcement
+	32, // 32: notification.GetActiveAnnouncementsResponse.announcements:type_name -> notification.Announcement
+	50, // 33: notification.DeadLetter.failed_at:type_name -> google.protobuf.Timestamp
+	37, // 34: notification.ListDeadLettersResponse.entries:type_name -> notification.DeadLetter
+	5,  // 35: notification.NotificationService.SubscribeToNotifications:input_type -> notification.SubscribeRequest
+	6,  // 36: notification.NotificationService.SendNotification:input_type -> notification.SendNotificationRequest
+	8,  // 37: notification.NotificationService.SendOrgNotification:input_type -> notification.SendOrgNotificationRequest
+	9,  // 38: notification.NotificationService.SendTeamNotification:input_type -> notification.SendTeamNotificationRequest
+	11, // 39: notification.NotificationService.GetNotifications:input_type -> notification.GetNotificationsRequest
+	13, // 40: notification.NotificationService.MarkAsRead:input_type -> notification.MarkAsReadRequest
+	15, // 41: notification.NotificationService.MarkAllAsRead:input_type -> notification.MarkAllAsReadRequest
+	17, // 42: notification.NotificationService.DeleteNotification:input_type -> notification.DeleteNotificationRequest
+	19, // 43: notification.NotificationService.ClearNotifications:input_type -> notification.ClearNotificationsRequest
+	21, // 44: notification.NotificationService.ExportUserData:input_type -> notification.ExportUserDataRequest
+	23, // 45: notification.NotificationService.EraseUserData:input_type -> notification.EraseUserDataRequest
+	26, // 46: notification.NotificationService.SetTeamsIntegration:input_type -> notification.SetTeamsIntegrationRequest
+	28, // 47: notification.NotificationService.GetTeamsIntegration:input_type -> notification.GetTeamsIntegrationRequest
+	30, // 48: notification.NotificationService.DeleteTeamsIntegration:input_type -> notification.DeleteTeamsIntegrationRequest
+	33, // 49: notification.NotificationService.PublishAnnouncement:input_type -> notification.PublishAnnouncementRequest
+	35, // 50: notification.NotificationService.GetActiveAnnouncements:input_type -> notification.GetActiveAnnouncementsRequest
+	38, // 51: notification.NotificationService.ListDeadLetters:input_type -> notification.ListDeadLettersRequest
+	40, // 52: notification.NotificationService.RequeueDeadLetters:input_type -> notification.RequeueDeadLettersRequest
+	42, // 53: notification.NotificationService.PurgeDeadLetters:input_type -> notification.PurgeDeadLettersRequest
+	3,  // 54: notification.NotificationService.SubscribeToNotifications:output_type -> notification.NotificationEvent
+	7,  // 55: notification.NotificationService.SendNotification:output_type -> notification.SendNotificationResponse
+	10, // 56: notification.NotificationService.SendOrgNotification:output_type -> notification.SendBroadcastResponse
+	10, // 57: notification.NotificationService.SendTeamNotification:output_type -> notification.SendBroadcastResponse
+	12, // 58: notification.NotificationService.GetNotifications:output_type -> notification.GetNotificationsResponse
+	14, // 59: notification.NotificationService.MarkAsRead:output_type -> notification.MarkAsReadResponse
+	16, // 60: notification.NotificationService.MarkAllAsRead:output_type -> notification.MarkAllAsReadResponse
+	18, // 61: notification.NotificationService.DeleteNotification:output_type -> notification.DeleteNotificationResponse
+	20, // 62: notification.NotificationService.ClearNotifications:output_type -> notification.ClearNotificationsResponse
+	22, // 63: notification.NotificationService.ExportUserData:output_type -> notification.ExportUserDataResponse
+	24, // 64: notification.NotificationService.EraseUserData:output_type -> notification.EraseUserDataResponse
+	27, // 65: notification.NotificationService.SetTeamsIntegration:output_type -> notification.SetTeamsIntegrationResponse
+	29, // 66: notification.NotificationService.GetTeamsIntegration:output_type -> notification.GetTeamsIntegrationResponse
+	31, // 67: notification.NotificationService.DeleteTeamsIntegration:output_type -> notification.DeleteTeamsIntegrationResponse
+	34, // 68: notification.NotificationService.PublishAnnouncement:output_type -> notification.PublishAnnouncementResponse
+	36, // 69: notification.NotificationService.GetActiveAnnouncements:output_type -> notification.GetActiveAnnouncementsResponse
+	39, // 70: notification.NotificationService.ListDeadLetters:output_type -> notification.ListDeadLettersResponse
+	41, // 71: notification.NotificationService.RequeueDeadLetters:output_type -> notification.RequeueDeadLettersResponse
+	43, // 72: notification.NotificationService.PurgeDeadLetters:output_type -> notification.PurgeDeadLettersResponse
+	54, // [54:73] is the sub-list for method output_type
+	35, // [35:54] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
 }
 
 func init() { file_notification_proto_init() }
@@ -760,8 +3189,8 @@ func file_notification_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_notification_proto_rawDesc), len(file_notification_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   10,
+			NumEnums:      3,
+			NumMessages:   47,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -21,8 +21,23 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	NotificationService_SubscribeToNotifications_FullMethodName = "/notification.NotificationService/SubscribeToNotifications"
 	NotificationService_SendNotification_FullMethodName         = "/notification.NotificationService/SendNotification"
+	NotificationService_SendOrgNotification_FullMethodName      = "/notification.NotificationService/SendOrgNotification"
+	NotificationService_SendTeamNotification_FullMethodName     = "/notification.NotificationService/SendTeamNotification"
 	NotificationService_GetNotifications_FullMethodName         = "/notification.NotificationService/GetNotifications"
 	NotificationService_MarkAsRead_FullMethodName               = "/notification.NotificationService/MarkAsRead"
+	NotificationService_MarkAllAsRead_FullMethodName            = "/notification.NotificationService/MarkAllAsRead"
+	NotificationService_DeleteNotification_FullMethodName       = "/notification.NotificationService/DeleteNotification"
+	NotificationService_ClearNotifications_FullMethodName       = "/notification.NotificationService/ClearNotifications"
+	NotificationService_ExportUserData_FullMethodName           = "/notification.NotificationService/ExportUserData"
+	NotificationService_EraseUserData_FullMethodName            = "/notification.NotificationService/EraseUserData"
+	NotificationService_SetTeamsIntegration_FullMethodName      = "/notification.NotificationService/SetTeamsIntegration"
+	NotificationService_GetTeamsIntegration_FullMethodName      = "/notification.NotificationService/GetTeamsIntegration"
+	NotificationService_DeleteTeamsIntegration_FullMethodName   = "/notification.NotificationService/DeleteTeamsIntegration"
+	NotificationService_PublishAnnouncement_FullMethodName      = "/notification.NotificationService/PublishAnnouncement"
+	NotificationService_GetActiveAnnouncements_FullMethodName   = "/notification.NotificationService/GetActiveAnnouncements"
+	NotificationService_ListDeadLetters_FullMethodName          = "/notification.NotificationService/ListDeadLetters"
+	NotificationService_RequeueDeadLetters_FullMethodName       = "/notification.NotificationService/RequeueDeadLetters"
+	NotificationService_PurgeDeadLetters_FullMethodName         = "/notification.NotificationService/PurgeDeadLetters"
 )
 
 // NotificationServiceClient is the client API for NotificationService service.
@@ -35,10 +50,37 @@ type NotificationServiceClient interface {
 	SubscribeToNotifications(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[SubscribeRequest, NotificationEvent], error)
 	// Send notification
 	SendNotification(ctx context.Context, in *SendNotificationRequest, opts ...grpc.CallOption) (*SendNotificationResponse, error)
+	// Send a notification to every member of an organization or team, such as
+	// an announcement or maintenance notice
+	SendOrgNotification(ctx context.Context, in *SendOrgNotificationRequest, opts ...grpc.CallOption) (*SendBroadcastResponse, error)
+	SendTeamNotification(ctx context.Context, in *SendTeamNotificationRequest, opts ...grpc.CallOption) (*SendBroadcastResponse, error)
 	// Get notification history
 	GetNotifications(ctx context.Context, in *GetNotificationsRequest, opts ...grpc.CallOption) (*GetNotificationsResponse, error)
 	// Mark notification as read
 	MarkAsRead(ctx context.Context, in *MarkAsReadRequest, opts ...grpc.CallOption) (*MarkAsReadResponse, error)
+	// Mark every unread notification as read, optionally only of one type or
+	// created before a time
+	MarkAllAsRead(ctx context.Context, in *MarkAllAsReadRequest, opts ...grpc.CallOption) (*MarkAllAsReadResponse, error)
+	// Delete a notification
+	DeleteNotification(ctx context.Context, in *DeleteNotificationRequest, opts ...grpc.CallOption) (*DeleteNotificationResponse, error)
+	// Delete all of a user's notifications, or only the read ones
+	ClearNotifications(ctx context.Context, in *ClearNotificationsRequest, opts ...grpc.CallOption) (*ClearNotificationsResponse, error)
+	// Export everything stored about a user (internal, used for GDPR export)
+	ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*ExportUserDataResponse, error)
+	// Anonymize or delete a user's data (internal, used for GDPR erasure)
+	EraseUserData(ctx context.Context, in *EraseUserDataRequest, opts ...grpc.CallOption) (*EraseUserDataResponse, error)
+	// Microsoft Teams webhook of an organization
+	SetTeamsIntegration(ctx context.Context, in *SetTeamsIntegrationRequest, opts ...grpc.CallOption) (*SetTeamsIntegrationResponse, error)
+	GetTeamsIntegration(ctx context.Context, in *GetTeamsIntegrationRequest, opts ...grpc.CallOption) (*GetTeamsIntegrationResponse, error)
+	DeleteTeamsIntegration(ctx context.Context, in *DeleteTeamsIntegrationRequest, opts ...grpc.CallOption) (*DeleteTeamsIntegrationResponse, error)
+	// Publish a platform-wide announcement (platform admins)
+	PublishAnnouncement(ctx context.Context, in *PublishAnnouncementRequest, opts ...grpc.CallOption) (*PublishAnnouncementResponse, error)
+	// Announcements that have not expired yet, newest first
+	GetActiveAnnouncements(ctx context.Context, in *GetActiveAnnouncementsRequest, opts ...grpc.CallOption) (*GetActiveAnnouncementsResponse, error)
+	// Dead letter queue of undeliverable notifications (platform admins)
+	ListDeadLetters(ctx context.Context, in *ListDeadLettersRequest, opts ...grpc.CallOption) (*ListDeadLettersResponse, error)
+	RequeueDeadLetters(ctx context.Context, in *RequeueDeadLettersRequest, opts ...grpc.CallOption) (*RequeueDeadLettersResponse, error)
+	PurgeDeadLetters(ctx context.Context, in *PurgeDeadLettersRequest, opts ...grpc.CallOption) (*PurgeDeadLettersResponse, error)
 }
 
 type notificationServiceClient struct {
@@ -72,6 +114,26 @@ func (c *notificationServiceClient) SendNotification(ctx context.Context, in *Se
 	return out, nil
 }
 
+func (c *notificationServiceClient) SendOrgNotification(ctx context.Context, in *SendOrgNotificationRequest, opts ...grpc.CallOption) (*SendBroadcastResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendBroadcastResponse)
+	err := c.cc.Invoke(ctx, NotificationService_SendOrgNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) SendTeamNotification(ctx context.Context, in *SendTeamNotificationRequest, opts ...grpc.CallOption) (*SendBroadcastResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendBroadcastResponse)
+	err := c.cc.Invoke(ctx, NotificationService_SendTeamNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *notificationServiceClient) GetNotifications(ctx context.Context, in *GetNotificationsRequest, opts ...grpc.CallOption) (*GetNotificationsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetNotificationsResponse)
@@ -92,6 +154,136 @@ func (c *notificationServiceClient) MarkAsRead(ctx context.Context, in *MarkAsRe
 	return out, nil
 }
 
+func (c *notificationServiceClient) MarkAllAsRead(ctx context.Context, in *MarkAllAsReadRequest, opts ...grpc.CallOption) (*MarkAllAsReadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkAllAsReadResponse)
+	err := c.cc.Invoke(ctx, NotificationService_MarkAllAsRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) DeleteNotification(ctx context.Context, in *DeleteNotificationRequest, opts ...grpc.CallOption) (*DeleteNotificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteNotificationResponse)
+	err := c.cc.Invoke(ctx, NotificationService_DeleteNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) ClearNotifications(ctx context.Context, in *ClearNotificationsRequest, opts ...grpc.CallOption) (*ClearNotificationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearNotificationsResponse)
+	err := c.cc.Invoke(ctx, NotificationService_ClearNotifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*ExportUserDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportUserDataResponse)
+	err := c.cc.Invoke(ctx, NotificationService_ExportUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) EraseUserData(ctx context.Context, in *EraseUserDataRequest, opts ...grpc.CallOption) (*EraseUserDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EraseUserDataResponse)
+	err := c.cc.Invoke(ctx, NotificationService_EraseUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) SetTeamsIntegration(ctx context.Context, in *SetTeamsIntegrationRequest, opts ...grpc.CallOption) (*SetTeamsIntegrationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTeamsIntegrationResponse)
+	err := c.cc.Invoke(ctx, NotificationService_SetTeamsIntegration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) GetTeamsIntegration(ctx context.Context, in *GetTeamsIntegrationRequest, opts ...grpc.CallOption) (*GetTeamsIntegrationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTeamsIntegrationResponse)
+	err := c.cc.Invoke(ctx, NotificationService_GetTeamsIntegration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) DeleteTeamsIntegration(ctx context.Context, in *DeleteTeamsIntegrationRequest, opts ...grpc.CallOption) (*DeleteTeamsIntegrationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTeamsIntegrationResponse)
+	err := c.cc.Invoke(ctx, NotificationService_DeleteTeamsIntegration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) PublishAnnouncement(ctx context.Context, in *PublishAnnouncementRequest, opts ...grpc.CallOption) (*PublishAnnouncementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishAnnouncementResponse)
+	err := c.cc.Invoke(ctx, NotificationService_PublishAnnouncement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) GetActiveAnnouncements(ctx context.Context, in *GetActiveAnnouncementsRequest, opts ...grpc.CallOption) (*GetActiveAnnouncementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetActiveAnnouncementsResponse)
+	err := c.cc.Invoke(ctx, NotificationService_GetActiveAnnouncements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) ListDeadLetters(ctx context.Context, in *ListDeadLettersRequest, opts ...grpc.CallOption) (*ListDeadLettersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeadLettersResponse)
+	err := c.cc.Invoke(ctx, NotificationService_ListDeadLetters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) RequeueDeadLetters(ctx context.Context, in *RequeueDeadLettersRequest, opts ...grpc.CallOption) (*RequeueDeadLettersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequeueDeadLettersResponse)
+	err := c.cc.Invoke(ctx, NotificationService_RequeueDeadLetters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) PurgeDeadLetters(ctx context.Context, in *PurgeDeadLettersRequest, opts ...grpc.CallOption) (*PurgeDeadLettersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeDeadLettersResponse)
+	err := c.cc.Invoke(ctx, NotificationService_PurgeDeadLetters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NotificationServiceServer is the server API for NotificationService service.
 // All implementations must embed UnimplementedNotificationServiceServer
 // for forward compatibility.
@@ -102,10 +294,37 @@ type NotificationServiceServer interface {
 	SubscribeToNotifications(grpc.BidiStreamingServer[SubscribeRequest, NotificationEvent]) error
 	// Send notification
 	SendNotification(context.Context, *SendNotificationRequest) (*SendNotificationResponse, error)
+	// Send a notification to every member of an organization or team, such as
+	// an announcement or maintenance notice
+	SendOrgNotification(context.Context, *SendOrgNotificationRequest) (*SendBroadcastResponse, error)
+	SendTeamNotification(context.Context, *SendTeamNotificationRequest) (*SendBroadcastResponse, error)
 	// Get notification history
 	GetNotifications(context.Context, *GetNotificationsRequest) (*GetNotificationsResponse, error)
 	// Mark notification as read
 	MarkAsRead(context.Context, *MarkAsReadRequest) (*MarkAsReadResponse, error)
+	// Mark every unread notification as read, optionally only of one type or
+	// created before a time
+	MarkAllAsRead(context.Context, *MarkAllAsReadRequest) (*MarkAllAsReadResponse, error)
+	// Delete a notification
+	DeleteNotification(context.Context, *DeleteNotificationRequest) (*DeleteNotificationResponse, error)
+	// Delete all of a user's notifications, or only the read ones
+	ClearNotifications(context.Context, *ClearNotificationsRequest) (*ClearNotificationsResponse, error)
+	// Export everything stored about a user (internal, used for GDPR export)
+	ExportUserData(context.Context, *ExportUserDataRequest) (*ExportUserDataResponse, error)
+	// Anonymize or delete a user's data (internal, used for GDPR erasure)
+	EraseUserData(context.Context, *EraseUserDataRequest) (*EraseUserDataResponse, error)
+	// Microsoft Teams webhook of an organization
+	SetTeamsIntegration(context.Context, *SetTeamsIntegrationRequest) (*SetTeamsIntegrationResponse, error)
+	GetTeamsIntegration(context.Context, *GetTeamsIntegrationRequest) (*GetTeamsIntegrationResponse, error)
+	DeleteTeamsIntegration(context.Context, *DeleteTeamsIntegrationRequest) (*DeleteTeamsIntegrationResponse, error)
+	// Publish a platform-wide announcement (platform admins)
+	PublishAnnouncement(context.Context, *PublishAnnouncementRequest) (*PublishAnnouncementResponse, error)
+	// Announcements that have not expired yet, newest first
+	GetActiveAnnouncements(context.Context, *GetActiveAnnouncementsRequest) (*GetActiveAnnouncementsResponse, error)
+	// Dead letter queue of undeliverable notifications (platform admins)
+	ListDeadLetters(context.Context, *ListDeadLettersRequest) (*ListDeadLettersResponse, error)
+	RequeueDeadLetters(context.Context, *RequeueDeadLettersRequest) (*RequeueDeadLettersResponse, error)
+	PurgeDeadLetters(context.Context, *PurgeDeadLettersRequest) (*PurgeDeadLettersResponse, error)
 	mustEmbedUnimplementedNotificationServiceServer()
 }
 
@@ -122,12 +341,57 @@ func (UnimplementedNotificationServiceServer) SubscribeToNotifications(grpc.Bidi
 func (UnimplementedNotificationServiceServer) SendNotification(context.Context, *SendNotificationRequest) (*SendNotificationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendNotification not implemented")
 }
+func (UnimplementedNotificationServiceServer) SendOrgNotification(context.Context, *SendOrgNotificationRequest) (*SendBroadcastResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendOrgNotification not implemented")
+}
+func (UnimplementedNotificationServiceServer) SendTeamNotification(context.Context, *SendTeamNotificationRequest) (*SendBroadcastResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendTeamNotification not implemented")
+}
 func (UnimplementedNotificationServiceServer) GetNotifications(context.Context, *GetNotificationsRequest) (*GetNotificationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNotifications not implemented")
 }
 func (UnimplementedNotificationServiceServer) MarkAsRead(context.Context, *MarkAsReadRequest) (*MarkAsReadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MarkAsRead not implemented")
 }
+func (UnimplementedNotificationServiceServer) MarkAllAsRead(context.Context, *MarkAllAsReadRequest) (*MarkAllAsReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkAllAsRead not implemented")
+}
+func (UnimplementedNotificationServiceServer) DeleteNotification(context.Context, *DeleteNotificationRequest) (*DeleteNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNotification not implemented")
+}
+func (UnimplementedNotificationServiceServer) ClearNotifications(context.Context, *ClearNotificationsRequest) (*ClearNotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearNotifications not implemented")
+}
+func (UnimplementedNotificationServiceServer) ExportUserData(context.Context, *ExportUserDataRequest) (*ExportUserDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUserData not implemented")
+}
+func (UnimplementedNotificationServiceServer) EraseUserData(context.Context, *EraseUserDataRequest) (*EraseUserDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EraseUserData not implemented")
+}
+func (UnimplementedNotificationServiceServer) SetTeamsIntegration(context.Context, *SetTeamsIntegrationRequest) (*SetTeamsIntegrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTeamsIntegration not implemented")
+}
+func (UnimplementedNotificationServiceServer) GetTeamsIntegration(context.Context, *GetTeamsIntegrationRequest) (*GetTeamsIntegrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeamsIntegration not implemented")
+}
+func (UnimplementedNotificationServiceServer) DeleteTeamsIntegration(context.Context, *DeleteTeamsIntegrationRequest) (*DeleteTeamsIntegrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTeamsIntegration not implemented")
+}
+func (UnimplementedNotificationServiceServer) PublishAnnouncement(context.Context, *PublishAnnouncementRequest) (*PublishAnnouncementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishAnnouncement not implemented")
+}
+func (UnimplementedNotificationServiceServer) GetActiveAnnouncements(context.Context, *GetActiveAnnouncementsRequest) (*GetActiveAnnouncementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActiveAnnouncements not implemented")
+}
+func (UnimplementedNotificationServiceServer) ListDeadLetters(context.Context, *ListDeadLettersRequest) (*ListDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeadLetters not implemented")
+}
+func (UnimplementedNotificationServiceServer) RequeueDeadLetters(context.Context, *RequeueDeadLettersRequest) (*RequeueDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequeueDeadLetters not implemented")
+}
+func (UnimplementedNotificationServiceServer) PurgeDeadLetters(context.Context, *PurgeDeadLettersRequest) (*PurgeDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeDeadLetters not implemented")
+}
 func (UnimplementedNotificationServiceServer) mustEmbedUnimplementedNotificationServiceServer() {}
 func (UnimplementedNotificationServiceServer) testEmbeddedByValue()                             {}
 
@@ -174,6 +438,42 @@ func _NotificationService_SendNotification_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NotificationService_SendOrgNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendOrgNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).SendOrgNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_SendOrgNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).SendOrgNotification(ctx, req.(*SendOrgNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_SendTeamNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendTeamNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).SendTeamNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_SendTeamNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).SendTeamNotification(ctx, req.(*SendTeamNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _NotificationService_GetNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetNotificationsRequest)
 	if err := dec(in); err != nil {
@@ -210,6 +510,240 @@ func _NotificationService_MarkAsRead_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NotificationService_MarkAllAsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkAllAsReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).MarkAllAsRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_MarkAllAsRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).MarkAllAsRead(ctx, req.(*MarkAllAsReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_DeleteNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).DeleteNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_DeleteNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).DeleteNotification(ctx, req.(*DeleteNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_ClearNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).ClearNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_ClearNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).ClearNotifications(ctx, req.(*ClearNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_ExportUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).ExportUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_ExportUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).ExportUserData(ctx, req.(*ExportUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_EraseUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EraseUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).EraseUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_EraseUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).EraseUserData(ctx, req.(*EraseUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_SetTeamsIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTeamsIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).SetTeamsIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_SetTeamsIntegration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).SetTeamsIntegration(ctx, req.(*SetTeamsIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_GetTeamsIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamsIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).GetTeamsIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_GetTeamsIntegration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).GetTeamsIntegration(ctx, req.(*GetTeamsIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_DeleteTeamsIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTeamsIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).DeleteTeamsIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_DeleteTeamsIntegration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).DeleteTeamsIntegration(ctx, req.(*DeleteTeamsIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_PublishAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishAnnouncementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).PublishAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_PublishAnnouncement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).PublishAnnouncement(ctx, req.(*PublishAnnouncementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_GetActiveAnnouncements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActiveAnnouncementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).GetActiveAnnouncements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_GetActiveAnnouncements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).GetActiveAnnouncements(ctx, req.(*GetActiveAnnouncementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_ListDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).ListDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_ListDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).ListDeadLetters(ctx, req.(*ListDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_RequeueDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequeueDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).RequeueDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_RequeueDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).RequeueDeadLetters(ctx, req.(*RequeueDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_PurgeDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).PurgeDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_PurgeDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).PurgeDeadLetters(ctx, req.(*PurgeDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NotificationService_ServiceDesc is the grpc.ServiceDesc for NotificationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -221,6 +755,14 @@ var NotificationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendNotification",
 			Handler:    _NotificationService_SendNotification_Handler,
 		},
+		{
+			MethodName: "SendOrgNotification",
+			Handler:    _NotificationService_SendOrgNotification_Handler,
+		},
+		{
+			MethodName: "SendTeamNotification",
+			Handler:    _NotificationService_SendTeamNotification_Handler,
+		},
 		{
 			MethodName: "GetNotifications",
 			Handler:    _NotificationService_GetNotifications_Handler,
@@ -229,6 +771,58 @@ var NotificationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MarkAsRead",
 			Handler:    _NotificationService_MarkAsRead_Handler,
 		},
+		{
+			MethodName: "MarkAllAsRead",
+			Handler:    _NotificationService_MarkAllAsRead_Handler,
+		},
+		{
+			MethodName: "DeleteNotification",
+			Handler:    _NotificationService_DeleteNotification_Handler,
+		},
+		{
+			MethodName: "ClearNotifications",
+			Handler:    _NotificationService_ClearNotifications_Handler,
+		},
+		{
+			MethodName: "ExportUserData",
+			Handler:    _NotificationService_ExportUserData_Handler,
+		},
+		{
+			MethodName: "EraseUserData",
+			Handler:    _NotificationService_EraseUserData_Handler,
+		},
+		{
+			MethodName: "SetTeamsIntegration",
+			Handler:    _NotificationService_SetTeamsIntegration_Handler,
+		},
+		{
+			MethodName: "GetTeamsIntegration",
+			Handler:    _NotificationService_GetTeamsIntegration_Handler,
+		},
+		{
+			MethodName: "DeleteTeamsIntegration",
+			Handler:    _NotificationService_DeleteTeamsIntegration_Handler,
+		},
+		{
+			MethodName: "PublishAnnouncement",
+			Handler:    _NotificationService_PublishAnnouncement_Handler,
+		},
+		{
+			MethodName: "GetActiveAnnouncements",
+			Handler:    _NotificationService_GetActiveAnnouncements_Handler,
+		},
+		{
+			MethodName: "ListDeadLetters",
+			Handler:    _NotificationService_ListDeadLetters_Handler,
+		},
+		{
+			MethodName: "RequeueDeadLetters",
+			Handler:    _NotificationService_RequeueDeadLetters_Handler,
+		},
+		{
+			MethodName: "PurgeDeadLetters",
+			Handler:    _NotificationService_PurgeDeadLetters_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -702,12 +702,15 @@ func (x *ListTeamsResponse) GetPageSize() int32 {
 }
 
 type UpdateTeamRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	TeamLeadId    string                 `protobuf:"bytes,4,opt,name=team_lead_id,json=teamLeadId,proto3" json:"team_lead_id,omitempty"`
-	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	TeamId      string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	TeamLeadId  string                 `protobuf:"bytes,4,opt,name=team_lead_id,json=teamLeadId,proto3" json:"team_lead_id,omitempty"`
+	Status      string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	// JSON object replacing the team's metadata; must match the "team"
+	// metadata schema
+	Metadata      string `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -777,6 +780,13 @@ func (x *UpdateTeamRequest) GetStatus() string {
 	return ""
 }
 
+func (x *UpdateTeamRequest) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
 type UpdateTeamResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
@@ -830,10 +840,14 @@ func (x *UpdateTeamResponse) GetMessage() string {
 }
 
 type DeleteTeamRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	TeamId string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	// What happens to the team's open tasks: detach (default), reassign to
+	// fallback_owner_id, or block the deletion while any remain
+	TaskPolicy      string `protobuf:"bytes,2,opt,name=task_policy,json=taskPolicy,proto3" json:"task_policy,omitempty"`
+	FallbackOwnerId string `protobuf:"bytes,3,opt,name=fallback_owner_id,json=fallbackOwnerId,proto3" json:"fallback_owner_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *DeleteTeamRequest) Reset() {
@@ -873,11 +887,27 @@ func (x *DeleteTeamRequest) GetTeamId() string {
 	return ""
 }
 
+func (x *DeleteTeamRequest) GetTaskPolicy() string {
+	if x != nil {
+		return x.TaskPolicy
+	}
+	return ""
+}
+
+func (x *DeleteTeamRequest) GetFallbackOwnerId() string {
+	if x != nil {
+		return x.FallbackOwnerId
+	}
+	return ""
+}
+
 type DeleteTeamResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Message         string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	TasksReassigned int32                  `protobuf:"varint,2,opt,name=tasks_reassigned,json=tasksReassigned,proto3" json:"tasks_reassigned,omitempty"`
+	TasksDetached   int32                  `protobuf:"varint,3,opt,name=tasks_detached,json=tasksDetached,proto3" json:"tasks_detached,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *DeleteTeamResponse) Reset() {
@@ -917,6 +947,20 @@ func (x *DeleteTeamResponse) GetMessage() string {
 	return ""
 }
 
+func (x *DeleteTeamResponse) GetTasksReassigned() int32 {
+	if x != nil {
+		return x.TasksReassigned
+	}
+	return 0
+}
+
+func (x *DeleteTeamResponse) GetTasksDetached() int32 {
+	if x != nil {
+		return x.TasksDetached
+	}
+	return 0
+}
+
 type AddTeamMemberRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
@@ -1125,27 +1169,30 @@ func (x *RemoveTeamMemberResponse) GetMessage() string {
 	return ""
 }
 
-type ListTeamMembersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// One user to add in a bulk membership request
+type BulkMemberItem struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	UserId               string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role                 string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	AllocationPercentage int32                  `protobuf:"varint,3,opt,name=allocation_percentage,json=allocationPercentage,proto3" json:"allocation_percentage,omitempty"` // projects only; defaults to 100
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *ListTeamMembersRequest) Reset() {
-	*x = ListTeamMembersRequest{}
+func (x *BulkMemberItem) Reset() {
+	*x = BulkMemberItem{}
 	mi := &file_organization_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTeamMembersRequest) String() string {
+func (x *BulkMemberItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTeamMembersRequest) ProtoMessage() {}
+func (*BulkMemberItem) ProtoMessage() {}
 
-func (x *ListTeamMembersRequest) ProtoReflect() protoreflect.Message {
+func (x *BulkMemberItem) ProtoReflect() protoreflect.Message {
 	mi := &file_organization_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1157,39 +1204,57 @@ func (x *ListTeamMembersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTeamMembersRequest.ProtoReflect.Descriptor instead.
-func (*ListTeamMembersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BulkMemberItem.ProtoReflect.Descriptor instead.
+func (*BulkMemberItem) Descriptor() ([]byte, []int) {
 	return file_organization_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ListTeamMembersRequest) GetTeamId() string {
+func (x *BulkMemberItem) GetUserId() string {
 	if x != nil {
-		return x.TeamId
+		return x.UserId
 	}
 	return ""
 }
 
-type ListTeamMembersResponse struct {
+func (x *BulkMemberItem) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *BulkMemberItem) GetAllocationPercentage() int32 {
+	if x != nil {
+		return x.AllocationPercentage
+	}
+	return 0
+}
+
+// Outcome of one BulkMemberItem; failed items do not stop the others
+type BulkMemberResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Members       []*TeamMember          `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	MemberId      string                 `protobuf:"bytes,4,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTeamMembersResponse) Reset() {
-	*x = ListTeamMembersResponse{}
+func (x *BulkMemberResult) Reset() {
+	*x = BulkMemberResult{}
 	mi := &file_organization_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTeamMembersResponse) String() string {
+func (x *BulkMemberResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTeamMembersResponse) ProtoMessage() {}
+func (*BulkMemberResult) ProtoMessage() {}
 
-func (x *ListTeamMembersResponse) ProtoReflect() protoreflect.Message {
+func (x *BulkMemberResult) ProtoReflect() protoreflect.Message {
 	mi := &file_organization_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1201,59 +1266,61 @@ func (x *ListTeamMembersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTeamMembersResponse.ProtoReflect.Descriptor instead.
-func (*ListTeamMembersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BulkMemberResult.ProtoReflect.Descriptor instead.
+func (*BulkMemberResult) Descriptor() ([]byte, []int) {
 	return file_organization_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ListTeamMembersResponse) GetMembers() []*TeamMember {
+func (x *BulkMemberResult) GetUserId() string {
 	if x != nil {
-		return x.Members
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-type Project struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	OrgId            string                 `protobuf:"bytes,2,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
-	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description      string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	ProjectManagerId string                 `protobuf:"bytes,5,opt,name=project_manager_id,json=projectManagerId,proto3" json:"project_manager_id,omitempty"`
-	Status           string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`                        // planning, active, on_hold, completed, cancelled
-	Priority         string                 `protobuf:"bytes,7,opt,name=priority,proto3" json:"priority,omitempty"`                    // low, medium, high, critical
-	StartDate        string                 `protobuf:"bytes,8,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // ISO date string
-	EndDate          string                 `protobuf:"bytes,9,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // ISO date string
-	Budget           float64                `protobuf:"fixed64,10,opt,name=budget,proto3" json:"budget,omitempty"`
-	Progress         int32                  `protobuf:"varint,11,opt,name=progress,proto3" json:"progress,omitempty"`
-	Metadata         string                 `protobuf:"bytes,12,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	CreatedBy        string                 `protobuf:"bytes,15,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	// Populated fields
-	ProjectManager *ProjectManager  `protobuf:"bytes,16,opt,name=project_manager,json=projectManager,proto3" json:"project_manager,omitempty"`
-	Teams          []*ProjectTeam   `protobuf:"bytes,17,rep,name=teams,proto3" json:"teams,omitempty"`
-	Members        []*ProjectMember `protobuf:"bytes,18,rep,name=members,proto3" json:"members,omitempty"`
-	TeamCount      int32            `protobuf:"varint,19,opt,name=team_count,json=teamCount,proto3" json:"team_count,omitempty"`
-	MemberCount    int32            `protobuf:"varint,20,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *BulkMemberResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
 }
 
-func (x *Project) Reset() {
-	*x = Project{}
+func (x *BulkMemberResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BulkMemberResult) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+type BulkAddTeamMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Members       []*BulkMemberItem      `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkAddTeamMembersRequest) Reset() {
+	*x = BulkAddTeamMembersRequest{}
 	mi := &file_organization_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Project) String() string {
+func (x *BulkAddTeamMembersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Project) ProtoMessage() {}
+func (*BulkAddTeamMembersRequest) ProtoMessage() {}
 
-func (x *Project) ProtoReflect() protoreflect.Message {
+func (x *BulkAddTeamMembersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_organization_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1265,176 +1332,5982 @@ func (x *Project) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Project.ProtoReflect.Descriptor instead.
-func (*Project) Descriptor() ([]byte, []int) {
+// Deprecated: Use BulkAddTeamMembersRequest.ProtoReflect.Descriptor instead.
+func (*BulkAddTeamMembersRequest) Descriptor() ([]byte, []int) {
 	return file_organization_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *Project) GetId() string {
+func (x *BulkAddTeamMembersRequest) GetTeamId() string {
 	if x != nil {
-		return x.Id
+		return x.TeamId
 	}
 	return ""
 }
 
-func (x *Project) GetOrgId() string {
+func (x *BulkAddTeamMembersRequest) GetMembers() []*BulkMemberItem {
 	if x != nil {
-		return x.OrgId
+		return x.Members
 	}
-	return ""
+	return nil
 }
 
-func (x *Project) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+type BulkAddTeamMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BulkMemberResult    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Added         int32                  `protobuf:"varint,2,opt,name=added,proto3" json:"added,omitempty"`
+	Failed        int32                  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Project) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+func (x *BulkAddTeamMembersResponse) Reset() {
+	*x = BulkAddTeamMembersResponse{}
+	mi := &file_organization_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Project) GetProjectManagerId() string {
-	if x != nil {
-		return x.ProjectManagerId
-	}
-	return ""
+func (x *BulkAddTeamMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Project) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
-}
+func (*BulkAddTeamMembersResponse) ProtoMessage() {}
 
-func (x *Project) GetPriority() string {
+func (x *BulkAddTeamMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[20]
 	if x != nil {
-		return x.Priority
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Project) GetStartDate() string {
-	if x != nil {
-		return x.StartDate
-	}
-	return ""
+// Deprecated: Use BulkAddTeamMembersResponse.ProtoReflect.Descriptor instead.
+func (*BulkAddTeamMembersResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *Project) GetEndDate() string {
+func (x *BulkAddTeamMembersResponse) GetResults() []*BulkMemberResult {
 	if x != nil {
-		return x.EndDate
+		return x.Results
 	}
-	return ""
+	return nil
 }
 
-func (x *Project) GetBudget() float64 {
+func (x *BulkAddTeamMembersResponse) GetAdded() int32 {
 	if x != nil {
-		return x.Budget
+		return x.Added
 	}
 	return 0
 }
 
-func (x *Project) GetProgress() int32 {
+func (x *BulkAddTeamMembersResponse) GetFailed() int32 {
 	if x != nil {
-		return x.Progress
+		return x.Failed
 	}
 	return 0
 }
 
-func (x *Project) GetMetadata() string {
+func (x *BulkAddTeamMembersResponse) GetMessage() string {
 	if x != nil {
-		return x.Metadata
+		return x.Message
 	}
 	return ""
 }
 
-func (x *Project) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
+type ListTeamMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Project) GetUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return nil
+func (x *ListTeamMembersRequest) Reset() {
+	*x = ListTeamMembersRequest{}
+	mi := &file_organization_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Project) GetCreatedBy() string {
-	if x != nil {
-		return x.CreatedBy
-	}
-	return ""
+func (x *ListTeamMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Project) GetProjectManager() *ProjectManager {
+func (*ListTeamMembersRequest) ProtoMessage() {}
+
+func (x *ListTeamMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[21]
 	if x != nil {
-		return x.ProjectManager
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Project) GetTeams() []*ProjectTeam {
-	if x != nil {
-		return x.Teams
-	}
-	return nil
+// Deprecated: Use ListTeamMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListTeamMembersRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *Project) GetMembers() []*ProjectMember {
+func (x *ListTeamMembersRequest) GetTeamId() string {
 	if x != nil {
-		return x.Members
+		return x.TeamId
 	}
-	return nil
+	return ""
 }
 
-func (x *Project) GetTeamCount() int32 {
-	if x != nil {
+type ListTeamMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*TeamMember          `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTeamMembersResponse) Reset() {
+	*x = ListTeamMembersResponse{}
+	mi := &file_organization_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTeamMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTeamMembersResponse) ProtoMessage() {}
+
+func (x *ListTeamMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTeamMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListTeamMembersResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListTeamMembersResponse) GetMembers() []*TeamMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type TransferTeamOwnershipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	NewOwnerId    string                 `protobuf:"bytes,2,opt,name=new_owner_id,json=newOwnerId,proto3" json:"new_owner_id,omitempty"` // becomes the team lead
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferTeamOwnershipRequest) Reset() {
+	*x = TransferTeamOwnershipRequest{}
+	mi := &file_organization_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferTeamOwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferTeamOwnershipRequest) ProtoMessage() {}
+
+func (x *TransferTeamOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferTeamOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*TransferTeamOwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *TransferTeamOwnershipRequest) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *TransferTeamOwnershipRequest) GetNewOwnerId() string {
+	if x != nil {
+		return x.NewOwnerId
+	}
+	return ""
+}
+
+type TransferTeamOwnershipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferTeamOwnershipResponse) Reset() {
+	*x = TransferTeamOwnershipResponse{}
+	mi := &file_organization_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferTeamOwnershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferTeamOwnershipResponse) ProtoMessage() {}
+
+func (x *TransferTeamOwnershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferTeamOwnershipResponse.ProtoReflect.Descriptor instead.
+func (*TransferTeamOwnershipResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TransferTeamOwnershipResponse) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+func (x *TransferTeamOwnershipResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type Project struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrgId            string                 `protobuf:"bytes,2,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description      string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	ProjectManagerId string                 `protobuf:"bytes,5,opt,name=project_manager_id,json=projectManagerId,proto3" json:"project_manager_id,omitempty"`
+	Status           string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`                        // planning, active, on_hold, completed, cancelled
+	Priority         string                 `protobuf:"bytes,7,opt,name=priority,proto3" json:"priority,omitempty"`                    // low, medium, high, critical
+	StartDate        string                 `protobuf:"bytes,8,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // ISO date string
+	EndDate          string                 `protobuf:"bytes,9,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // ISO date string
+	Budget           float64                `protobuf:"fixed64,10,opt,name=budget,proto3" json:"budget,omitempty"`
+	Progress         int32                  `protobuf:"varint,11,opt,name=progress,proto3" json:"progress,omitempty"`
+	Metadata         string                 `protobuf:"bytes,12,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy        string                 `protobuf:"bytes,15,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	// Populated fields
+	ProjectManager *ProjectManager  `protobuf:"bytes,16,opt,name=project_manager,json=projectManager,proto3" json:"project_manager,omitempty"`
+	Teams          []*ProjectTeam   `protobuf:"bytes,17,rep,name=teams,proto3" json:"teams,omitempty"`
+	Members        []*ProjectMember `protobuf:"bytes,18,rep,name=members,proto3" json:"members,omitempty"`
+	TeamCount      int32            `protobuf:"varint,19,opt,name=team_count,json=teamCount,proto3" json:"team_count,omitempty"`
+	MemberCount    int32            `protobuf:"varint,20,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
+	// Set while the project is archived
+	ArchivedAt    *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Project) Reset() {
+	*x = Project{}
+	mi := &file_organization_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Project) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Project) ProtoMessage() {}
+
+func (x *Project) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Project.ProtoReflect.Descriptor instead.
+func (*Project) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Project) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Project) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *Project) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Project) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Project) GetProjectManagerId() string {
+	if x != nil {
+		return x.ProjectManagerId
+	}
+	return ""
+}
+
+func (x *Project) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Project) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+func (x *Project) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *Project) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *Project) GetBudget() float64 {
+	if x != nil {
+		return x.Budget
+	}
+	return 0
+}
+
+func (x *Project) GetProgress() int32 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *Project) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+func (x *Project) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Project) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Project) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Project) GetProjectManager() *ProjectManager {
+	if x != nil {
+		return x.ProjectManager
+	}
+	return nil
+}
+
+func (x *Project) GetTeams() []*ProjectTeam {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *Project) GetMembers() []*ProjectMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *Project) GetTeamCount() int32 {
+	if x != nil {
 		return x.TeamCount
 	}
-	return 0
+	return 0
+}
+
+func (x *Project) GetMemberCount() int32 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+func (x *Project) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+type ProjectManager struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FullName      string                 `protobuf:"bytes,2,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Username      string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectManager) Reset() {
+	*x = ProjectManager{}
+	mi := &file_organization_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectManager) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectManager) ProtoMessage() {}
+
+func (x *ProjectManager) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectManager.ProtoReflect.Descriptor instead.
+func (*ProjectManager) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ProjectManager) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProjectManager) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *ProjectManager) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ProjectManager) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type ProjectTeam struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProjectId  string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	TeamId     string                 `protobuf:"bytes,3,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	AssignedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=assigned_at,json=assignedAt,proto3" json:"assigned_at,omitempty"`
+	// Team details
+	TeamName        string `protobuf:"bytes,5,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	TeamMemberCount int32  `protobuf:"varint,6,opt,name=team_member_count,json=teamMemberCount,proto3" json:"team_member_count,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ProjectTeam) Reset() {
+	*x = ProjectTeam{}
+	mi := &file_organization_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectTeam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectTeam) ProtoMessage() {}
+
+func (x *ProjectTeam) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectTeam.ProtoReflect.Descriptor instead.
+func (*ProjectTeam) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ProjectTeam) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProjectTeam) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ProjectTeam) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *ProjectTeam) GetAssignedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AssignedAt
+	}
+	return nil
+}
+
+func (x *ProjectTeam) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *ProjectTeam) GetTeamMemberCount() int32 {
+	if x != nil {
+		return x.TeamMemberCount
+	}
+	return 0
+}
+
+type ProjectMember struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProjectId            string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	UserId               string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role                 string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	AllocationPercentage int32                  `protobuf:"varint,5,opt,name=allocation_percentage,json=allocationPercentage,proto3" json:"allocation_percentage,omitempty"`
+	JoinedAt             *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	IsActive             bool                   `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// User details
+	FullName      string `protobuf:"bytes,8,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	Email         string `protobuf:"bytes,9,opt,name=email,proto3" json:"email,omitempty"`
+	Username      string `protobuf:"bytes,10,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectMember) Reset() {
+	*x = ProjectMember{}
+	mi := &file_organization_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectMember) ProtoMessage() {}
+
+func (x *ProjectMember) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectMember.ProtoReflect.Descriptor instead.
+func (*ProjectMember) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ProjectMember) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProjectMember) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ProjectMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ProjectMember) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ProjectMember) GetAllocationPercentage() int32 {
+	if x != nil {
+		return x.AllocationPercentage
+	}
+	return 0
+}
+
+func (x *ProjectMember) GetJoinedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return nil
+}
+
+func (x *ProjectMember) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *ProjectMember) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *ProjectMember) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ProjectMember) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type CreateProjectRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	OrgId            string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description      string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ProjectManagerId string                 `protobuf:"bytes,4,opt,name=project_manager_id,json=projectManagerId,proto3" json:"project_manager_id,omitempty"`
+	Priority         string                 `protobuf:"bytes,5,opt,name=priority,proto3" json:"priority,omitempty"`
+	StartDate        string                 `protobuf:"bytes,6,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate          string                 `protobuf:"bytes,7,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Budget           float64                `protobuf:"fixed64,8,opt,name=budget,proto3" json:"budget,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateProjectRequest) Reset() {
+	*x = CreateProjectRequest{}
+	mi := &file_organization_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProjectRequest) ProtoMessage() {}
+
+func (x *CreateProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProjectRequest.ProtoReflect.Descriptor instead.
+func (*CreateProjectRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CreateProjectRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *CreateProjectRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateProjectRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateProjectRequest) GetProjectManagerId() string {
+	if x != nil {
+		return x.ProjectManagerId
+	}
+	return ""
+}
+
+func (x *CreateProjectRequest) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+func (x *CreateProjectRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *CreateProjectRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *CreateProjectRequest) GetBudget() float64 {
+	if x != nil {
+		return x.Budget
+	}
+	return 0
+}
+
+type CreateProjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProjectResponse) Reset() {
+	*x = CreateProjectResponse{}
+	mi := &file_organization_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProjectResponse) ProtoMessage() {}
+
+func (x *CreateProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProjectResponse.ProtoReflect.Descriptor instead.
+func (*CreateProjectResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CreateProjectResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+func (x *CreateProjectResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectRequest) Reset() {
+	*x = GetProjectRequest{}
+	mi := &file_organization_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectRequest) ProtoMessage() {}
+
+func (x *GetProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectRequest.ProtoReflect.Descriptor instead.
+func (*GetProjectRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetProjectRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type GetProjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectResponse) Reset() {
+	*x = GetProjectResponse{}
+	mi := &file_organization_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectResponse) ProtoMessage() {}
+
+func (x *GetProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectResponse.ProtoReflect.Descriptor instead.
+func (*GetProjectResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetProjectResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+type ListProjectsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	OrgId    string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Status   string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Priority string                 `protobuf:"bytes,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	Page     int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Archived projects are left out unless this is set, which lists only them
+	ArchivedOnly  bool `protobuf:"varint,6,opt,name=archived_only,json=archivedOnly,proto3" json:"archived_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProjectsRequest) Reset() {
+	*x = ListProjectsRequest{}
+	mi := &file_organization_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectsRequest) ProtoMessage() {}
+
+func (x *ListProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectsRequest.ProtoReflect.Descriptor instead.
+func (*ListProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListProjectsRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *ListProjectsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListProjectsRequest) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+func (x *ListProjectsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListProjectsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListProjectsRequest) GetArchivedOnly() bool {
+	if x != nil {
+		return x.ArchivedOnly
+	}
+	return false
+}
+
+type ListProjectsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Projects      []*Project             `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProjectsResponse) Reset() {
+	*x = ListProjectsResponse{}
+	mi := &file_organization_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectsResponse) ProtoMessage() {}
+
+func (x *ListProjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectsResponse.ProtoReflect.Descriptor instead.
+func (*ListProjectsResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListProjectsResponse) GetProjects() []*Project {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+func (x *ListProjectsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListProjectsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListProjectsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type UpdateProjectRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId   string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Status      string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Priority    string                 `protobuf:"bytes,5,opt,name=priority,proto3" json:"priority,omitempty"`
+	Progress    int32                  `protobuf:"varint,6,opt,name=progress,proto3" json:"progress,omitempty"`
+	Budget      float64                `protobuf:"fixed64,7,opt,name=budget,proto3" json:"budget,omitempty"`
+	// JSON object replacing the project's metadata; must match the "project"
+	// metadata schema
+	Metadata      string `protobuf:"bytes,8,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProjectRequest) Reset() {
+	*x = UpdateProjectRequest{}
+	mi := &file_organization_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProjectRequest) ProtoMessage() {}
+
+func (x *UpdateProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProjectRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProjectRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UpdateProjectRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *UpdateProjectRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateProjectRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateProjectRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UpdateProjectRequest) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+func (x *UpdateProjectRequest) GetProgress() int32 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *UpdateProjectRequest) GetBudget() float64 {
+	if x != nil {
+		return x.Budget
+	}
+	return 0
+}
+
+func (x *UpdateProjectRequest) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+type UpdateProjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProjectResponse) Reset() {
+	*x = UpdateProjectResponse{}
+	mi := &file_organization_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProjectResponse) ProtoMessage() {}
+
+func (x *UpdateProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProjectResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProjectResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *UpdateProjectResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+func (x *UpdateProjectResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ArchiveProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveProjectRequest) Reset() {
+	*x = ArchiveProjectRequest{}
+	mi := &file_organization_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveProjectRequest) ProtoMessage() {}
+
+func (x *ArchiveProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveProjectRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveProjectRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ArchiveProjectRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type ArchiveProjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveProjectResponse) Reset() {
+	*x = ArchiveProjectResponse{}
+	mi := &file_organization_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveProjectResponse) ProtoMessage() {}
+
+func (x *ArchiveProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveProjectResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveProjectResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ArchiveProjectResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+func (x *ArchiveProjectResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type UnarchiveProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnarchiveProjectRequest) Reset() {
+	*x = UnarchiveProjectRequest{}
+	mi := &file_organization_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnarchiveProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnarchiveProjectRequest) ProtoMessage() {}
+
+func (x *UnarchiveProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnarchiveProjectRequest.ProtoReflect.Descriptor instead.
+func (*UnarchiveProjectRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UnarchiveProjectRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type UnarchiveProjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnarchiveProjectResponse) Reset() {
+	*x = UnarchiveProjectResponse{}
+	mi := &file_organization_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnarchiveProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnarchiveProjectResponse) ProtoMessage() {}
+
+func (x *UnarchiveProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnarchiveProjectResponse.ProtoReflect.Descriptor instead.
+func (*UnarchiveProjectResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UnarchiveProjectResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+func (x *UnarchiveProjectResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type DeleteProjectRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// What happens to the project's open tasks: detach (default), reassign to
+	// fallback_owner_id, or block the deletion while any remain
+	TaskPolicy      string `protobuf:"bytes,2,opt,name=task_policy,json=taskPolicy,proto3" json:"task_policy,omitempty"`
+	FallbackOwnerId string `protobuf:"bytes,3,opt,name=fallback_owner_id,json=fallbackOwnerId,proto3" json:"fallback_owner_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeleteProjectRequest) Reset() {
+	*x = DeleteProjectRequest{}
+	mi := &file_organization_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProjectRequest) ProtoMessage() {}
+
+func (x *DeleteProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProjectRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProjectRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DeleteProjectRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *DeleteProjectRequest) GetTaskPolicy() string {
+	if x != nil {
+		return x.TaskPolicy
+	}
+	return ""
+}
+
+func (x *DeleteProjectRequest) GetFallbackOwnerId() string {
+	if x != nil {
+		return x.FallbackOwnerId
+	}
+	return ""
+}
+
+type DeleteProjectResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Message         string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	TasksReassigned int32                  `protobuf:"varint,2,opt,name=tasks_reassigned,json=tasksReassigned,proto3" json:"tasks_reassigned,omitempty"`
+	TasksDetached   int32                  `protobuf:"varint,3,opt,name=tasks_detached,json=tasksDetached,proto3" json:"tasks_detached,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeleteProjectResponse) Reset() {
+	*x = DeleteProjectResponse{}
+	mi := &file_organization_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProjectResponse) ProtoMessage() {}
+
+func (x *DeleteProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProjectResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProjectResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DeleteProjectResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DeleteProjectResponse) GetTasksReassigned() int32 {
+	if x != nil {
+		return x.TasksReassigned
+	}
+	return 0
+}
+
+func (x *DeleteProjectResponse) GetTasksDetached() int32 {
+	if x != nil {
+		return x.TasksDetached
+	}
+	return 0
+}
+
+type AssignTeamToProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	TeamId        string                 `protobuf:"bytes,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignTeamToProjectRequest) Reset() {
+	*x = AssignTeamToProjectRequest{}
+	mi := &file_organization_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignTeamToProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignTeamToProjectRequest) ProtoMessage() {}
+
+func (x *AssignTeamToProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignTeamToProjectRequest.ProtoReflect.Descriptor instead.
+func (*AssignTeamToProjectRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *AssignTeamToProjectRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *AssignTeamToProjectRequest) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+type AssignTeamToProjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectTeam   *ProjectTeam           `protobuf:"bytes,1,opt,name=project_team,json=projectTeam,proto3" json:"project_team,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignTeamToProjectResponse) Reset() {
+	*x = AssignTeamToProjectResponse{}
+	mi := &file_organization_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignTeamToProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignTeamToProjectResponse) ProtoMessage() {}
+
+func (x *AssignTeamToProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignTeamToProjectResponse.ProtoReflect.Descriptor instead.
+func (*AssignTeamToProjectResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *AssignTeamToProjectResponse) GetProjectTeam() *ProjectTeam {
+	if x != nil {
+		return x.ProjectTeam
+	}
+	return nil
+}
+
+func (x *AssignTeamToProjectResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type RemoveTeamFromProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	TeamId        string                 `protobuf:"bytes,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveTeamFromProjectRequest) Reset() {
+	*x = RemoveTeamFromProjectRequest{}
+	mi := &file_organization_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTeamFromProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTeamFromProjectRequest) ProtoMessage() {}
+
+func (x *RemoveTeamFromProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTeamFromProjectRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTeamFromProjectRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RemoveTeamFromProjectRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *RemoveTeamFromProjectRequest) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+type RemoveTeamFromProjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveTeamFromProjectResponse) Reset() {
+	*x = RemoveTeamFromProjectResponse{}
+	mi := &file_organization_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTeamFromProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTeamFromProjectResponse) ProtoMessage() {}
+
+func (x *RemoveTeamFromProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTeamFromProjectResponse.ProtoReflect.Descriptor instead.
+func (*RemoveTeamFromProjectResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *RemoveTeamFromProjectResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type AddProjectMemberRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId            string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	UserId               string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role                 string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	AllocationPercentage int32                  `protobuf:"varint,4,opt,name=allocation_percentage,json=allocationPercentage,proto3" json:"allocation_percentage,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AddProjectMemberRequest) Reset() {
+	*x = AddProjectMemberRequest{}
+	mi := &file_organization_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProjectMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProjectMemberRequest) ProtoMessage() {}
+
+func (x *AddProjectMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProjectMemberRequest.ProtoReflect.Descriptor instead.
+func (*AddProjectMemberRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *AddProjectMemberRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *AddProjectMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddProjectMemberRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *AddProjectMemberRequest) GetAllocationPercentage() int32 {
+	if x != nil {
+		return x.AllocationPercentage
+	}
+	return 0
+}
+
+type AddProjectMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *ProjectMember         `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddProjectMemberResponse) Reset() {
+	*x = AddProjectMemberResponse{}
+	mi := &file_organization_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProjectMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProjectMemberResponse) ProtoMessage() {}
+
+func (x *AddProjectMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProjectMemberResponse.ProtoReflect.Descriptor instead.
+func (*AddProjectMemberResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *AddProjectMemberResponse) GetMember() *ProjectMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+func (x *AddProjectMemberResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type RemoveProjectMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveProjectMemberRequest) Reset() {
+	*x = RemoveProjectMemberRequest{}
+	mi := &file_organization_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveProjectMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveProjectMemberRequest) ProtoMessage() {}
+
+func (x *RemoveProjectMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveProjectMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveProjectMemberRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RemoveProjectMemberRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *RemoveProjectMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RemoveProjectMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveProjectMemberResponse) Reset() {
+	*x = RemoveProjectMemberResponse{}
+	mi := &file_organization_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveProjectMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveProjectMemberResponse) ProtoMessage() {}
+
+func (x *RemoveProjectMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveProjectMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveProjectMemberResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RemoveProjectMemberResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type BulkAddProjectMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Members       []*BulkMemberItem      `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkAddProjectMembersRequest) Reset() {
+	*x = BulkAddProjectMembersRequest{}
+	mi := &file_organization_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkAddProjectMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkAddProjectMembersRequest) ProtoMessage() {}
+
+func (x *BulkAddProjectMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkAddProjectMembersRequest.ProtoReflect.Descriptor instead.
+func (*BulkAddProjectMembersRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *BulkAddProjectMembersRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *BulkAddProjectMembersRequest) GetMembers() []*BulkMemberItem {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type BulkAddProjectMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BulkMemberResult    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Added         int32                  `protobuf:"varint,2,opt,name=added,proto3" json:"added,omitempty"`
+	Failed        int32                  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkAddProjectMembersResponse) Reset() {
+	*x = BulkAddProjectMembersResponse{}
+	mi := &file_organization_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkAddProjectMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkAddProjectMembersResponse) ProtoMessage() {}
+
+func (x *BulkAddProjectMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkAddProjectMembersResponse.ProtoReflect.Descriptor instead.
+func (*BulkAddProjectMembersResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *BulkAddProjectMembersResponse) GetResults() []*BulkMemberResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BulkAddProjectMembersResponse) GetAdded() int32 {
+	if x != nil {
+		return x.Added
+	}
+	return 0
+}
+
+func (x *BulkAddProjectMembersResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *BulkAddProjectMembersResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type TransferProjectManagerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	NewManagerId  string                 `protobuf:"bytes,2,opt,name=new_manager_id,json=newManagerId,proto3" json:"new_manager_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferProjectManagerRequest) Reset() {
+	*x = TransferProjectManagerRequest{}
+	mi := &file_organization_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferProjectManagerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferProjectManagerRequest) ProtoMessage() {}
+
+func (x *TransferProjectManagerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferProjectManagerRequest.ProtoReflect.Descriptor instead.
+func (*TransferProjectManagerRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *TransferProjectManagerRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *TransferProjectManagerRequest) GetNewManagerId() string {
+	if x != nil {
+		return x.NewManagerId
+	}
+	return ""
+}
+
+type TransferProjectManagerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferProjectManagerResponse) Reset() {
+	*x = TransferProjectManagerResponse{}
+	mi := &file_organization_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferProjectManagerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferProjectManagerResponse) ProtoMessage() {}
+
+func (x *TransferProjectManagerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferProjectManagerResponse.ProtoReflect.Descriptor instead.
+func (*TransferProjectManagerResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *TransferProjectManagerResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+func (x *TransferProjectManagerResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ProjectMilestone struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	DueDate       string                 `protobuf:"bytes,3,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`             // ISO date string
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"` // set once the milestone is reached
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectMilestone) Reset() {
+	*x = ProjectMilestone{}
+	mi := &file_organization_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectMilestone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectMilestone) ProtoMessage() {}
+
+func (x *ProjectMilestone) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectMilestone.ProtoReflect.Descriptor instead.
+func (*ProjectMilestone) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ProjectMilestone) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProjectMilestone) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ProjectMilestone) GetDueDate() string {
+	if x != nil {
+		return x.DueDate
+	}
+	return ""
+}
+
+func (x *ProjectMilestone) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+type TimelineTask struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TaskId     string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	TaskKey    string                 `protobuf:"bytes,2,opt,name=task_key,json=taskKey,proto3" json:"task_key,omitempty"`
+	Title      string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Status     string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Priority   string                 `protobuf:"bytes,5,opt,name=priority,proto3" json:"priority,omitempty"`
+	AssignedTo string                 `protobuf:"bytes,6,opt,name=assigned_to,json=assignedTo,proto3" json:"assigned_to,omitempty"`
+	TeamId     string                 `protobuf:"bytes,7,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	// When work started, or when the task was created if it hasn't yet
+	Start         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=start,proto3" json:"start,omitempty"`
+	DueDate       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	IsOverdue     bool                   `protobuf:"varint,11,opt,name=is_overdue,json=isOverdue,proto3" json:"is_overdue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimelineTask) Reset() {
+	*x = TimelineTask{}
+	mi := &file_organization_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimelineTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimelineTask) ProtoMessage() {}
+
+func (x *TimelineTask) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimelineTask.ProtoReflect.Descriptor instead.
+func (*TimelineTask) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *TimelineTask) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *TimelineTask) GetTaskKey() string {
+	if x != nil {
+		return x.TaskKey
+	}
+	return ""
+}
+
+func (x *TimelineTask) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TimelineTask) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TimelineTask) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+func (x *TimelineTask) GetAssignedTo() string {
+	if x != nil {
+		return x.AssignedTo
+	}
+	return ""
+}
+
+func (x *TimelineTask) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *TimelineTask) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *TimelineTask) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *TimelineTask) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *TimelineTask) GetIsOverdue() bool {
+	if x != nil {
+		return x.IsOverdue
+	}
+	return false
+}
+
+// task_id cannot start until depends_on_id is done
+type TimelineDependency struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	DependsOnId   string                 `protobuf:"bytes,2,opt,name=depends_on_id,json=dependsOnId,proto3" json:"depends_on_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimelineDependency) Reset() {
+	*x = TimelineDependency{}
+	mi := &file_organization_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimelineDependency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimelineDependency) ProtoMessage() {}
+
+func (x *TimelineDependency) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimelineDependency.ProtoReflect.Descriptor instead.
+func (*TimelineDependency) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *TimelineDependency) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *TimelineDependency) GetDependsOnId() string {
+	if x != nil {
+		return x.DependsOnId
+	}
+	return ""
+}
+
+type GetProjectTimelineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectTimelineRequest) Reset() {
+	*x = GetProjectTimelineRequest{}
+	mi := &file_organization_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectTimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectTimelineRequest) ProtoMessage() {}
+
+func (x *GetProjectTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectTimelineRequest.ProtoReflect.Descriptor instead.
+func (*GetProjectTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetProjectTimelineRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type GetProjectTimelineResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId  string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Name       string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	StartDate  string                 `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // ISO date string
+	EndDate    string                 `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // ISO date string
+	Milestones []*ProjectMilestone    `protobuf:"bytes,5,rep,name=milestones,proto3" json:"milestones,omitempty"`
+	Tasks      []*TimelineTask        `protobuf:"bytes,6,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	// Only edges between tasks of this project
+	Dependencies  []*TimelineDependency `protobuf:"bytes,7,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectTimelineResponse) Reset() {
+	*x = GetProjectTimelineResponse{}
+	mi := &file_organization_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectTimelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectTimelineResponse) ProtoMessage() {}
+
+func (x *GetProjectTimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectTimelineResponse.ProtoReflect.Descriptor instead.
+func (*GetProjectTimelineResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetProjectTimelineResponse) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *GetProjectTimelineResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetProjectTimelineResponse) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetProjectTimelineResponse) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GetProjectTimelineResponse) GetMilestones() []*ProjectMilestone {
+	if x != nil {
+		return x.Milestones
+	}
+	return nil
+}
+
+func (x *GetProjectTimelineResponse) GetTasks() []*TimelineTask {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *GetProjectTimelineResponse) GetDependencies() []*TimelineDependency {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+// A project's weekly status snapshot
+type ProjectStatusReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	OrgId         string                 `protobuf:"bytes,3,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	WeekStart     string                 `protobuf:"bytes,4,opt,name=week_start,json=weekStart,proto3" json:"week_start,omitempty"` // Monday of the reported week, YYYY-MM-DD
+	RagStatus     string                 `protobuf:"bytes,5,opt,name=rag_status,json=ragStatus,proto3" json:"rag_status,omitempty"` // green, amber, red
+	Summary       string                 `protobuf:"bytes,6,opt,name=summary,proto3" json:"summary,omitempty"`
+	Risks         []string               `protobuf:"bytes,7,rep,name=risks,proto3" json:"risks,omitempty"`
+	SubmittedBy   string                 `protobuf:"bytes,8,opt,name=submitted_by,json=submittedBy,proto3" json:"submitted_by,omitempty"`
+	SubmitterName string                 `protobuf:"bytes,9,opt,name=submitter_name,json=submitterName,proto3" json:"submitter_name,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectStatusReport) Reset() {
+	*x = ProjectStatusReport{}
+	mi := &file_organization_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectStatusReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectStatusReport) ProtoMessage() {}
+
+func (x *ProjectStatusReport) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectStatusReport.ProtoReflect.Descriptor instead.
+func (*ProjectStatusReport) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ProjectStatusReport) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProjectStatusReport) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ProjectStatusReport) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *ProjectStatusReport) GetWeekStart() string {
+	if x != nil {
+		return x.WeekStart
+	}
+	return ""
+}
+
+func (x *ProjectStatusReport) GetRagStatus() string {
+	if x != nil {
+		return x.RagStatus
+	}
+	return ""
+}
+
+func (x *ProjectStatusReport) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *ProjectStatusReport) GetRisks() []string {
+	if x != nil {
+		return x.Risks
+	}
+	return nil
+}
+
+func (x *ProjectStatusReport) GetSubmittedBy() string {
+	if x != nil {
+		return x.SubmittedBy
+	}
+	return ""
+}
+
+func (x *ProjectStatusReport) GetSubmitterName() string {
+	if x != nil {
+		return x.SubmitterName
+	}
+	return ""
+}
+
+func (x *ProjectStatusReport) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ProjectStatusReport) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type SubmitProjectStatusReportRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	RagStatus string                 `protobuf:"bytes,2,opt,name=rag_status,json=ragStatus,proto3" json:"rag_status,omitempty"`
+	Summary   string                 `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	Risks     []string               `protobuf:"bytes,4,rep,name=risks,proto3" json:"risks,omitempty"`
+	// Any date in the reported week (YYYY-MM-DD); defaults to this week.
+	// Resubmitting a week replaces its report.
+	WeekStart     string `protobuf:"bytes,5,opt,name=week_start,json=weekStart,proto3" json:"week_start,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitProjectStatusReportRequest) Reset() {
+	*x = SubmitProjectStatusReportRequest{}
+	mi := &file_organization_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitProjectStatusReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitProjectStatusReportRequest) ProtoMessage() {}
+
+func (x *SubmitProjectStatusReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitProjectStatusReportRequest.ProtoReflect.Descriptor instead.
+func (*SubmitProjectStatusReportRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SubmitProjectStatusReportRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *SubmitProjectStatusReportRequest) GetRagStatus() string {
+	if x != nil {
+		return x.RagStatus
+	}
+	return ""
+}
+
+func (x *SubmitProjectStatusReportRequest) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *SubmitProjectStatusReportRequest) GetRisks() []string {
+	if x != nil {
+		return x.Risks
+	}
+	return nil
+}
+
+func (x *SubmitProjectStatusReportRequest) GetWeekStart() string {
+	if x != nil {
+		return x.WeekStart
+	}
+	return ""
+}
+
+type SubmitProjectStatusReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        *ProjectStatusReport   `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitProjectStatusReportResponse) Reset() {
+	*x = SubmitProjectStatusReportResponse{}
+	mi := &file_organization_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitProjectStatusReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitProjectStatusReportResponse) ProtoMessage() {}
+
+func (x *SubmitProjectStatusReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitProjectStatusReportResponse.ProtoReflect.Descriptor instead.
+func (*SubmitProjectStatusReportResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SubmitProjectStatusReportResponse) GetReport() *ProjectStatusReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+func (x *SubmitProjectStatusReportResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ListProjectStatusReportsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // most recent weeks first; default 12
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProjectStatusReportsRequest) Reset() {
+	*x = ListProjectStatusReportsRequest{}
+	mi := &file_organization_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProjectStatusReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectStatusReportsRequest) ProtoMessage() {}
+
+func (x *ListProjectStatusReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectStatusReportsRequest.ProtoReflect.Descriptor instead.
+func (*ListProjectStatusReportsRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListProjectStatusReportsRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ListProjectStatusReportsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListProjectStatusReportsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reports       []*ProjectStatusReport `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProjectStatusReportsResponse) Reset() {
+	*x = ListProjectStatusReportsResponse{}
+	mi := &file_organization_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProjectStatusReportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectStatusReportsResponse) ProtoMessage() {}
+
+func (x *ListProjectStatusReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectStatusReportsResponse.ProtoReflect.Descriptor instead.
+func (*ListProjectStatusReportsResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListProjectStatusReportsResponse) GetReports() []*ProjectStatusReport {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+// An event in a project's activity feed
+type ProjectActivity struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProjectId string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// member_added, milestone_reached, task_completed, status_changed,
+	// archived or unarchived
+	EventType     string                 `protobuf:"bytes,3,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Summary       string                 `protobuf:"bytes,4,opt,name=summary,proto3" json:"summary,omitempty"`
+	ActorId       string                 `protobuf:"bytes,5,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ActorName     string                 `protobuf:"bytes,6,opt,name=actor_name,json=actorName,proto3" json:"actor_name,omitempty"`
+	Metadata      map[string]string      `protobuf:"bytes,7,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectActivity) Reset() {
+	*x = ProjectActivity{}
+	mi := &file_organization_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectActivity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectActivity) ProtoMessage() {}
+
+func (x *ProjectActivity) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectActivity.ProtoReflect.Descriptor instead.
+func (*ProjectActivity) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ProjectActivity) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProjectActivity) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ProjectActivity) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *ProjectActivity) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *ProjectActivity) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *ProjectActivity) GetActorName() string {
+	if x != nil {
+		return x.ActorName
+	}
+	return ""
+}
+
+func (x *ProjectActivity) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *ProjectActivity) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetProjectActivityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // default 20, at most 100
+	EventType     string                 `protobuf:"bytes,4,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"` // only this kind of event when set
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectActivityRequest) Reset() {
+	*x = GetProjectActivityRequest{}
+	mi := &file_organization_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectActivityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectActivityRequest) ProtoMessage() {}
+
+func (x *GetProjectActivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectActivityRequest.ProtoReflect.Descriptor instead.
+func (*GetProjectActivityRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetProjectActivityRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *GetProjectActivityRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetProjectActivityRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetProjectActivityRequest) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+type GetProjectActivityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Activities    []*ProjectActivity     `protobuf:"bytes,1,rep,name=activities,proto3" json:"activities,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectActivityResponse) Reset() {
+	*x = GetProjectActivityResponse{}
+	mi := &file_organization_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectActivityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectActivityResponse) ProtoMessage() {}
+
+func (x *GetProjectActivityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectActivityResponse.ProtoReflect.Descriptor instead.
+func (*GetProjectActivityResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetProjectActivityResponse) GetActivities() []*ProjectActivity {
+	if x != nil {
+		return x.Activities
+	}
+	return nil
+}
+
+func (x *GetProjectActivityResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetProjectActivityResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetProjectActivityResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type CompleteProjectMilestoneRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteProjectMilestoneRequest) Reset() {
+	*x = CompleteProjectMilestoneRequest{}
+	mi := &file_organization_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteProjectMilestoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteProjectMilestoneRequest) ProtoMessage() {}
+
+func (x *CompleteProjectMilestoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteProjectMilestoneRequest.ProtoReflect.Descriptor instead.
+func (*CompleteProjectMilestoneRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *CompleteProjectMilestoneRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *CompleteProjectMilestoneRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CompleteProjectMilestoneResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Milestone     *ProjectMilestone      `protobuf:"bytes,1,opt,name=milestone,proto3" json:"milestone,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteProjectMilestoneResponse) Reset() {
+	*x = CompleteProjectMilestoneResponse{}
+	mi := &file_organization_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteProjectMilestoneResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteProjectMilestoneResponse) ProtoMessage() {}
+
+func (x *CompleteProjectMilestoneResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteProjectMilestoneResponse.ProtoReflect.Descriptor instead.
+func (*CompleteProjectMilestoneResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *CompleteProjectMilestoneResponse) GetMilestone() *ProjectMilestone {
+	if x != nil {
+		return x.Milestone
+	}
+	return nil
+}
+
+func (x *CompleteProjectMilestoneResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type Group struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrgId       string                 `protobuf:"bytes,2,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Name        string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	GroupType   string                 `protobuf:"bytes,5,opt,name=group_type,json=groupType,proto3" json:"group_type,omitempty"` // functional, temporary, committee, community
+	OwnerId     string                 `protobuf:"bytes,6,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Status      string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Metadata    string                 `protobuf:"bytes,8,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy   string                 `protobuf:"bytes,11,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	// Populated fields
+	Owner         *GroupOwner    `protobuf:"bytes,12,opt,name=owner,proto3" json:"owner,omitempty"`
+	Members       []*GroupMember `protobuf:"bytes,13,rep,name=members,proto3" json:"members,omitempty"`
+	MemberCount   int32          `protobuf:"varint,14,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Group) Reset() {
+	*x = Group{}
+	mi := &file_organization_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Group) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Group) ProtoMessage() {}
+
+func (x *Group) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Group.ProtoReflect.Descriptor instead.
+func (*Group) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *Group) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Group) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *Group) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Group) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Group) GetGroupType() string {
+	if x != nil {
+		return x.GroupType
+	}
+	return ""
+}
+
+func (x *Group) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *Group) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Group) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+func (x *Group) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Group) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Group) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Group) GetOwner() *GroupOwner {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *Group) GetMembers() []*GroupMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *Group) GetMemberCount() int32 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+type GroupOwner struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FullName      string                 `protobuf:"bytes,2,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Username      string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupOwner) Reset() {
+	*x = GroupOwner{}
+	mi := &file_organization_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupOwner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupOwner) ProtoMessage() {}
+
+func (x *GroupOwner) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupOwner.ProtoReflect.Descriptor instead.
+func (*GroupOwner) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GroupOwner) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GroupOwner) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *GroupOwner) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *GroupOwner) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type GroupMember struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GroupId  string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	UserId   string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role     string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	JoinedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	IsActive bool                   `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// User details
+	FullName      string `protobuf:"bytes,7,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	Email         string `protobuf:"bytes,8,opt,name=email,proto3" json:"email,omitempty"`
+	Username      string `protobuf:"bytes,9,opt,name=username,proto3" json:"username,omitempty"`
+	TeamName      string `protobuf:"bytes,10,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"` // If member belongs to a team
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupMember) Reset() {
+	*x = GroupMember{}
+	mi := &file_organization_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMember) ProtoMessage() {}
+
+func (x *GroupMember) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMember.ProtoReflect.Descriptor instead.
+func (*GroupMember) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GroupMember) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GroupMember) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GroupMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GroupMember) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *GroupMember) GetJoinedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return nil
+}
+
+func (x *GroupMember) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *GroupMember) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *GroupMember) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *GroupMember) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GroupMember) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type CreateGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	GroupType     string                 `protobuf:"bytes,4,opt,name=group_type,json=groupType,proto3" json:"group_type,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,5,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGroupRequest) Reset() {
+	*x = CreateGroupRequest{}
+	mi := &file_organization_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupRequest) ProtoMessage() {}
+
+func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupRequest.ProtoReflect.Descriptor instead.
+func (*CreateGroupRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *CreateGroupRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetGroupType() string {
+	if x != nil {
+		return x.GroupType
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+type CreateGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *Group                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGroupResponse) Reset() {
+	*x = CreateGroupResponse{}
+	mi := &file_organization_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupResponse) ProtoMessage() {}
+
+func (x *CreateGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupResponse.ProtoReflect.Descriptor instead.
+func (*CreateGroupResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *CreateGroupResponse) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+func (x *CreateGroupResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupRequest) Reset() {
+	*x = GetGroupRequest{}
+	mi := &file_organization_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupRequest) ProtoMessage() {}
+
+func (x *GetGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetGroupRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type GetGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *Group                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupResponse) Reset() {
+	*x = GetGroupResponse{}
+	mi := &file_organization_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupResponse) ProtoMessage() {}
+
+func (x *GetGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GetGroupResponse) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type ListGroupsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	GroupType     string                 `protobuf:"bytes,2,opt,name=group_type,json=groupType,proto3" json:"group_type,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupsRequest) Reset() {
+	*x = ListGroupsRequest{}
+	mi := &file_organization_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupsRequest) ProtoMessage() {}
+
+func (x *ListGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupsRequest.ProtoReflect.Descriptor instead.
+func (*ListGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ListGroupsRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *ListGroupsRequest) GetGroupType() string {
+	if x != nil {
+		return x.GroupType
+	}
+	return ""
+}
+
+func (x *ListGroupsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListGroupsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListGroupsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*Group               `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupsResponse) Reset() {
+	*x = ListGroupsResponse{}
+	mi := &file_organization_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupsResponse) ProtoMessage() {}
+
+func (x *ListGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupsResponse.ProtoReflect.Descriptor instead.
+func (*ListGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ListGroupsResponse) GetGroups() []*Group {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *ListGroupsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type UpdateGroupRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	GroupId     string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Status      string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	// JSON object replacing the group's metadata; must match the "group"
+	// metadata schema
+	Metadata      string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGroupRequest) Reset() {
+	*x = UpdateGroupRequest{}
+	mi := &file_organization_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupRequest) ProtoMessage() {}
+
+func (x *UpdateGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGroupRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *UpdateGroupRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *UpdateGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateGroupRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateGroupRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UpdateGroupRequest) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+type UpdateGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *Group                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGroupResponse) Reset() {
+	*x = UpdateGroupResponse{}
+	mi := &file_organization_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupResponse) ProtoMessage() {}
+
+func (x *UpdateGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGroupResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *UpdateGroupResponse) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+func (x *UpdateGroupResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type DeleteGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGroupRequest) Reset() {
+	*x = DeleteGroupRequest{}
+	mi := &file_organization_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGroupRequest) ProtoMessage() {}
+
+func (x *DeleteGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGroupRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGroupRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *DeleteGroupRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type DeleteGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGroupResponse) Reset() {
+	*x = DeleteGroupResponse{}
+	mi := &file_organization_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGroupResponse) ProtoMessage() {}
+
+func (x *DeleteGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGroupResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGroupResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *DeleteGroupResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type AddGroupMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddGroupMemberRequest) Reset() {
+	*x = AddGroupMemberRequest{}
+	mi := &file_organization_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddGroupMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddGroupMemberRequest) ProtoMessage() {}
+
+func (x *AddGroupMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddGroupMemberRequest.ProtoReflect.Descriptor instead.
+func (*AddGroupMemberRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *AddGroupMemberRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *AddGroupMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddGroupMemberRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type AddGroupMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *GroupMember           `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddGroupMemberResponse) Reset() {
+	*x = AddGroupMemberResponse{}
+	mi := &file_organization_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddGroupMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddGroupMemberResponse) ProtoMessage() {}
+
+func (x *AddGroupMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddGroupMemberResponse.ProtoReflect.Descriptor instead.
+func (*AddGroupMemberResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *AddGroupMemberResponse) GetMember() *GroupMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+func (x *AddGroupMemberResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type RemoveGroupMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveGroupMemberRequest) Reset() {
+	*x = RemoveGroupMemberRequest{}
+	mi := &file_organization_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveGroupMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveGroupMemberRequest) ProtoMessage() {}
+
+func (x *RemoveGroupMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveGroupMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveGroupMemberRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *RemoveGroupMemberRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *RemoveGroupMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RemoveGroupMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveGroupMemberResponse) Reset() {
+	*x = RemoveGroupMemberResponse{}
+	mi := &file_organization_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveGroupMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveGroupMemberResponse) ProtoMessage() {}
+
+func (x *RemoveGroupMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveGroupMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveGroupMemberResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *RemoveGroupMemberResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type TransferGroupOwnerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	NewOwnerId    string                 `protobuf:"bytes,2,opt,name=new_owner_id,json=newOwnerId,proto3" json:"new_owner_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferGroupOwnerRequest) Reset() {
+	*x = TransferGroupOwnerRequest{}
+	mi := &file_organization_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferGroupOwnerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferGroupOwnerRequest) ProtoMessage() {}
+
+func (x *TransferGroupOwnerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferGroupOwnerRequest.ProtoReflect.Descriptor instead.
+func (*TransferGroupOwnerRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *TransferGroupOwnerRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *TransferGroupOwnerRequest) GetNewOwnerId() string {
+	if x != nil {
+		return x.NewOwnerId
+	}
+	return ""
+}
+
+type TransferGroupOwnerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *Group                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferGroupOwnerResponse) Reset() {
+	*x = TransferGroupOwnerResponse{}
+	mi := &file_organization_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferGroupOwnerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferGroupOwnerResponse) ProtoMessage() {}
+
+func (x *TransferGroupOwnerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferGroupOwnerResponse.ProtoReflect.Descriptor instead.
+func (*TransferGroupOwnerResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *TransferGroupOwnerResponse) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+func (x *TransferGroupOwnerResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type OrgMember struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FullName  string                 `protobuf:"bytes,2,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	Email     string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Username  string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	Role      string                 `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Values for the organization's extra profile fields the caller may see
+	ProfileAttributes map[string]string `protobuf:"bytes,7,rep,name=profile_attributes,json=profileAttributes,proto3" json:"profile_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *OrgMember) Reset() {
+	*x = OrgMember{}
+	mi := &file_organization_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrgMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrgMember) ProtoMessage() {}
+
+func (x *OrgMember) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrgMember.ProtoReflect.Descriptor instead.
+func (*OrgMember) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *OrgMember) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OrgMember) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *OrgMember) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *OrgMember) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *OrgMember) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *OrgMember) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *OrgMember) GetProfileAttributes() map[string]string {
+	if x != nil {
+		return x.ProfileAttributes
+	}
+	return nil
+}
+
+type ListOrgMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrgMembersRequest) Reset() {
+	*x = ListOrgMembersRequest{}
+	mi := &file_organization_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrgMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrgMembersRequest) ProtoMessage() {}
+
+func (x *ListOrgMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrgMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListOrgMembersRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ListOrgMembersRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+type ListOrgMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*OrgMember           `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrgMembersResponse) Reset() {
+	*x = ListOrgMembersResponse{}
+	mi := &file_organization_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrgMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrgMembersResponse) ProtoMessage() {}
+
+func (x *ListOrgMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrgMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListOrgMembersResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ListOrgMembersResponse) GetMembers() []*OrgMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *ListOrgMembersResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type Workspace struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrgId         string                 `protobuf:"bytes,2,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	WorkspaceType string                 `protobuf:"bytes,5,opt,name=workspace_type,json=workspaceType,proto3" json:"workspace_type,omitempty"`
+	TeamId        string                 `protobuf:"bytes,6,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,7,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,8,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Settings      string                 `protobuf:"bytes,9,opt,name=settings,proto3" json:"settings,omitempty"`
+	IsPrivate     bool                   `protobuf:"varint,10,opt,name=is_private,json=isPrivate,proto3" json:"is_private,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Workspace) Reset() {
+	*x = Workspace{}
+	mi := &file_organization_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Workspace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Workspace) ProtoMessage() {}
+
+func (x *Workspace) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Workspace.ProtoReflect.Descriptor instead.
+func (*Workspace) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *Workspace) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Workspace) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *Workspace) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Workspace) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Workspace) GetWorkspaceType() string {
+	if x != nil {
+		return x.WorkspaceType
+	}
+	return ""
+}
+
+func (x *Workspace) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *Workspace) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *Workspace) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *Workspace) GetSettings() string {
+	if x != nil {
+		return x.Settings
+	}
+	return ""
+}
+
+func (x *Workspace) GetIsPrivate() bool {
+	if x != nil {
+		return x.IsPrivate
+	}
+	return false
+}
+
+func (x *Workspace) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Workspace) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateWorkspaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	WorkspaceType string                 `protobuf:"bytes,4,opt,name=workspace_type,json=workspaceType,proto3" json:"workspace_type,omitempty"`
+	TeamId        string                 `protobuf:"bytes,5,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,6,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	IsPrivate     bool                   `protobuf:"varint,7,opt,name=is_private,json=isPrivate,proto3" json:"is_private,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWorkspaceRequest) Reset() {
+	*x = CreateWorkspaceRequest{}
+	mi := &file_organization_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWorkspaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWorkspaceRequest) ProtoMessage() {}
+
+func (x *CreateWorkspaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*CreateWorkspaceRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *CreateWorkspaceRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *CreateWorkspaceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateWorkspaceRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateWorkspaceRequest) GetWorkspaceType() string {
+	if x != nil {
+		return x.WorkspaceType
+	}
+	return ""
+}
+
+func (x *CreateWorkspaceRequest) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *CreateWorkspaceRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *CreateWorkspaceRequest) GetIsPrivate() bool {
+	if x != nil {
+		return x.IsPrivate
+	}
+	return false
+}
+
+type CreateWorkspaceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Workspace     *Workspace             `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWorkspaceResponse) Reset() {
+	*x = CreateWorkspaceResponse{}
+	mi := &file_organization_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWorkspaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWorkspaceResponse) ProtoMessage() {}
+
+func (x *CreateWorkspaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWorkspaceResponse.ProtoReflect.Descriptor instead.
+func (*CreateWorkspaceResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *CreateWorkspaceResponse) GetWorkspace() *Workspace {
+	if x != nil {
+		return x.Workspace
+	}
+	return nil
+}
+
+func (x *CreateWorkspaceResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ListWorkspacesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	TeamId        string                 `protobuf:"bytes,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWorkspacesRequest) Reset() {
+	*x = ListWorkspacesRequest{}
+	mi := &file_organization_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWorkspacesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkspacesRequest) ProtoMessage() {}
+
+func (x *ListWorkspacesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkspacesRequest.ProtoReflect.Descriptor instead.
+func (*ListWorkspacesRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ListWorkspacesRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *ListWorkspacesRequest) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *ListWorkspacesRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type ListWorkspacesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Workspaces    []*Workspace           `protobuf:"bytes,1,rep,name=workspaces,proto3" json:"workspaces,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWorkspacesResponse) Reset() {
+	*x = ListWorkspacesResponse{}
+	mi := &file_organization_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWorkspacesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkspacesResponse) ProtoMessage() {}
+
+func (x *ListWorkspacesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkspacesResponse.ProtoReflect.Descriptor instead.
+func (*ListWorkspacesResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ListWorkspacesResponse) GetWorkspaces() []*Workspace {
+	if x != nil {
+		return x.Workspaces
+	}
+	return nil
+}
+
+type GetWorkspaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   string                 `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkspaceRequest) Reset() {
+	*x = GetWorkspaceRequest{}
+	mi := &file_organization_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkspaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkspaceRequest) ProtoMessage() {}
+
+func (x *GetWorkspaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkspaceRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *GetWorkspaceRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+type GetWorkspaceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Workspace     *Workspace             `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkspaceResponse) Reset() {
+	*x = GetWorkspaceResponse{}
+	mi := &file_organization_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkspaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkspaceResponse) ProtoMessage() {}
+
+func (x *GetWorkspaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkspaceResponse.ProtoReflect.Descriptor instead.
+func (*GetWorkspaceResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *GetWorkspaceResponse) GetWorkspace() *Workspace {
+	if x != nil {
+		return x.Workspace
+	}
+	return nil
+}
+
+type UpdateWorkspaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   string                 `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	WorkspaceType string                 `protobuf:"bytes,4,opt,name=workspace_type,json=workspaceType,proto3" json:"workspace_type,omitempty"`
+	// JSON object replacing the workspace's settings; must match the
+	// "workspace" metadata schema
+	Settings      string `protobuf:"bytes,5,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWorkspaceRequest) Reset() {
+	*x = UpdateWorkspaceRequest{}
+	mi := &file_organization_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWorkspaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWorkspaceRequest) ProtoMessage() {}
+
+func (x *UpdateWorkspaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWorkspaceRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *UpdateWorkspaceRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *UpdateWorkspaceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateWorkspaceRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateWorkspaceRequest) GetWorkspaceType() string {
+	if x != nil {
+		return x.WorkspaceType
+	}
+	return ""
+}
+
+func (x *UpdateWorkspaceRequest) GetSettings() string {
+	if x != nil {
+		return x.Settings
+	}
+	return ""
+}
+
+type UpdateWorkspaceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Workspace     *Workspace             `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWorkspaceResponse) Reset() {
+	*x = UpdateWorkspaceResponse{}
+	mi := &file_organization_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWorkspaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWorkspaceResponse) ProtoMessage() {}
+
+func (x *UpdateWorkspaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWorkspaceResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWorkspaceResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *UpdateWorkspaceResponse) GetWorkspace() *Workspace {
+	if x != nil {
+		return x.Workspace
+	}
+	return nil
+}
+
+func (x *UpdateWorkspaceResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type DeleteWorkspaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   string                 `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWorkspaceRequest) Reset() {
+	*x = DeleteWorkspaceRequest{}
+	mi := &file_organization_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWorkspaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWorkspaceRequest) ProtoMessage() {}
+
+func (x *DeleteWorkspaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWorkspaceRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *DeleteWorkspaceRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+type DeleteWorkspaceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWorkspaceResponse) Reset() {
+	*x = DeleteWorkspaceResponse{}
+	mi := &file_organization_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWorkspaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWorkspaceResponse) ProtoMessage() {}
+
+func (x *DeleteWorkspaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWorkspaceResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWorkspaceResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *DeleteWorkspaceResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type WorkspaceMember struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	WorkspaceId string                 `protobuf:"bytes,2,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	UserId      string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role        string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"` // owner, admin, member, viewer
+	JoinedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	IsActive    bool                   `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// User details
+	FullName      string `protobuf:"bytes,7,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	Email         string `protobuf:"bytes,8,opt,name=email,proto3" json:"email,omitempty"`
+	Username      string `protobuf:"bytes,9,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceMember) Reset() {
+	*x = WorkspaceMember{}
+	mi := &file_organization_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceMember) ProtoMessage() {}
+
+func (x *WorkspaceMember) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceMember.ProtoReflect.Descriptor instead.
+func (*WorkspaceMember) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *WorkspaceMember) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WorkspaceMember) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *WorkspaceMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *WorkspaceMember) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *WorkspaceMember) GetJoinedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return nil
+}
+
+func (x *WorkspaceMember) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *WorkspaceMember) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *WorkspaceMember) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *WorkspaceMember) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type AddWorkspaceMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   string                 `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"` // defaults to member
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddWorkspaceMemberRequest) Reset() {
+	*x = AddWorkspaceMemberRequest{}
+	mi := &file_organization_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddWorkspaceMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddWorkspaceMemberRequest) ProtoMessage() {}
+
+func (x *AddWorkspaceMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddWorkspaceMemberRequest.ProtoReflect.Descriptor instead.
+func (*AddWorkspaceMemberRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *AddWorkspaceMemberRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *AddWorkspaceMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddWorkspaceMemberRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type AddWorkspaceMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *WorkspaceMember       `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddWorkspaceMemberResponse) Reset() {
+	*x = AddWorkspaceMemberResponse{}
+	mi := &file_organization_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddWorkspaceMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddWorkspaceMemberResponse) ProtoMessage() {}
+
+func (x *AddWorkspaceMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddWorkspaceMemberResponse.ProtoReflect.Descriptor instead.
+func (*AddWorkspaceMemberResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *AddWorkspaceMemberResponse) GetMember() *WorkspaceMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+func (x *AddWorkspaceMemberResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type RemoveWorkspaceMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   string                 `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveWorkspaceMemberRequest) Reset() {
+	*x = RemoveWorkspaceMemberRequest{}
+	mi := &file_organization_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveWorkspaceMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveWorkspaceMemberRequest) ProtoMessage() {}
+
+func (x *RemoveWorkspaceMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveWorkspaceMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveWorkspaceMemberRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *RemoveWorkspaceMemberRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *RemoveWorkspaceMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RemoveWorkspaceMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveWorkspaceMemberResponse) Reset() {
+	*x = RemoveWorkspaceMemberResponse{}
+	mi := &file_organization_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveWorkspaceMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveWorkspaceMemberResponse) ProtoMessage() {}
+
+func (x *RemoveWorkspaceMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveWorkspaceMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveWorkspaceMemberResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *RemoveWorkspaceMemberResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ListWorkspaceMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   string                 `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWorkspaceMembersRequest) Reset() {
+	*x = ListWorkspaceMembersRequest{}
+	mi := &file_organization_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWorkspaceMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkspaceMembersRequest) ProtoMessage() {}
+
+func (x *ListWorkspaceMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkspaceMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListWorkspaceMembersRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *ListWorkspaceMembersRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+type ListWorkspaceMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*WorkspaceMember     `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWorkspaceMembersResponse) Reset() {
+	*x = ListWorkspaceMembersResponse{}
+	mi := &file_organization_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWorkspaceMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkspaceMembersResponse) ProtoMessage() {}
+
+func (x *ListWorkspaceMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkspaceMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListWorkspaceMembersResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ListWorkspaceMembersResponse) GetMembers() []*WorkspaceMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type ProjectTemplateTask struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Title       string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Priority    string                 `protobuf:"bytes,3,opt,name=priority,proto3" json:"priority,omitempty"` // low, medium, high, critical
+	Tags        []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Days after the project start the task is due; 0 leaves it without a due date
+	DueOffsetDays int32 `protobuf:"varint,5,opt,name=due_offset_days,json=dueOffsetDays,proto3" json:"due_offset_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectTemplateTask) Reset() {
+	*x = ProjectTemplateTask{}
+	mi := &file_organization_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectTemplateTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectTemplateTask) ProtoMessage() {}
+
+func (x *ProjectTemplateTask) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectTemplateTask.ProtoReflect.Descriptor instead.
+func (*ProjectTemplateTask) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *ProjectTemplateTask) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ProjectTemplateTask) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ProjectTemplateTask) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+func (x *ProjectTemplateTask) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ProjectTemplateTask) GetDueOffsetDays() int32 {
+	if x != nil {
+		return x.DueOffsetDays
+	}
+	return 0
+}
+
+type ProjectTemplateMilestone struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Days after the project start the milestone falls on
+	OffsetDays    int32 `protobuf:"varint,3,opt,name=offset_days,json=offsetDays,proto3" json:"offset_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectTemplateMilestone) Reset() {
+	*x = ProjectTemplateMilestone{}
+	mi := &file_organization_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectTemplateMilestone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectTemplateMilestone) ProtoMessage() {}
+
+func (x *ProjectTemplateMilestone) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *Project) GetMemberCount() int32 {
+// Deprecated: Use ProjectTemplateMilestone.ProtoReflect.Descriptor instead.
+func (*ProjectTemplateMilestone) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *ProjectTemplateMilestone) GetName() string {
 	if x != nil {
-		return x.MemberCount
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProjectTemplateMilestone) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ProjectTemplateMilestone) GetOffsetDays() int32 {
+	if x != nil {
+		return x.OffsetDays
 	}
 	return 0
 }
 
-type ProjectManager struct {
+type ProjectTemplateWorkspace struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	FullName      string                 `protobuf:"bytes,2,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
-	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	Username      string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	WorkspaceType string                 `protobuf:"bytes,1,opt,name=workspace_type,json=workspaceType,proto3" json:"workspace_type,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Settings      string                 `protobuf:"bytes,3,opt,name=settings,proto3" json:"settings,omitempty"` // JSON string
+	IsPrivate     bool                   `protobuf:"varint,4,opt,name=is_private,json=isPrivate,proto3" json:"is_private,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProjectManager) Reset() {
-	*x = ProjectManager{}
-	mi := &file_organization_proto_msgTypes[20]
+func (x *ProjectTemplateWorkspace) Reset() {
+	*x = ProjectTemplateWorkspace{}
+	mi := &file_organization_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProjectManager) String() string {
+func (x *ProjectTemplateWorkspace) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProjectManager) ProtoMessage() {}
+func (*ProjectTemplateWorkspace) ProtoMessage() {}
 
-func (x *ProjectManager) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[20]
+func (x *ProjectTemplateWorkspace) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1445,67 +7318,76 @@ func (x *ProjectManager) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProjectManager.ProtoReflect.Descriptor instead.
-func (*ProjectManager) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ProjectTemplateWorkspace.ProtoReflect.Descriptor instead.
+func (*ProjectTemplateWorkspace) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *ProjectManager) GetId() string {
+func (x *ProjectTemplateWorkspace) GetWorkspaceType() string {
 	if x != nil {
-		return x.Id
+		return x.WorkspaceType
 	}
 	return ""
 }
 
-func (x *ProjectManager) GetFullName() string {
+func (x *ProjectTemplateWorkspace) GetDescription() string {
 	if x != nil {
-		return x.FullName
+		return x.Description
 	}
 	return ""
 }
 
-func (x *ProjectManager) GetEmail() string {
+func (x *ProjectTemplateWorkspace) GetSettings() string {
 	if x != nil {
-		return x.Email
+		return x.Settings
 	}
 	return ""
 }
 
-func (x *ProjectManager) GetUsername() string {
+func (x *ProjectTemplateWorkspace) GetIsPrivate() bool {
 	if x != nil {
-		return x.Username
+		return x.IsPrivate
 	}
-	return ""
+	return false
 }
 
-type ProjectTeam struct {
-	state      protoimpl.MessageState `protogen:"open.v1"`
-	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ProjectId  string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	TeamId     string                 `protobuf:"bytes,3,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
-	AssignedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=assigned_at,json=assignedAt,proto3" json:"assigned_at,omitempty"`
-	// Team details
-	TeamName        string `protobuf:"bytes,5,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
-	TeamMemberCount int32  `protobuf:"varint,6,opt,name=team_member_count,json=teamMemberCount,proto3" json:"team_member_count,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type ProjectTemplate struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrgId           string                 `protobuf:"bytes,2,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Name            string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	SourceProjectId string                 `protobuf:"bytes,5,opt,name=source_project_id,json=sourceProjectId,proto3" json:"source_project_id,omitempty"`
+	Priority        string                 `protobuf:"bytes,6,opt,name=priority,proto3" json:"priority,omitempty"`
+	Budget          float64                `protobuf:"fixed64,7,opt,name=budget,proto3" json:"budget,omitempty"`
+	// Length of the project in days; 0 leaves new projects without an end date
+	DurationDays  int32                       `protobuf:"varint,8,opt,name=duration_days,json=durationDays,proto3" json:"duration_days,omitempty"`
+	TeamIds       []string                    `protobuf:"bytes,9,rep,name=team_ids,json=teamIds,proto3" json:"team_ids,omitempty"`
+	Workspace     *ProjectTemplateWorkspace   `protobuf:"bytes,10,opt,name=workspace,proto3" json:"workspace,omitempty"` // unset when the project had none
+	Tasks         []*ProjectTemplateTask      `protobuf:"bytes,11,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	Milestones    []*ProjectTemplateMilestone `protobuf:"bytes,12,rep,name=milestones,proto3" json:"milestones,omitempty"`
+	CreatedBy     string                      `protobuf:"bytes,13,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp      `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp      `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProjectTeam) Reset() {
-	*x = ProjectTeam{}
-	mi := &file_organization_proto_msgTypes[21]
+func (x *ProjectTemplate) Reset() {
+	*x = ProjectTemplate{}
+	mi := &file_organization_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProjectTeam) String() string {
+func (x *ProjectTemplate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProjectTeam) ProtoMessage() {}
+func (*ProjectTemplate) ProtoMessage() {}
 
-func (x *ProjectTeam) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[21]
+func (x *ProjectTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1516,85 +7398,145 @@ func (x *ProjectTeam) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProjectTeam.ProtoReflect.Descriptor instead.
-func (*ProjectTeam) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ProjectTemplate.ProtoReflect.Descriptor instead.
+func (*ProjectTemplate) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *ProjectTeam) GetId() string {
+func (x *ProjectTemplate) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *ProjectTeam) GetProjectId() string {
+func (x *ProjectTemplate) GetOrgId() string {
 	if x != nil {
-		return x.ProjectId
+		return x.OrgId
 	}
 	return ""
 }
 
-func (x *ProjectTeam) GetTeamId() string {
+func (x *ProjectTemplate) GetName() string {
 	if x != nil {
-		return x.TeamId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ProjectTeam) GetAssignedAt() *timestamppb.Timestamp {
+func (x *ProjectTemplate) GetDescription() string {
 	if x != nil {
-		return x.AssignedAt
+		return x.Description
 	}
-	return nil
+	return ""
 }
 
-func (x *ProjectTeam) GetTeamName() string {
+func (x *ProjectTemplate) GetSourceProjectId() string {
 	if x != nil {
-		return x.TeamName
+		return x.SourceProjectId
 	}
 	return ""
 }
 
-func (x *ProjectTeam) GetTeamMemberCount() int32 {
+func (x *ProjectTemplate) GetPriority() string {
 	if x != nil {
-		return x.TeamMemberCount
+		return x.Priority
+	}
+	return ""
+}
+
+func (x *ProjectTemplate) GetBudget() float64 {
+	if x != nil {
+		return x.Budget
 	}
 	return 0
 }
 
-type ProjectMember struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ProjectId            string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	UserId               string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Role                 string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
-	AllocationPercentage int32                  `protobuf:"varint,5,opt,name=allocation_percentage,json=allocationPercentage,proto3" json:"allocation_percentage,omitempty"`
-	JoinedAt             *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
-	IsActive             bool                   `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	// User details
-	FullName      string `protobuf:"bytes,8,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
-	Email         string `protobuf:"bytes,9,opt,name=email,proto3" json:"email,omitempty"`
-	Username      string `protobuf:"bytes,10,opt,name=username,proto3" json:"username,omitempty"`
+func (x *ProjectTemplate) GetDurationDays() int32 {
+	if x != nil {
+		return x.DurationDays
+	}
+	return 0
+}
+
+func (x *ProjectTemplate) GetTeamIds() []string {
+	if x != nil {
+		return x.TeamIds
+	}
+	return nil
+}
+
+func (x *ProjectTemplate) GetWorkspace() *ProjectTemplateWorkspace {
+	if x != nil {
+		return x.Workspace
+	}
+	return nil
+}
+
+func (x *ProjectTemplate) GetTasks() []*ProjectTemplateTask {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *ProjectTemplate) GetMilestones() []*ProjectTemplateMilestone {
+	if x != nil {
+		return x.Milestones
+	}
+	return nil
+}
+
+func (x *ProjectTemplate) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *ProjectTemplate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ProjectTemplate) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateProjectTemplateRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId   string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Copy the project's existing tasks as default tasks
+	IncludeTasks bool `protobuf:"varint,4,opt,name=include_tasks,json=includeTasks,proto3" json:"include_tasks,omitempty"`
+	// Default tasks and milestones added to those taken from the project
+	Tasks         []*ProjectTemplateTask      `protobuf:"bytes,5,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	Milestones    []*ProjectTemplateMilestone `protobuf:"bytes,6,rep,name=milestones,proto3" json:"milestones,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProjectMember) Reset() {
-	*x = ProjectMember{}
-	mi := &file_organization_proto_msgTypes[22]
+func (x *CreateProjectTemplateRequest) Reset() {
+	*x = CreateProjectTemplateRequest{}
+	mi := &file_organization_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProjectMember) String() string {
+func (x *CreateProjectTemplateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProjectMember) ProtoMessage() {}
+func (*CreateProjectTemplateRequest) ProtoMessage() {}
 
-func (x *ProjectMember) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[22]
+func (x *CreateProjectTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1605,110 +7547,171 @@ func (x *ProjectMember) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProjectMember.ProtoReflect.Descriptor instead.
-func (*ProjectMember) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use CreateProjectTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateProjectTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *ProjectMember) GetId() string {
+func (x *CreateProjectTemplateRequest) GetProjectId() string {
 	if x != nil {
-		return x.Id
+		return x.ProjectId
 	}
 	return ""
 }
 
-func (x *ProjectMember) GetProjectId() string {
+func (x *CreateProjectTemplateRequest) GetName() string {
 	if x != nil {
-		return x.ProjectId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ProjectMember) GetUserId() string {
+func (x *CreateProjectTemplateRequest) GetDescription() string {
 	if x != nil {
-		return x.UserId
+		return x.Description
 	}
 	return ""
 }
 
-func (x *ProjectMember) GetRole() string {
+func (x *CreateProjectTemplateRequest) GetIncludeTasks() bool {
 	if x != nil {
-		return x.Role
+		return x.IncludeTasks
 	}
-	return ""
+	return false
 }
 
-func (x *ProjectMember) GetAllocationPercentage() int32 {
+func (x *CreateProjectTemplateRequest) GetTasks() []*ProjectTemplateTask {
 	if x != nil {
-		return x.AllocationPercentage
+		return x.Tasks
 	}
-	return 0
+	return nil
 }
 
-func (x *ProjectMember) GetJoinedAt() *timestamppb.Timestamp {
+func (x *CreateProjectTemplateRequest) GetMilestones() []*ProjectTemplateMilestone {
 	if x != nil {
-		return x.JoinedAt
+		return x.Milestones
 	}
 	return nil
 }
 
-func (x *ProjectMember) GetIsActive() bool {
+type CreateProjectTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *ProjectTemplate       `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProjectTemplateResponse) Reset() {
+	*x = CreateProjectTemplateResponse{}
+	mi := &file_organization_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProjectTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProjectTemplateResponse) ProtoMessage() {}
+
+func (x *CreateProjectTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[115]
 	if x != nil {
-		return x.IsActive
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *ProjectMember) GetFullName() string {
+// Deprecated: Use CreateProjectTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateProjectTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *CreateProjectTemplateResponse) GetTemplate() *ProjectTemplate {
 	if x != nil {
-		return x.FullName
+		return x.Template
 	}
-	return ""
+	return nil
 }
 
-func (x *ProjectMember) GetEmail() string {
+func (x *CreateProjectTemplateResponse) GetMessage() string {
 	if x != nil {
-		return x.Email
+		return x.Message
 	}
 	return ""
 }
 
-func (x *ProjectMember) GetUsername() string {
+type GetProjectTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectTemplateRequest) Reset() {
+	*x = GetProjectTemplateRequest{}
+	mi := &file_organization_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectTemplateRequest) ProtoMessage() {}
+
+func (x *GetProjectTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[116]
 	if x != nil {
-		return x.Username
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type CreateProjectRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	OrgId            string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
-	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description      string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	ProjectManagerId string                 `protobuf:"bytes,4,opt,name=project_manager_id,json=projectManagerId,proto3" json:"project_manager_id,omitempty"`
-	Priority         string                 `protobuf:"bytes,5,opt,name=priority,proto3" json:"priority,omitempty"`
-	StartDate        string                 `protobuf:"bytes,6,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate          string                 `protobuf:"bytes,7,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	Budget           float64                `protobuf:"fixed64,8,opt,name=budget,proto3" json:"budget,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// Deprecated: Use GetProjectTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetProjectTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *GetProjectTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
 }
 
-func (x *CreateProjectRequest) Reset() {
-	*x = CreateProjectRequest{}
-	mi := &file_organization_proto_msgTypes[23]
+type GetProjectTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *ProjectTemplate       `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectTemplateResponse) Reset() {
+	*x = GetProjectTemplateResponse{}
+	mi := &file_organization_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProjectRequest) String() string {
+func (x *GetProjectTemplateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProjectRequest) ProtoMessage() {}
+func (*GetProjectTemplateResponse) ProtoMessage() {}
 
-func (x *CreateProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[23]
+func (x *GetProjectTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1719,90 +7722,85 @@ func (x *CreateProjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProjectRequest.ProtoReflect.Descriptor instead.
-func (*CreateProjectRequest) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use GetProjectTemplateResponse.ProtoReflect.Descriptor instead.
+func (*GetProjectTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *CreateProjectRequest) GetOrgId() string {
+func (x *GetProjectTemplateResponse) GetTemplate() *ProjectTemplate {
 	if x != nil {
-		return x.OrgId
+		return x.Template
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateProjectRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+type ListProjectTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateProjectRequest) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+func (x *ListProjectTemplatesRequest) Reset() {
+	*x = ListProjectTemplatesRequest{}
+	mi := &file_organization_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProjectRequest) GetProjectManagerId() string {
-	if x != nil {
-		return x.ProjectManagerId
-	}
-	return ""
+func (x *ListProjectTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CreateProjectRequest) GetPriority() string {
-	if x != nil {
-		return x.Priority
-	}
-	return ""
-}
+func (*ListProjectTemplatesRequest) ProtoMessage() {}
 
-func (x *CreateProjectRequest) GetStartDate() string {
+func (x *ListProjectTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[118]
 	if x != nil {
-		return x.StartDate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateProjectRequest) GetEndDate() string {
-	if x != nil {
-		return x.EndDate
-	}
-	return ""
+// Deprecated: Use ListProjectTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListProjectTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *CreateProjectRequest) GetBudget() float64 {
+func (x *ListProjectTemplatesRequest) GetOrgId() string {
 	if x != nil {
-		return x.Budget
+		return x.OrgId
 	}
-	return 0
+	return ""
 }
 
-type CreateProjectResponse struct {
+type ListProjectTemplatesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Templates     []*ProjectTemplate     `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateProjectResponse) Reset() {
-	*x = CreateProjectResponse{}
-	mi := &file_organization_proto_msgTypes[24]
+func (x *ListProjectTemplatesResponse) Reset() {
+	*x = ListProjectTemplatesResponse{}
+	mi := &file_organization_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProjectResponse) String() string {
+func (x *ListProjectTemplatesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProjectResponse) ProtoMessage() {}
+func (*ListProjectTemplatesResponse) ProtoMessage() {}
 
-func (x *CreateProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[24]
+func (x *ListProjectTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1813,47 +7811,47 @@ func (x *CreateProjectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProjectResponse.ProtoReflect.Descriptor instead.
-func (*CreateProjectResponse) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use ListProjectTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListProjectTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *CreateProjectResponse) GetProject() *Project {
+func (x *ListProjectTemplatesResponse) GetTemplates() []*ProjectTemplate {
 	if x != nil {
-		return x.Project
+		return x.Templates
 	}
 	return nil
 }
 
-func (x *CreateProjectResponse) GetMessage() string {
+func (x *ListProjectTemplatesResponse) GetTotal() int32 {
 	if x != nil {
-		return x.Message
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
-type GetProjectRequest struct {
+type DeleteProjectTemplateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProjectRequest) Reset() {
-	*x = GetProjectRequest{}
-	mi := &file_organization_proto_msgTypes[25]
+func (x *DeleteProjectTemplateRequest) Reset() {
+	*x = DeleteProjectTemplateRequest{}
+	mi := &file_organization_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProjectRequest) String() string {
+func (x *DeleteProjectTemplateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProjectRequest) ProtoMessage() {}
+func (*DeleteProjectTemplateRequest) ProtoMessage() {}
 
-func (x *GetProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[25]
+func (x *DeleteProjectTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1864,40 +7862,40 @@ func (x *GetProjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProjectRequest.ProtoReflect.Descriptor instead.
-func (*GetProjectRequest) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use DeleteProjectTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProjectTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *GetProjectRequest) GetProjectId() string {
+func (x *DeleteProjectTemplateRequest) GetTemplateId() string {
 	if x != nil {
-		return x.ProjectId
+		return x.TemplateId
 	}
 	return ""
 }
 
-type GetProjectResponse struct {
+type DeleteProjectTemplateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProjectResponse) Reset() {
-	*x = GetProjectResponse{}
-	mi := &file_organization_proto_msgTypes[26]
+func (x *DeleteProjectTemplateResponse) Reset() {
+	*x = DeleteProjectTemplateResponse{}
+	mi := &file_organization_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProjectResponse) String() string {
+func (x *DeleteProjectTemplateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProjectResponse) ProtoMessage() {}
+func (*DeleteProjectTemplateResponse) ProtoMessage() {}
 
-func (x *GetProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[26]
+func (x *DeleteProjectTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1908,44 +7906,44 @@ func (x *GetProjectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProjectResponse.ProtoReflect.Descriptor instead.
-func (*GetProjectResponse) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use DeleteProjectTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProjectTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *GetProjectResponse) GetProject() *Project {
+func (x *DeleteProjectTemplateResponse) GetMessage() string {
 	if x != nil {
-		return x.Project
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-type ListProjectsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	Priority      string                 `protobuf:"bytes,3,opt,name=priority,proto3" json:"priority,omitempty"`
-	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CreateProjectFromTemplateRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId       string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description      string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"` // defaults to the template's
+	ProjectManagerId string                 `protobuf:"bytes,4,opt,name=project_manager_id,json=projectManagerId,proto3" json:"project_manager_id,omitempty"`
+	StartDate        string                 `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // ISO date string; defaults to today
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *ListProjectsRequest) Reset() {
-	*x = ListProjectsRequest{}
-	mi := &file_organization_proto_msgTypes[27]
+func (x *CreateProjectFromTemplateRequest) Reset() {
+	*x = CreateProjectFromTemplateRequest{}
+	mi := &file_organization_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProjectsRequest) String() string {
+func (x *CreateProjectFromTemplateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProjectsRequest) ProtoMessage() {}
+func (*CreateProjectFromTemplateRequest) ProtoMessage() {}
 
-func (x *ListProjectsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[27]
+func (x *CreateProjectFromTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1956,71 +7954,73 @@ func (x *ListProjectsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProjectsRequest.ProtoReflect.Descriptor instead.
-func (*ListProjectsRequest) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use CreateProjectFromTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateProjectFromTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *ListProjectsRequest) GetOrgId() string {
+func (x *CreateProjectFromTemplateRequest) GetTemplateId() string {
 	if x != nil {
-		return x.OrgId
+		return x.TemplateId
 	}
 	return ""
 }
 
-func (x *ListProjectsRequest) GetStatus() string {
+func (x *CreateProjectFromTemplateRequest) GetName() string {
 	if x != nil {
-		return x.Status
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ListProjectsRequest) GetPriority() string {
+func (x *CreateProjectFromTemplateRequest) GetDescription() string {
 	if x != nil {
-		return x.Priority
+		return x.Description
 	}
 	return ""
 }
 
-func (x *ListProjectsRequest) GetPage() int32 {
+func (x *CreateProjectFromTemplateRequest) GetProjectManagerId() string {
 	if x != nil {
-		return x.Page
+		return x.ProjectManagerId
 	}
-	return 0
+	return ""
 }
 
-func (x *ListProjectsRequest) GetPageSize() int32 {
+func (x *CreateProjectFromTemplateRequest) GetStartDate() string {
 	if x != nil {
-		return x.PageSize
+		return x.StartDate
 	}
-	return 0
+	return ""
 }
 
-type ListProjectsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Projects      []*Project             `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+type CreateProjectFromTemplateResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Project      *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	Workspace    *Workspace             `protobuf:"bytes,2,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	TasksCreated int32                  `protobuf:"varint,3,opt,name=tasks_created,json=tasksCreated,proto3" json:"tasks_created,omitempty"`
+	// Default tasks the task service refused or could not be reached for
+	FailedTasks   []string `protobuf:"bytes,4,rep,name=failed_tasks,json=failedTasks,proto3" json:"failed_tasks,omitempty"`
+	Message       string   `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProjectsResponse) Reset() {
-	*x = ListProjectsResponse{}
-	mi := &file_organization_proto_msgTypes[28]
+func (x *CreateProjectFromTemplateResponse) Reset() {
+	*x = CreateProjectFromTemplateResponse{}
+	mi := &file_organization_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProjectsResponse) String() string {
+func (x *CreateProjectFromTemplateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProjectsResponse) ProtoMessage() {}
+func (*CreateProjectFromTemplateResponse) ProtoMessage() {}
 
-func (x *ListProjectsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[28]
+func (x *CreateProjectFromTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2031,67 +8031,69 @@ func (x *ListProjectsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProjectsResponse.ProtoReflect.Descriptor instead.
-func (*ListProjectsResponse) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use CreateProjectFromTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateProjectFromTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{123}
 }
 
-func (x *ListProjectsResponse) GetProjects() []*Project {
+func (x *CreateProjectFromTemplateResponse) GetProject() *Project {
 	if x != nil {
-		return x.Projects
+		return x.Project
 	}
 	return nil
 }
 
-func (x *ListProjectsResponse) GetTotal() int32 {
+func (x *CreateProjectFromTemplateResponse) GetWorkspace() *Workspace {
 	if x != nil {
-		return x.Total
+		return x.Workspace
 	}
-	return 0
+	return nil
 }
 
-func (x *ListProjectsResponse) GetPage() int32 {
+func (x *CreateProjectFromTemplateResponse) GetTasksCreated() int32 {
 	if x != nil {
-		return x.Page
+		return x.TasksCreated
 	}
 	return 0
 }
 
-func (x *ListProjectsResponse) GetPageSize() int32 {
+func (x *CreateProjectFromTemplateResponse) GetFailedTasks() []string {
 	if x != nil {
-		return x.PageSize
+		return x.FailedTasks
 	}
-	return 0
+	return nil
 }
 
-type UpdateProjectRequest struct {
+func (x *CreateProjectFromTemplateResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type WorkspaceTemplateBoard struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
-	Priority      string                 `protobuf:"bytes,5,opt,name=priority,proto3" json:"priority,omitempty"`
-	Progress      int32                  `protobuf:"varint,6,opt,name=progress,proto3" json:"progress,omitempty"`
-	Budget        float64                `protobuf:"fixed64,7,opt,name=budget,proto3" json:"budget,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Columns       []string               `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateProjectRequest) Reset() {
-	*x = UpdateProjectRequest{}
-	mi := &file_organization_proto_msgTypes[29]
+func (x *WorkspaceTemplateBoard) Reset() {
+	*x = WorkspaceTemplateBoard{}
+	mi := &file_organization_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProjectRequest) String() string {
+func (x *WorkspaceTemplateBoard) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProjectRequest) ProtoMessage() {}
+func (*WorkspaceTemplateBoard) ProtoMessage() {}
 
-func (x *UpdateProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[29]
+func (x *WorkspaceTemplateBoard) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2102,83 +8104,51 @@ func (x *UpdateProjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProjectRequest.ProtoReflect.Descriptor instead.
-func (*UpdateProjectRequest) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{29}
-}
-
-func (x *UpdateProjectRequest) GetProjectId() string {
-	if x != nil {
-		return x.ProjectId
-	}
-	return ""
+// Deprecated: Use WorkspaceTemplateBoard.ProtoReflect.Descriptor instead.
+func (*WorkspaceTemplateBoard) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{124}
 }
 
-func (x *UpdateProjectRequest) GetName() string {
+func (x *WorkspaceTemplateBoard) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *UpdateProjectRequest) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *UpdateProjectRequest) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
-}
-
-func (x *UpdateProjectRequest) GetPriority() string {
-	if x != nil {
-		return x.Priority
-	}
-	return ""
-}
-
-func (x *UpdateProjectRequest) GetProgress() int32 {
-	if x != nil {
-		return x.Progress
-	}
-	return 0
-}
-
-func (x *UpdateProjectRequest) GetBudget() float64 {
+func (x *WorkspaceTemplateBoard) GetColumns() []string {
 	if x != nil {
-		return x.Budget
+		return x.Columns
 	}
-	return 0
+	return nil
 }
 
-type UpdateProjectResponse struct {
+// A group created alongside each workspace made from the template, named
+// after the workspace
+type WorkspaceTemplateGroup struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	GroupType     string                 `protobuf:"bytes,3,opt,name=group_type,json=groupType,proto3" json:"group_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
-
-func (x *UpdateProjectResponse) Reset() {
-	*x = UpdateProjectResponse{}
-	mi := &file_organization_proto_msgTypes[30]
+
+func (x *WorkspaceTemplateGroup) Reset() {
+	*x = WorkspaceTemplateGroup{}
+	mi := &file_organization_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProjectResponse) String() string {
+func (x *WorkspaceTemplateGroup) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProjectResponse) ProtoMessage() {}
+func (*WorkspaceTemplateGroup) ProtoMessage() {}
 
-func (x *UpdateProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[30]
+func (x *WorkspaceTemplateGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2189,47 +8159,65 @@ func (x *UpdateProjectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProjectResponse.ProtoReflect.Descriptor instead.
-func (*UpdateProjectResponse) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use WorkspaceTemplateGroup.ProtoReflect.Descriptor instead.
+func (*WorkspaceTemplateGroup) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *UpdateProjectResponse) GetProject() *Project {
+func (x *WorkspaceTemplateGroup) GetName() string {
 	if x != nil {
-		return x.Project
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateProjectResponse) GetMessage() string {
+func (x *WorkspaceTemplateGroup) GetDescription() string {
 	if x != nil {
-		return x.Message
+		return x.Description
 	}
 	return ""
 }
 
-type DeleteProjectRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+func (x *WorkspaceTemplateGroup) GetGroupType() string {
+	if x != nil {
+		return x.GroupType
+	}
+	return ""
+}
+
+type WorkspaceTemplate struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Id            string                    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrgId         string                    `protobuf:"bytes,2,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Name          string                    `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                    `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	WorkspaceType string                    `protobuf:"bytes,5,opt,name=workspace_type,json=workspaceType,proto3" json:"workspace_type,omitempty"`
+	Settings      string                    `protobuf:"bytes,6,opt,name=settings,proto3" json:"settings,omitempty"` // JSON string, validated against the workspace settings schema
+	IsPrivate     bool                      `protobuf:"varint,7,opt,name=is_private,json=isPrivate,proto3" json:"is_private,omitempty"`
+	Boards        []*WorkspaceTemplateBoard `protobuf:"bytes,8,rep,name=boards,proto3" json:"boards,omitempty"`
+	Groups        []*WorkspaceTemplateGroup `protobuf:"bytes,9,rep,name=groups,proto3" json:"groups,omitempty"`
+	CreatedBy     string                    `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp    `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp    `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteProjectRequest) Reset() {
-	*x = DeleteProjectRequest{}
-	mi := &file_organization_proto_msgTypes[31]
+func (x *WorkspaceTemplate) Reset() {
+	*x = WorkspaceTemplate{}
+	mi := &file_organization_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteProjectRequest) String() string {
+func (x *WorkspaceTemplate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProjectRequest) ProtoMessage() {}
+func (*WorkspaceTemplate) ProtoMessage() {}
 
-func (x *DeleteProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[31]
+func (x *WorkspaceTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2240,85 +8228,124 @@ func (x *DeleteProjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProjectRequest.ProtoReflect.Descriptor instead.
-func (*DeleteProjectRequest) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use WorkspaceTemplate.ProtoReflect.Descriptor instead.
+func (*WorkspaceTemplate) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{126}
 }
 
-func (x *DeleteProjectRequest) GetProjectId() string {
+func (x *WorkspaceTemplate) GetId() string {
 	if x != nil {
-		return x.ProjectId
+		return x.Id
 	}
 	return ""
 }
 
-type DeleteProjectResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *WorkspaceTemplate) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
 }
 
-func (x *DeleteProjectResponse) Reset() {
-	*x = DeleteProjectResponse{}
-	mi := &file_organization_proto_msgTypes[32]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *WorkspaceTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *DeleteProjectResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *WorkspaceTemplate) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
 }
 
-func (*DeleteProjectResponse) ProtoMessage() {}
+func (x *WorkspaceTemplate) GetWorkspaceType() string {
+	if x != nil {
+		return x.WorkspaceType
+	}
+	return ""
+}
 
-func (x *DeleteProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[32]
+func (x *WorkspaceTemplate) GetSettings() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Settings
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use DeleteProjectResponse.ProtoReflect.Descriptor instead.
-func (*DeleteProjectResponse) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{32}
+func (x *WorkspaceTemplate) GetIsPrivate() bool {
+	if x != nil {
+		return x.IsPrivate
+	}
+	return false
 }
 
-func (x *DeleteProjectResponse) GetMessage() string {
+func (x *WorkspaceTemplate) GetBoards() []*WorkspaceTemplateBoard {
 	if x != nil {
-		return x.Message
+		return x.Boards
+	}
+	return nil
+}
+
+func (x *WorkspaceTemplate) GetGroups() []*WorkspaceTemplateGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *WorkspaceTemplate) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
 	}
 	return ""
 }
 
-type AssignTeamToProjectRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	TeamId        string                 `protobuf:"bytes,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+func (x *WorkspaceTemplate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *WorkspaceTemplate) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateWorkspaceTemplateRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	OrgId         string                    `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Name          string                    `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                    `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	WorkspaceType string                    `protobuf:"bytes,4,opt,name=workspace_type,json=workspaceType,proto3" json:"workspace_type,omitempty"`
+	Settings      string                    `protobuf:"bytes,5,opt,name=settings,proto3" json:"settings,omitempty"` // JSON string; boards below replace any boards in it
+	IsPrivate     bool                      `protobuf:"varint,6,opt,name=is_private,json=isPrivate,proto3" json:"is_private,omitempty"`
+	Boards        []*WorkspaceTemplateBoard `protobuf:"bytes,7,rep,name=boards,proto3" json:"boards,omitempty"`
+	Groups        []*WorkspaceTemplateGroup `protobuf:"bytes,8,rep,name=groups,proto3" json:"groups,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AssignTeamToProjectRequest) Reset() {
-	*x = AssignTeamToProjectRequest{}
-	mi := &file_organization_proto_msgTypes[33]
+func (x *CreateWorkspaceTemplateRequest) Reset() {
+	*x = CreateWorkspaceTemplateRequest{}
+	mi := &file_organization_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AssignTeamToProjectRequest) String() string {
+func (x *CreateWorkspaceTemplateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssignTeamToProjectRequest) ProtoMessage() {}
+func (*CreateWorkspaceTemplateRequest) ProtoMessage() {}
 
-func (x *AssignTeamToProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[33]
+func (x *CreateWorkspaceTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2329,100 +8356,90 @@ func (x *AssignTeamToProjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssignTeamToProjectRequest.ProtoReflect.Descriptor instead.
-func (*AssignTeamToProjectRequest) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use CreateWorkspaceTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateWorkspaceTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{127}
 }
 
-func (x *AssignTeamToProjectRequest) GetProjectId() string {
+func (x *CreateWorkspaceTemplateRequest) GetOrgId() string {
 	if x != nil {
-		return x.ProjectId
+		return x.OrgId
 	}
 	return ""
 }
 
-func (x *AssignTeamToProjectRequest) GetTeamId() string {
+func (x *CreateWorkspaceTemplateRequest) GetName() string {
 	if x != nil {
-		return x.TeamId
+		return x.Name
 	}
 	return ""
 }
 
-type AssignTeamToProjectResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProjectTeam   *ProjectTeam           `protobuf:"bytes,1,opt,name=project_team,json=projectTeam,proto3" json:"project_team,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *AssignTeamToProjectResponse) Reset() {
-	*x = AssignTeamToProjectResponse{}
-	mi := &file_organization_proto_msgTypes[34]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *CreateWorkspaceTemplateRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
 }
 
-func (x *AssignTeamToProjectResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CreateWorkspaceTemplateRequest) GetWorkspaceType() string {
+	if x != nil {
+		return x.WorkspaceType
+	}
+	return ""
 }
 
-func (*AssignTeamToProjectResponse) ProtoMessage() {}
-
-func (x *AssignTeamToProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[34]
+func (x *CreateWorkspaceTemplateRequest) GetSettings() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Settings
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use AssignTeamToProjectResponse.ProtoReflect.Descriptor instead.
-func (*AssignTeamToProjectResponse) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{34}
+func (x *CreateWorkspaceTemplateRequest) GetIsPrivate() bool {
+	if x != nil {
+		return x.IsPrivate
+	}
+	return false
 }
 
-func (x *AssignTeamToProjectResponse) GetProjectTeam() *ProjectTeam {
+func (x *CreateWorkspaceTemplateRequest) GetBoards() []*WorkspaceTemplateBoard {
 	if x != nil {
-		return x.ProjectTeam
+		return x.Boards
 	}
 	return nil
 }
 
-func (x *AssignTeamToProjectResponse) GetMessage() string {
+func (x *CreateWorkspaceTemplateRequest) GetGroups() []*WorkspaceTemplateGroup {
 	if x != nil {
-		return x.Message
+		return x.Groups
 	}
-	return ""
+	return nil
 }
 
-type RemoveTeamFromProjectRequest struct {
+type CreateWorkspaceTemplateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	TeamId        string                 `protobuf:"bytes,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Template      *WorkspaceTemplate     `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveTeamFromProjectRequest) Reset() {
-	*x = RemoveTeamFromProjectRequest{}
-	mi := &file_organization_proto_msgTypes[35]
+func (x *CreateWorkspaceTemplateResponse) Reset() {
+	*x = CreateWorkspaceTemplateResponse{}
+	mi := &file_organization_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveTeamFromProjectRequest) String() string {
+func (x *CreateWorkspaceTemplateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveTeamFromProjectRequest) ProtoMessage() {}
+func (*CreateWorkspaceTemplateResponse) ProtoMessage() {}
 
-func (x *RemoveTeamFromProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[35]
+func (x *CreateWorkspaceTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2433,47 +8450,47 @@ func (x *RemoveTeamFromProjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveTeamFromProjectRequest.ProtoReflect.Descriptor instead.
-func (*RemoveTeamFromProjectRequest) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use CreateWorkspaceTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateWorkspaceTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *RemoveTeamFromProjectRequest) GetProjectId() string {
+func (x *CreateWorkspaceTemplateResponse) GetTemplate() *WorkspaceTemplate {
 	if x != nil {
-		return x.ProjectId
+		return x.Template
 	}
-	return ""
+	return nil
 }
 
-func (x *RemoveTeamFromProjectRequest) GetTeamId() string {
+func (x *CreateWorkspaceTemplateResponse) GetMessage() string {
 	if x != nil {
-		return x.TeamId
+		return x.Message
 	}
 	return ""
 }
 
-type RemoveTeamFromProjectResponse struct {
+type GetWorkspaceTemplateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveTeamFromProjectResponse) Reset() {
-	*x = RemoveTeamFromProjectResponse{}
-	mi := &file_organization_proto_msgTypes[36]
+func (x *GetWorkspaceTemplateRequest) Reset() {
+	*x = GetWorkspaceTemplateRequest{}
+	mi := &file_organization_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveTeamFromProjectResponse) String() string {
+func (x *GetWorkspaceTemplateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveTeamFromProjectResponse) ProtoMessage() {}
+func (*GetWorkspaceTemplateRequest) ProtoMessage() {}
 
-func (x *RemoveTeamFromProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[36]
+func (x *GetWorkspaceTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2484,43 +8501,40 @@ func (x *RemoveTeamFromProjectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveTeamFromProjectResponse.ProtoReflect.Descriptor instead.
-func (*RemoveTeamFromProjectResponse) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use GetWorkspaceTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkspaceTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{129}
 }
 
-func (x *RemoveTeamFromProjectResponse) GetMessage() string {
+func (x *GetWorkspaceTemplateRequest) GetTemplateId() string {
 	if x != nil {
-		return x.Message
+		return x.TemplateId
 	}
 	return ""
 }
 
-type AddProjectMemberRequest struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId            string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	UserId               string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Role                 string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
-	AllocationPercentage int32                  `protobuf:"varint,4,opt,name=allocation_percentage,json=allocationPercentage,proto3" json:"allocation_percentage,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type GetWorkspaceTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *WorkspaceTemplate     `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddProjectMemberRequest) Reset() {
-	*x = AddProjectMemberRequest{}
-	mi := &file_organization_proto_msgTypes[37]
+func (x *GetWorkspaceTemplateResponse) Reset() {
+	*x = GetWorkspaceTemplateResponse{}
+	mi := &file_organization_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddProjectMemberRequest) String() string {
+func (x *GetWorkspaceTemplateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddProjectMemberRequest) ProtoMessage() {}
+func (*GetWorkspaceTemplateResponse) ProtoMessage() {}
 
-func (x *AddProjectMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[37]
+func (x *GetWorkspaceTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2531,62 +8545,85 @@ func (x *AddProjectMemberRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddProjectMemberRequest.ProtoReflect.Descriptor instead.
-func (*AddProjectMemberRequest) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use GetWorkspaceTemplateResponse.ProtoReflect.Descriptor instead.
+func (*GetWorkspaceTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *AddProjectMemberRequest) GetProjectId() string {
+func (x *GetWorkspaceTemplateResponse) GetTemplate() *WorkspaceTemplate {
 	if x != nil {
-		return x.ProjectId
+		return x.Template
 	}
-	return ""
+	return nil
 }
 
-func (x *AddProjectMemberRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
+type ListWorkspaceTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWorkspaceTemplatesRequest) Reset() {
+	*x = ListWorkspaceTemplatesRequest{}
+	mi := &file_organization_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWorkspaceTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AddProjectMemberRequest) GetRole() string {
+func (*ListWorkspaceTemplatesRequest) ProtoMessage() {}
+
+func (x *ListWorkspaceTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[131]
 	if x != nil {
-		return x.Role
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *AddProjectMemberRequest) GetAllocationPercentage() int32 {
+// Deprecated: Use ListWorkspaceTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListWorkspaceTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *ListWorkspaceTemplatesRequest) GetOrgId() string {
 	if x != nil {
-		return x.AllocationPercentage
+		return x.OrgId
 	}
-	return 0
+	return ""
 }
 
-type AddProjectMemberResponse struct {
+type ListWorkspaceTemplatesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Member        *ProjectMember         `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Templates     []*WorkspaceTemplate   `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddProjectMemberResponse) Reset() {
-	*x = AddProjectMemberResponse{}
-	mi := &file_organization_proto_msgTypes[38]
+func (x *ListWorkspaceTemplatesResponse) Reset() {
+	*x = ListWorkspaceTemplatesResponse{}
+	mi := &file_organization_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddProjectMemberResponse) String() string {
+func (x *ListWorkspaceTemplatesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddProjectMemberResponse) ProtoMessage() {}
+func (*ListWorkspaceTemplatesResponse) ProtoMessage() {}
 
-func (x *AddProjectMemberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[38]
+func (x *ListWorkspaceTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2597,48 +8634,47 @@ func (x *AddProjectMemberResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddProjectMemberResponse.ProtoReflect.Descriptor instead.
-func (*AddProjectMemberResponse) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use ListWorkspaceTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListWorkspaceTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{132}
 }
 
-func (x *AddProjectMemberResponse) GetMember() *ProjectMember {
+func (x *ListWorkspaceTemplatesResponse) GetTemplates() []*WorkspaceTemplate {
 	if x != nil {
-		return x.Member
+		return x.Templates
 	}
 	return nil
 }
 
-func (x *AddProjectMemberResponse) GetMessage() string {
+func (x *ListWorkspaceTemplatesResponse) GetTotal() int32 {
 	if x != nil {
-		return x.Message
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
-type RemoveProjectMemberRequest struct {
+type DeleteWorkspaceTemplateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveProjectMemberRequest) Reset() {
-	*x = RemoveProjectMemberRequest{}
-	mi := &file_organization_proto_msgTypes[39]
+func (x *DeleteWorkspaceTemplateRequest) Reset() {
+	*x = DeleteWorkspaceTemplateRequest{}
+	mi := &file_organization_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveProjectMemberRequest) String() string {
+func (x *DeleteWorkspaceTemplateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveProjectMemberRequest) ProtoMessage() {}
+func (*DeleteWorkspaceTemplateRequest) ProtoMessage() {}
 
-func (x *RemoveProjectMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[39]
+func (x *DeleteWorkspaceTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2649,47 +8685,40 @@ func (x *RemoveProjectMemberRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveProjectMemberRequest.ProtoReflect.Descriptor instead.
-func (*RemoveProjectMemberRequest) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{39}
-}
-
-func (x *RemoveProjectMemberRequest) GetProjectId() string {
-	if x != nil {
-		return x.ProjectId
-	}
-	return ""
+// Deprecated: Use DeleteWorkspaceTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWorkspaceTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{133}
 }
 
-func (x *RemoveProjectMemberRequest) GetUserId() string {
+func (x *DeleteWorkspaceTemplateRequest) GetTemplateId() string {
 	if x != nil {
-		return x.UserId
+		return x.TemplateId
 	}
 	return ""
 }
 
-type RemoveProjectMemberResponse struct {
+type DeleteWorkspaceTemplateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveProjectMemberResponse) Reset() {
-	*x = RemoveProjectMemberResponse{}
-	mi := &file_organization_proto_msgTypes[40]
+func (x *DeleteWorkspaceTemplateResponse) Reset() {
+	*x = DeleteWorkspaceTemplateResponse{}
+	mi := &file_organization_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveProjectMemberResponse) String() string {
+func (x *DeleteWorkspaceTemplateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveProjectMemberResponse) ProtoMessage() {}
+func (*DeleteWorkspaceTemplateResponse) ProtoMessage() {}
 
-func (x *RemoveProjectMemberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[40]
+func (x *DeleteWorkspaceTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2700,54 +8729,44 @@ func (x *RemoveProjectMemberResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveProjectMemberResponse.ProtoReflect.Descriptor instead.
-func (*RemoveProjectMemberResponse) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use DeleteWorkspaceTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWorkspaceTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{134}
 }
 
-func (x *RemoveProjectMemberResponse) GetMessage() string {
+func (x *DeleteWorkspaceTemplateResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type Group struct {
-	state       protoimpl.MessageState `protogen:"open.v1"`
-	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	OrgId       string                 `protobuf:"bytes,2,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
-	Name        string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	GroupType   string                 `protobuf:"bytes,5,opt,name=group_type,json=groupType,proto3" json:"group_type,omitempty"` // functional, temporary, committee, community
-	OwnerId     string                 `protobuf:"bytes,6,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
-	Status      string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
-	Metadata    string                 `protobuf:"bytes,8,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	CreatedBy   string                 `protobuf:"bytes,11,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	// Populated fields
-	Owner         *GroupOwner    `protobuf:"bytes,12,opt,name=owner,proto3" json:"owner,omitempty"`
-	Members       []*GroupMember `protobuf:"bytes,13,rep,name=members,proto3" json:"members,omitempty"`
-	MemberCount   int32          `protobuf:"varint,14,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
+type CreateWorkspaceFromTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"` // defaults to the template's
+	TeamId        string                 `protobuf:"bytes,4,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,5,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Group) Reset() {
-	*x = Group{}
-	mi := &file_organization_proto_msgTypes[41]
+func (x *CreateWorkspaceFromTemplateRequest) Reset() {
+	*x = CreateWorkspaceFromTemplateRequest{}
+	mi := &file_organization_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Group) String() string {
+func (x *CreateWorkspaceFromTemplateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Group) ProtoMessage() {}
+func (*CreateWorkspaceFromTemplateRequest) ProtoMessage() {}
 
-func (x *Group) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[41]
+func (x *CreateWorkspaceFromTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2758,134 +8777,138 @@ func (x *Group) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Group.ProtoReflect.Descriptor instead.
-func (*Group) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{41}
-}
-
-func (x *Group) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use CreateWorkspaceFromTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateWorkspaceFromTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{135}
 }
 
-func (x *Group) GetOrgId() string {
+func (x *CreateWorkspaceFromTemplateRequest) GetTemplateId() string {
 	if x != nil {
-		return x.OrgId
+		return x.TemplateId
 	}
 	return ""
 }
 
-func (x *Group) GetName() string {
+func (x *CreateWorkspaceFromTemplateRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *Group) GetDescription() string {
+func (x *CreateWorkspaceFromTemplateRequest) GetDescription() string {
 	if x != nil {
 		return x.Description
 	}
 	return ""
 }
 
-func (x *Group) GetGroupType() string {
+func (x *CreateWorkspaceFromTemplateRequest) GetTeamId() string {
 	if x != nil {
-		return x.GroupType
+		return x.TeamId
 	}
 	return ""
 }
 
-func (x *Group) GetOwnerId() string {
+func (x *CreateWorkspaceFromTemplateRequest) GetProjectId() string {
 	if x != nil {
-		return x.OwnerId
+		return x.ProjectId
 	}
 	return ""
 }
 
-func (x *Group) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+type CreateWorkspaceFromTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Workspace     *Workspace             `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	Groups        []*Group               `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Group) GetMetadata() string {
-	if x != nil {
-		return x.Metadata
-	}
-	return ""
+func (x *CreateWorkspaceFromTemplateResponse) Reset() {
+	*x = CreateWorkspaceFromTemplateResponse{}
+	mi := &file_organization_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Group) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
+func (x *CreateWorkspaceFromTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Group) GetUpdatedAt() *timestamppb.Timestamp {
+func (*CreateWorkspaceFromTemplateResponse) ProtoMessage() {}
+
+func (x *CreateWorkspaceFromTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[136]
 	if x != nil {
-		return x.UpdatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Group) GetCreatedBy() string {
-	if x != nil {
-		return x.CreatedBy
-	}
-	return ""
+// Deprecated: Use CreateWorkspaceFromTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateWorkspaceFromTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{136}
 }
 
-func (x *Group) GetOwner() *GroupOwner {
+func (x *CreateWorkspaceFromTemplateResponse) GetWorkspace() *Workspace {
 	if x != nil {
-		return x.Owner
+		return x.Workspace
 	}
 	return nil
 }
 
-func (x *Group) GetMembers() []*GroupMember {
+func (x *CreateWorkspaceFromTemplateResponse) GetGroups() []*Group {
 	if x != nil {
-		return x.Members
+		return x.Groups
 	}
 	return nil
 }
 
-func (x *Group) GetMemberCount() int32 {
+func (x *CreateWorkspaceFromTemplateResponse) GetMessage() string {
 	if x != nil {
-		return x.MemberCount
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-type GroupOwner struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	FullName      string                 `protobuf:"bytes,2,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
-	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	Username      string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// A role an organization defines from the built-in permission set, such as
+// "Project Lead" or "Read-only auditor"
+type CustomRole struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrgId           string                 `protobuf:"bytes,2,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Name            string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Permissions     []string               `protobuf:"bytes,5,rep,name=permissions,proto3" json:"permissions,omitempty"` // e.g. "project.create"
+	CreatedBy       string                 `protobuf:"bytes,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	AssignmentCount int32                  `protobuf:"varint,9,opt,name=assignment_count,json=assignmentCount,proto3" json:"assignment_count,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GroupOwner) Reset() {
-	*x = GroupOwner{}
-	mi := &file_organization_proto_msgTypes[42]
+func (x *CustomRole) Reset() {
+	*x = CustomRole{}
+	mi := &file_organization_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GroupOwner) String() string {
+func (x *CustomRole) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupOwner) ProtoMessage() {}
+func (*CustomRole) ProtoMessage() {}
 
-func (x *GroupOwner) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[42]
+func (x *CustomRole) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2896,71 +8919,109 @@ func (x *GroupOwner) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupOwner.ProtoReflect.Descriptor instead.
-func (*GroupOwner) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use CustomRole.ProtoReflect.Descriptor instead.
+func (*CustomRole) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{137}
 }
 
-func (x *GroupOwner) GetId() string {
+func (x *CustomRole) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *GroupOwner) GetFullName() string {
+func (x *CustomRole) GetOrgId() string {
 	if x != nil {
-		return x.FullName
+		return x.OrgId
 	}
 	return ""
 }
 
-func (x *GroupOwner) GetEmail() string {
+func (x *CustomRole) GetName() string {
 	if x != nil {
-		return x.Email
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GroupOwner) GetUsername() string {
+func (x *CustomRole) GetDescription() string {
 	if x != nil {
-		return x.Username
+		return x.Description
 	}
 	return ""
 }
 
-type GroupMember struct {
-	state    protoimpl.MessageState `protogen:"open.v1"`
-	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	GroupId  string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	UserId   string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Role     string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
-	JoinedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
-	IsActive bool                   `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+func (x *CustomRole) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *CustomRole) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *CustomRole) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CustomRole) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *CustomRole) GetAssignmentCount() int32 {
+	if x != nil {
+		return x.AssignmentCount
+	}
+	return 0
+}
+
+// A custom role held by a user across the organization, or only on one team
+// or project
+type CustomRoleAssignment struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RoleId     string                 `protobuf:"bytes,2,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	RoleName   string                 `protobuf:"bytes,3,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	OrgId      string                 `protobuf:"bytes,4,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	UserId     string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ScopeType  string                 `protobuf:"bytes,6,opt,name=scope_type,json=scopeType,proto3" json:"scope_type,omitempty"` // org, team, project
+	ScopeId    string                 `protobuf:"bytes,7,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`       // team or project id; empty for org
+	AssignedBy string                 `protobuf:"bytes,8,opt,name=assigned_by,json=assignedBy,proto3" json:"assigned_by,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	// User details
-	FullName      string `protobuf:"bytes,7,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
-	Email         string `protobuf:"bytes,8,opt,name=email,proto3" json:"email,omitempty"`
-	Username      string `protobuf:"bytes,9,opt,name=username,proto3" json:"username,omitempty"`
-	TeamName      string `protobuf:"bytes,10,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"` // If member belongs to a team
+	FullName      string `protobuf:"bytes,10,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	Email         string `protobuf:"bytes,11,opt,name=email,proto3" json:"email,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GroupMember) Reset() {
-	*x = GroupMember{}
-	mi := &file_organization_proto_msgTypes[43]
+func (x *CustomRoleAssignment) Reset() {
+	*x = CustomRoleAssignment{}
+	mi := &file_organization_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GroupMember) String() string {
+func (x *CustomRoleAssignment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupMember) ProtoMessage() {}
+func (*CustomRoleAssignment) ProtoMessage() {}
 
-func (x *GroupMember) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[43]
+func (x *CustomRoleAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2971,107 +9032,113 @@ func (x *GroupMember) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupMember.ProtoReflect.Descriptor instead.
-func (*GroupMember) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use CustomRoleAssignment.ProtoReflect.Descriptor instead.
+func (*CustomRoleAssignment) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{138}
 }
 
-func (x *GroupMember) GetId() string {
+func (x *CustomRoleAssignment) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *GroupMember) GetGroupId() string {
+func (x *CustomRoleAssignment) GetRoleId() string {
 	if x != nil {
-		return x.GroupId
+		return x.RoleId
+	}
+	return ""
+}
+
+func (x *CustomRoleAssignment) GetRoleName() string {
+	if x != nil {
+		return x.RoleName
 	}
 	return ""
 }
 
-func (x *GroupMember) GetUserId() string {
+func (x *CustomRoleAssignment) GetOrgId() string {
 	if x != nil {
-		return x.UserId
+		return x.OrgId
 	}
 	return ""
 }
 
-func (x *GroupMember) GetRole() string {
+func (x *CustomRoleAssignment) GetUserId() string {
 	if x != nil {
-		return x.Role
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *GroupMember) GetJoinedAt() *timestamppb.Timestamp {
+func (x *CustomRoleAssignment) GetScopeType() string {
 	if x != nil {
-		return x.JoinedAt
+		return x.ScopeType
 	}
-	return nil
+	return ""
 }
 
-func (x *GroupMember) GetIsActive() bool {
+func (x *CustomRoleAssignment) GetScopeId() string {
 	if x != nil {
-		return x.IsActive
+		return x.ScopeId
 	}
-	return false
+	return ""
 }
 
-func (x *GroupMember) GetFullName() string {
+func (x *CustomRoleAssignment) GetAssignedBy() string {
 	if x != nil {
-		return x.FullName
+		return x.AssignedBy
 	}
 	return ""
 }
 
-func (x *GroupMember) GetEmail() string {
+func (x *CustomRoleAssignment) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Email
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *GroupMember) GetUsername() string {
+func (x *CustomRoleAssignment) GetFullName() string {
 	if x != nil {
-		return x.Username
+		return x.FullName
 	}
 	return ""
 }
 
-func (x *GroupMember) GetTeamName() string {
+func (x *CustomRoleAssignment) GetEmail() string {
 	if x != nil {
-		return x.TeamName
+		return x.Email
 	}
 	return ""
 }
 
-type CreateGroupRequest struct {
+type CreateCustomRoleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	GroupType     string                 `protobuf:"bytes,4,opt,name=group_type,json=groupType,proto3" json:"group_type,omitempty"`
-	OwnerId       string                 `protobuf:"bytes,5,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Permissions   []string               `protobuf:"bytes,4,rep,name=permissions,proto3" json:"permissions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateGroupRequest) Reset() {
-	*x = CreateGroupRequest{}
-	mi := &file_organization_proto_msgTypes[44]
+func (x *CreateCustomRoleRequest) Reset() {
+	*x = CreateCustomRoleRequest{}
+	mi := &file_organization_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGroupRequest) String() string {
+func (x *CreateCustomRoleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGroupRequest) ProtoMessage() {}
+func (*CreateCustomRoleRequest) ProtoMessage() {}
 
-func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[44]
+func (x *CreateCustomRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3082,69 +9149,62 @@ func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGroupRequest.ProtoReflect.Descriptor instead.
-func (*CreateGroupRequest) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use CreateCustomRoleRequest.ProtoReflect.Descriptor instead.
+func (*CreateCustomRoleRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{139}
 }
 
-func (x *CreateGroupRequest) GetOrgId() string {
+func (x *CreateCustomRoleRequest) GetOrgId() string {
 	if x != nil {
 		return x.OrgId
 	}
 	return ""
 }
 
-func (x *CreateGroupRequest) GetName() string {
+func (x *CreateCustomRoleRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateGroupRequest) GetDescription() string {
+func (x *CreateCustomRoleRequest) GetDescription() string {
 	if x != nil {
 		return x.Description
 	}
 	return ""
 }
 
-func (x *CreateGroupRequest) GetGroupType() string {
-	if x != nil {
-		return x.GroupType
-	}
-	return ""
-}
-
-func (x *CreateGroupRequest) GetOwnerId() string {
+func (x *CreateCustomRoleRequest) GetPermissions() []string {
 	if x != nil {
-		return x.OwnerId
+		return x.Permissions
 	}
-	return ""
+	return nil
 }
 
-type CreateGroupResponse struct {
+type CreateCustomRoleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Group         *Group                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Role          *CustomRole            `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateGroupResponse) Reset() {
-	*x = CreateGroupResponse{}
-	mi := &file_organization_proto_msgTypes[45]
+func (x *CreateCustomRoleResponse) Reset() {
+	*x = CreateCustomRoleResponse{}
+	mi := &file_organization_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGroupResponse) String() string {
+func (x *CreateCustomRoleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGroupResponse) ProtoMessage() {}
+func (*CreateCustomRoleResponse) ProtoMessage() {}
 
-func (x *CreateGroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[45]
+func (x *CreateCustomRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3155,47 +9215,47 @@ func (x *CreateGroupResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGroupResponse.ProtoReflect.Descriptor instead.
-func (*CreateGroupResponse) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use CreateCustomRoleResponse.ProtoReflect.Descriptor instead.
+func (*CreateCustomRoleResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{140}
 }
 
-func (x *CreateGroupResponse) GetGroup() *Group {
+func (x *CreateCustomRoleResponse) GetRole() *CustomRole {
 	if x != nil {
-		return x.Group
+		return x.Role
 	}
 	return nil
 }
 
-func (x *CreateGroupResponse) GetMessage() string {
+func (x *CreateCustomRoleResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type GetGroupRequest struct {
+type ListCustomRolesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupRequest) Reset() {
-	*x = GetGroupRequest{}
-	mi := &file_organization_proto_msgTypes[46]
+func (x *ListCustomRolesRequest) Reset() {
+	*x = ListCustomRolesRequest{}
+	mi := &file_organization_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupRequest) String() string {
+func (x *ListCustomRolesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupRequest) ProtoMessage() {}
+func (*ListCustomRolesRequest) ProtoMessage() {}
 
-func (x *GetGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[46]
+func (x *ListCustomRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3206,40 +9266,42 @@ func (x *GetGroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupRequest.ProtoReflect.Descriptor instead.
-func (*GetGroupRequest) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use ListCustomRolesRequest.ProtoReflect.Descriptor instead.
+func (*ListCustomRolesRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{141}
 }
 
-func (x *GetGroupRequest) GetGroupId() string {
+func (x *ListCustomRolesRequest) GetOrgId() string {
 	if x != nil {
-		return x.GroupId
+		return x.OrgId
 	}
 	return ""
 }
 
-type GetGroupResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Group         *Group                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ListCustomRolesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Roles []*CustomRole          `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	// Permissions custom roles may grant
+	AvailablePermissions []string `protobuf:"bytes,2,rep,name=available_permissions,json=availablePermissions,proto3" json:"available_permissions,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *GetGroupResponse) Reset() {
-	*x = GetGroupResponse{}
-	mi := &file_organization_proto_msgTypes[47]
+func (x *ListCustomRolesResponse) Reset() {
+	*x = ListCustomRolesResponse{}
+	mi := &file_organization_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupResponse) String() string {
+func (x *ListCustomRolesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupResponse) ProtoMessage() {}
+func (*ListCustomRolesResponse) ProtoMessage() {}
 
-func (x *GetGroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[47]
+func (x *ListCustomRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3250,43 +9312,51 @@ func (x *GetGroupResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupResponse.ProtoReflect.Descriptor instead.
-func (*GetGroupResponse) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use ListCustomRolesResponse.ProtoReflect.Descriptor instead.
+func (*ListCustomRolesResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{142}
 }
 
-func (x *GetGroupResponse) GetGroup() *Group {
+func (x *ListCustomRolesResponse) GetRoles() []*CustomRole {
 	if x != nil {
-		return x.Group
+		return x.Roles
 	}
 	return nil
 }
 
-type ListGroupsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
-	GroupType     string                 `protobuf:"bytes,2,opt,name=group_type,json=groupType,proto3" json:"group_type,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+func (x *ListCustomRolesResponse) GetAvailablePermissions() []string {
+	if x != nil {
+		return x.AvailablePermissions
+	}
+	return nil
+}
+
+type UpdateCustomRoleRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	RoleId      string                 `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Replaces the role's permissions when not empty
+	Permissions   []string `protobuf:"bytes,4,rep,name=permissions,proto3" json:"permissions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListGroupsRequest) Reset() {
-	*x = ListGroupsRequest{}
-	mi := &file_organization_proto_msgTypes[48]
+func (x *UpdateCustomRoleRequest) Reset() {
+	*x = UpdateCustomRoleRequest{}
+	mi := &file_organization_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListGroupsRequest) String() string {
+func (x *UpdateCustomRoleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListGroupsRequest) ProtoMessage() {}
+func (*UpdateCustomRoleRequest) ProtoMessage() {}
 
-func (x *ListGroupsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[48]
+func (x *UpdateCustomRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3297,62 +9367,62 @@ func (x *ListGroupsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListGroupsRequest.ProtoReflect.Descriptor instead.
-func (*ListGroupsRequest) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use UpdateCustomRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCustomRoleRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{143}
 }
 
-func (x *ListGroupsRequest) GetOrgId() string {
+func (x *UpdateCustomRoleRequest) GetRoleId() string {
 	if x != nil {
-		return x.OrgId
+		return x.RoleId
 	}
 	return ""
 }
 
-func (x *ListGroupsRequest) GetGroupType() string {
+func (x *UpdateCustomRoleRequest) GetName() string {
 	if x != nil {
-		return x.GroupType
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ListGroupsRequest) GetPage() int32 {
+func (x *UpdateCustomRoleRequest) GetDescription() string {
 	if x != nil {
-		return x.Page
+		return x.Description
 	}
-	return 0
+	return ""
 }
 
-func (x *ListGroupsRequest) GetPageSize() int32 {
+func (x *UpdateCustomRoleRequest) GetPermissions() []string {
 	if x != nil {
-		return x.PageSize
+		return x.Permissions
 	}
-	return 0
+	return nil
 }
 
-type ListGroupsResponse struct {
+type UpdateCustomRoleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Groups        []*Group               `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Role          *CustomRole            `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListGroupsResponse) Reset() {
-	*x = ListGroupsResponse{}
-	mi := &file_organization_proto_msgTypes[49]
+func (x *UpdateCustomRoleResponse) Reset() {
+	*x = UpdateCustomRoleResponse{}
+	mi := &file_organization_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListGroupsResponse) String() string {
+func (x *UpdateCustomRoleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListGroupsResponse) ProtoMessage() {}
+func (*UpdateCustomRoleResponse) ProtoMessage() {}
 
-func (x *ListGroupsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[49]
+func (x *UpdateCustomRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3363,50 +9433,47 @@ func (x *ListGroupsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListGroupsResponse.ProtoReflect.Descriptor instead.
-func (*ListGroupsResponse) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use UpdateCustomRoleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCustomRoleResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{144}
 }
 
-func (x *ListGroupsResponse) GetGroups() []*Group {
+func (x *UpdateCustomRoleResponse) GetRole() *CustomRole {
 	if x != nil {
-		return x.Groups
+		return x.Role
 	}
 	return nil
 }
 
-func (x *ListGroupsResponse) GetTotal() int32 {
+func (x *UpdateCustomRoleResponse) GetMessage() string {
 	if x != nil {
-		return x.Total
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-type UpdateGroupRequest struct {
+type DeleteCustomRoleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	RoleId        string                 `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateGroupRequest) Reset() {
-	*x = UpdateGroupRequest{}
-	mi := &file_organization_proto_msgTypes[50]
+func (x *DeleteCustomRoleRequest) Reset() {
+	*x = DeleteCustomRoleRequest{}
+	mi := &file_organization_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateGroupRequest) String() string {
+func (x *DeleteCustomRoleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateGroupRequest) ProtoMessage() {}
+func (*DeleteCustomRoleRequest) ProtoMessage() {}
 
-func (x *UpdateGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[50]
+func (x *DeleteCustomRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3417,62 +9484,153 @@ func (x *UpdateGroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateGroupRequest.ProtoReflect.Descriptor instead.
-func (*UpdateGroupRequest) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use DeleteCustomRoleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCustomRoleRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{145}
 }
 
-func (x *UpdateGroupRequest) GetGroupId() string {
+func (x *DeleteCustomRoleRequest) GetRoleId() string {
 	if x != nil {
-		return x.GroupId
+		return x.RoleId
 	}
 	return ""
 }
 
-func (x *UpdateGroupRequest) GetName() string {
+type DeleteCustomRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCustomRoleResponse) Reset() {
+	*x = DeleteCustomRoleResponse{}
+	mi := &file_organization_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCustomRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCustomRoleResponse) ProtoMessage() {}
+
+func (x *DeleteCustomRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[146]
 	if x != nil {
-		return x.Name
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCustomRoleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCustomRoleResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *DeleteCustomRoleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
 	return ""
 }
 
-func (x *UpdateGroupRequest) GetDescription() string {
+type AssignCustomRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoleId        string                 `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ScopeType     string                 `protobuf:"bytes,3,opt,name=scope_type,json=scopeType,proto3" json:"scope_type,omitempty"` // org (default), team, project
+	ScopeId       string                 `protobuf:"bytes,4,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignCustomRoleRequest) Reset() {
+	*x = AssignCustomRoleRequest{}
+	mi := &file_organization_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignCustomRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignCustomRoleRequest) ProtoMessage() {}
+
+func (x *AssignCustomRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[147]
 	if x != nil {
-		return x.Description
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignCustomRoleRequest.ProtoReflect.Descriptor instead.
+func (*AssignCustomRoleRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *AssignCustomRoleRequest) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
 	}
 	return ""
 }
 
-func (x *UpdateGroupRequest) GetStatus() string {
+func (x *AssignCustomRoleRequest) GetUserId() string {
 	if x != nil {
-		return x.Status
+		return x.UserId
 	}
 	return ""
 }
 
-type UpdateGroupResponse struct {
+func (x *AssignCustomRoleRequest) GetScopeType() string {
+	if x != nil {
+		return x.ScopeType
+	}
+	return ""
+}
+
+func (x *AssignCustomRoleRequest) GetScopeId() string {
+	if x != nil {
+		return x.ScopeId
+	}
+	return ""
+}
+
+type AssignCustomRoleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Group         *Group                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Assignment    *CustomRoleAssignment  `protobuf:"bytes,1,opt,name=assignment,proto3" json:"assignment,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateGroupResponse) Reset() {
-	*x = UpdateGroupResponse{}
-	mi := &file_organization_proto_msgTypes[51]
+func (x *AssignCustomRoleResponse) Reset() {
+	*x = AssignCustomRoleResponse{}
+	mi := &file_organization_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateGroupResponse) String() string {
+func (x *AssignCustomRoleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateGroupResponse) ProtoMessage() {}
+func (*AssignCustomRoleResponse) ProtoMessage() {}
 
-func (x *UpdateGroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[51]
+func (x *AssignCustomRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3483,47 +9641,47 @@ func (x *UpdateGroupResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateGroupResponse.ProtoReflect.Descriptor instead.
-func (*UpdateGroupResponse) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use AssignCustomRoleResponse.ProtoReflect.Descriptor instead.
+func (*AssignCustomRoleResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{148}
 }
 
-func (x *UpdateGroupResponse) GetGroup() *Group {
+func (x *AssignCustomRoleResponse) GetAssignment() *CustomRoleAssignment {
 	if x != nil {
-		return x.Group
+		return x.Assignment
 	}
 	return nil
 }
 
-func (x *UpdateGroupResponse) GetMessage() string {
+func (x *AssignCustomRoleResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type DeleteGroupRequest struct {
+type UnassignCustomRoleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	AssignmentId  string                 `protobuf:"bytes,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteGroupRequest) Reset() {
-	*x = DeleteGroupRequest{}
-	mi := &file_organization_proto_msgTypes[52]
+func (x *UnassignCustomRoleRequest) Reset() {
+	*x = UnassignCustomRoleRequest{}
+	mi := &file_organization_proto_msgTypes[149]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteGroupRequest) String() string {
+func (x *UnassignCustomRoleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGroupRequest) ProtoMessage() {}
+func (*UnassignCustomRoleRequest) ProtoMessage() {}
 
-func (x *DeleteGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[52]
+func (x *UnassignCustomRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[149]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3534,40 +9692,40 @@ func (x *DeleteGroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGroupRequest.ProtoReflect.Descriptor instead.
-func (*DeleteGroupRequest) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use UnassignCustomRoleRequest.ProtoReflect.Descriptor instead.
+func (*UnassignCustomRoleRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{149}
 }
 
-func (x *DeleteGroupRequest) GetGroupId() string {
+func (x *UnassignCustomRoleRequest) GetAssignmentId() string {
 	if x != nil {
-		return x.GroupId
+		return x.AssignmentId
 	}
 	return ""
 }
 
-type DeleteGroupResponse struct {
+type UnassignCustomRoleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteGroupResponse) Reset() {
-	*x = DeleteGroupResponse{}
-	mi := &file_organization_proto_msgTypes[53]
+func (x *UnassignCustomRoleResponse) Reset() {
+	*x = UnassignCustomRoleResponse{}
+	mi := &file_organization_proto_msgTypes[150]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteGroupResponse) String() string {
+func (x *UnassignCustomRoleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGroupResponse) ProtoMessage() {}
+func (*UnassignCustomRoleResponse) ProtoMessage() {}
 
-func (x *DeleteGroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[53]
+func (x *UnassignCustomRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[150]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3578,42 +9736,45 @@ func (x *DeleteGroupResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGroupResponse.ProtoReflect.Descriptor instead.
-func (*DeleteGroupResponse) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use UnassignCustomRoleResponse.ProtoReflect.Descriptor instead.
+func (*UnassignCustomRoleResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{150}
 }
 
-func (x *DeleteGroupResponse) GetMessage() string {
+func (x *UnassignCustomRoleResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type AddGroupMemberRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+type ListCustomRoleAssignmentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	OrgId string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	// Optional filters
+	RoleId        string `protobuf:"bytes,2,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	UserId        string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ScopeType     string `protobuf:"bytes,4,opt,name=scope_type,json=scopeType,proto3" json:"scope_type,omitempty"`
+	ScopeId       string `protobuf:"bytes,5,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddGroupMemberRequest) Reset() {
-	*x = AddGroupMemberRequest{}
-	mi := &file_organization_proto_msgTypes[54]
+func (x *ListCustomRoleAssignmentsRequest) Reset() {
+	*x = ListCustomRoleAssignmentsRequest{}
+	mi := &file_organization_proto_msgTypes[151]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddGroupMemberRequest) String() string {
+func (x *ListCustomRoleAssignmentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddGroupMemberRequest) ProtoMessage() {}
+func (*ListCustomRoleAssignmentsRequest) ProtoMessage() {}
 
-func (x *AddGroupMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[54]
+func (x *ListCustomRoleAssignmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[151]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3624,55 +9785,68 @@ func (x *AddGroupMemberRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddGroupMemberRequest.ProtoReflect.Descriptor instead.
-func (*AddGroupMemberRequest) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use ListCustomRoleAssignmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListCustomRoleAssignmentsRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{151}
 }
 
-func (x *AddGroupMemberRequest) GetGroupId() string {
+func (x *ListCustomRoleAssignmentsRequest) GetOrgId() string {
 	if x != nil {
-		return x.GroupId
+		return x.OrgId
 	}
 	return ""
 }
 
-func (x *AddGroupMemberRequest) GetUserId() string {
+func (x *ListCustomRoleAssignmentsRequest) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+func (x *ListCustomRoleAssignmentsRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *AddGroupMemberRequest) GetRole() string {
+func (x *ListCustomRoleAssignmentsRequest) GetScopeType() string {
 	if x != nil {
-		return x.Role
+		return x.ScopeType
 	}
 	return ""
 }
 
-type AddGroupMemberResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Member        *GroupMember           `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+func (x *ListCustomRoleAssignmentsRequest) GetScopeId() string {
+	if x != nil {
+		return x.ScopeId
+	}
+	return ""
+}
+
+type ListCustomRoleAssignmentsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Assignments   []*CustomRoleAssignment `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddGroupMemberResponse) Reset() {
-	*x = AddGroupMemberResponse{}
-	mi := &file_organization_proto_msgTypes[55]
+func (x *ListCustomRoleAssignmentsResponse) Reset() {
+	*x = ListCustomRoleAssignmentsResponse{}
+	mi := &file_organization_proto_msgTypes[152]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddGroupMemberResponse) String() string {
+func (x *ListCustomRoleAssignmentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddGroupMemberResponse) ProtoMessage() {}
+func (*ListCustomRoleAssignmentsResponse) ProtoMessage() {}
 
-func (x *AddGroupMemberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[55]
+func (x *ListCustomRoleAssignmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[152]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3683,48 +9857,47 @@ func (x *AddGroupMemberResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddGroupMemberResponse.ProtoReflect.Descriptor instead.
-func (*AddGroupMemberResponse) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use ListCustomRoleAssignmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListCustomRoleAssignmentsResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{152}
 }
 
-func (x *AddGroupMemberResponse) GetMember() *GroupMember {
+func (x *ListCustomRoleAssignmentsResponse) GetAssignments() []*CustomRoleAssignment {
 	if x != nil {
-		return x.Member
+		return x.Assignments
 	}
 	return nil
 }
 
-func (x *AddGroupMemberResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-type RemoveGroupMemberRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// One project a user is allocated to during the report's date range
+type ProjectAllocation struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId            string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	ProjectName          string                 `protobuf:"bytes,2,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	ProjectStatus        string                 `protobuf:"bytes,3,opt,name=project_status,json=projectStatus,proto3" json:"project_status,omitempty"`
+	Role                 string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	AllocationPercentage int32                  `protobuf:"varint,5,opt,name=allocation_percentage,json=allocationPercentage,proto3" json:"allocation_percentage,omitempty"`
+	StartDate            string                 `protobuf:"bytes,6,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              string                 `protobuf:"bytes,7,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *RemoveGroupMemberRequest) Reset() {
-	*x = RemoveGroupMemberRequest{}
-	mi := &file_organization_proto_msgTypes[56]
+func (x *ProjectAllocation) Reset() {
+	*x = ProjectAllocation{}
+	mi := &file_organization_proto_msgTypes[153]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveGroupMemberRequest) String() string {
+func (x *ProjectAllocation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveGroupMemberRequest) ProtoMessage() {}
+func (*ProjectAllocation) ProtoMessage() {}
 
-func (x *RemoveGroupMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[56]
+func (x *ProjectAllocation) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[153]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3735,96 +9908,88 @@ func (x *RemoveGroupMemberRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveGroupMemberRequest.ProtoReflect.Descriptor instead.
-func (*RemoveGroupMemberRequest) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use ProjectAllocation.ProtoReflect.Descriptor instead.
+func (*ProjectAllocation) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{153}
 }
 
-func (x *RemoveGroupMemberRequest) GetGroupId() string {
+func (x *ProjectAllocation) GetProjectId() string {
 	if x != nil {
-		return x.GroupId
+		return x.ProjectId
 	}
 	return ""
 }
 
-func (x *RemoveGroupMemberRequest) GetUserId() string {
+func (x *ProjectAllocation) GetProjectName() string {
 	if x != nil {
-		return x.UserId
+		return x.ProjectName
 	}
 	return ""
 }
 
-type RemoveGroupMemberResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *RemoveGroupMemberResponse) Reset() {
-	*x = RemoveGroupMemberResponse{}
-	mi := &file_organization_proto_msgTypes[57]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ProjectAllocation) GetProjectStatus() string {
+	if x != nil {
+		return x.ProjectStatus
+	}
+	return ""
 }
 
-func (x *RemoveGroupMemberResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ProjectAllocation) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
 }
 
-func (*RemoveGroupMemberResponse) ProtoMessage() {}
-
-func (x *RemoveGroupMemberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[57]
+func (x *ProjectAllocation) GetAllocationPercentage() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.AllocationPercentage
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use RemoveGroupMemberResponse.ProtoReflect.Descriptor instead.
-func (*RemoveGroupMemberResponse) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{57}
+func (x *ProjectAllocation) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
 }
 
-func (x *RemoveGroupMemberResponse) GetMessage() string {
+func (x *ProjectAllocation) GetEndDate() string {
 	if x != nil {
-		return x.Message
+		return x.EndDate
 	}
 	return ""
 }
 
-type OrgMember struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	FullName      string                 `protobuf:"bytes,2,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
-	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	Username      string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
-	Role          string                 `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// A user's total allocation across the projects in the report
+type UserCapacity struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FullName        string                 `protobuf:"bytes,2,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	Email           string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	TotalAllocation int32                  `protobuf:"varint,4,opt,name=total_allocation,json=totalAllocation,proto3" json:"total_allocation,omitempty"` // sum of allocation_percentage
+	OverAllocated   bool                   `protobuf:"varint,5,opt,name=over_allocated,json=overAllocated,proto3" json:"over_allocated,omitempty"`       // total_allocation above the threshold
+	Projects        []*ProjectAllocation   `protobuf:"bytes,6,rep,name=projects,proto3" json:"projects,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *OrgMember) Reset() {
-	*x = OrgMember{}
-	mi := &file_organization_proto_msgTypes[58]
+func (x *UserCapacity) Reset() {
+	*x = UserCapacity{}
+	mi := &file_organization_proto_msgTypes[154]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrgMember) String() string {
+func (x *UserCapacity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrgMember) ProtoMessage() {}
+func (*UserCapacity) ProtoMessage() {}
 
-func (x *OrgMember) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[58]
+func (x *UserCapacity) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[154]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3835,75 +10000,83 @@ func (x *OrgMember) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrgMember.ProtoReflect.Descriptor instead.
-func (*OrgMember) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use UserCapacity.ProtoReflect.Descriptor instead.
+func (*UserCapacity) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{154}
 }
 
-func (x *OrgMember) GetId() string {
+func (x *UserCapacity) GetUserId() string {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *OrgMember) GetFullName() string {
+func (x *UserCapacity) GetFullName() string {
 	if x != nil {
 		return x.FullName
 	}
 	return ""
 }
 
-func (x *OrgMember) GetEmail() string {
+func (x *UserCapacity) GetEmail() string {
 	if x != nil {
 		return x.Email
 	}
 	return ""
 }
 
-func (x *OrgMember) GetUsername() string {
+func (x *UserCapacity) GetTotalAllocation() int32 {
 	if x != nil {
-		return x.Username
+		return x.TotalAllocation
 	}
-	return ""
+	return 0
 }
 
-func (x *OrgMember) GetRole() string {
+func (x *UserCapacity) GetOverAllocated() bool {
 	if x != nil {
-		return x.Role
+		return x.OverAllocated
 	}
-	return ""
+	return false
 }
 
-func (x *OrgMember) GetCreatedAt() *timestamppb.Timestamp {
+func (x *UserCapacity) GetProjects() []*ProjectAllocation {
 	if x != nil {
-		return x.CreatedAt
+		return x.Projects
 	}
 	return nil
 }
 
-type ListOrgMembersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListOrgMembersRequest) Reset() {
-	*x = ListOrgMembersRequest{}
-	mi := &file_organization_proto_msgTypes[59]
+type GetCapacityReportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	OrgId string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	// Optional: only report on active members of this team
+	TeamId string `protobuf:"bytes,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	// YYYY-MM-DD; projects overlapping the range count. Both default to today.
+	StartDate string `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate   string `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// Allocation above which a user is flagged; defaults to 100
+	Threshold         int32 `protobuf:"varint,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	OverAllocatedOnly bool  `protobuf:"varint,6,opt,name=over_allocated_only,json=overAllocatedOnly,proto3" json:"over_allocated_only,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetCapacityReportRequest) Reset() {
+	*x = GetCapacityReportRequest{}
+	mi := &file_organization_proto_msgTypes[155]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOrgMembersRequest) String() string {
+func (x *GetCapacityReportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOrgMembersRequest) ProtoMessage() {}
+func (*GetCapacityReportRequest) ProtoMessage() {}
 
-func (x *ListOrgMembersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[59]
+func (x *GetCapacityReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[155]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3914,103 +10087,81 @@ func (x *ListOrgMembersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOrgMembersRequest.ProtoReflect.Descriptor instead.
-func (*ListOrgMembersRequest) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use GetCapacityReportRequest.ProtoReflect.Descriptor instead.
+func (*GetCapacityReportRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{155}
 }
 
-func (x *ListOrgMembersRequest) GetOrgId() string {
+func (x *GetCapacityReportRequest) GetOrgId() string {
 	if x != nil {
 		return x.OrgId
 	}
 	return ""
 }
 
-type ListOrgMembersResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Members       []*OrgMember           `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListOrgMembersResponse) Reset() {
-	*x = ListOrgMembersResponse{}
-	mi := &file_organization_proto_msgTypes[60]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListOrgMembersResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetCapacityReportRequest) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
 }
 
-func (*ListOrgMembersResponse) ProtoMessage() {}
-
-func (x *ListOrgMembersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[60]
+func (x *GetCapacityReportRequest) GetStartDate() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.StartDate
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ListOrgMembersResponse.ProtoReflect.Descriptor instead.
-func (*ListOrgMembersResponse) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{60}
+func (x *GetCapacityReportRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
 }
 
-func (x *ListOrgMembersResponse) GetMembers() []*OrgMember {
+func (x *GetCapacityReportRequest) GetThreshold() int32 {
 	if x != nil {
-		return x.Members
+		return x.Threshold
 	}
-	return nil
+	return 0
 }
 
-func (x *ListOrgMembersResponse) GetTotal() int32 {
+func (x *GetCapacityReportRequest) GetOverAllocatedOnly() bool {
 	if x != nil {
-		return x.Total
+		return x.OverAllocatedOnly
 	}
-	return 0
+	return false
 }
 
-type Workspace struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	OrgId         string                 `protobuf:"bytes,2,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	WorkspaceType string                 `protobuf:"bytes,5,opt,name=workspace_type,json=workspaceType,proto3" json:"workspace_type,omitempty"`
-	TeamId        string                 `protobuf:"bytes,6,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
-	ProjectId     string                 `protobuf:"bytes,7,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	OwnerId       string                 `protobuf:"bytes,8,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
-	Settings      string                 `protobuf:"bytes,9,opt,name=settings,proto3" json:"settings,omitempty"`
-	IsPrivate     bool                   `protobuf:"varint,10,opt,name=is_private,json=isPrivate,proto3" json:"is_private,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetCapacityReportResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	OrgId              string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	TeamId             string                 `protobuf:"bytes,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	StartDate          string                 `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate            string                 `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Threshold          int32                  `protobuf:"varint,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Users              []*UserCapacity        `protobuf:"bytes,6,rep,name=users,proto3" json:"users,omitempty"`
+	OverAllocatedCount int32                  `protobuf:"varint,7,opt,name=over_allocated_count,json=overAllocatedCount,proto3" json:"over_allocated_count,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *Workspace) Reset() {
-	*x = Workspace{}
-	mi := &file_organization_proto_msgTypes[61]
+func (x *GetCapacityReportResponse) Reset() {
+	*x = GetCapacityReportResponse{}
+	mi := &file_organization_proto_msgTypes[156]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Workspace) String() string {
+func (x *GetCapacityReportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Workspace) ProtoMessage() {}
+func (*GetCapacityReportResponse) ProtoMessage() {}
 
-func (x *Workspace) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[61]
+func (x *GetCapacityReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[156]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4021,123 +10172,163 @@ func (x *Workspace) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Workspace.ProtoReflect.Descriptor instead.
-func (*Workspace) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use GetCapacityReportResponse.ProtoReflect.Descriptor instead.
+func (*GetCapacityReportResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{156}
 }
 
-func (x *Workspace) GetId() string {
+func (x *GetCapacityReportResponse) GetOrgId() string {
 	if x != nil {
-		return x.Id
+		return x.OrgId
 	}
 	return ""
 }
 
-func (x *Workspace) GetOrgId() string {
+func (x *GetCapacityReportResponse) GetTeamId() string {
 	if x != nil {
-		return x.OrgId
+		return x.TeamId
 	}
 	return ""
 }
 
-func (x *Workspace) GetName() string {
+func (x *GetCapacityReportResponse) GetStartDate() string {
 	if x != nil {
-		return x.Name
+		return x.StartDate
 	}
 	return ""
 }
 
-func (x *Workspace) GetDescription() string {
+func (x *GetCapacityReportResponse) GetEndDate() string {
 	if x != nil {
-		return x.Description
+		return x.EndDate
 	}
 	return ""
 }
 
-func (x *Workspace) GetWorkspaceType() string {
+func (x *GetCapacityReportResponse) GetThreshold() int32 {
 	if x != nil {
-		return x.WorkspaceType
+		return x.Threshold
 	}
-	return ""
+	return 0
 }
 
-func (x *Workspace) GetTeamId() string {
+func (x *GetCapacityReportResponse) GetUsers() []*UserCapacity {
 	if x != nil {
-		return x.TeamId
+		return x.Users
 	}
-	return ""
+	return nil
 }
 
-func (x *Workspace) GetProjectId() string {
+func (x *GetCapacityReportResponse) GetOverAllocatedCount() int32 {
 	if x != nil {
-		return x.ProjectId
+		return x.OverAllocatedCount
 	}
-	return ""
+	return 0
 }
 
-func (x *Workspace) GetOwnerId() string {
+// One team, project, group, workspace or member matching a search
+type SearchResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // team, project, group, workspace, member
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"` // email for members
+	Score         float64                `protobuf:"fixed64,5,opt,name=score,proto3" json:"score,omitempty"`           // higher ranks first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	mi := &file_organization_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[157]
 	if x != nil {
-		return x.OwnerId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *SearchResult) GetType() string {
+	if x != nil {
+		return x.Type
 	}
 	return ""
 }
 
-func (x *Workspace) GetSettings() string {
+func (x *SearchResult) GetId() string {
 	if x != nil {
-		return x.Settings
+		return x.Id
 	}
 	return ""
 }
 
-func (x *Workspace) GetIsPrivate() bool {
+func (x *SearchResult) GetName() string {
 	if x != nil {
-		return x.IsPrivate
+		return x.Name
 	}
-	return false
+	return ""
 }
 
-func (x *Workspace) GetCreatedAt() *timestamppb.Timestamp {
+func (x *SearchResult) GetDescription() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Description
 	}
-	return nil
+	return ""
 }
 
-func (x *Workspace) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *SearchResult) GetScore() float64 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Score
 	}
-	return nil
+	return 0
 }
 
-type CreateWorkspaceRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	WorkspaceType string                 `protobuf:"bytes,4,opt,name=workspace_type,json=workspaceType,proto3" json:"workspace_type,omitempty"`
-	TeamId        string                 `protobuf:"bytes,5,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
-	ProjectId     string                 `protobuf:"bytes,6,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	IsPrivate     bool                   `protobuf:"varint,7,opt,name=is_private,json=isPrivate,proto3" json:"is_private,omitempty"`
+type SearchOrganizationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	OrgId string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Query string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	// Optional: restrict to these result types
+	Types         []string `protobuf:"bytes,3,rep,name=types,proto3" json:"types,omitempty"`
+	Limit         int32    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"` // default 20, max 100
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateWorkspaceRequest) Reset() {
-	*x = CreateWorkspaceRequest{}
-	mi := &file_organization_proto_msgTypes[62]
+func (x *SearchOrganizationRequest) Reset() {
+	*x = SearchOrganizationRequest{}
+	mi := &file_organization_proto_msgTypes[158]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateWorkspaceRequest) String() string {
+func (x *SearchOrganizationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateWorkspaceRequest) ProtoMessage() {}
+func (*SearchOrganizationRequest) ProtoMessage() {}
 
-func (x *CreateWorkspaceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[62]
+func (x *SearchOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[158]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4148,83 +10339,105 @@ func (x *CreateWorkspaceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateWorkspaceRequest.ProtoReflect.Descriptor instead.
-func (*CreateWorkspaceRequest) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use SearchOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*SearchOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{158}
 }
 
-func (x *CreateWorkspaceRequest) GetOrgId() string {
+func (x *SearchOrganizationRequest) GetOrgId() string {
 	if x != nil {
 		return x.OrgId
 	}
 	return ""
 }
 
-func (x *CreateWorkspaceRequest) GetName() string {
+func (x *SearchOrganizationRequest) GetQuery() string {
 	if x != nil {
-		return x.Name
+		return x.Query
 	}
 	return ""
 }
 
-func (x *CreateWorkspaceRequest) GetDescription() string {
+func (x *SearchOrganizationRequest) GetTypes() []string {
 	if x != nil {
-		return x.Description
+		return x.Types
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateWorkspaceRequest) GetWorkspaceType() string {
+func (x *SearchOrganizationRequest) GetLimit() int32 {
 	if x != nil {
-		return x.WorkspaceType
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateWorkspaceRequest) GetTeamId() string {
-	if x != nil {
-		return x.TeamId
-	}
-	return ""
+type SearchOrganizationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*SearchResult        `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateWorkspaceRequest) GetProjectId() string {
+func (x *SearchOrganizationResponse) Reset() {
+	*x = SearchOrganizationResponse{}
+	mi := &file_organization_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchOrganizationResponse) ProtoMessage() {}
+
+func (x *SearchOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[159]
 	if x != nil {
-		return x.ProjectId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateWorkspaceRequest) GetIsPrivate() bool {
+// Deprecated: Use SearchOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*SearchOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *SearchOrganizationResponse) GetResults() []*SearchResult {
 	if x != nil {
-		return x.IsPrivate
+		return x.Results
 	}
-	return false
+	return nil
 }
 
-type CreateWorkspaceResponse struct {
+type GetMetadataSchemaRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Workspace     *Workspace             `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	EntityType    string                 `protobuf:"bytes,1,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"` // team, group, project or workspace
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateWorkspaceResponse) Reset() {
-	*x = CreateWorkspaceResponse{}
-	mi := &file_organization_proto_msgTypes[63]
+func (x *GetMetadataSchemaRequest) Reset() {
+	*x = GetMetadataSchemaRequest{}
+	mi := &file_organization_proto_msgTypes[160]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateWorkspaceResponse) String() string {
+func (x *GetMetadataSchemaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateWorkspaceResponse) ProtoMessage() {}
+func (*GetMetadataSchemaRequest) ProtoMessage() {}
 
-func (x *CreateWorkspaceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[63]
+func (x *GetMetadataSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[160]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4235,49 +10448,41 @@ func (x *CreateWorkspaceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateWorkspaceResponse.ProtoReflect.Descriptor instead.
-func (*CreateWorkspaceResponse) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{63}
-}
-
-func (x *CreateWorkspaceResponse) GetWorkspace() *Workspace {
-	if x != nil {
-		return x.Workspace
-	}
-	return nil
+// Deprecated: Use GetMetadataSchemaRequest.ProtoReflect.Descriptor instead.
+func (*GetMetadataSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{160}
 }
 
-func (x *CreateWorkspaceResponse) GetMessage() string {
+func (x *GetMetadataSchemaRequest) GetEntityType() string {
 	if x != nil {
-		return x.Message
+		return x.EntityType
 	}
 	return ""
 }
 
-type ListWorkspacesRequest struct {
+type GetMetadataSchemaResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
-	TeamId        string                 `protobuf:"bytes,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
-	ProjectId     string                 `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	EntityType    string                 `protobuf:"bytes,1,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	Schema        string                 `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"` // JSON Schema (draft 2020-12) document
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListWorkspacesRequest) Reset() {
-	*x = ListWorkspacesRequest{}
-	mi := &file_organization_proto_msgTypes[64]
+func (x *GetMetadataSchemaResponse) Reset() {
+	*x = GetMetadataSchemaResponse{}
+	mi := &file_organization_proto_msgTypes[161]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListWorkspacesRequest) String() string {
+func (x *GetMetadataSchemaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListWorkspacesRequest) ProtoMessage() {}
+func (*GetMetadataSchemaResponse) ProtoMessage() {}
 
-func (x *ListWorkspacesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[64]
+func (x *GetMetadataSchemaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[161]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4288,54 +10493,51 @@ func (x *ListWorkspacesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListWorkspacesRequest.ProtoReflect.Descriptor instead.
-func (*ListWorkspacesRequest) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{64}
-}
-
-func (x *ListWorkspacesRequest) GetOrgId() string {
-	if x != nil {
-		return x.OrgId
-	}
-	return ""
+// Deprecated: Use GetMetadataSchemaResponse.ProtoReflect.Descriptor instead.
+func (*GetMetadataSchemaResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{161}
 }
 
-func (x *ListWorkspacesRequest) GetTeamId() string {
+func (x *GetMetadataSchemaResponse) GetEntityType() string {
 	if x != nil {
-		return x.TeamId
+		return x.EntityType
 	}
 	return ""
 }
 
-func (x *ListWorkspacesRequest) GetProjectId() string {
+func (x *GetMetadataSchemaResponse) GetSchema() string {
 	if x != nil {
-		return x.ProjectId
+		return x.Schema
 	}
 	return ""
 }
 
-type ListWorkspacesResponse struct {
+// Requests the gateway counted for one organization on one day
+type OrgUsageSample struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Workspaces    []*Workspace           `protobuf:"bytes,1,rep,name=workspaces,proto3" json:"workspaces,omitempty"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Day           string                 `protobuf:"bytes,2,opt,name=day,proto3" json:"day,omitempty"` // YYYY-MM-DD, UTC
+	Requests      int64                  `protobuf:"varint,3,opt,name=requests,proto3" json:"requests,omitempty"`
+	UserIds       []string               `protobuf:"bytes,4,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"` // users who made those requests
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListWorkspacesResponse) Reset() {
-	*x = ListWorkspacesResponse{}
-	mi := &file_organization_proto_msgTypes[65]
+func (x *OrgUsageSample) Reset() {
+	*x = OrgUsageSample{}
+	mi := &file_organization_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListWorkspacesResponse) String() string {
+func (x *OrgUsageSample) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListWorkspacesResponse) ProtoMessage() {}
+func (*OrgUsageSample) ProtoMessage() {}
 
-func (x *ListWorkspacesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[65]
+func (x *OrgUsageSample) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4346,40 +10548,61 @@ func (x *ListWorkspacesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListWorkspacesResponse.ProtoReflect.Descriptor instead.
-func (*ListWorkspacesResponse) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use OrgUsageSample.ProtoReflect.Descriptor instead.
+func (*OrgUsageSample) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{162}
 }
 
-func (x *ListWorkspacesResponse) GetWorkspaces() []*Workspace {
+func (x *OrgUsageSample) GetOrgId() string {
 	if x != nil {
-		return x.Workspaces
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *OrgUsageSample) GetDay() string {
+	if x != nil {
+		return x.Day
+	}
+	return ""
+}
+
+func (x *OrgUsageSample) GetRequests() int64 {
+	if x != nil {
+		return x.Requests
+	}
+	return 0
+}
+
+func (x *OrgUsageSample) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
 	}
 	return nil
 }
 
-type GetWorkspaceRequest struct {
+type RecordOrgUsageRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	WorkspaceId   string                 `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	Samples       []*OrgUsageSample      `protobuf:"bytes,1,rep,name=samples,proto3" json:"samples,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetWorkspaceRequest) Reset() {
-	*x = GetWorkspaceRequest{}
-	mi := &file_organization_proto_msgTypes[66]
+func (x *RecordOrgUsageRequest) Reset() {
+	*x = RecordOrgUsageRequest{}
+	mi := &file_organization_proto_msgTypes[163]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetWorkspaceRequest) String() string {
+func (x *RecordOrgUsageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWorkspaceRequest) ProtoMessage() {}
+func (*RecordOrgUsageRequest) ProtoMessage() {}
 
-func (x *GetWorkspaceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[66]
+func (x *RecordOrgUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[163]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4390,40 +10613,40 @@ func (x *GetWorkspaceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWorkspaceRequest.ProtoReflect.Descriptor instead.
-func (*GetWorkspaceRequest) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{66}
+// Deprecated: Use RecordOrgUsageRequest.ProtoReflect.Descriptor instead.
+func (*RecordOrgUsageRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{163}
 }
 
-func (x *GetWorkspaceRequest) GetWorkspaceId() string {
+func (x *RecordOrgUsageRequest) GetSamples() []*OrgUsageSample {
 	if x != nil {
-		return x.WorkspaceId
+		return x.Samples
 	}
-	return ""
+	return nil
 }
 
-type GetWorkspaceResponse struct {
+type RecordOrgUsageResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Workspace     *Workspace             `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	Recorded      int32                  `protobuf:"varint,1,opt,name=recorded,proto3" json:"recorded,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetWorkspaceResponse) Reset() {
-	*x = GetWorkspaceResponse{}
-	mi := &file_organization_proto_msgTypes[67]
+func (x *RecordOrgUsageResponse) Reset() {
+	*x = RecordOrgUsageResponse{}
+	mi := &file_organization_proto_msgTypes[164]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetWorkspaceResponse) String() string {
+func (x *RecordOrgUsageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWorkspaceResponse) ProtoMessage() {}
+func (*RecordOrgUsageResponse) ProtoMessage() {}
 
-func (x *GetWorkspaceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[67]
+func (x *RecordOrgUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[164]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4434,44 +10657,45 @@ func (x *GetWorkspaceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWorkspaceResponse.ProtoReflect.Descriptor instead.
-func (*GetWorkspaceResponse) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{67}
+// Deprecated: Use RecordOrgUsageResponse.ProtoReflect.Descriptor instead.
+func (*RecordOrgUsageResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{164}
 }
 
-func (x *GetWorkspaceResponse) GetWorkspace() *Workspace {
+func (x *RecordOrgUsageResponse) GetRecorded() int32 {
 	if x != nil {
-		return x.Workspace
+		return x.Recorded
 	}
-	return nil
+	return 0
 }
 
-type UpdateWorkspaceRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	WorkspaceId   string                 `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	WorkspaceType string                 `protobuf:"bytes,4,opt,name=workspace_type,json=workspaceType,proto3" json:"workspace_type,omitempty"`
-	Settings      string                 `protobuf:"bytes,5,opt,name=settings,proto3" json:"settings,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// One day of an organization's usage
+type OrgUsageDay struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Day               string                 `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"` // YYYY-MM-DD, UTC
+	Requests          int64                  `protobuf:"varint,2,opt,name=requests,proto3" json:"requests,omitempty"`
+	ActiveUsers       int32                  `protobuf:"varint,3,opt,name=active_users,json=activeUsers,proto3" json:"active_users,omitempty"`
+	TasksCreated      int32                  `protobuf:"varint,4,opt,name=tasks_created,json=tasksCreated,proto3" json:"tasks_created,omitempty"`
+	NotificationsSent int32                  `protobuf:"varint,5,opt,name=notifications_sent,json=notificationsSent,proto3" json:"notifications_sent,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *UpdateWorkspaceRequest) Reset() {
-	*x = UpdateWorkspaceRequest{}
-	mi := &file_organization_proto_msgTypes[68]
+func (x *OrgUsageDay) Reset() {
+	*x = OrgUsageDay{}
+	mi := &file_organization_proto_msgTypes[165]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateWorkspaceRequest) String() string {
+func (x *OrgUsageDay) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateWorkspaceRequest) ProtoMessage() {}
+func (*OrgUsageDay) ProtoMessage() {}
 
-func (x *UpdateWorkspaceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[68]
+func (x *OrgUsageDay) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[165]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4482,69 +10706,70 @@ func (x *UpdateWorkspaceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateWorkspaceRequest.ProtoReflect.Descriptor instead.
-func (*UpdateWorkspaceRequest) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{68}
+// Deprecated: Use OrgUsageDay.ProtoReflect.Descriptor instead.
+func (*OrgUsageDay) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{165}
 }
 
-func (x *UpdateWorkspaceRequest) GetWorkspaceId() string {
+func (x *OrgUsageDay) GetDay() string {
 	if x != nil {
-		return x.WorkspaceId
+		return x.Day
 	}
 	return ""
 }
 
-func (x *UpdateWorkspaceRequest) GetName() string {
+func (x *OrgUsageDay) GetRequests() int64 {
 	if x != nil {
-		return x.Name
+		return x.Requests
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateWorkspaceRequest) GetDescription() string {
+func (x *OrgUsageDay) GetActiveUsers() int32 {
 	if x != nil {
-		return x.Description
+		return x.ActiveUsers
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateWorkspaceRequest) GetWorkspaceType() string {
+func (x *OrgUsageDay) GetTasksCreated() int32 {
 	if x != nil {
-		return x.WorkspaceType
+		return x.TasksCreated
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateWorkspaceRequest) GetSettings() string {
+func (x *OrgUsageDay) GetNotificationsSent() int32 {
 	if x != nil {
-		return x.Settings
+		return x.NotificationsSent
 	}
-	return ""
+	return 0
 }
 
-type UpdateWorkspaceResponse struct {
+type GetOrgUsageRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Workspace     *Workspace             `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	StartDate     string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // YYYY-MM-DD, defaults to 30 days before end_date
+	EndDate       string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // YYYY-MM-DD, defaults to today
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateWorkspaceResponse) Reset() {
-	*x = UpdateWorkspaceResponse{}
-	mi := &file_organization_proto_msgTypes[69]
+func (x *GetOrgUsageRequest) Reset() {
+	*x = GetOrgUsageRequest{}
+	mi := &file_organization_proto_msgTypes[166]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateWorkspaceResponse) String() string {
+func (x *GetOrgUsageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateWorkspaceResponse) ProtoMessage() {}
+func (*GetOrgUsageRequest) ProtoMessage() {}
 
-func (x *UpdateWorkspaceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[69]
+func (x *GetOrgUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[166]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4555,47 +10780,59 @@ func (x *UpdateWorkspaceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateWorkspaceResponse.ProtoReflect.Descriptor instead.
-func (*UpdateWorkspaceResponse) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use GetOrgUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetOrgUsageRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{166}
 }
 
-func (x *UpdateWorkspaceResponse) GetWorkspace() *Workspace {
+func (x *GetOrgUsageRequest) GetOrgId() string {
 	if x != nil {
-		return x.Workspace
+		return x.OrgId
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateWorkspaceResponse) GetMessage() string {
+func (x *GetOrgUsageRequest) GetStartDate() string {
 	if x != nil {
-		return x.Message
+		return x.StartDate
 	}
 	return ""
 }
 
-type DeleteWorkspaceRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	WorkspaceId   string                 `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+func (x *GetOrgUsageRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+type GetOrgUsageResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	OrgId     string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	StartDate string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate   string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Days      []*OrgUsageDay         `protobuf:"bytes,4,rep,name=days,proto3" json:"days,omitempty"` // oldest first, one per day of the range
+	// Sums over the range; active_users counts each user once
+	Totals        *OrgUsageDay `protobuf:"bytes,5,opt,name=totals,proto3" json:"totals,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteWorkspaceRequest) Reset() {
-	*x = DeleteWorkspaceRequest{}
-	mi := &file_organization_proto_msgTypes[70]
+func (x *GetOrgUsageResponse) Reset() {
+	*x = GetOrgUsageResponse{}
+	mi := &file_organization_proto_msgTypes[167]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteWorkspaceRequest) String() string {
+func (x *GetOrgUsageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteWorkspaceRequest) ProtoMessage() {}
+func (*GetOrgUsageResponse) ProtoMessage() {}
 
-func (x *DeleteWorkspaceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[70]
+func (x *GetOrgUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[167]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4606,60 +10843,44 @@ func (x *DeleteWorkspaceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteWorkspaceRequest.ProtoReflect.Descriptor instead.
-func (*DeleteWorkspaceRequest) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use GetOrgUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetOrgUsageResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{167}
 }
 
-func (x *DeleteWorkspaceRequest) GetWorkspaceId() string {
+func (x *GetOrgUsageResponse) GetOrgId() string {
 	if x != nil {
-		return x.WorkspaceId
+		return x.OrgId
 	}
 	return ""
 }
 
-type DeleteWorkspaceResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *DeleteWorkspaceResponse) Reset() {
-	*x = DeleteWorkspaceResponse{}
-	mi := &file_organization_proto_msgTypes[71]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *DeleteWorkspaceResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetOrgUsageResponse) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
 }
 
-func (*DeleteWorkspaceResponse) ProtoMessage() {}
-
-func (x *DeleteWorkspaceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_proto_msgTypes[71]
+func (x *GetOrgUsageResponse) GetEndDate() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.EndDate
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use DeleteWorkspaceResponse.ProtoReflect.Descriptor instead.
-func (*DeleteWorkspaceResponse) Descriptor() ([]byte, []int) {
-	return file_organization_proto_rawDescGZIP(), []int{71}
+func (x *GetOrgUsageResponse) GetDays() []*OrgUsageDay {
+	if x != nil {
+		return x.Days
+	}
+	return nil
 }
 
-func (x *DeleteWorkspaceResponse) GetMessage() string {
+func (x *GetOrgUsageResponse) GetTotals() *OrgUsageDay {
 	if x != nil {
-		return x.Message
+		return x.Totals
 	}
-	return ""
+	return nil
 }
 
 var File_organization_proto protoreflect.FileDescriptor
@@ -4726,21 +10947,27 @@ const file_organization_proto_rawDesc = "" +
 	"\x05teams\x18\x01 \x03(\v2\x12.organization.TeamR\x05teams\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x9c\x01\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xb8\x01\n" +
 	"\x11UpdateTeamRequest\x12\x17\n" +
 	"\ateam_id\x18\x01 \x01(\tR\x06teamId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12 \n" +
 	"\fteam_lead_id\x18\x04 \x01(\tR\n" +
 	"teamLeadId\x12\x16\n" +
-	"\x06status\x18\x05 \x01(\tR\x06status\"V\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1a\n" +
+	"\bmetadata\x18\x06 \x01(\tR\bmetadata\"V\n" +
 	"\x12UpdateTeamResponse\x12&\n" +
 	"\x04team\x18\x01 \x01(\v2\x12.organization.TeamR\x04team\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\",\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"y\n" +
 	"\x11DeleteTeamRequest\x12\x17\n" +
-	"\ateam_id\x18\x01 \x01(\tR\x06teamId\".\n" +
+	"\ateam_id\x18\x01 \x01(\tR\x06teamId\x12\x1f\n" +
+	"\vtask_policy\x18\x02 \x01(\tR\n" +
+	"taskPolicy\x12*\n" +
+	"\x11fallback_owner_id\x18\x03 \x01(\tR\x0ffallbackOwnerId\"\x80\x01\n" +
 	"\x12DeleteTeamResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"\\\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12)\n" +
+	"\x10tasks_reassigned\x18\x02 \x01(\x05R\x0ftasksReassigned\x12%\n" +
+	"\x0etasks_detached\x18\x03 \x01(\x05R\rtasksDetached\"\\\n" +
 	"\x14AddTeamMemberRequest\x12\x17\n" +
 	"\ateam_id\x18\x01 \x01(\tR\x06teamId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
@@ -4752,11 +10979,35 @@ const file_organization_proto_rawDesc = "" +
 	"\ateam_id\x18\x01 \x01(\tR\x06teamId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\"4\n" +
 	"\x18RemoveTeamMemberResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"1\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"r\n" +
+	"\x0eBulkMemberItem\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x123\n" +
+	"\x15allocation_percentage\x18\x03 \x01(\x05R\x14allocationPercentage\"x\n" +
+	"\x10BulkMemberResult\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12\x1b\n" +
+	"\tmember_id\x18\x04 \x01(\tR\bmemberId\"l\n" +
+	"\x19BulkAddTeamMembersRequest\x12\x17\n" +
+	"\ateam_id\x18\x01 \x01(\tR\x06teamId\x126\n" +
+	"\amembers\x18\x02 \x03(\v2\x1c.organization.BulkMemberItemR\amembers\"\x9e\x01\n" +
+	"\x1aBulkAddTeamMembersResponse\x128\n" +
+	"\aresults\x18\x01 \x03(\v2\x1e.organization.BulkMemberResultR\aresults\x12\x14\n" +
+	"\x05added\x18\x02 \x01(\x05R\x05added\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"1\n" +
 	"\x16ListTeamMembersRequest\x12\x17\n" +
 	"\ateam_id\x18\x01 \x01(\tR\x06teamId\"M\n" +
 	"\x17ListTeamMembersResponse\x122\n" +
-	"\amembers\x18\x01 \x03(\v2\x18.organization.TeamMemberR\amembers\"\xd8\x05\n" +
+	"\amembers\x18\x01 \x03(\v2\x18.organization.TeamMemberR\amembers\"Y\n" +
+	"\x1cTransferTeamOwnershipRequest\x12\x17\n" +
+	"\ateam_id\x18\x01 \x01(\tR\x06teamId\x12 \n" +
+	"\fnew_owner_id\x18\x02 \x01(\tR\n" +
+	"newOwnerId\"a\n" +
+	"\x1dTransferTeamOwnershipResponse\x12&\n" +
+	"\x04team\x18\x01 \x01(\v2\x12.organization.TeamR\x04team\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x95\x06\n" +
 	"\aProject\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x15\n" +
 	"\x06org_id\x18\x02 \x01(\tR\x05orgId\x12\x12\n" +
@@ -4783,7 +11034,9 @@ const file_organization_proto_rawDesc = "" +
 	"\amembers\x18\x12 \x03(\v2\x1b.organization.ProjectMemberR\amembers\x12\x1d\n" +
 	"\n" +
 	"team_count\x18\x13 \x01(\x05R\tteamCount\x12!\n" +
-	"\fmember_count\x18\x14 \x01(\x05R\vmemberCount\"o\n" +
+	"\fmember_count\x18\x14 \x01(\x05R\vmemberCount\x12;\n" +
+	"\varchived_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"archivedAt\"o\n" +
 	"\x0eProjectManager\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tfull_name\x18\x02 \x01(\tR\bfullName\x12\x14\n" +
@@ -4828,18 +11081,19 @@ const file_organization_proto_rawDesc = "" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\"E\n" +
 	"\x12GetProjectResponse\x12/\n" +
-	"\aproject\x18\x01 \x01(\v2\x15.organization.ProjectR\aproject\"\x91\x01\n" +
+	"\aproject\x18\x01 \x01(\v2\x15.organization.ProjectR\aproject\"\xb6\x01\n" +
 	"\x13ListProjectsRequest\x12\x15\n" +
 	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1a\n" +
 	"\bpriority\x18\x03 \x01(\tR\bpriority\x12\x12\n" +
 	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\"\x90\x01\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12#\n" +
+	"\rarchived_only\x18\x06 \x01(\bR\farchivedOnly\"\x90\x01\n" +
 	"\x14ListProjectsResponse\x121\n" +
 	"\bprojects\x18\x01 \x03(\v2\x15.organization.ProjectR\bprojects\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xd3\x01\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xef\x01\n" +
 	"\x14UpdateProjectRequest\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x12\n" +
@@ -4848,15 +11102,33 @@ const file_organization_proto_rawDesc = "" +
 	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1a\n" +
 	"\bpriority\x18\x05 \x01(\tR\bpriority\x12\x1a\n" +
 	"\bprogress\x18\x06 \x01(\x05R\bprogress\x12\x16\n" +
-	"\x06budget\x18\a \x01(\x01R\x06budget\"b\n" +
+	"\x06budget\x18\a \x01(\x01R\x06budget\x12\x1a\n" +
+	"\bmetadata\x18\b \x01(\tR\bmetadata\"b\n" +
 	"\x15UpdateProjectResponse\x12/\n" +
 	"\aproject\x18\x01 \x01(\v2\x15.organization.ProjectR\aproject\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"5\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"6\n" +
+	"\x15ArchiveProjectRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\"c\n" +
+	"\x16ArchiveProjectResponse\x12/\n" +
+	"\aproject\x18\x01 \x01(\v2\x15.organization.ProjectR\aproject\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"8\n" +
+	"\x17UnarchiveProjectRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\"e\n" +
+	"\x18UnarchiveProjectResponse\x12/\n" +
+	"\aproject\x18\x01 \x01(\v2\x15.organization.ProjectR\aproject\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x82\x01\n" +
 	"\x14DeleteProjectRequest\x12\x1d\n" +
 	"\n" +
-	"project_id\x18\x01 \x01(\tR\tprojectId\"1\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x1f\n" +
+	"\vtask_policy\x18\x02 \x01(\tR\n" +
+	"taskPolicy\x12*\n" +
+	"\x11fallback_owner_id\x18\x03 \x01(\tR\x0ffallbackOwnerId\"\x83\x01\n" +
 	"\x15DeleteProjectResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"T\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12)\n" +
+	"\x10tasks_reassigned\x18\x02 \x01(\x05R\x0ftasksReassigned\x12%\n" +
+	"\x0etasks_detached\x18\x03 \x01(\x05R\rtasksDetached\"T\n" +
 	"\x1aAssignTeamToProjectRequest\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x17\n" +
@@ -4884,7 +11156,134 @@ const file_organization_proto_rawDesc = "" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\"7\n" +
 	"\x1bRemoveProjectMemberResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"\xef\x03\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"u\n" +
+	"\x1cBulkAddProjectMembersRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x126\n" +
+	"\amembers\x18\x02 \x03(\v2\x1c.organization.BulkMemberItemR\amembers\"\xa1\x01\n" +
+	"\x1dBulkAddProjectMembersResponse\x128\n" +
+	"\aresults\x18\x01 \x03(\v2\x1e.organization.BulkMemberResultR\aresults\x12\x14\n" +
+	"\x05added\x18\x02 \x01(\x05R\x05added\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"d\n" +
+	"\x1dTransferProjectManagerRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12$\n" +
+	"\x0enew_manager_id\x18\x02 \x01(\tR\fnewManagerId\"k\n" +
+	"\x1eTransferProjectManagerResponse\x12/\n" +
+	"\aproject\x18\x01 \x01(\v2\x15.organization.ProjectR\aproject\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xa2\x01\n" +
+	"\x10ProjectMilestone\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x19\n" +
+	"\bdue_date\x18\x03 \x01(\tR\adueDate\x12=\n" +
+	"\fcompleted_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\"\x8d\x03\n" +
+	"\fTimelineTask\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x19\n" +
+	"\btask_key\x18\x02 \x01(\tR\ataskKey\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1a\n" +
+	"\bpriority\x18\x05 \x01(\tR\bpriority\x12\x1f\n" +
+	"\vassigned_to\x18\x06 \x01(\tR\n" +
+	"assignedTo\x12\x17\n" +
+	"\ateam_id\x18\a \x01(\tR\x06teamId\x120\n" +
+	"\x05start\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x125\n" +
+	"\bdue_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x12=\n" +
+	"\fcompleted_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12\x1d\n" +
+	"\n" +
+	"is_overdue\x18\v \x01(\bR\tisOverdue\"Q\n" +
+	"\x12TimelineDependency\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\"\n" +
+	"\rdepends_on_id\x18\x02 \x01(\tR\vdependsOnId\":\n" +
+	"\x19GetProjectTimelineRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\"\xc1\x02\n" +
+	"\x1aGetProjectTimelineResponse\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x04 \x01(\tR\aendDate\x12>\n" +
+	"\n" +
+	"milestones\x18\x05 \x03(\v2\x1e.organization.ProjectMilestoneR\n" +
+	"milestones\x120\n" +
+	"\x05tasks\x18\x06 \x03(\v2\x1a.organization.TimelineTaskR\x05tasks\x12D\n" +
+	"\fdependencies\x18\a \x03(\v2 .organization.TimelineDependencyR\fdependencies\"\x89\x03\n" +
+	"\x13ProjectStatusReport\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x12\x15\n" +
+	"\x06org_id\x18\x03 \x01(\tR\x05orgId\x12\x1d\n" +
+	"\n" +
+	"week_start\x18\x04 \x01(\tR\tweekStart\x12\x1d\n" +
+	"\n" +
+	"rag_status\x18\x05 \x01(\tR\tragStatus\x12\x18\n" +
+	"\asummary\x18\x06 \x01(\tR\asummary\x12\x14\n" +
+	"\x05risks\x18\a \x03(\tR\x05risks\x12!\n" +
+	"\fsubmitted_by\x18\b \x01(\tR\vsubmittedBy\x12%\n" +
+	"\x0esubmitter_name\x18\t \x01(\tR\rsubmitterName\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xaf\x01\n" +
+	" SubmitProjectStatusReportRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x1d\n" +
+	"\n" +
+	"rag_status\x18\x02 \x01(\tR\tragStatus\x12\x18\n" +
+	"\asummary\x18\x03 \x01(\tR\asummary\x12\x14\n" +
+	"\x05risks\x18\x04 \x03(\tR\x05risks\x12\x1d\n" +
+	"\n" +
+	"week_start\x18\x05 \x01(\tR\tweekStart\"x\n" +
+	"!SubmitProjectStatusReportResponse\x129\n" +
+	"\x06report\x18\x01 \x01(\v2!.organization.ProjectStatusReportR\x06report\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"V\n" +
+	"\x1fListProjectStatusReportsRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"_\n" +
+	" ListProjectStatusReportsResponse\x12;\n" +
+	"\areports\x18\x01 \x03(\v2!.organization.ProjectStatusReportR\areports\"\xf4\x02\n" +
+	"\x0fProjectActivity\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x03 \x01(\tR\teventType\x12\x18\n" +
+	"\asummary\x18\x04 \x01(\tR\asummary\x12\x19\n" +
+	"\bactor_id\x18\x05 \x01(\tR\aactorId\x12\x1d\n" +
+	"\n" +
+	"actor_name\x18\x06 \x01(\tR\tactorName\x12G\n" +
+	"\bmetadata\x18\a \x03(\v2+.organization.ProjectActivity.MetadataEntryR\bmetadata\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x8a\x01\n" +
+	"\x19GetProjectActivityRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x04 \x01(\tR\teventType\"\xa2\x01\n" +
+	"\x1aGetProjectActivityResponse\x12=\n" +
+	"\n" +
+	"activities\x18\x01 \x03(\v2\x1d.organization.ProjectActivityR\n" +
+	"activities\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"T\n" +
+	"\x1fCompleteProjectMilestoneRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"z\n" +
+	" CompleteProjectMilestoneResponse\x12<\n" +
+	"\tmilestone\x18\x01 \x01(\v2\x1e.organization.ProjectMilestoneR\tmilestone\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xef\x03\n" +
 	"\x05Group\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x15\n" +
 	"\x06org_id\x18\x02 \x01(\tR\x05orgId\x12\x12\n" +
@@ -4945,12 +11344,13 @@ const file_organization_proto_rawDesc = "" +
 	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"W\n" +
 	"\x12ListGroupsResponse\x12+\n" +
 	"\x06groups\x18\x01 \x03(\v2\x13.organization.GroupR\x06groups\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"}\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\x99\x01\n" +
 	"\x12UpdateGroupRequest\x12\x19\n" +
 	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x16\n" +
-	"\x06status\x18\x04 \x01(\tR\x06status\"Z\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1a\n" +
+	"\bmetadata\x18\x05 \x01(\tR\bmetadata\"Z\n" +
 	"\x13UpdateGroupResponse\x12)\n" +
 	"\x05group\x18\x01 \x01(\v2\x13.organization.GroupR\x05group\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\"/\n" +
@@ -4969,7 +11369,14 @@ const file_organization_proto_rawDesc = "" +
 	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\"5\n" +
 	"\x19RemoveGroupMemberResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"\xb9\x01\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"X\n" +
+	"\x19TransferGroupOwnerRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12 \n" +
+	"\fnew_owner_id\x18\x02 \x01(\tR\n" +
+	"newOwnerId\"a\n" +
+	"\x1aTransferGroupOwnerResponse\x12)\n" +
+	"\x05group\x18\x01 \x01(\v2\x13.organization.GroupR\x05group\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xde\x02\n" +
 	"\tOrgMember\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tfull_name\x18\x02 \x01(\tR\bfullName\x12\x14\n" +
@@ -4977,7 +11384,11 @@ const file_organization_proto_rawDesc = "" +
 	"\busername\x18\x04 \x01(\tR\busername\x12\x12\n" +
 	"\x04role\x18\x05 \x01(\tR\x04role\x129\n" +
 	"\n" +
-	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\".\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12]\n" +
+	"\x12profile_attributes\x18\a \x03(\v2..organization.OrgMember.ProfileAttributesEntryR\x11profileAttributes\x1aD\n" +
+	"\x16ProfileAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\".\n" +
 	"\x15ListOrgMembersRequest\x12\x15\n" +
 	"\x06org_id\x18\x01 \x01(\tR\x05orgId\"a\n" +
 	"\x16ListOrgMembersResponse\x121\n" +
@@ -5039,7 +11450,340 @@ const file_organization_proto_rawDesc = "" +
 	"\x16DeleteWorkspaceRequest\x12!\n" +
 	"\fworkspace_id\x18\x01 \x01(\tR\vworkspaceId\"3\n" +
 	"\x17DeleteWorkspaceResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage2\x87 \n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\x96\x02\n" +
+	"\x0fWorkspaceMember\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fworkspace_id\x18\x02 \x01(\tR\vworkspaceId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x127\n" +
+	"\tjoined_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bjoinedAt\x12\x1b\n" +
+	"\tis_active\x18\x06 \x01(\bR\bisActive\x12\x1b\n" +
+	"\tfull_name\x18\a \x01(\tR\bfullName\x12\x14\n" +
+	"\x05email\x18\b \x01(\tR\x05email\x12\x1a\n" +
+	"\busername\x18\t \x01(\tR\busername\"k\n" +
+	"\x19AddWorkspaceMemberRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\tR\vworkspaceId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\"m\n" +
+	"\x1aAddWorkspaceMemberResponse\x125\n" +
+	"\x06member\x18\x01 \x01(\v2\x1d.organization.WorkspaceMemberR\x06member\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"Z\n" +
+	"\x1cRemoveWorkspaceMemberRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\tR\vworkspaceId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"9\n" +
+	"\x1dRemoveWorkspaceMemberResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"@\n" +
+	"\x1bListWorkspaceMembersRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\tR\vworkspaceId\"W\n" +
+	"\x1cListWorkspaceMembersResponse\x127\n" +
+	"\amembers\x18\x01 \x03(\v2\x1d.organization.WorkspaceMemberR\amembers\"\xa5\x01\n" +
+	"\x13ProjectTemplateTask\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bpriority\x18\x03 \x01(\tR\bpriority\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12&\n" +
+	"\x0fdue_offset_days\x18\x05 \x01(\x05R\rdueOffsetDays\"q\n" +
+	"\x18ProjectTemplateMilestone\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1f\n" +
+	"\voffset_days\x18\x03 \x01(\x05R\n" +
+	"offsetDays\"\x9e\x01\n" +
+	"\x18ProjectTemplateWorkspace\x12%\n" +
+	"\x0eworkspace_type\x18\x01 \x01(\tR\rworkspaceType\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bsettings\x18\x03 \x01(\tR\bsettings\x12\x1d\n" +
+	"\n" +
+	"is_private\x18\x04 \x01(\bR\tisPrivate\"\xea\x04\n" +
+	"\x0fProjectTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x15\n" +
+	"\x06org_id\x18\x02 \x01(\tR\x05orgId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12*\n" +
+	"\x11source_project_id\x18\x05 \x01(\tR\x0fsourceProjectId\x12\x1a\n" +
+	"\bpriority\x18\x06 \x01(\tR\bpriority\x12\x16\n" +
+	"\x06budget\x18\a \x01(\x01R\x06budget\x12#\n" +
+	"\rduration_days\x18\b \x01(\x05R\fdurationDays\x12\x19\n" +
+	"\bteam_ids\x18\t \x03(\tR\ateamIds\x12D\n" +
+	"\tworkspace\x18\n" +
+	" \x01(\v2&.organization.ProjectTemplateWorkspaceR\tworkspace\x127\n" +
+	"\x05tasks\x18\v \x03(\v2!.organization.ProjectTemplateTaskR\x05tasks\x12F\n" +
+	"\n" +
+	"milestones\x18\f \x03(\v2&.organization.ProjectTemplateMilestoneR\n" +
+	"milestones\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\r \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x99\x02\n" +
+	"\x1cCreateProjectTemplateRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12#\n" +
+	"\rinclude_tasks\x18\x04 \x01(\bR\fincludeTasks\x127\n" +
+	"\x05tasks\x18\x05 \x03(\v2!.organization.ProjectTemplateTaskR\x05tasks\x12F\n" +
+	"\n" +
+	"milestones\x18\x06 \x03(\v2&.organization.ProjectTemplateMilestoneR\n" +
+	"milestones\"t\n" +
+	"\x1dCreateProjectTemplateResponse\x129\n" +
+	"\btemplate\x18\x01 \x01(\v2\x1d.organization.ProjectTemplateR\btemplate\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"<\n" +
+	"\x19GetProjectTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\"W\n" +
+	"\x1aGetProjectTemplateResponse\x129\n" +
+	"\btemplate\x18\x01 \x01(\v2\x1d.organization.ProjectTemplateR\btemplate\"4\n" +
+	"\x1bListProjectTemplatesRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\"q\n" +
+	"\x1cListProjectTemplatesResponse\x12;\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x1d.organization.ProjectTemplateR\ttemplates\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"?\n" +
+	"\x1cDeleteProjectTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\"9\n" +
+	"\x1dDeleteProjectTemplateResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\xc6\x01\n" +
+	" CreateProjectFromTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12,\n" +
+	"\x12project_manager_id\x18\x04 \x01(\tR\x10projectManagerId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x05 \x01(\tR\tstartDate\"\xed\x01\n" +
+	"!CreateProjectFromTemplateResponse\x12/\n" +
+	"\aproject\x18\x01 \x01(\v2\x15.organization.ProjectR\aproject\x125\n" +
+	"\tworkspace\x18\x02 \x01(\v2\x17.organization.WorkspaceR\tworkspace\x12#\n" +
+	"\rtasks_created\x18\x03 \x01(\x05R\ftasksCreated\x12!\n" +
+	"\ffailed_tasks\x18\x04 \x03(\tR\vfailedTasks\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\"F\n" +
+	"\x16WorkspaceTemplateBoard\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\acolumns\x18\x02 \x03(\tR\acolumns\"m\n" +
+	"\x16WorkspaceTemplateGroup\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"group_type\x18\x03 \x01(\tR\tgroupType\"\xe3\x03\n" +
+	"\x11WorkspaceTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x15\n" +
+	"\x06org_id\x18\x02 \x01(\tR\x05orgId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12%\n" +
+	"\x0eworkspace_type\x18\x05 \x01(\tR\rworkspaceType\x12\x1a\n" +
+	"\bsettings\x18\x06 \x01(\tR\bsettings\x12\x1d\n" +
+	"\n" +
+	"is_private\x18\a \x01(\bR\tisPrivate\x12<\n" +
+	"\x06boards\x18\b \x03(\v2$.organization.WorkspaceTemplateBoardR\x06boards\x12<\n" +
+	"\x06groups\x18\t \x03(\v2$.organization.WorkspaceTemplateGroupR\x06groups\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\n" +
+	" \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xcb\x02\n" +
+	"\x1eCreateWorkspaceTemplateRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12%\n" +
+	"\x0eworkspace_type\x18\x04 \x01(\tR\rworkspaceType\x12\x1a\n" +
+	"\bsettings\x18\x05 \x01(\tR\bsettings\x12\x1d\n" +
+	"\n" +
+	"is_private\x18\x06 \x01(\bR\tisPrivate\x12<\n" +
+	"\x06boards\x18\a \x03(\v2$.organization.WorkspaceTemplateBoardR\x06boards\x12<\n" +
+	"\x06groups\x18\b \x03(\v2$.organization.WorkspaceTemplateGroupR\x06groups\"x\n" +
+	"\x1fCreateWorkspaceTemplateResponse\x12;\n" +
+	"\btemplate\x18\x01 \x01(\v2\x1f.organization.WorkspaceTemplateR\btemplate\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\">\n" +
+	"\x1bGetWorkspaceTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\"[\n" +
+	"\x1cGetWorkspaceTemplateResponse\x12;\n" +
+	"\btemplate\x18\x01 \x01(\v2\x1f.organization.WorkspaceTemplateR\btemplate\"6\n" +
+	"\x1dListWorkspaceTemplatesRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\"u\n" +
+	"\x1eListWorkspaceTemplatesResponse\x12=\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x1f.organization.WorkspaceTemplateR\ttemplates\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"A\n" +
+	"\x1eDeleteWorkspaceTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\";\n" +
+	"\x1fDeleteWorkspaceTemplateResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\xb3\x01\n" +
+	"\"CreateWorkspaceFromTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x17\n" +
+	"\ateam_id\x18\x04 \x01(\tR\x06teamId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x05 \x01(\tR\tprojectId\"\xa3\x01\n" +
+	"#CreateWorkspaceFromTemplateResponse\x125\n" +
+	"\tworkspace\x18\x01 \x01(\v2\x17.organization.WorkspaceR\tworkspace\x12+\n" +
+	"\x06groups\x18\x02 \x03(\v2\x13.organization.GroupR\x06groups\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xcb\x02\n" +
+	"\n" +
+	"CustomRole\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x15\n" +
+	"\x06org_id\x18\x02 \x01(\tR\x05orgId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12 \n" +
+	"\vpermissions\x18\x05 \x03(\tR\vpermissions\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x06 \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12)\n" +
+	"\x10assignment_count\x18\t \x01(\x05R\x0fassignmentCount\"\xd5\x02\n" +
+	"\x14CustomRoleAssignment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\arole_id\x18\x02 \x01(\tR\x06roleId\x12\x1b\n" +
+	"\trole_name\x18\x03 \x01(\tR\broleName\x12\x15\n" +
+	"\x06org_id\x18\x04 \x01(\tR\x05orgId\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"scope_type\x18\x06 \x01(\tR\tscopeType\x12\x19\n" +
+	"\bscope_id\x18\a \x01(\tR\ascopeId\x12\x1f\n" +
+	"\vassigned_by\x18\b \x01(\tR\n" +
+	"assignedBy\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1b\n" +
+	"\tfull_name\x18\n" +
+	" \x01(\tR\bfullName\x12\x14\n" +
+	"\x05email\x18\v \x01(\tR\x05email\"\x88\x01\n" +
+	"\x17CreateCustomRoleRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12 \n" +
+	"\vpermissions\x18\x04 \x03(\tR\vpermissions\"b\n" +
+	"\x18CreateCustomRoleResponse\x12,\n" +
+	"\x04role\x18\x01 \x01(\v2\x18.organization.CustomRoleR\x04role\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"/\n" +
+	"\x16ListCustomRolesRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\"~\n" +
+	"\x17ListCustomRolesResponse\x12.\n" +
+	"\x05roles\x18\x01 \x03(\v2\x18.organization.CustomRoleR\x05roles\x123\n" +
+	"\x15available_permissions\x18\x02 \x03(\tR\x14availablePermissions\"\x8a\x01\n" +
+	"\x17UpdateCustomRoleRequest\x12\x17\n" +
+	"\arole_id\x18\x01 \x01(\tR\x06roleId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12 \n" +
+	"\vpermissions\x18\x04 \x03(\tR\vpermissions\"b\n" +
+	"\x18UpdateCustomRoleResponse\x12,\n" +
+	"\x04role\x18\x01 \x01(\v2\x18.organization.CustomRoleR\x04role\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"2\n" +
+	"\x17DeleteCustomRoleRequest\x12\x17\n" +
+	"\arole_id\x18\x01 \x01(\tR\x06roleId\"4\n" +
+	"\x18DeleteCustomRoleResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\x85\x01\n" +
+	"\x17AssignCustomRoleRequest\x12\x17\n" +
+	"\arole_id\x18\x01 \x01(\tR\x06roleId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"scope_type\x18\x03 \x01(\tR\tscopeType\x12\x19\n" +
+	"\bscope_id\x18\x04 \x01(\tR\ascopeId\"x\n" +
+	"\x18AssignCustomRoleResponse\x12B\n" +
+	"\n" +
+	"assignment\x18\x01 \x01(\v2\".organization.CustomRoleAssignmentR\n" +
+	"assignment\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"@\n" +
+	"\x19UnassignCustomRoleRequest\x12#\n" +
+	"\rassignment_id\x18\x01 \x01(\tR\fassignmentId\"6\n" +
+	"\x1aUnassignCustomRoleResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\xa5\x01\n" +
+	" ListCustomRoleAssignmentsRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x17\n" +
+	"\arole_id\x18\x02 \x01(\tR\x06roleId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"scope_type\x18\x04 \x01(\tR\tscopeType\x12\x19\n" +
+	"\bscope_id\x18\x05 \x01(\tR\ascopeId\"i\n" +
+	"!ListCustomRoleAssignmentsResponse\x12D\n" +
+	"\vassignments\x18\x01 \x03(\v2\".organization.CustomRoleAssignmentR\vassignments\"\xff\x01\n" +
+	"\x11ProjectAllocation\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12!\n" +
+	"\fproject_name\x18\x02 \x01(\tR\vprojectName\x12%\n" +
+	"\x0eproject_status\x18\x03 \x01(\tR\rprojectStatus\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x123\n" +
+	"\x15allocation_percentage\x18\x05 \x01(\x05R\x14allocationPercentage\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x06 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\a \x01(\tR\aendDate\"\xe9\x01\n" +
+	"\fUserCapacity\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tfull_name\x18\x02 \x01(\tR\bfullName\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12)\n" +
+	"\x10total_allocation\x18\x04 \x01(\x05R\x0ftotalAllocation\x12%\n" +
+	"\x0eover_allocated\x18\x05 \x01(\bR\roverAllocated\x12;\n" +
+	"\bprojects\x18\x06 \x03(\v2\x1f.organization.ProjectAllocationR\bprojects\"\xd2\x01\n" +
+	"\x18GetCapacityReportRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x17\n" +
+	"\ateam_id\x18\x02 \x01(\tR\x06teamId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x04 \x01(\tR\aendDate\x12\x1c\n" +
+	"\tthreshold\x18\x05 \x01(\x05R\tthreshold\x12.\n" +
+	"\x13over_allocated_only\x18\x06 \x01(\bR\x11overAllocatedOnly\"\x87\x02\n" +
+	"\x19GetCapacityReportResponse\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x17\n" +
+	"\ateam_id\x18\x02 \x01(\tR\x06teamId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x04 \x01(\tR\aendDate\x12\x1c\n" +
+	"\tthreshold\x18\x05 \x01(\x05R\tthreshold\x120\n" +
+	"\x05users\x18\x06 \x03(\v2\x1a.organization.UserCapacityR\x05users\x120\n" +
+	"\x14over_allocated_count\x18\a \x01(\x05R\x12overAllocatedCount\"~\n" +
+	"\fSearchResult\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05score\x18\x05 \x01(\x01R\x05score\"t\n" +
+	"\x19SearchOrganizationRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x14\n" +
+	"\x05types\x18\x03 \x03(\tR\x05types\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"R\n" +
+	"\x1aSearchOrganizationResponse\x124\n" +
+	"\aresults\x18\x01 \x03(\v2\x1a.organization.SearchResultR\aresults\";\n" +
+	"\x18GetMetadataSchemaRequest\x12\x1f\n" +
+	"\ventity_type\x18\x01 \x01(\tR\n" +
+	"entityType\"T\n" +
+	"\x19GetMetadataSchemaResponse\x12\x1f\n" +
+	"\ventity_type\x18\x01 \x01(\tR\n" +
+	"entityType\x12\x16\n" +
+	"\x06schema\x18\x02 \x01(\tR\x06schema\"p\n" +
+	"\x0eOrgUsageSample\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x10\n" +
+	"\x03day\x18\x02 \x01(\tR\x03day\x12\x1a\n" +
+	"\brequests\x18\x03 \x01(\x03R\brequests\x12\x19\n" +
+	"\buser_ids\x18\x04 \x03(\tR\auserIds\"O\n" +
+	"\x15RecordOrgUsageRequest\x126\n" +
+	"\asamples\x18\x01 \x03(\v2\x1c.organization.OrgUsageSampleR\asamples\"4\n" +
+	"\x16RecordOrgUsageResponse\x12\x1a\n" +
+	"\brecorded\x18\x01 \x01(\x05R\brecorded\"\xb2\x01\n" +
+	"\vOrgUsageDay\x12\x10\n" +
+	"\x03day\x18\x01 \x01(\tR\x03day\x12\x1a\n" +
+	"\brequests\x18\x02 \x01(\x03R\brequests\x12!\n" +
+	"\factive_users\x18\x03 \x01(\x05R\vactiveUsers\x12#\n" +
+	"\rtasks_created\x18\x04 \x01(\x05R\ftasksCreated\x12-\n" +
+	"\x12notifications_sent\x18\x05 \x01(\x05R\x11notificationsSent\"e\n" +
+	"\x12GetOrgUsageRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x03 \x01(\tR\aendDate\"\xc8\x01\n" +
+	"\x13GetOrgUsageResponse\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x03 \x01(\tR\aendDate\x12-\n" +
+	"\x04days\x18\x04 \x03(\v2\x19.organization.OrgUsageDayR\x04days\x121\n" +
+	"\x06totals\x18\x05 \x01(\v2\x19.organization.OrgUsageDayR\x06totals2\xf9N\n" +
 	"\x13OrganizationService\x12\x8b\x01\n" +
 	"\x0eListOrgMembers\x12#.organization.ListOrgMembersRequest\x1a$.organization.ListOrgMembersResponse\".\x82\xd3\xe4\x93\x02(\x12&/api/v1/organizations/{org_id}/members\x12\x80\x01\n" +
 	"\n" +
@@ -5050,19 +11794,30 @@ const file_organization_proto_rawDesc = "" +
 	"UpdateTeam\x12\x1f.organization.UpdateTeamRequest\x1a .organization.UpdateTeamResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\x1a\x17/api/v1/teams/{team_id}\x12p\n" +
 	"\n" +
 	"DeleteTeam\x12\x1f.organization.DeleteTeamRequest\x1a .organization.DeleteTeamResponse\"\x1f\x82\xd3\xe4\x93\x02\x19*\x17/api/v1/teams/{team_id}\x12\x84\x01\n" +
-	"\rAddTeamMember\x12\".organization.AddTeamMemberRequest\x1a#.organization.AddTeamMemberResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/teams/{team_id}/members\x12\x94\x01\n" +
+	"\rAddTeamMember\x12\".organization.AddTeamMemberRequest\x1a#.organization.AddTeamMemberResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/teams/{team_id}/members\x12\x98\x01\n" +
+	"\x12BulkAddTeamMembers\x12'.organization.BulkAddTeamMembersRequest\x1a(.organization.BulkAddTeamMembersResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/teams/{team_id}/members/bulk\x12\x94\x01\n" +
 	"\x10RemoveTeamMember\x12%.organization.RemoveTeamMemberRequest\x1a&.organization.RemoveTeamMemberResponse\"1\x82\xd3\xe4\x93\x02+*)/api/v1/teams/{team_id}/members/{user_id}\x12\x87\x01\n" +
-	"\x0fListTeamMembers\x12$.organization.ListTeamMembersRequest\x1a%.organization.ListTeamMembersResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/teams/{team_id}/members\x12\x8c\x01\n" +
+	"\x0fListTeamMembers\x12$.organization.ListTeamMembersRequest\x1a%.organization.ListTeamMembersResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/teams/{team_id}/members\x12\xa7\x01\n" +
+	"\x15TransferTeamOwnership\x12*.organization.TransferTeamOwnershipRequest\x1a+.organization.TransferTeamOwnershipResponse\"5\x82\xd3\xe4\x93\x02/:\x01*\"*/api/v1/teams/{team_id}/transfer-ownership\x12\x8c\x01\n" +
 	"\rCreateProject\x12\".organization.CreateProjectRequest\x1a#.organization.CreateProjectResponse\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/api/v1/organizations/{org_id}/projects\x12v\n" +
 	"\n" +
 	"GetProject\x12\x1f.organization.GetProjectRequest\x1a .organization.GetProjectResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/projects/{project_id}\x12\x86\x01\n" +
 	"\fListProjects\x12!.organization.ListProjectsRequest\x1a\".organization.ListProjectsResponse\"/\x82\xd3\xe4\x93\x02)\x12'/api/v1/organizations/{org_id}/projects\x12\x82\x01\n" +
-	"\rUpdateProject\x12\".organization.UpdateProjectRequest\x1a#.organization.UpdateProjectResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\x1a\x1d/api/v1/projects/{project_id}\x12\x7f\n" +
+	"\rUpdateProject\x12\".organization.UpdateProjectRequest\x1a#.organization.UpdateProjectResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\x1a\x1d/api/v1/projects/{project_id}\x12\x8d\x01\n" +
+	"\x0eArchiveProject\x12#.organization.ArchiveProjectRequest\x1a$.organization.ArchiveProjectResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/api/v1/projects/{project_id}/archive\x12\x95\x01\n" +
+	"\x10UnarchiveProject\x12%.organization.UnarchiveProjectRequest\x1a&.organization.UnarchiveProjectResponse\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/api/v1/projects/{project_id}/unarchive\x12\x7f\n" +
 	"\rDeleteProject\x12\".organization.DeleteProjectRequest\x1a#.organization.DeleteProjectResponse\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/api/v1/projects/{project_id}\x12\x9a\x01\n" +
 	"\x13AssignTeamToProject\x12(.organization.AssignTeamToProjectRequest\x1a).organization.AssignTeamToProjectResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/api/v1/projects/{project_id}/teams\x12\xa7\x01\n" +
 	"\x15RemoveTeamFromProject\x12*.organization.RemoveTeamFromProjectRequest\x1a+.organization.RemoveTeamFromProjectResponse\"5\x82\xd3\xe4\x93\x02/*-/api/v1/projects/{project_id}/teams/{team_id}\x12\x93\x01\n" +
-	"\x10AddProjectMember\x12%.organization.AddProjectMemberRequest\x1a&.organization.AddProjectMemberResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/api/v1/projects/{project_id}/members\x12\xa3\x01\n" +
-	"\x13RemoveProjectMember\x12(.organization.RemoveProjectMemberRequest\x1a).organization.RemoveProjectMemberResponse\"7\x82\xd3\xe4\x93\x021*//api/v1/projects/{project_id}/members/{user_id}\x12\x84\x01\n" +
+	"\x10AddProjectMember\x12%.organization.AddProjectMemberRequest\x1a&.organization.AddProjectMemberResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/api/v1/projects/{project_id}/members\x12\xa7\x01\n" +
+	"\x15BulkAddProjectMembers\x12*.organization.BulkAddProjectMembersRequest\x1a+.organization.BulkAddProjectMembersResponse\"5\x82\xd3\xe4\x93\x02/:\x01*\"*/api/v1/projects/{project_id}/members/bulk\x12\xa3\x01\n" +
+	"\x13RemoveProjectMember\x12(.organization.RemoveProjectMemberRequest\x1a).organization.RemoveProjectMemberResponse\"7\x82\xd3\xe4\x93\x021*//api/v1/projects/{project_id}/members/{user_id}\x12\xae\x01\n" +
+	"\x16TransferProjectManager\x12+.organization.TransferProjectManagerRequest\x1a,.organization.TransferProjectManagerResponse\"9\x82\xd3\xe4\x93\x023:\x01*\"./api/v1/projects/{project_id}/transfer-manager\x12\x97\x01\n" +
+	"\x12GetProjectTimeline\x12'.organization.GetProjectTimelineRequest\x1a(.organization.GetProjectTimelineResponse\".\x82\xd3\xe4\x93\x02(\x12&/api/v1/projects/{project_id}/timeline\x12\xb5\x01\n" +
+	"\x19SubmitProjectStatusReport\x12..organization.SubmitProjectStatusReportRequest\x1a/.organization.SubmitProjectStatusReportResponse\"7\x82\xd3\xe4\x93\x021:\x01*\",/api/v1/projects/{project_id}/status-reports\x12\xaf\x01\n" +
+	"\x18ListProjectStatusReports\x12-.organization.ListProjectStatusReportsRequest\x1a..organization.ListProjectStatusReportsResponse\"4\x82\xd3\xe4\x93\x02.\x12,/api/v1/projects/{project_id}/status-reports\x12\x97\x01\n" +
+	"\x12GetProjectActivity\x12'.organization.GetProjectActivityRequest\x1a(.organization.GetProjectActivityResponse\".\x82\xd3\xe4\x93\x02(\x12&/api/v1/projects/{project_id}/activity\x12\xb7\x01\n" +
+	"\x18CompleteProjectMilestone\x12-.organization.CompleteProjectMilestoneRequest\x1a..organization.CompleteProjectMilestoneResponse\"<\x82\xd3\xe4\x93\x026:\x01*\"1/api/v1/projects/{project_id}/milestones/complete\x12\x84\x01\n" +
 	"\vCreateGroup\x12 .organization.CreateGroupRequest\x1a!.organization.CreateGroupResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/api/v1/organizations/{org_id}/groups\x12l\n" +
 	"\bGetGroup\x12\x1d.organization.GetGroupRequest\x1a\x1e.organization.GetGroupResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/groups/{group_id}\x12~\n" +
 	"\n" +
@@ -5070,12 +11825,38 @@ const file_organization_proto_rawDesc = "" +
 	"\vUpdateGroup\x12 .organization.UpdateGroupRequest\x1a!.organization.UpdateGroupResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\x1a\x19/api/v1/groups/{group_id}\x12u\n" +
 	"\vDeleteGroup\x12 .organization.DeleteGroupRequest\x1a!.organization.DeleteGroupResponse\"!\x82\xd3\xe4\x93\x02\x1b*\x19/api/v1/groups/{group_id}\x12\x89\x01\n" +
 	"\x0eAddGroupMember\x12#.organization.AddGroupMemberRequest\x1a$.organization.AddGroupMemberResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/groups/{group_id}/members\x12\x99\x01\n" +
-	"\x11RemoveGroupMember\x12&.organization.RemoveGroupMemberRequest\x1a'.organization.RemoveGroupMemberResponse\"3\x82\xd3\xe4\x93\x02-*+/api/v1/groups/{group_id}/members/{user_id}\x12\x94\x01\n" +
+	"\x11RemoveGroupMember\x12&.organization.RemoveGroupMemberRequest\x1a'.organization.RemoveGroupMemberResponse\"3\x82\xd3\xe4\x93\x02-*+/api/v1/groups/{group_id}/members/{user_id}\x12\xa0\x01\n" +
+	"\x12TransferGroupOwner\x12'.organization.TransferGroupOwnerRequest\x1a(.organization.TransferGroupOwnerResponse\"7\x82\xd3\xe4\x93\x021:\x01*\",/api/v1/groups/{group_id}/transfer-ownership\x12\x94\x01\n" +
 	"\x0fCreateWorkspace\x12$.organization.CreateWorkspaceRequest\x1a%.organization.CreateWorkspaceResponse\"4\x82\xd3\xe4\x93\x02.:\x01*\")/api/v1/organizations/{org_id}/workspaces\x12\x80\x01\n" +
 	"\fGetWorkspace\x12!.organization.GetWorkspaceRequest\x1a\".organization.GetWorkspaceResponse\")\x82\xd3\xe4\x93\x02#\x12!/api/v1/workspaces/{workspace_id}\x12\x8e\x01\n" +
 	"\x0eListWorkspaces\x12#.organization.ListWorkspacesRequest\x1a$.organization.ListWorkspacesResponse\"1\x82\xd3\xe4\x93\x02+\x12)/api/v1/organizations/{org_id}/workspaces\x12\x8c\x01\n" +
 	"\x0fUpdateWorkspace\x12$.organization.UpdateWorkspaceRequest\x1a%.organization.UpdateWorkspaceResponse\",\x82\xd3\xe4\x93\x02&:\x01*\x1a!/api/v1/workspaces/{workspace_id}\x12\x89\x01\n" +
-	"\x0fDeleteWorkspace\x12$.organization.DeleteWorkspaceRequest\x1a%.organization.DeleteWorkspaceResponse\")\x82\xd3\xe4\x93\x02#*!/api/v1/workspaces/{workspace_id}BEZCgithub.com/chanduchitikam/task-management-system/proto/organizationb\x06proto3"
+	"\x0fDeleteWorkspace\x12$.organization.DeleteWorkspaceRequest\x1a%.organization.DeleteWorkspaceResponse\")\x82\xd3\xe4\x93\x02#*!/api/v1/workspaces/{workspace_id}\x12\x9d\x01\n" +
+	"\x12AddWorkspaceMember\x12'.organization.AddWorkspaceMemberRequest\x1a(.organization.AddWorkspaceMemberResponse\"4\x82\xd3\xe4\x93\x02.:\x01*\")/api/v1/workspaces/{workspace_id}/members\x12\xad\x01\n" +
+	"\x15RemoveWorkspaceMember\x12*.organization.RemoveWorkspaceMemberRequest\x1a+.organization.RemoveWorkspaceMemberResponse\";\x82\xd3\xe4\x93\x025*3/api/v1/workspaces/{workspace_id}/members/{user_id}\x12\xa0\x01\n" +
+	"\x14ListWorkspaceMembers\x12).organization.ListWorkspaceMembersRequest\x1a*.organization.ListWorkspaceMembersResponse\"1\x82\xd3\xe4\x93\x02+\x12)/api/v1/workspaces/{workspace_id}/members\x12\xa3\x01\n" +
+	"\x15CreateProjectTemplate\x12*.organization.CreateProjectTemplateRequest\x1a+.organization.CreateProjectTemplateResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/api/v1/projects/{project_id}/template\x12\x98\x01\n" +
+	"\x12GetProjectTemplate\x12'.organization.GetProjectTemplateRequest\x1a(.organization.GetProjectTemplateResponse\"/\x82\xd3\xe4\x93\x02)\x12'/api/v1/project-templates/{template_id}\x12\xa7\x01\n" +
+	"\x14ListProjectTemplates\x12).organization.ListProjectTemplatesRequest\x1a*.organization.ListProjectTemplatesResponse\"8\x82\xd3\xe4\x93\x022\x120/api/v1/organizations/{org_id}/project-templates\x12\xa1\x01\n" +
+	"\x15DeleteProjectTemplate\x12*.organization.DeleteProjectTemplateRequest\x1a+.organization.DeleteProjectTemplateResponse\"/\x82\xd3\xe4\x93\x02)*'/api/v1/project-templates/{template_id}\x12\xb9\x01\n" +
+	"\x19CreateProjectFromTemplate\x12..organization.CreateProjectFromTemplateRequest\x1a/.organization.CreateProjectFromTemplateResponse\";\x82\xd3\xe4\x93\x025:\x01*\"0/api/v1/project-templates/{template_id}/projects\x12\xb5\x01\n" +
+	"\x17CreateWorkspaceTemplate\x12,.organization.CreateWorkspaceTemplateRequest\x1a-.organization.CreateWorkspaceTemplateResponse\"=\x82\xd3\xe4\x93\x027:\x01*\"2/api/v1/organizations/{org_id}/workspace-templates\x12\xa0\x01\n" +
+	"\x14GetWorkspaceTemplate\x12).organization.GetWorkspaceTemplateRequest\x1a*.organization.GetWorkspaceTemplateResponse\"1\x82\xd3\xe4\x93\x02+\x12)/api/v1/workspace-templates/{template_id}\x12\xaf\x01\n" +
+	"\x16ListWorkspaceTemplates\x12+.organization.ListWorkspaceTemplatesRequest\x1a,.organization.ListWorkspaceTemplatesResponse\":\x82\xd3\xe4\x93\x024\x122/api/v1/organizations/{org_id}/workspace-templates\x12\xa9\x01\n" +
+	"\x17DeleteWorkspaceTemplate\x12,.organization.DeleteWorkspaceTemplateRequest\x1a-.organization.DeleteWorkspaceTemplateResponse\"1\x82\xd3\xe4\x93\x02+*)/api/v1/workspace-templates/{template_id}\x12\xc3\x01\n" +
+	"\x1bCreateWorkspaceFromTemplate\x120.organization.CreateWorkspaceFromTemplateRequest\x1a1.organization.CreateWorkspaceFromTemplateResponse\"?\x82\xd3\xe4\x93\x029:\x01*\"4/api/v1/workspace-templates/{template_id}/workspaces\x12\x99\x01\n" +
+	"\x10CreateCustomRole\x12%.organization.CreateCustomRoleRequest\x1a&.organization.CreateCustomRoleResponse\"6\x82\xd3\xe4\x93\x020:\x01*\"+/api/v1/organizations/{org_id}/custom-roles\x12\x93\x01\n" +
+	"\x0fListCustomRoles\x12$.organization.ListCustomRolesRequest\x1a%.organization.ListCustomRolesResponse\"3\x82\xd3\xe4\x93\x02-\x12+/api/v1/organizations/{org_id}/custom-roles\x12\x8c\x01\n" +
+	"\x10UpdateCustomRole\x12%.organization.UpdateCustomRoleRequest\x1a&.organization.UpdateCustomRoleResponse\")\x82\xd3\xe4\x93\x02#:\x01*\x1a\x1e/api/v1/custom-roles/{role_id}\x12\x89\x01\n" +
+	"\x10DeleteCustomRole\x12%.organization.DeleteCustomRoleRequest\x1a&.organization.DeleteCustomRoleResponse\"&\x82\xd3\xe4\x93\x02 *\x1e/api/v1/custom-roles/{role_id}\x12\x98\x01\n" +
+	"\x10AssignCustomRole\x12%.organization.AssignCustomRoleRequest\x1a&.organization.AssignCustomRoleResponse\"5\x82\xd3\xe4\x93\x02/:\x01*\"*/api/v1/custom-roles/{role_id}/assignments\x12\xa0\x01\n" +
+	"\x12UnassignCustomRole\x12'.organization.UnassignCustomRoleRequest\x1a(.organization.UnassignCustomRoleResponse\"7\x82\xd3\xe4\x93\x021*//api/v1/custom-role-assignments/{assignment_id}\x12\xbc\x01\n" +
+	"\x19ListCustomRoleAssignments\x12..organization.ListCustomRoleAssignmentsRequest\x1a/.organization.ListCustomRoleAssignmentsResponse\">\x82\xd3\xe4\x93\x028\x126/api/v1/organizations/{org_id}/custom-role-assignments\x12\x95\x01\n" +
+	"\x11GetCapacityReport\x12&.organization.GetCapacityReportRequest\x1a'.organization.GetCapacityReportResponse\"/\x82\xd3\xe4\x93\x02)\x12'/api/v1/organizations/{org_id}/capacity\x12\x96\x01\n" +
+	"\x12SearchOrganization\x12'.organization.SearchOrganizationRequest\x1a(.organization.SearchOrganizationResponse\"-\x82\xd3\xe4\x93\x02'\x12%/api/v1/organizations/{org_id}/search\x12\x94\x01\n" +
+	"\x11GetMetadataSchema\x12&.organization.GetMetadataSchemaRequest\x1a'.organization.GetMetadataSchemaResponse\".\x82\xd3\xe4\x93\x02(\x12&/api/v1/metadata-schemas/{entity_type}\x12\x80\x01\n" +
+	"\vGetOrgUsage\x12 .organization.GetOrgUsageRequest\x1a!.organization.GetOrgUsageResponse\",\x82\xd3\xe4\x93\x02&\x12$/api/v1/organizations/{org_id}/usage\x12[\n" +
+	"\x0eRecordOrgUsage\x12#.organization.RecordOrgUsageRequest\x1a$.organization.RecordOrgUsageResponseBEZCgithub.com/chanduchitikam/task-management-system/proto/organizationb\x06proto3"
 
 var (
 	file_organization_proto_rawDescOnce sync.Once
@@ -5089,190 +11870,428 @@ func file_organization_proto_rawDescGZIP() []byte {
 	return file_organization_proto_rawDescData
 }
 
-var file_organization_proto_msgTypes = make([]protoimpl.MessageInfo, 72)
+var file_organization_proto_msgTypes = make([]protoimpl.MessageInfo, 170)
 var file_organization_proto_goTypes = []any{
-	(*Team)(nil),                          // 0: organization.Team
-	(*TeamLead)(nil),                      // 1: organization.TeamLead
-	(*TeamMember)(nil),                    // 2: organization.TeamMember
-	(*CreateTeamRequest)(nil),             // 3: organization.CreateTeamRequest
-	(*CreateTeamResponse)(nil),            // 4: organization.CreateTeamResponse
-	(*GetTeamRequest)(nil),                // 5: organization.GetTeamRequest
-	(*GetTeamResponse)(nil),               // 6: organization.GetTeamResponse
-	(*ListTeamsRequest)(nil),              // 7: organization.ListTeamsRequest
-	(*ListTeamsResponse)(nil),             // 8: organization.ListTeamsResponse
-	(*UpdateTeamRequest)(nil),             // 9: organization.UpdateTeamRequest
-	(*UpdateTeamResponse)(nil),            // 10: organization.UpdateTeamResponse
-	(*DeleteTeamRequest)(nil),             // 11: organization.DeleteTeamRequest
-	(*DeleteTeamResponse)(nil),            // 12: organization.DeleteTeamResponse
-	(*AddTeamMemberRequest)(nil),          // 13: organization.AddTeamMemberRequest
-	(*AddTeamMemberResponse)(nil),         // 14: organization.AddTeamMemberResponse
-	(*RemoveTeamMemberRequest)(nil),       // 15: organization.RemoveTeamMemberRequest
-	(*RemoveTeamMemberResponse)(nil),      // 16: organization.RemoveTeamMemberResponse
-	(*ListTeamMembersRequest)(nil),        // 17: organization.ListTeamMembersRequest
-	(*ListTeamMembersResponse)(nil),       // 18: organization.ListTeamMembersResponse
-	(*Project)(nil),                       // 19: organization.Project
-	(*ProjectManager)(nil),                // 20: organization.ProjectManager
-	(*ProjectTeam)(nil),                   // 21: organization.ProjectTeam
-	(*ProjectMember)(nil),                 // 22: organization.ProjectMember
-	(*CreateProjectRequest)(nil),          // 23: organization.CreateProjectRequest
-	(*CreateProjectResponse)(nil),         // 24: organization.CreateProjectResponse
-	(*GetProjectRequest)(nil),             // 25: organization.GetProjectRequest
-	(*GetProjectResponse)(nil),            // 26: organization.GetProjectResponse
-	(*ListProjectsRequest)(nil),           // 27: organization.ListProjectsRequest
-	(*ListProjectsResponse)(nil),          // 28: organization.ListProjectsResponse
-	(*UpdateProjectRequest)(nil),          // 29: organization.UpdateProjectRequest
-	(*UpdateProjectResponse)(nil),         // 30: organization.UpdateProjectResponse
-	(*DeleteProjectRequest)(nil),          // 31: organization.DeleteProjectRequest
-	(*DeleteProjectResponse)(nil),         // 32: organization.DeleteProjectResponse
-	(*AssignTeamToProjectRequest)(nil),    // 33: organization.AssignTeamToProjectRequest
-	(*AssignTeamToProjectResponse)(nil),   // 34: organization.AssignTeamToProjectResponse
-	(*RemoveTeamFromProjectRequest)(nil),  // 35: organization.RemoveTeamFromProjectRequest
-	(*RemoveTeamFromProjectResponse)(nil), // 36: organization.RemoveTeamFromProjectResponse
-	(*AddProjectMemberRequest)(nil),       // 37: organization.AddProjectMemberRequest
-	(*AddProjectMemberResponse)(nil),      // 38: organization.AddProjectMemberResponse
-	(*RemoveProjectMemberRequest)(nil),    // 39: organization.RemoveProjectMemberRequest
-	(*RemoveProjectMemberResponse)(nil),   // 40: organization.RemoveProjectMemberResponse
-	(*Group)(nil),                         // 41: organization.Group
-	(*GroupOwner)(nil),                    // 42: organization.GroupOwner
-	(*GroupMember)(nil),                   // 43: organization.GroupMember
-	(*CreateGroupRequest)(nil),            // 44: organization.CreateGroupRequest
-	(*CreateGroupResponse)(nil),           // 45: organization.CreateGroupResponse
-	(*GetGroupRequest)(nil),               // 46: organization.GetGroupRequest
-	(*GetGroupResponse)(nil),              // 47: organization.GetGroupResponse
-	(*ListGroupsRequest)(nil),             // 48: organization.ListGroupsRequest
-	(*ListGroupsResponse)(nil),            // 49: organization.ListGroupsResponse
-	(*UpdateGroupRequest)(nil),            // 50: organization.UpdateGroupRequest
-	(*UpdateGroupResponse)(nil),           // 51: organization.UpdateGroupResponse
-	(*DeleteGroupRequest)(nil),            // 52: organization.DeleteGroupRequest
-	(*DeleteGroupResponse)(nil),           // 53: organization.DeleteGroupResponse
-	(*AddGroupMemberRequest)(nil),         // 54: organization.AddGroupMemberRequest
-	(*AddGroupMemberResponse)(nil),        // 55: organization.AddGroupMemberResponse
-	(*RemoveGroupMemberRequest)(nil),      // 56: organization.RemoveGroupMemberRequest
-	(*RemoveGroupMemberResponse)(nil),     // 57: organization.RemoveGroupMemberResponse
-	(*OrgMember)(nil),                     // 58: organization.OrgMember
-	(*ListOrgMembersRequest)(nil),         // 59: organization.ListOrgMembersRequest
-	(*ListOrgMembersResponse)(nil),        // 60: organization.ListOrgMembersResponse
-	(*Workspace)(nil),                     // 61: organization.Workspace
-	(*CreateWorkspaceRequest)(nil),        // 62: organization.CreateWorkspaceRequest
-	(*CreateWorkspaceResponse)(nil),       // 63: organization.CreateWorkspaceResponse
-	(*ListWorkspacesRequest)(nil),         // 64: organization.ListWorkspacesRequest
-	(*ListWorkspacesResponse)(nil),        // 65: organization.ListWorkspacesResponse
-	(*GetWorkspaceRequest)(nil),           // 66: organization.GetWorkspaceRequest
-	(*GetWorkspaceResponse)(nil),          // 67: organization.GetWorkspaceResponse
-	(*UpdateWorkspaceRequest)(nil),        // 68: organization.UpdateWorkspaceRequest
-	(*UpdateWorkspaceResponse)(nil),       // 69: organization.UpdateWorkspaceResponse
-	(*DeleteWorkspaceRequest)(nil),        // 70: organization.DeleteWorkspaceRequest
-	(*DeleteWorkspaceResponse)(nil),       // 71: organization.DeleteWorkspaceResponse
-	(*timestamppb.Timestamp)(nil),         // 72: google.protobuf.Timestamp
+	(*Team)(nil),                                // 0: organization.Team
+	(*TeamLead)(nil),                            // 1: organization.TeamLead
+	(*TeamMember)(nil),                          // 2: organization.TeamMember
+	(*CreateTeamRequest)(nil),                   // 3: organization.CreateTeamRequest
+	(*CreateTeamResponse)(nil),                  // 4: organization.CreateTeamResponse
+	(*GetTeamRequest)(nil),                      // 5: organization.GetTeamRequest
+	(*GetTeamResponse)(nil),                     // 6: organization.GetTeamResponse
+	(*ListTeamsRequest)(nil),                    // 7: organization.ListTeamsRequest
+	(*ListTeamsResponse)(nil),                   // 8: organization.ListTeamsResponse
+	(*UpdateTeamRequest)(nil),                   // 9: organization.UpdateTeamRequest
+	(*UpdateTeamResponse)(nil),                  // 10: organization.UpdateTeamResponse
+	(*DeleteTeamRequest)(nil),                   // 11: organization.DeleteTeamRequest
+	(*DeleteTeamResponse)(nil),                  // 12: organization.DeleteTeamResponse
+	(*AddTeamMemberRequest)(nil),                // 13: organization.AddTeamMemberRequest
+	(*AddTeamMemberResponse)(nil),               // 14: organization.AddTeamMemberResponse
+	(*RemoveTeamMemberRequest)(nil),             // 15: organization.RemoveTeamMemberRequest
+	(*RemoveTeamMemberResponse)(nil),            // 16: organization.RemoveTeamMemberResponse
+	(*BulkMemberItem)(nil),                      // 17: organization.BulkMemberItem
+	(*BulkMemberResult)(nil),                    // 18: organization.BulkMemberResult
+	(*BulkAddTeamMembersRequest)(nil),           // 19: organization.BulkAddTeamMembersRequest
+	(*BulkAddTeamMembersResponse)(nil),          // 20: organization.BulkAddTeamMembersResponse
+	(*ListTeamMembersRequest)(nil),              // 21: organization.ListTeamMembersRequest
+	(*ListTeamMembersResponse)(nil),             // 22: organization.ListTeamMembersResponse
+	(*TransferTeamOwnershipRequest)(nil),        // 23: organization.TransferTeamOwnershipRequest
+	(*TransferTeamOwnershipResponse)(nil),       // 24: organization.TransferTeamOwnershipResponse
+	(*Project)(nil),                             // 25: organization.Project
+	(*ProjectManager)(nil),                      // 26: organization.ProjectManager
+	(*ProjectTeam)(nil),                         // 27: organization.ProjectTeam
+	(*ProjectMember)(nil),                       // 28: organization.ProjectMember
+	(*CreateProjectRequest)(nil),                // 29: organization.CreateProjectRequest
+	(*CreateProjectResponse)(nil),               // 30: organization.CreateProjectResponse
+	(*GetProjectRequest)(nil),                   // 31: organization.GetProjectRequest
+	(*GetProjectResponse)(nil),                  // 32: organization.GetProjectResponse
+	(*ListProjectsRequest)(nil),                 // 33: organization.ListProjectsRequest
+	(*ListProjectsResponse)(nil),                // 34: organization.ListProjectsResponse
+	(*UpdateProjectRequest)(nil),                // 35: organization.UpdateProjectRequest
+	(*UpdateProjectResponse)(nil),               // 36: organization.UpdateProjectResponse
+	(*ArchiveProjectRequest)(nil),               // 37: organization.ArchiveProjectRequest
+	(*ArchiveProjectResponse)(nil),              // 38: organization.ArchiveProjectResponse
+	(*UnarchiveProjectRequest)(nil),             // 39: organization.UnarchiveProjectRequest
+	(*UnarchiveProjectResponse)(nil),            // 40: organization.UnarchiveProjectResponse
+	(*DeleteProjectRequest)(nil),                // 41: organization.DeleteProjectRequest
+	(*DeleteProjectResponse)(nil),               // 42: organization.DeleteProjectResponse
+	(*AssignTeamToProjectRequest)(nil),          // 43: organization.AssignTeamToProjectRequest
+	(*AssignTeamToProjectResponse)(nil),         // 44: organization.AssignTeamToProjectResponse
+	(*RemoveTeamFromProjectRequest)(nil),        // 45: organization.RemoveTeamFromProjectRequest
+	(*RemoveTeamFromProjectResponse)(nil),       // 46: organization.RemoveTeamFromProjectResponse
+	(*AddProjectMemberRequest)(nil),             // 47: organization.AddProjectMemberRequest
+	(*AddProjectMemberResponse)(nil),            // 48: organization.AddProjectMemberResponse
+	(*RemoveProjectMemberRequest)(nil),          // 49: organization.RemoveProjectMemberRequest
+	(*RemoveProjectMemberResponse)(nil),         // 50: organization.RemoveProjectMemberResponse
+	(*BulkAddProjectMembersRequest)(nil),        // 51: organization.BulkAddProjectMembersRequest
+	(*BulkAddProjectMembersResponse)(nil),       // 52: organization.BulkAddProjectMembersResponse
+	(*TransferProjectManagerRequest)(nil),       // 53: organization.TransferProjectManagerRequest
+	(*TransferProjectManagerResponse)(nil),      // 54: organization.TransferProjectManagerResponse
+	(*ProjectMilestone)(nil),                    // 55: organization.ProjectMilestone
+	(*TimelineTask)(nil),                        // 56: organization.TimelineTask
+	(*TimelineDependency)(nil),                  // 57: organization.TimelineDependency
+	(*GetProjectTimelineRequest)(nil),           // 58: organization.GetProjectTimelineRequest
+	(*GetProjectTimelineResponse)(nil),          // 59: organization.GetProjectTimelineResponse
+	(*ProjectStatusReport)(nil),                 // 60: organization.ProjectStatusReport
+	(*SubmitProjectStatusReportRequest)(nil),    // 61: organization.SubmitProjectStatusReportRequest
+	(*SubmitProjectStatusReportResponse)(nil),   // 62: organization.SubmitProjectStatusReportResponse
+	(*ListProjectStatusReportsRequest)(nil),     // 63: organization.ListProjectStatusReportsRequest
+	(*ListProjectStatusReportsResponse)(nil),    // 64: organization.ListProjectStatusReportsResponse
+	(*ProjectActivity)(nil),                     // 65: organization.ProjectActivity
+	(*GetProjectActivityRequest)(nil),           // 66: organization.GetProjectActivityRequest
+	(*GetProjectActivityResponse)(nil),          // 67: organization.GetProjectActivityResponse
+	(*CompleteProjectMilestoneRequest)(nil),     // 68: organization.CompleteProjectMilestoneRequest
+	(*CompleteProjectMilestoneResponse)(nil),    // 69: organization.CompleteProjectMilestoneResponse
+	(*Group)(nil),                               // 70: organization.Group
+	(*GroupOwner)(nil),                          // 71: organization.GroupOwner
+	(*GroupMember)(nil),                         // 72: organization.GroupMember
+	(*CreateGroupRequest)(nil),                  // 73: organization.CreateGroupRequest
+	(*CreateGroupResponse)(nil),                 // 74: organization.CreateGroupResponse
+	(*GetGroupRequest)(nil),                     // 75: organization.GetGroupRequest
+	(*GetGroupResponse)(nil),                    // 76: organization.GetGroupResponse
+	(*ListGroupsRequest)(nil),                   // 77: organization.ListGroupsRequest
+	(*ListGroupsResponse)(nil),                  // 78: organization.ListGroupsResponse
+	(*UpdateGroupRequest)(nil),                  // 79: organization.UpdateGroupRequest
+	(*UpdateGroupResponse)(nil),                 // 80: organization.UpdateGroupResponse
+	(*DeleteGroupRequest)(nil),                  // 81: organization.DeleteGroupRequest
+	(*DeleteGroupResponse)(nil),                 // 82: organization.DeleteGroupResponse
+	(*AddGroupMemberRequest)(nil),               // 83: organization.AddGroupMemberRequest
+	(*AddGroupMemberResponse)(nil),              // 84: organization.AddGroupMemberResponse
+	(*RemoveGroupMemberRequest)(nil),            // 85: organization.RemoveGroupMemberRequest
+	(*RemoveGroupMemberResponse)(nil),           // 86: organization.RemoveGroupMemberResponse
+	(*TransferGroupOwnerRequest)(nil),           // 87: organization.TransferGroupOwnerRequest
+	(*TransferGroupOwnerResponse)(nil),          // 88: organization.TransferGroupOwnerResponse
+	(*OrgMember)(nil),                           // 89: organization.OrgMember
+	(*ListOrgMembersRequest)(nil),               // 90: organization.ListOrgMembersRequest
+	(*ListOrgMembersResponse)(nil),              // 91: organization.ListOrgMembersResponse
+	(*Workspace)(nil),                           // 92: organization.Workspace
+	(*CreateWorkspaceRequest)(nil),              // 93: organization.CreateWorkspaceRequest
+	(*CreateWorkspaceResponse)(nil),             // 94: organization.CreateWorkspaceResponse
+	(*ListWorkspacesRequest)(nil),               // 95: organization.ListWorkspacesRequest
+	(*ListWorkspacesResponse)(nil),              // 96: organization.ListWorkspacesResponse
+	(*GetWorkspaceRequest)(nil),                 // 97: organization.GetWorkspaceRequest
+	(*GetWorkspaceResponse)(nil),                // 98: organization.GetWorkspaceResponse
+	(*UpdateWorkspaceRequest)(nil),              // 99: organization.UpdateWorkspaceRequest
+	(*UpdateWorkspaceResponse)(nil),             // 100: organization.UpdateWorkspaceResponse
+	(*DeleteWorkspaceRequest)(nil),              // 101: organization.DeleteWorkspaceRequest
+	(*DeleteWorkspaceResponse)(nil),             // 102: organization.DeleteWorkspaceResponse
+	(*WorkspaceMember)(nil),                     // 103: organization.WorkspaceMember
+	(*AddWorkspaceMemberRequest)(nil),           // 104: organization.AddWorkspaceMemberRequest
+	(*AddWorkspaceMemberResponse)(nil),          // 105: organization.AddWorkspaceMemberResponse
+	(*RemoveWorkspaceMemberRequest)(nil),        // 106: organization.RemoveWorkspaceMemberRequest
+	(*RemoveWorkspaceMemberResponse)(nil),       // 107: organization.RemoveWorkspaceMemberResponse
+	(*ListWorkspaceMembersRequest)(nil),         // 108: organization.ListWorkspaceMembersRequest
+	(*ListWorkspaceMembersResponse)(nil),        // 109: organization.ListWorkspaceMembersResponse
+	(*ProjectTemplateTask)(nil),                 // 110: organization.ProjectTemplateTask
+	(*ProjectTemplateMilestone)(nil),            // 111: organization.ProjectTemplateMilestone
+	(*ProjectTemplateWorkspace)(nil),            // 112: organization.ProjectTemplateWorkspace
+	(*ProjectTemplate)(nil),                     // 113: organization.ProjectTemplate
+	(*CreateProjectTemplateRequest)(nil),        // 114: organization.CreateProjectTemplateRequest
+	(*CreateProjectTemplateResponse)(nil),       // 115: organization.CreateProjectTemplateResponse
+	(*GetProjectTemplateRequest)(nil),           // 116: organization.GetProjectTemplateRequest
+	(*GetProjectTemplateResponse)(nil),          // 117: organization.GetProjectTemplateResponse
+	(*ListProjectTemplatesRequest)(nil),         // 118: organization.ListProjectTemplatesRequest
+	(*ListProjectTemplatesResponse)(nil),        // 119: organization.ListProjectTemplatesResponse
+	(*DeleteProjectTemplateRequest)(nil),        // 120: organization.DeleteProjectTemplateRequest
+	(*DeleteProjectTemplateResponse)(nil),       // 121: organization.DeleteProjectTemplateResponse
+	(*CreateProjectFromTemplateRequest)(nil),    // 122: organization.CreateProjectFromTemplateRequest
+	(*CreateProjectFromTemplateResponse)(nil),   // 123: organization.CreateProjectFromTemplateResponse
+	(*WorkspaceTemplateBoard)(nil),              // 124: organization.WorkspaceTemplateBoard
+	(*WorkspaceTemplateGroup)(nil),              // 125: organization.WorkspaceTemplateGroup
+	(*WorkspaceTemplate)(nil),                   // 126: organization.WorkspaceTemplate
+	(*CreateWorkspaceTemplateRequest)(nil),      // 127: organization.CreateWorkspaceTemplateRequest
+	(*CreateWorkspaceTemplateResponse)(nil),     // 128: organization.CreateWorkspaceTemplateResponse
+	(*GetWorkspaceTemplateRequest)(nil),         // 129: organization.GetWorkspaceTemplateRequest
+	(*GetWorkspaceTemplateResponse)(nil),        // 130: organization.GetWorkspaceTemplateResponse
+	(*ListWorkspaceTemplatesRequest)(nil),       // 131: organization.ListWorkspaceTemplatesRequest
+	(*ListWorkspaceTemplatesResponse)(nil),      // 132: organization.ListWorkspaceTemplatesResponse
+	(*DeleteWorkspaceTemplateRequest)(nil),      // 133: organization.DeleteWorkspaceTemplateRequest
+	(*DeleteWorkspaceTemplateResponse)(nil),     // 134: organization.DeleteWorkspaceTemplateResponse
+	(*CreateWorkspaceFromTemplateRequest)(nil),  // 135: organization.CreateWorkspaceFromTemplateRequest
+	(*CreateWorkspaceFromTemplateResponse)(nil), // 136: organization.CreateWorkspaceFromTemplateResponse
+	(*CustomRole)(nil),                          // 137: organization.CustomRole
+	(*CustomRoleAssignment)(nil),                // 138: organization.CustomRoleAssignment
+	(*CreateCustomRoleRequest)(nil),             // 139: organization.CreateCustomRoleRequest
+	(*CreateCustomRoleResponse)(nil),            // 140: organization.CreateCustomRoleResponse
+	(*ListCustomRolesRequest)(nil),              // 141: organization.ListCustomRolesRequest
+	(*ListCustomRolesResponse)(nil),             // 142: organization.ListCustomRolesResponse
+	(*UpdateCustomRoleRequest)(nil),             // 143: organization.UpdateCustomRoleRequest
+	(*UpdateCustomRoleResponse)(nil),            // 144: organization.UpdateCustomRoleResponse
+	(*DeleteCustomRoleRequest)(nil),             // 145: organization.DeleteCustomRoleRequest
+	(*DeleteCustomRoleResponse)(nil),            // 146: organization.DeleteCustomRoleResponse
+	(*AssignCustomRoleRequest)(nil),             // 147: organization.AssignCustomRoleRequest
+	(*AssignCustomRoleResponse)(nil),            // 148: organization.AssignCustomRoleResponse
+	(*UnassignCustomRoleRequest)(nil),           // 149: organization.UnassignCustomRoleRequest
+	(*UnassignCustomRoleResponse)(nil),          // 150: organization.UnassignCustomRoleResponse
+	(*ListCustomRoleAssignmentsRequest)(nil),    // 151: organization.ListCustomRoleAssignmentsRequest
+	(*ListCustomRoleAssignmentsResponse)(nil),   // 152: organization.ListCustomRoleAssignmentsResponse
+	(*ProjectAllocation)(nil),                   // 153: organization.ProjectAllocation
+	(*UserCapacity)(nil),                        // 154: organization.UserCapacity
+	(*GetCapacityReportRequest)(nil),            // 155: organization.GetCapacityReportRequest
+	(*GetCapacityReportResponse)(nil),           // 156: organization.GetCapacityReportResponse
+	(*SearchResult)(nil),                        // 157: organization.SearchResult
+	(*SearchOrganizationRequest)(nil),           // 158: organization.SearchOrganizationRequest
+	(*SearchOrganizationResponse)(nil),          // 159: organization.SearchOrganizationResponse
+	(*GetMetadataSchemaRequest)(nil),            // 160: organization.GetMetadataSchemaRequest
+	(*GetMetadataSchemaResponse)(nil),           // 161: organization.GetMetadataSchemaResponse
+	(*OrgUsageSample)(nil),                      // 162: organization.OrgUsageSample
+	(*RecordOrgUsageRequest)(nil),               // 163: organization.RecordOrgUsageRequest
+	(*RecordOrgUsageResponse)(nil),              // 164: organization.RecordOrgUsageResponse
+	(*OrgUsageDay)(nil),                         // 165: organization.OrgUsageDay
+	(*GetOrgUsageRequest)(nil),                  // 166: organization.GetOrgUsageRequest
+	(*GetOrgUsageResponse)(nil),                 // 167: organization.GetOrgUsageResponse
+	nil,                                         // 168: organization.ProjectActivity.MetadataEntry
+	nil,                                         // 169: organization.OrgMember.ProfileAttributesEntry
+	(*timestamppb.Timestamp)(nil),               // 170: google.protobuf.Timestamp
 }
 var file_organization_proto_depIdxs = []int32{
-	72, // 0: organization.Team.created_at:type_name -> google.protobuf.Timestamp
-	72, // 1: organization.Team.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 2: organization.Team.team_lead:type_name -> organization.TeamLead
-	2,  // 3: organization.Team.members:type_name -> organization.TeamMember
-	72, // 4: organization.TeamMember.joined_at:type_name -> google.protobuf.Timestamp
-	0,  // 5: organization.CreateTeamResponse.team:type_name -> organization.Team
-	0,  // 6: organization.GetTeamResponse.team:type_name -> organization.Team
-	0,  // 7: organization.ListTeamsResponse.teams:type_name -> organization.Team
-	0,  // 8: organization.UpdateTeamResponse.team:type_name -> organization.Team
-	2,  // 9: organization.AddTeamMemberResponse.member:type_name -> organization.TeamMember
-	2,  // 10: organization.ListTeamMembersResponse.members:type_name -> organization.TeamMember
-	72, // 11: organization.Project.created_at:type_name -> google.protobuf.Timestamp
-	72, // 12: organization.Project.updated_at:type_name -> google.protobuf.Timestamp
-	20, // 13: organization.Project.project_manager:type_name -> organization.ProjectManager
-	21, // 14: organization.Project.teams:type_name -> organization.ProjectTeam
-	22, // 15: organization.Project.members:type_name -> organization.ProjectMember
-	72, // 16: organization.ProjectTeam.assigned_at:type_name -> google.protobuf.Timestamp
-	72, // 17: organization.ProjectMember.joined_at:type_name -> google.protobuf.Timestamp
-	19, // 18: organization.CreateProjectResponse.project:type_name -> organization.Project
-	19, // 19: organization.GetProjectResponse.project:type_name -> organization.Project
-	19, // 20: organization.ListProjectsResponse.projects:type_name -> organization.Project
-	19, // 21: organization.UpdateProjectResponse.project:type_name -> organization.Project
-	21, // 22: organization.AssignTeamToProjectResponse.project_team:type_name -> organization.ProjectTeam
-	22, // 23: organization.AddProjectMemberResponse.member:type_name -> organization.ProjectMember
-	72, // 24: organization.Group.created_at:type_name -> google.protobuf.Timestamp
-	72, // 25: organization.Group.updated_at:type_name -> google.protobuf.Timestamp
-	42, // 26: organization.Group.owner:type_name -> organization.GroupOwner
-	43, // 27: organization.Group.members:type_name -> organization.GroupMember
-	72, // 28: organization.GroupMember.joined_at:type_name -> google.protobuf.Timestamp
-	41, // 29: organization.CreateGroupResponse.group:type_name -> organization.Group
-	41, // 30: organization.GetGroupResponse.group:type_name -> organization.Group
-	41, // 31: organization.ListGroupsResponse.groups:type_name -> organization.Group
-	41, // 32: organization.UpdateGroupResponse.group:type_name -> organization.Group
-	43, // 33: organization.AddGroupMemberResponse.member:type_name -> organization.GroupMember
-	72, // 34: organization.OrgMember.created_at:type_name -> google.protobuf.Timestamp
-	58, // 35: organization.ListOrgMembersResponse.members:type_name -> organization.OrgMember
-	72, // 36: organization.Workspace.created_at:type_name -> google.protobuf.Timestamp
-	72, // 37: organization.Workspace.updated_at:type_name -> google.protobuf.Timestamp
-	61, // 38: organization.CreateWorkspaceResponse.workspace:type_name -> organization.Workspace
-	61, // 39: organization.ListWorkspacesResponse.workspaces:type_name -> organization.Workspace
-	61, // 40: organization.GetWorkspaceResponse.workspace:type_name -> organization.Workspace
-	61, // 41: organization.UpdateWorkspaceResponse.workspace:type_name -> organization.Workspace
-	59, // 42: organization.OrganizationService.ListOrgMembers:input_type -> organization.ListOrgMembersRequest
-	3,  // 43: organization.OrganizationService.CreateTeam:input_type -> organization.CreateTeamRequest
-	5,  // 44: organization.OrganizationService.GetTeam:input_type -> organization.GetTeamRequest
-	7,  // 45: organization.OrganizationService.ListTeams:input_type -> organization.ListTeamsRequest
-	9,  // 46: organization.OrganizationService.UpdateTeam:input_type -> organization.UpdateTeamRequest
-	11, // 47: organization.OrganizationService.DeleteTeam:input_type -> organization.DeleteTeamRequest
-	13, // 48: organization.OrganizationService.AddTeamMember:input_type -> organization.AddTeamMemberRequest
-	15, // 49: organization.OrganizationService.RemoveTeamMember:input_type -> organization.RemoveTeamMemberRequest
-	17, // 50: organization.OrganizationService.ListTeamMembers:input_type -> organization.ListTeamMembersRequest
-	23, // 51: organization.OrganizationService.CreateProject:input_type -> organization.CreateProjectRequest
-	25, // 52: organization.OrganizationService.GetProject:input_type -> organization.GetProjectRequest
-	27, // 53: organization.OrganizationService.ListProjects:input_type -> organization.ListProjectsRequest
-	29, // 54: organization.OrganizationService.UpdateProject:input_type -> organization.UpdateProjectRequest
-	31, // 55: organization.OrganizationService.DeleteProject:input_type -> organization.DeleteProjectRequest
-	33, // 56: organization.OrganizationService.AssignTeamToProject:input_type -> organization.AssignTeamToProjectRequest
-	35, // 57: organization.OrganizationService.RemoveTeamFromProject:input_type -> organization.RemoveTeamFromProjectRequest
-	37, // 58: organization.OrganizationService.AddProjectMember:input_type -> organization.AddProjectMemberRequest
-	39, // 59: organization.OrganizationService.RemoveProjectMember:input_type -> organization.RemoveProjectMemberRequest
-	44, // 60: organization.OrganizationService.CreateGroup:input_type -> organization.CreateGroupRequest
-	46, // 61: organization.OrganizationService.GetGroup:input_type -> organization.GetGroupRequest
-	48, // 62: organization.OrganizationService.ListGroups:input_type -> organization.ListGroupsRequest
-	50, // 63: organization.OrganizationService.UpdateGroup:input_type -> organization.UpdateGroupRequest
-	52, // 64: organization.OrganizationService.DeleteGroup:input_type -> organization.DeleteGroupRequest
-	54, // 65: organization.OrganizationService.AddGroupMember:input_type -> organization.AddGroupMemberRequest
-	56, // 66: organization.OrganizationService.RemoveGroupMember:input_type -> organization.RemoveGroupMemberRequest
-	62, // 67: organization.OrganizationService.CreateWorkspace:input_type -> organization.CreateWorkspaceRequest
-	66, // 68: organization.OrganizationService.GetWorkspace:input_type -> organization.GetWorkspaceRequest
-	64, // 69: organization.OrganizationService.ListWorkspaces:input_type -> organization.ListWorkspacesRequest
-	68, // 70: organization.OrganizationService.UpdateWorkspace:input_type -> organization.UpdateWorkspaceRequest
-	70, // 71: organization.OrganizationService.DeleteWorkspace:input_type -> organization.DeleteWorkspaceRequest
-	60, // 72: organization.OrganizationService.ListOrgMembers:output_type -> organization.ListOrgMembersResponse
-	4,  // 73: organization.OrganizationService.CreateTeam:output_type -> organization.CreateTeamResponse
-	6,  // 74: organization.OrganizationService.GetTeam:output_type -> organization.GetTeamResponse
-	8,  // 75: organization.OrganizationService.ListTeams:output_type -> organization.ListTeamsResponse
-	10, // 76: organization.OrganizationService.UpdateTeam:output_type -> organization.UpdateTeamResponse
-	12, // 77: organization.OrganizationService.DeleteTeam:output_type -> organization.DeleteTeamResponse
-	14, // 78: organization.OrganizationService.AddTeamMember:output_type -> organization.AddTeamMemberResponse
-	16, // 79: organization.OrganizationService.RemoveTeamMember:output_type -> organization.RemoveTeamMemberResponse
-	18, // 80: organization.OrganizationService.ListTeamMembers:output_type -> organization.ListTeamMembersResponse
-	24, // 81: organization.OrganizationService.CreateProject:output_type -> organization.CreateProjectResponse
-	26, // 82: organization.OrganizationService.GetProject:output_type -> organization.GetProjectResponse
-	28, // 83: organization.OrganizationService.ListProjects:output_type -> organization.ListProjectsResponse
-	30, // 84: organization.OrganizationService.UpdateProject:output_type -> organization.UpdateProjectResponse
-	32, // 85: organization.OrganizationService.DeleteProject:output_type -> organization.DeleteProjectResponse
-	34, // 86: organization.OrganizationService.AssignTeamToProject:output_type -> organization.AssignTeamToProjectResponse
-	36, // 87: organization.OrganizationService.RemoveTeamFromProject:output_type -> organization.RemoveTeamFromProjectResponse
-	38, // 88: organization.OrganizationService.AddProjectMember:output_type -> organization.AddProjectMemberResponse
-	40, // 89: organization.OrganizationService.RemoveProjectMember:output_type -> organization.RemoveProjectMemberResponse
-	45, // 90: organization.OrganizationService.CreateGroup:output_type -> organization.CreateGroupResponse
-	47, // 91: organization.OrganizationService.GetGroup:output_type -> organization.GetGroupResponse
-	49, // 92: organization.OrganizationService.ListGroups:output_type -> organization.ListGroupsResponse
-	51, // 93: organization.OrganizationService.UpdateGroup:output_type -> organization.UpdateGroupResponse
-	53, // 94: organization.OrganizationService.DeleteGroup:output_type -> organization.DeleteGroupResponse
-	55, // 95: organization.OrganizationService.AddGroupMember:output_type -> organization.AddGroupMemberResponse
-	57, // 96: organization.OrganizationService.RemoveGroupMember:output_type -> organization.RemoveGroupMemberResponse
-	63, // 97: organization.OrganizationService.CreateWorkspace:output_type -> organization.CreateWorkspaceResponse
-	67, // 98: organization.OrganizationService.GetWorkspace:output_type -> organization.GetWorkspaceResponse
-	65, // 99: organization.OrganizationService.ListWorkspaces:output_type -> organization.ListWorkspacesResponse
-	69, // 100: organization.OrganizationService.UpdateWorkspace:output_type -> organization.UpdateWorkspaceResponse
-	71, // 101: organization.OrganizationService.DeleteWorkspace:output_type -> organization.DeleteWorkspaceResponse
-	72, // [72:102] is the sub-list for method output_type
-	42, // [42:72] is the sub-list for method input_type
-	42, // [42:42] is the sub-list for extension type_name
-	42, // [42:42] is the sub-list for extension extendee
-	0,  // [0:42] is the sub-list for field type_name
+	170, // 0: organization.Team.created_at:type_name -> google.protobuf.Timestamp
+	170, // 1: organization.Team.updated_at:type_name -> google.protobuf.Timestamp
+	1,   // 2: organization.Team.team_lead:type_name -> organization.TeamLead
+	2,   // 3: organization.Team.members:type_name -> organization.TeamMember
+	170, // 4: organization.TeamMember.joined_at:type_name -> google.protobuf.Timestamp
+	0,   // 5: organization.CreateTeamResponse.team:type_name -> organization.Team
+	0,   // 6: organization.GetTeamResponse.team:type_name -> organization.Team
+	0,   // 7: organization.ListTeamsResponse.teams:type_name -> organization.Team
+	0,   // 8: organization.UpdateTeamResponse.team:type_name -> organization.Team
+	2,   // 9: organization.AddTeamMemberResponse.member:type_name -> organization.TeamMember
+	17,  // 10: organization.BulkAddTeamMembersRequest.members:type_name -> organization.BulkMemberItem
+	18,  // 11: organization.BulkAddTeamMembersResponse.results:type_name -> organization.BulkMemberResult
+	2,   // 12: organization.ListTeamMembersResponse.members:type_name -> organization.TeamMember
+	0,   // 13: organization.TransferTeamOwnershipResponse.team:type_name -> organization.Team
+	170, // 14: organization.Project.created_at:type_name -> google.protobuf.Timestamp
+	170, // 15: organization.Project.updated_at:type_name -> google.protobuf.Timestamp
+	26,  // 16: organization.Project.project_manager:type_name -> organization.ProjectManager
+	27,  // 17: organization.Project.teams:type_name -> organization.ProjectTeam
+	28,  // 18: organization.Project.members:type_name -> organization.ProjectMember
+	170, // 19: organization.Project.archived_at:type_name -> google.protobuf.Timestamp
+	170, // 20: organization.ProjectTeam.assigned_at:type_name -> google.protobuf.Timestamp
+	170, // 21: organization.ProjectMember.joined_at:type_name -> google.protobuf.Timestamp
+	25,  // 22: organization.CreateProjectResponse.project:type_name -> organization.Project
+	25,  // 23: organization.GetProjectResponse.project:type_name -> organization.Project
+	25,  // 24: organization.ListProjectsResponse.projects:type_name -> organization.Project
+	25,  // 25: organization.UpdateProjectResponse.project:type_name -> organization.Project
+	25,  // 26: organization.ArchiveProjectResponse.project:type_name -> organization.Project
+	25,  // 27: organization.UnarchiveProjectResponse.project:type_name -> organization.Project
+	27,  // 28: organization.AssignTeamToProjectResponse.project_team:type_name -> organization.ProjectTeam
+	28,  // 29: organization.AddProjectMemberResponse.member:type_name -> organization.ProjectMember
+	17,  // 30: organization.BulkAddProjectMembersRequest.members:type_name -> organization.BulkMemberItem
+	18,  // 31: organization.BulkAddProjectMembersResponse.results:type_name -> organization.BulkMemberResult
+	25,  // 32: organization.TransferProjectManagerResponse.project:type_name -> organization.Project
+	170, // 33: organization.ProjectMilestone.completed_at:type_name -> google.protobuf.Timestamp
+	170, // 34: organization.TimelineTask.start:type_name -> google.protobuf.Timestamp
+	170, // 35: organization.TimelineTask.due_date:type_name -> google.protobuf.Timestamp
+	170, // 36: organization.TimelineTask.completed_at:type_name -> google.protobuf.Timestamp
+	55,  // 37: organization.GetProjectTimelineResponse.milestones:type_name -> organization.ProjectMilestone
+	56,  // 38: organization.GetProjectTimelineResponse.tasks:type_name -> organization.TimelineTask
+	57,  // 39: organization.GetProjectTimelineResponse.dependencies:type_name -> organization.TimelineDependency
+	170, // 40: organization.ProjectStatusReport.created_at:type_name -> google.protobuf.Timestamp
+	170, // 41: organization.ProjectStatusReport.updated_at:type_name -> google.protobuf.Timestamp
+	60,  // 42: organization.SubmitProjectStatusReportResponse.report:type_name -> organization.ProjectStatusReport
+	60,  // 43: organization.ListProjectStatusReportsResponse.reports:type_name -> organization.ProjectStatusReport
+	168, // 44: organization.ProjectActivity.metadata:type_name -> organization.ProjectActivity.MetadataEntry
+	170, // 45: organization.ProjectActivity.created_at:type_name -> google.protobuf.Timestamp
+	65,  // 46: organization.GetProjectActivityResponse.activities:type_name -> organization.ProjectActivity
+	55,  // 47: organization.CompleteProjectMilestoneResponse.milestone:type_name -> organization.ProjectMilestone
+	170, // 48: organization.Group.created_at:type_name -> google.protobuf.Timestamp
+	170, // 49: organization.Group.updated_at:type_name -> google.protobuf.Timestamp
+	71,  // 50: organization.Group.owner:type_name -> organization.GroupOwner
+	72,  // 51: organization.Group.members:type_name -> organization.GroupMember
+	170, // 52: organization.GroupMember.joined_at:type_name -> google.protobuf.Timestamp
+	70,  // 53: organization.CreateGroupResponse.group:type_name -> organization.Group
+	70,  // 54: organization.GetGroupResponse.group:type_name -> organization.Group
+	70,  // 55: organization.ListGroupsResponse.groups:type_name -> organization.Group
+	70,  // 56: organization.UpdateGroupResponse.group:type_name -> organization.Group
+	72,  // 57: organization.AddGroupMemberResponse.member:type_name -> organization.GroupMember
+	70,  // 58: organization.TransferGroupOwnerResponse.group:type_name -> organization.Group
+	170, // 59: organization.OrgMember.created_at:type_name -> google.protobuf.Timestamp
+	169, // 60: organization.OrgMember.profile_attributes:type_name -> organization.OrgMember.ProfileAttributesEntry
+	89,  // 61: organization.ListOrgMembersResponse.members:type_name -> organization.OrgMember
+	170, // 62: organization.Workspace.created_at:type_name -> google.protobuf.Timestamp
+	170, // 63: organization.Workspace.updated_at:type_name -> google.protobuf.Timestamp
+	92,  // 64: organization.CreateWorkspaceResponse.workspace:type_name -> organization.Workspace
+	92,  // 65: organization.ListWorkspacesResponse.workspaces:type_name -> organization.Workspace
+	92,  // 66: organization.GetWorkspaceResponse.workspace:type_name -> organization.Workspace
+	92,  // 67: organization.UpdateWorkspaceResponse.workspace:type_name -> organization.Workspace
+	170, // 68: organization.WorkspaceMember.joined_at:type_name -> google.protobuf.Timestamp
+	103, // 69: organization.AddWorkspaceMemberResponse.member:type_name -> organization.WorkspaceMember
+	103, // 70: organization.ListWorkspaceMembersResponse.members:type_name -> organization.WorkspaceMember
+	112, // 71: organization.ProjectTemplate.workspace:type_name -> organization.ProjectTemplateWorkspace
+	110, // 72: organization.ProjectTemplate.tasks:type_name -> organization.ProjectTemplateTask
+	111, // 73: organization.ProjectTemplate.milestones:type_name -> organization.ProjectTemplateMilestone
+	170, // 74: organization.ProjectTemplate.created_at:type_name -> google.protobuf.Timestamp
+	170, // 75: organization.ProjectTemplate.updated_at:type_name -> google.protobuf.Timestamp
+	110, // 76: organization.CreateProjectTemplateRequest.tasks:type_name -> organization.ProjectTemplateTask
+	111, // 77: organization.CreateProjectTemplateRequest.milestones:type_name -> organization.ProjectTemplateMilestone
+	113, // 78: organization.CreateProjectTemplateResponse.template:type_name -> organization.ProjectTemplate
+	113, // 79: organization.GetProjectTemplateResponse.template:type_name -> organization.ProjectTemplate
+	113, // 80: organization.ListProjectTemplatesResponse.templates:type_name -> organization.ProjectTemplate
+	25,  // 81: organization.CreateProjectFromTemplateResponse.project:type_name -> organization.Project
+	92,  // 82: organization.CreateProjectFromTemplateResponse.workspace:type_name -> organization.Workspace
+	124, // 83: organization.WorkspaceTemplate.boards:type_name -> organization.WorkspaceTemplateBoard
+	125, // 84: organization.WorkspaceTemplate.groups:type_name -> organization.WorkspaceTemplateGroup
+	170, // 85: organization.WorkspaceTemplate.created_at:type_name -> google.protobuf.Timestamp
+	170, // 86: organization.WorkspaceTemplate.updated_at:type_name -> google.protobuf.Timestamp
+	124, // 87: organization.CreateWorkspaceTemplateRequest.boards:type_name -> organization.WorkspaceTemplateBoard
+	125, // 88: organization.CreateWorkspaceTemplateRequest.groups:type_name -> organization.WorkspaceTemplateGroup
+	126, // 89: organization.CreateWorkspaceTemplateResponse.template:type_name -> organization.WorkspaceTemplate
+	126, // 90: organization.GetWorkspaceTemplateResponse.template:type_name -> organization.WorkspaceTemplate
+	126, // 91: organization.ListWorkspaceTemplatesResponse.templates:type_name -> organization.WorkspaceTemplate
+	92,  // 92: organization.CreateWorkspaceFromTemplateResponse.workspace:type_name -> organization.Workspace
+	70,  // 93: organization.CreateWorkspaceFromTemplateResponse.groups:type_name -> organization.Group
+	170, // 94: organization.CustomRole.created_at:type_name -> google.protobuf.Timestamp
+	170, // 95: organization.CustomRole.updated_at:type_name -> google.protobuf.Timestamp
+	170, // 96: organization.CustomRoleAssignment.created_at:type_name -> google.protobuf.Timestamp
+	137, // 97: organization.CreateCustomRoleResponse.role:type_name -> organization.CustomRole
+	137, // 98: organization.ListCustomRolesResponse.roles:type_name -> organization.CustomRole
+	137, // 99: organization.UpdateCustomRoleResponse.role:type_name -> organization.CustomRole
+	138, // 100: organization.AssignCustomRoleResponse.assignment:type_name -> organization.CustomRoleAssignment
+	138, // 101: organization.ListCustomRoleAssignmentsResponse.assignments:type_name -> organization.CustomRoleAssignment
+	153, // 102: organization.UserCapacity.projects:type_name -> organization.ProjectAllocation
+	154, // 103: organization.GetCapacityReportResponse.users:type_name -> organization.UserCapacity
+	157, // 104: organization.SearchOrganizationResponse.results:type_name -> organization.SearchResult
+	162, // 105: organization.RecordOrgUsageRequest.samples:type_name -> organization.OrgUsageSample
+	165, // 106: organization.GetOrgUsageResponse.days:type_name -> organization.OrgUsageDay
+	165, // 107: organization.GetOrgUsageResponse.totals:type_name -> organization.OrgUsageDay
+	90,  // 108: organization.OrganizationService.ListOrgMembers:input_type -> organization.ListOrgMembersRequest
+	3,   // 109: organization.OrganizationService.CreateTeam:input_type -> organization.CreateTeamRequest
+	5,   // 110: organization.OrganizationService.GetTeam:input_type -> organization.GetTeamRequest
+	7,   // 111: organization.OrganizationService.ListTeams:input_type -> organization.ListTeamsRequest
+	9,   // 112: organization.OrganizationService.UpdateTeam:input_type -> organization.UpdateTeamRequest
+	11,  // 113: organization.OrganizationService.DeleteTeam:input_type -> organization.DeleteTeamRequest
+	13,  // 114: organization.OrganizationService.AddTeamMember:input_type -> organization.AddTeamMemberRequest
+	19,  // 115: organization.OrganizationService.BulkAddTeamMembers:input_type -> organization.BulkAddTeamMembersRequest
+	15,  // 116: organization.OrganizationService.RemoveTeamMember:input_type -> organization.RemoveTeamMemberRequest
+	21,  // 117: organization.OrganizationService.ListTeamMembers:input_type -> organization.ListTeamMembersRequest
+	23,  // 118: organization.OrganizationService.TransferTeamOwnership:input_type -> organization.TransferTeamOwnershipRequest
+	29,  // 119: organization.OrganizationService.CreateProject:input_type -> organization.CreateProjectRequest
+	31,  // 120: organization.OrganizationService.GetProject:input_type -> organization.GetProjectRequest
+	33,  // 121: organization.OrganizationService.ListProjects:input_type -> organization.ListProjectsRequest
+	35,  // 122: organization.OrganizationService.UpdateProject:input_type -> organization.UpdateProjectRequest
+	37,  // 123: organization.OrganizationService.ArchiveProject:input_type -> organization.ArchiveProjectRequest
+	39,  // 124: organization.OrganizationService.UnarchiveProject:input_type -> organization.UnarchiveProjectRequest
+	41,  // 125: organization.OrganizationService.DeleteProject:input_type -> organization.DeleteProjectRequest
+	43,  // 126: organization.OrganizationService.AssignTeamToProject:input_type -> organization.AssignTeamToProjectRequest
+	45,  // 127: organization.OrganizationService.RemoveTeamFromProject:input_type -> organization.RemoveTeamFromProjectRequest
+	47,  // 128: organization.OrganizationService.AddProjectMember:input_type -> organization.AddProjectMemberRequest
+	51,  // 129: organization.OrganizationService.BulkAddProjectMembers:input_type -> organization.BulkAddProjectMembersRequest
+	49,  // 130: organization.OrganizationService.RemoveProjectMember:input_type -> organization.RemoveProjectMemberRequest
+	53,  // 131: organization.OrganizationService.TransferProjectManager:input_type -> organization.TransferProjectManagerRequest
+	58,  // 132: organization.OrganizationService.GetProjectTimeline:input_type -> organization.GetProjectTimelineRequest
+	61,  // 133: organization.OrganizationService.SubmitProjectStatusReport:input_type -> organization.SubmitProjectStatusReportRequest
+	63,  // 134: organization.OrganizationService.ListProjectStatusReports:input_type -> organization.ListProjectStatusReportsRequest
+	66,  // 135: organization.OrganizationService.GetProjectActivity:input_type -> organization.GetProjectActivityRequest
+	68,  // 136: organization.OrganizationService.CompleteProjectMilestone:input_type -> organization.CompleteProjectMilestoneRequest
+	73,  // 137: organization.OrganizationService.CreateGroup:input_type -> organization.CreateGroupRequest
+	75,  // 138: organization.OrganizationService.GetGroup:input_type -> organization.GetGroupRequest
+	77,  // 139: organization.OrganizationService.ListGroups:input_type -> organization.ListGroupsRequest
+	79,  // 140: organization.OrganizationService.UpdateGroup:input_type -> organization.UpdateGroupRequest
+	81,  // 141: organization.OrganizationService.DeleteGroup:input_type -> organization.DeleteGroupRequest
+	83,  // 142: organization.OrganizationService.AddGroupMember:input_type -> organization.AddGroupMemberRequest
+	85,  // 143: organization.OrganizationService.RemoveGroupMember:input_type -> organization.RemoveGroupMemberRequest
+	87,  // 144: organization.OrganizationService.TransferGroupOwner:input_type -> organization.TransferGroupOwnerRequest
+	93,  // 145: organization.OrganizationService.CreateWorkspace:input_type -> organization.CreateWorkspaceRequest
+	97,  // 146: organization.OrganizationService.GetWorkspace:input_type -> organization.GetWorkspaceRequest
+	95,  // 147: organization.OrganizationService.ListWorkspaces:input_type -> organization.ListWorkspacesRequest
+	99,  // 148: organization.OrganizationService.UpdateWorkspace:input_type -> organization.UpdateWorkspaceRequest
+	101, // 149: organization.OrganizationService.DeleteWorkspace:input_type -> organization.DeleteWorkspaceRequest
+	104, // 150: organization.OrganizationService.AddWorkspaceMember:input_type -> organization.AddWorkspaceMemberRequest
+	106, // 151: organization.OrganizationService.RemoveWorkspaceMember:input_type -> organization.RemoveWorkspaceMemberRequest
+	108, // 152: organization.OrganizationService.ListWorkspaceMembers:input_type -> organization.ListWorkspaceMembersRequest
+	114, // 153: organization.OrganizationService.CreateProjectTemplate:input_type -> organization.CreateProjectTemplateRequest
+	116, // 154: organization.OrganizationService.GetProjectTemplate:input_type -> organization.GetProjectTemplateRequest
+	118, // 155: organization.OrganizationService.ListProjectTemplates:input_type -> organization.ListProjectTemplatesRequest
+	120, // 156: organization.OrganizationService.DeleteProjectTemplate:input_type -> organization.DeleteProjectTemplateRequest
+	122, // 157: organization.OrganizationService.CreateProjectFromTemplate:input_type -> organization.CreateProjectFromTemplateRequest
+	127, // 158: organization.OrganizationService.CreateWorkspaceTemplate:input_type -> organization.CreateWorkspaceTemplateRequest
+	129, // 159: organization.OrganizationService.GetWorkspaceTemplate:input_type -> organization.GetWorkspaceTemplateRequest
+	131, // 160: organization.OrganizationService.ListWorkspaceTemplates:input_type -> organization.ListWorkspaceTemplatesRequest
+	133, // 161: organization.OrganizationService.DeleteWorkspaceTemplate:input_type -> organization.DeleteWorkspaceTemplateRequest
+	135, // 162: organization.OrganizationService.CreateWorkspaceFromTemplate:input_type -> organization.CreateWorkspaceFromTemplateRequest
+	139, // 163: organization.OrganizationService.CreateCustomRole:input_type -> organization.CreateCustomRoleRequest
+	141, // 164: organization.OrganizationService.ListCustomRoles:input_type -> organization.ListCustomRolesRequest
+	143, // 165: organization.OrganizationService.UpdateCustomRole:input_type -> organization.UpdateCustomRoleRequest
+	145, // 166: organization.OrganizationService.DeleteCustomRole:input_type -> organization.DeleteCustomRoleRequest
+	147, // 167: organization.OrganizationService.AssignCustomRole:input_type -> organization.AssignCustomRoleRequest
+	149, // 168: organization.OrganizationService.UnassignCustomRole:input_type -> organization.UnassignCustomRoleRequest
+	151, // 169: organization.OrganizationService.ListCustomRoleAssignments:input_type -> organization.ListCustomRoleAssignmentsRequest
+	155, // 170: organization.OrganizationService.GetCapacityReport:input_type -> organization.GetCapacityReportRequest
+	158, // 171: organization.OrganizationService.SearchOrganization:input_type -> organization.SearchOrganizationRequest
+	160, // 172: organization.OrganizationService.GetMetadataSchema:input_type -> organization.GetMetadataSchemaRequest
+	166, // 173: organization.OrganizationService.GetOrgUsage:input_type -> organization.GetOrgUsageRequest
+	163, // 174: organization.OrganizationService.RecordOrgUsage:input_type -> organization.RecordOrgUsageRequest
+	91,  // 175: organization.OrganizationService.ListOrgMembers:output_type -> organization.ListOrgMembersResponse
+	4,   // 176: organization.OrganizationService.CreateTeam:output_type -> organization.CreateTeamResponse
+	6,   // 177: organization.OrganizationService.GetTeam:output_type -> organization.GetTeamResponse
+	8,   // 178: organization.OrganizationService.ListTeams:output_type -> organization.ListTeamsResponse
+	10,  // 179: organization.OrganizationService.UpdateTeam:output_type -> organization.UpdateTeamResponse
+	12,  // 180: organization.OrganizationService.DeleteTeam:output_type -> organization.DeleteTeamResponse
+	14,  // 181: organization.OrganizationService.AddTeamMember:output_type -> organization.AddTeamMemberResponse
+	20,  // 182: organization.OrganizationService.BulkAddTeamMembers:output_type -> organization.BulkAddTeamMembersResponse
+	16,  // 183: organization.OrganizationService.RemoveTeamMember:output_type -> organization.RemoveTeamMemberResponse
+	22,  // 184: organization.OrganizationService.ListTeamMembers:output_type -> organization.ListTeamMembersResponse
+	24,  // 185: organization.OrganizationService.TransferTeamOwnership:output_type -> organization.TransferTeamOwnershipResponse
+	30,  // 186: organization.OrganizationService.CreateProject:output_type -> organization.CreateProjectResponse
+	32,  // 187: organization.OrganizationService.GetProject:output_type -> organization.GetProjectResponse
+	34,  // 188: organization.OrganizationService.ListProjects:output_type -> organization.ListProjectsResponse
+	36,  // 189: organization.OrganizationService.UpdateProject:output_type -> organization.UpdateProjectResponse
+	38,  // 190: organization.OrganizationService.ArchiveProject:output_type -> organization.ArchiveProjectResponse
+	40,  // 191: organization.OrganizationService.UnarchiveProject:output_type -> organization.UnarchiveProjectResponse
+	42,  // 192: organization.OrganizationService.DeleteProject:output_type -> organization.DeleteProjectResponse
+	44,  // 193: organization.OrganizationService.AssignTeamToProject:output_type -> organization.AssignTeamToProjectResponse
+	46,  // 194: organization.OrganizationService.RemoveTeamFromProject:output_type -> organization.RemoveTeamFromProjectResponse
+	48,  // 195: organization.OrganizationService.AddProjectMember:output_type -> organization.AddProjectMemberResponse
+	52,  // 196: organization.OrganizationService.BulkAddProjectMembers:output_type -> organization.BulkAddProjectMembersResponse
+	50,  // 197: organization.OrganizationService.RemoveProjectMember:output_type -> organization.RemoveProjectMemberResponse
+	54,  // 198: organization.OrganizationService.TransferProjectManager:output_type -> organization.TransferProjectManagerResponse
+	59,  // 199: organization.OrganizationService.GetProjectTimeline:output_type -> organization.GetProjectTimelineResponse
+	62,  // 200: organization.OrganizationService.SubmitProjectStatusReport:output_type -> organization.SubmitProjectStatusReportResponse
+	64,  // 201: organization.OrganizationService.ListProjectStatusReports:output_type -> organization.ListProjectStatusReportsResponse
+	67,  // 202: organization.OrganizationService.GetProjectActivity:output_type -> organization.GetProjectActivityResponse
+	69,  // 203: organization.OrganizationService.CompleteProjectMilestone:output_type -> organization.CompleteProjectMilestoneResponse
+	74,  // 204: organization.OrganizationService.CreateGroup:output_type -> organization.CreateGroupResponse
+	76,  // 205: organization.OrganizationService.GetGroup:output_type -> organization.GetGroupResponse
+	78,  // 206: organization.OrganizationService.ListGroups:output_type -> organization.ListGroupsResponse
+	80,  // 207: organization.OrganizationService.UpdateGroup:output_type -> organization.UpdateGroupResponse
+	82,  // 208: organization.OrganizationService.DeleteGroup:output_type -> organization.DeleteGroupResponse
+	84,  // 209: organization.OrganizationService.AddGroupMember:output_type -> organization.AddGroupMemberResponse
+	86,  // 210: organization.OrganizationService.RemoveGroupMember:output_type -> organization.RemoveGroupMemberResponse
+	88,  // 211: organization.OrganizationService.TransferGroupOwner:output_type -> organization.TransferGroupOwnerResponse
+	94,  // 212: organization.OrganizationService.CreateWorkspace:output_type -> organization.CreateWorkspaceResponse
+	98,  // 213: organization.OrganizationService.GetWorkspace:output_type -> organization.GetWorkspaceResponse
+	96,  // 214: organization.OrganizationService.ListWorkspaces:output_type -> organization.ListWorkspacesResponse
+	100, // 215: organization.OrganizationService.UpdateWorkspace:output_type -> organization.UpdateWorkspaceResponse
+	102, // 216: organization.OrganizationService.DeleteWorkspace:output_type -> organization.DeleteWorkspaceResponse
+	105, // 217: organization.OrganizationService.AddWorkspaceMember:output_type -> organization.AddWorkspaceMemberResponse
+	107, // 218: organization.OrganizationService.RemoveWorkspaceMember:output_type -> organization.RemoveWorkspaceMemberResponse
+	109, // 219: organization.OrganizationService.ListWorkspaceMembers:output_type -> organization.ListWorkspaceMembersResponse
+	115, // 220: organization.OrganizationService.CreateProjectTemplate:output_type -> organization.CreateProjectTemplateResponse
+	117, // 221: organization.OrganizationService.GetProjectTemplate:output_type -> organization.GetProjectTemplateResponse
+	119, // 222: organization.OrganizationService.ListProjectTemplates:output_type -> organization.ListProjectTemplatesResponse
+	121, // 223: organization.OrganizationService.DeleteProjectTemplate:output_type -> organization.DeleteProjectTemplateResponse
+	123, // 224: organization.OrganizationService.CreateProjectFromTemplate:output_type -> organization.CreateProjectFromTemplateResponse
+	128, // 225: organization.OrganizationService.CreateWorkspaceTemplate:output_type -> organization.CreateWorkspaceTemplateResponse
+	130, // 226: organization.OrganizationService.GetWorkspaceTemplate:output_type -> organization.GetWorkspaceTemplateResponse
+	132, // 227: organization.OrganizationService.ListWorkspaceTemplates:output_type -> organization.ListWorkspaceTemplatesResponse
+	134, // 228: organization.OrganizationService.DeleteWorkspaceTemplate:output_type -> organization.DeleteWorkspaceTemplateResponse
+	136, // 229: organization.OrganizationService.CreateWorkspaceFromTemplate:output_type -> organization.CreateWorkspaceFromTemplateResponse
+	140, // 230: organization.OrganizationService.CreateCustomRole:output_type -> organization.CreateCustomRoleResponse
+	142, // 231: organization.OrganizationService.ListCustomRoles:output_type -> organization.ListCustomRolesResponse
+	144, // 232: organization.OrganizationService.UpdateCustomRole:output_type -> organization.UpdateCustomRoleResponse
+	146, // 233: organization.OrganizationService.DeleteCustomRole:output_type -> organization.DeleteCustomRoleResponse
+	148, // 234: organization.OrganizationService.AssignCustomRole:output_type -> organization.AssignCustomRoleResponse
+	150, // 235: organization.OrganizationService.UnassignCustomRole:output_type -> organization.UnassignCustomRoleResponse
+	152, // 236: organization.OrganizationService.ListCustomRoleAssignments:output_type -> organization.ListCustomRoleAssignmentsResponse
+	156, // 237: organization.OrganizationService.GetCapacityReport:output_type -> organization.GetCapacityReportResponse
+	159, // 238: organization.OrganizationService.SearchOrganization:output_type -> organization.SearchOrganizationResponse
+	161, // 239: organization.OrganizationService.GetMetadataSchema:output_type -> organization.GetMetadataSchemaResponse
+	167, // 240: organization.OrganizationService.GetOrgUsage:output_type -> organization.GetOrgUsageResponse
+	164, // 241: organization.OrganizationService.RecordOrgUsage:output_type -> organization.RecordOrgUsageResponse
+	175, // [175:242] is the sub-list for method output_type
+	108, // [108:175] is the sub-list for method input_type
+	108, // [108:108] is the sub-list for extension type_name
+	108, // [108:108] is the sub-list for extension extendee
+	0,   // [0:108] is the sub-list for field type_name
 }
 
 func init() { file_organization_proto_init() }
@@ -5286,7 +12305,7 @@ func file_organization_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_organization_proto_rawDesc), len(file_organization_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   72,
+			NumMessages:   170,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
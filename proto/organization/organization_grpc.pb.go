@@ -19,36 +19,73 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	OrganizationService_ListOrgMembers_FullMethodName        = "/organization.OrganizationService/ListOrgMembers"
-	OrganizationService_CreateTeam_FullMethodName            = "/organization.OrganizationService/CreateTeam"
-	OrganizationService_GetTeam_FullMethodName               = "/organization.OrganizationService/GetTeam"
-	OrganizationService_ListTeams_FullMethodName             = "/organization.OrganizationService/ListTeams"
-	OrganizationService_UpdateTeam_FullMethodName            = "/organization.OrganizationService/UpdateTeam"
-	OrganizationService_DeleteTeam_FullMethodName            = "/organization.OrganizationService/DeleteTeam"
-	OrganizationService_AddTeamMember_FullMethodName         = "/organization.OrganizationService/AddTeamMember"
-	OrganizationService_RemoveTeamMember_FullMethodName      = "/organization.OrganizationService/RemoveTeamMember"
-	OrganizationService_ListTeamMembers_FullMethodName       = "/organization.OrganizationService/ListTeamMembers"
-	OrganizationService_CreateProject_FullMethodName         = "/organization.OrganizationService/CreateProject"
-	OrganizationService_GetProject_FullMethodName            = "/organization.OrganizationService/GetProject"
-	OrganizationService_ListProjects_FullMethodName          = "/organization.OrganizationService/ListProjects"
-	OrganizationService_UpdateProject_FullMethodName         = "/organization.OrganizationService/UpdateProject"
-	OrganizationService_DeleteProject_FullMethodName         = "/organization.OrganizationService/DeleteProject"
-	OrganizationService_AssignTeamToProject_FullMethodName   = "/organization.OrganizationService/AssignTeamToProject"
-	OrganizationService_RemoveTeamFromProject_FullMethodName = "/organization.OrganizationService/RemoveTeamFromProject"
-	OrganizationService_AddProjectMember_FullMethodName      = "/organization.OrganizationService/AddProjectMember"
-	OrganizationService_RemoveProjectMember_FullMethodName   = "/organization.OrganizationService/RemoveProjectMember"
-	OrganizationService_CreateGroup_FullMethodName           = "/organization.OrganizationService/CreateGroup"
-	OrganizationService_GetGroup_FullMethodName              = "/organization.OrganizationService/GetGroup"
-	OrganizationService_ListGroups_FullMethodName            = "/organization.OrganizationService/ListGroups"
-	OrganizationService_UpdateGroup_FullMethodName           = "/organization.OrganizationService/UpdateGroup"
-	OrganizationService_DeleteGroup_FullMethodName           = "/organization.OrganizationService/DeleteGroup"
-	OrganizationService_AddGroupMember_FullMethodName        = "/organization.OrganizationService/AddGroupMember"
-	OrganizationService_RemoveGroupMember_FullMethodName     = "/organization.OrganizationService/RemoveGroupMember"
-	OrganizationService_CreateWorkspace_FullMethodName       = "/organization.OrganizationService/CreateWorkspace"
-	OrganizationService_GetWorkspace_FullMethodName          = "/organization.OrganizationService/GetWorkspace"
-	OrganizationService_ListWorkspaces_FullMethodName        = "/organization.OrganizationService/ListWorkspaces"
-	OrganizationService_UpdateWorkspace_FullMethodName       = "/organization.OrganizationService/UpdateWorkspace"
-	OrganizationService_DeleteWorkspace_FullMethodName       = "/organization.OrganizationService/DeleteWorkspace"
+	OrganizationService_ListOrgMembers_FullMethodName              = "/organization.OrganizationService/ListOrgMembers"
+	OrganizationService_CreateTeam_FullMethodName                  = "/organization.OrganizationService/CreateTeam"
+	OrganizationService_GetTeam_FullMethodName                     = "/organization.OrganizationService/GetTeam"
+	OrganizationService_ListTeams_FullMethodName                   = "/organization.OrganizationService/ListTeams"
+	OrganizationService_UpdateTeam_FullMethodName                  = "/organization.OrganizationService/UpdateTeam"
+	OrganizationService_DeleteTeam_FullMethodName                  = "/organization.OrganizationService/DeleteTeam"
+	OrganizationService_AddTeamMember_FullMethodName               = "/organization.OrganizationService/AddTeamMember"
+	OrganizationService_BulkAddTeamMembers_FullMethodName          = "/organization.OrganizationService/BulkAddTeamMembers"
+	OrganizationService_RemoveTeamMember_FullMethodName            = "/organization.OrganizationService/RemoveTeamMember"
+	OrganizationService_ListTeamMembers_FullMethodName             = "/organization.OrganizationService/ListTeamMembers"
+	OrganizationService_TransferTeamOwnership_FullMethodName       = "/organization.OrganizationService/TransferTeamOwnership"
+	OrganizationService_CreateProject_FullMethodName               = "/organization.OrganizationService/CreateProject"
+	OrganizationService_GetProject_FullMethodName                  = "/organization.OrganizationService/GetProject"
+	OrganizationService_ListProjects_FullMethodName                = "/organization.OrganizationService/ListProjects"
+	OrganizationService_UpdateProject_FullMethodName               = "/organization.OrganizationService/UpdateProject"
+	OrganizationService_ArchiveProject_FullMethodName              = "/organization.OrganizationService/ArchiveProject"
+	OrganizationService_UnarchiveProject_FullMethodName            = "/organization.OrganizationService/UnarchiveProject"
+	OrganizationService_DeleteProject_FullMethodName               = "/organization.OrganizationService/DeleteProject"
+	OrganizationService_AssignTeamToProject_FullMethodName         = "/organization.OrganizationService/AssignTeamToProject"
+	OrganizationService_RemoveTeamFromProject_FullMethodName       = "/organization.OrganizationService/RemoveTeamFromProject"
+	OrganizationService_AddProjectMember_FullMethodName            = "/organization.OrganizationService/AddProjectMember"
+	OrganizationService_BulkAddProjectMembers_FullMethodName       = "/organization.OrganizationService/BulkAddProjectMembers"
+	OrganizationService_RemoveProjectMember_FullMethodName         = "/organization.OrganizationService/RemoveProjectMember"
+	OrganizationService_TransferProjectManager_FullMethodName      = "/organization.OrganizationService/TransferProjectManager"
+	OrganizationService_GetProjectTimeline_FullMethodName          = "/organization.OrganizationService/GetProjectTimeline"
+	OrganizationService_SubmitProjectStatusReport_FullMethodName   = "/organization.OrganizationService/SubmitProjectStatusReport"
+	OrganizationService_ListProjectStatusReports_FullMethodName    = "/organization.OrganizationService/ListProjectStatusReports"
+	OrganizationService_GetProjectActivity_FullMethodName          = "/organization.OrganizationService/GetProjectActivity"
+	OrganizationService_CompleteProjectMilestone_FullMethodName    = "/organization.OrganizationService/CompleteProjectMilestone"
+	OrganizationService_CreateGroup_FullMethodName                 = "/organization.OrganizationService/CreateGroup"
+	OrganizationService_GetGroup_FullMethodName                    = "/organization.OrganizationService/GetGroup"
+	OrganizationService_ListGroups_FullMethodName                  = "/organization.OrganizationService/ListGroups"
+	OrganizationService_UpdateGroup_FullMethodName                 = "/organization.OrganizationService/UpdateGroup"
+	OrganizationService_DeleteGroup_FullMethodName                 = "/organization.OrganizationService/DeleteGroup"
+	OrganizationService_AddGroupMember_FullMethodName              = "/organization.OrganizationService/AddGroupMember"
+	OrganizationService_RemoveGroupMember_FullMethodName           = "/organization.OrganizationService/RemoveGroupMember"
+	OrganizationService_TransferGroupOwner_FullMethodName          = "/organization.OrganizationService/TransferGroupOwner"
+	OrganizationService_CreateWorkspace_FullMethodName             = "/organization.OrganizationService/CreateWorkspace"
+	OrganizationService_GetWorkspace_FullMethodName                = "/organization.OrganizationService/GetWorkspace"
+	OrganizationService_ListWorkspaces_FullMethodName              = "/organization.OrganizationService/ListWorkspaces"
+	OrganizationService_UpdateWorkspace_FullMethodName             = "/organization.OrganizationService/UpdateWorkspace"
+	OrganizationService_DeleteWorkspace_FullMethodName             = "/organization.OrganizationService/DeleteWorkspace"
+	OrganizationService_AddWorkspaceMember_FullMethodName          = "/organization.OrganizationService/AddWorkspaceMember"
+	OrganizationService_RemoveWorkspaceMember_FullMethodName       = "/organization.OrganizationService/RemoveWorkspaceMember"
+	OrganizationService_ListWorkspaceMembers_FullMethodName        = "/organization.OrganizationService/ListWorkspaceMembers"
+	OrganizationService_CreateProjectTemplate_FullMethodName       = "/organization.OrganizationService/CreateProjectTemplate"
+	OrganizationService_GetProjectTemplate_FullMethodName          = "/organization.OrganizationService/GetProjectTemplate"
+	OrganizationService_ListProjectTemplates_FullMethodName        = "/organization.OrganizationService/ListProjectTemplates"
+	OrganizationService_DeleteProjectTemplate_FullMethodName       = "/organization.OrganizationService/DeleteProjectTemplate"
+	OrganizationService_CreateProjectFromTemplate_FullMethodName   = "/organization.OrganizationService/CreateProjectFromTemplate"
+	OrganizationService_CreateWorkspaceTemplate_FullMethodName     = "/organization.OrganizationService/CreateWorkspaceTemplate"
+	OrganizationService_GetWorkspaceTemplate_FullMethodName        = "/organization.OrganizationService/GetWorkspaceTemplate"
+	OrganizationService_ListWorkspaceTemplates_FullMethodName      = "/organization.OrganizationService/ListWorkspaceTemplates"
+	OrganizationService_DeleteWorkspaceTemplate_FullMethodName     = "/organization.OrganizationService/DeleteWorkspaceTemplate"
+	OrganizationService_CreateWorkspaceFromTemplate_FullMethodName = "/organization.OrganizationService/CreateWorkspaceFromTemplate"
+	OrganizationService_CreateCustomRole_FullMethodName            = "/organization.OrganizationService/CreateCustomRole"
+	OrganizationService_ListCustomRoles_FullMethodName             = "/organization.OrganizationService/ListCustomRoles"
+	OrganizationService_UpdateCustomRole_FullMethodName            = "/organization.OrganizationService/UpdateCustomRole"
+	OrganizationService_DeleteCustomRole_FullMethodName            = "/organization.OrganizationService/DeleteCustomRole"
+	OrganizationService_AssignCustomRole_FullMethodName            = "/organization.OrganizationService/AssignCustomRole"
+	OrganizationService_UnassignCustomRole_FullMethodName          = "/organization.OrganizationService/UnassignCustomRole"
+	OrganizationService_ListCustomRoleAssignments_FullMethodName   = "/organization.OrganizationService/ListCustomRoleAssignments"
+	OrganizationService_GetCapacityReport_FullMethodName           = "/organization.OrganizationService/GetCapacityReport"
+	OrganizationService_SearchOrganization_FullMethodName          = "/organization.OrganizationService/SearchOrganization"
+	OrganizationService_GetMetadataSchema_FullMethodName           = "/organization.OrganizationService/GetMetadataSchema"
+	OrganizationService_GetOrgUsage_FullMethodName                 = "/organization.OrganizationService/GetOrgUsage"
+	OrganizationService_RecordOrgUsage_FullMethodName              = "/organization.OrganizationService/RecordOrgUsage"
 )
 
 // OrganizationServiceClient is the client API for OrganizationService service.
@@ -64,18 +101,29 @@ type OrganizationServiceClient interface {
 	UpdateTeam(ctx context.Context, in *UpdateTeamRequest, opts ...grpc.CallOption) (*UpdateTeamResponse, error)
 	DeleteTeam(ctx context.Context, in *DeleteTeamRequest, opts ...grpc.CallOption) (*DeleteTeamResponse, error)
 	AddTeamMember(ctx context.Context, in *AddTeamMemberRequest, opts ...grpc.CallOption) (*AddTeamMemberResponse, error)
+	BulkAddTeamMembers(ctx context.Context, in *BulkAddTeamMembersRequest, opts ...grpc.CallOption) (*BulkAddTeamMembersResponse, error)
 	RemoveTeamMember(ctx context.Context, in *RemoveTeamMemberRequest, opts ...grpc.CallOption) (*RemoveTeamMemberResponse, error)
 	ListTeamMembers(ctx context.Context, in *ListTeamMembersRequest, opts ...grpc.CallOption) (*ListTeamMembersResponse, error)
+	TransferTeamOwnership(ctx context.Context, in *TransferTeamOwnershipRequest, opts ...grpc.CallOption) (*TransferTeamOwnershipResponse, error)
 	// Project Management
 	CreateProject(ctx context.Context, in *CreateProjectRequest, opts ...grpc.CallOption) (*CreateProjectResponse, error)
 	GetProject(ctx context.Context, in *GetProjectRequest, opts ...grpc.CallOption) (*GetProjectResponse, error)
 	ListProjects(ctx context.Context, in *ListProjectsRequest, opts ...grpc.CallOption) (*ListProjectsResponse, error)
 	UpdateProject(ctx context.Context, in *UpdateProjectRequest, opts ...grpc.CallOption) (*UpdateProjectResponse, error)
+	ArchiveProject(ctx context.Context, in *ArchiveProjectRequest, opts ...grpc.CallOption) (*ArchiveProjectResponse, error)
+	UnarchiveProject(ctx context.Context, in *UnarchiveProjectRequest, opts ...grpc.CallOption) (*UnarchiveProjectResponse, error)
 	DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*DeleteProjectResponse, error)
 	AssignTeamToProject(ctx context.Context, in *AssignTeamToProjectRequest, opts ...grpc.CallOption) (*AssignTeamToProjectResponse, error)
 	RemoveTeamFromProject(ctx context.Context, in *RemoveTeamFromProjectRequest, opts ...grpc.CallOption) (*RemoveTeamFromProjectResponse, error)
 	AddProjectMember(ctx context.Context, in *AddProjectMemberRequest, opts ...grpc.CallOption) (*AddProjectMemberResponse, error)
+	BulkAddProjectMembers(ctx context.Context, in *BulkAddProjectMembersRequest, opts ...grpc.CallOption) (*BulkAddProjectMembersResponse, error)
 	RemoveProjectMember(ctx context.Context, in *RemoveProjectMemberRequest, opts ...grpc.CallOption) (*RemoveProjectMemberResponse, error)
+	TransferProjectManager(ctx context.Context, in *TransferProjectManagerRequest, opts ...grpc.CallOption) (*TransferProjectManagerResponse, error)
+	GetProjectTimeline(ctx context.Context, in *GetProjectTimelineRequest, opts ...grpc.CallOption) (*GetProjectTimelineResponse, error)
+	SubmitProjectStatusReport(ctx context.Context, in *SubmitProjectStatusReportRequest, opts ...grpc.CallOption) (*SubmitProjectStatusReportResponse, error)
+	ListProjectStatusReports(ctx context.Context, in *ListProjectStatusReportsRequest, opts ...grpc.CallOption) (*ListProjectStatusReportsResponse, error)
+	GetProjectActivity(ctx context.Context, in *GetProjectActivityRequest, opts ...grpc.CallOption) (*GetProjectActivityResponse, error)
+	CompleteProjectMilestone(ctx context.Context, in *CompleteProjectMilestoneRequest, opts ...grpc.CallOption) (*CompleteProjectMilestoneResponse, error)
 	// Group Management
 	CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*CreateGroupResponse, error)
 	GetGroup(ctx context.Context, in *GetGroupRequest, opts ...grpc.CallOption) (*GetGroupResponse, error)
@@ -84,12 +132,46 @@ type OrganizationServiceClient interface {
 	DeleteGroup(ctx context.Context, in *DeleteGroupRequest, opts ...grpc.CallOption) (*DeleteGroupResponse, error)
 	AddGroupMember(ctx context.Context, in *AddGroupMemberRequest, opts ...grpc.CallOption) (*AddGroupMemberResponse, error)
 	RemoveGroupMember(ctx context.Context, in *RemoveGroupMemberRequest, opts ...grpc.CallOption) (*RemoveGroupMemberResponse, error)
+	TransferGroupOwner(ctx context.Context, in *TransferGroupOwnerRequest, opts ...grpc.CallOption) (*TransferGroupOwnerResponse, error)
 	// Workspace Management
 	CreateWorkspace(ctx context.Context, in *CreateWorkspaceRequest, opts ...grpc.CallOption) (*CreateWorkspaceResponse, error)
 	GetWorkspace(ctx context.Context, in *GetWorkspaceRequest, opts ...grpc.CallOption) (*GetWorkspaceResponse, error)
 	ListWorkspaces(ctx context.Context, in *ListWorkspacesRequest, opts ...grpc.CallOption) (*ListWorkspacesResponse, error)
 	UpdateWorkspace(ctx context.Context, in *UpdateWorkspaceRequest, opts ...grpc.CallOption) (*UpdateWorkspaceResponse, error)
 	DeleteWorkspace(ctx context.Context, in *DeleteWorkspaceRequest, opts ...grpc.CallOption) (*DeleteWorkspaceResponse, error)
+	AddWorkspaceMember(ctx context.Context, in *AddWorkspaceMemberRequest, opts ...grpc.CallOption) (*AddWorkspaceMemberResponse, error)
+	RemoveWorkspaceMember(ctx context.Context, in *RemoveWorkspaceMemberRequest, opts ...grpc.CallOption) (*RemoveWorkspaceMemberResponse, error)
+	ListWorkspaceMembers(ctx context.Context, in *ListWorkspaceMembersRequest, opts ...grpc.CallOption) (*ListWorkspaceMembersResponse, error)
+	// Project Templates
+	CreateProjectTemplate(ctx context.Context, in *CreateProjectTemplateRequest, opts ...grpc.CallOption) (*CreateProjectTemplateResponse, error)
+	GetProjectTemplate(ctx context.Context, in *GetProjectTemplateRequest, opts ...grpc.CallOption) (*GetProjectTemplateResponse, error)
+	ListProjectTemplates(ctx context.Context, in *ListProjectTemplatesRequest, opts ...grpc.CallOption) (*ListProjectTemplatesResponse, error)
+	DeleteProjectTemplate(ctx context.Context, in *DeleteProjectTemplateRequest, opts ...grpc.CallOption) (*DeleteProjectTemplateResponse, error)
+	CreateProjectFromTemplate(ctx context.Context, in *CreateProjectFromTemplateRequest, opts ...grpc.CallOption) (*CreateProjectFromTemplateResponse, error)
+	// Workspace Templates
+	CreateWorkspaceTemplate(ctx context.Context, in *CreateWorkspaceTemplateRequest, opts ...grpc.CallOption) (*CreateWorkspaceTemplateResponse, error)
+	GetWorkspaceTemplate(ctx context.Context, in *GetWorkspaceTemplateRequest, opts ...grpc.CallOption) (*GetWorkspaceTemplateResponse, error)
+	ListWorkspaceTemplates(ctx context.Context, in *ListWorkspaceTemplatesRequest, opts ...grpc.CallOption) (*ListWorkspaceTemplatesResponse, error)
+	DeleteWorkspaceTemplate(ctx context.Context, in *DeleteWorkspaceTemplateRequest, opts ...grpc.CallOption) (*DeleteWorkspaceTemplateResponse, error)
+	CreateWorkspaceFromTemplate(ctx context.Context, in *CreateWorkspaceFromTemplateRequest, opts ...grpc.CallOption) (*CreateWorkspaceFromTemplateResponse, error)
+	// Custom Roles
+	CreateCustomRole(ctx context.Context, in *CreateCustomRoleRequest, opts ...grpc.CallOption) (*CreateCustomRoleResponse, error)
+	ListCustomRoles(ctx context.Context, in *ListCustomRolesRequest, opts ...grpc.CallOption) (*ListCustomRolesResponse, error)
+	UpdateCustomRole(ctx context.Context, in *UpdateCustomRoleRequest, opts ...grpc.CallOption) (*UpdateCustomRoleResponse, error)
+	DeleteCustomRole(ctx context.Context, in *DeleteCustomRoleRequest, opts ...grpc.CallOption) (*DeleteCustomRoleResponse, error)
+	AssignCustomRole(ctx context.Context, in *AssignCustomRoleRequest, opts ...grpc.CallOption) (*AssignCustomRoleResponse, error)
+	UnassignCustomRole(ctx context.Context, in *UnassignCustomRoleRequest, opts ...grpc.CallOption) (*UnassignCustomRoleResponse, error)
+	ListCustomRoleAssignments(ctx context.Context, in *ListCustomRoleAssignmentsRequest, opts ...grpc.CallOption) (*ListCustomRoleAssignmentsResponse, error)
+	// Capacity
+	GetCapacityReport(ctx context.Context, in *GetCapacityReportRequest, opts ...grpc.CallOption) (*GetCapacityReportResponse, error)
+	// Search
+	SearchOrganization(ctx context.Context, in *SearchOrganizationRequest, opts ...grpc.CallOption) (*SearchOrganizationResponse, error)
+	// Metadata
+	GetMetadataSchema(ctx context.Context, in *GetMetadataSchemaRequest, opts ...grpc.CallOption) (*GetMetadataSchemaResponse, error)
+	// Usage
+	GetOrgUsage(ctx context.Context, in *GetOrgUsageRequest, opts ...grpc.CallOption) (*GetOrgUsageResponse, error)
+	// Add the gateway's request counts to the daily usage (internal, used by the gateway)
+	RecordOrgUsage(ctx context.Context, in *RecordOrgUsageRequest, opts ...grpc.CallOption) (*RecordOrgUsageResponse, error)
 }
 
 type organizationServiceClient struct {
@@ -170,6 +252,16 @@ func (c *organizationServiceClient) AddTeamMember(ctx context.Context, in *AddTe
 	return out, nil
 }
 
+func (c *organizationServiceClient) BulkAddTeamMembers(ctx context.Context, in *BulkAddTeamMembersRequest, opts ...grpc.CallOption) (*BulkAddTeamMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkAddTeamMembersResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_BulkAddTeamMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *organizationServiceClient) RemoveTeamMember(ctx context.Context, in *RemoveTeamMemberRequest, opts ...grpc.CallOption) (*RemoveTeamMemberResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RemoveTeamMemberResponse)
@@ -190,6 +282,16 @@ func (c *organizationServiceClient) ListTeamMembers(ctx context.Context, in *Lis
 	return out, nil
 }
 
+func (c *organizationServiceClient) TransferTeamOwnership(ctx context.Context, in *TransferTeamOwnershipRequest, opts ...grpc.CallOption) (*TransferTeamOwnershipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferTeamOwnershipResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_TransferTeamOwnership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *organizationServiceClient) CreateProject(ctx context.Context, in *CreateProjectRequest, opts ...grpc.CallOption) (*CreateProjectResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateProjectResponse)
@@ -230,6 +332,26 @@ func (c *organizationServiceClient) UpdateProject(ctx context.Context, in *Updat
 	return out, nil
 }
 
+func (c *organizationServiceClient) ArchiveProject(ctx context.Context, in *ArchiveProjectRequest, opts ...grpc.CallOption) (*ArchiveProjectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchiveProjectResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_ArchiveProject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) UnarchiveProject(ctx context.Context, in *UnarchiveProjectRequest, opts ...grpc.CallOption) (*UnarchiveProjectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnarchiveProjectResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_UnarchiveProject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *organizationServiceClient) DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*DeleteProjectResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteProjectResponse)
@@ -270,6 +392,16 @@ func (c *organizationServiceClient) AddProjectMember(ctx context.Context, in *Ad
 	return out, nil
 }
 
+func (c *organizationServiceClient) BulkAddProjectMembers(ctx context.Context, in *BulkAddProjectMembersRequest, opts ...grpc.CallOption) (*BulkAddProjectMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkAddProjectMembersResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_BulkAddProjectMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *organizationServiceClient) RemoveProjectMember(ctx context.Context, in *RemoveProjectMemberRequest, opts ...grpc.CallOption) (*RemoveProjectMemberResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RemoveProjectMemberResponse)
@@ -280,6 +412,66 @@ func (c *organizationServiceClient) RemoveProjectMember(ctx context.Context, in
 	return out, nil
 }
 
+func (c *organizationServiceClient) TransferProjectManager(ctx context.Context, in *TransferProjectManagerRequest, opts ...grpc.CallOption) (*TransferProjectManagerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferProjectManagerResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_TransferProjectManager_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) GetProjectTimeline(ctx context.Context, in *GetProjectTimelineRequest, opts ...grpc.CallOption) (*GetProjectTimelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProjectTimelineResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_GetProjectTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) SubmitProjectStatusReport(ctx context.Context, in *SubmitProjectStatusReportRequest, opts ...grpc.CallOption) (*SubmitProjectStatusReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitProjectStatusReportResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_SubmitProjectStatusReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) ListProjectStatusReports(ctx context.Context, in *ListProjectStatusReportsRequest, opts ...grpc.CallOption) (*ListProjectStatusReportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProjectStatusReportsResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_ListProjectStatusReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) GetProjectActivity(ctx context.Context, in *GetProjectActivityRequest, opts ...grpc.CallOption) (*GetProjectActivityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProjectActivityResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_GetProjectActivity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) CompleteProjectMilestone(ctx context.Context, in *CompleteProjectMilestoneRequest, opts ...grpc.CallOption) (*CompleteProjectMilestoneResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteProjectMilestoneResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_CompleteProjectMilestone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *organizationServiceClient) CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*CreateGroupResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateGroupResponse)
@@ -350,6 +542,16 @@ func (c *organizationServiceClient) RemoveGroupMember(ctx context.Context, in *R
 	return out, nil
 }
 
+func (c *organizationServiceClient) TransferGroupOwner(ctx context.Context, in *TransferGroupOwnerRequest, opts ...grpc.CallOption) (*TransferGroupOwnerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferGroupOwnerResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_TransferGroupOwner_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *organizationServiceClient) CreateWorkspace(ctx context.Context, in *CreateWorkspaceRequest, opts ...grpc.CallOption) (*CreateWorkspaceResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateWorkspaceResponse)
@@ -400,109 +602,437 @@ func (c *organizationServiceClient) DeleteWorkspace(ctx context.Context, in *Del
 	return out, nil
 }
 
-// OrganizationServiceServer is the server API for OrganizationService service.
-// All implementations must embed UnimplementedOrganizationServiceServer
-// for forward compatibility.
-type OrganizationServiceServer interface {
-	// Organization Member Management
-	ListOrgMembers(context.Context, *ListOrgMembersRequest) (*ListOrgMembersResponse, error)
-	// Team Management
-	CreateTeam(context.Context, *CreateTeamRequest) (*CreateTeamResponse, error)
-	GetTeam(context.Context, *GetTeamRequest) (*GetTeamResponse, error)
-	ListTeams(context.Context, *ListTeamsRequest) (*ListTeamsResponse, error)
-	UpdateTeam(context.Context, *UpdateTeamRequest) (*UpdateTeamResponse, error)
-	DeleteTeam(context.Context, *DeleteTeamRequest) (*DeleteTeamResponse, error)
-	AddTeamMember(context.Context, *AddTeamMemberRequest) (*AddTeamMemberResponse, error)
-	RemoveTeamMember(context.Context, *RemoveTeamMemberRequest) (*RemoveTeamMemberResponse, error)
-	ListTeamMembers(context.Context, *ListTeamMembersRequest) (*ListTeamMembersResponse, error)
-	// Project Management
-	CreateProject(context.Context, *CreateProjectRequest) (*CreateProjectResponse, error)
-	GetProject(context.Context, *GetProjectRequest) (*GetProjectResponse, error)
-	ListProjects(context.Context, *ListProjectsRequest) (*ListProjectsResponse, error)
-	UpdateProject(context.Context, *UpdateProjectRequest) (*UpdateProjectResponse, error)
-	DeleteProject(context.Context, *DeleteProjectRequest) (*DeleteProjectResponse, error)
-	AssignTeamToProject(context.Context, *AssignTeamToProjectRequest) (*AssignTeamToProjectResponse, error)
-	RemoveTeamFromProject(context.Context, *RemoveTeamFromProjectRequest) (*RemoveTeamFromProjectResponse, error)
-	AddProjectMember(context.Context, *AddProjectMemberRequest) (*AddProjectMemberResponse, error)
-	RemoveProjectMember(context.Context, *RemoveProjectMemberRequest) (*RemoveProjectMemberResponse, error)
-	// Group Management
-	CreateGroup(context.Context, *CreateGroupRequest) (*CreateGroupResponse, error)
-	GetGroup(context.Context, *GetGroupRequest) (*GetGroupResponse, error)
-	ListGroups(context.Context, *ListGroupsRequest) (*ListGroupsResponse, error)
-	UpdateGroup(context.Context, *UpdateGroupRequest) (*UpdateGroupResponse, error)
-	DeleteGroup(context.Context, *DeleteGroupRequest) (*DeleteGroupResponse, error)
-	AddGroupMember(context.Context, *AddGroupMemberRequest) (*AddGroupMemberResponse, error)
-	RemoveGroupMember(context.Context, *RemoveGroupMemberRequest) (*RemoveGroupMemberResponse, error)
-	// Workspace Management
-	CreateWorkspace(context.Context, *CreateWorkspaceRequest) (*CreateWorkspaceResponse, error)
-	GetWorkspace(context.Context, *GetWorkspaceRequest) (*GetWorkspaceResponse, error)
-	ListWorkspaces(context.Context, *ListWorkspacesRequest) (*ListWorkspacesResponse, error)
-	UpdateWorkspace(context.Context, *UpdateWorkspaceRequest) (*UpdateWorkspaceResponse, error)
-	DeleteWorkspace(context.Context, *DeleteWorkspaceRequest) (*DeleteWorkspaceResponse, error)
-	mustEmbedUnimplementedOrganizationServiceServer()
+func (c *organizationServiceClient) AddWorkspaceMember(ctx context.Context, in *AddWorkspaceMemberRequest, opts ...grpc.CallOption) (*AddWorkspaceMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddWorkspaceMemberResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_AddWorkspaceMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedOrganizationServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedOrganizationServiceServer struct{}
-
-func (UnimplementedOrganizationServiceServer) ListOrgMembers(context.Context, *ListOrgMembersRequest) (*ListOrgMembersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListOrgMembers not implemented")
+func (c *organizationServiceClient) RemoveWorkspaceMember(ctx context.Context, in *RemoveWorkspaceMemberRequest, opts ...grpc.CallOption) (*RemoveWorkspaceMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveWorkspaceMemberResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_RemoveWorkspaceMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrganizationServiceServer) CreateTeam(context.Context, *CreateTeamRequest) (*CreateTeamResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTeam not implemented")
+
+func (c *organizationServiceClient) ListWorkspaceMembers(ctx context.Context, in *ListWorkspaceMembersRequest, opts ...grpc.CallOption) (*ListWorkspaceMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWorkspaceMembersResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_ListWorkspaceMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrganizationServiceServer) GetTeam(context.Context, *GetTeamRequest) (*GetTeamResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTeam not implemented")
+
+func (c *organizationServiceClient) CreateProjectTemplate(ctx context.Context, in *CreateProjectTemplateRequest, opts ...grpc.CallOption) (*CreateProjectTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateProjectTemplateResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_CreateProjectTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrganizationServiceServer) ListTeams(context.Context, *ListTeamsRequest) (*ListTeamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTeams not implemented")
+
+func (c *organizationServiceClient) GetProjectTemplate(ctx context.Context, in *GetProjectTemplateRequest, opts ...grpc.CallOption) (*GetProjectTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProjectTemplateResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_GetProjectTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrganizationServiceServer) UpdateTeam(context.Context, *UpdateTeamRequest) (*UpdateTeamResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateTeam not implemented")
+
+func (c *organizationServiceClient) ListProjectTemplates(ctx context.Context, in *ListProjectTemplatesRequest, opts ...grpc.CallOption) (*ListProjectTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProjectTemplatesResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_ListProjectTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrganizationServiceServer) DeleteTeam(context.Context, *DeleteTeamRequest) (*DeleteTeamResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteTeam not implemented")
+
+func (c *organizationServiceClient) DeleteProjectTemplate(ctx context.Context, in *DeleteProjectTemplateRequest, opts ...grpc.CallOption) (*DeleteProjectTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteProjectTemplateResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_DeleteProjectTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrganizationServiceServer) AddTeamMember(context.Context, *AddTeamMemberRequest) (*AddTeamMemberResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddTeamMember not implemented")
+
+func (c *organizationServiceClient) CreateProjectFromTemplate(ctx context.Context, in *CreateProjectFromTemplateRequest, opts ...grpc.CallOption) (*CreateProjectFromTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateProjectFromTemplateResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_CreateProjectFromTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrganizationServiceServer) RemoveTeamMember(context.Context, *RemoveTeamMemberRequest) (*RemoveTeamMemberResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveTeamMember not implemented")
+
+func (c *organizationServiceClient) CreateWorkspaceTemplate(ctx context.Context, in *CreateWorkspaceTemplateRequest, opts ...grpc.CallOption) (*CreateWorkspaceTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateWorkspaceTemplateResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_CreateWorkspaceTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrganizationServiceServer) ListTeamMembers(context.Context, *ListTeamMembersRequest) (*ListTeamMembersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTeamMembers not implemented")
+
+func (c *organizationServiceClient) GetWorkspaceTemplate(ctx context.Context, in *GetWorkspaceTemplateRequest, opts ...grpc.CallOption) (*GetWorkspaceTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWorkspaceTemplateResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_GetWorkspaceTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrganizationServiceServer) CreateProject(context.Context, *CreateProjectRequest) (*CreateProjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateProject not implemented")
+
+func (c *organizationServiceClient) ListWorkspaceTemplates(ctx context.Context, in *ListWorkspaceTemplatesRequest, opts ...grpc.CallOption) (*ListWorkspaceTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWorkspaceTemplatesResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_ListWorkspaceTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrganizationServiceServer) GetProject(context.Context, *GetProjectRequest) (*GetProjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProject not implemented")
+
+func (c *organizationServiceClient) DeleteWorkspaceTemplate(ctx context.Context, in *DeleteWorkspaceTemplateRequest, opts ...grpc.CallOption) (*DeleteWorkspaceTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWorkspaceTemplateResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_DeleteWorkspaceTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrganizationServiceServer) ListProjects(context.Context, *ListProjectsRequest) (*ListProjectsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListProjects not implemented")
+
+func (c *organizationServiceClient) CreateWorkspaceFromTemplate(ctx context.Context, in *CreateWorkspaceFromTemplateRequest, opts ...grpc.CallOption) (*CreateWorkspaceFromTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateWorkspaceFromTemplateResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_CreateWorkspaceFromTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrganizationServiceServer) UpdateProject(context.Context, *UpdateProjectRequest) (*UpdateProjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateProject not implemented")
+
+func (c *organizationServiceClient) CreateCustomRole(ctx context.Context, in *CreateCustomRoleRequest, opts ...grpc.CallOption) (*CreateCustomRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCustomRoleResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_CreateCustomRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrganizationServiceServer) DeleteProject(context.Context, *DeleteProjectRequest) (*DeleteProjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteProject not implemented")
+
+func (c *organizationServiceClient) ListCustomRoles(ctx context.Context, in *ListCustomRolesRequest, opts ...grpc.CallOption) (*ListCustomRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCustomRolesResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_ListCustomRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrganizationServiceServer) AssignTeamToProject(context.Context, *AssignTeamToProjectRequest) (*AssignTeamToProjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AssignTeamToProject not implemented")
+
+func (c *organizationServiceClient) UpdateCustomRole(ctx context.Context, in *UpdateCustomRoleRequest, opts ...grpc.CallOption) (*UpdateCustomRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCustomRoleResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_UpdateCustomRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrganizationServiceServer) RemoveTeamFromProject(context.Context, *RemoveTeamFromProjectRequest) (*RemoveTeamFromProjectResponse, error) {
+
+func (c *organizationServiceClient) DeleteCustomRole(ctx context.Context, in *DeleteCustomRoleRequest, opts ...grpc.CallOption) (*DeleteCustomRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCustomRoleResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_DeleteCustomRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) AssignCustomRole(ctx context.Context, in *AssignCustomRoleRequest, opts ...grpc.CallOption) (*AssignCustomRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignCustomRoleResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_AssignCustomRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) UnassignCustomRole(ctx context.Context, in *UnassignCustomRoleRequest, opts ...grpc.CallOption) (*UnassignCustomRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnassignCustomRoleResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_UnassignCustomRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) ListCustomRoleAssignments(ctx context.Context, in *ListCustomRoleAssignmentsRequest, opts ...grpc.CallOption) (*ListCustomRoleAssignmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCustomRoleAssignmentsResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_ListCustomRoleAssignments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) GetCapacityReport(ctx context.Context, in *GetCapacityReportRequest, opts ...grpc.CallOption) (*GetCapacityReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCapacityReportResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_GetCapacityReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) SearchOrganization(ctx context.Context, in *SearchOrganizationRequest, opts ...grpc.CallOption) (*SearchOrganizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchOrganizationResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_SearchOrganization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) GetMetadataSchema(ctx context.Context, in *GetMetadataSchemaRequest, opts ...grpc.CallOption) (*GetMetadataSchemaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMetadataSchemaResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_GetMetadataSchema_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) GetOrgUsage(ctx context.Context, in *GetOrgUsageRequest, opts ...grpc.CallOption) (*GetOrgUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrgUsageResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_GetOrgUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) RecordOrgUsage(ctx context.Context, in *RecordOrgUsageRequest, opts ...grpc.CallOption) (*RecordOrgUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordOrgUsageResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_RecordOrgUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OrganizationServiceServer is the server API for OrganizationService service.
+// All implementations must embed UnimplementedOrganizationServiceServer
+// for forward compatibility.
+type OrganizationServiceServer interface {
+	// Organization Member Management
+	ListOrgMembers(context.Context, *ListOrgMembersRequest) (*ListOrgMembersResponse, error)
+	// Team Management
+	CreateTeam(context.Context, *CreateTeamRequest) (*CreateTeamResponse, error)
+	GetTeam(context.Context, *GetTeamRequest) (*GetTeamResponse, error)
+	ListTeams(context.Context, *ListTeamsRequest) (*ListTeamsResponse, error)
+	UpdateTeam(context.Context, *UpdateTeamRequest) (*UpdateTeamResponse, error)
+	DeleteTeam(context.Context, *DeleteTeamRequest) (*DeleteTeamResponse, error)
+	AddTeamMember(context.Context, *AddTeamMemberRequest) (*AddTeamMemberResponse, error)
+	BulkAddTeamMembers(context.Context, *BulkAddTeamMembersRequest) (*BulkAddTeamMembersResponse, error)
+	RemoveTeamMember(context.Context, *RemoveTeamMemberRequest) (*RemoveTeamMemberResponse, error)
+	ListTeamMembers(context.Context, *ListTeamMembersRequest) (*ListTeamMembersResponse, error)
+	TransferTeamOwnership(context.Context, *TransferTeamOwnershipRequest) (*TransferTeamOwnershipResponse, error)
+	// Project Management
+	CreateProject(context.Context, *CreateProjectRequest) (*CreateProjectResponse, error)
+	GetProject(context.Context, *GetProjectRequest) (*GetProjectResponse, error)
+	ListProjects(context.Context, *ListProjectsRequest) (*ListProjectsResponse, error)
+	UpdateProject(context.Context, *UpdateProjectRequest) (*UpdateProjectResponse, error)
+	ArchiveProject(context.Context, *ArchiveProjectRequest) (*ArchiveProjectResponse, error)
+	UnarchiveProject(context.Context, *UnarchiveProjectRequest) (*UnarchiveProjectResponse, error)
+	DeleteProject(context.Context, *DeleteProjectRequest) (*DeleteProjectResponse, error)
+	AssignTeamToProject(context.Context, *AssignTeamToProjectRequest) (*AssignTeamToProjectResponse, error)
+	RemoveTeamFromProject(context.Context, *RemoveTeamFromProjectRequest) (*RemoveTeamFromProjectResponse, error)
+	AddProjectMember(context.Context, *AddProjectMemberRequest) (*AddProjectMemberResponse, error)
+	BulkAddProjectMembers(context.Context, *BulkAddProjectMembersRequest) (*BulkAddProjectMembersResponse, error)
+	RemoveProjectMember(context.Context, *RemoveProjectMemberRequest) (*RemoveProjectMemberResponse, error)
+	TransferProjectManager(context.Context, *TransferProjectManagerRequest) (*TransferProjectManagerResponse, error)
+	GetProjectTimeline(context.Context, *GetProjectTimelineRequest) (*GetProjectTimelineResponse, error)
+	SubmitProjectStatusReport(context.Context, *SubmitProjectStatusReportRequest) (*SubmitProjectStatusReportResponse, error)
+	ListProjectStatusReports(context.Context, *ListProjectStatusReportsRequest) (*ListProjectStatusReportsResponse, error)
+	GetProjectActivity(context.Context, *GetProjectActivityRequest) (*GetProjectActivityResponse, error)
+	CompleteProjectMilestone(context.Context, *CompleteProjectMilestoneRequest) (*CompleteProjectMilestoneResponse, error)
+	// Group Management
+	CreateGroup(context.Context, *CreateGroupRequest) (*CreateGroupResponse, error)
+	GetGroup(context.Context, *GetGroupRequest) (*GetGroupResponse, error)
+	ListGroups(context.Context, *ListGroupsRequest) (*ListGroupsResponse, error)
+	UpdateGroup(context.Context, *UpdateGroupRequest) (*UpdateGroupResponse, error)
+	DeleteGroup(context.Context, *DeleteGroupRequest) (*DeleteGroupResponse, error)
+	AddGroupMember(context.Context, *AddGroupMemberRequest) (*AddGroupMemberResponse, error)
+	RemoveGroupMember(context.Context, *RemoveGroupMemberRequest) (*RemoveGroupMemberResponse, error)
+	TransferGroupOwner(context.Context, *TransferGroupOwnerRequest) (*TransferGroupOwnerResponse, error)
+	// Workspace Management
+	CreateWorkspace(context.Context, *CreateWorkspaceRequest) (*CreateWorkspaceResponse, error)
+	GetWorkspace(context.Context, *GetWorkspaceRequest) (*GetWorkspaceResponse, error)
+	ListWorkspaces(context.Context, *ListWorkspacesRequest) (*ListWorkspacesResponse, error)
+	UpdateWorkspace(context.Context, *UpdateWorkspaceRequest) (*UpdateWorkspaceResponse, error)
+	DeleteWorkspace(context.Context, *DeleteWorkspaceRequest) (*DeleteWorkspaceResponse, error)
+	AddWorkspaceMember(context.Context, *AddWorkspaceMemberRequest) (*AddWorkspaceMemberResponse, error)
+	RemoveWorkspaceMember(context.Context, *RemoveWorkspaceMemberRequest) (*RemoveWorkspaceMemberResponse, error)
+	ListWorkspaceMembers(context.Context, *ListWorkspaceMembersRequest) (*ListWorkspaceMembersResponse, error)
+	// Project Templates
+	CreateProjectTemplate(context.Context, *CreateProjectTemplateRequest) (*CreateProjectTemplateResponse, error)
+	GetProjectTemplate(context.Context, *GetProjectTemplateRequest) (*GetProjectTemplateResponse, error)
+	ListProjectTemplates(context.Context, *ListProjectTemplatesRequest) (*ListProjectTemplatesResponse, error)
+	DeleteProjectTemplate(context.Context, *DeleteProjectTemplateRequest) (*DeleteProjectTemplateResponse, error)
+	CreateProjectFromTemplate(context.Context, *CreateProjectFromTemplateRequest) (*CreateProjectFromTemplateResponse, error)
+	// Workspace Templates
+	CreateWorkspaceTemplate(context.Context, *CreateWorkspaceTemplateRequest) (*CreateWorkspaceTemplateResponse, error)
+	GetWorkspaceTemplate(context.Context, *GetWorkspaceTemplateRequest) (*GetWorkspaceTemplateResponse, error)
+	ListWorkspaceTemplates(context.Context, *ListWorkspaceTemplatesRequest) (*ListWorkspaceTemplatesResponse, error)
+	DeleteWorkspaceTemplate(context.Context, *DeleteWorkspaceTemplateRequest) (*DeleteWorkspaceTemplateResponse, error)
+	CreateWorkspaceFromTemplate(context.Context, *CreateWorkspaceFromTemplateRequest) (*CreateWorkspaceFromTemplateResponse, error)
+	// Custom Roles
+	CreateCustomRole(context.Context, *CreateCustomRoleRequest) (*CreateCustomRoleResponse, error)
+	ListCustomRoles(context.Context, *ListCustomRolesRequest) (*ListCustomRolesResponse, error)
+	UpdateCustomRole(context.Context, *UpdateCustomRoleRequest) (*UpdateCustomRoleResponse, error)
+	DeleteCustomRole(context.Context, *DeleteCustomRoleRequest) (*DeleteCustomRoleResponse, error)
+	AssignCustomRole(context.Context, *AssignCustomRoleRequest) (*AssignCustomRoleResponse, error)
+	UnassignCustomRole(context.Context, *UnassignCustomRoleRequest) (*UnassignCustomRoleResponse, error)
+	ListCustomRoleAssignments(context.Context, *ListCustomRoleAssignmentsRequest) (*ListCustomRoleAssignmentsResponse, error)
+	// Capacity
+	GetCapacityReport(context.Context, *GetCapacityReportRequest) (*GetCapacityReportResponse, error)
+	// Search
+	SearchOrganization(context.Context, *SearchOrganizationRequest) (*SearchOrganizationResponse, error)
+	// Metadata
+	GetMetadataSchema(context.Context, *GetMetadataSchemaRequest) (*GetMetadataSchemaResponse, error)
+	// Usage
+	GetOrgUsage(context.Context, *GetOrgUsageRequest) (*GetOrgUsageResponse, error)
+	// Add the gateway's request counts to the daily usage (internal, used by the gateway)
+	RecordOrgUsage(context.Context, *RecordOrgUsageRequest) (*RecordOrgUsageResponse, error)
+	mustEmbedUnimplementedOrganizationServiceServer()
+}
+
+// UnimplementedOrganizationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedOrganizationServiceServer struct{}
+
+func (UnimplementedOrganizationServiceServer) ListOrgMembers(context.Context, *ListOrgMembersRequest) (*ListOrgMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrgMembers not implemented")
+}
+func (UnimplementedOrganizationServiceServer) CreateTeam(context.Context, *CreateTeamRequest) (*CreateTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTeam not implemented")
+}
+func (UnimplementedOrganizationServiceServer) GetTeam(context.Context, *GetTeamRequest) (*GetTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeam not implemented")
+}
+func (UnimplementedOrganizationServiceServer) ListTeams(context.Context, *ListTeamsRequest) (*ListTeamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTeams not implemented")
+}
+func (UnimplementedOrganizationServiceServer) UpdateTeam(context.Context, *UpdateTeamRequest) (*UpdateTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTeam not implemented")
+}
+func (UnimplementedOrganizationServiceServer) DeleteTeam(context.Context, *DeleteTeamRequest) (*DeleteTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTeam not implemented")
+}
+func (UnimplementedOrganizationServiceServer) AddTeamMember(context.Context, *AddTeamMemberRequest) (*AddTeamMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTeamMember not implemented")
+}
+func (UnimplementedOrganizationServiceServer) BulkAddTeamMembers(context.Context, *BulkAddTeamMembersRequest) (*BulkAddTeamMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkAddTeamMembers not implemented")
+}
+func (UnimplementedOrganizationServiceServer) RemoveTeamMember(context.Context, *RemoveTeamMemberRequest) (*RemoveTeamMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTeamMember not implemented")
+}
+func (UnimplementedOrganizationServiceServer) ListTeamMembers(context.Context, *ListTeamMembersRequest) (*ListTeamMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTeamMembers not implemented")
+}
+func (UnimplementedOrganizationServiceServer) TransferTeamOwnership(context.Context, *TransferTeamOwnershipRequest) (*TransferTeamOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferTeamOwnership not implemented")
+}
+func (UnimplementedOrganizationServiceServer) CreateProject(context.Context, *CreateProjectRequest) (*CreateProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProject not implemented")
+}
+func (UnimplementedOrganizationServiceServer) GetProject(context.Context, *GetProjectRequest) (*GetProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProject not implemented")
+}
+func (UnimplementedOrganizationServiceServer) ListProjects(context.Context, *ListProjectsRequest) (*ListProjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProjects not implemented")
+}
+func (UnimplementedOrganizationServiceServer) UpdateProject(context.Context, *UpdateProjectRequest) (*UpdateProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProject not implemented")
+}
+func (UnimplementedOrganizationServiceServer) ArchiveProject(context.Context, *ArchiveProjectRequest) (*ArchiveProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveProject not implemented")
+}
+func (UnimplementedOrganizationServiceServer) UnarchiveProject(context.Context, *UnarchiveProjectRequest) (*UnarchiveProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnarchiveProject not implemented")
+}
+func (UnimplementedOrganizationServiceServer) DeleteProject(context.Context, *DeleteProjectRequest) (*DeleteProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProject not implemented")
+}
+func (UnimplementedOrganizationServiceServer) AssignTeamToProject(context.Context, *AssignTeamToProjectRequest) (*AssignTeamToProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignTeamToProject not implemented")
+}
+func (UnimplementedOrganizationServiceServer) RemoveTeamFromProject(context.Context, *RemoveTeamFromProjectRequest) (*RemoveTeamFromProjectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveTeamFromProject not implemented")
 }
 func (UnimplementedOrganizationServiceServer) AddProjectMember(context.Context, *AddProjectMemberRequest) (*AddProjectMemberResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddProjectMember not implemented")
 }
+func (UnimplementedOrganizationServiceServer) BulkAddProjectMembers(context.Context, *BulkAddProjectMembersRequest) (*BulkAddProjectMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkAddProjectMembers not implemented")
+}
 func (UnimplementedOrganizationServiceServer) RemoveProjectMember(context.Context, *RemoveProjectMemberRequest) (*RemoveProjectMemberResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveProjectMember not implemented")
 }
+func (UnimplementedOrganizationServiceServer) TransferProjectManager(context.Context, *TransferProjectManagerRequest) (*TransferProjectManagerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferProjectManager not implemented")
+}
+func (UnimplementedOrganizationServiceServer) GetProjectTimeline(context.Context, *GetProjectTimelineRequest) (*GetProjectTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProjectTimeline not implemented")
+}
+func (UnimplementedOrganizationServiceServer) SubmitProjectStatusReport(context.Context, *SubmitProjectStatusReportRequest) (*SubmitProjectStatusReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitProjectStatusReport not implemented")
+}
+func (UnimplementedOrganizationServiceServer) ListProjectStatusReports(context.Context, *ListProjectStatusReportsRequest) (*ListProjectStatusReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProjectStatusReports not implemented")
+}
+func (UnimplementedOrganizationServiceServer) GetProjectActivity(context.Context, *GetProjectActivityRequest) (*GetProjectActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProjectActivity not implemented")
+}
+func (UnimplementedOrganizationServiceServer) CompleteProjectMilestone(context.Context, *CompleteProjectMilestoneRequest) (*CompleteProjectMilestoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteProjectMilestone not implemented")
+}
 func (UnimplementedOrganizationServiceServer) CreateGroup(context.Context, *CreateGroupRequest) (*CreateGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
 }
@@ -524,6 +1054,9 @@ func (UnimplementedOrganizationServiceServer) AddGroupMember(context.Context, *A
 func (UnimplementedOrganizationServiceServer) RemoveGroupMember(context.Context, *RemoveGroupMemberRequest) (*RemoveGroupMemberResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveGroupMember not implemented")
 }
+func (UnimplementedOrganizationServiceServer) TransferGroupOwner(context.Context, *TransferGroupOwnerRequest) (*TransferGroupOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferGroupOwner not implemented")
+}
 func (UnimplementedOrganizationServiceServer) CreateWorkspace(context.Context, *CreateWorkspaceRequest) (*CreateWorkspaceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateWorkspace not implemented")
 }
@@ -539,6 +1072,81 @@ func (UnimplementedOrganizationServiceServer) UpdateWorkspace(context.Context, *
 func (UnimplementedOrganizationServiceServer) DeleteWorkspace(context.Context, *DeleteWorkspaceRequest) (*DeleteWorkspaceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkspace not implemented")
 }
+func (UnimplementedOrganizationServiceServer) AddWorkspaceMember(context.Context, *AddWorkspaceMemberRequest) (*AddWorkspaceMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWorkspaceMember not implemented")
+}
+func (UnimplementedOrganizationServiceServer) RemoveWorkspaceMember(context.Context, *RemoveWorkspaceMemberRequest) (*RemoveWorkspaceMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWorkspaceMember not implemented")
+}
+func (UnimplementedOrganizationServiceServer) ListWorkspaceMembers(context.Context, *ListWorkspaceMembersRequest) (*ListWorkspaceMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkspaceMembers not implemented")
+}
+func (UnimplementedOrganizationServiceServer) CreateProjectTemplate(context.Context, *CreateProjectTemplateRequest) (*CreateProjectTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProjectTemplate not implemented")
+}
+func (UnimplementedOrganizationServiceServer) GetProjectTemplate(context.Context, *GetProjectTemplateRequest) (*GetProjectTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProjectTemplate not implemented")
+}
+func (UnimplementedOrganizationServiceServer) ListProjectTemplates(context.Context, *ListProjectTemplatesRequest) (*ListProjectTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProjectTemplates not implemented")
+}
+func (UnimplementedOrganizationServiceServer) DeleteProjectTemplate(context.Context, *DeleteProjectTemplateRequest) (*DeleteProjectTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProjectTemplate not implemented")
+}
+func (UnimplementedOrganizationServiceServer) CreateProjectFromTemplate(context.Context, *CreateProjectFromTemplateRequest) (*CreateProjectFromTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProjectFromTemplate not implemented")
+}
+func (UnimplementedOrganizationServiceServer) CreateWorkspaceTemplate(context.Context, *CreateWorkspaceTemplateRequest) (*CreateWorkspaceTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWorkspaceTemplate not implemented")
+}
+func (UnimplementedOrganizationServiceServer) GetWorkspaceTemplate(context.Context, *GetWorkspaceTemplateRequest) (*GetWorkspaceTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkspaceTemplate not implemented")
+}
+func (UnimplementedOrganizationServiceServer) ListWorkspaceTemplates(context.Context, *ListWorkspaceTemplatesRequest) (*ListWorkspaceTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkspaceTemplates not implemented")
+}
+func (UnimplementedOrganizationServiceServer) DeleteWorkspaceTemplate(context.Context, *DeleteWorkspaceTemplateRequest) (*DeleteWorkspaceTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkspaceTemplate not implemented")
+}
+func (UnimplementedOrganizationServiceServer) CreateWorkspaceFromTemplate(context.Context, *CreateWorkspaceFromTemplateRequest) (*CreateWorkspaceFromTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWorkspaceFromTemplate not implemented")
+}
+func (UnimplementedOrganizationServiceServer) CreateCustomRole(context.Context, *CreateCustomRoleRequest) (*CreateCustomRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCustomRole not implemented")
+}
+func (UnimplementedOrganizationServiceServer) ListCustomRoles(context.Context, *ListCustomRolesRequest) (*ListCustomRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCustomRoles not implemented")
+}
+func (UnimplementedOrganizationServiceServer) UpdateCustomRole(context.Context, *UpdateCustomRoleRequest) (*UpdateCustomRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCustomRole not implemented")
+}
+func (UnimplementedOrganizationServiceServer) DeleteCustomRole(context.Context, *DeleteCustomRoleRequest) (*DeleteCustomRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCustomRole not implemented")
+}
+func (UnimplementedOrganizationServiceServer) AssignCustomRole(context.Context, *AssignCustomRoleRequest) (*AssignCustomRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignCustomRole not implemented")
+}
+func (UnimplementedOrganizationServiceServer) UnassignCustomRole(context.Context, *UnassignCustomRoleRequest) (*UnassignCustomRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnassignCustomRole not implemented")
+}
+func (UnimplementedOrganizationServiceServer) ListCustomRoleAssignments(context.Context, *ListCustomRoleAssignmentsRequest) (*ListCustomRoleAssignmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCustomRoleAssignments not implemented")
+}
+func (UnimplementedOrganizationServiceServer) GetCapacityReport(context.Context, *GetCapacityReportRequest) (*GetCapacityReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCapacityReport not implemented")
+}
+func (UnimplementedOrganizationServiceServer) SearchOrganization(context.Context, *SearchOrganizationRequest) (*SearchOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchOrganization not implemented")
+}
+func (UnimplementedOrganizationServiceServer) GetMetadataSchema(context.Context, *GetMetadataSchemaRequest) (*GetMetadataSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetadataSchema not implemented")
+}
+func (UnimplementedOrganizationServiceServer) GetOrgUsage(context.Context, *GetOrgUsageRequest) (*GetOrgUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrgUsage not implemented")
+}
+func (UnimplementedOrganizationServiceServer) RecordOrgUsage(context.Context, *RecordOrgUsageRequest) (*RecordOrgUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordOrgUsage not implemented")
+}
 func (UnimplementedOrganizationServiceServer) mustEmbedUnimplementedOrganizationServiceServer() {}
 func (UnimplementedOrganizationServiceServer) testEmbeddedByValue()                             {}
 
@@ -557,545 +1165,1211 @@ func RegisterOrganizationServiceServer(s grpc.ServiceRegistrar, srv Organization
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&OrganizationService_ServiceDesc, srv)
+	s.RegisterService(&OrganizationService_ServiceDesc, srv)
+}
+
+func _OrganizationService_ListOrgMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrgMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).ListOrgMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_ListOrgMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).ListOrgMembers(ctx, req.(*ListOrgMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_CreateTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).CreateTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_CreateTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).CreateTeam(ctx, req.(*CreateTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_GetTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).GetTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_GetTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).GetTeam(ctx, req.(*GetTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_ListTeams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTeamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).ListTeams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_ListTeams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).ListTeams(ctx, req.(*ListTeamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_UpdateTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).UpdateTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_UpdateTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).UpdateTeam(ctx, req.(*UpdateTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_DeleteTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).DeleteTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_DeleteTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).DeleteTeam(ctx, req.(*DeleteTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_AddTeamMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTeamMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).AddTeamMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_AddTeamMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).AddTeamMember(ctx, req.(*AddTeamMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_BulkAddTeamMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkAddTeamMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).BulkAddTeamMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_BulkAddTeamMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).BulkAddTeamMembers(ctx, req.(*BulkAddTeamMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_RemoveTeamMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTeamMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).RemoveTeamMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_RemoveTeamMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).RemoveTeamMember(ctx, req.(*RemoveTeamMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_ListTeamMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTeamMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).ListTeamMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_ListTeamMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).ListTeamMembers(ctx, req.(*ListTeamMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_TransferTeamOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferTeamOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).TransferTeamOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_TransferTeamOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).TransferTeamOwnership(ctx, req.(*TransferTeamOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_CreateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).CreateProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_CreateProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).CreateProject(ctx, req.(*CreateProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_GetProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).GetProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_GetProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).GetProject(ctx, req.(*GetProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_ListProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).ListProjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_ListProjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).ListProjects(ctx, req.(*ListProjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_UpdateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).UpdateProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_UpdateProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).UpdateProject(ctx, req.(*UpdateProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_ArchiveProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).ArchiveProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_ArchiveProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).ArchiveProject(ctx, req.(*ArchiveProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_UnarchiveProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnarchiveProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).UnarchiveProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_UnarchiveProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).UnarchiveProject(ctx, req.(*UnarchiveProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_DeleteProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).DeleteProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_DeleteProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).DeleteProject(ctx, req.(*DeleteProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_AssignTeamToProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignTeamToProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).AssignTeamToProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_AssignTeamToProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).AssignTeamToProject(ctx, req.(*AssignTeamToProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_RemoveTeamFromProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTeamFromProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).RemoveTeamFromProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_RemoveTeamFromProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).RemoveTeamFromProject(ctx, req.(*RemoveTeamFromProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_AddProjectMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddProjectMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).AddProjectMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_AddProjectMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).AddProjectMember(ctx, req.(*AddProjectMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_BulkAddProjectMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkAddProjectMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).BulkAddProjectMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_BulkAddProjectMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).BulkAddProjectMembers(ctx, req.(*BulkAddProjectMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_RemoveProjectMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveProjectMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).RemoveProjectMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_RemoveProjectMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).RemoveProjectMember(ctx, req.(*RemoveProjectMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_TransferProjectManager_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferProjectManagerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).TransferProjectManager(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_TransferProjectManager_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).TransferProjectManager(ctx, req.(*TransferProjectManagerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_GetProjectTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).GetProjectTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_GetProjectTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).GetProjectTimeline(ctx, req.(*GetProjectTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_SubmitProjectStatusReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitProjectStatusReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).SubmitProjectStatusReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_SubmitProjectStatusReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).SubmitProjectStatusReport(ctx, req.(*SubmitProjectStatusReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_ListProjectStatusReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectStatusReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).ListProjectStatusReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_ListProjectStatusReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).ListProjectStatusReports(ctx, req.(*ListProjectStatusReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_GetProjectActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).GetProjectActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_GetProjectActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).GetProjectActivity(ctx, req.(*GetProjectActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_CompleteProjectMilestone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteProjectMilestoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).CompleteProjectMilestone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_CompleteProjectMilestone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).CompleteProjectMilestone(ctx, req.(*CompleteProjectMilestoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).CreateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_CreateGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).CreateGroup(ctx, req.(*CreateGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_GetGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).GetGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_GetGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).GetGroup(ctx, req.(*GetGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_ListGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).ListGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_ListGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).ListGroups(ctx, req.(*ListGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_UpdateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).UpdateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_UpdateGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).UpdateGroup(ctx, req.(*UpdateGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_DeleteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).DeleteGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_DeleteGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).DeleteGroup(ctx, req.(*DeleteGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_AddGroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddGroupMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).AddGroupMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_AddGroupMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).AddGroupMember(ctx, req.(*AddGroupMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_RemoveGroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveGroupMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).RemoveGroupMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_RemoveGroupMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).RemoveGroupMember(ctx, req.(*RemoveGroupMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_TransferGroupOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferGroupOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).TransferGroupOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_TransferGroupOwner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).TransferGroupOwner(ctx, req.(*TransferGroupOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _OrganizationService_ListOrgMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListOrgMembersRequest)
+func _OrganizationService_CreateWorkspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWorkspaceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).ListOrgMembers(ctx, in)
+		return srv.(OrganizationServiceServer).CreateWorkspace(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrganizationService_ListOrgMembers_FullMethodName,
+		FullMethod: OrganizationService_CreateWorkspace_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).ListOrgMembers(ctx, req.(*ListOrgMembersRequest))
+		return srv.(OrganizationServiceServer).CreateWorkspace(ctx, req.(*CreateWorkspaceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrganizationService_CreateTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTeamRequest)
+func _OrganizationService_GetWorkspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkspaceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).CreateTeam(ctx, in)
+		return srv.(OrganizationServiceServer).GetWorkspace(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrganizationService_CreateTeam_FullMethodName,
+		FullMethod: OrganizationService_GetWorkspace_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).CreateTeam(ctx, req.(*CreateTeamRequest))
+		return srv.(OrganizationServiceServer).GetWorkspace(ctx, req.(*GetWorkspaceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrganizationService_GetTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTeamRequest)
+func _OrganizationService_ListWorkspaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkspacesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).GetTeam(ctx, in)
+		return srv.(OrganizationServiceServer).ListWorkspaces(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrganizationService_GetTeam_FullMethodName,
+		FullMethod: OrganizationService_ListWorkspaces_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).GetTeam(ctx, req.(*GetTeamRequest))
+		return srv.(OrganizationServiceServer).ListWorkspaces(ctx, req.(*ListWorkspacesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrganizationService_ListTeams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTeamsRequest)
+func _OrganizationService_UpdateWorkspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkspaceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).ListTeams(ctx, in)
+		return srv.(OrganizationServiceServer).UpdateWorkspace(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrganizationService_ListTeams_FullMethodName,
+		FullMethod: OrganizationService_UpdateWorkspace_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).ListTeams(ctx, req.(*ListTeamsRequest))
+		return srv.(OrganizationServiceServer).UpdateWorkspace(ctx, req.(*UpdateWorkspaceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrganizationService_UpdateTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTeamRequest)
+func _OrganizationService_DeleteWorkspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWorkspaceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).UpdateTeam(ctx, in)
+		return srv.(OrganizationServiceServer).DeleteWorkspace(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrganizationService_UpdateTeam_FullMethodName,
+		FullMethod: OrganizationService_DeleteWorkspace_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).UpdateTeam(ctx, req.(*UpdateTeamRequest))
+		return srv.(OrganizationServiceServer).DeleteWorkspace(ctx, req.(*DeleteWorkspaceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrganizationService_DeleteTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTeamRequest)
+func _OrganizationService_AddWorkspaceMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddWorkspaceMemberRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).DeleteTeam(ctx, in)
+		return srv.(OrganizationServiceServer).AddWorkspaceMember(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrganizationService_DeleteTeam_FullMethodName,
+		FullMethod: OrganizationService_AddWorkspaceMember_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).DeleteTeam(ctx, req.(*DeleteTeamRequest))
+		return srv.(OrganizationServiceServer).AddWorkspaceMember(ctx, req.(*AddWorkspaceMemberRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrganizationService_AddTeamMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddTeamMemberRequest)
+func _OrganizationService_RemoveWorkspaceMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveWorkspaceMemberRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).AddTeamMember(ctx, in)
+		return srv.(OrganizationServiceServer).RemoveWorkspaceMember(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrganizationService_AddTeamMember_FullMethodName,
+		FullMethod: OrganizationService_RemoveWorkspaceMember_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).AddTeamMember(ctx, req.(*AddTeamMemberRequest))
+		return srv.(OrganizationServiceServer).RemoveWorkspaceMember(ctx, req.(*RemoveWorkspaceMemberRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrganizationService_RemoveTeamMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveTeamMemberRequest)
+func _OrganizationService_ListWorkspaceMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkspaceMembersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).RemoveTeamMember(ctx, in)
+		return srv.(OrganizationServiceServer).ListWorkspaceMembers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrganizationService_RemoveTeamMember_FullMethodName,
+		FullMethod: OrganizationService_ListWorkspaceMembers_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).RemoveTeamMember(ctx, req.(*RemoveTeamMemberRequest))
+		return srv.(OrganizationServiceServer).ListWorkspaceMembers(ctx, req.(*ListWorkspaceMembersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrganizationService_ListTeamMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTeamMembersRequest)
+func _OrganizationService_CreateProjectTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProjectTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).ListTeamMembers(ctx, in)
+		return srv.(OrganizationServiceServer).CreateProjectTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrganizationService_ListTeamMembers_FullMethodName,
+		FullMethod: OrganizationService_CreateProjectTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).ListTeamMembers(ctx, req.(*ListTeamMembersRequest))
+		return srv.(OrganizationServiceServer).CreateProjectTemplate(ctx, req.(*CreateProjectTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrganizationService_CreateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateProjectRequest)
+func _OrganizationService_GetProjectTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).CreateProject(ctx, in)
+		return srv.(OrganizationServiceServer).GetProjectTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrganizationService_CreateProject_FullMethodName,
+		FullMethod: OrganizationService_GetProjectTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).CreateProject(ctx, req.(*CreateProjectRequest))
+		return srv.(OrganizationServiceServer).GetProjectTemplate(ctx, req.(*GetProjectTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrganizationService_GetProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProjectRequest)
+func _OrganizationService_ListProjectTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectTemplatesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).GetProject(ctx, in)
+		return srv.(OrganizationServiceServer).ListProjectTemplates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrganizationService_GetProject_FullMethodName,
+		FullMethod: OrganizationService_ListProjectTemplates_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).GetProject(ctx, req.(*GetProjectRequest))
+		return srv.(OrganizationServiceServer).ListProjectTemplates(ctx, req.(*ListProjectTemplatesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrganizationService_ListProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListProjectsRequest)
+func _OrganizationService_DeleteProjectTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProjectTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).ListProjects(ctx, in)
+		return srv.(OrganizationServiceServer).DeleteProjectTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrganizationService_ListProjects_FullMethodName,
+		FullMethod: OrganizationService_DeleteProjectTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).ListProjects(ctx, req.(*ListProjectsRequest))
+		return srv.(OrganizationServiceServer).DeleteProjectTemplate(ctx, req.(*DeleteProjectTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrganizationService_UpdateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateProjectRequest)
+func _OrganizationService_CreateProjectFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProjectFromTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).UpdateProject(ctx, in)
+		return srv.(OrganizationServiceServer).CreateProjectFromTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrganizationService_UpdateProject_FullMethodName,
+		FullMethod: OrganizationService_CreateProjectFromTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).UpdateProject(ctx, req.(*UpdateProjectRequest))
+		return srv.(OrganizationServiceServer).CreateProjectFromTemplate(ctx, req.(*CreateProjectFromTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrganizationService_DeleteProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteProjectRequest)
+func _OrganizationService_CreateWorkspaceTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWorkspaceTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).DeleteProject(ctx, in)
+		return srv.(OrganizationServiceServer).CreateWorkspaceTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrganizationService_DeleteProject_FullMethodName,
+		FullMethod: OrganizationService_CreateWorkspaceTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).DeleteProject(ctx, req.(*DeleteProjectRequest))
+		return srv.(OrganizationServiceServer).CreateWorkspaceTemplate(ctx, req.(*CreateWorkspaceTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrganizationService_AssignTeamToProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AssignTeamToProjectRequest)
+func _OrganizationService_GetWorkspaceTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkspaceTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).AssignTeamToProject(ctx, in)
+		return srv.(OrganizationServiceServer).GetWorkspaceTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrganizationService_AssignTeamToProject_FullMethodName,
+		FullMethod: OrganizationService_GetWorkspaceTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).AssignTeamToProject(ctx, req.(*AssignTeamToProjectRequest))
+		return srv.(OrganizationServiceServer).GetWorkspaceTemplate(ctx, req.(*GetWorkspaceTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrganizationService_RemoveTeamFromProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveTeamFromProjectRequest)
+func _OrganizationService_ListWorkspaceTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkspaceTemplatesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).RemoveTeamFromProject(ctx, in)
+		return srv.(OrganizationServiceServer).ListWorkspaceTemplates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrganizationService_RemoveTeamFromProject_FullMethodName,
+		FullMethod: OrganizationService_ListWorkspaceTemplates_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).RemoveTeamFromProject(ctx, req.(*RemoveTeamFromProjectRequest))
+		return srv.(OrganizationServiceServer).ListWorkspaceTemplates(ctx, req.(*ListWorkspaceTemplatesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrganizationService_AddProjectMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddProjectMemberRequest)
+func _OrganizationService_DeleteWorkspaceTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWorkspaceTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).AddProjectMember(ctx, in)
+		return srv.(OrganizationServiceServer).DeleteWorkspaceTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrganizationService_AddProjectMember_FullMethodName,
+		FullMethod: OrganizationService_DeleteWorkspaceTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).AddProjectMember(ctx, req.(*AddProjectMemberRequest))
+		return srv.(OrganizationServiceServer).DeleteWorkspaceTemplate(ctx, req.(*DeleteWorkspaceTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrganizationService_RemoveProjectMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveProjectMemberRequest)
+func _OrganizationService_CreateWorkspaceFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWorkspaceFromTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).RemoveProjectMember(ctx, in)
+		return srv.(OrganizationServiceServer).CreateWorkspaceFromTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrganizationService_RemoveProjectMember_FullMethodName,
+		FullMethod: OrganizationService_CreateWorkspaceFromTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).RemoveProjectMember(ctx, req.(*RemoveProjectMemberRequest))
+		return srv.(OrganizationServiceServer).CreateWorkspaceFromTemplate(ctx, req.(*CreateWorkspaceFromTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrganizationService_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateGroupRequest)
+func _OrganizationService_CreateCustomRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCustomRoleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).CreateGroup(ctx, in)
+		return srv.(OrganizationServiceServer).CreateCustomRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrganizationService_CreateGroup_FullMethodName,
+		FullMethod: OrganizationService_CreateCustomRole_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).CreateGroup(ctx, req.(*CreateGroupRequest))
+		return srv.(OrganizationServiceServer).CreateCustomRole(ctx, req.(*CreateCustomRoleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrganizationService_GetGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetGroupRequest)
+func _OrganizationService_ListCustomRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCustomRolesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).GetGroup(ctx, in)
+		return srv.(OrganizationServiceServer).ListCustomRoles(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrganizationService_GetGroup_FullMethodName,
+		FullMethod: OrganizationService_ListCustomRoles_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).GetGroup(ctx, req.(*GetGroupRequest))
+		return srv.(OrganizationServiceServer).ListCustomRoles(ctx, req.(*ListCustomRolesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrganizationService_ListGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListGroupsRequest)
+func _OrganizationService_UpdateCustomRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCustomRoleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).ListGroups(ctx, in)
+		return srv.(OrganizationServiceServer).UpdateCustomRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrganizationService_ListGroups_FullMethodName,
+		FullMethod: OrganizationService_UpdateCustomRole_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).ListGroups(ctx, req.(*ListGroupsRequest))
+		return srv.(OrganizationServiceServer).UpdateCustomRole(ctx, req.(*UpdateCustomRoleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrganizationService_UpdateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateGroupRequest)
+func _OrganizationService_DeleteCustomRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCustomRoleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).UpdateGroup(ctx, in)
+		return srv.(OrganizationServiceServer).DeleteCustomRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrganizationService_UpdateGroup_FullMethodName,
+		FullMethod: OrganizationService_DeleteCustomRole_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).UpdateGroup(ctx, req.(*UpdateGroupRequest))
+		return srv.(OrganizationServiceServer).DeleteCustomRole(ctx, req.(*DeleteCustomRoleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrganizationService_DeleteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteGroupRequest)
+func _OrganizationService_AssignCustomRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignCustomRoleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).DeleteGroup(ctx, in)
+		return srv.(OrganizationServiceServer).AssignCustomRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrganizationService_DeleteGroup_FullMethodName,
+		FullMethod: OrganizationService_AssignCustomRole_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).DeleteGroup(ctx, req.(*DeleteGroupRequest))
+		return srv.(OrganizationServiceServer).AssignCustomRole(ctx, req.(*AssignCustomRoleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrganizationService_AddGroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddGroupMemberRequest)
+func _OrganizationService_UnassignCustomRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnassignCustomRoleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).AddGroupMember(ctx, in)
+		return srv.(OrganizationServiceServer).UnassignCustomRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrganizationService_AddGroupMember_FullMethodName,
+		FullMethod: OrganizationService_UnassignCustomRole_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).AddGroupMember(ctx, req.(*AddGroupMemberRequest))
+		return srv.(OrganizationServiceServer).UnassignCustomRole(ctx, req.(*UnassignCustomRoleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrganizationService_RemoveGroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveGroupMemberRequest)
+func _OrganizationService_ListCustomRoleAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCustomRoleAssignmentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).RemoveGroupMember(ctx, in)
+		return srv.(OrganizationServiceServer).ListCustomRoleAssignments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrganizationService_RemoveGroupMember_FullMethodName,
+		FullMethod: OrganizationService_ListCustomRoleAssignments_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).RemoveGroupMember(ctx, req.(*RemoveGroupMemberRequest))
+		return srv.(OrganizationServiceServer).ListCustomRoleAssignments(ctx, req.(*ListCustomRoleAssignmentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrganizationService_CreateWorkspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateWorkspaceRequest)
+func _OrganizationService_GetCapacityReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCapacityReportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).CreateWorkspace(ctx, in)
+		return srv.(OrganizationServiceServer).GetCapacityReport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrganizationService_CreateWorkspace_FullMethodName,
+		FullMethod: OrganizationService_GetCapacityReport_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).CreateWorkspace(ctx, req.(*CreateWorkspaceRequest))
+		return srv.(OrganizationServiceServer).GetCapacityReport(ctx, req.(*GetCapacityReportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrganizationService_GetWorkspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetWorkspaceRequest)
+func _OrganizationService_SearchOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchOrganizationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).GetWorkspace(ctx, in)
+		return srv.(OrganizationServiceServer).SearchOrganization(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrganizationService_GetWorkspace_FullMethodName,
+		FullMethod: OrganizationService_SearchOrganization_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).GetWorkspace(ctx, req.(*GetWorkspaceRequest))
+		return srv.(OrganizationServiceServer).SearchOrganization(ctx, req.(*SearchOrganizationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrganizationService_ListWorkspaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListWorkspacesRequest)
+func _OrganizationService_GetMetadataSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMetadataSchemaRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).ListWorkspaces(ctx, in)
+		return srv.(OrganizationServiceServer).GetMetadataSchema(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrganizationService_ListWorkspaces_FullMethodName,
+		FullMethod: OrganizationService_GetMetadataSchema_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).ListWorkspaces(ctx, req.(*ListWorkspacesRequest))
+		return srv.(OrganizationServiceServer).GetMetadataSchema(ctx, req.(*GetMetadataSchemaRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrganizationService_UpdateWorkspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateWorkspaceRequest)
+func _OrganizationService_GetOrgUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrgUsageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).UpdateWorkspace(ctx, in)
+		return srv.(OrganizationServiceServer).GetOrgUsage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrganizationService_UpdateWorkspace_FullMethodName,
+		FullMethod: OrganizationService_GetOrgUsage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).UpdateWorkspace(ctx, req.(*UpdateWorkspaceRequest))
+		return srv.(OrganizationServiceServer).GetOrgUsage(ctx, req.(*GetOrgUsageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrganizationService_DeleteWorkspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteWorkspaceRequest)
+func _OrganizationService_RecordOrgUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordOrgUsageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).DeleteWorkspace(ctx, in)
+		return srv.(OrganizationServiceServer).RecordOrgUsage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrganizationService_DeleteWorkspace_FullMethodName,
+		FullMethod: OrganizationService_RecordOrgUsage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).DeleteWorkspace(ctx, req.(*DeleteWorkspaceRequest))
+		return srv.(OrganizationServiceServer).RecordOrgUsage(ctx, req.(*RecordOrgUsageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1135,6 +2409,10 @@ var OrganizationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddTeamMember",
 			Handler:    _OrganizationService_AddTeamMember_Handler,
 		},
+		{
+			MethodName: "BulkAddTeamMembers",
+			Handler:    _OrganizationService_BulkAddTeamMembers_Handler,
+		},
 		{
 			MethodName: "RemoveTeamMember",
 			Handler:    _OrganizationService_RemoveTeamMember_Handler,
@@ -1143,6 +2421,10 @@ var OrganizationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListTeamMembers",
 			Handler:    _OrganizationService_ListTeamMembers_Handler,
 		},
+		{
+			MethodName: "TransferTeamOwnership",
+			Handler:    _OrganizationService_TransferTeamOwnership_Handler,
+		},
 		{
 			MethodName: "CreateProject",
 			Handler:    _OrganizationService_CreateProject_Handler,
@@ -1159,6 +2441,14 @@ var OrganizationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateProject",
 			Handler:    _OrganizationService_UpdateProject_Handler,
 		},
+		{
+			MethodName: "ArchiveProject",
+			Handler:    _OrganizationService_ArchiveProject_Handler,
+		},
+		{
+			MethodName: "UnarchiveProject",
+			Handler:    _OrganizationService_UnarchiveProject_Handler,
+		},
 		{
 			MethodName: "DeleteProject",
 			Handler:    _OrganizationService_DeleteProject_Handler,
@@ -1175,10 +2465,38 @@ var OrganizationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddProjectMember",
 			Handler:    _OrganizationService_AddProjectMember_Handler,
 		},
+		{
+			MethodName: "BulkAddProjectMembers",
+			Handler:    _OrganizationService_BulkAddProjectMembers_Handler,
+		},
 		{
 			MethodName: "RemoveProjectMember",
 			Handler:    _OrganizationService_RemoveProjectMember_Handler,
 		},
+		{
+			MethodName: "TransferProjectManager",
+			Handler:    _OrganizationService_TransferProjectManager_Handler,
+		},
+		{
+			MethodName: "GetProjectTimeline",
+			Handler:    _OrganizationService_GetProjectTimeline_Handler,
+		},
+		{
+			MethodName: "SubmitProjectStatusReport",
+			Handler:    _OrganizationService_SubmitProjectStatusReport_Handler,
+		},
+		{
+			MethodName: "ListProjectStatusReports",
+			Handler:    _OrganizationService_ListProjectStatusReports_Handler,
+		},
+		{
+			MethodName: "GetProjectActivity",
+			Handler:    _OrganizationService_GetProjectActivity_Handler,
+		},
+		{
+			MethodName: "CompleteProjectMilestone",
+			Handler:    _OrganizationService_CompleteProjectMilestone_Handler,
+		},
 		{
 			MethodName: "CreateGroup",
 			Handler:    _OrganizationService_CreateGroup_Handler,
@@ -1207,6 +2525,10 @@ var OrganizationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveGroupMember",
 			Handler:    _OrganizationService_RemoveGroupMember_Handler,
 		},
+		{
+			MethodName: "TransferGroupOwner",
+			Handler:    _OrganizationService_TransferGroupOwner_Handler,
+		},
 		{
 			MethodName: "CreateWorkspace",
 			Handler:    _OrganizationService_CreateWorkspace_Handler,
@@ -1227,6 +2549,106 @@ var OrganizationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteWorkspace",
 			Handler:    _OrganizationService_DeleteWorkspace_Handler,
 		},
+		{
+			MethodName: "AddWorkspaceMember",
+			Handler:    _OrganizationService_AddWorkspaceMember_Handler,
+		},
+		{
+			MethodName: "RemoveWorkspaceMember",
+			Handler:    _OrganizationService_RemoveWorkspaceMember_Handler,
+		},
+		{
+			MethodName: "ListWorkspaceMembers",
+			Handler:    _OrganizationService_ListWorkspaceMembers_Handler,
+		},
+		{
+			MethodName: "CreateProjectTemplate",
+			Handler:    _OrganizationService_CreateProjectTemplate_Handler,
+		},
+		{
+			MethodName: "GetProjectTemplate",
+			Handler:    _OrganizationService_GetProjectTemplate_Handler,
+		},
+		{
+			MethodName: "ListProjectTemplates",
+			Handler:    _OrganizationService_ListProjectTemplates_Handler,
+		},
+		{
+			MethodName: "DeleteProjectTemplate",
+			Handler:    _OrganizationService_DeleteProjectTemplate_Handler,
+		},
+		{
+			MethodName: "CreateProjectFromTemplate",
+			Handler:    _OrganizationService_CreateProjectFromTemplate_Handler,
+		},
+		{
+			MethodName: "CreateWorkspaceTemplate",
+			Handler:    _OrganizationService_CreateWorkspaceTemplate_Handler,
+		},
+		{
+			MethodName: "GetWorkspaceTemplate",
+			Handler:    _OrganizationService_GetWorkspaceTemplate_Handler,
+		},
+		{
+			MethodName: "ListWorkspaceTemplates",
+			Handler:    _OrganizationService_ListWorkspaceTemplates_Handler,
+		},
+		{
+			MethodName: "DeleteWorkspaceTemplate",
+			Handler:    _OrganizationService_DeleteWorkspaceTemplate_Handler,
+		},
+		{
+			MethodName: "CreateWorkspaceFromTemplate",
+			Handler:    _OrganizationService_CreateWorkspaceFromTemplate_Handler,
+		},
+		{
+			MethodName: "CreateCustomRole",
+			Handler:    _OrganizationService_CreateCustomRole_Handler,
+		},
+		{
+			MethodName: "ListCustomRoles",
+			Handler:    _OrganizationService_ListCustomRoles_Handler,
+		},
+		{
+			MethodName: "UpdateCustomRole",
+			Handler:    _OrganizationService_UpdateCustomRole_Handler,
+		},
+		{
+			MethodName: "DeleteCustomRole",
+			Handler:    _OrganizationService_DeleteCustomRole_Handler,
+		},
+		{
+			MethodName: "AssignCustomRole",
+			Handler:    _OrganizationService_AssignCustomRole_Handler,
+		},
+		{
+			MethodName: "UnassignCustomRole",
+			Handler:    _OrganizationService_UnassignCustomRole_Handler,
+		},
+		{
+			MethodName: "ListCustomRoleAssignments",
+			Handler:    _OrganizationService_ListCustomRoleAssignments_Handler,
+		},
+		{
+			MethodName: "GetCapacityReport",
+			Handler:    _OrganizationService_GetCapacityReport_Handler,
+		},
+		{
+			MethodName: "SearchOrganization",
+			Handler:    _OrganizationService_SearchOrganization_Handler,
+		},
+		{
+			MethodName: "GetMetadataSchema",
+			Handler:    _OrganizationService_GetMetadataSchema_Handler,
+		},
+		{
+			MethodName: "GetOrgUsage",
+			Handler:    _OrganizationService_GetOrgUsage_Handler,
+		},
+		{
+			MethodName: "RecordOrgUsage",
+			Handler:    _OrganizationService_RecordOrgUsage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "organization.proto",
@@ -8,6 +8,7 @@ package task
 
 import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -140,20 +141,28 @@ func (TaskPriority) EnumDescriptor() ([]byte, []int) {
 
 // Task message
 type Task struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Status        TaskStatus             `protobuf:"varint,4,opt,name=status,proto3,enum=task.TaskStatus" json:"status,omitempty"`
-	Priority      TaskPriority           `protobuf:"varint,5,opt,name=priority,proto3,enum=task.TaskPriority" json:"priority,omitempty"`
-	AssignedTo    string                 `protobuf:"bytes,6,opt,name=assigned_to,json=assignedTo,proto3" json:"assigned_to,omitempty"`
-	CreatedBy     string                 `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	TeamId        string                 `protobuf:"bytes,8,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
-	GroupId       string                 `protobuf:"bytes,9,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	DueDate       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Tags          []string               `protobuf:"bytes,13,rep,name=tags,proto3" json:"tags,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	TaskId      string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Status      TaskStatus             `protobuf:"varint,4,opt,name=status,proto3,enum=task.TaskStatus" json:"status,omitempty"`
+	Priority    TaskPriority           `protobuf:"varint,5,opt,name=priority,proto3,enum=task.TaskPriority" json:"priority,omitempty"`
+	AssignedTo  string                 `protobuf:"bytes,6,opt,name=assigned_to,json=assignedTo,proto3" json:"assigned_to,omitempty"`
+	CreatedBy   string                 `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	TeamId      string                 `protobuf:"bytes,8,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	GroupId     string                 `protobuf:"bytes,9,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	DueDate     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Tags        []string               `protobuf:"bytes,13,rep,name=tags,proto3" json:"tags,omitempty"`
+	ProjectId   string                 `protobuf:"bytes,14,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	TaskKey     string                 `protobuf:"bytes,15,opt,name=task_key,json=taskKey,proto3" json:"task_key,omitempty"`
+	StartedAt   *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CompletedAt *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	// Incremented on every change; send it back on updates
+	Version int64 `protobuf:"varint,18,opt,name=version,proto3" json:"version,omitempty"`
+	// Set by the overdue job while an open task is past its due date
+	IsOverdue     bool `protobuf:"varint,19,opt,name=is_overdue,json=isOverdue,proto3" json:"is_overdue,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -279,18 +288,63 @@ func (x *Task) GetTags() []string {
 	return nil
 }
 
+func (x *Task) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *Task) GetTaskKey() string {
+	if x != nil {
+		return x.TaskKey
+	}
+	return ""
+}
+
+func (x *Task) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *Task) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *Task) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Task) GetIsOverdue() bool {
+	if x != nil {
+		return x.IsOverdue
+	}
+	return false
+}
+
 // Create task request
 type CreateTaskRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Status        TaskStatus             `protobuf:"varint,3,opt,name=status,proto3,enum=task.TaskStatus" json:"status,omitempty"`
-	Priority      TaskPriority           `protobuf:"varint,4,opt,name=priority,proto3,enum=task.TaskPriority" json:"priority,omitempty"`
-	AssignedTo    string                 `protobuf:"bytes,5,opt,name=assigned_to,json=assignedTo,proto3" json:"assigned_to,omitempty"`
-	TeamId        string                 `protobuf:"bytes,6,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
-	GroupId       string                 `protobuf:"bytes,7,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	DueDate       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
-	Tags          []string               `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Title       string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Status      TaskStatus             `protobuf:"varint,3,opt,name=status,proto3,enum=task.TaskStatus" json:"status,omitempty"`
+	Priority    TaskPriority           `protobuf:"varint,4,opt,name=priority,proto3,enum=task.TaskPriority" json:"priority,omitempty"`
+	AssignedTo  string                 `protobuf:"bytes,5,opt,name=assigned_to,json=assignedTo,proto3" json:"assigned_to,omitempty"`
+	TeamId      string                 `protobuf:"bytes,6,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	GroupId     string                 `protobuf:"bytes,7,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	DueDate     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	Tags        []string               `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	ProjectId   string                 `protobuf:"bytes,10,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// Optional; keys take the prefix of the task's project, or "TASK" without one, and a different prefix is rejected
+	ProjectKey    string `protobuf:"bytes,11,opt,name=project_key,json=projectKey,proto3" json:"project_key,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -388,6 +442,20 @@ func (x *CreateTaskRequest) GetTags() []string {
 	return nil
 }
 
+func (x *CreateTaskRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetProjectKey() string {
+	if x != nil {
+		return x.ProjectKey
+	}
+	return ""
+}
+
 // Create task response
 type CreateTaskResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -441,7 +509,7 @@ func (x *CreateTaskResponse) GetMessage() string {
 	return ""
 }
 
-// Get task request
+// Get task request (task_id accepts either the UUID or the task key, e.g. "WEB-42")
 type GetTaskRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
@@ -533,15 +601,17 @@ func (x *GetTaskResponse) GetTask() *Task {
 
 // Update task request
 type UpdateTaskRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Status        TaskStatus             `protobuf:"varint,4,opt,name=status,proto3,enum=task.TaskStatus" json:"status,omitempty"`
-	Priority      TaskPriority           `protobuf:"varint,5,opt,name=priority,proto3,enum=task.TaskPriority" json:"priority,omitempty"`
-	AssignedTo    string                 `protobuf:"bytes,6,opt,name=assigned_to,json=assignedTo,proto3" json:"assigned_to,omitempty"`
-	DueDate       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
-	Tags          []string               `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	TaskId      string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Status      TaskStatus             `protobuf:"varint,4,opt,name=status,proto3,enum=task.TaskStatus" json:"status,omitempty"`
+	Priority    TaskPriority           `protobuf:"varint,5,opt,name=priority,proto3,enum=task.TaskPriority" json:"priority,omitempty"`
+	AssignedTo  string                 `protobuf:"bytes,6,opt,name=assigned_to,json=assignedTo,proto3" json:"assigned_to,omitempty"`
+	DueDate     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	Tags        []string               `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Version the client last read; the update is rejected with ABORTED if it is stale
+	Version       int64 `protobuf:"varint,9,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -632,6 +702,13 @@ func (x *UpdateTaskRequest) GetTags() []string {
 	return nil
 }
 
+func (x *UpdateTaskRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 // Update task response
 type UpdateTaskResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -785,8 +862,12 @@ type ListTasksRequest struct {
 	TeamFilter       string                 `protobuf:"bytes,5,opt,name=team_filter,json=teamFilter,proto3" json:"team_filter,omitempty"`
 	GroupFilter      string                 `protobuf:"bytes,6,opt,name=group_filter,json=groupFilter,proto3" json:"group_filter,omitempty"`
 	AssignedToFilter string                 `protobuf:"bytes,7,opt,name=assigned_to_filter,json=assignedToFilter,proto3" json:"assigned_to_filter,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	ProjectFilter    string                 `protobuf:"bytes,8,opt,name=project_filter,json=projectFilter,proto3" json:"project_filter,omitempty"`
+	// Matches a task key exactly or a substring of the title
+	Search        string `protobuf:"bytes,9,opt,name=search,proto3" json:"search,omitempty"`
+	OverdueOnly   bool   `protobuf:"varint,10,opt,name=overdue_only,json=overdueOnly,proto3" json:"overdue_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListTasksRequest) Reset() {
@@ -868,6 +949,27 @@ func (x *ListTasksRequest) GetAssignedToFilter() string {
 	return ""
 }
 
+func (x *ListTasksRequest) GetProjectFilter() string {
+	if x != nil {
+		return x.ProjectFilter
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetOverdueOnly() bool {
+	if x != nil {
+		return x.OverdueOnly
+	}
+	return false
+}
+
 // List tasks response
 type ListTasksResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1045,9 +1147,11 @@ func (x *AssignTaskResponse) GetMessage() string {
 
 // Update task status request
 type UpdateTaskStatusRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	Status        TaskStatus             `protobuf:"varint,2,opt,name=status,proto3,enum=task.TaskStatus" json:"status,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	TaskId string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Status TaskStatus             `protobuf:"varint,2,opt,name=status,proto3,enum=task.TaskStatus" json:"status,omitempty"`
+	// Version the client last read; the update is rejected with ABORTED if it is stale
+	Version       int64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1096,6 +1200,13 @@ func (x *UpdateTaskStatusRequest) GetStatus() TaskStatus {
 	return TaskStatus_TASK_STATUS_UNSPECIFIED
 }
 
+func (x *UpdateTaskStatusRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 // Update task status response
 type UpdateTaskStatusResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1271,136 +1382,4607 @@ func (x *GetUserTasksResponse) GetTotalCount() int32 {
 	return 0
 }
 
-var File_task_proto protoreflect.FileDescriptor
+// Get calendar feed URL request
+type GetCalendarFeedURLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_task_proto_rawDesc = "" +
-	"\n" +
-	"\n" +
-	"task.proto\x12\x04task\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe6\x03\n" +
-	"\x04Task\x12\x17\n" +
-	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12(\n" +
-	"\x06status\x18\x04 \x01(\x0e2\x10.task.TaskStatusR\x06status\x12.\n" +
-	"\bpriority\x18\x05 \x01(\x0e2\x12.task.TaskPriorityR\bpriority\x12\x1f\n" +
-	"\vassigned_to\x18\x06 \x01(\tR\n" +
-	"assignedTo\x12\x1d\n" +
-	"\n" +
-	"created_by\x18\a \x01(\tR\tcreatedBy\x12\x17\n" +
-	"\ateam_id\x18\b \x01(\tR\x06teamId\x12\x19\n" +
-	"\bgroup_id\x18\t \x01(\tR\agroupId\x125\n" +
-	"\bdue_date\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x129\n" +
-	"\n" +
-	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x12\n" +
-	"\x04tags\x18\r \x03(\tR\x04tags\"\xc5\x02\n" +
-	"\x11CreateTaskRequest\x12\x14\n" +
-	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\x12(\n" +
-	"\x06status\x18\x03 \x01(\x0e2\x10.task.TaskStatusR\x06status\x12.\n" +
-	"\bpriority\x18\x04 \x01(\x0e2\x12.task.TaskPriorityR\bpriority\x12\x1f\n" +
-	"\vassigned_to\x18\x05 \x01(\tR\n" +
-	"assignedTo\x12\x17\n" +
-	"\ateam_id\x18\x06 \x01(\tR\x06teamId\x12\x19\n" +
-	"\bgroup_id\x18\a \x01(\tR\agroupId\x125\n" +
-	"\bdue_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x12\x12\n" +
-	"\x04tags\x18\t \x03(\tR\x04tags\"N\n" +
-	"\x12CreateTaskResponse\x12\x1e\n" +
-	"\x04task\x18\x01 \x01(\v2\n" +
-	".task.TaskR\x04task\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\")\n" +
-	"\x0eGetTaskRequest\x12\x17\n" +
-	"\atask_id\x18\x01 \x01(\tR\x06taskId\"1\n" +
-	"\x0fGetTaskResponse\x12\x1e\n" +
-	"\x04task\x18\x01 \x01(\v2\n" +
-	".task.TaskR\x04task\"\xaa\x02\n" +
-	"\x11UpdateTaskRequest\x12\x17\n" +
-	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12(\n" +
-	"\x06status\x18\x04 \x01(\x0e2\x10.task.TaskStatusR\x06status\x12.\n" +
-	"\bpriority\x18\x05 \x01(\x0e2\x12.task.TaskPriorityR\bpriority\x12\x1f\n" +
-	"\vassigned_to\x18\x06 \x01(\tR\n" +
-	"assignedTo\x125\n" +
-	"\bdue_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x12\x12\n" +
-	"\x04tags\x18\b \x03(\tR\x04tags\"N\n" +
-	"\x12UpdateTaskResponse\x12\x1e\n" +
-	"\x04task\x18\x01 \x01(\v2\n" +
-	".task.TaskR\x04task\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\",\n" +
-	"\x11DeleteTaskRequest\x12\x17\n" +
-	"\atask_id\x18\x01 \x01(\tR\x06taskId\".\n" +
-	"\x12DeleteTaskResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"\xa9\x02\n" +
-	"\x10ListTasksRequest\x12\x12\n" +
-	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x125\n" +
-	"\rstatus_filter\x18\x03 \x01(\x0e2\x10.task.TaskStatusR\fstatusFilter\x12;\n" +
-	"\x0fpriority_filter\x18\x04 \x01(\x0e2\x12.task.TaskPriorityR\x0epriorityFilter\x12\x1f\n" +
-	"\vteam_filter\x18\x05 \x01(\tR\n" +
-	"teamFilter\x12!\n" +
-	"\fgroup_filter\x18\x06 \x01(\tR\vgroupFilter\x12,\n" +
-	"\x12assigned_to_filter\x18\a \x01(\tR\x10assignedToFilter\"\x87\x01\n" +
-	"\x11ListTasksResponse\x12 \n" +
-	"\x05tasks\x18\x01 \x03(\v2\n" +
-	".task.TaskR\x05tasks\x12\x1f\n" +
-	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\x12\x12\n" +
-	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"E\n" +
-	"\x11AssignTaskRequest\x12\x17\n" +
-	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\"N\n" +
-	"\x12AssignTaskResponse\x12\x1e\n" +
-	"\x04task\x18\x01 \x01(\v2\n" +
-	".task.TaskR\x04task\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\\\n" +
-	"\x17UpdateTaskStatusRequest\x12\x17\n" +
-	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12(\n" +
-	"\x06status\x18\x02 \x01(\x0e2\x10.task.TaskStatusR\x06status\"T\n" +
-	"\x18UpdateTaskStatusResponse\x12\x1e\n" +
-	"\x04task\x18\x01 \x01(\v2\n" +
-	".task.TaskR\x04task\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\x96\x01\n" +
-	"\x13GetUserTasksRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x125\n" +
-	"\rstatus_filter\x18\x02 \x01(\x0e2\x10.task.TaskStatusR\fstatusFilter\x12\x12\n" +
-	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"Y\n" +
-	"\x14GetUserTasksResponse\x12 \n" +
-	"\x05tasks\x18\x01 \x03(\v2\n" +
-	".task.TaskR\x05tasks\x12\x1f\n" +
-	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount*\xad\x01\n" +
-	"\n" +
-	"TaskStatus\x12\x1b\n" +
-	"\x17TASK_STATUS_UNSPECIFIED\x10\x00\x12\x14\n" +
-	"\x10TASK_STATUS_TODO\x10\x01\x12\x1b\n" +
-	"\x17TASK_STATUS_IN_PROGRESS\x10\x02\x12\x19\n" +
-	"\x15TASK_STATUS_IN_REVIEW\x10\x03\x12\x19\n" +
-	"\x15TASK_STATUS_COMPLETED\x10\x04\x12\x19\n" +
-	"\x15TASK_STATUS_CANCELLED\x10\x05*\x92\x01\n" +
-	"\fTaskPriority\x12\x1d\n" +
-	"\x19TASK_PRIORITY_UNSPECIFIED\x10\x00\x12\x15\n" +
-	"\x11TASK_PRIORITY_LOW\x10\x01\x12\x18\n" +
-	"\x14TASK_PRIORITY_MEDIUM\x10\x02\x12\x16\n" +
-	"\x12TASK_PRIORITY_HIGH\x10\x03\x12\x1a\n" +
-	"\x16TASK_PRIORITY_CRITICAL\x10\x042\xb5\x06\n" +
-	"\vTaskService\x12Y\n" +
-	"\n" +
-	"CreateTask\x12\x17.task.CreateTaskRequest\x1a\x18.task.CreateTaskResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/api/v1/tasks\x12W\n" +
-	"\aGetTask\x12\x14.task.GetTaskRequest\x1a\x15.task.GetTaskResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/tasks/{task_id}\x12c\n" +
-	"\n" +
-	"UpdateTask\x12\x17.task.UpdateTaskRequest\x1a\x18.task.UpdateTaskResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\x1a\x17/api/v1/tasks/{task_id}\x12`\n" +
-	"\n" +
-	"DeleteTask\x12\x17.task.DeleteTaskRequest\x1a\x18.task.DeleteTaskResponse\"\x1f\x82\xd3\xe4\x93\x02\x19*\x17/api/v1/tasks/{task_id}\x12S\n" +
-	"\tListTasks\x12\x16.task.ListTasksRequest\x1a\x17.task.ListTasksResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/v1/tasks\x12j\n" +
-	"\n" +
-	"AssignTask\x12\x17.task.AssignTaskRequest\x1a\x18.task.AssignTaskResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/tasks/{task_id}/assign\x12|\n" +
-	"\x10UpdateTaskStatus\x12\x1d.task.UpdateTaskStatusRequest\x1a\x1e.task.UpdateTaskStatusResponse\")\x82\xd3\xe4\x93\x02#:\x01*2\x1e/api/v1/tasks/{task_id}/status\x12l\n" +
-	"\fGetUserTasks\x12\x19.task.GetUserTasksRequest\x1a\x1a.task.GetUserTasksResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/users/{user_id}/tasksBBZ@github.com/chanduchitikam/task-management-system/proto/task;taskb\x06proto3"
+func (x *GetCalendarFeedURLRequest) Reset() {
+	*x = GetCalendarFeedURLRequest{}
+	mi := &file_task_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarFeedURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarFeedURLRequest) ProtoMessage() {}
+
+func (x *GetCalendarFeedURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarFeedURLRequest.ProtoReflect.Descriptor instead.
+func (*GetCalendarFeedURLRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{17}
+}
+
+// Get calendar feed URL response
+type GetCalendarFeedURLResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Path relative to the gateway, including the signed token
+	Url           string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Token         string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCalendarFeedURLResponse) Reset() {
+	*x = GetCalendarFeedURLResponse{}
+	mi := &file_task_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarFeedURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarFeedURLResponse) ProtoMessage() {}
+
+func (x *GetCalendarFeedURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarFeedURLResponse.ProtoReflect.Descriptor instead.
+func (*GetCalendarFeedURLResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetCalendarFeedURLResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *GetCalendarFeedURLResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// Rotate calendar feed URL request
+type RotateCalendarFeedURLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateCalendarFeedURLRequest) Reset() {
+	*x = RotateCalendarFeedURLRequest{}
+	mi := &file_task_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateCalendarFeedURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateCalendarFeedURLRequest) ProtoMessage() {}
+
+func (x *RotateCalendarFeedURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateCalendarFeedURLRequest.ProtoReflect.Descriptor instead.
+func (*RotateCalendarFeedURLRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{19}
+}
+
+// Rotate calendar feed URL response
+type RotateCalendarFeedURLResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Path relative to the gateway, including the signed token
+	Url           string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Token         string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateCalendarFeedURLResponse) Reset() {
+	*x = RotateCalendarFeedURLResponse{}
+	mi := &file_task_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateCalendarFeedURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateCalendarFeedURLResponse) ProtoMessage() {}
+
+func (x *RotateCalendarFeedURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateCalendarFeedURLResponse.ProtoReflect.Descriptor instead.
+func (*RotateCalendarFeedURLResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RotateCalendarFeedURLResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *RotateCalendarFeedURLResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// Get calendar feed request
+type GetCalendarFeedRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Token  string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	// Calendar component to emit: "event" (default), "todo", or "both"
+	Component string `protobuf:"bytes,3,opt,name=component,proto3" json:"component,omitempty"`
+	// Include completed and cancelled tasks
+	IncludeCompleted bool `protobuf:"varint,4,opt,name=include_completed,json=includeCompleted,proto3" json:"include_completed,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetCalendarFeedRequest) Reset() {
+	*x = GetCalendarFeedRequest{}
+	mi := &file_task_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarFeedRequest) ProtoMessage() {}
+
+func (x *GetCalendarFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarFeedRequest.ProtoReflect.Descriptor instead.
+func (*GetCalendarFeedRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetCalendarFeedRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetCalendarFeedRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetCalendarFeedRequest) GetComponent() string {
+	if x != nil {
+		return x.Component
+	}
+	return ""
+}
+
+func (x *GetCalendarFeedRequest) GetIncludeCompleted() bool {
+	if x != nil {
+		return x.IncludeCompleted
+	}
+	return false
+}
+
+// Outgoing webhook registration
+type Webhook struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	WebhookId string                 `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	OrgId     string                 `protobuf:"bytes,2,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Url       string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	// Event types, e.g. "task.created"; empty means all task events
+	EventTypes    []string               `protobuf:"bytes,4,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	Active        bool                   `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	mi := &file_task_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Webhook) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+func (x *Webhook) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *Webhook) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Webhook) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Webhook) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Webhook) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Create webhook request
+type CreateWebhookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Url   string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Shared secret used to sign deliveries; generated when empty
+	Secret        string   `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	EventTypes    []string `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookRequest) Reset() {
+	*x = CreateWebhookRequest{}
+	mi := &file_task_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookRequest) ProtoMessage() {}
+
+func (x *CreateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+// Create webhook response (the secret is only returned once)
+type CreateWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhook       *Webhook               `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	Secret        string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookResponse) Reset() {
+	*x = CreateWebhookResponse{}
+	mi := &file_task_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookResponse) ProtoMessage() {}
+
+func (x *CreateWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookResponse.ProtoReflect.Descriptor instead.
+func (*CreateWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateWebhookResponse) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+func (x *CreateWebhookResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *CreateWebhookResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// List webhooks request
+type ListWebhooksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksRequest) Reset() {
+	*x = ListWebhooksRequest{}
+	mi := &file_task_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksRequest) ProtoMessage() {}
+
+func (x *ListWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{25}
+}
+
+// List webhooks response
+type ListWebhooksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhooks      []*Webhook             `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksResponse) Reset() {
+	*x = ListWebhooksResponse{}
+	mi := &file_task_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksResponse) ProtoMessage() {}
+
+func (x *ListWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListWebhooksResponse) GetWebhooks() []*Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+// Delete webhook request
+type DeleteWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WebhookId     string                 `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookRequest) Reset() {
+	*x = DeleteWebhookRequest{}
+	mi := &file_task_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeleteWebhookRequest) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+// Delete webhook response
+type DeleteWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookResponse) Reset() {
+	*x = DeleteWebhookResponse{}
+	mi := &file_task_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookResponse) ProtoMessage() {}
+
+func (x *DeleteWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DeleteWebhookResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Export tasks request
+type ExportTasksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "list" (default) or "board" (grouped into status columns)
+	View string `protobuf:"bytes,1,opt,name=view,proto3" json:"view,omitempty"`
+	// "csv" (default) or "html"
+	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	// Columns to include, e.g. ["key", "title", "status"]; defaults to a standard set
+	Columns          []string     `protobuf:"bytes,3,rep,name=columns,proto3" json:"columns,omitempty"`
+	StatusFilter     TaskStatus   `protobuf:"varint,4,opt,name=status_filter,json=statusFilter,proto3,enum=task.TaskStatus" json:"status_filter,omitempty"`
+	PriorityFilter   TaskPriority `protobuf:"varint,5,opt,name=priority_filter,json=priorityFilter,proto3,enum=task.TaskPriority" json:"priority_filter,omitempty"`
+	TeamFilter       string       `protobuf:"bytes,6,opt,name=team_filter,json=teamFilter,proto3" json:"team_filter,omitempty"`
+	GroupFilter      string       `protobuf:"bytes,7,opt,name=group_filter,json=groupFilter,proto3" json:"group_filter,omitempty"`
+	ProjectFilter    string       `protobuf:"bytes,8,opt,name=project_filter,json=projectFilter,proto3" json:"project_filter,omitempty"`
+	AssignedToFilter string       `protobuf:"bytes,9,opt,name=assigned_to_filter,json=assignedToFilter,proto3" json:"assigned_to_filter,omitempty"`
+	Search           string       `protobuf:"bytes,10,opt,name=search,proto3" json:"search,omitempty"`
+	// Optional heading for printable output
+	Title         string `protobuf:"bytes,11,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportTasksRequest) Reset() {
+	*x = ExportTasksRequest{}
+	mi := &file_task_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTasksRequest) ProtoMessage() {}
+
+func (x *ExportTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTasksRequest.ProtoReflect.Descriptor instead.
+func (*ExportTasksRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ExportTasksRequest) GetView() string {
+	if x != nil {
+		return x.View
+	}
+	return ""
+}
+
+func (x *ExportTasksRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportTasksRequest) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *ExportTasksRequest) GetStatusFilter() TaskStatus {
+	if x != nil {
+		return x.StatusFilter
+	}
+	return TaskStatus_TASK_STATUS_UNSPECIFIED
+}
+
+func (x *ExportTasksRequest) GetPriorityFilter() TaskPriority {
+	if x != nil {
+		return x.PriorityFilter
+	}
+	return TaskPriority_TASK_PRIORITY_UNSPECIFIED
+}
+
+func (x *ExportTasksRequest) GetTeamFilter() string {
+	if x != nil {
+		return x.TeamFilter
+	}
+	return ""
+}
+
+func (x *ExportTasksRequest) GetGroupFilter() string {
+	if x != nil {
+		return x.GroupFilter
+	}
+	return ""
+}
+
+func (x *ExportTasksRequest) GetProjectFilter() string {
+	if x != nil {
+		return x.ProjectFilter
+	}
+	return ""
+}
+
+func (x *ExportTasksRequest) GetAssignedToFilter() string {
+	if x != nil {
+		return x.AssignedToFilter
+	}
+	return ""
+}
+
+func (x *ExportTasksRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *ExportTasksRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+// Watch task request
+type WatchTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchTaskRequest) Reset() {
+	*x = WatchTaskRequest{}
+	mi := &file_task_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchTaskRequest) ProtoMessage() {}
+
+func (x *WatchTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchTaskRequest.ProtoReflect.Descriptor instead.
+func (*WatchTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *WatchTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+// Watch task response
+type WatchTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchTaskResponse) Reset() {
+	*x = WatchTaskResponse{}
+	mi := &file_task_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchTaskResponse) ProtoMessage() {}
+
+func (x *WatchTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchTaskResponse.ProtoReflect.Descriptor instead.
+func (*WatchTaskResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *WatchTaskResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Unwatch task request
+type UnwatchTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnwatchTaskRequest) Reset() {
+	*x = UnwatchTaskRequest{}
+	mi := &file_task_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnwatchTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnwatchTaskRequest) ProtoMessage() {}
+
+func (x *UnwatchTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnwatchTaskRequest.ProtoReflect.Descriptor instead.
+func (*UnwatchTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UnwatchTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+// Unwatch task response
+type UnwatchTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnwatchTaskResponse) Reset() {
+	*x = UnwatchTaskResponse{}
+	mi := &file_task_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnwatchTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnwatchTaskResponse) ProtoMessage() {}
+
+func (x *UnwatchTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnwatchTaskResponse.ProtoReflect.Descriptor instead.
+func (*UnwatchTaskResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UnwatchTaskResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Get my work request
+type GetMyWorkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Maximum tasks returned per section (default 10, max 100); counts are always totals
+	Limit int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// IANA time zone used to compute "this week" (default UTC)
+	Timezone string `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Include completed and cancelled tasks in the assigned and watching sections
+	IncludeCompleted bool `protobuf:"varint,3,opt,name=include_completed,json=includeCompleted,proto3" json:"include_completed,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetMyWorkRequest) Reset() {
+	*x = GetMyWorkRequest{}
+	mi := &file_task_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyWorkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyWorkRequest) ProtoMessage() {}
+
+func (x *GetMyWorkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyWorkRequest.ProtoReflect.Descriptor instead.
+func (*GetMyWorkRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetMyWorkRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetMyWorkRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GetMyWorkRequest) GetIncludeCompleted() bool {
+	if x != nil {
+		return x.IncludeCompleted
+	}
+	return false
+}
+
+// A group of tasks on the dashboard
+type WorkSection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkSection) Reset() {
+	*x = WorkSection{}
+	mi := &file_task_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkSection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkSection) ProtoMessage() {}
+
+func (x *WorkSection) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkSection.ProtoReflect.Descriptor instead.
+func (*WorkSection) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *WorkSection) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *WorkSection) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// Get my work response
+type GetMyWorkResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Assigned    *WorkSection           `protobuf:"bytes,1,opt,name=assigned,proto3" json:"assigned,omitempty"`
+	Watching    *WorkSection           `protobuf:"bytes,2,opt,name=watching,proto3" json:"watching,omitempty"`
+	Overdue     *WorkSection           `protobuf:"bytes,3,opt,name=overdue,proto3" json:"overdue,omitempty"`
+	DueThisWeek *WorkSection           `protobuf:"bytes,4,opt,name=due_this_week,json=dueThisWeek,proto3" json:"due_this_week,omitempty"`
+	// Open assigned tasks counted by status
+	AssignedByStatus map[string]int32       `protobuf:"bytes,5,rep,name=assigned_by_status,json=assignedByStatus,proto3" json:"assigned_by_status,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	WeekStart        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=week_start,json=weekStart,proto3" json:"week_start,omitempty"`
+	WeekEnd          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=week_end,json=weekEnd,proto3" json:"week_end,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetMyWorkResponse) Reset() {
+	*x = GetMyWorkResponse{}
+	mi := &file_task_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyWorkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyWorkResponse) ProtoMessage() {}
+
+func (x *GetMyWorkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyWorkResponse.ProtoReflect.Descriptor instead.
+func (*GetMyWorkResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetMyWorkResponse) GetAssigned() *WorkSection {
+	if x != nil {
+		return x.Assigned
+	}
+	return nil
+}
+
+func (x *GetMyWorkResponse) GetWatching() *WorkSection {
+	if x != nil {
+		return x.Watching
+	}
+	return nil
+}
+
+func (x *GetMyWorkResponse) GetOverdue() *WorkSection {
+	if x != nil {
+		return x.Overdue
+	}
+	return nil
+}
+
+func (x *GetMyWorkResponse) GetDueThisWeek() *WorkSection {
+	if x != nil {
+		return x.DueThisWeek
+	}
+	return nil
+}
+
+func (x *GetMyWorkResponse) GetAssignedByStatus() map[string]int32 {
+	if x != nil {
+		return x.AssignedByStatus
+	}
+	return nil
+}
+
+func (x *GetMyWorkResponse) GetWeekStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WeekStart
+	}
+	return nil
+}
+
+func (x *GetMyWorkResponse) GetWeekEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WeekEnd
+	}
+	return nil
+}
+
+// Get task stats request
+type GetTaskStatsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	TeamId    string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	GroupId   string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	ProjectId string                 `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// Trend window in days (default 30, max 365)
+	Days          int32 `protobuf:"varint,4,opt,name=days,proto3" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskStatsRequest) Reset() {
+	*x = GetTaskStatsRequest{}
+	mi := &file_task_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskStatsRequest) ProtoMessage() {}
+
+func (x *GetTaskStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskStatsRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetTaskStatsRequest) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *GetTaskStatsRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GetTaskStatsRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *GetTaskStatsRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+// Tasks created and completed on one day (UTC)
+type TaskTrendPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Created       int32                  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Completed     int32                  `protobuf:"varint,3,opt,name=completed,proto3" json:"completed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskTrendPoint) Reset() {
+	*x = TaskTrendPoint{}
+	mi := &file_task_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskTrendPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskTrendPoint) ProtoMessage() {}
+
+func (x *TaskTrendPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskTrendPoint.ProtoReflect.Descriptor instead.
+func (*TaskTrendPoint) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *TaskTrendPoint) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *TaskTrendPoint) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *TaskTrendPoint) GetCompleted() int32 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+// Workload of one assignee
+type AssigneeLoad struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Open              int32                  `protobuf:"varint,2,opt,name=open,proto3" json:"open,omitempty"`
+	InProgress        int32                  `protobuf:"varint,3,opt,name=in_progress,json=inProgress,proto3" json:"in_progress,omitempty"`
+	Overdue           int32                  `protobuf:"varint,4,opt,name=overdue,proto3" json:"overdue,omitempty"`
+	CompletedInWindow int32                  `protobuf:"varint,5,opt,name=completed_in_window,json=completedInWindow,proto3" json:"completed_in_window,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AssigneeLoad) Reset() {
+	*x = AssigneeLoad{}
+	mi := &file_task_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssigneeLoad) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssigneeLoad) ProtoMessage() {}
+
+func (x *AssigneeLoad) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssigneeLoad.ProtoReflect.Descriptor instead.
+func (*AssigneeLoad) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AssigneeLoad) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AssigneeLoad) GetOpen() int32 {
+	if x != nil {
+		return x.Open
+	}
+	return 0
+}
+
+func (x *AssigneeLoad) GetInProgress() int32 {
+	if x != nil {
+		return x.InProgress
+	}
+	return 0
+}
+
+func (x *AssigneeLoad) GetOverdue() int32 {
+	if x != nil {
+		return x.Overdue
+	}
+	return 0
+}
+
+func (x *AssigneeLoad) GetCompletedInWindow() int32 {
+	if x != nil {
+		return x.CompletedInWindow
+	}
+	return 0
+}
+
+// Get task stats response
+type GetTaskStatsResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Total      int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	ByStatus   map[string]int32       `protobuf:"bytes,2,rep,name=by_status,json=byStatus,proto3" json:"by_status,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	ByPriority map[string]int32       `protobuf:"bytes,3,rep,name=by_priority,json=byPriority,proto3" json:"by_priority,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Trend      []*TaskTrendPoint      `protobuf:"bytes,4,rep,name=trend,proto3" json:"trend,omitempty"`
+	// Average hours from start (or creation) to completion for tasks completed in the window
+	AvgCycleTimeHours float64         `protobuf:"fixed64,5,opt,name=avg_cycle_time_hours,json=avgCycleTimeHours,proto3" json:"avg_cycle_time_hours,omitempty"`
+	CompletedInWindow int32           `protobuf:"varint,6,opt,name=completed_in_window,json=completedInWindow,proto3" json:"completed_in_window,omitempty"`
+	AssigneeLoad      []*AssigneeLoad `protobuf:"bytes,7,rep,name=assignee_load,json=assigneeLoad,proto3" json:"assignee_load,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetTaskStatsResponse) Reset() {
+	*x = GetTaskStatsResponse{}
+	mi := &file_task_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskStatsResponse) ProtoMessage() {}
+
+func (x *GetTaskStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetTaskStatsResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetTaskStatsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetTaskStatsResponse) GetByStatus() map[string]int32 {
+	if x != nil {
+		return x.ByStatus
+	}
+	return nil
+}
+
+func (x *GetTaskStatsResponse) GetByPriority() map[string]int32 {
+	if x != nil {
+		return x.ByPriority
+	}
+	return nil
+}
+
+func (x *GetTaskStatsResponse) GetTrend() []*TaskTrendPoint {
+	if x != nil {
+		return x.Trend
+	}
+	return nil
+}
+
+func (x *GetTaskStatsResponse) GetAvgCycleTimeHours() float64 {
+	if x != nil {
+		return x.AvgCycleTimeHours
+	}
+	return 0
+}
+
+func (x *GetTaskStatsResponse) GetCompletedInWindow() int32 {
+	if x != nil {
+		return x.CompletedInWindow
+	}
+	return 0
+}
+
+func (x *GetTaskStatsResponse) GetAssigneeLoad() []*AssigneeLoad {
+	if x != nil {
+		return x.AssigneeLoad
+	}
+	return nil
+}
+
+// Board column mapped to a task status
+type BoardColumn struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ColumnId string                 `protobuf:"bytes,1,opt,name=column_id,json=columnId,proto3" json:"column_id,omitempty"`
+	Name     string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Status   TaskStatus             `protobuf:"varint,3,opt,name=status,proto3,enum=task.TaskStatus" json:"status,omitempty"`
+	Position int32                  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	// Maximum tasks allowed in the column; 0 means no limit
+	WipLimit int32 `protobuf:"varint,5,opt,name=wip_limit,json=wipLimit,proto3" json:"wip_limit,omitempty"`
+	// Tasks currently in the column (set on reads)
+	TaskCount     int32 `protobuf:"varint,6,opt,name=task_count,json=taskCount,proto3" json:"task_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoardColumn) Reset() {
+	*x = BoardColumn{}
+	mi := &file_task_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoardColumn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardColumn) ProtoMessage() {}
+
+func (x *BoardColumn) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardColumn.ProtoReflect.Descriptor instead.
+func (*BoardColumn) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *BoardColumn) GetColumnId() string {
+	if x != nil {
+		return x.ColumnId
+	}
+	return ""
+}
+
+func (x *BoardColumn) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BoardColumn) GetStatus() TaskStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TaskStatus_TASK_STATUS_UNSPECIFIED
+}
+
+func (x *BoardColumn) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *BoardColumn) GetWipLimit() int32 {
+	if x != nil {
+		return x.WipLimit
+	}
+	return 0
+}
+
+func (x *BoardColumn) GetTaskCount() int32 {
+	if x != nil {
+		return x.TaskCount
+	}
+	return 0
+}
+
+// Board of status columns for a project or team
+type Board struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BoardId       string                 `protobuf:"bytes,1,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	OrgId         string                 `protobuf:"bytes,2,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	TeamId        string                 `protobuf:"bytes,4,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Name          string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Columns       []*BoardColumn         `protobuf:"bytes,6,rep,name=columns,proto3" json:"columns,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Board) Reset() {
+	*x = Board{}
+	mi := &file_task_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Board) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Board) ProtoMessage() {}
+
+func (x *Board) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Board.ProtoReflect.Descriptor instead.
+func (*Board) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Board) GetBoardId() string {
+	if x != nil {
+		return x.BoardId
+	}
+	return ""
+}
+
+func (x *Board) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *Board) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *Board) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *Board) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Board) GetColumns() []*BoardColumn {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *Board) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Board) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Board) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Create board request; exactly one of project_id or team_id is required
+type CreateBoardRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Name      string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ProjectId string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	TeamId    string                 `protobuf:"bytes,3,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	// Defaults to one column per task status when empty
+	Columns       []*BoardColumn `protobuf:"bytes,4,rep,name=columns,proto3" json:"columns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBoardRequest) Reset() {
+	*x = CreateBoardRequest{}
+	mi := &file_task_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBoardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBoardRequest) ProtoMessage() {}
+
+func (x *CreateBoardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBoardRequest.ProtoReflect.Descriptor instead.
+func (*CreateBoardRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CreateBoardRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateBoardRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *CreateBoardRequest) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *CreateBoardRequest) GetColumns() []*BoardColumn {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+// Create board response
+type CreateBoardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Board         *Board                 `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBoardResponse) Reset() {
+	*x = CreateBoardResponse{}
+	mi := &file_task_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBoardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBoardResponse) ProtoMessage() {}
+
+func (x *CreateBoardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBoardResponse.ProtoReflect.Descriptor instead.
+func (*CreateBoardResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CreateBoardResponse) GetBoard() *Board {
+	if x != nil {
+		return x.Board
+	}
+	return nil
+}
+
+func (x *CreateBoardResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Get board request
+type GetBoardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BoardId       string                 `protobuf:"bytes,1,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBoardRequest) Reset() {
+	*x = GetBoardRequest{}
+	mi := &file_task_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBoardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBoardRequest) ProtoMessage() {}
+
+func (x *GetBoardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBoardRequest.ProtoReflect.Descriptor instead.
+func (*GetBoardRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetBoardRequest) GetBoardId() string {
+	if x != nil {
+		return x.BoardId
+	}
+	return ""
+}
+
+// Get board response
+type GetBoardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Board         *Board                 `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBoardResponse) Reset() {
+	*x = GetBoardResponse{}
+	mi := &file_task_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBoardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBoardResponse) ProtoMessage() {}
+
+func (x *GetBoardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBoardResponse.ProtoReflect.Descriptor instead.
+func (*GetBoardResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetBoardResponse) GetBoard() *Board {
+	if x != nil {
+		return x.Board
+	}
+	return nil
+}
+
+// List boards request
+type ListBoardsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	TeamId        string                 `protobuf:"bytes,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBoardsRequest) Reset() {
+	*x = ListBoardsRequest{}
+	mi := &file_task_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBoardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBoardsRequest) ProtoMessage() {}
+
+func (x *ListBoardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBoardsRequest.ProtoReflect.Descriptor instead.
+func (*ListBoardsRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListBoardsRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ListBoardsRequest) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+// List boards response
+type ListBoardsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Boards        []*Board               `protobuf:"bytes,1,rep,name=boards,proto3" json:"boards,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBoardsResponse) Reset() {
+	*x = ListBoardsResponse{}
+	mi := &file_task_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBoardsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBoardsResponse) ProtoMessage() {}
+
+func (x *ListBoardsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBoardsResponse.ProtoReflect.Descriptor instead.
+func (*ListBoardsResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListBoardsResponse) GetBoards() []*Board {
+	if x != nil {
+		return x.Boards
+	}
+	return nil
+}
+
+// Update board request
+type UpdateBoardRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	BoardId string                 `protobuf:"bytes,1,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	Name    string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// When set, replaces the board's columns; columns keep their column_id
+	Columns       []*BoardColumn `protobuf:"bytes,3,rep,name=columns,proto3" json:"columns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBoardRequest) Reset() {
+	*x = UpdateBoardRequest{}
+	mi := &file_task_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBoardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBoardRequest) ProtoMessage() {}
+
+func (x *UpdateBoardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBoardRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBoardRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UpdateBoardRequest) GetBoardId() string {
+	if x != nil {
+		return x.BoardId
+	}
+	return ""
+}
+
+func (x *UpdateBoardRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateBoardRequest) GetColumns() []*BoardColumn {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+// Update board response
+type UpdateBoardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Board         *Board                 `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBoardResponse) Reset() {
+	*x = UpdateBoardResponse{}
+	mi := &file_task_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBoardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBoardResponse) ProtoMessage() {}
+
+func (x *UpdateBoardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBoardResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBoardResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *UpdateBoardResponse) GetBoard() *Board {
+	if x != nil {
+		return x.Board
+	}
+	return nil
+}
+
+func (x *UpdateBoardResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Delete board request
+type DeleteBoardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BoardId       string                 `protobuf:"bytes,1,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBoardRequest) Reset() {
+	*x = DeleteBoardRequest{}
+	mi := &file_task_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBoardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBoardRequest) ProtoMessage() {}
+
+func (x *DeleteBoardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBoardRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBoardRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DeleteBoardRequest) GetBoardId() string {
+	if x != nil {
+		return x.BoardId
+	}
+	return ""
+}
+
+// Delete board response
+type DeleteBoardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBoardResponse) Reset() {
+	*x = DeleteBoardResponse{}
+	mi := &file_task_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBoardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBoardResponse) ProtoMessage() {}
+
+func (x *DeleteBoardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBoardResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBoardResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DeleteBoardResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Personal task reminder, either at an absolute time or relative to the due date
+type TaskReminder struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ReminderId       string                 `protobuf:"bytes,1,opt,name=reminder_id,json=reminderId,proto3" json:"reminder_id,omitempty"`
+	TaskId           string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	UserId           string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RemindAt         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=remind_at,json=remindAt,proto3" json:"remind_at,omitempty"`
+	BeforeDueMinutes int32                  `protobuf:"varint,5,opt,name=before_due_minutes,json=beforeDueMinutes,proto3" json:"before_due_minutes,omitempty"`
+	// When the reminder will fire (follows the due date for relative reminders)
+	FireAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=fire_at,json=fireAt,proto3" json:"fire_at,omitempty"`
+	SentAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskReminder) Reset() {
+	*x = TaskReminder{}
+	mi := &file_task_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskReminder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskReminder) ProtoMessage() {}
+
+func (x *TaskReminder) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskReminder.ProtoReflect.Descriptor instead.
+func (*TaskReminder) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *TaskReminder) GetReminderId() string {
+	if x != nil {
+		return x.ReminderId
+	}
+	return ""
+}
+
+func (x *TaskReminder) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *TaskReminder) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TaskReminder) GetRemindAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RemindAt
+	}
+	return nil
+}
+
+func (x *TaskReminder) GetBeforeDueMinutes() int32 {
+	if x != nil {
+		return x.BeforeDueMinutes
+	}
+	return 0
+}
+
+func (x *TaskReminder) GetFireAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FireAt
+	}
+	return nil
+}
+
+func (x *TaskReminder) GetSentAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SentAt
+	}
+	return nil
+}
+
+func (x *TaskReminder) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Create task reminder request; set exactly one of remind_at or before_due_minutes
+type CreateTaskReminderRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TaskId   string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	RemindAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=remind_at,json=remindAt,proto3" json:"remind_at,omitempty"`
+	// e.g. 2880 to be reminded two days before the due date
+	BeforeDueMinutes int32 `protobuf:"varint,3,opt,name=before_due_minutes,json=beforeDueMinutes,proto3" json:"before_due_minutes,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateTaskReminderRequest) Reset() {
+	*x = CreateTaskReminderRequest{}
+	mi := &file_task_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaskReminderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaskReminderRequest) ProtoMessage() {}
+
+func (x *CreateTaskReminderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaskReminderRequest.ProtoReflect.Descriptor instead.
+func (*CreateTaskReminderRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CreateTaskReminderRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *CreateTaskReminderRequest) GetRemindAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RemindAt
+	}
+	return nil
+}
+
+func (x *CreateTaskReminderRequest) GetBeforeDueMinutes() int32 {
+	if x != nil {
+		return x.BeforeDueMinutes
+	}
+	return 0
+}
+
+// Create task reminder response
+type CreateTaskReminderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reminder      *TaskReminder          `protobuf:"bytes,1,opt,name=reminder,proto3" json:"reminder,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTaskReminderResponse) Reset() {
+	*x = CreateTaskReminderResponse{}
+	mi := &file_task_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaskReminderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaskReminderResponse) ProtoMessage() {}
+
+func (x *CreateTaskReminderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaskReminderResponse.ProtoReflect.Descriptor instead.
+func (*CreateTaskReminderResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CreateTaskReminderResponse) GetReminder() *TaskReminder {
+	if x != nil {
+		return x.Reminder
+	}
+	return nil
+}
+
+func (x *CreateTaskReminderResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// List task reminders request
+type ListTaskRemindersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskRemindersRequest) Reset() {
+	*x = ListTaskRemindersRequest{}
+	mi := &file_task_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskRemindersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskRemindersRequest) ProtoMessage() {}
+
+func (x *ListTaskRemindersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskRemindersRequest.ProtoReflect.Descriptor instead.
+func (*ListTaskRemindersRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListTaskRemindersRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+// List task reminders response
+type ListTaskRemindersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reminders     []*TaskReminder        `protobuf:"bytes,1,rep,name=reminders,proto3" json:"reminders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskRemindersResponse) Reset() {
+	*x = ListTaskRemindersResponse{}
+	mi := &file_task_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskRemindersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskRemindersResponse) ProtoMessage() {}
+
+func (x *ListTaskRemindersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskRemindersResponse.ProtoReflect.Descriptor instead.
+func (*ListTaskRemindersResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListTaskRemindersResponse) GetReminders() []*TaskReminder {
+	if x != nil {
+		return x.Reminders
+	}
+	return nil
+}
+
+// Delete task reminder request
+type DeleteTaskReminderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	ReminderId    string                 `protobuf:"bytes,2,opt,name=reminder_id,json=reminderId,proto3" json:"reminder_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTaskReminderRequest) Reset() {
+	*x = DeleteTaskReminderRequest{}
+	mi := &file_task_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaskReminderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaskReminderRequest) ProtoMessage() {}
+
+func (x *DeleteTaskReminderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaskReminderRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTaskReminderRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *DeleteTaskReminderRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *DeleteTaskReminderRequest) GetReminderId() string {
+	if x != nil {
+		return x.ReminderId
+	}
+	return ""
+}
+
+// Delete task reminder response
+type DeleteTaskReminderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTaskReminderResponse) Reset() {
+	*x = DeleteTaskReminderResponse{}
+	mi := &file_task_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaskReminderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaskReminderResponse) ProtoMessage() {}
+
+func (x *DeleteTaskReminderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaskReminderResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTaskReminderResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DeleteTaskReminderResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Open work per assignee within a team or group
+type MemberWorkload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Open          int32                  `protobuf:"varint,2,opt,name=open,proto3" json:"open,omitempty"`
+	InProgress    int32                  `protobuf:"varint,3,opt,name=in_progress,json=inProgress,proto3" json:"in_progress,omitempty"`
+	Overdue       int32                  `protobuf:"varint,4,opt,name=overdue,proto3" json:"overdue,omitempty"`
+	Completed     int32                  `protobuf:"varint,5,opt,name=completed,proto3" json:"completed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberWorkload) Reset() {
+	*x = MemberWorkload{}
+	mi := &file_task_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberWorkload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberWorkload) ProtoMessage() {}
+
+func (x *MemberWorkload) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberWorkload.ProtoReflect.Descriptor instead.
+func (*MemberWorkload) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *MemberWorkload) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MemberWorkload) GetOpen() int32 {
+	if x != nil {
+		return x.Open
+	}
+	return 0
+}
+
+func (x *MemberWorkload) GetInProgress() int32 {
+	if x != nil {
+		return x.InProgress
+	}
+	return 0
+}
+
+func (x *MemberWorkload) GetOverdue() int32 {
+	if x != nil {
+		return x.Overdue
+	}
+	return 0
+}
+
+func (x *MemberWorkload) GetCompleted() int32 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+// Get team tasks request
+type GetTeamTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	StatusFilter  TaskStatus             `protobuf:"varint,2,opt,name=status_filter,json=statusFilter,proto3,enum=task.TaskStatus" json:"status_filter,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamTasksRequest) Reset() {
+	*x = GetTeamTasksRequest{}
+	mi := &file_task_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamTasksRequest) ProtoMessage() {}
+
+func (x *GetTeamTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamTasksRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamTasksRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetTeamTasksRequest) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *GetTeamTasksRequest) GetStatusFilter() TaskStatus {
+	if x != nil {
+		return x.StatusFilter
+	}
+	return TaskStatus_TASK_STATUS_UNSPECIFIED
+}
+
+func (x *GetTeamTasksRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetTeamTasksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// Get team tasks response; by_status and workload cover the whole team
+type GetTeamTasksResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Tasks          []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	TotalCount     int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page           int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize       int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	ByStatus       map[string]int32       `protobuf:"bytes,5,rep,name=by_status,json=byStatus,proto3" json:"by_status,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Workload       []*MemberWorkload      `protobuf:"bytes,6,rep,name=workload,proto3" json:"workload,omitempty"`
+	UnassignedOpen int32                  `protobuf:"varint,7,opt,name=unassigned_open,json=unassignedOpen,proto3" json:"unassigned_open,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetTeamTasksResponse) Reset() {
+	*x = GetTeamTasksResponse{}
+	mi := &file_task_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamTasksResponse) ProtoMessage() {}
+
+func (x *GetTeamTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamTasksResponse.ProtoReflect.Descriptor instead.
+func (*GetTeamTasksResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetTeamTasksResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *GetTeamTasksResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetTeamTasksResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetTeamTasksResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetTeamTasksResponse) GetByStatus() map[string]int32 {
+	if x != nil {
+		return x.ByStatus
+	}
+	return nil
+}
+
+func (x *GetTeamTasksResponse) GetWorkload() []*MemberWorkload {
+	if x != nil {
+		return x.Workload
+	}
+	return nil
+}
+
+func (x *GetTeamTasksResponse) GetUnassignedOpen() int32 {
+	if x != nil {
+		return x.UnassignedOpen
+	}
+	return 0
+}
+
+// Get group tasks request
+type GetGroupTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	StatusFilter  TaskStatus             `protobuf:"varint,2,opt,name=status_filter,json=statusFilter,proto3,enum=task.TaskStatus" json:"status_filter,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupTasksRequest) Reset() {
+	*x = GetGroupTasksRequest{}
+	mi := &file_task_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupTasksRequest) ProtoMessage() {}
+
+func (x *GetGroupTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupTasksRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupTasksRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetGroupTasksRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GetGroupTasksRequest) GetStatusFilter() TaskStatus {
+	if x != nil {
+		return x.StatusFilter
+	}
+	return TaskStatus_TASK_STATUS_UNSPECIFIED
+}
+
+func (x *GetGroupTasksRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetGroupTasksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// Get group tasks response; by_status and workload cover the whole group
+type GetGroupTasksResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Tasks          []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	TotalCount     int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page           int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize       int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	ByStatus       map[string]int32       `protobuf:"bytes,5,rep,name=by_status,json=byStatus,proto3" json:"by_status,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Workload       []*MemberWorkload      `protobuf:"bytes,6,rep,name=workload,proto3" json:"workload,omitempty"`
+	UnassignedOpen int32                  `protobuf:"varint,7,opt,name=unassigned_open,json=unassignedOpen,proto3" json:"unassigned_open,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetGroupTasksResponse) Reset() {
+	*x = GetGroupTasksResponse{}
+	mi := &file_task_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupTasksResponse) ProtoMessage() {}
+
+func (x *GetGroupTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupTasksResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupTasksResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetGroupTasksResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *GetGroupTasksResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetGroupTasksResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetGroupTasksResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetGroupTasksResponse) GetByStatus() map[string]int32 {
+	if x != nil {
+		return x.ByStatus
+	}
+	return nil
+}
+
+func (x *GetGroupTasksResponse) GetWorkload() []*MemberWorkload {
+	if x != nil {
+		return x.Workload
+	}
+	return nil
+}
+
+func (x *GetGroupTasksResponse) GetUnassignedOpen() int32 {
+	if x != nil {
+		return x.UnassignedOpen
+	}
+	return 0
+}
+
+// Task comment
+type TaskComment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommentId     string                 `protobuf:"bytes,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	AuthorId      string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Body          string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskComment) Reset() {
+	*x = TaskComment{}
+	mi := &file_task_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskComment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskComment) ProtoMessage() {}
+
+func (x *TaskComment) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskComment.ProtoReflect.Descriptor instead.
+func (*TaskComment) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *TaskComment) GetCommentId() string {
+	if x != nil {
+		return x.CommentId
+	}
+	return ""
+}
+
+func (x *TaskComment) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *TaskComment) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *TaskComment) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *TaskComment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TaskComment) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Create task comment request
+type CreateTaskCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Body          string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTaskCommentRequest) Reset() {
+	*x = CreateTaskCommentRequest{}
+	mi := &file_task_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaskCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaskCommentRequest) ProtoMessage() {}
+
+func (x *CreateTaskCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaskCommentRequest.ProtoReflect.Descriptor instead.
+func (*CreateTaskCommentRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CreateTaskCommentRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *CreateTaskCommentRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+// Create task comment response
+type CreateTaskCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comment       *TaskComment           `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTaskCommentResponse) Reset() {
+	*x = CreateTaskCommentResponse{}
+	mi := &file_task_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaskCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaskCommentResponse) ProtoMessage() {}
+
+func (x *CreateTaskCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaskCommentResponse.ProtoReflect.Descriptor instead.
+func (*CreateTaskCommentResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CreateTaskCommentResponse) GetComment() *TaskComment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+func (x *CreateTaskCommentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// List task comments request
+type ListTaskCommentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskCommentsRequest) Reset() {
+	*x = ListTaskCommentsRequest{}
+	mi := &file_task_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskCommentsRequest) ProtoMessage() {}
+
+func (x *ListTaskCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListTaskCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ListTaskCommentsRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ListTaskCommentsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListTaskCommentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// List task comments response
+type ListTaskCommentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comments      []*TaskComment         `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskCommentsResponse) Reset() {
+	*x = ListTaskCommentsResponse{}
+	mi := &file_task_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskCommentsResponse) ProtoMessage() {}
+
+func (x *ListTaskCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListTaskCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListTaskCommentsResponse) GetComments() []*TaskComment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *ListTaskCommentsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// Get priority matrix request. Urgent means due within urgent_within_hours
+// (or overdue); important means high or critical priority.
+type GetPriorityMatrixRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whose tasks to bucket; defaults to the caller. Ignored when team_id is set
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TeamId string `protobuf:"bytes,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	// Defaults to 48
+	UrgentWithinHours int32 `protobuf:"varint,3,opt,name=urgent_within_hours,json=urgentWithinHours,proto3" json:"urgent_within_hours,omitempty"`
+	// "do_first", "schedule", "delegate" or "eliminate" to page through one
+	// quadrant; empty returns the first page of every quadrant
+	Quadrant      string `protobuf:"bytes,4,opt,name=quadrant,proto3" json:"quadrant,omitempty"`
+	Page          int32  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriorityMatrixRequest) Reset() {
+	*x = GetPriorityMatrixRequest{}
+	mi := &file_task_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriorityMatrixRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriorityMatrixRequest) ProtoMessage() {}
+
+func (x *GetPriorityMatrixRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriorityMatrixRequest.ProtoReflect.Descriptor instead.
+func (*GetPriorityMatrixRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetPriorityMatrixRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetPriorityMatrixRequest) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *GetPriorityMatrixRequest) GetUrgentWithinHours() int32 {
+	if x != nil {
+		return x.UrgentWithinHours
+	}
+	return 0
+}
+
+func (x *GetPriorityMatrixRequest) GetQuadrant() string {
+	if x != nil {
+		return x.Quadrant
+	}
+	return ""
+}
+
+func (x *GetPriorityMatrixRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetPriorityMatrixRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// One quadrant of the priority matrix
+type MatrixQuadrant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Urgent        bool                   `protobuf:"varint,2,opt,name=urgent,proto3" json:"urgent,omitempty"`
+	Important     bool                   `protobuf:"varint,3,opt,name=important,proto3" json:"important,omitempty"`
+	Tasks         []*Task                `protobuf:"bytes,4,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,5,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page          int32                  `protobuf:"varint,6,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatrixQuadrant) Reset() {
+	*x = MatrixQuadrant{}
+	mi := &file_task_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatrixQuadrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatrixQuadrant) ProtoMessage() {}
+
+func (x *MatrixQuadrant) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatrixQuadrant.ProtoReflect.Descriptor instead.
+func (*MatrixQuadrant) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *MatrixQuadrant) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MatrixQuadrant) GetUrgent() bool {
+	if x != nil {
+		return x.Urgent
+	}
+	return false
+}
+
+func (x *MatrixQuadrant) GetImportant() bool {
+	if x != nil {
+		return x.Important
+	}
+	return false
+}
+
+func (x *MatrixQuadrant) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *MatrixQuadrant) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *MatrixQuadrant) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *MatrixQuadrant) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// Get priority matrix response
+type GetPriorityMatrixResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Quadrants []*MatrixQuadrant      `protobuf:"bytes,1,rep,name=quadrants,proto3" json:"quadrants,omitempty"`
+	// Tasks due before this time count as urgent
+	UrgentBefore  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=urgent_before,json=urgentBefore,proto3" json:"urgent_before,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriorityMatrixResponse) Reset() {
+	*x = GetPriorityMatrixResponse{}
+	mi := &file_task_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriorityMatrixResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriorityMatrixResponse) ProtoMessage() {}
+
+func (x *GetPriorityMatrixResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriorityMatrixResponse.ProtoReflect.Descriptor instead.
+func (*GetPriorityMatrixResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetPriorityMatrixResponse) GetQuadrants() []*MatrixQuadrant {
+	if x != nil {
+		return x.Quadrants
+	}
+	return nil
+}
+
+func (x *GetPriorityMatrixResponse) GetUrgentBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UrgentBefore
+	}
+	return nil
+}
+
+// Export user data request (internal, used by the user service)
+type ExportUserDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserDataRequest) Reset() {
+	*x = ExportUserDataRequest{}
+	mi := &file_task_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataRequest) ProtoMessage() {}
+
+func (x *ExportUserDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportUserDataRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ExportUserDataRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// Export user data response
+type ExportUserDataResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// JSON document with everything this service holds about the user
+	Data          []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserDataResponse) Reset() {
+	*x = ExportUserDataResponse{}
+	mi := &file_task_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataResponse) ProtoMessage() {}
+
+func (x *ExportUserDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataResponse.ProtoReflect.Descriptor instead.
+func (*ExportUserDataResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ExportUserDataResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Erase user data request (internal, used by the user service)
+type EraseUserDataRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// "anonymize" keeps shared records but detaches them from the user;
+	// "delete" also removes records that only the user owns
+	Mode          string `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EraseUserDataRequest) Reset() {
+	*x = EraseUserDataRequest{}
+	mi := &file_task_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EraseUserDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EraseUserDataRequest) ProtoMessage() {}
+
+func (x *EraseUserDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EraseUserDataRequest.ProtoReflect.Descriptor instead.
+func (*EraseUserDataRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *EraseUserDataRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *EraseUserDataRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+// Erase user data response
+type EraseUserDataResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Rows affected per record type
+	Affected      map[string]int64 `protobuf:"bytes,1,rep,name=affected,proto3" json:"affected,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EraseUserDataResponse) Reset() {
+	*x = EraseUserDataResponse{}
+	mi := &file_task_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EraseUserDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EraseUserDataResponse) ProtoMessage() {}
+
+func (x *EraseUserDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EraseUserDataResponse.ProtoReflect.Descriptor instead.
+func (*EraseUserDataResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *EraseUserDataResponse) GetAffected() map[string]int64 {
+	if x != nil {
+		return x.Affected
+	}
+	return nil
+}
+
+// A task that cannot start until depends_on_id is done
+type TaskDependency struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	DependsOnId   string                 `protobuf:"bytes,2,opt,name=depends_on_id,json=dependsOnId,proto3" json:"depends_on_id,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskDependency) Reset() {
+	*x = TaskDependency{}
+	mi := &file_task_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskDependency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskDependency) ProtoMessage() {}
+
+func (x *TaskDependency) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskDependency.ProtoReflect.Descriptor instead.
+func (*TaskDependency) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *TaskDependency) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *TaskDependency) GetDependsOnId() string {
+	if x != nil {
+		return x.DependsOnId
+	}
+	return ""
+}
+
+func (x *TaskDependency) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *TaskDependency) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Add task dependency request (task_id and depends_on_id accept task keys)
+type AddTaskDependencyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	DependsOnId   string                 `protobuf:"bytes,2,opt,name=depends_on_id,json=dependsOnId,proto3" json:"depends_on_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTaskDependencyRequest) Reset() {
+	*x = AddTaskDependencyRequest{}
+	mi := &file_task_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTaskDependencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTaskDependencyRequest) ProtoMessage() {}
+
+func (x *AddTaskDependencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTaskDependencyRequest.ProtoReflect.Descriptor instead.
+func (*AddTaskDependencyRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *AddTaskDependencyRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *AddTaskDependencyRequest) GetDependsOnId() string {
+	if x != nil {
+		return x.DependsOnId
+	}
+	return ""
+}
+
+// Add task dependency response
+type AddTaskDependencyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dependency    *TaskDependency        `protobuf:"bytes,1,opt,name=dependency,proto3" json:"dependency,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTaskDependencyResponse) Reset() {
+	*x = AddTaskDependencyResponse{}
+	mi := &file_task_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTaskDependencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTaskDependencyResponse) ProtoMessage() {}
+
+func (x *AddTaskDependencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTaskDependencyResponse.ProtoReflect.Descriptor instead.
+func (*AddTaskDependencyResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *AddTaskDependencyResponse) GetDependency() *TaskDependency {
+	if x != nil {
+		return x.Dependency
+	}
+	return nil
+}
+
+func (x *AddTaskDependencyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Remove task dependency request
+type RemoveTaskDependencyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	DependsOnId   string                 `protobuf:"bytes,2,opt,name=depends_on_id,json=dependsOnId,proto3" json:"depends_on_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveTaskDependencyRequest) Reset() {
+	*x = RemoveTaskDependencyRequest{}
+	mi := &file_task_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTaskDependencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTaskDependencyRequest) ProtoMessage() {}
+
+func (x *RemoveTaskDependencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTaskDependencyRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTaskDependencyRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *RemoveTaskDependencyRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *RemoveTaskDependencyRequest) GetDependsOnId() string {
+	if x != nil {
+		return x.DependsOnId
+	}
+	return ""
+}
+
+// Remove task dependency response
+type RemoveTaskDependencyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveTaskDependencyResponse) Reset() {
+	*x = RemoveTaskDependencyResponse{}
+	mi := &file_task_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTaskDependencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTaskDependencyResponse) ProtoMessage() {}
+
+func (x *RemoveTaskDependencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTaskDependencyResponse.ProtoReflect.Descriptor instead.
+func (*RemoveTaskDependencyResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *RemoveTaskDependencyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// List task dependencies request
+type ListTaskDependenciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskDependenciesRequest) Reset() {
+	*x = ListTaskDependenciesRequest{}
+	mi := &file_task_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskDependenciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskDependenciesRequest) ProtoMessage() {}
+
+func (x *ListTaskDependenciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskDependenciesRequest.ProtoReflect.Descriptor instead.
+func (*ListTaskDependenciesRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ListTaskDependenciesRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+// List task dependencies response
+type ListTaskDependenciesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Tasks this task waits on
+	BlockedBy []*TaskDependency `protobuf:"bytes,1,rep,name=blocked_by,json=blockedBy,proto3" json:"blocked_by,omitempty"`
+	// Tasks waiting on this task
+	Blocking      []*TaskDependency `protobuf:"bytes,2,rep,name=blocking,proto3" json:"blocking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskDependenciesResponse) Reset() {
+	*x = ListTaskDependenciesResponse{}
+	mi := &file_task_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskDependenciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskDependenciesResponse) ProtoMessage() {}
+
+func (x *ListTaskDependenciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskDependenciesResponse.ProtoReflect.Descriptor instead.
+func (*ListTaskDependenciesResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ListTaskDependenciesResponse) GetBlockedBy() []*TaskDependency {
+	if x != nil {
+		return x.BlockedBy
+	}
+	return nil
+}
+
+func (x *ListTaskDependenciesResponse) GetBlocking() []*TaskDependency {
+	if x != nil {
+		return x.Blocking
+	}
+	return nil
+}
+
+var File_task_proto protoreflect.FileDescriptor
+
+const file_task_proto_rawDesc = "" +
+	"\n" +
+	"\n" +
+	"task.proto\x12\x04task\x1a\x1cgoogle/api/annotations.proto\x1a\x19google/api/httpbody.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd3\x05\n" +
+	"\x04Task\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12(\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x10.task.TaskStatusR\x06status\x12.\n" +
+	"\bpriority\x18\x05 \x01(\x0e2\x12.task.TaskPriorityR\bpriority\x12\x1f\n" +
+	"\vassigned_to\x18\x06 \x01(\tR\n" +
+	"assignedTo\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\a \x01(\tR\tcreatedBy\x12\x17\n" +
+	"\ateam_id\x18\b \x01(\tR\x06teamId\x12\x19\n" +
+	"\bgroup_id\x18\t \x01(\tR\agroupId\x125\n" +
+	"\bdue_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x12\n" +
+	"\x04tags\x18\r \x03(\tR\x04tags\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x0e \x01(\tR\tprojectId\x12\x19\n" +
+	"\btask_key\x18\x0f \x01(\tR\ataskKey\x129\n" +
+	"\n" +
+	"started_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12=\n" +
+	"\fcompleted_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12\x18\n" +
+	"\aversion\x18\x12 \x01(\x03R\aversion\x12\x1d\n" +
+	"\n" +
+	"is_overdue\x18\x13 \x01(\bR\tisOverdue\"\x85\x03\n" +
+	"\x11CreateTaskRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12(\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x10.task.TaskStatusR\x06status\x12.\n" +
+	"\bpriority\x18\x04 \x01(\x0e2\x12.task.TaskPriorityR\bpriority\x12\x1f\n" +
+	"\vassigned_to\x18\x05 \x01(\tR\n" +
+	"assignedTo\x12\x17\n" +
+	"\ateam_id\x18\x06 \x01(\tR\x06teamId\x12\x19\n" +
+	"\bgroup_id\x18\a \x01(\tR\agroupId\x125\n" +
+	"\bdue_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x12\x12\n" +
+	"\x04tags\x18\t \x03(\tR\x04tags\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\n" +
+	" \x01(\tR\tprojectId\x12\x1f\n" +
+	"\vproject_key\x18\v \x01(\tR\n" +
+	"projectKey\"N\n" +
+	"\x12CreateTaskResponse\x12\x1e\n" +
+	"\x04task\x18\x01 \x01(\v2\n" +
+	".task.TaskR\x04task\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\")\n" +
+	"\x0eGetTaskRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\"1\n" +
+	"\x0fGetTaskResponse\x12\x1e\n" +
+	"\x04task\x18\x01 \x01(\v2\n" +
+	".task.TaskR\x04task\"\xc4\x02\n" +
+	"\x11UpdateTaskRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12(\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x10.task.TaskStatusR\x06status\x12.\n" +
+	"\bpriority\x18\x05 \x01(\x0e2\x12.task.TaskPriorityR\bpriority\x12\x1f\n" +
+	"\vassigned_to\x18\x06 \x01(\tR\n" +
+	"assignedTo\x125\n" +
+	"\bdue_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x12\x12\n" +
+	"\x04tags\x18\b \x03(\tR\x04tags\x12\x18\n" +
+	"\aversion\x18\t \x01(\x03R\aversion\"N\n" +
+	"\x12UpdateTaskResponse\x12\x1e\n" +
+	"\x04task\x18\x01 \x01(\v2\n" +
+	".task.TaskR\x04task\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\",\n" +
+	"\x11DeleteTaskRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\".\n" +
+	"\x12DeleteTaskResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\x8b\x03\n" +
+	"\x10ListTasksRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x125\n" +
+	"\rstatus_filter\x18\x03 \x01(\x0e2\x10.task.TaskStatusR\fstatusFilter\x12;\n" +
+	"\x0fpriority_filter\x18\x04 \x01(\x0e2\x12.task.TaskPriorityR\x0epriorityFilter\x12\x1f\n" +
+	"\vteam_filter\x18\x05 \x01(\tR\n" +
+	"teamFilter\x12!\n" +
+	"\fgroup_filter\x18\x06 \x01(\tR\vgroupFilter\x12,\n" +
+	"\x12assigned_to_filter\x18\a \x01(\tR\x10assignedToFilter\x12%\n" +
+	"\x0eproject_filter\x18\b \x01(\tR\rprojectFilter\x12\x16\n" +
+	"\x06search\x18\t \x01(\tR\x06search\x12!\n" +
+	"\foverdue_only\x18\n" +
+	" \x01(\bR\voverdueOnly\"\x87\x01\n" +
+	"\x11ListTasksResponse\x12 \n" +
+	"\x05tasks\x18\x01 \x03(\v2\n" +
+	".task.TaskR\x05tasks\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"E\n" +
+	"\x11AssignTaskRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"N\n" +
+	"\x12AssignTaskResponse\x12\x1e\n" +
+	"\x04task\x18\x01 \x01(\v2\n" +
+	".task.TaskR\x04task\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"v\n" +
+	"\x17UpdateTaskStatusRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12(\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x10.task.TaskStatusR\x06status\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x03R\aversion\"T\n" +
+	"\x18UpdateTaskStatusResponse\x12\x1e\n" +
+	"\x04task\x18\x01 \x01(\v2\n" +
+	".task.TaskR\x04task\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x96\x01\n" +
+	"\x13GetUserTasksRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x125\n" +
+	"\rstatus_filter\x18\x02 \x01(\x0e2\x10.task.TaskStatusR\fstatusFilter\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"Y\n" +
+	"\x14GetUserTasksResponse\x12 \n" +
+	"\x05tasks\x18\x01 \x03(\v2\n" +
+	".task.TaskR\x05tasks\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\"\x1b\n" +
+	"\x19GetCalendarFeedURLRequest\"D\n" +
+	"\x1aGetCalendarFeedURLResponse\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"\x1e\n" +
+	"\x1cRotateCalendarFeedURLRequest\"G\n" +
+	"\x1dRotateCalendarFeedURLResponse\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"\x92\x01\n" +
+	"\x16GetCalendarFeedRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12\x1c\n" +
+	"\tcomponent\x18\x03 \x01(\tR\tcomponent\x12+\n" +
+	"\x11include_completed\x18\x04 \x01(\bR\x10includeCompleted\"\x9f\x02\n" +
+	"\aWebhook\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\tR\twebhookId\x12\x15\n" +
+	"\x06org_id\x18\x02 \x01(\tR\x05orgId\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x1f\n" +
+	"\vevent_types\x18\x04 \x03(\tR\n" +
+	"eventTypes\x12\x16\n" +
+	"\x06active\x18\x05 \x01(\bR\x06active\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x06 \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"a\n" +
+	"\x14CreateWebhookRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\x12\x1f\n" +
+	"\vevent_types\x18\x03 \x03(\tR\n" +
+	"eventTypes\"r\n" +
+	"\x15CreateWebhookResponse\x12'\n" +
+	"\awebhook\x18\x01 \x01(\v2\r.task.WebhookR\awebhook\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\x15\n" +
+	"\x13ListWebhooksRequest\"A\n" +
+	"\x14ListWebhooksResponse\x12)\n" +
+	"\bwebhooks\x18\x01 \x03(\v2\r.task.WebhookR\bwebhooks\"5\n" +
+	"\x14DeleteWebhookRequest\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\tR\twebhookId\"1\n" +
+	"\x15DeleteWebhookResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\x95\x03\n" +
+	"\x12ExportTasksRequest\x12\x12\n" +
+	"\x04view\x18\x01 \x01(\tR\x04view\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12\x18\n" +
+	"\acolumns\x18\x03 \x03(\tR\acolumns\x125\n" +
+	"\rstatus_filter\x18\x04 \x01(\x0e2\x10.task.TaskStatusR\fstatusFilter\x12;\n" +
+	"\x0fpriority_filter\x18\x05 \x01(\x0e2\x12.task.TaskPriorityR\x0epriorityFilter\x12\x1f\n" +
+	"\vteam_filter\x18\x06 \x01(\tR\n" +
+	"teamFilter\x12!\n" +
+	"\fgroup_filter\x18\a \x01(\tR\vgroupFilter\x12%\n" +
+	"\x0eproject_filter\x18\b \x01(\tR\rprojectFilter\x12,\n" +
+	"\x12assigned_to_filter\x18\t \x01(\tR\x10assignedToFilter\x12\x16\n" +
+	"\x06search\x18\n" +
+	" \x01(\tR\x06search\x12\x14\n" +
+	"\x05title\x18\v \x01(\tR\x05title\"+\n" +
+	"\x10WatchTaskRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\"-\n" +
+	"\x11WatchTaskResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"-\n" +
+	"\x12UnwatchTaskRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\"/\n" +
+	"\x13UnwatchTaskResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"q\n" +
+	"\x10GetMyWorkRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x1a\n" +
+	"\btimezone\x18\x02 \x01(\tR\btimezone\x12+\n" +
+	"\x11include_completed\x18\x03 \x01(\bR\x10includeCompleted\"P\n" +
+	"\vWorkSection\x12 \n" +
+	"\x05tasks\x18\x01 \x03(\v2\n" +
+	".task.TaskR\x05tasks\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\"\xe9\x03\n" +
+	"\x11GetMyWorkResponse\x12-\n" +
+	"\bassigned\x18\x01 \x01(\v2\x11.task.WorkSectionR\bassigned\x12-\n" +
+	"\bwatching\x18\x02 \x01(\v2\x11.task.WorkSectionR\bwatching\x12+\n" +
+	"\aoverdue\x18\x03 \x01(\v2\x11.task.WorkSectionR\aoverdue\x125\n" +
+	"\rdue_this_week\x18\x04 \x01(\v2\x11.task.WorkSectionR\vdueThisWeek\x12[\n" +
+	"\x12assigned_by_status\x18\x05 \x03(\v2-.task.GetMyWorkResponse.AssignedByStatusEntryR\x10assignedByStatus\x129\n" +
+	"\n" +
+	"week_start\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tweekStart\x125\n" +
+	"\bweek_end\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\aweekEnd\x1aC\n" +
+	"\x15AssignedByStatusEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"|\n" +
+	"\x13GetTaskStatsRequest\x12\x17\n" +
+	"\ateam_id\x18\x01 \x01(\tR\x06teamId\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\tR\agroupId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x03 \x01(\tR\tprojectId\x12\x12\n" +
+	"\x04days\x18\x04 \x01(\x05R\x04days\"\\\n" +
+	"\x0eTaskTrendPoint\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\x05R\acreated\x12\x1c\n" +
+	"\tcompleted\x18\x03 \x01(\x05R\tcompleted\"\xa6\x01\n" +
+	"\fAssigneeLoad\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04open\x18\x02 \x01(\x05R\x04open\x12\x1f\n" +
+	"\vin_progress\x18\x03 \x01(\x05R\n" +
+	"inProgress\x12\x18\n" +
+	"\aoverdue\x18\x04 \x01(\x05R\aoverdue\x12.\n" +
+	"\x13completed_in_window\x18\x05 \x01(\x05R\x11completedInWindow\"\x82\x04\n" +
+	"\x14GetTaskStatsResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12E\n" +
+	"\tby_status\x18\x02 \x03(\v2(.task.GetTaskStatsResponse.ByStatusEntryR\bbyStatus\x12K\n" +
+	"\vby_priority\x18\x03 \x03(\v2*.task.GetTaskStatsResponse.ByPriorityEntryR\n" +
+	"byPriority\x12*\n" +
+	"\x05trend\x18\x04 \x03(\v2\x14.task.TaskTrendPointR\x05trend\x12/\n" +
+	"\x14avg_cycle_time_hours\x18\x05 \x01(\x01R\x11avgCycleTimeHours\x12.\n" +
+	"\x13completed_in_window\x18\x06 \x01(\x05R\x11completedInWindow\x127\n" +
+	"\rassignee_load\x18\a \x03(\v2\x12.task.AssigneeLoadR\fassigneeLoad\x1a;\n" +
+	"\rByStatusEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1a=\n" +
+	"\x0fByPriorityEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xc0\x01\n" +
+	"\vBoardColumn\x12\x1b\n" +
+	"\tcolumn_id\x18\x01 \x01(\tR\bcolumnId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12(\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x10.task.TaskStatusR\x06status\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\x05R\bposition\x12\x1b\n" +
+	"\twip_limit\x18\x05 \x01(\x05R\bwipLimit\x12\x1d\n" +
+	"\n" +
+	"task_count\x18\x06 \x01(\x05R\ttaskCount\"\xc7\x02\n" +
+	"\x05Board\x12\x19\n" +
+	"\bboard_id\x18\x01 \x01(\tR\aboardId\x12\x15\n" +
+	"\x06org_id\x18\x02 \x01(\tR\x05orgId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x03 \x01(\tR\tprojectId\x12\x17\n" +
+	"\ateam_id\x18\x04 \x01(\tR\x06teamId\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12+\n" +
+	"\acolumns\x18\x06 \x03(\v2\x11.task.BoardColumnR\acolumns\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\a \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x8d\x01\n" +
+	"\x12CreateBoardRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x12\x17\n" +
+	"\ateam_id\x18\x03 \x01(\tR\x06teamId\x12+\n" +
+	"\acolumns\x18\x04 \x03(\v2\x11.task.BoardColumnR\acolumns\"R\n" +
+	"\x13CreateBoardResponse\x12!\n" +
+	"\x05board\x18\x01 \x01(\v2\v.task.BoardR\x05board\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\",\n" +
+	"\x0fGetBoardRequest\x12\x19\n" +
+	"\bboard_id\x18\x01 \x01(\tR\aboardId\"5\n" +
+	"\x10GetBoardResponse\x12!\n" +
+	"\x05board\x18\x01 \x01(\v2\v.task.BoardR\x05board\"K\n" +
+	"\x11ListBoardsRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x17\n" +
+	"\ateam_id\x18\x02 \x01(\tR\x06teamId\"9\n" +
+	"\x12ListBoardsResponse\x12#\n" +
+	"\x06boards\x18\x01 \x03(\v2\v.task.BoardR\x06boards\"p\n" +
+	"\x12UpdateBoardRequest\x12\x19\n" +
+	"\bboard_id\x18\x01 \x01(\tR\aboardId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12+\n" +
+	"\acolumns\x18\x03 \x03(\v2\x11.task.BoardColumnR\acolumns\"R\n" +
+	"\x13UpdateBoardResponse\x12!\n" +
+	"\x05board\x18\x01 \x01(\v2\v.task.BoardR\x05board\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"/\n" +
+	"\x12DeleteBoardRequest\x12\x19\n" +
+	"\bboard_id\x18\x01 \x01(\tR\aboardId\"/\n" +
+	"\x13DeleteBoardResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\xed\x02\n" +
+	"\fTaskReminder\x12\x1f\n" +
+	"\vreminder_id\x18\x01 \x01(\tR\n" +
+	"reminderId\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x127\n" +
+	"\tremind_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bremindAt\x12,\n" +
+	"\x12before_due_minutes\x18\x05 \x01(\x05R\x10beforeDueMinutes\x123\n" +
+	"\afire_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x06fireAt\x123\n" +
+	"\asent_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x06sentAt\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x9b\x01\n" +
+	"\x19CreateTaskReminderRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x127\n" +
+	"\tremind_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bremindAt\x12,\n" +
+	"\x12before_due_minutes\x18\x03 \x01(\x05R\x10beforeDueMinutes\"f\n" +
+	"\x1aCreateTaskReminderResponse\x12.\n" +
+	"\breminder\x18\x01 \x01(\v2\x12.task.TaskReminderR\breminder\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"3\n" +
+	"\x18ListTaskRemindersRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\"M\n" +
+	"\x19ListTaskRemindersResponse\x120\n" +
+	"\treminders\x18\x01 \x03(\v2\x12.task.TaskReminderR\treminders\"U\n" +
+	"\x19DeleteTaskReminderRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x1f\n" +
+	"\vreminder_id\x18\x02 \x01(\tR\n" +
+	"reminderId\"6\n" +
+	"\x1aDeleteTaskReminderResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\x96\x01\n" +
+	"\x0eMemberWorkload\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04open\x18\x02 \x01(\x05R\x04open\x12\x1f\n" +
+	"\vin_progress\x18\x03 \x01(\x05R\n" +
+	"inProgress\x12\x18\n" +
+	"\aoverdue\x18\x04 \x01(\x05R\aoverdue\x12\x1c\n" +
+	"\tcompleted\x18\x05 \x01(\x05R\tcompleted\"\x96\x01\n" +
+	"\x13GetTeamTasksRequest\x12\x17\n" +
+	"\ateam_id\x18\x01 \x01(\tR\x06teamId\x125\n" +
+	"\rstatus_filter\x18\x02 \x01(\x0e2\x10.task.TaskStatusR\fstatusFilter\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xe9\x02\n" +
+	"\x14GetTeamTasksResponse\x12 \n" +
+	"\x05tasks\x18\x01 \x03(\v2\n" +
+	".task.TaskR\x05tasks\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12E\n" +
+	"\tby_status\x18\x05 \x03(\v2(.task.GetTeamTasksResponse.ByStatusEntryR\bbyStatus\x120\n" +
+	"\bworkload\x18\x06 \x03(\v2\x14.task.MemberWorkloadR\bworkload\x12'\n" +
+	"\x0funassigned_open\x18\a \x01(\x05R\x0eunassignedOpen\x1a;\n" +
+	"\rByStatusEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\x99\x01\n" +
+	"\x14GetGroupTasksRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x125\n" +
+	"\rstatus_filter\x18\x02 \x01(\x0e2\x10.task.TaskStatusR\fstatusFilter\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xeb\x02\n" +
+	"\x15GetGroupTasksResponse\x12 \n" +
+	"\x05tasks\x18\x01 \x03(\v2\n" +
+	".task.TaskR\x05tasks\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12F\n" +
+	"\tby_status\x18\x05 \x03(\v2).task.GetGroupTasksResponse.ByStatusEntryR\bbyStatus\x120\n" +
+	"\bworkload\x18\x06 \x03(\v2\x14.task.MemberWorkloadR\bworkload\x12'\n" +
+	"\x0funassigned_open\x18\a \x01(\x05R\x0eunassignedOpen\x1a;\n" +
+	"\rByStatusEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xec\x01\n" +
+	"\vTaskComment\x12\x1d\n" +
+	"\n" +
+	"comment_id\x18\x01 \x01(\tR\tcommentId\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"G\n" +
+	"\x18CreateTaskCommentRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x12\n" +
+	"\x04body\x18\x02 \x01(\tR\x04body\"b\n" +
+	"\x19CreateTaskCommentResponse\x12+\n" +
+	"\acomment\x18\x01 \x01(\v2\x11.task.TaskCommentR\acomment\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"c\n" +
+	"\x17ListTaskCommentsRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"j\n" +
+	"\x18ListTaskCommentsResponse\x12-\n" +
+	"\bcomments\x18\x01 \x03(\v2\x11.task.TaskCommentR\bcomments\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\"\xc9\x01\n" +
+	"\x18GetPriorityMatrixRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\ateam_id\x18\x02 \x01(\tR\x06teamId\x12.\n" +
+	"\x13urgent_within_hours\x18\x03 \x01(\x05R\x11urgentWithinHours\x12\x1a\n" +
+	"\bquadrant\x18\x04 \x01(\tR\bquadrant\x12\x12\n" +
+	"\x04page\x18\x05 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\"\xce\x01\n" +
+	"\x0eMatrixQuadrant\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06urgent\x18\x02 \x01(\bR\x06urgent\x12\x1c\n" +
+	"\timportant\x18\x03 \x01(\bR\timportant\x12 \n" +
+	"\x05tasks\x18\x04 \x03(\v2\n" +
+	".task.TaskR\x05tasks\x12\x1f\n" +
+	"\vtotal_count\x18\x05 \x01(\x05R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x06 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\a \x01(\x05R\bpageSize\"\x90\x01\n" +
+	"\x19GetPriorityMatrixResponse\x122\n" +
+	"\tquadrants\x18\x01 \x03(\v2\x14.task.MatrixQuadrantR\tquadrants\x12?\n" +
+	"\rurgent_before\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\furgentBefore\"0\n" +
+	"\x15ExportUserDataRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\",\n" +
+	"\x16ExportUserDataResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"C\n" +
+	"\x14EraseUserDataRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04mode\x18\x02 \x01(\tR\x04mode\"\x9b\x01\n" +
+	"\x15EraseUserDataResponse\x12E\n" +
+	"\baffected\x18\x01 \x03(\v2).task.EraseUserDataResponse.AffectedEntryR\baffected\x1a;\n" +
+	"\rAffectedEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\xa7\x01\n" +
+	"\x0eTaskDependency\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\"\n" +
+	"\rdepends_on_id\x18\x02 \x01(\tR\vdependsOnId\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x03 \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"W\n" +
+	"\x18AddTaskDependencyRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\"\n" +
+	"\rdepends_on_id\x18\x02 \x01(\tR\vdependsOnId\"k\n" +
+	"\x19AddTaskDependencyResponse\x124\n" +
+	"\n" +
+	"dependency\x18\x01 \x01(\v2\x14.task.TaskDependencyR\n" +
+	"dependency\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"Z\n" +
+	"\x1bRemoveTaskDependencyRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\"\n" +
+	"\rdepends_on_id\x18\x02 \x01(\tR\vdependsOnId\"8\n" +
+	"\x1cRemoveTaskDependencyResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"6\n" +
+	"\x1bListTaskDependenciesRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\"\x85\x01\n" +
+	"\x1cListTaskDependenciesResponse\x123\n" +
+	"\n" +
+	"blocked_by\x18\x01 \x03(\v2\x14.task.TaskDependencyR\tblockedBy\x120\n" +
+	"\bblocking\x18\x02 \x03(\v2\x14.task.TaskDependencyR\bblocking*\xad\x01\n" +
+	"\n" +
+	"TaskStatus\x12\x1b\n" +
+	"\x17TASK_STATUS_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10TASK_STATUS_TODO\x10\x01\x12\x1b\n" +
+	"\x17TASK_STATUS_IN_PROGRESS\x10\x02\x12\x19\n" +
+	"\x15TASK_STATUS_IN_REVIEW\x10\x03\x12\x19\n" +
+	"\x15TASK_STATUS_COMPLETED\x10\x04\x12\x19\n" +
+	"\x15TASK_STATUS_CANCELLED\x10\x05*\x92\x01\n" +
+	"\fTaskPriority\x12\x1d\n" +
+	"\x19TASK_PRIORITY_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11TASK_PRIORITY_LOW\x10\x01\x12\x18\n" +
+	"\x14TASK_PRIORITY_MEDIUM\x10\x02\x12\x16\n" +
+	"\x12TASK_PRIORITY_HIGH\x10\x03\x12\x1a\n" +
+	"\x16TASK_PRIORITY_CRITICAL\x10\x042\x8f \n" +
+	"\vTaskService\x12Y\n" +
+	"\n" +
+	"CreateTask\x12\x17.task.CreateTaskRequest\x1a\x18.task.CreateTaskResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/api/v1/tasks\x12W\n" +
+	"\aGetTask\x12\x14.task.GetTaskRequest\x1a\x15.task.GetTaskResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/tasks/{task_id}\x12c\n" +
+	"\n" +
+	"UpdateTask\x12\x17.task.UpdateTaskRequest\x1a\x18.task.UpdateTaskResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\x1a\x17/api/v1/tasks/{task_id}\x12`\n" +
+	"\n" +
+	"DeleteTask\x12\x17.task.DeleteTaskRequest\x1a\x18.task.DeleteTaskResponse\"\x1f\x82\xd3\xe4\x93\x02\x19*\x17/api/v1/tasks/{task_id}\x12S\n" +
+	"\tListTasks\x12\x16.task.ListTasksRequest\x1a\x17.task.ListTasksResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/v1/tasks\x12j\n" +
+	"\n" +
+	"AssignTask\x12\x17.task.AssignTaskRequest\x1a\x18.task.AssignTaskResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/tasks/{task_id}/assign\x12|\n" +
+	"\x10UpdateTaskStatus\x12\x1d.task.UpdateTaskStatusRequest\x1a\x1e.task.UpdateTaskStatusResponse\")\x82\xd3\xe4\x93\x02#:\x01*2\x1e/api/v1/tasks/{task_id}/status\x12l\n" +
+	"\fGetUserTasks\x12\x19.task.GetUserTasksRequest\x1a\x1a.task.GetUserTasksResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/users/{user_id}/tasks\x12z\n" +
+	"\x12GetCalendarFeedURL\x12\x1f.task.GetCalendarFeedURLRequest\x1a .task.GetCalendarFeedURLResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/calendar/feed-url\x12\x8d\x01\n" +
+	"\x15RotateCalendarFeedURL\x12\".task.RotateCalendarFeedURLRequest\x1a#.task.RotateCalendarFeedURLResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/calendar/feed-url/rotate\x12r\n" +
+	"\x0fGetCalendarFeed\x12\x1c.task.GetCalendarFeedRequest\x1a\x14.google.api.HttpBody\"+\x82\xd3\xe4\x93\x02%\x12#/api/v1/calendar/{user_id}/feed.ics\x12e\n" +
+	"\rCreateWebhook\x12\x1a.task.CreateWebhookRequest\x1a\x1b.task.CreateWebhookResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/api/v1/webhooks\x12_\n" +
+	"\fListWebhooks\x12\x19.task.ListWebhooksRequest\x1a\x1a.task.ListWebhooksResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/api/v1/webhooks\x12o\n" +
+	"\rDeleteWebhook\x12\x1a.task.DeleteWebhookRequest\x1a\x1b.task.DeleteWebhookResponse\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/api/v1/webhooks/{webhook_id}\x12\\\n" +
+	"\vExportTasks\x12\x18.task.ExportTasksRequest\x1a\x14.google.api.HttpBody\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/exports/tasks\x12f\n" +
+	"\tWatchTask\x12\x16.task.WatchTaskRequest\x1a\x17.task.WatchTaskResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/tasks/{task_id}/watch\x12i\n" +
+	"\vUnwatchTask\x12\x18.task.UnwatchTaskRequest\x1a\x19.task.UnwatchTaskResponse\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/api/v1/tasks/{task_id}/watch\x12U\n" +
+	"\tGetMyWork\x12\x16.task.GetMyWorkRequest\x1a\x17.task.GetMyWorkResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/api/v1/me/work\x12f\n" +
+	"\fGetTaskStats\x12\x19.task.GetTaskStatsRequest\x1a\x1a.task.GetTaskStatsResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/analytics/tasks\x12]\n" +
+	"\vCreateBoard\x12\x18.task.CreateBoardRequest\x1a\x19.task.CreateBoardResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/api/v1/boards\x12\\\n" +
+	"\bGetBoard\x12\x15.task.GetBoardRequest\x1a\x16.task.GetBoardResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/boards/{board_id}\x12W\n" +
+	"\n" +
+	"ListBoards\x12\x17.task.ListBoardsRequest\x1a\x18.task.ListBoardsResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/api/v1/boards\x12h\n" +
+	"\vUpdateBoard\x12\x18.task.UpdateBoardRequest\x1a\x19.task.UpdateBoardResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\x1a\x19/api/v1/boards/{board_id}\x12e\n" +
+	"\vDeleteBoard\x12\x18.task.DeleteBoardRequest\x1a\x19.task.DeleteBoardResponse\"!\x82\xd3\xe4\x93\x02\x1b*\x19/api/v1/boards/{board_id}\x12\x85\x01\n" +
+	"\x12CreateTaskReminder\x12\x1f.task.CreateTaskReminderRequest\x1a .task.CreateTaskReminderResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/tasks/{task_id}/reminders\x12\x7f\n" +
+	"\x11ListTaskReminders\x12\x1e.task.ListTaskRemindersRequest\x1a\x1f.task.ListTaskRemindersResponse\")\x82\xd3\xe4\x93\x02#\x12!/api/v1/tasks/{task_id}/reminders\x12\x90\x01\n" +
+	"\x12DeleteTaskReminder\x12\x1f.task.DeleteTaskReminderRequest\x1a .task.DeleteTaskReminderResponse\"7\x82\xd3\xe4\x93\x021*//api/v1/tasks/{task_id}/reminders/{reminder_id}\x12l\n" +
+	"\fGetTeamTasks\x12\x19.task.GetTeamTasksRequest\x1a\x1a.task.GetTeamTasksResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/teams/{team_id}/tasks\x12q\n" +
+	"\rGetGroupTasks\x12\x1a.task.GetGroupTasksRequest\x1a\x1b.task.GetGroupTasksResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/groups/{group_id}/tasks\x12\x81\x01\n" +
+	"\x11CreateTaskComment\x12\x1e.task.CreateTaskCommentRequest\x1a\x1f.task.CreateTaskCommentResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/tasks/{task_id}/comments\x12{\n" +
+	"\x10ListTaskComments\x12\x1d.task.ListTaskCommentsRequest\x1a\x1e.task.ListTaskCommentsResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /api/v1/tasks/{task_id}/comments\x12\x85\x01\n" +
+	"\x11AddTaskDependency\x12\x1e.task.AddTaskDependencyRequest\x1a\x1f.task.AddTaskDependencyResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/tasks/{task_id}/dependencies\x12\x9b\x01\n" +
+	"\x14RemoveTaskDependency\x12!.task.RemoveTaskDependencyRequest\x1a\".task.RemoveTaskDependencyResponse\"<\x82\xd3\xe4\x93\x026*4/api/v1/tasks/{task_id}/dependencies/{depends_on_id}\x12\x8b\x01\n" +
+	"\x14ListTaskDependencies\x12!.task.ListTaskDependenciesRequest\x1a\".task.ListTaskDependenciesResponse\",\x82\xd3\xe4\x93\x02&\x12$/api/v1/tasks/{task_id}/dependencies\x12\x7f\n" +
+	"\x11GetPriorityMatrix\x12\x1e.task.GetPriorityMatrixRequest\x1a\x1f.task.GetPriorityMatrixResponse\")\x82\xd3\xe4\x93\x02#\x12!/api/v1/analytics/priority-matrix\x12K\n" +
+	"\x0eExportUserData\x12\x1b.task.ExportUserDataRequest\x1a\x1c.task.ExportUserDataResponse\x12H\n" +
+	"\rEraseUserData\x12\x1a.task.EraseUserDataRequest\x1a\x1b.task.EraseUserDataResponseBBZ@github.com/chanduchitikam/task-management-system/proto/task;taskb\x06proto3"
 
 var (
 	file_task_proto_rawDescOnce sync.Once
@@ -1415,73 +5997,262 @@ func file_task_proto_rawDescGZIP() []byte {
 }
 
 var file_task_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_task_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_task_proto_msgTypes = make([]protoimpl.MessageInfo, 90)
 var file_task_proto_goTypes = []any{
-	(TaskStatus)(0),                  // 0: task.TaskStatus
-	(TaskPriority)(0),                // 1: task.TaskPriority
-	(*Task)(nil),                     // 2: task.Task
-	(*CreateTaskRequest)(nil),        // 3: task.CreateTaskRequest
-	(*CreateTaskResponse)(nil),       // 4: task.CreateTaskResponse
-	(*GetTaskRequest)(nil),           // 5: task.GetTaskRequest
-	(*GetTaskResponse)(nil),          // 6: task.GetTaskResponse
-	(*UpdateTaskRequest)(nil),        // 7: task.UpdateTaskRequest
-	(*UpdateTaskResponse)(nil),       // 8: task.UpdateTaskResponse
-	(*DeleteTaskRequest)(nil),        // 9: task.DeleteTaskRequest
-	(*DeleteTaskResponse)(nil),       // 10: task.DeleteTaskResponse
-	(*ListTasksRequest)(nil),         // 11: task.ListTasksRequest
-	(*ListTasksResponse)(nil),        // 12: task.ListTasksResponse
-	(*AssignTaskRequest)(nil),        // 13: task.AssignTaskRequest
-	(*AssignTaskResponse)(nil),       // 14: task.AssignTaskResponse
-	(*UpdateTaskStatusRequest)(nil),  // 15: task.UpdateTaskStatusRequest
-	(*UpdateTaskStatusResponse)(nil), // 16: task.UpdateTaskStatusResponse
-	(*GetUserTasksRequest)(nil),      // 17: task.GetUserTasksRequest
-	(*GetUserTasksResponse)(nil),     // 18: task.GetUserTasksResponse
-	(*timestamppb.Timestamp)(nil),    // 19: google.protobuf.Timestamp
+	(TaskStatus)(0),                       // 0: task.TaskStatus
+	(TaskPriority)(0),                     // 1: task.TaskPriority
+	(*Task)(nil),                          // 2: task.Task
+	(*CreateTaskRequest)(nil),             // 3: task.CreateTaskRequest
+	(*CreateTaskResponse)(nil),            // 4: task.CreateTaskResponse
+	(*GetTaskRequest)(nil),                // 5: task.GetTaskRequest
+	(*GetTaskResponse)(nil),               // 6: task.GetTaskResponse
+	(*UpdateTaskRequest)(nil),             // 7: task.UpdateTaskRequest
+	(*UpdateTaskResponse)(nil),            // 8: task.UpdateTaskResponse
+	(*DeleteTaskRequest)(nil),             // 9: task.DeleteTaskRequest
+	(*DeleteTaskResponse)(nil),            // 10: task.DeleteTaskResponse
+	(*ListTasksRequest)(nil),              // 11: task.ListTasksRequest
+	(*ListTasksResponse)(nil),             // 12: task.ListTasksResponse
+	(*AssignTaskRequest)(nil),             // 13: task.AssignTaskRequest
+	(*AssignTaskResponse)(nil),            // 14: task.AssignTaskResponse
+	(*UpdateTaskStatusRequest)(nil),       // 15: task.UpdateTaskStatusRequest
+	(*UpdateTaskStatusResponse)(nil),      // 16: task.UpdateTaskStatusResponse
+	(*GetUserTasksRequest)(nil),           // 17: task.GetUserTasksRequest
+	(*GetUserTasksResponse)(nil),          // 18: task.GetUserTasksResponse
+	(*GetCalendarFeedURLRequest)(nil),     // 19: task.GetCalendarFeedURLRequest
+	(*GetCalendarFeedURLResponse)(nil),    // 20: task.GetCalendarFeedURLResponse
+	(*RotateCalendarFeedURLRequest)(nil),  // 21: task.RotateCalendarFeedURLRequest
+	(*RotateCalendarFeedURLResponse)(nil), // 22: task.RotateCalendarFeedURLResponse
+	(*GetCalendarFeedRequest)(nil),        // 23: task.GetCalendarFeedRequest
+	(*Webhook)(nil),                       // 24: task.Webhook
+	(*CreateWebhookRequest)(nil),          // 25: task.CreateWebhookRequest
+	(*CreateWebhookResponse)(nil),         // 26: task.CreateWebhookResponse
+	(*ListWebhooksRequest)(nil),           // 27: task.ListWebhooksRequest
+	(*ListWebhooksResponse)(nil),          // 28: task.ListWebhooksResponse
+	(*DeleteWebhookRequest)(nil),          // 29: task.DeleteWebhookRequest
+	(*DeleteWebhookResponse)(nil),         // 30: task.DeleteWebhookResponse
+	(*ExportTasksRequest)(nil),            // 31: task.ExportTasksRequest
+	(*WatchTaskRequest)(nil),              // 32: task.WatchTaskRequest
+	(*WatchTaskResponse)(nil),             // 33: task.WatchTaskResponse
+	(*UnwatchTaskRequest)(nil),            // 34: task.UnwatchTaskRequest
+	(*UnwatchTaskResponse)(nil),           // 35: task.UnwatchTaskResponse
+	(*GetMyWorkRequest)(nil),              // 36: task.GetMyWorkRequest
+	(*WorkSection)(nil),                   // 37: task.WorkSection
+	(*GetMyWorkResponse)(nil),             // 38: task.GetMyWorkResponse
+	(*GetTaskStatsRequest)(nil),           // 39: task.GetTaskStatsRequest
+	(*TaskTrendPoint)(nil),                // 40: task.TaskTrendPoint
+	(*AssigneeLoad)(nil),                  // 41: task.AssigneeLoad
+	(*GetTaskStatsResponse)(nil),          // 42: task.GetTaskStatsResponse
+	(*BoardColumn)(nil),                   // 43: task.BoardColumn
+	(*Board)(nil),                         // 44: task.Board
+	(*CreateBoardRequest)(nil),            // 45: task.CreateBoardRequest
+	(*CreateBoardResponse)(nil),           // 46: task.CreateBoardResponse
+	(*GetBoardRequest)(nil),               // 47: task.GetBoardRequest
+	(*GetBoardResponse)(nil),              // 48: task.GetBoardResponse
+	(*ListBoardsRequest)(nil),             // 49: task.ListBoardsRequest
+	(*ListBoardsResponse)(nil),            // 50: task.ListBoardsResponse
+	(*UpdateBoardRequest)(nil),            // 51: task.UpdateBoardRequest
+	(*UpdateBoardResponse)(nil),           // 52: task.UpdateBoardResponse
+	(*DeleteBoardRequest)(nil),            // 53: task.DeleteBoardRequest
+	(*DeleteBoardResponse)(nil),           // 54: task.DeleteBoardResponse
+	(*TaskReminder)(nil),                  // 55: task.TaskReminder
+	(*CreateTaskReminderRequest)(nil),     // 56: task.CreateTaskReminderRequest
+	(*CreateTaskReminderResponse)(nil),    // 57: task.CreateTaskReminderResponse
+	(*ListTaskRemindersRequest)(nil),      // 58: task.ListTaskRemindersRequest
+	(*ListTaskRemindersResponse)(nil),     // 59: task.ListTaskRemindersResponse
+	(*DeleteTaskReminderRequest)(nil),     // 60: task.DeleteTaskReminderRequest
+	(*DeleteTaskReminderResponse)(nil),    // 61: task.DeleteTaskReminderResponse
+	(*MemberWorkload)(nil),                // 62: task.MemberWorkload
+	(*GetTeamTasksRequest)(nil),           // 63: task.GetTeamTasksRequest
+	(*GetTeamTasksResponse)(nil),          // 64: task.GetTeamTasksResponse
+	(*GetGroupTasksRequest)(nil),          // 65: task.GetGroupTasksRequest
+	(*GetGroupTasksResponse)(nil),         // 66: task.GetGroupTasksResponse
+	(*TaskComment)(nil),                   // 67: task.TaskComment
+	(*CreateTaskCommentRequest)(nil),      // 68: task.CreateTaskCommentRequest
+	(*CreateTaskCommentResponse)(nil),     // 69: task.CreateTaskCommentResponse
+	(*ListTaskCommentsRequest)(nil),       // 70: task.ListTaskCommentsRequest
+	(*ListTaskCommentsResponse)(nil),      // 71: task.ListTaskCommentsResponse
+	(*GetPriorityMatrixRequest)(nil),      // 72: task.GetPriorityMatrixRequest
+	(*MatrixQuadrant)(nil),                // 73: task.MatrixQuadrant
+	(*GetPriorityMatrixResponse)(nil),     // 74: task.GetPriorityMatrixResponse
+	(*ExportUserDataRequest)(nil),         // 75: task.ExportUserDataRequest
+	(*ExportUserDataResponse)(nil),        // 76: task.ExportUserDataResponse
+	(*EraseUserDataRequest)(nil),          // 77: task.EraseUserDataRequest
+	(*EraseUserDataResponse)(nil),         // 78: task.EraseUserDataResponse
+	(*TaskDependency)(nil),                // 79: task.TaskDependency
+	(*AddTaskDependencyRequest)(nil),      // 80: task.AddTaskDependencyRequest
+	(*AddTaskDependencyResponse)(nil),     // 81: task.AddTaskDependencyResponse
+	(*RemoveTaskDependencyRequest)(nil),   // 82: task.RemoveTaskDependencyRequest
+	(*RemoveTaskDependencyResponse)(nil),  // 83: task.RemoveTaskDependencyResponse
+	(*ListTaskDependenciesRequest)(nil),   // 84: task.ListTaskDependenciesRequest
+	(*ListTaskDependenciesResponse)(nil),  // 85: task.ListTaskDependenciesResponse
+	nil,                                   // 86: task.GetMyWorkResponse.AssignedByStatusEntry
+	nil,                                   // 87: task.GetTaskStatsResponse.ByStatusEntry
+	nil,                                   // 88: task.GetTaskStatsResponse.ByPriorityEntry
+	nil,                                   // 89: task.GetTeamTasksResponse.ByStatusEntry
+	nil,                                   // 90: task.GetGroupTasksResponse.ByStatusEntry
+	nil,                                   // 91: task.EraseUserDataResponse.AffectedEntry
+	(*timestamppb.Timestamp)(nil),         // 92: google.protobuf.Timestamp
+	(*httpbody.HttpBody)(nil),             // 93: google.api.HttpBody
 }
 var file_task_proto_depIdxs = []int32{
-	0,  // 0: task.Task.status:type_name -> task.TaskStatus
-	1,  // 1: task.Task.priority:type_name -> task.TaskPriority
-	19, // 2: task.Task.due_date:type_name -> google.protobuf.Timestamp
-	19, // 3: task.Task.created_at:type_name -> google.protobuf.Timestamp
-	19, // 4: task.Task.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 5: task.CreateTaskRequest.status:type_name -> task.TaskStatus
-	1,  // 6: task.CreateTaskRequest.priority:type_name -> task.TaskPriority
-	19, // 7: task.CreateTaskRequest.due_date:type_name -> google.protobuf.Timestamp
-	2,  // 8: task.CreateTaskResponse.task:type_name -> task.Task
-	2,  // 9: task.GetTaskResponse.task:type_name -> task.Task
-	0,  // 10: task.UpdateTaskRequest.status:type_name -> task.TaskStatus
-	1,  // 11: task.UpdateTaskRequest.priority:type_name -> task.TaskPriority
-	19, // 12: task.UpdateTaskRequest.due_date:type_name -> google.protobuf.Timestamp
-	2,  // 13: task.UpdateTaskResponse.task:type_name -> task.Task
-	0,  // 14: task.ListTasksRequest.status_filter:type_name -> task.TaskStatus
-	1,  // 15: task.ListTasksRequest.priority_filter:type_name -> task.TaskPriority
-	2,  // 16: task.ListTasksResponse.tasks:type_name -> task.Task
-	2,  // 17: task.AssignTaskResponse.task:type_name -> task.Task
-	0,  // 18: task.UpdateTaskStatusRequest.status:type_name -> task.TaskStatus
-	2,  // 19: task.UpdateTaskStatusResponse.task:type_name -> task.Task
-	0,  // 20: task.GetUserTasksRequest.status_filter:type_name -> task.TaskStatus
-	2,  // 21: task.GetUserTasksResponse.tasks:type_name -> task.Task
-	3,  // 22: task.TaskService.CreateTask:input_type -> task.CreateTaskRequest
-	5,  // 23: task.TaskService.GetTask:input_type -> task.GetTaskRequest
-	7,  // 24: task.TaskService.UpdateTask:input_type -> task.UpdateTaskRequest
-	9,  // 25: task.TaskService.DeleteTask:input_type -> task.DeleteTaskRequest
-	11, // 26: task.TaskService.ListTasks:input_type -> task.ListTasksRequest
-	13, // 27: task.TaskService.AssignTask:input_type -> task.AssignTaskRequest
-	15, // 28: task.TaskService.UpdateTaskStatus:input_type -> task.UpdateTaskStatusRequest
-	17, // 29: task.TaskService.GetUserTasks:input_type -> task.GetUserTasksRequest
-	4,  // 30: task.TaskService.CreateTask:output_type -> task.CreateTaskResponse
-	6,  // 31: task.TaskService.GetTask:output_type -> task.GetTaskResponse
-	8,  // 32: task.TaskService.UpdateTask:output_type -> task.UpdateTaskResponse
-	10, // 33: task.TaskService.DeleteTask:output_type -> task.DeleteTaskResponse
-	12, // 34: task.TaskService.ListTasks:output_type -> task.ListTasksResponse
-	14, // 35: task.TaskService.AssignTask:output_type -> task.AssignTaskResponse
-	16, // 36: task.TaskService.UpdateTaskStatus:output_type -> task.UpdateTaskStatusResponse
-	18, // 37: task.TaskService.GetUserTasks:output_type -> task.GetUserTasksResponse
-	30, // [30:38] is the sub-list for method output_type
-	22, // [22:30] is the sub-list for method input_type
-	22, // [22:22] is the sub-list for extension type_name
-	22, // [22:22] is the sub-list for extension extendee
-	0,  // [0:22] is the sub-list for field type_name
+	0,   // 0: task.Task.status:type_name -> task.TaskStatus
+	1,   // 1: task.Task.priority:type_name -> task.TaskPriority
+	92,  // 2: task.Task.due_date:type_name -> google.protobuf.Timestamp
+	92,  // 3: task.Task.created_at:type_name -> google.protobuf.Timestamp
+	92,  // 4: task.Task.updated_at:type_name -> google.protobuf.Timestamp
+	92,  // 5: task.Task.started_at:type_name -> google.protobuf.Timestamp
+	92,  // 6: task.Task.completed_at:type_name -> google.protobuf.Timestamp
+	0,   // 7: task.CreateTaskRequest.status:type_name -> task.TaskStatus
+	1,   // 8: task.CreateTaskRequest.priority:type_name -> task.TaskPriority
+	92,  // 9: task.CreateTaskRequest.due_date:type_name -> google.protobuf.Timestamp
+	2,   // 10: task.CreateTaskResponse.task:type_name -> task.Task
+	2,   // 11: task.GetTaskResponse.task:type_name -> task.Task
+	0,   // 12: task.UpdateTaskRequest.status:type_name -> task.TaskStatus
+	1,   // 13: task.UpdateTaskRequest.priority:type_name -> task.TaskPriority
+	92,  // 14: task.UpdateTaskRequest.due_date:type_name -> google.protobuf.Timestamp
+	2,   // 15: task.UpdateTaskResponse.task:type_name -> task.Task
+	0,   // 16: task.ListTasksRequest.status_filter:type_name -> task.TaskStatus
+	1,   // 17: task.ListTasksRequest.priority_filter:type_name -> task.TaskPriority
+	2,   // 18: task.ListTasksResponse.tasks:type_name -> task.Task
+	2,   // 19: task.AssignTaskResponse.task:type_name -> task.Task
+	0,   // 20: task.UpdateTaskStatusRequest.status:type_name -> task.TaskStatus
+	2,   // 21: task.UpdateTaskStatusResponse.task:type_name -> task.Task
+	0,   // 22: task.GetUserTasksRequest.status_filter:type_name -> task.TaskStatus
+	2,   // 23: task.GetUserTasksResponse.tasks:type_name -> task.Task
+	92,  // 24: task.Webhook.created_at:type_name -> google.protobuf.Timestamp
+	92,  // 25: task.Webhook.updated_at:type_name -> google.protobuf.Timestamp
+	24,  // 26: task.CreateWebhookResponse.webhook:type_name -> task.Webhook
+	24,  // 27: task.ListWebhooksResponse.webhooks:type_name -> task.Webhook
+	0,   // 28: task.ExportTasksRequest.status_filter:type_name -> task.TaskStatus
+	1,   // 29: task.ExportTasksRequest.priority_filter:type_name -> task.TaskPriority
+	2,   // 30: task.WorkSection.tasks:type_name -> task.Task
+	37,  // 31: task.GetMyWorkResponse.assigned:type_name -> task.WorkSection
+	37,  // 32: task.GetMyWorkResponse.watching:type_name -> task.WorkSection
+	37,  // 33: task.GetMyWorkResponse.overdue:type_name -> task.WorkSection
+	37,  // 34: task.GetMyWorkResponse.due_this_week:type_name -> task.WorkSection
+	86,  // 35: task.GetMyWorkResponse.assigned_by_status:type_name -> task.GetMyWorkResponse.AssignedByStatusEntry
+	92,  // 36: task.GetMyWorkResponse.week_start:type_name -> google.protobuf.Timestamp
+	92,  // 37: task.GetMyWorkResponse.week_end:type_name -> google.protobuf.Timestamp
+	87,  // 38: task.GetTaskStatsResponse.by_status:type_name -> task.GetTaskStatsResponse.ByStatusEntry
+	88,  // 39: task.GetTaskStatsResponse.by_priority:type_name -> task.GetTaskStatsResponse.ByPriorityEntry
+	40,  // 40: task.GetTaskStatsResponse.trend:type_name -> task.TaskTrendPoint
+	41,  // 41: task.GetTaskStatsResponse.assignee_load:type_name -> task.AssigneeLoad
+	0,   // 42: task.BoardColumn.status:type_name -> task.TaskStatus
+	43,  // 43: task.Board.columns:type_name -> task.BoardColumn
+	92,  // 44: task.Board.created_at:type_name -> google.protobuf.Timestamp
+	92,  // 45: task.Board.updated_at:type_name -> google.protobuf.Timestamp
+	43,  // 46: task.CreateBoardRequest.columns:type_name -> task.BoardColumn
+	44,  // 47: task.CreateBoardResponse.board:type_name -> task.Board
+	44,  // 48: task.GetBoardResponse.board:type_name -> task.Board
+	44,  // 49: task.ListBoardsResponse.boards:type_name -> task.Board
+	43,  // 50: task.UpdateBoardRequest.columns:type_name -> task.BoardColumn
+	44,  // 51: task.UpdateBoardResponse.board:type_name -> task.Board
+	92,  // 52: task.TaskReminder.remind_at:type_name -> google.protobuf.Timestamp
+	92,  // 53: task.TaskReminder.fire_at:type_name -> google.protobuf.Timestamp
+	92,  // 54: task.TaskReminder.sent_at:type_name -> google.protobuf.Timestamp
+	92,  // 55: task.TaskReminder.created_at:type_name -> google.protobuf.Timestamp
+	92,  // 56: task.CreateTaskReminderRequest.remind_at:type_name -> google.protobuf.Timestamp
+	55,  // 57: task.CreateTaskReminderResponse.reminder:type_name -> task.TaskReminder
+	55,  // 58: task.ListTaskRemindersResponse.reminders:type_name -> task.TaskReminder
+	0,   // 59: task.GetTeamTasksRequest.status_filter:type_name -> task.TaskStatus
+	2,   // 60: task.GetTeamTasksResponse.tasks:type_name -> task.Task
+	89,  // 61: task.GetTeamTasksResponse.by_status:type_name -> task.GetTeamTasksResponse.ByStatusEntry
+	62,  // 62: task.GetTeamTasksResponse.workload:type_name -> task.MemberWorkload
+	0,   // 63: task.GetGroupTasksRequest.status_filter:type_name -> task.TaskStatus
+	2,   // 64: task.GetGroupTasksResponse.tasks:type_name -> task.Task
+	90,  // 65: task.GetGroupTasksResponse.by_status:type_name -> task.GetGroupTasksResponse.ByStatusEntry
+	62,  // 66: task.GetGroupTasksResponse.workload:type_name -> task.MemberWorkload
+	92,  // 67: task.TaskComment.created_at:type_name -> google.protobuf.Timestamp
+	92,  // 68: task.TaskComment.updated_at:type_name -> google.protobuf.Timestamp
+	67,  // 69: task.CreateTaskCommentResponse.comment:type_name -> task.TaskComment
+	67,  // 70: task.ListTaskCommentsResponse.comments:type_name -> task.TaskComment
+	2,   // 71: task.MatrixQuadrant.tasks:type_name -> task.Task
+	73,  // 72: task.GetPriorityMatrixResponse.quadrants:type_name -> task.MatrixQuadrant
+	92,  // 73: task.GetPriorityMatrixResponse.urgent_before:type_name -> google.protobuf.Timestamp
+	91,  // 74: task.EraseUserDataResponse.affected:type_name -> task.EraseUserDataResponse.AffectedEntry
+	92,  // 75: task.TaskDependency.created_at:type_name -> google.protobuf.Timestamp
+	79,  // 76: task.AddTaskDependencyResponse.dependency:type_name -> task.TaskDependency
+	79,  // 77: task.ListTaskDependenciesResponse.blocked_by:type_name -> task.TaskDependency
+	79,  // 78: task.ListTaskDependenciesResponse.blocking:type_name -> task.TaskDependency
+	3,   // 79: task.TaskService.CreateTask:input_type -> task.CreateTaskRequest
+	5,   // 80: task.TaskService.GetTask:input_type -> task.GetTaskRequest
+	7,   // 81: task.TaskService.UpdateTask:input_type -> task.UpdateTaskRequest
+	9,   // 82: task.TaskService.DeleteTask:input_type -> task.DeleteTaskRequest
+	11,  // 83: task.TaskService.ListTasks:input_type -> task.ListTasksRequest
+	13,  // 84: task.TaskService.AssignTask:input_type -> task.AssignTaskRequest
+	15,  // 85: task.TaskService.UpdateTaskStatus:input_type -> task.UpdateTaskStatusRequest
+	17,  // 86: task.TaskService.GetUserTasks:input_type -> task.GetUserTasksRequest
+	19,  // 87: task.TaskService.GetCalendarFeedURL:input_type -> task.GetCalendarFeedURLRequest
+	21,  // 88: task.TaskService.RotateCalendarFeedURL:input_type -> task.RotateCalendarFeedURLRequest
+	23,  // 89: task.TaskService.GetCalendarFeed:input_type -> task.GetCalendarFeedRequest
+	25,  // 90: task.TaskService.CreateWebhook:input_type -> task.CreateWebhookRequest
+	27,  // 91: task.TaskService.ListWebhooks:input_type -> task.ListWebhooksRequest
+	29,  // 92: task.TaskService.DeleteWebhook:input_type -> task.DeleteWebhookRequest
+	31,  // 93: task.TaskService.ExportTasks:input_type -> task.ExportTasksRequest
+	32,  // 94: task.TaskService.WatchTask:input_type -> task.WatchTaskRequest
+	34,  // 95: task.TaskService.UnwatchTask:input_type -> task.UnwatchTaskRequest
+	36,  // 96: task.TaskService.GetMyWork:input_type -> task.GetMyWorkRequest
+	39,  // 97: task.TaskService.GetTaskStats:input_type -> task.GetTaskStatsRequest
+	45,  // 98: task.TaskService.CreateBoard:input_type -> task.CreateBoardRequest
+	47,  // 99: task.TaskService.GetBoard:input_type -> task.GetBoardRequest
+	49,  // 100: task.TaskService.ListBoards:input_type -> task.ListBoardsRequest
+	51,  // 101: task.TaskService.UpdateBoard:input_type -> task.UpdateBoardRequest
+	53,  // 102: task.TaskService.DeleteBoard:input_type -> task.DeleteBoardRequest
+	56,  // 103: task.TaskService.CreateTaskReminder:input_type -> task.CreateTaskReminderRequest
+	58,  // 104: task.TaskService.ListTaskReminders:input_type -> task.ListTaskRemindersRequest
+	60,  // 105: task.TaskService.DeleteTaskReminder:input_type -> task.DeleteTaskReminderRequest
+	63,  // 106: task.TaskService.GetTeamTasks:input_type -> task.GetTeamTasksRequest
+	65,  // 107: task.TaskService.GetGroupTasks:input_type -> task.GetGroupTasksRequest
+	68,  // 108: task.TaskService.CreateTaskComment:input_type -> task.CreateTaskCommentRequest
+	70,  // 109: task.TaskService.ListTaskComments:input_type -> task.ListTaskCommentsRequest
+	80,  // 110: task.TaskService.AddTaskDependency:input_type -> task.AddTaskDependencyRequest
+	82,  // 111: task.TaskService.RemoveTaskDependency:input_type -> task.RemoveTaskDependencyRequest
+	84,  // 112: task.TaskService.ListTaskDependencies:input_type -> task.ListTaskDependenciesRequest
+	72,  // 113: task.TaskService.GetPriorityMatrix:input_type -> task.GetPriorityMatrixRequest
+	75,  // 114: task.TaskService.ExportUserData:input_type -> task.ExportUserDataRequest
+	77,  // 115: task.TaskService.EraseUserData:input_type -> task.EraseUserDataRequest
+	4,   // 116: task.TaskService.CreateTask:output_type -> task.CreateTaskResponse
+	6,   // 117: task.TaskService.GetTask:output_type -> task.GetTaskResponse
+	8,   // 118: task.TaskService.UpdateTask:output_type -> task.UpdateTaskResponse
+	10,  // 119: task.TaskService.DeleteTask:output_type -> task.DeleteTaskResponse
+	12,  // 120: task.TaskService.ListTasks:output_type -> task.ListTasksResponse
+	14,  // 121: task.TaskService.AssignTask:output_type -> task.AssignTaskResponse
+	16,  // 122: task.TaskService.UpdateTaskStatus:output_type -> task.UpdateTaskStatusResponse
+	18,  // 123: task.TaskService.GetUserTasks:output_type -> task.GetUserTasksResponse
+	20,  // 124: task.TaskService.GetCalendarFeedURL:output_type -> task.GetCalendarFeedURLResponse
+	22,  // 125: task.TaskService.RotateCalendarFeedURL:output_type -> task.RotateCalendarFeedURLResponse
+	93,  // 126: task.TaskService.GetCalendarFeed:output_type -> google.api.HttpBody
+	26,  // 127: task.TaskService.CreateWebhook:output_type -> task.CreateWebhookResponse
+	28,  // 128: task.TaskService.ListWebhooks:output_type -> task.ListWebhooksResponse
+	30,  // 129: task.TaskService.DeleteWebhook:output_type -> task.DeleteWebhookResponse
+	93,  // 130: task.TaskService.ExportTasks:output_type -> google.api.HttpBody
+	33,  // 131: task.TaskService.WatchTask:output_type -> task.WatchTaskResponse
+	35,  // 132: task.TaskService.UnwatchTask:output_type -> task.UnwatchTaskResponse
+	38,  // 133: task.TaskService.GetMyWork:output_type -> task.GetMyWorkResponse
+	42,  // 134: task.TaskService.GetTaskStats:output_type -> task.GetTaskStatsResponse
+	46,  // 135: task.TaskService.CreateBoard:output_type -> task.CreateBoardResponse
+	48,  // 136: task.TaskService.GetBoard:output_type -> task.GetBoardResponse
+	50,  // 137: task.TaskService.ListBoards:output_type -> task.ListBoardsResponse
+	52,  // 138: task.TaskService.UpdateBoard:output_type -> task.UpdateBoardResponse
+	54,  // 139: task.TaskService.DeleteBoard:output_type -> task.DeleteBoardResponse
+	57,  // 140: task.TaskService.CreateTaskReminder:output_type -> task.CreateTaskReminderResponse
+	59,  // 141: task.TaskService.ListTaskReminders:output_type -> task.ListTaskRemindersResponse
+	61,  // 142: task.TaskService.DeleteTaskReminder:output_type -> task.DeleteTaskReminderResponse
+	64,  // 143: task.TaskService.GetTeamTasks:output_type -> task.GetTeamTasksResponse
+	66,  // 144: task.TaskService.GetGroupTasks:output_type -> task.GetGroupTasksResponse
+	69,  // 145: task.TaskService.CreateTaskComment:output_type -> task.CreateTaskCommentResponse
+	71,  // 146: task.TaskService.ListTaskComments:output_type -> task.ListTaskCommentsResponse
+	81,  // 147: task.TaskService.AddTaskDependency:output_type -> task.AddTaskDependencyResponse
+	83,  // 148: task.TaskService.RemoveTaskDependency:output_type -> task.RemoveTaskDependencyResponse
+	85,  // 149: task.TaskService.ListTaskDependencies:output_type -> task.ListTaskDependenciesResponse
+	74,  // 150: task.TaskService.GetPriorityMatrix:output_type -> task.GetPriorityMatrixResponse
+	76,  // 151: task.TaskService.ExportUserData:output_type -> task.ExportUserDataResponse
+	78,  // 152: task.TaskService.EraseUserData:output_type -> task.EraseUserDataResponse
+	116, // [116:153] is the sub-list for method output_type
+	79,  // [79:116] is the sub-list for method input_type
+	79,  // [79:79] is the sub-list for extension type_name
+	79,  // [79:79] is the sub-list for extension extendee
+	0,   // [0:79] is the sub-list for field type_name
 }
 
 func init() { file_task_proto_init() }
@@ -1495,7 +6266,7 @@ func file_task_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_task_proto_rawDesc), len(file_task_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   17,
+			NumMessages:   90,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
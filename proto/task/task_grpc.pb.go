@@ -8,6 +8,7 @@ package task
 
 import (
 	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -19,14 +20,43 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TaskService_CreateTask_FullMethodName       = "/task.TaskService/CreateTask"
-	TaskService_GetTask_FullMethodName          = "/task.TaskService/GetTask"
-	TaskService_UpdateTask_FullMethodName       = "/task.TaskService/UpdateTask"
-	TaskService_DeleteTask_FullMethodName       = "/task.TaskService/DeleteTask"
-	TaskService_ListTasks_FullMethodName        = "/task.TaskService/ListTasks"
-	TaskService_AssignTask_FullMethodName       = "/task.TaskService/AssignTask"
-	TaskService_UpdateTaskStatus_FullMethodName = "/task.TaskService/UpdateTaskStatus"
-	TaskService_GetUserTasks_FullMethodName     = "/task.TaskService/GetUserTasks"
+	TaskService_CreateTask_FullMethodName            = "/task.TaskService/CreateTask"
+	TaskService_GetTask_FullMethodName               = "/task.TaskService/GetTask"
+	TaskService_UpdateTask_FullMethodName            = "/task.TaskService/UpdateTask"
+	TaskService_DeleteTask_FullMethodName            = "/task.TaskService/DeleteTask"
+	TaskService_ListTasks_FullMethodName             = "/task.TaskService/ListTasks"
+	TaskService_AssignTask_FullMethodName            = "/task.TaskService/AssignTask"
+	TaskService_UpdateTaskStatus_FullMethodName      = "/task.TaskService/UpdateTaskStatus"
+	TaskService_GetUserTasks_FullMethodName          = "/task.TaskService/GetUserTasks"
+	TaskService_GetCalendarFeedURL_FullMethodName    = "/task.TaskService/GetCalendarFeedURL"
+	TaskService_RotateCalendarFeedURL_FullMethodName = "/task.TaskService/RotateCalendarFeedURL"
+	TaskService_GetCalendarFeed_FullMethodName       = "/task.TaskService/GetCalendarFeed"
+	TaskService_CreateWebhook_FullMethodName         = "/task.TaskService/CreateWebhook"
+	TaskService_ListWebhooks_FullMethodName          = "/task.TaskService/ListWebhooks"
+	TaskService_DeleteWebhook_FullMethodName         = "/task.TaskService/DeleteWebhook"
+	TaskService_ExportTasks_FullMethodName           = "/task.TaskService/ExportTasks"
+	TaskService_WatchTask_FullMethodName             = "/task.TaskService/WatchTask"
+	TaskService_UnwatchTask_FullMethodName           = "/task.TaskService/UnwatchTask"
+	TaskService_GetMyWork_FullMethodName             = "/task.TaskService/GetMyWork"
+	TaskService_GetTaskStats_FullMethodName          = "/task.TaskService/GetTaskStats"
+	TaskService_CreateBoard_FullMethodName           = "/task.TaskService/CreateBoard"
+	TaskService_GetBoard_FullMethodName              = "/task.TaskService/GetBoard"
+	TaskService_ListBoards_FullMethodName            = "/task.TaskService/ListBoards"
+	TaskService_UpdateBoard_FullMethodName           = "/task.TaskService/UpdateBoard"
+	TaskService_DeleteBoard_FullMethodName           = "/task.TaskService/DeleteBoard"
+	TaskService_CreateTaskReminder_FullMethodName    = "/task.TaskService/CreateTaskReminder"
+	TaskService_ListTaskReminders_FullMethodName     = "/task.TaskService/ListTaskReminders"
+	TaskService_DeleteTaskReminder_FullMethodName    = "/task.TaskService/DeleteTaskReminder"
+	TaskService_GetTeamTasks_FullMethodName          = "/task.TaskService/GetTeamTasks"
+	TaskService_GetGroupTasks_FullMethodName         = "/task.TaskService/GetGroupTasks"
+	TaskService_CreateTaskComment_FullMethodName     = "/task.TaskService/CreateTaskComment"
+	TaskService_ListTaskComments_FullMethodName      = "/task.TaskService/ListTaskComments"
+	TaskService_AddTaskDependency_FullMethodName     = "/task.TaskService/AddTaskDependency"
+	TaskService_RemoveTaskDependency_FullMethodName  = "/task.TaskService/RemoveTaskDependency"
+	TaskService_ListTaskDependencies_FullMethodName  = "/task.TaskService/ListTaskDependencies"
+	TaskService_GetPriorityMatrix_FullMethodName     = "/task.TaskService/GetPriorityMatrix"
+	TaskService_ExportUserData_FullMethodName        = "/task.TaskService/ExportUserData"
+	TaskService_EraseUserData_FullMethodName         = "/task.TaskService/EraseUserData"
 )
 
 // TaskServiceClient is the client API for TaskService service.
@@ -51,6 +81,64 @@ type TaskServiceClient interface {
 	UpdateTaskStatus(ctx context.Context, in *UpdateTaskStatusRequest, opts ...grpc.CallOption) (*UpdateTaskStatusResponse, error)
 	// Get tasks assigned to a user
 	GetUserTasks(ctx context.Context, in *GetUserTasksRequest, opts ...grpc.CallOption) (*GetUserTasksResponse, error)
+	// Get a signed calendar feed URL for the current user
+	GetCalendarFeedURL(ctx context.Context, in *GetCalendarFeedURLRequest, opts ...grpc.CallOption) (*GetCalendarFeedURLResponse, error)
+	// Issue the current user a new calendar feed URL, revoking the previous ones
+	RotateCalendarFeedURL(ctx context.Context, in *RotateCalendarFeedURLRequest, opts ...grpc.CallOption) (*RotateCalendarFeedURLResponse, error)
+	// Render a user's tasks with due dates as an iCalendar feed (authorized by the signed token)
+	GetCalendarFeed(ctx context.Context, in *GetCalendarFeedRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	// Register an outgoing webhook for the caller's org
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error)
+	// List the org's webhooks
+	ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error)
+	// Delete a webhook
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error)
+	// Export a board snapshot or filtered task list as CSV or printable HTML
+	ExportTasks(ctx context.Context, in *ExportTasksRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	// Watch a task to follow its changes
+	WatchTask(ctx context.Context, in *WatchTaskRequest, opts ...grpc.CallOption) (*WatchTaskResponse, error)
+	// Stop watching a task
+	UnwatchTask(ctx context.Context, in *UnwatchTaskRequest, opts ...grpc.CallOption) (*UnwatchTaskResponse, error)
+	// Get the caller's dashboard: assigned, watching, overdue and due this week
+	GetMyWork(ctx context.Context, in *GetMyWorkRequest, opts ...grpc.CallOption) (*GetMyWorkResponse, error)
+	// Get task analytics for the caller's org, optionally narrowed to a team/group/project
+	GetTaskStats(ctx context.Context, in *GetTaskStatsRequest, opts ...grpc.CallOption) (*GetTaskStatsResponse, error)
+	// Create a board for a project or team
+	CreateBoard(ctx context.Context, in *CreateBoardRequest, opts ...grpc.CallOption) (*CreateBoardResponse, error)
+	// Get a board with its columns and current task counts
+	GetBoard(ctx context.Context, in *GetBoardRequest, opts ...grpc.CallOption) (*GetBoardResponse, error)
+	// List the org's boards, optionally for one project or team
+	ListBoards(ctx context.Context, in *ListBoardsRequest, opts ...grpc.CallOption) (*ListBoardsResponse, error)
+	// Rename a board or replace its columns
+	UpdateBoard(ctx context.Context, in *UpdateBoardRequest, opts ...grpc.CallOption) (*UpdateBoardResponse, error)
+	// Delete a board
+	DeleteBoard(ctx context.Context, in *DeleteBoardRequest, opts ...grpc.CallOption) (*DeleteBoardResponse, error)
+	// Set a personal reminder on a task
+	CreateTaskReminder(ctx context.Context, in *CreateTaskReminderRequest, opts ...grpc.CallOption) (*CreateTaskReminderResponse, error)
+	// List the caller's reminders on a task
+	ListTaskReminders(ctx context.Context, in *ListTaskRemindersRequest, opts ...grpc.CallOption) (*ListTaskRemindersResponse, error)
+	// Delete one of the caller's reminders
+	DeleteTaskReminder(ctx context.Context, in *DeleteTaskReminderRequest, opts ...grpc.CallOption) (*DeleteTaskReminderResponse, error)
+	// Get a team's tasks with status breakdown and member workload
+	GetTeamTasks(ctx context.Context, in *GetTeamTasksRequest, opts ...grpc.CallOption) (*GetTeamTasksResponse, error)
+	// Get a group's tasks with status breakdown and member workload
+	GetGroupTasks(ctx context.Context, in *GetGroupTasksRequest, opts ...grpc.CallOption) (*GetGroupTasksResponse, error)
+	// Comment on a task; @username mentions notify the mentioned users
+	CreateTaskComment(ctx context.Context, in *CreateTaskCommentRequest, opts ...grpc.CallOption) (*CreateTaskCommentResponse, error)
+	// List a task's comments, oldest first
+	ListTaskComments(ctx context.Context, in *ListTaskCommentsRequest, opts ...grpc.CallOption) (*ListTaskCommentsResponse, error)
+	// Mark a task as blocked until another task is done
+	AddTaskDependency(ctx context.Context, in *AddTaskDependencyRequest, opts ...grpc.CallOption) (*AddTaskDependencyResponse, error)
+	// Remove a dependency between two tasks
+	RemoveTaskDependency(ctx context.Context, in *RemoveTaskDependencyRequest, opts ...grpc.CallOption) (*RemoveTaskDependencyResponse, error)
+	// List the tasks a task waits on and the tasks waiting on it
+	ListTaskDependencies(ctx context.Context, in *ListTaskDependenciesRequest, opts ...grpc.CallOption) (*ListTaskDependenciesResponse, error)
+	// Get open tasks bucketed into an Eisenhower matrix for a user or team
+	GetPriorityMatrix(ctx context.Context, in *GetPriorityMatrixRequest, opts ...grpc.CallOption) (*GetPriorityMatrixResponse, error)
+	// Export everything stored about a user (internal, used for GDPR export)
+	ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*ExportUserDataResponse, error)
+	// Anonymize or delete a user's data (internal, used for GDPR erasure)
+	EraseUserData(ctx context.Context, in *EraseUserDataRequest, opts ...grpc.CallOption) (*EraseUserDataResponse, error)
 }
 
 type taskServiceClient struct {
@@ -141,6 +229,296 @@ func (c *taskServiceClient) GetUserTasks(ctx context.Context, in *GetUserTasksRe
 	return out, nil
 }
 
+func (c *taskServiceClient) GetCalendarFeedURL(ctx context.Context, in *GetCalendarFeedURLRequest, opts ...grpc.CallOption) (*GetCalendarFeedURLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCalendarFeedURLResponse)
+	err := c.cc.Invoke(ctx, TaskService_GetCalendarFeedURL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) RotateCalendarFeedURL(ctx context.Context, in *RotateCalendarFeedURLRequest, opts ...grpc.CallOption) (*RotateCalendarFeedURLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateCalendarFeedURLResponse)
+	err := c.cc.Invoke(ctx, TaskService_RotateCalendarFeedURL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) GetCalendarFeed(ctx context.Context, in *GetCalendarFeedRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, TaskService_GetCalendarFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateWebhookResponse)
+	err := c.cc.Invoke(ctx, TaskService_CreateWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhooksResponse)
+	err := c.cc.Invoke(ctx, TaskService_ListWebhooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWebhookResponse)
+	err := c.cc.Invoke(ctx, TaskService_DeleteWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ExportTasks(ctx context.Context, in *ExportTasksRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, TaskService_ExportTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) WatchTask(ctx context.Context, in *WatchTaskRequest, opts ...grpc.CallOption) (*WatchTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WatchTaskResponse)
+	err := c.cc.Invoke(ctx, TaskService_WatchTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) UnwatchTask(ctx context.Context, in *UnwatchTaskRequest, opts ...grpc.CallOption) (*UnwatchTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnwatchTaskResponse)
+	err := c.cc.Invoke(ctx, TaskService_UnwatchTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) GetMyWork(ctx context.Context, in *GetMyWorkRequest, opts ...grpc.CallOption) (*GetMyWorkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMyWorkResponse)
+	err := c.cc.Invoke(ctx, TaskService_GetMyWork_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) GetTaskStats(ctx context.Context, in *GetTaskStatsRequest, opts ...grpc.CallOption) (*GetTaskStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTaskStatsResponse)
+	err := c.cc.Invoke(ctx, TaskService_GetTaskStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) CreateBoard(ctx context.Context, in *CreateBoardRequest, opts ...grpc.CallOption) (*CreateBoardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBoardResponse)
+	err := c.cc.Invoke(ctx, TaskService_CreateBoard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) GetBoard(ctx context.Context, in *GetBoardRequest, opts ...grpc.CallOption) (*GetBoardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBoardResponse)
+	err := c.cc.Invoke(ctx, TaskService_GetBoard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListBoards(ctx context.Context, in *ListBoardsRequest, opts ...grpc.CallOption) (*ListBoardsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBoardsResponse)
+	err := c.cc.Invoke(ctx, TaskService_ListBoards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) UpdateBoard(ctx context.Context, in *UpdateBoardRequest, opts ...grpc.CallOption) (*UpdateBoardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateBoardResponse)
+	err := c.cc.Invoke(ctx, TaskService_UpdateBoard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) DeleteBoard(ctx context.Context, in *DeleteBoardRequest, opts ...grpc.CallOption) (*DeleteBoardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteBoardResponse)
+	err := c.cc.Invoke(ctx, TaskService_DeleteBoard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) CreateTaskReminder(ctx context.Context, in *CreateTaskReminderRequest, opts ...grpc.CallOption) (*CreateTaskReminderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTaskReminderResponse)
+	err := c.cc.Invoke(ctx, TaskService_CreateTaskReminder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListTaskReminders(ctx context.Context, in *ListTaskRemindersRequest, opts ...grpc.CallOption) (*ListTaskRemindersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTaskRemindersResponse)
+	err := c.cc.Invoke(ctx, TaskService_ListTaskReminders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) DeleteTaskReminder(ctx context.Context, in *DeleteTaskReminderRequest, opts ...grpc.CallOption) (*DeleteTaskReminderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTaskReminderResponse)
+	err := c.cc.Invoke(ctx, TaskService_DeleteTaskReminder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) GetTeamTasks(ctx context.Context, in *GetTeamTasksRequest, opts ...grpc.CallOption) (*GetTeamTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTeamTasksResponse)
+	err := c.cc.Invoke(ctx, TaskService_GetTeamTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) GetGroupTasks(ctx context.Context, in *GetGroupTasksRequest, opts ...grpc.CallOption) (*GetGroupTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGroupTasksResponse)
+	err := c.cc.Invoke(ctx, TaskService_GetGroupTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) CreateTaskComment(ctx context.Context, in *CreateTaskCommentRequest, opts ...grpc.CallOption) (*CreateTaskCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTaskCommentResponse)
+	err := c.cc.Invoke(ctx, TaskService_CreateTaskComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListTaskComments(ctx context.Context, in *ListTaskCommentsRequest, opts ...grpc.CallOption) (*ListTaskCommentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTaskCommentsResponse)
+	err := c.cc.Invoke(ctx, TaskService_ListTaskComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) AddTaskDependency(ctx context.Context, in *AddTaskDependencyRequest, opts ...grpc.CallOption) (*AddTaskDependencyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddTaskDependencyResponse)
+	err := c.cc.Invoke(ctx, TaskService_AddTaskDependency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) RemoveTaskDependency(ctx context.Context, in *RemoveTaskDependencyRequest, opts ...grpc.CallOption) (*RemoveTaskDependencyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveTaskDependencyResponse)
+	err := c.cc.Invoke(ctx, TaskService_RemoveTaskDependency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListTaskDependencies(ctx context.Context, in *ListTaskDependenciesRequest, opts ...grpc.CallOption) (*ListTaskDependenciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTaskDependenciesResponse)
+	err := c.cc.Invoke(ctx, TaskService_ListTaskDependencies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) GetPriorityMatrix(ctx context.Context, in *GetPriorityMatrixRequest, opts ...grpc.CallOption) (*GetPriorityMatrixResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPriorityMatrixResponse)
+	err := c.cc.Invoke(ctx, TaskService_GetPriorityMatrix_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*ExportUserDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportUserDataResponse)
+	err := c.cc.Invoke(ctx, TaskService_ExportUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) EraseUserData(ctx context.Context, in *EraseUserDataRequest, opts ...grpc.CallOption) (*EraseUserDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EraseUserDataResponse)
+	err := c.cc.Invoke(ctx, TaskService_EraseUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TaskServiceServer is the server API for TaskService service.
 // All implementations must embed UnimplementedTaskServiceServer
 // for forward compatibility.
@@ -163,6 +541,64 @@ type TaskServiceServer interface {
 	UpdateTaskStatus(context.Context, *UpdateTaskStatusRequest) (*UpdateTaskStatusResponse, error)
 	// Get tasks assigned to a user
 	GetUserTasks(context.Context, *GetUserTasksRequest) (*GetUserTasksResponse, error)
+	// Get a signed calendar feed URL for the current user
+	GetCalendarFeedURL(context.Context, *GetCalendarFeedURLRequest) (*GetCalendarFeedURLResponse, error)
+	// Issue the current user a new calendar feed URL, revoking the previous ones
+	RotateCalendarFeedURL(context.Context, *RotateCalendarFeedURLRequest) (*RotateCalendarFeedURLResponse, error)
+	// Render a user's tasks with due dates as an iCalendar feed (authorized by the signed token)
+	GetCalendarFeed(context.Context, *GetCalendarFeedRequest) (*httpbody.HttpBody, error)
+	// Register an outgoing webhook for the caller's org
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error)
+	// List the org's webhooks
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error)
+	// Delete a webhook
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error)
+	// Export a board snapshot or filtered task list as CSV or printable HTML
+	ExportTasks(context.Context, *ExportTasksRequest) (*httpbody.HttpBody, error)
+	// Watch a task to follow its changes
+	WatchTask(context.Context, *WatchTaskRequest) (*WatchTaskResponse, error)
+	// Stop watching a task
+	UnwatchTask(context.Context, *UnwatchTaskRequest) (*UnwatchTaskResponse, error)
+	// Get the caller's dashboard: assigned, watching, overdue and due this week
+	GetMyWork(context.Context, *GetMyWorkRequest) (*GetMyWorkResponse, error)
+	// Get task analytics for the caller's org, optionally narrowed to a team/group/project
+	GetTaskStats(context.Context, *GetTaskStatsRequest) (*GetTaskStatsResponse, error)
+	// Create a board for a project or team
+	CreateBoard(context.Context, *CreateBoardRequest) (*CreateBoardResponse, error)
+	// Get a board with its columns and current task counts
+	GetBoard(context.Context, *GetBoardRequest) (*GetBoardResponse, error)
+	// List the org's boards, optionally for one project or team
+	ListBoards(context.Context, *ListBoardsRequest) (*ListBoardsResponse, error)
+	// Rename a board or replace its columns
+	UpdateBoard(context.Context, *UpdateBoardRequest) (*UpdateBoardResponse, error)
+	// Delete a board
+	DeleteBoard(context.Context, *DeleteBoardRequest) (*DeleteBoardResponse, error)
+	// Set a personal reminder on a task
+	CreateTaskReminder(context.Context, *CreateTaskReminderRequest) (*CreateTaskReminderResponse, error)
+	// List the caller's reminders on a task
+	ListTaskReminders(context.Context, *ListTaskRemindersRequest) (*ListTaskRemindersResponse, error)
+	// Delete one of the caller's reminders
+	DeleteTaskReminder(context.Context, *DeleteTaskReminderRequest) (*DeleteTaskReminderResponse, error)
+	// Get a team's tasks with status breakdown and member workload
+	GetTeamTasks(context.Context, *GetTeamTasksRequest) (*GetTeamTasksResponse, error)
+	// Get a group's tasks with status breakdown and member workload
+	GetGroupTasks(context.Context, *GetGroupTasksRequest) (*GetGroupTasksResponse, error)
+	// Comment on a task; @username mentions notify the mentioned users
+	CreateTaskComment(context.Context, *CreateTaskCommentRequest) (*CreateTaskCommentResponse, error)
+	// List a task's comments, oldest first
+	ListTaskComments(context.Context, *ListTaskCommentsRequest) (*ListTaskCommentsResponse, error)
+	// Mark a task as blocked until another task is done
+	AddTaskDependency(context.Context, *AddTaskDependencyRequest) (*AddTaskDependencyResponse, error)
+	// Remove a dependency between two tasks
+	RemoveTaskDependency(context.Context, *RemoveTaskDependencyRequest) (*RemoveTaskDependencyResponse, error)
+	// List the tasks a task waits on and the tasks waiting on it
+	ListTaskDependencies(context.Context, *ListTaskDependenciesRequest) (*ListTaskDependenciesResponse, error)
+	// Get open tasks bucketed into an Eisenhower matrix for a user or team
+	GetPriorityMatrix(context.Context, *GetPriorityMatrixRequest) (*GetPriorityMatrixResponse, error)
+	// Export everything stored about a user (internal, used for GDPR export)
+	ExportUserData(context.Context, *ExportUserDataRequest) (*ExportUserDataResponse, error)
+	// Anonymize or delete a user's data (internal, used for GDPR erasure)
+	EraseUserData(context.Context, *EraseUserDataRequest) (*EraseUserDataResponse, error)
 	mustEmbedUnimplementedTaskServiceServer()
 }
 
@@ -197,6 +633,93 @@ func (UnimplementedTaskServiceServer) UpdateTaskStatus(context.Context, *UpdateT
 func (UnimplementedTaskServiceServer) GetUserTasks(context.Context, *GetUserTasksRequest) (*GetUserTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserTasks not implemented")
 }
+func (UnimplementedTaskServiceServer) GetCalendarFeedURL(context.Context, *GetCalendarFeedURLRequest) (*GetCalendarFeedURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCalendarFeedURL not implemented")
+}
+func (UnimplementedTaskServiceServer) RotateCalendarFeedURL(context.Context, *RotateCalendarFeedURLRequest) (*RotateCalendarFeedURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateCalendarFeedURL not implemented")
+}
+func (UnimplementedTaskServiceServer) GetCalendarFeed(context.Context, *GetCalendarFeedRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCalendarFeed not implemented")
+}
+func (UnimplementedTaskServiceServer) CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedTaskServiceServer) ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedTaskServiceServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedTaskServiceServer) ExportTasks(context.Context, *ExportTasksRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportTasks not implemented")
+}
+func (UnimplementedTaskServiceServer) WatchTask(context.Context, *WatchTaskRequest) (*WatchTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WatchTask not implemented")
+}
+func (UnimplementedTaskServiceServer) UnwatchTask(context.Context, *UnwatchTaskRequest) (*UnwatchTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnwatchTask not implemented")
+}
+func (UnimplementedTaskServiceServer) GetMyWork(context.Context, *GetMyWorkRequest) (*GetMyWorkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMyWork not implemented")
+}
+func (UnimplementedTaskServiceServer) GetTaskStats(context.Context, *GetTaskStatsRequest) (*GetTaskStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskStats not implemented")
+}
+func (UnimplementedTaskServiceServer) CreateBoard(context.Context, *CreateBoardRequest) (*CreateBoardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBoard not implemented")
+}
+func (UnimplementedTaskServiceServer) GetBoard(context.Context, *GetBoardRequest) (*GetBoardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBoard not implemented")
+}
+func (UnimplementedTaskServiceServer) ListBoards(context.Context, *ListBoardsRequest) (*ListBoardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBoards not implemented")
+}
+func (UnimplementedTaskServiceServer) UpdateBoard(context.Context, *UpdateBoardRequest) (*UpdateBoardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBoard not implemented")
+}
+func (UnimplementedTaskServiceServer) DeleteBoard(context.Context, *DeleteBoardRequest) (*DeleteBoardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBoard not implemented")
+}
+func (UnimplementedTaskServiceServer) CreateTaskReminder(context.Context, *CreateTaskReminderRequest) (*CreateTaskReminderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTaskReminder not implemented")
+}
+func (UnimplementedTaskServiceServer) ListTaskReminders(context.Context, *ListTaskRemindersRequest) (*ListTaskRemindersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTaskReminders not implemented")
+}
+func (UnimplementedTaskServiceServer) DeleteTaskReminder(context.Context, *DeleteTaskReminderRequest) (*DeleteTaskReminderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTaskReminder not implemented")
+}
+func (UnimplementedTaskServiceServer) GetTeamTasks(context.Context, *GetTeamTasksRequest) (*GetTeamTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeamTasks not implemented")
+}
+func (UnimplementedTaskServiceServer) GetGroupTasks(context.Context, *GetGroupTasksRequest) (*GetGroupTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupTasks not implemented")
+}
+func (UnimplementedTaskServiceServer) CreateTaskComment(context.Context, *CreateTaskCommentRequest) (*CreateTaskCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTaskComment not implemented")
+}
+func (UnimplementedTaskServiceServer) ListTaskComments(context.Context, *ListTaskCommentsRequest) (*ListTaskCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTaskComments not implemented")
+}
+func (UnimplementedTaskServiceServer) AddTaskDependency(context.Context, *AddTaskDependencyRequest) (*AddTaskDependencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTaskDependency not implemented")
+}
+func (UnimplementedTaskServiceServer) RemoveTaskDependency(context.Context, *RemoveTaskDependencyRequest) (*RemoveTaskDependencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTaskDependency not implemented")
+}
+func (UnimplementedTaskServiceServer) ListTaskDependencies(context.Context, *ListTaskDependenciesRequest) (*ListTaskDependenciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTaskDependencies not implemented")
+}
+func (UnimplementedTaskServiceServer) GetPriorityMatrix(context.Context, *GetPriorityMatrixRequest) (*GetPriorityMatrixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriorityMatrix not implemented")
+}
+func (UnimplementedTaskServiceServer) ExportUserData(context.Context, *ExportUserDataRequest) (*ExportUserDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUserData not implemented")
+}
+func (UnimplementedTaskServiceServer) EraseUserData(context.Context, *EraseUserDataRequest) (*EraseUserDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EraseUserData not implemented")
+}
 func (UnimplementedTaskServiceServer) mustEmbedUnimplementedTaskServiceServer() {}
 func (UnimplementedTaskServiceServer) testEmbeddedByValue()                     {}
 
@@ -362,30 +885,552 @@ func _TaskService_GetUserTasks_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-// TaskService_ServiceDesc is the grpc.ServiceDesc for TaskService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var TaskService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "task.TaskService",
-	HandlerType: (*TaskServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateTask",
-			Handler:    _TaskService_CreateTask_Handler,
-		},
-		{
-			MethodName: "GetTask",
-			Handler:    _TaskService_GetTask_Handler,
-		},
-		{
-			MethodName: "UpdateTask",
-			Handler:    _TaskService_UpdateTask_Handler,
-		},
-		{
-			MethodName: "DeleteTask",
-			Handler:    _TaskService_DeleteTask_Handler,
-		},
-		{
+func _TaskService_GetCalendarFeedURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCalendarFeedURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetCalendarFeedURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_GetCalendarFeedURL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetCalendarFeedURL(ctx, req.(*GetCalendarFeedURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_RotateCalendarFeedURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateCalendarFeedURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).RotateCalendarFeedURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_RotateCalendarFeedURL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).RotateCalendarFeedURL(ctx, req.(*RotateCalendarFeedURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GetCalendarFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCalendarFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetCalendarFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_GetCalendarFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetCalendarFeed(ctx, req.(*GetCalendarFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_CreateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListWebhooks(ctx, req.(*ListWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_DeleteWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ExportTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ExportTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ExportTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ExportTasks(ctx, req.(*ExportTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_WatchTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WatchTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).WatchTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_WatchTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).WatchTask(ctx, req.(*WatchTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_UnwatchTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnwatchTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).UnwatchTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_UnwatchTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).UnwatchTask(ctx, req.(*UnwatchTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GetMyWork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyWorkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetMyWork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_GetMyWork_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetMyWork(ctx, req.(*GetMyWorkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GetTaskStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetTaskStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_GetTaskStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetTaskStats(ctx, req.(*GetTaskStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_CreateBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBoardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).CreateBoard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_CreateBoard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).CreateBoard(ctx, req.(*CreateBoardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GetBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBoardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetBoard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_GetBoard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetBoard(ctx, req.(*GetBoardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ListBoards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBoardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListBoards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListBoards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListBoards(ctx, req.(*ListBoardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_UpdateBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBoardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).UpdateBoard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_UpdateBoard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).UpdateBoard(ctx, req.(*UpdateBoardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_DeleteBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBoardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).DeleteBoard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_DeleteBoard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).DeleteBoard(ctx, req.(*DeleteBoardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_CreateTaskReminder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTaskReminderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).CreateTaskReminder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_CreateTaskReminder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).CreateTaskReminder(ctx, req.(*CreateTaskReminderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ListTaskReminders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaskRemindersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListTaskReminders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListTaskReminders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListTaskReminders(ctx, req.(*ListTaskRemindersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_DeleteTaskReminder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTaskReminderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).DeleteTaskReminder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_DeleteTaskReminder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).DeleteTaskReminder(ctx, req.(*DeleteTaskReminderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GetTeamTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetTeamTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_GetTeamTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetTeamTasks(ctx, req.(*GetTeamTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GetGroupTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetGroupTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_GetGroupTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetGroupTasks(ctx, req.(*GetGroupTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_CreateTaskComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTaskCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).CreateTaskComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_CreateTaskComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).CreateTaskComment(ctx, req.(*CreateTaskCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ListTaskComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaskCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListTaskComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListTaskComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListTaskComments(ctx, req.(*ListTaskCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_AddTaskDependency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTaskDependencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).AddTaskDependency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_AddTaskDependency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).AddTaskDependency(ctx, req.(*AddTaskDependencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_RemoveTaskDependency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTaskDependencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).RemoveTaskDependency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_RemoveTaskDependency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).RemoveTaskDependency(ctx, req.(*RemoveTaskDependencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ListTaskDependencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaskDependenciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListTaskDependencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListTaskDependencies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListTaskDependencies(ctx, req.(*ListTaskDependenciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GetPriorityMatrix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriorityMatrixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetPriorityMatrix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_GetPriorityMatrix_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetPriorityMatrix(ctx, req.(*GetPriorityMatrixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ExportUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ExportUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ExportUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ExportUserData(ctx, req.(*ExportUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_EraseUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EraseUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).EraseUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_EraseUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).EraseUserData(ctx, req.(*EraseUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TaskService_ServiceDesc is the grpc.ServiceDesc for TaskService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TaskService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "task.TaskService",
+	HandlerType: (*TaskServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTask",
+			Handler:    _TaskService_CreateTask_Handler,
+		},
+		{
+			MethodName: "GetTask",
+			Handler:    _TaskService_GetTask_Handler,
+		},
+		{
+			MethodName: "UpdateTask",
+			Handler:    _TaskService_UpdateTask_Handler,
+		},
+		{
+			MethodName: "DeleteTask",
+			Handler:    _TaskService_DeleteTask_Handler,
+		},
+		{
 			MethodName: "ListTasks",
 			Handler:    _TaskService_ListTasks_Handler,
 		},
@@ -401,6 +1446,122 @@ var TaskService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserTasks",
 			Handler:    _TaskService_GetUserTasks_Handler,
 		},
+		{
+			MethodName: "GetCalendarFeedURL",
+			Handler:    _TaskService_GetCalendarFeedURL_Handler,
+		},
+		{
+			MethodName: "RotateCalendarFeedURL",
+			Handler:    _TaskService_RotateCalendarFeedURL_Handler,
+		},
+		{
+			MethodName: "GetCalendarFeed",
+			Handler:    _TaskService_GetCalendarFeed_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _TaskService_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _TaskService_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _TaskService_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "ExportTasks",
+			Handler:    _TaskService_ExportTasks_Handler,
+		},
+		{
+			MethodName: "WatchTask",
+			Handler:    _TaskService_WatchTask_Handler,
+		},
+		{
+			MethodName: "UnwatchTask",
+			Handler:    _TaskService_UnwatchTask_Handler,
+		},
+		{
+			MethodName: "GetMyWork",
+			Handler:    _TaskService_GetMyWork_Handler,
+		},
+		{
+			MethodName: "GetTaskStats",
+			Handler:    _TaskService_GetTaskStats_Handler,
+		},
+		{
+			MethodName: "CreateBoard",
+			Handler:    _TaskService_CreateBoard_Handler,
+		},
+		{
+			MethodName: "GetBoard",
+			Handler:    _TaskService_GetBoard_Handler,
+		},
+		{
+			MethodName: "ListBoards",
+			Handler:    _TaskService_ListBoards_Handler,
+		},
+		{
+			MethodName: "UpdateBoard",
+			Handler:    _TaskService_UpdateBoard_Handler,
+		},
+		{
+			MethodName: "DeleteBoard",
+			Handler:    _TaskService_DeleteBoard_Handler,
+		},
+		{
+			MethodName: "CreateTaskReminder",
+			Handler:    _TaskService_CreateTaskReminder_Handler,
+		},
+		{
+			MethodName: "ListTaskReminders",
+			Handler:    _TaskService_ListTaskReminders_Handler,
+		},
+		{
+			MethodName: "DeleteTaskReminder",
+			Handler:    _TaskService_DeleteTaskReminder_Handler,
+		},
+		{
+			MethodName: "GetTeamTasks",
+			Handler:    _TaskService_GetTeamTasks_Handler,
+		},
+		{
+			MethodName: "GetGroupTasks",
+			Handler:    _TaskService_GetGroupTasks_Handler,
+		},
+		{
+			MethodName: "CreateTaskComment",
+			Handler:    _TaskService_CreateTaskComment_Handler,
+		},
+		{
+			MethodName: "ListTaskComments",
+			Handler:    _TaskService_ListTaskComments_Handler,
+		},
+		{
+			MethodName: "AddTaskDependency",
+			Handler:    _TaskService_AddTaskDependency_Handler,
+		},
+		{
+			MethodName: "RemoveTaskDependency",
+			Handler:    _TaskService_RemoveTaskDependency_Handler,
+		},
+		{
+			MethodName: "ListTaskDependencies",
+			Handler:    _TaskService_ListTaskDependencies_Handler,
+		},
+		{
+			MethodName: "GetPriorityMatrix",
+			Handler:    _TaskService_GetPriorityMatrix_Handler,
+		},
+		{
+			MethodName: "ExportUserData",
+			Handler:    _TaskService_ExportUserData_Handler,
+		},
+		{
+			MethodName: "EraseUserData",
+			Handler:    _TaskService_EraseUserData_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "task.proto",
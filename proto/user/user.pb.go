@@ -75,11 +75,16 @@ func (UserRole) EnumDescriptor() ([]byte, []int) {
 
 // Invite request (created by org admin)
 type InviteRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
-	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
-	ExpiresHours  int32                  `protobuf:"varint,4,opt,name=expires_hours,json=expiresHours,proto3" json:"expires_hours,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	OrgId        string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Email        string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Role         string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	ExpiresHours int32                  `protobuf:"varint,4,opt,name=expires_hours,json=expiresHours,proto3" json:"expires_hours,omitempty"`
+	// Guest invites (role "guest") name the one project or workspace the
+	// guest may reach and for how many days; defaults to 30
+	ScopeType     string `protobuf:"bytes,5,opt,name=scope_type,json=scopeType,proto3" json:"scope_type,omitempty"`
+	ScopeId       string `protobuf:"bytes,6,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
+	AccessDays    int32  `protobuf:"varint,7,opt,name=access_days,json=accessDays,proto3" json:"access_days,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -142,11 +147,34 @@ func (x *InviteRequest) GetExpiresHours() int32 {
 	return 0
 }
 
+func (x *InviteRequest) GetScopeType() string {
+	if x != nil {
+		return x.ScopeType
+	}
+	return ""
+}
+
+func (x *InviteRequest) GetScopeId() string {
+	if x != nil {
+		return x.ScopeId
+	}
+	return ""
+}
+
+func (x *InviteRequest) GetAccessDays() int32 {
+	if x != nil {
+		return x.AccessDays
+	}
+	return 0
+}
+
 // Invite response
 type InviteResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	InviteId      string                 `protobuf:"bytes,1,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	InviteId string                 `protobuf:"bytes,1,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
+	Message  string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// the invite token, only returned by development deployments
+	Token         string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -195,6 +223,13 @@ func (x *InviteResponse) GetMessage() string {
 	return ""
 }
 
+func (x *InviteResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
 // Accept invite request (used by invitee)
 type AcceptInviteRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -319,15 +354,28 @@ func (x *AcceptInviteResponse) GetMessage() string {
 
 // Invite model returned in list
 type Invite struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	InviteId      string                 `protobuf:"bytes,1,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
-	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	OrgId         string                 `protobuf:"bytes,3,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
-	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
-	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
-	UsedAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=used_at,json=usedAt,proto3" json:"used_at,omitempty"`
-	CreatedBy     string                 `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	InviteId  string                 `protobuf:"bytes,1,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
+	Email     string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	OrgId     string                 `protobuf:"bytes,3,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Role      string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	UsedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=used_at,json=usedAt,proto3" json:"used_at,omitempty"`
+	CreatedBy string                 `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	RevokedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	// pending, accepted, expired or revoked
+	Status    string `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	SendCount int32  `protobuf:"varint,11,opt,name=send_count,json=sendCount,proto3" json:"send_count,omitempty"`
+	// queued, sent or failed; empty when the invite was not emailed
+	DeliveryStatus   string                 `protobuf:"bytes,12,opt,name=delivery_status,json=deliveryStatus,proto3" json:"delivery_status,omitempty"`
+	DeliveryAttempts int32                  `protobuf:"varint,13,opt,name=delivery_attempts,json=deliveryAttempts,proto3" json:"delivery_attempts,omitempty"`
+	DeliveryError    string                 `protobuf:"bytes,14,opt,name=delivery_error,json=deliveryError,proto3" json:"delivery_error,omitempty"`
+	DeliveredAt      *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	// project or workspace, for guest invites
+	ScopeType     string `protobuf:"bytes,16,opt,name=scope_type,json=scopeType,proto3" json:"scope_type,omitempty"`
+	ScopeId       string `protobuf:"bytes,17,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
+	AccessDays    int32  `protobuf:"varint,18,opt,name=access_days,json=accessDays,proto3" json:"access_days,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -418,6 +466,76 @@ func (x *Invite) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Invite) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+func (x *Invite) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Invite) GetSendCount() int32 {
+	if x != nil {
+		return x.SendCount
+	}
+	return 0
+}
+
+func (x *Invite) GetDeliveryStatus() string {
+	if x != nil {
+		return x.DeliveryStatus
+	}
+	return ""
+}
+
+func (x *Invite) GetDeliveryAttempts() int32 {
+	if x != nil {
+		return x.DeliveryAttempts
+	}
+	return 0
+}
+
+func (x *Invite) GetDeliveryError() string {
+	if x != nil {
+		return x.DeliveryError
+	}
+	return ""
+}
+
+func (x *Invite) GetDeliveredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return nil
+}
+
+func (x *Invite) GetScopeType() string {
+	if x != nil {
+		return x.ScopeType
+	}
+	return ""
+}
+
+func (x *Invite) GetScopeId() string {
+	if x != nil {
+		return x.ScopeId
+	}
+	return ""
+}
+
+func (x *Invite) GetAccessDays() int32 {
+	if x != nil {
+		return x.AccessDays
+	}
+	return 0
+}
+
 type ListInvitesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
@@ -831,6 +949,8 @@ type LoginResponse struct {
 	ExpiresIn                int64                  `protobuf:"varint,4,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
 	MustChangePassword       bool                   `protobuf:"varint,5,opt,name=must_change_password,json=mustChangePassword,proto3" json:"must_change_password,omitempty"`                     // User must change temp password
 	MustSetSecurityQuestions bool                   `protobuf:"varint,6,opt,name=must_set_security_questions,json=mustSetSecurityQuestions,proto3" json:"must_set_security_questions,omitempty"` // User must set security questions (one-time)
+	AccountDeletionCancelled bool                   `protobuf:"varint,7,opt,name=account_deletion_cancelled,json=accountDeletionCancelled,proto3" json:"account_deletion_cancelled,omitempty"`   // A scheduled account deletion was cancelled by this login
+	MustRegisterPasskey      bool                   `protobuf:"varint,8,opt,name=must_register_passkey,json=mustRegisterPasskey,proto3" json:"must_register_passkey,omitempty"`                  // The user's organization requires admins to sign in with a passkey
 	unknownFields            protoimpl.UnknownFields
 	sizeCache                protoimpl.SizeCache
 }
@@ -907,6 +1027,20 @@ func (x *LoginResponse) GetMustSetSecurityQuestions() bool {
 	return false
 }
 
+func (x *LoginResponse) GetAccountDeletionCancelled() bool {
+	if x != nil {
+		return x.AccountDeletionCancelled
+	}
+	return false
+}
+
+func (x *LoginResponse) GetMustRegisterPasskey() bool {
+	if x != nil {
+		return x.MustRegisterPasskey
+	}
+	return false
+}
+
 // Get user request
 type GetUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2197,8 +2331,15 @@ type OrganizationMember struct {
 	MustChangePassword   bool                   `protobuf:"varint,9,opt,name=must_change_password,json=mustChangePassword,proto3" json:"must_change_password,omitempty"`
 	FailedLoginAttempts  int32                  `protobuf:"varint,10,opt,name=failed_login_attempts,json=failedLoginAttempts,proto3" json:"failed_login_attempts,omitempty"`
 	HasSecurityQuestions bool                   `protobuf:"varint,11,opt,name=has_security_questions,json=hasSecurityQuestions,proto3" json:"has_security_questions,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	// Set while the account is temporarily locked after failed logins
+	LockedUntil *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=locked_until,json=lockedUntil,proto3" json:"locked_until,omitempty"`
+	// Set while an admin has suspended the account
+	SuspendedAt      *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=suspended_at,json=suspendedAt,proto3" json:"suspended_at,omitempty"`
+	SuspensionReason string                 `protobuf:"bytes,14,opt,name=suspension_reason,json=suspensionReason,proto3" json:"suspension_reason,omitempty"`
+	// Values for the organization's extra profile fields
+	ProfileAttributes map[string]string `protobuf:"bytes,15,rep,name=profile_attributes,json=profileAttributes,proto3" json:"profile_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *OrganizationMember) Reset() {
@@ -2308,6 +2449,34 @@ func (x *OrganizationMember) GetHasSecurityQuestions() bool {
 	return false
 }
 
+func (x *OrganizationMember) GetLockedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LockedUntil
+	}
+	return nil
+}
+
+func (x *OrganizationMember) GetSuspendedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SuspendedAt
+	}
+	return nil
+}
+
+func (x *OrganizationMember) GetSuspensionReason() string {
+	if x != nil {
+		return x.SuspensionReason
+	}
+	return ""
+}
+
+func (x *OrganizationMember) GetProfileAttributes() map[string]string {
+	if x != nil {
+		return x.ProfileAttributes
+	}
+	return nil
+}
+
 // List organization members response
 type ListOrganizationMembersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -3164,148 +3333,6905 @@ func (x *AdminResetPasswordResponse) GetMessage() string {
 	return ""
 }
 
-var File_user_proto protoreflect.FileDescriptor
+// Resolve usernames request
+type ResolveUsernamesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Usernames     []string               `protobuf:"bytes,2,rep,name=usernames,proto3" json:"usernames,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_user_proto_rawDesc = "" +
-	"\n" +
-	"\n" +
-	"user.proto\x12\x04user\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"u\n" +
-	"\rInviteRequest\x12\x15\n" +
-	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x14\n" +
-	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
-	"\x04role\x18\x03 \x01(\tR\x04role\x12#\n" +
-	"\rexpires_hours\x18\x04 \x01(\x05R\fexpiresHours\"G\n" +
-	"\x0eInviteResponse\x12\x1b\n" +
-	"\tinvite_id\x18\x01 \x01(\tR\binviteId\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\x80\x01\n" +
-	"\x13AcceptInviteRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1a\n" +
-	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x03 \x01(\tR\bpassword\x12\x1b\n" +
-	"\tfull_name\x18\x04 \x01(\tR\bfullName\"P\n" +
-	"\x14AcceptInviteResponse\x12\x1e\n" +
-	"\x04user\x18\x01 \x01(\v2\n" +
-	".user.UserR\x04user\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\xb0\x02\n" +
-	"\x06Invite\x12\x1b\n" +
-	"\tinvite_id\x18\x01 \x01(\tR\binviteId\x12\x14\n" +
-	"\x05email\x18\x02 \x01(\tR\x05email\x12\x15\n" +
-	"\x06org_id\x18\x03 \x01(\tR\x05orgId\x12\x12\n" +
-	"\x04role\x18\x04 \x01(\tR\x04role\x129\n" +
-	"\n" +
-	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x123\n" +
-	"\aused_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x06usedAt\x12\x1d\n" +
-	"\n" +
-	"created_by\x18\a \x01(\tR\tcreatedBy\x129\n" +
-	"\n" +
-	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\\\n" +
-	"\x12ListInvitesRequest\x12\x15\n" +
-	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x12\n" +
-	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\x8f\x01\n" +
-	"\x13ListInvitesResponse\x12&\n" +
-	"\ainvites\x18\x01 \x03(\v2\f.user.InviteR\ainvites\x12\x1f\n" +
-	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\x12\x12\n" +
-	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x88\x02\n" +
-	"\x04User\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
-	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1a\n" +
-	"\busername\x18\x03 \x01(\tR\busername\x12\x1b\n" +
-	"\tfull_name\x18\x04 \x01(\tR\bfullName\x12\"\n" +
-	"\x04role\x18\x05 \x01(\x0e2\x0e.user.UserRoleR\x04role\x129\n" +
-	"\n" +
-	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xa0\x01\n" +
-	"\x0fRegisterRequest\x12\x14\n" +
-	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
-	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x03 \x01(\tR\bpassword\x12\x1b\n" +
-	"\tfull_name\x18\x04 \x01(\tR\bfullName\x12\"\n" +
-	"\x04role\x18\x05 \x01(\x0e2\x0e.user.UserRoleR\x04role\"L\n" +
-	"\x10RegisterResponse\x12\x1e\n" +
-	"\x04user\x18\x01 \x01(\v2\n" +
-	".user.UserR\x04user\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"@\n" +
-	"\fLoginRequest\x12\x14\n" +
-	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"\x87\x02\n" +
-	"\rLoginResponse\x12!\n" +
-	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
-	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12\x1e\n" +
-	"\x04user\x18\x03 \x01(\v2\n" +
-	".user.UserR\x04user\x12\x1d\n" +
-	"\n" +
-	"expires_in\x18\x04 \x01(\x03R\texpiresIn\x120\n" +
-	"\x14must_change_password\x18\x05 \x01(\bR\x12mustChangePassword\x12=\n" +
-	"\x1bmust_set_security_questions\x18\x06 \x01(\bR\x18mustSetSecurityQuestions\")\n" +
-	"\x0eGetUserRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"1\n" +
-	"\x0fGetUserResponse\x12\x1e\n" +
-	"\x04user\x18\x01 \x01(\v2\n" +
-	".user.UserR\x04user\"\x9f\x01\n" +
-	"\x11UpdateUserRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
-	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1a\n" +
-	"\busername\x18\x03 \x01(\tR\busername\x12\x1b\n" +
-	"\tfull_name\x18\x04 \x01(\tR\bfullName\x12\"\n" +
-	"\x04role\x18\x05 \x01(\x0e2\x0e.user.UserRoleR\x04role\"N\n" +
-	"\x12UpdateUserResponse\x12\x1e\n" +
-	"\x04user\x18\x01 \x01(\v2\n" +
-	".user.UserR\x04user\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\",\n" +
-	"\x11DeleteUserRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\".\n" +
-	"\x12DeleteUserResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"d\n" +
-	"\x10ListUsersRequest\x12\x12\n" +
-	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1f\n" +
-	"\vrole_filter\x18\x03 \x01(\tR\n" +
-	"roleFilter\"\x87\x01\n" +
-	"\x11ListUsersResponse\x12 \n" +
-	"\x05users\x18\x01 \x03(\v2\n" +
-	".user.UserR\x05users\x12\x1f\n" +
-	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\x12\x12\n" +
-	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\",\n" +
-	"\x14ValidateTokenRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\"\x84\x01\n" +
-	"\x15ValidateTokenResponse\x12\x14\n" +
-	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\"\n" +
-	"\x04role\x18\x03 \x01(\x0e2\x0e.user.UserRoleR\x04role\x12\x18\n" +
-	"\amessage\x18\x04 \x01(\tR\amessage\"\xb2\x01\n" +
-	"\fOrganization\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x129\n" +
-	"\n" +
-	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12!\n" +
-	"\fmember_count\x18\x05 \x01(\x05R\vmemberCount\"\xca\x01\n" +
-	"\x1bRegisterOrganizationRequest\x12\x19\n" +
-	"\borg_name\x18\x01 \x01(\tR\aorgName\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1f\n" +
-	"\vadmin_email\x18\x03 \x01(\tR\n" +
-	"adminEmail\x12%\n" +
-	"\x0eadmin_password\x18\x04 \x01(\tR\radminPassword\x12&\n" +
-	"\x0fadmin_full_name\x18\x05 \x01(\tR\radminFullName\"\xb5\x01\n" +
-	"\x1cRegisterOrganizationResponse\x126\n" +
-	"\forganization\x18\x01 \x01(\v2\x12.user.OrganizationR\forganization\x12 \n" +
-	"\x05admin\x18\x02 \x01(\v2\n" +
-	".user.UserR\x05admin\x12!\n" +
-	"\faccess_token\x18\x03 \x01(\tR\vaccessToken\x12\x18\n" +
-	"\amessage\x18\x04 \x01(\tR\amessage\"\x1d\n" +
-	"\x1bListAllOrganizationsRequest\"X\n" +
-	"\x1cListAllOrganizationsResponse\x128\n" +
-	"\rorganizations\x18\x01 \x03(\v2\x12.user.OrganizationR\rorganizations\"\x1d\n" +
-	"\x1bGetPlatformAnalyticsRequest\"\xbf\x01\n" +
-	"\x1cGetPlatformAnalyticsResponse\x12/\n" +
-	"\x13total_organizations\x18\x01 \x01(\x03R\x12totalOrganizations\x12\x1f\n" +
-	"\vtotal_users\x18\x02 \x01(\x03R\n" +
+func (x *ResolveUsernamesRequest) Reset() {
+	*x = ResolveUsernamesRequest{}
+	mi := &file_user_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveUsernamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveUsernamesRequest) ProtoMessage() {}
+
+func (x *ResolveUsernamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveUsernamesRequest.ProtoReflect.Descriptor instead.
+func (*ResolveUsernamesRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ResolveUsernamesRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *ResolveUsernamesRequest) GetUsernames() []string {
+	if x != nil {
+		return x.Usernames
+	}
+	return nil
+}
+
+// Resolve usernames response; unknown usernames are omitted
+type ResolveUsernamesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveUsernamesResponse) Reset() {
+	*x = ResolveUsernamesResponse{}
+	mi := &file_user_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveUsernamesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveUsernamesResponse) ProtoMessage() {}
+
+func (x *ResolveUsernamesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveUsernamesResponse.ProtoReflect.Descriptor instead.
+func (*ResolveUsernamesResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ResolveUsernamesResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+// Refresh token request
+type RefreshTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshTokenRequest) Reset() {
+	*x = RefreshTokenRequest{}
+	mi := &file_user_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenRequest) ProtoMessage() {}
+
+func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
+func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *RefreshTokenRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+// Refresh token response
+type RefreshTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	User          *User                  `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	ExpiresIn     int64                  `protobuf:"varint,4,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshTokenResponse) Reset() {
+	*x = RefreshTokenResponse{}
+	mi := &file_user_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenResponse) ProtoMessage() {}
+
+func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenResponse.ProtoReflect.Descriptor instead.
+func (*RefreshTokenResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *RefreshTokenResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *RefreshTokenResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *RefreshTokenResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *RefreshTokenResponse) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+// Unlock user request
+type UnlockUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockUserRequest) Reset() {
+	*x = UnlockUserRequest{}
+	mi := &file_user_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockUserRequest) ProtoMessage() {}
+
+func (x *UnlockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockUserRequest.ProtoReflect.Descriptor instead.
+func (*UnlockUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *UnlockUserRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *UnlockUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// Unlock user response
+type UnlockUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockUserResponse) Reset() {
+	*x = UnlockUserResponse{}
+	mi := &file_user_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockUserResponse) ProtoMessage() {}
+
+func (x *UnlockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockUserResponse.ProtoReflect.Descriptor instead.
+func (*UnlockUserResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *UnlockUserResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Audit log entry
+type AuditLogEntry struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrgId      string                 `protobuf:"bytes,2,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	ActorId    string                 `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ActorEmail string                 `protobuf:"bytes,4,opt,name=actor_email,json=actorEmail,proto3" json:"actor_email,omitempty"`
+	// e.g. "auth.login", "user.role_changed", "org.deleted"
+	Action        string                 `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
+	TargetType    string                 `protobuf:"bytes,6,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"`
+	TargetId      string                 `protobuf:"bytes,7,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Metadata      map[string]string      `protobuf:"bytes,8,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	IpAddress     string                 `protobuf:"bytes,9,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditLogEntry) Reset() {
+	*x = AuditLogEntry{}
+	mi := &file_user_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditLogEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogEntry) ProtoMessage() {}
+
+func (x *AuditLogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogEntry.ProtoReflect.Descriptor instead.
+func (*AuditLogEntry) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *AuditLogEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetActorEmail() string {
+	if x != nil {
+		return x.ActorEmail
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *AuditLogEntry) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// List audit logs request; all filters are optional
+type ListAuditLogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	ActorId       string                 `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Page          int32                  `protobuf:"varint,6,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditLogsRequest) Reset() {
+	*x = ListAuditLogsRequest{}
+	mi := &file_user_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditLogsRequest) ProtoMessage() {}
+
+func (x *ListAuditLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditLogsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditLogsRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListAuditLogsRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *ListAuditLogsRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *ListAuditLogsRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ListAuditLogsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ListAuditLogsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ListAuditLogsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAuditLogsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// List audit logs response, newest first
+type ListAuditLogsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*AuditLogEntry       `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditLogsResponse) Reset() {
+	*x = ListAuditLogsResponse{}
+	mi := &file_user_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditLogsResponse) ProtoMessage() {}
+
+func (x *ListAuditLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditLogsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditLogsResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListAuditLogsResponse) GetEntries() []*AuditLogEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListAuditLogsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListAuditLogsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAuditLogsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// LDAP sync configuration
+type LDAPConfig struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	OrgId   string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Enabled bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// ldap:// or ldaps:// URL
+	Url      string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	StartTls bool   `protobuf:"varint,4,opt,name=start_tls,json=startTls,proto3" json:"start_tls,omitempty"`
+	BindDn   string `protobuf:"bytes,5,opt,name=bind_dn,json=bindDn,proto3" json:"bind_dn,omitempty"`
+	// Write-only; left empty on update to keep the stored password
+	BindPassword    string `protobuf:"bytes,6,opt,name=bind_password,json=bindPassword,proto3" json:"bind_password,omitempty"`
+	HasBindPassword bool   `protobuf:"varint,7,opt,name=has_bind_password,json=hasBindPassword,proto3" json:"has_bind_password,omitempty"`
+	BaseDn          string `protobuf:"bytes,8,opt,name=base_dn,json=baseDn,proto3" json:"base_dn,omitempty"`
+	// Defaults to active person accounts
+	UserFilter string `protobuf:"bytes,9,opt,name=user_filter,json=userFilter,proto3" json:"user_filter,omitempty"`
+	// Default to mail, sAMAccountName and displayName
+	EmailAttribute    string `protobuf:"bytes,10,opt,name=email_attribute,json=emailAttribute,proto3" json:"email_attribute,omitempty"`
+	UsernameAttribute string `protobuf:"bytes,11,opt,name=username_attribute,json=usernameAttribute,proto3" json:"username_attribute,omitempty"`
+	NameAttribute     string `protobuf:"bytes,12,opt,name=name_attribute,json=nameAttribute,proto3" json:"name_attribute,omitempty"`
+	// Directory group DN -> team ID
+	GroupTeams map[string]string `protobuf:"bytes,13,rep,name=group_teams,json=groupTeams,proto3" json:"group_teams,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Defaults to 60
+	SyncIntervalMinutes int32                  `protobuf:"varint,14,opt,name=sync_interval_minutes,json=syncIntervalMinutes,proto3" json:"sync_interval_minutes,omitempty"`
+	LastSyncAt          *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=last_sync_at,json=lastSyncAt,proto3" json:"last_sync_at,omitempty"`
+	LastSyncError       string                 `protobuf:"bytes,16,opt,name=last_sync_error,json=lastSyncError,proto3" json:"last_sync_error,omitempty"`
+	LastSyncStats       *LDAPSyncStats         `protobuf:"bytes,17,opt,name=last_sync_stats,json=lastSyncStats,proto3" json:"last_sync_stats,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *LDAPConfig) Reset() {
+	*x = LDAPConfig{}
+	mi := &file_user_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LDAPConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LDAPConfig) ProtoMessage() {}
+
+func (x *LDAPConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LDAPConfig.ProtoReflect.Descriptor instead.
+func (*LDAPConfig) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *LDAPConfig) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *LDAPConfig) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *LDAPConfig) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *LDAPConfig) GetStartTls() bool {
+	if x != nil {
+		return x.StartTls
+	}
+	return false
+}
+
+func (x *LDAPConfig) GetBindDn() string {
+	if x != nil {
+		return x.BindDn
+	}
+	return ""
+}
+
+func (x *LDAPConfig) GetBindPassword() string {
+	if x != nil {
+		return x.BindPassword
+	}
+	return ""
+}
+
+func (x *LDAPConfig) GetHasBindPassword() bool {
+	if x != nil {
+		return x.HasBindPassword
+	}
+	return false
+}
+
+func (x *LDAPConfig) GetBaseDn() string {
+	if x != nil {
+		return x.BaseDn
+	}
+	return ""
+}
+
+func (x *LDAPConfig) GetUserFilter() string {
+	if x != nil {
+		return x.UserFilter
+	}
+	return ""
+}
+
+func (x *LDAPConfig) GetEmailAttribute() string {
+	if x != nil {
+		return x.EmailAttribute
+	}
+	return ""
+}
+
+func (x *LDAPConfig) GetUsernameAttribute() string {
+	if x != nil {
+		return x.UsernameAttribute
+	}
+	return ""
+}
+
+func (x *LDAPConfig) GetNameAttribute() string {
+	if x != nil {
+		return x.NameAttribute
+	}
+	return ""
+}
+
+func (x *LDAPConfig) GetGroupTeams() map[string]string {
+	if x != nil {
+		return x.GroupTeams
+	}
+	return nil
+}
+
+func (x *LDAPConfig) GetSyncIntervalMinutes() int32 {
+	if x != nil {
+		return x.SyncIntervalMinutes
+	}
+	return 0
+}
+
+func (x *LDAPConfig) GetLastSyncAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSyncAt
+	}
+	return nil
+}
+
+func (x *LDAPConfig) GetLastSyncError() string {
+	if x != nil {
+		return x.LastSyncError
+	}
+	return ""
+}
+
+func (x *LDAPConfig) GetLastSyncStats() *LDAPSyncStats {
+	if x != nil {
+		return x.LastSyncStats
+	}
+	return nil
+}
+
+// Outcome of one LDAP sync run
+type LDAPSyncStats struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Created            int32                  `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
+	Updated            int32                  `protobuf:"varint,2,opt,name=updated,proto3" json:"updated,omitempty"`
+	Deactivated        int32                  `protobuf:"varint,3,opt,name=deactivated,proto3" json:"deactivated,omitempty"`
+	Reactivated        int32                  `protobuf:"varint,4,opt,name=reactivated,proto3" json:"reactivated,omitempty"`
+	TeamMembersAdded   int32                  `protobuf:"varint,5,opt,name=team_members_added,json=teamMembersAdded,proto3" json:"team_members_added,omitempty"`
+	TeamMembersRemoved int32                  `protobuf:"varint,6,opt,name=team_members_removed,json=teamMembersRemoved,proto3" json:"team_members_removed,omitempty"`
+	// Directory entries without an email or owned by another org
+	Skipped       int32 `protobuf:"varint,7,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LDAPSyncStats) Reset() {
+	*x = LDAPSyncStats{}
+	mi := &file_user_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LDAPSyncStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LDAPSyncStats) ProtoMessage() {}
+
+func (x *LDAPSyncStats) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LDAPSyncStats.ProtoReflect.Descriptor instead.
+func (*LDAPSyncStats) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *LDAPSyncStats) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *LDAPSyncStats) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *LDAPSyncStats) GetDeactivated() int32 {
+	if x != nil {
+		return x.Deactivated
+	}
+	return 0
+}
+
+func (x *LDAPSyncStats) GetReactivated() int32 {
+	if x != nil {
+		return x.Reactivated
+	}
+	return 0
+}
+
+func (x *LDAPSyncStats) GetTeamMembersAdded() int32 {
+	if x != nil {
+		return x.TeamMembersAdded
+	}
+	return 0
+}
+
+func (x *LDAPSyncStats) GetTeamMembersRemoved() int32 {
+	if x != nil {
+		return x.TeamMembersRemoved
+	}
+	return 0
+}
+
+func (x *LDAPSyncStats) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+// Get LDAP config request
+type GetLDAPConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLDAPConfigRequest) Reset() {
+	*x = GetLDAPConfigRequest{}
+	mi := &file_user_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLDAPConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLDAPConfigRequest) ProtoMessage() {}
+
+func (x *GetLDAPConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLDAPConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetLDAPConfigRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetLDAPConfigRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+// Get LDAP config response
+type GetLDAPConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *LDAPConfig            `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLDAPConfigResponse) Reset() {
+	*x = GetLDAPConfigResponse{}
+	mi := &file_user_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLDAPConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLDAPConfigResponse) ProtoMessage() {}
+
+func (x *GetLDAPConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLDAPConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetLDAPConfigResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetLDAPConfigResponse) GetConfig() *LDAPConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+// Upsert LDAP config request
+type UpsertLDAPConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Config        *LDAPConfig            `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertLDAPConfigRequest) Reset() {
+	*x = UpsertLDAPConfigRequest{}
+	mi := &file_user_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertLDAPConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertLDAPConfigRequest) ProtoMessage() {}
+
+func (x *UpsertLDAPConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertLDAPConfigRequest.ProtoReflect.Descriptor instead.
+func (*UpsertLDAPConfigRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *UpsertLDAPConfigRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *UpsertLDAPConfigRequest) GetConfig() *LDAPConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+// Upsert LDAP config response
+type UpsertLDAPConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *LDAPConfig            `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertLDAPConfigResponse) Reset() {
+	*x = UpsertLDAPConfigResponse{}
+	mi := &file_user_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertLDAPConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertLDAPConfigResponse) ProtoMessage() {}
+
+func (x *UpsertLDAPConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertLDAPConfigResponse.ProtoReflect.Descriptor instead.
+func (*UpsertLDAPConfigResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *UpsertLDAPConfigResponse) GetConfig() *LDAPConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *UpsertLDAPConfigResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Sync LDAP request
+type SyncLDAPRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncLDAPRequest) Reset() {
+	*x = SyncLDAPRequest{}
+	mi := &file_user_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncLDAPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncLDAPRequest) ProtoMessage() {}
+
+func (x *SyncLDAPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncLDAPRequest.ProtoReflect.Descriptor instead.
+func (*SyncLDAPRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SyncLDAPRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+// Sync LDAP response
+type SyncLDAPResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stats         *LDAPSyncStats         `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncLDAPResponse) Reset() {
+	*x = SyncLDAPResponse{}
+	mi := &file_user_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncLDAPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncLDAPResponse) ProtoMessage() {}
+
+func (x *SyncLDAPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncLDAPResponse.ProtoReflect.Descriptor instead.
+func (*SyncLDAPResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *SyncLDAPResponse) GetStats() *LDAPSyncStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *SyncLDAPResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Personal API key (never includes the key itself)
+type APIKey struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	KeyId string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// First characters of the key, for recognising it
+	Prefix        string                 `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Scopes        []string               `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Revoked       bool                   `protobuf:"varint,8,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *APIKey) Reset() {
+	*x = APIKey{}
+	mi := &file_user_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *APIKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIKey) ProtoMessage() {}
+
+func (x *APIKey) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIKey.ProtoReflect.Descriptor instead.
+func (*APIKey) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *APIKey) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *APIKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *APIKey) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *APIKey) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *APIKey) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *APIKey) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+func (x *APIKey) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *APIKey) GetRevoked() bool {
+	if x != nil {
+		return x.Revoked
+	}
+	return false
+}
+
+// Create API key request
+type CreateAPIKeyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// e.g. "tasks:read", "tasks:write"
+	Scopes []string `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// Defaults to 90, at most 365
+	ExpiresInDays int32 `protobuf:"varint,3,opt,name=expires_in_days,json=expiresInDays,proto3" json:"expires_in_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAPIKeyRequest) Reset() {
+	*x = CreateAPIKeyRequest{}
+	mi := &file_user_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyRequest) ProtoMessage() {}
+
+func (x *CreateAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CreateAPIKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CreateAPIKeyRequest) GetExpiresInDays() int32 {
+	if x != nil {
+		return x.ExpiresInDays
+	}
+	return 0
+}
+
+// Create API key response
+type CreateAPIKeyResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey *APIKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	// Shown once; store it securely
+	Key           string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAPIKeyResponse) Reset() {
+	*x = CreateAPIKeyResponse{}
+	mi := &file_user_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyResponse) ProtoMessage() {}
+
+func (x *CreateAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CreateAPIKeyResponse) GetApiKey() *APIKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *CreateAPIKeyResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// List API keys request
+type ListAPIKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAPIKeysRequest) Reset() {
+	*x = ListAPIKeysRequest{}
+	mi := &file_user_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAPIKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPIKeysRequest) ProtoMessage() {}
+
+func (x *ListAPIKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPIKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListAPIKeysRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{72}
+}
+
+// List API keys response
+type ListAPIKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKeys       []*APIKey              `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAPIKeysResponse) Reset() {
+	*x = ListAPIKeysResponse{}
+	mi := &file_user_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAPIKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPIKeysResponse) ProtoMessage() {}
+
+func (x *ListAPIKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPIKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListAPIKeysResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ListAPIKeysResponse) GetApiKeys() []*APIKey {
+	if x != nil {
+		return x.ApiKeys
+	}
+	return nil
+}
+
+// Revoke API key request
+type RevokeAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAPIKeyRequest) Reset() {
+	*x = RevokeAPIKeyRequest{}
+	mi := &file_user_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPIKeyRequest) ProtoMessage() {}
+
+func (x *RevokeAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *RevokeAPIKeyRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+// Revoke API key response
+type RevokeAPIKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAPIKeyResponse) Reset() {
+	*x = RevokeAPIKeyResponse{}
+	mi := &file_user_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPIKeyResponse) ProtoMessage() {}
+
+func (x *RevokeAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*RevokeAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *RevokeAPIKeyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Validate API key request
+type ValidateAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateAPIKeyRequest) Reset() {
+	*x = ValidateAPIKeyRequest{}
+	mi := &file_user_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateAPIKeyRequest) ProtoMessage() {}
+
+func (x *ValidateAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*ValidateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ValidateAPIKeyRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// Validate API key response
+type ValidateAPIKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrgId         string                 `protobuf:"bytes,3,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	Email         string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	Scopes        []string               `protobuf:"bytes,6,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	KeyId         string                 `protobuf:"bytes,7,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateAPIKeyResponse) Reset() {
+	*x = ValidateAPIKeyResponse{}
+	mi := &file_user_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateAPIKeyResponse) ProtoMessage() {}
+
+func (x *ValidateAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*ValidateAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ValidateAPIKeyResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateAPIKeyResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ValidateAPIKeyResponse) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *ValidateAPIKeyResponse) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ValidateAPIKeyResponse) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ValidateAPIKeyResponse) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *ValidateAPIKeyResponse) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+// Non-human account owned by an organization
+type ServiceAccount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrgId         string                 `protobuf:"bytes,2,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	IsActive      bool                   `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ApiKeys       []*APIKey              `protobuf:"bytes,7,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceAccount) Reset() {
+	*x = ServiceAccount{}
+	mi := &file_user_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceAccount) ProtoMessage() {}
+
+func (x *ServiceAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceAccount.ProtoReflect.Descriptor instead.
+func (*ServiceAccount) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ServiceAccount) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ServiceAccount) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *ServiceAccount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServiceAccount) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ServiceAccount) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *ServiceAccount) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ServiceAccount) GetApiKeys() []*APIKey {
+	if x != nil {
+		return x.ApiKeys
+	}
+	return nil
+}
+
+// Create service account request
+type CreateServiceAccountRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	OrgId string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Scopes for the first key, e.g. "tasks:write"
+	Scopes []string `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// Defaults to 90, at most 365
+	ExpiresInDays int32 `protobuf:"varint,4,opt,name=expires_in_days,json=expiresInDays,proto3" json:"expires_in_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateServiceAccountRequest) Reset() {
+	*x = CreateServiceAccountRequest{}
+	mi := &file_user_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateServiceAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateServiceAccountRequest) ProtoMessage() {}
+
+func (x *CreateServiceAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateServiceAccountRequest.ProtoReflect.Descriptor instead.
+func (*CreateServiceAccountRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *CreateServiceAccountRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *CreateServiceAccountRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateServiceAccountRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CreateServiceAccountRequest) GetExpiresInDays() int32 {
+	if x != nil {
+		return x.ExpiresInDays
+	}
+	return 0
+}
+
+// Create service account response
+type CreateServiceAccountResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ServiceAccount *ServiceAccount        `protobuf:"bytes,1,opt,name=service_account,json=serviceAccount,proto3" json:"service_account,omitempty"`
+	// Shown once; store it securely
+	Key           string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateServiceAccountResponse) Reset() {
+	*x = CreateServiceAccountResponse{}
+	mi := &file_user_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateServiceAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateServiceAccountResponse) ProtoMessage() {}
+
+func (x *CreateServiceAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateServiceAccountResponse.ProtoReflect.Descriptor instead.
+func (*CreateServiceAccountResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *CreateServiceAccountResponse) GetServiceAccount() *ServiceAccount {
+	if x != nil {
+		return x.ServiceAccount
+	}
+	return nil
+}
+
+func (x *CreateServiceAccountResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// List service accounts request
+type ListServiceAccountsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListServiceAccountsRequest) Reset() {
+	*x = ListServiceAccountsRequest{}
+	mi := &file_user_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListServiceAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServiceAccountsRequest) ProtoMessage() {}
+
+func (x *ListServiceAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServiceAccountsRequest.ProtoReflect.Descriptor instead.
+func (*ListServiceAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ListServiceAccountsRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+// List service accounts response
+type ListServiceAccountsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ServiceAccounts []*ServiceAccount      `protobuf:"bytes,1,rep,name=service_accounts,json=serviceAccounts,proto3" json:"service_accounts,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListServiceAccountsResponse) Reset() {
+	*x = ListServiceAccountsResponse{}
+	mi := &file_user_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListServiceAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServiceAccountsResponse) ProtoMessage() {}
+
+func (x *ListServiceAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServiceAccountsResponse.ProtoReflect.Descriptor instead.
+func (*ListServiceAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ListServiceAccountsResponse) GetServiceAccounts() []*ServiceAccount {
+	if x != nil {
+		return x.ServiceAccounts
+	}
+	return nil
+}
+
+// Rotate service account key request
+type RotateServiceAccountKeyRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	OrgId            string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	ServiceAccountId string                 `protobuf:"bytes,2,opt,name=service_account_id,json=serviceAccountId,proto3" json:"service_account_id,omitempty"`
+	// Defaults to the scopes of the newest current key
+	Scopes        []string `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	ExpiresInDays int32    `protobuf:"varint,4,opt,name=expires_in_days,json=expiresInDays,proto3" json:"expires_in_days,omitempty"`
+	// How long the old keys keep working; 0 revokes them immediately
+	GracePeriodMinutes int32 `protobuf:"varint,5,opt,name=grace_period_minutes,json=gracePeriodMinutes,proto3" json:"grace_period_minutes,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RotateServiceAccountKeyRequest) Reset() {
+	*x = RotateServiceAccountKeyRequest{}
+	mi := &file_user_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateServiceAccountKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateServiceAccountKeyRequest) ProtoMessage() {}
+
+func (x *RotateServiceAccountKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateServiceAccountKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateServiceAccountKeyRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *RotateServiceAccountKeyRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *RotateServiceAccountKeyRequest) GetServiceAccountId() string {
+	if x != nil {
+		return x.ServiceAccountId
+	}
+	return ""
+}
+
+func (x *RotateServiceAccountKeyRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *RotateServiceAccountKeyRequest) GetExpiresInDays() int32 {
+	if x != nil {
+		return x.ExpiresInDays
+	}
+	return 0
+}
+
+func (x *RotateServiceAccountKeyRequest) GetGracePeriodMinutes() int32 {
+	if x != nil {
+		return x.GracePeriodMinutes
+	}
+	return 0
+}
+
+// Rotate service account key response
+type RotateServiceAccountKeyResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey *APIKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	// Shown once; store it securely
+	Key           string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateServiceAccountKeyResponse) Reset() {
+	*x = RotateServiceAccountKeyResponse{}
+	mi := &file_user_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateServiceAccountKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateServiceAccountKeyResponse) ProtoMessage() {}
+
+func (x *RotateServiceAccountKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateServiceAccountKeyResponse.ProtoReflect.Descriptor instead.
+func (*RotateServiceAccountKeyResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *RotateServiceAccountKeyResponse) GetApiKey() *APIKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *RotateServiceAccountKeyResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// Delete service account request
+type DeleteServiceAccountRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	OrgId            string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	ServiceAccountId string                 `protobuf:"bytes,2,opt,name=service_account_id,json=serviceAccountId,proto3" json:"service_account_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DeleteServiceAccountRequest) Reset() {
+	*x = DeleteServiceAccountRequest{}
+	mi := &file_user_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteServiceAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteServiceAccountRequest) ProtoMessage() {}
+
+func (x *DeleteServiceAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteServiceAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteServiceAccountRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *DeleteServiceAccountRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *DeleteServiceAccountRequest) GetServiceAccountId() string {
+	if x != nil {
+		return x.ServiceAccountId
+	}
+	return ""
+}
+
+// Delete service account response
+type DeleteServiceAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteServiceAccountResponse) Reset() {
+	*x = DeleteServiceAccountResponse{}
+	mi := &file_user_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteServiceAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteServiceAccountResponse) ProtoMessage() {}
+
+func (x *DeleteServiceAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteServiceAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeleteServiceAccountResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *DeleteServiceAccountResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Suspend user request
+type SuspendUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendUserRequest) Reset() {
+	*x = SuspendUserRequest{}
+	mi := &file_user_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendUserRequest) ProtoMessage() {}
+
+func (x *SuspendUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendUserRequest.ProtoReflect.Descriptor instead.
+func (*SuspendUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *SuspendUserRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *SuspendUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SuspendUserRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Suspend user response
+type SuspendUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendUserResponse) Reset() {
+	*x = SuspendUserResponse{}
+	mi := &file_user_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendUserResponse) ProtoMessage() {}
+
+func (x *SuspendUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendUserResponse.ProtoReflect.Descriptor instead.
+func (*SuspendUserResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *SuspendUserResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Reactivate user request
+type ReactivateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactivateUserRequest) Reset() {
+	*x = ReactivateUserRequest{}
+	mi := &file_user_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactivateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactivateUserRequest) ProtoMessage() {}
+
+func (x *ReactivateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactivateUserRequest.ProtoReflect.Descriptor instead.
+func (*ReactivateUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ReactivateUserRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *ReactivateUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// Reactivate user response
+type ReactivateUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactivateUserResponse) Reset() {
+	*x = ReactivateUserResponse{}
+	mi := &file_user_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactivateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactivateUserResponse) ProtoMessage() {}
+
+func (x *ReactivateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactivateUserResponse.ProtoReflect.Descriptor instead.
+func (*ReactivateUserResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ReactivateUserResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Export user data request; leave org_id and user_id empty to export yourself
+type ExportUserDataRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	OrgId  string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	UserId string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// "json" (default) or "zip"
+	Format        string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserDataRequest) Reset() {
+	*x = ExportUserDataRequest{}
+	mi := &file_user_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataRequest) ProtoMessage() {}
+
+func (x *ExportUserDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportUserDataRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ExportUserDataRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *ExportUserDataRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ExportUserDataRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+// Export user data response
+type ExportUserDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserDataResponse) Reset() {
+	*x = ExportUserDataResponse{}
+	mi := &file_user_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataResponse) ProtoMessage() {}
+
+func (x *ExportUserDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataResponse.ProtoReflect.Descriptor instead.
+func (*ExportUserDataResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ExportUserDataResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ExportUserDataResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportUserDataResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Progress of erasing a user across services
+type DataErasureRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrgId  string                 `protobuf:"bytes,3,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	// "anonymize" or "delete"
+	Mode string `protobuf:"bytes,4,opt,name=mode,proto3" json:"mode,omitempty"`
+	// scheduled, pending, running, completed, failed or cancelled
+	Status      string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Attempts    int32                  `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError   string                 `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CompletedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	// Services whose data has been erased so far
+	CompletedSteps []string `protobuf:"bytes,10,rep,name=completed_steps,json=completedSteps,proto3" json:"completed_steps,omitempty"`
+	// When a scheduled self-service deletion starts
+	ScheduledFor  *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=scheduled_for,json=scheduledFor,proto3" json:"scheduled_for,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataErasureRequest) Reset() {
+	*x = DataErasureRequest{}
+	mi := &file_user_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataErasureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataErasureRequest) ProtoMessage() {}
+
+func (x *DataErasureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataErasureRequest.ProtoReflect.Descriptor instead.
+func (*DataErasureRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *DataErasureRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DataErasureRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DataErasureRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *DataErasureRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *DataErasureRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DataErasureRequest) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *DataErasureRequest) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *DataErasureRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DataErasureRequest) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *DataErasureRequest) GetCompletedSteps() []string {
+	if x != nil {
+		return x.CompletedSteps
+	}
+	return nil
+}
+
+func (x *DataErasureRequest) GetScheduledFor() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledFor
+	}
+	return nil
+}
+
+// Request data erasure request
+type RequestDataErasureRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	OrgId  string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	UserId string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// "anonymize" (default) keeps shared records under a placeholder user;
+	// "delete" also removes records only the user owned
+	Mode          string `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestDataErasureRequest) Reset() {
+	*x = RequestDataErasureRequest{}
+	mi := &file_user_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestDataErasureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestDataErasureRequest) ProtoMessage() {}
+
+func (x *RequestDataErasureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestDataErasureRequest.ProtoReflect.Descriptor instead.
+func (*RequestDataErasureRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *RequestDataErasureRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *RequestDataErasureRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RequestDataErasureRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+// Request data erasure response
+type RequestDataErasureResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Request       *DataErasureRequest    `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestDataErasureResponse) Reset() {
+	*x = RequestDataErasureResponse{}
+	mi := &file_user_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestDataErasureResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestDataErasureResponse) ProtoMessage() {}
+
+func (x *RequestDataErasureResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestDataErasureResponse.ProtoReflect.Descriptor instead.
+func (*RequestDataErasureResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *RequestDataErasureResponse) GetRequest() *DataErasureRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+// Get data erasure request request
+type GetDataErasureRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	RequestId     string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDataErasureRequestRequest) Reset() {
+	*x = GetDataErasureRequestRequest{}
+	mi := &file_user_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDataErasureRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataErasureRequestRequest) ProtoMessage() {}
+
+func (x *GetDataErasureRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataErasureRequestRequest.ProtoReflect.Descriptor instead.
+func (*GetDataErasureRequestRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *GetDataErasureRequestRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *GetDataErasureRequestRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+// Get data erasure request response
+type GetDataErasureRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Request       *DataErasureRequest    `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDataErasureRequestResponse) Reset() {
+	*x = GetDataErasureRequestResponse{}
+	mi := &file_user_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDataErasureRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataErasureRequestResponse) ProtoMessage() {}
+
+func (x *GetDataErasureRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataErasureRequestResponse.ProtoReflect.Descriptor instead.
+func (*GetDataErasureRequestResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *GetDataErasureRequestResponse) GetRequest() *DataErasureRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+// Resend invite request
+type ResendInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	InviteId      string                 `protobuf:"bytes,2,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
+	ExpiresHours  int32                  `protobuf:"varint,3,opt,name=expires_hours,json=expiresHours,proto3" json:"expires_hours,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendInviteRequest) Reset() {
+	*x = ResendInviteRequest{}
+	mi := &file_user_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendInviteRequest) ProtoMessage() {}
+
+func (x *ResendInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendInviteRequest.ProtoReflect.Descriptor instead.
+func (*ResendInviteRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ResendInviteRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *ResendInviteRequest) GetInviteId() string {
+	if x != nil {
+		return x.InviteId
+	}
+	return ""
+}
+
+func (x *ResendInviteRequest) GetExpiresHours() int32 {
+	if x != nil {
+		return x.ExpiresHours
+	}
+	return 0
+}
+
+// Resend invite response
+type ResendInviteResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Invite  *Invite                `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// the new invite token, only returned by development deployments
+	Token         string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendInviteResponse) Reset() {
+	*x = ResendInviteResponse{}
+	mi := &file_user_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendInviteResponse) ProtoMessage() {}
+
+func (x *ResendInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendInviteResponse.ProtoReflect.Descriptor instead.
+func (*ResendInviteResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ResendInviteResponse) GetInvite() *Invite {
+	if x != nil {
+		return x.Invite
+	}
+	return nil
+}
+
+func (x *ResendInviteResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ResendInviteResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// Revoke invite request
+type RevokeInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	InviteId      string                 `protobuf:"bytes,2,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInviteRequest) Reset() {
+	*x = RevokeInviteRequest{}
+	mi := &file_user_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInviteRequest) ProtoMessage() {}
+
+func (x *RevokeInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInviteRequest.ProtoReflect.Descriptor instead.
+func (*RevokeInviteRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *RevokeInviteRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *RevokeInviteRequest) GetInviteId() string {
+	if x != nil {
+		return x.InviteId
+	}
+	return ""
+}
+
+// Revoke invite response
+type RevokeInviteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInviteResponse) Reset() {
+	*x = RevokeInviteResponse{}
+	mi := &file_user_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInviteResponse) ProtoMessage() {}
+
+func (x *RevokeInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInviteResponse.ProtoReflect.Descriptor instead.
+func (*RevokeInviteResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *RevokeInviteResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// One login attempt
+type LoginEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Success       bool                   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	FailureReason string                 `protobuf:"bytes,5,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	IpAddress     string                 `protobuf:"bytes,6,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,7,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Fingerprint of the signing-in device
+	DeviceId string `protobuf:"bytes,9,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	// Set on successful logins from a device and network not seen before
+	NewDevice     bool `protobuf:"varint,10,opt,name=new_device,json=newDevice,proto3" json:"new_device,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginEvent) Reset() {
+	*x = LoginEvent{}
+	mi := &file_user_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginEvent) ProtoMessage() {}
+
+func (x *LoginEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginEvent.ProtoReflect.Descriptor instead.
+func (*LoginEvent) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *LoginEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LoginEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LoginEvent) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *LoginEvent) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *LoginEvent) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+func (x *LoginEvent) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *LoginEvent) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *LoginEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *LoginEvent) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *LoginEvent) GetNewDevice() bool {
+	if x != nil {
+		return x.NewDevice
+	}
+	return false
+}
+
+// Get login history request; an empty user_id means the caller
+type GetLoginHistoryRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page     int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// only return failed attempts
+	FailuresOnly  bool `protobuf:"varint,4,opt,name=failures_only,json=failuresOnly,proto3" json:"failures_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLoginHistoryRequest) Reset() {
+	*x = GetLoginHistoryRequest{}
+	mi := &file_user_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLoginHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoginHistoryRequest) ProtoMessage() {}
+
+func (x *GetLoginHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoginHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetLoginHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *GetLoginHistoryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetLoginHistoryRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetLoginHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetLoginHistoryRequest) GetFailuresOnly() bool {
+	if x != nil {
+		return x.FailuresOnly
+	}
+	return false
+}
+
+// Get login history response
+type GetLoginHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*LoginEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLoginHistoryResponse) Reset() {
+	*x = GetLoginHistoryResponse{}
+	mi := &file_user_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLoginHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoginHistoryResponse) ProtoMessage() {}
+
+func (x *GetLoginHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoginHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetLoginHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *GetLoginHistoryResponse) GetEvents() []*LoginEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *GetLoginHistoryResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetLoginHistoryResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetLoginHistoryResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// A proposed grant or revocation of the super admin role
+type SuperAdminChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// grant or revoke
+	Action      string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	UserId      string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserEmail   string `protobuf:"bytes,4,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	RequestedBy string `protobuf:"bytes,5,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	Reason      string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	// pending, applied, rejected or expired
+	Status            string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	ApprovedBy        []string               `protobuf:"bytes,8,rep,name=approved_by,json=approvedBy,proto3" json:"approved_by,omitempty"`
+	RequiredApprovals int32                  `protobuf:"varint,9,opt,name=required_approvals,json=requiredApprovals,proto3" json:"required_approvals,omitempty"`
+	DecidedBy         string                 `protobuf:"bytes,10,opt,name=decided_by,json=decidedBy,proto3" json:"decided_by,omitempty"`
+	DecisionReason    string                 `protobuf:"bytes,11,opt,name=decision_reason,json=decisionReason,proto3" json:"decision_reason,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt         *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	DecidedAt         *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SuperAdminChange) Reset() {
+	*x = SuperAdminChange{}
+	mi := &file_user_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuperAdminChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuperAdminChange) ProtoMessage() {}
+
+func (x *SuperAdminChange) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuperAdminChange.ProtoReflect.Descriptor instead.
+func (*SuperAdminChange) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *SuperAdminChange) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SuperAdminChange) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *SuperAdminChange) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SuperAdminChange) GetUserEmail() string {
+	if x != nil {
+		return x.UserEmail
+	}
+	return ""
+}
+
+func (x *SuperAdminChange) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *SuperAdminChange) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SuperAdminChange) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SuperAdminChange) GetApprovedBy() []string {
+	if x != nil {
+		return x.ApprovedBy
+	}
+	return nil
+}
+
+func (x *SuperAdminChange) GetRequiredApprovals() int32 {
+	if x != nil {
+		return x.RequiredApprovals
+	}
+	return 0
+}
+
+func (x *SuperAdminChange) GetDecidedBy() string {
+	if x != nil {
+		return x.DecidedBy
+	}
+	return ""
+}
+
+func (x *SuperAdminChange) GetDecisionReason() string {
+	if x != nil {
+		return x.DecisionReason
+	}
+	return ""
+}
+
+func (x *SuperAdminChange) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SuperAdminChange) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *SuperAdminChange) GetDecidedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return nil
+}
+
+// List super admins request
+type ListSuperAdminsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSuperAdminsRequest) Reset() {
+	*x = ListSuperAdminsRequest{}
+	mi := &file_user_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSuperAdminsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSuperAdminsRequest) ProtoMessage() {}
+
+func (x *ListSuperAdminsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSuperAdminsRequest.ProtoReflect.Descriptor instead.
+func (*ListSuperAdminsRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{106}
+}
+
+// List super admins response
+type ListSuperAdminsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSuperAdminsResponse) Reset() {
+	*x = ListSuperAdminsResponse{}
+	mi := &file_user_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSuperAdminsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSuperAdminsResponse) ProtoMessage() {}
+
+func (x *ListSuperAdminsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSuperAdminsResponse.ProtoReflect.Descriptor instead.
+func (*ListSuperAdminsResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ListSuperAdminsResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+// Grant super admin request
+type GrantSuperAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantSuperAdminRequest) Reset() {
+	*x = GrantSuperAdminRequest{}
+	mi := &file_user_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantSuperAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantSuperAdminRequest) ProtoMessage() {}
+
+func (x *GrantSuperAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantSuperAdminRequest.ProtoReflect.Descriptor instead.
+func (*GrantSuperAdminRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *GrantSuperAdminRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GrantSuperAdminRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Revoke super admin request
+type RevokeSuperAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSuperAdminRequest) Reset() {
+	*x = RevokeSuperAdminRequest{}
+	mi := &file_user_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSuperAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSuperAdminRequest) ProtoMessage() {}
+
+func (x *RevokeSuperAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSuperAdminRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSuperAdminRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *RevokeSuperAdminRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RevokeSuperAdminRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Super admin change response
+type SuperAdminChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Change        *SuperAdminChange      `protobuf:"bytes,1,opt,name=change,proto3" json:"change,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuperAdminChangeResponse) Reset() {
+	*x = SuperAdminChangeResponse{}
+	mi := &file_user_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuperAdminChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuperAdminChangeResponse) ProtoMessage() {}
+
+func (x *SuperAdminChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuperAdminChangeResponse.ProtoReflect.Descriptor instead.
+func (*SuperAdminChangeResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *SuperAdminChangeResponse) GetChange() *SuperAdminChange {
+	if x != nil {
+		return x.Change
+	}
+	return nil
+}
+
+func (x *SuperAdminChangeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// List super admin changes request
+type ListSuperAdminChangesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// filter by status; empty lists all
+	Status        string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSuperAdminChangesRequest) Reset() {
+	*x = ListSuperAdminChangesRequest{}
+	mi := &file_user_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSuperAdminChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSuperAdminChangesRequest) ProtoMessage() {}
+
+func (x *ListSuperAdminChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSuperAdminChangesRequest.ProtoReflect.Descriptor instead.
+func (*ListSuperAdminChangesRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *ListSuperAdminChangesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// List super admin changes response
+type ListSuperAdminChangesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*SuperAdminChange    `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSuperAdminChangesResponse) Reset() {
+	*x = ListSuperAdminChangesResponse{}
+	mi := &file_user_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSuperAdminChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSuperAdminChangesResponse) ProtoMessage() {}
+
+func (x *ListSuperAdminChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSuperAdminChangesResponse.ProtoReflect.Descriptor instead.
+func (*ListSuperAdminChangesResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *ListSuperAdminChangesResponse) GetChanges() []*SuperAdminChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// Approve super admin change request
+type ApproveSuperAdminChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChangeId      string                 `protobuf:"bytes,1,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveSuperAdminChangeRequest) Reset() {
+	*x = ApproveSuperAdminChangeRequest{}
+	mi := &file_user_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveSuperAdminChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveSuperAdminChangeRequest) ProtoMessage() {}
+
+func (x *ApproveSuperAdminChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveSuperAdminChangeRequest.ProtoReflect.Descriptor instead.
+func (*ApproveSuperAdminChangeRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *ApproveSuperAdminChangeRequest) GetChangeId() string {
+	if x != nil {
+		return x.ChangeId
+	}
+	return ""
+}
+
+// Reject super admin change request
+type RejectSuperAdminChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChangeId      string                 `protobuf:"bytes,1,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectSuperAdminChangeRequest) Reset() {
+	*x = RejectSuperAdminChangeRequest{}
+	mi := &file_user_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectSuperAdminChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectSuperAdminChangeRequest) ProtoMessage() {}
+
+func (x *RejectSuperAdminChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectSuperAdminChangeRequest.ProtoReflect.Descriptor instead.
+func (*RejectSuperAdminChangeRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *RejectSuperAdminChangeRequest) GetChangeId() string {
+	if x != nil {
+		return x.ChangeId
+	}
+	return ""
+}
+
+func (x *RejectSuperAdminChangeRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// One organization a user belongs to
+type OrganizationMembership struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	OrgId   string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	OrgName string                 `protobuf:"bytes,2,opt,name=org_name,json=orgName,proto3" json:"org_name,omitempty"`
+	Role    string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	// the organization the account was created in
+	Home bool `protobuf:"varint,4,opt,name=home,proto3" json:"home,omitempty"`
+	// the organization the caller's token is scoped to
+	Current       bool                   `protobuf:"varint,5,opt,name=current,proto3" json:"current,omitempty"`
+	JoinedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrganizationMembership) Reset() {
+	*x = OrganizationMembership{}
+	mi := &file_user_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizationMembership) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationMembership) ProtoMessage() {}
+
+func (x *OrganizationMembership) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationMembership.ProtoReflect.Descriptor instead.
+func (*OrganizationMembership) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *OrganizationMembership) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *OrganizationMembership) GetOrgName() string {
+	if x != nil {
+		return x.OrgName
+	}
+	return ""
+}
+
+func (x *OrganizationMembership) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *OrganizationMembership) GetHome() bool {
+	if x != nil {
+		return x.Home
+	}
+	return false
+}
+
+func (x *OrganizationMembership) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+func (x *OrganizationMembership) GetJoinedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return nil
+}
+
+// List my organizations request
+type ListMyOrganizationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyOrganizationsRequest) Reset() {
+	*x = ListMyOrganizationsRequest{}
+	mi := &file_user_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyOrganizationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyOrganizationsRequest) ProtoMessage() {}
+
+func (x *ListMyOrganizationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyOrganizationsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyOrganizationsRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{116}
+}
+
+// List my organizations response
+type ListMyOrganizationsResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Organizations []*OrganizationMembership `protobuf:"bytes,1,rep,name=organizations,proto3" json:"organizations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyOrganizationsResponse) Reset() {
+	*x = ListMyOrganizationsResponse{}
+	mi := &file_user_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyOrganizationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyOrganizationsResponse) ProtoMessage() {}
+
+func (x *ListMyOrganizationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyOrganizationsResponse.ProtoReflect.Descriptor instead.
+func (*ListMyOrganizationsResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *ListMyOrganizationsResponse) GetOrganizations() []*OrganizationMembership {
+	if x != nil {
+		return x.Organizations
+	}
+	return nil
+}
+
+// Switch organization request
+type SwitchOrganizationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SwitchOrganizationRequest) Reset() {
+	*x = SwitchOrganizationRequest{}
+	mi := &file_user_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SwitchOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwitchOrganizationRequest) ProtoMessage() {}
+
+func (x *SwitchOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwitchOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*SwitchOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *SwitchOrganizationRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+// Switch organization response
+type SwitchOrganizationResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	AccessToken   string                  `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken  string                  `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	ExpiresIn     int64                   `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	Organization  *OrganizationMembership `protobuf:"bytes,4,opt,name=organization,proto3" json:"organization,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SwitchOrganizationResponse) Reset() {
+	*x = SwitchOrganizationResponse{}
+	mi := &file_user_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SwitchOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwitchOrganizationResponse) ProtoMessage() {}
+
+func (x *SwitchOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwitchOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*SwitchOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *SwitchOrganizationResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *SwitchOrganizationResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *SwitchOrganizationResponse) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+func (x *SwitchOrganizationResponse) GetOrganization() *OrganizationMembership {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+// Update organization member role request
+type UpdateOrganizationMemberRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrganizationMemberRoleRequest) Reset() {
+	*x = UpdateOrganizationMemberRoleRequest{}
+	mi := &file_user_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrganizationMemberRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrganizationMemberRoleRequest) ProtoMessage() {}
+
+func (x *UpdateOrganizationMemberRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrganizationMemberRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOrganizationMemberRoleRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *UpdateOrganizationMemberRoleRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *UpdateOrganizationMemberRoleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateOrganizationMemberRoleRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+// Update organization member role response
+type UpdateOrganizationMemberRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrganizationMemberRoleResponse) Reset() {
+	*x = UpdateOrganizationMemberRoleResponse{}
+	mi := &file_user_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrganizationMemberRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrganizationMemberRoleResponse) ProtoMessage() {}
+
+func (x *UpdateOrganizationMemberRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrganizationMemberRoleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateOrganizationMemberRoleResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *UpdateOrganizationMemberRoleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Forgot password request
+type ForgotPasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForgotPasswordRequest) Reset() {
+	*x = ForgotPasswordRequest{}
+	mi := &file_user_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForgotPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForgotPasswordRequest) ProtoMessage() {}
+
+func (x *ForgotPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForgotPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ForgotPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *ForgotPasswordRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+// Forgot password response
+type ForgotPasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForgotPasswordResponse) Reset() {
+	*x = ForgotPasswordResponse{}
+	mi := &file_user_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForgotPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForgotPasswordResponse) ProtoMessage() {}
+
+func (x *ForgotPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForgotPasswordResponse.ProtoReflect.Descriptor instead.
+func (*ForgotPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *ForgotPasswordResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Reset password with token request
+type ResetPasswordWithTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordWithTokenRequest) Reset() {
+	*x = ResetPasswordWithTokenRequest{}
+	mi := &file_user_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordWithTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordWithTokenRequest) ProtoMessage() {}
+
+func (x *ResetPasswordWithTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordWithTokenRequest.ProtoReflect.Descriptor instead.
+func (*ResetPasswordWithTokenRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *ResetPasswordWithTokenRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ResetPasswordWithTokenRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+// Reset password with token response
+type ResetPasswordWithTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordWithTokenResponse) Reset() {
+	*x = ResetPasswordWithTokenResponse{}
+	mi := &file_user_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordWithTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordWithTokenResponse) ProtoMessage() {}
+
+func (x *ResetPasswordWithTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordWithTokenResponse.ProtoReflect.Descriptor instead.
+func (*ResetPasswordWithTokenResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *ResetPasswordWithTokenResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Invite domain policy. With no allowed domains, invites may go to any
+// address and directly created members must use the primary domain.
+type InviteDomainPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	OrgId string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	// the organization's own domain, always allowed
+	PrimaryDomain  string   `protobuf:"bytes,2,opt,name=primary_domain,json=primaryDomain,proto3" json:"primary_domain,omitempty"`
+	AllowedDomains []string `protobuf:"bytes,3,rep,name=allowed_domains,json=allowedDomains,proto3" json:"allowed_domains,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InviteDomainPolicy) Reset() {
+	*x = InviteDomainPolicy{}
+	mi := &file_user_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteDomainPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteDomainPolicy) ProtoMessage() {}
+
+func (x *InviteDomainPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteDomainPolicy.ProtoReflect.Descriptor instead.
+func (*InviteDomainPolicy) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *InviteDomainPolicy) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *InviteDomainPolicy) GetPrimaryDomain() string {
+	if x != nil {
+		return x.PrimaryDomain
+	}
+	return ""
+}
+
+func (x *InviteDomainPolicy) GetAllowedDomains() []string {
+	if x != nil {
+		return x.AllowedDomains
+	}
+	return nil
+}
+
+// Get invite domain policy request
+type GetInviteDomainPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInviteDomainPolicyRequest) Reset() {
+	*x = GetInviteDomainPolicyRequest{}
+	mi := &file_user_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInviteDomainPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInviteDomainPolicyRequest) ProtoMessage() {}
+
+func (x *GetInviteDomainPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInviteDomainPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetInviteDomainPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *GetInviteDomainPolicyRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+// Update invite domain policy request
+type UpdateInviteDomainPolicyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	OrgId string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	// replaces the current list; empty removes the restriction
+	AllowedDomains []string `protobuf:"bytes,2,rep,name=allowed_domains,json=allowedDomains,proto3" json:"allowed_domains,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateInviteDomainPolicyRequest) Reset() {
+	*x = UpdateInviteDomainPolicyRequest{}
+	mi := &file_user_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateInviteDomainPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateInviteDomainPolicyRequest) ProtoMessage() {}
+
+func (x *UpdateInviteDomainPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateInviteDomainPolicyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateInviteDomainPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *UpdateInviteDomainPolicyRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *UpdateInviteDomainPolicyRequest) GetAllowedDomains() []string {
+	if x != nil {
+		return x.AllowedDomains
+	}
+	return nil
+}
+
+// Delete my account request
+type DeleteMyAccountRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// current password, confirming the request
+	Password string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	// "anonymize" (default) or "delete", as for RequestDataErasure
+	Mode          string `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMyAccountRequest) Reset() {
+	*x = DeleteMyAccountRequest{}
+	mi := &file_user_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMyAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMyAccountRequest) ProtoMessage() {}
+
+func (x *DeleteMyAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMyAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMyAccountRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *DeleteMyAccountRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *DeleteMyAccountRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+// Delete my account response
+type DeleteMyAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Request       *DataErasureRequest    `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMyAccountResponse) Reset() {
+	*x = DeleteMyAccountResponse{}
+	mi := &file_user_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMyAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMyAccountResponse) ProtoMessage() {}
+
+func (x *DeleteMyAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMyAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMyAccountResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *DeleteMyAccountResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DeleteMyAccountResponse) GetRequest() *DataErasureRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+// One extra profile field defined by an organization
+type ProfileField struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// lowercase identifier used as the attribute key, e.g. "department"
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// text, email, phone, url or slack
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// "members" (default) shows the value to everyone in the org; "admins"
+	// only to member admins and the member themself
+	Visibility    string `protobuf:"bytes,4,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProfileField) Reset() {
+	*x = ProfileField{}
+	mi := &file_user_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfileField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileField) ProtoMessage() {}
+
+func (x *ProfileField) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileField.ProtoReflect.Descriptor instead.
+func (*ProfileField) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *ProfileField) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ProfileField) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ProfileField) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ProfileField) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+// An organization's extra profile fields
+type ProfileFieldSchema struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Fields        []*ProfileField        `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProfileFieldSchema) Reset() {
+	*x = ProfileFieldSchema{}
+	mi := &file_user_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfileFieldSchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileFieldSchema) ProtoMessage() {}
+
+func (x *ProfileFieldSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileFieldSchema.ProtoReflect.Descriptor instead.
+func (*ProfileFieldSchema) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *ProfileFieldSchema) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *ProfileFieldSchema) GetFields() []*ProfileField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// Get profile fields request
+type GetProfileFieldsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileFieldsRequest) Reset() {
+	*x = GetProfileFieldsRequest{}
+	mi := &file_user_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileFieldsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileFieldsRequest) ProtoMessage() {}
+
+func (x *GetProfileFieldsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileFieldsRequest.ProtoReflect.Descriptor instead.
+func (*GetProfileFieldsRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *GetProfileFieldsRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+// Update profile fields request
+type UpdateProfileFieldsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	OrgId string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	// replaces the current fields; values of removed fields are hidden
+	Fields        []*ProfileField `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileFieldsRequest) Reset() {
+	*x = UpdateProfileFieldsRequest{}
+	mi := &file_user_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileFieldsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileFieldsRequest) ProtoMessage() {}
+
+func (x *UpdateProfileFieldsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileFieldsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProfileFieldsRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *UpdateProfileFieldsRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *UpdateProfileFieldsRequest) GetFields() []*ProfileField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// Update profile attributes request
+type UpdateProfileAttributesRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	OrgId  string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	UserId string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// values to set; an empty value clears the field
+	Attributes    map[string]string `protobuf:"bytes,3,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileAttributesRequest) Reset() {
+	*x = UpdateProfileAttributesRequest{}
+	mi := &file_user_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileAttributesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileAttributesRequest) ProtoMessage() {}
+
+func (x *UpdateProfileAttributesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileAttributesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProfileAttributesRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *UpdateProfileAttributesRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *UpdateProfileAttributesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateProfileAttributesRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// Update profile attributes response
+type UpdateProfileAttributesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the member's values for the organization's fields after the update
+	Attributes    map[string]string `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileAttributesResponse) Reset() {
+	*x = UpdateProfileAttributesResponse{}
+	mi := &file_user_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileAttributesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileAttributesResponse) ProtoMessage() {}
+
+func (x *UpdateProfileAttributesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileAttributesResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProfileAttributesResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *UpdateProfileAttributesResponse) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// An organization's sign-in security settings
+type OrgSecuritySettings struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	OrgId string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	// Notify members when they sign in from a new device or network
+	NewDeviceAlerts bool `protobuf:"varint,2,opt,name=new_device_alerts,json=newDeviceAlerts,proto3" json:"new_device_alerts,omitempty"`
+	// Admins must sign in with a passkey once they have registered one
+	RequirePasskeysForAdmins bool `protobuf:"varint,3,opt,name=require_passkeys_for_admins,json=requirePasskeysForAdmins,proto3" json:"require_passkeys_for_admins,omitempty"`
+	// CIDR ranges members may connect from; empty allows any address
+	IpAllowlist   []string `protobuf:"bytes,4,rep,name=ip_allowlist,json=ipAllowlist,proto3" json:"ip_allowlist,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrgSecuritySettings) Reset() {
+	*x = OrgSecuritySettings{}
+	mi := &file_user_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrgSecuritySettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrgSecuritySettings) ProtoMessage() {}
+
+func (x *OrgSecuritySettings) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrgSecuritySettings.ProtoReflect.Descriptor instead.
+func (*OrgSecuritySettings) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *OrgSecuritySettings) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *OrgSecuritySettings) GetNewDeviceAlerts() bool {
+	if x != nil {
+		return x.NewDeviceAlerts
+	}
+	return false
+}
+
+func (x *OrgSecuritySettings) GetRequirePasskeysForAdmins() bool {
+	if x != nil {
+		return x.RequirePasskeysForAdmins
+	}
+	return false
+}
+
+func (x *OrgSecuritySettings) GetIpAllowlist() []string {
+	if x != nil {
+		return x.IpAllowlist
+	}
+	return nil
+}
+
+// A set of CIDR ranges, e.g. "203.0.113.0/24"; bare addresses are single
+// hosts
+type IPAllowlist struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cidrs         []string               `protobuf:"bytes,1,rep,name=cidrs,proto3" json:"cidrs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IPAllowlist) Reset() {
+	*x = IPAllowlist{}
+	mi := &file_user_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IPAllowlist) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IPAllowlist) ProtoMessage() {}
+
+func (x *IPAllowlist) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IPAllowlist.ProtoReflect.Descriptor instead.
+func (*IPAllowlist) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *IPAllowlist) GetCidrs() []string {
+	if x != nil {
+		return x.Cidrs
+	}
+	return nil
+}
+
+// Get org security settings request
+type GetOrgSecuritySettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrgSecuritySettingsRequest) Reset() {
+	*x = GetOrgSecuritySettingsRequest{}
+	mi := &file_user_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrgSecuritySettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrgSecuritySettingsRequest) ProtoMessage() {}
+
+func (x *GetOrgSecuritySettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrgSecuritySettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetOrgSecuritySettingsRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *GetOrgSecuritySettingsRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+// Update org security settings request; unset fields are left unchanged
+type UpdateOrgSecuritySettingsRequest struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	OrgId                    string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	NewDeviceAlerts          *bool                  `protobuf:"varint,2,opt,name=new_device_alerts,json=newDeviceAlerts,proto3,oneof" json:"new_device_alerts,omitempty"`
+	RequirePasskeysForAdmins *bool                  `protobuf:"varint,3,opt,name=require_passkeys_for_admins,json=requirePasskeysForAdmins,proto3,oneof" json:"require_passkeys_for_admins,omitempty"`
+	// Replaces the allowlist; an empty list removes the restriction
+	IpAllowlist   *IPAllowlist `protobuf:"bytes,4,opt,name=ip_allowlist,json=ipAllowlist,proto3" json:"ip_allowlist,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrgSecuritySettingsRequest) Reset() {
+	*x = UpdateOrgSecuritySettingsRequest{}
+	mi := &file_user_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrgSecuritySettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrgSecuritySettingsRequest) ProtoMessage() {}
+
+func (x *UpdateOrgSecuritySettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrgSecuritySettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOrgSecuritySettingsRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *UpdateOrgSecuritySettingsRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *UpdateOrgSecuritySettingsRequest) GetNewDeviceAlerts() bool {
+	if x != nil && x.NewDeviceAlerts != nil {
+		return *x.NewDeviceAlerts
+	}
+	return false
+}
+
+func (x *UpdateOrgSecuritySettingsRequest) GetRequirePasskeysForAdmins() bool {
+	if x != nil && x.RequirePasskeysForAdmins != nil {
+		return *x.RequirePasskeysForAdmins
+	}
+	return false
+}
+
+func (x *UpdateOrgSecuritySettingsRequest) GetIpAllowlist() *IPAllowlist {
+	if x != nil {
+		return x.IpAllowlist
+	}
+	return nil
+}
+
+// A registered passkey (WebAuthn credential)
+type Passkey struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PasskeyId string                 `protobuf:"bytes,1,opt,name=passkey_id,json=passkeyId,proto3" json:"passkey_id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// base64url credential ID, as the browser reports it
+	CredentialId string   `protobuf:"bytes,3,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
+	Transports   []string `protobuf:"bytes,4,rep,name=transports,proto3" json:"transports,omitempty"`
+	// Synced between the user's devices by their password manager
+	BackupEligible bool                   `protobuf:"varint,5,opt,name=backup_eligible,json=backupEligible,proto3" json:"backup_eligible,omitempty"`
+	LastUsedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Passkey) Reset() {
+	*x = Passkey{}
+	mi := &file_user_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Passkey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Passkey) ProtoMessage() {}
+
+func (x *Passkey) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Passkey.ProtoReflect.Descriptor instead.
+func (*Passkey) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *Passkey) GetPasskeyId() string {
+	if x != nil {
+		return x.PasskeyId
+	}
+	return ""
+}
+
+func (x *Passkey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Passkey) GetCredentialId() string {
+	if x != nil {
+		return x.CredentialId
+	}
+	return ""
+}
+
+func (x *Passkey) GetTransports() []string {
+	if x != nil {
+		return x.Transports
+	}
+	return nil
+}
+
+func (x *Passkey) GetBackupEligible() bool {
+	if x != nil {
+		return x.BackupEligible
+	}
+	return false
+}
+
+func (x *Passkey) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+func (x *Passkey) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Begin passkey registration request
+type BeginPasskeyRegistrationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginPasskeyRegistrationRequest) Reset() {
+	*x = BeginPasskeyRegistrationRequest{}
+	mi := &file_user_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginPasskeyRegistrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginPasskeyRegistrationRequest) ProtoMessage() {}
+
+func (x *BeginPasskeyRegistrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginPasskeyRegistrationRequest.ProtoReflect.Descriptor instead.
+func (*BeginPasskeyRegistrationRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{142}
+}
+
+// Options for navigator.credentials.create(); binary values are base64url
+type PasskeyRegistrationOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Identifies the ceremony when finishing it
+	SessionId       string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Challenge       string `protobuf:"bytes,2,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	RpId            string `protobuf:"bytes,3,opt,name=rp_id,json=rpId,proto3" json:"rp_id,omitempty"`
+	RpName          string `protobuf:"bytes,4,opt,name=rp_name,json=rpName,proto3" json:"rp_name,omitempty"`
+	UserHandle      string `protobuf:"bytes,5,opt,name=user_handle,json=userHandle,proto3" json:"user_handle,omitempty"`
+	UserName        string `protobuf:"bytes,6,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	UserDisplayName string `protobuf:"bytes,7,opt,name=user_display_name,json=userDisplayName,proto3" json:"user_display_name,omitempty"`
+	// Passkeys the user already has, so the authenticator isn't registered twice
+	ExcludeCredentialIds []string `protobuf:"bytes,8,rep,name=exclude_credential_ids,json=excludeCredentialIds,proto3" json:"exclude_credential_ids,omitempty"`
+	TimeoutMs            int64    `protobuf:"varint,9,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	// "required" or "preferred"
+	UserVerification string `protobuf:"bytes,10,opt,name=user_verification,json=userVerification,proto3" json:"user_verification,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PasskeyRegistrationOptions) Reset() {
+	*x = PasskeyRegistrationOptions{}
+	mi := &file_user_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PasskeyRegistrationOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasskeyRegistrationOptions) ProtoMessage() {}
+
+func (x *PasskeyRegistrationOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasskeyRegistrationOptions.ProtoReflect.Descriptor instead.
+func (*PasskeyRegistrationOptions) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *PasskeyRegistrationOptions) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *PasskeyRegistrationOptions) GetChallenge() string {
+	if x != nil {
+		return x.Challenge
+	}
+	return ""
+}
+
+func (x *PasskeyRegistrationOptions) GetRpId() string {
+	if x != nil {
+		return x.RpId
+	}
+	return ""
+}
+
+func (x *PasskeyRegistrationOptions) GetRpName() string {
+	if x != nil {
+		return x.RpName
+	}
+	return ""
+}
+
+func (x *PasskeyRegistrationOptions) GetUserHandle() string {
+	if x != nil {
+		return x.UserHandle
+	}
+	return ""
+}
+
+func (x *PasskeyRegistrationOptions) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *PasskeyRegistrationOptions) GetUserDisplayName() string {
+	if x != nil {
+		return x.UserDisplayName
+	}
+	return ""
+}
+
+func (x *PasskeyRegistrationOptions) GetExcludeCredentialIds() []string {
+	if x != nil {
+		return x.ExcludeCredentialIds
+	}
+	return nil
+}
+
+func (x *PasskeyRegistrationOptions) GetTimeoutMs() int64 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+func (x *PasskeyRegistrationOptions) GetUserVerification() string {
+	if x != nil {
+		return x.UserVerification
+	}
+	return ""
+}
+
+// Finish passkey registration request; binary values are base64url
+type FinishPasskeyRegistrationRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SessionId string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Label shown in the passkey list, such as "Work laptop"
+	Name              string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CredentialId      string   `protobuf:"bytes,3,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
+	ClientDataJson    string   `protobuf:"bytes,4,opt,name=client_data_json,json=clientDataJson,proto3" json:"client_data_json,omitempty"`
+	AttestationObject string   `protobuf:"bytes,5,opt,name=attestation_object,json=attestationObject,proto3" json:"attestation_object,omitempty"`
+	Transports        []string `protobuf:"bytes,6,rep,name=transports,proto3" json:"transports,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *FinishPasskeyRegistrationRequest) Reset() {
+	*x = FinishPasskeyRegistrationRequest{}
+	mi := &file_user_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishPasskeyRegistrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishPasskeyRegistrationRequest) ProtoMessage() {}
+
+func (x *FinishPasskeyRegistrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishPasskeyRegistrationRequest.ProtoReflect.Descriptor instead.
+func (*FinishPasskeyRegistrationRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *FinishPasskeyRegistrationRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *FinishPasskeyRegistrationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FinishPasskeyRegistrationRequest) GetCredentialId() string {
+	if x != nil {
+		return x.CredentialId
+	}
+	return ""
+}
+
+func (x *FinishPasskeyRegistrationRequest) GetClientDataJson() string {
+	if x != nil {
+		return x.ClientDataJson
+	}
+	return ""
+}
+
+func (x *FinishPasskeyRegistrationRequest) GetAttestationObject() string {
+	if x != nil {
+		return x.AttestationObject
+	}
+	return ""
+}
+
+func (x *FinishPasskeyRegistrationRequest) GetTransports() []string {
+	if x != nil {
+		return x.Transports
+	}
+	return nil
+}
+
+// List passkeys request
+type ListPasskeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPasskeysRequest) Reset() {
+	*x = ListPasskeysRequest{}
+	mi := &file_user_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPasskeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPasskeysRequest) ProtoMessage() {}
+
+func (x *ListPasskeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPasskeysRequest.ProtoReflect.Descriptor instead.
+func (*ListPasskeysRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{145}
+}
+
+// List passkeys response
+type ListPasskeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Passkeys      []*Passkey             `protobuf:"bytes,1,rep,name=passkeys,proto3" json:"passkeys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPasskeysResponse) Reset() {
+	*x = ListPasskeysResponse{}
+	mi := &file_user_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPasskeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPasskeysResponse) ProtoMessage() {}
+
+func (x *ListPasskeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPasskeysResponse.ProtoReflect.Descriptor instead.
+func (*ListPasskeysResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *ListPasskeysResponse) GetPasskeys() []*Passkey {
+	if x != nil {
+		return x.Passkeys
+	}
+	return nil
+}
+
+// Delete passkey request
+type DeletePasskeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PasskeyId     string                 `protobuf:"bytes,1,opt,name=passkey_id,json=passkeyId,proto3" json:"passkey_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePasskeyRequest) Reset() {
+	*x = DeletePasskeyRequest{}
+	mi := &file_user_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePasskeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePasskeyRequest) ProtoMessage() {}
+
+func (x *DeletePasskeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePasskeyRequest.ProtoReflect.Descriptor instead.
+func (*DeletePasskeyRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *DeletePasskeyRequest) GetPasskeyId() string {
+	if x != nil {
+		return x.PasskeyId
+	}
+	return ""
+}
+
+// Delete passkey response
+type DeletePasskeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePasskeyResponse) Reset() {
+	*x = DeletePasskeyResponse{}
+	mi := &file_user_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePasskeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePasskeyResponse) ProtoMessage() {}
+
+func (x *DeletePasskeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePasskeyResponse.ProtoReflect.Descriptor instead.
+func (*DeletePasskeyResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *DeletePasskeyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// A signed-in session: the sign-in a chain of rotated refresh tokens came
+// from
+type Session struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	SessionId  string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	OrgId      string                 `protobuf:"bytes,2,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	DeviceId   string                 `protobuf:"bytes,3,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	UserAgent  string                 `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	IpAddress  string                 `protobuf:"bytes,5,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	SignedInAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=signed_in_at,json=signedInAt,proto3" json:"signed_in_at,omitempty"`
+	// When the session last refreshed its tokens
+	LastRefreshedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_refreshed_at,json=lastRefreshedAt,proto3" json:"last_refreshed_at,omitempty"`
+	ExpiresAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Session) Reset() {
+	*x = Session{}
+	mi := &file_user_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *Session) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *Session) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *Session) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *Session) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *Session) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *Session) GetSignedInAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SignedInAt
+	}
+	return nil
+}
+
+func (x *Session) GetLastRefreshedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRefreshedAt
+	}
+	return nil
+}
+
+func (x *Session) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// List sessions request
+type ListSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsRequest) Reset() {
+	*x = ListSessionsRequest{}
+	mi := &file_user_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsRequest) ProtoMessage() {}
+
+func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{150}
+}
+
+// List sessions response
+type ListSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*Session             `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
+	mi := &file_user_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsResponse) ProtoMessage() {}
+
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *ListSessionsResponse) GetSessions() []*Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+// Revoke session request
+type RevokeSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionRequest) Reset() {
+	*x = RevokeSessionRequest{}
+	mi := &file_user_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionRequest) ProtoMessage() {}
+
+func (x *RevokeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *RevokeSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+// Revoke session response
+type RevokeSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionResponse) Reset() {
+	*x = RevokeSessionResponse{}
+	mi := &file_user_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionResponse) ProtoMessage() {}
+
+func (x *RevokeSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionResponse.ProtoReflect.Descriptor instead.
+func (*RevokeSessionResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *RevokeSessionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Begin passkey sign-in request. Without an email the browser offers any
+// passkey it holds for this site.
+type BeginPasskeyLoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginPasskeyLoginRequest) Reset() {
+	*x = BeginPasskeyLoginRequest{}
+	mi := &file_user_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginPasskeyLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginPasskeyLoginRequest) ProtoMessage() {}
+
+func (x *BeginPasskeyLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginPasskeyLoginRequest.ProtoReflect.Descriptor instead.
+func (*BeginPasskeyLoginRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *BeginPasskeyLoginRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+// Options for navigator.credentials.get(); binary values are base64url
+type PasskeyLoginOptions struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	SessionId          string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Challenge          string                 `protobuf:"bytes,2,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	RpId               string                 `protobuf:"bytes,3,opt,name=rp_id,json=rpId,proto3" json:"rp_id,omitempty"`
+	AllowCredentialIds []string               `protobuf:"bytes,4,rep,name=allow_credential_ids,json=allowCredentialIds,proto3" json:"allow_credential_ids,omitempty"`
+	TimeoutMs          int64                  `protobuf:"varint,5,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	UserVerification   string                 `protobuf:"bytes,6,opt,name=user_verification,json=userVerification,proto3" json:"user_verification,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *PasskeyLoginOptions) Reset() {
+	*x = PasskeyLoginOptions{}
+	mi := &file_user_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PasskeyLoginOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasskeyLoginOptions) ProtoMessage() {}
+
+func (x *PasskeyLoginOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasskeyLoginOptions.ProtoReflect.Descriptor instead.
+func (*PasskeyLoginOptions) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *PasskeyLoginOptions) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *PasskeyLoginOptions) GetChallenge() string {
+	if x != nil {
+		return x.Challenge
+	}
+	return ""
+}
+
+func (x *PasskeyLoginOptions) GetRpId() string {
+	if x != nil {
+		return x.RpId
+	}
+	return ""
+}
+
+func (x *PasskeyLoginOptions) GetAllowCredentialIds() []string {
+	if x != nil {
+		return x.AllowCredentialIds
+	}
+	return nil
+}
+
+func (x *PasskeyLoginOptions) GetTimeoutMs() int64 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+func (x *PasskeyLoginOptions) GetUserVerification() string {
+	if x != nil {
+		return x.UserVerification
+	}
+	return ""
+}
+
+// Finish passkey sign-in request; binary values are base64url
+type FinishPasskeyLoginRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SessionId         string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	CredentialId      string                 `protobuf:"bytes,2,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
+	ClientDataJson    string                 `protobuf:"bytes,3,opt,name=client_data_json,json=clientDataJson,proto3" json:"client_data_json,omitempty"`
+	AuthenticatorData string                 `protobuf:"bytes,4,opt,name=authenticator_data,json=authenticatorData,proto3" json:"authenticator_data,omitempty"`
+	Signature         string                 `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	UserHandle        string                 `protobuf:"bytes,6,opt,name=user_handle,json=userHandle,proto3" json:"user_handle,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *FinishPasskeyLoginRequest) Reset() {
+	*x = FinishPasskeyLoginRequest{}
+	mi := &file_user_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishPasskeyLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishPasskeyLoginRequest) ProtoMessage() {}
+
+func (x *FinishPasskeyLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[156]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishPasskeyLoginRequest.ProtoReflect.Descriptor instead.
+func (*FinishPasskeyLoginRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *FinishPasskeyLoginRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *FinishPasskeyLoginRequest) GetCredentialId() string {
+	if x != nil {
+		return x.CredentialId
+	}
+	return ""
+}
+
+func (x *FinishPasskeyLoginRequest) GetClientDataJson() string {
+	if x != nil {
+		return x.ClientDataJson
+	}
+	return ""
+}
+
+func (x *FinishPasskeyLoginRequest) GetAuthenticatorData() string {
+	if x != nil {
+		return x.AuthenticatorData
+	}
+	return ""
+}
+
+func (x *FinishPasskeyLoginRequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *FinishPasskeyLoginRequest) GetUserHandle() string {
+	if x != nil {
+		return x.UserHandle
+	}
+	return ""
+}
+
+// Get security dashboard request
+type GetSecurityDashboardRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	OrgId string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	// Members who haven't signed in for this many days are stale (default 90)
+	StaleAfterDays int32 `protobuf:"varint,2,opt,name=stale_after_days,json=staleAfterDays,proto3" json:"stale_after_days,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetSecurityDashboardRequest) Reset() {
+	*x = GetSecurityDashboardRequest{}
+	mi := &file_user_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSecurityDashboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecurityDashboardRequest) ProtoMessage() {}
+
+func (x *GetSecurityDashboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[157]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSecurityDashboardRequest.ProtoReflect.Descriptor instead.
+func (*GetSecurityDashboardRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *GetSecurityDashboardRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *GetSecurityDashboardRequest) GetStaleAfterDays() int32 {
+	if x != nil {
+		return x.StaleAfterDays
+	}
+	return 0
+}
+
+// Accounts in an organization that need an admin's attention. Service
+// accounts are not included.
+type SecurityDashboard struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	OrgId string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	// Invites that have not been accepted, revoked or expired
+	PendingInvites []*Invite `protobuf:"bytes,2,rep,name=pending_invites,json=pendingInvites,proto3" json:"pending_invites,omitempty"`
+	// Accounts currently locked after failed sign-ins
+	LockedAccounts []*OrganizationMember `protobuf:"bytes,3,rep,name=locked_accounts,json=lockedAccounts,proto3" json:"locked_accounts,omitempty"`
+	// Active members without a passkey
+	WithoutMfa []*OrganizationMember `protobuf:"bytes,4,rep,name=without_mfa,json=withoutMfa,proto3" json:"without_mfa,omitempty"`
+	// Active members who have never signed in
+	NeverLoggedIn []*OrganizationMember `protobuf:"bytes,5,rep,name=never_logged_in,json=neverLoggedIn,proto3" json:"never_logged_in,omitempty"`
+	// Active members whose last sign-in is older than stale_after_days
+	StaleAccounts  []*OrganizationMember  `protobuf:"bytes,6,rep,name=stale_accounts,json=staleAccounts,proto3" json:"stale_accounts,omitempty"`
+	StaleAfterDays int32                  `protobuf:"varint,7,opt,name=stale_after_days,json=staleAfterDays,proto3" json:"stale_after_days,omitempty"`
+	MemberCount    int32                  `protobuf:"varint,8,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
+	GeneratedAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SecurityDashboard) Reset() {
+	*x = SecurityDashboard{}
+	mi := &file_user_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecurityDashboard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecurityDashboard) ProtoMessage() {}
+
+func (x *SecurityDashboard) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[158]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecurityDashboard.ProtoReflect.Descriptor instead.
+func (*SecurityDashboard) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *SecurityDashboard) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *SecurityDashboard) GetPendingInvites() []*Invite {
+	if x != nil {
+		return x.PendingInvites
+	}
+	return nil
+}
+
+func (x *SecurityDashboard) GetLockedAccounts() []*OrganizationMember {
+	if x != nil {
+		return x.LockedAccounts
+	}
+	return nil
+}
+
+func (x *SecurityDashboard) GetWithoutMfa() []*OrganizationMember {
+	if x != nil {
+		return x.WithoutMfa
+	}
+	return nil
+}
+
+func (x *SecurityDashboard) GetNeverLoggedIn() []*OrganizationMember {
+	if x != nil {
+		return x.NeverLoggedIn
+	}
+	return nil
+}
+
+func (x *SecurityDashboard) GetStaleAccounts() []*OrganizationMember {
+	if x != nil {
+		return x.StaleAccounts
+	}
+	return nil
+}
+
+func (x *SecurityDashboard) GetStaleAfterDays() int32 {
+	if x != nil {
+		return x.StaleAfterDays
+	}
+	return 0
+}
+
+func (x *SecurityDashboard) GetMemberCount() int32 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+func (x *SecurityDashboard) GetGeneratedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return nil
+}
+
+// Get org IP allowlist request
+type GetOrgIPAllowlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrgIPAllowlistRequest) Reset() {
+	*x = GetOrgIPAllowlistRequest{}
+	mi := &file_user_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrgIPAllowlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrgIPAllowlistRequest) ProtoMessage() {}
+
+func (x *GetOrgIPAllowlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[159]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrgIPAllowlistRequest.ProtoReflect.Descriptor instead.
+func (*GetOrgIPAllowlistRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *GetOrgIPAllowlistRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+// Get org IP allowlist response; empty cidrs allow any address
+type GetOrgIPAllowlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cidrs         []string               `protobuf:"bytes,1,rep,name=cidrs,proto3" json:"cidrs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrgIPAllowlistResponse) Reset() {
+	*x = GetOrgIPAllowlistResponse{}
+	mi := &file_user_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrgIPAllowlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrgIPAllowlistResponse) ProtoMessage() {}
+
+func (x *GetOrgIPAllowlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[160]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrgIPAllowlistResponse.ProtoReflect.Descriptor instead.
+func (*GetOrgIPAllowlistResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *GetOrgIPAllowlistResponse) GetCidrs() []string {
+	if x != nil {
+		return x.Cidrs
+	}
+	return nil
+}
+
+// Record blocked access request
+type RecordBlockedAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IpAddress     string                 `protobuf:"bytes,3,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	Method        string                 `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	Path          string                 `protobuf:"bytes,5,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordBlockedAccessRequest) Reset() {
+	*x = RecordBlockedAccessRequest{}
+	mi := &file_user_proto_msgTypes[161]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordBlockedAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordBlockedAccessRequest) ProtoMessage() {}
+
+func (x *RecordBlockedAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[161]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordBlockedAccessRequest.ProtoReflect.Descriptor instead.
+func (*RecordBlockedAccessRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *RecordBlockedAccessRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *RecordBlockedAccessRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RecordBlockedAccessRequest) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *RecordBlockedAccessRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *RecordBlockedAccessRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+// Record blocked access response
+type RecordBlockedAccessResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordBlockedAccessResponse) Reset() {
+	*x = RecordBlockedAccessResponse{}
+	mi := &file_user_proto_msgTypes[162]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordBlockedAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordBlockedAccessResponse) ProtoMessage() {}
+
+func (x *RecordBlockedAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[162]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordBlockedAccessResponse.ProtoReflect.Descriptor instead.
+func (*RecordBlockedAccessResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{162}
+}
+
+var File_user_proto protoreflect.FileDescriptor
+
+const file_user_proto_rawDesc = "" +
+	"\n" +
+	"\n" +
+	"user.proto\x12\x04user\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd0\x01\n" +
+	"\rInviteRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12#\n" +
+	"\rexpires_hours\x18\x04 \x01(\x05R\fexpiresHours\x12\x1d\n" +
+	"\n" +
+	"scope_type\x18\x05 \x01(\tR\tscopeType\x12\x19\n" +
+	"\bscope_id\x18\x06 \x01(\tR\ascopeId\x12\x1f\n" +
+	"\vaccess_days\x18\a \x01(\x05R\n" +
+	"accessDays\"]\n" +
+	"\x0eInviteResponse\x12\x1b\n" +
+	"\tinvite_id\x18\x01 \x01(\tR\binviteId\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\"\x80\x01\n" +
+	"\x13AcceptInviteRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x12\x1b\n" +
+	"\tfull_name\x18\x04 \x01(\tR\bfullName\"P\n" +
+	"\x14AcceptInviteResponse\x12\x1e\n" +
+	"\x04user\x18\x01 \x01(\v2\n" +
+	".user.UserR\x04user\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xb9\x05\n" +
+	"\x06Invite\x12\x1b\n" +
+	"\tinvite_id\x18\x01 \x01(\tR\binviteId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x15\n" +
+	"\x06org_id\x18\x03 \x01(\tR\x05orgId\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x123\n" +
+	"\aused_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x06usedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\a \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"revoked_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\trevokedAt\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"send_count\x18\v \x01(\x05R\tsendCount\x12'\n" +
+	"\x0fdelivery_status\x18\f \x01(\tR\x0edeliveryStatus\x12+\n" +
+	"\x11delivery_attempts\x18\r \x01(\x05R\x10deliveryAttempts\x12%\n" +
+	"\x0edelivery_error\x18\x0e \x01(\tR\rdeliveryError\x12=\n" +
+	"\fdelivered_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\vdeliveredAt\x12\x1d\n" +
+	"\n" +
+	"scope_type\x18\x10 \x01(\tR\tscopeType\x12\x19\n" +
+	"\bscope_id\x18\x11 \x01(\tR\ascopeId\x12\x1f\n" +
+	"\vaccess_days\x18\x12 \x01(\x05R\n" +
+	"accessDays\"\\\n" +
+	"\x12ListInvitesRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\x8f\x01\n" +
+	"\x13ListInvitesResponse\x12&\n" +
+	"\ainvites\x18\x01 \x03(\v2\f.user.InviteR\ainvites\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x88\x02\n" +
+	"\x04User\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x1b\n" +
+	"\tfull_name\x18\x04 \x01(\tR\bfullName\x12\"\n" +
+	"\x04role\x18\x05 \x01(\x0e2\x0e.user.UserRoleR\x04role\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xa0\x01\n" +
+	"\x0fRegisterRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x12\x1b\n" +
+	"\tfull_name\x18\x04 \x01(\tR\bfullName\x12\"\n" +
+	"\x04role\x18\x05 \x01(\x0e2\x0e.user.UserRoleR\x04role\"L\n" +
+	"\x10RegisterResponse\x12\x1e\n" +
+	"\x04user\x18\x01 \x01(\v2\n" +
+	".user.UserR\x04user\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"@\n" +
+	"\fLoginRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"\xf9\x02\n" +
+	"\rLoginResponse\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12\x1e\n" +
+	"\x04user\x18\x03 \x01(\v2\n" +
+	".user.UserR\x04user\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x04 \x01(\x03R\texpiresIn\x120\n" +
+	"\x14must_change_password\x18\x05 \x01(\bR\x12mustChangePassword\x12=\n" +
+	"\x1bmust_set_security_questions\x18\x06 \x01(\bR\x18mustSetSecurityQuestions\x12<\n" +
+	"\x1aaccount_deletion_cancelled\x18\a \x01(\bR\x18accountDeletionCancelled\x122\n" +
+	"\x15must_register_passkey\x18\b \x01(\bR\x13mustRegisterPasskey\")\n" +
+	"\x0eGetUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"1\n" +
+	"\x0fGetUserResponse\x12\x1e\n" +
+	"\x04user\x18\x01 \x01(\v2\n" +
+	".user.UserR\x04user\"\x9f\x01\n" +
+	"\x11UpdateUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x1b\n" +
+	"\tfull_name\x18\x04 \x01(\tR\bfullName\x12\"\n" +
+	"\x04role\x18\x05 \x01(\x0e2\x0e.user.UserRoleR\x04role\"N\n" +
+	"\x12UpdateUserResponse\x12\x1e\n" +
+	"\x04user\x18\x01 \x01(\v2\n" +
+	".user.UserR\x04user\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\",\n" +
+	"\x11DeleteUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\".\n" +
+	"\x12DeleteUserResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"d\n" +
+	"\x10ListUsersRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1f\n" +
+	"\vrole_filter\x18\x03 \x01(\tR\n" +
+	"roleFilter\"\x87\x01\n" +
+	"\x11ListUsersResponse\x12 \n" +
+	"\x05users\x18\x01 \x03(\v2\n" +
+	".user.UserR\x05users\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\",\n" +
+	"\x14ValidateTokenRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\x84\x01\n" +
+	"\x15ValidateTokenResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\"\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x0e.user.UserRoleR\x04role\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\xb2\x01\n" +
+	"\fOrganization\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12!\n" +
+	"\fmember_count\x18\x05 \x01(\x05R\vmemberCount\"\xca\x01\n" +
+	"\x1bRegisterOrganizationRequest\x12\x19\n" +
+	"\borg_name\x18\x01 \x01(\tR\aorgName\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1f\n" +
+	"\vadmin_email\x18\x03 \x01(\tR\n" +
+	"adminEmail\x12%\n" +
+	"\x0eadmin_password\x18\x04 \x01(\tR\radminPassword\x12&\n" +
+	"\x0fadmin_full_name\x18\x05 \x01(\tR\radminFullName\"\xb5\x01\n" +
+	"\x1cRegisterOrganizationResponse\x126\n" +
+	"\forganization\x18\x01 \x01(\v2\x12.user.OrganizationR\forganization\x12 \n" +
+	"\x05admin\x18\x02 \x01(\v2\n" +
+	".user.UserR\x05admin\x12!\n" +
+	"\faccess_token\x18\x03 \x01(\tR\vaccessToken\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\x1d\n" +
+	"\x1bListAllOrganizationsRequest\"X\n" +
+	"\x1cListAllOrganizationsResponse\x128\n" +
+	"\rorganizations\x18\x01 \x03(\v2\x12.user.OrganizationR\rorganizations\"\x1d\n" +
+	"\x1bGetPlatformAnalyticsRequest\"\xbf\x01\n" +
+	"\x1cGetPlatformAnalyticsResponse\x12/\n" +
+	"\x13total_organizations\x18\x01 \x01(\x03R\x12totalOrganizations\x12\x1f\n" +
+	"\vtotal_users\x18\x02 \x01(\x03R\n" +
 	"totalUsers\x12,\n" +
 	"\x12active_users_today\x18\x03 \x01(\x03R\x10activeUsersToday\x12\x1f\n" +
 	"\vtotal_tasks\x18\x04 \x01(\x03R\n" +
@@ -3327,7 +10253,7 @@ const file_user_proto_rawDesc = "" +
 	"\x1aDeleteOrganizationResponse\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\"7\n" +
 	"\x1eListOrganizationMembersRequest\x12\x15\n" +
-	"\x06org_id\x18\x01 \x01(\tR\x05orgId\"\xbd\x03\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\"\x8e\x06\n" +
 	"\x12OrganizationMember\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1a\n" +
@@ -3342,7 +10268,14 @@ const file_user_proto_rawDesc = "" +
 	"\x14must_change_password\x18\t \x01(\bR\x12mustChangePassword\x122\n" +
 	"\x15failed_login_attempts\x18\n" +
 	" \x01(\x05R\x13failedLoginAttempts\x124\n" +
-	"\x16has_security_questions\x18\v \x01(\bR\x14hasSecurityQuestions\"U\n" +
+	"\x16has_security_questions\x18\v \x01(\bR\x14hasSecurityQuestions\x12=\n" +
+	"\flocked_until\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\vlockedUntil\x12=\n" +
+	"\fsuspended_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\vsuspendedAt\x12+\n" +
+	"\x11suspension_reason\x18\x0e \x01(\tR\x10suspensionReason\x12^\n" +
+	"\x12profile_attributes\x18\x0f \x03(\v2/.user.OrganizationMember.ProfileAttributesEntryR\x11profileAttributes\x1aD\n" +
+	"\x16ProfileAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"U\n" +
 	"\x1fListOrganizationMembersResponse\x122\n" +
 	"\amembers\x18\x01 \x03(\v2\x18.user.OrganizationMemberR\amembers\"Q\n" +
 	"\x1fRemoveOrganizationMemberRequest\x12\x15\n" +
@@ -3392,11 +10325,526 @@ const file_user_proto_rawDesc = "" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\"b\n" +
 	"\x1aAdminResetPasswordResponse\x12*\n" +
 	"\x11new_temp_password\x18\x01 \x01(\tR\x0fnewTempPassword\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage*P\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"N\n" +
+	"\x17ResolveUsernamesRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x1c\n" +
+	"\tusernames\x18\x02 \x03(\tR\tusernames\"<\n" +
+	"\x18ResolveUsernamesResponse\x12 \n" +
+	"\x05users\x18\x01 \x03(\v2\n" +
+	".user.UserR\x05users\":\n" +
+	"\x13RefreshTokenRequest\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"\x9d\x01\n" +
+	"\x14RefreshTokenResponse\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12\x1e\n" +
+	"\x04user\x18\x03 \x01(\v2\n" +
+	".user.UserR\x04user\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x04 \x01(\x03R\texpiresIn\"C\n" +
+	"\x11UnlockUserRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\".\n" +
+	"\x12UnlockUserResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\x9e\x03\n" +
+	"\rAuditLogEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x15\n" +
+	"\x06org_id\x18\x02 \x01(\tR\x05orgId\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\tR\aactorId\x12\x1f\n" +
+	"\vactor_email\x18\x04 \x01(\tR\n" +
+	"actorEmail\x12\x16\n" +
+	"\x06action\x18\x05 \x01(\tR\x06action\x12\x1f\n" +
+	"\vtarget_type\x18\x06 \x01(\tR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\a \x01(\tR\btargetId\x12=\n" +
+	"\bmetadata\x18\b \x03(\v2!.user.AuditLogEntry.MetadataEntryR\bmetadata\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\t \x01(\tR\tipAddress\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x83\x02\n" +
+	"\x14ListAuditLogsRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x19\n" +
+	"\bactor_id\x18\x02 \x01(\tR\aactorId\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x129\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x12\n" +
+	"\x04page\x18\x06 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\a \x01(\x05R\bpageSize\"\x98\x01\n" +
+	"\x15ListAuditLogsResponse\x12-\n" +
+	"\aentries\x18\x01 \x03(\v2\x13.user.AuditLogEntryR\aentries\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xe8\x05\n" +
+	"\n" +
+	"LDAPConfig\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x1b\n" +
+	"\tstart_tls\x18\x04 \x01(\bR\bstartTls\x12\x17\n" +
+	"\abind_dn\x18\x05 \x01(\tR\x06bindDn\x12#\n" +
+	"\rbind_password\x18\x06 \x01(\tR\fbindPassword\x12*\n" +
+	"\x11has_bind_password\x18\a \x01(\bR\x0fhasBindPassword\x12\x17\n" +
+	"\abase_dn\x18\b \x01(\tR\x06baseDn\x12\x1f\n" +
+	"\vuser_filter\x18\t \x01(\tR\n" +
+	"userFilter\x12'\n" +
+	"\x0femail_attribute\x18\n" +
+	" \x01(\tR\x0eemailAttribute\x12-\n" +
+	"\x12username_attribute\x18\v \x01(\tR\x11usernameAttribute\x12%\n" +
+	"\x0ename_attribute\x18\f \x01(\tR\rnameAttribute\x12A\n" +
+	"\vgroup_teams\x18\r \x03(\v2 .user.LDAPConfig.GroupTeamsEntryR\n" +
+	"groupTeams\x122\n" +
+	"\x15sync_interval_minutes\x18\x0e \x01(\x05R\x13syncIntervalMinutes\x12<\n" +
+	"\flast_sync_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastSyncAt\x12&\n" +
+	"\x0flast_sync_error\x18\x10 \x01(\tR\rlastSyncError\x12;\n" +
+	"\x0flast_sync_stats\x18\x11 \x01(\v2\x13.user.LDAPSyncStatsR\rlastSyncStats\x1a=\n" +
+	"\x0fGroupTeamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x81\x02\n" +
+	"\rLDAPSyncStats\x12\x18\n" +
+	"\acreated\x18\x01 \x01(\x05R\acreated\x12\x18\n" +
+	"\aupdated\x18\x02 \x01(\x05R\aupdated\x12 \n" +
+	"\vdeactivated\x18\x03 \x01(\x05R\vdeactivated\x12 \n" +
+	"\vreactivated\x18\x04 \x01(\x05R\vreactivated\x12,\n" +
+	"\x12team_members_added\x18\x05 \x01(\x05R\x10teamMembersAdded\x120\n" +
+	"\x14team_members_removed\x18\x06 \x01(\x05R\x12teamMembersRemoved\x12\x18\n" +
+	"\askipped\x18\a \x01(\x05R\askipped\"-\n" +
+	"\x14GetLDAPConfigRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\"A\n" +
+	"\x15GetLDAPConfigResponse\x12(\n" +
+	"\x06config\x18\x01 \x01(\v2\x10.user.LDAPConfigR\x06config\"Z\n" +
+	"\x17UpsertLDAPConfigRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12(\n" +
+	"\x06config\x18\x02 \x01(\v2\x10.user.LDAPConfigR\x06config\"^\n" +
+	"\x18UpsertLDAPConfigResponse\x12(\n" +
+	"\x06config\x18\x01 \x01(\v2\x10.user.LDAPConfigR\x06config\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"(\n" +
+	"\x0fSyncLDAPRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\"W\n" +
+	"\x10SyncLDAPResponse\x12)\n" +
+	"\x05stats\x18\x01 \x01(\v2\x13.user.LDAPSyncStatsR\x05stats\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xb1\x02\n" +
+	"\x06APIKey\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06prefix\x18\x03 \x01(\tR\x06prefix\x12\x16\n" +
+	"\x06scopes\x18\x04 \x03(\tR\x06scopes\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12<\n" +
+	"\flast_used_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x18\n" +
+	"\arevoked\x18\b \x01(\bR\arevoked\"i\n" +
+	"\x13CreateAPIKeyRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06scopes\x18\x02 \x03(\tR\x06scopes\x12&\n" +
+	"\x0fexpires_in_days\x18\x03 \x01(\x05R\rexpiresInDays\"O\n" +
+	"\x14CreateAPIKeyResponse\x12%\n" +
+	"\aapi_key\x18\x01 \x01(\v2\f.user.APIKeyR\x06apiKey\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\"\x14\n" +
+	"\x12ListAPIKeysRequest\">\n" +
+	"\x13ListAPIKeysResponse\x12'\n" +
+	"\bapi_keys\x18\x01 \x03(\v2\f.user.APIKeyR\aapiKeys\",\n" +
+	"\x13RevokeAPIKeyRequest\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\"0\n" +
+	"\x14RevokeAPIKeyResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\")\n" +
+	"\x15ValidateAPIKeyRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"\xb7\x01\n" +
+	"\x16ValidateAPIKeyResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x15\n" +
+	"\x06org_id\x18\x03 \x01(\tR\x05orgId\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12\x14\n" +
+	"\x05email\x18\x05 \x01(\tR\x05email\x12\x16\n" +
+	"\x06scopes\x18\x06 \x03(\tR\x06scopes\x12\x15\n" +
+	"\x06key_id\x18\a \x01(\tR\x05keyId\"\xe0\x01\n" +
+	"\x0eServiceAccount\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x15\n" +
+	"\x06org_id\x18\x02 \x01(\tR\x05orgId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12\x1b\n" +
+	"\tis_active\x18\x05 \x01(\bR\bisActive\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12'\n" +
+	"\bapi_keys\x18\a \x03(\v2\f.user.APIKeyR\aapiKeys\"\x88\x01\n" +
+	"\x1bCreateServiceAccountRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06scopes\x18\x03 \x03(\tR\x06scopes\x12&\n" +
+	"\x0fexpires_in_days\x18\x04 \x01(\x05R\rexpiresInDays\"o\n" +
+	"\x1cCreateServiceAccountResponse\x12=\n" +
+	"\x0fservice_account\x18\x01 \x01(\v2\x14.user.ServiceAccountR\x0eserviceAccount\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\"3\n" +
+	"\x1aListServiceAccountsRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\"^\n" +
+	"\x1bListServiceAccountsResponse\x12?\n" +
+	"\x10service_accounts\x18\x01 \x03(\v2\x14.user.ServiceAccountR\x0fserviceAccounts\"\xd7\x01\n" +
+	"\x1eRotateServiceAccountKeyRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12,\n" +
+	"\x12service_account_id\x18\x02 \x01(\tR\x10serviceAccountId\x12\x16\n" +
+	"\x06scopes\x18\x03 \x03(\tR\x06scopes\x12&\n" +
+	"\x0fexpires_in_days\x18\x04 \x01(\x05R\rexpiresInDays\x120\n" +
+	"\x14grace_period_minutes\x18\x05 \x01(\x05R\x12gracePeriodMinutes\"Z\n" +
+	"\x1fRotateServiceAccountKeyResponse\x12%\n" +
+	"\aapi_key\x18\x01 \x01(\v2\f.user.APIKeyR\x06apiKey\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\"b\n" +
+	"\x1bDeleteServiceAccountRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12,\n" +
+	"\x12service_account_id\x18\x02 \x01(\tR\x10serviceAccountId\"8\n" +
+	"\x1cDeleteServiceAccountResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\\\n" +
+	"\x12SuspendUserRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"/\n" +
+	"\x13SuspendUserResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"G\n" +
+	"\x15ReactivateUserRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"2\n" +
+	"\x16ReactivateUserResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"_\n" +
+	"\x15ExportUserDataRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\"k\n" +
+	"\x16ExportUserDataResponse\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\"\x9f\x03\n" +
+	"\x12DataErasureRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x15\n" +
+	"\x06org_id\x18\x03 \x01(\tR\x05orgId\x12\x12\n" +
+	"\x04mode\x18\x04 \x01(\tR\x04mode\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1a\n" +
+	"\battempts\x18\x06 \x01(\x05R\battempts\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\a \x01(\tR\tlastError\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
+	"\fcompleted_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12'\n" +
+	"\x0fcompleted_steps\x18\n" +
+	" \x03(\tR\x0ecompletedSteps\x12?\n" +
+	"\rscheduled_for\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\fscheduledFor\"_\n" +
+	"\x19RequestDataErasureRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04mode\x18\x03 \x01(\tR\x04mode\"P\n" +
+	"\x1aRequestDataErasureResponse\x122\n" +
+	"\arequest\x18\x01 \x01(\v2\x18.user.DataErasureRequestR\arequest\"T\n" +
+	"\x1cGetDataErasureRequestRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\tR\trequestId\"S\n" +
+	"\x1dGetDataErasureRequestResponse\x122\n" +
+	"\arequest\x18\x01 \x01(\v2\x18.user.DataErasureRequestR\arequest\"n\n" +
+	"\x13ResendInviteRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x1b\n" +
+	"\tinvite_id\x18\x02 \x01(\tR\binviteId\x12#\n" +
+	"\rexpires_hours\x18\x03 \x01(\x05R\fexpiresHours\"l\n" +
+	"\x14ResendInviteResponse\x12$\n" +
+	"\x06invite\x18\x01 \x01(\v2\f.user.InviteR\x06invite\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\"I\n" +
+	"\x13RevokeInviteRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x1b\n" +
+	"\tinvite_id\x18\x02 \x01(\tR\binviteId\"0\n" +
+	"\x14RevokeInviteResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\xc1\x02\n" +
+	"\n" +
+	"LoginEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x18\n" +
+	"\asuccess\x18\x04 \x01(\bR\asuccess\x12%\n" +
+	"\x0efailure_reason\x18\x05 \x01(\tR\rfailureReason\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x06 \x01(\tR\tipAddress\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\a \x01(\tR\tuserAgent\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1b\n" +
+	"\tdevice_id\x18\t \x01(\tR\bdeviceId\x12\x1d\n" +
+	"\n" +
+	"new_device\x18\n" +
+	" \x01(\bR\tnewDevice\"\x87\x01\n" +
+	"\x16GetLoginHistoryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12#\n" +
+	"\rfailures_only\x18\x04 \x01(\bR\ffailuresOnly\"\x95\x01\n" +
+	"\x17GetLoginHistoryResponse\x12(\n" +
+	"\x06events\x18\x01 \x03(\v2\x10.user.LoginEventR\x06events\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x8e\x04\n" +
+	"\x10SuperAdminChange\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_email\x18\x04 \x01(\tR\tuserEmail\x12!\n" +
+	"\frequested_by\x18\x05 \x01(\tR\vrequestedBy\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x1f\n" +
+	"\vapproved_by\x18\b \x03(\tR\n" +
+	"approvedBy\x12-\n" +
+	"\x12required_approvals\x18\t \x01(\x05R\x11requiredApprovals\x12\x1d\n" +
+	"\n" +
+	"decided_by\x18\n" +
+	" \x01(\tR\tdecidedBy\x12'\n" +
+	"\x0fdecision_reason\x18\v \x01(\tR\x0edecisionReason\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"decided_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tdecidedAt\"\x18\n" +
+	"\x16ListSuperAdminsRequest\";\n" +
+	"\x17ListSuperAdminsResponse\x12 \n" +
+	"\x05users\x18\x01 \x03(\v2\n" +
+	".user.UserR\x05users\"I\n" +
+	"\x16GrantSuperAdminRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"J\n" +
+	"\x17RevokeSuperAdminRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"d\n" +
+	"\x18SuperAdminChangeResponse\x12.\n" +
+	"\x06change\x18\x01 \x01(\v2\x16.user.SuperAdminChangeR\x06change\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"6\n" +
+	"\x1cListSuperAdminChangesRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"Q\n" +
+	"\x1dListSuperAdminChangesResponse\x120\n" +
+	"\achanges\x18\x01 \x03(\v2\x16.user.SuperAdminChangeR\achanges\"=\n" +
+	"\x1eApproveSuperAdminChangeRequest\x12\x1b\n" +
+	"\tchange_id\x18\x01 \x01(\tR\bchangeId\"T\n" +
+	"\x1dRejectSuperAdminChangeRequest\x12\x1b\n" +
+	"\tchange_id\x18\x01 \x01(\tR\bchangeId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\xc5\x01\n" +
+	"\x16OrganizationMembership\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x19\n" +
+	"\borg_name\x18\x02 \x01(\tR\aorgName\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x12\n" +
+	"\x04home\x18\x04 \x01(\bR\x04home\x12\x18\n" +
+	"\acurrent\x18\x05 \x01(\bR\acurrent\x127\n" +
+	"\tjoined_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bjoinedAt\"\x1c\n" +
+	"\x1aListMyOrganizationsRequest\"a\n" +
+	"\x1bListMyOrganizationsResponse\x12B\n" +
+	"\rorganizations\x18\x01 \x03(\v2\x1c.user.OrganizationMembershipR\rorganizations\"2\n" +
+	"\x19SwitchOrganizationRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\"\xc5\x01\n" +
+	"\x1aSwitchOrganizationResponse\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x03 \x01(\x03R\texpiresIn\x12@\n" +
+	"\forganization\x18\x04 \x01(\v2\x1c.user.OrganizationMembershipR\forganization\"i\n" +
+	"#UpdateOrganizationMemberRoleRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\"@\n" +
+	"$UpdateOrganizationMemberRoleResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"-\n" +
+	"\x15ForgotPasswordRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"2\n" +
+	"\x16ForgotPasswordResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"X\n" +
+	"\x1dResetPasswordWithTokenRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12!\n" +
+	"\fnew_password\x18\x02 \x01(\tR\vnewPassword\":\n" +
+	"\x1eResetPasswordWithTokenResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"{\n" +
+	"\x12InviteDomainPolicy\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12%\n" +
+	"\x0eprimary_domain\x18\x02 \x01(\tR\rprimaryDomain\x12'\n" +
+	"\x0fallowed_domains\x18\x03 \x03(\tR\x0eallowedDomains\"5\n" +
+	"\x1cGetInviteDomainPolicyRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\"a\n" +
+	"\x1fUpdateInviteDomainPolicyRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12'\n" +
+	"\x0fallowed_domains\x18\x02 \x03(\tR\x0eallowedDomains\"H\n" +
+	"\x16DeleteMyAccountRequest\x12\x1a\n" +
+	"\bpassword\x18\x01 \x01(\tR\bpassword\x12\x12\n" +
+	"\x04mode\x18\x02 \x01(\tR\x04mode\"g\n" +
+	"\x17DeleteMyAccountResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x122\n" +
+	"\arequest\x18\x02 \x01(\v2\x18.user.DataErasureRequestR\arequest\"j\n" +
+	"\fProfileField\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x04 \x01(\tR\n" +
+	"visibility\"W\n" +
+	"\x12ProfileFieldSchema\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12*\n" +
+	"\x06fields\x18\x02 \x03(\v2\x12.user.ProfileFieldR\x06fields\"0\n" +
+	"\x17GetProfileFieldsRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\"_\n" +
+	"\x1aUpdateProfileFieldsRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12*\n" +
+	"\x06fields\x18\x02 \x03(\v2\x12.user.ProfileFieldR\x06fields\"\xe5\x01\n" +
+	"\x1eUpdateProfileAttributesRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12T\n" +
+	"\n" +
+	"attributes\x18\x03 \x03(\v24.user.UpdateProfileAttributesRequest.AttributesEntryR\n" +
+	"attributes\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb7\x01\n" +
+	"\x1fUpdateProfileAttributesResponse\x12U\n" +
+	"\n" +
+	"attributes\x18\x01 \x03(\v25.user.UpdateProfileAttributesResponse.AttributesEntryR\n" +
+	"attributes\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xba\x01\n" +
+	"\x13OrgSecuritySettings\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12*\n" +
+	"\x11new_device_alerts\x18\x02 \x01(\bR\x0fnewDeviceAlerts\x12=\n" +
+	"\x1brequire_passkeys_for_admins\x18\x03 \x01(\bR\x18requirePasskeysForAdmins\x12!\n" +
+	"\fip_allowlist\x18\x04 \x03(\tR\vipAllowlist\"#\n" +
+	"\vIPAllowlist\x12\x14\n" +
+	"\x05cidrs\x18\x01 \x03(\tR\x05cidrs\"6\n" +
+	"\x1dGetOrgSecuritySettingsRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\"\x9a\x02\n" +
+	" UpdateOrgSecuritySettingsRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12/\n" +
+	"\x11new_device_alerts\x18\x02 \x01(\bH\x00R\x0fnewDeviceAlerts\x88\x01\x01\x12B\n" +
+	"\x1brequire_passkeys_for_admins\x18\x03 \x01(\bH\x01R\x18requirePasskeysForAdmins\x88\x01\x01\x124\n" +
+	"\fip_allowlist\x18\x04 \x01(\v2\x11.user.IPAllowlistR\vipAllowlistB\x14\n" +
+	"\x12_new_device_alertsB\x1e\n" +
+	"\x1c_require_passkeys_for_admins\"\xa3\x02\n" +
+	"\aPasskey\x12\x1d\n" +
+	"\n" +
+	"passkey_id\x18\x01 \x01(\tR\tpasskeyId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
+	"\rcredential_id\x18\x03 \x01(\tR\fcredentialId\x12\x1e\n" +
+	"\n" +
+	"transports\x18\x04 \x03(\tR\n" +
+	"transports\x12'\n" +
+	"\x0fbackup_eligible\x18\x05 \x01(\bR\x0ebackupEligible\x12<\n" +
+	"\flast_used_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"!\n" +
+	"\x1fBeginPasskeyRegistrationRequest\"\xf3\x02\n" +
+	"\x1aPasskeyRegistrationOptions\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1c\n" +
+	"\tchallenge\x18\x02 \x01(\tR\tchallenge\x12\x13\n" +
+	"\x05rp_id\x18\x03 \x01(\tR\x04rpId\x12\x17\n" +
+	"\arp_name\x18\x04 \x01(\tR\x06rpName\x12\x1f\n" +
+	"\vuser_handle\x18\x05 \x01(\tR\n" +
+	"userHandle\x12\x1b\n" +
+	"\tuser_name\x18\x06 \x01(\tR\buserName\x12*\n" +
+	"\x11user_display_name\x18\a \x01(\tR\x0fuserDisplayName\x124\n" +
+	"\x16exclude_credential_ids\x18\b \x03(\tR\x14excludeCredentialIds\x12\x1d\n" +
+	"\n" +
+	"timeout_ms\x18\t \x01(\x03R\ttimeoutMs\x12+\n" +
+	"\x11user_verification\x18\n" +
+	" \x01(\tR\x10userVerification\"\xf3\x01\n" +
+	" FinishPasskeyRegistrationRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
+	"\rcredential_id\x18\x03 \x01(\tR\fcredentialId\x12(\n" +
+	"\x10client_data_json\x18\x04 \x01(\tR\x0eclientDataJson\x12-\n" +
+	"\x12attestation_object\x18\x05 \x01(\tR\x11attestationObject\x12\x1e\n" +
+	"\n" +
+	"transports\x18\x06 \x03(\tR\n" +
+	"transports\"\x15\n" +
+	"\x13ListPasskeysRequest\"A\n" +
+	"\x14ListPasskeysResponse\x12)\n" +
+	"\bpasskeys\x18\x01 \x03(\v2\r.user.PasskeyR\bpasskeys\"5\n" +
+	"\x14DeletePasskeyRequest\x12\x1d\n" +
+	"\n" +
+	"passkey_id\x18\x01 \x01(\tR\tpasskeyId\"1\n" +
+	"\x15DeletePasskeyResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\xdb\x02\n" +
+	"\aSession\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x15\n" +
+	"\x06org_id\x18\x02 \x01(\tR\x05orgId\x12\x1b\n" +
+	"\tdevice_id\x18\x03 \x01(\tR\bdeviceId\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x04 \x01(\tR\tuserAgent\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x05 \x01(\tR\tipAddress\x12<\n" +
+	"\fsigned_in_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"signedInAt\x12F\n" +
+	"\x11last_refreshed_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x0flastRefreshedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\x15\n" +
+	"\x13ListSessionsRequest\"A\n" +
+	"\x14ListSessionsResponse\x12)\n" +
+	"\bsessions\x18\x01 \x03(\v2\r.user.SessionR\bsessions\"5\n" +
+	"\x14RevokeSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"1\n" +
+	"\x15RevokeSessionResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"0\n" +
+	"\x18BeginPasskeyLoginRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"\xe5\x01\n" +
+	"\x13PasskeyLoginOptions\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1c\n" +
+	"\tchallenge\x18\x02 \x01(\tR\tchallenge\x12\x13\n" +
+	"\x05rp_id\x18\x03 \x01(\tR\x04rpId\x120\n" +
+	"\x14allow_credential_ids\x18\x04 \x03(\tR\x12allowCredentialIds\x12\x1d\n" +
+	"\n" +
+	"timeout_ms\x18\x05 \x01(\x03R\ttimeoutMs\x12+\n" +
+	"\x11user_verification\x18\x06 \x01(\tR\x10userVerification\"\xf7\x01\n" +
+	"\x19FinishPasskeyLoginRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12#\n" +
+	"\rcredential_id\x18\x02 \x01(\tR\fcredentialId\x12(\n" +
+	"\x10client_data_json\x18\x03 \x01(\tR\x0eclientDataJson\x12-\n" +
+	"\x12authenticator_data\x18\x04 \x01(\tR\x11authenticatorData\x12\x1c\n" +
+	"\tsignature\x18\x05 \x01(\tR\tsignature\x12\x1f\n" +
+	"\vuser_handle\x18\x06 \x01(\tR\n" +
+	"userHandle\"^\n" +
+	"\x1bGetSecurityDashboardRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12(\n" +
+	"\x10stale_after_days\x18\x02 \x01(\x05R\x0estaleAfterDays\"\xee\x03\n" +
+	"\x11SecurityDashboard\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x125\n" +
+	"\x0fpending_invites\x18\x02 \x03(\v2\f.user.InviteR\x0ependingInvites\x12A\n" +
+	"\x0flocked_accounts\x18\x03 \x03(\v2\x18.user.OrganizationMemberR\x0elockedAccounts\x129\n" +
+	"\vwithout_mfa\x18\x04 \x03(\v2\x18.user.OrganizationMemberR\n" +
+	"withoutMfa\x12@\n" +
+	"\x0fnever_logged_in\x18\x05 \x03(\v2\x18.user.OrganizationMemberR\rneverLoggedIn\x12?\n" +
+	"\x0estale_accounts\x18\x06 \x03(\v2\x18.user.OrganizationMemberR\rstaleAccounts\x12(\n" +
+	"\x10stale_after_days\x18\a \x01(\x05R\x0estaleAfterDays\x12!\n" +
+	"\fmember_count\x18\b \x01(\x05R\vmemberCount\x12=\n" +
+	"\fgenerated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\"1\n" +
+	"\x18GetOrgIPAllowlistRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\"1\n" +
+	"\x19GetOrgIPAllowlistResponse\x12\x14\n" +
+	"\x05cidrs\x18\x01 \x03(\tR\x05cidrs\"\x97\x01\n" +
+	"\x1aRecordBlockedAccessRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x03 \x01(\tR\tipAddress\x12\x16\n" +
+	"\x06method\x18\x04 \x01(\tR\x06method\x12\x12\n" +
+	"\x04path\x18\x05 \x01(\tR\x04path\"\x1d\n" +
+	"\x1bRecordBlockedAccessResponse*P\n" +
 	"\bUserRole\x12\x19\n" +
 	"\x15USER_ROLE_UNSPECIFIED\x10\x00\x12\x14\n" +
 	"\x10USER_ROLE_MEMBER\x10\x01\x12\x13\n" +
-	"\x0fUSER_ROLE_ADMIN\x10\x022\xec\x15\n" +
+	"\x0fUSER_ROLE_ADMIN\x10\x022\x8eM\n" +
 	"\vUserService\x12[\n" +
 	"\bRegister\x12\x15.user.RegisterRequest\x1a\x16.user.RegisterResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/v1/auth/register\x12O\n" +
 	"\x05Login\x12\x12.user.LoginRequest\x1a\x13.user.LoginResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/v1/auth/login\x12W\n" +
@@ -3423,7 +10871,61 @@ const file_user_proto_rawDesc = "" +
 	"\x14SetSecurityQuestions\x12!.user.SetSecurityQuestionsRequest\x1a\".user.SetSecurityQuestionsResponse\"5\x82\xd3\xe4\x93\x02/:\x01*\"*/api/v1/users/{user_id}/security-questions\x12{\n" +
 	"\rResetPassword\x12\x1a.user.ResetPasswordRequest\x1a\x1b.user.ResetPasswordResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/api/v1/users/{user_id}/reset-password\x12\xac\x01\n" +
 	"\x1aResetPasswordWithQuestions\x12'.user.ResetPasswordWithQuestionsRequest\x1a(.user.ResetPasswordWithQuestionsResponse\";\x82\xd3\xe4\x93\x025:\x01*\"0/api/v1/users/{user_id}/reset-password-questions\x12\xa3\x01\n" +
-	"\x12AdminResetPassword\x12\x1f.user.AdminResetPasswordRequest\x1a .user.AdminResetPasswordResponse\"J\x82\xd3\xe4\x93\x02D:\x01*\"?/api/v1/organizations/{org_id}/members/{user_id}/reset-passwordBBZ@github.com/chanduchitikam/task-management-system/proto/user;userb\x06proto3"
+	"\x12AdminResetPassword\x12\x1f.user.AdminResetPasswordRequest\x1a .user.AdminResetPasswordResponse\"J\x82\xd3\xe4\x93\x02D:\x01*\"?/api/v1/organizations/{org_id}/members/{user_id}/reset-password\x12Q\n" +
+	"\x10ResolveUsernames\x12\x1d.user.ResolveUsernamesRequest\x1a\x1e.user.ResolveUsernamesResponse\x12f\n" +
+	"\fRefreshToken\x12\x19.user.RefreshTokenRequest\x1a\x1a.user.RefreshTokenResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/api/v1/auth/refresh\x12\x83\x01\n" +
+	"\n" +
+	"UnlockUser\x12\x17.user.UnlockUserRequest\x1a\x18.user.UnlockUserResponse\"B\x82\xd3\xe4\x93\x02<:\x01*\"7/api/v1/organizations/{org_id}/members/{user_id}/unlock\x12d\n" +
+	"\rListAuditLogs\x12\x1a.user.ListAuditLogsRequest\x1a\x1b.user.ListAuditLogsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v1/audit-logs\x12u\n" +
+	"\rGetLDAPConfig\x12\x1a.user.GetLDAPConfigRequest\x1a\x1b.user.GetLDAPConfigResponse\"+\x82\xd3\xe4\x93\x02%\x12#/api/v1/organizations/{org_id}/ldap\x12\x81\x01\n" +
+	"\x10UpsertLDAPConfig\x12\x1d.user.UpsertLDAPConfigRequest\x1a\x1e.user.UpsertLDAPConfigResponse\".\x82\xd3\xe4\x93\x02(:\x01*\x1a#/api/v1/organizations/{org_id}/ldap\x12n\n" +
+	"\bSyncLDAP\x12\x15.user.SyncLDAPRequest\x1a\x16.user.SyncLDAPResponse\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/api/v1/organizations/{org_id}/ldap/sync\x12k\n" +
+	"\fCreateAPIKey\x12\x19.user.CreateAPIKeyRequest\x1a\x1a.user.CreateAPIKeyResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/users/me/api-keys\x12e\n" +
+	"\vListAPIKeys\x12\x18.user.ListAPIKeysRequest\x1a\x19.user.ListAPIKeysResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/users/me/api-keys\x12q\n" +
+	"\fRevokeAPIKey\x12\x19.user.RevokeAPIKeyRequest\x1a\x1a.user.RevokeAPIKeyResponse\"*\x82\xd3\xe4\x93\x02$*\"/api/v1/users/me/api-keys/{key_id}\x12K\n" +
+	"\x0eValidateAPIKey\x12\x1b.user.ValidateAPIKeyRequest\x1a\x1c.user.ValidateAPIKeyResponse\x12T\n" +
+	"\x11GetOrgIPAllowlist\x12\x1e.user.GetOrgIPAllowlistRequest\x1a\x1f.user.GetOrgIPAllowlistResponse\x12Z\n" +
+	"\x13RecordBlockedAccess\x12 .user.RecordBlockedAccessRequest\x1a!.user.RecordBlockedAccessResponse\x12\x99\x01\n" +
+	"\x14CreateServiceAccount\x12!.user.CreateServiceAccountRequest\x1a\".user.CreateServiceAccountResponse\":\x82\xd3\xe4\x93\x024:\x01*\"//api/v1/organizations/{org_id}/service-accounts\x12\x93\x01\n" +
+	"\x13ListServiceAccounts\x12 .user.ListServiceAccountsRequest\x1a!.user.ListServiceAccountsResponse\"7\x82\xd3\xe4\x93\x021\x12//api/v1/organizations/{org_id}/service-accounts\x12\xbe\x01\n" +
+	"\x17RotateServiceAccountKey\x12$.user.RotateServiceAccountKeyRequest\x1a%.user.RotateServiceAccountKeyResponse\"V\x82\xd3\xe4\x93\x02P:\x01*\"K/api/v1/organizations/{org_id}/service-accounts/{service_account_id}/rotate\x12\xab\x01\n" +
+	"\x14DeleteServiceAccount\x12!.user.DeleteServiceAccountRequest\x1a\".user.DeleteServiceAccountResponse\"L\x82\xd3\xe4\x93\x02F*D/api/v1/organizations/{org_id}/service-accounts/{service_account_id}\x12\x87\x01\n" +
+	"\vSuspendUser\x12\x18.user.SuspendUserRequest\x1a\x19.user.SuspendUserResponse\"C\x82\xd3\xe4\x93\x02=:\x01*\"8/api/v1/organizations/{org_id}/members/{user_id}/suspend\x12\x93\x01\n" +
+	"\x0eReactivateUser\x12\x1b.user.ReactivateUserRequest\x1a\x1c.user.ReactivateUserResponse\"F\x82\xd3\xe4\x93\x02@:\x01*\";/api/v1/organizations/{org_id}/members/{user_id}/reactivate\x12\xa7\x01\n" +
+	"\x0eExportUserData\x12\x1b.user.ExportUserDataRequest\x1a\x1c.user.ExportUserDataResponse\"Z\x82\xd3\xe4\x93\x02TZ9\x127/api/v1/organizations/{org_id}/members/{user_id}/export\x12\x17/api/v1/users/me/export\x12\x9a\x01\n" +
+	"\x12RequestDataErasure\x12\x1f.user.RequestDataErasureRequest\x1a .user.RequestDataErasureResponse\"A\x82\xd3\xe4\x93\x02;:\x01*\"6/api/v1/organizations/{org_id}/members/{user_id}/erase\x12\xa6\x01\n" +
+	"\x15GetDataErasureRequest\x12\".user.GetDataErasureRequestRequest\x1a#.user.GetDataErasureRequestResponse\"D\x82\xd3\xe4\x93\x02>\x12</api/v1/organizations/{org_id}/erasure-requests/{request_id}\x12\x82\x01\n" +
+	"\fResendInvite\x12\x19.user.ResendInviteRequest\x1a\x1a.user.ResendInviteResponse\";\x82\xd3\xe4\x93\x025:\x01*\"0/api/v1/orgs/{org_id}/invites/{invite_id}/resend\x12x\n" +
+	"\fRevokeInvite\x12\x19.user.RevokeInviteRequest\x1a\x1a.user.RevokeInviteResponse\"1\x82\xd3\xe4\x93\x02+*)/api/v1/orgs/{org_id}/invites/{invite_id}\x12\x9f\x01\n" +
+	"\x0fGetLoginHistory\x12\x1c.user.GetLoginHistoryRequest\x1a\x1d.user.GetLoginHistoryResponse\"O\x82\xd3\xe4\x93\x02IZ \x12\x1e/api/v1/users/me/login-history\x12%/api/v1/users/{user_id}/login-history\x12r\n" +
+	"\x0fListSuperAdmins\x12\x1c.user.ListSuperAdminsRequest\x1a\x1d.user.ListSuperAdminsResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/admin/super-admins\x12v\n" +
+	"\x0fGrantSuperAdmin\x12\x1c.user.GrantSuperAdminRequest\x1a\x1e.user.SuperAdminChangeResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/admin/super-admins\x12\x7f\n" +
+	"\x10RevokeSuperAdmin\x12\x1d.user.RevokeSuperAdminRequest\x1a\x1e.user.SuperAdminChangeResponse\",\x82\xd3\xe4\x93\x02&*$/api/v1/admin/super-admins/{user_id}\x12\x8b\x01\n" +
+	"\x15ListSuperAdminChanges\x12\".user.ListSuperAdminChangesRequest\x1a#.user.ListSuperAdminChangesResponse\")\x82\xd3\xe4\x93\x02#\x12!/api/v1/admin/super-admin-changes\x12\xa1\x01\n" +
+	"\x17ApproveSuperAdminChange\x12$.user.ApproveSuperAdminChangeRequest\x1a\x1e.user.SuperAdminChangeResponse\"@\x82\xd3\xe4\x93\x02::\x01*\"5/api/v1/admin/super-admin-changes/{change_id}/approve\x12\x9e\x01\n" +
+	"\x16RejectSuperAdminChange\x12#.user.RejectSuperAdminChangeRequest\x1a\x1e.user.SuperAdminChangeResponse\"?\x82\xd3\xe4\x93\x029:\x01*\"4/api/v1/admin/super-admin-changes/{change_id}/reject\x12\x82\x01\n" +
+	"\x13ListMyOrganizations\x12 .user.ListMyOrganizationsRequest\x1a!.user.ListMyOrganizationsResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/api/v1/users/me/organizations\x12{\n" +
+	"\x12SwitchOrganization\x12\x1f.user.SwitchOrganizationRequest\x1a .user.SwitchOrganizationResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/auth/switch-org\x12\xb7\x01\n" +
+	"\x1cUpdateOrganizationMemberRole\x12).user.UpdateOrganizationMemberRoleRequest\x1a*.user.UpdateOrganizationMemberRoleResponse\"@\x82\xd3\xe4\x93\x02::\x01*\x1a5/api/v1/organizations/{org_id}/members/{user_id}/role\x12t\n" +
+	"\x0eForgotPassword\x12\x1b.user.ForgotPasswordRequest\x1a\x1c.user.ForgotPasswordResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/auth/forgot-password\x12\x8b\x01\n" +
+	"\x16ResetPasswordWithToken\x12#.user.ResetPasswordWithTokenRequest\x1a$.user.ResetPasswordWithTokenResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/auth/reset-password\x12\x8c\x01\n" +
+	"\x15GetInviteDomainPolicy\x12\".user.GetInviteDomainPolicyRequest\x1a\x18.user.InviteDomainPolicy\"5\x82\xd3\xe4\x93\x02/\x12-/api/v1/organizations/{org_id}/invite-domains\x12\x95\x01\n" +
+	"\x18UpdateInviteDomainPolicy\x12%.user.UpdateInviteDomainPolicyRequest\x1a\x18.user.InviteDomainPolicy\"8\x82\xd3\xe4\x93\x022:\x01*\x1a-/api/v1/organizations/{org_id}/invite-domains\x12r\n" +
+	"\x0fDeleteMyAccount\x12\x1c.user.DeleteMyAccountRequest\x1a\x1d.user.DeleteMyAccountResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/users/me/delete\x12\x82\x01\n" +
+	"\x10GetProfileFields\x12\x1d.user.GetProfileFieldsRequest\x1a\x18.user.ProfileFieldSchema\"5\x82\xd3\xe4\x93\x02/\x12-/api/v1/organizations/{org_id}/profile-fields\x12\x8b\x01\n" +
+	"\x13UpdateProfileFields\x12 .user.UpdateProfileFieldsRequest\x1a\x18.user.ProfileFieldSchema\"8\x82\xd3\xe4\x93\x022:\x01*\x1a-/api/v1/organizations/{org_id}/profile-fields\x12\xab\x01\n" +
+	"\x17UpdateProfileAttributes\x12$.user.UpdateProfileAttributesRequest\x1a%.user.UpdateProfileAttributesResponse\"C\x82\xd3\xe4\x93\x02=:\x01*\x1a8/api/v1/organizations/{org_id}/members/{user_id}/profile\x12\x92\x01\n" +
+	"\x16GetOrgSecuritySettings\x12#.user.GetOrgSecuritySettingsRequest\x1a\x19.user.OrgSecuritySettings\"8\x82\xd3\xe4\x93\x022\x120/api/v1/organizations/{org_id}/security-settings\x12\x9b\x01\n" +
+	"\x19UpdateOrgSecuritySettings\x12&.user.UpdateOrgSecuritySettingsRequest\x1a\x19.user.OrgSecuritySettings\";\x82\xd3\xe4\x93\x025:\x01*20/api/v1/organizations/{org_id}/security-settings\x12\x98\x01\n" +
+	"\x18BeginPasskeyRegistration\x12%.user.BeginPasskeyRegistrationRequest\x1a .user.PasskeyRegistrationOptions\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/api/v1/users/me/passkeys/register/begin\x12\x88\x01\n" +
+	"\x19FinishPasskeyRegistration\x12&.user.FinishPasskeyRegistrationRequest\x1a\r.user.Passkey\"4\x82\xd3\xe4\x93\x02.:\x01*\")/api/v1/users/me/passkeys/register/finish\x12h\n" +
+	"\fListPasskeys\x12\x19.user.ListPasskeysRequest\x1a\x1a.user.ListPasskeysResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/users/me/passkeys\x12x\n" +
+	"\rDeletePasskey\x12\x1a.user.DeletePasskeyRequest\x1a\x1b.user.DeletePasskeyResponse\".\x82\xd3\xe4\x93\x02(*&/api/v1/users/me/passkeys/{passkey_id}\x12h\n" +
+	"\fListSessions\x12\x19.user.ListSessionsRequest\x1a\x1a.user.ListSessionsResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/users/me/sessions\x12x\n" +
+	"\rRevokeSession\x12\x1a.user.RevokeSessionRequest\x1a\x1b.user.RevokeSessionResponse\".\x82\xd3\xe4\x93\x02(*&/api/v1/users/me/sessions/{session_id}\x12u\n" +
+	"\x11BeginPasskeyLogin\x12\x1e.user.BeginPasskeyLoginRequest\x1a\x19.user.PasskeyLoginOptions\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/auth/passkey/begin\x12r\n" +
+	"\x12FinishPasskeyLogin\x12\x1f.user.FinishPasskeyLoginRequest\x1a\x13.user.LoginResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/auth/passkey/finish\x12\x8d\x01\n" +
+	"\x14GetSecurityDashboard\x12!.user.GetSecurityDashboardRequest\x1a\x17.user.SecurityDashboard\"9\x82\xd3\xe4\x93\x023\x121/api/v1/organizations/{org_id}/security-dashboardBBZ@github.com/chanduchitikam/task-management-system/proto/user;userb\x06proto3"
 
 var (
 	file_user_proto_rawDescOnce sync.Once
@@ -3438,144 +10940,431 @@ func file_user_proto_rawDescGZIP() []byte {
 }
 
 var file_user_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 52)
+var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 168)
 var file_user_proto_goTypes = []any{
-	(UserRole)(0),                              // 0: user.UserRole
-	(*InviteRequest)(nil),                      // 1: user.InviteRequest
-	(*InviteResponse)(nil),                     // 2: user.InviteResponse
-	(*AcceptInviteRequest)(nil),                // 3: user.AcceptInviteRequest
-	(*AcceptInviteResponse)(nil),               // 4: user.AcceptInviteResponse
-	(*Invite)(nil),                             // 5: user.Invite
-	(*ListInvitesRequest)(nil),                 // 6: user.ListInvitesRequest
-	(*ListInvitesResponse)(nil),                // 7: user.ListInvitesResponse
-	(*User)(nil),                               // 8: user.User
-	(*RegisterRequest)(nil),                    // 9: user.RegisterRequest
-	(*RegisterResponse)(nil),                   // 10: user.RegisterResponse
-	(*LoginRequest)(nil),                       // 11: user.LoginRequest
-	(*LoginResponse)(nil),                      // 12: user.LoginResponse
-	(*GetUserRequest)(nil),                     // 13: user.GetUserRequest
-	(*GetUserResponse)(nil),                    // 14: user.GetUserResponse
-	(*UpdateUserRequest)(nil),                  // 15: user.UpdateUserRequest
-	(*UpdateUserResponse)(nil),                 // 16: user.UpdateUserResponse
-	(*DeleteUserRequest)(nil),                  // 17: user.DeleteUserRequest
-	(*DeleteUserResponse)(nil),                 // 18: user.DeleteUserResponse
-	(*ListUsersRequest)(nil),                   // 19: user.ListUsersRequest
-	(*ListUsersResponse)(nil),                  // 20: user.ListUsersResponse
-	(*ValidateTokenRequest)(nil),               // 21: user.ValidateTokenRequest
-	(*ValidateTokenResponse)(nil),              // 22: user.ValidateTokenResponse
-	(*Organization)(nil),                       // 23: user.Organization
-	(*RegisterOrganizationRequest)(nil),        // 24: user.RegisterOrganizationRequest
-	(*RegisterOrganizationResponse)(nil),       // 25: user.RegisterOrganizationResponse
-	(*ListAllOrganizationsRequest)(nil),        // 26: user.ListAllOrganizationsRequest
-	(*ListAllOrganizationsResponse)(nil),       // 27: user.ListAllOrganizationsResponse
-	(*GetPlatformAnalyticsRequest)(nil),        // 28: user.GetPlatformAnalyticsRequest
-	(*GetPlatformAnalyticsResponse)(nil),       // 29: user.GetPlatformAnalyticsResponse
-	(*ListAllUsersRequest)(nil),                // 30: user.ListAllUsersRequest
-	(*UserWithOrg)(nil),                        // 31: user.UserWithOrg
-	(*ListAllUsersResponse)(nil),               // 32: user.ListAllUsersResponse
-	(*DeleteOrganizationRequest)(nil),          // 33: user.DeleteOrganizationRequest
-	(*DeleteOrganizationResponse)(nil),         // 34: user.DeleteOrganizationResponse
-	(*ListOrganizationMembersRequest)(nil),     // 35: user.ListOrganizationMembersRequest
-	(*OrganizationMember)(nil),                 // 36: user.OrganizationMember
-	(*ListOrganizationMembersResponse)(nil),    // 37: user.ListOrganizationMembersResponse
-	(*RemoveOrganizationMemberRequest)(nil),    // 38: user.RemoveOrganizationMemberRequest
-	(*RemoveOrganizationMemberResponse)(nil),   // 39: user.RemoveOrganizationMemberResponse
-	(*CreateOrganizationMemberRequest)(nil),    // 40: user.CreateOrganizationMemberRequest
-	(*CreateOrganizationMemberResponse)(nil),   // 41: user.CreateOrganizationMemberResponse
-	(*GetOrganizationRequest)(nil),             // 42: user.GetOrganizationRequest
-	(*GetOrganizationResponse)(nil),            // 43: user.GetOrganizationResponse
-	(*SecurityQuestion)(nil),                   // 44: user.SecurityQuestion
-	(*SetSecurityQuestionsRequest)(nil),        // 45: user.SetSecurityQuestionsRequest
-	(*SetSecurityQuestionsResponse)(nil),       // 46: user.SetSecurityQuestionsResponse
-	(*ResetPasswordRequest)(nil),               // 47: user.ResetPasswordRequest
-	(*ResetPasswordResponse)(nil),              // 48: user.ResetPasswordResponse
-	(*ResetPasswordWithQuestionsRequest)(nil),  // 49: user.ResetPasswordWithQuestionsRequest
-	(*ResetPasswordWithQuestionsResponse)(nil), // 50: user.ResetPasswordWithQuestionsResponse
-	(*AdminResetPasswordRequest)(nil),          // 51: user.AdminResetPasswordRequest
-	(*AdminResetPasswordResponse)(nil),         // 52: user.AdminResetPasswordResponse
-	(*timestamppb.Timestamp)(nil),              // 53: google.protobuf.Timestamp
+	(UserRole)(0),                                // 0: user.UserRole
+	(*InviteRequest)(nil),                        // 1: user.InviteRequest
+	(*InviteResponse)(nil),                       // 2: user.InviteResponse
+	(*AcceptInviteRequest)(nil),                  // 3: user.AcceptInviteRequest
+	(*AcceptInviteResponse)(nil),                 // 4: user.AcceptInviteResponse
+	(*Invite)(nil),                               // 5: user.Invite
+	(*ListInvitesRequest)(nil),                   // 6: user.ListInvitesRequest
+	(*ListInvitesResponse)(nil),                  // 7: user.ListInvitesResponse
+	(*User)(nil),                                 // 8: user.User
+	(*RegisterRequest)(nil),                      // 9: user.RegisterRequest
+	(*RegisterResponse)(nil),                     // 10: user.RegisterResponse
+	(*LoginRequest)(nil),                         // 11: user.LoginRequest
+	(*LoginResponse)(nil),                        // 12: user.LoginResponse
+	(*GetUserRequest)(nil),                       // 13: user.GetUserRequest
+	(*GetUserResponse)(nil),                      // 14: user.GetUserResponse
+	(*UpdateUserRequest)(nil),                    // 15: user.UpdateUserRequest
+	(*UpdateUserResponse)(nil),                   // 16: user.UpdateUserResponse
+	(*DeleteUserRequest)(nil),                    // 17: user.DeleteUserRequest
+	(*DeleteUserResponse)(nil),                   // 18: user.DeleteUserResponse
+	(*ListUsersRequest)(nil),                     // 19: user.ListUsersRequest
+	(*ListUsersResponse)(nil),                    // 20: user.ListUsersResponse
+	(*ValidateTokenRequest)(nil),                 // 21: user.ValidateTokenRequest
+	(*ValidateTokenResponse)(nil),                // 22: user.ValidateTokenResponse
+	(*Organization)(nil),                         // 23: user.Organization
+	(*RegisterOrganizationRequest)(nil),          // 24: user.RegisterOrganizationRequest
+	(*RegisterOrganizationResponse)(nil),         // 25: user.RegisterOrganizationResponse
+	(*ListAllOrganizationsRequest)(nil),          // 26: user.ListAllOrganizationsRequest
+	(*ListAllOrganizationsResponse)(nil),         // 27: user.ListAllOrganizationsResponse
+	(*GetPlatformAnalyticsRequest)(nil),          // 28: user.GetPlatformAnalyticsRequest
+	(*GetPlatformAnalyticsResponse)(nil),         // 29: user.GetPlatformAnalyticsResponse
+	(*ListAllUsersRequest)(nil),                  // 30: user.ListAllUsersRequest
+	(*UserWithOrg)(nil),                          // 31: user.UserWithOrg
+	(*ListAllUsersResponse)(nil),                 // 32: user.ListAllUsersResponse
+	(*DeleteOrganizationRequest)(nil),            // 33: user.DeleteOrganizationRequest
+	(*DeleteOrganizationResponse)(nil),           // 34: user.DeleteOrganizationResponse
+	(*ListOrganizationMembersRequest)(nil),       // 35: user.ListOrganizationMembersRequest
+	(*OrganizationMember)(nil),                   // 36: user.OrganizationMember
+	(*ListOrganizationMembersResponse)(nil),      // 37: user.ListOrganizationMembersResponse
+	(*RemoveOrganizationMemberRequest)(nil),      // 38: user.RemoveOrganizationMemberRequest
+	(*RemoveOrganizationMemberResponse)(nil),     // 39: user.RemoveOrganizationMemberResponse
+	(*CreateOrganizationMemberRequest)(nil),      // 40: user.CreateOrganizationMemberRequest
+	(*CreateOrganizationMemberResponse)(nil),     // 41: user.CreateOrganizationMemberResponse
+	(*GetOrganizationRequest)(nil),               // 42: user.GetOrganizationRequest
+	(*GetOrganizationResponse)(nil),              // 43: user.GetOrganizationResponse
+	(*SecurityQuestion)(nil),                     // 44: user.SecurityQuestion
+	(*SetSecurityQuestionsRequest)(nil),          // 45: user.SetSecurityQuestionsRequest
+	(*SetSecurityQuestionsResponse)(nil),         // 46: user.SetSecurityQuestionsResponse
+	(*ResetPasswordRequest)(nil),                 // 47: user.ResetPasswordRequest
+	(*ResetPasswordResponse)(nil),                // 48: user.ResetPasswordResponse
+	(*ResetPasswordWithQuestionsRequest)(nil),    // 49: user.ResetPasswordWithQuestionsRequest
+	(*ResetPasswordWithQuestionsResponse)(nil),   // 50: user.ResetPasswordWithQuestionsResponse
+	(*AdminResetPasswordRequest)(nil),            // 51: user.AdminResetPasswordRequest
+	(*AdminResetPasswordResponse)(nil),           // 52: user.AdminResetPasswordResponse
+	(*ResolveUsernamesRequest)(nil),              // 53: user.ResolveUsernamesRequest
+	(*ResolveUsernamesResponse)(nil),             // 54: user.ResolveUsernamesResponse
+	(*RefreshTokenRequest)(nil),                  // 55: user.RefreshTokenRequest
+	(*RefreshTokenResponse)(nil),                 // 56: user.RefreshTokenResponse
+	(*UnlockUserRequest)(nil),                    // 57: user.UnlockUserRequest
+	(*UnlockUserResponse)(nil),                   // 58: user.UnlockUserResponse
+	(*AuditLogEntry)(nil),                        // 59: user.AuditLogEntry
+	(*ListAuditLogsRequest)(nil),                 // 60: user.ListAuditLogsRequest
+	(*ListAuditLogsResponse)(nil),                // 61: user.ListAuditLogsResponse
+	(*LDAPConfig)(nil),                           // 62: user.LDAPConfig
+	(*LDAPSyncStats)(nil),                        // 63: user.LDAPSyncStats
+	(*GetLDAPConfigRequest)(nil),                 // 64: user.GetLDAPConfigRequest
+	(*GetLDAPConfigResponse)(nil),                // 65: user.GetLDAPConfigResponse
+	(*UpsertLDAPConfigRequest)(nil),              // 66: user.UpsertLDAPConfigRequest
+	(*UpsertLDAPConfigResponse)(nil),             // 67: user.UpsertLDAPConfigResponse
+	(*SyncLDAPRequest)(nil),                      // 68: user.SyncLDAPRequest
+	(*SyncLDAPResponse)(nil),                     // 69: user.SyncLDAPResponse
+	(*APIKey)(nil),                               // 70: user.APIKey
+	(*CreateAPIKeyRequest)(nil),                  // 71: user.CreateAPIKeyRequest
+	(*CreateAPIKeyResponse)(nil),                 // 72: user.CreateAPIKeyResponse
+	(*ListAPIKeysRequest)(nil),                   // 73: user.ListAPIKeysRequest
+	(*ListAPIKeysResponse)(nil),                  // 74: user.ListAPIKeysResponse
+	(*RevokeAPIKeyRequest)(nil),                  // 75: user.RevokeAPIKeyRequest
+	(*RevokeAPIKeyResponse)(nil),                 // 76: user.RevokeAPIKeyResponse
+	(*ValidateAPIKeyRequest)(nil),                // 77: user.ValidateAPIKeyRequest
+	(*ValidateAPIKeyResponse)(nil),               // 78: user.ValidateAPIKeyResponse
+	(*ServiceAccount)(nil),                       // 79: user.ServiceAccount
+	(*CreateServiceAccountRequest)(nil),          // 80: user.CreateServiceAccountRequest
+	(*CreateServiceAccountResponse)(nil),         // 81: user.CreateServiceAccountResponse
+	(*ListServiceAccountsRequest)(nil),           // 82: user.ListServiceAccountsRequest
+	(*ListServiceAccountsResponse)(nil),          // 83: user.ListServiceAccountsResponse
+	(*RotateServiceAccountKeyRequest)(nil),       // 84: user.RotateServiceAccountKeyRequest
+	(*RotateServiceAccountKeyResponse)(nil),      // 85: user.RotateServiceAccountKeyResponse
+	(*DeleteServiceAccountRequest)(nil),          // 86: user.DeleteServiceAccountRequest
+	(*DeleteServiceAccountResponse)(nil),         // 87: user.DeleteServiceAccountResponse
+	(*SuspendUserRequest)(nil),                   // 88: user.SuspendUserRequest
+	(*SuspendUserResponse)(nil),                  // 89: user.SuspendUserResponse
+	(*ReactivateUserRequest)(nil),                // 90: user.ReactivateUserRequest
+	(*ReactivateUserResponse)(nil),               // 91: user.ReactivateUserResponse
+	(*ExportUserDataRequest)(nil),                // 92: user.ExportUserDataRequest
+	(*ExportUserDataResponse)(nil),               // 93: user.ExportUserDataResponse
+	(*DataErasureRequest)(nil),                   // 94: user.DataErasureRequest
+	(*RequestDataErasureRequest)(nil),            // 95: user.RequestDataErasureRequest
+	(*RequestDataErasureResponse)(nil),           // 96: user.RequestDataErasureResponse
+	(*GetDataErasureRequestRequest)(nil),         // 97: user.GetDataErasureRequestRequest
+	(*GetDataErasureRequestResponse)(nil),        // 98: user.GetDataErasureRequestResponse
+	(*ResendInviteRequest)(nil),                  // 99: user.ResendInviteRequest
+	(*ResendInviteResponse)(nil),                 // 100: user.ResendInviteResponse
+	(*RevokeInviteRequest)(nil),                  // 101: user.RevokeInviteRequest
+	(*RevokeInviteResponse)(nil),                 // 102: user.RevokeInviteResponse
+	(*LoginEvent)(nil),                           // 103: user.LoginEvent
+	(*GetLoginHistoryRequest)(nil),               // 104: user.GetLoginHistoryRequest
+	(*GetLoginHistoryResponse)(nil),              // 105: user.GetLoginHistoryResponse
+	(*SuperAdminChange)(nil),                     // 106: user.SuperAdminChange
+	(*ListSuperAdminsRequest)(nil),               // 107: user.ListSuperAdminsRequest
+	(*ListSuperAdminsResponse)(nil),              // 108: user.ListSuperAdminsResponse
+	(*GrantSuperAdminRequest)(nil),               // 109: user.GrantSuperAdminRequest
+	(*RevokeSuperAdminRequest)(nil),              // 110: user.RevokeSuperAdminRequest
+	(*SuperAdminChangeResponse)(nil),             // 111: user.SuperAdminChangeResponse
+	(*ListSuperAdminChangesRequest)(nil),         // 112: user.ListSuperAdminChangesRequest
+	(*ListSuperAdminChangesResponse)(nil),        // 113: user.ListSuperAdminChangesResponse
+	(*ApproveSuperAdminChangeRequest)(nil),       // 114: user.ApproveSuperAdminChangeRequest
+	(*RejectSuperAdminChangeRequest)(nil),        // 115: user.RejectSuperAdminChangeRequest
+	(*OrganizationMembership)(nil),               // 116: user.OrganizationMembership
+	(*ListMyOrganizationsRequest)(nil),           // 117: user.ListMyOrganizationsRequest
+	(*ListMyOrganizationsResponse)(nil),          // 118: user.ListMyOrganizationsResponse
+	(*SwitchOrganizationRequest)(nil),            // 119: user.SwitchOrganizationRequest
+	(*SwitchOrganizationResponse)(nil),           // 120: user.SwitchOrganizationResponse
+	(*UpdateOrganizationMemberRoleRequest)(nil),  // 121: user.UpdateOrganizationMemberRoleRequest
+	(*UpdateOrganizationMemberRoleResponse)(nil), // 122: user.UpdateOrganizationMemberRoleResponse
+	(*ForgotPasswordRequest)(nil),                // 123: user.ForgotPasswordRequest
+	(*ForgotPasswordResponse)(nil),               // 124: user.ForgotPasswordResponse
+	(*ResetPasswordWithTokenRequest)(nil),        // 125: user.ResetPasswordWithTokenRequest
+	(*ResetPasswordWithTokenResponse)(nil),       // 126: user.ResetPasswordWithTokenResponse
+	(*InviteDomainPolicy)(nil),                   // 127: user.InviteDomainPolicy
+	(*GetInviteDomainPolicyRequest)(nil),         // 128: user.GetInviteDomainPolicyRequest
+	(*UpdateInviteDomainPolicyRequest)(nil),      // 129: user.UpdateInviteDomainPolicyRequest
+	(*DeleteMyAccountRequest)(nil),               // 130: user.DeleteMyAccountRequest
+	(*DeleteMyAccountResponse)(nil),              // 131: user.DeleteMyAccountResponse
+	(*ProfileField)(nil),                         // 132: user.ProfileField
+	(*ProfileFieldSchema)(nil),                   // 133: user.ProfileFieldSchema
+	(*GetProfileFieldsRequest)(nil),              // 134: user.GetProfileFieldsRequest
+	(*UpdateProfileFieldsRequest)(nil),           // 135: user.UpdateProfileFieldsRequest
+	(*UpdateProfileAttributesRequest)(nil),       // 136: user.UpdateProfileAttributesRequest
+	(*UpdateProfileAttributesResponse)(nil),      // 137: user.UpdateProfileAttributesResponse
+	(*OrgSecuritySettings)(nil),                  // 138: user.OrgSecuritySettings
+	(*IPAllowlist)(nil),                          // 139: user.IPAllowlist
+	(*GetOrgSecuritySettingsRequest)(nil),        // 140: user.GetOrgSecuritySettingsRequest
+	(*UpdateOrgSecuritySettingsRequest)(nil),     // 141: user.UpdateOrgSecuritySettingsRequest
+	(*Passkey)(nil),                              // 142: user.Passkey
+	(*BeginPasskeyRegistrationRequest)(nil),      // 143: user.BeginPasskeyRegistrationRequest
+	(*PasskeyRegistrationOptions)(nil),           // 144: user.PasskeyRegistrationOptions
+	(*FinishPasskeyRegistrationRequest)(nil),     // 145: user.FinishPasskeyRegistrationRequest
+	(*ListPasskeysRequest)(nil),                  // 146: user.ListPasskeysRequest
+	(*ListPasskeysResponse)(nil),                 // 147: user.ListPasskeysResponse
+	(*DeletePasskeyRequest)(nil),                 // 148: user.DeletePasskeyRequest
+	(*DeletePasskeyResponse)(nil),                // 149: user.DeletePasskeyResponse
+	(*Session)(nil),                              // 150: user.Session
+	(*ListSessionsRequest)(nil),                  // 151: user.ListSessionsRequest
+	(*ListSessionsResponse)(nil),                 // 152: user.ListSessionsResponse
+	(*RevokeSessionRequest)(nil),                 // 153: user.RevokeSessionRequest
+	(*RevokeSessionResponse)(nil),                // 154: user.RevokeSessionResponse
+	(*BeginPasskeyLoginRequest)(nil),             // 155: user.BeginPasskeyLoginRequest
+	(*PasskeyLoginOptions)(nil),                  // 156: user.PasskeyLoginOptions
+	(*FinishPasskeyLoginRequest)(nil),            // 157: user.FinishPasskeyLoginRequest
+	(*GetSecurityDashboardRequest)(nil),          // 158: user.GetSecurityDashboardRequest
+	(*SecurityDashboard)(nil),                    // 159: user.SecurityDashboard
+	(*GetOrgIPAllowlistRequest)(nil),             // 160: user.GetOrgIPAllowlistRequest
+	(*GetOrgIPAllowlistResponse)(nil),            // 161: user.GetOrgIPAllowlistResponse
+	(*RecordBlockedAccessRequest)(nil),           // 162: user.RecordBlockedAccessRequest
+	(*RecordBlockedAccessResponse)(nil),          // 163: user.RecordBlockedAccessResponse
+	nil,                                          // 164: user.OrganizationMember.ProfileAttributesEntry
+	nil,                                          // 165: user.AuditLogEntry.MetadataEntry
+	nil,                                          // 166: user.LDAPConfig.GroupTeamsEntry
+	nil,                                          // 167: user.UpdateProfileAttributesRequest.AttributesEntry
+	nil,                                          // 168: user.UpdateProfileAttributesResponse.AttributesEntry
+	(*timestamppb.Timestamp)(nil),                // 169: google.protobuf.Timestamp
 }
 var file_user_proto_depIdxs = []int32{
-	8,  // 0: user.AcceptInviteResponse.user:type_name -> user.User
-	53, // 1: user.Invite.expires_at:type_name -> google.protobuf.Timestamp
-	53, // 2: user.Invite.used_at:type_name -> google.protobuf.Timestamp
-	53, // 3: user.Invite.created_at:type_name -> google.protobuf.Timestamp
-	5,  // 4: user.ListInvitesResponse.invites:type_name -> user.Invite
-	0,  // 5: user.User.role:type_name -> user.UserRole
-	53, // 6: user.User.created_at:type_name -> google.protobuf.Timestamp
-	53, // 7: user.User.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 8: user.RegisterRequest.role:type_name -> user.UserRole
-	8,  // 9: user.RegisterResponse.user:type_name -> user.User
-	8,  // 10: user.LoginResponse.user:type_name -> user.User
-	8,  // 11: user.GetUserResponse.user:type_name -> user.User
-	0,  // 12: user.UpdateUserRequest.role:type_name -> user.UserRole
-	8,  // 13: user.UpdateUserResponse.user:type_name -> user.User
-	8,  // 14: user.ListUsersResponse.users:type_name -> user.User
-	0,  // 15: user.ValidateTokenResponse.role:type_name -> user.UserRole
-	53, // 16: user.Organization.created_at:type_name -> google.protobuf.Timestamp
-	23, // 17: user.RegisterOrganizationResponse.organization:type_name -> user.Organization
-	8,  // 18: user.RegisterOrganizationResponse.admin:type_name -> user.User
-	23, // 19: user.ListAllOrganizationsResponse.organizations:type_name -> user.Organization
-	53, // 20: user.UserWithOrg.created_at:type_name -> google.protobuf.Timestamp
-	31, // 21: user.ListAllUsersResponse.users:type_name -> user.UserWithOrg
-	53, // 22: user.OrganizationMember.created_at:type_name -> google.protobuf.Timestamp
-	53, // 23: user.OrganizationMember.last_login:type_name -> google.protobuf.Timestamp
-	36, // 24: user.ListOrganizationMembersResponse.members:type_name -> user.OrganizationMember
-	36, // 25: user.CreateOrganizationMemberResponse.member:type_name -> user.OrganizationMember
-	23, // 26: user.GetOrganizationResponse.organization:type_name -> user.Organization
-	44, // 27: user.SetSecurityQuestionsRequest.questions:type_name -> user.SecurityQuestion
-	44, // 28: user.ResetPasswordWithQuestionsRequest.questions:type_name -> user.SecurityQuestion
-	9,  // 29: user.UserService.Register:input_type -> user.RegisterRequest
-	11, // 30: user.UserService.Login:input_type -> user.LoginRequest
-	13, // 31: user.UserService.GetUser:input_type -> user.GetUserRequest
-	15, // 32: user.UserService.UpdateUser:input_type -> user.UpdateUserRequest
-	17, // 33: user.UserService.DeleteUser:input_type -> user.DeleteUserRequest
-	19, // 34: user.UserService.ListUsers:input_type -> user.ListUsersRequest
-	21, // 35: user.UserService.ValidateToken:input_type -> user.ValidateTokenRequest
-	1,  // 36: user.UserService.InviteUser:input_type -> user.InviteRequest
-	3,  // 37: user.UserService.AcceptInvite:input_type -> user.AcceptInviteRequest
-	6,  // 38: user.UserService.ListInvites:input_type -> user.ListInvitesRequest
-	24, // 39: user.UserService.RegisterOrganization:input_type -> user.RegisterOrganizationRequest
-	26, // 40: user.UserService.ListAllOrganizations:input_type -> user.ListAllOrganizationsRequest
-	28, // 41: user.UserService.GetPlatformAnalytics:input_type -> user.GetPlatformAnalyticsRequest
-	30, // 42: user.UserService.ListAllUsers:input_type -> user.ListAllUsersRequest
-	33, // 43: user.UserService.DeleteOrganization:input_type -> user.DeleteOrganizationRequest
-	35, // 44: user.UserService.ListOrganizationMembers:input_type -> user.ListOrganizationMembersRequest
-	38, // 45: user.UserService.RemoveOrganizationMember:input_type -> user.RemoveOrganizationMemberRequest
-	40, // 46: user.UserService.CreateOrganizationMember:input_type -> user.CreateOrganizationMemberRequest
-	42, // 47: user.UserService.GetOrganization:input_type -> user.GetOrganizationRequest
-	45, // 48: user.UserService.SetSecurityQuestions:input_type -> user.SetSecurityQuestionsRequest
-	47, // 49: user.UserService.ResetPassword:input_type -> user.ResetPasswordRequest
-	49, // 50: user.UserService.ResetPasswordWithQuestions:input_type -> user.ResetPasswordWithQuestionsRequest
-	51, // 51: user.UserService.AdminResetPassword:input_type -> user.AdminResetPasswordRequest
-	10, // 52: user.UserService.Register:output_type -> user.RegisterResponse
-	12, // 53: user.UserService.Login:output_type -> user.LoginResponse
-	14, // 54: user.UserService.GetUser:output_type -> user.GetUserResponse
-	16, // 55: user.UserService.UpdateUser:output_type -> user.UpdateUserResponse
-	18, // 56: user.UserService.DeleteUser:output_type -> user.DeleteUserResponse
-	20, // 57: user.UserService.ListUsers:output_type -> user.ListUsersResponse
-	22, // 58: user.UserService.ValidateToken:output_type -> user.ValidateTokenResponse
-	2,  // 59: user.UserService.InviteUser:output_type -> user.InviteResponse
-	4,  // 60: user.UserService.AcceptInvite:output_type -> user.AcceptInviteResponse
-	7,  // 61: user.UserService.ListInvites:output_type -> user.ListInvitesResponse
-	25, // 62: user.UserService.RegisterOrganization:output_type -> user.RegisterOrganizationResponse
-	27, // 63: user.UserService.ListAllOrganizations:output_type -> user.ListAllOrganizationsResponse
-	29, // 64: user.UserService.GetPlatformAnalytics:output_type -> user.GetPlatformAnalyticsResponse
-	32, // 65: user.UserService.ListAllUsers:output_type -> user.ListAllUsersResponse
-	34, // 66: user.UserService.DeleteOrganization:output_type -> user.DeleteOrganizationResponse
-	37, // 67: user.UserService.ListOrganizationMembers:output_type -> user.ListOrganizationMembersResponse
-	39, // 68: user.UserService.RemoveOrganizationMember:output_type -> user.RemoveOrganizationMemberResponse
-	41, // 69: user.UserService.CreateOrganizationMember:output_type -> user.CreateOrganizationMemberResponse
-	43, // 70: user.UserService.GetOrganization:output_type -> user.GetOrganizationResponse
-	46, // 71: user.UserService.SetSecurityQuestions:output_type -> user.SetSecurityQuestionsResponse
-	48, // 72: user.UserService.ResetPassword:output_type -> user.ResetPasswordResponse
-	50, // 73: user.UserService.ResetPasswordWithQuestions:output_type -> user.ResetPasswordWithQuestionsResponse
-	52, // 74: user.UserService.AdminResetPassword:output_type -> user.AdminResetPasswordResponse
-	52, // [52:75] is the sub-list for method output_type
-	29, // [29:52] is the sub-list for method input_type
-	29, // [29:29] is the sub-list for extension type_name
-	29, // [29:29] is the sub-list for extension extendee
-	0,  // [0:29] is the sub-list for field type_name
+	8,   // 0: user.AcceptInviteResponse.user:type_name -> user.User
+	169, // 1: user.Invite.expires_at:type_name -> google.protobuf.Timestamp
+	169, // 2: user.Invite.used_at:type_name -> google.protobuf.Timestamp
+	169, // 3: user.Invite.created_at:type_name -> google.protobuf.Timestamp
+	169, // 4: user.Invite.revoked_at:type_name -> google.protobuf.Timestamp
+	169, // 5: user.Invite.delivered_at:type_name -> google.protobuf.Timestamp
+	5,   // 6: user.ListInvitesResponse.invites:type_name -> user.Invite
+	0,   // 7: user.User.role:type_name -> user.UserRole
+	169, // 8: user.User.created_at:type_name -> google.protobuf.Timestamp
+	169, // 9: user.User.updated_at:type_name -> google.protobuf.Timestamp
+	0,   // 10: user.RegisterRequest.role:type_name -> user.UserRole
+	8,   // 11: user.RegisterResponse.user:type_name -> user.User
+	8,   // 12: user.LoginResponse.user:type_name -> user.User
+	8,   // 13: user.GetUserResponse.user:type_name -> user.User
+	0,   // 14: user.UpdateUserRequest.role:type_name -> user.UserRole
+	8,   // 15: user.UpdateUserResponse.user:type_name -> user.User
+	8,   // 16: user.ListUsersResponse.users:type_name -> user.User
+	0,   // 17: user.ValidateTokenResponse.role:type_name -> user.UserRole
+	169, // 18: user.Organization.created_at:type_name -> google.protobuf.Timestamp
+	23,  // 19: user.RegisterOrganizationResponse.organization:type_name -> user.Organization
+	8,   // 20: user.RegisterOrganizationResponse.admin:type_name -> user.User
+	23,  // 21: user.ListAllOrganizationsResponse.organizations:type_name -> user.Organization
+	169, // 22: user.UserWithOrg.created_at:type_name -> google.protobuf.Timestamp
+	31,  // 23: user.ListAllUsersResponse.users:type_name -> user.UserWithOrg
+	169, // 24: user.OrganizationMember.created_at:type_name -> google.protobuf.Timestamp
+	169, // 25: user.OrganizationMember.last_login:type_name -> google.protobuf.Timestamp
+	169, // 26: user.OrganizationMember.locked_until:type_name -> google.protobuf.Timestamp
+	169, // 27: user.OrganizationMember.suspended_at:type_name -> google.protobuf.Timestamp
+	164, // 28: user.OrganizationMember.profile_attributes:type_name -> user.OrganizationMember.ProfileAttributesEntry
+	36,  // 29: user.ListOrganizationMembersResponse.members:type_name -> user.OrganizationMember
+	36,  // 30: user.CreateOrganizationMemberResponse.member:type_name -> user.OrganizationMember
+	23,  // 31: user.GetOrganizationResponse.organization:type_name -> user.Organization
+	44,  // 32: user.SetSecurityQuestionsRequest.questions:type_name -> user.SecurityQuestion
+	44,  // 33: user.ResetPasswordWithQuestionsRequest.questions:type_name -> user.SecurityQuestion
+	8,   // 34: user.ResolveUsernamesResponse.users:type_name -> user.User
+	8,   // 35: user.RefreshTokenResponse.user:type_name -> user.User
+	165, // 36: user.AuditLogEntry.metadata:type_name -> user.AuditLogEntry.MetadataEntry
+	169, // 37: user.AuditLogEntry.created_at:type_name -> google.protobuf.Timestamp
+	169, // 38: user.ListAuditLogsRequest.start_time:type_name -> google.protobuf.Timestamp
+	169, // 39: user.ListAuditLogsRequest.end_time:type_name -> google.protobuf.Timestamp
+	59,  // 40: user.ListAuditLogsResponse.entries:type_name -> user.AuditLogEntry
+	166, // 41: user.LDAPConfig.group_teams:type_name -> user.LDAPConfig.GroupTeamsEntry
+	169, // 42: user.LDAPConfig.last_sync_at:type_name -> google.protobuf.Timestamp
+	63,  // 43: user.LDAPConfig.last_sync_stats:type_name -> user.LDAPSyncStats
+	62,  // 44: user.GetLDAPConfigResponse.config:type_name -> user.LDAPConfig
+	62,  // 45: user.UpsertLDAPConfigRequest.config:type_name -> user.LDAPConfig
+	62,  // 46: user.UpsertLDAPConfigResponse.config:type_name -> user.LDAPConfig
+	63,  // 47: user.SyncLDAPResponse.stats:type_name -> user.LDAPSyncStats
+	169, // 48: user.APIKey.expires_at:type_name -> google.protobuf.Timestamp
+	169, // 49: user.APIKey.last_used_at:type_name -> google.protobuf.Timestamp
+	169, // 50: user.APIKey.created_at:type_name -> google.protobuf.Timestamp
+	70,  // 51: user.CreateAPIKeyResponse.api_key:type_name -> user.APIKey
+	70,  // 52: user.ListAPIKeysResponse.api_keys:type_name -> user.APIKey
+	169, // 53: user.ServiceAccount.created_at:type_name -> google.protobuf.Timestamp
+	70,  // 54: user.ServiceAccount.api_keys:type_name -> user.APIKey
+	79,  // 55: user.CreateServiceAccountResponse.service_account:type_name -> user.ServiceAccount
+	79,  // 56: user.ListServiceAccountsResponse.service_accounts:type_name -> user.ServiceAccount
+	70,  // 57: user.RotateServiceAccountKeyResponse.api_key:type_name -> user.APIKey
+	169, // 58: user.DataErasureRequest.created_at:type_name -> google.protobuf.Timestamp
+	169, // 59: user.DataErasureRequest.completed_at:type_name -> google.protobuf.Timestamp
+	169, // 60: user.DataErasureRequest.scheduled_for:type_name -> google.protobuf.Timestamp
+	94,  // 61: user.RequestDataErasureResponse.request:type_name -> user.DataErasureRequest
+	94,  // 62: user.GetDataErasureRequestResponse.request:type_name -> user.DataErasureRequest
+	5,   // 63: user.ResendInviteResponse.invite:type_name -> user.Invite
+	169, // 64: user.LoginEvent.created_at:type_name -> google.protobuf.Timestamp
+	103, // 65: user.GetLoginHistoryResponse.events:type_name -> user.LoginEvent
+	169, // 66: user.SuperAdminChange.created_at:type_name -> google.protobuf.Timestamp
+	169, // 67: user.SuperAdminChange.expires_at:type_name -> google.protobuf.Timestamp
+	169, // 68: user.SuperAdminChange.decided_at:type_name -> google.protobuf.Timestamp
+	8,   // 69: user.ListSuperAdminsResponse.users:type_name -> user.User
+	106, // 70: user.SuperAdminChangeResponse.change:type_name -> user.SuperAdminChange
+	106, // 71: user.ListSuperAdminChangesResponse.changes:type_name -> user.SuperAdminChange
+	169, // 72: user.OrganizationMembership.joined_at:type_name -> google.protobuf.Timestamp
+	116, // 73: user.ListMyOrganizationsResponse.organizations:type_name -> user.OrganizationMembership
+	116, // 74: user.SwitchOrganizationResponse.organization:type_name -> user.OrganizationMembership
+	94,  // 75: user.DeleteMyAccountResponse.request:type_name -> user.DataErasureRequest
+	132, // 76: user.ProfileFieldSchema.fields:type_name -> user.ProfileField
+	132, // 77: user.UpdateProfileFieldsRequest.fields:type_name -> user.ProfileField
+	167, // 78: user.UpdateProfileAttributesRequest.attributes:type_name -> user.UpdateProfileAttributesRequest.AttributesEntry
+	168, // 79: user.UpdateProfileAttributesResponse.attributes:type_name -> user.UpdateProfileAttributesResponse.AttributesEntry
+	139, // 80: user.UpdateOrgSecuritySettingsRequest.ip_allowlist:type_name -> user.IPAllowlist
+	169, // 81: user.Passkey.last_used_at:type_name -> google.protobuf.Timestamp
+	169, // 82: user.Passkey.created_at:type_name -> google.protobuf.Timestamp
+	142, // 83: user.ListPasskeysResponse.passkeys:type_name -> user.Passkey
+	169, // 84: user.Session.signed_in_at:type_name -> google.protobuf.Timestamp
+	169, // 85: user.Session.last_refreshed_at:type_name -> google.protobuf.Timestamp
+	169, // 86: user.Session.expires_at:type_name -> google.protobuf.Timestamp
+	150, // 87: user.ListSessionsResponse.sessions:type_name -> user.Session
+	5,   // 88: user.SecurityDashboard.pending_invites:type_name -> user.Invite
+	36,  // 89: user.SecurityDashboard.locked_accounts:type_name -> user.OrganizationMember
+	36,  // 90: user.SecurityDashboard.without_mfa:type_name -> user.OrganizationMember
+	36,  // 91: user.SecurityDashboard.never_logged_in:type_name -> user.OrganizationMember
+	36,  // 92: user.SecurityDashboard.stale_accounts:type_name -> user.OrganizationMember
+	169, // 93: user.SecurityDashboard.generated_at:type_name -> google.protobuf.Timestamp
+	9,   // 94: user.UserService.Register:input_type -> user.RegisterRequest
+	11,  // 95: user.UserService.Login:input_type -> user.LoginRequest
+	13,  // 96: user.UserService.GetUser:input_type -> user.GetUserRequest
+	15,  // 97: user.UserService.UpdateUser:input_type -> user.UpdateUserRequest
+	17,  // 98: user.UserService.DeleteUser:input_type -> user.DeleteUserRequest
+	19,  // 99: user.UserService.ListUsers:input_type -> user.ListUsersRequest
+	21,  // 100: user.UserService.ValidateToken:input_type -> user.ValidateTokenRequest
+	1,   // 101: user.UserService.InviteUser:input_type -> user.InviteRequest
+	3,   // 102: user.UserService.AcceptInvite:input_type -> user.AcceptInviteRequest
+	6,   // 103: user.UserService.ListInvites:input_type -> user.ListInvitesRequest
+	24,  // 104: user.UserService.RegisterOrganization:input_type -> user.RegisterOrganizationRequest
+	26,  // 105: user.UserService.ListAllOrganizations:input_type -> user.ListAllOrganizationsRequest
+	28,  // 106: user.UserService.GetPlatformAnalytics:input_type -> user.GetPlatformAnalyticsRequest
+	30,  // 107: user.UserService.ListAllUsers:input_type -> user.ListAllUsersRequest
+	33,  // 108: user.UserService.DeleteOrganization:input_type -> user.DeleteOrganizationRequest
+	35,  // 109: user.UserService.ListOrganizationMembers:input_type -> user.ListOrganizationMembersRequest
+	38,  // 110: user.UserService.RemoveOrganizationMember:input_type -> user.RemoveOrganizationMemberRequest
+	40,  // 111: user.UserService.CreateOrganizationMember:input_type -> user.CreateOrganizationMemberRequest
+	42,  // 112: user.UserService.GetOrganization:input_type -> user.GetOrganizationRequest
+	45,  // 113: user.UserService.SetSecurityQuestions:input_type -> user.SetSecurityQuestionsRequest
+	47,  // 114: user.UserService.ResetPassword:input_type -> user.ResetPasswordRequest
+	49,  // 115: user.UserService.ResetPasswordWithQuestions:input_type -> user.ResetPasswordWithQuestionsRequest
+	51,  // 116: user.UserService.AdminResetPassword:input_type -> user.AdminResetPasswordRequest
+	53,  // 117: user.UserService.ResolveUsernames:input_type -> user.ResolveUsernamesRequest
+	55,  // 118: user.UserService.RefreshToken:input_type -> user.RefreshTokenRequest
+	57,  // 119: user.UserService.UnlockUser:input_type -> user.UnlockUserRequest
+	60,  // 120: user.UserService.ListAuditLogs:input_type -> user.ListAuditLogsRequest
+	64,  // 121: user.UserService.GetLDAPConfig:input_type -> user.GetLDAPConfigRequest
+	66,  // 122: user.UserService.UpsertLDAPConfig:input_type -> user.UpsertLDAPConfigRequest
+	68,  // 123: user.UserService.SyncLDAP:input_type -> user.SyncLDAPRequest
+	71,  // 124: user.UserService.CreateAPIKey:input_type -> user.CreateAPIKeyRequest
+	73,  // 125: user.UserService.ListAPIKeys:input_type -> user.ListAPIKeysRequest
+	75,  // 126: user.UserService.RevokeAPIKey:input_type -> user.RevokeAPIKeyRequest
+	77,  // 127: user.UserService.ValidateAPIKey:input_type -> user.ValidateAPIKeyRequest
+	160, // 128: user.UserService.GetOrgIPAllowlist:input_type -> user.GetOrgIPAllowlistRequest
+	162, // 129: user.UserService.RecordBlockedAccess:input_type -> user.RecordBlockedAccessRequest
+	80,  // 130: user.UserService.CreateServiceAccount:input_type -> user.CreateServiceAccountRequest
+	82,  // 131: user.UserService.ListServiceAccounts:input_type -> user.ListServiceAccountsRequest
+	84,  // 132: user.UserService.RotateServiceAccountKey:input_type -> user.RotateServiceAccountKeyRequest
+	86,  // 133: user.UserService.DeleteServiceAccount:input_type -> user.DeleteServiceAccountRequest
+	88,  // 134: user.UserService.SuspendUser:input_type -> user.SuspendUserRequest
+	90,  // 135: user.UserService.ReactivateUser:input_type -> user.ReactivateUserRequest
+	92,  // 136: user.UserService.ExportUserData:input_type -> user.ExportUserDataRequest
+	95,  // 137: user.UserService.RequestDataErasure:input_type -> user.RequestDataErasureRequest
+	97,  // 138: user.UserService.GetDataErasureRequest:input_type -> user.GetDataErasureRequestRequest
+	99,  // 139: user.UserService.ResendInvite:input_type -> user.ResendInviteRequest
+	101, // 140: user.UserService.RevokeInvite:input_type -> user.RevokeInviteRequest
+	104, // 141: user.UserService.GetLoginHistory:input_type -> user.GetLoginHistoryRequest
+	107, // 142: user.UserService.ListSuperAdmins:input_type -> user.ListSuperAdminsRequest
+	109, // 143: user.UserService.GrantSuperAdmin:input_type -> user.GrantSuperAdminRequest
+	110, // 144: user.UserService.RevokeSuperAdmin:input_type -> user.RevokeSuperAdminRequest
+	112, // 145: user.UserService.ListSuperAdminChanges:input_type -> user.ListSuperAdminChangesRequest
+	114, // 146: user.UserService.ApproveSuperAdminChange:input_type -> user.ApproveSuperAdminChangeRequest
+	115, // 147: user.UserService.RejectSuperAdminChange:input_type -> user.RejectSuperAdminChangeRequest
+	117, // 148: user.UserService.ListMyOrganizations:input_type -> user.ListMyOrganizationsRequest
+	119, // 149: user.UserService.SwitchOrganization:input_type -> user.SwitchOrganizationRequest
+	121, // 150: user.UserService.UpdateOrganizationMemberRole:input_type -> user.UpdateOrganizationMemberRoleRequest
+	123, // 151: user.UserService.ForgotPassword:input_type -> user.ForgotPasswordRequest
+	125, // 152: user.UserService.ResetPasswordWithToken:input_type -> user.ResetPasswordWithTokenRequest
+	128, // 153: user.UserService.GetInviteDomainPolicy:input_type -> user.GetInviteDomainPolicyRequest
+	129, // 154: user.UserService.UpdateInviteDomainPolicy:input_type -> user.UpdateInviteDomainPolicyRequest
+	130, // 155: user.UserService.DeleteMyAccount:input_type -> user.DeleteMyAccountRequest
+	134, // 156: user.UserService.GetProfileFields:input_type -> user.GetProfileFieldsRequest
+	135, // 157: user.UserService.UpdateProfileFields:input_type -> user.UpdateProfileFieldsRequest
+	136, // 158: user.UserService.UpdateProfileAttributes:input_type -> user.UpdateProfileAttributesRequest
+	140, // 159: user.UserService.GetOrgSecuritySettings:input_type -> user.GetOrgSecuritySettingsRequest
+	141, // 160: user.UserService.UpdateOrgSecuritySettings:input_type -> user.UpdateOrgSecuritySettingsRequest
+	143, // 161: user.UserService.BeginPasskeyRegistration:input_type -> user.BeginPasskeyRegistrationRequest
+	145, // 162: user.UserService.FinishPasskeyRegistration:input_type -> user.FinishPasskeyRegistrationRequest
+	146, // 163: user.UserService.ListPasskeys:input_type -> user.ListPasskeysRequest
+	148, // 164: user.UserService.DeletePasskey:input_type -> user.DeletePasskeyRequest
+	151, // 165: user.UserService.ListSessions:input_type -> user.ListSessionsRequest
+	153, // 166: user.UserService.RevokeSession:input_type -> user.RevokeSessionRequest
+	155, // 167: user.UserService.BeginPasskeyLogin:input_type -> user.BeginPasskeyLoginRequest
+	157, // 168: user.UserService.FinishPasskeyLogin:input_type -> user.FinishPasskeyLoginRequest
+	158, // 169: user.UserService.GetSecurityDashboard:input_type -> user.GetSecurityDashboardRequest
+	10,  // 170: user.UserService.Register:output_type -> user.RegisterResponse
+	12,  // 171: user.UserService.Login:output_type -> user.LoginResponse
+	14,  // 172: user.UserService.GetUser:output_type -> user.GetUserResponse
+	16,  // 173: user.UserService.UpdateUser:output_type -> user.UpdateUserResponse
+	18,  // 174: user.UserService.DeleteUser:output_type -> user.DeleteUserResponse
+	20,  // 175: user.UserService.ListUsers:output_type -> user.ListUsersResponse
+	22,  // 176: user.UserService.ValidateToken:output_type -> user.ValidateTokenResponse
+	2,   // 177: user.UserService.InviteUser:output_type -> user.InviteResponse
+	4,   // 178: user.UserService.AcceptInvite:output_type -> user.AcceptInviteResponse
+	7,   // 179: user.UserService.ListInvites:output_type -> user.ListInvitesResponse
+	25,  // 180: user.UserService.RegisterOrganization:output_type -> user.RegisterOrganizationResponse
+	27,  // 181: user.UserService.ListAllOrganizations:output_type -> user.ListAllOrganizationsResponse
+	29,  // 182: user.UserService.GetPlatformAnalytics:output_type -> user.GetPlatformAnalyticsResponse
+	32,  // 183: user.UserService.ListAllUsers:output_type -> user.ListAllUsersResponse
+	34,  // 184: user.UserService.DeleteOrganization:output_type -> user.DeleteOrganizationResponse
+	37,  // 185: user.UserService.ListOrganizationMembers:output_type -> user.ListOrganizationMembersResponse
+	39,  // 186: user.UserService.RemoveOrganizationMember:output_type -> user.RemoveOrganizationMemberResponse
+	41,  // 187: user.UserService.CreateOrganizationMember:output_type -> user.CreateOrganizationMemberResponse
+	43,  // 188: user.UserService.GetOrganization:output_type -> user.GetOrganizationResponse
+	46,  // 189: user.UserService.SetSecurityQuestions:output_type -> user.SetSecurityQuestionsResponse
+	48,  // 190: user.UserService.ResetPassword:output_type -> user.ResetPasswordResponse
+	50,  // 191: user.UserService.ResetPasswordWithQuestions:output_type -> user.ResetPasswordWithQuestionsResponse
+	52,  // 192: user.UserService.AdminResetPassword:output_type -> user.AdminResetPasswordResponse
+	54,  // 193: user.UserService.ResolveUsernames:output_type -> user.ResolveUsernamesResponse
+	56,  // 194: user.UserService.RefreshToken:output_type -> user.RefreshTokenResponse
+	58,  // 195: user.UserService.UnlockUser:output_type -> user.UnlockUserResponse
+	61,  // 196: user.UserService.ListAuditLogs:output_type -> user.ListAuditLogsResponse
+	65,  // 197: user.UserService.GetLDAPConfig:output_type -> user.GetLDAPConfigResponse
+	67,  // 198: user.UserService.UpsertLDAPConfig:output_type -> user.UpsertLDAPConfigResponse
+	69,  // 199: user.UserService.SyncLDAP:output_type -> user.SyncLDAPResponse
+	72,  // 200: user.UserService.CreateAPIKey:output_type -> user.CreateAPIKeyResponse
+	74,  // 201: user.UserService.ListAPIKeys:output_type -> user.ListAPIKeysResponse
+	76,  // 202: user.UserService.RevokeAPIKey:output_type -> user.RevokeAPIKeyResponse
+	78,  // 203: user.UserService.ValidateAPIKey:output_type -> user.ValidateAPIKeyResponse
+	161, // 204: user.UserService.GetOrgIPAllowlist:output_type -> user.GetOrgIPAllowlistResponse
+	163, // 205: user.UserService.RecordBlockedAccess:output_type -> user.RecordBlockedAccessResponse
+	81,  // 206: user.UserService.CreateServiceAccount:output_type -> user.CreateServiceAccountResponse
+	83,  // 207: user.UserService.ListServiceAccounts:output_type -> user.ListServiceAccountsResponse
+	85,  // 208: user.UserService.RotateServiceAccountKey:output_type -> user.RotateServiceAccountKeyResponse
+	87,  // 209: user.UserService.DeleteServiceAccount:output_type -> user.DeleteServiceAccountResponse
+	89,  // 210: user.UserService.SuspendUser:output_type -> user.SuspendUserResponse
+	91,  // 211: user.UserService.ReactivateUser:output_type -> user.ReactivateUserResponse
+	93,  // 212: user.UserService.ExportUserData:output_type -> user.ExportUserDataResponse
+	96,  // 213: user.UserService.RequestDataErasure:output_type -> user.RequestDataErasureResponse
+	98,  // 214: user.UserService.GetDataErasureRequest:output_type -> user.GetDataErasureRequestResponse
+	100, // 215: user.UserService.ResendInvite:output_type -> user.ResendInviteResponse
+	102, // 216: user.UserService.RevokeInvite:output_type -> user.RevokeInviteResponse
+	105, // 217: user.UserService.GetLoginHistory:output_type -> user.GetLoginHistoryResponse
+	108, // 218: user.UserService.ListSuperAdmins:output_type -> user.ListSuperAdminsResponse
+	111, // 219: user.UserService.GrantSuperAdmin:output_type -> user.SuperAdminChangeResponse
+	111, // 220: user.UserService.RevokeSuperAdmin:output_type -> user.SuperAdminChangeResponse
+	113, // 221: user.UserService.ListSuperAdminChanges:output_type -> user.ListSuperAdminChangesResponse
+	111, // 222: user.UserService.ApproveSuperAdminChange:output_type -> user.SuperAdminChangeResponse
+	111, // 223: user.UserService.RejectSuperAdminChange:output_type -> user.SuperAdminChangeResponse
+	118, // 224: user.UserService.ListMyOrganizations:output_type -> user.ListMyOrganizationsResponse
+	120, // 225: user.UserService.SwitchOrganization:output_type -> user.SwitchOrganizationResponse
+	122, // 226: user.UserService.UpdateOrganizationMemberRole:output_type -> user.UpdateOrganizationMemberRoleResponse
+	124, // 227: user.UserService.ForgotPassword:output_type -> user.ForgotPasswordResponse
+	126, // 228: user.UserService.ResetPasswordWithToken:output_type -> user.ResetPasswordWithTokenResponse
+	127, // 229: user.UserService.GetInviteDomainPolicy:output_type -> user.InviteDomainPolicy
+	127, // 230: user.UserService.UpdateInviteDomainPolicy:output_type -> user.InviteDomainPolicy
+	131, // 231: user.UserService.DeleteMyAccount:output_type -> user.DeleteMyAccountResponse
+	133, // 232: user.UserService.GetProfileFields:output_type -> user.ProfileFieldSchema
+	133, // 233: user.UserService.UpdateProfileFields:output_type -> user.ProfileFieldSchema
+	137, // 234: user.UserService.UpdateProfileAttributes:output_type -> user.UpdateProfileAttributesResponse
+	138, // 235: user.UserService.GetOrgSecuritySettings:output_type -> user.OrgSecuritySettings
+	138, // 236: user.UserService.UpdateOrgSecuritySettings:output_type -> user.OrgSecuritySettings
+	144, // 237: user.UserService.BeginPasskeyRegistration:output_type -> user.PasskeyRegistrationOptions
+	142, // 238: user.UserService.FinishPasskeyRegistration:output_type -> user.Passkey
+	147, // 239: user.UserService.ListPasskeys:output_type -> user.ListPasskeysResponse
+	149, // 240: user.UserService.DeletePasskey:output_type -> user.DeletePasskeyResponse
+	152, // 241: user.UserService.ListSessions:output_type -> user.ListSessionsResponse
+	154, // 242: user.UserService.RevokeSession:output_type -> user.RevokeSessionResponse
+	156, // 243: user.UserService.BeginPasskeyLogin:output_type -> user.PasskeyLoginOptions
+	12,  // 244: user.UserService.FinishPasskeyLogin:output_type -> user.LoginResponse
+	159, // 245: user.UserService.GetSecurityDashboard:output_type -> user.SecurityDashboard
+	170, // [170:246] is the sub-list for method output_type
+	94,  // [94:170] is the sub-list for method input_type
+	94,  // [94:94] is the sub-list for extension type_name
+	94,  // [94:94] is the sub-list for extension extendee
+	0,   // [0:94] is the sub-list for field type_name
 }
 
 func init() { file_user_proto_init() }
@@ -3583,13 +11372,14 @@ func file_user_proto_init() {
 	if File_user_proto != nil {
 		return
 	}
+	file_user_proto_msgTypes[140].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_proto_rawDesc), len(file_user_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   52,
+			NumMessages:   168,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,29 +19,82 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_Register_FullMethodName                   = "/user.UserService/Register"
-	UserService_Login_FullMethodName                      = "/user.UserService/Login"
-	UserService_GetUser_FullMethodName                    = "/user.UserService/GetUser"
-	UserService_UpdateUser_FullMethodName                 = "/user.UserService/UpdateUser"
-	UserService_DeleteUser_FullMethodName                 = "/user.UserService/DeleteUser"
-	UserService_ListUsers_FullMethodName                  = "/user.UserService/ListUsers"
-	UserService_ValidateToken_FullMethodName              = "/user.UserService/ValidateToken"
-	UserService_InviteUser_FullMethodName                 = "/user.UserService/InviteUser"
-	UserService_AcceptInvite_FullMethodName               = "/user.UserService/AcceptInvite"
-	UserService_ListInvites_FullMethodName                = "/user.UserService/ListInvites"
-	UserService_RegisterOrganization_FullMethodName       = "/user.UserService/RegisterOrganization"
-	UserService_ListAllOrganizations_FullMethodName       = "/user.UserService/ListAllOrganizations"
-	UserService_GetPlatformAnalytics_FullMethodName       = "/user.UserService/GetPlatformAnalytics"
-	UserService_ListAllUsers_FullMethodName               = "/user.UserService/ListAllUsers"
-	UserService_DeleteOrganization_FullMethodName         = "/user.UserService/DeleteOrganization"
-	UserService_ListOrganizationMembers_FullMethodName    = "/user.UserService/ListOrganizationMembers"
-	UserService_RemoveOrganizationMember_FullMethodName   = "/user.UserService/RemoveOrganizationMember"
-	UserService_CreateOrganizationMember_FullMethodName   = "/user.UserService/CreateOrganizationMember"
-	UserService_GetOrganization_FullMethodName            = "/user.UserService/GetOrganization"
-	UserService_SetSecurityQuestions_FullMethodName       = "/user.UserService/SetSecurityQuestions"
-	UserService_ResetPassword_FullMethodName              = "/user.UserService/ResetPassword"
-	UserService_ResetPasswordWithQuestions_FullMethodName = "/user.UserService/ResetPasswordWithQuestions"
-	UserService_AdminResetPassword_FullMethodName         = "/user.UserService/AdminResetPassword"
+	UserService_Register_FullMethodName                     = "/user.UserService/Register"
+	UserService_Login_FullMethodName                        = "/user.UserService/Login"
+	UserService_GetUser_FullMethodName                      = "/user.UserService/GetUser"
+	UserService_UpdateUser_FullMethodName                   = "/user.UserService/UpdateUser"
+	UserService_DeleteUser_FullMethodName                   = "/user.UserService/DeleteUser"
+	UserService_ListUsers_FullMethodName                    = "/user.UserService/ListUsers"
+	UserService_ValidateToken_FullMethodName                = "/user.UserService/ValidateToken"
+	UserService_InviteUser_FullMethodName                   = "/user.UserService/InviteUser"
+	UserService_AcceptInvite_FullMethodName                 = "/user.UserService/AcceptInvite"
+	UserService_ListInvites_FullMethodName                  = "/user.UserService/ListInvites"
+	UserService_RegisterOrganization_FullMethodName         = "/user.UserService/RegisterOrganization"
+	UserService_ListAllOrganizations_FullMethodName         = "/user.UserService/ListAllOrganizations"
+	UserService_GetPlatformAnalytics_FullMethodName         = "/user.UserService/GetPlatformAnalytics"
+	UserService_ListAllUsers_FullMethodName                 = "/user.UserService/ListAllUsers"
+	UserService_DeleteOrganization_FullMethodName           = "/user.UserService/DeleteOrganization"
+	UserService_ListOrganizationMembers_FullMethodName      = "/user.UserService/ListOrganizationMembers"
+	UserService_RemoveOrganizationMember_FullMethodName     = "/user.UserService/RemoveOrganizationMember"
+	UserService_CreateOrganizationMember_FullMethodName     = "/user.UserService/CreateOrganizationMember"
+	UserService_GetOrganization_FullMethodName              = "/user.UserService/GetOrganization"
+	UserService_SetSecurityQuestions_FullMethodName         = "/user.UserService/SetSecurityQuestions"
+	UserService_ResetPassword_FullMethodName                = "/user.UserService/ResetPassword"
+	UserService_ResetPasswordWithQuestions_FullMethodName   = "/user.UserService/ResetPasswordWithQuestions"
+	UserService_AdminResetPassword_FullMethodName           = "/user.UserService/AdminResetPassword"
+	UserService_ResolveUsernames_FullMethodName             = "/user.UserService/ResolveUsernames"
+	UserService_RefreshToken_FullMethodName                 = "/user.UserService/RefreshToken"
+	UserService_UnlockUser_FullMethodName                   = "/user.UserService/UnlockUser"
+	UserService_ListAuditLogs_FullMethodName                = "/user.UserService/ListAuditLogs"
+	UserService_GetLDAPConfig_FullMethodName                = "/user.UserService/GetLDAPConfig"
+	UserService_UpsertLDAPConfig_FullMethodName             = "/user.UserService/UpsertLDAPConfig"
+	UserService_SyncLDAP_FullMethodName                     = "/user.UserService/SyncLDAP"
+	UserService_CreateAPIKey_FullMethodName                 = "/user.UserService/CreateAPIKey"
+	UserService_ListAPIKeys_FullMethodName                  = "/user.UserService/ListAPIKeys"
+	UserService_RevokeAPIKey_FullMethodName                 = "/user.UserService/RevokeAPIKey"
+	UserService_ValidateAPIKey_FullMethodName               = "/user.UserService/ValidateAPIKey"
+	UserService_GetOrgIPAllowlist_FullMethodName            = "/user.UserService/GetOrgIPAllowlist"
+	UserService_RecordBlockedAccess_FullMethodName          = "/user.UserService/RecordBlockedAccess"
+	UserService_CreateServiceAccount_FullMethodName         = "/user.UserService/CreateServiceAccount"
+	UserService_ListServiceAccounts_FullMethodName          = "/user.UserService/ListServiceAccounts"
+	UserService_RotateServiceAccountKey_FullMethodName      = "/user.UserService/RotateServiceAccountKey"
+	UserService_DeleteServiceAccount_FullMethodName         = "/user.UserService/DeleteServiceAccount"
+	UserService_SuspendUser_FullMethodName                  = "/user.UserService/SuspendUser"
+	UserService_ReactivateUser_FullMethodName               = "/user.UserService/ReactivateUser"
+	UserService_ExportUserData_FullMethodName               = "/user.UserService/ExportUserData"
+	UserService_RequestDataErasure_FullMethodName           = "/user.UserService/RequestDataErasure"
+	UserService_GetDataErasureRequest_FullMethodName        = "/user.UserService/GetDataErasureRequest"
+	UserService_ResendInvite_FullMethodName                 = "/user.UserService/ResendInvite"
+	UserService_RevokeInvite_FullMethodName                 = "/user.UserService/RevokeInvite"
+	UserService_GetLoginHistory_FullMethodName              = "/user.UserService/GetLoginHistory"
+	UserService_ListSuperAdmins_FullMethodName              = "/user.UserService/ListSuperAdmins"
+	UserService_GrantSuperAdmin_FullMethodName              = "/user.UserService/GrantSuperAdmin"
+	UserService_RevokeSuperAdmin_FullMethodName             = "/user.UserService/RevokeSuperAdmin"
+	UserService_ListSuperAdminChanges_FullMethodName        = "/user.UserService/ListSuperAdminChanges"
+	UserService_ApproveSuperAdminChange_FullMethodName      = "/user.UserService/ApproveSuperAdminChange"
+	UserService_RejectSuperAdminChange_FullMethodName       = "/user.UserService/RejectSuperAdminChange"
+	UserService_ListMyOrganizations_FullMethodName          = "/user.UserService/ListMyOrganizations"
+	UserService_SwitchOrganization_FullMethodName           = "/user.UserService/SwitchOrganization"
+	UserService_UpdateOrganizationMemberRole_FullMethodName = "/user.UserService/UpdateOrganizationMemberRole"
+	UserService_ForgotPassword_FullMethodName               = "/user.UserService/ForgotPassword"
+	UserService_ResetPasswordWithToken_FullMethodName       = "/user.UserService/ResetPasswordWithToken"
+	UserService_GetInviteDomainPolicy_FullMethodName        = "/user.UserService/GetInviteDomainPolicy"
+	UserService_UpdateInviteDomainPolicy_FullMethodName     = "/user.UserService/UpdateInviteDomainPolicy"
+	UserService_DeleteMyAccount_FullMethodName              = "/user.UserService/DeleteMyAccount"
+	UserService_GetProfileFields_FullMethodName             = "/user.UserService/GetProfileFields"
+	UserService_UpdateProfileFields_FullMethodName          = "/user.UserService/UpdateProfileFields"
+	UserService_UpdateProfileAttributes_FullMethodName      = "/user.UserService/UpdateProfileAttributes"
+	UserService_GetOrgSecuritySettings_FullMethodName       = "/user.UserService/GetOrgSecuritySettings"
+	UserService_UpdateOrgSecuritySettings_FullMethodName    = "/user.UserService/UpdateOrgSecuritySettings"
+	UserService_BeginPasskeyRegistration_FullMethodName     = "/user.UserService/BeginPasskeyRegistration"
+	UserService_FinishPasskeyRegistration_FullMethodName    = "/user.UserService/FinishPasskeyRegistration"
+	UserService_ListPasskeys_FullMethodName                 = "/user.UserService/ListPasskeys"
+	UserService_DeletePasskey_FullMethodName                = "/user.UserService/DeletePasskey"
+	UserService_ListSessions_FullMethodName                 = "/user.UserService/ListSessions"
+	UserService_RevokeSession_FullMethodName                = "/user.UserService/RevokeSession"
+	UserService_BeginPasskeyLogin_FullMethodName            = "/user.UserService/BeginPasskeyLogin"
+	UserService_FinishPasskeyLogin_FullMethodName           = "/user.UserService/FinishPasskeyLogin"
+	UserService_GetSecurityDashboard_FullMethodName         = "/user.UserService/GetSecurityDashboard"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -97,6 +150,115 @@ type UserServiceClient interface {
 	ResetPasswordWithQuestions(ctx context.Context, in *ResetPasswordWithQuestionsRequest, opts ...grpc.CallOption) (*ResetPasswordWithQuestionsResponse, error)
 	// Admin force reset password (generates new temp password)
 	AdminResetPassword(ctx context.Context, in *AdminResetPasswordRequest, opts ...grpc.CallOption) (*AdminResetPasswordResponse, error)
+	// Resolve usernames to users within an org (internal, used for @mentions)
+	ResolveUsernames(ctx context.Context, in *ResolveUsernamesRequest, opts ...grpc.CallOption) (*ResolveUsernamesResponse, error)
+	// Exchange a refresh token for a new access/refresh token pair
+	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error)
+	// Admin unlock of an account locked after failed logins
+	UnlockUser(ctx context.Context, in *UnlockUserRequest, opts ...grpc.CallOption) (*UnlockUserResponse, error)
+	// Query the audit log (org admins see their org, super admins any org)
+	ListAuditLogs(ctx context.Context, in *ListAuditLogsRequest, opts ...grpc.CallOption) (*ListAuditLogsResponse, error)
+	// Get an organization's LDAP / Active Directory sync configuration
+	GetLDAPConfig(ctx context.Context, in *GetLDAPConfigRequest, opts ...grpc.CallOption) (*GetLDAPConfigResponse, error)
+	// Create or update an organization's LDAP sync configuration
+	UpsertLDAPConfig(ctx context.Context, in *UpsertLDAPConfigRequest, opts ...grpc.CallOption) (*UpsertLDAPConfigResponse, error)
+	// Run an LDAP sync for an organization now
+	SyncLDAP(ctx context.Context, in *SyncLDAPRequest, opts ...grpc.CallOption) (*SyncLDAPResponse, error)
+	// Create a personal API key; the key itself is only returned here
+	CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error)
+	// List the caller's API keys
+	ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error)
+	// Revoke one of the caller's API keys
+	RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error)
+	// Resolve an API key to its owner (internal, used by the gateway)
+	ValidateAPIKey(ctx context.Context, in *ValidateAPIKeyRequest, opts ...grpc.CallOption) (*ValidateAPIKeyResponse, error)
+	// Fetch the networks an org's members may connect from (internal, used
+	// by the gateway)
+	GetOrgIPAllowlist(ctx context.Context, in *GetOrgIPAllowlistRequest, opts ...grpc.CallOption) (*GetOrgIPAllowlistResponse, error)
+	// Audit a request the gateway blocked by an org's IP allowlist (internal)
+	RecordBlockedAccess(ctx context.Context, in *RecordBlockedAccessRequest, opts ...grpc.CallOption) (*RecordBlockedAccessResponse, error)
+	// Create a service account with its first API key
+	CreateServiceAccount(ctx context.Context, in *CreateServiceAccountRequest, opts ...grpc.CallOption) (*CreateServiceAccountResponse, error)
+	// List an organization's service accounts
+	ListServiceAccounts(ctx context.Context, in *ListServiceAccountsRequest, opts ...grpc.CallOption) (*ListServiceAccountsResponse, error)
+	// Issue a new key for a service account and retire the current ones
+	RotateServiceAccountKey(ctx context.Context, in *RotateServiceAccountKeyRequest, opts ...grpc.CallOption) (*RotateServiceAccountKeyResponse, error)
+	// Deactivate a service account and revoke its keys
+	DeleteServiceAccount(ctx context.Context, in *DeleteServiceAccountRequest, opts ...grpc.CallOption) (*DeleteServiceAccountResponse, error)
+	// Suspend a member: they cannot sign in and their tokens stop working
+	SuspendUser(ctx context.Context, in *SuspendUserRequest, opts ...grpc.CallOption) (*SuspendUserResponse, error)
+	// Lift a suspension
+	ReactivateUser(ctx context.Context, in *ReactivateUserRequest, opts ...grpc.CallOption) (*ReactivateUserResponse, error)
+	// Export everything stored about a user as a JSON document or ZIP bundle
+	ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*ExportUserDataResponse, error)
+	// Start erasing a user across all services
+	RequestDataErasure(ctx context.Context, in *RequestDataErasureRequest, opts ...grpc.CallOption) (*RequestDataErasureResponse, error)
+	// Check the progress of an erasure
+	GetDataErasureRequest(ctx context.Context, in *GetDataErasureRequestRequest, opts ...grpc.CallOption) (*GetDataErasureRequestResponse, error)
+	// Issue a fresh token for a pending invite and email it again
+	ResendInvite(ctx context.Context, in *ResendInviteRequest, opts ...grpc.CallOption) (*ResendInviteResponse, error)
+	// Revoke a pending invite so its token can no longer be accepted
+	RevokeInvite(ctx context.Context, in *RevokeInviteRequest, opts ...grpc.CallOption) (*RevokeInviteResponse, error)
+	// Recent login attempts for a user (the user or their org admin)
+	GetLoginHistory(ctx context.Context, in *GetLoginHistoryRequest, opts ...grpc.CallOption) (*GetLoginHistoryResponse, error)
+	// List platform super admins (super admin only)
+	ListSuperAdmins(ctx context.Context, in *ListSuperAdminsRequest, opts ...grpc.CallOption) (*ListSuperAdminsResponse, error)
+	// Propose making a user super admin; takes effect once approved
+	GrantSuperAdmin(ctx context.Context, in *GrantSuperAdminRequest, opts ...grpc.CallOption) (*SuperAdminChangeResponse, error)
+	// Propose removing a user's super admin role; takes effect once approved
+	RevokeSuperAdmin(ctx context.Context, in *RevokeSuperAdminRequest, opts ...grpc.CallOption) (*SuperAdminChangeResponse, error)
+	// List proposed super admin changes
+	ListSuperAdminChanges(ctx context.Context, in *ListSuperAdminChangesRequest, opts ...grpc.CallOption) (*ListSuperAdminChangesResponse, error)
+	// Approve a proposed change as another super admin
+	ApproveSuperAdminChange(ctx context.Context, in *ApproveSuperAdminChangeRequest, opts ...grpc.CallOption) (*SuperAdminChangeResponse, error)
+	// Reject (or, as its requester, withdraw) a proposed change
+	RejectSuperAdminChange(ctx context.Context, in *RejectSuperAdminChangeRequest, opts ...grpc.CallOption) (*SuperAdminChangeResponse, error)
+	// Organizations the caller belongs to, with their role in each
+	ListMyOrganizations(ctx context.Context, in *ListMyOrganizationsRequest, opts ...grpc.CallOption) (*ListMyOrganizationsResponse, error)
+	// Issue tokens scoped to another organization the caller belongs to
+	SwitchOrganization(ctx context.Context, in *SwitchOrganizationRequest, opts ...grpc.CallOption) (*SwitchOrganizationResponse, error)
+	// Change a member's role within one organization (org admin only)
+	UpdateOrganizationMemberRole(ctx context.Context, in *UpdateOrganizationMemberRoleRequest, opts ...grpc.CallOption) (*UpdateOrganizationMemberRoleResponse, error)
+	// Email a password reset link (public; the response never reveals whether
+	// the account exists)
+	ForgotPassword(ctx context.Context, in *ForgotPasswordRequest, opts ...grpc.CallOption) (*ForgotPasswordResponse, error)
+	// Set a new password using the token from a reset email (public)
+	ResetPasswordWithToken(ctx context.Context, in *ResetPasswordWithTokenRequest, opts ...grpc.CallOption) (*ResetPasswordWithTokenResponse, error)
+	// Email domains an organization accepts for invites and new members
+	GetInviteDomainPolicy(ctx context.Context, in *GetInviteDomainPolicyRequest, opts ...grpc.CallOption) (*InviteDomainPolicy, error)
+	// Replace an organization's invite domain allowlist (org admin only)
+	UpdateInviteDomainPolicy(ctx context.Context, in *UpdateInviteDomainPolicyRequest, opts ...grpc.CallOption) (*InviteDomainPolicy, error)
+	// Schedule erasure of the caller's account after a grace period. Signing
+	// in again before it ends cancels the deletion.
+	DeleteMyAccount(ctx context.Context, in *DeleteMyAccountRequest, opts ...grpc.CallOption) (*DeleteMyAccountResponse, error)
+	// Get the extra profile fields an organization defines
+	GetProfileFields(ctx context.Context, in *GetProfileFieldsRequest, opts ...grpc.CallOption) (*ProfileFieldSchema, error)
+	// Replace an organization's extra profile fields
+	UpdateProfileFields(ctx context.Context, in *UpdateProfileFieldsRequest, opts ...grpc.CallOption) (*ProfileFieldSchema, error)
+	// Set a member's values for an organization's profile fields
+	UpdateProfileAttributes(ctx context.Context, in *UpdateProfileAttributesRequest, opts ...grpc.CallOption) (*UpdateProfileAttributesResponse, error)
+	// Get an organization's sign-in security settings
+	GetOrgSecuritySettings(ctx context.Context, in *GetOrgSecuritySettingsRequest, opts ...grpc.CallOption) (*OrgSecuritySettings, error)
+	// Change an organization's sign-in security settings
+	UpdateOrgSecuritySettings(ctx context.Context, in *UpdateOrgSecuritySettingsRequest, opts ...grpc.CallOption) (*OrgSecuritySettings, error)
+	// Start registering a passkey for the caller
+	BeginPasskeyRegistration(ctx context.Context, in *BeginPasskeyRegistrationRequest, opts ...grpc.CallOption) (*PasskeyRegistrationOptions, error)
+	// Complete a passkey registration with the authenticator's response
+	FinishPasskeyRegistration(ctx context.Context, in *FinishPasskeyRegistrationRequest, opts ...grpc.CallOption) (*Passkey, error)
+	// List the caller's passkeys
+	ListPasskeys(ctx context.Context, in *ListPasskeysRequest, opts ...grpc.CallOption) (*ListPasskeysResponse, error)
+	// Remove one of the caller's passkeys
+	DeletePasskey(ctx context.Context, in *DeletePasskeyRequest, opts ...grpc.CallOption) (*DeletePasskeyResponse, error)
+	// List the caller's signed-in sessions
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	// Sign one of the caller's sessions out
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error)
+	// Start a passkey sign-in
+	BeginPasskeyLogin(ctx context.Context, in *BeginPasskeyLoginRequest, opts ...grpc.CallOption) (*PasskeyLoginOptions, error)
+	// Complete a passkey sign-in and return tokens
+	FinishPasskeyLogin(ctx context.Context, in *FinishPasskeyLoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	// Summarise the accounts in an organization that need an admin's attention
+	GetSecurityDashboard(ctx context.Context, in *GetSecurityDashboardRequest, opts ...grpc.CallOption) (*SecurityDashboard, error)
 }
 
 type userServiceClient struct {
@@ -337,569 +499,2321 @@ func (c *userServiceClient) AdminResetPassword(ctx context.Context, in *AdminRes
 	return out, nil
 }
 
-// UserServiceServer is the server API for UserService service.
-// All implementations must embed UnimplementedUserServiceServer
-// for forward compatibility.
-//
-// UserService handles authentication and user profile management
-type UserServiceServer interface {
-	// Register a new user
-	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
-	// Login user and return JWT token
-	Login(context.Context, *LoginRequest) (*LoginResponse, error)
-	// Get user profile by ID
-	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
-	// Update user profile
-	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
-	// Delete user
-	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
-	// List all users (admin only)
-	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
-	// Validate JWT token
-	ValidateToken(context.Context, *ValidateTokenRequest) (*ValidateTokenResponse, error)
-	// Invite a user to an organization (org-admin only)
-	InviteUser(context.Context, *InviteRequest) (*InviteResponse, error)
-	// Accept an invite using token to complete registration
-	AcceptInvite(context.Context, *AcceptInviteRequest) (*AcceptInviteResponse, error)
-	// List invites for an organization (org-admin or global admin)
-	ListInvites(context.Context, *ListInvitesRequest) (*ListInvitesResponse, error)
-	// Register a new organization with admin user (public)
-	RegisterOrganization(context.Context, *RegisterOrganizationRequest) (*RegisterOrganizationResponse, error)
-	// List all organizations (super admin only)
-	ListAllOrganizations(context.Context, *ListAllOrganizationsRequest) (*ListAllOrganizationsResponse, error)
-	// Get platform analytics (super admin only)
-	GetPlatformAnalytics(context.Context, *GetPlatformAnalyticsRequest) (*GetPlatformAnalyticsResponse, error)
-	// List all users across platform (super admin only)
-	ListAllUsers(context.Context, *ListAllUsersRequest) (*ListAllUsersResponse, error)
-	// Delete organization (super admin only)
-	DeleteOrganization(context.Context, *DeleteOrganizationRequest) (*DeleteOrganizationResponse, error)
-	// List organization members (org admin or super admin)
-	ListOrganizationMembers(context.Context, *ListOrganizationMembersRequest) (*ListOrganizationMembersResponse, error)
-	// Remove member from organization (org admin or super admin)
-	RemoveOrganizationMember(context.Context, *RemoveOrganizationMemberRequest) (*RemoveOrganizationMemberResponse, error)
-	// Create organization member directly (org admin or super admin)
-	// Auto-generates username and one-time password
-	CreateOrganizationMember(context.Context, *CreateOrganizationMemberRequest) (*CreateOrganizationMemberResponse, error)
-	// Get organization details (org admin or super admin)
-	GetOrganization(context.Context, *GetOrganizationRequest) (*GetOrganizationResponse, error)
-	// Set security questions (required on first login)
-	SetSecurityQuestions(context.Context, *SetSecurityQuestionsRequest) (*SetSecurityQuestionsResponse, error)
-	// Reset password with old password
-	ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordResponse, error)
-	// Reset password using security questions
-	ResetPasswordWithQuestions(context.Context, *ResetPasswordWithQuestionsRequest) (*ResetPasswordWithQuestionsResponse, error)
-	// Admin force reset password (generates new temp password)
-	AdminResetPassword(context.Context, *AdminResetPasswordRequest) (*AdminResetPasswordResponse, error)
-	mustEmbedUnimplementedUserServiceServer()
+func (c *userServiceClient) ResolveUsernames(ctx context.Context, in *ResolveUsernamesRequest, opts ...grpc.CallOption) (*ResolveUsernamesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveUsernamesResponse)
+	err := c.cc.Invoke(ctx, UserService_ResolveUsernames_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedUserServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedUserServiceServer struct{}
+func (c *userServiceClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefreshTokenResponse)
+	err := c.cc.Invoke(ctx, UserService_RefreshToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func (UnimplementedUserServiceServer) Register(context.Context, *RegisterRequest) (*RegisterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+func (c *userServiceClient) UnlockUser(ctx context.Context, in *UnlockUserRequest, opts ...grpc.CallOption) (*UnlockUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlockUserResponse)
+	err := c.cc.Invoke(ctx, UserService_UnlockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+
+func (c *userServiceClient) ListAuditLogs(ctx context.Context, in *ListAuditLogsRequest, opts ...grpc.CallOption) (*ListAuditLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditLogsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListAuditLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+
+func (c *userServiceClient) GetLDAPConfig(ctx context.Context, in *GetLDAPConfigRequest, opts ...grpc.CallOption) (*GetLDAPConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLDAPConfigResponse)
+	err := c.cc.Invoke(ctx, UserService_GetLDAPConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+
+func (c *userServiceClient) UpsertLDAPConfig(ctx context.Context, in *UpsertLDAPConfigRequest, opts ...grpc.CallOption) (*UpsertLDAPConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpsertLDAPConfigResponse)
+	err := c.cc.Invoke(ctx, UserService_UpsertLDAPConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+
+func (c *userServiceClient) SyncLDAP(ctx context.Context, in *SyncLDAPRequest, opts ...grpc.CallOption) (*SyncLDAPResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SyncLDAPResponse)
+	err := c.cc.Invoke(ctx, UserService_SyncLDAP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+
+func (c *userServiceClient) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAPIKeyResponse)
+	err := c.cc.Invoke(ctx, UserService_CreateAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) ValidateToken(context.Context, *ValidateTokenRequest) (*ValidateTokenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidateToken not implemented")
+
+func (c *userServiceClient) ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAPIKeysResponse)
+	err := c.cc.Invoke(ctx, UserService_ListAPIKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) InviteUser(context.Context, *InviteRequest) (*InviteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InviteUser not implemented")
+
+func (c *userServiceClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeAPIKeyResponse)
+	err := c.cc.Invoke(ctx, UserService_RevokeAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) AcceptInvite(context.Context, *AcceptInviteRequest) (*AcceptInviteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AcceptInvite not implemented")
+
+func (c *userServiceClient) ValidateAPIKey(ctx context.Context, in *ValidateAPIKeyRequest, opts ...grpc.CallOption) (*ValidateAPIKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateAPIKeyResponse)
+	err := c.cc.Invoke(ctx, UserService_ValidateAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) ListInvites(context.Context, *ListInvitesRequest) (*ListInvitesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListInvites not implemented")
+
+func (c *userServiceClient) GetOrgIPAllowlist(ctx context.Context, in *GetOrgIPAllowlistRequest, opts ...grpc.CallOption) (*GetOrgIPAllowlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrgIPAllowlistResponse)
+	err := c.cc.Invoke(ctx, UserService_GetOrgIPAllowlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) RegisterOrganization(context.Context, *RegisterOrganizationRequest) (*RegisterOrganizationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterOrganization not implemented")
+
+func (c *userServiceClient) RecordBlockedAccess(ctx context.Context, in *RecordBlockedAccessRequest, opts ...grpc.CallOption) (*RecordBlockedAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordBlockedAccessResponse)
+	err := c.cc.Invoke(ctx, UserService_RecordBlockedAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) ListAllOrganizations(context.Context, *ListAllOrganizationsRequest) (*ListAllOrganizationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAllOrganizations not implemented")
+
+func (c *userServiceClient) CreateServiceAccount(ctx context.Context, in *CreateServiceAccountRequest, opts ...grpc.CallOption) (*CreateServiceAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateServiceAccountResponse)
+	err := c.cc.Invoke(ctx, UserService_CreateServiceAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) GetPlatformAnalytics(context.Context, *GetPlatformAnalyticsRequest) (*GetPlatformAnalyticsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPlatformAnalytics not implemented")
+
+func (c *userServiceClient) ListServiceAccounts(ctx context.Context, in *ListServiceAccountsRequest, opts ...grpc.CallOption) (*ListServiceAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListServiceAccountsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListServiceAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) ListAllUsers(context.Context, *ListAllUsersRequest) (*ListAllUsersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAllUsers not implemented")
+
+func (c *userServiceClient) RotateServiceAccountKey(ctx context.Context, in *RotateServiceAccountKeyRequest, opts ...grpc.CallOption) (*RotateServiceAccountKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateServiceAccountKeyResponse)
+	err := c.cc.Invoke(ctx, UserService_RotateServiceAccountKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) DeleteOrganization(context.Context, *DeleteOrganizationRequest) (*DeleteOrganizationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteOrganization not implemented")
+
+func (c *userServiceClient) DeleteServiceAccount(ctx context.Context, in *DeleteServiceAccountRequest, opts ...grpc.CallOption) (*DeleteServiceAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteServiceAccountResponse)
+	err := c.cc.Invoke(ctx, UserService_DeleteServiceAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) ListOrganizationMembers(context.Context, *ListOrganizationMembersRequest) (*ListOrganizationMembersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListOrganizationMembers not implemented")
+
+func (c *userServiceClient) SuspendUser(ctx context.Context, in *SuspendUserRequest, opts ...grpc.CallOption) (*SuspendUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuspendUserResponse)
+	err := c.cc.Invoke(ctx, UserService_SuspendUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) RemoveOrganizationMember(context.Context, *RemoveOrganizationMemberRequest) (*RemoveOrganizationMemberResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveOrganizationMember not implemented")
+
+func (c *userServiceClient) ReactivateUser(ctx context.Context, in *ReactivateUserRequest, opts ...grpc.CallOption) (*ReactivateUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReactivateUserResponse)
+	err := c.cc.Invoke(ctx, UserService_ReactivateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) CreateOrganizationMember(context.Context, *CreateOrganizationMemberRequest) (*CreateOrganizationMemberResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateOrganizationMember not implemented")
+
+func (c *userServiceClient) ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*ExportUserDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportUserDataResponse)
+	err := c.cc.Invoke(ctx, UserService_ExportUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) GetOrganization(context.Context, *GetOrganizationRequest) (*GetOrganizationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrganization not implemented")
+
+func (c *userServiceClient) RequestDataErasure(ctx context.Context, in *RequestDataErasureRequest, opts ...grpc.CallOption) (*RequestDataErasureResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestDataErasureResponse)
+	err := c.cc.Invoke(ctx, UserService_RequestDataErasure_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) SetSecurityQuestions(context.Context, *SetSecurityQuestionsRequest) (*SetSecurityQuestionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetSecurityQuestions not implemented")
+
+func (c *userServiceClient) GetDataErasureRequest(ctx context.Context, in *GetDataErasureRequestRequest, opts ...grpc.CallOption) (*GetDataErasureRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDataErasureRequestResponse)
+	err := c.cc.Invoke(ctx, UserService_GetDataErasureRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
+
+func (c *userServiceClient) ResendInvite(ctx context.Context, in *ResendInviteRequest, opts ...grpc.CallOption) (*ResendInviteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResendInviteResponse)
+	err := c.cc.Invoke(ctx, UserService_ResendInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) ResetPasswordWithQuestions(context.Context, *ResetPasswordWithQuestionsRequest) (*ResetPasswordWithQuestionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ResetPasswordWithQuestions not implemented")
+
+func (c *userServiceClient) RevokeInvite(ctx context.Context, in *RevokeInviteRequest, opts ...grpc.CallOption) (*RevokeInviteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeInviteResponse)
+	err := c.cc.Invoke(ctx, UserService_RevokeInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) AdminResetPassword(context.Context, *AdminResetPasswordRequest) (*AdminResetPasswordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AdminResetPassword not implemented")
+
+func (c *userServiceClient) GetLoginHistory(ctx context.Context, in *GetLoginHistoryRequest, opts ...grpc.CallOption) (*GetLoginHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLoginHistoryResponse)
+	err := c.cc.Invoke(ctx, UserService_GetLoginHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListSuperAdmins(ctx context.Context, in *ListSuperAdminsRequest, opts ...grpc.CallOption) (*ListSuperAdminsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSuperAdminsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListSuperAdmins_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GrantSuperAdmin(ctx context.Context, in *GrantSuperAdminRequest, opts ...grpc.CallOption) (*SuperAdminChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuperAdminChangeResponse)
+	err := c.cc.Invoke(ctx, UserService_GrantSuperAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RevokeSuperAdmin(ctx context.Context, in *RevokeSuperAdminRequest, opts ...grpc.CallOption) (*SuperAdminChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuperAdminChangeResponse)
+	err := c.cc.Invoke(ctx, UserService_RevokeSuperAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListSuperAdminChanges(ctx context.Context, in *ListSuperAdminChangesRequest, opts ...grpc.CallOption) (*ListSuperAdminChangesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSuperAdminChangesResponse)
+	err := c.cc.Invoke(ctx, UserService_ListSuperAdminChanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ApproveSuperAdminChange(ctx context.Context, in *ApproveSuperAdminChangeRequest, opts ...grpc.CallOption) (*SuperAdminChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuperAdminChangeResponse)
+	err := c.cc.Invoke(ctx, UserService_ApproveSuperAdminChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RejectSuperAdminChange(ctx context.Context, in *RejectSuperAdminChangeRequest, opts ...grpc.CallOption) (*SuperAdminChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuperAdminChangeResponse)
+	err := c.cc.Invoke(ctx, UserService_RejectSuperAdminChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListMyOrganizations(ctx context.Context, in *ListMyOrganizationsRequest, opts ...grpc.CallOption) (*ListMyOrganizationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMyOrganizationsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListMyOrganizations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SwitchOrganization(ctx context.Context, in *SwitchOrganizationRequest, opts ...grpc.CallOption) (*SwitchOrganizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SwitchOrganizationResponse)
+	err := c.cc.Invoke(ctx, UserService_SwitchOrganization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateOrganizationMemberRole(ctx context.Context, in *UpdateOrganizationMemberRoleRequest, opts ...grpc.CallOption) (*UpdateOrganizationMemberRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateOrganizationMemberRoleResponse)
+	err := c.cc.Invoke(ctx, UserService_UpdateOrganizationMemberRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ForgotPassword(ctx context.Context, in *ForgotPasswordRequest, opts ...grpc.CallOption) (*ForgotPasswordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ForgotPasswordResponse)
+	err := c.cc.Invoke(ctx, UserService_ForgotPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ResetPasswordWithToken(ctx context.Context, in *ResetPasswordWithTokenRequest, opts ...grpc.CallOption) (*ResetPasswordWithTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetPasswordWithTokenResponse)
+	err := c.cc.Invoke(ctx, UserService_ResetPasswordWithToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetInviteDomainPolicy(ctx context.Context, in *GetInviteDomainPolicyRequest, opts ...grpc.CallOption) (*InviteDomainPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InviteDomainPolicy)
+	err := c.cc.Invoke(ctx, UserService_GetInviteDomainPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateInviteDomainPolicy(ctx context.Context, in *UpdateInviteDomainPolicyRequest, opts ...grpc.CallOption) (*InviteDomainPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InviteDomainPolicy)
+	err := c.cc.Invoke(ctx, UserService_UpdateInviteDomainPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteMyAccount(ctx context.Context, in *DeleteMyAccountRequest, opts ...grpc.CallOption) (*DeleteMyAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteMyAccountResponse)
+	err := c.cc.Invoke(ctx, UserService_DeleteMyAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetProfileFields(ctx context.Context, in *GetProfileFieldsRequest, opts ...grpc.CallOption) (*ProfileFieldSchema, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProfileFieldSchema)
+	err := c.cc.Invoke(ctx, UserService_GetProfileFields_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateProfileFields(ctx context.Context, in *UpdateProfileFieldsRequest, opts ...grpc.CallOption) (*ProfileFieldSchema, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProfileFieldSchema)
+	err := c.cc.Invoke(ctx, UserService_UpdateProfileFields_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateProfileAttributes(ctx context.Context, in *UpdateProfileAttributesRequest, opts ...grpc.CallOption) (*UpdateProfileAttributesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateProfileAttributesResponse)
+	err := c.cc.Invoke(ctx, UserService_UpdateProfileAttributes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetOrgSecuritySettings(ctx context.Context, in *GetOrgSecuritySettingsRequest, opts ...grpc.CallOption) (*OrgSecuritySettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrgSecuritySettings)
+	err := c.cc.Invoke(ctx, UserService_GetOrgSecuritySettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateOrgSecuritySettings(ctx context.Context, in *UpdateOrgSecuritySettingsRequest, opts ...grpc.CallOption) (*OrgSecuritySettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrgSecuritySettings)
+	err := c.cc.Invoke(ctx, UserService_UpdateOrgSecuritySettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) BeginPasskeyRegistration(ctx context.Context, in *BeginPasskeyRegistrationRequest, opts ...grpc.CallOption) (*PasskeyRegistrationOptions, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PasskeyRegistrationOptions)
+	err := c.cc.Invoke(ctx, UserService_BeginPasskeyRegistration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) FinishPasskeyRegistration(ctx context.Context, in *FinishPasskeyRegistrationRequest, opts ...grpc.CallOption) (*Passkey, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Passkey)
+	err := c.cc.Invoke(ctx, UserService_FinishPasskeyRegistration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListPasskeys(ctx context.Context, in *ListPasskeysRequest, opts ...grpc.CallOption) (*ListPasskeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPasskeysResponse)
+	err := c.cc.Invoke(ctx, UserService_ListPasskeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeletePasskey(ctx context.Context, in *DeletePasskeyRequest, opts ...grpc.CallOption) (*DeletePasskeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePasskeyResponse)
+	err := c.cc.Invoke(ctx, UserService_DeletePasskey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeSessionResponse)
+	err := c.cc.Invoke(ctx, UserService_RevokeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) BeginPasskeyLogin(ctx context.Context, in *BeginPasskeyLoginRequest, opts ...grpc.CallOption) (*PasskeyLoginOptions, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PasskeyLoginOptions)
+	err := c.cc.Invoke(ctx, UserService_BeginPasskeyLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) FinishPasskeyLogin(ctx context.Context, in *FinishPasskeyLoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, UserService_FinishPasskeyLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetSecurityDashboard(ctx context.Context, in *GetSecurityDashboardRequest, opts ...grpc.CallOption) (*SecurityDashboard, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SecurityDashboard)
+	err := c.cc.Invoke(ctx, UserService_GetSecurityDashboard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UserServiceServer is the server API for UserService service.
+// All implementations must embed UnimplementedUserServiceServer
+// for forward compatibility.
+//
+// UserService handles authentication and user profile management
+type UserServiceServer interface {
+	// Register a new user
+	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
+	// Login user and return JWT token
+	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	// Get user profile by ID
+	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
+	// Update user profile
+	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
+	// Delete user
+	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
+	// List all users (admin only)
+	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	// Validate JWT token
+	ValidateToken(context.Context, *ValidateTokenRequest) (*ValidateTokenResponse, error)
+	// Invite a user to an organization (org-admin only)
+	InviteUser(context.Context, *InviteRequest) (*InviteResponse, error)
+	// Accept an invite using token to complete registration
+	AcceptInvite(context.Context, *AcceptInviteRequest) (*AcceptInviteResponse, error)
+	// List invites for an organization (org-admin or global admin)
+	ListInvites(context.Context, *ListInvitesRequest) (*ListInvitesResponse, error)
+	// Register a new organization with admin user (public)
+	RegisterOrganization(context.Context, *RegisterOrganizationRequest) (*RegisterOrganizationResponse, error)
+	// List all organizations (super admin only)
+	ListAllOrganizations(context.Context, *ListAllOrganizationsRequest) (*ListAllOrganizationsResponse, error)
+	// Get platform analytics (super admin only)
+	GetPlatformAnalytics(context.Context, *GetPlatformAnalyticsRequest) (*GetPlatformAnalyticsResponse, error)
+	// List all users across platform (super admin only)
+	ListAllUsers(context.Context, *ListAllUsersRequest) (*ListAllUsersResponse, error)
+	// Delete organization (super admin only)
+	DeleteOrganization(context.Context, *DeleteOrganizationRequest) (*DeleteOrganizationResponse, error)
+	// List organization members (org admin or super admin)
+	ListOrganizationMembers(context.Context, *ListOrganizationMembersRequest) (*ListOrganizationMembersResponse, error)
+	// Remove member from organization (org admin or super admin)
+	RemoveOrganizationMember(context.Context, *RemoveOrganizationMemberRequest) (*RemoveOrganizationMemberResponse, error)
+	// Create organization member directly (org admin or super admin)
+	// Auto-generates username and one-time password
+	CreateOrganizationMember(context.Context, *CreateOrganizationMemberRequest) (*CreateOrganizationMemberResponse, error)
+	// Get organization details (org admin or super admin)
+	GetOrganization(context.Context, *GetOrganizationRequest) (*GetOrganizationResponse, error)
+	// Set security questions (required on first login)
+	SetSecurityQuestions(context.Context, *SetSecurityQuestionsRequest) (*SetSecurityQuestionsResponse, error)
+	// Reset password with old password
+	ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordResponse, error)
+	// Reset password using security questions
+	ResetPasswordWithQuestions(context.Context, *ResetPasswordWithQuestionsRequest) (*ResetPasswordWithQuestionsResponse, error)
+	// Admin force reset password (generates new temp password)
+	AdminResetPassword(context.Context, *AdminResetPasswordRequest) (*AdminResetPasswordResponse, error)
+	// Resolve usernames to users within an org (internal, used for @mentions)
+	ResolveUsernames(context.Context, *ResolveUsernamesRequest) (*ResolveUsernamesResponse, error)
+	// Exchange a refresh token for a new access/refresh token pair
+	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
+	// Admin unlock of an account locked after failed logins
+	UnlockUser(context.Context, *UnlockUserRequest) (*UnlockUserResponse, error)
+	// Query the audit log (org admins see their org, super admins any org)
+	ListAuditLogs(context.Context, *ListAuditLogsRequest) (*ListAuditLogsResponse, error)
+	// Get an organization's LDAP / Active Directory sync configuration
+	GetLDAPConfig(context.Context, *GetLDAPConfigRequest) (*GetLDAPConfigResponse, error)
+	// Create or update an organization's LDAP sync configuration
+	UpsertLDAPConfig(context.Context, *UpsertLDAPConfigRequest) (*UpsertLDAPConfigResponse, error)
+	// Run an LDAP sync for an organization now
+	SyncLDAP(context.Context, *SyncLDAPRequest) (*SyncLDAPResponse, error)
+	// Create a personal API key; the key itself is only returned here
+	CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error)
+	// List the caller's API keys
+	ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error)
+	// Revoke one of the caller's API keys
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error)
+	// Resolve an API key to its owner (internal, used by the gateway)
+	ValidateAPIKey(context.Context, *ValidateAPIKeyRequest) (*ValidateAPIKeyResponse, error)
+	// Fetch the networks an org's members may connect from (internal, used
+	// by the gateway)
+	GetOrgIPAllowlist(context.Context, *GetOrgIPAllowlistRequest) (*GetOrgIPAllowlistResponse, error)
+	// Audit a request the gateway blocked by an org's IP allowlist (internal)
+	RecordBlockedAccess(context.Context, *RecordBlockedAccessRequest) (*RecordBlockedAccessResponse, error)
+	// Create a service account with its first API key
+	CreateServiceAccount(context.Context, *CreateServiceAccountRequest) (*CreateServiceAccountResponse, error)
+	// List an organization's service accounts
+	ListServiceAccounts(context.Context, *ListServiceAccountsRequest) (*ListServiceAccountsResponse, error)
+	// Issue a new key for a service account and retire the current ones
+	RotateServiceAccountKey(context.Context, *RotateServiceAccountKeyRequest) (*RotateServiceAccountKeyResponse, error)
+	// Deactivate a service account and revoke its keys
+	DeleteServiceAccount(context.Context, *DeleteServiceAccountRequest) (*DeleteServiceAccountResponse, error)
+	// Suspend a member: they cannot sign in and their tokens stop working
+	SuspendUser(context.Context, *SuspendUserRequest) (*SuspendUserResponse, error)
+	// Lift a suspension
+	ReactivateUser(context.Context, *ReactivateUserRequest) (*ReactivateUserResponse, error)
+	// Export everything stored about a user as a JSON document or ZIP bundle
+	ExportUserData(context.Context, *ExportUserDataRequest) (*ExportUserDataResponse, error)
+	// Start erasing a user across all services
+	RequestDataErasure(context.Context, *RequestDataErasureRequest) (*RequestDataErasureResponse, error)
+	// Check the progress of an erasure
+	GetDataErasureRequest(context.Context, *GetDataErasureRequestRequest) (*GetDataErasureRequestResponse, error)
+	// Issue a fresh token for a pending invite and email it again
+	ResendInvite(context.Context, *ResendInviteRequest) (*ResendInviteResponse, error)
+	// Revoke a pending invite so its token can no longer be accepted
+	RevokeInvite(context.Context, *RevokeInviteRequest) (*RevokeInviteResponse, error)
+	// Recent login attempts for a user (the user or their org admin)
+	GetLoginHistory(context.Context, *GetLoginHistoryRequest) (*GetLoginHistoryResponse, error)
+	// List platform super admins (super admin only)
+	ListSuperAdmins(context.Context, *ListSuperAdminsRequest) (*ListSuperAdminsResponse, error)
+	// Propose making a user super admin; takes effect once approved
+	GrantSuperAdmin(context.Context, *GrantSuperAdminRequest) (*SuperAdminChangeResponse, error)
+	// Propose removing a user's super admin role; takes effect once approved
+	RevokeSuperAdmin(context.Context, *RevokeSuperAdminRequest) (*SuperAdminChangeResponse, error)
+	// List proposed super admin changes
+	ListSuperAdminChanges(context.Context, *ListSuperAdminChangesRequest) (*ListSuperAdminChangesResponse, error)
+	// Approve a proposed change as another super admin
+	ApproveSuperAdminChange(context.Context, *ApproveSuperAdminChangeRequest) (*SuperAdminChangeResponse, error)
+	// Reject (or, as its requester, withdraw) a proposed change
+	RejectSuperAdminChange(context.Context, *RejectSuperAdminChangeRequest) (*SuperAdminChangeResponse, error)
+	// Organizations the caller belongs to, with their role in each
+	ListMyOrganizations(context.Context, *ListMyOrganizationsRequest) (*ListMyOrganizationsResponse, error)
+	// Issue tokens scoped to another organization the caller belongs to
+	SwitchOrganization(context.Context, *SwitchOrganizationRequest) (*SwitchOrganizationResponse, error)
+	// Change a member's role within one organization (org admin only)
+	UpdateOrganizationMemberRole(context.Context, *UpdateOrganizationMemberRoleRequest) (*UpdateOrganizationMemberRoleResponse, error)
+	// Email a password reset link (public; the response never reveals whether
+	// the account exists)
+	ForgotPassword(context.Context, *ForgotPasswordRequest) (*ForgotPasswordResponse, error)
+	// Set a new password using the token from a reset email (public)
+	ResetPasswordWithToken(context.Context, *ResetPasswordWithTokenRequest) (*ResetPasswordWithTokenResponse, error)
+	// Email domains an organization accepts for invites and new members
+	GetInviteDomainPolicy(context.Context, *GetInviteDomainPolicyRequest) (*InviteDomainPolicy, error)
+	// Replace an organization's invite domain allowlist (org admin only)
+	UpdateInviteDomainPolicy(context.Context, *UpdateInviteDomainPolicyRequest) (*InviteDomainPolicy, error)
+	// Schedule erasure of the caller's account after a grace period. Signing
+	// in again before it ends cancels the deletion.
+	DeleteMyAccount(context.Context, *DeleteMyAccountRequest) (*DeleteMyAccountResponse, error)
+	// Get the extra profile fields an organization defines
+	GetProfileFields(context.Context, *GetProfileFieldsRequest) (*ProfileFieldSchema, error)
+	// Replace an organization's extra profile fields
+	UpdateProfileFields(context.Context, *UpdateProfileFieldsRequest) (*ProfileFieldSchema, error)
+	// Set a member's values for an organization's profile fields
+	UpdateProfileAttributes(context.Context, *UpdateProfileAttributesRequest) (*UpdateProfileAttributesResponse, error)
+	// Get an organization's sign-in security settings
+	GetOrgSecuritySettings(context.Context, *GetOrgSecuritySettingsRequest) (*OrgSecuritySettings, error)
+	// Change an organization's sign-in security settings
+	UpdateOrgSecuritySettings(context.Context, *UpdateOrgSecuritySettingsRequest) (*OrgSecuritySettings, error)
+	// Start registering a passkey for the caller
+	BeginPasskeyRegistration(context.Context, *BeginPasskeyRegistrationRequest) (*PasskeyRegistrationOptions, error)
+	// Complete a passkey registration with the authenticator's response
+	FinishPasskeyRegistration(context.Context, *FinishPasskeyRegistrationRequest) (*Passkey, error)
+	// List the caller's passkeys
+	ListPasskeys(context.Context, *ListPasskeysRequest) (*ListPasskeysResponse, error)
+	// Remove one of the caller's passkeys
+	DeletePasskey(context.Context, *DeletePasskeyRequest) (*DeletePasskeyResponse, error)
+	// List the caller's signed-in sessions
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
+	// Sign one of the caller's sessions out
+	RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error)
+	// Start a passkey sign-in
+	BeginPasskeyLogin(context.Context, *BeginPasskeyLoginRequest) (*PasskeyLoginOptions, error)
+	// Complete a passkey sign-in and return tokens
+	FinishPasskeyLogin(context.Context, *FinishPasskeyLoginRequest) (*LoginResponse, error)
+	// Summarise the accounts in an organization that need an admin's attention
+	GetSecurityDashboard(context.Context, *GetSecurityDashboardRequest) (*SecurityDashboard, error)
+	mustEmbedUnimplementedUserServiceServer()
+}
+
+// UnimplementedUserServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedUserServiceServer struct{}
+
+func (UnimplementedUserServiceServer) Register(context.Context, *RegisterRequest) (*RegisterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+}
+func (UnimplementedUserServiceServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedUserServiceServer) GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedUserServiceServer) ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (UnimplementedUserServiceServer) ValidateToken(context.Context, *ValidateTokenRequest) (*ValidateTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateToken not implemented")
+}
+func (UnimplementedUserServiceServer) InviteUser(context.Context, *InviteRequest) (*InviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteUser not implemented")
+}
+func (UnimplementedUserServiceServer) AcceptInvite(context.Context, *AcceptInviteRequest) (*AcceptInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptInvite not implemented")
+}
+func (UnimplementedUserServiceServer) ListInvites(context.Context, *ListInvitesRequest) (*ListInvitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInvites not implemented")
+}
+func (UnimplementedUserServiceServer) RegisterOrganization(context.Context, *RegisterOrganizationRequest) (*RegisterOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterOrganization not implemented")
+}
+func (UnimplementedUserServiceServer) ListAllOrganizations(context.Context, *ListAllOrganizationsRequest) (*ListAllOrganizationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAllOrganizations not implemented")
+}
+func (UnimplementedUserServiceServer) GetPlatformAnalytics(context.Context, *GetPlatformAnalyticsRequest) (*GetPlatformAnalyticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlatformAnalytics not implemented")
+}
+func (UnimplementedUserServiceServer) ListAllUsers(context.Context, *ListAllUsersRequest) (*ListAllUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAllUsers not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteOrganization(context.Context, *DeleteOrganizationRequest) (*DeleteOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOrganization not implemented")
+}
+func (UnimplementedUserServiceServer) ListOrganizationMembers(context.Context, *ListOrganizationMembersRequest) (*ListOrganizationMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrganizationMembers not implemented")
+}
+func (UnimplementedUserServiceServer) RemoveOrganizationMember(context.Context, *RemoveOrganizationMemberRequest) (*RemoveOrganizationMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveOrganizationMember not implemented")
+}
+func (UnimplementedUserServiceServer) CreateOrganizationMember(context.Context, *CreateOrganizationMemberRequest) (*CreateOrganizationMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrganizationMember not implemented")
+}
+func (UnimplementedUserServiceServer) GetOrganization(context.Context, *GetOrganizationRequest) (*GetOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrganization not implemented")
+}
+func (UnimplementedUserServiceServer) SetSecurityQuestions(context.Context, *SetSecurityQuestionsRequest) (*SetSecurityQuestionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSecurityQuestions not implemented")
+}
+func (UnimplementedUserServiceServer) ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
+}
+func (UnimplementedUserServiceServer) ResetPasswordWithQuestions(context.Context, *ResetPasswordWithQuestionsRequest) (*ResetPasswordWithQuestionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPasswordWithQuestions not implemented")
+}
+func (UnimplementedUserServiceServer) AdminResetPassword(context.Context, *AdminResetPasswordRequest) (*AdminResetPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminResetPassword not implemented")
+}
+func (UnimplementedUserServiceServer) ResolveUsernames(context.Context, *ResolveUsernamesRequest) (*ResolveUsernamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveUsernames not implemented")
+}
+func (UnimplementedUserServiceServer) RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedUserServiceServer) UnlockUser(context.Context, *UnlockUserRequest) (*UnlockUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockUser not implemented")
+}
+func (UnimplementedUserServiceServer) ListAuditLogs(context.Context, *ListAuditLogsRequest) (*ListAuditLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditLogs not implemented")
+}
+func (UnimplementedUserServiceServer) GetLDAPConfig(context.Context, *GetLDAPConfigRequest) (*GetLDAPConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLDAPConfig not implemented")
+}
+func (UnimplementedUserServiceServer) UpsertLDAPConfig(context.Context, *UpsertLDAPConfigRequest) (*UpsertLDAPConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertLDAPConfig not implemented")
+}
+func (UnimplementedUserServiceServer) SyncLDAP(context.Context, *SyncLDAPRequest) (*SyncLDAPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncLDAP not implemented")
+}
+func (UnimplementedUserServiceServer) CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKey not implemented")
+}
+func (UnimplementedUserServiceServer) ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAPIKeys not implemented")
+}
+func (UnimplementedUserServiceServer) RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIKey not implemented")
+}
+func (UnimplementedUserServiceServer) ValidateAPIKey(context.Context, *ValidateAPIKeyRequest) (*ValidateAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateAPIKey not implemented")
+}
+func (UnimplementedUserServiceServer) GetOrgIPAllowlist(context.Context, *GetOrgIPAllowlistRequest) (*GetOrgIPAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrgIPAllowlist not implemented")
+}
+func (UnimplementedUserServiceServer) RecordBlockedAccess(context.Context, *RecordBlockedAccessRequest) (*RecordBlockedAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordBlockedAccess not implemented")
+}
+func (UnimplementedUserServiceServer) CreateServiceAccount(context.Context, *CreateServiceAccountRequest) (*CreateServiceAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServiceAccount not implemented")
+}
+func (UnimplementedUserServiceServer) ListServiceAccounts(context.Context, *ListServiceAccountsRequest) (*ListServiceAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServiceAccounts not implemented")
+}
+func (UnimplementedUserServiceServer) RotateServiceAccountKey(context.Context, *RotateServiceAccountKeyRequest) (*RotateServiceAccountKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateServiceAccountKey not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteServiceAccount(context.Context, *DeleteServiceAccountRequest) (*DeleteServiceAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteServiceAccount not implemented")
+}
+func (UnimplementedUserServiceServer) SuspendUser(context.Context, *SuspendUserRequest) (*SuspendUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendUser not implemented")
+}
+func (UnimplementedUserServiceServer) ReactivateUser(context.Context, *ReactivateUserRequest) (*ReactivateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReactivateUser not implemented")
+}
+func (UnimplementedUserServiceServer) ExportUserData(context.Context, *ExportUserDataRequest) (*ExportUserDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUserData not implemented")
+}
+func (UnimplementedUserServiceServer) RequestDataErasure(context.Context, *RequestDataErasureRequest) (*RequestDataErasureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestDataErasure not implemented")
+}
+func (UnimplementedUserServiceServer) GetDataErasureRequest(context.Context, *GetDataErasureRequestRequest) (*GetDataErasureRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataErasureRequest not implemented")
+}
+func (UnimplementedUserServiceServer) ResendInvite(context.Context, *ResendInviteRequest) (*ResendInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResendInvite not implemented")
+}
+func (UnimplementedUserServiceServer) RevokeInvite(context.Context, *RevokeInviteRequest) (*RevokeInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeInvite not implemented")
+}
+func (UnimplementedUserServiceServer) GetLoginHistory(context.Context, *GetLoginHistoryRequest) (*GetLoginHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoginHistory not implemented")
+}
+func (UnimplementedUserServiceServer) ListSuperAdmins(context.Context, *ListSuperAdminsRequest) (*ListSuperAdminsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSuperAdmins not implemented")
+}
+func (UnimplementedUserServiceServer) GrantSuperAdmin(context.Context, *GrantSuperAdminRequest) (*SuperAdminChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantSuperAdmin not implemented")
+}
+func (UnimplementedUserServiceServer) RevokeSuperAdmin(context.Context, *RevokeSuperAdminRequest) (*SuperAdminChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSuperAdmin not implemented")
+}
+func (UnimplementedUserServiceServer) ListSuperAdminChanges(context.Context, *ListSuperAdminChangesRequest) (*ListSuperAdminChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSuperAdminChanges not implemented")
+}
+func (UnimplementedUserServiceServer) ApproveSuperAdminChange(context.Context, *ApproveSuperAdminChangeRequest) (*SuperAdminChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveSuperAdminChange not implemented")
+}
+func (UnimplementedUserServiceServer) RejectSuperAdminChange(context.Context, *RejectSuperAdminChangeRequest) (*SuperAdminChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectSuperAdminChange not implemented")
+}
+func (UnimplementedUserServiceServer) ListMyOrganizations(context.Context, *ListMyOrganizationsRequest) (*ListMyOrganizationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyOrganizations not implemented")
+}
+func (UnimplementedUserServiceServer) SwitchOrganization(context.Context, *SwitchOrganizationRequest) (*SwitchOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwitchOrganization not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateOrganizationMemberRole(context.Context, *UpdateOrganizationMemberRoleRequest) (*UpdateOrganizationMemberRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrganizationMemberRole not implemented")
+}
+func (UnimplementedUserServiceServer) ForgotPassword(context.Context, *ForgotPasswordRequest) (*ForgotPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForgotPassword not implemented")
+}
+func (UnimplementedUserServiceServer) ResetPasswordWithToken(context.Context, *ResetPasswordWithTokenRequest) (*ResetPasswordWithTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPasswordWithToken not implemented")
+}
+func (UnimplementedUserServiceServer) GetInviteDomainPolicy(context.Context, *GetInviteDomainPolicyRequest) (*InviteDomainPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInviteDomainPolicy not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateInviteDomainPolicy(context.Context, *UpdateInviteDomainPolicyRequest) (*InviteDomainPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateInviteDomainPolicy not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteMyAccount(context.Context, *DeleteMyAccountRequest) (*DeleteMyAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMyAccount not implemented")
+}
+func (UnimplementedUserServiceServer) GetProfileFields(context.Context, *GetProfileFieldsRequest) (*ProfileFieldSchema, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProfileFields not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateProfileFields(context.Context, *UpdateProfileFieldsRequest) (*ProfileFieldSchema, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfileFields not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateProfileAttributes(context.Context, *UpdateProfileAttributesRequest) (*UpdateProfileAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfileAttributes not implemented")
+}
+func (UnimplementedUserServiceServer) GetOrgSecuritySettings(context.Context, *GetOrgSecuritySettingsRequest) (*OrgSecuritySettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrgSecuritySettings not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateOrgSecuritySettings(context.Context, *UpdateOrgSecuritySettingsRequest) (*OrgSecuritySettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrgSecuritySettings not implemented")
+}
+func (UnimplementedUserServiceServer) BeginPasskeyRegistration(context.Context, *BeginPasskeyRegistrationRequest) (*PasskeyRegistrationOptions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginPasskeyRegistration not implemented")
+}
+func (UnimplementedUserServiceServer) FinishPasskeyRegistration(context.Context, *FinishPasskeyRegistrationRequest) (*Passkey, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishPasskeyRegistration not implemented")
+}
+func (UnimplementedUserServiceServer) ListPasskeys(context.Context, *ListPasskeysRequest) (*ListPasskeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPasskeys not implemented")
+}
+func (UnimplementedUserServiceServer) DeletePasskey(context.Context, *DeletePasskeyRequest) (*DeletePasskeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePasskey not implemented")
+}
+func (UnimplementedUserServiceServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedUserServiceServer) RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedUserServiceServer) BeginPasskeyLogin(context.Context, *BeginPasskeyLoginRequest) (*PasskeyLoginOptions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginPasskeyLogin not implemented")
+}
+func (UnimplementedUserServiceServer) FinishPasskeyLogin(context.Context, *FinishPasskeyLoginRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishPasskeyLogin not implemented")
+}
+func (UnimplementedUserServiceServer) GetSecurityDashboard(context.Context, *GetSecurityDashboardRequest) (*SecurityDashboard, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSecurityDashboard not implemented")
+}
+func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
+func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UserServiceServer will
+// result in compilation errors.
+type UnsafeUserServiceServer interface {
+	mustEmbedUnimplementedUserServiceServer()
+}
+
+func RegisterUserServiceServer(s grpc.ServiceRegistrar, srv UserServiceServer) {
+	// If the following call pancis, it indicates UnimplementedUserServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&UserService_ServiceDesc, srv)
+}
+
+func _UserService_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_Register_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Register(ctx, req.(*RegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUser(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListUsers(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ValidateToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ValidateToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ValidateToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ValidateToken(ctx, req.(*ValidateTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_InviteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).InviteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_InviteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).InviteUser(ctx, req.(*InviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_AcceptInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AcceptInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_AcceptInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AcceptInvite(ctx, req.(*AcceptInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListInvites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListInvites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListInvites(ctx, req.(*ListInvitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RegisterOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RegisterOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RegisterOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RegisterOrganization(ctx, req.(*RegisterOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListAllOrganizations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllOrganizationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListAllOrganizations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListAllOrganizations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListAllOrganizations(ctx, req.(*ListAllOrganizationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetPlatformAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlatformAnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetPlatformAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetPlatformAnalytics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetPlatformAnalytics(ctx, req.(*GetPlatformAnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListAllUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListAllUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListAllUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListAllUsers(ctx, req.(*ListAllUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteOrganization(ctx, req.(*DeleteOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListOrganizationMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrganizationMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListOrganizationMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListOrganizationMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListOrganizationMembers(ctx, req.(*ListOrganizationMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RemoveOrganizationMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveOrganizationMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RemoveOrganizationMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RemoveOrganizationMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RemoveOrganizationMember(ctx, req.(*RemoveOrganizationMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreateOrganizationMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrganizationMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateOrganizationMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateOrganizationMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateOrganizationMember(ctx, req.(*CreateOrganizationMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetOrganization(ctx, req.(*GetOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetSecurityQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSecurityQuestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetSecurityQuestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetSecurityQuestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetSecurityQuestions(ctx, req.(*SetSecurityQuestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ResetPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ResetPassword(ctx, req.(*ResetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ResetPasswordWithQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordWithQuestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ResetPasswordWithQuestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ResetPasswordWithQuestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ResetPasswordWithQuestions(ctx, req.(*ResetPasswordWithQuestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_AdminResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminResetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AdminResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_AdminResetPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AdminResetPassword(ctx, req.(*AdminResetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ResolveUsernames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveUsernamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ResolveUsernames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ResolveUsernames_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ResolveUsernames(ctx, req.(*ResolveUsernamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RefreshToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UnlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UnlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UnlockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UnlockUser(ctx, req.(*UnlockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListAuditLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListAuditLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListAuditLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListAuditLogs(ctx, req.(*ListAuditLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetLDAPConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLDAPConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetLDAPConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetLDAPConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetLDAPConfig(ctx, req.(*GetLDAPConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpsertLDAPConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertLDAPConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpsertLDAPConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpsertLDAPConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpsertLDAPConfig(ctx, req.(*UpsertLDAPConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SyncLDAP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncLDAPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SyncLDAP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SyncLDAP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SyncLDAP(ctx, req.(*SyncLDAPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateAPIKey(ctx, req.(*CreateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListAPIKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAPIKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListAPIKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListAPIKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListAPIKeys(ctx, req.(*ListAPIKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RevokeAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RevokeAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RevokeAPIKey(ctx, req.(*RevokeAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ValidateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ValidateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ValidateAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ValidateAPIKey(ctx, req.(*ValidateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetOrgIPAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrgIPAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetOrgIPAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetOrgIPAllowlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetOrgIPAllowlist(ctx, req.(*GetOrgIPAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RecordBlockedAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordBlockedAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RecordBlockedAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RecordBlockedAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RecordBlockedAccess(ctx, req.(*RecordBlockedAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreateServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServiceAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateServiceAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateServiceAccount(ctx, req.(*CreateServiceAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListServiceAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServiceAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListServiceAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListServiceAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListServiceAccounts(ctx, req.(*ListServiceAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RotateServiceAccountKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateServiceAccountKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RotateServiceAccountKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RotateServiceAccountKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RotateServiceAccountKey(ctx, req.(*RotateServiceAccountKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteServiceAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteServiceAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteServiceAccount(ctx, req.(*DeleteServiceAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SuspendUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SuspendUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SuspendUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SuspendUser(ctx, req.(*SuspendUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ReactivateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactivateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ReactivateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ReactivateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ReactivateUser(ctx, req.(*ReactivateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ExportUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ExportUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ExportUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ExportUserData(ctx, req.(*ExportUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RequestDataErasure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestDataErasureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RequestDataErasure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RequestDataErasure_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RequestDataErasure(ctx, req.(*RequestDataErasureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetDataErasureRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataErasureRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetDataErasureRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetDataErasureRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetDataErasureRequest(ctx, req.(*GetDataErasureRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ResendInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResendInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ResendInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ResendInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ResendInvite(ctx, req.(*ResendInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RevokeInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RevokeInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RevokeInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RevokeInvite(ctx, req.(*RevokeInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetLoginHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoginHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetLoginHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetLoginHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetLoginHistory(ctx, req.(*GetLoginHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListSuperAdmins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSuperAdminsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListSuperAdmins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListSuperAdmins_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListSuperAdmins(ctx, req.(*ListSuperAdminsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GrantSuperAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantSuperAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GrantSuperAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GrantSuperAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GrantSuperAdmin(ctx, req.(*GrantSuperAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RevokeSuperAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSuperAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RevokeSuperAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RevokeSuperAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RevokeSuperAdmin(ctx, req.(*RevokeSuperAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
-func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
-// UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to UserServiceServer will
-// result in compilation errors.
-type UnsafeUserServiceServer interface {
-	mustEmbedUnimplementedUserServiceServer()
+func _UserService_ListSuperAdminChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSuperAdminChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListSuperAdminChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListSuperAdminChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListSuperAdminChanges(ctx, req.(*ListSuperAdminChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterUserServiceServer(s grpc.ServiceRegistrar, srv UserServiceServer) {
-	// If the following call pancis, it indicates UnimplementedUserServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _UserService_ApproveSuperAdminChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveSuperAdminChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&UserService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(UserServiceServer).ApproveSuperAdminChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ApproveSuperAdminChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ApproveSuperAdminChange(ctx, req.(*ApproveSuperAdminChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterRequest)
+func _UserService_RejectSuperAdminChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectSuperAdminChangeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).Register(ctx, in)
+		return srv.(UserServiceServer).RejectSuperAdminChange(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_Register_FullMethodName,
+		FullMethod: UserService_RejectSuperAdminChange_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).Register(ctx, req.(*RegisterRequest))
+		return srv.(UserServiceServer).RejectSuperAdminChange(ctx, req.(*RejectSuperAdminChangeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginRequest)
+func _UserService_ListMyOrganizations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyOrganizationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).Login(ctx, in)
+		return srv.(UserServiceServer).ListMyOrganizations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_Login_FullMethodName,
+		FullMethod: UserService_ListMyOrganizations_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).Login(ctx, req.(*LoginRequest))
+		return srv.(UserServiceServer).ListMyOrganizations(ctx, req.(*ListMyOrganizationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserRequest)
+func _UserService_SwitchOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwitchOrganizationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).GetUser(ctx, in)
+		return srv.(UserServiceServer).SwitchOrganization(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_GetUser_FullMethodName,
+		FullMethod: UserService_SwitchOrganization_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).GetUser(ctx, req.(*GetUserRequest))
+		return srv.(UserServiceServer).SwitchOrganization(ctx, req.(*SwitchOrganizationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserRequest)
+func _UserService_UpdateOrganizationMemberRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOrganizationMemberRoleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).UpdateUser(ctx, in)
+		return srv.(UserServiceServer).UpdateOrganizationMemberRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_UpdateUser_FullMethodName,
+		FullMethod: UserService_UpdateOrganizationMemberRole_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+		return srv.(UserServiceServer).UpdateOrganizationMemberRole(ctx, req.(*UpdateOrganizationMemberRoleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteUserRequest)
+func _UserService_ForgotPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForgotPasswordRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).DeleteUser(ctx, in)
+		return srv.(UserServiceServer).ForgotPassword(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_DeleteUser_FullMethodName,
+		FullMethod: UserService_ForgotPassword_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+		return srv.(UserServiceServer).ForgotPassword(ctx, req.(*ForgotPasswordRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListUsersRequest)
+func _UserService_ResetPasswordWithToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordWithTokenRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).ListUsers(ctx, in)
+		return srv.(UserServiceServer).ResetPasswordWithToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_ListUsers_FullMethodName,
+		FullMethod: UserService_ResetPasswordWithToken_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).ListUsers(ctx, req.(*ListUsersRequest))
+		return srv.(UserServiceServer).ResetPasswordWithToken(ctx, req.(*ResetPasswordWithTokenRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_ValidateToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ValidateTokenRequest)
+func _UserService_GetInviteDomainPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInviteDomainPolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).ValidateToken(ctx, in)
+		return srv.(UserServiceServer).GetInviteDomainPolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_ValidateToken_FullMethodName,
+		FullMethod: UserService_GetInviteDomainPolicy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).ValidateToken(ctx, req.(*ValidateTokenRequest))
+		return srv.(UserServiceServer).GetInviteDomainPolicy(ctx, req.(*GetInviteDomainPolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_InviteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InviteRequest)
+func _UserService_UpdateInviteDomainPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateInviteDomainPolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).InviteUser(ctx, in)
+		return srv.(UserServiceServer).UpdateInviteDomainPolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_InviteUser_FullMethodName,
+		FullMethod: UserService_UpdateInviteDomainPolicy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).InviteUser(ctx, req.(*InviteRequest))
+		return srv.(UserServiceServer).UpdateInviteDomainPolicy(ctx, req.(*UpdateInviteDomainPolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_AcceptInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AcceptInviteRequest)
+func _UserService_DeleteMyAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMyAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).AcceptInvite(ctx, in)
+		return srv.(UserServiceServer).DeleteMyAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_AcceptInvite_FullMethodName,
+		FullMethod: UserService_DeleteMyAccount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).AcceptInvite(ctx, req.(*AcceptInviteRequest))
+		return srv.(UserServiceServer).DeleteMyAccount(ctx, req.(*DeleteMyAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_ListInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListInvitesRequest)
+func _UserService_GetProfileFields_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProfileFieldsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).ListInvites(ctx, in)
+		return srv.(UserServiceServer).GetProfileFields(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_ListInvites_FullMethodName,
+		FullMethod: UserService_GetProfileFields_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).ListInvites(ctx, req.(*ListInvitesRequest))
+		return srv.(UserServiceServer).GetProfileFields(ctx, req.(*GetProfileFieldsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_RegisterOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterOrganizationRequest)
+func _UserService_UpdateProfileFields_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProfileFieldsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).RegisterOrganization(ctx, in)
+		return srv.(UserServiceServer).UpdateProfileFields(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_RegisterOrganization_FullMethodName,
+		FullMethod: UserService_UpdateProfileFields_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).RegisterOrganization(ctx, req.(*RegisterOrganizationRequest))
+		return srv.(UserServiceServer).UpdateProfileFields(ctx, req.(*UpdateProfileFieldsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_ListAllOrganizations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAllOrganizationsRequest)
+func _UserService_UpdateProfileAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProfileAttributesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).ListAllOrganizations(ctx, in)
+		return srv.(UserServiceServer).UpdateProfileAttributes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_ListAllOrganizations_FullMethodName,
+		FullMethod: UserService_UpdateProfileAttributes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).ListAllOrganizations(ctx, req.(*ListAllOrganizationsRequest))
+		return srv.(UserServiceServer).UpdateProfileAttributes(ctx, req.(*UpdateProfileAttributesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_GetPlatformAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPlatformAnalyticsRequest)
+func _UserService_GetOrgSecuritySettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrgSecuritySettingsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).GetPlatformAnalytics(ctx, in)
+		return srv.(UserServiceServer).GetOrgSecuritySettings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_GetPlatformAnalytics_FullMethodName,
+		FullMethod: UserService_GetOrgSecuritySettings_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).GetPlatformAnalytics(ctx, req.(*GetPlatformAnalyticsRequest))
+		return srv.(UserServiceServer).GetOrgSecuritySettings(ctx, req.(*GetOrgSecuritySettingsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_ListAllUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAllUsersRequest)
+func _UserService_UpdateOrgSecuritySettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOrgSecuritySettingsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).ListAllUsers(ctx, in)
+		return srv.(UserServiceServer).UpdateOrgSecuritySettings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_ListAllUsers_FullMethodName,
+		FullMethod: UserService_UpdateOrgSecuritySettings_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).ListAllUsers(ctx, req.(*ListAllUsersRequest))
+		return srv.(UserServiceServer).UpdateOrgSecuritySettings(ctx, req.(*UpdateOrgSecuritySettingsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_DeleteOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteOrganizationRequest)
+func _UserService_BeginPasskeyRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeginPasskeyRegistrationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).DeleteOrganization(ctx, in)
+		return srv.(UserServiceServer).BeginPasskeyRegistration(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_DeleteOrganization_FullMethodName,
+		FullMethod: UserService_BeginPasskeyRegistration_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).DeleteOrganization(ctx, req.(*DeleteOrganizationRequest))
+		return srv.(UserServiceServer).BeginPasskeyRegistration(ctx, req.(*BeginPasskeyRegistrationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_ListOrganizationMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListOrganizationMembersRequest)
+func _UserService_FinishPasskeyRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinishPasskeyRegistrationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).ListOrganizationMembers(ctx, in)
+		return srv.(UserServiceServer).FinishPasskeyRegistration(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_ListOrganizationMembers_FullMethodName,
+		FullMethod: UserService_FinishPasskeyRegistration_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).ListOrganizationMembers(ctx, req.(*ListOrganizationMembersRequest))
+		return srv.(UserServiceServer).FinishPasskeyRegistration(ctx, req.(*FinishPasskeyRegistrationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_RemoveOrganizationMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveOrganizationMemberRequest)
+func _UserService_ListPasskeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPasskeysRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).RemoveOrganizationMember(ctx, in)
+		return srv.(UserServiceServer).ListPasskeys(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_RemoveOrganizationMember_FullMethodName,
+		FullMethod: UserService_ListPasskeys_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).RemoveOrganizationMember(ctx, req.(*RemoveOrganizationMemberRequest))
+		return srv.(UserServiceServer).ListPasskeys(ctx, req.(*ListPasskeysRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_CreateOrganizationMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateOrganizationMemberRequest)
+func _UserService_DeletePasskey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePasskeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).CreateOrganizationMember(ctx, in)
+		return srv.(UserServiceServer).DeletePasskey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_CreateOrganizationMember_FullMethodName,
+		FullMethod: UserService_DeletePasskey_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).CreateOrganizationMember(ctx, req.(*CreateOrganizationMemberRequest))
+		return srv.(UserServiceServer).DeletePasskey(ctx, req.(*DeletePasskeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_GetOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOrganizationRequest)
+func _UserService_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).GetOrganization(ctx, in)
+		return srv.(UserServiceServer).ListSessions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_GetOrganization_FullMethodName,
+		FullMethod: UserService_ListSessions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).GetOrganization(ctx, req.(*GetOrganizationRequest))
+		return srv.(UserServiceServer).ListSessions(ctx, req.(*ListSessionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_SetSecurityQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetSecurityQuestionsRequest)
+func _UserService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).SetSecurityQuestions(ctx, in)
+		return srv.(UserServiceServer).RevokeSession(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_SetSecurityQuestions_FullMethodName,
+		FullMethod: UserService_RevokeSession_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).SetSecurityQuestions(ctx, req.(*SetSecurityQuestionsRequest))
+		return srv.(UserServiceServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResetPasswordRequest)
+func _UserService_BeginPasskeyLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeginPasskeyLoginRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).ResetPassword(ctx, in)
+		return srv.(UserServiceServer).BeginPasskeyLogin(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_ResetPassword_FullMethodName,
+		FullMethod: UserService_BeginPasskeyLogin_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).ResetPassword(ctx, req.(*ResetPasswordRequest))
+		return srv.(UserServiceServer).BeginPasskeyLogin(ctx, req.(*BeginPasskeyLoginRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_ResetPasswordWithQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResetPasswordWithQuestionsRequest)
+func _UserService_FinishPasskeyLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinishPasskeyLoginRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).ResetPasswordWithQuestions(ctx, in)
+		return srv.(UserServiceServer).FinishPasskeyLogin(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_ResetPasswordWithQuestions_FullMethodName,
+		FullMethod: UserService_FinishPasskeyLogin_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).ResetPasswordWithQuestions(ctx, req.(*ResetPasswordWithQuestionsRequest))
+		return srv.(UserServiceServer).FinishPasskeyLogin(ctx, req.(*FinishPasskeyLoginRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_AdminResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AdminResetPasswordRequest)
+func _UserService_GetSecurityDashboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSecurityDashboardRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).AdminResetPassword(ctx, in)
+		return srv.(UserServiceServer).GetSecurityDashboard(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_AdminResetPassword_FullMethodName,
+		FullMethod: UserService_GetSecurityDashboard_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).AdminResetPassword(ctx, req.(*AdminResetPasswordRequest))
+		return srv.(UserServiceServer).GetSecurityDashboard(ctx, req.(*GetSecurityDashboardRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1003,6 +2917,218 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AdminResetPassword",
 			Handler:    _UserService_AdminResetPassword_Handler,
 		},
+		{
+			MethodName: "ResolveUsernames",
+			Handler:    _UserService_ResolveUsernames_Handler,
+		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _UserService_RefreshToken_Handler,
+		},
+		{
+			MethodName: "UnlockUser",
+			Handler:    _UserService_UnlockUser_Handler,
+		},
+		{
+			MethodName: "ListAuditLogs",
+			Handler:    _UserService_ListAuditLogs_Handler,
+		},
+		{
+			MethodName: "GetLDAPConfig",
+			Handler:    _UserService_GetLDAPConfig_Handler,
+		},
+		{
+			MethodName: "UpsertLDAPConfig",
+			Handler:    _UserService_UpsertLDAPConfig_Handler,
+		},
+		{
+			MethodName: "SyncLDAP",
+			Handler:    _UserService_SyncLDAP_Handler,
+		},
+		{
+			MethodName: "CreateAPIKey",
+			Handler:    _UserService_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "ListAPIKeys",
+			Handler:    _UserService_ListAPIKeys_Handler,
+		},
+		{
+			MethodName: "RevokeAPIKey",
+			Handler:    _UserService_RevokeAPIKey_Handler,
+		},
+		{
+			MethodName: "ValidateAPIKey",
+			Handler:    _UserService_ValidateAPIKey_Handler,
+		},
+		{
+			MethodName: "GetOrgIPAllowlist",
+			Handler:    _UserService_GetOrgIPAllowlist_Handler,
+		},
+		{
+			MethodName: "RecordBlockedAccess",
+			Handler:    _UserService_RecordBlockedAccess_Handler,
+		},
+		{
+			MethodName: "CreateServiceAccount",
+			Handler:    _UserService_CreateServiceAccount_Handler,
+		},
+		{
+			MethodName: "ListServiceAccounts",
+			Handler:    _UserService_ListServiceAccounts_Handler,
+		},
+		{
+			MethodName: "RotateServiceAccountKey",
+			Handler:    _UserService_RotateServiceAccountKey_Handler,
+		},
+		{
+			MethodName: "DeleteServiceAccount",
+			Handler:    _UserService_DeleteServiceAccount_Handler,
+		},
+		{
+			MethodName: "SuspendUser",
+			Handler:    _UserService_SuspendUser_Handler,
+		},
+		{
+			MethodName: "ReactivateUser",
+			Handler:    _UserService_ReactivateUser_Handler,
+		},
+		{
+			MethodName: "ExportUserData",
+			Handler:    _UserService_ExportUserData_Handler,
+		},
+		{
+			MethodName: "RequestDataErasure",
+			Handler:    _UserService_RequestDataErasure_Handler,
+		},
+		{
+			MethodName: "GetDataErasureRequest",
+			Handler:    _UserService_GetDataErasureRequest_Handler,
+		},
+		{
+			MethodName: "ResendInvite",
+			Handler:    _UserService_ResendInvite_Handler,
+		},
+		{
+			MethodName: "RevokeInvite",
+			Handler:    _UserService_RevokeInvite_Handler,
+		},
+		{
+			MethodName: "GetLoginHistory",
+			Handler:    _UserService_GetLoginHistory_Handler,
+		},
+		{
+			MethodName: "ListSuperAdmins",
+			Handler:    _UserService_ListSuperAdmins_Handler,
+		},
+		{
+			MethodName: "GrantSuperAdmin",
+			Handler:    _UserService_GrantSuperAdmin_Handler,
+		},
+		{
+			MethodName: "RevokeSuperAdmin",
+			Handler:    _UserService_RevokeSuperAdmin_Handler,
+		},
+		{
+			MethodName: "ListSuperAdminChanges",
+			Handler:    _UserService_ListSuperAdminChanges_Handler,
+		},
+		{
+			MethodName: "ApproveSuperAdminChange",
+			Handler:    _UserService_ApproveSuperAdminChange_Handler,
+		},
+		{
+			MethodName: "RejectSuperAdminChange",
+			Handler:    _UserService_RejectSuperAdminChange_Handler,
+		},
+		{
+			MethodName: "ListMyOrganizations",
+			Handler:    _UserService_ListMyOrganizations_Handler,
+		},
+		{
+			MethodName: "SwitchOrganization",
+			Handler:    _UserService_SwitchOrganization_Handler,
+		},
+		{
+			MethodName: "UpdateOrganizationMemberRole",
+			Handler:    _UserService_UpdateOrganizationMemberRole_Handler,
+		},
+		{
+			MethodName: "ForgotPassword",
+			Handler:    _UserService_ForgotPassword_Handler,
+		},
+		{
+			MethodName: "ResetPasswordWithToken",
+			Handler:    _UserService_ResetPasswordWithToken_Handler,
+		},
+		{
+			MethodName: "GetInviteDomainPolicy",
+			Handler:    _UserService_GetInviteDomainPolicy_Handler,
+		},
+		{
+			MethodName: "UpdateInviteDomainPolicy",
+			Handler:    _UserService_UpdateInviteDomainPolicy_Handler,
+		},
+		{
+			MethodName: "DeleteMyAccount",
+			Handler:    _UserService_DeleteMyAccount_Handler,
+		},
+		{
+			MethodName: "GetProfileFields",
+			Handler:    _UserService_GetProfileFields_Handler,
+		},
+		{
+			MethodName: "UpdateProfileFields",
+			Handler:    _UserService_UpdateProfileFields_Handler,
+		},
+		{
+			MethodName: "UpdateProfileAttributes",
+			Handler:    _UserService_UpdateProfileAttributes_Handler,
+		},
+		{
+			MethodName: "GetOrgSecuritySettings",
+			Handler:    _UserService_GetOrgSecuritySettings_Handler,
+		},
+		{
+			MethodName: "UpdateOrgSecuritySettings",
+			Handler:    _UserService_UpdateOrgSecuritySettings_Handler,
+		},
+		{
+			MethodName: "BeginPasskeyRegistration",
+			Handler:    _UserService_BeginPasskeyRegistration_Handler,
+		},
+		{
+			MethodName: "FinishPasskeyRegistration",
+			Handler:    _UserService_FinishPasskeyRegistration_Handler,
+		},
+		{
+			MethodName: "ListPasskeys",
+			Handler:    _UserService_ListPasskeys_Handler,
+		},
+		{
+			MethodName: "DeletePasskey",
+			Handler:    _UserService_DeletePasskey_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _UserService_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _UserService_RevokeSession_Handler,
+		},
+		{
+			MethodName: "BeginPasskeyLogin",
+			Handler:    _UserService_BeginPasskeyLogin_Handler,
+		},
+		{
+			MethodName: "FinishPasskeyLogin",
+			Handler:    _UserService_FinishPasskeyLogin_Handler,
+		},
+		{
+			MethodName: "GetSecurityDashboard",
+			Handler:    _UserService_GetSecurityDashboard_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user.proto",
@@ -27,5 +27,8 @@ go build -o bin/notification-service ./services/notification
 echo -e "${YELLOW}Building API Gateway...${NC}"
 go build -o bin/gateway ./gateway
 
+echo -e "${YELLOW}Building migrate...${NC}"
+go build -o bin/migrate ./cmd/migrate
+
 echo -e "${GREEN}✓ Build complete!${NC}"
 echo -e "Binaries created in ${YELLOW}./bin/${NC}"
@@ -9,20 +9,23 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
 
+	"github.com/chanduchitikam/task-management-system/migrations"
 	"github.com/chanduchitikam/task-management-system/pkg/cache"
+	"github.com/chanduchitikam/task-management-system/pkg/certs"
 	"github.com/chanduchitikam/task-management-system/pkg/config"
 	"github.com/chanduchitikam/task-management-system/pkg/database"
 	notificationpb "github.com/chanduchitikam/task-management-system/proto/notification"
+	organizationpb "github.com/chanduchitikam/task-management-system/proto/organization"
 	"github.com/chanduchitikam/task-management-system/services/notification/models"
 	"github.com/chanduchitikam/task-management-system/services/notification/service"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
-	"google.golang.org/protobuf/encoding/protojson"
 )
 
 func main() {
@@ -31,6 +34,9 @@ func main() {
 	if err != nil {
 		log.Fatalf("Failed to load config: %v", err)
 	}
+	if err := cfg.Validate(); err != nil {
+		log.Fatalf("Invalid config: %v", err)
+	}
 
 	// 	// 	// Connect to database
 	db, err := database.NewPostgresConnection(cfg.Database.GetDSN())
@@ -38,16 +44,31 @@ func main() {
 		log.Fatalf("Failed to connect to database: %v", err)
 	}
 
-	// 	// 	// Auto-migrate models
-	if err := database.AutoMigrate(db, &models.Notification{}, &models.NotificationPreference{}); err != nil {
-		log.Fatalf("Failed to migrate database: %v", err)
+	// Bring the schema up to date, then check it has everything the models
+	// use
+	sqlDB, err := db.DB()
+	if err != nil {
+		log.Fatalf("Failed to get database instance: %v", err)
 	}
-	if err := database.AutoMigrate(db, &models.Device{}); err != nil {
+	if err := migrations.Prepare(sqlDB, "notification", cfg.Database.MigrateOnStart); err != nil {
 		log.Fatalf("Failed to migrate database: %v", err)
 	}
+	if err := database.CheckModels(db, &models.Notification{}, &models.NotificationPreference{}, &models.Device{}, &models.TeamsIntegration{}, &models.Announcement{}); err != nil {
+		log.Fatalf("Database schema drift: %v", err)
+	}
+
+	// Service traffic is TLS once certificates are configured
+	serverCreds, err := certs.ServerCredentials(cfg.TLS)
+	if err != nil {
+		log.Fatalf("Invalid TLS config: %v", err)
+	}
+	dialCreds, err := certs.DialCredentials(cfg.TLS)
+	if err != nil {
+		log.Fatalf("Invalid TLS config: %v", err)
+	}
 
 	//  	//  	// Create gRPC server
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(serverCreds)
 
 	//  	//  	// Create Redis client and NotificationService with distributed delivery
 	redisClient, err := cache.NewRedisClient(cfg.Redis.GetRedisAddr(), cfg.Redis.Password, cfg.Redis.DB)
@@ -61,10 +82,13 @@ func main() {
 	// console provider always present for local visibility
 	providers = append(providers, &service.ConsoleProvider{})
 
+	// push providers reach the devices users register
+	var pushProviders []service.PushProvider
+
 	// FCM provider (legacy server key)
 	if fcmKey := os.Getenv("FCM_SERVER_KEY"); fcmKey != "" {
 		if f := service.NewFCMProvider(fcmKey); f != nil {
-			providers = append(providers, f)
+			pushProviders = append(pushProviders, f)
 			log.Println("FCM provider enabled")
 		}
 	}
@@ -77,101 +101,48 @@ func main() {
 	apnsSandbox := os.Getenv("APNS_USE_SANDBOX") == "1"
 	if apnsKeyPath != "" && apnsKeyID != "" && apnsTeamID != "" && apnsTopic != "" {
 		if ap, err := service.NewAPNSProvider(apnsKeyPath, apnsKeyID, apnsTeamID, apnsTopic, apnsSandbox); err == nil {
-			providers = append(providers, ap)
+			pushProviders = append(pushProviders, ap)
 			log.Println("APNs provider enabled")
 		} else {
 			log.Printf("failed to enable APNs provider: %v", err)
 		}
 	}
 
+	// Microsoft Teams provider; each organization configures its own webhook
+	providers = append(providers, service.NewTeamsProvider(db, os.Getenv("APP_BASE_URL")))
+
 	notificationService := service.NewNotificationService(db, redisClient, providers...)
+	notificationService.SetPushProviders(pushProviders...)
+	// e.g. NOTIFICATION_PROVIDER_TIMEOUT=5s
+	if timeout, err := time.ParseDuration(os.Getenv("NOTIFICATION_PROVIDER_TIMEOUT")); err == nil {
+		notificationService.SetDeliveryTimeout(timeout)
+	}
+
+	// Resolve org and team broadcast recipients against the organization service
+	orgServiceAddr := os.Getenv("ORG_SERVICE_ADDR")
+	if orgServiceAddr == "" {
+		orgServiceAddr = fmt.Sprintf("localhost:%d", cfg.Server.GRPCPort+3)
+	}
+	orgConn, err := grpc.NewClient(orgServiceAddr, grpc.WithTransportCredentials(dialCreds))
+	if err != nil {
+		log.Fatalf("Failed to create organization service client: %v", err)
+	}
+	defer orgConn.Close()
+	notificationService.SetMemberDirectory(service.NewOrgServiceDirectory(organizationpb.NewOrganizationServiceClient(orgConn)))
 	notificationpb.RegisterNotificationServiceServer(grpcServer, notificationService)
 
 	// Start a durable worker to consume Redis Stream and process deliveries
 	if redisClient != nil {
-		go func() {
-			stream := "notifications:stream"
-			group := "notification_workers"
-			hostname := "local"
-			if hn, err := os.Hostname(); err == nil {
-				hostname = hn
-			}
-			consumer := fmt.Sprintf("%s-%d", hostname, os.Getpid())
-
-			// create consumer group if not exists
-			if err := redisClient.XGroupCreateMkStream(context.Background(), stream, group, "0"); err != nil {
-				// ignore BUSYGROUP error
-				if !strings.Contains(err.Error(), "BUSYGROUP") {
-					log.Printf("warning: failed to create consumer group: %v", err)
-				}
-			}
-
-			log.Printf("notification stream worker %s started", consumer)
-			for {
-				msgs, err := redisClient.XReadGroup(context.Background(), group, consumer, stream, 10, 5000*time.Millisecond)
-				if err != nil {
-					log.Printf("error reading from stream: %v", err)
-					time.Sleep(time.Second)
-					continue
-				}
-				if len(msgs) == 0 {
-					continue
-				}
-
-				for _, m := range msgs {
-					// payload stored under 'payload'
-					raw, ok := m.Values["payload"]
-					if !ok {
-						// ack and skip malformed
-						if _, err := redisClient.XAck(context.Background(), stream, group, m.ID); err != nil {
-							log.Printf("failed to ack malformed message %s: %v", m.ID, err)
-						}
-						continue
-					}
-
-					var payloadStr string
-					switch v := raw.(type) {
-					case string:
-						payloadStr = v
-					case []byte:
-						payloadStr = string(v)
-					default:
-						payloadStr = fmt.Sprintf("%v", v)
-					}
-
-					var event notificationpb.NotificationEvent
-					if err := protojson.Unmarshal([]byte(payloadStr), &event); err != nil {
-						log.Printf("failed to unmarshal stream payload for id %s: %v", m.ID, err)
-						// move malformed payload to DLQ for inspection and ack the original
-						dlqValues := map[string]interface{}{
-							"original_message_id": m.ID,
-							"user_id":             m.Values["user_id"],
-							"payload":             payloadStr,
-							"error":               err.Error(),
-						}
-						if _, addErr := redisClient.XAdd(context.Background(), "notifications:dlq", dlqValues); addErr != nil {
-							log.Printf("failed to add to DLQ for message %s: %v", m.ID, addErr)
-						}
-						if _, ackErr := redisClient.XAck(context.Background(), stream, group, m.ID); ackErr != nil {
-							log.Printf("failed to ack bad message %s: %v", m.ID, ackErr)
-						}
-						continue
-					}
-
-					// process delivery
-					if err := notificationService.ProcessStreamEvent(context.Background(), &event); err != nil {
-						log.Printf("error processing stream event %s: %v", event.NotificationId, err)
-						// do not ack, let it be retried
-						continue
-					}
-
-					// acknowledge
-					if _, err := redisClient.XAck(context.Background(), stream, group, m.ID); err != nil {
-						log.Printf("failed to ack message %s: %v", m.ID, err)
-					}
-				}
-			}
-		}()
+		hostname := "local"
+		if hn, err := os.Hostname(); err == nil {
+			hostname = hn
+		}
+		consumer := fmt.Sprintf("%s-%d", hostname, os.Getpid())
+		maxAttempts, _ := strconv.Atoi(os.Getenv("NOTIFICATION_MAX_ATTEMPTS"))
+		// e.g. NOTIFICATION_COALESCE_WINDOW=30s; unset delivers every event on its own
+		coalesceWindow, _ := time.ParseDuration(os.Getenv("NOTIFICATION_COALESCE_WINDOW"))
+		worker := service.NewStreamWorker(redisClient, notificationService, consumer, maxAttempts, coalesceWindow)
+		go worker.Run(context.Background())
 	}
 
 	// start internal HTTP server for device registration and metrics
@@ -196,6 +167,14 @@ func main() {
 					http.Error(w, "user_id and token required", http.StatusBadRequest)
 					return
 				}
+				// the platform picks the push provider that reaches the device
+				req.Platform = strings.ToLower(strings.TrimSpace(req.Platform))
+				switch req.Platform {
+				case models.PlatformIOS, models.PlatformAndroid, models.PlatformWeb:
+				default:
+					http.Error(w, "platform must be ios, android or web", http.StatusBadRequest)
+					return
+				}
 				// upsert device by token
 				// upsert device by token (create or update existing)
 				var existing models.Device
@@ -227,6 +206,30 @@ func main() {
 				w.Header().Set("Content-Type", "application/json")
 				_ = json.NewEncoder(w).Encode(devices)
 				return
+			case "DELETE":
+				// unregister a device, e.g. when its user logs out
+				var req struct {
+					UserID string `json:"user_id"`
+					Token  string `json:"token"`
+				}
+				if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+					http.Error(w, "invalid body", http.StatusBadRequest)
+					return
+				}
+				if req.Token == "" {
+					http.Error(w, "token required", http.StatusBadRequest)
+					return
+				}
+				q := db.Where("token = ?", req.Token)
+				if req.UserID != "" {
+					q = q.Where("user_id = ?", req.UserID)
+				}
+				if err := q.Delete(&models.Device{}).Error; err != nil {
+					http.Error(w, "failed to delete device", http.StatusInternalServerError)
+					return
+				}
+				w.WriteHeader(http.StatusNoContent)
+				return
 			default:
 				http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
 				return
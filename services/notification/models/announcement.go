@@ -0,0 +1,30 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Announcement is a platform-wide notice shown to every user until it expires
+type Announcement struct {
+	ID        string    `gorm:"primaryKey;type:uuid;default:gen_random_uuid()" json:"id"`
+	Title     string    `gorm:"not null" json:"title"`
+	Body      string    `gorm:"not null" json:"body"`
+	Link      string    `json:"link,omitempty"`
+	ExpiresAt time.Time `gorm:"not null;index" json:"expires_at"`
+	CreatedBy string    `gorm:"type:uuid" json:"created_by,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (a *Announcement) BeforeCreate(tx *gorm.DB) error {
+	if a.ID == "" {
+		a.ID = uuid.New().String()
+	}
+	return nil
+}
+
+func (Announcement) TableName() string {
+	return "announcements"
+}
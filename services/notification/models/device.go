@@ -7,6 +7,13 @@ import (
 	"gorm.io/gorm"
 )
 
+// Device platforms, each served by the push provider that reaches it
+const (
+	PlatformIOS     = "ios"
+	PlatformAndroid = "android"
+	PlatformWeb     = "web"
+)
+
 // Device represents a user device for push notifications
 type Device struct {
 	ID        string         `gorm:"primaryKey;type:uuid;default:gen_random_uuid()" json:"id"`
@@ -18,6 +18,9 @@ type Notification struct {
 	RelatedUserID string    `gorm:"type:uuid" json:"related_user_id"`
 	Read          bool      `gorm:"default:false" json:"read"`
 	Metadata      string    `gorm:"type:jsonb" json:"metadata"`
+	Category      string    `gorm:"type:varchar(32);index" json:"category"`
+	Severity      string    `gorm:"type:varchar(16);default:'info'" json:"severity"`
+	Priority      string    `gorm:"type:varchar(16);default:'normal'" json:"priority"`
 	CreatedAt     time.Time `json:"created_at"`
 }
 
@@ -11,10 +11,16 @@ type NotificationPreference struct {
 	ID     string `gorm:"primaryKey;type:uuid;default:gen_random_uuid()" json:"id"`
 	UserID string `gorm:"type:uuid;not null;index" json:"user_id"`
 	// Channels stores a JSON object mapping channel names to enabled/disabled, e.g. {"push":true,"email":false}
-	Channels  string         `gorm:"type:jsonb;default:'{}'" json:"channels"`
-	CreatedAt time.Time      `json:"created_at"`
-	UpdatedAt time.Time      `json:"updated_at"`
-	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
+	Channels string `gorm:"type:jsonb;default:'{}'" json:"channels"`
+	// QuietHoursStart and QuietHoursEnd ("HH:MM" in Timezone) bound when only
+	// critical notifications are pushed; empty means no quiet hours. The
+	// window may wrap past midnight.
+	QuietHoursStart string         `gorm:"type:varchar(5)" json:"quiet_hours_start"`
+	QuietHoursEnd   string         `gorm:"type:varchar(5)" json:"quiet_hours_end"`
+	Timezone        string         `gorm:"type:varchar(64)" json:"timezone"`
+	CreatedAt       time.Time      `json:"created_at"`
+	UpdatedAt       time.Time      `json:"updated_at"`
+	DeletedAt       gorm.DeletedAt `gorm:"index" json:"-"`
 }
 
 func (NotificationPreference) TableName() string {
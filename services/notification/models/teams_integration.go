@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// TeamsIntegration is an organization's Microsoft Teams incoming webhook.
+// WebhookURL carries its own credential, so it is never handed back out.
+type TeamsIntegration struct {
+	ID                string    `gorm:"primaryKey;type:uuid;default:gen_random_uuid()" json:"id"`
+	OrgID             string    `gorm:"type:uuid;not null;uniqueIndex" json:"org_id"`
+	WebhookURL        string    `gorm:"not null" json:"-"`
+	NotifyAssignments bool      `gorm:"not null;default:true" json:"notify_assignments"`
+	NotifyDueDates    bool      `gorm:"not null;default:true" json:"notify_due_dates"`
+	Enabled           bool      `gorm:"not null;default:true" json:"enabled"`
+	UpdatedBy         string    `gorm:"type:uuid" json:"updated_by,omitempty"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+func (t *TeamsIntegration) BeforeCreate(tx *gorm.DB) error {
+	if t.ID == "" {
+		t.ID = uuid.New().String()
+	}
+	return nil
+}
+
+func (TeamsIntegration) TableName() string {
+	return "teams_integrations"
+}
@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/pkg/cache"
+	notificationpb "github.com/chanduchitikam/task-management-system/proto/notification"
+	"github.com/chanduchitikam/task-management-system/services/notification/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// maxAnnouncementLifetime caps how far ahead an announcement may expire
+	maxAnnouncementLifetime = 90 * 24 * time.Hour
+	// maxActiveAnnouncements caps how many announcements are shown at once
+	maxActiveAnnouncements = 20
+)
+
+// PublishAnnouncement stores a platform-wide announcement and shows it to
+// every connected session. Users who connect later get it from
+// GetActiveAnnouncements until it expires.
+func (s *NotificationService) PublishAnnouncement(ctx context.Context, req *notificationpb.PublishAnnouncementRequest) (*notificationpb.PublishAnnouncementResponse, error) {
+	if err := authorizePlatformAdmin(ctx, "publish announcements"); err != nil {
+		return nil, err
+	}
+	title := strings.TrimSpace(req.Title)
+	body := strings.TrimSpace(req.Body)
+	if title == "" || body == "" {
+		return nil, status.Error(codes.InvalidArgument, "title and body are required")
+	}
+	link := strings.TrimSpace(req.Link)
+	if link != "" && !validAnnouncementLink(link) {
+		return nil, status.Error(codes.InvalidArgument, "link must be an http(s) URL or a path starting with /")
+	}
+	if req.ExpiresAt == nil {
+		return nil, status.Error(codes.InvalidArgument, "expires_at is required")
+	}
+	expiresAt := req.ExpiresAt.AsTime()
+	now := time.Now()
+	if !expiresAt.After(now) {
+		return nil, status.Error(codes.InvalidArgument, "expires_at must be in the future")
+	}
+	if expiresAt.Sub(now) > maxAnnouncementLifetime {
+		return nil, status.Errorf(codes.InvalidArgument, "announcements may run for at most %d days", int(maxAnnouncementLifetime.Hours()/24))
+	}
+
+	callerID, _, _ := callerIdentity(ctx)
+	announcement := &models.Announcement{
+		Title:     title,
+		Body:      body,
+		Link:      link,
+		ExpiresAt: expiresAt,
+		CreatedBy: callerID,
+	}
+	if err := s.db.WithContext(ctx).Create(announcement).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to create announcement")
+	}
+
+	ev := cache.AnnouncementEvent{ID: announcement.ID, Title: title, Body: body, Link: link, ExpiresAt: expiresAt}
+	if s.redis == nil {
+		s.broadcastToAll(announcementToEvent(ev))
+	} else if err := s.redis.PublishAnnouncement(ctx, ev); err != nil {
+		log.Printf("failed to publish announcement %s: %v", announcement.ID, err)
+		s.broadcastToAll(announcementToEvent(ev))
+	}
+	return &notificationpb.PublishAnnouncementResponse{Announcement: announcementToProto(announcement)}, nil
+}
+
+// GetActiveAnnouncements returns the announcements that have not expired
+func (s *NotificationService) GetActiveAnnouncements(ctx context.Context, req *notificationpb.GetActiveAnnouncementsRequest) (*notificationpb.GetActiveAnnouncementsResponse, error) {
+	var announcements []models.Announcement
+	if err := s.db.WithContext(ctx).Where("expires_at > ?", time.Now()).
+		Order("created_at DESC").Limit(maxActiveAnnouncements).
+		Find(&announcements).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to get announcements")
+	}
+	resp := &notificationpb.GetActiveAnnouncementsResponse{}
+	for i := range announcements {
+		resp.Announcements = append(resp.Announcements, announcementToProto(&announcements[i]))
+	}
+	return resp, nil
+}
+
+func validAnnouncementLink(link string) bool {
+	if strings.HasPrefix(link, "/") {
+		return !strings.HasPrefix(link, "//")
+	}
+	u, err := url.Parse(link)
+	return err == nil && (u.Scheme == "https" || u.Scheme == "http") && u.Host != ""
+}
+
+func announcementToProto(a *models.Announcement) *notificationpb.Announcement {
+	return &notificationpb.Announcement{
+		Id:        a.ID,
+		Title:     a.Title,
+		Body:      a.Body,
+		Link:      a.Link,
+		ExpiresAt: timestamppb.New(a.ExpiresAt),
+		CreatedBy: a.CreatedBy,
+		CreatedAt: timestamppb.New(a.CreatedAt),
+	}
+}
+
+// announcementToEvent is how an announcement reaches notification
+// subscribers
+func announcementToEvent(ev cache.AnnouncementEvent) *notificationpb.NotificationEvent {
+	metadata := map[string]string{
+		"announcement_id": ev.ID,
+		"expires_at":      ev.ExpiresAt.UTC().Format(time.RFC3339),
+	}
+	if ev.Link != "" {
+		metadata["link"] = ev.Link
+	}
+	return &notificationpb.NotificationEvent{
+		NotificationId: ev.ID,
+		Title:          ev.Title,
+		Message:        ev.Body,
+		Category:       CategoryAnnouncement,
+		Severity:       notificationpb.NotificationSeverity_NOTIFICATION_SEVERITY_INFO,
+		CreatedAt:      timestamppb.Now(),
+		Metadata:       metadata,
+	}
+}
@@ -0,0 +1,274 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
+	"github.com/chanduchitikam/task-management-system/pkg/metrics"
+	notificationpb "github.com/chanduchitikam/task-management-system/proto/notification"
+	organizationpb "github.com/chanduchitikam/task-management-system/proto/organization"
+	"github.com/chanduchitikam/task-management-system/services/notification/models"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// broadcastBatchSize is how many notifications are written per insert
+const broadcastBatchSize = 500
+
+// MemberDirectory resolves who an org or team broadcast reaches
+type MemberDirectory interface {
+	OrgMemberIDs(ctx context.Context, orgID string) ([]string, error)
+	// TeamMembers returns the team's organization and active member IDs
+	TeamMembers(ctx context.Context, teamID string) (orgID string, memberIDs []string, err error)
+}
+
+// OrgServiceDirectory resolves members through the organization service
+type OrgServiceDirectory struct {
+	client organizationpb.OrganizationServiceClient
+}
+
+// NewOrgServiceDirectory creates a directory backed by the organization service
+func NewOrgServiceDirectory(client organizationpb.OrganizationServiceClient) *OrgServiceDirectory {
+	return &OrgServiceDirectory{client: client}
+}
+
+// OrgMemberIDs returns the IDs of orgID's members
+func (d *OrgServiceDirectory) OrgMemberIDs(ctx context.Context, orgID string) ([]string, error) {
+	resp, err := d.client.ListOrgMembers(forwardIdentity(ctx), &organizationpb.ListOrgMembersRequest{OrgId: orgID})
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, len(resp.Members))
+	for i, member := range resp.Members {
+		ids[i] = member.Id
+	}
+	return ids, nil
+}
+
+// TeamMembers returns teamID's organization and the IDs of its active members
+func (d *OrgServiceDirectory) TeamMembers(ctx context.Context, teamID string) (string, []string, error) {
+	ctx = forwardIdentity(ctx)
+	team, err := d.client.GetTeam(ctx, &organizationpb.GetTeamRequest{TeamId: teamID})
+	if err != nil {
+		return "", nil, err
+	}
+	resp, err := d.client.ListTeamMembers(ctx, &organizationpb.ListTeamMembersRequest{TeamId: teamID})
+	if err != nil {
+		return "", nil, err
+	}
+	ids := make([]string, len(resp.Members))
+	for i, member := range resp.Members {
+		ids[i] = member.UserId
+	}
+	return team.GetTeam().GetOrgId(), ids, nil
+}
+
+// forwardIdentity passes the caller's identity on to the organization service
+func forwardIdentity(ctx context.Context) context.Context {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		return metadata.NewOutgoingContext(ctx, md)
+	}
+	return ctx
+}
+
+// SetMemberDirectory sets how org and team broadcasts find their recipients
+func (s *NotificationService) SetMemberDirectory(directory MemberDirectory) {
+	s.directory = directory
+}
+
+// SendOrgNotification notifies every member of an organization
+func (s *NotificationService) SendOrgNotification(ctx context.Context, req *notificationpb.SendOrgNotificationRequest) (*notificationpb.SendBroadcastResponse, error) {
+	if req.Title == "" {
+		return nil, status.Error(codes.InvalidArgument, "title is required")
+	}
+	if _, err := uuid.Parse(req.OrgId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid org_id")
+	}
+	category, err := resolveBroadcastCategory(req.Category)
+	if err != nil {
+		return nil, err
+	}
+	_, callerOrg, role := callerIdentity(ctx)
+	if !authz.IsOrgAdmin(role, callerOrg, req.OrgId) {
+		return nil, status.Error(codes.PermissionDenied, "only organization admins may notify the whole organization")
+	}
+	if s.directory == nil {
+		return nil, status.Error(codes.Unavailable, "member directory is not configured")
+	}
+
+	memberIDs, err := s.directory.OrgMemberIDs(ctx, req.OrgId)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to list organization members: %v", err)
+	}
+	severity := resolveSeverity(req.Severity, req.Type)
+	meta := copyMetadata(req.Metadata)
+	meta["org_id"] = req.OrgId
+	return s.fanOut(ctx, memberIDs, broadcast{
+		notifType: req.Type, title: req.Title, message: req.Message, metadata: meta,
+		category: category, severity: severity, priority: resolvePriority(req.Priority, severity),
+	})
+}
+
+// SendTeamNotification notifies every active member of a team
+func (s *NotificationService) SendTeamNotification(ctx context.Context, req *notificationpb.SendTeamNotificationRequest) (*notificationpb.SendBroadcastResponse, error) {
+	if req.Title == "" {
+		return nil, status.Error(codes.InvalidArgument, "title is required")
+	}
+	if _, err := uuid.Parse(req.TeamId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid team_id")
+	}
+	category, err := resolveBroadcastCategory(req.Category)
+	if err != nil {
+		return nil, err
+	}
+	if s.directory == nil {
+		return nil, status.Error(codes.Unavailable, "member directory is not configured")
+	}
+
+	orgID, memberIDs, err := s.directory.TeamMembers(ctx, req.TeamId)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Error(codes.NotFound, "team not found")
+		}
+		return nil, status.Errorf(codes.Unavailable, "failed to list team members: %v", err)
+	}
+	_, callerOrg, role := callerIdentity(ctx)
+	if !authz.CanInOrg(role, callerOrg, authz.TeamManage, orgID) {
+		return nil, status.Error(codes.PermissionDenied, "only team managers may notify the whole team")
+	}
+	severity := resolveSeverity(req.Severity, req.Type)
+	meta := copyMetadata(req.Metadata)
+	meta["org_id"] = orgID
+	meta["team_id"] = req.TeamId
+	return s.fanOut(ctx, memberIDs, broadcast{
+		notifType: req.Type, title: req.Title, message: req.Message, metadata: meta,
+		category: category, severity: severity, priority: resolvePriority(req.Priority, severity),
+	})
+}
+
+// broadcast is the notification an org or team broadcast sends each member
+type broadcast struct {
+	notifType notificationpb.NotificationType
+	title     string
+	message   string
+	metadata  map[string]string
+	category  string
+	severity  notificationpb.NotificationSeverity
+	priority  notificationpb.NotificationPriority
+}
+
+// resolveBroadcastCategory files broadcasts under announcements unless the
+// sender picks a category
+func resolveBroadcastCategory(category string) (string, error) {
+	if strings.TrimSpace(category) == "" {
+		return CategoryAnnouncement, nil
+	}
+	return resolveCategory(category, notificationpb.NotificationType_NOTIFICATION_TYPE_UNSPECIFIED)
+}
+
+// fanOut stores one notification per recipient in batches and queues them
+// for delivery on the stream in a single round trip. Connected clients
+// receive them as the workers deliver them.
+func (s *NotificationService) fanOut(ctx context.Context, userIDs []string, b broadcast) (*notificationpb.SendBroadcastResponse, error) {
+	seen := make(map[string]bool, len(userIDs))
+	recipients := make([]string, 0, len(userIDs))
+	for _, id := range userIDs {
+		if id != "" && !seen[id] {
+			seen[id] = true
+			recipients = append(recipients, id)
+		}
+	}
+	if len(recipients) == 0 {
+		return &notificationpb.SendBroadcastResponse{}, nil
+	}
+
+	metadataJSON, err := json.Marshal(b.metadata)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to marshal metadata")
+	}
+	now := time.Now()
+	notifications := make([]models.Notification, len(recipients))
+	for i, userID := range recipients {
+		notifications[i] = models.Notification{
+			UserID:    userID,
+			Type:      s.typeToString(b.notifType),
+			Title:     b.title,
+			Message:   b.message,
+			Metadata:  string(metadataJSON),
+			Category:  b.category,
+			Severity:  severityToString(b.severity),
+			Priority:  priorityToString(b.priority),
+			CreatedAt: now,
+		}
+	}
+	if err := s.db.WithContext(ctx).CreateInBatches(&notifications, broadcastBatchSize).Error; err != nil {
+		recordSent(s.typeToString(b.notifType), channelInApp, err)
+		return nil, status.Error(codes.Internal, "failed to create notifications")
+	}
+	metrics.NotificationsSent.WithLabelValues(s.typeToString(b.notifType), channelInApp, "success").Add(float64(len(notifications)))
+
+	resp := &notificationpb.SendBroadcastResponse{Recipients: int32(len(recipients))}
+	if s.redis == nil {
+		return resp, nil
+	}
+
+	// recipients who turned push off keep only the stored notification
+	var prefs []models.NotificationPreference
+	if err := s.db.WithContext(ctx).Where("user_id IN ?", recipients).Find(&prefs).Error; err != nil {
+		log.Printf("failed to load notification preferences for broadcast: %v", err)
+	}
+	pushOff := make(map[string]bool, len(prefs))
+	for _, pref := range prefs {
+		if !pushEnabled(pref.Channels) {
+			pushOff[pref.UserID] = true
+		}
+	}
+
+	entries := make([]map[string]interface{}, 0, len(notifications))
+	for i := range notifications {
+		if pushOff[notifications[i].UserID] {
+			continue
+		}
+		payload, err := protojson.Marshal(s.modelToProto(&notifications[i], b.metadata))
+		if err != nil {
+			log.Printf("failed to marshal broadcast notification %s: %v", notifications[i].ID, err)
+			continue
+		}
+		entries = append(entries, map[string]interface{}{
+			"user_id": notifications[i].UserID,
+			"payload": string(payload),
+		})
+	}
+	queued, err := s.redis.XAddBatch(ctx, NotificationStream, entries)
+	if err != nil {
+		log.Printf("queued %d of %d broadcast notifications: %v", queued, len(entries), err)
+	}
+	resp.Queued = int32(queued)
+	return resp, nil
+}
+
+// pushEnabled reports whether a preference's channels allow push delivery
+func pushEnabled(channelsJSON string) bool {
+	var channels map[string]bool
+	if err := json.Unmarshal([]byte(channelsJSON), &channels); err == nil {
+		if enabled, ok := channels["push"]; ok && !enabled {
+			return false
+		}
+	}
+	return true
+}
+
+func copyMetadata(in map[string]string) map[string]string {
+	out := make(map[string]string, len(in)+2)
+	for k, v := range in {
+		out[k] = v
+	}
+	return out
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"regexp"
+	"strings"
+
+	notificationpb "github.com/chanduchitikam/task-management-system/proto/notification"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Inbox categories notifications are filed under unless the sender picks one
+const (
+	CategoryTask         = "task"
+	CategorySecurity     = "security"
+	CategoryReport       = "report"
+	CategoryAnnouncement = "announcement"
+	CategoryGeneral      = "general"
+)
+
+var categoryPattern = regexp.MustCompile(`^[a-z0-9_-]{1,32}$`)
+
+// resolveCategory validates a sender's category, falling back to the one
+// implied by the type
+func resolveCategory(category string, t notificationpb.NotificationType) (string, error) {
+	category = strings.ToLower(strings.TrimSpace(category))
+	if category == "" {
+		return categoryForType(t), nil
+	}
+	if !categoryPattern.MatchString(category) {
+		return "", status.Error(codes.InvalidArgument, "category must be 1-32 lowercase letters, digits, '-' or '_'")
+	}
+	return category, nil
+}
+
+func categoryForType(t notificationpb.NotificationType) string {
+	switch t {
+	case notificationpb.NotificationType_NOTIFICATION_TYPE_TASK_ASSIGNED,
+		notificationpb.NotificationType_NOTIFICATION_TYPE_TASK_UPDATED,
+		notificationpb.NotificationType_NOTIFICATION_TYPE_TASK_COMPLETED,
+		notificationpb.NotificationType_NOTIFICATION_TYPE_TASK_COMMENT,
+		notificationpb.NotificationType_NOTIFICATION_TYPE_TASK_DUE_SOON,
+		notificationpb.NotificationType_NOTIFICATION_TYPE_TASK_OVERDUE,
+		notificationpb.NotificationType_NOTIFICATION_TYPE_TASK_MENTION:
+		return CategoryTask
+	case notificationpb.NotificationType_NOTIFICATION_TYPE_ACCOUNT_LOCKED,
+		notificationpb.NotificationType_NOTIFICATION_TYPE_NEW_DEVICE_LOGIN,
+		notificationpb.NotificationType_NOTIFICATION_TYPE_SESSION_REVOKED:
+		return CategorySecurity
+	case notificationpb.NotificationType_NOTIFICATION_TYPE_STATUS_REPORT_DUE:
+		return CategoryReport
+	default:
+		return CategoryGeneral
+	}
+}
+
+// resolveSeverity returns the sender's severity, or the one implied by the
+// type when unspecified
+func resolveSeverity(severity notificationpb.NotificationSeverity, t notificationpb.NotificationType) notificationpb.NotificationSeverity {
+	if severity != notificationpb.NotificationSeverity_NOTIFICATION_SEVERITY_UNSPECIFIED {
+		return severity
+	}
+	switch t {
+	case notificationpb.NotificationType_NOTIFICATION_TYPE_ACCOUNT_LOCKED,
+		notificationpb.NotificationType_NOTIFICATION_TYPE_NEW_DEVICE_LOGIN,
+		notificationpb.NotificationType_NOTIFICATION_TYPE_SESSION_REVOKED,
+		notificationpb.NotificationType_NOTIFICATION_TYPE_TASK_OVERDUE:
+		return notificationpb.NotificationSeverity_NOTIFICATION_SEVERITY_WARNING
+	default:
+		return notificationpb.NotificationSeverity_NOTIFICATION_SEVERITY_INFO
+	}
+}
+
+func severityToString(s notificationpb.NotificationSeverity) string {
+	switch s {
+	case notificationpb.NotificationSeverity_NOTIFICATION_SEVERITY_WARNING:
+		return "warning"
+	case notificationpb.NotificationSeverity_NOTIFICATION_SEVERITY_CRITICAL:
+		return "critical"
+	default:
+		return "info"
+	}
+}
+
+func stringToSeverity(s string) notificationpb.NotificationSeverity {
+	switch s {
+	case "warning":
+		return notificationpb.NotificationSeverity_NOTIFICATION_SEVERITY_WARNING
+	case "critical":
+		return notificationpb.NotificationSeverity_NOTIFICATION_SEVERITY_CRITICAL
+	default:
+		return notificationpb.NotificationSeverity_NOTIFICATION_SEVERITY_INFO
+	}
+}
+
+// storedCategory is the category of a stored notification, filing those
+// from before categories existed under general
+func storedCategory(category string) string {
+	if category == "" {
+		return CategoryGeneral
+	}
+	return category
+}
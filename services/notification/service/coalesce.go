@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	notificationpb "github.com/chanduchitikam/task-management-system/proto/notification"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// notificationCoalesceQueue holds coalescing groups until their window ends
+	notificationCoalesceQueue = "notifications:coalesce:due"
+	// coalescedCountKey is the metadata key of how many events a grouped
+	// notification stands for
+	coalescedCountKey = "coalesced_count"
+)
+
+// coalescedNouns names what piles up for each coalescable type
+var coalescedNouns = map[notificationpb.NotificationType]string{
+	notificationpb.NotificationType_NOTIFICATION_TYPE_TASK_COMMENT: "comments",
+	notificationpb.NotificationType_NOTIFICATION_TYPE_TASK_UPDATED: "updates",
+	notificationpb.NotificationType_NOTIFICATION_TYPE_TASK_MENTION: "mentions",
+}
+
+func coalesceGroupKey(event *notificationpb.NotificationEvent) string {
+	return fmt.Sprintf("notifications:coalesce:%s:%s:%d", event.UserId, event.TaskId, event.Type)
+}
+
+// coalesce holds event back in its user/task/type group until the window
+// ends. It returns false if the event should be delivered right away.
+func (w *StreamWorker) coalesce(ctx context.Context, event *notificationpb.NotificationEvent, payload string) bool {
+	if w.coalesceWindow <= 0 || event.TaskId == "" {
+		return false
+	}
+	if _, ok := coalescedNouns[event.Type]; !ok {
+		return false
+	}
+
+	key := coalesceGroupKey(event)
+	// outlive the window so a slow flusher still finds the group
+	n, err := w.redis.ListAppend(ctx, key, payload, 2*w.coalesceWindow+time.Minute)
+	if err != nil {
+		log.Printf("failed to coalesce notification %s, delivering it alone: %v", event.NotificationId, err)
+		return false
+	}
+	if n > 1 {
+		return true
+	}
+	if err := w.redis.DelayedAdd(ctx, notificationCoalesceQueue, key, time.Now().Add(w.coalesceWindow)); err != nil {
+		log.Printf("failed to schedule coalesced group %s, delivering it now: %v", key, err)
+		w.flushGroup(ctx, key)
+	}
+	return true
+}
+
+// flushCoalesced puts one notification per group whose window has ended
+// back on the stream
+func (w *StreamWorker) flushCoalesced(ctx context.Context) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		due, err := w.redis.DelayedPopDue(ctx, notificationCoalesceQueue, time.Now(), 100)
+		if err != nil {
+			log.Printf("failed to read due coalesced notifications: %v", err)
+			continue
+		}
+		for _, key := range due {
+			w.flushGroup(ctx, key)
+		}
+	}
+}
+
+func (w *StreamWorker) flushGroup(ctx context.Context, key string) {
+	payloads, err := w.redis.ListTakeAll(ctx, key)
+	if err != nil {
+		log.Printf("failed to read coalesced group %s, trying again shortly: %v", key, err)
+		if err := w.redis.DelayedAdd(ctx, notificationCoalesceQueue, key, time.Now().Add(retryBaseDelay)); err != nil {
+			log.Printf("lost coalesced group %s: %v", key, err)
+		}
+		return
+	}
+	event := mergeCoalesced(payloads)
+	if event == nil {
+		return
+	}
+	payload, err := protojson.Marshal(event)
+	if err != nil {
+		log.Printf("failed to marshal coalesced notification %s: %v", event.NotificationId, err)
+		return
+	}
+
+	values := map[string]interface{}{
+		"user_id":   event.UserId,
+		"payload":   string(payload),
+		"coalesced": "1",
+	}
+	if _, err := w.redis.XAdd(ctx, NotificationStream, values); err != nil {
+		log.Printf("failed to requeue coalesced group %s, trying again shortly: %v", key, err)
+		// the merged event carries its count, so it merges again cleanly
+		if _, err := w.redis.ListAppend(ctx, key, string(payload), 2*w.coalesceWindow+time.Minute); err == nil {
+			err = w.redis.DelayedAdd(ctx, notificationCoalesceQueue, key, time.Now().Add(retryBaseDelay))
+			if err == nil {
+				return
+			}
+		}
+		log.Printf("lost coalesced group %s", key)
+	}
+}
+
+// mergeCoalesced collapses a group's events into one notification about the
+// latest of them, such as "10 new comments on PROJ-12". A group of one is
+// returned as is.
+func mergeCoalesced(payloads []string) *notificationpb.NotificationEvent {
+	var latest *notificationpb.NotificationEvent
+	count := 0
+	for _, p := range payloads {
+		var event notificationpb.NotificationEvent
+		if err := protojson.Unmarshal([]byte(p), &event); err != nil {
+			log.Printf("dropping malformed coalesced notification: %v", err)
+			continue
+		}
+		n, err := strconv.Atoi(event.Metadata[coalescedCountKey])
+		if err != nil || n < 1 {
+			n = 1
+		}
+		count += n
+		latest = &event
+	}
+	if latest == nil || count == 1 {
+		return latest
+	}
+
+	merged := proto.Clone(latest).(*notificationpb.NotificationEvent)
+	label := merged.Metadata["task_key"]
+	if label == "" {
+		label = "a task"
+	}
+	noun := coalescedNouns[merged.Type]
+	merged.Title = fmt.Sprintf("%d new %s", count, noun)
+	merged.Message = fmt.Sprintf("%d new %s on %s", count, noun, label)
+	if merged.Metadata == nil {
+		merged.Metadata = map[string]string{}
+	}
+	merged.Metadata[coalescedCountKey] = strconv.Itoa(count)
+	return merged
+}
@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/pkg/metrics"
+	notificationpb "github.com/chanduchitikam/task-management-system/proto/notification"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultDeadLetterPageSize = 50
+	maxDeadLetterPageSize     = 500
+)
+
+// ListDeadLetters pages through the DLQ, oldest first
+func (s *NotificationService) ListDeadLetters(ctx context.Context, req *notificationpb.ListDeadLettersRequest) (*notificationpb.ListDeadLettersResponse, error) {
+	if err := authorizePlatformAdmin(ctx, "manage the notification DLQ"); err != nil {
+		return nil, err
+	}
+	if s.redis == nil {
+		return nil, status.Error(codes.FailedPrecondition, "notification stream is not available")
+	}
+	pageSize := int64(req.PageSize)
+	if pageSize < 1 {
+		pageSize = defaultDeadLetterPageSize
+	}
+	if pageSize > maxDeadLetterPageSize {
+		pageSize = maxDeadLetterPageSize
+	}
+	start := "-"
+	if req.AfterId != "" {
+		start = "(" + req.AfterId
+	}
+
+	// one extra entry tells whether there is another page
+	msgs, err := s.redis.XRange(ctx, NotificationDLQ, start, "+", pageSize+1)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to read DLQ")
+	}
+	total, err := s.redis.XLen(ctx, NotificationDLQ)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to count DLQ")
+	}
+	metrics.NotificationDLQDepth.Set(float64(total))
+
+	resp := &notificationpb.ListDeadLettersResponse{Total: total}
+	if int64(len(msgs)) > pageSize {
+		msgs = msgs[:pageSize]
+		resp.NextAfterId = msgs[len(msgs)-1].ID
+	}
+	for _, m := range msgs {
+		resp.Entries = append(resp.Entries, deadLetterToProto(m))
+	}
+	return resp, nil
+}
+
+// RequeueDeadLetters puts the given DLQ entries back on the stream with a
+// fresh attempt count and removes them from the DLQ
+func (s *NotificationService) RequeueDeadLetters(ctx context.Context, req *notificationpb.RequeueDeadLettersRequest) (*notificationpb.RequeueDeadLettersResponse, error) {
+	if err := authorizePlatformAdmin(ctx, "manage the notification DLQ"); err != nil {
+		return nil, err
+	}
+	if s.redis == nil {
+		return nil, status.Error(codes.FailedPrecondition, "notification stream is not available")
+	}
+	if len(req.Ids) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "ids are required")
+	}
+
+	resp := &notificationpb.RequeueDeadLettersResponse{}
+	for _, id := range req.Ids {
+		msgs, err := s.redis.XRange(ctx, NotificationDLQ, id, id, 1)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid id %q", id)
+		}
+		if len(msgs) == 0 {
+			resp.NotFound = append(resp.NotFound, id)
+			continue
+		}
+		m := msgs[0]
+		values := map[string]interface{}{
+			"user_id": streamValue(m.Values["user_id"]),
+			"payload": streamValue(m.Values["payload"]),
+		}
+		if _, err := s.redis.XAdd(ctx, NotificationStream, values); err != nil {
+			return nil, status.Error(codes.Internal, "failed to requeue notification")
+		}
+		if _, err := s.redis.XDel(ctx, NotificationDLQ, id); err != nil {
+			log.Printf("requeued DLQ entry %s but failed to remove it: %v", id, err)
+		}
+		resp.Requeued++
+	}
+	s.refreshDLQDepth(ctx)
+	return resp, nil
+}
+
+// PurgeDeadLetters drops DLQ entries older than the given age
+func (s *NotificationService) PurgeDeadLetters(ctx context.Context, req *notificationpb.PurgeDeadLettersRequest) (*notificationpb.PurgeDeadLettersResponse, error) {
+	if err := authorizePlatformAdmin(ctx, "manage the notification DLQ"); err != nil {
+		return nil, err
+	}
+	if s.redis == nil {
+		return nil, status.Error(codes.FailedPrecondition, "notification stream is not available")
+	}
+	if req.OlderThanHours < 1 {
+		return nil, status.Error(codes.InvalidArgument, "older_than_hours must be at least 1")
+	}
+
+	cutoff := time.Now().Add(-time.Duration(req.OlderThanHours) * time.Hour)
+	purged, err := s.redis.XTrimBefore(ctx, NotificationDLQ, cutoff)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to purge DLQ")
+	}
+	s.refreshDLQDepth(ctx)
+	return &notificationpb.PurgeDeadLettersResponse{Purged: purged}, nil
+}
+
+// refreshDLQDepth updates the DLQ depth metric
+func (s *NotificationService) refreshDLQDepth(ctx context.Context) {
+	if s.redis == nil {
+		return
+	}
+	depth, err := s.redis.XLen(ctx, NotificationDLQ)
+	if err != nil {
+		log.Printf("failed to read DLQ depth: %v", err)
+		return
+	}
+	metrics.NotificationDLQDepth.Set(float64(depth))
+}
+
+func deadLetterToProto(m redis.XMessage) *notificationpb.DeadLetter {
+	dl := &notificationpb.DeadLetter{
+		Id:                m.ID,
+		OriginalMessageId: streamValue(m.Values["original_message_id"]),
+		UserId:            streamValue(m.Values["user_id"]),
+		Error:             streamValue(m.Values["error"]),
+		Payload:           streamValue(m.Values["payload"]),
+	}
+	if attempts, err := strconv.Atoi(streamValue(m.Values["attempts"])); err == nil {
+		dl.Attempts = int32(attempts)
+	}
+	if failedAt, err := time.Parse(time.RFC3339, streamValue(m.Values["failed_at"])); err == nil {
+		dl.FailedAt = timestamppb.New(failedAt)
+	} else if ms, err := strconv.ParseInt(strings.SplitN(m.ID, "-", 2)[0], 10, 64); err == nil {
+		// entries from before failed_at was recorded
+		dl.FailedAt = timestamppb.New(time.UnixMilli(ms))
+	}
+	var event notificationpb.NotificationEvent
+	if err := protojson.Unmarshal([]byte(dl.Payload), &event); err == nil {
+		dl.NotificationId = event.NotificationId
+		dl.Title = event.Title
+	}
+	return dl
+}
@@ -9,15 +9,18 @@ import (
 	"log"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/redis/go-redis/v9"
 
 	"github.com/chanduchitikam/task-management-system/pkg/cache"
+	"github.com/chanduchitikam/task-management-system/pkg/metrics"
 	notificationpb "github.com/chanduchitikam/task-management-system/proto/notification"
 	"github.com/chanduchitikam/task-management-system/services/notification/models"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
 )
@@ -31,27 +34,55 @@ type NotificationService struct {
 	mu          sync.RWMutex
 	// psub holds the pattern subscription so it can be closed on shutdown
 	psub *redis.PubSub
+	// eventsSub receives read-state changes and announcements to forward to
+	// subscribers
+	eventsSub *redis.PubSub
 	// providers deliver notifications to external channels
 	providers []Provider
+	// pushProviders deliver to the recipient's registered devices
+	pushProviders []PushProvider
+	// directory resolves the recipients of org and team broadcasts
+	directory MemberDirectory
+	// deliveryTimeout bounds each provider delivery and device push
+	deliveryTimeout time.Duration
 }
 
+// DefaultDeliveryTimeout bounds each provider delivery and device push
+// unless SetDeliveryTimeout changes it
+const DefaultDeliveryTimeout = 10 * time.Second
+
 // // // NewNotificationService creates a new NotificationService instance
 func NewNotificationService(db *gorm.DB, redisClient *cache.RedisClient, providers ...Provider) *NotificationService {
 	s := &NotificationService{
-		db:          db,
-		redis:       redisClient,
-		subscribers: make(map[string][]chan *notificationpb.NotificationEvent),
-		providers:   providers,
+		db:              db,
+		redis:           redisClient,
+		subscribers:     make(map[string][]chan *notificationpb.NotificationEvent),
+		providers:       providers,
+		deliveryTimeout: DefaultDeliveryTimeout,
 	}
 
 	// start redis subscriber to forward published notifications to local subscribers
 	if redisClient != nil {
 		go s.startRedisSubscriber(context.Background())
+		go s.startEventSubscriber(context.Background())
 	}
 
 	return s
 }
 
+// SetDeliveryTimeout sets how long each provider delivery and device push
+// may take
+func (s *NotificationService) SetDeliveryTimeout(timeout time.Duration) {
+	if timeout > 0 {
+		s.deliveryTimeout = timeout
+	}
+}
+
+// SetPushProviders sets the providers that push to users' registered devices
+func (s *NotificationService) SetPushProviders(providers ...PushProvider) {
+	s.pushProviders = providers
+}
+
 // // // SubscribeToNotifications handles bidirectional streaming for notifications
 func (s *NotificationService) SubscribeToNotifications(stream notificationpb.NotificationService_SubscribeToNotificationsServer) error {
 	ctx := stream.Context()
@@ -76,6 +107,7 @@ func (s *NotificationService) SubscribeToNotifications(stream notificationpb.Not
 	s.mu.Lock()
 	s.subscribers[userID] = append(s.subscribers[userID], notifChan)
 	s.mu.Unlock()
+	metrics.ActiveSubscribers.Inc()
 
 	// 	// 	// Cleanup on disconnect
 	defer func() {
@@ -91,6 +123,7 @@ func (s *NotificationService) SubscribeToNotifications(stream notificationpb.Not
 			delete(s.subscribers, userID)
 		}
 		s.mu.Unlock()
+		metrics.ActiveSubscribers.Dec()
 		close(notifChan)
 		log.Printf("User %s unsubscribed from notifications", userID)
 	}()
@@ -138,6 +171,11 @@ func (s *NotificationService) SendNotification(ctx context.Context, req *notific
 	if req.UserId == "" || req.Title == "" {
 		return nil, status.Error(codes.InvalidArgument, "user_id and title are required")
 	}
+	category, err := resolveCategory(req.Category, req.Type)
+	if err != nil {
+		return nil, err
+	}
+	severity := resolveSeverity(req.Severity, req.Type)
 
 	// 	// 	// Convert metadata to JSON
 	metadataJSON := "{}"
@@ -159,11 +197,16 @@ func (s *NotificationService) SendNotification(ctx context.Context, req *notific
 		RelatedUserID: req.RelatedUserId,
 		Read:          false,
 		Metadata:      metadataJSON,
+		Category:      category,
+		Severity:      severityToString(severity),
+		Priority:      priorityToString(resolvePriority(req.Priority, severity)),
 	}
 
 	if err := s.db.Create(notification).Error; err != nil {
+		recordSent(notification.Type, channelInApp, err)
 		return nil, status.Error(codes.Internal, "failed to create notification")
 	}
+	recordSent(notification.Type, channelInApp, nil)
 
 	// 	// 	// Broadcast to subscribed clients
 	event := s.modelToProto(notification, req.Metadata)
@@ -186,12 +229,7 @@ func (s *NotificationService) SendNotification(ctx context.Context, req *notific
 	deliverToProviders := true
 	var pref models.NotificationPreference
 	if err := s.db.Where("user_id = ?", req.UserId).First(&pref).Error; err == nil {
-		var channels map[string]bool
-		if err := json.Unmarshal([]byte(pref.Channels), &channels); err == nil {
-			if enabled, ok := channels["push"]; ok && !enabled {
-				deliverToProviders = false
-			}
-		}
+		deliverToProviders = pushEnabled(pref.Channels)
 	}
 
 	// append to a durable Redis Stream for workers to process (durable delivery)
@@ -201,7 +239,7 @@ func (s *NotificationService) SendNotification(ctx context.Context, req *notific
 				"user_id": req.UserId,
 				"payload": string(payload),
 			}
-			if _, err := s.redis.XAdd(ctx, "notifications:stream", values); err != nil {
+			if _, err := s.redis.XAdd(ctx, NotificationStream, values); err != nil {
 				log.Printf("failed to XAdd notification to stream: %v", err)
 			}
 		} else {
@@ -244,6 +282,36 @@ func (s *NotificationService) startRedisSubscriber(ctx context.Context) {
 	log.Printf("notification redis subscriber stopped")
 }
 
+// startEventSubscriber forwards read-state changes and announcements
+// published by any instance to local subscribers
+func (s *NotificationService) startEventSubscriber(ctx context.Context) {
+	sub := s.redis.Subscribe(ctx, cache.ReadStateChannel, cache.AnnouncementsChannel)
+	s.mu.Lock()
+	s.eventsSub = sub
+	s.mu.Unlock()
+
+	for msg := range sub.Channel() {
+		switch msg.Channel {
+		case cache.ReadStateChannel:
+			var ev cache.ReadStateEvent
+			if err := json.Unmarshal([]byte(msg.Payload), &ev); err != nil {
+				log.Printf("invalid read state event: %v", err)
+				continue
+			}
+			if ev.UserID != "" {
+				s.broadcastNotification(ev.UserID, readStateToProto(ev))
+			}
+		case cache.AnnouncementsChannel:
+			var ev cache.AnnouncementEvent
+			if err := json.Unmarshal([]byte(msg.Payload), &ev); err != nil {
+				log.Printf("invalid announcement event: %v", err)
+				continue
+			}
+			s.broadcastToAll(announcementToEvent(ev))
+		}
+	}
+}
+
 // Shutdown closes any background resources (redis subscription/client)
 func (s *NotificationService) Shutdown(ctx context.Context) error {
 	s.mu.Lock()
@@ -255,6 +323,12 @@ func (s *NotificationService) Shutdown(ctx context.Context) error {
 		}
 		s.psub = nil
 	}
+	if s.eventsSub != nil {
+		if err := s.eventsSub.Close(); err != nil {
+			log.Printf("error closing events subscription: %v", err)
+		}
+		s.eventsSub = nil
+	}
 
 	if s.redis != nil {
 		if err := s.redis.Close(); err != nil {
@@ -289,6 +363,20 @@ func (s *NotificationService) GetNotifications(ctx context.Context, req *notific
 	if req.UnreadOnly {
 		query = query.Where("read = ?", false)
 	}
+	if req.Type != notificationpb.NotificationType_NOTIFICATION_TYPE_UNSPECIFIED {
+		query = query.Where("type = ?", s.typeToString(req.Type))
+	}
+	if req.TaskId != "" {
+		query = query.Where("task_id = ?", req.TaskId)
+	}
+	if req.Category == CategoryGeneral {
+		query = query.Where("(category = ? OR category = '' OR category IS NULL)", CategoryGeneral)
+	} else if req.Category != "" {
+		query = query.Where("category = ?", req.Category)
+	}
+	if req.CriticalUnreadOnly {
+		query = query.Where("severity = ? AND read = ?", severityToString(notificationpb.NotificationSeverity_NOTIFICATION_SEVERITY_CRITICAL), false)
+	}
 
 	// 	// 	// Get total and unread counts
 	var totalCount, unreadCount int64
@@ -299,6 +387,10 @@ func (s *NotificationService) GetNotifications(ctx context.Context, req *notific
 	if err := s.db.Model(&models.Notification{}).Where("user_id = ? AND read = ?", req.UserId, false).Count(&unreadCount).Error; err != nil {
 		return nil, status.Error(codes.Internal, "failed to count unread notifications")
 	}
+	unreadByCategory, err := s.unreadByCategory(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
 
 	// 	// 	// Get notifications
 	var notifications []models.Notification
@@ -317,12 +409,34 @@ func (s *NotificationService) GetNotifications(ctx context.Context, req *notific
 	}
 
 	return &notificationpb.GetNotificationsResponse{
-		Notifications: protoNotifications,
-		TotalCount:    int32(totalCount),
-		UnreadCount:   int32(unreadCount),
+		Notifications:    protoNotifications,
+		TotalCount:       int32(totalCount),
+		UnreadCount:      int32(unreadCount),
+		UnreadByCategory: unreadByCategory,
 	}, nil
 }
 
+// unreadByCategory counts a user's unread notifications in each category
+func (s *NotificationService) unreadByCategory(ctx context.Context, userID string) (map[string]int32, error) {
+	var rows []struct {
+		Category string
+		Count    int32
+	}
+	err := s.db.WithContext(ctx).Model(&models.Notification{}).
+		Select("COALESCE(category, '') AS category, COUNT(*) AS count").
+		Where("user_id = ? AND read = ?", userID, false).
+		Group("COALESCE(category, '')").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to count unread notifications")
+	}
+	counts := make(map[string]int32, len(rows))
+	for _, row := range rows {
+		counts[storedCategory(row.Category)] += row.Count
+	}
+	return counts, nil
+}
+
 // // // MarkAsRead marks a notification as read
 func (s *NotificationService) MarkAsRead(ctx context.Context, req *notificationpb.MarkAsReadRequest) (*notificationpb.MarkAsReadResponse, error) {
 	if req.NotificationId == "" {
@@ -341,12 +455,76 @@ func (s *NotificationService) MarkAsRead(ctx context.Context, req *notificationp
 	if err := s.db.Save(&notification).Error; err != nil {
 		return nil, status.Error(codes.Internal, "failed to mark notification as read")
 	}
+	s.syncReadState(ctx, cache.ReadStateEvent{UserID: notification.UserID, NotificationIDs: []string{notification.ID}})
 
 	return &notificationpb.MarkAsReadResponse{
 		Message: "Notification marked as read",
 	}, nil
 }
 
+// MarkAllAsRead marks a user's unread notifications as read, optionally only
+// those of one type or created before a time
+func (s *NotificationService) MarkAllAsRead(ctx context.Context, req *notificationpb.MarkAllAsReadRequest) (*notificationpb.MarkAllAsReadResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	query := s.db.Model(&models.Notification{}).Where("user_id = ? AND read = ?", req.UserId, false)
+	if req.Type != notificationpb.NotificationType_NOTIFICATION_TYPE_UNSPECIFIED {
+		query = query.Where("type = ?", s.typeToString(req.Type))
+	}
+	if req.Before != nil {
+		query = query.Where("created_at < ?", req.Before.AsTime())
+	}
+
+	result := query.Update("read", true)
+	if result.Error != nil {
+		return nil, status.Error(codes.Internal, "failed to mark notifications as read")
+	}
+	if result.RowsAffected > 0 {
+		s.syncReadState(ctx, cache.ReadStateEvent{UserID: req.UserId, Bulk: true})
+	}
+	return &notificationpb.MarkAllAsReadResponse{Updated: int32(result.RowsAffected)}, nil
+}
+
+// DeleteNotification deletes one of a user's notifications
+func (s *NotificationService) DeleteNotification(ctx context.Context, req *notificationpb.DeleteNotificationRequest) (*notificationpb.DeleteNotificationResponse, error) {
+	if req.NotificationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "notification_id is required")
+	}
+
+	result := s.db.Where("id = ? AND user_id = ?", req.NotificationId, req.UserId).Delete(&models.Notification{})
+	if result.Error != nil {
+		return nil, status.Error(codes.Internal, "failed to delete notification")
+	}
+	if result.RowsAffected == 0 {
+		return nil, status.Error(codes.NotFound, "notification not found")
+	}
+	s.syncReadState(ctx, cache.ReadStateEvent{UserID: req.UserId, NotificationIDs: []string{req.NotificationId}, Deleted: true})
+	return &notificationpb.DeleteNotificationResponse{Message: "Notification deleted"}, nil
+}
+
+// ClearNotifications deletes all of a user's notifications, or only the read
+// ones
+func (s *NotificationService) ClearNotifications(ctx context.Context, req *notificationpb.ClearNotificationsRequest) (*notificationpb.ClearNotificationsResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	query := s.db.Where("user_id = ?", req.UserId)
+	if req.ReadOnly {
+		query = query.Where("read = ?", true)
+	}
+	result := query.Delete(&models.Notification{})
+	if result.Error != nil {
+		return nil, status.Error(codes.Internal, "failed to clear notifications")
+	}
+	if result.RowsAffected > 0 {
+		s.syncReadState(ctx, cache.ReadStateEvent{UserID: req.UserId, Bulk: true, Deleted: true})
+	}
+	return &notificationpb.ClearNotificationsResponse{Deleted: int32(result.RowsAffected)}, nil
+}
+
 // // // Helper methods
 
 func (s *NotificationService) broadcastNotification(userID string, event *notificationpb.NotificationEvent) {
@@ -370,6 +548,24 @@ func (s *NotificationService) broadcastNotification(userID string, event *notifi
 	}
 }
 
+// broadcastToAll sends event to every local subscriber, addressed to each
+func (s *NotificationService) broadcastToAll(event *notificationpb.NotificationEvent) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for userID, channels := range s.subscribers {
+		addressed := proto.Clone(event).(*notificationpb.NotificationEvent)
+		addressed.UserId = userID
+		for _, ch := range channels {
+			select {
+			case ch <- addressed:
+			default:
+				log.Printf("Channel full for user %s, skipping notification", userID)
+			}
+		}
+	}
+}
+
 func (s *NotificationService) modelToProto(notif *models.Notification, metadata map[string]string) *notificationpb.NotificationEvent {
 	return &notificationpb.NotificationEvent{
 		NotificationId: notif.ID,
@@ -382,22 +578,149 @@ func (s *NotificationService) modelToProto(notif *models.Notification, metadata
 		CreatedAt:      timestamppb.New(notif.CreatedAt),
 		Read:           notif.Read,
 		Metadata:       metadata,
+		Category:       storedCategory(notif.Category),
+		Severity:       stringToSeverity(notif.Severity),
+		Priority:       stringToPriority(notif.Priority),
 	}
 }
 
-// ProcessStreamEvent performs delivery for events coming from the stream/worker
-func (s *NotificationService) ProcessStreamEvent(ctx context.Context, event *notificationpb.NotificationEvent) error {
+// deliveryDedupeTTL is how long a delivered notification_id is remembered so
+// redeliveries of the same stream entry are skipped
+const deliveryDedupeTTL = 24 * time.Hour
+
+func deliveryDedupeKey(notificationID string) string {
+	return fmt.Sprintf("notifications:delivered:%s", notificationID)
+}
+
+// ProcessStreamEvent performs delivery for events coming from the stream/worker.
+// It returns the errors of every provider that failed, so the worker can
+// retry the event. An event already delivered, e.g. redelivered after a
+// worker crashed before acking it, is skipped.
+func (s *NotificationService) ProcessStreamEvent(ctx context.Context, event *notificationpb.NotificationEvent) (err error) {
+	if s.redis != nil && event.NotificationId != "" {
+		key := deliveryDedupeKey(event.NotificationId)
+		claimed, claimErr := s.redis.SetNX(ctx, key, time.Now().Unix(), deliveryDedupeTTL)
+		if claimErr != nil {
+			// delivering twice beats not delivering
+			log.Printf("failed to check delivery of notification %s: %v", event.NotificationId, claimErr)
+		} else if !claimed {
+			log.Printf("skipping already delivered notification %s", event.NotificationId)
+			metrics.NotificationsSent.WithLabelValues(s.typeToString(event.Type), channelStream, "duplicate").Inc()
+			return nil
+		} else {
+			// release the claim so the worker's retry is not skipped
+			defer func() {
+				if err != nil {
+					if delErr := s.redis.Delete(context.WithoutCancel(ctx), key); delErr != nil {
+						log.Printf("failed to release delivery of notification %s: %v", event.NotificationId, delErr)
+					}
+				}
+			}()
+		}
+	}
+
+	event.Priority = resolvePriority(event.Priority, event.Severity)
+
 	// broadcast to any connected local subscribers
 	s.broadcastNotification(event.UserId, event)
 
-	// deliver to external providers (run serially to allow error handling; providers should be lightweight)
+	// deliver to external providers and devices in parallel, each under its
+	// own deadline so a slow one does not hold up the rest
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+	fail := func(err error) {
+		mu.Lock()
+		errs = append(errs, err)
+		mu.Unlock()
+	}
 	for _, p := range s.providers {
-		if err := p.Deliver(ctx, event); err != nil {
-			log.Printf("provider delivery error for notification %s: %v", event.NotificationId, err)
+		wg.Add(1)
+		go func(p Provider) {
+			defer wg.Done()
+			pctx, cancel := context.WithTimeout(ctx, s.deliveryTimeout)
+			defer cancel()
+			err := p.Deliver(pctx, event)
+			recordSent(s.typeToString(event.Type), providerChannel(p), err)
+			if err != nil {
+				log.Printf("provider delivery error for notification %s: %v", event.NotificationId, err)
+				fail(fmt.Errorf("%s: %w", providerChannel(p), err))
+			}
+		}(p)
+	}
+	if event.Priority != notificationpb.NotificationPriority_NOTIFICATION_PRIORITY_CRITICAL && s.inQuietHours(ctx, event.UserId, time.Now()) {
+		// the notification stays in the inbox; only critical ones are pushed
+		log.Printf("not pushing notification %s during quiet hours of user %s", event.NotificationId, event.UserId)
+	} else if err := s.pushToDevices(ctx, event); err != nil {
+		fail(err)
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+// pushToDevices pushes event to each of the recipient's registered devices
+// through the push provider serving its platform, all at once
+func (s *NotificationService) pushToDevices(ctx context.Context, event *notificationpb.NotificationEvent) error {
+	if len(s.pushProviders) == 0 {
+		return nil
+	}
+	var devices []models.Device
+	if err := s.db.WithContext(ctx).Where("user_id = ?", event.UserId).Find(&devices).Error; err != nil {
+		return fmt.Errorf("failed to load devices of user %s: %w", event.UserId, err)
+	}
+	byPlatform := make(map[string][]models.Device)
+	for _, d := range devices {
+		platform := strings.ToLower(d.Platform)
+		byPlatform[platform] = append(byPlatform[platform], d)
+	}
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+	for _, p := range s.pushProviders {
+		for _, platform := range p.Platforms() {
+			for _, d := range byPlatform[platform] {
+				wg.Add(1)
+				go func(p PushProvider, d models.Device) {
+					defer wg.Done()
+					if err := s.pushToDevice(ctx, p, event, d); err != nil {
+						mu.Lock()
+						errs = append(errs, fmt.Errorf("%s device %s: %w", providerChannel(p), d.ID, err))
+						mu.Unlock()
+					}
+				}(p, d)
+			}
 		}
 	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
 
-	return nil
+// pushToDevice pushes event to one device, dropping the device if the push
+// service no longer knows its token
+func (s *NotificationService) pushToDevice(ctx context.Context, p PushProvider, event *notificationpb.NotificationEvent, d models.Device) error {
+	pctx, cancel := context.WithTimeout(ctx, s.deliveryTimeout)
+	defer cancel()
+	err := p.Push(pctx, event, d.Token)
+	if errors.Is(err, ErrInvalidDeviceToken) {
+		metrics.NotificationsSent.WithLabelValues(s.typeToString(event.Type), providerChannel(p), "invalid_token").Inc()
+		// retrying cannot reach this device, so stop targeting it
+		log.Printf("removing device %s of user %s: %v", d.ID, d.UserID, err)
+		if err := s.db.WithContext(ctx).Delete(&models.Device{}, "id = ?", d.ID).Error; err != nil {
+			log.Printf("failed to remove device %s: %v", d.ID, err)
+		}
+		return nil
+	}
+	recordSent(s.typeToString(event.Type), providerChannel(p), err)
+	if err != nil {
+		log.Printf("push to device %s failed for notification %s: %v", d.ID, event.NotificationId, err)
+	}
+	return err
 }
 
 func (s *NotificationService) typeToString(t notificationpb.NotificationType) string {
@@ -414,6 +737,16 @@ func (s *NotificationService) typeToString(t notificationpb.NotificationType) st
 		return "task_due_soon"
 	case notificationpb.NotificationType_NOTIFICATION_TYPE_TASK_OVERDUE:
 		return "task_overdue"
+	case notificationpb.NotificationType_NOTIFICATION_TYPE_TASK_MENTION:
+		return "task_mention"
+	case notificationpb.NotificationType_NOTIFICATION_TYPE_ACCOUNT_LOCKED:
+		return "account_locked"
+	case notificationpb.NotificationType_NOTIFICATION_TYPE_NEW_DEVICE_LOGIN:
+		return "new_device_login"
+	case notificationpb.NotificationType_NOTIFICATION_TYPE_SESSION_REVOKED:
+		return "session_revoked"
+	case notificationpb.NotificationType_NOTIFICATION_TYPE_STATUS_REPORT_DUE:
+		return "status_report_due"
 	default:
 		return "unknown"
 	}
@@ -433,19 +766,35 @@ func (s *NotificationService) stringToType(t string) notificationpb.Notification
 		return notificationpb.NotificationType_NOTIFICATION_TYPE_TASK_DUE_SOON
 	case "task_overdue":
 		return notificationpb.NotificationType_NOTIFICATION_TYPE_TASK_OVERDUE
+	case "task_mention":
+		return notificationpb.NotificationType_NOTIFICATION_TYPE_TASK_MENTION
+	case "account_locked":
+		return notificationpb.NotificationType_NOTIFICATION_TYPE_ACCOUNT_LOCKED
+	case "new_device_login":
+		return notificationpb.NotificationType_NOTIFICATION_TYPE_NEW_DEVICE_LOGIN
+	case "session_revoked":
+		return notificationpb.NotificationType_NOTIFICATION_TYPE_SESSION_REVOKED
+	case "status_report_due":
+		return notificationpb.NotificationType_NOTIFICATION_TYPE_STATUS_REPORT_DUE
 	default:
 		return notificationpb.NotificationType_NOTIFICATION_TYPE_UNSPECIFIED
 	}
 }
 
 // // // BroadcastTaskAssignment is a helper to broadcast task assignment notifications
-func (s *NotificationService) BroadcastTaskAssignment(userID, taskID, title string) error {
+// for a task of orgID, empty for personal tasks
+func (s *NotificationService) BroadcastTaskAssignment(userID, orgID, taskID, title string) error {
+	var metadata map[string]string
+	if orgID != "" {
+		metadata = map[string]string{eventOrgMetadata: orgID}
+	}
 	_, err := s.SendNotification(context.Background(), &notificationpb.SendNotificationRequest{
-		UserId:  userID,
-		Type:    notificationpb.NotificationType_NOTIFICATION_TYPE_TASK_ASSIGNED,
-		Title:   "New Task Assigned",
-		Message: fmt.Sprintf("You have been assigned to task: %s", title),
-		TaskId:  taskID,
+		UserId:   userID,
+		Type:     notificationpb.NotificationType_NOTIFICATION_TYPE_TASK_ASSIGNED,
+		Title:    "New Task Assigned",
+		Message:  fmt.Sprintf("You have been assigned to task: %s", title),
+		TaskId:   taskID,
+		Metadata: metadata,
 	})
 	return err
 }
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	notificationpb "github.com/chanduchitikam/task-management-system/proto/notification"
+	"github.com/chanduchitikam/task-management-system/services/notification/models"
+	"github.com/sideshow/apns2"
+)
+
+// resolvePriority returns the sender's priority, or critical for critical
+// severity and normal otherwise when unspecified
+func resolvePriority(priority notificationpb.NotificationPriority, severity notificationpb.NotificationSeverity) notificationpb.NotificationPriority {
+	if priority != notificationpb.NotificationPriority_NOTIFICATION_PRIORITY_UNSPECIFIED {
+		return priority
+	}
+	if severity == notificationpb.NotificationSeverity_NOTIFICATION_SEVERITY_CRITICAL {
+		return notificationpb.NotificationPriority_NOTIFICATION_PRIORITY_CRITICAL
+	}
+	return notificationpb.NotificationPriority_NOTIFICATION_PRIORITY_NORMAL
+}
+
+func priorityToString(p notificationpb.NotificationPriority) string {
+	switch p {
+	case notificationpb.NotificationPriority_NOTIFICATION_PRIORITY_LOW:
+		return "low"
+	case notificationpb.NotificationPriority_NOTIFICATION_PRIORITY_CRITICAL:
+		return "critical"
+	default:
+		return "normal"
+	}
+}
+
+func stringToPriority(p string) notificationpb.NotificationPriority {
+	switch p {
+	case "low":
+		return notificationpb.NotificationPriority_NOTIFICATION_PRIORITY_LOW
+	case "critical":
+		return notificationpb.NotificationPriority_NOTIFICATION_PRIORITY_CRITICAL
+	default:
+		return notificationpb.NotificationPriority_NOTIFICATION_PRIORITY_NORMAL
+	}
+}
+
+// apnsPriority maps a priority to the APNs delivery priority and
+// interruption level. Critical uses time-sensitive, as the critical level
+// needs an entitlement from Apple.
+func apnsPriority(p notificationpb.NotificationPriority) (int, string) {
+	switch p {
+	case notificationpb.NotificationPriority_NOTIFICATION_PRIORITY_LOW:
+		return apns2.PriorityLow, "passive"
+	case notificationpb.NotificationPriority_NOTIFICATION_PRIORITY_CRITICAL:
+		return apns2.PriorityHigh, "time-sensitive"
+	default:
+		return apns2.PriorityHigh, "active"
+	}
+}
+
+// fcmPriority maps a priority to the FCM message priority
+func fcmPriority(p notificationpb.NotificationPriority) string {
+	if p == notificationpb.NotificationPriority_NOTIFICATION_PRIORITY_CRITICAL {
+		return "high"
+	}
+	return "normal"
+}
+
+// inQuietHours reports whether userID's quiet hours are on at now
+func (s *NotificationService) inQuietHours(ctx context.Context, userID string, now time.Time) bool {
+	var pref models.NotificationPreference
+	if err := s.db.WithContext(ctx).Where("user_id = ?", userID).First(&pref).Error; err != nil {
+		return false
+	}
+	return quietHoursActive(pref, now)
+}
+
+func quietHoursActive(pref models.NotificationPreference, now time.Time) bool {
+	if pref.QuietHoursStart == "" || pref.QuietHoursEnd == "" {
+		return false
+	}
+	start, err := time.Parse("15:04", pref.QuietHoursStart)
+	if err != nil {
+		return false
+	}
+	end, err := time.Parse("15:04", pref.QuietHoursEnd)
+	if err != nil {
+		return false
+	}
+	loc := time.UTC
+	if pref.Timezone != "" {
+		if l, err := time.LoadLocation(pref.Timezone); err == nil {
+			loc = l
+		}
+	}
+
+	local := now.In(loc)
+	minute := local.Hour()*60 + local.Minute()
+	from := start.Hour()*60 + start.Minute()
+	to := end.Hour()*60 + end.Minute()
+	if from <= to {
+		return minute >= from && minute < to
+	}
+	// e.g. 22:00-07:00
+	return minute >= from || minute < to
+}
@@ -2,8 +2,10 @@ package service
 
 import (
 	"context"
+	"errors"
 	"log"
 
+	"github.com/chanduchitikam/task-management-system/pkg/metrics"
 	notificationpb "github.com/chanduchitikam/task-management-system/proto/notification"
 )
 
@@ -12,6 +14,50 @@ type Provider interface {
 	Deliver(ctx context.Context, event *notificationpb.NotificationEvent) error
 }
 
+// ErrInvalidDeviceToken is returned by a PushProvider when the push service
+// reports the device token is no longer valid. The device is then dropped.
+var ErrInvalidDeviceToken = errors.New("invalid device token")
+
+// PushProvider delivers to individual devices. ProcessStreamEvent looks up
+// the recipient's registered devices and pushes to each one on a platform
+// the provider serves.
+type PushProvider interface {
+	Platforms() []string
+	Push(ctx context.Context, event *notificationpb.NotificationEvent, deviceToken string) error
+}
+
+// Channels notifications are counted under in the notifications_sent_total
+// metric, besides each provider's own
+const (
+	channelInApp  = "in_app"
+	channelStream = "stream"
+)
+
+// providerChannel names the channel a provider delivers through
+func providerChannel(p interface{}) string {
+	switch p.(type) {
+	case *ConsoleProvider:
+		return "console"
+	case *TeamsProvider:
+		return "teams"
+	case *FCMProvider:
+		return "fcm"
+	case *APNSProvider:
+		return "apns"
+	default:
+		return "other"
+	}
+}
+
+// recordSent counts one delivery attempt of a notification of notifType
+func recordSent(notifType, channel string, err error) {
+	status := "success"
+	if err != nil {
+		status = "failed"
+	}
+	metrics.NotificationsSent.WithLabelValues(notifType, channel, status).Inc()
+}
+
 // ConsoleProvider is a simple provider that logs notifications (useful for local testing)
 type ConsoleProvider struct{}
 
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	notificationpb "github.com/chanduchitikam/task-management-system/proto/notification"
+	"github.com/chanduchitikam/task-management-system/services/notification/models"
 	"github.com/sideshow/apns2"
 	"github.com/sideshow/apns2/token"
 )
@@ -47,22 +48,25 @@ func NewAPNSProvider(keyPath, keyID, teamID, topic string, sandbox bool) (*APNSP
 	return &APNSProvider{client: apnsClient, topic: topic}, nil
 }
 
-// Deliver sends an APNs notification. Expects device token in event.Metadata["device_token"].
-func (a *APNSProvider) Deliver(ctx context.Context, event *notificationpb.NotificationEvent) error {
+// Platforms reports that APNs reaches iOS devices
+func (a *APNSProvider) Platforms() []string {
+	return []string{models.PlatformIOS}
+}
+
+// Push sends the event to one device via APNs
+func (a *APNSProvider) Push(ctx context.Context, event *notificationpb.NotificationEvent, deviceToken string) error {
 	if event == nil {
 		return errors.New("nil event")
 	}
-	var deviceToken string
-	if event.Metadata != nil {
-		if tok, ok := event.Metadata["device_token"]; ok {
-			deviceToken = tok
-		}
-	}
 	if deviceToken == "" {
-		return fmt.Errorf("missing device_token in metadata for notification %s", event.NotificationId)
+		return fmt.Errorf("missing device token for notification %s", event.NotificationId)
 	}
 
-	aps := map[string]interface{}{"aps": map[string]interface{}{"alert": map[string]string{"title": event.Title, "body": event.Message}}}
+	priority, interruptionLevel := apnsPriority(event.Priority)
+	aps := map[string]interface{}{"aps": map[string]interface{}{
+		"alert":              map[string]string{"title": event.Title, "body": event.Message},
+		"interruption-level": interruptionLevel,
+	}}
 	payloadBytes, _ := json.Marshal(aps)
 
 	p := &apns2.Notification{
@@ -70,12 +74,17 @@ func (a *APNSProvider) Deliver(ctx context.Context, event *notificationpb.Notifi
 		Topic:       a.topic,
 		Payload:     payloadBytes,
 		Expiration:  time.Now().Add(24 * time.Hour),
+		Priority:    priority,
 	}
 
-	res, err := a.client.Push(p)
+	res, err := a.client.PushWithContext(ctx, p)
 	if err != nil {
 		return fmt.Errorf("apns push failed: %w", err)
 	}
+	switch res.Reason {
+	case apns2.ReasonBadDeviceToken, apns2.ReasonUnregistered, apns2.ReasonDeviceTokenNotForTopic:
+		return fmt.Errorf("apns rejected device token (%s): %w", res.Reason, ErrInvalidDeviceToken)
+	}
 	if res.StatusCode >= 400 {
 		return fmt.Errorf("apns push failed status %d: %s", res.StatusCode, res.Reason)
 	}
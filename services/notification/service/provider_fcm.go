@@ -6,11 +6,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
 	"net/http"
 	"time"
 
 	notificationpb "github.com/chanduchitikam/task-management-system/proto/notification"
+	"github.com/chanduchitikam/task-management-system/services/notification/models"
 )
 
 // FCMProvider sends notifications via Firebase Cloud Messaging (legacy server key API)
@@ -29,21 +29,18 @@ func NewFCMProvider(serverKey string) *FCMProvider {
 	}
 }
 
-// Deliver sends a push notification using FCM. Expects device token in event.Metadata["device_token"].
-func (f *FCMProvider) Deliver(ctx context.Context, event *notificationpb.NotificationEvent) error {
+// Platforms reports that FCM reaches Android and web devices
+func (f *FCMProvider) Platforms() []string {
+	return []string{models.PlatformAndroid, models.PlatformWeb}
+}
+
+// Push sends the event to one device using FCM
+func (f *FCMProvider) Push(ctx context.Context, event *notificationpb.NotificationEvent, deviceToken string) error {
 	if event == nil {
 		return errors.New("nil event")
 	}
-
-	// extract device token from metadata
-	var deviceToken string
-	if event.Metadata != nil {
-		if tok, ok := event.Metadata["device_token"]; ok {
-			deviceToken = tok
-		}
-	}
 	if deviceToken == "" {
-		return fmt.Errorf("missing device_token in metadata for notification %s", event.NotificationId)
+		return fmt.Errorf("missing device token for notification %s", event.NotificationId)
 	}
 
 	payload := map[string]interface{}{
@@ -52,7 +49,8 @@ func (f *FCMProvider) Deliver(ctx context.Context, event *notificationpb.Notific
 			"title": event.Title,
 			"body":  event.Message,
 		},
-		"data": event.Metadata,
+		"data":     event.Metadata,
+		"priority": fcmPriority(event.Priority),
 	}
 
 	body, err := json.Marshal(payload)
@@ -60,19 +58,7 @@ func (f *FCMProvider) Deliver(ctx context.Context, event *notificationpb.Notific
 		return fmt.Errorf("failed to marshal fcm payload: %w", err)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, "POST", "https://fcm.googleapis.com/fcm/send", nil)
-	if err != nil {
-		return err
-	}
-	req.Header.Set("Authorization", "key="+f.serverKey)
-	req.Header.Set("Content-Type", "application/json")
-	req.Body = http.NoBody
-	// attach body via reader
-	req.GetBody = func() (io.ReadCloser, error) { return io.NopCloser(bytes.NewReader(body)), nil }
-	req.ContentLength = int64(len(body))
-
-	// Workaround: use http.NewRequestWithContext with body reader directly
-	req, err = http.NewRequestWithContext(ctx, "POST", "https://fcm.googleapis.com/fcm/send", bytes.NewReader(body))
+	req, err := http.NewRequestWithContext(ctx, "POST", "https://fcm.googleapis.com/fcm/send", bytes.NewReader(body))
 	if err != nil {
 		return err
 	}
@@ -89,5 +75,21 @@ func (f *FCMProvider) Deliver(ctx context.Context, event *notificationpb.Notific
 		return fmt.Errorf("fcm returned non-200 status: %d", resp.StatusCode)
 	}
 
-	return nil
+	// per-token failures come back with a 200
+	var result struct {
+		Results []struct {
+			Error string `json:"error"`
+		} `json:"results"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil || len(result.Results) == 0 {
+		return nil
+	}
+	switch reason := result.Results[0].Error; reason {
+	case "":
+		return nil
+	case "NotRegistered", "InvalidRegistration", "MismatchSenderId":
+		return fmt.Errorf("fcm rejected device token (%s): %w", reason, ErrInvalidDeviceToken)
+	default:
+		return fmt.Errorf("fcm push failed: %s", reason)
+	}
 }
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	notificationpb "github.com/chanduchitikam/task-management-system/proto/notification"
+	"github.com/chanduchitikam/task-management-system/services/notification/models"
+	"gorm.io/gorm"
+)
+
+// TeamsProvider posts task assignment and due-date notifications as
+// adaptive cards to the Microsoft Teams webhook of the organization the
+// task belongs to, named by the event's org_id metadata. Events without an
+// org, and organizations without an enabled webhook, are skipped.
+type TeamsProvider struct {
+	db     *gorm.DB
+	client *http.Client
+	// appURL is the web app's base URL, used for the card's "Open task" link
+	appURL string
+}
+
+// NewTeamsProvider creates a TeamsProvider. appURL may be empty, in which
+// case cards carry no link.
+func NewTeamsProvider(db *gorm.DB, appURL string) *TeamsProvider {
+	return &TeamsProvider{
+		db: db,
+		client: &http.Client{
+			Timeout: 10 * time.Second,
+			// webhooks are checked against teamsWebhookHosts; a redirect would
+			// get around that
+			CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+		},
+		appURL: strings.TrimRight(appURL, "/"),
+	}
+}
+
+// eventOrgMetadata is the notification metadata key naming the org an event
+// is about; recipients may belong to several
+const eventOrgMetadata = "org_id"
+
+// Deliver posts the event to its organization's Teams channel
+func (t *TeamsProvider) Deliver(ctx context.Context, event *notificationpb.NotificationEvent) error {
+	if event == nil {
+		return errors.New("nil event")
+	}
+	var dueDate bool
+	switch event.Type {
+	case notificationpb.NotificationType_NOTIFICATION_TYPE_TASK_ASSIGNED:
+	case notificationpb.NotificationType_NOTIFICATION_TYPE_TASK_DUE_SOON, notificationpb.NotificationType_NOTIFICATION_TYPE_TASK_OVERDUE:
+		dueDate = true
+	default:
+		return nil
+	}
+	orgID := event.Metadata[eventOrgMetadata]
+	if orgID == "" {
+		return nil
+	}
+
+	var integration models.TeamsIntegration
+	err := t.db.WithContext(ctx).Where("enabled = ? AND org_id = ?", true, orgID).First(&integration).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load teams integration: %w", err)
+	}
+	if (dueDate && !integration.NotifyDueDates) || (!dueDate && !integration.NotifyAssignments) {
+		return nil
+	}
+
+	body, err := json.Marshal(t.adaptiveCardMessage(event))
+	if err != nil {
+		return fmt.Errorf("failed to marshal teams payload: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", integration.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("teams webhook request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		reason, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("teams webhook returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(reason)))
+	}
+	return nil
+}
+
+// adaptiveCardMessage wraps the event in the message envelope Teams
+// webhooks accept, as a single adaptive card attachment
+func (t *TeamsProvider) adaptiveCardMessage(event *notificationpb.NotificationEvent) map[string]interface{} {
+	body := []interface{}{
+		map[string]interface{}{"type": "TextBlock", "text": event.Title, "weight": "Bolder", "size": "Medium", "wrap": true},
+		map[string]interface{}{"type": "TextBlock", "text": event.Message, "wrap": true},
+	}
+	var facts []interface{}
+	if key := event.Metadata["task_key"]; key != "" {
+		facts = append(facts, map[string]string{"title": "Task", "value": key})
+	}
+	if due := event.Metadata["due_date"]; due != "" {
+		if parsed, err := time.Parse(time.RFC3339, due); err == nil {
+			due = parsed.UTC().Format("Jan 2, 2006 15:04 MST")
+		}
+		facts = append(facts, map[string]string{"title": "Due", "value": due})
+	}
+	if len(facts) > 0 {
+		body = append(body, map[string]interface{}{"type": "FactSet", "facts": facts})
+	}
+
+	card := map[string]interface{}{
+		"$schema": "http://adaptivecards.io/schemas/adaptive-card.json",
+		"type":    "AdaptiveCard",
+		"version": "1.4",
+		"body":    body,
+	}
+	if t.appURL != "" && event.TaskId != "" {
+		card["actions"] = []interface{}{
+			map[string]string{"type": "Action.OpenUrl", "title": "Open task", "url": t.appURL + "/tasks/" + event.TaskId},
+		}
+	}
+	return map[string]interface{}{
+		"type": "message",
+		"attachments": []interface{}{
+			map[string]interface{}{"contentType": "application/vnd.microsoft.card.adaptive", "content": card},
+		},
+	}
+}
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+	"log"
+
+	"github.com/chanduchitikam/task-management-system/pkg/cache"
+	notificationpb "github.com/chanduchitikam/task-management-system/proto/notification"
+	"github.com/chanduchitikam/task-management-system/services/notification/models"
+)
+
+// syncReadState tells the user's other sessions about notifications read or
+// removed on this one, along with the unread count left. Every instance,
+// this one included, forwards it to its subscribers from the Redis channel.
+func (s *NotificationService) syncReadState(ctx context.Context, ev cache.ReadStateEvent) {
+	if err := s.db.WithContext(ctx).Model(&models.Notification{}).
+		Where("user_id = ? AND read = ?", ev.UserID, false).
+		Count(&ev.UnreadCount).Error; err != nil {
+		log.Printf("failed to count unread notifications of user %s: %v", ev.UserID, err)
+		return
+	}
+	if s.redis == nil {
+		s.broadcastNotification(ev.UserID, readStateToProto(ev))
+		return
+	}
+	if err := s.redis.PublishReadState(ctx, ev); err != nil {
+		log.Printf("failed to publish read state of user %s: %v", ev.UserID, err)
+		s.broadcastNotification(ev.UserID, readStateToProto(ev))
+	}
+}
+
+func readStateToProto(ev cache.ReadStateEvent) *notificationpb.NotificationEvent {
+	return &notificationpb.NotificationEvent{
+		UserId: ev.UserID,
+		ReadState: &notificationpb.ReadStateUpdate{
+			NotificationIds: ev.NotificationIDs,
+			Bulk:            ev.Bulk,
+			Deleted:         ev.Deleted,
+			UnreadCount:     int32(ev.UnreadCount),
+		},
+	}
+}
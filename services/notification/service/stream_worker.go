@@ -0,0 +1,321 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/pkg/cache"
+	notificationpb "github.com/chanduchitikam/task-management-system/proto/notification"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Redis keys of the durable delivery pipeline
+const (
+	// NotificationStream holds events waiting for provider delivery
+	NotificationStream = "notifications:stream"
+	// NotificationDLQ holds events that could not be parsed or delivered
+	NotificationDLQ = "notifications:dlq"
+	// notificationRetryQueue holds failed events until their next attempt
+	notificationRetryQueue  = "notifications:retry"
+	notificationWorkerGroup = "notification_workers"
+)
+
+const (
+	// DefaultMaxDeliveryAttempts is how many times an event is tried before
+	// it is moved to the DLQ
+	DefaultMaxDeliveryAttempts = 5
+	retryBaseDelay             = 10 * time.Second
+	retryMaxDelay              = 10 * time.Minute
+	// pendingClaimIdle is how long a read but unacked entry may sit before
+	// another worker takes it over from its presumably dead consumer
+	pendingClaimIdle = 5 * time.Minute
+)
+
+// retryEntry is a failed event waiting in the retry queue
+type retryEntry struct {
+	MessageID string `json:"message_id"`
+	UserID    string `json:"user_id"`
+	Payload   string `json:"payload"`
+	Attempts  int    `json:"attempts"`
+	Error     string `json:"error"`
+}
+
+// StreamWorker consumes the notification stream and delivers each event
+// through the service's providers. Failed events are retried with
+// exponential backoff and moved to the DLQ after maxAttempts tries.
+// Comments, updates and mentions for the same user and task arriving within
+// coalesceWindow are delivered as one grouped notification.
+type StreamWorker struct {
+	redis          *cache.RedisClient
+	service        *NotificationService
+	consumer       string
+	maxAttempts    int
+	coalesceWindow time.Duration
+}
+
+// NewStreamWorker creates a worker reading the stream as consumer. A zero
+// coalesceWindow delivers every event on its own.
+func NewStreamWorker(redisClient *cache.RedisClient, svc *NotificationService, consumer string, maxAttempts int, coalesceWindow time.Duration) *StreamWorker {
+	if maxAttempts < 1 {
+		maxAttempts = DefaultMaxDeliveryAttempts
+	}
+	return &StreamWorker{redis: redisClient, service: svc, consumer: consumer, maxAttempts: maxAttempts, coalesceWindow: coalesceWindow}
+}
+
+// Run consumes the stream until ctx is done. Due retries and coalesced
+// groups are put back on the stream alongside, and entries left pending by
+// dead consumers are taken over.
+func (w *StreamWorker) Run(ctx context.Context) {
+	// create consumer group if not exists
+	if err := w.redis.XGroupCreateMkStream(ctx, NotificationStream, notificationWorkerGroup, "0"); err != nil {
+		// ignore BUSYGROUP error
+		if !strings.Contains(err.Error(), "BUSYGROUP") {
+			log.Printf("warning: failed to create consumer group: %v", err)
+		}
+	}
+	go w.requeueDueRetries(ctx)
+	go w.reportDLQDepth(ctx)
+	go w.reclaimIdle(ctx)
+	if w.coalesceWindow > 0 {
+		go w.flushCoalesced(ctx)
+	}
+
+	log.Printf("notification stream worker %s started", w.consumer)
+	for ctx.Err() == nil {
+		msgs, err := w.redis.XReadGroup(ctx, notificationWorkerGroup, w.consumer, NotificationStream, 10, 5000*time.Millisecond)
+		if err != nil && err != redis.Nil {
+			log.Printf("error reading from stream: %v", err)
+			time.Sleep(time.Second)
+			continue
+		}
+		for _, m := range msgs {
+			w.handle(ctx, m)
+		}
+	}
+}
+
+func (w *StreamWorker) handle(ctx context.Context, m redis.XMessage) {
+	// payload stored under 'payload'
+	raw, ok := m.Values["payload"]
+	if !ok {
+		// ack and skip malformed
+		w.ack(ctx, m.ID)
+		return
+	}
+	payloadStr := streamValue(raw)
+	userID := streamValue(m.Values["user_id"])
+
+	var event notificationpb.NotificationEvent
+	if err := protojson.Unmarshal([]byte(payloadStr), &event); err != nil {
+		log.Printf("failed to unmarshal stream payload for id %s: %v", m.ID, err)
+		// move malformed payload to DLQ for inspection and ack the original
+		w.deadLetter(ctx, retryEntry{MessageID: m.ID, UserID: userID, Payload: payloadStr, Error: err.Error()})
+		w.ack(ctx, m.ID)
+		return
+	}
+
+	// retries and already grouped events are delivered as they are
+	_, coalesced := m.Values["coalesced"]
+	_, retried := m.Values["attempts"]
+	if !coalesced && !retried && w.coalesce(ctx, &event, payloadStr) {
+		w.ack(ctx, m.ID)
+		return
+	}
+
+	err := w.service.ProcessStreamEvent(ctx, &event)
+	if err == nil {
+		w.ack(ctx, m.ID)
+		return
+	}
+
+	attempts, _ := strconv.Atoi(streamValue(m.Values["attempts"]))
+	attempts++
+	entry := retryEntry{MessageID: m.ID, UserID: userID, Payload: payloadStr, Attempts: attempts, Error: err.Error()}
+	if attempts >= w.maxAttempts {
+		log.Printf("giving up on notification %s after %d attempts: %v", event.NotificationId, attempts, err)
+		w.deadLetter(ctx, entry)
+		w.ack(ctx, m.ID)
+		return
+	}
+
+	delay := retryDelay(attempts)
+	log.Printf("delivery of notification %s failed (attempt %d of %d), retrying in %s: %v", event.NotificationId, attempts, w.maxAttempts, delay, err)
+	member, _ := json.Marshal(entry)
+	if err := w.redis.DelayedAdd(ctx, notificationRetryQueue, string(member), time.Now().Add(delay)); err != nil {
+		// leave it pending so it is not lost
+		log.Printf("failed to schedule retry of message %s: %v", m.ID, err)
+		return
+	}
+	w.ack(ctx, m.ID)
+}
+
+// requeueDueRetries moves retries whose backoff has passed back onto the
+// stream, carrying their attempt count
+func (w *StreamWorker) requeueDueRetries(ctx context.Context) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		due, err := w.redis.DelayedPopDue(ctx, notificationRetryQueue, time.Now(), 100)
+		if err != nil {
+			log.Printf("failed to read due notification retries: %v", err)
+			continue
+		}
+		for _, member := range due {
+			var entry retryEntry
+			if err := json.Unmarshal([]byte(member), &entry); err != nil {
+				log.Printf("dropping malformed notification retry: %v", err)
+				continue
+			}
+			values := map[string]interface{}{
+				"user_id":  entry.UserID,
+				"payload":  entry.Payload,
+				"attempts": entry.Attempts,
+			}
+			if _, err := w.redis.XAdd(ctx, NotificationStream, values); err != nil {
+				log.Printf("failed to requeue message %s, trying again shortly: %v", entry.MessageID, err)
+				if err := w.redis.DelayedAdd(ctx, notificationRetryQueue, member, time.Now().Add(retryBaseDelay)); err != nil {
+					log.Printf("lost retry of message %s: %v", entry.MessageID, err)
+				}
+			}
+		}
+	}
+}
+
+// reclaimIdle periodically claims entries other consumers read but never
+// acked, e.g. because they crashed mid-batch, and handles them here.
+// Entries that have already been read more than maxAttempts times are
+// taken to crash their worker and are moved to the DLQ instead.
+func (w *StreamWorker) reclaimIdle(ctx context.Context) {
+	ticker := time.NewTicker(pendingClaimIdle / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		pending, err := w.redis.XPendingRange(ctx, NotificationStream, notificationWorkerGroup, "-", "+", 1000)
+		if err != nil {
+			log.Printf("failed to read pending notifications: %v", err)
+			continue
+		}
+		deliveries := make(map[string]int64)
+		for _, p := range pending {
+			if p.Idle >= pendingClaimIdle {
+				deliveries[p.ID] = p.RetryCount
+			}
+		}
+		if len(deliveries) == 0 {
+			continue
+		}
+
+		start := "0-0"
+		for ctx.Err() == nil {
+			msgs, next, err := w.redis.XAutoClaim(ctx, NotificationStream, notificationWorkerGroup, w.consumer, pendingClaimIdle, start, 100)
+			if err != nil {
+				log.Printf("failed to claim idle notifications: %v", err)
+				break
+			}
+			for _, m := range msgs {
+				if m.Values == nil {
+					// trimmed from the stream while pending
+					w.ack(ctx, m.ID)
+					continue
+				}
+				if deliveries[m.ID] > int64(w.maxAttempts) {
+					log.Printf("notification stream entry %s was read %d times without being acked, moving it to the DLQ", m.ID, deliveries[m.ID])
+					w.deadLetter(ctx, retryEntry{
+						MessageID: m.ID,
+						UserID:    streamValue(m.Values["user_id"]),
+						Payload:   streamValue(m.Values["payload"]),
+						Attempts:  int(deliveries[m.ID]),
+						Error:     "never acked by its worker",
+					})
+					w.ack(ctx, m.ID)
+					continue
+				}
+				log.Printf("claimed notification stream entry %s idle for over %s", m.ID, pendingClaimIdle)
+				w.handle(ctx, m)
+			}
+			if next == "0-0" || next == "" {
+				break
+			}
+			start = next
+		}
+	}
+}
+
+func (w *StreamWorker) deadLetter(ctx context.Context, entry retryEntry) {
+	dlqValues := map[string]interface{}{
+		"original_message_id": entry.MessageID,
+		"user_id":             entry.UserID,
+		"payload":             entry.Payload,
+		"error":               entry.Error,
+		"attempts":            entry.Attempts,
+		"failed_at":           time.Now().UTC().Format(time.RFC3339),
+	}
+	if _, err := w.redis.XAdd(ctx, NotificationDLQ, dlqValues); err != nil {
+		log.Printf("failed to add to DLQ for message %s: %v", entry.MessageID, err)
+		return
+	}
+	w.service.refreshDLQDepth(ctx)
+}
+
+// reportDLQDepth keeps the DLQ depth metric current, including entries
+// added by other workers
+func (w *StreamWorker) reportDLQDepth(ctx context.Context) {
+	ticker := time.NewTicker(30 * time.Second)
+	defer ticker.Stop()
+	for {
+		w.service.refreshDLQDepth(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *StreamWorker) ack(ctx context.Context, id string) {
+	if _, err := w.redis.XAck(ctx, NotificationStream, notificationWorkerGroup, id); err != nil {
+		log.Printf("failed to ack message %s: %v", id, err)
+	}
+}
+
+// retryDelay is the backoff before the next try of an event that has
+// failed attempts times: doubling from retryBaseDelay up to retryMaxDelay
+func retryDelay(attempts int) time.Duration {
+	delay := retryBaseDelay
+	for i := 1; i < attempts && delay < retryMaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > retryMaxDelay {
+		delay = retryMaxDelay
+	}
+	return delay
+}
+
+func streamValue(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
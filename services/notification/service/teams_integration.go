@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
+	notificationpb "github.com/chanduchitikam/task-management-system/proto/notification"
+	"github.com/chanduchitikam/task-management-system/services/notification/models"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// teamsWebhookHosts are the domains Teams incoming webhooks are served
+// from. Anything else is refused so the worker cannot be pointed at
+// internal addresses.
+var teamsWebhookHosts = []string{".webhook.office.com", ".logic.azure.com", ".api.powerplatform.com"}
+
+// authorizeOrgAdmin checks the caller may manage orgID's integrations
+func authorizeOrgAdmin(ctx context.Context, orgID string) error {
+	if _, err := uuid.Parse(orgID); err != nil {
+		return status.Error(codes.InvalidArgument, "invalid org_id")
+	}
+	_, callerOrg, role := callerIdentity(ctx)
+	if !authz.IsOrgAdmin(role, callerOrg, orgID) {
+		return status.Error(codes.PermissionDenied, "only organization admins may manage integrations")
+	}
+	return nil
+}
+
+func validateTeamsWebhook(raw string) (*url.URL, error) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return nil, status.Error(codes.InvalidArgument, "webhook_url must be an https URL")
+	}
+	host := strings.ToLower(u.Hostname())
+	for _, suffix := range teamsWebhookHosts {
+		if strings.HasSuffix(host, suffix) {
+			return u, nil
+		}
+	}
+	return nil, status.Error(codes.InvalidArgument, "webhook_url must be a Microsoft Teams incoming webhook")
+}
+
+// SetTeamsIntegration creates or replaces the organization's Teams webhook
+func (s *NotificationService) SetTeamsIntegration(ctx context.Context, req *notificationpb.SetTeamsIntegrationRequest) (*notificationpb.SetTeamsIntegrationResponse, error) {
+	if err := authorizeOrgAdmin(ctx, req.OrgId); err != nil {
+		return nil, err
+	}
+	webhook, err := validateTeamsWebhook(req.WebhookUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, _, _ := callerIdentity(ctx)
+	integration := &models.TeamsIntegration{
+		OrgID:             req.OrgId,
+		WebhookURL:        webhook.String(),
+		NotifyAssignments: req.NotifyAssignments,
+		NotifyDueDates:    req.NotifyDueDates,
+		Enabled:           req.Enabled,
+	}
+	if _, err := uuid.Parse(userID); err == nil {
+		integration.UpdatedBy = userID
+	}
+	err = s.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "org_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"webhook_url", "notify_assignments", "notify_due_dates", "enabled", "updated_by", "updated_at"}),
+	}).Create(integration).Error
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to save teams integration")
+	}
+
+	return &notificationpb.SetTeamsIntegrationResponse{
+		Integration: teamsIntegrationToProto(integration),
+		Message:     "Teams integration saved",
+	}, nil
+}
+
+// GetTeamsIntegration returns the organization's Teams webhook settings
+func (s *NotificationService) GetTeamsIntegration(ctx context.Context, req *notificationpb.GetTeamsIntegrationRequest) (*notificationpb.GetTeamsIntegrationResponse, error) {
+	if err := authorizeOrgAdmin(ctx, req.OrgId); err != nil {
+		return nil, err
+	}
+	var integration models.TeamsIntegration
+	if err := s.db.WithContext(ctx).Where("org_id = ?", req.OrgId).First(&integration).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "teams integration not configured")
+		}
+		return nil, status.Error(codes.Internal, "failed to get teams integration")
+	}
+	return &notificationpb.GetTeamsIntegrationResponse{Integration: teamsIntegrationToProto(&integration)}, nil
+}
+
+// DeleteTeamsIntegration removes the organization's Teams webhook
+func (s *NotificationService) DeleteTeamsIntegration(ctx context.Context, req *notificationpb.DeleteTeamsIntegrationRequest) (*notificationpb.DeleteTeamsIntegrationResponse, error) {
+	if err := authorizeOrgAdmin(ctx, req.OrgId); err != nil {
+		return nil, err
+	}
+	result := s.db.WithContext(ctx).Where("org_id = ?", req.OrgId).Delete(&models.TeamsIntegration{})
+	if result.Error != nil {
+		return nil, status.Error(codes.Internal, "failed to delete teams integration")
+	}
+	if result.RowsAffected == 0 {
+		return nil, status.Error(codes.NotFound, "teams integration not configured")
+	}
+	return &notificationpb.DeleteTeamsIntegrationResponse{Message: "Teams integration removed"}, nil
+}
+
+func teamsIntegrationToProto(t *models.TeamsIntegration) *notificationpb.TeamsIntegration {
+	pb := &notificationpb.TeamsIntegration{
+		OrgId:             t.OrgID,
+		NotifyAssignments: t.NotifyAssignments,
+		NotifyDueDates:    t.NotifyDueDates,
+		Enabled:           t.Enabled,
+		UpdatedAt:         timestamppb.New(t.UpdatedAt),
+	}
+	if u, err := url.Parse(t.WebhookURL); err == nil {
+		pb.WebhookHost = u.Host
+	}
+	return pb
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+
+	notificationpb "github.com/chanduchitikam/task-management-system/proto/notification"
+	"github.com/chanduchitikam/task-management-system/services/notification/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// userDataExport is the notification service's part of a user's data export
+type userDataExport struct {
+	Notifications []models.Notification           `json:"notifications"`
+	Preferences   []models.NotificationPreference `json:"preferences"`
+	Devices       []models.Device                 `json:"devices"`
+}
+
+// ExportUserData returns the user's notifications, preferences and devices
+func (s *NotificationService) ExportUserData(ctx context.Context, req *notificationpb.ExportUserDataRequest) (*notificationpb.ExportUserDataResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	var out userDataExport
+	db := s.db.WithContext(ctx)
+	if err := db.Where("user_id = ?", req.UserId).Order("created_at").Find(&out.Notifications).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to export notifications")
+	}
+	if err := db.Where("user_id = ?", req.UserId).Find(&out.Preferences).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to export notification preferences")
+	}
+	if err := db.Where("user_id = ?", req.UserId).Find(&out.Devices).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to export devices")
+	}
+	// push tokens are credentials, not personal data worth handing out
+	for i := range out.Devices {
+		out.Devices[i].Token = ""
+	}
+
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to encode notification data")
+	}
+	return &notificationpb.ExportUserDataResponse{Data: data}, nil
+}
+
+// EraseUserData removes the user's notifications, preferences and devices and
+// clears references to them in other users' notifications. Both modes erase
+// the same data since none of it is shared. Safe to repeat.
+func (s *NotificationService) EraseUserData(ctx context.Context, req *notificationpb.EraseUserDataRequest) (*notificationpb.EraseUserDataResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if req.Mode != "" && req.Mode != "anonymize" && req.Mode != "delete" {
+		return nil, status.Error(codes.InvalidArgument, "mode must be anonymize or delete")
+	}
+
+	affected := make(map[string]int64)
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		steps := []struct {
+			name string
+			exec func() *gorm.DB
+		}{
+			{"notifications_deleted", func() *gorm.DB {
+				return tx.Where("user_id = ?", req.UserId).Delete(&models.Notification{})
+			}},
+			{"notifications_anonymized", func() *gorm.DB {
+				return tx.Model(&models.Notification{}).Where("related_user_id = ?", req.UserId).UpdateColumn("related_user_id", nil)
+			}},
+			{"preferences_deleted", func() *gorm.DB {
+				return tx.Unscoped().Where("user_id = ?", req.UserId).Delete(&models.NotificationPreference{})
+			}},
+			{"devices_deleted", func() *gorm.DB {
+				return tx.Unscoped().Where("user_id = ?", req.UserId).Delete(&models.Device{})
+			}},
+		}
+		for _, step := range steps {
+			res := step.exec()
+			if res.Error != nil {
+				return res.Error
+			}
+			affected[step.name] = res.RowsAffected
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to erase notification data: %v", err)
+	}
+
+	return &notificationpb.EraseUserDataResponse{Affected: affected}, nil
+}
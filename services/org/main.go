@@ -1,14 +1,20 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
 	"net/http"
 	"os"
 
+	"github.com/chanduchitikam/task-management-system/migrations"
+	"github.com/chanduchitikam/task-management-system/pkg/certs"
+	"github.com/chanduchitikam/task-management-system/pkg/config"
 	"github.com/chanduchitikam/task-management-system/pkg/database"
+	notificationpb "github.com/chanduchitikam/task-management-system/proto/notification"
 	"github.com/chanduchitikam/task-management-system/proto/organization"
+	taskpb "github.com/chanduchitikam/task-management-system/proto/task"
 	"github.com/chanduchitikam/task-management-system/services/org/service"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"google.golang.org/grpc"
@@ -18,8 +24,16 @@ import (
 func main() {
 	log.Println("Starting Organization Service...")
 
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		log.Fatalf("Invalid config: %v", err)
+	}
+
 	// Connect to database
-	db, err := database.Connect()
+	db, err := database.NewSQLConnection(cfg.Database.GetDSN())
 	if err != nil {
 		log.Fatalf("Failed to connect to database: %v", err)
 	}
@@ -27,9 +41,50 @@ func main() {
 
 	log.Println("✓ Connected to database")
 
+	// The org service's tables only exist in the SQL migrations, so apply
+	// them before serving
+	if err := migrations.Prepare(db, "org", cfg.Database.MigrateOnStart); err != nil {
+		log.Fatalf("Failed to migrate database: %v", err)
+	}
+
 	// Create organization service
 	orgService := service.NewOrganizationService(db)
 
+	// Service traffic is TLS once certificates are configured
+	serverCreds, err := certs.ServerCredentials(cfg.TLS)
+	if err != nil {
+		log.Fatalf("Invalid TLS config: %v", err)
+	}
+	dialCreds, err := certs.DialCredentials(cfg.TLS)
+	if err != nil {
+		log.Fatalf("Invalid TLS config: %v", err)
+	}
+
+	// Project templates create their default tasks through the task service
+	taskServiceAddr := os.Getenv("TASK_SERVICE_ADDR")
+	if taskServiceAddr == "" {
+		taskServiceAddr = "localhost:50052"
+	}
+	taskConn, err := grpc.NewClient(taskServiceAddr, grpc.WithTransportCredentials(dialCreds))
+	if err != nil {
+		log.Fatalf("Failed to create task service client: %v", err)
+	}
+	defer taskConn.Close()
+	orgService.SetTaskClient(taskpb.NewTaskServiceClient(taskConn))
+
+	// Project managers are reminded about missing status reports
+	notificationServiceAddr := os.Getenv("NOTIFICATION_SERVICE_ADDR")
+	if notificationServiceAddr == "" {
+		notificationServiceAddr = "localhost:50053"
+	}
+	notificationConn, err := grpc.NewClient(notificationServiceAddr, grpc.WithTransportCredentials(dialCreds))
+	if err != nil {
+		log.Fatalf("Failed to create notification service client: %v", err)
+	}
+	defer notificationConn.Close()
+	orgService.SetNotificationClient(notificationpb.NewNotificationServiceClient(notificationConn))
+	go orgService.RunStatusReportReminders(context.Background())
+
 	// Setup gRPC server
 	port := os.Getenv("GRPC_PORT")
 	if port == "" {
@@ -41,7 +96,8 @@ func main() {
 		log.Fatalf("Failed to listen on port %s: %v", port, err)
 	}
 
-	grpcServer := grpc.NewServer()
+	// Guests only reach the project or workspace they were invited to
+	grpcServer := grpc.NewServer(serverCreds, grpc.UnaryInterceptor(orgService.GuestInterceptor()))
 	organization.RegisterOrganizationServiceServer(grpcServer, orgService)
 
 	// Enable reflection for grpcurl
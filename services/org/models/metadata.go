@@ -0,0 +1,94 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// EntityMetadata holds the metadata keys every team, group and project (and
+// the settings of every workspace) may carry. Custom is left to clients.
+type EntityMetadata struct {
+	Color  string                 `json:"color,omitempty"`
+	Icon   string                 `json:"icon,omitempty"`
+	Labels []string               `json:"labels,omitempty"`
+	Custom map[string]interface{} `json:"custom,omitempty"`
+}
+
+// TeamMetadata is the typed form of Team.Metadata
+type TeamMetadata struct {
+	EntityMetadata
+	SlackChannel string `json:"slack_channel,omitempty"`
+}
+
+// GroupMetadata is the typed form of Group.Metadata
+type GroupMetadata struct {
+	EntityMetadata
+	WorkspaceID string `json:"workspace_id,omitempty"`
+}
+
+// ProjectMilestone is a milestone as kept in a project's metadata under
+// "milestones"
+type ProjectMilestone struct {
+	Name        string     `json:"name"`
+	Description string     `json:"description,omitempty"`
+	DueDate     string     `json:"due_date"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
+// ProjectMetadata is the typed form of Project.Metadata
+type ProjectMetadata struct {
+	EntityMetadata
+	TemplateID string             `json:"template_id,omitempty"`
+	Milestones []ProjectMilestone `json:"milestones,omitempty"`
+}
+
+// WorkspaceSettings is the typed form of Workspace.Settings
+type WorkspaceSettings struct {
+	EntityMetadata
+	DefaultView          string           `json:"default_view,omitempty"`
+	NotificationsEnabled *bool            `json:"notifications_enabled,omitempty"`
+	Boards               []WorkspaceBoard `json:"boards,omitempty"`
+}
+
+// WorkspaceBoard is a board as kept in a workspace's settings under "boards"
+type WorkspaceBoard struct {
+	Name    string   `json:"name"`
+	Columns []string `json:"columns,omitempty"`
+}
+
+// decodeMetadata decodes a stored metadata string into v. Empty strings
+// decode to the zero value.
+func decodeMetadata(raw string, v interface{}) error {
+	if raw == "" {
+		return nil
+	}
+	return json.Unmarshal([]byte(raw), v)
+}
+
+// TypedMetadata decodes the team's metadata
+func (t *Team) TypedMetadata() (TeamMetadata, error) {
+	var m TeamMetadata
+	err := decodeMetadata(t.Metadata, &m)
+	return m, err
+}
+
+// TypedMetadata decodes the group's metadata
+func (g *Group) TypedMetadata() (GroupMetadata, error) {
+	var m GroupMetadata
+	err := decodeMetadata(g.Metadata, &m)
+	return m, err
+}
+
+// TypedMetadata decodes the project's metadata
+func (p *Project) TypedMetadata() (ProjectMetadata, error) {
+	var m ProjectMetadata
+	err := decodeMetadata(p.Metadata, &m)
+	return m, err
+}
+
+// TypedSettings decodes the workspace's settings
+func (w *Workspace) TypedSettings() (WorkspaceSettings, error) {
+	var s WorkspaceSettings
+	err := decodeMetadata(w.Settings, &s)
+	return s, err
+}
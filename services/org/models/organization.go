@@ -51,6 +51,7 @@ type Project struct {
 	CreatedAt        time.Time  `db:"created_at" json:"created_at"`
 	UpdatedAt        time.Time  `db:"updated_at" json:"updated_at"`
 	CreatedBy        *uuid.UUID `db:"created_by" json:"created_by,omitempty"`
+	ArchivedAt       *time.Time `db:"archived_at" json:"archived_at,omitempty"`
 }
 
 type ProjectTeam struct {
@@ -124,3 +125,93 @@ type Workspace struct {
 	CreatedAt     time.Time  `db:"created_at" json:"created_at"`
 	UpdatedAt     time.Time  `db:"updated_at" json:"updated_at"`
 }
+
+// ProjectTemplate is a saved project layout new projects are created from.
+// Definition holds the JSON encoded blueprint.
+type ProjectTemplate struct {
+	ID              uuid.UUID  `db:"id" json:"id"`
+	OrgID           uuid.UUID  `db:"org_id" json:"org_id"`
+	Name            string     `db:"name" json:"name"`
+	Description     *string    `db:"description" json:"description,omitempty"`
+	SourceProjectID *uuid.UUID `db:"source_project_id" json:"source_project_id,omitempty"`
+	Definition      string     `db:"definition" json:"definition"`
+	CreatedBy       *uuid.UUID `db:"created_by" json:"created_by,omitempty"`
+	CreatedAt       time.Time  `db:"created_at" json:"created_at"`
+	UpdatedAt       time.Time  `db:"updated_at" json:"updated_at"`
+}
+
+// WorkspaceTemplate is a saved workspace layout new workspaces are created
+// from. Definition holds the JSON encoded blueprint.
+type WorkspaceTemplate struct {
+	ID          uuid.UUID  `db:"id" json:"id"`
+	OrgID       uuid.UUID  `db:"org_id" json:"org_id"`
+	Name        string     `db:"name" json:"name"`
+	Description *string    `db:"description" json:"description,omitempty"`
+	Definition  string     `db:"definition" json:"definition"`
+	CreatedBy   *uuid.UUID `db:"created_by" json:"created_by,omitempty"`
+	CreatedAt   time.Time  `db:"created_at" json:"created_at"`
+	UpdatedAt   time.Time  `db:"updated_at" json:"updated_at"`
+}
+
+type WorkspaceMember struct {
+	ID          uuid.UUID  `db:"id" json:"id"`
+	WorkspaceID uuid.UUID  `db:"workspace_id" json:"workspace_id"`
+	UserID      uuid.UUID  `db:"user_id" json:"user_id"`
+	Role        string     `db:"role" json:"role"`
+	JoinedAt    time.Time  `db:"joined_at" json:"joined_at"`
+	LeftAt      *time.Time `db:"left_at" json:"left_at,omitempty"`
+	IsActive    bool       `db:"is_active" json:"is_active"`
+
+	// Joined user data
+	FullName string `db:"full_name" json:"full_name,omitempty"`
+	Email    string `db:"email" json:"email,omitempty"`
+	Username string `db:"username" json:"username,omitempty"`
+}
+
+// CustomRole is a named set of built-in permissions an organization defines
+type CustomRole struct {
+	ID          uuid.UUID  `db:"id" json:"id"`
+	OrgID       uuid.UUID  `db:"org_id" json:"org_id"`
+	Name        string     `db:"name" json:"name"`
+	Description *string    `db:"description" json:"description,omitempty"`
+	Permissions []string   `db:"permissions" json:"permissions"`
+	CreatedBy   *uuid.UUID `db:"created_by" json:"created_by,omitempty"`
+	CreatedAt   time.Time  `db:"created_at" json:"created_at"`
+	UpdatedAt   time.Time  `db:"updated_at" json:"updated_at"`
+}
+
+// CustomRoleAssignment grants a custom role to a user across the
+// organization, or on the team or project named by ScopeID
+type CustomRoleAssignment struct {
+	ID         uuid.UUID  `db:"id" json:"id"`
+	RoleID     uuid.UUID  `db:"role_id" json:"role_id"`
+	OrgID      uuid.UUID  `db:"org_id" json:"org_id"`
+	UserID     uuid.UUID  `db:"user_id" json:"user_id"`
+	ScopeType  string     `db:"scope_type" json:"scope_type"`
+	ScopeID    *uuid.UUID `db:"scope_id" json:"scope_id,omitempty"`
+	AssignedBy *uuid.UUID `db:"assigned_by" json:"assigned_by,omitempty"`
+	CreatedAt  time.Time  `db:"created_at" json:"created_at"`
+
+	// Joined data
+	RoleName string `db:"role_name" json:"role_name,omitempty"`
+	FullName string `db:"full_name" json:"full_name,omitempty"`
+	Email    string `db:"email" json:"email,omitempty"`
+}
+
+// ProjectStatusReport is a project's weekly RAG status snapshot. WeekStart is
+// the Monday of the reported week.
+type ProjectStatusReport struct {
+	ID          uuid.UUID  `db:"id" json:"id"`
+	ProjectID   uuid.UUID  `db:"project_id" json:"project_id"`
+	OrgID       uuid.UUID  `db:"org_id" json:"org_id"`
+	WeekStart   time.Time  `db:"week_start" json:"week_start"`
+	RAGStatus   string     `db:"rag_status" json:"rag_status"`
+	Summary     string     `db:"summary" json:"summary"`
+	Risks       []string   `db:"risks" json:"risks"`
+	SubmittedBy *uuid.UUID `db:"submitted_by" json:"submitted_by,omitempty"`
+	CreatedAt   time.Time  `db:"created_at" json:"created_at"`
+	UpdatedAt   time.Time  `db:"updated_at" json:"updated_at"`
+
+	// Joined user data
+	SubmitterName string `db:"submitter_name" json:"submitter_name,omitempty"`
+}
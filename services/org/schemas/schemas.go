@@ -0,0 +1,9 @@
+// Package schemas embeds the JSON schemas that the metadata of teams,
+// groups and projects and the settings of workspaces must satisfy. Each
+// file is named after the entity type it describes.
+package schemas
+
+import "embed"
+
+//go:embed *.json
+var FS embed.FS
@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/proto/organization"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxBulkMembers caps how many users one bulk membership request may add
+const maxBulkMembers = 500
+
+func (s *OrganizationService) BulkAddTeamMembers(ctx context.Context, req *organization.BulkAddTeamMembersRequest) (*organization.BulkAddTeamMembersResponse, error) {
+	teamID, err := uuid.Parse(req.TeamId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid team_id")
+	}
+
+	results, added, err := s.bulkAddMembers(ctx, teamOwnership, teamID, req.Members)
+	if err != nil {
+		return nil, err
+	}
+
+	return &organization.BulkAddTeamMembersResponse{
+		Results: results,
+		Added:   added,
+		Failed:  int32(len(results)) - added,
+		Message: fmt.Sprintf("%d members added to team", added),
+	}, nil
+}
+
+func (s *OrganizationService) BulkAddProjectMembers(ctx context.Context, req *organization.BulkAddProjectMembersRequest) (*organization.BulkAddProjectMembersResponse, error) {
+	projectID, err := uuid.Parse(req.ProjectId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid project_id")
+	}
+
+	results, added, err := s.bulkAddMembers(ctx, projectOwnership, projectID, req.Members)
+	if err != nil {
+		return nil, err
+	}
+
+	return &organization.BulkAddProjectMembersResponse{
+		Results: results,
+		Added:   added,
+		Failed:  int32(len(results)) - added,
+		Message: fmt.Sprintf("%d members added to project", added),
+	}, nil
+}
+
+// bulkAddMembers adds or reactivates the given users on team or project id in
+// one transaction. Items that fail validation (a bad id, a duplicate, a user
+// outside the organization) are reported and skipped; a database error rolls
+// back the whole batch. The owner of the resource or an admin holding its
+// permission may add members.
+func (s *OrganizationService) bulkAddMembers(ctx context.Context, r ownedResource, id uuid.UUID, items []*organization.BulkMemberItem) ([]*organization.BulkMemberResult, int32, error) {
+	if len(items) == 0 {
+		return nil, 0, status.Error(codes.InvalidArgument, "members are required")
+	}
+	if len(items) > maxBulkMembers {
+		return nil, 0, status.Errorf(codes.InvalidArgument, "at most %d members may be added at once", maxBulkMembers)
+	}
+
+	var orgID uuid.UUID
+	var owner uuid.NullUUID
+	err := s.db.QueryRowContext(ctx,
+		fmt.Sprintf("SELECT org_id, %s FROM %s WHERE id = $1", r.ownerColumn, r.table), id,
+	).Scan(&orgID, &owner)
+	if err == sql.ErrNoRows {
+		return nil, 0, status.Errorf(codes.NotFound, "%s not found", r.name)
+	}
+	if err != nil {
+		return nil, 0, status.Errorf(codes.Internal, "failed to get %s: %v", r.name, err)
+	}
+	callerID := callerUUID(ctx)
+	isOwner := owner.Valid && callerID != uuid.Nil && owner.UUID == callerID
+	if !isOwner && !s.callerCan(ctx, r.perm, orgID.String(), r.name, id) {
+		return nil, 0, status.Error(codes.PermissionDenied, "access denied")
+	}
+
+	withAllocation := r.table == "projects"
+	results := make([]*organization.BulkMemberResult, len(items))
+	userIDs := make([]uuid.UUID, len(items))
+	seen := make(map[uuid.UUID]bool, len(items))
+	for i, item := range items {
+		results[i] = &organization.BulkMemberResult{UserId: item.UserId}
+		userID, err := uuid.Parse(item.UserId)
+		switch {
+		case err != nil:
+			results[i].Error = "invalid user_id"
+		case seen[userID]:
+			results[i].Error = "duplicate user_id"
+		case withAllocation && (item.AllocationPercentage < 0 || item.AllocationPercentage > 100):
+			results[i].Error = "allocation_percentage must be between 1 and 100"
+		default:
+			member, err := s.isOrgMember(ctx, orgID, userID)
+			if err != nil {
+				return nil, 0, err
+			}
+			if !member {
+				results[i].Error = "user is not a member of this organization"
+			}
+		}
+		if results[i].Error == "" {
+			seen[userID] = true
+			userIDs[i] = userID
+		}
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, 0, status.Errorf(codes.Internal, "failed to add %s members: %v", r.name, err)
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	var added int32
+	for i, item := range items {
+		if results[i].Error != "" {
+			continue
+		}
+		role := item.Role
+		if role == "" {
+			role = r.memberRole
+		}
+
+		memberID := uuid.New()
+		if withAllocation {
+			allocation := item.AllocationPercentage
+			if allocation == 0 {
+				allocation = 100
+			}
+			err = tx.QueryRowContext(ctx, `
+				INSERT INTO project_members (id, project_id, user_id, role, allocation_percentage, joined_at, is_active)
+				VALUES ($1, $2, $3, $4, $5, $6, true)
+				ON CONFLICT (project_id, user_id) DO UPDATE
+				SET is_active = true, role = $4, allocation_percentage = $5, left_at = NULL
+				RETURNING id
+			`, memberID, id, userIDs[i], role, allocation, now).Scan(&memberID)
+		} else {
+			err = tx.QueryRowContext(ctx, `
+				INSERT INTO team_members (id, team_id, user_id, role, joined_at, is_active)
+				VALUES ($1, $2, $3, $4, $5, true)
+				ON CONFLICT (team_id, user_id) DO UPDATE
+				SET is_active = true, role = $4, left_at = NULL
+				RETURNING id
+			`, memberID, id, userIDs[i], role, now).Scan(&memberID)
+		}
+		if err != nil {
+			return nil, 0, status.Errorf(codes.Internal, "failed to add %s member %s: %v", r.name, item.UserId, err)
+		}
+		results[i].Success = true
+		results[i].MemberId = memberID.String()
+		added++
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, 0, status.Errorf(codes.Internal, "failed to add %s members: %v", r.name, err)
+	}
+	if withAllocation && added > 0 {
+		s.recordProjectActivity(ctx, id, activityMemberAdded,
+			fmt.Sprintf("%d members joined the project", added),
+			map[string]string{"count": fmt.Sprint(added)})
+	}
+	return results, added, nil
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
+	"github.com/chanduchitikam/task-management-system/proto/organization"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultCapacityThreshold is the total allocation a user may reach before
+// being flagged as over-allocated
+const defaultCapacityThreshold = 100
+
+// GetCapacityReport sums each user's allocation_percentage over the active
+// project memberships whose project overlaps the date range, and flags users
+// above the threshold. Completed, cancelled and archived projects do not
+// count.
+func (s *OrganizationService) GetCapacityReport(ctx context.Context, req *organization.GetCapacityReportRequest) (*organization.GetCapacityReportResponse, error) {
+	orgID, err := uuid.Parse(req.OrgId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid org_id")
+	}
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	startDate, endDate := today, today
+	if req.StartDate != "" {
+		if startDate, err = time.Parse("2006-01-02", req.StartDate); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid start_date format, use YYYY-MM-DD")
+		}
+		if req.EndDate == "" {
+			endDate = startDate
+		}
+	}
+	if req.EndDate != "" {
+		if endDate, err = time.Parse("2006-01-02", req.EndDate); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid end_date format, use YYYY-MM-DD")
+		}
+	}
+	if endDate.Before(startDate) {
+		return nil, status.Error(codes.InvalidArgument, "end_date must not be before start_date")
+	}
+
+	threshold := req.Threshold
+	if threshold < 0 {
+		return nil, status.Error(codes.InvalidArgument, "threshold must not be negative")
+	}
+	if threshold == 0 {
+		threshold = defaultCapacityThreshold
+	}
+
+	query := `
+		SELECT pm.user_id, u.full_name, u.email,
+		       p.id, p.name, p.status, pm.role, pm.allocation_percentage, p.start_date, p.end_date
+		FROM project_members pm
+		JOIN projects p ON p.id = pm.project_id
+		JOIN users u ON u.id = pm.user_id
+		WHERE p.org_id = $1 AND pm.is_active = true
+		  AND p.status NOT IN ('completed', 'cancelled') AND p.archived_at IS NULL
+		  AND (p.start_date IS NULL OR p.start_date <= $2)
+		  AND (p.end_date IS NULL OR p.end_date >= $3)
+	`
+	args := []interface{}{orgID, endDate, startDate}
+
+	if req.TeamId != "" {
+		teamID, err := uuid.Parse(req.TeamId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid team_id")
+		}
+		var teamOrg uuid.UUID
+		err = s.db.QueryRowContext(ctx, "SELECT org_id FROM teams WHERE id = $1", teamID).Scan(&teamOrg)
+		if err == sql.ErrNoRows || (err == nil && teamOrg != orgID) {
+			return nil, status.Error(codes.NotFound, "team not found")
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get team: %v", err)
+		}
+		if !s.callerCan(ctx, authz.TaskViewOthers, orgID.String(), customRoleScopeTeam, teamID) {
+			return nil, status.Error(codes.PermissionDenied, "access denied")
+		}
+		query += " AND pm.user_id IN (SELECT user_id FROM team_members WHERE team_id = $4 AND is_active = true)"
+		args = append(args, teamID)
+	} else if !s.callerCan(ctx, authz.TaskViewOthers, orgID.String(), "", uuid.Nil) {
+		return nil, status.Error(codes.PermissionDenied, "access denied")
+	}
+
+	query += " ORDER BY u.full_name ASC, pm.user_id, p.name ASC"
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get project allocations: %v", err)
+	}
+	defer rows.Close()
+
+	var users []*organization.UserCapacity
+	for rows.Next() {
+		var userID, fullName, email string
+		var a organization.ProjectAllocation
+		var projectStart, projectEnd sql.NullTime
+		err := rows.Scan(&userID, &fullName, &email,
+			&a.ProjectId, &a.ProjectName, &a.ProjectStatus, &a.Role, &a.AllocationPercentage, &projectStart, &projectEnd)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan project allocation: %v", err)
+		}
+		if projectStart.Valid {
+			a.StartDate = projectStart.Time.Format("2006-01-02")
+		}
+		if projectEnd.Valid {
+			a.EndDate = projectEnd.Time.Format("2006-01-02")
+		}
+
+		// rows arrive grouped by user
+		if len(users) == 0 || users[len(users)-1].UserId != userID {
+			users = append(users, &organization.UserCapacity{UserId: userID, FullName: fullName, Email: email})
+		}
+		u := users[len(users)-1]
+		u.TotalAllocation += a.AllocationPercentage
+		u.Projects = append(u.Projects, &a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get project allocations: %v", err)
+	}
+
+	resp := &organization.GetCapacityReportResponse{
+		OrgId:     orgID.String(),
+		TeamId:    req.TeamId,
+		StartDate: startDate.Format("2006-01-02"),
+		EndDate:   endDate.Format("2006-01-02"),
+		Threshold: threshold,
+	}
+	for _, u := range users {
+		u.OverAllocated = u.TotalAllocation > threshold
+		if u.OverAllocated {
+			resp.OverAllocatedCount++
+		} else if req.OverAllocatedOnly {
+			continue
+		}
+		resp.Users = append(resp.Users, u)
+	}
+	return resp, nil
+}
@@ -0,0 +1,514 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
+	"github.com/chanduchitikam/task-management-system/proto/organization"
+	"github.com/chanduchitikam/task-management-system/services/org/models"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ============================================================================
+// CUSTOM ROLES
+// ============================================================================
+
+// Custom roles bundle built-in permissions under an org-chosen name and add
+// to, never replace, the permissions of a member's built-in role. They are
+// honoured by the organization service's checks.
+
+// Scopes a custom role can be assigned at
+const (
+	customRoleScopeOrg     = "org"
+	customRoleScopeTeam    = "team"
+	customRoleScopeProject = "project"
+)
+
+const maxCustomRoleNameLength = 100
+
+func (s *OrganizationService) CreateCustomRole(ctx context.Context, req *organization.CreateCustomRoleRequest) (*organization.CreateCustomRoleResponse, error) {
+	orgID, err := uuid.Parse(req.OrgId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid org_id")
+	}
+	if !s.callerCan(ctx, authz.MemberManage, orgID.String(), "", uuid.Nil) {
+		return nil, status.Error(codes.PermissionDenied, "access denied")
+	}
+	name, err := customRoleName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	perms, err := customRolePermissions(ctx, req.Permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	role := models.CustomRole{
+		ID:          uuid.New(),
+		OrgID:       orgID,
+		Name:        name,
+		Description: &req.Description,
+		Permissions: perms,
+	}
+	if id := callerUUID(ctx); id != uuid.Nil {
+		role.CreatedBy = &id
+	}
+	err = s.db.QueryRowContext(ctx, `
+		INSERT INTO custom_roles (id, org_id, name, description, permissions, created_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $7)
+		RETURNING created_at, updated_at
+	`, role.ID, role.OrgID, role.Name, role.Description, pq.Array(role.Permissions), role.CreatedBy, time.Now(),
+	).Scan(&role.CreatedAt, &role.UpdatedAt)
+	if isUniqueViolation(err) {
+		return nil, status.Error(codes.AlreadyExists, "a role with this name already exists")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create custom role: %v", err)
+	}
+
+	return &organization.CreateCustomRoleResponse{
+		Role:    customRoleToProto(&role, 0),
+		Message: "Custom role created successfully",
+	}, nil
+}
+
+func (s *OrganizationService) ListCustomRoles(ctx context.Context, req *organization.ListCustomRolesRequest) (*organization.ListCustomRolesResponse, error) {
+	orgID, err := uuid.Parse(req.OrgId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid org_id")
+	}
+	if !s.callerCan(ctx, authz.MemberView, orgID.String(), "", uuid.Nil) {
+		return nil, status.Error(codes.PermissionDenied, "access denied")
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT r.id, r.org_id, r.name, r.description, r.permissions, r.created_by, r.created_at, r.updated_at,
+		       (SELECT COUNT(*) FROM custom_role_assignments a WHERE a.role_id = r.id) as assignment_count
+		FROM custom_roles r
+		WHERE r.org_id = $1
+		ORDER BY r.name ASC
+	`, orgID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list custom roles: %v", err)
+	}
+	defer rows.Close()
+
+	var roles []*organization.CustomRole
+	for rows.Next() {
+		var role models.CustomRole
+		var assignments int32
+		err := rows.Scan(&role.ID, &role.OrgID, &role.Name, &role.Description, pq.Array(&role.Permissions),
+			&role.CreatedBy, &role.CreatedAt, &role.UpdatedAt, &assignments)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan custom role: %v", err)
+		}
+		roles = append(roles, customRoleToProto(&role, assignments))
+	}
+
+	available := authz.OrgPermissions()
+	resp := &organization.ListCustomRolesResponse{
+		Roles:                roles,
+		AvailablePermissions: make([]string, 0, len(available)),
+	}
+	for _, p := range available {
+		resp.AvailablePermissions = append(resp.AvailablePermissions, string(p))
+	}
+	return resp, nil
+}
+
+func (s *OrganizationService) UpdateCustomRole(ctx context.Context, req *organization.UpdateCustomRoleRequest) (*organization.UpdateCustomRoleResponse, error) {
+	roleID, err := uuid.Parse(req.RoleId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid role_id")
+	}
+	role, err := s.loadCustomRole(ctx, roleID)
+	if err != nil {
+		return nil, err
+	}
+	if !s.callerCan(ctx, authz.MemberManage, role.OrgID.String(), "", uuid.Nil) {
+		return nil, status.Error(codes.PermissionDenied, "access denied")
+	}
+	// editing a role changes what its holders may do, so the caller must be
+	// able to grant what it already grants as well as what it will
+	callerRole, _ := callerIdentity(ctx)
+	if !authz.CanGrantPermissions(callerRole, toPermissions(role.Permissions)) {
+		return nil, status.Error(codes.PermissionDenied, "cannot change a role granting permissions you do not hold")
+	}
+
+	query := "UPDATE custom_roles SET updated_at = $1"
+	args := []interface{}{time.Now()}
+	argCount := 2
+
+	if req.Name != "" {
+		name, err := customRoleName(req.Name)
+		if err != nil {
+			return nil, err
+		}
+		query += fmt.Sprintf(", name = $%d", argCount)
+		args = append(args, name)
+		argCount++
+	}
+	if req.Description != "" {
+		query += fmt.Sprintf(", description = $%d", argCount)
+		args = append(args, req.Description)
+		argCount++
+	}
+	if len(req.Permissions) > 0 {
+		perms, err := customRolePermissions(ctx, req.Permissions)
+		if err != nil {
+			return nil, err
+		}
+		query += fmt.Sprintf(", permissions = $%d", argCount)
+		args = append(args, pq.Array(perms))
+		argCount++
+	}
+
+	query += fmt.Sprintf(" WHERE id = $%d", argCount)
+	args = append(args, roleID)
+
+	_, err = s.db.ExecContext(ctx, query, args...)
+	if isUniqueViolation(err) {
+		return nil, status.Error(codes.AlreadyExists, "a role with this name already exists")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update custom role: %v", err)
+	}
+
+	role, err = s.loadCustomRole(ctx, roleID)
+	if err != nil {
+		return nil, err
+	}
+	var assignments int32
+	s.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM custom_role_assignments WHERE role_id = $1", roleID).Scan(&assignments)
+
+	return &organization.UpdateCustomRoleResponse{
+		Role:    customRoleToProto(role, assignments),
+		Message: "Custom role updated successfully",
+	}, nil
+}
+
+func (s *OrganizationService) DeleteCustomRole(ctx context.Context, req *organization.DeleteCustomRoleRequest) (*organization.DeleteCustomRoleResponse, error) {
+	roleID, err := uuid.Parse(req.RoleId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid role_id")
+	}
+	if err := s.authorizeRow(ctx, authz.MemberManage, "custom_roles", roleID); err != nil {
+		return nil, err
+	}
+
+	// assignments go with the role
+	result, err := s.db.ExecContext(ctx, "DELETE FROM custom_roles WHERE id = $1", roleID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete custom role: %v", err)
+	}
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return nil, status.Error(codes.NotFound, "custom role not found")
+	}
+
+	return &organization.DeleteCustomRoleResponse{
+		Message: "Custom role deleted successfully",
+	}, nil
+}
+
+func (s *OrganizationService) AssignCustomRole(ctx context.Context, req *organization.AssignCustomRoleRequest) (*organization.AssignCustomRoleResponse, error) {
+	roleID, err := uuid.Parse(req.RoleId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid role_id")
+	}
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+	scopeType := req.ScopeType
+	if scopeType == "" {
+		scopeType = customRoleScopeOrg
+	}
+
+	role, err := s.loadCustomRole(ctx, roleID)
+	if err != nil {
+		return nil, err
+	}
+	if !s.callerCan(ctx, authz.MemberManage, role.OrgID.String(), "", uuid.Nil) {
+		return nil, status.Error(codes.PermissionDenied, "access denied")
+	}
+	callerRole, _ := callerIdentity(ctx)
+	if !authz.CanGrantPermissions(callerRole, toPermissions(role.Permissions)) {
+		return nil, status.Error(codes.PermissionDenied, "cannot assign a role granting permissions you do not hold")
+	}
+
+	var scopeID *uuid.UUID
+	switch scopeType {
+	case customRoleScopeOrg:
+		if req.ScopeId != "" {
+			return nil, status.Error(codes.InvalidArgument, "scope_id must be empty for org-wide assignments")
+		}
+	case customRoleScopeTeam, customRoleScopeProject:
+		id, err := uuid.Parse(req.ScopeId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid scope_id")
+		}
+		var scopeOrg uuid.UUID
+		err = s.db.QueryRowContext(ctx, fmt.Sprintf("SELECT org_id FROM %ss WHERE id = $1", scopeType), id).Scan(&scopeOrg)
+		if err == sql.ErrNoRows || (err == nil && scopeOrg != role.OrgID) {
+			return nil, status.Errorf(codes.NotFound, "%s not found", scopeType)
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get %s: %v", scopeType, err)
+		}
+		scopeID = &id
+	default:
+		return nil, status.Error(codes.InvalidArgument, "scope_type must be one of org, team, project")
+	}
+
+	member, err := s.isOrgMember(ctx, role.OrgID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !member {
+		return nil, status.Error(codes.FailedPrecondition, "user is not a member of this organization")
+	}
+
+	assignment := models.CustomRoleAssignment{
+		ID:        uuid.New(),
+		RoleID:    roleID,
+		OrgID:     role.OrgID,
+		UserID:    userID,
+		ScopeType: scopeType,
+		ScopeID:   scopeID,
+	}
+	if id := callerUUID(ctx); id != uuid.Nil {
+		assignment.AssignedBy = &id
+	}
+	_, err = s.db.ExecContext(ctx, `
+		INSERT INTO custom_role_assignments (id, role_id, org_id, user_id, scope_type, scope_id, assigned_by, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`, assignment.ID, assignment.RoleID, assignment.OrgID, assignment.UserID, assignment.ScopeType,
+		assignment.ScopeID, assignment.AssignedBy, time.Now())
+	if isUniqueViolation(err) {
+		return nil, status.Error(codes.AlreadyExists, "user already holds this role here")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to assign custom role: %v", err)
+	}
+
+	assignments, err := s.listCustomRoleAssignments(ctx, "a.id = $1", assignment.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(assignments) == 0 {
+		return nil, status.Error(codes.NotFound, "custom role assignment not found")
+	}
+
+	return &organization.AssignCustomRoleResponse{
+		Assignment: assignments[0],
+		Message:    "Custom role assigned successfully",
+	}, nil
+}
+
+func (s *OrganizationService) UnassignCustomRole(ctx context.Context, req *organization.UnassignCustomRoleRequest) (*organization.UnassignCustomRoleResponse, error) {
+	assignmentID, err := uuid.Parse(req.AssignmentId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid assignment_id")
+	}
+	if err := s.authorizeRow(ctx, authz.MemberManage, "custom_role_assignments", assignmentID); err != nil {
+		return nil, err
+	}
+
+	result, err := s.db.ExecContext(ctx, "DELETE FROM custom_role_assignments WHERE id = $1", assignmentID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unassign custom role: %v", err)
+	}
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return nil, status.Error(codes.NotFound, "custom role assignment not found")
+	}
+
+	return &organization.UnassignCustomRoleResponse{
+		Message: "Custom role unassigned successfully",
+	}, nil
+}
+
+func (s *OrganizationService) ListCustomRoleAssignments(ctx context.Context, req *organization.ListCustomRoleAssignmentsRequest) (*organization.ListCustomRoleAssignmentsResponse, error) {
+	orgID, err := uuid.Parse(req.OrgId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid org_id")
+	}
+	if !s.callerCan(ctx, authz.MemberView, orgID.String(), "", uuid.Nil) {
+		return nil, status.Error(codes.PermissionDenied, "access denied")
+	}
+
+	where := "a.org_id = $1"
+	args := []interface{}{orgID}
+	argCount := 2
+	filters := []struct {
+		column, value, name string
+		isUUID              bool
+	}{
+		{"a.role_id", req.RoleId, "role_id", true},
+		{"a.user_id", req.UserId, "user_id", true},
+		{"a.scope_type", req.ScopeType, "scope_type", false},
+		{"a.scope_id", req.ScopeId, "scope_id", true},
+	}
+	for _, f := range filters {
+		if f.value == "" {
+			continue
+		}
+		var value interface{} = f.value
+		if f.isUUID {
+			id, err := uuid.Parse(f.value)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid %s", f.name)
+			}
+			value = id
+		}
+		where += fmt.Sprintf(" AND %s = $%d", f.column, argCount)
+		args = append(args, value)
+		argCount++
+	}
+
+	assignments, err := s.listCustomRoleAssignments(ctx, where, args...)
+	if err != nil {
+		return nil, err
+	}
+	return &organization.ListCustomRoleAssignmentsResponse{Assignments: assignments}, nil
+}
+
+// Helper functions
+
+func (s *OrganizationService) loadCustomRole(ctx context.Context, roleID uuid.UUID) (*models.CustomRole, error) {
+	var role models.CustomRole
+	err := s.db.QueryRowContext(ctx, `
+		SELECT id, org_id, name, description, permissions, created_by, created_at, updated_at
+		FROM custom_roles WHERE id = $1
+	`, roleID).Scan(&role.ID, &role.OrgID, &role.Name, &role.Description, pq.Array(&role.Permissions),
+		&role.CreatedBy, &role.CreatedAt, &role.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "custom role not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get custom role: %v", err)
+	}
+	return &role, nil
+}
+
+func (s *OrganizationService) listCustomRoleAssignments(ctx context.Context, where string, args ...interface{}) ([]*organization.CustomRoleAssignment, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT a.id, a.role_id, r.name, a.org_id, a.user_id, a.scope_type, a.scope_id, a.assigned_by, a.created_at,
+		       COALESCE(u.full_name, ''), u.email
+		FROM custom_role_assignments a
+		JOIN custom_roles r ON r.id = a.role_id
+		JOIN users u ON u.id = a.user_id
+		WHERE `+where+`
+		ORDER BY r.name ASC, a.created_at ASC
+	`, args...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list custom role assignments: %v", err)
+	}
+	defer rows.Close()
+
+	var assignments []*organization.CustomRoleAssignment
+	for rows.Next() {
+		var a models.CustomRoleAssignment
+		err := rows.Scan(&a.ID, &a.RoleID, &a.RoleName, &a.OrgID, &a.UserID, &a.ScopeType, &a.ScopeID,
+			&a.AssignedBy, &a.CreatedAt, &a.FullName, &a.Email)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan custom role assignment: %v", err)
+		}
+		pb := &organization.CustomRoleAssignment{
+			Id:        a.ID.String(),
+			RoleId:    a.RoleID.String(),
+			RoleName:  a.RoleName,
+			OrgId:     a.OrgID.String(),
+			UserId:    a.UserID.String(),
+			ScopeType: a.ScopeType,
+			CreatedAt: timestamppb.New(a.CreatedAt),
+			FullName:  a.FullName,
+			Email:     a.Email,
+		}
+		if a.ScopeID != nil {
+			pb.ScopeId = a.ScopeID.String()
+		}
+		if a.AssignedBy != nil {
+			pb.AssignedBy = a.AssignedBy.String()
+		}
+		assignments = append(assignments, pb)
+	}
+	return assignments, nil
+}
+
+// customRoleName validates a role name. Built-in role names are reserved so
+// a custom role is never mistaken for one.
+func customRoleName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", status.Error(codes.InvalidArgument, "name is required")
+	}
+	if len(name) > maxCustomRoleNameLength {
+		return "", status.Errorf(codes.InvalidArgument, "name must be at most %d characters", maxCustomRoleNameLength)
+	}
+	if authz.IsRole(strings.ToLower(strings.ReplaceAll(name, " ", "_"))) {
+		return "", status.Error(codes.InvalidArgument, "name is reserved for a built-in role")
+	}
+	return name, nil
+}
+
+// customRolePermissions validates and de-duplicates the permissions of a
+// custom role. The caller must hold each one.
+func customRolePermissions(ctx context.Context, requested []string) ([]string, error) {
+	if len(requested) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one permission is required")
+	}
+	seen := make(map[string]bool, len(requested))
+	perms := make([]string, 0, len(requested))
+	for _, p := range requested {
+		p = strings.TrimSpace(p)
+		if !authz.IsOrgPermission(authz.Permission(p)) {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown permission %q", p)
+		}
+		if !seen[p] {
+			seen[p] = true
+			perms = append(perms, p)
+		}
+	}
+	callerRole, _ := callerIdentity(ctx)
+	if !authz.CanGrantPermissions(callerRole, toPermissions(perms)) {
+		return nil, status.Error(codes.PermissionDenied, "cannot grant permissions you do not hold")
+	}
+	return perms, nil
+}
+
+func toPermissions(perms []string) []authz.Permission {
+	out := make([]authz.Permission, 0, len(perms))
+	for _, p := range perms {
+		out = append(out, authz.Permission(p))
+	}
+	return out
+}
+
+func customRoleToProto(role *models.CustomRole, assignments int32) *organization.CustomRole {
+	pb := &organization.CustomRole{
+		Id:              role.ID.String(),
+		OrgId:           role.OrgID.String(),
+		Name:            role.Name,
+		Permissions:     role.Permissions,
+		CreatedAt:       timestamppb.New(role.CreatedAt),
+		UpdatedAt:       timestamppb.New(role.UpdatedAt),
+		AssignmentCount: assignments,
+	}
+	if role.Description != nil {
+		pb.Description = *role.Description
+	}
+	if role.CreatedBy != nil {
+		pb.CreatedBy = role.CreatedBy.String()
+	}
+	return pb
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
 	"github.com/chanduchitikam/task-management-system/proto/organization"
 	"github.com/chanduchitikam/task-management-system/services/org/models"
 	"github.com/google/uuid"
@@ -246,6 +247,14 @@ func (s *OrganizationService) UpdateGroup(ctx context.Context, req *organization
 		args = append(args, req.Status)
 		argCount++
 	}
+	if req.Metadata != "" {
+		if err := validateMetadata(metadataGroup, req.Metadata); err != nil {
+			return nil, err
+		}
+		query += fmt.Sprintf(", metadata = $%d", argCount)
+		args = append(args, req.Metadata)
+		argCount++
+	}
 
 	query += fmt.Sprintf(" WHERE id = $%d", argCount)
 	args = append(args, groupID)
@@ -272,6 +281,10 @@ func (s *OrganizationService) DeleteGroup(ctx context.Context, req *organization
 		return nil, status.Error(codes.InvalidArgument, "invalid group_id")
 	}
 
+	if err := s.authorizeRow(ctx, authz.TeamManage, "groups", groupID); err != nil {
+		return nil, err
+	}
+
 	query := "DELETE FROM groups WHERE id = $1"
 	result, err := s.db.ExecContext(ctx, query, groupID)
 	if err != nil {
@@ -466,22 +479,42 @@ func (s *OrganizationService) CreateWorkspace(ctx context.Context, req *organiza
 		workspaceType = "general"
 	}
 
+	// the creator owns the workspace
+	var ownerID *uuid.UUID
+	if id := callerUUID(ctx); id != uuid.Nil {
+		ownerID = &id
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create workspace: %v", err)
+	}
+	defer tx.Rollback()
+
 	query := `
-		INSERT INTO workspaces (id, org_id, name, description, workspace_type, team_id, project_id, settings, is_private, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		INSERT INTO workspaces (id, org_id, name, description, workspace_type, team_id, project_id, owner_id, settings, is_private, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 		RETURNING id, created_at, updated_at
 	`
 
 	now := time.Now()
 	var workspace models.Workspace
-	err = s.db.QueryRowContext(ctx, query,
-		workspaceID, orgID, req.Name, req.Description, workspaceType, teamID, projectID,
+	err = tx.QueryRowContext(ctx, query,
+		workspaceID, orgID, req.Name, req.Description, workspaceType, teamID, projectID, ownerID,
 		"{}", req.IsPrivate, now, now,
 	).Scan(&workspace.ID, &workspace.CreatedAt, &workspace.UpdatedAt)
 
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create workspace: %v", err)
 	}
+	if ownerID != nil {
+		if err := addWorkspaceOwner(ctx, tx, workspaceID, *ownerID, now); err != nil {
+			return nil, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create workspace: %v", err)
+	}
 
 	workspaceProto := &organization.Workspace{
 		Id:            workspace.ID.String(),
@@ -501,6 +534,9 @@ func (s *OrganizationService) CreateWorkspace(ctx context.Context, req *organiza
 	if projectID != nil {
 		workspaceProto.ProjectId = projectID.String()
 	}
+	if ownerID != nil {
+		workspaceProto.OwnerId = ownerID.String()
+	}
 
 	return &organization.CreateWorkspaceResponse{
 		Workspace: workspaceProto,
@@ -514,14 +550,19 @@ func (s *OrganizationService) ListWorkspaces(ctx context.Context, req *organizat
 		return nil, status.Error(codes.InvalidArgument, "invalid org_id")
 	}
 
+	// private workspaces are listed only to their members
 	query := `
 		SELECT id, org_id, name, description, workspace_type, team_id, project_id, owner_id, settings, is_private, created_at, updated_at
 		FROM workspaces
 		WHERE org_id = $1
+		  AND (is_private = false OR EXISTS (
+			SELECT 1 FROM workspace_members wm
+			WHERE wm.workspace_id = workspaces.id AND wm.user_id = $2 AND wm.is_active = true
+		  ))
 	`
 
-	args := []interface{}{orgID}
-	argCount := 2
+	args := []interface{}{orgID, callerUUID(ctx)}
+	argCount := 3
 
 	if req.TeamId != "" {
 		query += fmt.Sprintf(" AND team_id = $%d", argCount)
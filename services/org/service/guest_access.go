@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
+	"github.com/chanduchitikam/task-management-system/proto/organization"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Scopes a guest's access may cover, as recorded in guest_access
+const (
+	guestScopeProject   = "project"
+	guestScopeWorkspace = "workspace"
+)
+
+// guestMethods are the only RPCs a guest may call, each mapped to the
+// project or workspace its request targets. Everything else in the
+// organization is hidden from guests.
+var guestMethods = map[string]func(req interface{}) (scopeType, id string){
+	organization.OrganizationService_GetProject_FullMethodName: func(req interface{}) (string, string) {
+		return guestScopeProject, req.(*organization.GetProjectRequest).ProjectId
+	},
+	organization.OrganizationService_GetWorkspace_FullMethodName: func(req interface{}) (string, string) {
+		return guestScopeWorkspace, req.(*organization.GetWorkspaceRequest).WorkspaceId
+	},
+	organization.OrganizationService_ListWorkspaceMembers_FullMethodName: func(req interface{}) (string, string) {
+		return guestScopeWorkspace, req.(*organization.ListWorkspaceMembersRequest).WorkspaceId
+	},
+}
+
+// GuestInterceptor limits callers holding the guest role to guestMethods,
+// and to the project or workspace their unexpired guest access covers
+func (s *OrganizationService) GuestInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		role, orgID := callerIdentity(ctx)
+		if !authz.IsGuest(role) {
+			return handler(ctx, req)
+		}
+		target, ok := guestMethods[info.FullMethod]
+		if !ok {
+			return nil, status.Error(codes.PermissionDenied, "guests may only access the project or workspace they were invited to")
+		}
+		scopeType, id := target(req)
+		scopeID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid %s_id", scopeType)
+		}
+		allowed, err := s.guestCanReach(ctx, orgID, scopeType, scopeID)
+		if err != nil {
+			return nil, err
+		}
+		if !allowed {
+			return nil, status.Error(codes.PermissionDenied, "guest access to this "+scopeType+" has expired or was never granted")
+		}
+		return handler(ctx, req)
+	}
+}
+
+// guestCanReach reports whether the calling guest has unexpired access to
+// the project or workspace scopeID in orgID. Access to a workspace extends
+// to the project it belongs to.
+func (s *OrganizationService) guestCanReach(ctx context.Context, orgID, scopeType string, scopeID uuid.UUID) (bool, error) {
+	userID := callerUUID(ctx)
+	if userID == uuid.Nil || orgID == "" {
+		return false, nil
+	}
+	var allowed bool
+	err := s.db.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM guest_access g
+			LEFT JOIN workspaces w ON g.scope_type = 'workspace' AND w.id = g.scope_id
+			WHERE g.user_id = $1 AND g.org_id = $2 AND g.expires_at > NOW()
+			  AND ((g.scope_type = $3 AND g.scope_id = $4) OR ($3 = 'project' AND w.project_id = $4))
+		)
+	`, userID, orgID, scopeType, scopeID).Scan(&allowed)
+	if err != nil {
+		return false, status.Errorf(codes.Internal, "failed to check guest access: %v", err)
+	}
+	return allowed, nil
+}
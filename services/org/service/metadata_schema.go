@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/chanduchitikam/task-management-system/proto/organization"
+	"github.com/chanduchitikam/task-management-system/services/org/schemas"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Entity types whose metadata (settings, for workspaces) is checked against
+// a schema in the schemas package
+const (
+	metadataTeam      = "team"
+	metadataGroup     = "group"
+	metadataProject   = "project"
+	metadataWorkspace = "workspace"
+)
+
+var metadataSchemas = compileMetadataSchemas(metadataTeam, metadataGroup, metadataProject, metadataWorkspace)
+
+// compileMetadataSchemas compiles the embedded schema of each entity type.
+// The schemas ship with the binary, so a broken one is a build bug.
+func compileMetadataSchemas(entities ...string) map[string]*jsonschema.Schema {
+	compiler := jsonschema.NewCompiler()
+	compiler.Draft = jsonschema.Draft2020
+	compiler.AssertFormat = true
+	compiled := make(map[string]*jsonschema.Schema, len(entities))
+	for _, entity := range entities {
+		url := schemaURL(entity)
+		f, err := schemas.FS.Open(entity + ".json")
+		if err != nil {
+			log.Fatalf("missing %s metadata schema: %v", entity, err)
+		}
+		err = compiler.AddResource(url, f)
+		f.Close()
+		if err != nil {
+			log.Fatalf("failed to load %s metadata schema: %v", entity, err)
+		}
+		if compiled[entity], err = compiler.Compile(url); err != nil {
+			log.Fatalf("failed to compile %s metadata schema: %v", entity, err)
+		}
+	}
+	return compiled
+}
+
+func schemaURL(entity string) string {
+	return "schemas/" + entity + ".json"
+}
+
+// validateMetadata checks raw is a JSON object satisfying entity's schema
+func validateMetadata(entity, raw string) error {
+	var v interface{}
+	if err := json.Unmarshal([]byte(raw), &v); err != nil {
+		return status.Errorf(codes.InvalidArgument, "%s metadata is not valid JSON", entity)
+	}
+	err := metadataSchemas[entity].Validate(v)
+	var ve *jsonschema.ValidationError
+	if errors.As(err, &ve) {
+		return status.Errorf(codes.InvalidArgument, "invalid %s metadata: %s", entity, describeSchemaError(ve))
+	}
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid %s metadata: %v", entity, err)
+	}
+	return nil
+}
+
+// describeSchemaError names the first offending location and why, which is
+// more use to a client than the whole tree of failed keywords
+func describeSchemaError(ve *jsonschema.ValidationError) string {
+	for len(ve.Causes) > 0 {
+		ve = ve.Causes[0]
+	}
+	location := ve.InstanceLocation
+	if location == "" {
+		location = "/"
+	}
+	return fmt.Sprintf("%s: %s", location, strings.TrimSpace(ve.Message))
+}
+
+// GetMetadataSchema returns the JSON schema an entity type's metadata must
+// satisfy, so clients can validate before writing
+func (s *OrganizationService) GetMetadataSchema(ctx context.Context, req *organization.GetMetadataSchemaRequest) (*organization.GetMetadataSchemaResponse, error) {
+	entity := strings.ToLower(strings.TrimSpace(req.EntityType))
+	if _, ok := metadataSchemas[entity]; !ok {
+		return nil, status.Error(codes.InvalidArgument, "entity_type must be team, group, project or workspace")
+	}
+	schema, err := schemas.FS.ReadFile(entity + ".json")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to read %s metadata schema: %v", entity, err)
+	}
+	return &organization.GetMetadataSchemaResponse{EntityType: entity, Schema: string(schema)}, nil
+}
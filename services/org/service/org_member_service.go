@@ -3,7 +3,10 @@ package service
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"errors"
 
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
 	"github.com/chanduchitikam/task-management-system/proto/organization"
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
@@ -26,12 +29,21 @@ func (s *OrganizationService) ListOrgMembers(ctx context.Context, req *organizat
 		return nil, status.Error(codes.InvalidArgument, "invalid org_id")
 	}
 
-	// Query to get all users in the organization
+	fields, err := s.visibleProfileFields(ctx, req.OrgId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Query to get all users in the organization, including members whose
+	// home organization is elsewhere (with the role they hold here)
 	query := `
-		SELECT id, full_name, email, username, role, created_at
-		FROM users
-		WHERE org_id = $1
-		ORDER BY full_name ASC
+		SELECT u.id, u.full_name, u.email, u.username,
+			CASE WHEN u.org_id = $1 OR u.role = 'super_admin' THEN u.role ELSE om.role END,
+			u.created_at, u.profile_attributes
+		FROM users u
+		LEFT JOIN organization_memberships om ON om.user_id = u.id AND om.org_id = $1
+		WHERE u.org_id = $1 OR om.id IS NOT NULL
+		ORDER BY u.full_name ASC
 	`
 
 	rows, err := s.db.QueryContext(ctx, query, orgID)
@@ -44,6 +56,7 @@ func (s *OrganizationService) ListOrgMembers(ctx context.Context, req *organizat
 	for rows.Next() {
 		var member organization.OrgMember
 		var createdAt sql.NullTime
+		var attributes []byte
 
 		err := rows.Scan(
 			&member.Id,
@@ -52,6 +65,7 @@ func (s *OrganizationService) ListOrgMembers(ctx context.Context, req *organizat
 			&member.Username,
 			&member.Role,
 			&createdAt,
+			&attributes,
 		)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to scan member: %v", err)
@@ -60,6 +74,7 @@ func (s *OrganizationService) ListOrgMembers(ctx context.Context, req *organizat
 		if createdAt.Valid {
 			member.CreatedAt = timestamppb.New(createdAt.Time)
 		}
+		member.ProfileAttributes = profileAttributes(attributes, fields)
 
 		members = append(members, &member)
 	}
@@ -73,3 +88,70 @@ func (s *OrganizationService) ListOrgMembers(ctx context.Context, req *organizat
 		Total:   int32(len(members)),
 	}, nil
 }
+
+// visibleProfileFields returns the keys of the org's extra profile fields
+// the caller may see. The fields are defined through the user service; only
+// member admins see those marked admins-only.
+func (s *OrganizationService) visibleProfileFields(ctx context.Context, orgID string) ([]string, error) {
+	var raw []byte
+	err := s.db.QueryRowContext(ctx, "SELECT settings->'profile_fields' FROM organizations WHERE id = $1", orgID).Scan(&raw)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "organization not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load profile fields: %v", err)
+	}
+	var defs []struct {
+		Key        string `json:"key"`
+		Visibility string `json:"visibility"`
+	}
+	if len(raw) > 0 {
+		_ = json.Unmarshal(raw, &defs)
+	}
+
+	isAdmin := s.callerCan(ctx, authz.MemberManage, orgID, "", uuid.Nil)
+	keys := make([]string, 0, len(defs))
+	for _, d := range defs {
+		if d.Visibility == "admins" && !isAdmin {
+			continue
+		}
+		keys = append(keys, d.Key)
+	}
+	return keys, nil
+}
+
+// profileAttributes picks the values of the given fields from a user's
+// stored attributes
+func profileAttributes(raw []byte, keys []string) map[string]string {
+	if len(raw) == 0 || len(keys) == 0 {
+		return nil
+	}
+	var values map[string]string
+	if err := json.Unmarshal(raw, &values); err != nil {
+		return nil
+	}
+	out := make(map[string]string, len(keys))
+	for _, k := range keys {
+		if v := values[k]; v != "" {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+// isOrgMember reports whether userID belongs to orgID, as their home
+// organization or through a membership
+func (s *OrganizationService) isOrgMember(ctx context.Context, orgID, userID uuid.UUID) (bool, error) {
+	var exists bool
+	err := s.db.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM users u
+			WHERE u.id = $2 AND (u.org_id = $1
+				OR EXISTS (SELECT 1 FROM organization_memberships om WHERE om.user_id = u.id AND om.org_id = $1))
+		)
+	`, orgID, userID).Scan(&exists)
+	if err != nil {
+		return false, status.Errorf(codes.Internal, "failed to check organization membership: %v", err)
+	}
+	return exists, nil
+}
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
+	"github.com/chanduchitikam/task-management-system/proto/organization"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultUsageDays is how far back GetOrgUsage reports without a start_date
+	defaultUsageDays = 30
+	// maxUsageDays caps the range of one usage report
+	maxUsageDays = 366
+)
+
+// RecordOrgUsage adds the request counts and active users the gateway
+// gathered to each organization's daily usage. Samples for unknown
+// organizations or users are dropped.
+func (s *OrganizationService) RecordOrgUsage(ctx context.Context, req *organization.RecordOrgUsageRequest) (*organization.RecordOrgUsageResponse, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record usage: %v", err)
+	}
+	defer tx.Rollback()
+
+	var recorded int32
+	for _, sample := range req.Samples {
+		orgID, err := uuid.Parse(sample.OrgId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid org_id %q", sample.OrgId)
+		}
+		day, err := time.Parse("2006-01-02", sample.Day)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid day %q, use YYYY-MM-DD", sample.Day)
+		}
+		if sample.Requests < 0 {
+			return nil, status.Error(codes.InvalidArgument, "requests must not be negative")
+		}
+		userIDs := make([]string, 0, len(sample.UserIds))
+		for _, id := range sample.UserIds {
+			if _, err := uuid.Parse(id); err == nil {
+				userIDs = append(userIDs, id)
+			}
+		}
+
+		res, err := tx.ExecContext(ctx, `
+			INSERT INTO org_usage_daily (org_id, day, requests)
+			SELECT id, $2, $3 FROM organizations WHERE id = $1
+			ON CONFLICT (org_id, day) DO UPDATE SET requests = org_usage_daily.requests + EXCLUDED.requests
+		`, orgID, day, sample.Requests)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to record usage: %v", err)
+		}
+		if n, _ := res.RowsAffected(); n == 0 {
+			continue
+		}
+		if len(userIDs) > 0 {
+			_, err = tx.ExecContext(ctx, `
+				INSERT INTO org_active_users_daily (org_id, day, user_id)
+				SELECT $1, $2, id FROM users WHERE id = ANY($3::uuid[])
+				ON CONFLICT DO NOTHING
+			`, orgID, day, pq.Array(userIDs))
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to record active users: %v", err)
+			}
+		}
+		recorded++
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record usage: %v", err)
+	}
+	return &organization.RecordOrgUsageResponse{Recorded: recorded}, nil
+}
+
+// GetOrgUsage reports an organization's API requests, active users, tasks
+// created and notifications sent for each day of a date range (UTC)
+func (s *OrganizationService) GetOrgUsage(ctx context.Context, req *organization.GetOrgUsageRequest) (*organization.GetOrgUsageResponse, error) {
+	orgID, err := uuid.Parse(req.OrgId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid org_id")
+	}
+	if !s.callerCan(ctx, authz.OrgManage, orgID.String(), "", uuid.Nil) {
+		return nil, status.Error(codes.PermissionDenied, "only organization admins may view usage")
+	}
+
+	endDate := time.Now().UTC().Truncate(24 * time.Hour)
+	if req.EndDate != "" {
+		if endDate, err = time.Parse("2006-01-02", req.EndDate); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid end_date format, use YYYY-MM-DD")
+		}
+	}
+	startDate := endDate.AddDate(0, 0, -(defaultUsageDays - 1))
+	if req.StartDate != "" {
+		if startDate, err = time.Parse("2006-01-02", req.StartDate); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid start_date format, use YYYY-MM-DD")
+		}
+	}
+	if endDate.Before(startDate) {
+		return nil, status.Error(codes.InvalidArgument, "end_date must not be before start_date")
+	}
+	if endDate.Sub(startDate) >= maxUsageDays*24*time.Hour {
+		return nil, status.Errorf(codes.InvalidArgument, "usage reports cover at most %d days", maxUsageDays)
+	}
+
+	// days are UTC, so each one spans [d, d + 1 day) read as UTC
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT to_char(d, 'YYYY-MM-DD'),
+		       COALESCE(u.requests, 0),
+		       (SELECT COUNT(*) FROM org_active_users_daily a WHERE a.org_id = $1 AND a.day = d::date),
+		       (SELECT COUNT(*) FROM tasks t
+		        WHERE t.org_id = $1
+		          AND t.created_at >= d AT TIME ZONE 'UTC' AND t.created_at < (d + interval '1 day') AT TIME ZONE 'UTC'),
+		       (SELECT COUNT(*) FROM notifications n JOIN users nu ON nu.id = n.user_id
+		        WHERE nu.org_id = $1
+		          AND n.created_at >= d AT TIME ZONE 'UTC' AND n.created_at < (d + interval '1 day') AT TIME ZONE 'UTC')
+		FROM generate_series($2::timestamp, $3::timestamp, interval '1 day') AS d
+		LEFT JOIN org_usage_daily u ON u.org_id = $1 AND u.day = d::date
+		ORDER BY d
+	`, orgID, startDate.Format("2006-01-02"), endDate.Format("2006-01-02"))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get usage: %v", err)
+	}
+	defer rows.Close()
+
+	resp := &organization.GetOrgUsageResponse{
+		OrgId:     orgID.String(),
+		StartDate: startDate.Format("2006-01-02"),
+		EndDate:   endDate.Format("2006-01-02"),
+		Totals:    &organization.OrgUsageDay{},
+	}
+	for rows.Next() {
+		var day organization.OrgUsageDay
+		if err := rows.Scan(&day.Day, &day.Requests, &day.ActiveUsers, &day.TasksCreated, &day.NotificationsSent); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan usage: %v", err)
+		}
+		resp.Totals.Requests += day.Requests
+		resp.Totals.TasksCreated += day.TasksCreated
+		resp.Totals.NotificationsSent += day.NotificationsSent
+		resp.Days = append(resp.Days, &day)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get usage: %v", err)
+	}
+
+	err = s.db.QueryRowContext(ctx, `
+		SELECT COUNT(DISTINCT user_id) FROM org_active_users_daily
+		WHERE org_id = $1 AND day BETWEEN $2 AND $3
+	`, orgID, startDate, endDate).Scan(&resp.Totals.ActiveUsers)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count active users: %v", err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,205 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
+	"github.com/chanduchitikam/task-management-system/proto/organization"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ============================================================================
+// OWNERSHIP TRANSFER
+// ============================================================================
+
+// ownedResource describes where a team, project or group records its owner
+// and the membership row the owner holds
+type ownedResource struct {
+	name        string
+	table       string
+	ownerColumn string
+	// perm lets admins transfer resources they do not own
+	perm         authz.Permission
+	memberTable  string
+	memberColumn string
+	ownerRole    string
+	// memberRole is what the previous owner's membership drops to
+	memberRole string
+	hasLeftAt  bool
+}
+
+var (
+	teamOwnership = ownedResource{
+		name: "team", table: "teams", ownerColumn: "team_lead_id", perm: authz.TeamManage,
+		memberTable: "team_members", memberColumn: "team_id", ownerRole: "lead", memberRole: "member", hasLeftAt: true,
+	}
+	projectOwnership = ownedResource{
+		name: "project", table: "projects", ownerColumn: "project_manager_id", perm: authz.ProjectDelete,
+		memberTable: "project_members", memberColumn: "project_id", ownerRole: "manager", memberRole: "contributor", hasLeftAt: true,
+	}
+	groupOwnership = ownedResource{
+		name: "group", table: "groups", ownerColumn: "owner_id", perm: authz.TeamManage,
+		memberTable: "group_members", memberColumn: "group_id", ownerRole: "owner", memberRole: "member",
+	}
+)
+
+func (s *OrganizationService) TransferTeamOwnership(ctx context.Context, req *organization.TransferTeamOwnershipRequest) (*organization.TransferTeamOwnershipResponse, error) {
+	teamID, err := uuid.Parse(req.TeamId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid team_id")
+	}
+	newOwnerID, err := uuid.Parse(req.NewOwnerId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid new_owner_id")
+	}
+
+	if err := s.transferOwnership(ctx, teamOwnership, teamID, newOwnerID); err != nil {
+		return nil, err
+	}
+
+	teamResp, err := s.GetTeam(ctx, &organization.GetTeamRequest{TeamId: teamID.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	return &organization.TransferTeamOwnershipResponse{
+		Team:    teamResp.Team,
+		Message: "Team ownership transferred successfully",
+	}, nil
+}
+
+func (s *OrganizationService) TransferProjectManager(ctx context.Context, req *organization.TransferProjectManagerRequest) (*organization.TransferProjectManagerResponse, error) {
+	projectID, err := uuid.Parse(req.ProjectId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid project_id")
+	}
+	newManagerID, err := uuid.Parse(req.NewManagerId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid new_manager_id")
+	}
+
+	if err := s.transferOwnership(ctx, projectOwnership, projectID, newManagerID); err != nil {
+		return nil, err
+	}
+
+	projectResp, err := s.GetProject(ctx, &organization.GetProjectRequest{ProjectId: projectID.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	return &organization.TransferProjectManagerResponse{
+		Project: projectResp.Project,
+		Message: "Project manager transferred successfully",
+	}, nil
+}
+
+func (s *OrganizationService) TransferGroupOwner(ctx context.Context, req *organization.TransferGroupOwnerRequest) (*organization.TransferGroupOwnerResponse, error) {
+	groupID, err := uuid.Parse(req.GroupId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid group_id")
+	}
+	newOwnerID, err := uuid.Parse(req.NewOwnerId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid new_owner_id")
+	}
+
+	if err := s.transferOwnership(ctx, groupOwnership, groupID, newOwnerID); err != nil {
+		return nil, err
+	}
+
+	groupResp, err := s.GetGroup(ctx, &organization.GetGroupRequest{GroupId: groupID.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	return &organization.TransferGroupOwnerResponse{
+		Group:   groupResp.Group,
+		Message: "Group ownership transferred successfully",
+	}, nil
+}
+
+// transferOwnership hands resource id to newOwnerID. The current owner or an
+// admin holding the resource's permission may transfer it, and only to a
+// member of the organization. The new owner joins the resource with the
+// owner's role; the previous owner stays on as a regular member.
+func (s *OrganizationService) transferOwnership(ctx context.Context, r ownedResource, id, newOwnerID uuid.UUID) error {
+	var orgID uuid.UUID
+	var currentOwner uuid.NullUUID
+	err := s.db.QueryRowContext(ctx,
+		fmt.Sprintf("SELECT org_id, %s FROM %s WHERE id = $1", r.ownerColumn, r.table), id,
+	).Scan(&orgID, &currentOwner)
+	if err == sql.ErrNoRows {
+		return status.Errorf(codes.NotFound, "%s not found", r.name)
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get %s: %v", r.name, err)
+	}
+
+	callerID := callerUUID(ctx)
+	isOwner := currentOwner.Valid && callerID != uuid.Nil && currentOwner.UUID == callerID
+	if !isOwner && !s.callerCan(ctx, r.perm, orgID.String(), r.name, id) {
+		return status.Error(codes.PermissionDenied, "access denied")
+	}
+	if currentOwner.Valid && currentOwner.UUID == newOwnerID {
+		return status.Errorf(codes.FailedPrecondition, "user already owns this %s", r.name)
+	}
+
+	member, err := s.isOrgMember(ctx, orgID, newOwnerID)
+	if err != nil {
+		return err
+	}
+	if !member {
+		return status.Error(codes.FailedPrecondition, "new owner is not a member of this organization")
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to transfer %s: %v", r.name, err)
+	}
+	defer tx.Rollback()
+
+	// the guard on the current owner makes concurrent transfers fail
+	// instead of silently overwriting each other
+	now := time.Now()
+	result, err := tx.ExecContext(ctx,
+		fmt.Sprintf("UPDATE %s SET %s = $1, updated_at = $2 WHERE id = $3 AND %s IS NOT DISTINCT FROM $4",
+			r.table, r.ownerColumn, r.ownerColumn),
+		newOwnerID, now, id, currentOwner)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to transfer %s: %v", r.name, err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return status.Errorf(codes.Aborted, "%s ownership changed concurrently; try again", r.name)
+	}
+
+	rejoin := "is_active = true, role = $4"
+	if r.hasLeftAt {
+		rejoin += ", left_at = NULL"
+	}
+	_, err = tx.ExecContext(ctx, fmt.Sprintf(`
+		INSERT INTO %[1]s (id, %[2]s, user_id, role, joined_at, is_active)
+		VALUES ($1, $2, $3, $4, $5, true)
+		ON CONFLICT (%[2]s, user_id) DO UPDATE SET %[3]s
+	`, r.memberTable, r.memberColumn, rejoin), uuid.New(), id, newOwnerID, r.ownerRole, now)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to add new owner to %s: %v", r.name, err)
+	}
+	if currentOwner.Valid {
+		_, err = tx.ExecContext(ctx,
+			fmt.Sprintf("UPDATE %s SET role = $1 WHERE %s = $2 AND user_id = $3 AND role = $4", r.memberTable, r.memberColumn),
+			r.memberRole, id, currentOwner.UUID, r.ownerRole)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to update previous owner of %s: %v", r.name, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return status.Errorf(codes.Internal, "failed to transfer %s: %v", r.name, err)
+	}
+	return nil
+}
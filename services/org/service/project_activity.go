@@ -0,0 +1,229 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
+	"github.com/chanduchitikam/task-management-system/proto/organization"
+	"github.com/chanduchitikam/task-management-system/services/org/models"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Project activity event types. task_completed is recorded by the task
+// service.
+const (
+	activityMemberAdded      = "member_added"
+	activityMilestoneReached = "milestone_reached"
+	activityStatusChanged    = "status_changed"
+	activityArchived         = "archived"
+	activityUnarchived       = "unarchived"
+)
+
+const (
+	defaultActivityPageSize = 20
+	maxActivityPageSize     = 100
+)
+
+// recordProjectActivity appends an event to the project's feed, attributed
+// to the caller. Call it once the change is committed: the feed describes
+// what already happened, so a failure to record is logged, not returned.
+func (s *OrganizationService) recordProjectActivity(ctx context.Context, projectID uuid.UUID, eventType, summary string, metadata map[string]string) {
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+	encoded, err := json.Marshal(metadata)
+	if err != nil {
+		log.Printf("failed to encode %s activity for project %s: %v", eventType, projectID, err)
+		return
+	}
+	var actorID interface{}
+	if id := callerUUID(ctx); id != uuid.Nil {
+		actorID = id
+	}
+	_, err = s.db.ExecContext(ctx, `
+		INSERT INTO project_activity (id, project_id, org_id, actor_id, event_type, summary, metadata, created_at)
+		SELECT $1, p.id, p.org_id, $3, $4, $5, $6, $7 FROM projects p WHERE p.id = $2
+	`, uuid.New(), projectID, actorID, eventType, summary, string(encoded), time.Now())
+	if err != nil {
+		log.Printf("failed to record %s activity for project %s: %v", eventType, projectID, err)
+	}
+}
+
+// userDisplayName is how activity summaries name userID: their full name,
+// else their username
+func (s *OrganizationService) userDisplayName(ctx context.Context, userID uuid.UUID) string {
+	var name string
+	err := s.db.QueryRowContext(ctx, "SELECT COALESCE(NULLIF(full_name, ''), username) FROM users WHERE id = $1", userID).Scan(&name)
+	if err != nil {
+		return "A member"
+	}
+	return name
+}
+
+// GetProjectActivity pages through the project's activity feed, newest first
+func (s *OrganizationService) GetProjectActivity(ctx context.Context, req *organization.GetProjectActivityRequest) (*organization.GetProjectActivityResponse, error) {
+	projectID, err := uuid.Parse(req.ProjectId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid project_id")
+	}
+	if err := s.authorizeRow(ctx, authz.MemberView, "projects", projectID); err != nil {
+		return nil, err
+	}
+
+	page := req.Page
+	if page < 1 {
+		page = 1
+	}
+	pageSize := req.PageSize
+	if pageSize < 1 {
+		pageSize = defaultActivityPageSize
+	}
+	if pageSize > maxActivityPageSize {
+		pageSize = maxActivityPageSize
+	}
+
+	where := "a.project_id = $1"
+	args := []interface{}{projectID}
+	if eventType := strings.TrimSpace(req.EventType); eventType != "" {
+		where += " AND a.event_type = $2"
+		args = append(args, eventType)
+	}
+
+	var total int32
+	if err := s.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM project_activity a WHERE "+where, args...).Scan(&total); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count project activity: %v", err)
+	}
+
+	rows, err := s.db.QueryContext(ctx, fmt.Sprintf(`
+		SELECT a.id, a.project_id, a.event_type, a.summary, a.actor_id, COALESCE(u.full_name, ''), a.metadata, a.created_at
+		FROM project_activity a
+		LEFT JOIN users u ON u.id = a.actor_id
+		WHERE %s
+		ORDER BY a.created_at DESC, a.id DESC
+		LIMIT %d OFFSET %d
+	`, where, pageSize, (page-1)*pageSize), args...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get project activity: %v", err)
+	}
+	defer rows.Close()
+
+	resp := &organization.GetProjectActivityResponse{Total: total, Page: page, PageSize: pageSize}
+	for rows.Next() {
+		var a organization.ProjectActivity
+		var actorID sql.NullString
+		var metadata []byte
+		var createdAt time.Time
+		if err := rows.Scan(&a.Id, &a.ProjectId, &a.EventType, &a.Summary, &actorID, &a.ActorName, &metadata, &createdAt); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan project activity: %v", err)
+		}
+		a.ActorId = actorID.String
+		if err := json.Unmarshal(metadata, &a.Metadata); err != nil {
+			log.Printf("ignoring malformed metadata of project activity %s: %v", a.Id, err)
+		}
+		a.CreatedAt = timestamppb.New(createdAt)
+		resp.Activities = append(resp.Activities, &a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get project activity: %v", err)
+	}
+	return resp, nil
+}
+
+// CompleteProjectMilestone marks one of the milestones in the project's
+// metadata as reached and records it in the activity feed
+func (s *OrganizationService) CompleteProjectMilestone(ctx context.Context, req *organization.CompleteProjectMilestoneRequest) (*organization.CompleteProjectMilestoneResponse, error) {
+	projectID, err := uuid.Parse(req.ProjectId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid project_id")
+	}
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if _, err := s.authorizeProjectManager(ctx, projectID); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to complete milestone: %v", err)
+	}
+	defer tx.Rollback()
+
+	var projectMetadata string
+	err = tx.QueryRowContext(ctx, "SELECT COALESCE(metadata, '{}') FROM projects WHERE id = $1 FOR UPDATE", projectID).Scan(&projectMetadata)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "project not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get project: %v", err)
+	}
+
+	// keep every other metadata key as it is
+	var meta map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(projectMetadata), &meta); err != nil || meta == nil {
+		return nil, status.Error(codes.NotFound, "milestone not found")
+	}
+	var milestones []models.ProjectMilestone
+	if raw, ok := meta["milestones"]; !ok || json.Unmarshal(raw, &milestones) != nil {
+		return nil, status.Error(codes.NotFound, "milestone not found")
+	}
+	var reached *models.ProjectMilestone
+	for i := range milestones {
+		if strings.EqualFold(milestones[i].Name, name) {
+			reached = &milestones[i]
+			break
+		}
+	}
+	if reached == nil {
+		return nil, status.Error(codes.NotFound, "milestone not found")
+	}
+	if reached.CompletedAt != nil {
+		return nil, status.Error(codes.FailedPrecondition, "milestone is already complete")
+	}
+
+	now := time.Now().UTC()
+	reached.CompletedAt = &now
+	if meta["milestones"], err = json.Marshal(milestones); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode project metadata: %v", err)
+	}
+	encoded, err := json.Marshal(meta)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode project metadata: %v", err)
+	}
+	if _, err := tx.ExecContext(ctx, "UPDATE projects SET metadata = $1, updated_at = $2 WHERE id = $3", string(encoded), now, projectID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to complete milestone: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to complete milestone: %v", err)
+	}
+	s.recordProjectActivity(ctx, projectID, activityMilestoneReached,
+		fmt.Sprintf("Milestone %q reached", reached.Name),
+		map[string]string{"milestone": reached.Name, "due_date": reached.DueDate})
+
+	return &organization.CompleteProjectMilestoneResponse{
+		Milestone: milestoneToProto(*reached),
+		Message:   "Milestone completed successfully",
+	}, nil
+}
+
+func milestoneToProto(m models.ProjectMilestone) *organization.ProjectMilestone {
+	pb := &organization.ProjectMilestone{
+		Name:        m.Name,
+		Description: m.Description,
+		DueDate:     m.DueDate,
+	}
+	if m.CompletedAt != nil {
+		pb.CompletedAt = timestamppb.New(*m.CompletedAt)
+	}
+	return pb
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
 	"github.com/chanduchitikam/task-management-system/proto/organization"
 	"github.com/chanduchitikam/task-management-system/services/org/models"
 	"github.com/google/uuid"
@@ -90,7 +91,7 @@ func (s *OrganizationService) GetProject(ctx context.Context, req *organization.
 
 	query := `
 		SELECT p.id, p.org_id, p.name, p.description, p.project_manager_id, p.status, p.priority,
-		       p.start_date, p.end_date, p.budget, p.progress, p.metadata, p.created_at, p.updated_at, p.created_by,
+		       p.start_date, p.end_date, p.budget, p.progress, p.metadata, p.created_at, p.updated_at, p.created_by, p.archived_at,
 		       u.id as manager_id, u.full_name as manager_name, u.email as manager_email, u.username as manager_username,
 		       COALESCE((SELECT COUNT(*) FROM project_teams WHERE project_id = p.id), 0) as team_count,
 		       COALESCE((SELECT COUNT(*) FROM project_members WHERE project_id = p.id AND is_active = true), 0) as member_count
@@ -108,7 +109,7 @@ func (s *OrganizationService) GetProject(ctx context.Context, req *organization.
 	err = s.db.QueryRowContext(ctx, query, projectID).Scan(
 		&project.ID, &project.OrgID, &project.Name, &project.Description, &project.ProjectManagerID,
 		&project.Status, &project.Priority, &startDate, &endDate, &budget, &project.Progress,
-		&project.Metadata, &project.CreatedAt, &project.UpdatedAt, &project.CreatedBy,
+		&project.Metadata, &project.CreatedAt, &project.UpdatedAt, &project.CreatedBy, &project.ArchivedAt,
 		&managerID, &managerName, &managerEmail, &managerUsername, &teamCount, &memberCount,
 	)
 
@@ -142,6 +143,9 @@ func (s *OrganizationService) GetProject(ctx context.Context, req *organization.
 	if project.CreatedBy != nil {
 		projectProto.CreatedBy = project.CreatedBy.String()
 	}
+	if project.ArchivedAt != nil {
+		projectProto.ArchivedAt = timestamppb.New(*project.ArchivedAt)
+	}
 	if startDate.Valid {
 		projectProto.StartDate = startDate.Time.Format("2006-01-02")
 	}
@@ -172,7 +176,7 @@ func (s *OrganizationService) ListProjects(ctx context.Context, req *organizatio
 
 	query := `
 		SELECT p.id, p.org_id, p.name, p.description, p.project_manager_id, p.status, p.priority,
-		       p.start_date, p.end_date, p.budget, p.progress, p.metadata, p.created_at, p.updated_at,
+		       p.start_date, p.end_date, p.budget, p.progress, p.metadata, p.created_at, p.updated_at, p.archived_at,
 		       u.id as manager_id, u.full_name as manager_name, u.email as manager_email, u.username as manager_username,
 		       COALESCE((SELECT COUNT(*) FROM project_teams WHERE project_id = p.id), 0) as team_count,
 		       COALESCE((SELECT COUNT(*) FROM project_members WHERE project_id = p.id AND is_active = true), 0) as member_count
@@ -180,6 +184,11 @@ func (s *OrganizationService) ListProjects(ctx context.Context, req *organizatio
 		LEFT JOIN users u ON p.project_manager_id = u.id
 		WHERE p.org_id = $1
 	`
+	if req.ArchivedOnly {
+		query += " AND p.archived_at IS NOT NULL"
+	} else {
+		query += " AND p.archived_at IS NULL"
+	}
 
 	args := []interface{}{orgID}
 	argCount := 2
@@ -215,7 +224,7 @@ func (s *OrganizationService) ListProjects(ctx context.Context, req *organizatio
 		err := rows.Scan(
 			&project.ID, &project.OrgID, &project.Name, &project.Description, &project.ProjectManagerID,
 			&project.Status, &project.Priority, &startDate, &endDate, &budget, &project.Progress,
-			&project.Metadata, &project.CreatedAt, &project.UpdatedAt,
+			&project.Metadata, &project.CreatedAt, &project.UpdatedAt, &project.ArchivedAt,
 			&managerID, &managerName, &managerEmail, &managerUsername, &teamCount, &memberCount,
 		)
 		if err != nil {
@@ -242,6 +251,9 @@ func (s *OrganizationService) ListProjects(ctx context.Context, req *organizatio
 		if project.ProjectManagerID != nil {
 			projectProto.ProjectManagerId = project.ProjectManagerID.String()
 		}
+		if project.ArchivedAt != nil {
+			projectProto.ArchivedAt = timestamppb.New(*project.ArchivedAt)
+		}
 		if startDate.Valid {
 			projectProto.StartDate = startDate.Time.Format("2006-01-02")
 		}
@@ -309,6 +321,15 @@ func (s *OrganizationService) UpdateProject(ctx context.Context, req *organizati
 		return nil, status.Error(codes.InvalidArgument, "invalid project_id")
 	}
 
+	var previousStatus string
+	err = s.db.QueryRowContext(ctx, "SELECT status FROM projects WHERE id = $1", projectID).Scan(&previousStatus)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "project not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get project: %v", err)
+	}
+
 	query := "UPDATE projects SET updated_at = $1"
 	args := []interface{}{time.Now()}
 	argCount := 2
@@ -343,6 +364,14 @@ func (s *OrganizationService) UpdateProject(ctx context.Context, req *organizati
 		args = append(args, req.Budget)
 		argCount++
 	}
+	if req.Metadata != "" {
+		if err := validateMetadata(metadataProject, req.Metadata); err != nil {
+			return nil, err
+		}
+		query += fmt.Sprintf(", metadata = $%d", argCount)
+		args = append(args, req.Metadata)
+		argCount++
+	}
 
 	query += fmt.Sprintf(" WHERE id = $%d", argCount)
 	args = append(args, projectID)
@@ -351,6 +380,11 @@ func (s *OrganizationService) UpdateProject(ctx context.Context, req *organizati
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update project: %v", err)
 	}
+	if req.Status != "" && req.Status != previousStatus {
+		s.recordProjectActivity(ctx, projectID, activityStatusChanged,
+			fmt.Sprintf("Status changed from %s to %s", previousStatus, req.Status),
+			map[string]string{"from": previousStatus, "to": req.Status})
+	}
 
 	projectResp, err := s.GetProject(ctx, &organization.GetProjectRequest{ProjectId: projectID.String()})
 	if err != nil {
@@ -363,25 +397,96 @@ func (s *OrganizationService) UpdateProject(ctx context.Context, req *organizati
 	}, nil
 }
 
-func (s *OrganizationService) DeleteProject(ctx context.Context, req *organization.DeleteProjectRequest) (*organization.DeleteProjectResponse, error) {
+// ArchiveProject hides the project from default lists and stops new tasks
+// being created in it. Its data, status and memberships are kept.
+func (s *OrganizationService) ArchiveProject(ctx context.Context, req *organization.ArchiveProjectRequest) (*organization.ArchiveProjectResponse, error) {
 	projectID, err := uuid.Parse(req.ProjectId)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid project_id")
 	}
 
-	query := "DELETE FROM projects WHERE id = $1"
-	result, err := s.db.ExecContext(ctx, query, projectID)
+	if _, err := s.authorizeProjectManager(ctx, projectID); err != nil {
+		return nil, err
+	}
+
+	var archivedBy interface{}
+	if callerID := callerUUID(ctx); callerID != uuid.Nil {
+		archivedBy = callerID
+	}
+	now := time.Now()
+	result, err := s.db.ExecContext(ctx,
+		"UPDATE projects SET archived_at = $1, archived_by = $2, updated_at = $1 WHERE id = $3 AND archived_at IS NULL",
+		now, archivedBy, projectID)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete project: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to archive project: %v", err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "project is already archived")
 	}
+	s.recordProjectActivity(ctx, projectID, activityArchived, "Project archived", nil)
 
-	rows, _ := result.RowsAffected()
-	if rows == 0 {
-		return nil, status.Error(codes.NotFound, "project not found")
+	projectResp, err := s.GetProject(ctx, &organization.GetProjectRequest{ProjectId: projectID.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	return &organization.ArchiveProjectResponse{
+		Project: projectResp.Project,
+		Message: "Project archived successfully",
+	}, nil
+}
+
+func (s *OrganizationService) UnarchiveProject(ctx context.Context, req *organization.UnarchiveProjectRequest) (*organization.UnarchiveProjectResponse, error) {
+	projectID, err := uuid.Parse(req.ProjectId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid project_id")
+	}
+
+	if _, err := s.authorizeProjectManager(ctx, projectID); err != nil {
+		return nil, err
+	}
+
+	result, err := s.db.ExecContext(ctx,
+		"UPDATE projects SET archived_at = NULL, archived_by = NULL, updated_at = $1 WHERE id = $2 AND archived_at IS NOT NULL",
+		time.Now(), projectID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unarchive project: %v", err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "project is not archived")
+	}
+	s.recordProjectActivity(ctx, projectID, activityUnarchived, "Project unarchived", nil)
+
+	projectResp, err := s.GetProject(ctx, &organization.GetProjectRequest{ProjectId: projectID.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	return &organization.UnarchiveProjectResponse{
+		Project: projectResp.Project,
+		Message: "Project unarchived successfully",
+	}, nil
+}
+
+func (s *OrganizationService) DeleteProject(ctx context.Context, req *organization.DeleteProjectRequest) (*organization.DeleteProjectResponse, error) {
+	projectID, err := uuid.Parse(req.ProjectId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid project_id")
+	}
+
+	if err := s.authorizeRow(ctx, authz.ProjectDelete, "projects", projectID); err != nil {
+		return nil, err
+	}
+
+	reassigned, detached, err := s.deleteWithTaskPolicy(ctx, "projects", "project_id", projectID, req.TaskPolicy, req.FallbackOwnerId)
+	if err != nil {
+		return nil, err
 	}
 
 	return &organization.DeleteProjectResponse{
-		Message: "Project deleted successfully",
+		Message:         "Project deleted successfully",
+		TasksReassigned: int32(reassigned),
+		TasksDetached:   int32(detached),
 	}, nil
 }
 
@@ -469,6 +574,9 @@ func (s *OrganizationService) AddProjectMember(ctx context.Context, req *organiz
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to add project member: %v", err)
 	}
+	s.recordProjectActivity(ctx, projectID, activityMemberAdded,
+		fmt.Sprintf("%s joined the project as %s", s.userDisplayName(ctx, userID), role),
+		map[string]string{"user_id": userID.String(), "role": role})
 
 	return &organization.AddProjectMemberResponse{
 		Message: "Member added to project successfully",
@@ -496,3 +604,26 @@ func (s *OrganizationService) RemoveProjectMember(ctx context.Context, req *orga
 		Message: "Member removed from project successfully",
 	}, nil
 }
+
+// authorizeProjectManager allows the project's manager, or an admin holding
+// ProjectDelete, to act on the project. It returns the project's org.
+func (s *OrganizationService) authorizeProjectManager(ctx context.Context, projectID uuid.UUID) (uuid.UUID, error) {
+	var orgID uuid.UUID
+	var manager uuid.NullUUID
+	err := s.db.QueryRowContext(ctx, "SELECT org_id, project_manager_id FROM projects WHERE id = $1", projectID).Scan(&orgID, &manager)
+	if err == sql.ErrNoRows {
+		return uuid.Nil, status.Error(codes.NotFound, "project not found")
+	}
+	if err != nil {
+		return uuid.Nil, status.Errorf(codes.Internal, "failed to get project: %v", err)
+	}
+
+	callerID := callerUUID(ctx)
+	if manager.Valid && callerID != uuid.Nil && manager.UUID == callerID {
+		return orgID, nil
+	}
+	if !s.callerCan(ctx, authz.ProjectDelete, orgID.String(), customRoleScopeProject, projectID) {
+		return uuid.Nil, status.Error(codes.PermissionDenied, "access denied")
+	}
+	return orgID, nil
+}
@@ -0,0 +1,258 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
+	notificationpb "github.com/chanduchitikam/task-management-system/proto/notification"
+	"github.com/chanduchitikam/task-management-system/proto/organization"
+	"github.com/chanduchitikam/task-management-system/services/org/models"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultStatusReportLimit = 12
+
+	// statusReminderInterval is how often the reminder worker looks for
+	// projects missing this week's report
+	statusReminderInterval = time.Hour
+	// statusReportDueDay is the weekday from which managers who have not
+	// reported for the week are reminded
+	statusReportDueDay = time.Friday
+)
+
+var ragStatuses = map[string]bool{"green": true, "amber": true, "red": true}
+
+// SubmitProjectStatusReport records the project's status for a week. The
+// project manager or an admin may report; resubmitting a week replaces it.
+func (s *OrganizationService) SubmitProjectStatusReport(ctx context.Context, req *organization.SubmitProjectStatusReportRequest) (*organization.SubmitProjectStatusReportResponse, error) {
+	projectID, err := uuid.Parse(req.ProjectId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid project_id")
+	}
+	rag := strings.ToLower(strings.TrimSpace(req.RagStatus))
+	if !ragStatuses[rag] {
+		return nil, status.Error(codes.InvalidArgument, "rag_status must be green, amber or red")
+	}
+
+	weekOf := time.Now()
+	if req.WeekStart != "" {
+		if weekOf, err = time.Parse("2006-01-02", req.WeekStart); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid week_start format, use YYYY-MM-DD")
+		}
+	}
+	weekStart := startOfWeek(weekOf)
+
+	orgID, err := s.authorizeProjectManager(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	callerID := callerUUID(ctx)
+
+	risks := make([]string, 0, len(req.Risks))
+	for _, r := range req.Risks {
+		if r = strings.TrimSpace(r); r != "" {
+			risks = append(risks, r)
+		}
+	}
+	var submittedBy interface{}
+	if callerID != uuid.Nil {
+		submittedBy = callerID
+	}
+
+	now := time.Now()
+	var reportID uuid.UUID
+	err = s.db.QueryRowContext(ctx, `
+		INSERT INTO project_status_reports (id, project_id, org_id, week_start, rag_status, summary, risks, submitted_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $9)
+		ON CONFLICT (project_id, week_start) DO UPDATE
+		SET rag_status = $5, summary = $6, risks = $7, submitted_by = $8, updated_at = $9
+		RETURNING id
+	`, uuid.New(), projectID, orgID, weekStart, rag, strings.TrimSpace(req.Summary), pq.Array(risks), submittedBy, now).Scan(&reportID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to submit status report: %v", err)
+	}
+
+	reports, err := s.listStatusReports(ctx, "r.id = $1", 1, reportID)
+	if err != nil {
+		return nil, err
+	}
+	if len(reports) == 0 {
+		return nil, status.Error(codes.Internal, "failed to load status report")
+	}
+
+	return &organization.SubmitProjectStatusReportResponse{
+		Report:  reports[0],
+		Message: "Status report submitted successfully",
+	}, nil
+}
+
+// ListProjectStatusReports returns the project's reports, latest week first
+func (s *OrganizationService) ListProjectStatusReports(ctx context.Context, req *organization.ListProjectStatusReportsRequest) (*organization.ListProjectStatusReportsResponse, error) {
+	projectID, err := uuid.Parse(req.ProjectId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid project_id")
+	}
+	if err := s.authorizeRow(ctx, authz.MemberView, "projects", projectID); err != nil {
+		return nil, err
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultStatusReportLimit
+	}
+	reports, err := s.listStatusReports(ctx, "r.project_id = $1", limit, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return &organization.ListProjectStatusReportsResponse{Reports: reports}, nil
+}
+
+// RunStatusReportReminders notifies the manager of every active project that
+// has no report for the current week once the week's due day arrives, at most
+// once per project and week.
+func (s *OrganizationService) RunStatusReportReminders(ctx context.Context) {
+	if s.notifications == nil {
+		log.Println("status report reminders disabled: no notification client")
+		return
+	}
+	ticker := time.NewTicker(statusReminderInterval)
+	defer ticker.Stop()
+
+	log.Println("status report reminder worker started")
+	for {
+		if now := time.Now(); now.Weekday() >= statusReportDueDay || now.Weekday() == time.Sunday {
+			s.sendStatusReportReminders(ctx, startOfWeek(now))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *OrganizationService) sendStatusReportReminders(ctx context.Context, weekStart time.Time) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT p.id, p.name, p.project_manager_id
+		FROM projects p
+		WHERE p.status = 'active' AND p.archived_at IS NULL AND p.project_manager_id IS NOT NULL
+		  AND NOT EXISTS (SELECT 1 FROM project_status_reports r WHERE r.project_id = p.id AND r.week_start = $1)
+		  AND NOT EXISTS (SELECT 1 FROM project_status_reminders m WHERE m.project_id = p.id AND m.week_start = $1)
+	`, weekStart)
+	if err != nil {
+		log.Printf("failed to find projects missing status reports: %v", err)
+		return
+	}
+	type pending struct {
+		projectID, managerID uuid.UUID
+		name                 string
+	}
+	var due []pending
+	for rows.Next() {
+		var p pending
+		if err := rows.Scan(&p.projectID, &p.name, &p.managerID); err != nil {
+			log.Printf("failed to scan project missing status report: %v", err)
+			continue
+		}
+		due = append(due, p)
+	}
+	rows.Close()
+
+	week := weekStart.Format("2006-01-02")
+	for _, p := range due {
+		// claim the reminder first so concurrent instances send it once
+		result, err := s.db.ExecContext(ctx,
+			"INSERT INTO project_status_reminders (project_id, week_start, sent_at) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING",
+			p.projectID, weekStart, time.Now())
+		if err != nil {
+			log.Printf("failed to record status report reminder for project %s: %v", p.projectID, err)
+			continue
+		}
+		if n, _ := result.RowsAffected(); n == 0 {
+			continue
+		}
+
+		_, err = s.notifications.SendNotification(ctx, &notificationpb.SendNotificationRequest{
+			UserId:  p.managerID.String(),
+			Type:    notificationpb.NotificationType_NOTIFICATION_TYPE_STATUS_REPORT_DUE,
+			Title:   "Status report due",
+			Message: fmt.Sprintf("%s has no status report for the week of %s", p.name, week),
+			Metadata: map[string]string{
+				"project_id": p.projectID.String(),
+				"week_start": week,
+			},
+		})
+		if err != nil {
+			log.Printf("failed to remind manager of project %s about status report: %v", p.projectID, err)
+			// release the claim so a later pass retries
+			_, err = s.db.ExecContext(ctx, "DELETE FROM project_status_reminders WHERE project_id = $1 AND week_start = $2", p.projectID, weekStart)
+			if err != nil {
+				log.Printf("failed to release status report reminder for project %s: %v", p.projectID, err)
+			}
+		}
+	}
+}
+
+func (s *OrganizationService) listStatusReports(ctx context.Context, where string, limit int, args ...interface{}) ([]*organization.ProjectStatusReport, error) {
+	query := fmt.Sprintf(`
+		SELECT r.id, r.project_id, r.org_id, r.week_start, r.rag_status, r.summary, r.risks, r.submitted_by,
+		       COALESCE(u.full_name, ''), r.created_at, r.updated_at
+		FROM project_status_reports r
+		LEFT JOIN users u ON u.id = r.submitted_by
+		WHERE %s
+		ORDER BY r.week_start DESC
+		LIMIT %d
+	`, where, limit)
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list status reports: %v", err)
+	}
+	defer rows.Close()
+
+	var reports []*organization.ProjectStatusReport
+	for rows.Next() {
+		var r models.ProjectStatusReport
+		err := rows.Scan(&r.ID, &r.ProjectID, &r.OrgID, &r.WeekStart, &r.RAGStatus, &r.Summary, pq.Array(&r.Risks),
+			&r.SubmittedBy, &r.SubmitterName, &r.CreatedAt, &r.UpdatedAt)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan status report: %v", err)
+		}
+		report := &organization.ProjectStatusReport{
+			Id:            r.ID.String(),
+			ProjectId:     r.ProjectID.String(),
+			OrgId:         r.OrgID.String(),
+			WeekStart:     r.WeekStart.Format("2006-01-02"),
+			RagStatus:     r.RAGStatus,
+			Summary:       r.Summary,
+			Risks:         r.Risks,
+			SubmitterName: r.SubmitterName,
+			CreatedAt:     timestamppb.New(r.CreatedAt),
+			UpdatedAt:     timestamppb.New(r.UpdatedAt),
+		}
+		if r.SubmittedBy != nil {
+			report.SubmittedBy = r.SubmittedBy.String()
+		}
+		reports = append(reports, report)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list status reports: %v", err)
+	}
+	return reports, nil
+}
+
+// startOfWeek returns the Monday of t's week, at midnight UTC
+func startOfWeek(t time.Time) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+}
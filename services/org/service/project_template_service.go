@@ -0,0 +1,645 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
+	"github.com/chanduchitikam/task-management-system/proto/organization"
+	taskpb "github.com/chanduchitikam/task-management-system/proto/task"
+	"github.com/chanduchitikam/task-management-system/services/org/models"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ============================================================================
+// PROJECT TEMPLATES
+// ============================================================================
+
+// projectTemplateDefinition is the blueprint stored in
+// project_templates.definition. Dates are kept as day offsets from the
+// project start so a template can be reused at any time.
+type projectTemplateDefinition struct {
+	Priority     string              `json:"priority,omitempty"`
+	Budget       float64             `json:"budget,omitempty"`
+	DurationDays int32               `json:"duration_days,omitempty"`
+	TeamIDs      []string            `json:"team_ids,omitempty"`
+	Workspace    *templateWorkspace  `json:"workspace,omitempty"`
+	Tasks        []templateTask      `json:"tasks,omitempty"`
+	Milestones   []templateMilestone `json:"milestones,omitempty"`
+}
+
+type templateWorkspace struct {
+	WorkspaceType string `json:"workspace_type"`
+	Description   string `json:"description,omitempty"`
+	Settings      string `json:"settings,omitempty"`
+	IsPrivate     bool   `json:"is_private"`
+}
+
+type templateTask struct {
+	Title         string   `json:"title"`
+	Description   string   `json:"description,omitempty"`
+	Priority      string   `json:"priority,omitempty"`
+	Tags          []string `json:"tags,omitempty"`
+	DueOffsetDays int32    `json:"due_offset_days,omitempty"`
+}
+
+type templateMilestone struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	OffsetDays  int32  `json:"offset_days"`
+}
+
+var taskPriorities = map[string]taskpb.TaskPriority{
+	"low":      taskpb.TaskPriority_TASK_PRIORITY_LOW,
+	"medium":   taskpb.TaskPriority_TASK_PRIORITY_MEDIUM,
+	"high":     taskpb.TaskPriority_TASK_PRIORITY_HIGH,
+	"critical": taskpb.TaskPriority_TASK_PRIORITY_CRITICAL,
+}
+
+func (s *OrganizationService) CreateProjectTemplate(ctx context.Context, req *organization.CreateProjectTemplateRequest) (*organization.CreateProjectTemplateResponse, error) {
+	projectID, err := uuid.Parse(req.ProjectId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid project_id")
+	}
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if err := s.authorizeRow(ctx, authz.ProjectCreate, "projects", projectID); err != nil {
+		return nil, err
+	}
+
+	var orgID uuid.UUID
+	var description sql.NullString
+	var priority, projectMetadata string
+	var startDate, endDate sql.NullTime
+	var budget sql.NullFloat64
+	err = s.db.QueryRowContext(ctx, `
+		SELECT org_id, description, priority, start_date, end_date, budget, metadata
+		FROM projects WHERE id = $1
+	`, projectID).Scan(&orgID, &description, &priority, &startDate, &endDate, &budget, &projectMetadata)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "project not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get project: %v", err)
+	}
+
+	def := projectTemplateDefinition{Priority: priority, Budget: budget.Float64}
+	if startDate.Valid && endDate.Valid && !endDate.Time.Before(startDate.Time) {
+		def.DurationDays = daysBetween(startDate.Time, endDate.Time)
+	}
+
+	teamRows, err := s.db.QueryContext(ctx, "SELECT team_id FROM project_teams WHERE project_id = $1 ORDER BY assigned_at", projectID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get project teams: %v", err)
+	}
+	for teamRows.Next() {
+		var teamID uuid.UUID
+		if err := teamRows.Scan(&teamID); err != nil {
+			teamRows.Close()
+			return nil, status.Errorf(codes.Internal, "failed to scan project team: %v", err)
+		}
+		def.TeamIDs = append(def.TeamIDs, teamID.String())
+	}
+	teamRows.Close()
+
+	var ws templateWorkspace
+	var wsDescription sql.NullString
+	err = s.db.QueryRowContext(ctx, `
+		SELECT workspace_type, description, settings, is_private
+		FROM workspaces WHERE project_id = $1
+		ORDER BY created_at LIMIT 1
+	`, projectID).Scan(&ws.WorkspaceType, &wsDescription, &ws.Settings, &ws.IsPrivate)
+	switch {
+	case err == nil:
+		ws.Description = wsDescription.String
+		def.Workspace = &ws
+	case err != sql.ErrNoRows:
+		return nil, status.Errorf(codes.Internal, "failed to get project workspace: %v", err)
+	}
+
+	if req.IncludeTasks {
+		tasks, err := s.projectTasks(ctx, projectID, startDate)
+		if err != nil {
+			return nil, err
+		}
+		def.Tasks = tasks
+	}
+	if startDate.Valid {
+		def.Milestones = projectMilestones(projectMetadata, startDate.Time)
+	}
+	for _, t := range req.Tasks {
+		task, err := templateTaskFromProto(t)
+		if err != nil {
+			return nil, err
+		}
+		def.Tasks = append(def.Tasks, task)
+	}
+	for _, m := range req.Milestones {
+		if strings.TrimSpace(m.Name) == "" {
+			return nil, status.Error(codes.InvalidArgument, "milestone name is required")
+		}
+		if m.OffsetDays < 0 {
+			return nil, status.Error(codes.InvalidArgument, "milestone offset_days must not be negative")
+		}
+		def.Milestones = append(def.Milestones, templateMilestone{Name: m.Name, Description: m.Description, OffsetDays: m.OffsetDays})
+	}
+
+	definition, err := json.Marshal(def)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode template: %v", err)
+	}
+	templateDescription := req.Description
+	if templateDescription == "" {
+		templateDescription = description.String
+	}
+	var createdBy *uuid.UUID
+	if id, err := uuid.Parse(callerUserID(ctx)); err == nil {
+		createdBy = &id
+	}
+
+	tmpl := models.ProjectTemplate{
+		ID:              uuid.New(),
+		OrgID:           orgID,
+		Name:            name,
+		Description:     &templateDescription,
+		SourceProjectID: &projectID,
+		Definition:      string(definition),
+		CreatedBy:       createdBy,
+	}
+	err = s.db.QueryRowContext(ctx, `
+		INSERT INTO project_templates (id, org_id, name, description, source_project_id, definition, created_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $8)
+		RETURNING created_at, updated_at
+	`, tmpl.ID, tmpl.OrgID, tmpl.Name, tmpl.Description, tmpl.SourceProjectID, tmpl.Definition, tmpl.CreatedBy, time.Now(),
+	).Scan(&tmpl.CreatedAt, &tmpl.UpdatedAt)
+	if isUniqueViolation(err) {
+		return nil, status.Error(codes.AlreadyExists, "a project template with this name already exists")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create project template: %v", err)
+	}
+
+	return &organization.CreateProjectTemplateResponse{
+		Template: projectTemplateToProto(&tmpl, &def),
+		Message:  "Project template created successfully",
+	}, nil
+}
+
+func (s *OrganizationService) GetProjectTemplate(ctx context.Context, req *organization.GetProjectTemplateRequest) (*organization.GetProjectTemplateResponse, error) {
+	templateID, err := uuid.Parse(req.TemplateId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid template_id")
+	}
+	if err := s.authorizeRow(ctx, authz.MemberView, "project_templates", templateID); err != nil {
+		return nil, err
+	}
+
+	tmpl, def, err := s.loadProjectTemplate(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+	return &organization.GetProjectTemplateResponse{Template: projectTemplateToProto(tmpl, def)}, nil
+}
+
+func (s *OrganizationService) ListProjectTemplates(ctx context.Context, req *organization.ListProjectTemplatesRequest) (*organization.ListProjectTemplatesResponse, error) {
+	orgID, err := uuid.Parse(req.OrgId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid org_id")
+	}
+	if !s.callerCan(ctx, authz.MemberView, orgID.String(), "", uuid.Nil) {
+		return nil, status.Error(codes.PermissionDenied, "access denied")
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id, org_id, name, description, source_project_id, definition, created_by, created_at, updated_at
+		FROM project_templates
+		WHERE org_id = $1
+		ORDER BY name ASC
+	`, orgID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list project templates: %v", err)
+	}
+	defer rows.Close()
+
+	var templates []*organization.ProjectTemplate
+	for rows.Next() {
+		var tmpl models.ProjectTemplate
+		err := rows.Scan(&tmpl.ID, &tmpl.OrgID, &tmpl.Name, &tmpl.Description, &tmpl.SourceProjectID,
+			&tmpl.Definition, &tmpl.CreatedBy, &tmpl.CreatedAt, &tmpl.UpdatedAt)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan project template: %v", err)
+		}
+		var def projectTemplateDefinition
+		if err := json.Unmarshal([]byte(tmpl.Definition), &def); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to decode project template %s: %v", tmpl.ID, err)
+		}
+		templates = append(templates, projectTemplateToProto(&tmpl, &def))
+	}
+
+	return &organization.ListProjectTemplatesResponse{
+		Templates: templates,
+		Total:     int32(len(templates)),
+	}, nil
+}
+
+func (s *OrganizationService) DeleteProjectTemplate(ctx context.Context, req *organization.DeleteProjectTemplateRequest) (*organization.DeleteProjectTemplateResponse, error) {
+	templateID, err := uuid.Parse(req.TemplateId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid template_id")
+	}
+	if err := s.authorizeRow(ctx, authz.ProjectDelete, "project_templates", templateID); err != nil {
+		return nil, err
+	}
+
+	result, err := s.db.ExecContext(ctx, "DELETE FROM project_templates WHERE id = $1", templateID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete project template: %v", err)
+	}
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return nil, status.Error(codes.NotFound, "project template not found")
+	}
+
+	return &organization.DeleteProjectTemplateResponse{
+		Message: "Project template deleted successfully",
+	}, nil
+}
+
+// CreateProjectFromTemplate creates a project with the template's teams,
+// workspace and milestones in one transaction, then asks the task service
+// for its default tasks. A task that cannot be created is reported instead
+// of undoing the project.
+func (s *OrganizationService) CreateProjectFromTemplate(ctx context.Context, req *organization.CreateProjectFromTemplateRequest) (*organization.CreateProjectFromTemplateResponse, error) {
+	templateID, err := uuid.Parse(req.TemplateId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid template_id")
+	}
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if err := s.authorizeRow(ctx, authz.ProjectCreate, "project_templates", templateID); err != nil {
+		return nil, err
+	}
+	tmpl, def, err := s.loadProjectTemplate(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	var managerID *uuid.UUID
+	if req.ProjectManagerId != "" {
+		id, err := uuid.Parse(req.ProjectManagerId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid project_manager_id")
+		}
+		managerID = &id
+	}
+	now := time.Now()
+	startDate := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if req.StartDate != "" {
+		startDate, err = time.Parse("2006-01-02", req.StartDate)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "start_date must be formatted as YYYY-MM-DD")
+		}
+	}
+	var endDate *time.Time
+	if def.DurationDays > 0 {
+		end := startDate.AddDate(0, 0, int(def.DurationDays))
+		endDate = &end
+	}
+	description := req.Description
+	if description == "" && tmpl.Description != nil {
+		description = *tmpl.Description
+	}
+	priority := def.Priority
+	if priority == "" {
+		priority = "medium"
+	}
+
+	milestones := make([]models.ProjectMilestone, 0, len(def.Milestones))
+	for _, m := range def.Milestones {
+		milestones = append(milestones, models.ProjectMilestone{
+			Name:        m.Name,
+			Description: m.Description,
+			DueDate:     startDate.AddDate(0, 0, int(m.OffsetDays)).Format("2006-01-02"),
+		})
+	}
+	meta := map[string]interface{}{"template_id": tmpl.ID.String()}
+	if len(milestones) > 0 {
+		meta["milestones"] = milestones
+	}
+	projectMetadata, err := json.Marshal(meta)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode project metadata: %v", err)
+	}
+	var createdBy *uuid.UUID
+	if id, err := uuid.Parse(callerUserID(ctx)); err == nil {
+		createdBy = &id
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to start transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	projectID := uuid.New()
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO projects (id, org_id, name, description, project_manager_id, status, priority, start_date, end_date, budget, progress, metadata, created_at, updated_at, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $13, $14)
+	`, projectID, tmpl.OrgID, name, description, managerID, "planning", priority,
+		startDate, endDate, def.Budget, 0, string(projectMetadata), now, createdBy)
+	if isUniqueViolation(err) {
+		return nil, status.Error(codes.AlreadyExists, "a project with this name already exists")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create project: %v", err)
+	}
+
+	// teams deleted since the template was saved are skipped
+	var teamIDs []string
+	for _, id := range def.TeamIDs {
+		result, err := tx.ExecContext(ctx, `
+			INSERT INTO project_teams (id, project_id, team_id, assigned_at, assigned_by)
+			SELECT $1, $2, id, $3, $4 FROM teams WHERE id = $5 AND org_id = $6
+			ON CONFLICT (project_id, team_id) DO NOTHING
+		`, uuid.New(), projectID, now, createdBy, id, tmpl.OrgID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to assign team to project: %v", err)
+		}
+		if n, _ := result.RowsAffected(); n > 0 {
+			teamIDs = append(teamIDs, id)
+		}
+	}
+
+	var workspaceID *uuid.UUID
+	if def.Workspace != nil {
+		id := uuid.New()
+		settings := def.Workspace.Settings
+		if settings == "" {
+			settings = "{}"
+		}
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO workspaces (id, org_id, name, description, workspace_type, project_id, owner_id, settings, is_private, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $10)
+		`, id, tmpl.OrgID, name, def.Workspace.Description, def.Workspace.WorkspaceType, projectID,
+			createdBy, settings, def.Workspace.IsPrivate, now)
+		if isUniqueViolation(err) {
+			return nil, status.Error(codes.AlreadyExists, "a workspace with this project's name already exists")
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create workspace: %v", err)
+		}
+		if createdBy != nil {
+			if err := addWorkspaceOwner(ctx, tx, id, *createdBy, now); err != nil {
+				return nil, err
+			}
+		}
+		workspaceID = &id
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create project: %v", err)
+	}
+
+	resp := &organization.CreateProjectFromTemplateResponse{}
+	created, failed := s.createTemplateTasks(ctx, projectID, startDate, teamIDs, def.Tasks)
+	resp.TasksCreated = created
+	resp.FailedTasks = failed
+
+	projectResp, err := s.GetProject(ctx, &organization.GetProjectRequest{ProjectId: projectID.String()})
+	if err != nil {
+		return nil, err
+	}
+	resp.Project = projectResp.Project
+	if workspaceID != nil {
+		wsResp, err := s.GetWorkspace(ctx, &organization.GetWorkspaceRequest{WorkspaceId: workspaceID.String()})
+		if err != nil {
+			return nil, err
+		}
+		resp.Workspace = wsResp.Workspace
+	}
+
+	resp.Message = "Project created from template successfully"
+	if len(failed) > 0 {
+		resp.Message = fmt.Sprintf("Project created from template; %d of %d default tasks could not be created", len(failed), len(def.Tasks))
+	}
+	return resp, nil
+}
+
+// createTemplateTasks creates a new project's default tasks as the caller,
+// so the task service applies its usual rules. Tasks go to the project's
+// first team, since members may not create unassigned tasks.
+func (s *OrganizationService) createTemplateTasks(ctx context.Context, projectID uuid.UUID, startDate time.Time, teamIDs []string, tasks []templateTask) (int32, []string) {
+	if len(tasks) == 0 {
+		return 0, nil
+	}
+	var failed []string
+	if s.tasks == nil {
+		log.Printf("warning: no task service configured; skipping %d default tasks of project %s", len(tasks), projectID)
+		for _, t := range tasks {
+			failed = append(failed, t.Title)
+		}
+		return 0, failed
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		ctx = metadata.NewOutgoingContext(ctx, md)
+	}
+	var teamID string
+	if len(teamIDs) > 0 {
+		teamID = teamIDs[0]
+	}
+	var created int32
+	for _, t := range tasks {
+		req := &taskpb.CreateTaskRequest{
+			Title:       t.Title,
+			Description: t.Description,
+			Priority:    taskPriorities[t.Priority],
+			Tags:        t.Tags,
+			TeamId:      teamID,
+			ProjectId:   projectID.String(),
+		}
+		if t.DueOffsetDays > 0 {
+			req.DueDate = timestamppb.New(startDate.AddDate(0, 0, int(t.DueOffsetDays)))
+		}
+		if _, err := s.tasks.CreateTask(ctx, req); err != nil {
+			log.Printf("failed to create default task %q for project %s: %v", t.Title, projectID, err)
+			failed = append(failed, t.Title)
+			continue
+		}
+		created++
+	}
+	return created, failed
+}
+
+func (s *OrganizationService) loadProjectTemplate(ctx context.Context, templateID uuid.UUID) (*models.ProjectTemplate, *projectTemplateDefinition, error) {
+	var tmpl models.ProjectTemplate
+	err := s.db.QueryRowContext(ctx, `
+		SELECT id, org_id, name, description, source_project_id, definition, created_by, created_at, updated_at
+		FROM project_templates WHERE id = $1
+	`, templateID).Scan(&tmpl.ID, &tmpl.OrgID, &tmpl.Name, &tmpl.Description, &tmpl.SourceProjectID,
+		&tmpl.Definition, &tmpl.CreatedBy, &tmpl.CreatedAt, &tmpl.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil, status.Error(codes.NotFound, "project template not found")
+	}
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get project template: %v", err)
+	}
+	var def projectTemplateDefinition
+	if err := json.Unmarshal([]byte(tmpl.Definition), &def); err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to decode project template: %v", err)
+	}
+	return &tmpl, &def, nil
+}
+
+// projectTasks reads a project's tasks for use as template defaults. Due
+// dates are kept relative to the project start when it has one.
+func (s *OrganizationService) projectTasks(ctx context.Context, projectID uuid.UUID, startDate sql.NullTime) ([]templateTask, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT title, COALESCE(description, ''), priority, COALESCE(tags, ''), due_date
+		FROM tasks WHERE project_id = $1
+		ORDER BY created_at
+	`, projectID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get project tasks: %v", err)
+	}
+	defer rows.Close()
+
+	var tasks []templateTask
+	for rows.Next() {
+		var t templateTask
+		var tags string
+		var dueDate sql.NullTime
+		if err := rows.Scan(&t.Title, &t.Description, &t.Priority, &tags, &dueDate); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan project task: %v", err)
+		}
+		for _, tag := range strings.Split(tags, ",") {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				t.Tags = append(t.Tags, tag)
+			}
+		}
+		if dueDate.Valid && startDate.Valid && dueDate.Time.After(startDate.Time) {
+			t.DueOffsetDays = daysBetween(startDate.Time, dueDate.Time)
+		}
+		tasks = append(tasks, t)
+	}
+	return tasks, rows.Err()
+}
+
+func templateTaskFromProto(t *organization.ProjectTemplateTask) (templateTask, error) {
+	if strings.TrimSpace(t.Title) == "" {
+		return templateTask{}, status.Error(codes.InvalidArgument, "task title is required")
+	}
+	if t.DueOffsetDays < 0 {
+		return templateTask{}, status.Error(codes.InvalidArgument, "task due_offset_days must not be negative")
+	}
+	priority := strings.ToLower(t.Priority)
+	if priority == "" {
+		priority = "medium"
+	}
+	if _, ok := taskPriorities[priority]; !ok {
+		return templateTask{}, status.Errorf(codes.InvalidArgument, "invalid task priority %q", t.Priority)
+	}
+	return templateTask{
+		Title:         t.Title,
+		Description:   t.Description,
+		Priority:      priority,
+		Tags:          t.Tags,
+		DueOffsetDays: t.DueOffsetDays,
+	}, nil
+}
+
+// projectMilestones converts the milestones in a project's metadata to
+// offsets from its start. Malformed metadata yields none.
+func projectMilestones(projectMetadata string, startDate time.Time) []templateMilestone {
+	var meta struct {
+		Milestones []models.ProjectMilestone `json:"milestones"`
+	}
+	if err := json.Unmarshal([]byte(projectMetadata), &meta); err != nil {
+		return nil
+	}
+	var milestones []templateMilestone
+	for _, m := range meta.Milestones {
+		due, err := time.Parse("2006-01-02", m.DueDate)
+		if err != nil || m.Name == "" {
+			continue
+		}
+		var offset int32
+		if due.After(startDate) {
+			offset = daysBetween(startDate, due)
+		}
+		milestones = append(milestones, templateMilestone{Name: m.Name, Description: m.Description, OffsetDays: offset})
+	}
+	return milestones
+}
+
+func projectTemplateToProto(tmpl *models.ProjectTemplate, def *projectTemplateDefinition) *organization.ProjectTemplate {
+	pb := &organization.ProjectTemplate{
+		Id:           tmpl.ID.String(),
+		OrgId:        tmpl.OrgID.String(),
+		Name:         tmpl.Name,
+		Priority:     def.Priority,
+		Budget:       def.Budget,
+		DurationDays: def.DurationDays,
+		TeamIds:      def.TeamIDs,
+		CreatedAt:    timestamppb.New(tmpl.CreatedAt),
+		UpdatedAt:    timestamppb.New(tmpl.UpdatedAt),
+	}
+	if tmpl.Description != nil {
+		pb.Description = *tmpl.Description
+	}
+	if tmpl.SourceProjectID != nil {
+		pb.SourceProjectId = tmpl.SourceProjectID.String()
+	}
+	if tmpl.CreatedBy != nil {
+		pb.CreatedBy = tmpl.CreatedBy.String()
+	}
+	if def.Workspace != nil {
+		pb.Workspace = &organization.ProjectTemplateWorkspace{
+			WorkspaceType: def.Workspace.WorkspaceType,
+			Description:   def.Workspace.Description,
+			Settings:      def.Workspace.Settings,
+			IsPrivate:     def.Workspace.IsPrivate,
+		}
+	}
+	for _, t := range def.Tasks {
+		pb.Tasks = append(pb.Tasks, &organization.ProjectTemplateTask{
+			Title:         t.Title,
+			Description:   t.Description,
+			Priority:      t.Priority,
+			Tags:          t.Tags,
+			DueOffsetDays: t.DueOffsetDays,
+		})
+	}
+	for _, m := range def.Milestones {
+		pb.Milestones = append(pb.Milestones, &organization.ProjectTemplateMilestone{
+			Name:        m.Name,
+			Description: m.Description,
+			OffsetDays:  m.OffsetDays,
+		})
+	}
+	return pb
+}
+
+func daysBetween(from, to time.Time) int32 {
+	return int32(to.Sub(from).Hours() / 24)
+}
+
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505"
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
+	"github.com/chanduchitikam/task-management-system/proto/organization"
+	"github.com/chanduchitikam/task-management-system/services/org/models"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetProjectTimeline returns everything a Gantt chart of the project needs:
+// its dates and milestones, its tasks' start and due dates, and the
+// dependencies between those tasks
+func (s *OrganizationService) GetProjectTimeline(ctx context.Context, req *organization.GetProjectTimelineRequest) (*organization.GetProjectTimelineResponse, error) {
+	projectID, err := uuid.Parse(req.ProjectId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid project_id")
+	}
+	if err := s.authorizeRow(ctx, authz.MemberView, "projects", projectID); err != nil {
+		return nil, err
+	}
+
+	var name, projectMetadata string
+	var startDate, endDate sql.NullTime
+	err = s.db.QueryRowContext(ctx, "SELECT name, start_date, end_date, metadata FROM projects WHERE id = $1", projectID).
+		Scan(&name, &startDate, &endDate, &projectMetadata)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "project not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get project: %v", err)
+	}
+
+	resp := &organization.GetProjectTimelineResponse{ProjectId: projectID.String(), Name: name}
+	if startDate.Valid {
+		resp.StartDate = startDate.Time.Format("2006-01-02")
+	}
+	if endDate.Valid {
+		resp.EndDate = endDate.Time.Format("2006-01-02")
+	}
+	var meta struct {
+		Milestones []models.ProjectMilestone `json:"milestones"`
+	}
+	if err := json.Unmarshal([]byte(projectMetadata), &meta); err == nil {
+		for _, m := range meta.Milestones {
+			resp.Milestones = append(resp.Milestones, milestoneToProto(m))
+		}
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id, COALESCE(task_key, ''), title, status, priority, assigned_to, team_id,
+		       COALESCE(started_at, created_at), due_date, completed_at, is_overdue
+		FROM tasks
+		WHERE project_id = $1
+		ORDER BY COALESCE(started_at, created_at) ASC
+	`, projectID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get project tasks: %v", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var t organization.TimelineTask
+		var assignedTo, teamID sql.NullString
+		var start, dueDate, completedAt sql.NullTime
+		err := rows.Scan(&t.TaskId, &t.TaskKey, &t.Title, &t.Status, &t.Priority, &assignedTo, &teamID,
+			&start, &dueDate, &completedAt, &t.IsOverdue)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan task: %v", err)
+		}
+		t.AssignedTo = assignedTo.String
+		t.TeamId = teamID.String
+		if start.Valid {
+			t.Start = timestamppb.New(start.Time)
+		}
+		if dueDate.Valid {
+			t.DueDate = timestamppb.New(dueDate.Time)
+		}
+		if completedAt.Valid {
+			t.CompletedAt = timestamppb.New(completedAt.Time)
+		}
+		resp.Tasks = append(resp.Tasks, &t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get project tasks: %v", err)
+	}
+
+	depRows, err := s.db.QueryContext(ctx, `
+		SELECT d.task_id, d.depends_on_id
+		FROM task_dependencies d
+		JOIN tasks t ON t.id = d.task_id
+		JOIN tasks dep ON dep.id = d.depends_on_id
+		WHERE t.project_id = $1 AND dep.project_id = $1
+		ORDER BY d.created_at ASC
+	`, projectID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get task dependencies: %v", err)
+	}
+	defer depRows.Close()
+	for depRows.Next() {
+		var d organization.TimelineDependency
+		if err := depRows.Scan(&d.TaskId, &d.DependsOnId); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan task dependency: %v", err)
+		}
+		resp.Dependencies = append(resp.Dependencies, &d)
+	}
+	if err := depRows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get task dependencies: %v", err)
+	}
+
+	return resp, nil
+}
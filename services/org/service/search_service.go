@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
+	"github.com/chanduchitikam/task-management-system/proto/organization"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+)
+
+// searchSources are the entity types SearchOrganization looks through. $1 is
+// the org id and $2 the escaped query; workspaces also take the caller's user
+// id as $4.
+var searchSources = []struct {
+	kind  string
+	query string
+}{
+	{"team", `
+		SELECT 'team', id::text, name, COALESCE(description, ''), ` + searchScore("name", "description") + `
+		FROM teams WHERE org_id = $1`},
+	{"project", `
+		SELECT 'project', id::text, name, COALESCE(description, ''), ` + searchScore("name", "description") + `
+		FROM projects WHERE org_id = $1`},
+	{"group", `
+		SELECT 'group', id::text, name, COALESCE(description, ''), ` + searchScore("name", "description") + `
+		FROM groups WHERE org_id = $1`},
+	// private workspaces are found only by their members, as in ListWorkspaces
+	{"workspace", `
+		SELECT 'workspace', id::text, name, COALESCE(description, ''), ` + searchScore("name", "description") + `
+		FROM workspaces
+		WHERE org_id = $1
+		  AND (is_private = false OR EXISTS (
+			SELECT 1 FROM workspace_members wm
+			WHERE wm.workspace_id = workspaces.id AND wm.user_id = $4 AND wm.is_active = true
+		  ))`},
+	{"member", `
+		SELECT 'member', u.id::text, COALESCE(NULLIF(u.full_name, ''), u.username), u.email,
+		       GREATEST(` + searchScore("u.full_name", "u.email") + `, ` + searchScore("u.username", "u.email") + `)
+		FROM users u
+		WHERE u.org_id = $1 OR EXISTS (
+			SELECT 1 FROM organization_memberships om WHERE om.user_id = u.id AND om.org_id = $1
+		)`},
+}
+
+// searchScore ranks a row by how well its name column matches the query: an
+// exact match beats a prefix, a prefix beats a word inside the name, and a
+// hit in the secondary column alone ranks lowest. Rows that match nowhere
+// score 0 and are dropped.
+func searchScore(name, secondary string) string {
+	return fmt.Sprintf(`CASE
+			WHEN %[1]s ILIKE $2 THEN 100
+			WHEN %[1]s ILIKE $2 || '%%' THEN 75
+			WHEN %[1]s ILIKE '%% ' || $2 || '%%' THEN 60
+			WHEN %[1]s ILIKE '%%' || $2 || '%%' THEN 50
+			WHEN %[2]s ILIKE '%%' || $2 || '%%' THEN 10
+			ELSE 0 END`, name, secondary)
+}
+
+// SearchOrganization looks for the query across the organization's teams,
+// projects, groups, workspaces and members and returns one ranked list
+func (s *OrganizationService) SearchOrganization(ctx context.Context, req *organization.SearchOrganizationRequest) (*organization.SearchOrganizationResponse, error) {
+	orgID, err := uuid.Parse(req.OrgId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid org_id")
+	}
+	q := strings.TrimSpace(req.Query)
+	if q == "" {
+		return nil, status.Error(codes.InvalidArgument, "query is required")
+	}
+	if !s.callerCan(ctx, authz.MemberView, orgID.String(), "", uuid.Nil) {
+		return nil, status.Error(codes.PermissionDenied, "access denied")
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+	if limit > maxSearchLimit {
+		limit = maxSearchLimit
+	}
+
+	wanted := make(map[string]bool, len(req.Types))
+	for _, t := range req.Types {
+		wanted[strings.ToLower(t)] = true
+	}
+	var parts []string
+	args := []interface{}{orgID, escapeLike(q), limit}
+	for _, src := range searchSources {
+		if len(wanted) == 0 || wanted[src.kind] {
+			parts = append(parts, src.query)
+			delete(wanted, src.kind)
+			if src.kind == "workspace" {
+				args = append(args, callerUUID(ctx))
+			}
+		}
+	}
+	for t := range wanted {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown result type %q", t)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT type, id, name, description, score FROM (%s) AS results (type, id, name, description, score)
+		WHERE score > 0
+		ORDER BY score DESC, name ASC
+		LIMIT $3
+	`, strings.Join(parts, "\n\t\tUNION ALL"))
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search organization: %v", err)
+	}
+	defer rows.Close()
+
+	resp := &organization.SearchOrganizationResponse{}
+	for rows.Next() {
+		var r organization.SearchResult
+		if err := rows.Scan(&r.Type, &r.Id, &r.Name, &r.Description, &r.Score); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan search result: %v", err)
+		}
+		resp.Results = append(resp.Results, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search organization: %v", err)
+	}
+	return resp, nil
+}
+
+// escapeLike makes LIKE wildcards in s match literally
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// What deleting a team or project does with its open tasks
+const (
+	taskPolicyDetach   = "detach"
+	taskPolicyReassign = "reassign"
+	taskPolicyBlock    = "block"
+)
+
+// openTaskCondition matches tasks that are neither completed nor cancelled
+const openTaskCondition = "status NOT IN ('completed', 'cancelled')"
+
+// deleteWithTaskPolicy deletes row id of table (teams or projects) and, in the
+// same transaction, applies policy to the tasks whose taskColumn points at
+// it. Every task is detached from the row; reassign also hands its open tasks
+// to fallbackOwner, and block refuses while any open task remains.
+func (s *OrganizationService) deleteWithTaskPolicy(ctx context.Context, table, taskColumn string, id uuid.UUID, policy, fallbackOwner string) (reassigned, detached int64, err error) {
+	name := singular(table)
+	if policy == "" {
+		policy = taskPolicyDetach
+	}
+
+	var fallbackID uuid.UUID
+	switch policy {
+	case taskPolicyDetach, taskPolicyBlock:
+	case taskPolicyReassign:
+		if fallbackID, err = uuid.Parse(fallbackOwner); err != nil {
+			return 0, 0, status.Error(codes.InvalidArgument, "fallback_owner_id is required to reassign tasks")
+		}
+	default:
+		return 0, 0, status.Error(codes.InvalidArgument, "task_policy must be detach, reassign or block")
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, 0, status.Errorf(codes.Internal, "failed to delete %s: %v", name, err)
+	}
+	defer tx.Rollback()
+
+	var orgID uuid.UUID
+	err = tx.QueryRowContext(ctx, fmt.Sprintf("SELECT org_id FROM %s WHERE id = $1 FOR UPDATE", table), id).Scan(&orgID)
+	if err == sql.ErrNoRows {
+		return 0, 0, status.Errorf(codes.NotFound, "%s not found", name)
+	}
+	if err != nil {
+		return 0, 0, status.Errorf(codes.Internal, "failed to delete %s: %v", name, err)
+	}
+
+	now := time.Now()
+	switch policy {
+	case taskPolicyBlock:
+		var open int
+		err := tx.QueryRowContext(ctx,
+			fmt.Sprintf("SELECT COUNT(*) FROM tasks WHERE %s = $1 AND %s", taskColumn, openTaskCondition), id,
+		).Scan(&open)
+		if err != nil {
+			return 0, 0, status.Errorf(codes.Internal, "failed to count open tasks: %v", err)
+		}
+		if open > 0 {
+			return 0, 0, status.Errorf(codes.FailedPrecondition, "%s has %d open tasks", name, open)
+		}
+	case taskPolicyReassign:
+		member, err := s.isOrgMember(ctx, orgID, fallbackID)
+		if err != nil {
+			return 0, 0, err
+		}
+		if !member {
+			return 0, 0, status.Error(codes.FailedPrecondition, "fallback owner is not a member of this organization")
+		}
+		result, err := tx.ExecContext(ctx,
+			fmt.Sprintf("UPDATE tasks SET assigned_to = $1, updated_at = $2 WHERE %s = $3 AND %s", taskColumn, openTaskCondition),
+			fallbackID, now, id)
+		if err != nil {
+			return 0, 0, status.Errorf(codes.Internal, "failed to reassign tasks: %v", err)
+		}
+		reassigned, _ = result.RowsAffected()
+	}
+
+	result, err := tx.ExecContext(ctx,
+		fmt.Sprintf("UPDATE tasks SET %s = NULL, updated_at = $1 WHERE %s = $2", taskColumn, taskColumn), now, id)
+	if err != nil {
+		return 0, 0, status.Errorf(codes.Internal, "failed to detach tasks: %v", err)
+	}
+	detached, _ = result.RowsAffected()
+
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE id = $1", table), id); err != nil {
+		return 0, 0, status.Errorf(codes.Internal, "failed to delete %s: %v", name, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, 0, status.Errorf(codes.Internal, "failed to delete %s: %v", name, err)
+	}
+	return reassigned, detached, nil
+}
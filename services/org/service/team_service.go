@@ -6,7 +6,10 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
+	notificationpb "github.com/chanduchitikam/task-management-system/proto/notification"
 	"github.com/chanduchitikam/task-management-system/proto/organization"
+	taskpb "github.com/chanduchitikam/task-management-system/proto/task"
 	"github.com/chanduchitikam/task-management-system/services/org/models"
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
@@ -16,13 +19,28 @@ import (
 
 type OrganizationService struct {
 	organization.UnimplementedOrganizationServiceServer
-	db *sql.DB
+	db            *sql.DB
+	tasks         taskpb.TaskServiceClient
+	notifications notificationpb.NotificationServiceClient
 }
 
 func NewOrganizationService(db *sql.DB) *OrganizationService {
 	return &OrganizationService{db: db}
 }
 
+// SetTaskClient lets project templates create their default tasks through
+// the task service. Without it templates still create projects, without
+// tasks.
+func (s *OrganizationService) SetTaskClient(client taskpb.TaskServiceClient) {
+	s.tasks = client
+}
+
+// SetNotificationClient lets the service remind project managers about
+// missing status reports
+func (s *OrganizationService) SetNotificationClient(client notificationpb.NotificationServiceClient) {
+	s.notifications = client
+}
+
 // ============================================================================
 // TEAM MANAGEMENT
 // ============================================================================
@@ -314,6 +332,14 @@ func (s *OrganizationService) UpdateTeam(ctx context.Context, req *organization.
 		args = append(args, req.Status)
 		argCount++
 	}
+	if req.Metadata != "" {
+		if err := validateMetadata(metadataTeam, req.Metadata); err != nil {
+			return nil, err
+		}
+		query += fmt.Sprintf(", metadata = $%d", argCount)
+		args = append(args, req.Metadata)
+		argCount++
+	}
 
 	query += fmt.Sprintf(" WHERE id = $%d", argCount)
 	args = append(args, teamID)
@@ -340,19 +366,19 @@ func (s *OrganizationService) DeleteTeam(ctx context.Context, req *organization.
 		return nil, status.Error(codes.InvalidArgument, "invalid team_id")
 	}
 
-	query := "DELETE FROM teams WHERE id = $1"
-	result, err := s.db.ExecContext(ctx, query, teamID)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete team: %v", err)
+	if err := s.authorizeRow(ctx, authz.TeamManage, "teams", teamID); err != nil {
+		return nil, err
 	}
 
-	rows, _ := result.RowsAffected()
-	if rows == 0 {
-		return nil, status.Error(codes.NotFound, "team not found")
+	reassigned, detached, err := s.deleteWithTaskPolicy(ctx, "teams", "team_id", teamID, req.TaskPolicy, req.FallbackOwnerId)
+	if err != nil {
+		return nil, err
 	}
 
 	return &organization.DeleteTeamResponse{
-		Message: "Team deleted successfully",
+		Message:         "Team deleted successfully",
+		TasksReassigned: int32(reassigned),
+		TasksDetached:   int32(detached),
 	}, nil
 }
 
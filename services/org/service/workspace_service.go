@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
 	"github.com/chanduchitikam/task-management-system/proto/organization"
 	"github.com/chanduchitikam/task-management-system/services/org/models"
 	"github.com/google/uuid"
@@ -22,6 +23,10 @@ func (s *OrganizationService) GetWorkspace(ctx context.Context, req *organizatio
 		return nil, status.Error(codes.InvalidArgument, "invalid workspace_id")
 	}
 
+	if _, _, err := s.workspaceAccess(ctx, workspaceID); err != nil {
+		return nil, err
+	}
+
 	query := `
 		SELECT id, org_id, name, description, workspace_type, team_id, project_id, owner_id, settings, is_private, created_at, updated_at
 		FROM workspaces
@@ -75,6 +80,10 @@ func (s *OrganizationService) UpdateWorkspace(ctx context.Context, req *organiza
 		return nil, status.Error(codes.InvalidArgument, "invalid workspace_id")
 	}
 
+	if _, _, err := s.workspaceAccess(ctx, workspaceID); err != nil {
+		return nil, err
+	}
+
 	query := "UPDATE workspaces SET updated_at = $1"
 	args := []interface{}{time.Now()}
 	argCount := 2
@@ -95,6 +104,9 @@ func (s *OrganizationService) UpdateWorkspace(ctx context.Context, req *organiza
 		argCount++
 	}
 	if req.Settings != "" {
+		if err := validateMetadata(metadataWorkspace, req.Settings); err != nil {
+			return nil, err
+		}
 		query += fmt.Sprintf(", settings = $%d", argCount)
 		args = append(args, req.Settings)
 		argCount++
@@ -125,6 +137,10 @@ func (s *OrganizationService) DeleteWorkspace(ctx context.Context, req *organiza
 		return nil, status.Error(codes.InvalidArgument, "invalid workspace_id")
 	}
 
+	if err := s.authorizeRow(ctx, authz.OrgManage, "workspaces", workspaceID); err != nil {
+		return nil, err
+	}
+
 	query := "DELETE FROM workspaces WHERE id = $1"
 	result, err := s.db.ExecContext(ctx, query, workspaceID)
 	if err != nil {
@@ -140,3 +156,267 @@ func (s *OrganizationService) DeleteWorkspace(ctx context.Context, req *organiza
 		Message: "Workspace deleted successfully",
 	}, nil
 }
+
+// Workspace roles. Owners and admins manage a workspace's members.
+const (
+	workspaceRoleOwner  = "owner"
+	workspaceRoleAdmin  = "admin"
+	workspaceRoleMember = "member"
+	workspaceRoleViewer = "viewer"
+)
+
+var workspaceRoles = map[string]bool{
+	workspaceRoleOwner:  true,
+	workspaceRoleAdmin:  true,
+	workspaceRoleMember: true,
+	workspaceRoleViewer: true,
+}
+
+// addWorkspaceOwner makes userID an owner of a workspace being created
+func addWorkspaceOwner(ctx context.Context, tx *sql.Tx, workspaceID, userID uuid.UUID, now time.Time) error {
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO workspace_members (id, workspace_id, user_id, role, joined_at, is_active, added_by)
+		VALUES ($1, $2, $3, $4, $5, true, $3)
+		ON CONFLICT (workspace_id, user_id) DO UPDATE SET is_active = true, role = $4, left_at = NULL
+	`, uuid.New(), workspaceID, userID, workspaceRoleOwner, now)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to add workspace owner: %v", err)
+	}
+	return nil
+}
+
+// workspaceAccess loads the workspace's organization and the caller's role
+// in it. Private workspaces are reported as not found to non-members.
+func (s *OrganizationService) workspaceAccess(ctx context.Context, workspaceID uuid.UUID) (orgID uuid.UUID, memberRole string, err error) {
+	var isPrivate bool
+	var role sql.NullString
+	err = s.db.QueryRowContext(ctx, `
+		SELECT w.org_id, w.is_private, wm.role
+		FROM workspaces w
+		LEFT JOIN workspace_members wm ON wm.workspace_id = w.id AND wm.user_id = $2 AND wm.is_active = true
+		WHERE w.id = $1
+	`, workspaceID, callerUUID(ctx)).Scan(&orgID, &isPrivate, &role)
+	if err == sql.ErrNoRows || (err == nil && isPrivate && !role.Valid) {
+		return uuid.Nil, "", status.Error(codes.NotFound, "workspace not found")
+	}
+	if err != nil {
+		return uuid.Nil, "", status.Errorf(codes.Internal, "failed to get workspace: %v", err)
+	}
+	return orgID, role.String, nil
+}
+
+// authorizeWorkspaceMembers checks the caller may change who belongs to a
+// workspace: its owners and admins, or whoever manages the organization
+func (s *OrganizationService) authorizeWorkspaceMembers(ctx context.Context, workspaceID uuid.UUID) (uuid.UUID, error) {
+	var orgID uuid.UUID
+	err := s.db.QueryRowContext(ctx, "SELECT org_id FROM workspaces WHERE id = $1", workspaceID).Scan(&orgID)
+	if err == sql.ErrNoRows {
+		return uuid.Nil, status.Error(codes.NotFound, "workspace not found")
+	}
+	if err != nil {
+		return uuid.Nil, status.Errorf(codes.Internal, "failed to get workspace: %v", err)
+	}
+	if s.callerCan(ctx, authz.OrgManage, orgID.String(), "", uuid.Nil) {
+		return orgID, nil
+	}
+	_, memberRole, err := s.workspaceAccess(ctx, workspaceID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if memberRole != workspaceRoleOwner && memberRole != workspaceRoleAdmin {
+		return uuid.Nil, status.Error(codes.PermissionDenied, "access denied")
+	}
+	return orgID, nil
+}
+
+func (s *OrganizationService) AddWorkspaceMember(ctx context.Context, req *organization.AddWorkspaceMemberRequest) (*organization.AddWorkspaceMemberResponse, error) {
+	workspaceID, err := uuid.Parse(req.WorkspaceId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid workspace_id")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	role := req.Role
+	if role == "" {
+		role = workspaceRoleMember
+	}
+	if !workspaceRoles[role] {
+		return nil, status.Error(codes.InvalidArgument, "role must be one of owner, admin, member, viewer")
+	}
+
+	orgID, err := s.authorizeWorkspaceMembers(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	member, err := s.isOrgMember(ctx, orgID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !member {
+		return nil, status.Error(codes.FailedPrecondition, "user is not a member of this organization")
+	}
+
+	// demoting the last owner would leave nobody in charge of the workspace
+	if role != workspaceRoleOwner {
+		if err := s.keepWorkspaceOwner(ctx, workspaceID, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	query := `
+		INSERT INTO workspace_members (id, workspace_id, user_id, role, joined_at, is_active, added_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (workspace_id, user_id) DO UPDATE
+		SET is_active = true, role = $4, left_at = NULL
+	`
+	callerID := callerUUID(ctx)
+	var addedBy *uuid.UUID
+	if callerID != uuid.Nil {
+		addedBy = &callerID
+	}
+	_, err = s.db.ExecContext(ctx, query, uuid.New(), workspaceID, userID, role, time.Now(), true, addedBy)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to add workspace member: %v", err)
+	}
+
+	members, err := s.getWorkspaceMembers(ctx, workspaceID, &userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(members) == 0 {
+		return nil, status.Error(codes.NotFound, "workspace member not found")
+	}
+
+	return &organization.AddWorkspaceMemberResponse{
+		Member:  members[0],
+		Message: "Member added to workspace successfully",
+	}, nil
+}
+
+func (s *OrganizationService) RemoveWorkspaceMember(ctx context.Context, req *organization.RemoveWorkspaceMemberRequest) (*organization.RemoveWorkspaceMemberResponse, error) {
+	workspaceID, err := uuid.Parse(req.WorkspaceId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid workspace_id")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	// members may always leave; removing others takes an owner or admin
+	if userID != callerUUID(ctx) {
+		if _, err := s.authorizeWorkspaceMembers(ctx, workspaceID); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.keepWorkspaceOwner(ctx, workspaceID, userID); err != nil {
+		return nil, err
+	}
+
+	query := "UPDATE workspace_members SET is_active = false, left_at = $1 WHERE workspace_id = $2 AND user_id = $3 AND is_active = true"
+	result, err := s.db.ExecContext(ctx, query, time.Now(), workspaceID, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to remove workspace member: %v", err)
+	}
+
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return nil, status.Error(codes.NotFound, "workspace member not found")
+	}
+
+	return &organization.RemoveWorkspaceMemberResponse{
+		Message: "Member removed from workspace successfully",
+	}, nil
+}
+
+func (s *OrganizationService) ListWorkspaceMembers(ctx context.Context, req *organization.ListWorkspaceMembersRequest) (*organization.ListWorkspaceMembersResponse, error) {
+	workspaceID, err := uuid.Parse(req.WorkspaceId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid workspace_id")
+	}
+
+	if _, _, err := s.workspaceAccess(ctx, workspaceID); err != nil {
+		return nil, err
+	}
+
+	members, err := s.getWorkspaceMembers(ctx, workspaceID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &organization.ListWorkspaceMembersResponse{Members: members}, nil
+}
+
+// keepWorkspaceOwner refuses to remove or demote userID when they are the
+// workspace's only owner
+func (s *OrganizationService) keepWorkspaceOwner(ctx context.Context, workspaceID, userID uuid.UUID) error {
+	var isOwner bool
+	var owners int
+	err := s.db.QueryRowContext(ctx, `
+		SELECT COALESCE(BOOL_OR(user_id = $2), false), COUNT(*)
+		FROM workspace_members
+		WHERE workspace_id = $1 AND role = $3 AND is_active = true
+	`, workspaceID, userID, workspaceRoleOwner).Scan(&isOwner, &owners)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check workspace owners: %v", err)
+	}
+	if isOwner && owners == 1 {
+		return status.Error(codes.FailedPrecondition, "a workspace must keep at least one owner")
+	}
+	return nil
+}
+
+// getWorkspaceMembers lists a workspace's active members, or only userID
+// when it is given
+func (s *OrganizationService) getWorkspaceMembers(ctx context.Context, workspaceID uuid.UUID, userID *uuid.UUID) ([]*organization.WorkspaceMember, error) {
+	query := `
+		SELECT wm.id, wm.workspace_id, wm.user_id, wm.role, wm.joined_at, wm.is_active,
+		       u.full_name, u.email, u.username
+		FROM workspace_members wm
+		JOIN users u ON wm.user_id = u.id
+		WHERE wm.workspace_id = $1 AND wm.is_active = true
+	`
+	args := []interface{}{workspaceID}
+	if userID != nil {
+		query += " AND wm.user_id = $2"
+		args = append(args, *userID)
+	}
+	query += " ORDER BY wm.joined_at ASC"
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace members: %v", err)
+	}
+	defer rows.Close()
+
+	var members []*organization.WorkspaceMember
+	for rows.Next() {
+		var member models.WorkspaceMember
+		err := rows.Scan(
+			&member.ID, &member.WorkspaceID, &member.UserID, &member.Role, &member.JoinedAt, &member.IsActive,
+			&member.FullName, &member.Email, &member.Username,
+		)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan workspace member: %v", err)
+		}
+
+		members = append(members, &organization.WorkspaceMember{
+			Id:          member.ID.String(),
+			WorkspaceId: member.WorkspaceID.String(),
+			UserId:      member.UserID.String(),
+			Role:        member.Role,
+			JoinedAt:    timestamppb.New(member.JoinedAt),
+			IsActive:    member.IsActive,
+			FullName:    member.FullName,
+			Email:       member.Email,
+			Username:    member.Username,
+		})
+	}
+
+	return members, nil
+}
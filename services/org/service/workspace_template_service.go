@@ -0,0 +1,415 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
+	"github.com/chanduchitikam/task-management-system/proto/organization"
+	"github.com/chanduchitikam/task-management-system/services/org/models"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ============================================================================
+// WORKSPACE TEMPLATES
+// ============================================================================
+
+// workspaceTemplateDefinition is the blueprint stored in
+// workspace_templates.definition. Boards end up in the new workspace's
+// settings; groups are created next to it.
+type workspaceTemplateDefinition struct {
+	WorkspaceType string                  `json:"workspace_type"`
+	Settings      string                  `json:"settings,omitempty"`
+	IsPrivate     bool                    `json:"is_private"`
+	Boards        []models.WorkspaceBoard `json:"boards,omitempty"`
+	Groups        []templateGroup         `json:"groups,omitempty"`
+}
+
+type templateGroup struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	GroupType   string `json:"group_type,omitempty"`
+}
+
+func (s *OrganizationService) CreateWorkspaceTemplate(ctx context.Context, req *organization.CreateWorkspaceTemplateRequest) (*organization.CreateWorkspaceTemplateResponse, error) {
+	orgID, err := uuid.Parse(req.OrgId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid org_id")
+	}
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if !s.callerCan(ctx, authz.TeamManage, orgID.String(), "", uuid.Nil) {
+		return nil, status.Error(codes.PermissionDenied, "access denied")
+	}
+
+	def := workspaceTemplateDefinition{
+		WorkspaceType: req.WorkspaceType,
+		IsPrivate:     req.IsPrivate,
+	}
+	if def.WorkspaceType == "" {
+		def.WorkspaceType = "general"
+	}
+	for _, b := range req.Boards {
+		def.Boards = append(def.Boards, models.WorkspaceBoard{Name: strings.TrimSpace(b.Name), Columns: b.Columns})
+	}
+	seen := make(map[string]bool, len(req.Groups))
+	for _, g := range req.Groups {
+		groupName := strings.TrimSpace(g.Name)
+		if groupName == "" {
+			return nil, status.Error(codes.InvalidArgument, "group name is required")
+		}
+		if seen[strings.ToLower(groupName)] {
+			return nil, status.Errorf(codes.InvalidArgument, "group %q is listed twice", groupName)
+		}
+		seen[strings.ToLower(groupName)] = true
+		def.Groups = append(def.Groups, templateGroup{Name: groupName, Description: g.Description, GroupType: g.GroupType})
+	}
+	if strings.TrimSpace(req.Settings) != "" {
+		def.Settings = req.Settings
+	}
+	// store the settings a workspace will get, so a bad template fails now
+	// rather than on every use
+	settings, err := def.workspaceSettings()
+	if err != nil {
+		return nil, err
+	}
+	if err := validateMetadata(metadataWorkspace, settings); err != nil {
+		return nil, err
+	}
+	def.Settings = settings
+
+	definition, err := json.Marshal(def)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode template: %v", err)
+	}
+	var createdBy *uuid.UUID
+	if id := callerUUID(ctx); id != uuid.Nil {
+		createdBy = &id
+	}
+	tmpl := models.WorkspaceTemplate{
+		ID:          uuid.New(),
+		OrgID:       orgID,
+		Name:        name,
+		Description: &req.Description,
+		Definition:  string(definition),
+		CreatedBy:   createdBy,
+	}
+	err = s.db.QueryRowContext(ctx, `
+		INSERT INTO workspace_templates (id, org_id, name, description, definition, created_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $7)
+		RETURNING created_at, updated_at
+	`, tmpl.ID, tmpl.OrgID, tmpl.Name, tmpl.Description, tmpl.Definition, tmpl.CreatedBy, time.Now(),
+	).Scan(&tmpl.CreatedAt, &tmpl.UpdatedAt)
+	if isUniqueViolation(err) {
+		return nil, status.Error(codes.AlreadyExists, "a workspace template with this name already exists")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create workspace template: %v", err)
+	}
+
+	return &organization.CreateWorkspaceTemplateResponse{
+		Template: workspaceTemplateToProto(&tmpl, &def),
+		Message:  "Workspace template created successfully",
+	}, nil
+}
+
+func (s *OrganizationService) GetWorkspaceTemplate(ctx context.Context, req *organization.GetWorkspaceTemplateRequest) (*organization.GetWorkspaceTemplateResponse, error) {
+	templateID, err := uuid.Parse(req.TemplateId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid template_id")
+	}
+	if err := s.authorizeRow(ctx, authz.MemberView, "workspace_templates", templateID); err != nil {
+		return nil, err
+	}
+
+	tmpl, def, err := s.loadWorkspaceTemplate(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+	return &organization.GetWorkspaceTemplateResponse{Template: workspaceTemplateToProto(tmpl, def)}, nil
+}
+
+func (s *OrganizationService) ListWorkspaceTemplates(ctx context.Context, req *organization.ListWorkspaceTemplatesRequest) (*organization.ListWorkspaceTemplatesResponse, error) {
+	orgID, err := uuid.Parse(req.OrgId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid org_id")
+	}
+	if !s.callerCan(ctx, authz.MemberView, orgID.String(), "", uuid.Nil) {
+		return nil, status.Error(codes.PermissionDenied, "access denied")
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id, org_id, name, description, definition, created_by, created_at, updated_at
+		FROM workspace_templates
+		WHERE org_id = $1
+		ORDER BY name ASC
+	`, orgID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list workspace templates: %v", err)
+	}
+	defer rows.Close()
+
+	var templates []*organization.WorkspaceTemplate
+	for rows.Next() {
+		var tmpl models.WorkspaceTemplate
+		err := rows.Scan(&tmpl.ID, &tmpl.OrgID, &tmpl.Name, &tmpl.Description, &tmpl.Definition,
+			&tmpl.CreatedBy, &tmpl.CreatedAt, &tmpl.UpdatedAt)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan workspace template: %v", err)
+		}
+		var def workspaceTemplateDefinition
+		if err := json.Unmarshal([]byte(tmpl.Definition), &def); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to decode workspace template %s: %v", tmpl.ID, err)
+		}
+		templates = append(templates, workspaceTemplateToProto(&tmpl, &def))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list workspace templates: %v", err)
+	}
+
+	return &organization.ListWorkspaceTemplatesResponse{
+		Templates: templates,
+		Total:     int32(len(templates)),
+	}, nil
+}
+
+func (s *OrganizationService) DeleteWorkspaceTemplate(ctx context.Context, req *organization.DeleteWorkspaceTemplateRequest) (*organization.DeleteWorkspaceTemplateResponse, error) {
+	templateID, err := uuid.Parse(req.TemplateId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid template_id")
+	}
+	if err := s.authorizeRow(ctx, authz.TeamManage, "workspace_templates", templateID); err != nil {
+		return nil, err
+	}
+
+	result, err := s.db.ExecContext(ctx, "DELETE FROM workspace_templates WHERE id = $1", templateID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete workspace template: %v", err)
+	}
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return nil, status.Error(codes.NotFound, "workspace template not found")
+	}
+
+	return &organization.DeleteWorkspaceTemplateResponse{
+		Message: "Workspace template deleted successfully",
+	}, nil
+}
+
+// CreateWorkspaceFromTemplate creates a workspace with the template's type,
+// settings and boards, and the template's groups named after the new
+// workspace, all in one transaction. The caller owns the workspace and its
+// groups.
+func (s *OrganizationService) CreateWorkspaceFromTemplate(ctx context.Context, req *organization.CreateWorkspaceFromTemplateRequest) (*organization.CreateWorkspaceFromTemplateResponse, error) {
+	templateID, err := uuid.Parse(req.TemplateId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid template_id")
+	}
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if err := s.authorizeRow(ctx, authz.MemberView, "workspace_templates", templateID); err != nil {
+		return nil, err
+	}
+	tmpl, def, err := s.loadWorkspaceTemplate(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	teamID, err := s.templateTarget(ctx, "teams", req.TeamId, tmpl.OrgID)
+	if err != nil {
+		return nil, err
+	}
+	projectID, err := s.templateTarget(ctx, "projects", req.ProjectId, tmpl.OrgID)
+	if err != nil {
+		return nil, err
+	}
+	settings, err := def.workspaceSettings()
+	if err != nil {
+		return nil, err
+	}
+	if err := validateMetadata(metadataWorkspace, settings); err != nil {
+		return nil, err
+	}
+	description := req.Description
+	if description == "" && tmpl.Description != nil {
+		description = *tmpl.Description
+	}
+	var ownerID *uuid.UUID
+	if id := callerUUID(ctx); id != uuid.Nil {
+		ownerID = &id
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to start transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	workspaceID := uuid.New()
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO workspaces (id, org_id, name, description, workspace_type, team_id, project_id, owner_id, settings, is_private, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $11)
+	`, workspaceID, tmpl.OrgID, name, description, def.WorkspaceType, teamID, projectID, ownerID,
+		settings, def.IsPrivate, now)
+	if isUniqueViolation(err) {
+		return nil, status.Error(codes.AlreadyExists, "a workspace with this name already exists")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create workspace: %v", err)
+	}
+	if ownerID != nil {
+		if err := addWorkspaceOwner(ctx, tx, workspaceID, *ownerID, now); err != nil {
+			return nil, err
+		}
+	}
+
+	groupMetadata, err := json.Marshal(models.GroupMetadata{WorkspaceID: workspaceID.String()})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode group metadata: %v", err)
+	}
+	groupIDs := make([]uuid.UUID, 0, len(def.Groups))
+	for _, g := range def.Groups {
+		groupName := fmt.Sprintf("%s %s", name, g.Name)
+		groupType := g.GroupType
+		if groupType == "" {
+			groupType = "functional"
+		}
+		groupID := uuid.New()
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO groups (id, org_id, name, description, group_type, owner_id, status, metadata, created_at, updated_at, created_by)
+			VALUES ($1, $2, $3, $4, $5, $6, 'active', $7, $8, $8, $6)
+		`, groupID, tmpl.OrgID, groupName, g.Description, groupType, ownerID, string(groupMetadata), now)
+		if isUniqueViolation(err) {
+			return nil, status.Errorf(codes.AlreadyExists, "a group named %q already exists", groupName)
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create group: %v", err)
+		}
+		groupIDs = append(groupIDs, groupID)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create workspace: %v", err)
+	}
+
+	resp := &organization.CreateWorkspaceFromTemplateResponse{Message: "Workspace created from template successfully"}
+	wsResp, err := s.GetWorkspace(ctx, &organization.GetWorkspaceRequest{WorkspaceId: workspaceID.String()})
+	if err != nil {
+		return nil, err
+	}
+	resp.Workspace = wsResp.Workspace
+	for _, id := range groupIDs {
+		groupResp, err := s.GetGroup(ctx, &organization.GetGroupRequest{GroupId: id.String()})
+		if err != nil {
+			return nil, err
+		}
+		resp.Groups = append(resp.Groups, groupResp.Group)
+	}
+	return resp, nil
+}
+
+// templateTarget parses the optional team or project a workspace made from
+// a template belongs to, and checks it is part of orgID
+func (s *OrganizationService) templateTarget(ctx context.Context, table, id string, orgID uuid.UUID) (*uuid.UUID, error) {
+	if id == "" {
+		return nil, nil
+	}
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid %s_id", singular(table))
+	}
+	var owner uuid.UUID
+	err = s.db.QueryRowContext(ctx, fmt.Sprintf("SELECT org_id FROM %s WHERE id = $1", table), parsed).Scan(&owner)
+	if err == sql.ErrNoRows || (err == nil && owner != orgID) {
+		return nil, status.Errorf(codes.NotFound, "%s not found", singular(table))
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get %s: %v", singular(table), err)
+	}
+	return &parsed, nil
+}
+
+// workspaceSettings is the settings JSON of a workspace made from the
+// template: its settings with its boards under "boards"
+func (d *workspaceTemplateDefinition) workspaceSettings() (string, error) {
+	settings := map[string]json.RawMessage{}
+	if d.Settings != "" {
+		if err := json.Unmarshal([]byte(d.Settings), &settings); err != nil || settings == nil {
+			return "", status.Error(codes.InvalidArgument, "settings must be a JSON object")
+		}
+	}
+	if len(d.Boards) > 0 {
+		boards, err := json.Marshal(d.Boards)
+		if err != nil {
+			return "", status.Errorf(codes.Internal, "failed to encode boards: %v", err)
+		}
+		settings["boards"] = boards
+	}
+	encoded, err := json.Marshal(settings)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to encode settings: %v", err)
+	}
+	return string(encoded), nil
+}
+
+func (s *OrganizationService) loadWorkspaceTemplate(ctx context.Context, templateID uuid.UUID) (*models.WorkspaceTemplate, *workspaceTemplateDefinition, error) {
+	var tmpl models.WorkspaceTemplate
+	err := s.db.QueryRowContext(ctx, `
+		SELECT id, org_id, name, description, definition, created_by, created_at, updated_at
+		FROM workspace_templates WHERE id = $1
+	`, templateID).Scan(&tmpl.ID, &tmpl.OrgID, &tmpl.Name, &tmpl.Description, &tmpl.Definition,
+		&tmpl.CreatedBy, &tmpl.CreatedAt, &tmpl.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil, status.Error(codes.NotFound, "workspace template not found")
+	}
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get workspace template: %v", err)
+	}
+	var def workspaceTemplateDefinition
+	if err := json.Unmarshal([]byte(tmpl.Definition), &def); err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to decode workspace template: %v", err)
+	}
+	return &tmpl, &def, nil
+}
+
+func workspaceTemplateToProto(tmpl *models.WorkspaceTemplate, def *workspaceTemplateDefinition) *organization.WorkspaceTemplate {
+	pb := &organization.WorkspaceTemplate{
+		Id:            tmpl.ID.String(),
+		OrgId:         tmpl.OrgID.String(),
+		Name:          tmpl.Name,
+		WorkspaceType: def.WorkspaceType,
+		Settings:      def.Settings,
+		IsPrivate:     def.IsPrivate,
+		CreatedAt:     timestamppb.New(tmpl.CreatedAt),
+		UpdatedAt:     timestamppb.New(tmpl.UpdatedAt),
+	}
+	if tmpl.Description != nil {
+		pb.Description = *tmpl.Description
+	}
+	if tmpl.CreatedBy != nil {
+		pb.CreatedBy = tmpl.CreatedBy.String()
+	}
+	for _, b := range def.Boards {
+		pb.Boards = append(pb.Boards, &organization.WorkspaceTemplateBoard{Name: b.Name, Columns: b.Columns})
+	}
+	for _, g := range def.Groups {
+		pb.Groups = append(pb.Groups, &organization.WorkspaceTemplateGroup{
+			Name:        g.Name,
+			Description: g.Description,
+			GroupType:   g.GroupType,
+		})
+	}
+	return pb
+}
@@ -1,15 +1,24 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
 	"net/http"
+	"os"
+	"time"
 
+	"github.com/chanduchitikam/task-management-system/migrations"
+	"github.com/chanduchitikam/task-management-system/pkg/auth"
 	"github.com/chanduchitikam/task-management-system/pkg/cache"
+	"github.com/chanduchitikam/task-management-system/pkg/certs"
 	"github.com/chanduchitikam/task-management-system/pkg/config"
 	"github.com/chanduchitikam/task-management-system/pkg/database"
+	"github.com/chanduchitikam/task-management-system/pkg/encryption"
+	organizationpb "github.com/chanduchitikam/task-management-system/proto/organization"
 	taskpb "github.com/chanduchitikam/task-management-system/proto/task"
+	userpb "github.com/chanduchitikam/task-management-system/proto/user"
 	"github.com/chanduchitikam/task-management-system/services/task/models"
 	"github.com/chanduchitikam/task-management-system/services/task/service"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -23,6 +32,9 @@ func main() {
 	if err != nil {
 		log.Fatalf("Failed to load config: %v", err)
 	}
+	if err := cfg.Validate(); err != nil {
+		log.Fatalf("Invalid config: %v", err)
+	}
 
 	// 	// 	// Connect to database
 	db, err := database.NewPostgresConnection(cfg.Database.GetDSN())
@@ -30,10 +42,18 @@ func main() {
 		log.Fatalf("Failed to connect to database: %v", err)
 	}
 
-	// 	// 	// Auto-migrate models
-	if err := database.AutoMigrate(db, &models.Task{}); err != nil {
+	// Bring the schema up to date, then check it has everything the models
+	// use
+	sqlDB, err := db.DB()
+	if err != nil {
+		log.Fatalf("Failed to get database instance: %v", err)
+	}
+	if err := migrations.Prepare(sqlDB, "task", cfg.Database.MigrateOnStart); err != nil {
 		log.Fatalf("Failed to migrate database: %v", err)
 	}
+	if err := database.CheckModels(db, &models.Task{}, &models.TaskKeySequence{}, &models.Webhook{}, &models.WebhookDelivery{}, &models.TaskWatcher{}, &models.Board{}, &models.BoardColumn{}, &models.TaskReminder{}, &models.TaskComment{}, &models.TaskDependency{}, &models.CalendarFeedSecret{}); err != nil {
+		log.Fatalf("Database schema drift: %v", err)
+	}
 
 	// 	// 	// Connect to Redis
 	redisClient, err := cache.NewRedisClient(
@@ -45,13 +65,81 @@ func main() {
 		log.Fatalf("Failed to connect to Redis: %v", err)
 	}
 
-	// 	// 	// Create gRPC server
-	grpcServer := grpc.NewServer()
+	taskService := service.NewTaskService(db, redisClient)
+
+	// Calendar feed tokens are signed with the keys the gateway trusts
+	feedTokens, err := auth.NewJWTManagerFromConfig(cfg.JWT)
+	if err != nil {
+		log.Fatalf("Invalid JWT config: %v", err)
+	}
+	taskService.SetFeedTokens(feedTokens)
+
+	// Service traffic is TLS once certificates are configured
+	serverCreds, err := certs.ServerCredentials(cfg.TLS)
+	if err != nil {
+		log.Fatalf("Invalid TLS config: %v", err)
+	}
+	dialCreds, err := certs.DialCredentials(cfg.TLS)
+	if err != nil {
+		log.Fatalf("Invalid TLS config: %v", err)
+	}
+
+	// 	// 	// Create gRPC server; guests only reach their project's tasks
+	grpcServer := grpc.NewServer(serverCreds, grpc.UnaryInterceptor(taskService.GuestInterceptor()))
 
 	// 	// 	// Register TaskService
-	taskService := service.NewTaskService(db, redisClient)
 	taskpb.RegisterTaskServiceServer(grpcServer, taskService)
 
+	// Validate assignees against the organization service
+	orgServiceAddr := os.Getenv("ORG_SERVICE_ADDR")
+	if orgServiceAddr == "" {
+		orgServiceAddr = fmt.Sprintf("localhost:%d", cfg.Server.GRPCPort+3)
+	}
+	orgConn, err := grpc.NewClient(orgServiceAddr, grpc.WithTransportCredentials(dialCreds))
+	if err != nil {
+		log.Fatalf("Failed to create organization service client: %v", err)
+	}
+	defer orgConn.Close()
+	taskService.SetMembershipVerifier(
+		service.NewOrgServiceMembership(organizationpb.NewOrganizationServiceClient(orgConn), 30*time.Second),
+		os.Getenv("ASSIGNEE_REQUIRE_TEAM_MEMBERSHIP") == "1",
+	)
+
+	// Resolve @mentions against the user service
+	userServiceAddr := os.Getenv("USER_SERVICE_ADDR")
+	if userServiceAddr == "" {
+		userServiceAddr = fmt.Sprintf("localhost:%d", cfg.Server.GRPCPort)
+	}
+	userConn, err := grpc.NewClient(userServiceAddr, grpc.WithTransportCredentials(dialCreds))
+	if err != nil {
+		log.Fatalf("Failed to create user service client: %v", err)
+	}
+	defer userConn.Close()
+	taskService.SetMentionResolver(service.NewUserServiceResolver(userpb.NewUserServiceClient(userConn)))
+
+	// Webhook secrets are sealed once a key is configured; secrets stored
+	// earlier are sealed in the background
+	secrets, err := encryption.FromConfig(cfg.Encryption)
+	if err != nil {
+		log.Fatalf("Invalid encryption config: %v", err)
+	}
+	if secrets != nil {
+		taskService.SetEncryption(secrets)
+		go taskService.ResealWebhookSecrets(context.Background())
+	}
+
+	// Deliver queued webhook events in the background
+	go taskService.RunWebhookWorker(context.Background())
+
+	// Flag overdue tasks and notify their owners
+	go taskService.RunOverdueWorker(context.Background())
+
+	// Publish personal task reminders as they come due
+	go taskService.RunReminderWorker(context.Background())
+
+	// Revoke the calendar feeds of users removed from an org
+	go taskService.RunFeedRevocations(context.Background())
+
 	// 	// 	// Register reflection
 	reflection.Register(grpcServer)
 
@@ -0,0 +1,60 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Board groups a project's or team's tasks into status columns
+type Board struct {
+	ID        string        `gorm:"primaryKey;type:uuid" json:"id"`
+	OrgID     string        `gorm:"type:uuid;not null;index" json:"org_id"`
+	ProjectID *string       `gorm:"type:uuid;index;default:null" json:"project_id,omitempty"`
+	TeamID    *string       `gorm:"type:uuid;index;default:null" json:"team_id,omitempty"`
+	Name      string        `gorm:"not null" json:"name"`
+	CreatedBy string        `gorm:"type:uuid;not null" json:"created_by"`
+	CreatedAt time.Time     `json:"created_at"`
+	UpdatedAt time.Time     `json:"updated_at"`
+	Columns   []BoardColumn `gorm:"foreignKey:BoardID;constraint:OnDelete:CASCADE" json:"columns"`
+}
+
+// BeforeCreate hook to generate UUID
+func (b *Board) BeforeCreate(tx *gorm.DB) error {
+	if b.ID == "" {
+		b.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// TableName specifies the table name
+func (Board) TableName() string {
+	return "task_boards"
+}
+
+// BoardColumn maps a board column to a task status. A WIPLimit of zero means
+// the column is unlimited.
+type BoardColumn struct {
+	ID        string    `gorm:"primaryKey;type:uuid" json:"id"`
+	BoardID   string    `gorm:"type:uuid;not null;index" json:"board_id"`
+	Name      string    `gorm:"not null" json:"name"`
+	Status    string    `gorm:"not null" json:"status"`
+	Position  int       `gorm:"not null;default:0" json:"position"`
+	WIPLimit  int       `gorm:"column:wip_limit;not null;default:0" json:"wip_limit"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// BeforeCreate hook to generate UUID
+func (c *BoardColumn) BeforeCreate(tx *gorm.DB) error {
+	if c.ID == "" {
+		c.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// TableName specifies the table name
+func (BoardColumn) TableName() string {
+	return "task_board_columns"
+}
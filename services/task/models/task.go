@@ -19,10 +19,20 @@ type Task struct {
 	CreatedBy   string     `gorm:"type:uuid;not null" json:"created_by"`
 	TeamID      *string    `gorm:"type:uuid;default:null" json:"team_id,omitempty"`
 	GroupID     *string    `gorm:"type:uuid;default:null" json:"group_id,omitempty"`
+	ProjectID   *string    `gorm:"type:uuid;index;default:null" json:"project_id,omitempty"`
+	TaskKey     string     `gorm:"index" json:"task_key"` // Human-friendly key, e.g. "WEB-42"
 	DueDate     *time.Time `json:"due_date,omitempty"`
+	StartedAt   *time.Time `json:"started_at,omitempty"`                // First moved to in_progress
+	CompletedAt *time.Time `gorm:"index" json:"completed_at,omitempty"` // Last moved to completed
 	CreatedAt   time.Time  `json:"created_at"`
 	UpdatedAt   time.Time  `json:"updated_at"`
 	Tags        string     `gorm:"type:text" json:"tags"` // Stored as comma-separated values
+	Version     int64      `gorm:"not null;default:1" json:"version"`
+
+	// Maintained by the overdue job; OverdueNotifiedAt makes the overdue
+	// notification fire once per task.
+	IsOverdue         bool       `gorm:"not null;default:false;index" json:"is_overdue"`
+	OverdueNotifiedAt *time.Time `json:"overdue_notified_at,omitempty"`
 }
 
 // // // BeforeCreate hook to generate UUID
@@ -37,3 +47,110 @@ func (t *Task) BeforeCreate(tx *gorm.DB) error {
 func (Task) TableName() string {
 	return "tasks"
 }
+
+// TaskKeySequence holds the last issued task number for a key prefix.
+// Sequences are scoped to the project, or to the org (the creator for
+// personal tasks) for tasks outside one, so a key resolves to a single task
+// wherever it can be looked up.
+type TaskKeySequence struct {
+	ScopeID   string    `gorm:"primaryKey" json:"scope_id"`
+	Prefix    string    `gorm:"primaryKey" json:"prefix"`
+	LastValue int64     `gorm:"not null;default:0" json:"last_value"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TableName specifies the table name
+func (TaskKeySequence) TableName() string {
+	return "task_key_sequences"
+}
+
+// TaskWatcher records a user following a task they are not necessarily assigned to
+type TaskWatcher struct {
+	TaskID    string    `gorm:"primaryKey;type:uuid" json:"task_id"`
+	UserID    string    `gorm:"primaryKey;type:uuid;index" json:"user_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TableName specifies the table name
+func (TaskWatcher) TableName() string {
+	return "task_watchers"
+}
+
+// TaskReminder is a user's personal reminder on a task. Absolute reminders set
+// RemindAt; relative ones set BeforeDueMinutes and follow the task's due date.
+// FireAt is the resolved time the scheduler polls on.
+type TaskReminder struct {
+	ID               string     `gorm:"primaryKey;type:uuid" json:"id"`
+	TaskID           string     `gorm:"type:uuid;not null;index" json:"task_id"`
+	UserID           string     `gorm:"type:uuid;not null;index" json:"user_id"`
+	RemindAt         *time.Time `json:"remind_at,omitempty"`
+	BeforeDueMinutes int        `gorm:"not null;default:0" json:"before_due_minutes"`
+	FireAt           time.Time  `gorm:"not null;index" json:"fire_at"`
+	SentAt           *time.Time `gorm:"index" json:"sent_at,omitempty"`
+	CreatedAt        time.Time  `json:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+}
+
+// BeforeCreate hook to generate UUID
+func (r *TaskReminder) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == "" {
+		r.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// TableName specifies the table name
+func (TaskReminder) TableName() string {
+	return "task_reminders"
+}
+
+// TaskComment is a discussion entry on a task
+type TaskComment struct {
+	ID        string    `gorm:"primaryKey;type:uuid" json:"id"`
+	TaskID    string    `gorm:"type:uuid;not null;index" json:"task_id"`
+	AuthorID  string    `gorm:"type:uuid;not null" json:"author_id"`
+	Body      string    `gorm:"type:text;not null" json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// BeforeCreate hook to generate UUID
+func (c *TaskComment) BeforeCreate(tx *gorm.DB) error {
+	if c.ID == "" {
+		c.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// TableName specifies the table name
+func (TaskComment) TableName() string {
+	return "task_comments"
+}
+
+// TaskDependency records that TaskID cannot start until DependsOnID is done
+type TaskDependency struct {
+	TaskID      string    `gorm:"primaryKey;type:uuid" json:"task_id"`
+	DependsOnID string    `gorm:"primaryKey;type:uuid;index" json:"depends_on_id"`
+	CreatedBy   string    `gorm:"type:uuid;not null" json:"created_by"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// TableName specifies the table name
+func (TaskDependency) TableName() string {
+	return "task_dependencies"
+}
+
+// CalendarFeedSecret is mixed into a user's calendar feed tokens, so
+// replacing it revokes the feed URLs handed out before. Users who never
+// rotated their feed have no row.
+type CalendarFeedSecret struct {
+	UserID    string    `gorm:"primaryKey;type:uuid" json:"user_id"`
+	Secret    string    `gorm:"not null" json:"-"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TableName specifies the table name
+func (CalendarFeedSecret) TableName() string {
+	return "calendar_feed_secrets"
+}
@@ -0,0 +1,69 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Webhook is an org-scoped subscription to task events
+type Webhook struct {
+	ID         string    `gorm:"primaryKey;type:uuid" json:"id"`
+	OrgID      string    `gorm:"type:uuid;not null;index" json:"org_id"`
+	URL        string    `gorm:"not null" json:"url"`
+	Secret     string    `gorm:"not null" json:"-"`
+	EventTypes string    `gorm:"type:text" json:"event_types"` // Stored as comma-separated values; empty means all
+	Active     bool      `gorm:"not null;default:true" json:"active"`
+	CreatedBy  string    `gorm:"type:uuid;not null" json:"created_by"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// BeforeCreate hook to generate UUID
+func (w *Webhook) BeforeCreate(tx *gorm.DB) error {
+	if w.ID == "" {
+		w.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// TableName specifies the table name
+func (Webhook) TableName() string {
+	return "task_webhooks"
+}
+
+// Webhook delivery states
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliverySucceeded = "succeeded"
+	WebhookDeliveryFailed    = "failed"
+)
+
+// WebhookDelivery is a single queued attempt to notify a webhook of an event
+type WebhookDelivery struct {
+	ID            string    `gorm:"primaryKey;type:uuid" json:"id"`
+	WebhookID     string    `gorm:"type:uuid;not null;index" json:"webhook_id"`
+	EventType     string    `gorm:"not null" json:"event_type"`
+	Payload       string    `gorm:"type:text;not null" json:"payload"`
+	Status        string    `gorm:"not null;default:'pending';index:idx_webhook_deliveries_due,priority:1" json:"status"`
+	Attempts      int       `gorm:"not null;default:0" json:"attempts"`
+	NextAttemptAt time.Time `gorm:"not null;index:idx_webhook_deliveries_due,priority:2" json:"next_attempt_at"`
+	LastError     string    `gorm:"type:text" json:"last_error,omitempty"`
+	ResponseCode  int       `json:"response_code,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// BeforeCreate hook to generate UUID
+func (d *WebhookDelivery) BeforeCreate(tx *gorm.DB) error {
+	if d.ID == "" {
+		d.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// TableName specifies the table name
+func (WebhookDelivery) TableName() string {
+	return "task_webhook_deliveries"
+}
@@ -0,0 +1,356 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
+	taskpb "github.com/chanduchitikam/task-management-system/proto/task"
+	"github.com/chanduchitikam/task-management-system/services/task/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+const maxBoardColumns = 20
+
+// CreateBoard creates a board for a project or team in the caller's org
+func (s *TaskService) CreateBoard(ctx context.Context, req *taskpb.CreateBoardRequest) (*taskpb.CreateBoardResponse, error) {
+	userID, orgID, role := s.extractAuth(ctx)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	if orgID == "" || !authz.Can(role, authz.OrgManage) {
+		return nil, status.Error(codes.PermissionDenied, "only org admins can manage boards")
+	}
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if (req.ProjectId == "") == (req.TeamId == "") {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of project_id or team_id is required")
+	}
+
+	columns, err := s.buildBoardColumns(req.Columns, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	board := &models.Board{
+		OrgID:     orgID,
+		Name:      req.Name,
+		CreatedBy: userID,
+		Columns:   columns,
+	}
+	if req.ProjectId != "" {
+		board.ProjectID = &req.ProjectId
+	}
+	if req.TeamId != "" {
+		board.TeamID = &req.TeamId
+	}
+	if err := s.db.Create(board).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to create board")
+	}
+
+	pb, err := s.boardToProto(board)
+	if err != nil {
+		return nil, err
+	}
+	return &taskpb.CreateBoardResponse{Board: pb, Message: "Board created successfully"}, nil
+}
+
+// GetBoard returns a board with per-column task counts
+func (s *TaskService) GetBoard(ctx context.Context, req *taskpb.GetBoardRequest) (*taskpb.GetBoardResponse, error) {
+	board, err := s.findBoard(ctx, req.BoardId)
+	if err != nil {
+		return nil, err
+	}
+	pb, err := s.boardToProto(board)
+	if err != nil {
+		return nil, err
+	}
+	return &taskpb.GetBoardResponse{Board: pb}, nil
+}
+
+// ListBoards lists the boards in the caller's org
+func (s *TaskService) ListBoards(ctx context.Context, req *taskpb.ListBoardsRequest) (*taskpb.ListBoardsResponse, error) {
+	userID, orgID, _ := s.extractAuth(ctx)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	if orgID == "" {
+		return &taskpb.ListBoardsResponse{}, nil
+	}
+
+	query := s.db.Preload("Columns", orderBoardColumns).Where("org_id = ?", orgID)
+	if req.ProjectId != "" {
+		query = query.Where("project_id = ?", req.ProjectId)
+	}
+	if req.TeamId != "" {
+		query = query.Where("team_id = ?", req.TeamId)
+	}
+
+	var boards []models.Board
+	if err := query.Order("name ASC").Find(&boards).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to list boards")
+	}
+
+	resp := &taskpb.ListBoardsResponse{Boards: make([]*taskpb.Board, len(boards))}
+	for i := range boards {
+		pb, err := s.boardToProto(&boards[i])
+		if err != nil {
+			return nil, err
+		}
+		resp.Boards[i] = pb
+	}
+	return resp, nil
+}
+
+// UpdateBoard renames a board and/or replaces its columns
+func (s *TaskService) UpdateBoard(ctx context.Context, req *taskpb.UpdateBoardRequest) (*taskpb.UpdateBoardResponse, error) {
+	_, _, role := s.extractAuth(ctx)
+	if !authz.Can(role, authz.OrgManage) {
+		return nil, status.Error(codes.PermissionDenied, "only org admins can manage boards")
+	}
+	board, err := s.findBoard(ctx, req.BoardId)
+	if err != nil {
+		return nil, err
+	}
+
+	var columns []models.BoardColumn
+	if len(req.Columns) > 0 {
+		if columns, err = s.buildBoardColumns(req.Columns, board); err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if req.Name != "" {
+			board.Name = req.Name
+		}
+		if err := tx.Model(board).Update("name", board.Name).Error; err != nil {
+			return err
+		}
+		if columns == nil {
+			return nil
+		}
+		if err := tx.Where("board_id = ?", board.ID).Delete(&models.BoardColumn{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Create(&columns).Error; err != nil {
+			return err
+		}
+		board.Columns = columns
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to update board")
+	}
+
+	pb, err := s.boardToProto(board)
+	if err != nil {
+		return nil, err
+	}
+	return &taskpb.UpdateBoardResponse{Board: pb, Message: "Board updated successfully"}, nil
+}
+
+// DeleteBoard removes a board and its columns; tasks are unaffected
+func (s *TaskService) DeleteBoard(ctx context.Context, req *taskpb.DeleteBoardRequest) (*taskpb.DeleteBoardResponse, error) {
+	_, _, role := s.extractAuth(ctx)
+	if !authz.Can(role, authz.OrgManage) {
+		return nil, status.Error(codes.PermissionDenied, "only org admins can manage boards")
+	}
+	board, err := s.findBoard(ctx, req.BoardId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("board_id = ?", board.ID).Delete(&models.BoardColumn{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(board).Error
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to delete board")
+	}
+
+	return &taskpb.DeleteBoardResponse{Message: "Board deleted successfully"}, nil
+}
+
+// checkWIPLimit rejects moving a task into newStatus when any board covering
+// the task's project or team has a full column for that status. The task
+// itself is not counted, so re-saving a task in a full column is allowed.
+func (s *TaskService) checkWIPLimit(task *models.Task, newStatus string) error {
+	if task.OrgID == nil || (task.ProjectID == nil && task.TeamID == nil) {
+		return nil
+	}
+
+	query := s.db.Preload("Columns", "status = ? AND wip_limit > 0", newStatus).
+		Where("org_id = ?", *task.OrgID)
+	switch {
+	case task.ProjectID != nil && task.TeamID != nil:
+		query = query.Where("project_id = ? OR team_id = ?", *task.ProjectID, *task.TeamID)
+	case task.ProjectID != nil:
+		query = query.Where("project_id = ?", *task.ProjectID)
+	default:
+		query = query.Where("team_id = ?", *task.TeamID)
+	}
+
+	var boards []models.Board
+	if err := query.Find(&boards).Error; err != nil {
+		return status.Error(codes.Internal, "failed to load boards")
+	}
+
+	for i := range boards {
+		for _, col := range boards[i].Columns {
+			count := boardTasksQuery(s.db, &boards[i]).Where("status = ?", newStatus)
+			if task.ID != "" {
+				count = count.Where("id <> ?", task.ID)
+			}
+			var n int64
+			if err := count.Count(&n).Error; err != nil {
+				return status.Error(codes.Internal, "failed to count board tasks")
+			}
+			if n >= int64(col.WIPLimit) {
+				return status.Errorf(codes.FailedPrecondition, "column %q on board %q is at its WIP limit of %d", col.Name, boards[i].Name, col.WIPLimit)
+			}
+		}
+	}
+	return nil
+}
+
+// findBoard loads a board in the caller's org
+func (s *TaskService) findBoard(ctx context.Context, boardID string) (*models.Board, error) {
+	if boardID == "" {
+		return nil, status.Error(codes.InvalidArgument, "board_id is required")
+	}
+	userID, orgID, _ := s.extractAuth(ctx)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	if orgID == "" {
+		return nil, status.Error(codes.NotFound, "board not found")
+	}
+
+	var board models.Board
+	if err := s.db.Preload("Columns", orderBoardColumns).
+		Where("id = ? AND org_id = ?", boardID, orgID).
+		First(&board).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "board not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to find board")
+	}
+	return &board, nil
+}
+
+// buildBoardColumns validates requested columns, in display order. With no
+// columns a board gets one per task status. Column IDs are kept only when they
+// already belong to board, so updates don't churn IDs clients hold.
+func (s *TaskService) buildBoardColumns(in []*taskpb.BoardColumn, board *models.Board) ([]models.BoardColumn, error) {
+	if len(in) == 0 {
+		columns := make([]models.BoardColumn, len(boardColumns))
+		for i, st := range boardColumns {
+			columns[i] = models.BoardColumn{Name: boardColumnTitles[st], Status: st, Position: i}
+		}
+		return columns, nil
+	}
+	if len(in) > maxBoardColumns {
+		return nil, status.Errorf(codes.InvalidArgument, "a board can have at most %d columns", maxBoardColumns)
+	}
+
+	existing := map[string]bool{}
+	if board != nil {
+		for _, c := range board.Columns {
+			existing[c.ID] = true
+		}
+	}
+
+	seen := map[string]bool{}
+	columns := make([]models.BoardColumn, 0, len(in))
+	for i, c := range in {
+		if c.Status == taskpb.TaskStatus_TASK_STATUS_UNSPECIFIED {
+			return nil, status.Error(codes.InvalidArgument, "every column needs a status")
+		}
+		st := s.statusToString(c.Status)
+		if seen[st] {
+			return nil, status.Errorf(codes.InvalidArgument, "status %s is mapped to more than one column", st)
+		}
+		seen[st] = true
+		if c.WipLimit < 0 {
+			return nil, status.Error(codes.InvalidArgument, "wip_limit cannot be negative")
+		}
+
+		col := models.BoardColumn{
+			Name:     c.Name,
+			Status:   st,
+			Position: i,
+			WIPLimit: int(c.WipLimit),
+		}
+		if col.Name == "" {
+			col.Name = boardColumnTitles[st]
+		}
+		if existing[c.ColumnId] {
+			col.ID = c.ColumnId
+		}
+		if board != nil {
+			col.BoardID = board.ID
+		}
+		columns = append(columns, col)
+	}
+	return columns, nil
+}
+
+// boardTasksQuery selects the tasks a board displays
+func boardTasksQuery(db *gorm.DB, board *models.Board) *gorm.DB {
+	query := db.Model(&models.Task{}).Where("org_id = ?", board.OrgID)
+	if board.ProjectID != nil {
+		return query.Where("project_id = ?", *board.ProjectID)
+	}
+	return query.Where("team_id = ?", derefString(board.TeamID))
+}
+
+func orderBoardColumns(db *gorm.DB) *gorm.DB {
+	return db.Order("position ASC")
+}
+
+func (s *TaskService) boardToProto(board *models.Board) (*taskpb.Board, error) {
+	var counts []struct {
+		Status string
+		Count  int32
+	}
+	if err := boardTasksQuery(s.db, board).
+		Select("status, COUNT(*) AS count").
+		Group("status").
+		Scan(&counts).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to count board tasks")
+	}
+	byStatus := make(map[string]int32, len(counts))
+	for _, c := range counts {
+		byStatus[c.Status] = c.Count
+	}
+
+	pb := &taskpb.Board{
+		BoardId:   board.ID,
+		OrgId:     board.OrgID,
+		ProjectId: derefString(board.ProjectID),
+		TeamId:    derefString(board.TeamID),
+		Name:      board.Name,
+		CreatedBy: board.CreatedBy,
+		CreatedAt: timestamppb.New(board.CreatedAt),
+		UpdatedAt: timestamppb.New(board.UpdatedAt),
+		Columns:   make([]*taskpb.BoardColumn, len(board.Columns)),
+	}
+	for i, c := range board.Columns {
+		pb.Columns[i] = &taskpb.BoardColumn{
+			ColumnId:  c.ID,
+			Name:      c.Name,
+			Status:    s.stringToStatus(c.Status),
+			Position:  int32(c.Position),
+			WipLimit:  int32(c.WIPLimit),
+			TaskCount: byStatus[c.Status],
+		}
+	}
+	return pb, nil
+}
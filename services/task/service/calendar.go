@@ -0,0 +1,359 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/pkg/auth"
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
+	"github.com/chanduchitikam/task-management-system/pkg/cache"
+	taskpb "github.com/chanduchitikam/task-management-system/proto/task"
+	"github.com/chanduchitikam/task-management-system/services/task/models"
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	icalDateFormat     = "20060102"
+	icalDateTimeFormat = "20060102T150405Z"
+	icalMaxLineOctets  = 75
+)
+
+// SetFeedTokens sets the JWT manager calendar feed tokens are signed with,
+// which must share the gateway's keys. The feed endpoints are unavailable
+// until it is set.
+func (s *TaskService) SetFeedTokens(jm *auth.JWTManager) {
+	s.feedTokens = jm
+}
+
+// feedSecret returns the user's current calendar feed secret, or "" if they
+// have none yet
+func (s *TaskService) feedSecret(ctx context.Context, userID string) (string, error) {
+	var secrets []string
+	err := s.db.WithContext(ctx).Model(&models.CalendarFeedSecret{}).
+		Where("user_id = ?", userID).Pluck("secret", &secrets).Error
+	if err != nil || len(secrets) == 0 {
+		return "", err
+	}
+	return secrets[0], nil
+}
+
+// ensureFeedSecret returns the user's feed secret, creating one the first
+// time their feed URL is asked for
+func (s *TaskService) ensureFeedSecret(ctx context.Context, userID string) (string, error) {
+	secret, err := s.feedSecret(ctx, userID)
+	if err != nil || secret != "" {
+		return secret, err
+	}
+	secret, err = newFeedSecret()
+	if err != nil {
+		return "", err
+	}
+	// a concurrent first request may have created one; either will do
+	err = s.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&models.CalendarFeedSecret{UserID: userID, Secret: secret}).Error
+	if err != nil {
+		return "", err
+	}
+	return s.feedSecret(ctx, userID)
+}
+
+func newFeedSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// feedOwnerActive reports whether userID may still read a feed issued for
+// orgID: their account is active and not suspended, and they still belong to
+// orgID, if it is set
+func (s *TaskService) feedOwnerActive(ctx context.Context, userID, orgID string) (bool, error) {
+	query := `SELECT EXISTS (SELECT 1 FROM users u WHERE u.id = ? AND u.is_active AND u.suspended_at IS NULL`
+	args := []interface{}{userID}
+	if orgID != "" {
+		query += ` AND (u.org_id = ? OR u.role = ? OR EXISTS (
+			SELECT 1 FROM organization_memberships m WHERE m.user_id = u.id AND m.org_id = ?))`
+		args = append(args, orgID, authz.RoleSuperAdmin, orgID)
+	}
+	var active bool
+	err := s.db.WithContext(ctx).Raw(query+")", args...).Scan(&active).Error
+	return active, err
+}
+
+// RunFeedRevocations rotates the feed secret of users removed from an org,
+// as published by the user service, until ctx is cancelled. GetCalendarFeed
+// checks membership too, so a missed event only delays the rotation.
+func (s *TaskService) RunFeedRevocations(ctx context.Context) {
+	pubsub := s.cache.Subscribe(ctx, cache.MembershipEventsChannel)
+	defer pubsub.Close()
+
+	ch := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-ch:
+			if !ok {
+				return
+			}
+			var ev cache.MembershipEvent
+			if err := json.Unmarshal([]byte(msg.Payload), &ev); err != nil {
+				log.Printf("invalid membership event: %v", err)
+				continue
+			}
+			if ev.Type != cache.MembershipRemoved || ev.UserID == "" {
+				continue
+			}
+			// the next feed URL request creates a fresh secret
+			if err := s.db.WithContext(ctx).Where("user_id = ?", ev.UserID).Delete(&models.CalendarFeedSecret{}).Error; err != nil {
+				log.Printf("failed to rotate feed secret of user %s: %v", ev.UserID, err)
+			}
+		}
+	}
+}
+
+func calendarFeedURL(userID, token string) string {
+	return fmt.Sprintf("/api/v1/calendar/%s/feed.ics?token=%s", url.PathEscape(userID), url.QueryEscape(token))
+}
+
+// GetCalendarFeedURL returns the signed iCal URL for the caller
+func (s *TaskService) GetCalendarFeedURL(ctx context.Context, req *taskpb.GetCalendarFeedURLRequest) (*taskpb.GetCalendarFeedURLResponse, error) {
+	userID, orgID, _ := s.extractAuth(ctx)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	if s.feedTokens == nil {
+		return nil, status.Error(codes.Unavailable, "calendar feeds are not configured")
+	}
+
+	secret, err := s.ensureFeedSecret(ctx, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load feed secret")
+	}
+	token := s.feedTokens.GenerateFeedToken(userID, orgID, secret)
+
+	return &taskpb.GetCalendarFeedURLResponse{
+		Url:   calendarFeedURL(userID, token),
+		Token: token,
+	}, nil
+}
+
+// RotateCalendarFeedURL replaces the caller's feed secret, so every feed URL
+// issued before stops working, and returns a new URL
+func (s *TaskService) RotateCalendarFeedURL(ctx context.Context, req *taskpb.RotateCalendarFeedURLRequest) (*taskpb.RotateCalendarFeedURLResponse, error) {
+	userID, orgID, _ := s.extractAuth(ctx)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	if s.feedTokens == nil {
+		return nil, status.Error(codes.Unavailable, "calendar feeds are not configured")
+	}
+
+	secret, err := newFeedSecret()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to generate feed secret")
+	}
+	err = s.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"secret", "updated_at"}),
+	}).Create(&models.CalendarFeedSecret{UserID: userID, Secret: secret}).Error
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to rotate feed secret")
+	}
+	token := s.feedTokens.GenerateFeedToken(userID, orgID, secret)
+
+	return &taskpb.RotateCalendarFeedURLResponse{
+		Url:   calendarFeedURL(userID, token),
+		Token: token,
+	}, nil
+}
+
+// GetCalendarFeed renders the user's tasks with due dates as an iCalendar
+// document. Calendar clients poll this URL without credentials, so the signed
+// token is the only authorization, and stops working once the user leaves
+// the token's org or their account is deactivated.
+func (s *TaskService) GetCalendarFeed(ctx context.Context, req *taskpb.GetCalendarFeedRequest) (*httpbody.HttpBody, error) {
+	if req.UserId == "" || req.Token == "" {
+		return nil, status.Error(codes.Unauthenticated, "feed token required")
+	}
+	if s.feedTokens == nil {
+		return nil, status.Error(codes.Unavailable, "calendar feeds are not configured")
+	}
+	if _, err := uuid.Parse(req.UserId); err != nil {
+		return nil, status.Error(codes.PermissionDenied, "invalid feed token")
+	}
+
+	secret, err := s.feedSecret(ctx, req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load feed secret")
+	}
+	orgID, err := s.feedTokens.ValidateFeedToken(req.UserId, secret, req.Token)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, "invalid feed token")
+	}
+	active, err := s.feedOwnerActive(ctx, req.UserId, orgID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to check feed access")
+	}
+	if !active {
+		return nil, status.Error(codes.PermissionDenied, "invalid feed token")
+	}
+
+	component := strings.ToLower(req.Component)
+	switch component {
+	case "":
+		component = "event"
+	case "event", "todo", "both":
+	default:
+		return nil, status.Error(codes.InvalidArgument, "component must be event, todo, or both")
+	}
+
+	query := s.db.Model(&models.Task{}).
+		Where("assigned_to = ? OR (assigned_to IS NULL AND created_by = ?)", req.UserId, req.UserId).
+		Where("due_date IS NOT NULL")
+	if orgID != "" {
+		query = query.Where("org_id = ?", orgID)
+	} else {
+		query = query.Where("org_id IS NULL")
+	}
+	if !req.IncludeCompleted {
+		query = query.Where("status NOT IN ?", []string{"completed", "cancelled"})
+	}
+
+	var tasks []models.Task
+	if err := query.Order("due_date ASC").Limit(1000).Find(&tasks).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to load tasks")
+	}
+
+	return &httpbody.HttpBody{
+		ContentType: "text/calendar; charset=utf-8",
+		Data:        []byte(renderICalendar(tasks, component, time.Now().UTC())),
+	}, nil
+}
+
+// renderICalendar builds an RFC 5545 calendar. Events are all-day entries on
+// the due date, which is what Google Calendar displays; todos carry the exact
+// due time and completion status for clients that support them.
+func renderICalendar(tasks []models.Task, component string, now time.Time) string {
+	var b strings.Builder
+	writeLine := func(line string) {
+		b.WriteString(foldICalLine(line))
+		b.WriteString("\r\n")
+	}
+
+	writeLine("BEGIN:VCALENDAR")
+	writeLine("VERSION:2.0")
+	writeLine("PRODID:-//Taskflow//Task Management System//EN")
+	writeLine("CALSCALE:GREGORIAN")
+	writeLine("METHOD:PUBLISH")
+	writeLine("X-WR-CALNAME:Taskflow tasks")
+
+	stamp := now.Format(icalDateTimeFormat)
+	for _, task := range tasks {
+		due := task.DueDate.UTC()
+		summary := task.Title
+		if task.TaskKey != "" {
+			summary = task.TaskKey + " " + summary
+		}
+
+		if component == "event" || component == "both" {
+			writeLine("BEGIN:VEVENT")
+			writeLine("UID:" + task.ID + "-event@taskflow")
+			writeLine("DTSTAMP:" + stamp)
+			writeLine("DTSTART;VALUE=DATE:" + due.Format(icalDateFormat))
+			writeLine("DTEND;VALUE=DATE:" + due.AddDate(0, 0, 1).Format(icalDateFormat))
+			writeLine("SUMMARY:" + escapeICalText(summary))
+			if task.Description != "" {
+				writeLine("DESCRIPTION:" + escapeICalText(task.Description))
+			}
+			writeLine("LAST-MODIFIED:" + task.UpdatedAt.UTC().Format(icalDateTimeFormat))
+			writeLine("TRANSP:TRANSPARENT")
+			writeLine("END:VEVENT")
+		}
+
+		if component == "todo" || component == "both" {
+			writeLine("BEGIN:VTODO")
+			writeLine("UID:" + task.ID + "-todo@taskflow")
+			writeLine("DTSTAMP:" + stamp)
+			writeLine("DUE:" + due.Format(icalDateTimeFormat))
+			writeLine("SUMMARY:" + escapeICalText(summary))
+			if task.Description != "" {
+				writeLine("DESCRIPTION:" + escapeICalText(task.Description))
+			}
+			writeLine("STATUS:" + icalTodoStatus(task.Status))
+			writeLine("PRIORITY:" + icalPriority(task.Priority))
+			writeLine("LAST-MODIFIED:" + task.UpdatedAt.UTC().Format(icalDateTimeFormat))
+			writeLine("END:VTODO")
+		}
+	}
+
+	writeLine("END:VCALENDAR")
+	return b.String()
+}
+
+func icalTodoStatus(taskStatus string) string {
+	switch taskStatus {
+	case "completed":
+		return "COMPLETED"
+	case "cancelled":
+		return "CANCELLED"
+	case "in_progress", "in_review":
+		return "IN-PROCESS"
+	default:
+		return "NEEDS-ACTION"
+	}
+}
+
+// icalPriority maps task priority onto the 1 (highest) to 9 (lowest) scale.
+func icalPriority(priority string) string {
+	switch priority {
+	case "critical":
+		return "1"
+	case "high":
+		return "3"
+	case "low":
+		return "9"
+	default:
+		return "5"
+	}
+}
+
+var icalTextEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`)
+
+func escapeICalText(s string) string {
+	return icalTextEscaper.Replace(s)
+}
+
+// foldICalLine splits content lines longer than 75 octets, continuing them
+// with a leading space, without breaking UTF-8 sequences.
+func foldICalLine(line string) string {
+	if len(line) <= icalMaxLineOctets {
+		return line
+	}
+	var b strings.Builder
+	width := 0
+	limit := icalMaxLineOctets
+	for _, r := range line {
+		n := len(string(r))
+		if width+n > limit {
+			b.WriteString("\r\n ")
+			width = 0
+			limit = icalMaxLineOctets - 1
+		}
+		b.WriteRune(r)
+		width += n
+	}
+	return b.String()
+}
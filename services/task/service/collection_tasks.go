@@ -0,0 +1,207 @@
+package service
+
+import (
+	"context"
+	"sort"
+
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
+	taskpb "github.com/chanduchitikam/task-management-system/proto/task"
+	"github.com/chanduchitikam/task-management-system/services/task/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const maxCollectionPageSize = 100
+
+// taskCollection is the shared payload of the team and group task views
+type taskCollection struct {
+	tasks          []*taskpb.Task
+	total          int32
+	page           int32
+	pageSize       int32
+	byStatus       map[string]int32
+	workload       []*taskpb.MemberWorkload
+	unassignedOpen int32
+}
+
+// GetTeamTasks returns a page of a team's tasks plus team-wide aggregates
+func (s *TaskService) GetTeamTasks(ctx context.Context, req *taskpb.GetTeamTasksRequest) (*taskpb.GetTeamTasksResponse, error) {
+	if req.TeamId == "" {
+		return nil, status.Error(codes.InvalidArgument, "team_id is required")
+	}
+	var isMember func(context.Context, string, string) (bool, error)
+	if s.membership != nil {
+		isMember = s.membership.IsTeamMember
+	}
+	base, err := s.collectionQuery(ctx, "team_id", req.TeamId, isMember)
+	if err != nil {
+		return nil, err
+	}
+	c, err := s.loadTaskCollection(base, req.StatusFilter, req.Page, req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &taskpb.GetTeamTasksResponse{
+		Tasks:          c.tasks,
+		TotalCount:     c.total,
+		Page:           c.page,
+		PageSize:       c.pageSize,
+		ByStatus:       c.byStatus,
+		Workload:       c.workload,
+		UnassignedOpen: c.unassignedOpen,
+	}, nil
+}
+
+// GetGroupTasks returns a page of a group's tasks plus group-wide aggregates
+func (s *TaskService) GetGroupTasks(ctx context.Context, req *taskpb.GetGroupTasksRequest) (*taskpb.GetGroupTasksResponse, error) {
+	if req.GroupId == "" {
+		return nil, status.Error(codes.InvalidArgument, "group_id is required")
+	}
+	var isMember func(context.Context, string, string) (bool, error)
+	if s.membership != nil {
+		isMember = s.membership.IsGroupMember
+	}
+	base, err := s.collectionQuery(ctx, "group_id", req.GroupId, isMember)
+	if err != nil {
+		return nil, err
+	}
+	c, err := s.loadTaskCollection(base, req.StatusFilter, req.Page, req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &taskpb.GetGroupTasksResponse{
+		Tasks:          c.tasks,
+		TotalCount:     c.total,
+		Page:           c.page,
+		PageSize:       c.pageSize,
+		ByStatus:       c.byStatus,
+		Workload:       c.workload,
+		UnassignedOpen: c.unassignedOpen,
+	}, nil
+}
+
+// collectionQuery scopes tasks to one team or group in the caller's org. Org
+// admins and members of the team or group see all of its tasks; anyone else
+// only sees the ones assigned to or created by them.
+func (s *TaskService) collectionQuery(ctx context.Context, column, id string, isMember func(context.Context, string, string) (bool, error)) (*gorm.DB, error) {
+	userID, orgID, role := s.extractAuth(ctx)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	if orgID == "" {
+		return nil, status.Error(codes.PermissionDenied, "teams and groups require an organization")
+	}
+
+	query := s.db.Model(&models.Task{}).Where("org_id = ?", orgID).Where(column+" = ?", id)
+	if authz.Can(role, authz.TaskViewAll) {
+		return query, nil
+	}
+
+	member := false
+	if isMember != nil {
+		ok, err := isMember(ctx, id, userID)
+		if err != nil {
+			return nil, status.Error(codes.Unavailable, "unable to verify membership")
+		}
+		member = ok
+	}
+	if !member {
+		query = query.Where("(assigned_to = ? OR created_by = ?)", userID, userID)
+	}
+	return query, nil
+}
+
+func (s *TaskService) loadTaskCollection(base *gorm.DB, statusFilter taskpb.TaskStatus, page, pageSize int32) (*taskCollection, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+	if pageSize > maxCollectionPageSize {
+		pageSize = maxCollectionPageSize
+	}
+	scoped := func() *gorm.DB { return base.Session(&gorm.Session{}) }
+
+	c := &taskCollection{
+		page:     page,
+		pageSize: pageSize,
+		byStatus: map[string]int32{},
+	}
+
+	listed := scoped()
+	if statusFilter != taskpb.TaskStatus_TASK_STATUS_UNSPECIFIED {
+		listed = listed.Where("status = ?", s.statusToString(statusFilter))
+	}
+	var total int64
+	if err := listed.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to count tasks")
+	}
+	c.total = int32(total)
+
+	var tasks []models.Task
+	if err := listed.Order("created_at DESC").Offset(int((page - 1) * pageSize)).Limit(int(pageSize)).Find(&tasks).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to list tasks")
+	}
+	c.tasks = make([]*taskpb.Task, len(tasks))
+	for i := range tasks {
+		c.tasks[i] = s.modelToProto(&tasks[i])
+	}
+
+	var grouped []struct {
+		Key   string
+		Count int32
+	}
+	if err := scoped().Select("status AS key, COUNT(*) AS count").Group("status").Scan(&grouped).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to count tasks by status")
+	}
+	for _, g := range grouped {
+		c.byStatus[g.Key] = g.Count
+	}
+
+	var unassigned int64
+	if err := scoped().Where("assigned_to IS NULL AND status NOT IN ?", closedTaskStatuses).Count(&unassigned).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to count unassigned tasks")
+	}
+	c.unassignedOpen = int32(unassigned)
+
+	var loads []struct {
+		AssignedTo string
+		Open       int32
+		InProgress int32
+		Overdue    int32
+		Completed  int32
+	}
+	if err := scoped().
+		Select(`assigned_to,
+			SUM(CASE WHEN status NOT IN ('completed', 'cancelled') THEN 1 ELSE 0 END) AS open,
+			SUM(CASE WHEN status = 'in_progress' THEN 1 ELSE 0 END) AS in_progress,
+			SUM(CASE WHEN is_overdue = ? THEN 1 ELSE 0 END) AS overdue,
+			SUM(CASE WHEN status = 'completed' THEN 1 ELSE 0 END) AS completed`, true).
+		Where("assigned_to IS NOT NULL").
+		Group("assigned_to").
+		Scan(&loads).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to compute member workload")
+	}
+	for _, l := range loads {
+		c.workload = append(c.workload, &taskpb.MemberWorkload{
+			UserId:     l.AssignedTo,
+			Open:       l.Open,
+			InProgress: l.InProgress,
+			Overdue:    l.Overdue,
+			Completed:  l.Completed,
+		})
+	}
+	sort.Slice(c.workload, func(i, j int) bool {
+		a, b := c.workload[i], c.workload[j]
+		if a.Open != b.Open {
+			return a.Open > b.Open
+		}
+		return a.UserId < b.UserId
+	})
+
+	return c, nil
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	taskpb "github.com/chanduchitikam/task-management-system/proto/task"
+	"github.com/chanduchitikam/task-management-system/services/task/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	maxCommentLength   = 10000
+	defaultCommentPage = 50
+	maxCommentPageSize = 200
+)
+
+// CreateTaskComment adds a comment to a task the caller can see
+func (s *TaskService) CreateTaskComment(ctx context.Context, req *taskpb.CreateTaskCommentRequest) (*taskpb.CreateTaskCommentResponse, error) {
+	userID, _, _ := s.extractAuth(ctx)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	body := strings.TrimSpace(req.Body)
+	if body == "" {
+		return nil, status.Error(codes.InvalidArgument, "body is required")
+	}
+	if len(body) > maxCommentLength {
+		return nil, status.Errorf(codes.InvalidArgument, "body must be at most %d characters", maxCommentLength)
+	}
+
+	// GetTask applies the visibility rules and resolves task keys
+	resp, err := s.GetTask(ctx, &taskpb.GetTaskRequest{TaskId: req.TaskId})
+	if err != nil {
+		return nil, err
+	}
+	var task models.Task
+	if err := s.db.Where("id = ?", resp.Task.TaskId).First(&task).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to find task")
+	}
+
+	comment := &models.TaskComment{TaskID: task.ID, AuthorID: userID, Body: body}
+	if err := s.db.Create(comment).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to create comment")
+	}
+	s.notifyMentions(&task, userID, body, "", "comment")
+
+	return &taskpb.CreateTaskCommentResponse{
+		Comment: commentToProto(comment),
+		Message: "Comment added successfully",
+	}, nil
+}
+
+// ListTaskComments lists a task's comments, oldest first
+func (s *TaskService) ListTaskComments(ctx context.Context, req *taskpb.ListTaskCommentsRequest) (*taskpb.ListTaskCommentsResponse, error) {
+	resp, err := s.GetTask(ctx, &taskpb.GetTaskRequest{TaskId: req.TaskId})
+	if err != nil {
+		return nil, err
+	}
+
+	page := req.Page
+	if page < 1 {
+		page = 1
+	}
+	pageSize := req.PageSize
+	if pageSize < 1 {
+		pageSize = defaultCommentPage
+	}
+	if pageSize > maxCommentPageSize {
+		pageSize = maxCommentPageSize
+	}
+
+	query := s.db.Model(&models.TaskComment{}).Where("task_id = ?", resp.Task.TaskId)
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to count comments")
+	}
+
+	var comments []models.TaskComment
+	if err := query.Order("created_at ASC").Offset(int((page - 1) * pageSize)).Limit(int(pageSize)).Find(&comments).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to list comments")
+	}
+
+	out := &taskpb.ListTaskCommentsResponse{
+		Comments:   make([]*taskpb.TaskComment, len(comments)),
+		TotalCount: int32(total),
+	}
+	for i := range comments {
+		out.Comments[i] = commentToProto(&comments[i])
+	}
+	return out, nil
+}
+
+func commentToProto(c *models.TaskComment) *taskpb.TaskComment {
+	return &taskpb.TaskComment{
+		CommentId: c.ID,
+		TaskId:    c.TaskID,
+		AuthorId:  c.AuthorID,
+		Body:      c.Body,
+		CreatedAt: timestamppb.New(c.CreatedAt),
+		UpdatedAt: timestamppb.New(c.UpdatedAt),
+	}
+}
@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	taskpb "github.com/chanduchitikam/task-management-system/proto/task"
+	"github.com/chanduchitikam/task-management-system/services/task/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// AddTaskDependency records that a task waits on another one. Both must be
+// visible to the caller and belong to the same organization, and the new
+// edge may not close a cycle.
+func (s *TaskService) AddTaskDependency(ctx context.Context, req *taskpb.AddTaskDependencyRequest) (*taskpb.AddTaskDependencyResponse, error) {
+	userID, _, _ := s.extractAuth(ctx)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	if req.TaskId == "" || req.DependsOnId == "" {
+		return nil, status.Error(codes.InvalidArgument, "task_id and depends_on_id are required")
+	}
+
+	task, err := s.visibleTask(ctx, req.TaskId)
+	if err != nil {
+		return nil, err
+	}
+	dependsOn, err := s.visibleTask(ctx, req.DependsOnId)
+	if err != nil {
+		return nil, err
+	}
+	if task.ID == dependsOn.ID {
+		return nil, status.Error(codes.InvalidArgument, "a task cannot depend on itself")
+	}
+	if derefString(task.OrgID) != derefString(dependsOn.OrgID) {
+		return nil, status.Error(codes.InvalidArgument, "tasks must belong to the same organization")
+	}
+
+	dep := &models.TaskDependency{TaskID: task.ID, DependsOnID: dependsOn.ID, CreatedBy: userID}
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		cycle, err := dependencyReaches(tx, dependsOn.ID, task.ID)
+		if err != nil {
+			return err
+		}
+		if cycle {
+			return errDependencyCycle
+		}
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(dep).Error
+	})
+	if errors.Is(err, errDependencyCycle) {
+		return nil, status.Error(codes.FailedPrecondition, "dependency would create a cycle")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to add dependency")
+	}
+
+	return &taskpb.AddTaskDependencyResponse{
+		Dependency: dependencyToProto(dep),
+		Message:    "Dependency added successfully",
+	}, nil
+}
+
+// RemoveTaskDependency deletes the edge between two tasks
+func (s *TaskService) RemoveTaskDependency(ctx context.Context, req *taskpb.RemoveTaskDependencyRequest) (*taskpb.RemoveTaskDependencyResponse, error) {
+	task, err := s.visibleTask(ctx, req.TaskId)
+	if err != nil {
+		return nil, err
+	}
+	dependsOn, err := s.visibleTask(ctx, req.DependsOnId)
+	if err != nil {
+		return nil, err
+	}
+
+	result := s.db.Where("task_id = ? AND depends_on_id = ?", task.ID, dependsOn.ID).Delete(&models.TaskDependency{})
+	if result.Error != nil {
+		return nil, status.Error(codes.Internal, "failed to remove dependency")
+	}
+	if result.RowsAffected == 0 {
+		return nil, status.Error(codes.NotFound, "dependency not found")
+	}
+
+	return &taskpb.RemoveTaskDependencyResponse{Message: "Dependency removed successfully"}, nil
+}
+
+// ListTaskDependencies lists both directions of a task's dependencies
+func (s *TaskService) ListTaskDependencies(ctx context.Context, req *taskpb.ListTaskDependenciesRequest) (*taskpb.ListTaskDependenciesResponse, error) {
+	task, err := s.visibleTask(ctx, req.TaskId)
+	if err != nil {
+		return nil, err
+	}
+
+	var blockedBy, blocking []models.TaskDependency
+	if err := s.db.Where("task_id = ?", task.ID).Order("created_at ASC").Find(&blockedBy).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to list dependencies")
+	}
+	if err := s.db.Where("depends_on_id = ?", task.ID).Order("created_at ASC").Find(&blocking).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to list dependencies")
+	}
+
+	out := &taskpb.ListTaskDependenciesResponse{
+		BlockedBy: make([]*taskpb.TaskDependency, len(blockedBy)),
+		Blocking:  make([]*taskpb.TaskDependency, len(blocking)),
+	}
+	for i := range blockedBy {
+		out.BlockedBy[i] = dependencyToProto(&blockedBy[i])
+	}
+	for i := range blocking {
+		out.Blocking[i] = dependencyToProto(&blocking[i])
+	}
+	return out, nil
+}
+
+var errDependencyCycle = errors.New("dependency cycle")
+
+// visibleTask loads a task by id or key, applying GetTask's visibility rules
+func (s *TaskService) visibleTask(ctx context.Context, ref string) (*models.Task, error) {
+	resp, err := s.GetTask(ctx, &taskpb.GetTaskRequest{TaskId: ref})
+	if err != nil {
+		return nil, err
+	}
+	var task models.Task
+	if err := s.db.Where("id = ?", resp.Task.TaskId).First(&task).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to find task")
+	}
+	return &task, nil
+}
+
+// dependencyReaches reports whether target is reachable from start by
+// following depends_on edges
+func dependencyReaches(tx *gorm.DB, start, target string) (bool, error) {
+	seen := map[string]bool{start: true}
+	frontier := []string{start}
+	for len(frontier) > 0 {
+		var next []string
+		if err := tx.Model(&models.TaskDependency{}).Where("task_id IN ?", frontier).Pluck("depends_on_id", &next).Error; err != nil {
+			return false, err
+		}
+		frontier = frontier[:0]
+		for _, id := range next {
+			if id == target {
+				return true, nil
+			}
+			if !seen[id] {
+				seen[id] = true
+				frontier = append(frontier, id)
+			}
+		}
+	}
+	return false, nil
+}
+
+func dependencyToProto(d *models.TaskDependency) *taskpb.TaskDependency {
+	return &taskpb.TaskDependency{
+		TaskId:      d.TaskID,
+		DependsOnId: d.DependsOnID,
+		CreatedBy:   d.CreatedBy,
+		CreatedAt:   timestamppb.New(d.CreatedAt),
+	}
+}
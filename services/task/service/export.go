@@ -0,0 +1,307 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"html/template"
+	"strings"
+	"time"
+
+	taskpb "github.com/chanduchitikam/task-management-system/proto/task"
+	"github.com/chanduchitikam/task-management-system/services/task/models"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const exportMaxRows = 5000
+
+// boardColumns is the left-to-right column order of a board snapshot
+var boardColumns = []string{"todo", "in_progress", "in_review", "completed", "cancelled"}
+
+var boardColumnTitles = map[string]string{
+	"todo":        "To Do",
+	"in_progress": "In Progress",
+	"in_review":   "In Review",
+	"completed":   "Completed",
+	"cancelled":   "Cancelled",
+}
+
+// exportColumn describes one selectable column of an export
+type exportColumn struct {
+	Header string
+	Value  func(t *models.Task) string
+}
+
+var exportColumns = map[string]exportColumn{
+	"key":         {"Key", func(t *models.Task) string { return t.TaskKey }},
+	"id":          {"ID", func(t *models.Task) string { return t.ID }},
+	"title":       {"Title", func(t *models.Task) string { return t.Title }},
+	"description": {"Description", func(t *models.Task) string { return t.Description }},
+	"status":      {"Status", func(t *models.Task) string { return t.Status }},
+	"priority":    {"Priority", func(t *models.Task) string { return t.Priority }},
+	"assigned_to": {"Assignee", func(t *models.Task) string { return derefString(t.AssignedTo) }},
+	"created_by":  {"Created By", func(t *models.Task) string { return t.CreatedBy }},
+	"team_id":     {"Team", func(t *models.Task) string { return derefString(t.TeamID) }},
+	"group_id":    {"Group", func(t *models.Task) string { return derefString(t.GroupID) }},
+	"project_id":  {"Project", func(t *models.Task) string { return derefString(t.ProjectID) }},
+	"due_date":    {"Due", func(t *models.Task) string { return formatExportTime(t.DueDate) }},
+	"created_at":  {"Created", func(t *models.Task) string { return formatExportTime(&t.CreatedAt) }},
+	"updated_at":  {"Updated", func(t *models.Task) string { return formatExportTime(&t.UpdatedAt) }},
+	"tags":        {"Tags", func(t *models.Task) string { return strings.ReplaceAll(t.Tags, ",", ", ") }},
+}
+
+var defaultExportColumns = []string{"key", "title", "status", "priority", "assigned_to", "due_date"}
+
+// ExportTasks renders the caller's visible tasks as CSV or printable HTML,
+// either as a flat list or as a board snapshot grouped by status.
+func (s *TaskService) ExportTasks(ctx context.Context, req *taskpb.ExportTasksRequest) (*httpbody.HttpBody, error) {
+	view := strings.ToLower(req.View)
+	if view == "" {
+		view = "list"
+	}
+	if view != "list" && view != "board" {
+		return nil, status.Error(codes.InvalidArgument, "view must be list or board")
+	}
+	format := strings.ToLower(req.Format)
+	if format == "" {
+		format = "csv"
+	}
+	if format != "csv" && format != "html" {
+		return nil, status.Error(codes.InvalidArgument, "format must be csv or html")
+	}
+
+	columnNames := req.Columns
+	if len(columnNames) == 0 {
+		columnNames = defaultExportColumns
+	}
+	columns := make([]exportColumn, 0, len(columnNames))
+	for _, name := range columnNames {
+		col, ok := exportColumns[strings.ToLower(name)]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown column %q", name)
+		}
+		columns = append(columns, col)
+	}
+
+	userID, orgID, role := s.extractAuth(ctx)
+	query, err := s.visibleTasksQuery(userID, orgID, role)
+	if err != nil {
+		return nil, err
+	}
+	query = s.applyTaskFilters(query, taskFilters{
+		Status:     req.StatusFilter,
+		Priority:   req.PriorityFilter,
+		TeamID:     req.TeamFilter,
+		GroupID:    req.GroupFilter,
+		Project:    req.ProjectFilter,
+		AssignedTo: req.AssignedToFilter,
+		Search:     req.Search,
+	})
+
+	var tasks []models.Task
+	if err := query.Order("created_at DESC").Limit(exportMaxRows).Find(&tasks).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to export tasks")
+	}
+
+	title := req.Title
+	if title == "" {
+		title = "Tasks"
+		if view == "board" {
+			title = "Board"
+		}
+	}
+
+	var body []byte
+	var contentType string
+	if format == "csv" {
+		body, err = renderTasksCSV(tasks, columns, view == "board")
+		contentType = "text/csv; charset=utf-8"
+	} else {
+		body, err = renderTasksHTML(tasks, columns, view == "board", title, time.Now())
+		contentType = "text/html; charset=utf-8"
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to render export")
+	}
+
+	return &httpbody.HttpBody{ContentType: contentType, Data: body}, nil
+}
+
+// groupByBoardColumn buckets tasks into board columns, preserving order
+func groupByBoardColumn(tasks []models.Task) map[string][]*models.Task {
+	grouped := make(map[string][]*models.Task, len(boardColumns))
+	for i := range tasks {
+		grouped[tasks[i].Status] = append(grouped[tasks[i].Status], &tasks[i])
+	}
+	return grouped
+}
+
+func renderTasksCSV(tasks []models.Task, columns []exportColumn, board bool) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	header := make([]string, 0, len(columns)+1)
+	if board {
+		header = append(header, "Column")
+	}
+	for _, col := range columns {
+		header = append(header, col.Header)
+	}
+	if err := w.Write(header); err != nil {
+		return nil, err
+	}
+
+	writeRow := func(prefix string, t *models.Task) error {
+		row := make([]string, 0, len(header))
+		if board {
+			row = append(row, prefix)
+		}
+		for _, col := range columns {
+			row = append(row, col.Value(t))
+		}
+		return w.Write(row)
+	}
+
+	if board {
+		grouped := groupByBoardColumn(tasks)
+		for _, status := range boardColumns {
+			for _, t := range grouped[status] {
+				if err := writeRow(boardColumnTitles[status], t); err != nil {
+					return nil, err
+				}
+			}
+		}
+	} else {
+		for i := range tasks {
+			if err := writeRow("", &tasks[i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+var exportHTMLTemplate = template.Must(template.New("export").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+body { font-family: -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; font-size: 12px; color: #111; margin: 24px; }
+h1 { font-size: 18px; margin: 0 0 4px; }
+.meta { color: #666; margin-bottom: 16px; }
+table { border-collapse: collapse; width: 100%; }
+th, td { border: 1px solid #ccc; padding: 4px 6px; text-align: left; vertical-align: top; }
+th { background: #f2f2f2; }
+.board { display: flex; gap: 12px; align-items: flex-start; }
+.column { flex: 1; border: 1px solid #ccc; border-radius: 4px; padding: 6px; }
+.column h2 { font-size: 13px; margin: 0 0 6px; }
+.card { border: 1px solid #ddd; border-radius: 3px; padding: 4px 6px; margin-bottom: 6px; page-break-inside: avoid; }
+.card .field { color: #444; }
+@media print { body { margin: 0; } th { background: #eee !important; -webkit-print-color-adjust: exact; } }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<div class="meta">{{.Count}} tasks &middot; generated {{.Generated}}</div>
+{{if .Board}}
+<div class="board">
+{{range .Columns}}<div class="column">
+<h2>{{.Title}} ({{len .Cards}})</h2>
+{{range .Cards}}<div class="card">{{range .}}<div class="field">{{.}}</div>{{end}}</div>
+{{end}}</div>
+{{end}}</div>
+{{else}}
+<table>
+<thead><tr>{{range .Headers}}<th>{{.}}</th>{{end}}</tr></thead>
+<tbody>
+{{range .Rows}}<tr>{{range .}}<td>{{.}}</td>{{end}}</tr>
+{{end}}</tbody>
+</table>
+{{end}}
+</body>
+</html>
+`))
+
+type exportBoardColumn struct {
+	Title string
+	Cards [][]string
+}
+
+func renderTasksHTML(tasks []models.Task, columns []exportColumn, board bool, title string, now time.Time) ([]byte, error) {
+	data := struct {
+		Title     string
+		Count     int
+		Generated string
+		Board     bool
+		Headers   []string
+		Rows      [][]string
+		Columns   []exportBoardColumn
+	}{
+		Title:     title,
+		Count:     len(tasks),
+		Generated: now.UTC().Format("2006-01-02 15:04 MST"),
+		Board:     board,
+	}
+
+	values := func(t *models.Task) []string {
+		row := make([]string, len(columns))
+		for i, col := range columns {
+			row[i] = col.Value(t)
+		}
+		return row
+	}
+
+	if board {
+		grouped := groupByBoardColumn(tasks)
+		for _, status := range boardColumns {
+			col := exportBoardColumn{Title: boardColumnTitles[status]}
+			for _, t := range grouped[status] {
+				col.Cards = append(col.Cards, nonEmpty(values(t)))
+			}
+			data.Columns = append(data.Columns, col)
+		}
+	} else {
+		for _, col := range columns {
+			data.Headers = append(data.Headers, col.Header)
+		}
+		for i := range tasks {
+			data.Rows = append(data.Rows, values(&tasks[i]))
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := exportHTMLTemplate.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("render export: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func nonEmpty(values []string) []string {
+	out := values[:0]
+	for _, v := range values {
+		if v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func formatExportTime(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format("2006-01-02 15:04")
+}
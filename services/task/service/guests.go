@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
+	taskpb "github.com/chanduchitikam/task-management-system/proto/task"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// guestMethods are the task RPCs open to guests; each handler narrows its
+// query to the guest's projects with scopeToGuest
+var guestMethods = map[string]bool{
+	taskpb.TaskService_CreateTask_FullMethodName:       true,
+	taskpb.TaskService_GetTask_FullMethodName:          true,
+	taskpb.TaskService_UpdateTask_FullMethodName:       true,
+	taskpb.TaskService_UpdateTaskStatus_FullMethodName: true,
+	taskpb.TaskService_ListTasks_FullMethodName:        true,
+}
+
+// guestProjectsQuery selects the projects a guest's unexpired access covers,
+// directly or through a workspace that belongs to a project
+const guestProjectsQuery = `
+	SELECT g.scope_id FROM guest_access g
+	WHERE g.user_id = ? AND g.org_id = ? AND g.scope_type = 'project' AND g.expires_at > NOW()
+	UNION
+	SELECT w.project_id FROM guest_access g
+	JOIN workspaces w ON w.id = g.scope_id
+	WHERE g.user_id = ? AND g.org_id = ? AND g.scope_type = 'workspace' AND g.expires_at > NOW()
+	  AND w.project_id IS NOT NULL`
+
+// GuestInterceptor refuses every task RPC to guests except guestMethods
+func (s *TaskService) GuestInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if _, _, role := s.extractAuth(ctx); authz.IsGuest(role) && !guestMethods[info.FullMethod] {
+			return nil, status.Error(codes.PermissionDenied, "guests may only work on tasks in the project they were invited to")
+		}
+		return handler(ctx, req)
+	}
+}
+
+// scopeToGuest limits query to tasks in the projects a guest may reach.
+// Other roles are returned unchanged.
+func (s *TaskService) scopeToGuest(query *gorm.DB, userID, orgID, role string) *gorm.DB {
+	if !authz.IsGuest(role) {
+		return query
+	}
+	if userID == "" || orgID == "" {
+		return query.Where("1 = 0")
+	}
+	return query.Where("project_id IN ("+guestProjectsQuery+")", userID, orgID, userID, orgID)
+}
+
+// ensureGuestProject checks a guest creating a task puts it in a project
+// their access covers
+func (s *TaskService) ensureGuestProject(userID, orgID, projectID string) error {
+	if projectID == "" {
+		return status.Error(codes.PermissionDenied, "guests must create tasks in the project they were invited to")
+	}
+	var found []string
+	err := s.db.Raw("SELECT project_id FROM ("+guestProjectsQuery+") AS guest_projects (project_id) WHERE project_id = ?",
+		userID, orgID, userID, orgID, projectID).Scan(&found).Error
+	if err != nil {
+		return status.Error(codes.Internal, "failed to check guest access")
+	}
+	if len(found) == 0 {
+		return status.Error(codes.PermissionDenied, "guest access to this project has expired or was never granted")
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	organizationpb "github.com/chanduchitikam/task-management-system/proto/organization"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MembershipVerifier answers whether a user belongs to an org or team
+type MembershipVerifier interface {
+	IsOrgMember(ctx context.Context, orgID, userID string) (bool, error)
+	IsTeamMember(ctx context.Context, teamID, userID string) (bool, error)
+	IsGroupMember(ctx context.Context, groupID, userID string) (bool, error)
+}
+
+// OrgServiceMembership verifies membership against the organization service,
+// caching each org's and team's member set for a short TTL so bulk
+// assignments don't turn into one RPC per task.
+type OrgServiceMembership struct {
+	client  organizationpb.OrganizationServiceClient
+	ttl     time.Duration
+	timeout time.Duration
+
+	mu     sync.Mutex
+	orgs   map[string]memberSet
+	teams  map[string]memberSet
+	groups map[string]memberSet
+}
+
+type memberSet struct {
+	members map[string]bool
+	expires time.Time
+}
+
+// NewOrgServiceMembership creates a verifier backed by the organization service
+func NewOrgServiceMembership(client organizationpb.OrganizationServiceClient, ttl time.Duration) *OrgServiceMembership {
+	return &OrgServiceMembership{
+		client:  client,
+		ttl:     ttl,
+		timeout: 3 * time.Second,
+		orgs:    make(map[string]memberSet),
+		teams:   make(map[string]memberSet),
+		groups:  make(map[string]memberSet),
+	}
+}
+
+// IsOrgMember reports whether userID is a member of orgID
+func (m *OrgServiceMembership) IsOrgMember(ctx context.Context, orgID, userID string) (bool, error) {
+	return m.lookup(ctx, m.orgs, orgID, userID, func(ctx context.Context) ([]string, error) {
+		resp, err := m.client.ListOrgMembers(ctx, &organizationpb.ListOrgMembersRequest{OrgId: orgID})
+		if err != nil {
+			return nil, err
+		}
+		ids := make([]string, len(resp.Members))
+		for i, member := range resp.Members {
+			ids[i] = member.Id
+		}
+		return ids, nil
+	})
+}
+
+// IsTeamMember reports whether userID is an active member of teamID
+func (m *OrgServiceMembership) IsTeamMember(ctx context.Context, teamID, userID string) (bool, error) {
+	return m.lookup(ctx, m.teams, teamID, userID, func(ctx context.Context) ([]string, error) {
+		resp, err := m.client.ListTeamMembers(ctx, &organizationpb.ListTeamMembersRequest{TeamId: teamID})
+		if err != nil {
+			return nil, err
+		}
+		ids := make([]string, len(resp.Members))
+		for i, member := range resp.Members {
+			ids[i] = member.UserId
+		}
+		return ids, nil
+	})
+}
+
+// IsGroupMember reports whether userID is an active member of groupID
+func (m *OrgServiceMembership) IsGroupMember(ctx context.Context, groupID, userID string) (bool, error) {
+	return m.lookup(ctx, m.groups, groupID, userID, func(ctx context.Context) ([]string, error) {
+		resp, err := m.client.GetGroup(ctx, &organizationpb.GetGroupRequest{GroupId: groupID})
+		if err != nil {
+			return nil, err
+		}
+		ids := make([]string, 0, len(resp.Group.GetMembers()))
+		for _, member := range resp.Group.GetMembers() {
+			if member.IsActive {
+				ids = append(ids, member.UserId)
+			}
+		}
+		return ids, nil
+	})
+}
+
+func (m *OrgServiceMembership) lookup(ctx context.Context, sets map[string]memberSet, key, userID string, fetch func(context.Context) ([]string, error)) (bool, error) {
+	// Only hits are served from cache; a miss refetches so a user who was
+	// just added isn't rejected until the entry expires.
+	m.mu.Lock()
+	set, ok := sets[key]
+	m.mu.Unlock()
+	if ok && time.Now().Before(set.expires) && set.members[userID] {
+		return true, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, m.timeout)
+	defer cancel()
+	ids, err := fetch(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	set = memberSet{members: make(map[string]bool, len(ids)), expires: time.Now().Add(m.ttl)}
+	for _, id := range ids {
+		set.members[id] = true
+	}
+	m.mu.Lock()
+	sets[key] = set
+	m.mu.Unlock()
+	return set.members[userID], nil
+}
+
+// SetMembershipVerifier enables assignee validation. With requireTeam set,
+// assignees of team tasks must also belong to that team.
+func (s *TaskService) SetMembershipVerifier(v MembershipVerifier, requireTeam bool) {
+	s.membership = v
+	s.requireTeamMembership = requireTeam
+}
+
+// verifyAssignee rejects assigning an org task to someone outside the org (or
+// outside the task's team when team membership is required). Personal tasks
+// and deployments without a verifier are not checked.
+func (s *TaskService) verifyAssignee(ctx context.Context, orgID string, teamID *string, assignee string) error {
+	if s.membership == nil || orgID == "" || assignee == "" {
+		return nil
+	}
+
+	ok, err := s.membership.IsOrgMember(ctx, orgID, assignee)
+	if err != nil {
+		return status.Error(codes.Unavailable, "unable to verify assignee membership")
+	}
+	if !ok {
+		return status.Error(codes.FailedPrecondition, "assignee is not a member of this organization")
+	}
+
+	if s.requireTeamMembership && teamID != nil && *teamID != "" {
+		ok, err := s.membership.IsTeamMember(ctx, *teamID, assignee)
+		if err != nil {
+			return status.Error(codes.Unavailable, "unable to verify assignee membership")
+		}
+		if !ok {
+			return status.Error(codes.FailedPrecondition, "assignee is not a member of the task's team")
+		}
+	}
+	return nil
+}
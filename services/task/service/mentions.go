@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	notificationpb "github.com/chanduchitikam/task-management-system/proto/notification"
+	userpb "github.com/chanduchitikam/task-management-system/proto/user"
+	"github.com/chanduchitikam/task-management-system/services/task/models"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	maxMentionsPerText = 20
+	mentionTimeout     = 5 * time.Second
+)
+
+// mentionPattern matches @username where the @ does not follow a word
+// character, so email addresses are not treated as mentions.
+var mentionPattern = regexp.MustCompile(`(?:^|[^\w@.])@([A-Za-z0-9][A-Za-z0-9._-]{0,63})`)
+
+// UsernameResolver maps usernames to user IDs within an org
+type UsernameResolver interface {
+	ResolveUsernames(ctx context.Context, orgID string, usernames []string) (map[string]string, error)
+}
+
+// UserServiceResolver resolves usernames through the user service
+type UserServiceResolver struct {
+	client userpb.UserServiceClient
+}
+
+// NewUserServiceResolver creates a resolver backed by the user service
+func NewUserServiceResolver(client userpb.UserServiceClient) *UserServiceResolver {
+	return &UserServiceResolver{client: client}
+}
+
+// ResolveUsernames returns lowercase username -> user ID for known users
+func (r *UserServiceResolver) ResolveUsernames(ctx context.Context, orgID string, usernames []string) (map[string]string, error) {
+	resp, err := r.client.ResolveUsernames(ctx, &userpb.ResolveUsernamesRequest{OrgId: orgID, Usernames: usernames})
+	if err != nil {
+		return nil, err
+	}
+	ids := make(map[string]string, len(resp.Users))
+	for _, u := range resp.Users {
+		ids[strings.ToLower(u.Username)] = u.UserId
+	}
+	return ids, nil
+}
+
+// SetMentionResolver enables @mention notifications
+func (s *TaskService) SetMentionResolver(r UsernameResolver) {
+	s.mentions = r
+}
+
+// parseMentions returns the distinct lowercase usernames mentioned in text,
+// in order of first appearance
+func parseMentions(text string) []string {
+	var names []string
+	seen := map[string]bool{}
+	for _, m := range mentionPattern.FindAllStringSubmatch(text, -1) {
+		// trailing punctuation ends a sentence, not a username
+		name := strings.ToLower(strings.TrimRight(m[1], ".-"))
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		names = append(names, name)
+		if len(names) == maxMentionsPerText {
+			break
+		}
+	}
+	return names
+}
+
+// notifyMentions sends a mention notification to each user newly mentioned
+// in text (compared with previous) other than the author. It runs in the
+// background so resolution never delays or fails the write that triggered it.
+func (s *TaskService) notifyMentions(task *models.Task, authorID, text, previous, source string) {
+	if s.mentions == nil || s.cache == nil || task.OrgID == nil {
+		return
+	}
+
+	names := parseMentions(text)
+	if previous != "" && len(names) > 0 {
+		already := map[string]bool{}
+		for _, n := range parseMentions(previous) {
+			already[n] = true
+		}
+		fresh := names[:0]
+		for _, n := range names {
+			if !already[n] {
+				fresh = append(fresh, n)
+			}
+		}
+		names = fresh
+	}
+	if len(names) == 0 {
+		return
+	}
+
+	orgID := *task.OrgID
+	taskCopy := *task
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), mentionTimeout)
+		defer cancel()
+
+		ids, err := s.mentions.ResolveUsernames(ctx, orgID, names)
+		if err != nil {
+			log.Printf("failed to resolve mentions on task %s: %v", taskCopy.ID, err)
+			return
+		}
+
+		label := taskCopy.Title
+		if taskCopy.TaskKey != "" {
+			label = taskCopy.TaskKey + " " + taskCopy.Title
+		}
+		for _, name := range names {
+			userID, ok := ids[name]
+			if !ok || userID == authorID {
+				continue
+			}
+			event := &notificationpb.NotificationEvent{
+				NotificationId: uuid.New().String(),
+				UserId:         userID,
+				Type:           notificationpb.NotificationType_NOTIFICATION_TYPE_TASK_MENTION,
+				Title:          "You were mentioned",
+				Message:        fmt.Sprintf("You were mentioned in a %s on %s", source, label),
+				TaskId:         taskCopy.ID,
+				RelatedUserId:  authorID,
+				CreatedAt:      timestamppb.Now(),
+				Metadata: map[string]string{
+					"task_key": taskCopy.TaskKey,
+					"source":   source,
+				},
+			}
+			if err := s.enqueueNotification(ctx, event); err != nil {
+				log.Printf("failed to send mention notification to %s: %v", userID, err)
+			}
+		}
+	}()
+}
@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	taskpb "github.com/chanduchitikam/task-management-system/proto/task"
+	"github.com/chanduchitikam/task-management-system/services/task/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	defaultMyWorkLimit = 10
+	maxMyWorkLimit     = 100
+)
+
+var closedTaskStatuses = []string{"completed", "cancelled"}
+
+// WatchTask subscribes the caller to a task they can see
+func (s *TaskService) WatchTask(ctx context.Context, req *taskpb.WatchTaskRequest) (*taskpb.WatchTaskResponse, error) {
+	userID, _, _ := s.extractAuth(ctx)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	// GetTask applies the visibility rules and resolves task keys
+	resp, err := s.GetTask(ctx, &taskpb.GetTaskRequest{TaskId: req.TaskId})
+	if err != nil {
+		return nil, err
+	}
+
+	watcher := &models.TaskWatcher{TaskID: resp.Task.TaskId, UserID: userID}
+	if err := s.db.Clauses(clause.OnConflict{DoNothing: true}).Create(watcher).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to watch task")
+	}
+
+	return &taskpb.WatchTaskResponse{Message: "Task watched successfully"}, nil
+}
+
+// UnwatchTask removes the caller's subscription to a task
+func (s *TaskService) UnwatchTask(ctx context.Context, req *taskpb.UnwatchTaskRequest) (*taskpb.UnwatchTaskResponse, error) {
+	if req.TaskId == "" {
+		return nil, status.Error(codes.InvalidArgument, "task_id is required")
+	}
+	userID, _, _ := s.extractAuth(ctx)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	taskID := req.TaskId
+	if isTaskKey(taskID) {
+		resp, err := s.GetTask(ctx, &taskpb.GetTaskRequest{TaskId: req.TaskId})
+		if err != nil {
+			return nil, err
+		}
+		taskID = resp.Task.TaskId
+	}
+
+	if err := s.db.Where("task_id = ? AND user_id = ?", taskID, userID).Delete(&models.TaskWatcher{}).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to unwatch task")
+	}
+
+	return &taskpb.UnwatchTaskResponse{Message: "Task unwatched successfully"}, nil
+}
+
+// GetMyWork returns the caller's dashboard sections in one call
+func (s *TaskService) GetMyWork(ctx context.Context, req *taskpb.GetMyWorkRequest) (*taskpb.GetMyWorkResponse, error) {
+	userID, orgID, _ := s.extractAuth(ctx)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	limit := int(req.Limit)
+	if limit < 1 {
+		limit = defaultMyWorkLimit
+	}
+	if limit > maxMyWorkLimit {
+		limit = maxMyWorkLimit
+	}
+
+	loc := time.UTC
+	if req.Timezone != "" {
+		l, err := time.LoadLocation(req.Timezone)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid timezone")
+		}
+		loc = l
+	}
+	now := time.Now().In(loc)
+	weekStart, weekEnd := weekBounds(now)
+
+	// scoped returns a fresh query limited to the caller's org
+	scoped := func() *gorm.DB {
+		query := s.db.Model(&models.Task{})
+		if orgID != "" {
+			return query.Where("org_id = ?", orgID)
+		}
+		return query.Where("org_id IS NULL")
+	}
+	open := func(q *gorm.DB) *gorm.DB {
+		return q.Where("status NOT IN ?", closedTaskStatuses)
+	}
+
+	assigned := scoped().Where("assigned_to = ?", userID)
+	if !req.IncludeCompleted {
+		assigned = open(assigned)
+	}
+
+	watching := scoped().Where("id IN (?)", s.db.Model(&models.TaskWatcher{}).Select("task_id").Where("user_id = ?", userID))
+	if !req.IncludeCompleted {
+		watching = open(watching)
+	}
+
+	overdue := open(scoped().Where("assigned_to = ?", userID)).
+		Where("due_date IS NOT NULL AND due_date < ?", now)
+
+	dueThisWeek := open(scoped().Where("assigned_to = ?", userID)).
+		Where("due_date >= ? AND due_date < ?", now, weekEnd)
+
+	resp := &taskpb.GetMyWorkResponse{
+		AssignedByStatus: map[string]int32{},
+		WeekStart:        timestamppb.New(weekStart),
+		WeekEnd:          timestamppb.New(weekEnd),
+	}
+
+	var err error
+	if resp.Assigned, err = s.workSection(assigned, "created_at DESC", limit); err != nil {
+		return nil, err
+	}
+	if resp.Watching, err = s.workSection(watching, "updated_at DESC", limit); err != nil {
+		return nil, err
+	}
+	if resp.Overdue, err = s.workSection(overdue, "due_date ASC", limit); err != nil {
+		return nil, err
+	}
+	if resp.DueThisWeek, err = s.workSection(dueThisWeek, "due_date ASC", limit); err != nil {
+		return nil, err
+	}
+
+	var counts []struct {
+		Status string
+		Count  int32
+	}
+	if err := open(scoped().Where("assigned_to = ?", userID)).
+		Select("status, COUNT(*) AS count").Group("status").Scan(&counts).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to count tasks")
+	}
+	for _, c := range counts {
+		resp.AssignedByStatus[c.Status] = c.Count
+	}
+
+	return resp, nil
+}
+
+func (s *TaskService) workSection(query *gorm.DB, order string, limit int) (*taskpb.WorkSection, error) {
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to count tasks")
+	}
+
+	var tasks []models.Task
+	if err := query.Order(order).Limit(limit).Find(&tasks).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to load tasks")
+	}
+
+	section := &taskpb.WorkSection{
+		Tasks:      make([]*taskpb.Task, len(tasks)),
+		TotalCount: int32(total),
+	}
+	for i := range tasks {
+		section.Tasks[i] = s.modelToProto(&tasks[i])
+	}
+	return section, nil
+}
+
+// weekBounds returns the Monday-to-Monday week containing t, in t's location
+func weekBounds(t time.Time) (time.Time, time.Time) {
+	offset := (int(t.Weekday()) + 6) % 7 // days since Monday
+	start := time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, t.Location())
+	return start, start.AddDate(0, 0, 7)
+}
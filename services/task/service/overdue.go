@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	notificationpb "github.com/chanduchitikam/task-management-system/proto/notification"
+	"github.com/chanduchitikam/task-management-system/services/task/models"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	overdueScanInterval = time.Minute
+	overdueBatchSize    = 100
+
+	// notificationStream is consumed by the notification service's delivery workers
+	notificationStream = "notifications:stream"
+)
+
+// isTaskOverdue reports whether an open task is past its due date at now
+func isTaskOverdue(task *models.Task, now time.Time) bool {
+	if task.DueDate == nil || !task.DueDate.Before(now) {
+		return false
+	}
+	for _, closed := range closedTaskStatuses {
+		if task.Status == closed {
+			return false
+		}
+	}
+	return true
+}
+
+// RunOverdueWorker keeps the is_overdue flag in step with due dates and sends
+// each newly overdue task's owner a single TASK_OVERDUE notification.
+func (s *TaskService) RunOverdueWorker(ctx context.Context) {
+	ticker := time.NewTicker(overdueScanInterval)
+	defer ticker.Stop()
+
+	log.Println("overdue task worker started")
+	for {
+		s.refreshOverdueFlags(time.Now())
+		for s.notifyOverdueBatch(ctx) == overdueBatchSize {
+			// keep draining while batches are full
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// refreshOverdueFlags sets and clears is_overdue in bulk. UpdateColumn leaves
+// updated_at and version alone since the flag is derived, not a user edit.
+func (s *TaskService) refreshOverdueFlags(now time.Time) {
+	if err := s.db.Model(&models.Task{}).
+		Where("is_overdue = ? AND due_date IS NOT NULL AND due_date < ? AND status NOT IN ?", false, now, closedTaskStatuses).
+		UpdateColumn("is_overdue", true).Error; err != nil {
+		log.Printf("failed to mark overdue tasks: %v", err)
+	}
+	if err := s.db.Model(&models.Task{}).
+		Where("is_overdue = ? AND (due_date IS NULL OR due_date >= ? OR status IN ?)", true, now, closedTaskStatuses).
+		UpdateColumn("is_overdue", false).Error; err != nil {
+		log.Printf("failed to clear overdue tasks: %v", err)
+	}
+}
+
+// notifyOverdueBatch notifies overdue tasks that have not been notified yet
+// and returns how many were sent. Each task is claimed by setting
+// overdue_notified_at first, so concurrent replicas never notify the same
+// task twice.
+func (s *TaskService) notifyOverdueBatch(ctx context.Context) int {
+	if s.cache == nil {
+		return 0
+	}
+
+	var tasks []models.Task
+	if err := s.db.Where("is_overdue = ? AND overdue_notified_at IS NULL", true).
+		Order("due_date ASC").
+		Limit(overdueBatchSize).
+		Find(&tasks).Error; err != nil {
+		log.Printf("failed to load overdue tasks: %v", err)
+		return 0
+	}
+
+	sent := 0
+	for i := range tasks {
+		task := &tasks[i]
+		now := time.Now()
+		claim := s.db.Model(&models.Task{}).
+			Where("id = ? AND overdue_notified_at IS NULL", task.ID).
+			UpdateColumn("overdue_notified_at", now)
+		if claim.Error != nil {
+			log.Printf("failed to claim overdue task %s: %v", task.ID, claim.Error)
+			continue
+		}
+		if claim.RowsAffected == 0 {
+			continue
+		}
+
+		if err := s.sendOverdueNotification(ctx, task, now); err != nil {
+			log.Printf("failed to send overdue notification for task %s: %v", task.ID, err)
+			// release the claim so the next scan retries
+			s.db.Model(&models.Task{}).Where("id = ?", task.ID).UpdateColumn("overdue_notified_at", nil)
+			continue
+		}
+		sent++
+	}
+	return sent
+}
+
+// sendOverdueNotification notifies the task's assignee, or its creator when
+// the task is unassigned.
+func (s *TaskService) sendOverdueNotification(ctx context.Context, task *models.Task, now time.Time) error {
+	recipient := task.CreatedBy
+	if task.AssignedTo != nil && *task.AssignedTo != "" {
+		recipient = *task.AssignedTo
+	}
+
+	label := task.Title
+	if task.TaskKey != "" {
+		label = task.TaskKey + " " + task.Title
+	}
+
+	event := &notificationpb.NotificationEvent{
+		NotificationId: uuid.New().String(),
+		UserId:         recipient,
+		Type:           notificationpb.NotificationType_NOTIFICATION_TYPE_TASK_OVERDUE,
+		Title:          "Task overdue",
+		Message:        fmt.Sprintf("%s is past its due date", label),
+		TaskId:         task.ID,
+		CreatedAt:      timestamppb.New(now),
+		Metadata: map[string]string{
+			"task_key": task.TaskKey,
+			"due_date": task.DueDate.UTC().Format(time.RFC3339),
+		},
+	}
+	if task.OrgID != nil {
+		// the org's channels are chosen by the task's org, not the recipient's
+		event.Metadata["org_id"] = *task.OrgID
+	}
+	return s.enqueueNotification(ctx, event)
+}
+
+// enqueueNotification appends event to the durable notification stream in the
+// same shape the notification service writes, so its workers deliver it
+func (s *TaskService) enqueueNotification(ctx context.Context, event *notificationpb.NotificationEvent) error {
+	payload, err := protojson.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.cache.XAdd(ctx, notificationStream, map[string]interface{}{
+		"user_id": event.UserId,
+		"payload": string(payload),
+	})
+	return err
+}
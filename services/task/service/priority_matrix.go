@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
+	taskpb "github.com/chanduchitikam/task-management-system/proto/task"
+	"github.com/chanduchitikam/task-management-system/services/task/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultUrgentWithinHours = 48
+	maxUrgentWithinHours     = 24 * 90
+	defaultMatrixPageSize    = 10
+)
+
+// importantPriorities are the priorities counted as important in the matrix
+var importantPriorities = []string{"high", "critical"}
+
+// matrixQuadrants lists the Eisenhower quadrants in display order
+var matrixQuadrants = []struct {
+	name      string
+	urgent    bool
+	important bool
+}{
+	{"do_first", true, true},
+	{"schedule", false, true},
+	{"delegate", true, false},
+	{"eliminate", false, false},
+}
+
+// GetPriorityMatrix buckets open tasks for a user or team by urgency (due
+// date) and importance (priority)
+func (s *TaskService) GetPriorityMatrix(ctx context.Context, req *taskpb.GetPriorityMatrixRequest) (*taskpb.GetPriorityMatrixResponse, error) {
+	userID, orgID, role := s.extractAuth(ctx)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	hours := req.UrgentWithinHours
+	if hours < 0 || hours > maxUrgentWithinHours {
+		return nil, status.Errorf(codes.InvalidArgument, "urgent_within_hours must be between 1 and %d", maxUrgentWithinHours)
+	}
+	if hours == 0 {
+		hours = defaultUrgentWithinHours
+	}
+
+	found := req.Quadrant == ""
+	for _, q := range matrixQuadrants {
+		if q.name == req.Quadrant {
+			found = true
+		}
+	}
+	if !found {
+		return nil, status.Error(codes.InvalidArgument, "quadrant must be do_first, schedule, delegate or eliminate")
+	}
+
+	page := req.Page
+	if page < 1 || req.Quadrant == "" {
+		page = 1
+	}
+	pageSize := req.PageSize
+	if pageSize < 1 {
+		pageSize = defaultMatrixPageSize
+	}
+	if pageSize > maxCollectionPageSize {
+		pageSize = maxCollectionPageSize
+	}
+
+	var base *gorm.DB
+	if req.TeamId != "" {
+		var isMember func(context.Context, string, string) (bool, error)
+		if s.membership != nil {
+			isMember = s.membership.IsTeamMember
+		}
+		q, err := s.collectionQuery(ctx, "team_id", req.TeamId, isMember)
+		if err != nil {
+			return nil, err
+		}
+		base = q
+	} else {
+		target := req.UserId
+		if target == "" {
+			target = userID
+		}
+		if target != userID && !authz.Can(role, authz.TaskViewOthers) {
+			return nil, status.Error(codes.PermissionDenied, "only admins can view another user's matrix")
+		}
+		base = s.db.Model(&models.Task{}).Where("assigned_to = ?", target)
+		if orgID != "" {
+			base = base.Where("org_id = ?", orgID)
+		} else {
+			base = base.Where("org_id IS NULL")
+		}
+	}
+	base = base.Where("status NOT IN ?", closedTaskStatuses)
+
+	urgentBefore := time.Now().Add(time.Duration(hours) * time.Hour)
+	resp := &taskpb.GetPriorityMatrixResponse{UrgentBefore: timestamppb.New(urgentBefore)}
+	for _, q := range matrixQuadrants {
+		if req.Quadrant != "" && q.name != req.Quadrant {
+			continue
+		}
+
+		query := base.Session(&gorm.Session{})
+		if q.urgent {
+			query = query.Where("due_date IS NOT NULL AND due_date < ?", urgentBefore)
+		} else {
+			query = query.Where("(due_date IS NULL OR due_date >= ?)", urgentBefore)
+		}
+		if q.important {
+			query = query.Where("priority IN ?", importantPriorities)
+		} else {
+			query = query.Where("priority NOT IN ?", importantPriorities)
+		}
+
+		var total int64
+		if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+			return nil, status.Error(codes.Internal, "failed to count tasks")
+		}
+		var tasks []models.Task
+		if err := query.Order("due_date IS NULL, due_date ASC, created_at ASC").
+			Offset(int((page - 1) * pageSize)).
+			Limit(int(pageSize)).
+			Find(&tasks).Error; err != nil {
+			return nil, status.Error(codes.Internal, "failed to list tasks")
+		}
+
+		quadrant := &taskpb.MatrixQuadrant{
+			Name:       q.name,
+			Urgent:     q.urgent,
+			Important:  q.important,
+			Tasks:      make([]*taskpb.Task, len(tasks)),
+			TotalCount: int32(total),
+			Page:       page,
+			PageSize:   pageSize,
+		}
+		for i := range tasks {
+			quadrant.Tasks[i] = s.modelToProto(&tasks[i])
+		}
+		resp.Quadrants = append(resp.Quadrants, quadrant)
+	}
+	return resp, nil
+}
@@ -0,0 +1,288 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	notificationpb "github.com/chanduchitikam/task-management-system/proto/notification"
+	taskpb "github.com/chanduchitikam/task-management-system/proto/task"
+	"github.com/chanduchitikam/task-management-system/services/task/models"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+const (
+	maxRemindersPerTask    = 10
+	reminderPollInterval   = 30 * time.Second
+	reminderBatchSize      = 100
+	maxReminderLeadMinutes = 60 * 24 * 365
+)
+
+// CreateTaskReminder sets a reminder for the caller on a task they can see
+func (s *TaskService) CreateTaskReminder(ctx context.Context, req *taskpb.CreateTaskReminderRequest) (*taskpb.CreateTaskReminderResponse, error) {
+	userID, _, _ := s.extractAuth(ctx)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	if (req.RemindAt == nil) == (req.BeforeDueMinutes == 0) {
+		return nil, status.Error(codes.InvalidArgument, "set exactly one of remind_at or before_due_minutes")
+	}
+	if req.BeforeDueMinutes < 0 || req.BeforeDueMinutes > maxReminderLeadMinutes {
+		return nil, status.Error(codes.InvalidArgument, "before_due_minutes must be between 1 and 525600")
+	}
+
+	// GetTask applies the visibility rules and resolves task keys
+	resp, err := s.GetTask(ctx, &taskpb.GetTaskRequest{TaskId: req.TaskId})
+	if err != nil {
+		return nil, err
+	}
+	task := resp.Task
+
+	reminder := &models.TaskReminder{
+		TaskID:           task.TaskId,
+		UserID:           userID,
+		BeforeDueMinutes: int(req.BeforeDueMinutes),
+	}
+	if req.RemindAt != nil {
+		remindAt := req.RemindAt.AsTime()
+		reminder.RemindAt = &remindAt
+		reminder.FireAt = remindAt
+	} else {
+		if task.DueDate == nil {
+			return nil, status.Error(codes.FailedPrecondition, "task has no due date")
+		}
+		reminder.FireAt = reminderFireAt(task.DueDate.AsTime(), reminder.BeforeDueMinutes)
+	}
+	if !reminder.FireAt.After(time.Now()) {
+		return nil, status.Error(codes.InvalidArgument, "reminder time must be in the future")
+	}
+
+	var count int64
+	if err := s.db.Model(&models.TaskReminder{}).
+		Where("task_id = ? AND user_id = ? AND sent_at IS NULL", task.TaskId, userID).
+		Count(&count).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to count reminders")
+	}
+	if count >= maxRemindersPerTask {
+		return nil, status.Errorf(codes.ResourceExhausted, "at most %d pending reminders per task", maxRemindersPerTask)
+	}
+
+	if err := s.db.Create(reminder).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to create reminder")
+	}
+
+	return &taskpb.CreateTaskReminderResponse{
+		Reminder: reminderToProto(reminder),
+		Message:  "Reminder created successfully",
+	}, nil
+}
+
+// ListTaskReminders lists the caller's reminders on a task
+func (s *TaskService) ListTaskReminders(ctx context.Context, req *taskpb.ListTaskRemindersRequest) (*taskpb.ListTaskRemindersResponse, error) {
+	userID, _, _ := s.extractAuth(ctx)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	resp, err := s.GetTask(ctx, &taskpb.GetTaskRequest{TaskId: req.TaskId})
+	if err != nil {
+		return nil, err
+	}
+
+	var reminders []models.TaskReminder
+	if err := s.db.Where("task_id = ? AND user_id = ?", resp.Task.TaskId, userID).
+		Order("fire_at ASC").
+		Find(&reminders).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to list reminders")
+	}
+
+	out := &taskpb.ListTaskRemindersResponse{Reminders: make([]*taskpb.TaskReminder, len(reminders))}
+	for i := range reminders {
+		out.Reminders[i] = reminderToProto(&reminders[i])
+	}
+	return out, nil
+}
+
+// DeleteTaskReminder removes one of the caller's reminders
+func (s *TaskService) DeleteTaskReminder(ctx context.Context, req *taskpb.DeleteTaskReminderRequest) (*taskpb.DeleteTaskReminderResponse, error) {
+	if req.ReminderId == "" {
+		return nil, status.Error(codes.InvalidArgument, "reminder_id is required")
+	}
+	userID, _, _ := s.extractAuth(ctx)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	query := s.db.Where("id = ? AND user_id = ?", req.ReminderId, userID)
+	if req.TaskId != "" && !isTaskKey(req.TaskId) {
+		query = query.Where("task_id = ?", req.TaskId)
+	}
+	result := query.Delete(&models.TaskReminder{})
+	if result.Error != nil {
+		return nil, status.Error(codes.Internal, "failed to delete reminder")
+	}
+	if result.RowsAffected == 0 {
+		return nil, status.Error(codes.NotFound, "reminder not found")
+	}
+
+	return &taskpb.DeleteTaskReminderResponse{Message: "Reminder deleted successfully"}, nil
+}
+
+// rescheduleReminders moves relative reminders after a due date change.
+// Reminders pushed back into the future are re-armed so they fire again.
+func (s *TaskService) rescheduleReminders(task *models.Task) {
+	var reminders []models.TaskReminder
+	if err := s.db.Where("task_id = ? AND remind_at IS NULL", task.ID).Find(&reminders).Error; err != nil {
+		log.Printf("failed to load reminders for task %s: %v", task.ID, err)
+		return
+	}
+
+	now := time.Now()
+	for i := range reminders {
+		r := &reminders[i]
+		updates := map[string]interface{}{}
+		if task.DueDate == nil {
+			// Nothing to be relative to; park the reminder as sent
+			if r.SentAt == nil {
+				updates["sent_at"] = now
+			}
+		} else {
+			fireAt := reminderFireAt(*task.DueDate, r.BeforeDueMinutes)
+			updates["fire_at"] = fireAt
+			if fireAt.After(now) {
+				updates["sent_at"] = nil
+			}
+		}
+		if len(updates) == 0 {
+			continue
+		}
+		if err := s.db.Model(r).Updates(updates).Error; err != nil {
+			log.Printf("failed to reschedule reminder %s: %v", r.ID, err)
+		}
+	}
+}
+
+// RunReminderWorker publishes due reminders until ctx is cancelled
+func (s *TaskService) RunReminderWorker(ctx context.Context) {
+	ticker := time.NewTicker(reminderPollInterval)
+	defer ticker.Stop()
+
+	log.Println("task reminder worker started")
+	for {
+		for s.sendReminderBatch(ctx) == reminderBatchSize {
+			// keep draining while batches are full
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// sendReminderBatch sends due reminders on open tasks and returns how many
+// were sent. Reminders are claimed by setting sent_at before publishing so
+// concurrent replicas never send one twice.
+func (s *TaskService) sendReminderBatch(ctx context.Context) int {
+	if s.cache == nil {
+		return 0
+	}
+
+	openTasks := s.db.Model(&models.Task{}).Select("id").Where("status NOT IN ?", closedTaskStatuses)
+	var reminders []models.TaskReminder
+	if err := s.db.Where("sent_at IS NULL AND fire_at <= ? AND task_id IN (?)", time.Now(), openTasks).
+		Order("fire_at ASC").
+		Limit(reminderBatchSize).
+		Find(&reminders).Error; err != nil {
+		log.Printf("failed to load due reminders: %v", err)
+		return 0
+	}
+
+	sent := 0
+	for i := range reminders {
+		r := &reminders[i]
+		now := time.Now()
+		claim := s.db.Model(&models.TaskReminder{}).
+			Where("id = ? AND sent_at IS NULL", r.ID).
+			UpdateColumn("sent_at", now)
+		if claim.Error != nil {
+			log.Printf("failed to claim reminder %s: %v", r.ID, claim.Error)
+			continue
+		}
+		if claim.RowsAffected == 0 {
+			continue
+		}
+
+		if err := s.sendReminderNotification(ctx, r, now); err != nil {
+			log.Printf("failed to send reminder %s: %v", r.ID, err)
+			// release the claim so the next poll retries
+			s.db.Model(&models.TaskReminder{}).Where("id = ?", r.ID).UpdateColumn("sent_at", nil)
+			continue
+		}
+		sent++
+	}
+	return sent
+}
+
+func (s *TaskService) sendReminderNotification(ctx context.Context, r *models.TaskReminder, now time.Time) error {
+	var task models.Task
+	if err := s.db.Where("id = ?", r.TaskID).First(&task).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// task is gone; treat the reminder as delivered
+			return nil
+		}
+		return err
+	}
+
+	label := task.Title
+	if task.TaskKey != "" {
+		label = task.TaskKey + " " + task.Title
+	}
+	message := fmt.Sprintf("Reminder: %s", label)
+	metadata := map[string]string{"reminder_id": r.ID, "task_key": task.TaskKey}
+	if task.OrgID != nil {
+		metadata["org_id"] = *task.OrgID
+	}
+	if task.DueDate != nil {
+		message = fmt.Sprintf("Reminder: %s is due %s", label, task.DueDate.UTC().Format("Jan 2 15:04 MST"))
+		metadata["due_date"] = task.DueDate.UTC().Format(time.RFC3339)
+	}
+
+	return s.enqueueNotification(ctx, &notificationpb.NotificationEvent{
+		NotificationId: uuid.New().String(),
+		UserId:         r.UserID,
+		Type:           notificationpb.NotificationType_NOTIFICATION_TYPE_TASK_DUE_SOON,
+		Title:          "Task reminder",
+		Message:        message,
+		TaskId:         task.ID,
+		CreatedAt:      timestamppb.New(now),
+		Metadata:       metadata,
+	})
+}
+
+func reminderFireAt(due time.Time, beforeDueMinutes int) time.Time {
+	return due.Add(-time.Duration(beforeDueMinutes) * time.Minute)
+}
+
+func reminderToProto(r *models.TaskReminder) *taskpb.TaskReminder {
+	pb := &taskpb.TaskReminder{
+		ReminderId:       r.ID,
+		TaskId:           r.TaskID,
+		UserId:           r.UserID,
+		BeforeDueMinutes: int32(r.BeforeDueMinutes),
+		FireAt:           timestamppb.New(r.FireAt),
+		CreatedAt:        timestamppb.New(r.CreatedAt),
+	}
+	if r.RemindAt != nil {
+		pb.RemindAt = timestamppb.New(*r.RemindAt)
+	}
+	if r.SentAt != nil {
+		pb.SentAt = timestamppb.New(*r.SentAt)
+	}
+	return pb
+}
@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	taskpb "github.com/chanduchitikam/task-management-system/proto/task"
+	"github.com/chanduchitikam/task-management-system/services/task/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultStatsDays = 30
+	maxStatsDays     = 365
+)
+
+// GetTaskStats aggregates task counts, completion trend, cycle time and
+// assignee load server-side so dashboards don't have to page through every
+// task.
+func (s *TaskService) GetTaskStats(ctx context.Context, req *taskpb.GetTaskStatsRequest) (*taskpb.GetTaskStatsResponse, error) {
+	userID, orgID, role := s.extractAuth(ctx)
+	base, err := s.visibleTasksQuery(userID, orgID, role)
+	if err != nil {
+		return nil, err
+	}
+	base = s.applyTaskFilters(base, taskFilters{
+		TeamID:  req.TeamId,
+		GroupID: req.GroupId,
+		Project: req.ProjectId,
+	})
+	scoped := func() *gorm.DB { return base.Session(&gorm.Session{}) }
+
+	days := int(req.Days)
+	if days < 1 {
+		days = defaultStatsDays
+	}
+	if days > maxStatsDays {
+		days = maxStatsDays
+	}
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	windowStart := today.AddDate(0, 0, -(days - 1))
+
+	resp := &taskpb.GetTaskStatsResponse{
+		ByStatus:   map[string]int32{},
+		ByPriority: map[string]int32{},
+	}
+
+	var grouped []struct {
+		Key   string
+		Count int32
+	}
+	if err := scoped().Select("status AS key, COUNT(*) AS count").Group("status").Scan(&grouped).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to count tasks by status")
+	}
+	for _, g := range grouped {
+		resp.ByStatus[g.Key] = g.Count
+		resp.Total += g.Count
+	}
+
+	grouped = nil
+	if err := scoped().Select("priority AS key, COUNT(*) AS count").Group("priority").Scan(&grouped).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to count tasks by priority")
+	}
+	for _, g := range grouped {
+		resp.ByPriority[g.Key] = g.Count
+	}
+
+	// Trend and cycle time are bucketed in Go to stay portable across databases
+	var created []time.Time
+	if err := scoped().Where("created_at >= ?", windowStart).Pluck("created_at", &created).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to load task trend")
+	}
+	var completed []models.Task
+	if err := scoped().Select("id, assigned_to, created_at, started_at, completed_at").
+		Where("status = ? AND completed_at >= ?", "completed", windowStart).
+		Find(&completed).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to load completed tasks")
+	}
+
+	points := make([]*taskpb.TaskTrendPoint, days)
+	index := make(map[string]*taskpb.TaskTrendPoint, days)
+	for i := 0; i < days; i++ {
+		date := windowStart.AddDate(0, 0, i).Format("2006-01-02")
+		points[i] = &taskpb.TaskTrendPoint{Date: date}
+		index[date] = points[i]
+	}
+	for _, t := range created {
+		if p, ok := index[t.UTC().Format("2006-01-02")]; ok {
+			p.Created++
+		}
+	}
+
+	completedBy := map[string]int32{}
+	var cycleTotal time.Duration
+	for _, t := range completed {
+		if p, ok := index[t.CompletedAt.UTC().Format("2006-01-02")]; ok {
+			p.Completed++
+		}
+		start := t.CreatedAt
+		if t.StartedAt != nil {
+			start = *t.StartedAt
+		}
+		if d := t.CompletedAt.Sub(start); d > 0 {
+			cycleTotal += d
+		}
+		if t.AssignedTo != nil {
+			completedBy[*t.AssignedTo]++
+		}
+	}
+	resp.Trend = points
+	resp.CompletedInWindow = int32(len(completed))
+	if len(completed) > 0 {
+		resp.AvgCycleTimeHours = cycleTotal.Hours() / float64(len(completed))
+	}
+
+	var loads []struct {
+		AssignedTo string
+		Open       int32
+		InProgress int32
+		Overdue    int32
+	}
+	if err := scoped().
+		Select(`assigned_to,
+			COUNT(*) AS open,
+			SUM(CASE WHEN status = 'in_progress' THEN 1 ELSE 0 END) AS in_progress,
+			SUM(CASE WHEN due_date IS NOT NULL AND due_date < ? THEN 1 ELSE 0 END) AS overdue`, now).
+		Where("assigned_to IS NOT NULL AND status NOT IN ?", closedTaskStatuses).
+		Group("assigned_to").
+		Scan(&loads).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to compute assignee load")
+	}
+
+	byUser := map[string]*taskpb.AssigneeLoad{}
+	for _, l := range loads {
+		byUser[l.AssignedTo] = &taskpb.AssigneeLoad{
+			UserId:     l.AssignedTo,
+			Open:       l.Open,
+			InProgress: l.InProgress,
+			Overdue:    l.Overdue,
+		}
+	}
+	for userID, n := range completedBy {
+		load, ok := byUser[userID]
+		if !ok {
+			load = &taskpb.AssigneeLoad{UserId: userID}
+			byUser[userID] = load
+		}
+		load.CompletedInWindow = n
+	}
+	for _, load := range byUser {
+		resp.AssigneeLoad = append(resp.AssigneeLoad, load)
+	}
+	sort.Slice(resp.AssigneeLoad, func(i, j int) bool {
+		a, b := resp.AssigneeLoad[i], resp.AssigneeLoad[j]
+		if a.Open != b.Open {
+			return a.Open > b.Open
+		}
+		return a.UserId < b.UserId
+	})
+
+	return resp, nil
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const defaultTaskKeyPrefix = "TASK"
+
+var (
+	projectKeyPattern = regexp.MustCompile(`^[A-Z][A-Z0-9]{1,9}$`)
+	taskKeyPattern    = regexp.MustCompile(`^[A-Z][A-Z0-9]{1,9}-[0-9]+$`)
+)
+
+var (
+	// errProjectNotFound is returned when a task names a project that doesn't exist
+	errProjectNotFound = errors.New("project not found")
+	// errProjectKeyMismatch is returned when a task asks for a prefix other
+	// than its project's
+	errProjectKeyMismatch = errors.New("project key does not match the project")
+)
+
+// normalizeProjectKey upper-cases the requested prefix. It returns false for
+// prefixes that would make ambiguous keys.
+func normalizeProjectKey(key string) (string, bool) {
+	key = strings.ToUpper(strings.TrimSpace(key))
+	return key, key == "" || projectKeyPattern.MatchString(key)
+}
+
+// projectKeyBase derives a key prefix from a project name: the initials of a
+// name of several words, or the start of a single word
+func projectKeyBase(name string) string {
+	words := strings.FieldsFunc(strings.ToUpper(name), func(r rune) bool {
+		return (r < 'A' || r > 'Z') && (r < '0' || r > '9')
+	})
+	base := ""
+	if len(words) == 1 {
+		base = words[0]
+	} else {
+		for _, w := range words {
+			base += w[:1]
+		}
+	}
+	base = strings.TrimLeft(base, "0123456789")
+	if len(base) > 4 {
+		base = base[:4]
+	}
+	if len(base) < 2 {
+		return "PROJ"
+	}
+	return base
+}
+
+// projectTaskKeyPrefix returns the key prefix of orgID's projectID, deriving
+// one from its name and storing it on the project the first time. A derived
+// prefix is unique in the org and not used by any of the org's existing task
+// keys, so the project's keys can't collide with older ones. The project row
+// stays locked until tx ends.
+func projectTaskKeyPrefix(tx *gorm.DB, orgID, projectID string) (string, error) {
+	var project struct {
+		OrgID  string
+		Name   string
+		Prefix *string
+	}
+	err := tx.Raw("SELECT org_id, name, task_key_prefix AS prefix FROM projects WHERE id = ? AND org_id = ? FOR UPDATE",
+		projectID, orgID).Scan(&project).Error
+	if err != nil {
+		return "", err
+	}
+	if project.OrgID == "" {
+		return "", errProjectNotFound
+	}
+	if project.Prefix != nil && *project.Prefix != "" {
+		return *project.Prefix, nil
+	}
+
+	base := projectKeyBase(project.Name)
+	for n := 1; ; n++ {
+		prefix := base
+		if n > 1 {
+			suffix := strconv.Itoa(n)
+			prefix = base[:min(len(base), 10-len(suffix))] + suffix
+		}
+		if prefix == defaultTaskKeyPrefix {
+			continue
+		}
+		var taken int64
+		err := tx.Raw(`SELECT (SELECT COUNT(*) FROM projects WHERE org_id = ? AND task_key_prefix = ?)
+			+ (SELECT COUNT(*) FROM tasks WHERE org_id = ? AND task_key LIKE ?)`,
+			project.OrgID, prefix, project.OrgID, prefix+"-%").Scan(&taken).Error
+		if err != nil {
+			return "", err
+		}
+		if taken > 0 {
+			continue
+		}
+		if err := tx.Exec("UPDATE projects SET task_key_prefix = ? WHERE id = ?", prefix, projectID).Error; err != nil {
+			return "", err
+		}
+		return prefix, nil
+	}
+}
+
+// isTaskKey reports whether ref looks like a task key rather than a UUID.
+func isTaskKey(ref string) bool {
+	if _, err := uuid.Parse(ref); err == nil {
+		return false
+	}
+	return taskKeyPattern.MatchString(strings.ToUpper(ref))
+}
+
+// whereTaskRef scopes a query to a task by UUID or by task key.
+func whereTaskRef(db *gorm.DB, ref string) *gorm.DB {
+	if isTaskKey(ref) {
+		return db.Where("task_key = ?", strings.ToUpper(ref))
+	}
+	return db.Where("id = ?", ref)
+}
+
+// nextTaskKey atomically bumps the sequence for scopeID/prefix and returns
+// the formatted key. Project tasks are sequenced with the project id as
+// scopeID, other tasks with their org or creator. It must run inside the
+// transaction that creates the task so a failed insert does not burn a
+// number.
+func nextTaskKey(tx *gorm.DB, scopeID, prefix string) (string, error) {
+	var next int64
+	err := tx.Raw(`INSERT INTO task_key_sequences (scope_id, prefix, last_value, updated_at)
+		VALUES (?, ?, 1, CURRENT_TIMESTAMP)
+		ON CONFLICT (scope_id, prefix)
+		DO UPDATE SET last_value = task_key_sequences.last_value + 1, updated_at = CURRENT_TIMESTAMP
+		RETURNING last_value`, scopeID, prefix).Scan(&next).Error
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s-%d", prefix, next), nil
+}
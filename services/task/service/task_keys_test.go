@@ -0,0 +1,85 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeProjectKey(t *testing.T) {
+	tests := []struct {
+		key   string
+		want  string
+		valid bool
+	}{
+		{"", "", true},
+		{"  ", "", true},
+		{"web", "WEB", true},
+		{" Ops2 ", "OPS2", true},
+		{"ABCDEFGHIJ", "ABCDEFGHIJ", true},
+		{"A", "A", false},
+		{"2FA", "2FA", false},
+		{"ABCDEFGHIJK", "ABCDEFGHIJK", false},
+		{"WEB-1", "WEB-1", false},
+		{"WEB APP", "WEB APP", false},
+		{"ÉTÉ", "ÉTÉ", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			got, valid := normalizeProjectKey(tt.key)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.valid, valid)
+		})
+	}
+}
+
+func TestProjectKeyBase(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"Website", "WEBS"},
+		{"ops", "OPS"},
+		{"Mobile App Redesign", "MAR"},
+		{"mobile-app", "MA"},
+		{"Q3 Planning", "QP"},
+		{"One Two Three Four Five", "OTTF"},
+		{"2024 Roadmap", "PROJ"},
+		{"2024", "PROJ"},
+		{"X", "PROJ"},
+		{"", "PROJ"},
+		{"!!!", "PROJ"},
+		{"Été", "PROJ"},
+		{"api v2", "AV"},
+		{"3D Printing Lab", "PL"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := projectKeyBase(tt.name)
+			assert.Equal(t, tt.want, got)
+			_, valid := normalizeProjectKey(got)
+			assert.True(t, valid, "derived prefix %q must be a valid project key", got)
+		})
+	}
+}
+
+func TestIsTaskKey(t *testing.T) {
+	tests := []struct {
+		ref  string
+		want bool
+	}{
+		{"WEB-12", true},
+		{"web-12", true},
+		{"OPS2-1", true},
+		{"W-1", false},
+		{"WEB-", false},
+		{"WEB12", false},
+		{"12-WEB", false},
+		{"3f8a1c2e-0b5d-4c7e-9a61-2d4f6b8e0c13", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ref, func(t *testing.T) {
+			assert.Equal(t, tt.want, isTaskKey(tt.ref))
+		})
+	}
+}
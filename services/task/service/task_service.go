@@ -2,19 +2,27 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"log"
 	"strings"
+	"time"
 
 	"github.com/chanduchitikam/task-management-system/pkg/auth"
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
 	"github.com/chanduchitikam/task-management-system/pkg/cache"
 	"github.com/chanduchitikam/task-management-system/pkg/config"
+	"github.com/chanduchitikam/task-management-system/pkg/encryption"
 	taskpb "github.com/chanduchitikam/task-management-system/proto/task"
 	"github.com/chanduchitikam/task-management-system/services/task/models"
+	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // // // TaskService implements the TaskService gRPC service
@@ -22,6 +30,15 @@ type TaskService struct {
 	taskpb.UnimplementedTaskServiceServer
 	db    *gorm.DB
 	cache *cache.RedisClient
+	// membership validates assignees when configured
+	membership            MembershipVerifier
+	requireTeamMembership bool
+	// mentions resolves @username mentions when configured
+	mentions UsernameResolver
+	// secrets seals webhook signing secrets when configured
+	secrets *encryption.Keyring
+	// feedTokens signs calendar feed tokens when configured
+	feedTokens *auth.JWTManager
 }
 
 // extractAuth reads auth info from the context. It first checks context values
@@ -55,8 +72,11 @@ func (s *TaskService) extractAuth(ctx context.Context) (userID, orgID, role stri
 					token := strings.TrimSpace(strings.TrimPrefix(authVals[0], "Bearer"))
 					if token != "" {
 						if cfg, err := config.LoadConfig(); err == nil {
-							jm := auth.NewJWTManager(cfg.JWT.SecretKey, cfg.JWT.AccessTokenDuration, cfg.JWT.RefreshTokenDuration)
-							if claims, err := jm.ValidateToken(token); err == nil {
+							// JWKS keys are cached per URL, so this is cheap
+							// past the first call
+							if jm, err := auth.NewJWTManagerFromConfig(cfg.JWT); err != nil {
+								log.Printf("warning: invalid JWT config: %v", err)
+							} else if claims, err := jm.ValidateToken(token); err == nil {
 								if claims.UserID != "" {
 									userID = claims.UserID
 								}
@@ -123,11 +143,21 @@ func (s *TaskService) CreateTask(ctx context.Context, req *taskpb.CreateTaskRequ
 		createdBy = userID
 	}
 
-	// Enforce assignment rules: if creating within an org, non-admins must assign to team/group.
-	if orgID != "" && role != "admin" && req.TeamId == "" && req.GroupId == "" && req.AssignedTo == "" {
+	// Guests' tasks belong to their project, which stands in for an assignee
+	if authz.IsGuest(role) {
+		if err := s.ensureGuestProject(userID, orgID, req.ProjectId); err != nil {
+			return nil, err
+		}
+	} else if orgID != "" && !authz.Can(role, authz.TaskAssignAny) && req.TeamId == "" && req.GroupId == "" && req.AssignedTo == "" {
+		// Enforce assignment rules: if creating within an org, non-admins must assign to team/group.
 		return nil, status.Error(codes.PermissionDenied, "Non-admins must assign tasks to a team, group, or user")
 	}
 
+	requestedPrefix, ok := normalizeProjectKey(req.ProjectKey)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "project_key must be 2-10 letters or digits, starting with a letter")
+	}
+
 	// 	// 	// Respect the requested status, default to "todo" if not specified
 	taskStatus := "todo"
 	if req.Status != taskpb.TaskStatus_TASK_STATUS_UNSPECIFIED {
@@ -145,9 +175,9 @@ func (s *TaskService) CreateTask(ctx context.Context, req *taskpb.CreateTaskRequ
 		Description: req.Description,
 		Priority:    taskPriority,
 		CreatedBy:   createdBy,
-		Status:      taskStatus,
 		Tags:        strings.Join(req.Tags, ","),
 	}
+	setTaskStatus(task, taskStatus)
 	if orgID != "" {
 		task.OrgID = &orgID
 	}
@@ -160,15 +190,64 @@ func (s *TaskService) CreateTask(ctx context.Context, req *taskpb.CreateTaskRequ
 	if req.GroupId != "" {
 		task.GroupID = &req.GroupId
 	}
+	if req.ProjectId != "" {
+		if err := s.ensureProjectOpen(orgID, req.ProjectId); err != nil {
+			return nil, err
+		}
+		task.ProjectID = &req.ProjectId
+	}
 
 	if req.DueDate != nil {
 		dueDate := req.DueDate.AsTime()
 		task.DueDate = &dueDate
 	}
+	task.IsOverdue = isTaskOverdue(task, time.Now())
+	if err := s.verifyAssignee(ctx, orgID, task.TeamID, req.AssignedTo); err != nil {
+		return nil, err
+	}
+	if err := s.checkWIPLimit(task, task.Status); err != nil {
+		return nil, err
+	}
 
-	if err := s.db.Create(task).Error; err != nil {
+	// Keys are sequenced per project under the project's prefix, otherwise
+	// per org, or per creator for personal tasks.
+	keyScope, keyPrefix := createdBy, defaultTaskKeyPrefix
+	if orgID != "" {
+		keyScope = orgID
+	}
+	if req.ProjectId != "" {
+		keyScope = req.ProjectId
+	}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if req.ProjectId != "" {
+			prefix, err := projectTaskKeyPrefix(tx, orgID, req.ProjectId)
+			if err != nil {
+				return err
+			}
+			keyPrefix = prefix
+		}
+		if requestedPrefix != "" && requestedPrefix != keyPrefix {
+			return errProjectKeyMismatch
+		}
+		key, err := nextTaskKey(tx, keyScope, keyPrefix)
+		if err != nil {
+			return err
+		}
+		task.TaskKey = key
+		return tx.Create(task).Error
+	})
+	if errors.Is(err, errProjectNotFound) {
+		return nil, status.Error(codes.NotFound, "project not found")
+	}
+	if errors.Is(err, errProjectKeyMismatch) {
+		return nil, status.Errorf(codes.InvalidArgument, "project_key must be empty or %s, the prefix of the task's project", keyPrefix)
+	}
+	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to create task")
 	}
+	s.publishTaskEvent(EventTaskCreated, task)
+	s.notifyMentions(task, createdBy, task.Description, "", "task description")
 
 	return &taskpb.CreateTaskResponse{
 		Task:    s.modelToProto(task),
@@ -185,23 +264,10 @@ func (s *TaskService) GetTask(ctx context.Context, req *taskpb.GetTaskRequest) (
 	userID, orgID, role := s.extractAuth(ctx)
 
 	var task models.Task
-	query := s.db.Where("id = ?", req.TaskId)
-	if orgID != "" {
-		query = query.Where("org_id = ?", orgID)
-	} else {
-		// Caller not in any org: restrict to org-less tasks.
-		if role == "admin" {
-			query = query.Where("org_id IS NULL")
-		} else {
-			// Non-admins without an org can only access their own tasks (created_by or assigned_to)
-			// userID already extracted above
-			if userID == "" {
-				return nil, status.Error(codes.Unauthenticated, "authentication required")
-			}
-			query = query.Where("org_id IS NULL AND (assigned_to = ? OR created_by = ?)", userID, userID)
-		}
+	query, err := s.scopeToTaskAccess(whereTaskRef(s.db, req.TaskId), userID, orgID, role, authz.TaskViewAll)
+	if err != nil {
+		return nil, err
 	}
-
 	if err := query.First(&task).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, status.Error(codes.NotFound, "task not found")
@@ -219,28 +285,15 @@ func (s *TaskService) UpdateTask(ctx context.Context, req *taskpb.UpdateTaskRequ
 	if req.TaskId == "" {
 		return nil, status.Error(codes.InvalidArgument, "task_id is required")
 	}
+	if req.Version < 1 {
+		return nil, status.Error(codes.InvalidArgument, "version is required")
+	}
 	userID, orgID, role := s.extractAuth(ctx)
 
 	var task models.Task
-	query := s.db.Where("id = ?", req.TaskId)
-
-	// Authorization logic: Users can access tasks they created OR tasks in their org
-	if orgID != "" {
-		// User is part of an org: allow access to org tasks OR personal tasks they created
-		if userID != "" {
-			query = query.Where("(org_id = ? OR (org_id IS NULL AND created_by = ?))", orgID, userID)
-		} else {
-			query = query.Where("org_id = ?", orgID)
-		}
-	} else {
-		if role == "admin" {
-			query = query.Where("org_id IS NULL")
-		} else {
-			if userID == "" {
-				return nil, status.Error(codes.Unauthenticated, "authentication required")
-			}
-			query = query.Where("org_id IS NULL AND (created_by = ? OR assigned_to = ?)", userID, userID)
-		}
+	query, err := s.scopeToTaskAccess(s.db.Where("id = ?", req.TaskId), userID, orgID, role, authz.TaskManageAll)
+	if err != nil {
+		return nil, err
 	}
 	if err := query.First(&task).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -249,6 +302,9 @@ func (s *TaskService) UpdateTask(ctx context.Context, req *taskpb.UpdateTaskRequ
 		return nil, status.Error(codes.Internal, "failed to find task")
 	}
 
+	previousDescription := task.Description
+	previousStatus := task.Status
+
 	// 	// 	// Update fields
 	if req.Title != "" {
 		task.Title = req.Title
@@ -257,12 +313,21 @@ func (s *TaskService) UpdateTask(ctx context.Context, req *taskpb.UpdateTaskRequ
 		task.Description = req.Description
 	}
 	if req.Status != taskpb.TaskStatus_TASK_STATUS_UNSPECIFIED {
-		task.Status = s.statusToString(req.Status)
+		newStatus := s.statusToString(req.Status)
+		if newStatus != task.Status {
+			if err := s.checkWIPLimit(&task, newStatus); err != nil {
+				return nil, err
+			}
+		}
+		setTaskStatus(&task, newStatus)
 	}
 	if req.Priority != taskpb.TaskPriority_TASK_PRIORITY_UNSPECIFIED {
 		task.Priority = s.priorityToString(req.Priority)
 	}
 	if req.AssignedTo != "" {
+		if err := s.verifyAssignee(ctx, derefString(task.OrgID), task.TeamID, req.AssignedTo); err != nil {
+			return nil, err
+		}
 		task.AssignedTo = &req.AssignedTo
 	}
 	if req.DueDate != nil {
@@ -273,9 +338,19 @@ func (s *TaskService) UpdateTask(ctx context.Context, req *taskpb.UpdateTaskRequ
 		task.Tags = strings.Join(req.Tags, ",")
 	}
 
-	if err := s.db.Save(&task).Error; err != nil {
-		return nil, status.Error(codes.Internal, "failed to update task")
+	if err := s.saveTaskVersioned(&task, req.Version); err != nil {
+		return nil, err
+	}
+	if req.DueDate != nil {
+		s.rescheduleReminders(&task)
+	}
+	if task.Description != previousDescription {
+		s.notifyMentions(&task, userID, task.Description, previousDescription, "task description")
+	}
+	if task.Status == "completed" && previousStatus != "completed" {
+		s.recordProjectTaskCompleted(&task, userID)
 	}
+	s.publishTaskEvent(EventTaskUpdated, &task)
 
 	return &taskpb.UpdateTaskResponse{
 		Task:    s.modelToProto(&task),
@@ -290,20 +365,12 @@ func (s *TaskService) DeleteTask(ctx context.Context, req *taskpb.DeleteTaskRequ
 	}
 	userID, orgID, role := s.extractAuth(ctx)
 
-	query := s.db.Where("id = ?", req.TaskId)
-	if orgID != "" {
-		query = query.Where("org_id = ?", orgID)
-	} else {
-		if role == "admin" {
-			query = query.Where("org_id IS NULL")
-		} else {
-			if userID == "" {
-				return nil, status.Error(codes.Unauthenticated, "authentication required")
-			}
-			query = query.Where("org_id IS NULL AND (created_by = ? OR assigned_to = ?)", userID, userID)
-		}
+	query, err := s.scopeToTaskAccess(s.db.Where("id = ?", req.TaskId), userID, orgID, role, authz.TaskManageAll)
+	if err != nil {
+		return nil, err
 	}
-	result := query.Delete(&models.Task{})
+	var task models.Task
+	result := query.Clauses(clause.Returning{}).Delete(&task)
 	if result.Error != nil {
 		return nil, status.Error(codes.Internal, "failed to delete task")
 	}
@@ -311,6 +378,11 @@ func (s *TaskService) DeleteTask(ctx context.Context, req *taskpb.DeleteTaskRequ
 	if result.RowsAffected == 0 {
 		return nil, status.Error(codes.NotFound, "task not found")
 	}
+	s.db.Where("task_id = ?", task.ID).Delete(&models.TaskWatcher{})
+	s.db.Where("task_id = ?", task.ID).Delete(&models.TaskReminder{})
+	s.db.Where("task_id = ?", task.ID).Delete(&models.TaskComment{})
+	s.db.Where("task_id = ? OR depends_on_id = ?", task.ID, task.ID).Delete(&models.TaskDependency{})
+	s.publishTaskEvent(EventTaskDeleted, &task)
 
 	return &taskpb.DeleteTaskResponse{
 		Message: "Task deleted successfully",
@@ -331,45 +403,20 @@ func (s *TaskService) ListTasks(ctx context.Context, req *taskpb.ListTasksReques
 
 	offset := (page - 1) * pageSize
 
-	query := s.db.Model(&models.Task{})
 	userID, orgID, role := s.extractAuth(ctx)
-
-	if orgID != "" {
-		query = query.Where("org_id = ?", orgID)
-	} else {
-		// Caller not in an org: only consider org-less tasks by default
-		query = query.Where("org_id IS NULL")
-	}
-
-	// Only show tasks for user's org and assigned team/group
-	if role != "admin" {
-		// Non-admins: only see tasks assigned to their team/group or personal
-		if req.TeamFilter != "" {
-			query = query.Where("team_id = ?", req.TeamFilter)
-		}
-		if req.GroupFilter != "" {
-			query = query.Where("group_id = ?", req.GroupFilter)
-		}
-		// Ensure non-admins only see personal or assigned tasks. Org-less callers already filtered to org-less tasks above.
-		if userID == "" {
-			return nil, status.Error(codes.Unauthenticated, "authentication required")
-		}
-		query = query.Where("(assigned_to = ? OR created_by = ?)", userID, userID)
-	} else {
-		// Admins: can see all org tasks, but can filter by team/group
-		if req.TeamFilter != "" {
-			query = query.Where("team_id = ?", req.TeamFilter)
-		}
-		if req.GroupFilter != "" {
-			query = query.Where("group_id = ?", req.GroupFilter)
-		}
-	}
-	if req.StatusFilter != taskpb.TaskStatus_TASK_STATUS_UNSPECIFIED {
-		query = query.Where("status = ?", s.statusToString(req.StatusFilter))
-	}
-	if req.PriorityFilter != taskpb.TaskPriority_TASK_PRIORITY_UNSPECIFIED {
-		query = query.Where("priority = ?", s.priorityToString(req.PriorityFilter))
-	}
+	query, err := s.visibleTasksQuery(userID, orgID, role)
+	if err != nil {
+		return nil, err
+	}
+	query = s.applyTaskFilters(query, taskFilters{
+		Status:   req.StatusFilter,
+		Priority: req.PriorityFilter,
+		TeamID:   req.TeamFilter,
+		GroupID:  req.GroupFilter,
+		Project:  req.ProjectFilter,
+		Search:   req.Search,
+		Overdue:  req.OverdueOnly,
+	})
 
 	// 	// 	// Get total count
 	var totalCount int64
@@ -406,18 +453,9 @@ func (s *TaskService) AssignTask(ctx context.Context, req *taskpb.AssignTaskRequ
 	userID, orgID, role := s.extractAuth(ctx)
 
 	var task models.Task
-	query := s.db.Where("id = ?", req.TaskId)
-	if orgID != "" {
-		query = query.Where("org_id = ?", orgID)
-	} else {
-		if role == "admin" {
-			query = query.Where("org_id IS NULL")
-		} else {
-			if userID == "" {
-				return nil, status.Error(codes.Unauthenticated, "authentication required")
-			}
-			query = query.Where("org_id IS NULL AND (created_by = ? OR assigned_to = ?)", userID, userID)
-		}
+	query, err := s.scopeToTaskAccess(s.db.Where("id = ?", req.TaskId), userID, orgID, role, authz.TaskManageAll)
+	if err != nil {
+		return nil, err
 	}
 	if err := query.First(&task).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -426,11 +464,15 @@ func (s *TaskService) AssignTask(ctx context.Context, req *taskpb.AssignTaskRequ
 		return nil, status.Error(codes.Internal, "failed to find task")
 	}
 
+	if err := s.verifyAssignee(ctx, derefString(task.OrgID), task.TeamID, req.UserId); err != nil {
+		return nil, err
+	}
 	task.AssignedTo = &req.UserId
 
-	if err := s.db.Save(&task).Error; err != nil {
-		return nil, status.Error(codes.Internal, "failed to assign task")
+	if err := s.saveTaskVersioned(&task, task.Version); err != nil {
+		return nil, err
 	}
+	s.publishTaskEvent(EventTaskUpdated, &task)
 
 	// 	// 	// TODO: Send notification to assigned user
 
@@ -445,19 +487,16 @@ func (s *TaskService) UpdateTaskStatus(ctx context.Context, req *taskpb.UpdateTa
 	if req.TaskId == "" {
 		return nil, status.Error(codes.InvalidArgument, "task_id is required")
 	}
+	if req.Version < 1 {
+		return nil, status.Error(codes.InvalidArgument, "version is required")
+	}
 
 	userID, orgID, role := s.extractAuth(ctx)
 
 	var task models.Task
-	query := s.db.Where("id = ?", req.TaskId)
-	if orgID != "" {
-		query = query.Where("org_id = ?", orgID)
-	} else {
-		if role == "admin" {
-			query = query.Where("org_id IS NULL")
-		} else {
-			query = query.Where("org_id IS NULL AND (created_by = ? OR assigned_to = ?)", userID, userID)
-		}
+	query, err := s.scopeToTaskAccess(s.db.Where("id = ?", req.TaskId), userID, orgID, role, authz.TaskManageAll)
+	if err != nil {
+		return nil, err
 	}
 	if err := query.First(&task).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -466,11 +505,22 @@ func (s *TaskService) UpdateTaskStatus(ctx context.Context, req *taskpb.UpdateTa
 		return nil, status.Error(codes.Internal, "failed to find task")
 	}
 
-	task.Status = s.statusToString(req.Status)
+	newStatus := s.statusToString(req.Status)
+	previousStatus := task.Status
+	if newStatus != task.Status {
+		if err := s.checkWIPLimit(&task, newStatus); err != nil {
+			return nil, err
+		}
+	}
+	setTaskStatus(&task, newStatus)
 
-	if err := s.db.Save(&task).Error; err != nil {
-		return nil, status.Error(codes.Internal, "failed to update task status")
+	if err := s.saveTaskVersioned(&task, req.Version); err != nil {
+		return nil, err
 	}
+	if newStatus == "completed" && previousStatus != "completed" {
+		s.recordProjectTaskCompleted(&task, userID)
+	}
+	s.publishTaskEvent(EventTaskUpdated, &task)
 
 	// 	// 	// TODO: Send notification for status change
 
@@ -529,6 +579,188 @@ func (s *TaskService) GetUserTasks(ctx context.Context, req *taskpb.GetUserTasks
 	}, nil
 }
 
+// taskFilters are the optional list filters shared by ListTasks and exports
+type taskFilters struct {
+	Status     taskpb.TaskStatus
+	Priority   taskpb.TaskPriority
+	TeamID     string
+	GroupID    string
+	Project    string
+	AssignedTo string
+	Search     string
+	Overdue    bool
+}
+
+// visibleTasksQuery scopes a task query to what the caller may list: tasks in
+// their org (or org-less tasks when they have none), and for non-admins only
+// tasks assigned to or created by them. Guests see every task of the
+// projects they were invited to.
+func (s *TaskService) visibleTasksQuery(userID, orgID, role string) (*gorm.DB, error) {
+	query := s.db.Model(&models.Task{})
+	if orgID != "" {
+		query = query.Where("org_id = ?", orgID)
+	} else {
+		// Caller not in an org: only consider org-less tasks by default
+		query = query.Where("org_id IS NULL")
+	}
+
+	if authz.IsGuest(role) {
+		return s.scopeToGuest(query, userID, orgID, role), nil
+	}
+	if !authz.Can(role, authz.TaskViewAll) {
+		if userID == "" {
+			return nil, status.Error(codes.Unauthenticated, "authentication required")
+		}
+		query = query.Where("(assigned_to = ? OR created_by = ?)", userID, userID)
+	}
+	return query, nil
+}
+
+// scopeToTaskAccess limits query to the tasks authz.TaskAccess lets the
+// caller use perm on, and guests further to their projects
+func (s *TaskService) scopeToTaskAccess(query *gorm.DB, userID, orgID, role string, perm authz.Permission) (*gorm.DB, error) {
+	scope := authz.TaskAccess(userID, orgID, role, perm)
+	if scope.Empty() {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	var conds []string
+	var args []interface{}
+	if scope.OrgID != "" {
+		conds = append(conds, "org_id = ?")
+		args = append(args, scope.OrgID)
+	}
+	if scope.AllPersonal {
+		conds = append(conds, "org_id IS NULL")
+	} else if scope.PersonalOf != "" {
+		conds = append(conds, "(org_id IS NULL AND (created_by = ? OR assigned_to = ?))")
+		args = append(args, scope.PersonalOf, scope.PersonalOf)
+	}
+	query = query.Where("("+strings.Join(conds, " OR ")+")", args...)
+	return s.scopeToGuest(query, userID, orgID, role), nil
+}
+
+func (s *TaskService) applyTaskFilters(query *gorm.DB, f taskFilters) *gorm.DB {
+	if f.TeamID != "" {
+		query = query.Where("team_id = ?", f.TeamID)
+	}
+	if f.GroupID != "" {
+		query = query.Where("group_id = ?", f.GroupID)
+	}
+	if f.AssignedTo != "" {
+		query = query.Where("assigned_to = ?", f.AssignedTo)
+	}
+	if f.Status != taskpb.TaskStatus_TASK_STATUS_UNSPECIFIED {
+		query = query.Where("status = ?", s.statusToString(f.Status))
+	}
+	if f.Priority != taskpb.TaskPriority_TASK_PRIORITY_UNSPECIFIED {
+		query = query.Where("priority = ?", s.priorityToString(f.Priority))
+	}
+	if f.Project != "" {
+		query = query.Where("project_id = ?", f.Project)
+	}
+	if f.Overdue {
+		query = query.Where("is_overdue = ?", true)
+	}
+	if search := strings.TrimSpace(f.Search); search != "" {
+		if isTaskKey(search) {
+			query = query.Where("task_key = ?", strings.ToUpper(search))
+		} else {
+			query = query.Where("LOWER(title) LIKE ?", "%"+strings.ToLower(search)+"%")
+		}
+	}
+	return query
+}
+
+// saveTaskVersioned writes all task fields only if the stored version still
+// equals expected, bumping the version. A stale version means someone else
+// updated the task since the client read it, so the write is rejected rather
+// than silently overwriting their change.
+func (s *TaskService) saveTaskVersioned(task *models.Task, expected int64) error {
+	if task.Version != expected {
+		return status.Errorf(codes.Aborted, "task was modified concurrently (current version %d)", task.Version)
+	}
+
+	task.Version = expected + 1
+	task.UpdatedAt = time.Now()
+	task.IsOverdue = isTaskOverdue(task, task.UpdatedAt)
+	// overdue_notified_at belongs to the overdue job; a stale copy must not
+	// clear it and trigger a second notification
+	result := s.db.Model(task).Where("version = ?", expected).Select("*").Omit("overdue_notified_at").Updates(task)
+	if result.Error != nil {
+		task.Version = expected
+		return status.Error(codes.Internal, "failed to update task")
+	}
+	if result.RowsAffected == 0 {
+		task.Version = expected
+		return status.Error(codes.Aborted, "task was modified concurrently; reload and retry")
+	}
+	return nil
+}
+
+// ensureProjectOpen rejects new tasks in a project outside the caller's org,
+// or one the organization service has archived. Personal tasks have no
+// project.
+func (s *TaskService) ensureProjectOpen(orgID, projectID string) error {
+	if orgID == "" {
+		return status.Error(codes.InvalidArgument, "personal tasks cannot belong to a project")
+	}
+	var archived []bool
+	err := s.db.Raw("SELECT archived_at IS NOT NULL FROM projects WHERE id = ? AND org_id = ?", projectID, orgID).
+		Scan(&archived).Error
+	if err != nil {
+		return status.Error(codes.Internal, "failed to check project")
+	}
+	if len(archived) == 0 {
+		return status.Error(codes.NotFound, "project not found")
+	}
+	if archived[0] {
+		return status.Error(codes.FailedPrecondition, "project is archived")
+	}
+	return nil
+}
+
+// recordProjectTaskCompleted adds a task_completed event to the activity
+// feed of the task's project, which the organization service serves. The
+// task is already saved, so failures are only logged.
+func (s *TaskService) recordProjectTaskCompleted(task *models.Task, actorID string) {
+	if task.ProjectID == nil || task.OrgID == nil {
+		return
+	}
+	metadata, err := json.Marshal(map[string]string{"task_id": task.ID, "task_key": task.TaskKey})
+	if err != nil {
+		return
+	}
+	var actor interface{}
+	if actorID != "" {
+		actor = actorID
+	}
+	summary := fmt.Sprintf("%s %s completed", task.TaskKey, task.Title)
+	err = s.db.Exec(`
+		INSERT INTO project_activity (id, project_id, org_id, actor_id, event_type, summary, metadata, created_at)
+		SELECT ?, p.id, p.org_id, ?, 'task_completed', ?, ?, ? FROM projects p WHERE p.id = ? AND p.org_id = ?
+	`, uuid.New().String(), actor, strings.TrimSpace(summary), string(metadata), time.Now(), *task.ProjectID, *task.OrgID).Error
+	if err != nil {
+		log.Printf("failed to record completion of task %s in project activity: %v", task.ID, err)
+	}
+}
+
+// setTaskStatus changes a task's status and maintains the lifecycle
+// timestamps used for cycle-time analytics.
+func setTaskStatus(task *models.Task, newStatus string) {
+	now := time.Now()
+	if newStatus == "in_progress" && task.StartedAt == nil {
+		task.StartedAt = &now
+	}
+	if newStatus == "completed" {
+		if task.Status != "completed" || task.CompletedAt == nil {
+			task.CompletedAt = &now
+		}
+	} else {
+		task.CompletedAt = nil
+	}
+	task.Status = newStatus
+}
+
 // // // Helper functions
 func (s *TaskService) modelToProto(task *models.Task) *taskpb.Task {
 	protoTask := &taskpb.Task{
@@ -538,6 +770,9 @@ func (s *TaskService) modelToProto(task *models.Task) *taskpb.Task {
 		Status:      s.stringToStatus(task.Status),
 		Priority:    s.stringToPriority(task.Priority),
 		CreatedBy:   task.CreatedBy,
+		TaskKey:     task.TaskKey,
+		Version:     task.Version,
+		IsOverdue:   task.IsOverdue,
 		CreatedAt:   timestamppb.New(task.CreatedAt),
 		UpdatedAt:   timestamppb.New(task.UpdatedAt),
 	}
@@ -555,10 +790,22 @@ func (s *TaskService) modelToProto(task *models.Task) *taskpb.Task {
 		protoTask.GroupId = *task.GroupID
 	}
 
+	if task.ProjectID != nil {
+		protoTask.ProjectId = *task.ProjectID
+	}
+
 	if task.DueDate != nil {
 		protoTask.DueDate = timestamppb.New(*task.DueDate)
 	}
 
+	if task.StartedAt != nil {
+		protoTask.StartedAt = timestamppb.New(*task.StartedAt)
+	}
+
+	if task.CompletedAt != nil {
+		protoTask.CompletedAt = timestamppb.New(*task.CompletedAt)
+	}
+
 	if task.Tags != "" {
 		protoTask.Tags = strings.Split(task.Tags, ",")
 	}
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+
+	taskpb "github.com/chanduchitikam/task-management-system/proto/task"
+	"github.com/chanduchitikam/task-management-system/services/task/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// deletedUserID replaces erased users on records that outlive them; it is
+// the same placeholder CreateTask uses for tasks without a creator
+const deletedUserID = "00000000-0000-0000-0000-000000000000"
+
+// Erasure modes
+const (
+	eraseModeAnonymize = "anonymize"
+	eraseModeDelete    = "delete"
+)
+
+// userDataExport is the task service's part of a user's data export
+type userDataExport struct {
+	TasksCreated  []models.Task         `json:"tasks_created"`
+	TasksAssigned []models.Task         `json:"tasks_assigned"`
+	Comments      []models.TaskComment  `json:"comments"`
+	Watching      []models.TaskWatcher  `json:"watching"`
+	Reminders     []models.TaskReminder `json:"reminders"`
+	Boards        []models.Board        `json:"boards_created"`
+}
+
+// ExportUserData returns every task record that belongs to or mentions the user
+func (s *TaskService) ExportUserData(ctx context.Context, req *taskpb.ExportUserDataRequest) (*taskpb.ExportUserDataResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	var out userDataExport
+	db := s.db.WithContext(ctx)
+	queries := []struct {
+		dest  interface{}
+		query *gorm.DB
+	}{
+		{&out.TasksCreated, db.Where("created_by = ?", req.UserId).Order("created_at")},
+		{&out.TasksAssigned, db.Where("assigned_to = ? AND created_by <> ?", req.UserId, req.UserId).Order("created_at")},
+		{&out.Comments, db.Where("author_id = ?", req.UserId).Order("created_at")},
+		{&out.Watching, db.Where("user_id = ?", req.UserId)},
+		{&out.Reminders, db.Where("user_id = ?", req.UserId)},
+		{&out.Boards, db.Where("created_by = ?", req.UserId)},
+	}
+	for _, q := range queries {
+		if err := q.query.Find(q.dest).Error; err != nil {
+			return nil, status.Error(codes.Internal, "failed to export task data")
+		}
+	}
+
+	data, err := json.Marshal(out)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to encode task data")
+	}
+	return &taskpb.ExportUserDataResponse{Data: data}, nil
+}
+
+// EraseUserData detaches the user from task data. Org tasks always survive
+// (they belong to the organization) with the user replaced by a placeholder;
+// in delete mode the user's comments and personal tasks are removed too.
+// Safe to repeat.
+func (s *TaskService) EraseUserData(ctx context.Context, req *taskpb.EraseUserDataRequest) (*taskpb.EraseUserDataResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	mode := req.Mode
+	if mode == "" {
+		mode = eraseModeAnonymize
+	}
+	if mode != eraseModeAnonymize && mode != eraseModeDelete {
+		return nil, status.Error(codes.InvalidArgument, "mode must be anonymize or delete")
+	}
+
+	affected := make(map[string]int64)
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		run := func(name string, res *gorm.DB) error {
+			if res.Error != nil {
+				return res.Error
+			}
+			affected[name] += res.RowsAffected
+			return nil
+		}
+
+		if mode == eraseModeDelete {
+			personal := tx.Model(&models.Task{}).Select("id").Where("org_id IS NULL AND created_by = ?", req.UserId)
+			if err := run("comments_deleted", tx.Where("author_id = ? OR task_id IN (?)", req.UserId, personal).Delete(&models.TaskComment{})); err != nil {
+				return err
+			}
+			if err := run("watchers_deleted", tx.Where("task_id IN (?)", personal).Delete(&models.TaskWatcher{})); err != nil {
+				return err
+			}
+			if err := run("reminders_deleted", tx.Where("task_id IN (?)", personal).Delete(&models.TaskReminder{})); err != nil {
+				return err
+			}
+			if err := run("dependencies_deleted", tx.Where("task_id IN (?) OR depends_on_id IN (?)", personal, personal).Delete(&models.TaskDependency{})); err != nil {
+				return err
+			}
+			if err := run("tasks_deleted", tx.Where("org_id IS NULL AND created_by = ?", req.UserId).Delete(&models.Task{})); err != nil {
+				return err
+			}
+		} else {
+			if err := run("comments_anonymized", tx.Model(&models.TaskComment{}).Where("author_id = ?", req.UserId).Update("author_id", deletedUserID)); err != nil {
+				return err
+			}
+		}
+
+		steps := []struct {
+			name string
+			exec func() *gorm.DB
+		}{
+			{"tasks_anonymized", func() *gorm.DB {
+				return tx.Model(&models.Task{}).Where("created_by = ?", req.UserId).UpdateColumn("created_by", deletedUserID)
+			}},
+			{"tasks_unassigned", func() *gorm.DB {
+				return tx.Model(&models.Task{}).Where("assigned_to = ?", req.UserId).UpdateColumn("assigned_to", nil)
+			}},
+			{"watchers_deleted", func() *gorm.DB {
+				return tx.Where("user_id = ?", req.UserId).Delete(&models.TaskWatcher{})
+			}},
+			{"reminders_deleted", func() *gorm.DB {
+				return tx.Where("user_id = ?", req.UserId).Delete(&models.TaskReminder{})
+			}},
+			{"feed_secrets_deleted", func() *gorm.DB {
+				return tx.Where("user_id = ?", req.UserId).Delete(&models.CalendarFeedSecret{})
+			}},
+			{"dependencies_anonymized", func() *gorm.DB {
+				return tx.Model(&models.TaskDependency{}).Where("created_by = ?", req.UserId).UpdateColumn("created_by", deletedUserID)
+			}},
+			{"boards_anonymized", func() *gorm.DB {
+				return tx.Model(&models.Board{}).Where("created_by = ?", req.UserId).UpdateColumn("created_by", deletedUserID)
+			}},
+			{"webhooks_anonymized", func() *gorm.DB {
+				return tx.Model(&models.Webhook{}).Where("created_by = ?", req.UserId).UpdateColumn("created_by", deletedUserID)
+			}},
+		}
+		for _, step := range steps {
+			if err := run(step.name, step.exec()); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to erase task data: %v", err)
+	}
+
+	return &taskpb.EraseUserDataResponse{Affected: affected}, nil
+}
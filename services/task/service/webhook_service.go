@@ -0,0 +1,421 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
+	"github.com/chanduchitikam/task-management-system/pkg/cache"
+	"github.com/chanduchitikam/task-management-system/pkg/encryption"
+	taskpb "github.com/chanduchitikam/task-management-system/proto/task"
+	"github.com/chanduchitikam/task-management-system/services/task/models"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Task event types delivered to webhooks
+const (
+	EventTaskCreated = "task.created"
+	EventTaskUpdated = "task.updated"
+	EventTaskDeleted = "task.deleted"
+)
+
+var webhookEventTypes = map[string]bool{
+	EventTaskCreated: true,
+	EventTaskUpdated: true,
+	EventTaskDeleted: true,
+}
+
+const (
+	webhookMaxAttempts  = 8
+	webhookBaseBackoff  = 30 * time.Second
+	webhookMaxBackoff   = 6 * time.Hour
+	webhookPollInterval = 5 * time.Second
+	webhookBatchSize    = 20
+	// webhookClaimLease outlasts a batch whose every post times out, with
+	// as long again for the lookups and saves around them
+	webhookClaimLease = webhookBatchSize * 2 * webhookTimeout
+)
+
+// webhookSecretColumn is sealed with the service's keyring
+var webhookSecretColumn = encryption.Column{Table: "task_webhooks", Column: "secret", Scope: "id"}
+
+// SetEncryption enables sealing of webhook signing secrets. Without a
+// keyring they are stored in plaintext.
+func (s *TaskService) SetEncryption(k *encryption.Keyring) {
+	s.secrets = k
+}
+
+// ResealWebhookSecrets seals webhook secrets stored before encryption was
+// enabled or under a previous key
+func (s *TaskService) ResealWebhookSecrets(ctx context.Context) {
+	n, err := s.secrets.Reseal(ctx, s.db, webhookSecretColumn)
+	if err != nil {
+		log.Printf("failed to reseal webhook secrets: %v", err)
+		return
+	}
+	if n > 0 {
+		log.Printf("resealed %d webhook secrets", n)
+	}
+}
+
+// CreateWebhook registers an outgoing webhook for the caller's org
+func (s *TaskService) CreateWebhook(ctx context.Context, req *taskpb.CreateWebhookRequest) (*taskpb.CreateWebhookResponse, error) {
+	userID, orgID, role := s.extractAuth(ctx)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	if orgID == "" || !authz.Can(role, authz.OrgManage) {
+		return nil, status.Error(codes.PermissionDenied, "only org admins can manage webhooks")
+	}
+
+	if err := validateWebhookURL(ctx, req.Url); err != nil {
+		return nil, err
+	}
+
+	for _, et := range req.EventTypes {
+		if !webhookEventTypes[et] {
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported event type %q", et)
+		}
+	}
+
+	secret := req.Secret
+	if secret == "" {
+		buf := make([]byte, 32)
+		if _, err := rand.Read(buf); err != nil {
+			return nil, status.Error(codes.Internal, "failed to generate secret")
+		}
+		secret = hex.EncodeToString(buf)
+	}
+
+	webhookID := uuid.New().String()
+	sealed, err := s.secrets.Seal(secret, webhookSecretColumn.Binding(webhookID))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to encrypt secret")
+	}
+	webhook := &models.Webhook{
+		ID:         webhookID,
+		OrgID:      orgID,
+		URL:        req.Url,
+		Secret:     sealed,
+		EventTypes: strings.Join(req.EventTypes, ","),
+		Active:     true,
+		CreatedBy:  userID,
+	}
+	if err := s.db.Create(webhook).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to create webhook")
+	}
+
+	return &taskpb.CreateWebhookResponse{
+		Webhook: webhookToProto(webhook),
+		Secret:  secret,
+		Message: "Webhook created successfully",
+	}, nil
+}
+
+// ListWebhooks lists the caller's org webhooks
+func (s *TaskService) ListWebhooks(ctx context.Context, req *taskpb.ListWebhooksRequest) (*taskpb.ListWebhooksResponse, error) {
+	_, orgID, role := s.extractAuth(ctx)
+	if orgID == "" || !authz.Can(role, authz.OrgManage) {
+		return nil, status.Error(codes.PermissionDenied, "only org admins can manage webhooks")
+	}
+
+	var webhooks []models.Webhook
+	if err := s.db.Where("org_id = ?", orgID).Order("created_at DESC").Find(&webhooks).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to list webhooks")
+	}
+
+	resp := &taskpb.ListWebhooksResponse{Webhooks: make([]*taskpb.Webhook, len(webhooks))}
+	for i := range webhooks {
+		resp.Webhooks[i] = webhookToProto(&webhooks[i])
+	}
+	return resp, nil
+}
+
+// DeleteWebhook removes a webhook and its pending deliveries
+func (s *TaskService) DeleteWebhook(ctx context.Context, req *taskpb.DeleteWebhookRequest) (*taskpb.DeleteWebhookResponse, error) {
+	if req.WebhookId == "" {
+		return nil, status.Error(codes.InvalidArgument, "webhook_id is required")
+	}
+	_, orgID, role := s.extractAuth(ctx)
+	if orgID == "" || !authz.Can(role, authz.OrgManage) {
+		return nil, status.Error(codes.PermissionDenied, "only org admins can manage webhooks")
+	}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("id = ? AND org_id = ?", req.WebhookId, orgID).Delete(&models.Webhook{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return tx.Where("webhook_id = ?", req.WebhookId).Delete(&models.WebhookDelivery{}).Error
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Error(codes.NotFound, "webhook not found")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to delete webhook")
+	}
+
+	return &taskpb.DeleteWebhookResponse{Message: "Webhook deleted successfully"}, nil
+}
+
+// publishTaskEvent pushes an org task change to live boards and queues a
+// delivery for every active org webhook subscribed to eventType. Failures
+// are logged rather than returned so these problems never fail the task
+// operation that triggered them.
+func (s *TaskService) publishTaskEvent(eventType string, task *models.Task) {
+	if task.OrgID == nil || *task.OrgID == "" {
+		return
+	}
+
+	taskJSON, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(s.modelToProto(task))
+	if err != nil {
+		log.Printf("failed to marshal task %s for webhook: %v", task.ID, err)
+		return
+	}
+	s.broadcastTaskEvent(eventType, task, taskJSON)
+
+	var webhooks []models.Webhook
+	if err := s.db.Where("org_id = ? AND active = ?", *task.OrgID, true).Find(&webhooks).Error; err != nil {
+		log.Printf("failed to load webhooks for org %s: %v", *task.OrgID, err)
+		return
+	}
+	if len(webhooks) == 0 {
+		return
+	}
+
+	now := time.Now().UTC()
+	for _, wh := range webhooks {
+		if !webhookSubscribes(&wh, eventType) {
+			continue
+		}
+		delivery := &models.WebhookDelivery{
+			ID:            uuid.New().String(),
+			WebhookID:     wh.ID,
+			EventType:     eventType,
+			Status:        models.WebhookDeliveryPending,
+			NextAttemptAt: now,
+		}
+		payload, err := json.Marshal(map[string]interface{}{
+			"id":         delivery.ID,
+			"event":      eventType,
+			"org_id":     wh.OrgID,
+			"created_at": now.Format(time.RFC3339),
+			"task":       json.RawMessage(taskJSON),
+		})
+		if err != nil {
+			continue
+		}
+		delivery.Payload = string(payload)
+		if err := s.db.Create(delivery).Error; err != nil {
+			log.Printf("failed to queue webhook delivery for %s: %v", wh.ID, err)
+		}
+	}
+}
+
+// broadcastTaskEvent tells the gateways about the change, for the
+// WebSocket rooms watching the task
+func (s *TaskService) broadcastTaskEvent(eventType string, task *models.Task, taskJSON []byte) {
+	if s.cache == nil {
+		return
+	}
+	ev := cache.TaskEvent{
+		Type:   eventType,
+		TaskID: task.ID,
+		OrgID:  *task.OrgID,
+		Task:   taskJSON,
+	}
+	if task.TeamID != nil {
+		ev.TeamID = *task.TeamID
+	}
+	if task.ProjectID != nil {
+		ev.ProjectID = *task.ProjectID
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	if err := s.cache.PublishTaskEvent(ctx, ev); err != nil {
+		log.Printf("failed to broadcast %s for task %s: %v", eventType, task.ID, err)
+	}
+}
+
+func webhookSubscribes(wh *models.Webhook, eventType string) bool {
+	if wh.EventTypes == "" {
+		return true
+	}
+	for _, et := range strings.Split(wh.EventTypes, ",") {
+		if et == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// RunWebhookWorker delivers queued webhook events until ctx is cancelled.
+// Rows are claimed with SKIP LOCKED so several task service replicas can run
+// the worker concurrently.
+func (s *TaskService) RunWebhookWorker(ctx context.Context) {
+	client := newWebhookClient()
+	ticker := time.NewTicker(webhookPollInterval)
+	defer ticker.Stop()
+
+	log.Println("webhook delivery worker started")
+	for {
+		for s.deliverWebhookBatch(ctx, client) == webhookBatchSize {
+			// keep draining while batches are full
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// deliverWebhookBatch claims due deliveries by pushing their next attempt out
+// by a lease, then posts them outside the transaction so row locks are not
+// held across HTTP calls. A crashed worker's claims simply expire. Outcomes
+// are only recorded while the claim is still ours, so a worker that overran
+// its lease can't overwrite another's result.
+func (s *TaskService) deliverWebhookBatch(ctx context.Context, client *http.Client) int {
+	var deliveries []models.WebhookDelivery
+	// timestamps are stored to the microsecond; the claim is matched exactly
+	lease := time.Now().UTC().Add(webhookClaimLease).Truncate(time.Microsecond)
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		now := time.Now().UTC()
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND next_attempt_at <= ?", models.WebhookDeliveryPending, now).
+			Order("next_attempt_at ASC").
+			Limit(webhookBatchSize).
+			Find(&deliveries).Error; err != nil {
+			return err
+		}
+		if len(deliveries) == 0 {
+			return nil
+		}
+		ids := make([]string, len(deliveries))
+		for i := range deliveries {
+			ids[i] = deliveries[i].ID
+		}
+		return tx.Model(&models.WebhookDelivery{}).Where("id IN ?", ids).
+			Update("next_attempt_at", lease).Error
+	})
+	if err != nil {
+		log.Printf("failed to claim webhook deliveries: %v", err)
+		return 0
+	}
+
+	for i := range deliveries {
+		if !time.Now().Before(lease) {
+			// the rest may already be claimed by another worker
+			log.Printf("webhook claim lease expired with %d deliveries unsent", len(deliveries)-i)
+			break
+		}
+		d := &deliveries[i]
+		var wh models.Webhook
+		if err := s.db.Where("id = ?", d.WebhookID).First(&wh).Error; err != nil {
+			d.Status = models.WebhookDeliveryFailed
+			d.LastError = "webhook no longer exists"
+		} else {
+			s.attemptWebhookDelivery(ctx, client, &wh, d)
+		}
+		res := s.db.Model(d).Where("next_attempt_at = ?", lease).Select("*").Updates(d)
+		if res.Error != nil {
+			log.Printf("failed to record webhook delivery %s: %v", d.ID, res.Error)
+		} else if res.RowsAffected == 0 {
+			log.Printf("webhook delivery %s was claimed by another worker; its outcome is not recorded", d.ID)
+		}
+	}
+	return len(deliveries)
+}
+
+// attemptWebhookDelivery posts one delivery and records the outcome,
+// scheduling a retry with exponential backoff on failure.
+func (s *TaskService) attemptWebhookDelivery(ctx context.Context, client *http.Client, wh *models.Webhook, d *models.WebhookDelivery) {
+	d.Attempts++
+
+	payload := d.Payload
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	secret, err := s.secrets.Open(wh.Secret, webhookSecretColumn.Binding(wh.ID))
+	var req *http.Request
+	if err == nil {
+		req, err = http.NewRequestWithContext(ctx, http.MethodPost, wh.URL, strings.NewReader(payload))
+	}
+	if err == nil {
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("User-Agent", "Taskflow-Webhooks/1.0")
+		req.Header.Set("X-Taskflow-Event", d.EventType)
+		req.Header.Set("X-Taskflow-Delivery", d.ID)
+		req.Header.Set("X-Taskflow-Timestamp", timestamp)
+		req.Header.Set("X-Taskflow-Signature", "sha256="+signWebhookPayload(secret, timestamp, payload))
+
+		var resp *http.Response
+		resp, err = client.Do(req)
+		if err == nil {
+			resp.Body.Close()
+			d.ResponseCode = resp.StatusCode
+			if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+				d.Status = models.WebhookDeliverySucceeded
+				d.LastError = ""
+				return
+			}
+			err = fmt.Errorf("unexpected status %d", resp.StatusCode)
+		}
+	}
+
+	d.LastError = err.Error()
+	if d.Attempts >= webhookMaxAttempts {
+		d.Status = models.WebhookDeliveryFailed
+		return
+	}
+	backoff := webhookBaseBackoff << (d.Attempts - 1)
+	if backoff > webhookMaxBackoff {
+		backoff = webhookMaxBackoff
+	}
+	d.NextAttemptAt = time.Now().UTC().Add(backoff)
+}
+
+// signWebhookPayload computes the hex HMAC-SHA256 of "timestamp.payload".
+// Receivers should recompute it and reject stale timestamps.
+func signWebhookPayload(secret, timestamp, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write([]byte(payload))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func webhookToProto(w *models.Webhook) *taskpb.Webhook {
+	pb := &taskpb.Webhook{
+		WebhookId: w.ID,
+		OrgId:     w.OrgID,
+		Url:       w.URL,
+		Active:    w.Active,
+		CreatedBy: w.CreatedBy,
+		CreatedAt: timestamppb.New(w.CreatedAt),
+		UpdatedAt: timestamppb.New(w.UpdatedAt),
+	}
+	if w.EventTypes != "" {
+		pb.EventTypes = strings.Split(w.EventTypes, ",")
+	}
+	return pb
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const webhookTimeout = 10 * time.Second
+
+// errBlockedWebhookAddress stops deliveries to addresses webhooks may not
+// reach
+var errBlockedWebhookAddress = errors.New("webhook address is not public")
+
+// sharedAddressSpace (RFC 6598) is carrier-grade NAT, and where some clouds
+// put their metadata service
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// blockedWebhookIP reports whether ip is internal to the deployment:
+// loopback, private, link-local (cloud metadata), unspecified or multicast.
+// Webhooks are registered by org admins, who must not be able to point the
+// worker at the services' own network.
+func blockedWebhookIP(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	return !ip.IsValid() || ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() ||
+		ip.IsMulticast() || sharedAddressSpace.Contains(ip)
+}
+
+// validateWebhookURL checks a webhook URL is absolute http(s) and that its
+// host only resolves to public addresses. The worker checks again when it
+// dials, as the host may later resolve elsewhere.
+func validateWebhookURL(ctx context.Context, raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Hostname() == "" {
+		return status.Error(codes.InvalidArgument, "url must be an absolute http(s) URL")
+	}
+	host := u.Hostname()
+	if ip, err := netip.ParseAddr(host); err == nil {
+		if blockedWebhookIP(ip) {
+			return status.Error(codes.InvalidArgument, "url must not point to a loopback, private or link-local address")
+		}
+		return nil
+	}
+	ips, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+	if err != nil || len(ips) == 0 {
+		return status.Errorf(codes.InvalidArgument, "url host %q does not resolve", host)
+	}
+	for _, ip := range ips {
+		if blockedWebhookIP(ip) {
+			return status.Error(codes.InvalidArgument, "url must not point to a loopback, private or link-local address")
+		}
+	}
+	return nil
+}
+
+// newWebhookClient returns the client deliveries are posted with. It refuses
+// to connect to blocked addresses whatever the host resolves to at the
+// time, and does not follow redirects, which would get around both checks.
+func newWebhookClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: webhookTimeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip, err := netip.ParseAddr(host)
+			if err != nil || blockedWebhookIP(ip) {
+				return errBlockedWebhookAddress
+			}
+			return nil
+		},
+	}
+	return &http.Client{
+		Timeout: webhookTimeout,
+		// no Proxy: a proxy would make the connection the dialer can't check
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: webhookTimeout,
+			MaxIdleConns:        20,
+			IdleConnTimeout:     90 * time.Second,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+	}
+}
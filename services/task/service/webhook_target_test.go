@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBlockedWebhookIP(t *testing.T) {
+	tests := []struct {
+		ip      string
+		blocked bool
+	}{
+		{"127.0.0.1", true},
+		{"127.8.8.8", true},
+		{"::1", true},
+		{"10.1.2.3", true},
+		{"172.16.0.1", true},
+		{"172.31.255.255", true},
+		{"192.168.1.1", true},
+		{"fd00::1", true},
+		{"169.254.169.254", true},
+		{"fe80::1", true},
+		{"0.0.0.0", true},
+		{"::", true},
+		{"224.0.0.1", true},
+		{"ff02::1", true},
+		{"100.64.0.1", true},
+		{"100.127.255.255", true},
+		{"::ffff:127.0.0.1", true},
+		{"::ffff:169.254.169.254", true},
+		{"8.8.8.8", false},
+		{"172.32.0.1", false},
+		{"100.128.0.1", false},
+		{"2001:4860:4860::8888", false},
+		{"::ffff:8.8.8.8", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ip, func(t *testing.T) {
+			assert.Equal(t, tt.blocked, blockedWebhookIP(netip.MustParseAddr(tt.ip)))
+		})
+	}
+	assert.True(t, blockedWebhookIP(netip.Addr{}), "the zero address")
+}
+
+func TestValidateWebhookURL(t *testing.T) {
+	tests := []struct {
+		name  string
+		url   string
+		valid bool
+	}{
+		{"public https", "https://8.8.8.8/hook", true},
+		{"public http with port", "http://8.8.8.8:8080/hook", true},
+		{"public ipv6", "https://[2001:4860:4860::8888]/hook", true},
+		{"loopback", "https://127.0.0.1/hook", false},
+		{"loopback ipv6", "https://[::1]/hook", false},
+		{"mapped loopback", "https://[::ffff:127.0.0.1]/hook", false},
+		{"private", "http://10.0.0.5/hook", false},
+		{"metadata service", "http://169.254.169.254/latest/meta-data", false},
+		{"shared address space", "http://100.100.100.200/", false},
+		{"host resolving to loopback", "http://localhost:9090/", false},
+		{"other scheme", "ftp://8.8.8.8/hook", false},
+		{"file", "file:///etc/passwd", false},
+		{"relative", "/hook", false},
+		{"no host", "https:///hook", false},
+		{"malformed", "https://%zz/", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateWebhookURL(context.Background(), tt.url)
+			if tt.valid {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
+
+func TestWebhookClientRefusesBlockedAddresses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("the worker reached a loopback address")
+	}))
+	defer server.Close()
+
+	resp, err := newWebhookClient().Post(server.URL, "application/json", nil)
+	if resp != nil {
+		resp.Body.Close()
+	}
+	assert.ErrorIs(t, err, errBlockedWebhookAddress)
+}
+
+func TestWebhookClientDoesNotFollowRedirects(t *testing.T) {
+	client := newWebhookClient()
+	require.NotNil(t, client.CheckRedirect)
+	assert.ErrorIs(t, client.CheckRedirect(nil, nil), http.ErrUseLastResponse)
+}
@@ -1,23 +1,32 @@
 package main
 
 import (
-	"crypto/rand"
-	"crypto/sha256"
-	"encoding/hex"
-	"encoding/json"
+	"context"
 	"errors"
 	"fmt"
 	"log"
 	"net"
 	"net/http"
-	"net/smtp"
 	"os"
+	"os/signal"
+	"strconv"
 	"strings"
+	"syscall"
 	"time"
 
+	"github.com/chanduchitikam/task-management-system/migrations"
 	"github.com/chanduchitikam/task-management-system/pkg/auth"
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
+	"github.com/chanduchitikam/task-management-system/pkg/cache"
+	"github.com/chanduchitikam/task-management-system/pkg/certs"
 	"github.com/chanduchitikam/task-management-system/pkg/config"
 	"github.com/chanduchitikam/task-management-system/pkg/database"
+	"github.com/chanduchitikam/task-management-system/pkg/encryption"
+	"github.com/chanduchitikam/task-management-system/pkg/mailer"
+	"github.com/chanduchitikam/task-management-system/pkg/webauthn"
+	notificationpb "github.com/chanduchitikam/task-management-system/proto/notification"
+	organizationpb "github.com/chanduchitikam/task-management-system/proto/organization"
+	taskpb "github.com/chanduchitikam/task-management-system/proto/task"
 	userpb "github.com/chanduchitikam/task-management-system/proto/user"
 	"github.com/chanduchitikam/task-management-system/services/user/models"
 	"github.com/chanduchitikam/task-management-system/services/user/service"
@@ -33,6 +42,9 @@ func main() {
 	if err != nil {
 		log.Fatalf("Failed to load config: %v", err)
 	}
+	if err := cfg.Validate(); err != nil {
+		log.Fatalf("Invalid config: %v", err)
+	}
 
 	// 	// 	// Connect to database
 	db, err := database.NewPostgresConnection(cfg.Database.GetDSN())
@@ -40,10 +52,18 @@ func main() {
 		log.Fatalf("Failed to connect to database: %v", err)
 	}
 
-	// 	// 	// Auto-migrate models
-	if err := database.AutoMigrate(db, &models.User{}, &models.Organization{}, &models.Invite{}); err != nil {
+	// Bring the schema up to date, then check it has everything the models
+	// use
+	sqlDB, err := db.DB()
+	if err != nil {
+		log.Fatalf("Failed to get database instance: %v", err)
+	}
+	if err := migrations.Prepare(sqlDB, "user", cfg.Database.MigrateOnStart); err != nil {
 		log.Fatalf("Failed to migrate database: %v", err)
 	}
+	if err := database.CheckModels(db, &models.User{}, &models.Organization{}, &models.Invite{}, &models.AuditLog{}, &models.LDAPConfig{}, &models.APIKey{}, &models.DataErasureRequest{}, &models.LoginEvent{}, &models.SuperAdminChange{}, &models.OrganizationMembership{}, &models.GuestAccess{}, &models.PasswordResetToken{}, &models.Passkey{}, &models.PasskeyChallenge{}, &models.RefreshToken{}); err != nil {
+		log.Fatalf("Database schema drift: %v", err)
+	}
 
 	// Ensure global super admin account exists (admin@taskflow.com)
 	adminEmail := "admin@taskflow.com"
@@ -60,7 +80,7 @@ func main() {
 				Username: "admin",
 				Password: hashed,
 				FullName: "TaskFlow Super Admin",
-				Role:     "super_admin",
+				Role:     authz.RoleSuperAdmin,
 			}
 			if err := db.Create(&admin).Error; err != nil {
 				log.Fatalf("failed to create admin user: %v", err)
@@ -69,281 +89,180 @@ func main() {
 		} else {
 			log.Fatalf("failed to query admin user: %v", err)
 		}
+	} else if admin.Role == authz.RoleAdmin && admin.OrgID == nil {
+		// Older deployments seeded an org-less "admin" that was recognised by
+		// its email; platform access now requires the explicit role
+		if err := db.Model(&admin).Update("role", authz.RoleSuperAdmin).Error; err != nil {
+			log.Fatalf("failed to upgrade admin user: %v", err)
+		}
+		log.Printf("Upgraded %s to super admin", adminEmail)
 	} else {
 		log.Printf("Super admin account already exists: %s", adminEmail)
 	}
 
-	// 	// 	// Create JWT manager
-	jwtManager := auth.NewJWTManager(
-		cfg.JWT.SecretKey,
-		cfg.JWT.AccessTokenDuration,
-		cfg.JWT.RefreshTokenDuration,
-	)
-
-	// 	// 	// Create gRPC server
-	grpcServer := grpc.NewServer()
-
-	// Start a simple HTTP API for invite operations
-	go func() {
-		httpMux := http.NewServeMux()
-
-		// Metrics endpoint
-		httpMux.Handle("/metrics", promhttp.Handler())
-
-		// Create org user (org admin only) -> create invite (secure)
-		httpMux.HandleFunc("/api/v1/orgs/users", func(w http.ResponseWriter, r *http.Request) {
-			if r.Method == http.MethodPost {
-				// require Authorization header
-				authHeader := r.Header.Get("Authorization")
-				if authHeader == "" {
-					http.Error(w, "missing authorization", http.StatusUnauthorized)
-					return
-				}
-				token := strings.TrimPrefix(authHeader, "Bearer ")
-				claims, err := jwtManager.ValidateToken(token)
-				if err != nil {
-					http.Error(w, "invalid token", http.StatusUnauthorized)
-					return
-				}
-
-				// parse org_id from query
-				orgID := r.URL.Query().Get("org_id")
-				if orgID == "" {
-					http.Error(w, "org_id is required", http.StatusBadRequest)
-					return
-				}
-
-				// Only org admins for this org can create users
-				if claims.Role != "org_admin" || claims.OrgID != orgID {
-					// allow global admin to view but not create
-					http.Error(w, "forbidden: only organization admins can create users", http.StatusForbidden)
-					return
-				}
-
-				// decode body
-				var req struct {
-					Email        string `json:"email"`
-					Username     string `json:"username"`
-					FullName     string `json:"full_name"`
-					Role         string `json:"role"`
-					ExpiresHours int    `json:"expires_hours"`
-				}
-				if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-					http.Error(w, "invalid body", http.StatusBadRequest)
-					return
-				}
-
-				if req.Email == "" || req.Username == "" {
-					http.Error(w, "email and username required", http.StatusBadRequest)
-					return
-				}
-
-				role := req.Role
-				if role == "" {
-					role = "member"
-				}
-
-				// generate secure token (plaintext returned once) and store only hash
-				inviteToken, err := generateSecureToken(32)
-				if err != nil {
-					http.Error(w, "failed to generate invite token", http.StatusInternalServerError)
-					return
-				}
-				tokenHash := hashString(inviteToken)
-
-				expires := time.Now().Add(72 * time.Hour)
-				if req.ExpiresHours > 0 {
-					expires = time.Now().Add(time.Duration(req.ExpiresHours) * time.Hour)
-				}
-
-				invite := models.Invite{
-					Email:     strings.ToLower(req.Email),
-					OrgID:     orgID,
-					Role:      role,
-					TokenHash: tokenHash,
-					ExpiresAt: expires,
-					CreatedBy: claims.UserID,
-				}
-
-				if err := db.Create(&invite).Error; err != nil {
-					http.Error(w, "failed to create invite", http.StatusInternalServerError)
-					return
-				}
-
-				// In production, attempt to email the invite token; in development, return token in response.
-				var emailed bool
-				// Check SMTP configuration via environment variables
-				smtpHost := os.Getenv("SMTP_HOST")
-				smtpPort := os.Getenv("SMTP_PORT")
-				smtpUser := os.Getenv("SMTP_USER")
-				smtpPass := os.Getenv("SMTP_PASS")
-				smtpFrom := os.Getenv("SMTP_FROM")
-
-				if strings.ToLower(cfg.Server.Environment) != "development" && smtpHost != "" && smtpPort != "" {
-					// attempt to send email
-					body := fmt.Sprintf("You have been invited to join organization %s. Use this token to accept the invite: %s", orgID, inviteToken)
-					if err := sendMail(smtpHost+":"+smtpPort, smtpUser, smtpPass, smtpFrom, invite.Email, "TaskFlow Invite", body); err != nil {
-						log.Printf("warning: failed to send invite email: %v", err)
-					} else {
-						emailed = true
-					}
-				}
+	// With a rotation schedule the user service makes its signing keys,
+	// starting with the first
+	if _, err := auth.RotateKeyDir(cfg.JWT, time.Now()); err != nil {
+		log.Fatalf("Failed to rotate JWT keys: %v", err)
+	}
 
-				resp := map[string]string{
-					"invite_id": invite.ID,
-					"email":     invite.Email,
+	// 	// 	// Create JWT manager
+	jwtManager, err := auth.NewJWTManagerFromConfig(cfg.JWT)
+	if err != nil {
+		log.Fatalf("Invalid JWT config: %v", err)
+	}
+	if cfg.JWT.KeyDir != "" && cfg.JWT.RotationInterval > 0 {
+		go func() {
+			ticker := time.NewTicker(time.Minute)
+			defer ticker.Stop()
+			for range ticker.C {
+				if changed, err := auth.RotateKeyDir(cfg.JWT, time.Now()); err != nil {
+					log.Printf("JWT key rotation failed: %v", err)
+				} else if changed {
+					log.Printf("Rotated JWT keys in %s", cfg.JWT.KeyDir)
 				}
-				if strings.ToLower(cfg.Server.Environment) == "development" {
-					resp["token"] = inviteToken
-				} else if emailed {
-					resp["message"] = "invite emailed to recipient"
-				} else {
-					resp["message"] = "invite created; token delivery not configured"
+				// also picks up keys other replicas sharing the directory
+				// added
+				if err := jwtManager.LoadKeys(cfg.JWT); err != nil {
+					log.Printf("Keeping current JWT keys: %v", err)
 				}
-
-				w.Header().Set("Content-Type", "application/json")
-				json.NewEncoder(w).Encode(resp)
-				return
 			}
-			// For other methods, respond 405
-			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
-		})
-
-		// Accept invite and set password -> create user
-		httpMux.HandleFunc("/api/v1/invite/accept", func(w http.ResponseWriter, r *http.Request) {
-			if r.Method != http.MethodPost {
-				http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
-				return
-			}
-			var req struct {
-				Token    string `json:"token"`
-				Password string `json:"password"`
-				Username string `json:"username"`
-				FullName string `json:"full_name"`
-			}
-			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-				http.Error(w, "invalid body", http.StatusBadRequest)
-				return
-			}
-			if req.Token == "" || req.Password == "" || req.Username == "" {
-				http.Error(w, "token, password and username required", http.StatusBadRequest)
-				return
-			}
-
-			// lookup invite by hashed token
-			tokenHash := hashString(req.Token)
-			var invite models.Invite
-			if err := db.Where("token_hash = ?", tokenHash).First(&invite).Error; err != nil {
-				http.Error(w, "invalid or expired invite", http.StatusBadRequest)
-				return
-			}
-			if invite.UsedAt != nil || invite.ExpiresAt.Before(time.Now()) {
-				http.Error(w, "invite already used or expired", http.StatusBadRequest)
-				return
+		}()
+	}
+	// Signing keys are re-read on SIGHUP, so a key rotated in
+	// JWT_SECRET_FILE is used without a restart
+	go func() {
+		hup := make(chan os.Signal, 1)
+		signal.Notify(hup, syscall.SIGHUP)
+		for range hup {
+			reloaded, err := config.LoadConfig()
+			if err == nil {
+				err = reloaded.Validate()
 			}
-
-			// ensure no existing user with this email
-			var existing models.User
-			if err := db.Where("LOWER(email) = ?", strings.ToLower(invite.Email)).First(&existing).Error; err == nil {
-				http.Error(w, "user with this email already exists", http.StatusConflict)
-				return
+			if err == nil {
+				err = jwtManager.LoadKeys(reloaded.JWT)
 			}
-
-			// create user
-			hashedPass, err := auth.HashPassword(req.Password)
 			if err != nil {
-				http.Error(w, "failed to hash password", http.StatusInternalServerError)
-				return
-			}
-			newUser := models.User{
-				Email:    strings.ToLower(invite.Email),
-				Username: req.Username,
-				Password: hashedPass,
-				FullName: req.FullName,
-				Role:     invite.Role,
-			}
-			if invite.OrgID != "" {
-				newUser.OrgID = &invite.OrgID
-			}
-			if err := db.Create(&newUser).Error; err != nil {
-				http.Error(w, "failed to create user", http.StatusInternalServerError)
-				return
-			}
-
-			now := time.Now()
-			invite.UsedAt = &now
-			if err := db.Save(&invite).Error; err != nil {
-				// Log but don't fail creation
-				log.Printf("warning: failed to mark invite used: %v", err)
+				log.Printf("Keeping current JWT keys: %v", err)
+				continue
 			}
+			log.Printf("Reloaded JWT keys")
+		}
+	}()
 
-			w.Header().Set("Content-Type", "application/json")
-			json.NewEncoder(w).Encode(map[string]string{"message": "user created successfully"})
-		})
+	userService := service.NewUserService(db, jwtManager)
+	if n, err := strconv.Atoi(os.Getenv("SUPER_ADMIN_APPROVALS")); err == nil {
+		userService.SetSuperAdminApprovals(n)
+	}
+	if days, err := strconv.Atoi(os.Getenv("ACCOUNT_DELETION_GRACE_DAYS")); err == nil {
+		userService.SetAccountDeletionGrace(time.Duration(days) * 24 * time.Hour)
+	}
 
-		// List org users (org admin or global admin)
-		httpMux.HandleFunc("/api/v1/orgs/users/list", func(w http.ResponseWriter, r *http.Request) {
-			if r.Method != http.MethodGet {
-				http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
-				return
-			}
-			authHeader := r.Header.Get("Authorization")
-			if authHeader == "" {
-				http.Error(w, "missing authorization", http.StatusUnauthorized)
-				return
-			}
-			token := strings.TrimPrefix(authHeader, "Bearer ")
-			claims, err := jwtManager.ValidateToken(token)
-			if err != nil {
-				http.Error(w, "invalid token", http.StatusUnauthorized)
-				return
-			}
+	// Invites and password reset links are emailed outside development,
+	// where InviteUser returns the invite token instead
+	if strings.ToLower(cfg.Server.Environment) == "development" {
+		userService.SetReturnInviteTokens(true)
+	} else if cfg.SMTP.Enabled() {
+		userService.SetMailer(mailer.NewSMTPSender(cfg.SMTP), cfg.SMTP.InviteAcceptURL)
+		userService.SetPasswordResetURL(cfg.SMTP.PasswordResetURL)
+		go userService.RunInviteMailer(context.Background())
+	}
 
-			orgID := r.URL.Query().Get("org_id")
-			if orgID == "" {
-				http.Error(w, "org_id is required", http.StatusBadRequest)
-				return
-			}
+	// Security questions and directory bind passwords are sealed once a key
+	// is configured; rows stored earlier are sealed in the background
+	secrets, err := encryption.FromConfig(cfg.Encryption)
+	if err != nil {
+		log.Fatalf("Invalid encryption config: %v", err)
+	}
+	if secrets != nil {
+		userService.SetEncryption(secrets)
+		go userService.ResealSecrets(context.Background())
+	} else {
+		log.Println("warning: ENCRYPTION_KEY not set, sensitive user data is stored unencrypted")
+	}
 
-			// Allow if requester is org admin for this org OR super admin
-			isOrgAdmin := claims.Role == "org_admin" && claims.OrgID == orgID
-			isSuperAdmin := claims.Role == "super_admin"
-			if !isOrgAdmin && !isSuperAdmin {
-				http.Error(w, "forbidden", http.StatusForbidden)
-				return
-			}
+	if rp := webauthn.FromConfig(cfg.WebAuthn); rp != nil {
+		userService.SetPasskeys(rp)
+	}
 
-			var users []models.User
-			if err := db.Where("org_id = ?", orgID).Find(&users).Error; err != nil {
-				http.Error(w, "failed to list users", http.StatusInternalServerError)
-				return
-			}
+	// Service traffic is TLS once certificates are configured
+	serverCreds, err := certs.ServerCredentials(cfg.TLS)
+	if err != nil {
+		log.Fatalf("Invalid TLS config: %v", err)
+	}
+	dialCreds, err := certs.DialCredentials(cfg.TLS)
+	if err != nil {
+		log.Fatalf("Invalid TLS config: %v", err)
+	}
 
-			out := make([]map[string]interface{}, 0, len(users))
-			for _, u := range users {
-				out = append(out, map[string]interface{}{
-					"user_id":   u.ID,
-					"email":     u.Email,
-					"username":  u.Username,
-					"full_name": u.FullName,
-					"role":      u.Role,
-				})
-			}
-			w.Header().Set("Content-Type", "application/json")
-			json.NewEncoder(w).Encode(map[string]interface{}{"users": out})
-		})
+	// 	// 	// Create gRPC server
+	grpcServer := grpc.NewServer(serverCreds)
 
-		addr := ":8080"
-		log.Printf("UserService HTTP invite API listening on %s", addr)
-		if err := http.ListenAndServe(addr, httpMux); err != nil {
-			log.Fatalf("failed to start http invite api: %v", err)
+	// Start HTTP server for metrics
+	go func() {
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", promhttp.Handler())
+		// public keys for services verifying RS256 or ES256 tokens
+		mux.HandleFunc("/.well-known/jwks.json", jwtManager.ServeJWKS)
+		metricsAddr := ":8080"
+		log.Printf("UserService metrics server listening on %s", metricsAddr)
+		if err := http.ListenAndServe(metricsAddr, mux); err != nil {
+			log.Fatalf("Failed to start metrics server: %v", err)
 		}
 	}()
 
 	// 	// 	// Register UserService
-	userService := service.NewUserService(db, jwtManager)
+	if redisClient, err := cache.NewRedisClient(cfg.Redis.GetRedisAddr(), cfg.Redis.Password, cfg.Redis.DB); err != nil {
+		log.Printf("warning: failed to connect to redis, membership events disabled: %v", err)
+	} else {
+		userService.SetCache(redisClient)
+	}
+
+	// Directory group -> team mapping goes through the organization service
+	orgServiceAddr := os.Getenv("ORG_SERVICE_ADDR")
+	if orgServiceAddr == "" {
+		orgServiceAddr = fmt.Sprintf("localhost:%d", cfg.Server.GRPCPort+3)
+	}
+	orgConn, err := grpc.NewClient(orgServiceAddr, grpc.WithTransportCredentials(dialCreds))
+	if err != nil {
+		log.Fatalf("Failed to create organization service client: %v", err)
+	}
+	defer orgConn.Close()
+	userService.SetOrgClient(organizationpb.NewOrganizationServiceClient(orgConn))
+
+	// Data export and erasure reach into the task and notification services
+	taskServiceAddr := os.Getenv("TASK_SERVICE_ADDR")
+	if taskServiceAddr == "" {
+		taskServiceAddr = fmt.Sprintf("localhost:%d", cfg.Server.GRPCPort+1)
+	}
+	taskConn, err := grpc.NewClient(taskServiceAddr, grpc.WithTransportCredentials(dialCreds))
+	if err != nil {
+		log.Fatalf("Failed to create task service client: %v", err)
+	}
+	defer taskConn.Close()
+	notificationServiceAddr := os.Getenv("NOTIFICATION_SERVICE_ADDR")
+	if notificationServiceAddr == "" {
+		notificationServiceAddr = fmt.Sprintf("localhost:%d", cfg.Server.GRPCPort+2)
+	}
+	notificationConn, err := grpc.NewClient(notificationServiceAddr, grpc.WithTransportCredentials(dialCreds))
+	if err != nil {
+		log.Fatalf("Failed to create notification service client: %v", err)
+	}
+	defer notificationConn.Close()
+	userService.SetDataClients(taskpb.NewTaskServiceClient(taskConn), notificationpb.NewNotificationServiceClient(notificationConn))
 	userpb.RegisterUserServiceServer(grpcServer, userService)
 
+	// Import users and group memberships from org-configured LDAP servers
+	go userService.RunLDAPSyncWorker(context.Background())
+
+	// Finish or retry queued GDPR erasures
+	go userService.RunErasureWorker(context.Background())
+
+	// Drop invites that expired or were revoked long ago
+	go userService.RunInviteCleanupWorker(context.Background())
+	go userService.RunLoginHistoryCleanupWorker(context.Background())
+	go userService.RunRefreshTokenCleanupWorker(context.Background())
+
 	// 	// 	// Register reflection for grpcurl
 	reflection.Register(grpcServer)
 
@@ -359,33 +278,3 @@ func main() {
 		log.Fatalf("Failed to serve: %v", err)
 	}
 }
-
-// sendMail sends a simple plaintext email using basic SMTP auth.
-func sendMail(addr, user, pass, from, to, subject, body string) error {
-	// If no SMTP user/pass provided, try unauthenticated send
-	msg := "From: " + from + "\r\n" +
-		"To: " + to + "\r\n" +
-		"Subject: " + subject + "\r\n\r\n" +
-		body + "\r\n"
-
-	if user != "" && pass != "" {
-		auth := smtp.PlainAuth("", user, pass, strings.Split(addr, ":")[0])
-		return smtp.SendMail(addr, auth, from, []string{to}, []byte(msg))
-	}
-	return smtp.SendMail(addr, nil, from, []string{to}, []byte(msg))
-}
-
-// generateSecureToken returns a cryptographically secure random token of n bytes encoded as hex
-func generateSecureToken(n int) (string, error) {
-	b := make([]byte, n)
-	if _, err := rand.Read(b); err != nil {
-		return "", err
-	}
-	return hex.EncodeToString(b), nil
-}
-
-// hashString returns a SHA-256 hex digest of the input string
-func hashString(s string) string {
-	h := sha256.Sum256([]byte(s))
-	return hex.EncodeToString(h[:])
-}
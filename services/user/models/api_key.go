@@ -0,0 +1,35 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// APIKey is a personal access key for automation. Only a SHA-256 hash of the
+// key is stored; Prefix is kept so users can tell their keys apart.
+type APIKey struct {
+	ID         string     `gorm:"primaryKey;type:uuid" json:"id"`
+	UserID     string     `gorm:"type:uuid;not null;index" json:"user_id"`
+	OrgID      *string    `gorm:"type:uuid;index" json:"org_id,omitempty"`
+	Name       string     `gorm:"not null" json:"name"`
+	Prefix     string     `gorm:"not null" json:"prefix"`
+	KeyHash    string     `gorm:"not null;uniqueIndex" json:"-"`
+	Scopes     string     `gorm:"not null" json:"scopes"` // comma separated
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+func (k *APIKey) BeforeCreate(tx *gorm.DB) error {
+	if k.ID == "" {
+		k.ID = uuid.New().String()
+	}
+	return nil
+}
+
+func (APIKey) TableName() string {
+	return "api_keys"
+}
@@ -0,0 +1,47 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ErrAuditLogImmutable is returned when code tries to change an audit entry
+var ErrAuditLogImmutable = errors.New("audit log entries are append-only")
+
+// AuditLog records an admin or authentication action. Rows are never updated
+// or deleted; the hooks below guard against it at the ORM level and the
+// migration adds the same rule in the database.
+type AuditLog struct {
+	ID         string    `gorm:"primaryKey;type:uuid" json:"id"`
+	OrgID      *string   `gorm:"type:uuid;index" json:"org_id,omitempty"`
+	ActorID    string    `gorm:"index" json:"actor_id"`
+	ActorEmail string    `json:"actor_email"`
+	Action     string    `gorm:"not null;index" json:"action"`
+	TargetType string    `json:"target_type"`
+	TargetID   string    `gorm:"index" json:"target_id"`
+	Metadata   string    `gorm:"type:text" json:"metadata,omitempty"`
+	IPAddress  string    `json:"ip_address,omitempty"`
+	CreatedAt  time.Time `gorm:"index" json:"created_at"`
+}
+
+func (a *AuditLog) BeforeCreate(tx *gorm.DB) error {
+	if a.ID == "" {
+		a.ID = uuid.New().String()
+	}
+	return nil
+}
+
+func (a *AuditLog) BeforeUpdate(tx *gorm.DB) error {
+	return ErrAuditLogImmutable
+}
+
+func (a *AuditLog) BeforeDelete(tx *gorm.DB) error {
+	return ErrAuditLogImmutable
+}
+
+func (AuditLog) TableName() string {
+	return "audit_logs"
+}
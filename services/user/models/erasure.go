@@ -0,0 +1,50 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Data erasure statuses. Self-service deletions wait as scheduled until
+// their grace period ends, and are cancelled if the user signs back in.
+const (
+	ErasureScheduled = "scheduled"
+	ErasurePending   = "pending"
+	ErasureRunning   = "running"
+	ErasureCompleted = "completed"
+	ErasureFailed    = "failed"
+	ErasureCancelled = "cancelled"
+)
+
+// DataErasureRequest tracks erasing one user across services. Each service
+// step is recorded as it completes so retries resume where they stopped.
+type DataErasureRequest struct {
+	ID                    string     `gorm:"primaryKey;type:uuid" json:"id"`
+	UserID                string     `gorm:"type:uuid;not null;index" json:"user_id"`
+	OrgID                 *string    `gorm:"type:uuid;index" json:"org_id,omitempty"`
+	RequestedBy           string     `gorm:"type:uuid" json:"requested_by"`
+	Mode                  string     `gorm:"not null" json:"mode"`
+	Status                string     `gorm:"not null;default:'pending';index" json:"status"`
+	Attempts              int        `gorm:"not null;default:0" json:"attempts"`
+	LastError             string     `json:"last_error,omitempty"`
+	NextAttemptAt         time.Time  `gorm:"not null;index" json:"next_attempt_at"`
+	ClaimedAt             *time.Time `json:"claimed_at,omitempty"`
+	TasksErasedAt         *time.Time `json:"tasks_erased_at,omitempty"`
+	NotificationsErasedAt *time.Time `json:"notifications_erased_at,omitempty"`
+	CompletedAt           *time.Time `json:"completed_at,omitempty"`
+	CreatedAt             time.Time  `json:"created_at"`
+	UpdatedAt             time.Time  `json:"updated_at"`
+}
+
+func (r *DataErasureRequest) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == "" {
+		r.ID = uuid.New().String()
+	}
+	return nil
+}
+
+func (DataErasureRequest) TableName() string {
+	return "data_erasure_requests"
+}
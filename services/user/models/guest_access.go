@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Scopes a guest may be limited to
+const (
+	GuestScopeProject   = "project"
+	GuestScopeWorkspace = "workspace"
+)
+
+// GuestAccess lets a guest reach one project or workspace of an
+// organization until ExpiresAt
+type GuestAccess struct {
+	ID        string    `gorm:"primaryKey;type:uuid" json:"id"`
+	UserID    string    `gorm:"type:uuid;not null;uniqueIndex:unique_guest_access;index:idx_guest_access_user_org" json:"user_id"`
+	OrgID     string    `gorm:"type:uuid;not null;index:idx_guest_access_user_org" json:"org_id"`
+	ScopeType string    `gorm:"not null;uniqueIndex:unique_guest_access" json:"scope_type"`
+	ScopeID   string    `gorm:"type:uuid;not null;uniqueIndex:unique_guest_access" json:"scope_id"`
+	ExpiresAt time.Time `gorm:"not null" json:"expires_at"`
+	InviteID  *string   `gorm:"type:uuid" json:"invite_id,omitempty"`
+	GrantedBy *string   `gorm:"type:uuid" json:"granted_by,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (g *GuestAccess) BeforeCreate(tx *gorm.DB) error {
+	if g.ID == "" {
+		g.ID = uuid.New().String()
+	}
+	return nil
+}
+
+func (GuestAccess) TableName() string {
+	return "guest_access"
+}
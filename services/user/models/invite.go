@@ -16,9 +16,51 @@ type Invite struct {
 	TokenHash string     `gorm:"not null" json:"token_hash"`
 	ExpiresAt time.Time  `json:"expires_at"`
 	UsedAt    *time.Time `json:"used_at,omitempty"`
-	CreatedBy string     `gorm:"type:uuid" json:"created_by"`
-	CreatedAt time.Time  `json:"created_at"`
-	UpdatedAt time.Time  `json:"updated_at"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	RevokedBy string     `json:"revoked_by,omitempty"`
+	SendCount int        `gorm:"not null;default:1" json:"send_count"`
+	// Guest invites are limited to one project or workspace for AccessDays
+	// after acceptance
+	ScopeType  string  `json:"scope_type,omitempty"`
+	ScopeID    *string `gorm:"type:uuid" json:"scope_id,omitempty"`
+	AccessDays int     `gorm:"not null;default:0" json:"access_days,omitempty"`
+	// Email delivery state, see the Delivery* constants
+	DeliveryStatus   string     `json:"delivery_status,omitempty"`
+	DeliveryAttempts int        `gorm:"not null;default:0" json:"delivery_attempts"`
+	DeliveryError    string     `json:"delivery_error,omitempty"`
+	DeliveredAt      *time.Time `json:"delivered_at,omitempty"`
+	CreatedBy        string     `gorm:"type:uuid" json:"created_by"`
+	CreatedAt        time.Time  `json:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+}
+
+// Invite statuses derived from the row
+const (
+	InvitePending  = "pending"
+	InviteAccepted = "accepted"
+	InviteExpired  = "expired"
+	InviteRevoked  = "revoked"
+)
+
+// Invite email delivery statuses. An empty status means the invite was not
+// emailed.
+const (
+	DeliveryQueued = "queued"
+	DeliverySent   = "sent"
+	DeliveryFailed = "failed"
+)
+
+// Status reports where the invite is in its lifecycle at now
+func (i *Invite) Status(now time.Time) string {
+	switch {
+	case i.UsedAt != nil:
+		return InviteAccepted
+	case i.RevokedAt != nil:
+		return InviteRevoked
+	case !now.Before(i.ExpiresAt):
+		return InviteExpired
+	}
+	return InvitePending
 }
 
 func (i *Invite) BeforeCreate(tx *gorm.DB) error {
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// LDAPConfig is an organization's directory sync configuration
+type LDAPConfig struct {
+	ID           string `gorm:"primaryKey;type:uuid" json:"id"`
+	OrgID        string `gorm:"type:uuid;uniqueIndex;not null" json:"org_id"`
+	Enabled      bool   `gorm:"not null;default:false" json:"enabled"`
+	URL          string `gorm:"not null" json:"url"`
+	StartTLS     bool   `gorm:"not null;default:false" json:"start_tls"`
+	BindDN       string `json:"bind_dn"`
+	BindPassword string `json:"-"`
+	BaseDN       string `gorm:"not null" json:"base_dn"`
+	UserFilter   string `json:"user_filter"`
+
+	// Attribute names; defaults suit Active Directory
+	EmailAttribute    string `json:"email_attribute"`
+	UsernameAttribute string `json:"username_attribute"`
+	NameAttribute     string `json:"name_attribute"`
+
+	// GroupTeams maps directory group DNs to TaskFlow team IDs (JSON object)
+	GroupTeams string `gorm:"type:text" json:"group_teams"`
+
+	SyncIntervalMinutes int        `gorm:"not null;default:60" json:"sync_interval_minutes"`
+	LastSyncAt          *time.Time `json:"last_sync_at,omitempty"`
+	LastSyncError       string     `gorm:"type:text" json:"last_sync_error,omitempty"`
+	LastSyncStats       string     `gorm:"type:text" json:"last_sync_stats,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (c *LDAPConfig) BeforeCreate(tx *gorm.DB) error {
+	if c.ID == "" {
+		c.ID = uuid.New().String()
+	}
+	return nil
+}
+
+func (LDAPConfig) TableName() string {
+	return "ldap_configs"
+}
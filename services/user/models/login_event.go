@@ -0,0 +1,50 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Login failure reasons
+const (
+	LoginUnknownEmail       = "unknown_email"
+	LoginInvalidCredentials = "invalid_credentials"
+	LoginDeactivated        = "deactivated"
+	LoginSuspended          = "suspended"
+	LoginLocked             = "locked"
+	LoginThrottled          = "throttled"
+	LoginServiceAccount     = "service_account"
+	LoginDirectoryDown      = "directory_unavailable"
+	LoginPasskeyRequired    = "passkey_required"
+	LoginInvalidPasskey     = "invalid_passkey"
+)
+
+// LoginEvent records one login attempt. UserID is empty when the email did
+// not match an account.
+type LoginEvent struct {
+	ID            string  `gorm:"primaryKey;type:uuid" json:"id"`
+	UserID        *string `gorm:"type:uuid;index:idx_login_events_user_created,priority:1;index:idx_login_events_user_device,priority:1" json:"user_id,omitempty"`
+	OrgID         *string `gorm:"type:uuid;index" json:"org_id,omitempty"`
+	Email         string  `gorm:"index" json:"email"`
+	Success       bool    `gorm:"not null" json:"success"`
+	FailureReason string  `json:"failure_reason,omitempty"`
+	IPAddress     string  `json:"ip_address,omitempty"`
+	UserAgent     string  `json:"user_agent,omitempty"`
+	// DeviceID fingerprints the client from the headers the gateway forwards
+	DeviceID  string    `gorm:"index:idx_login_events_user_device,priority:2" json:"device_id,omitempty"`
+	NewDevice bool      `gorm:"not null;default:false" json:"new_device,omitempty"`
+	CreatedAt time.Time `gorm:"index:idx_login_events_user_created,priority:2" json:"created_at"`
+}
+
+func (e *LoginEvent) BeforeCreate(tx *gorm.DB) error {
+	if e.ID == "" {
+		e.ID = uuid.New().String()
+	}
+	return nil
+}
+
+func (LoginEvent) TableName() string {
+	return "login_events"
+}
@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// OrganizationMembership places a user in an organization other than their
+// home one (users.org_id), with a role that applies only there
+type OrganizationMembership struct {
+	ID        string    `gorm:"primaryKey;type:uuid" json:"id"`
+	UserID    string    `gorm:"type:uuid;not null;uniqueIndex:idx_org_memberships_user_org" json:"user_id"`
+	OrgID     string    `gorm:"type:uuid;not null;uniqueIndex:idx_org_memberships_user_org;index" json:"org_id"`
+	Role      string    `gorm:"not null;default:'member'" json:"role"`
+	InvitedBy string    `json:"invited_by,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (m *OrganizationMembership) BeforeCreate(tx *gorm.DB) error {
+	if m.ID == "" {
+		m.ID = uuid.New().String()
+	}
+	return nil
+}
+
+func (OrganizationMembership) TableName() string {
+	return "organization_memberships"
+}
@@ -0,0 +1,67 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Passkey is a WebAuthn credential a user can sign in with instead of a
+// password
+type Passkey struct {
+	ID     string `gorm:"primaryKey;type:uuid" json:"id"`
+	UserID string `gorm:"type:uuid;not null;index" json:"user_id"`
+	// CredentialID is base64url encoded, as browsers report it
+	CredentialID string `gorm:"not null;uniqueIndex" json:"credential_id"`
+	// PublicKey is the credential's COSE key
+	PublicKey      []byte     `gorm:"not null" json:"-"`
+	SignCount      int64      `gorm:"not null;default:0" json:"-"`
+	AAGUID         string     `json:"aaguid,omitempty"`
+	Name           string     `json:"name"`
+	Transports     string     `json:"transports,omitempty"` // comma-separated
+	BackupEligible bool       `json:"backup_eligible"`
+	LastUsedAt     *time.Time `json:"last_used_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+func (p *Passkey) BeforeCreate(tx *gorm.DB) error {
+	if p.ID == "" {
+		p.ID = uuid.New().String()
+	}
+	return nil
+}
+
+func (Passkey) TableName() string {
+	return "passkeys"
+}
+
+// Passkey ceremony purposes
+const (
+	PasskeyRegistration = "registration"
+	PasskeyLogin        = "login"
+)
+
+// PasskeyChallenge is a pending passkey registration or sign-in. Each is
+// used at most once.
+type PasskeyChallenge struct {
+	ID string `gorm:"primaryKey;type:uuid" json:"id"`
+	// UserID is empty for sign-ins that let the browser pick the account
+	UserID    *string    `gorm:"type:uuid;index" json:"user_id,omitempty"`
+	Purpose   string     `gorm:"not null" json:"purpose"`
+	Challenge string     `gorm:"not null" json:"-"`
+	ExpiresAt time.Time  `gorm:"not null" json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+func (c *PasskeyChallenge) BeforeCreate(tx *gorm.DB) error {
+	if c.ID == "" {
+		c.ID = uuid.New().String()
+	}
+	return nil
+}
+
+func (PasskeyChallenge) TableName() string {
+	return "passkey_challenges"
+}
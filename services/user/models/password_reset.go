@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// PasswordResetToken is an emailed, single-use password reset link. Only
+// the token's hash is stored.
+type PasswordResetToken struct {
+	ID          string     `gorm:"primaryKey;type:uuid" json:"id"`
+	UserID      string     `gorm:"type:uuid;not null;index" json:"user_id"`
+	TokenHash   string     `gorm:"not null;uniqueIndex" json:"-"`
+	ExpiresAt   time.Time  `gorm:"not null" json:"expires_at"`
+	UsedAt      *time.Time `json:"used_at,omitempty"`
+	RequestedIP string     `json:"requested_ip,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+func (t *PasswordResetToken) BeforeCreate(tx *gorm.DB) error {
+	if t.ID == "" {
+		t.ID = uuid.New().String()
+	}
+	return nil
+}
+
+func (PasswordResetToken) TableName() string {
+	return "password_reset_tokens"
+}
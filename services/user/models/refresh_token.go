@@ -0,0 +1,34 @@
+package models
+
+import "time"
+
+// Why a refresh token stopped being valid
+const (
+	RefreshRevokedRotated   = "rotated"
+	RefreshRevokedSignedOut = "signed_out"
+	RefreshRevokedSessions  = "sessions_revoked"
+)
+
+// RefreshToken is one issued refresh token. ID is the token's jti and
+// FamilyID the sign-in it was rotated from; only the token's hash is
+// stored. A token is valid until it expires or RevokedAt is set.
+type RefreshToken struct {
+	ID               string     `gorm:"primaryKey;type:uuid" json:"id"`
+	UserID           string     `gorm:"type:uuid;not null;index" json:"user_id"`
+	OrgID            *string    `gorm:"type:uuid" json:"org_id,omitempty"`
+	FamilyID         string     `gorm:"type:uuid;not null;index" json:"family_id"`
+	TokenHash        string     `gorm:"not null;uniqueIndex" json:"-"`
+	DeviceID         string     `json:"device_id,omitempty"`
+	UserAgent        string     `json:"user_agent,omitempty"`
+	IPAddress        string     `json:"ip_address,omitempty"`
+	SessionStartedAt time.Time  `gorm:"not null" json:"session_started_at"`
+	ExpiresAt        time.Time  `gorm:"not null" json:"expires_at"`
+	RevokedAt        *time.Time `json:"revoked_at,omitempty"`
+	RevokedReason    string     `json:"revoked_reason,omitempty"`
+	ReplacedBy       *string    `gorm:"type:uuid" json:"replaced_by,omitempty"`
+	CreatedAt        time.Time  `json:"created_at"`
+}
+
+func (RefreshToken) TableName() string {
+	return "refresh_tokens"
+}
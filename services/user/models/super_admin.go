@@ -0,0 +1,52 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Super admin change actions and statuses
+const (
+	SuperAdminGrant  = "grant"
+	SuperAdminRevoke = "revoke"
+
+	SuperAdminChangePending  = "pending"
+	SuperAdminChangeApplied  = "applied"
+	SuperAdminChangeRejected = "rejected"
+	SuperAdminChangeExpired  = "expired"
+)
+
+// SuperAdminChange is a proposed grant or revocation of the super_admin
+// role. It is applied once enough other super admins approve it.
+type SuperAdminChange struct {
+	ID        string `gorm:"primaryKey;type:uuid" json:"id"`
+	Action    string `gorm:"not null" json:"action"`
+	UserID    string `gorm:"type:uuid;not null;index" json:"user_id"`
+	UserEmail string `json:"user_email"`
+	// PreviousRole is the role a grant replaced, restored on revocation
+	PreviousRole      string     `json:"previous_role,omitempty"`
+	RequestedBy       string     `gorm:"type:uuid;not null" json:"requested_by"`
+	Reason            string     `json:"reason,omitempty"`
+	Status            string     `gorm:"not null;default:'pending';index" json:"status"`
+	ApprovedBy        string     `json:"approved_by,omitempty"` // comma-separated user IDs
+	RequiredApprovals int        `gorm:"not null;default:1" json:"required_approvals"`
+	DecidedBy         string     `json:"decided_by,omitempty"`
+	DecisionReason    string     `json:"decision_reason,omitempty"`
+	ExpiresAt         time.Time  `json:"expires_at"`
+	DecidedAt         *time.Time `json:"decided_at,omitempty"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+}
+
+func (c *SuperAdminChange) BeforeCreate(tx *gorm.DB) error {
+	if c.ID == "" {
+		c.ID = uuid.New().String()
+	}
+	return nil
+}
+
+func (SuperAdminChange) TableName() string {
+	return "super_admin_changes"
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/datatypes"
 	"gorm.io/gorm"
 )
 
@@ -22,6 +23,33 @@ type User struct {
 	HasLoggedIn         bool       `gorm:"default:false" json:"has_logged_in"`
 	LastLogin           *time.Time `json:"last_login"`
 	FailedLoginAttempts int        `gorm:"default:0" json:"failed_login_attempts"`
+	// Temporary lockout; LockoutCount drives the exponential backoff and
+	// resets on a successful login
+	LockedUntil  *time.Time `json:"locked_until,omitempty"`
+	LockoutCount int        `gorm:"default:0" json:"lockout_count"`
+
+	// Deactivated users keep their data but cannot sign in
+	IsActive      bool       `gorm:"not null;default:true;index" json:"is_active"`
+	DeactivatedAt *time.Time `json:"deactivated_at,omitempty"`
+
+	// Suspension is an admin hold separate from deactivation: the account
+	// keeps its data and memberships but cannot sign in
+	SuspendedAt      *time.Time `gorm:"index" json:"suspended_at,omitempty"`
+	SuspendedBy      string     `json:"suspended_by,omitempty"`
+	SuspensionReason string     `json:"suspension_reason,omitempty"`
+
+	// Users provisioned from a directory (ExternalSource "ldap") are matched
+	// on ExternalID (their DN) and authenticate against the directory
+	ExternalSource string `gorm:"index" json:"external_source,omitempty"`
+	ExternalID     string `gorm:"index" json:"external_id,omitempty"`
+
+	// Service accounts are non-human org members that authenticate only
+	// with API keys
+	IsServiceAccount bool `gorm:"not null;default:false;index" json:"is_service_account"`
+
+	// Values for the extra profile fields organizations define, keyed by
+	// field key (JSON object of strings)
+	ProfileAttributes datatypes.JSON `gorm:"type:jsonb" json:"profile_attributes,omitempty"`
 
 	// Security questions (JSON: [{question: "Q1", answer_hash: "hash1"}, ...])
 	SecurityQuestions string `gorm:"type:text" json:"security_questions,omitempty"`
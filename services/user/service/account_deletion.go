@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/pkg/auth"
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
+	userpb "github.com/chanduchitikam/task-management-system/proto/user"
+	"github.com/chanduchitikam/task-management-system/services/user/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const defaultAccountDeletionGrace = 14 * 24 * time.Hour
+
+// SetAccountDeletionGrace sets how long a self-service account deletion waits
+// before the account is erased
+func (s *UserService) SetAccountDeletionGrace(d time.Duration) {
+	if d <= 0 {
+		d = defaultAccountDeletionGrace
+	}
+	s.deletionGrace = d
+}
+
+func (s *UserService) accountDeletionGrace() time.Duration {
+	if s.deletionGrace <= 0 {
+		return defaultAccountDeletionGrace
+	}
+	return s.deletionGrace
+}
+
+// DeleteMyAccount schedules erasure of the caller's account. Every session is
+// signed out now; the account stays usable so that signing in again before
+// the grace period ends cancels the deletion.
+func (s *UserService) DeleteMyAccount(ctx context.Context, req *userpb.DeleteMyAccountRequest) (*userpb.DeleteMyAccountResponse, error) {
+	userID := getStringFromContext(ctx, "user_id")
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	if authenticatedByAPIKey(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "api keys cannot delete accounts")
+	}
+	if req.Password == "" {
+		return nil, status.Error(codes.InvalidArgument, "password is required")
+	}
+	mode := req.Mode
+	if mode == "" {
+		mode = eraseModeAnonymize
+	}
+	if mode != eraseModeAnonymize && mode != eraseModeDelete {
+		return nil, status.Error(codes.InvalidArgument, "mode must be anonymize or delete")
+	}
+	if s.taskClient == nil || s.notificationClient == nil {
+		return nil, status.Error(codes.Unavailable, "account deletion is not configured")
+	}
+
+	var user models.User
+	if err := s.db.First(&user, "id = ?", userID).Error; err != nil {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if user.IsServiceAccount {
+		return nil, status.Error(codes.FailedPrecondition, "service accounts are deleted by their organization's admins")
+	}
+	if user.Role == authz.RoleSuperAdmin {
+		return nil, status.Error(codes.FailedPrecondition, "revoke your super admin role before deleting your account")
+	}
+	if err := s.checkNotLastAdmin(&user); err != nil {
+		return nil, err
+	}
+
+	checkPassword := func() error { return auth.CheckPassword(req.Password, user.Password) }
+	if user.ExternalSource == ldapSource {
+		checkPassword = func() error { return s.ldapAuthenticate(&user, req.Password) }
+	}
+	if err := checkPassword(); err != nil {
+		if errors.Is(err, errDirectoryUnavailable) {
+			return nil, status.Error(codes.Unavailable, "unable to reach your organization's directory")
+		}
+		return nil, status.Error(codes.Unauthenticated, "incorrect password")
+	}
+
+	var existing models.DataErasureRequest
+	err := s.db.Where("user_id = ? AND status IN ?", user.ID,
+		[]string{models.ErasureScheduled, models.ErasurePending, models.ErasureRunning}).First(&existing).Error
+	if err == nil {
+		return &userpb.DeleteMyAccountResponse{
+			Message: "Your account is already scheduled for deletion",
+			Request: erasureToProto(&existing),
+		}, nil
+	}
+
+	scheduledFor := time.Now().Add(s.accountDeletionGrace())
+	erasure := &models.DataErasureRequest{
+		UserID:        user.ID,
+		OrgID:         user.OrgID,
+		RequestedBy:   user.ID,
+		Mode:          mode,
+		Status:        models.ErasureScheduled,
+		NextAttemptAt: scheduledFor,
+	}
+	if err := s.db.Create(erasure).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to schedule account deletion")
+	}
+	s.revokeSessions(ctx, user.ID)
+	s.recordAudit(ctx, auditEvent{
+		OrgID:      getStringValue(user.OrgID),
+		Action:     auditDeletionScheduled,
+		TargetType: "user",
+		TargetID:   user.ID,
+		Metadata: map[string]string{
+			"mode":          mode,
+			"request_id":    erasure.ID,
+			"scheduled_for": scheduledFor.UTC().Format(time.RFC3339),
+		},
+	})
+
+	return &userpb.DeleteMyAccountResponse{
+		Message: fmt.Sprintf("Your account will be deleted on %s. Sign in before then to cancel.",
+			scheduledFor.UTC().Format("Jan 2, 2006 15:04 MST")),
+		Request: erasureToProto(erasure),
+	}, nil
+}
+
+// checkNotLastAdmin refuses to delete the only admin of any organization the
+// user belongs to, which would leave it unmanageable
+func (s *UserService) checkNotLastAdmin(user *models.User) error {
+	orgIDs := []string{}
+	if user.OrgID != nil && authz.Can(user.Role, authz.MemberManage) {
+		orgIDs = append(orgIDs, *user.OrgID)
+	}
+	var memberships []models.OrganizationMembership
+	s.db.Select("org_id").Where("user_id = ? AND role IN ?", user.ID, authz.RolesWith(authz.MemberManage)).Find(&memberships)
+	for _, m := range memberships {
+		orgIDs = append(orgIDs, m.OrgID)
+	}
+	for _, orgID := range orgIDs {
+		if s.countOrgAdmins(orgID) <= 1 {
+			return status.Error(codes.FailedPrecondition, "you are the last admin of an organization; make someone else an admin first")
+		}
+	}
+	return nil
+}
+
+// cancelAccountDeletion cancels the user's scheduled deletion, reporting
+// whether there was one. Deletions already under way cannot be cancelled.
+func (s *UserService) cancelAccountDeletion(ctx context.Context, user *models.User) bool {
+	res := s.db.Model(&models.DataErasureRequest{}).
+		Where("user_id = ? AND status = ?", user.ID, models.ErasureScheduled).
+		Update("status", models.ErasureCancelled)
+	if res.Error != nil {
+		log.Printf("warning: failed to cancel scheduled deletion for user %s: %v", user.ID, res.Error)
+		return false
+	}
+	if res.RowsAffected == 0 {
+		return false
+	}
+	s.recordAudit(ctx, auditEvent{
+		OrgID:      getStringValue(user.OrgID),
+		ActorID:    user.ID,
+		ActorEmail: user.Email,
+		Action:     auditDeletionCancelled,
+		TargetType: "user",
+		TargetID:   user.ID,
+	})
+	return true
+}
@@ -0,0 +1,265 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/pkg/auth"
+	userpb "github.com/chanduchitikam/task-management-system/proto/user"
+	"github.com/chanduchitikam/task-management-system/services/user/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultAPIKeyExpiryDays = 90
+	maxAPIKeyExpiryDays     = 365
+	maxAPIKeysPerUser       = 25
+	apiKeyDisplayPrefixLen  = 12
+
+	// last_used_at is only written when older than this, so busy keys do not
+	// turn every request into a write
+	apiKeyUsageResolution = time.Minute
+)
+
+// authenticatedByAPIKey reports whether the gateway authenticated this call
+// with an API key rather than a user session
+func authenticatedByAPIKey(ctx context.Context) bool {
+	return getStringFromContext(ctx, "x-auth-method") == "api_key"
+}
+
+// CreateAPIKey issues a new API key for the caller. The key is returned once
+// and only its hash is stored.
+func (s *UserService) CreateAPIKey(ctx context.Context, req *userpb.CreateAPIKeyRequest) (*userpb.CreateAPIKeyResponse, error) {
+	userID := getStringFromContext(ctx, "user_id")
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	if authenticatedByAPIKey(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "api keys cannot manage api keys")
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	scopes, err := normalizeAPIKeyScopes(req.Scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	var user models.User
+	if err := s.db.First(&user, "id = ?", userID).Error; err != nil {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+
+	key, plain, err := s.issueAPIKey(&user, name, scopes, req.ExpiresInDays)
+	if err != nil {
+		return nil, err
+	}
+	s.recordAudit(ctx, auditEvent{
+		OrgID:      getStringValue(user.OrgID),
+		Action:     auditAPIKeyCreated,
+		TargetType: "api_key",
+		TargetID:   key.ID,
+		Metadata:   map[string]string{"name": name, "scopes": key.Scopes},
+	})
+
+	return &userpb.CreateAPIKeyResponse{
+		ApiKey: apiKeyToProto(key),
+		Key:    plain,
+	}, nil
+}
+
+// normalizeAPIKeyScopes validates and de-duplicates requested scopes
+func normalizeAPIKeyScopes(requested []string) ([]string, error) {
+	if len(requested) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one scope is required")
+	}
+	scopes := make([]string, 0, len(requested))
+	seen := make(map[string]bool)
+	for _, scope := range requested {
+		scope = strings.TrimSpace(scope)
+		if !auth.ValidAPIKeyScope(scope) {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown scope %q", scope)
+		}
+		if !seen[scope] {
+			seen[scope] = true
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes, nil
+}
+
+// issueAPIKey stores a new key for owner and returns it with its plaintext
+func (s *UserService) issueAPIKey(owner *models.User, name string, scopes []string, days int32) (*models.APIKey, string, error) {
+	if days <= 0 {
+		days = defaultAPIKeyExpiryDays
+	}
+	if days > maxAPIKeyExpiryDays {
+		return nil, "", status.Errorf(codes.InvalidArgument, "expires_in_days must be at most %d", maxAPIKeyExpiryDays)
+	}
+
+	var active int64
+	if err := s.db.Model(&models.APIKey{}).
+		Where("user_id = ? AND revoked_at IS NULL AND (expires_at IS NULL OR expires_at > ?)", owner.ID, time.Now()).
+		Count(&active).Error; err != nil {
+		return nil, "", status.Error(codes.Internal, "failed to count api keys")
+	}
+	if active >= maxAPIKeysPerUser {
+		return nil, "", status.Errorf(codes.ResourceExhausted, "at most %d active api keys are allowed", maxAPIKeysPerUser)
+	}
+
+	secret, err := generateSecureToken(32)
+	if err != nil {
+		return nil, "", status.Error(codes.Internal, "failed to generate api key")
+	}
+	plain := auth.APIKeyPrefix + secret
+	expiresAt := time.Now().AddDate(0, 0, int(days))
+
+	key := &models.APIKey{
+		UserID:    owner.ID,
+		OrgID:     owner.OrgID,
+		Name:      name,
+		Prefix:    plain[:apiKeyDisplayPrefixLen],
+		KeyHash:   hashString(plain),
+		Scopes:    strings.Join(scopes, ","),
+		ExpiresAt: &expiresAt,
+	}
+	if err := s.db.Create(key).Error; err != nil {
+		return nil, "", status.Error(codes.Internal, "failed to create api key")
+	}
+	return key, plain, nil
+}
+
+// ListAPIKeys returns the caller's API keys, newest first
+func (s *UserService) ListAPIKeys(ctx context.Context, req *userpb.ListAPIKeysRequest) (*userpb.ListAPIKeysResponse, error) {
+	userID := getStringFromContext(ctx, "user_id")
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	if authenticatedByAPIKey(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "api keys cannot manage api keys")
+	}
+
+	var keys []models.APIKey
+	if err := s.db.Where("user_id = ?", userID).Order("created_at DESC").Find(&keys).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to list api keys")
+	}
+
+	resp := &userpb.ListAPIKeysResponse{ApiKeys: make([]*userpb.APIKey, len(keys))}
+	for i := range keys {
+		resp.ApiKeys[i] = apiKeyToProto(&keys[i])
+	}
+	return resp, nil
+}
+
+// RevokeAPIKey revokes one of the caller's API keys
+func (s *UserService) RevokeAPIKey(ctx context.Context, req *userpb.RevokeAPIKeyRequest) (*userpb.RevokeAPIKeyResponse, error) {
+	userID := getStringFromContext(ctx, "user_id")
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	if authenticatedByAPIKey(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "api keys cannot manage api keys")
+	}
+	if req.KeyId == "" {
+		return nil, status.Error(codes.InvalidArgument, "key_id is required")
+	}
+
+	var key models.APIKey
+	if err := s.db.First(&key, "id = ? AND user_id = ?", req.KeyId, userID).Error; err != nil {
+		return nil, status.Error(codes.NotFound, "api key not found")
+	}
+	if key.RevokedAt == nil {
+		if err := s.db.Model(&key).Update("revoked_at", time.Now()).Error; err != nil {
+			return nil, status.Error(codes.Internal, "failed to revoke api key")
+		}
+		s.recordAudit(ctx, auditEvent{
+			OrgID:      getStringValue(key.OrgID),
+			Action:     auditAPIKeyRevoked,
+			TargetType: "api_key",
+			TargetID:   key.ID,
+			Metadata:   map[string]string{"name": key.Name},
+		})
+	}
+
+	return &userpb.RevokeAPIKeyResponse{
+		Message: fmt.Sprintf("API key %s revoked", key.Name),
+	}, nil
+}
+
+// ValidateAPIKey resolves a key to its owner for the gateway. The owner's
+// current role and organization are returned, so demoted or moved users do
+// not keep their old access through a key.
+func (s *UserService) ValidateAPIKey(ctx context.Context, req *userpb.ValidateAPIKeyRequest) (*userpb.ValidateAPIKeyResponse, error) {
+	if !auth.IsAPIKey(req.Key) {
+		return &userpb.ValidateAPIKeyResponse{Valid: false}, nil
+	}
+
+	var key models.APIKey
+	if err := s.db.First(&key, "key_hash = ?", hashString(req.Key)).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return &userpb.ValidateAPIKeyResponse{Valid: false}, nil
+		}
+		return nil, status.Error(codes.Internal, "failed to look up api key")
+	}
+
+	now := time.Now()
+	if key.RevokedAt != nil || (key.ExpiresAt != nil && !now.Before(*key.ExpiresAt)) {
+		return &userpb.ValidateAPIKeyResponse{Valid: false}, nil
+	}
+
+	var user models.User
+	if err := s.db.First(&user, "id = ?", key.UserID).Error; err != nil {
+		return &userpb.ValidateAPIKeyResponse{Valid: false}, nil
+	}
+	// keys are bound to the organization they were created in
+	if !user.IsActive || user.SuspendedAt != nil || getStringValue(user.OrgID) != getStringValue(key.OrgID) {
+		return &userpb.ValidateAPIKeyResponse{Valid: false}, nil
+	}
+
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= apiKeyUsageResolution {
+		s.db.Model(&key).UpdateColumn("last_used_at", now)
+	}
+
+	return &userpb.ValidateAPIKeyResponse{
+		Valid:  true,
+		UserId: user.ID,
+		OrgId:  getStringValue(user.OrgID),
+		Role:   user.Role,
+		Email:  user.Email,
+		Scopes: splitList(key.Scopes),
+		KeyId:  key.ID,
+	}, nil
+}
+
+// splitList splits a comma-separated column
+func splitList(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, ",")
+}
+
+func apiKeyToProto(k *models.APIKey) *userpb.APIKey {
+	pb := &userpb.APIKey{
+		KeyId:     k.ID,
+		Name:      k.Name,
+		Prefix:    k.Prefix,
+		Scopes:    splitList(k.Scopes),
+		CreatedAt: timestamppb.New(k.CreatedAt),
+		Revoked:   k.RevokedAt != nil,
+	}
+	if k.ExpiresAt != nil {
+		pb.ExpiresAt = timestamppb.New(*k.ExpiresAt)
+	}
+	if k.LastUsedAt != nil {
+		pb.LastUsedAt = timestamppb.New(*k.LastUsedAt)
+	}
+	return pb
+}
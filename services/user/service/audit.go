@@ -0,0 +1,226 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
+	userpb "github.com/chanduchitikam/task-management-system/proto/user"
+	"github.com/chanduchitikam/task-management-system/services/user/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Audit actions
+const (
+	auditLogin          = "auth.login"
+	auditLoginFailed    = "auth.login_failed"
+	auditAccountLocked  = "auth.account_locked"
+	auditAccountUnlock  = "user.unlocked"
+	auditInviteCreated  = "invite.created"
+	auditInviteAccepted = "invite.accepted"
+	auditInviteResent   = "invite.resent"
+	auditInviteRevoked  = "invite.revoked"
+	auditRoleChanged    = "user.role_changed"
+	auditPasswordReset  = "user.password_reset"
+	auditUserDeleted    = "user.deleted"
+	auditMemberCreated  = "member.created"
+	auditMemberRemoved  = "member.removed"
+	auditOrgDeleted     = "org.deleted"
+	auditAPIKeyCreated  = "api_key.created"
+	auditAPIKeyRevoked  = "api_key.revoked"
+
+	auditServiceAccountCreated   = "service_account.created"
+	auditServiceAccountRotated   = "service_account.key_rotated"
+	auditServiceAccountDeleted   = "service_account.deleted"
+	auditUserSuspended           = "user.suspended"
+	auditUserReactivated         = "user.reactivated"
+	auditDataExported            = "user.data_exported"
+	auditErasureRequested        = "user.erasure_requested"
+	auditUserErased              = "user.erased"
+	auditSuperAdminRequested     = "super_admin.requested"
+	auditSuperAdminApproved      = "super_admin.approved"
+	auditSuperAdminRejected      = "super_admin.rejected"
+	auditSuperAdminGranted       = "super_admin.granted"
+	auditSuperAdminRevoked       = "super_admin.revoked"
+	auditPasswordResetRequested  = "user.password_reset_requested"
+	auditInviteDomainsUpdated    = "org.invite_domains_updated"
+	auditDeletionScheduled       = "user.deletion_scheduled"
+	auditDeletionCancelled       = "user.deletion_cancelled"
+	auditProfileFieldsUpdated    = "org.profile_fields_updated"
+	auditProfileUpdated          = "user.profile_updated"
+	auditNewDeviceLogin          = "auth.new_device_login"
+	auditSecuritySettingsUpdated = "org.security_settings_updated"
+	auditPasskeyAdded            = "user.passkey_added"
+	auditPasskeyRemoved          = "user.passkey_removed"
+	auditRefreshTokenReused      = "auth.refresh_token_reused"
+	auditIPBlocked               = "auth.ip_blocked"
+	auditSessionRevoked          = "auth.session_revoked"
+)
+
+const (
+	defaultAuditPageSize = 50
+	maxAuditPageSize     = 200
+)
+
+// auditEvent describes one audit entry. The actor defaults to the caller in
+// ctx; set ActorID for unauthenticated flows such as login.
+type auditEvent struct {
+	OrgID      string
+	ActorID    string
+	ActorEmail string
+	Action     string
+	TargetType string
+	TargetID   string
+	Metadata   map[string]string
+}
+
+// recordAudit appends an audit entry. Failures are logged and never fail the
+// action being audited.
+func (s *UserService) recordAudit(ctx context.Context, ev auditEvent) {
+	if ev.ActorID == "" {
+		ev.ActorID = getStringFromContext(ctx, "user_id")
+		ev.ActorEmail = getStringFromContext(ctx, "email")
+	}
+
+	entry := &models.AuditLog{
+		ActorID:    ev.ActorID,
+		ActorEmail: ev.ActorEmail,
+		Action:     ev.Action,
+		TargetType: ev.TargetType,
+		TargetID:   ev.TargetID,
+		IPAddress:  clientIP(ctx),
+	}
+	if ev.OrgID != "" {
+		entry.OrgID = &ev.OrgID
+	}
+	if len(ev.Metadata) > 0 {
+		if b, err := json.Marshal(ev.Metadata); err == nil {
+			entry.Metadata = string(b)
+		}
+	}
+
+	if err := s.db.Create(entry).Error; err != nil {
+		log.Printf("warning: failed to record audit entry %s for %s: %v", ev.Action, ev.TargetID, err)
+	}
+}
+
+// clientIPMetadata carries the client's address as the gateway resolved
+// it behind TRUSTED_PROXIES. The gateway drops it from inbound requests, so
+// unlike X-Forwarded-For callers can't choose it.
+const clientIPMetadata = "x-client-ip"
+
+// clientIP returns the originating client address, preferring the address
+// the gateway resolved over the direct peer
+func clientIP(ctx context.Context) string {
+	if ip := gatewayClientIP(ctx); ip != "" {
+		return ip
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+	return ""
+}
+
+// gatewayClientIP returns the client address the gateway resolved, or ""
+// for calls that did not come through it
+func gatewayClientIP(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if vals := md.Get(clientIPMetadata); len(vals) > 0 {
+			return vals[0]
+		}
+	}
+	return ""
+}
+
+// ListAuditLogs returns audit entries, newest first
+func (s *UserService) ListAuditLogs(ctx context.Context, req *userpb.ListAuditLogsRequest) (*userpb.ListAuditLogsResponse, error) {
+	role := getStringFromContext(ctx, "role")
+	callerOrg := getStringFromContext(ctx, "org_id")
+
+	orgID := req.OrgId
+	switch {
+	case authz.IsPlatformAdmin(role):
+	case authz.Can(role, authz.AuditRead) && callerOrg != "":
+		if orgID == "" {
+			orgID = callerOrg
+		}
+		if orgID != callerOrg {
+			return nil, status.Error(codes.PermissionDenied, "access denied")
+		}
+	default:
+		return nil, status.Error(codes.PermissionDenied, "admin access required")
+	}
+
+	page := req.Page
+	if page < 1 {
+		page = 1
+	}
+	pageSize := req.PageSize
+	if pageSize < 1 {
+		pageSize = defaultAuditPageSize
+	}
+	if pageSize > maxAuditPageSize {
+		pageSize = maxAuditPageSize
+	}
+
+	query := s.db.Model(&models.AuditLog{})
+	if orgID != "" {
+		query = query.Where("org_id = ?", orgID)
+	}
+	if req.ActorId != "" {
+		query = query.Where("actor_id = ?", req.ActorId)
+	}
+	if req.Action != "" {
+		query = query.Where("action = ?", req.Action)
+	}
+	if req.StartTime != nil {
+		query = query.Where("created_at >= ?", req.StartTime.AsTime())
+	}
+	if req.EndTime != nil {
+		query = query.Where("created_at < ?", req.EndTime.AsTime())
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to count audit entries")
+	}
+
+	var entries []models.AuditLog
+	if err := query.Order("created_at DESC").Offset(int((page - 1) * pageSize)).Limit(int(pageSize)).Find(&entries).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to list audit entries")
+	}
+
+	resp := &userpb.ListAuditLogsResponse{
+		Entries:    make([]*userpb.AuditLogEntry, len(entries)),
+		TotalCount: int32(total),
+		Page:       page,
+		PageSize:   pageSize,
+	}
+	for i := range entries {
+		resp.Entries[i] = auditToProto(&entries[i])
+	}
+	return resp, nil
+}
+
+func auditToProto(a *models.AuditLog) *userpb.AuditLogEntry {
+	pb := &userpb.AuditLogEntry{
+		Id:         a.ID,
+		OrgId:      getStringValue(a.OrgID),
+		ActorId:    a.ActorID,
+		ActorEmail: a.ActorEmail,
+		Action:     a.Action,
+		TargetType: a.TargetType,
+		TargetId:   a.TargetID,
+		IpAddress:  a.IPAddress,
+		CreatedAt:  timestamppb.New(a.CreatedAt),
+	}
+	if a.Metadata != "" {
+		_ = json.Unmarshal([]byte(a.Metadata), &pb.Metadata)
+	}
+	return pb
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"errors"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/services/user/models"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// defaultGuestAccessDays is how long a guest keeps access when the invite
+// does not say
+const defaultGuestAccessDays = 30
+
+// maxGuestAccessDays caps how long one guest invite may grant access for
+const maxGuestAccessDays = 365
+
+// validateGuestScope checks a guest invite names a project or workspace of
+// orgID and a usable access period
+func (s *UserService) validateGuestScope(orgID, scopeType, scopeID string, accessDays int32) error {
+	var table string
+	switch scopeType {
+	case models.GuestScopeProject:
+		table = "projects"
+	case models.GuestScopeWorkspace:
+		table = "workspaces"
+	default:
+		return status.Error(codes.InvalidArgument, "guest invites need a scope_type of project or workspace")
+	}
+	if _, err := uuid.Parse(scopeID); err != nil {
+		return status.Error(codes.InvalidArgument, "guest invites need a valid scope_id")
+	}
+	if accessDays < 0 || accessDays > maxGuestAccessDays {
+		return status.Errorf(codes.InvalidArgument, "access_days must be between 1 and %d", maxGuestAccessDays)
+	}
+
+	var found []string
+	if err := s.db.Raw("SELECT org_id FROM "+table+" WHERE id = ?", scopeID).Scan(&found).Error; err != nil {
+		return status.Error(codes.Internal, "failed to check guest scope")
+	}
+	if len(found) == 0 || found[0] != orgID {
+		return status.Errorf(codes.NotFound, "%s not found", scopeType)
+	}
+	return nil
+}
+
+// grantGuestAccess records the scope of an accepted guest invite for userID
+// and adds them to that project or workspace. Accepting a second invite to
+// the same scope extends the access.
+func grantGuestAccess(tx *gorm.DB, invite *models.Invite, userID string) error {
+	if invite.ScopeID == nil {
+		return status.Error(codes.FailedPrecondition, "guest invite has no scope")
+	}
+	days := invite.AccessDays
+	if days <= 0 {
+		days = defaultGuestAccessDays
+	}
+	now := time.Now()
+	access := &models.GuestAccess{
+		UserID:    userID,
+		OrgID:     invite.OrgID,
+		ScopeType: invite.ScopeType,
+		ScopeID:   *invite.ScopeID,
+		ExpiresAt: now.AddDate(0, 0, days),
+		InviteID:  &invite.ID,
+	}
+	if invite.CreatedBy != "" {
+		access.GrantedBy = &invite.CreatedBy
+	}
+	err := tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "scope_type"}, {Name: "scope_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"org_id", "expires_at", "invite_id", "granted_by"}),
+	}).Create(access).Error
+	if err != nil {
+		return status.Error(codes.Internal, "failed to grant guest access")
+	}
+
+	switch invite.ScopeType {
+	case models.GuestScopeProject:
+		err = tx.Exec(`
+			INSERT INTO project_members (id, project_id, user_id, role, joined_at, is_active)
+			VALUES (?, ?, ?, 'guest', ?, true)
+			ON CONFLICT (project_id, user_id) DO UPDATE SET is_active = true, left_at = NULL
+		`, uuid.New(), access.ScopeID, userID, now).Error
+	case models.GuestScopeWorkspace:
+		err = tx.Exec(`
+			INSERT INTO workspace_members (id, workspace_id, user_id, role, joined_at, is_active, added_by)
+			VALUES (?, ?, ?, 'member', ?, true, ?)
+			ON CONFLICT (workspace_id, user_id) DO UPDATE SET is_active = true, left_at = NULL
+		`, uuid.New(), access.ScopeID, userID, now, access.GrantedBy).Error
+	default:
+		err = errors.New("unknown guest scope")
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to add guest to %s", invite.ScopeType)
+	}
+	return nil
+}
@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
+	userpb "github.com/chanduchitikam/task-management-system/proto/user"
+	"github.com/chanduchitikam/task-management-system/services/user/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/datatypes"
+)
+
+const maxAllowedEmailDomains = 50
+
+var emailDomainPattern = regexp.MustCompile(`^([a-z0-9]([a-z0-9-]*[a-z0-9])?\.)+[a-z]{2,}$`)
+
+// The allowlist is kept under "allowed_email_domains" in the organization's
+// settings, next to its branding
+
+// inviteDomainPolicy returns the organization's primary domain and its
+// additional allowed domains
+func (s *UserService) inviteDomainPolicy(orgID string) (string, []string, error) {
+	var org models.Organization
+	if err := s.db.Select("id", "domain", "settings").First(&org, "id = ?", orgID).Error; err != nil {
+		return "", nil, status.Error(codes.NotFound, "organization not found")
+	}
+	var settings struct {
+		AllowedEmailDomains []string `json:"allowed_email_domains"`
+	}
+	if len(org.Settings) > 0 {
+		_ = json.Unmarshal(org.Settings, &settings)
+	}
+	return strings.ToLower(org.Domain), settings.AllowedEmailDomains, nil
+}
+
+func emailDomain(email string) (string, error) {
+	parts := strings.Split(strings.TrimSpace(email), "@")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", status.Error(codes.InvalidArgument, "invalid email format")
+	}
+	return strings.ToLower(parts[1]), nil
+}
+
+func domainAllowed(domain, primary string, allowed []string) bool {
+	if domain == primary {
+		return true
+	}
+	for _, d := range allowed {
+		if domain == d {
+			return true
+		}
+	}
+	return false
+}
+
+func domainPolicyError(primary string, allowed []string) error {
+	domains := allowed
+	if primary != "" {
+		domains = append([]string{primary}, allowed...)
+	}
+	return status.Errorf(codes.InvalidArgument, "email domain must be one of @%s for this organization", strings.Join(domains, ", @"))
+}
+
+// validateInviteEmailDomain checks an invitee's address against the org's
+// allowlist. Orgs without an allowlist accept invites to any domain.
+func (s *UserService) validateInviteEmailDomain(email, orgID string) error {
+	primary, allowed, err := s.inviteDomainPolicy(orgID)
+	if err != nil {
+		return err
+	}
+	if len(allowed) == 0 {
+		return nil
+	}
+	domain, err := emailDomain(email)
+	if err != nil {
+		return err
+	}
+	if !domainAllowed(domain, primary, allowed) {
+		return domainPolicyError(primary, allowed)
+	}
+	return nil
+}
+
+// normalizeEmailDomains lowercases, dedupes and validates an allowlist
+func normalizeEmailDomains(in []string) ([]string, error) {
+	seen := make(map[string]bool, len(in))
+	out := make([]string, 0, len(in))
+	for _, d := range in {
+		d = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(d)), "@")
+		if d == "" || seen[d] {
+			continue
+		}
+		if !emailDomainPattern.MatchString(d) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid email domain %q", d)
+		}
+		seen[d] = true
+		out = append(out, d)
+	}
+	if len(out) > maxAllowedEmailDomains {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d allowed domains", maxAllowedEmailDomains)
+	}
+	sort.Strings(out)
+	return out, nil
+}
+
+// GetInviteDomainPolicy returns the email domains an organization accepts
+func (s *UserService) GetInviteDomainPolicy(ctx context.Context, req *userpb.GetInviteDomainPolicyRequest) (*userpb.InviteDomainPolicy, error) {
+	if req.OrgId == "" {
+		return nil, status.Error(codes.InvalidArgument, "org_id is required")
+	}
+	if !callerCan(ctx, authz.MemberInvite, req.OrgId) {
+		return nil, status.Error(codes.PermissionDenied, "access denied")
+	}
+	primary, allowed, err := s.inviteDomainPolicy(req.OrgId)
+	if err != nil {
+		return nil, err
+	}
+	return &userpb.InviteDomainPolicy{OrgId: req.OrgId, PrimaryDomain: primary, AllowedDomains: allowed}, nil
+}
+
+// UpdateInviteDomainPolicy replaces an organization's allowlist. Pending
+// invites to domains no longer allowed can no longer be accepted.
+func (s *UserService) UpdateInviteDomainPolicy(ctx context.Context, req *userpb.UpdateInviteDomainPolicyRequest) (*userpb.InviteDomainPolicy, error) {
+	if req.OrgId == "" {
+		return nil, status.Error(codes.InvalidArgument, "org_id is required")
+	}
+	if !callerCan(ctx, authz.OrgManage, req.OrgId) {
+		return nil, status.Error(codes.PermissionDenied, "access denied")
+	}
+	domains, err := normalizeEmailDomains(req.AllowedDomains)
+	if err != nil {
+		return nil, err
+	}
+
+	var org models.Organization
+	if err := s.db.Select("id", "domain", "settings").First(&org, "id = ?", req.OrgId).Error; err != nil {
+		return nil, status.Error(codes.NotFound, "organization not found")
+	}
+	settings := map[string]json.RawMessage{}
+	if len(org.Settings) > 0 {
+		_ = json.Unmarshal(org.Settings, &settings)
+	}
+	if len(domains) == 0 {
+		delete(settings, "allowed_email_domains")
+	} else {
+		raw, _ := json.Marshal(domains)
+		settings["allowed_email_domains"] = raw
+	}
+	encoded, err := json.Marshal(settings)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to encode settings")
+	}
+	if err := s.db.Model(&org).Update("settings", datatypes.JSON(encoded)).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to update invite domains")
+	}
+	s.recordAudit(ctx, auditEvent{
+		OrgID:      req.OrgId,
+		Action:     auditInviteDomainsUpdated,
+		TargetType: "organization",
+		TargetID:   req.OrgId,
+		Metadata:   map[string]string{"allowed_domains": strings.Join(domains, ",")},
+	})
+
+	return &userpb.InviteDomainPolicy{OrgId: req.OrgId, PrimaryDomain: strings.ToLower(org.Domain), AllowedDomains: domains}, nil
+}
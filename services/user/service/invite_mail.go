@@ -0,0 +1,255 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"encoding/json"
+	htmltemplate "html/template"
+	"log"
+	"net/url"
+	"regexp"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/pkg/mailer"
+	"github.com/chanduchitikam/task-management-system/services/user/models"
+)
+
+const (
+	inviteMailQueueSize       = 256
+	maxInviteDeliveryAttempts = 5
+	inviteDeliveryBaseBackoff = 30 * time.Second
+	inviteSendTimeout         = 30 * time.Second
+
+	defaultBrandColor = "#4F46E5"
+)
+
+//go:embed templates/invite.html templates/invite.txt
+var inviteTemplates embed.FS
+
+var (
+	inviteHTMLTemplate = htmltemplate.Must(htmltemplate.ParseFS(inviteTemplates, "templates/invite.html"))
+	inviteTextTemplate = texttemplate.Must(texttemplate.ParseFS(inviteTemplates, "templates/invite.txt"))
+
+	brandColorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{3}([0-9a-fA-F]{3})?$`)
+)
+
+// inviteDelivery is one queued invite email. The plaintext token only lives
+// in the queue; the invite row stores its hash.
+type inviteDelivery struct {
+	inviteID string
+	token    string
+	attempt  int
+}
+
+// orgBranding is read from the "branding" key of an organization's settings
+type orgBranding struct {
+	DisplayName  string `json:"display_name"`
+	LogoURL      string `json:"logo_url"`
+	PrimaryColor string `json:"primary_color"`
+	SupportEmail string `json:"support_email"`
+}
+
+type inviteEmailData struct {
+	Subject      string
+	OrgName      string
+	LogoURL      string
+	PrimaryColor string
+	SupportEmail string
+	Role         string
+	Token        string
+	AcceptURL    string
+	ExpiresAt    string
+}
+
+// SetMailer enables emailing invites. Deliveries are queued and sent by
+// RunInviteMailer; acceptURL, if set, is linked from the email.
+func (s *UserService) SetMailer(sender mailer.Sender, acceptURL string) {
+	s.mailer = sender
+	s.inviteAcceptURL = acceptURL
+	s.inviteMail = make(chan inviteDelivery, inviteMailQueueSize)
+	s.mailerStartedAt = time.Now()
+}
+
+// SetReturnInviteTokens makes InviteUser and ResendInvite return the invite
+// token instead of emailing it. Only for development deployments.
+func (s *UserService) SetReturnInviteTokens(enabled bool) {
+	s.returnInviteTokens = enabled
+}
+
+// QueueInviteEmail queues delivery of an invite's token, reporting whether
+// it was queued. Progress is recorded on the invite row.
+func (s *UserService) QueueInviteEmail(invite *models.Invite, token string) bool {
+	if s.mailer == nil {
+		return false
+	}
+	s.setInviteDelivery(invite.ID, map[string]interface{}{
+		"delivery_status":   models.DeliveryQueued,
+		"delivery_attempts": 0,
+		"delivery_error":    "",
+		"delivered_at":      nil,
+	})
+	return s.enqueueInviteDelivery(inviteDelivery{inviteID: invite.ID, token: token})
+}
+
+func (s *UserService) enqueueInviteDelivery(d inviteDelivery) bool {
+	select {
+	case s.inviteMail <- d:
+		return true
+	default:
+		s.setInviteDelivery(d.inviteID, map[string]interface{}{
+			"delivery_status": models.DeliveryFailed,
+			"delivery_error":  "delivery queue is full; resend the invite",
+		})
+		return false
+	}
+}
+
+// RunInviteMailer sends queued invite emails until ctx is cancelled, retrying
+// failures with exponential backoff
+func (s *UserService) RunInviteMailer(ctx context.Context) {
+	if s.mailer == nil {
+		return
+	}
+
+	// Deliveries queued before this process started lost their token with
+	// the old process; they can only be recovered by resending
+	if err := s.db.Model(&models.Invite{}).
+		Where("delivery_status = ? AND updated_at < ?", models.DeliveryQueued, s.mailerStartedAt).
+		Updates(map[string]interface{}{
+			"delivery_status": models.DeliveryFailed,
+			"delivery_error":  "delivery interrupted by a restart; resend the invite",
+		}).Error; err != nil {
+		log.Printf("failed to mark interrupted invite deliveries: %v", err)
+	}
+
+	log.Println("invite mailer started")
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case d := <-s.inviteMail:
+			s.deliverInvite(ctx, d)
+		}
+	}
+}
+
+func (s *UserService) deliverInvite(ctx context.Context, d inviteDelivery) {
+	var invite models.Invite
+	if err := s.db.First(&invite, "id = ?", d.inviteID).Error; err != nil {
+		return
+	}
+	// a resend replaced the token, or the invite was used or revoked
+	if invite.TokenHash != hashString(d.token) || invite.Status(time.Now()) != models.InvitePending {
+		return
+	}
+
+	msg, err := s.renderInviteEmail(&invite, d.token)
+	if err != nil {
+		log.Printf("failed to render invite email %s: %v", invite.ID, err)
+		s.setInviteDelivery(invite.ID, map[string]interface{}{
+			"delivery_status": models.DeliveryFailed,
+			"delivery_error":  "failed to render email",
+		})
+		return
+	}
+
+	d.attempt++
+	sendCtx, cancel := context.WithTimeout(ctx, inviteSendTimeout)
+	err = s.mailer.Send(sendCtx, msg)
+	cancel()
+	if err == nil {
+		s.setInviteDelivery(invite.ID, map[string]interface{}{
+			"delivery_status":   models.DeliverySent,
+			"delivery_attempts": d.attempt,
+			"delivery_error":    "",
+			"delivered_at":      time.Now(),
+		})
+		return
+	}
+
+	log.Printf("invite email %s attempt %d failed: %v", invite.ID, d.attempt, err)
+	if d.attempt >= maxInviteDeliveryAttempts {
+		s.setInviteDelivery(invite.ID, map[string]interface{}{
+			"delivery_status":   models.DeliveryFailed,
+			"delivery_attempts": d.attempt,
+			"delivery_error":    err.Error(),
+		})
+		return
+	}
+	s.setInviteDelivery(invite.ID, map[string]interface{}{
+		"delivery_attempts": d.attempt,
+		"delivery_error":    err.Error(),
+	})
+	backoff := inviteDeliveryBaseBackoff << (d.attempt - 1)
+	time.AfterFunc(backoff, func() { s.enqueueInviteDelivery(d) })
+}
+
+func (s *UserService) setInviteDelivery(inviteID string, updates map[string]interface{}) {
+	if err := s.db.Model(&models.Invite{}).Where("id = ?", inviteID).Updates(updates).Error; err != nil {
+		log.Printf("failed to record delivery state for invite %s: %v", inviteID, err)
+	}
+}
+
+func (s *UserService) renderInviteEmail(invite *models.Invite, token string) (mailer.Message, error) {
+	branding := s.orgBranding(invite.OrgID)
+	data := inviteEmailData{
+		OrgName:      branding.DisplayName,
+		LogoURL:      branding.LogoURL,
+		PrimaryColor: branding.PrimaryColor,
+		SupportEmail: branding.SupportEmail,
+		Role:         invite.Role,
+		Token:        token,
+		ExpiresAt:    invite.ExpiresAt.UTC().Format("Jan 2, 2006 15:04 MST"),
+	}
+	data.Subject = "You're invited to join " + data.OrgName + " on TaskFlow"
+	if s.inviteAcceptURL != "" {
+		if u, err := url.Parse(s.inviteAcceptURL); err == nil {
+			q := u.Query()
+			q.Set("token", token)
+			u.RawQuery = q.Encode()
+			data.AcceptURL = u.String()
+		}
+	}
+
+	var html, text bytes.Buffer
+	if err := inviteHTMLTemplate.Execute(&html, data); err != nil {
+		return mailer.Message{}, err
+	}
+	if err := inviteTextTemplate.Execute(&text, data); err != nil {
+		return mailer.Message{}, err
+	}
+	return mailer.Message{To: invite.Email, Subject: data.Subject, Text: text.String(), HTML: html.String()}, nil
+}
+
+// orgBranding returns the organization's email branding, falling back to
+// its name and the TaskFlow colours
+func (s *UserService) orgBranding(orgID string) orgBranding {
+	b := orgBranding{DisplayName: "TaskFlow", PrimaryColor: defaultBrandColor}
+
+	var org models.Organization
+	if err := s.db.Select("name", "settings").First(&org, "id = ?", orgID).Error; err != nil {
+		return b
+	}
+	if org.Name != "" {
+		b.DisplayName = org.Name
+	}
+	var settings struct {
+		Branding orgBranding `json:"branding"`
+	}
+	if len(org.Settings) == 0 || json.Unmarshal(org.Settings, &settings) != nil {
+		return b
+	}
+	if settings.Branding.DisplayName != "" {
+		b.DisplayName = settings.Branding.DisplayName
+	}
+	if u, err := url.Parse(settings.Branding.LogoURL); err == nil && u.Scheme == "https" {
+		b.LogoURL = settings.Branding.LogoURL
+	}
+	if brandColorPattern.MatchString(settings.Branding.PrimaryColor) {
+		b.PrimaryColor = settings.Branding.PrimaryColor
+	}
+	b.SupportEmail = settings.Branding.SupportEmail
+	return b
+}
@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
+	userpb "github.com/chanduchitikam/task-management-system/proto/user"
+	"github.com/chanduchitikam/task-management-system/services/user/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultInviteExpiry = 72 * time.Hour
+
+	inviteCleanupInterval = time.Hour
+	// expired and revoked invites are kept this long so admins can see
+	// what happened to them, then deleted
+	inviteRetention = 30 * 24 * time.Hour
+)
+
+func inviteExpiry(hours int32) time.Time {
+	if hours > 0 {
+		return time.Now().Add(time.Duration(hours) * time.Hour)
+	}
+	return time.Now().Add(defaultInviteExpiry)
+}
+
+// findManagedInvite loads an invite of orgID for a caller allowed to manage
+// the org's invites
+func (s *UserService) findManagedInvite(ctx context.Context, orgID, inviteID string) (*models.Invite, error) {
+	if orgID == "" || inviteID == "" {
+		return nil, status.Error(codes.InvalidArgument, "org_id and invite_id are required")
+	}
+	if !callerCan(ctx, authz.MemberInvite, orgID) {
+		return nil, status.Error(codes.PermissionDenied, "only organization admins may manage invites for this org")
+	}
+
+	var invite models.Invite
+	if err := s.db.First(&invite, "id = ? AND org_id = ?", inviteID, orgID).Error; err != nil {
+		return nil, status.Error(codes.NotFound, "invite not found")
+	}
+	return &invite, nil
+}
+
+// ResendInvite replaces a pending or expired invite's token, so any copy of
+// the old token stops working, extends its expiry and emails it again
+func (s *UserService) ResendInvite(ctx context.Context, req *userpb.ResendInviteRequest) (*userpb.ResendInviteResponse, error) {
+	invite, err := s.findManagedInvite(ctx, req.OrgId, req.InviteId)
+	if err != nil {
+		return nil, err
+	}
+	switch invite.Status(time.Now()) {
+	case models.InviteAccepted:
+		return nil, status.Error(codes.FailedPrecondition, "invite has already been accepted")
+	case models.InviteRevoked:
+		return nil, status.Error(codes.FailedPrecondition, "invite has been revoked")
+	}
+
+	if !authz.IsGuest(invite.Role) {
+		if err := s.validateInviteEmailDomain(invite.Email, invite.OrgID); err != nil {
+			return nil, err
+		}
+	}
+	var existing models.User
+	if err := s.db.Where("LOWER(email) = ?", strings.ToLower(invite.Email)).First(&existing).Error; err == nil {
+		if _, ok := s.orgRole(&existing, invite.OrgID); ok {
+			return nil, status.Error(codes.AlreadyExists, "user is already a member of this organization")
+		}
+	}
+
+	token, err := generateSecureToken(32)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to generate token")
+	}
+	invite.TokenHash = hashString(token)
+	invite.ExpiresAt = inviteExpiry(req.ExpiresHours)
+	invite.SendCount++
+	if err := s.db.Save(invite).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to update invite")
+	}
+	s.recordAudit(ctx, auditEvent{
+		OrgID:      invite.OrgID,
+		Action:     auditInviteResent,
+		TargetType: "invite",
+		TargetID:   invite.ID,
+		Metadata:   map[string]string{"email": invite.Email},
+	})
+
+	resp := &userpb.ResendInviteResponse{Invite: inviteToProto(invite), Message: "invite reissued; deliver token to user via secure channel"}
+	if s.returnInviteTokens {
+		resp.Token = token
+	} else if s.QueueInviteEmail(invite, token) {
+		resp.Message = "invite queued for email delivery"
+	}
+	return resp, nil
+}
+
+// RevokeInvite cancels a pending invite
+func (s *UserService) RevokeInvite(ctx context.Context, req *userpb.RevokeInviteRequest) (*userpb.RevokeInviteResponse, error) {
+	invite, err := s.findManagedInvite(ctx, req.OrgId, req.InviteId)
+	if err != nil {
+		return nil, err
+	}
+	switch invite.Status(time.Now()) {
+	case models.InviteAccepted:
+		return nil, status.Error(codes.FailedPrecondition, "invite has already been accepted")
+	case models.InviteRevoked:
+		return &userpb.RevokeInviteResponse{Message: "invite already revoked"}, nil
+	}
+
+	now := time.Now()
+	result := s.db.Model(&models.Invite{}).
+		Where("id = ? AND used_at IS NULL AND revoked_at IS NULL", invite.ID).
+		Updates(map[string]interface{}{"revoked_at": now, "revoked_by": getStringFromContext(ctx, "user_id")})
+	if result.Error != nil {
+		return nil, status.Error(codes.Internal, "failed to revoke invite")
+	}
+	if result.RowsAffected == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "invite is no longer pending")
+	}
+	s.recordAudit(ctx, auditEvent{
+		OrgID:      invite.OrgID,
+		Action:     auditInviteRevoked,
+		TargetType: "invite",
+		TargetID:   invite.ID,
+		Metadata:   map[string]string{"email": invite.Email},
+	})
+
+	return &userpb.RevokeInviteResponse{Message: fmt.Sprintf("invite for %s revoked", invite.Email)}, nil
+}
+
+// RunInviteCleanupWorker deletes invites that expired or were revoked more
+// than the retention period ago, until ctx is cancelled
+func (s *UserService) RunInviteCleanupWorker(ctx context.Context) {
+	ticker := time.NewTicker(inviteCleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		s.cleanupInvites()
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *UserService) cleanupInvites() {
+	cutoff := time.Now().Add(-inviteRetention)
+	result := s.db.Where("used_at IS NULL AND (expires_at < ? OR revoked_at < ?)", cutoff, cutoff).Delete(&models.Invite{})
+	if result.Error != nil {
+		log.Printf("failed to clean up invites: %v", result.Error)
+		return
+	}
+	if result.RowsAffected > 0 {
+		log.Printf("deleted %d expired or revoked invites", result.RowsAffected)
+	}
+}
+
+func inviteToProto(iv *models.Invite) *userpb.Invite {
+	pb := &userpb.Invite{
+		InviteId:  iv.ID,
+		Email:     iv.Email,
+		OrgId:     iv.OrgID,
+		Role:      iv.Role,
+		ExpiresAt: timestamppb.New(iv.ExpiresAt),
+		CreatedBy: iv.CreatedBy,
+		CreatedAt: timestamppb.New(iv.CreatedAt),
+		Status:    iv.Status(time.Now()),
+		SendCount: int32(iv.SendCount),
+
+		DeliveryStatus:   iv.DeliveryStatus,
+		DeliveryAttempts: int32(iv.DeliveryAttempts),
+		DeliveryError:    iv.DeliveryError,
+	}
+	if iv.UsedAt != nil {
+		pb.UsedAt = timestamppb.New(*iv.UsedAt)
+	}
+	if iv.RevokedAt != nil {
+		pb.RevokedAt = timestamppb.New(*iv.RevokedAt)
+	}
+	if iv.DeliveredAt != nil {
+		pb.DeliveredAt = timestamppb.New(*iv.DeliveredAt)
+	}
+	if iv.ScopeID != nil {
+		pb.ScopeType = iv.ScopeType
+		pb.ScopeId = *iv.ScopeID
+		pb.AccessDays = int32(iv.AccessDays)
+	}
+	return pb
+}
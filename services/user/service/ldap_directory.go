@@ -0,0 +1,142 @@
+package service
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/services/user/models"
+	"github.com/go-ldap/ldap/v3"
+)
+
+const (
+	defaultLDAPUserFilter = "(&(objectCategory=person)(objectClass=user)(!(userAccountControl:1.2.840.113556.1.4.803:=2)))"
+	defaultEmailAttribute = "mail"
+	defaultUsernameAttr   = "sAMAccountName"
+	defaultNameAttribute  = "displayName"
+
+	ldapDialTimeout = 10 * time.Second
+	ldapOpTimeout   = 30 * time.Second
+	ldapPageSize    = 500
+)
+
+// directoryUser is one user entry read from the directory
+type directoryUser struct {
+	DN       string
+	Email    string
+	Username string
+	FullName string
+}
+
+// directory is the subset of LDAP operations the sync and login paths need
+type directory interface {
+	SearchUsers(cfg *models.LDAPConfig) ([]directoryUser, error)
+	GroupMembers(groupDN string) ([]string, error)
+	Authenticate(dn, password string) error
+	Close() error
+}
+
+// dialDirectory connects and binds with the configured service account.
+// It is a variable so the sync can run against a fake directory.
+var dialDirectory = func(cfg *models.LDAPConfig) (directory, error) {
+	conn, err := ldap.DialURL(cfg.URL, ldap.DialWithDialer(&net.Dialer{Timeout: ldapDialTimeout}))
+	if err != nil {
+		return nil, fmt.Errorf("connect: %w", err)
+	}
+	conn.SetTimeout(ldapOpTimeout)
+
+	if cfg.StartTLS {
+		host := cfg.URL
+		if u, err := url.Parse(cfg.URL); err == nil {
+			host = u.Hostname()
+		}
+		if err := conn.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("start tls: %w", err)
+		}
+	}
+	if cfg.BindDN != "" {
+		if err := conn.Bind(cfg.BindDN, cfg.BindPassword); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("bind: %w", err)
+		}
+	}
+	return &ldapDirectory{conn: conn, bindDN: cfg.BindDN, bindPassword: cfg.BindPassword}, nil
+}
+
+type ldapDirectory struct {
+	conn         *ldap.Conn
+	bindDN       string
+	bindPassword string
+}
+
+func (d *ldapDirectory) SearchUsers(cfg *models.LDAPConfig) ([]directoryUser, error) {
+	emailAttr := valueOr(cfg.EmailAttribute, defaultEmailAttribute)
+	usernameAttr := valueOr(cfg.UsernameAttribute, defaultUsernameAttr)
+	nameAttr := valueOr(cfg.NameAttribute, defaultNameAttribute)
+
+	req := ldap.NewSearchRequest(
+		cfg.BaseDN, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 0, 0, false,
+		valueOr(cfg.UserFilter, defaultLDAPUserFilter),
+		[]string{emailAttr, usernameAttr, nameAttr},
+		nil,
+	)
+	res, err := d.conn.SearchWithPaging(req, ldapPageSize)
+	if err != nil {
+		return nil, fmt.Errorf("search users: %w", err)
+	}
+
+	users := make([]directoryUser, 0, len(res.Entries))
+	for _, e := range res.Entries {
+		users = append(users, directoryUser{
+			DN:       e.DN,
+			Email:    strings.ToLower(strings.TrimSpace(e.GetAttributeValue(emailAttr))),
+			Username: strings.TrimSpace(e.GetAttributeValue(usernameAttr)),
+			FullName: strings.TrimSpace(e.GetAttributeValue(nameAttr)),
+		})
+	}
+	return users, nil
+}
+
+func (d *ldapDirectory) GroupMembers(groupDN string) ([]string, error) {
+	req := ldap.NewSearchRequest(
+		groupDN, ldap.ScopeBaseObject, ldap.NeverDerefAliases, 0, 0, false,
+		"(objectClass=*)", []string{"member"}, nil,
+	)
+	res, err := d.conn.Search(req)
+	if err != nil {
+		return nil, fmt.Errorf("read group %s: %w", groupDN, err)
+	}
+	if len(res.Entries) == 0 {
+		return nil, fmt.Errorf("group %s not found", groupDN)
+	}
+	return res.Entries[0].GetAttributeValues("member"), nil
+}
+
+// Authenticate checks a user's password by binding as them, then restores
+// the service account binding so the connection stays usable
+func (d *ldapDirectory) Authenticate(dn, password string) error {
+	if password == "" {
+		// an empty password is an unauthenticated bind and always succeeds
+		return ldap.NewError(ldap.LDAPResultInvalidCredentials, fmt.Errorf("empty password"))
+	}
+	err := d.conn.Bind(dn, password)
+	if d.bindDN != "" {
+		d.conn.Bind(d.bindDN, d.bindPassword)
+	}
+	return err
+}
+
+func (d *ldapDirectory) Close() error {
+	return d.conn.Close()
+}
+
+func valueOr(v, fallback string) string {
+	if v == "" {
+		return fallback
+	}
+	return v
+}
@@ -0,0 +1,542 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/pkg/auth"
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
+	"github.com/chanduchitikam/task-management-system/pkg/cache"
+	organizationpb "github.com/chanduchitikam/task-management-system/proto/organization"
+	userpb "github.com/chanduchitikam/task-management-system/proto/user"
+	"github.com/chanduchitikam/task-management-system/services/user/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+const (
+	ldapSource              = "ldap"
+	ldapPollInterval        = time.Minute
+	defaultLDAPSyncInterval = 60
+	minLDAPSyncInterval     = 5
+	ldapTeamCallTimeout     = 5 * time.Second
+)
+
+const (
+	auditLDAPConfigUpdated = "ldap.config_updated"
+	auditLDAPSynced        = "ldap.synced"
+)
+
+var usernameCleanup = regexp.MustCompile(`[^a-z0-9._-]+`)
+
+// errDirectoryUnavailable means the password could not be checked at all, as
+// opposed to being wrong
+var errDirectoryUnavailable = errors.New("directory unavailable")
+
+// SetOrgClient enables features that call the organization service, such as
+// mapping directory groups to teams during LDAP sync
+func (s *UserService) SetOrgClient(c organizationpb.OrganizationServiceClient) {
+	s.orgClient = c
+}
+
+// GetLDAPConfig returns an organization's LDAP sync configuration
+func (s *UserService) GetLDAPConfig(ctx context.Context, req *userpb.GetLDAPConfigRequest) (*userpb.GetLDAPConfigResponse, error) {
+	if req.OrgId == "" {
+		return nil, status.Error(codes.InvalidArgument, "org_id is required")
+	}
+	if !callerCan(ctx, authz.OrgManage, req.OrgId) {
+		return nil, status.Error(codes.PermissionDenied, "access denied")
+	}
+
+	var cfg models.LDAPConfig
+	if err := s.db.Where("org_id = ?", req.OrgId).First(&cfg).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "ldap is not configured for this organization")
+		}
+		return nil, status.Error(codes.Internal, "failed to load ldap config")
+	}
+	return &userpb.GetLDAPConfigResponse{Config: ldapConfigToProto(&cfg)}, nil
+}
+
+// UpsertLDAPConfig creates or replaces an organization's LDAP configuration
+func (s *UserService) UpsertLDAPConfig(ctx context.Context, req *userpb.UpsertLDAPConfigRequest) (*userpb.UpsertLDAPConfigResponse, error) {
+	if req.OrgId == "" || req.Config == nil {
+		return nil, status.Error(codes.InvalidArgument, "org_id and config are required")
+	}
+	if !callerCan(ctx, authz.OrgManage, req.OrgId) {
+		return nil, status.Error(codes.PermissionDenied, "access denied")
+	}
+	in := req.Config
+	if !strings.HasPrefix(in.Url, "ldap://") && !strings.HasPrefix(in.Url, "ldaps://") {
+		return nil, status.Error(codes.InvalidArgument, "url must start with ldap:// or ldaps://")
+	}
+	if strings.TrimSpace(in.BaseDn) == "" {
+		return nil, status.Error(codes.InvalidArgument, "base_dn is required")
+	}
+	interval := int(in.SyncIntervalMinutes)
+	if interval == 0 {
+		interval = defaultLDAPSyncInterval
+	}
+	if interval < minLDAPSyncInterval {
+		return nil, status.Errorf(codes.InvalidArgument, "sync_interval_minutes must be at least %d", minLDAPSyncInterval)
+	}
+	groupTeams, err := json.Marshal(in.GroupTeams)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid group_teams")
+	}
+
+	var cfg models.LDAPConfig
+	err = s.db.Where("org_id = ?", req.OrgId).First(&cfg).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Error(codes.Internal, "failed to load ldap config")
+	}
+	cfg.OrgID = req.OrgId
+	cfg.Enabled = in.Enabled
+	cfg.URL = in.Url
+	cfg.StartTLS = in.StartTls
+	cfg.BindDN = in.BindDn
+	if in.BindPassword != "" {
+		sealed, err := s.secrets.Seal(in.BindPassword, ldapBindPasswordColumn.Binding(req.OrgId))
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to encrypt bind password")
+		}
+		cfg.BindPassword = sealed
+	}
+	cfg.BaseDN = in.BaseDn
+	cfg.UserFilter = in.UserFilter
+	cfg.EmailAttribute = in.EmailAttribute
+	cfg.UsernameAttribute = in.UsernameAttribute
+	cfg.NameAttribute = in.NameAttribute
+	cfg.GroupTeams = string(groupTeams)
+	cfg.SyncIntervalMinutes = interval
+
+	if err := s.db.Save(&cfg).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to save ldap config")
+	}
+	s.recordAudit(ctx, auditEvent{
+		OrgID:      req.OrgId,
+		Action:     auditLDAPConfigUpdated,
+		TargetType: "organization",
+		TargetID:   req.OrgId,
+		Metadata:   map[string]string{"enabled": fmt.Sprint(cfg.Enabled), "url": cfg.URL},
+	})
+
+	return &userpb.UpsertLDAPConfigResponse{
+		Config:  ldapConfigToProto(&cfg),
+		Message: "LDAP configuration saved",
+	}, nil
+}
+
+// SyncLDAP runs a directory sync for an organization immediately
+func (s *UserService) SyncLDAP(ctx context.Context, req *userpb.SyncLDAPRequest) (*userpb.SyncLDAPResponse, error) {
+	if req.OrgId == "" {
+		return nil, status.Error(codes.InvalidArgument, "org_id is required")
+	}
+	if !callerCan(ctx, authz.OrgManage, req.OrgId) {
+		return nil, status.Error(codes.PermissionDenied, "access denied")
+	}
+
+	var cfg models.LDAPConfig
+	if err := s.db.Where("org_id = ?", req.OrgId).First(&cfg).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "ldap is not configured for this organization")
+		}
+		return nil, status.Error(codes.Internal, "failed to load ldap config")
+	}
+
+	stats, err := s.runLDAPSync(ctx, &cfg)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "ldap sync failed: %v", err)
+	}
+	return &userpb.SyncLDAPResponse{Stats: stats, Message: "LDAP sync completed"}, nil
+}
+
+// RunLDAPSyncWorker syncs each enabled organization on its configured
+// interval until ctx is cancelled
+func (s *UserService) RunLDAPSyncWorker(ctx context.Context) {
+	ticker := time.NewTicker(ldapPollInterval)
+	defer ticker.Stop()
+
+	log.Println("ldap sync worker started")
+	for {
+		s.syncDueLDAPConfigs(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *UserService) syncDueLDAPConfigs(ctx context.Context) {
+	var configs []models.LDAPConfig
+	if err := s.db.Where("enabled = ?", true).Find(&configs).Error; err != nil {
+		log.Printf("failed to load ldap configs: %v", err)
+		return
+	}
+
+	now := time.Now()
+	for i := range configs {
+		cfg := &configs[i]
+		if cfg.LastSyncAt != nil && now.Before(cfg.LastSyncAt.Add(time.Duration(cfg.SyncIntervalMinutes)*time.Minute)) {
+			continue
+		}
+		// Claim the run by moving last_sync_at forward from the value we read,
+		// so only one replica syncs an org per interval
+		claim := s.db.Model(&models.LDAPConfig{}).Where("id = ?", cfg.ID)
+		if cfg.LastSyncAt == nil {
+			claim = claim.Where("last_sync_at IS NULL")
+		} else {
+			claim = claim.Where("last_sync_at = ?", *cfg.LastSyncAt)
+		}
+		result := claim.UpdateColumn("last_sync_at", now)
+		if result.Error != nil || result.RowsAffected == 0 {
+			continue
+		}
+		if _, err := s.runLDAPSync(ctx, cfg); err != nil {
+			log.Printf("ldap sync for org %s failed: %v", cfg.OrgID, err)
+		}
+	}
+}
+
+// runLDAPSync syncs one org and records the outcome on its config
+func (s *UserService) runLDAPSync(ctx context.Context, cfg *models.LDAPConfig) (*userpb.LDAPSyncStats, error) {
+	stats, err := s.syncLDAP(ctx, cfg)
+
+	updates := map[string]interface{}{"last_sync_at": time.Now(), "last_sync_error": ""}
+	if err != nil {
+		updates["last_sync_error"] = err.Error()
+	}
+	if stats != nil {
+		if b, mErr := protojson.Marshal(stats); mErr == nil {
+			updates["last_sync_stats"] = string(b)
+		}
+	}
+	if dbErr := s.db.Model(&models.LDAPConfig{}).Where("id = ?", cfg.ID).UpdateColumns(updates).Error; dbErr != nil {
+		log.Printf("failed to record ldap sync result for org %s: %v", cfg.OrgID, dbErr)
+	}
+
+	if stats != nil {
+		meta := map[string]string{
+			"created":     fmt.Sprint(stats.Created),
+			"updated":     fmt.Sprint(stats.Updated),
+			"deactivated": fmt.Sprint(stats.Deactivated),
+			"reactivated": fmt.Sprint(stats.Reactivated),
+		}
+		if err != nil {
+			meta["error"] = err.Error()
+		}
+		s.recordAudit(ctx, auditEvent{
+			OrgID:      cfg.OrgID,
+			ActorID:    "system",
+			Action:     auditLDAPSynced,
+			TargetType: "organization",
+			TargetID:   cfg.OrgID,
+			Metadata:   meta,
+		})
+	}
+	return stats, err
+}
+
+// syncLDAP imports users from the directory, deactivates directory users that
+// disappeared and reconciles mapped group memberships. A nil stats result
+// means nothing was changed.
+func (s *UserService) syncLDAP(ctx context.Context, cfg *models.LDAPConfig) (*userpb.LDAPSyncStats, error) {
+	dialCfg, err := s.directoryConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	dir, err := dialDirectory(dialCfg)
+	if err != nil {
+		return nil, err
+	}
+	defer dir.Close()
+
+	entries, err := dir.SearchUsers(cfg)
+	if err != nil {
+		return nil, err
+	}
+	// An empty result is far more likely a bad filter or base DN than an
+	// emptied directory; don't deactivate the whole org over it
+	if len(entries) == 0 {
+		return nil, errors.New("directory returned no users")
+	}
+
+	stats := &userpb.LDAPSyncStats{}
+	byDN := make(map[string]string, len(entries))
+	for _, e := range entries {
+		userID, err := s.upsertDirectoryUser(cfg.OrgID, e, stats)
+		if err != nil {
+			return stats, err
+		}
+		if userID != "" {
+			byDN[strings.ToLower(e.DN)] = userID
+		}
+	}
+
+	seen := make(map[string]bool, len(byDN))
+	for _, id := range byDN {
+		seen[id] = true
+	}
+	var managed []models.User
+	if err := s.db.Where("org_id = ? AND external_source = ?", cfg.OrgID, ldapSource).Find(&managed).Error; err != nil {
+		return stats, fmt.Errorf("load directory users: %w", err)
+	}
+	managedIDs := make(map[string]bool, len(managed))
+	for i := range managed {
+		u := &managed[i]
+		managedIDs[u.ID] = true
+		if seen[u.ID] || !u.IsActive {
+			continue
+		}
+		now := time.Now()
+		if err := s.db.Model(u).Updates(map[string]interface{}{"is_active": false, "deactivated_at": now}).Error; err != nil {
+			return stats, fmt.Errorf("deactivate %s: %w", u.Email, err)
+		}
+		stats.Deactivated++
+		s.publishMembershipEvent(ctx, cache.MembershipRemoved, cfg.OrgID, u.ID)
+	}
+
+	return stats, s.syncLDAPTeams(ctx, cfg, dir, byDN, managedIDs, stats)
+}
+
+// upsertDirectoryUser creates or refreshes the user for a directory entry and
+// returns its ID, or "" when the entry is skipped
+func (s *UserService) upsertDirectoryUser(orgID string, e directoryUser, stats *userpb.LDAPSyncStats) (string, error) {
+	if e.Email == "" {
+		stats.Skipped++
+		return "", nil
+	}
+
+	var user models.User
+	err := s.db.Where("org_id = ? AND external_source = ? AND LOWER(external_id) = ?", orgID, ldapSource, strings.ToLower(e.DN)).First(&user).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		// Fall back to email so existing accounts are adopted, and users
+		// moved to another OU keep their account
+		err = s.db.Where("LOWER(email) = ?", e.Email).First(&user).Error
+	}
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return "", fmt.Errorf("find %s: %w", e.Email, err)
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		username, err := s.uniqueUsername(e)
+		if err != nil {
+			return "", err
+		}
+		// Directory users authenticate against LDAP; the local password is
+		// random and never used
+		secret, err := generateSecureToken(32)
+		if err != nil {
+			return "", err
+		}
+		hashed, err := auth.HashPassword(secret)
+		if err != nil {
+			return "", err
+		}
+		user = models.User{
+			Email:          e.Email,
+			Username:       username,
+			Password:       hashed,
+			FullName:       e.FullName,
+			Role:           "member",
+			OrgID:          &orgID,
+			IsActive:       true,
+			HasLoggedIn:    true,
+			ExternalSource: ldapSource,
+			ExternalID:     e.DN,
+		}
+		if err := s.db.Create(&user).Error; err != nil {
+			return "", fmt.Errorf("create %s: %w", e.Email, err)
+		}
+		stats.Created++
+		return user.ID, nil
+	}
+
+	if user.OrgID == nil || *user.OrgID != orgID {
+		// the email belongs to an account in another org
+		stats.Skipped++
+		return "", nil
+	}
+
+	updates := map[string]interface{}{}
+	if user.ExternalSource != ldapSource || user.ExternalID != e.DN {
+		updates["external_source"] = ldapSource
+		updates["external_id"] = e.DN
+	}
+	if user.Email != e.Email {
+		updates["email"] = e.Email
+	}
+	if e.FullName != "" && user.FullName != e.FullName {
+		updates["full_name"] = e.FullName
+	}
+	if !user.IsActive {
+		updates["is_active"] = true
+		updates["deactivated_at"] = nil
+		stats.Reactivated++
+	}
+	if len(updates) == 0 {
+		return user.ID, nil
+	}
+	if err := s.db.Model(&user).Updates(updates).Error; err != nil {
+		return "", fmt.Errorf("update %s: %w", e.Email, err)
+	}
+	if _, reactivated := updates["is_active"]; !reactivated {
+		stats.Updated++
+	}
+	return user.ID, nil
+}
+
+// uniqueUsername derives a free username from the directory entry
+func (s *UserService) uniqueUsername(e directoryUser) (string, error) {
+	base := usernameCleanup.ReplaceAllString(strings.ToLower(e.Username), "")
+	if base == "" {
+		base = usernameCleanup.ReplaceAllString(strings.Split(e.Email, "@")[0], "")
+	}
+	if base == "" {
+		base = "user"
+	}
+
+	candidate := base
+	for i := 2; i < 100; i++ {
+		var count int64
+		if err := s.db.Model(&models.User{}).Where("username = ?", candidate).Count(&count).Error; err != nil {
+			return "", err
+		}
+		if count == 0 {
+			return candidate, nil
+		}
+		candidate = fmt.Sprintf("%s%d", base, i)
+	}
+	return "", fmt.Errorf("no free username for %s", e.Email)
+}
+
+// syncLDAPTeams makes each mapped team's directory-managed members match the
+// group. Members added to a team by hand (non-directory users) are left alone.
+func (s *UserService) syncLDAPTeams(ctx context.Context, cfg *models.LDAPConfig, dir directory, byDN map[string]string, managed map[string]bool, stats *userpb.LDAPSyncStats) error {
+	groupTeams := map[string]string{}
+	if cfg.GroupTeams != "" {
+		if err := json.Unmarshal([]byte(cfg.GroupTeams), &groupTeams); err != nil {
+			return fmt.Errorf("invalid group_teams: %w", err)
+		}
+	}
+	if len(groupTeams) == 0 {
+		return nil
+	}
+	if s.orgClient == nil {
+		return errors.New("group to team mapping requires the organization service")
+	}
+
+	var errs []error
+	for groupDN, teamID := range groupTeams {
+		memberDNs, err := dir.GroupMembers(groupDN)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		want := map[string]bool{}
+		for _, dn := range memberDNs {
+			if id, ok := byDN[strings.ToLower(dn)]; ok {
+				want[id] = true
+			}
+		}
+
+		callCtx, cancel := context.WithTimeout(ctx, ldapTeamCallTimeout)
+		current, err := s.orgClient.ListTeamMembers(callCtx, &organizationpb.ListTeamMembersRequest{TeamId: teamID})
+		cancel()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("list team %s: %w", teamID, err))
+			continue
+		}
+		have := map[string]bool{}
+		for _, m := range current.Members {
+			have[m.UserId] = true
+			if want[m.UserId] || !managed[m.UserId] {
+				continue
+			}
+			callCtx, cancel := context.WithTimeout(ctx, ldapTeamCallTimeout)
+			_, err := s.orgClient.RemoveTeamMember(callCtx, &organizationpb.RemoveTeamMemberRequest{TeamId: teamID, UserId: m.UserId})
+			cancel()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("remove %s from team %s: %w", m.UserId, teamID, err))
+				continue
+			}
+			stats.TeamMembersRemoved++
+		}
+		for id := range want {
+			if have[id] {
+				continue
+			}
+			callCtx, cancel := context.WithTimeout(ctx, ldapTeamCallTimeout)
+			_, err := s.orgClient.AddTeamMember(callCtx, &organizationpb.AddTeamMemberRequest{TeamId: teamID, UserId: id})
+			cancel()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("add %s to team %s: %w", id, teamID, err))
+				continue
+			}
+			stats.TeamMembersAdded++
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ldapAuthenticate verifies a directory user's password against their org's
+// directory
+func (s *UserService) ldapAuthenticate(user *models.User, password string) error {
+	var cfg models.LDAPConfig
+	if err := s.db.Where("org_id = ?", getStringValue(user.OrgID)).First(&cfg).Error; err != nil {
+		return fmt.Errorf("%w: %v", errDirectoryUnavailable, err)
+	}
+	dialCfg, err := s.directoryConfig(&cfg)
+	if err != nil {
+		log.Printf("ldap login for %s: %v", user.Email, err)
+		return fmt.Errorf("%w: %v", errDirectoryUnavailable, err)
+	}
+	dir, err := dialDirectory(dialCfg)
+	if err != nil {
+		log.Printf("ldap login for %s: %v", user.Email, err)
+		return fmt.Errorf("%w: %v", errDirectoryUnavailable, err)
+	}
+	defer dir.Close()
+	return dir.Authenticate(user.ExternalID, password)
+}
+
+func ldapConfigToProto(c *models.LDAPConfig) *userpb.LDAPConfig {
+	pb := &userpb.LDAPConfig{
+		OrgId:               c.OrgID,
+		Enabled:             c.Enabled,
+		Url:                 c.URL,
+		StartTls:            c.StartTLS,
+		BindDn:              c.BindDN,
+		HasBindPassword:     c.BindPassword != "",
+		BaseDn:              c.BaseDN,
+		UserFilter:          c.UserFilter,
+		EmailAttribute:      c.EmailAttribute,
+		UsernameAttribute:   c.UsernameAttribute,
+		NameAttribute:       c.NameAttribute,
+		SyncIntervalMinutes: int32(c.SyncIntervalMinutes),
+		LastSyncError:       c.LastSyncError,
+	}
+	if c.GroupTeams != "" {
+		_ = json.Unmarshal([]byte(c.GroupTeams), &pb.GroupTeams)
+	}
+	if c.LastSyncAt != nil {
+		pb.LastSyncAt = timestamppb.New(*c.LastSyncAt)
+	}
+	if c.LastSyncStats != "" {
+		pb.LastSyncStats = &userpb.LDAPSyncStats{}
+		if err := protojson.Unmarshal([]byte(c.LastSyncStats), pb.LastSyncStats); err != nil {
+			pb.LastSyncStats = nil
+		}
+	}
+	return pb
+}
@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
+	userpb "github.com/chanduchitikam/task-management-system/proto/user"
+	"github.com/chanduchitikam/task-management-system/services/user/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultLoginHistoryPageSize = 50
+	maxLoginHistoryPageSize     = 200
+
+	loginHistoryRetention       = 90 * 24 * time.Hour
+	loginHistoryCleanupInterval = 24 * time.Hour
+
+	maxUserAgentLength = 512
+)
+
+// clientUserAgent returns the browser's user agent as forwarded by the
+// gateway, falling back to the gRPC client's own
+func clientUserAgent(ctx context.Context) string {
+	ua := getStringFromContext(ctx, "x-client-user-agent")
+	if ua == "" {
+		ua = getStringFromContext(ctx, "grpcgateway-user-agent")
+	}
+	if ua == "" {
+		ua = getStringFromContext(ctx, "user-agent")
+	}
+	if len(ua) > maxUserAgentLength {
+		ua = ua[:maxUserAgentLength]
+	}
+	return ua
+}
+
+// recordLogin stores a login attempt; an empty reason means it succeeded.
+// user is nil when the email matched no account. Failures are logged and
+// never affect the login. It reports whether a successful login came from
+// a device and network the user had not signed in from before.
+func (s *UserService) recordLogin(ctx context.Context, email string, user *models.User, reason string) bool {
+	event := &models.LoginEvent{
+		Email:         email,
+		Success:       reason == "",
+		FailureReason: reason,
+		IPAddress:     loginClientIP(ctx),
+		UserAgent:     clientUserAgent(ctx),
+		DeviceID:      deviceFingerprint(ctx),
+	}
+	if user != nil {
+		event.UserID = &user.ID
+		event.OrgID = user.OrgID
+		if event.Success {
+			event.NewDevice = s.isNewDevice(user.ID, event.DeviceID, event.IPAddress)
+		}
+	}
+	if err := s.db.Create(event).Error; err != nil {
+		log.Printf("warning: failed to record login event for %s: %v", email, err)
+	}
+	return event.NewDevice
+}
+
+// GetLoginHistory returns a user's login attempts, newest first. Users see
+// their own; org admins see their members'.
+func (s *UserService) GetLoginHistory(ctx context.Context, req *userpb.GetLoginHistoryRequest) (*userpb.GetLoginHistoryResponse, error) {
+	callerID := getStringFromContext(ctx, "user_id")
+	if callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	userID := req.UserId
+	if userID == "" || userID == "me" {
+		userID = callerID
+	}
+
+	if userID != callerID {
+		var target models.User
+		if err := s.db.Select("id", "org_id").First(&target, "id = ?", userID).Error; err != nil {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		role := getStringFromContext(ctx, "role")
+		if !authz.IsPlatformAdmin(role) && (target.OrgID == nil || !callerCan(ctx, authz.MemberManage, *target.OrgID)) {
+			return nil, status.Error(codes.PermissionDenied, "access denied")
+		}
+	}
+
+	page := req.Page
+	if page < 1 {
+		page = 1
+	}
+	pageSize := req.PageSize
+	if pageSize < 1 {
+		pageSize = defaultLoginHistoryPageSize
+	}
+	if pageSize > maxLoginHistoryPageSize {
+		pageSize = maxLoginHistoryPageSize
+	}
+
+	query := s.db.Model(&models.LoginEvent{}).Where("user_id = ?", userID)
+	if req.FailuresOnly {
+		query = query.Where("success = ?", false)
+	}
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to count login history")
+	}
+	var events []models.LoginEvent
+	if err := query.Order("created_at DESC").Offset(int((page - 1) * pageSize)).Limit(int(pageSize)).Find(&events).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to load login history")
+	}
+
+	resp := &userpb.GetLoginHistoryResponse{
+		Events:     make([]*userpb.LoginEvent, len(events)),
+		TotalCount: int32(total),
+		Page:       page,
+		PageSize:   pageSize,
+	}
+	for i := range events {
+		resp.Events[i] = loginEventToProto(&events[i])
+	}
+	return resp, nil
+}
+
+// RunLoginHistoryCleanupWorker deletes login events past the retention
+// period until ctx is cancelled
+func (s *UserService) RunLoginHistoryCleanupWorker(ctx context.Context) {
+	ticker := time.NewTicker(loginHistoryCleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		result := s.db.Where("created_at < ?", time.Now().Add(-loginHistoryRetention)).Delete(&models.LoginEvent{})
+		if result.Error != nil {
+			log.Printf("failed to clean up login history: %v", result.Error)
+		} else if result.RowsAffected > 0 {
+			log.Printf("deleted %d login events past retention", result.RowsAffected)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func loginEventToProto(e *models.LoginEvent) *userpb.LoginEvent {
+	return &userpb.LoginEvent{
+		Id:            e.ID,
+		UserId:        getStringValue(e.UserID),
+		Email:         e.Email,
+		Success:       e.Success,
+		FailureReason: e.FailureReason,
+		IpAddress:     e.IPAddress,
+		UserAgent:     e.UserAgent,
+		CreatedAt:     timestamppb.New(e.CreatedAt),
+		DeviceId:      e.DeviceID,
+		NewDevice:     e.NewDevice,
+	}
+}
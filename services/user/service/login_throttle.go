@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"log"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/pkg/cache"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Login throttling complements the per-account lockout: it also counts
+// attempts against unknown emails and spreads across accounts from one IP.
+// Failures are counted in Redis sliding windows; without Redis it is off.
+const (
+	loginThrottleWindow = 15 * time.Minute
+
+	maxEmailLoginFailures = 20
+	maxIPLoginFailures    = 50
+
+	// clients should show a CAPTCHA past these, and each further failure for
+	// the email doubles the wait before the next attempt is accepted
+	captchaAfterEmailFailures = 3
+	captchaAfterIPFailures    = 10
+	maxLoginBackoff           = time.Minute
+
+	errorDomain = "taskflow"
+)
+
+func loginEmailKey(email string) string { return "login:failures:email:" + email }
+func loginIPKey(ip string) string       { return "login:failures:ip:" + ip }
+
+// loginSignals is what the client is told about throttling
+type loginSignals struct {
+	retryAfter      time.Duration
+	captchaRequired bool
+}
+
+// loginClientIP is the caller's IP without the port
+func loginClientIP(ctx context.Context) string {
+	ip := clientIP(ctx)
+	if host, _, err := net.SplitHostPort(ip); err == nil {
+		return host
+	}
+	return ip
+}
+
+// loginWindows reads the failure windows for email and ip. ok is false when
+// throttling is unavailable.
+func (s *UserService) loginWindows(ctx context.Context, email, ip string, now time.Time) (byEmail, byIP cache.SlidingWindow, ok bool) {
+	if s.cache == nil {
+		return byEmail, byIP, false
+	}
+	var err error
+	if byEmail, err = s.cache.SlidingWindowGet(ctx, loginEmailKey(email), loginThrottleWindow, now); err != nil {
+		log.Printf("warning: login throttle unavailable: %v", err)
+		return byEmail, byIP, false
+	}
+	if ip != "" {
+		if byIP, err = s.cache.SlidingWindowGet(ctx, loginIPKey(ip), loginThrottleWindow, now); err != nil {
+			log.Printf("warning: login throttle unavailable: %v", err)
+			return byEmail, byIP, false
+		}
+	}
+	return byEmail, byIP, true
+}
+
+// loginThrottleSignals works out how long the next attempt must wait
+func loginThrottleSignals(byEmail, byIP cache.SlidingWindow, now time.Time) loginSignals {
+	sig := loginSignals{
+		captchaRequired: byEmail.Count >= captchaAfterEmailFailures || byIP.Count >= captchaAfterIPFailures,
+	}
+	wait := func(d time.Duration) {
+		if d > sig.retryAfter {
+			sig.retryAfter = d
+		}
+	}
+	if byEmail.Count >= maxEmailLoginFailures {
+		wait(byEmail.Oldest.Add(loginThrottleWindow).Sub(now))
+	}
+	if byIP.Count >= maxIPLoginFailures {
+		wait(byIP.Oldest.Add(loginThrottleWindow).Sub(now))
+	}
+	if extra := byEmail.Count - captchaAfterEmailFailures; extra > 0 {
+		backoff := maxLoginBackoff
+		if extra < 7 {
+			backoff = time.Second << (extra - 1)
+		}
+		if backoff > maxLoginBackoff {
+			backoff = maxLoginBackoff
+		}
+		wait(byEmail.Newest.Add(backoff).Sub(now))
+	}
+	return sig
+}
+
+// checkLoginThrottle refuses an attempt that arrives before its backoff has
+// elapsed
+func (s *UserService) checkLoginThrottle(ctx context.Context, email, ip string) error {
+	now := time.Now()
+	byEmail, byIP, ok := s.loginWindows(ctx, email, ip, now)
+	if !ok {
+		return nil
+	}
+	sig := loginThrottleSignals(byEmail, byIP, now)
+	if sig.retryAfter <= 0 {
+		return nil
+	}
+	return loginError(codes.ResourceExhausted, "too many failed login attempts; try again later", "LOGIN_THROTTLED", sig)
+}
+
+// loginFailed counts a failed attempt and returns err annotated with the
+// throttling the next attempt will face
+func (s *UserService) loginFailed(ctx context.Context, email, ip string, code codes.Code, msg string) error {
+	if s.cache == nil {
+		return status.Error(code, msg)
+	}
+	now := time.Now()
+	if err := s.cache.SlidingWindowRecord(ctx, loginEmailKey(email), loginThrottleWindow, now); err != nil {
+		log.Printf("warning: failed to record failed login: %v", err)
+		return status.Error(code, msg)
+	}
+	if ip != "" {
+		if err := s.cache.SlidingWindowRecord(ctx, loginIPKey(ip), loginThrottleWindow, now); err != nil {
+			log.Printf("warning: failed to record failed login: %v", err)
+		}
+	}
+
+	byEmail, byIP, ok := s.loginWindows(ctx, email, ip, now)
+	if !ok {
+		return status.Error(code, msg)
+	}
+	sig := loginThrottleSignals(byEmail, byIP, now)
+	if sig.retryAfter <= 0 && !sig.captchaRequired {
+		return status.Error(code, msg)
+	}
+	return loginError(code, msg, "INVALID_CREDENTIALS", sig)
+}
+
+// resetLoginThrottle forgets an email's failures after a successful login.
+// The IP window is left alone so one good login cannot hide spraying.
+func (s *UserService) resetLoginThrottle(ctx context.Context, email string) {
+	if s.cache == nil {
+		return
+	}
+	if err := s.cache.SlidingWindowReset(ctx, loginEmailKey(email)); err != nil {
+		log.Printf("warning: failed to reset login throttle: %v", err)
+	}
+}
+
+// loginError attaches the throttling signals as error details: RetryInfo for
+// the wait, ErrorInfo metadata for clients that key off strings
+func loginError(code codes.Code, msg, reason string, sig loginSignals) error {
+	st := status.New(code, msg)
+	info := &errdetails.ErrorInfo{
+		Reason: reason,
+		Domain: errorDomain,
+		Metadata: map[string]string{
+			"captcha_required": strconv.FormatBool(sig.captchaRequired),
+		},
+	}
+	if sig.retryAfter > 0 {
+		retryAfter := sig.retryAfter.Round(time.Second)
+		if retryAfter < time.Second {
+			retryAfter = time.Second
+		}
+		info.Metadata["retry_after_seconds"] = strconv.Itoa(int(retryAfter / time.Second))
+		if withDetails, err := st.WithDetails(info, &errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)}); err == nil {
+			return withDetails.Err()
+		}
+		return st.Err()
+	}
+	if withDetails, err := st.WithDetails(info); err == nil {
+		return withDetails.Err()
+	}
+	return st.Err()
+}
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/pkg/cache"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoginThrottleSignals(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	window := func(count int64, oldest, newest time.Duration) cache.SlidingWindow {
+		return cache.SlidingWindow{Count: count, Oldest: now.Add(-oldest), Newest: now.Add(-newest)}
+	}
+
+	tests := []struct {
+		name    string
+		byEmail cache.SlidingWindow
+		byIP    cache.SlidingWindow
+		want    loginSignals
+	}{
+		{"no failures", cache.SlidingWindow{}, cache.SlidingWindow{}, loginSignals{}},
+		{"below the captcha threshold", window(2, time.Minute, 0), window(9, time.Minute, 0), loginSignals{}},
+		{"email captcha threshold", window(3, time.Minute, 0), cache.SlidingWindow{}, loginSignals{captchaRequired: true}},
+		{"ip captcha threshold", cache.SlidingWindow{}, window(10, time.Minute, 0), loginSignals{captchaRequired: true}},
+		{"first backoff", window(4, time.Minute, 0), cache.SlidingWindow{}, loginSignals{retryAfter: time.Second, captchaRequired: true}},
+		{"backoff doubles", window(5, time.Minute, 0), cache.SlidingWindow{}, loginSignals{retryAfter: 2 * time.Second, captchaRequired: true}},
+		{"backoff counts from the newest failure", window(6, time.Minute, time.Second), cache.SlidingWindow{}, loginSignals{retryAfter: 3 * time.Second, captchaRequired: true}},
+		{"elapsed backoff", window(4, time.Minute, 5*time.Second), cache.SlidingWindow{}, loginSignals{captchaRequired: true}},
+		{"backoff before the cap", window(9, time.Minute, 0), cache.SlidingWindow{}, loginSignals{retryAfter: 32 * time.Second, captchaRequired: true}},
+		{"backoff is capped", window(10, time.Minute, 0), cache.SlidingWindow{}, loginSignals{retryAfter: maxLoginBackoff, captchaRequired: true}},
+		{"email limit waits for the window", window(20, 10*time.Minute, 0), cache.SlidingWindow{}, loginSignals{retryAfter: 5 * time.Minute, captchaRequired: true}},
+		{"ip limit waits for the window", cache.SlidingWindow{}, window(50, 14*time.Minute, 0), loginSignals{retryAfter: time.Minute, captchaRequired: true}},
+		{"longest wait wins", window(4, time.Minute, 0), window(50, 5*time.Minute, 0), loginSignals{retryAfter: 10 * time.Minute, captchaRequired: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, loginThrottleSignals(tt.byEmail, tt.byIP, now))
+		})
+	}
+}
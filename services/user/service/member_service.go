@@ -8,6 +8,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -79,30 +80,25 @@ func (s *UserService) generateOneTimePassword() (string, error) {
 	return string(password), nil
 }
 
-// ValidateOrgEmailDomain ensures email matches organization domain
+// ValidateOrgEmailDomain ensures email matches the organization's domain or
+// one of its allowed invite domains
 func (s *UserService) validateOrgEmailDomain(email, orgID string) error {
-	// Get organization
-	var org models.Organization
-	if err := s.db.First(&org, "id = ?", orgID).Error; err != nil {
-		return status.Error(codes.NotFound, "organization not found")
+	primary, allowed, err := s.inviteDomainPolicy(orgID)
+	if err != nil {
+		return err
 	}
 
-	if org.Domain == "" {
+	if primary == "" && len(allowed) == 0 {
 		// If org doesn't have a domain set, allow any email
 		return nil
 	}
 
-	// Extract domain from email
-	parts := strings.Split(email, "@")
-	if len(parts) != 2 {
-		return status.Error(codes.InvalidArgument, "invalid email format")
+	domain, err := emailDomain(email)
+	if err != nil {
+		return err
 	}
-	emailDomain := strings.ToLower(parts[1])
-	orgDomain := strings.ToLower(org.Domain)
-
-	if emailDomain != orgDomain {
-		return status.Errorf(codes.InvalidArgument,
-			"email domain must be @%s for this organization", orgDomain)
+	if !domainAllowed(domain, primary, allowed) {
+		return domainPolicyError(primary, allowed)
 	}
 
 	return nil
@@ -120,6 +116,16 @@ func (s *UserService) CreateOrganizationMember(ctx context.Context, req *userpb.
 	if req.Email == "" {
 		return nil, status.Error(codes.InvalidArgument, "email is required")
 	}
+	if !callerCan(ctx, authz.MemberManage, req.OrgId) {
+		return nil, status.Error(codes.PermissionDenied, "access denied")
+	}
+	role := req.Role
+	if role == "" {
+		role = authz.RoleMember
+	}
+	if !authz.Assignable(role) || !authz.CanGrant(getStringFromContext(ctx, "role"), role) {
+		return nil, status.Errorf(codes.PermissionDenied, "not allowed to grant role %q", role)
+	}
 
 	// Validate email domain matches organization
 	if err := s.validateOrgEmailDomain(req.Email, req.OrgId); err != nil {
@@ -150,12 +156,6 @@ func (s *UserService) CreateOrganizationMember(ctx context.Context, req *userpb.
 		return nil, status.Error(codes.Internal, "failed to hash password")
 	}
 
-	// Set default role
-	role := req.Role
-	if role == "" {
-		role = "member"
-	}
-
 	// Create user
 	fullName := fmt.Sprintf("%s %s", req.FirstName, req.LastName)
 	user := models.User{
@@ -171,6 +171,13 @@ func (s *UserService) CreateOrganizationMember(ctx context.Context, req *userpb.
 	if err := s.db.Create(&user).Error; err != nil {
 		return nil, status.Error(codes.Internal, "failed to create user")
 	}
+	s.recordAudit(ctx, auditEvent{
+		OrgID:      req.OrgId,
+		Action:     auditMemberCreated,
+		TargetType: "user",
+		TargetID:   user.ID,
+		Metadata:   map[string]string{"email": user.Email, "role": user.Role},
+	})
 
 	// Convert to proto
 	member := &userpb.OrganizationMember{
@@ -218,6 +225,41 @@ func (s *UserService) GetOrganization(ctx context.Context, req *userpb.GetOrgani
 }
 
 // Helper to safely get string value from pointer
+// maxResolveUsernames bounds a single ResolveUsernames lookup
+const maxResolveUsernames = 50
+
+// ResolveUsernames maps usernames to users in an org. It has no HTTP route and
+// is only called by other services, e.g. to resolve @mentions.
+func (s *UserService) ResolveUsernames(ctx context.Context, req *userpb.ResolveUsernamesRequest) (*userpb.ResolveUsernamesResponse, error) {
+	if req.OrgId == "" {
+		return nil, status.Error(codes.InvalidArgument, "org_id is required")
+	}
+	if len(req.Usernames) > maxResolveUsernames {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d usernames per request", maxResolveUsernames)
+	}
+
+	usernames := make([]string, 0, len(req.Usernames))
+	for _, u := range req.Usernames {
+		if u = strings.ToLower(strings.TrimSpace(u)); u != "" {
+			usernames = append(usernames, u)
+		}
+	}
+	if len(usernames) == 0 {
+		return &userpb.ResolveUsernamesResponse{}, nil
+	}
+
+	var users []models.User
+	if err := s.db.Scopes(s.inOrg(req.OrgId)).Where("LOWER(username) IN ?", usernames).Find(&users).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to resolve usernames")
+	}
+
+	resp := &userpb.ResolveUsernamesResponse{Users: make([]*userpb.User, len(users))}
+	for i := range users {
+		resp.Users[i] = s.modelToProto(&users[i])
+	}
+	return resp, nil
+}
+
 func getStringValue(s *string) string {
 	if s == nil {
 		return ""
@@ -0,0 +1,290 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
+	"github.com/chanduchitikam/task-management-system/pkg/cache"
+	userpb "github.com/chanduchitikam/task-management-system/proto/user"
+	"github.com/chanduchitikam/task-management-system/services/user/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// A user's home organization and role live on the user row; every other
+// organization they belong to has a row in organization_memberships. Tokens
+// carry the org the session is scoped to and the role held there.
+
+// orgRole returns the role user holds in orgID and whether they belong to it.
+// Super admins hold their platform role in every organization.
+func (s *UserService) orgRole(user *models.User, orgID string) (string, bool) {
+	if orgID == "" {
+		return "", false
+	}
+	if user.Role == authz.RoleSuperAdmin {
+		return user.Role, true
+	}
+	if user.OrgID != nil && *user.OrgID == orgID {
+		return user.Role, true
+	}
+	var m models.OrganizationMembership
+	res := s.db.Select("role").Where("user_id = ? AND org_id = ?", user.ID, orgID).Limit(1).Find(&m)
+	if res.Error != nil || res.RowsAffected == 0 {
+		return "", false
+	}
+	return m.Role, true
+}
+
+// sessionScope picks the org and role for a session. A requested org the user
+// no longer belongs to falls back to their home org.
+func (s *UserService) sessionScope(user *models.User, orgID string) (string, string) {
+	if orgID != "" && orgID != getStringValue(user.OrgID) {
+		if role, ok := s.orgRole(user, orgID); ok {
+			return orgID, role
+		}
+	}
+	return getStringValue(user.OrgID), user.Role
+}
+
+// inOrg limits a users query to members of orgID, home or otherwise
+func (s *UserService) inOrg(orgID string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		members := s.db.Model(&models.OrganizationMembership{}).Select("user_id").Where("org_id = ?", orgID)
+		return db.Where("(users.org_id = ? OR users.id IN (?))", orgID, members)
+	}
+}
+
+// membershipRoles returns the per-org role of every non-home member of orgID
+func (s *UserService) membershipRoles(orgID string) map[string]string {
+	var rows []models.OrganizationMembership
+	s.db.Select("user_id", "role").Where("org_id = ?", orgID).Find(&rows)
+	roles := make(map[string]string, len(rows))
+	for _, m := range rows {
+		roles[m.UserID] = m.Role
+	}
+	return roles
+}
+
+// countOrgAdmins counts members of orgID who can manage members
+func (s *UserService) countOrgAdmins(orgID string) int64 {
+	adminRoles := authz.RolesWith(authz.MemberManage)
+	var home, extra int64
+	s.db.Model(&models.User{}).Where("org_id = ? AND role IN ?", orgID, adminRoles).Count(&home)
+	s.db.Model(&models.OrganizationMembership{}).Where("org_id = ? AND role IN ?", orgID, adminRoles).Count(&extra)
+	return home + extra
+}
+
+// addMembership adds an existing user to an organization other than their home one
+func (s *UserService) addMembership(ctx context.Context, user *models.User, orgID, role, invitedBy string) error {
+	if _, ok := s.orgRole(user, orgID); ok {
+		return status.Error(codes.AlreadyExists, "user is already a member of this organization")
+	}
+	m := &models.OrganizationMembership{UserID: user.ID, OrgID: orgID, Role: role, InvitedBy: invitedBy}
+	if err := s.db.Create(m).Error; err != nil {
+		return status.Error(codes.Internal, "failed to add membership")
+	}
+	s.publishMembershipEvent(ctx, cache.MembershipAdded, orgID, user.ID)
+	return nil
+}
+
+// removeMembership drops a non-home membership. It reports false when the
+// user has no such membership.
+func (s *UserService) removeMembership(orgID, userID string) (bool, error) {
+	var m models.OrganizationMembership
+	if err := s.db.First(&m, "user_id = ? AND org_id = ?", userID, orgID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, nil
+		}
+		return false, status.Error(codes.Internal, "failed to load membership")
+	}
+	if authz.Can(m.Role, authz.MemberManage) && s.countOrgAdmins(orgID) <= 1 {
+		return true, status.Error(codes.FailedPrecondition, "cannot remove the last organization admin")
+	}
+	if err := s.db.Delete(&m).Error; err != nil {
+		return true, status.Error(codes.Internal, "failed to remove member")
+	}
+	s.db.Where("user_id = ? AND org_id = ?", userID, orgID).Delete(&models.GuestAccess{})
+	return true, nil
+}
+
+// ListMyOrganizations returns every organization the caller belongs to
+func (s *UserService) ListMyOrganizations(ctx context.Context, req *userpb.ListMyOrganizationsRequest) (*userpb.ListMyOrganizationsResponse, error) {
+	userID := getStringFromContext(ctx, "user_id")
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing authentication context")
+	}
+	var user models.User
+	if err := s.db.First(&user, "id = ?", userID).Error; err != nil {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	current := getStringFromContext(ctx, "org_id")
+
+	var memberships []models.OrganizationMembership
+	if err := s.db.Where("user_id = ?", user.ID).Order("created_at").Find(&memberships).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to list organizations")
+	}
+
+	orgIDs := make([]string, 0, len(memberships)+1)
+	if user.OrgID != nil {
+		orgIDs = append(orgIDs, *user.OrgID)
+	}
+	for _, m := range memberships {
+		orgIDs = append(orgIDs, m.OrgID)
+	}
+	names := make(map[string]string, len(orgIDs))
+	if len(orgIDs) > 0 {
+		var orgs []models.Organization
+		s.db.Select("id", "name").Where("id IN ?", orgIDs).Find(&orgs)
+		for _, o := range orgs {
+			names[o.ID] = o.Name
+		}
+	}
+
+	resp := &userpb.ListMyOrganizationsResponse{}
+	if user.OrgID != nil {
+		resp.Organizations = append(resp.Organizations, &userpb.OrganizationMembership{
+			OrgId:    *user.OrgID,
+			OrgName:  names[*user.OrgID],
+			Role:     user.Role,
+			Home:     true,
+			Current:  current == *user.OrgID,
+			JoinedAt: timestamppb.New(user.CreatedAt),
+		})
+	}
+	for _, m := range memberships {
+		role := m.Role
+		if user.Role == authz.RoleSuperAdmin {
+			role = user.Role
+		}
+		resp.Organizations = append(resp.Organizations, &userpb.OrganizationMembership{
+			OrgId:    m.OrgID,
+			OrgName:  names[m.OrgID],
+			Role:     role,
+			Current:  current == m.OrgID,
+			JoinedAt: timestamppb.New(m.CreatedAt),
+		})
+	}
+	return resp, nil
+}
+
+// SwitchOrganization issues a token pair scoped to another organization the
+// caller belongs to. Refreshing those tokens keeps the chosen org.
+func (s *UserService) SwitchOrganization(ctx context.Context, req *userpb.SwitchOrganizationRequest) (*userpb.SwitchOrganizationResponse, error) {
+	if req.OrgId == "" {
+		return nil, status.Error(codes.InvalidArgument, "org_id is required")
+	}
+	userID := getStringFromContext(ctx, "user_id")
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing authentication context")
+	}
+	// API keys are bound to the org they were issued in
+	if authenticatedByAPIKey(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "api keys cannot switch organizations")
+	}
+
+	var user models.User
+	if err := s.db.First(&user, "id = ?", userID).Error; err != nil {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if !user.IsActive {
+		return nil, status.Error(codes.PermissionDenied, "account is deactivated")
+	}
+	if user.SuspendedAt != nil {
+		return nil, errAccountSuspended
+	}
+
+	var org models.Organization
+	if err := s.db.Select("id", "name").First(&org, "id = ?", req.OrgId).Error; err != nil {
+		return nil, status.Error(codes.NotFound, "organization not found")
+	}
+	role, ok := s.orgRole(&user, org.ID)
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "you are not a member of this organization")
+	}
+
+	accessToken, err := s.jwtManager.GenerateAccessToken(user.ID, user.Email, role, org.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to generate access token")
+	}
+	refreshToken, err := s.issueRefreshToken(ctx, user.ID, org.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to generate refresh token")
+	}
+
+	return &userpb.SwitchOrganizationResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		ExpiresIn:    int64(s.jwtManager.AccessTokenDuration().Seconds()),
+		Organization: &userpb.OrganizationMembership{
+			OrgId:   org.ID,
+			OrgName: org.Name,
+			Role:    role,
+			Home:    org.ID == getStringValue(user.OrgID),
+			Current: true,
+		},
+	}, nil
+}
+
+// UpdateOrganizationMemberRole changes the role a member holds in one
+// organization, whether it is their home org or an additional one
+func (s *UserService) UpdateOrganizationMemberRole(ctx context.Context, req *userpb.UpdateOrganizationMemberRoleRequest) (*userpb.UpdateOrganizationMemberRoleResponse, error) {
+	if req.OrgId == "" || req.UserId == "" || req.Role == "" {
+		return nil, status.Error(codes.InvalidArgument, "org_id, user_id and role are required")
+	}
+	if !callerCan(ctx, authz.MemberManage, req.OrgId) {
+		return nil, status.Error(codes.PermissionDenied, "access denied")
+	}
+	callerRole := getStringFromContext(ctx, "role")
+	if !authz.Assignable(req.Role) || !authz.CanGrant(callerRole, req.Role) {
+		return nil, status.Errorf(codes.PermissionDenied, "not allowed to grant role %q", req.Role)
+	}
+
+	var user models.User
+	if err := s.db.First(&user, "id = ?", req.UserId).Error; err != nil {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if user.Role == authz.RoleSuperAdmin {
+		return nil, status.Error(codes.FailedPrecondition, "super admin roles are changed through RevokeSuperAdmin")
+	}
+	previous, ok := s.orgRole(&user, req.OrgId)
+	if !ok {
+		return nil, status.Error(codes.NotFound, "user does not belong to this organization")
+	}
+	if previous == req.Role {
+		return &userpb.UpdateOrganizationMemberRoleResponse{Message: "Role unchanged"}, nil
+	}
+	// admins cannot demote someone holding permissions they lack
+	if !authz.CanGrant(callerRole, previous) {
+		return nil, status.Error(codes.PermissionDenied, "not allowed to change this member's role")
+	}
+	if authz.Can(previous, authz.MemberManage) && !authz.Can(req.Role, authz.MemberManage) && s.countOrgAdmins(req.OrgId) <= 1 {
+		return nil, status.Error(codes.FailedPrecondition, "cannot demote the last organization admin")
+	}
+
+	var err error
+	if getStringValue(user.OrgID) == req.OrgId {
+		err = s.db.Model(&user).Update("role", req.Role).Error
+	} else {
+		err = s.db.Model(&models.OrganizationMembership{}).
+			Where("user_id = ? AND org_id = ?", user.ID, req.OrgId).
+			Update("role", req.Role).Error
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to update role")
+	}
+	s.recordAudit(ctx, auditEvent{
+		OrgID:      req.OrgId,
+		Action:     auditRoleChanged,
+		TargetType: "user",
+		TargetID:   user.ID,
+		Metadata:   map[string]string{"from": previous, "to": req.Role},
+	})
+
+	return &userpb.UpdateOrganizationMemberRoleResponse{
+		Message: fmt.Sprintf("%s is now %s", user.FullName, req.Role),
+	}, nil
+}
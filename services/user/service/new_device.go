@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+	"time"
+
+	notificationpb "github.com/chanduchitikam/task-management-system/proto/notification"
+	"github.com/chanduchitikam/task-management-system/services/user/models"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// how many of the user's past logins from a device are compared against
+const maxKnownDeviceLogins = 100
+
+// deviceFingerprint identifies the signing-in client from the headers the
+// gateway forwards. Apps that send a stable X-Device-Id are identified by
+// it; browsers by their user agent, language and platform hints. It is
+// empty when the client sent none of these.
+func deviceFingerprint(ctx context.Context) string {
+	var source string
+	if id := getStringFromContext(ctx, "x-device-id"); id != "" {
+		source = "id:" + id
+	} else {
+		parts := []string{
+			clientUserAgent(ctx),
+			getStringFromContext(ctx, "x-client-accept-language"),
+			getStringFromContext(ctx, "x-client-platform"),
+		}
+		if strings.Join(parts, "") == "" {
+			return ""
+		}
+		source = "ua:" + strings.Join(parts, "\n")
+	}
+	sum := sha256.Sum256([]byte(source))
+	return hex.EncodeToString(sum[:8])
+}
+
+// ipNetwork reduces an address to its /24 (IPv4) or /48 (IPv6) network so
+// that address churn within one network does not count as a new location
+func ipNetwork(ip string) string {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return ip
+	}
+	if v4 := parsed.To4(); v4 != nil {
+		return v4.Mask(net.CIDRMask(24, 32)).String()
+	}
+	return parsed.Mask(net.CIDRMask(48, 128)).String()
+}
+
+// isNewDevice reports whether a successful login from deviceID at ip is a
+// combination the user has not signed in from before. Users with no
+// fingerprinted logins yet, such as on their first sign-in, have nothing to
+// compare against and are not alerted.
+func (s *UserService) isNewDevice(userID, deviceID, ip string) bool {
+	if deviceID == "" {
+		return false
+	}
+	var known int64
+	if err := s.db.Model(&models.LoginEvent{}).
+		Where("user_id = ? AND success = ? AND device_id <> ''", userID, true).
+		Limit(1).Count(&known).Error; err != nil || known == 0 {
+		return false
+	}
+
+	var prior []models.LoginEvent
+	if err := s.db.Select("ip_address").
+		Where("user_id = ? AND success = ? AND device_id = ?", userID, true, deviceID).
+		Order("created_at DESC").Limit(maxKnownDeviceLogins).Find(&prior).Error; err != nil {
+		return false
+	}
+	network := ipNetwork(ip)
+	for _, e := range prior {
+		if ipNetwork(e.IPAddress) == network {
+			return false
+		}
+	}
+	return true
+}
+
+// alertNewDevice tells the user about a sign-in from a new device, unless
+// their organization turned the alerts off. Delivery is best effort.
+func (s *UserService) alertNewDevice(ctx context.Context, user *models.User) {
+	ip := loginClientIP(ctx)
+	ua := clientUserAgent(ctx)
+	s.recordAudit(ctx, auditEvent{
+		OrgID:      getStringValue(user.OrgID),
+		ActorID:    user.ID,
+		ActorEmail: user.Email,
+		Action:     auditNewDeviceLogin,
+		TargetType: "user",
+		TargetID:   user.ID,
+		Metadata:   map[string]string{"ip": ip, "user_agent": ua},
+	})
+
+	if s.cache == nil {
+		return
+	}
+	if user.OrgID != nil {
+		settings, err := s.orgSecurity(*user.OrgID)
+		if err == nil && !settings.newDeviceAlerts() {
+			return
+		}
+	}
+
+	device := ua
+	if device == "" {
+		device = "an unknown device"
+	} else if len(device) > 120 {
+		device = device[:120] + "…"
+	}
+	now := time.Now()
+	event := &notificationpb.NotificationEvent{
+		NotificationId: uuid.New().String(),
+		UserId:         user.ID,
+		Type:           notificationpb.NotificationType_NOTIFICATION_TYPE_NEW_DEVICE_LOGIN,
+		Title:          "New sign-in to your account",
+		Message: fmt.Sprintf("Your account was signed in to from %s (%s) at %s. If this wasn't you, reset your password now.",
+			device, ip, now.UTC().Format("Jan 2 15:04 MST")),
+		CreatedAt: timestamppb.New(now),
+		Metadata: map[string]string{
+			"ip_address": ip,
+			"user_agent": ua,
+			"device_id":  deviceFingerprint(ctx),
+		},
+	}
+	if err := s.queueNotification(ctx, event); err != nil {
+		log.Printf("failed to send new device alert to user %s: %v", user.ID, err)
+	}
+}
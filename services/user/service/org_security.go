@@ -0,0 +1,229 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
+	userpb "github.com/chanduchitikam/task-management-system/proto/user"
+	"github.com/chanduchitikam/task-management-system/services/user/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/datatypes"
+)
+
+const orgSecuritySettingKey = "security"
+
+// orgSecuritySettings is kept under "security" in the organization's
+// settings. Unset options take their defaults.
+type orgSecuritySettings struct {
+	NewDeviceAlerts          *bool    `json:"new_device_alerts,omitempty"`
+	RequirePasskeysForAdmins bool     `json:"require_passkeys_for_admins,omitempty"`
+	IPAllowlist              []string `json:"ip_allowlist,omitempty"`
+}
+
+const maxIPAllowlistEntries = 100
+
+// normalizeIPAllowlist validates CIDR ranges, turning bare addresses into
+// single-host ranges
+func normalizeIPAllowlist(cidrs []string) ([]string, error) {
+	if len(cidrs) > maxIPAllowlistEntries {
+		return nil, status.Errorf(codes.InvalidArgument, "ip_allowlist may hold at most %d ranges", maxIPAllowlistEntries)
+	}
+	out := make([]string, 0, len(cidrs))
+	seen := map[string]bool{}
+	for _, c := range cidrs {
+		c = strings.TrimSpace(c)
+		prefix, err := netip.ParsePrefix(c)
+		if err != nil {
+			addr, addrErr := netip.ParseAddr(c)
+			if addrErr != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid CIDR range %q", c)
+			}
+			prefix = netip.PrefixFrom(addr, addr.BitLen())
+		}
+		normalized := prefix.Masked().String()
+		if !seen[normalized] {
+			seen[normalized] = true
+			out = append(out, normalized)
+		}
+	}
+	return out, nil
+}
+
+// ipAllowed reports whether ip falls within cidrs; an empty list allows any
+// address
+func ipAllowed(cidrs []string, ip string) bool {
+	if len(cidrs) == 0 {
+		return true
+	}
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, c := range cidrs {
+		if prefix, err := netip.ParsePrefix(c); err == nil && prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// newDeviceAlerts is on unless the org turned it off
+func (o orgSecuritySettings) newDeviceAlerts() bool {
+	return o.NewDeviceAlerts == nil || *o.NewDeviceAlerts
+}
+
+func (s *UserService) orgSecurity(orgID string) (orgSecuritySettings, error) {
+	var org models.Organization
+	if err := s.db.Select("id", "settings").First(&org, "id = ?", orgID).Error; err != nil {
+		return orgSecuritySettings{}, status.Error(codes.NotFound, "organization not found")
+	}
+	var settings struct {
+		Security orgSecuritySettings `json:"security"`
+	}
+	if len(org.Settings) > 0 {
+		_ = json.Unmarshal(org.Settings, &settings)
+	}
+	return settings.Security, nil
+}
+
+func orgSecurityToProto(orgID string, o orgSecuritySettings) *userpb.OrgSecuritySettings {
+	return &userpb.OrgSecuritySettings{
+		OrgId:                    orgID,
+		NewDeviceAlerts:          o.newDeviceAlerts(),
+		RequirePasskeysForAdmins: o.RequirePasskeysForAdmins,
+		IpAllowlist:              o.IPAllowlist,
+	}
+}
+
+// GetOrgSecuritySettings returns an organization's sign-in security settings
+func (s *UserService) GetOrgSecuritySettings(ctx context.Context, req *userpb.GetOrgSecuritySettingsRequest) (*userpb.OrgSecuritySettings, error) {
+	if req.OrgId == "" {
+		return nil, status.Error(codes.InvalidArgument, "org_id is required")
+	}
+	if !callerCan(ctx, authz.MemberView, req.OrgId) {
+		return nil, status.Error(codes.PermissionDenied, "access denied")
+	}
+	settings, err := s.orgSecurity(req.OrgId)
+	if err != nil {
+		return nil, err
+	}
+	return orgSecurityToProto(req.OrgId, settings), nil
+}
+
+// UpdateOrgSecuritySettings changes the settings present in the request
+func (s *UserService) UpdateOrgSecuritySettings(ctx context.Context, req *userpb.UpdateOrgSecuritySettingsRequest) (*userpb.OrgSecuritySettings, error) {
+	if req.OrgId == "" {
+		return nil, status.Error(codes.InvalidArgument, "org_id is required")
+	}
+	if !callerCan(ctx, authz.OrgManage, req.OrgId) {
+		return nil, status.Error(codes.PermissionDenied, "access denied")
+	}
+
+	var org models.Organization
+	if err := s.db.Select("id", "settings").First(&org, "id = ?", req.OrgId).Error; err != nil {
+		return nil, status.Error(codes.NotFound, "organization not found")
+	}
+	settings := map[string]json.RawMessage{}
+	if len(org.Settings) > 0 {
+		_ = json.Unmarshal(org.Settings, &settings)
+	}
+	var security orgSecuritySettings
+	if raw, ok := settings[orgSecuritySettingKey]; ok {
+		_ = json.Unmarshal(raw, &security)
+	}
+
+	changes := map[string]string{}
+	if req.NewDeviceAlerts != nil {
+		security.NewDeviceAlerts = req.NewDeviceAlerts
+		changes["new_device_alerts"] = fmt.Sprint(*req.NewDeviceAlerts)
+	}
+	if req.RequirePasskeysForAdmins != nil {
+		if *req.RequirePasskeysForAdmins && s.passkeys == nil {
+			return nil, status.Error(codes.FailedPrecondition, "passkeys are not enabled on this server")
+		}
+		security.RequirePasskeysForAdmins = *req.RequirePasskeysForAdmins
+		changes["require_passkeys_for_admins"] = fmt.Sprint(*req.RequirePasskeysForAdmins)
+	}
+	if req.IpAllowlist != nil {
+		cidrs, err := normalizeIPAllowlist(req.IpAllowlist.Cidrs)
+		if err != nil {
+			return nil, err
+		}
+		// an admin must not lock themselves out; the gateway enforces the
+		// allowlist on the address it resolved, so check that one
+		if ip := gatewayClientIP(ctx); ip != "" && !ipAllowed(cidrs, hostOnly(ip)) {
+			return nil, status.Errorf(codes.FailedPrecondition, "your current address %s is not in the allowlist", hostOnly(ip))
+		}
+		security.IPAllowlist = cidrs
+		changes["ip_allowlist"] = strings.Join(cidrs, ",")
+	}
+	if len(changes) == 0 {
+		return orgSecurityToProto(req.OrgId, security), nil
+	}
+
+	raw, _ := json.Marshal(security)
+	settings[orgSecuritySettingKey] = raw
+	encoded, err := json.Marshal(settings)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to encode settings")
+	}
+	if err := s.db.Model(&org).Update("settings", datatypes.JSON(encoded)).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to update security settings")
+	}
+	s.recordAudit(ctx, auditEvent{
+		OrgID:      req.OrgId,
+		Action:     auditSecuritySettingsUpdated,
+		TargetType: "organization",
+		TargetID:   req.OrgId,
+		Metadata:   changes,
+	})
+	return orgSecurityToProto(req.OrgId, security), nil
+}
+
+// hostOnly strips the port from a peer address
+func hostOnly(addr string) string {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
+
+// GetOrgIPAllowlist returns the networks an org's members may connect from
+func (s *UserService) GetOrgIPAllowlist(ctx context.Context, req *userpb.GetOrgIPAllowlistRequest) (*userpb.GetOrgIPAllowlistResponse, error) {
+	if req.OrgId == "" {
+		return nil, status.Error(codes.InvalidArgument, "org_id is required")
+	}
+	settings, err := s.orgSecurity(req.OrgId)
+	if err != nil {
+		return nil, err
+	}
+	return &userpb.GetOrgIPAllowlistResponse{Cidrs: settings.IPAllowlist}, nil
+}
+
+// RecordBlockedAccess audits a request the gateway turned away because it
+// came from outside the org's allowlist
+func (s *UserService) RecordBlockedAccess(ctx context.Context, req *userpb.RecordBlockedAccessRequest) (*userpb.RecordBlockedAccessResponse, error) {
+	if req.OrgId == "" || req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "org_id and user_id are required")
+	}
+	s.recordAudit(ctx, auditEvent{
+		OrgID:      req.OrgId,
+		ActorID:    req.UserId,
+		Action:     auditIPBlocked,
+		TargetType: "user",
+		TargetID:   req.UserId,
+		Metadata: map[string]string{
+			"ip_address": req.IpAddress,
+			"method":     req.Method,
+			"path":       req.Path,
+		},
+	})
+	return &userpb.RecordBlockedAccessResponse{}, nil
+}
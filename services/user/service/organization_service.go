@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/chanduchitikam/task-management-system/pkg/auth"
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
 	"github.com/chanduchitikam/task-management-system/services/user/models"
 	"gorm.io/gorm"
 )
@@ -100,7 +101,7 @@ func (s *OrganizationService) RegisterOrganization(orgName, orgDescription, admi
 		Username: username,
 		Password: hashedPassword,
 		FullName: adminFullName,
-		Role:     "org_admin",
+		Role:     authz.RoleOrgAdmin,
 		OrgID:    &org.ID,
 	}
 	if err := tx.Create(admin).Error; err != nil {
@@ -176,10 +177,11 @@ func (s *OrganizationService) RemoveOrganizationMember(orgID, userID string) err
 	}
 
 	// Prevent removing the last org admin
-	if user.Role == "org_admin" {
-		var adminCount int64
-		s.db.Model(&models.User{}).Where("org_id = ? AND role = ?", orgID, "org_admin").Count(&adminCount)
-		if adminCount <= 1 {
+	if authz.Can(user.Role, authz.MemberManage) {
+		var adminCount, memberAdmins int64
+		s.db.Model(&models.User{}).Where("org_id = ? AND role IN ?", orgID, authz.RolesWith(authz.MemberManage)).Count(&adminCount)
+		s.db.Model(&models.OrganizationMembership{}).Where("org_id = ? AND role IN ?", orgID, authz.RolesWith(authz.MemberManage)).Count(&memberAdmins)
+		if adminCount+memberAdmins <= 1 {
 			return errors.New("cannot remove the last organization admin")
 		}
 	}
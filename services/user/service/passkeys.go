@@ -0,0 +1,440 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
+	"github.com/chanduchitikam/task-management-system/pkg/webauthn"
+	userpb "github.com/chanduchitikam/task-management-system/proto/user"
+	"github.com/chanduchitikam/task-management-system/services/user/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+const (
+	// how long a browser has to complete a passkey ceremony
+	passkeyCeremonyTimeout = 5 * time.Minute
+	maxPasskeysPerUser     = 20
+	// passkeys replace the password, so the authenticator must verify the
+	// user with a PIN or biometric
+	passkeyUserVerification = "required"
+)
+
+var (
+	errPasskeysDisabled = status.Error(codes.FailedPrecondition, "passkeys are not enabled on this server")
+	errPasskeyRequired  = status.Error(codes.PermissionDenied, "your organization requires admins to sign in with a passkey")
+	errInvalidPasskey   = status.Error(codes.Unauthenticated, "invalid passkey")
+)
+
+// transports browsers report, passed back as hints when signing in
+var passkeyTransports = map[string]bool{
+	"usb": true, "nfc": true, "ble": true, "smart-card": true, "hybrid": true, "internal": true,
+}
+
+// SetPasskeys enables passkey registration and sign-in for rp
+func (s *UserService) SetPasskeys(rp *webauthn.RelyingParty) {
+	s.passkeys = rp
+}
+
+func (s *UserService) hasPasskey(userID string) bool {
+	var n int64
+	s.db.Model(&models.Passkey{}).Where("user_id = ?", userID).Count(&n)
+	return n > 0
+}
+
+// passkeysRequired reports whether user is an admin of a home organization
+// that requires admins to sign in with a passkey
+func (s *UserService) passkeysRequired(user *models.User) bool {
+	if s.passkeys == nil || user.OrgID == nil || !authz.Can(user.Role, authz.MemberManage) {
+		return false
+	}
+	settings, err := s.orgSecurity(*user.OrgID)
+	return err == nil && settings.RequirePasskeysForAdmins
+}
+
+// startPasskeyCeremony stores a new challenge for a registration or sign-in
+func (s *UserService) startPasskeyCeremony(userID *string, purpose string) (*models.PasskeyChallenge, error) {
+	challenge, err := webauthn.NewChallenge()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to generate challenge")
+	}
+	now := time.Now()
+	// expired ceremonies are never claimed; clear them as new ones start
+	s.db.Where("expires_at < ?", now.Add(-time.Hour)).Delete(&models.PasskeyChallenge{})
+
+	c := &models.PasskeyChallenge{
+		UserID:    userID,
+		Purpose:   purpose,
+		Challenge: challenge,
+		ExpiresAt: now.Add(passkeyCeremonyTimeout),
+	}
+	if err := s.db.Create(c).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to start passkey ceremony")
+	}
+	return c, nil
+}
+
+// claimPasskeyCeremony marks a pending ceremony used and returns it. A
+// ceremony can only be completed once, by the user who started it.
+func (s *UserService) claimPasskeyCeremony(sessionID, purpose, userID string) (*models.PasskeyChallenge, error) {
+	if sessionID == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_id is required")
+	}
+	var c models.PasskeyChallenge
+	if err := s.db.First(&c, "id = ? AND purpose = ?", sessionID, purpose).Error; err != nil {
+		return nil, status.Error(codes.NotFound, "passkey session not found")
+	}
+	if userID != "" && getStringValue(c.UserID) != userID {
+		return nil, status.Error(codes.NotFound, "passkey session not found")
+	}
+	now := time.Now()
+	if c.UsedAt != nil || now.After(c.ExpiresAt) {
+		return nil, status.Error(codes.FailedPrecondition, "passkey session has expired; start again")
+	}
+	claim := s.db.Model(&models.PasskeyChallenge{}).Where("id = ? AND used_at IS NULL", c.ID).Update("used_at", now)
+	if claim.Error != nil {
+		return nil, status.Error(codes.Internal, "failed to claim passkey session")
+	}
+	if claim.RowsAffected == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "passkey session has expired; start again")
+	}
+	return &c, nil
+}
+
+func (s *UserService) userCredentialIDs(userID string) []string {
+	var ids []string
+	s.db.Model(&models.Passkey{}).Where("user_id = ?", userID).Order("created_at").Pluck("credential_id", &ids)
+	return ids
+}
+
+// BeginPasskeyRegistration starts registering a passkey for the caller
+func (s *UserService) BeginPasskeyRegistration(ctx context.Context, req *userpb.BeginPasskeyRegistrationRequest) (*userpb.PasskeyRegistrationOptions, error) {
+	userID := getStringFromContext(ctx, "user_id")
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	if authenticatedByAPIKey(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "api keys cannot manage passkeys")
+	}
+	if s.passkeys == nil {
+		return nil, errPasskeysDisabled
+	}
+
+	var user models.User
+	if err := s.db.First(&user, "id = ?", userID).Error; err != nil {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if user.IsServiceAccount {
+		return nil, status.Error(codes.PermissionDenied, "service accounts cannot register passkeys")
+	}
+	existing := s.userCredentialIDs(user.ID)
+	if len(existing) >= maxPasskeysPerUser {
+		return nil, status.Errorf(codes.ResourceExhausted, "at most %d passkeys can be registered", maxPasskeysPerUser)
+	}
+
+	c, err := s.startPasskeyCeremony(&user.ID, models.PasskeyRegistration)
+	if err != nil {
+		return nil, err
+	}
+	displayName := user.FullName
+	if displayName == "" {
+		displayName = user.Email
+	}
+	return &userpb.PasskeyRegistrationOptions{
+		SessionId:            c.ID,
+		Challenge:            c.Challenge,
+		RpId:                 s.passkeys.ID,
+		RpName:               s.passkeys.Name,
+		UserHandle:           webauthn.Encode([]byte(user.ID)),
+		UserName:             user.Email,
+		UserDisplayName:      displayName,
+		ExcludeCredentialIds: existing,
+		TimeoutMs:            passkeyCeremonyTimeout.Milliseconds(),
+		UserVerification:     passkeyUserVerification,
+	}, nil
+}
+
+// FinishPasskeyRegistration verifies the authenticator's response and saves
+// the new passkey
+func (s *UserService) FinishPasskeyRegistration(ctx context.Context, req *userpb.FinishPasskeyRegistrationRequest) (*userpb.Passkey, error) {
+	userID := getStringFromContext(ctx, "user_id")
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	if authenticatedByAPIKey(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "api keys cannot manage passkeys")
+	}
+	if s.passkeys == nil {
+		return nil, errPasskeysDisabled
+	}
+	clientData, err1 := webauthn.Decode(req.ClientDataJson)
+	attestation, err2 := webauthn.Decode(req.AttestationObject)
+	if err1 != nil || err2 != nil || len(clientData) == 0 || len(attestation) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "client_data_json and attestation_object must be base64url encoded")
+	}
+
+	c, err := s.claimPasskeyCeremony(req.SessionId, models.PasskeyRegistration, userID)
+	if err != nil {
+		return nil, err
+	}
+	cred, err := s.passkeys.VerifyRegistration(c.Challenge, clientData, attestation, true)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "passkey registration could not be verified: "+err.Error())
+	}
+	credentialID := webauthn.Encode(cred.ID)
+	if req.CredentialId != "" && strings.TrimRight(req.CredentialId, "=") != credentialID {
+		return nil, status.Error(codes.InvalidArgument, "credential_id does not match the attestation")
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		name = "Passkey"
+	}
+	if len(name) > 100 {
+		name = name[:100]
+	}
+	var transports []string
+	for _, t := range req.Transports {
+		if passkeyTransports[t] {
+			transports = append(transports, t)
+		}
+	}
+	pk := &models.Passkey{
+		UserID:         userID,
+		CredentialID:   credentialID,
+		PublicKey:      cred.PublicKey,
+		SignCount:      int64(cred.SignCount),
+		AAGUID:         webauthn.Encode(cred.AAGUID),
+		Name:           name,
+		Transports:     strings.Join(transports, ","),
+		BackupEligible: cred.BackupEligible,
+	}
+
+	var user models.User
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Select("id", "org_id").First(&user, "id = ?", userID).Error; err != nil {
+			return status.Error(codes.NotFound, "user not found")
+		}
+		var taken int64
+		tx.Model(&models.Passkey{}).Where("credential_id = ?", credentialID).Count(&taken)
+		if taken > 0 {
+			return status.Error(codes.AlreadyExists, "this passkey is already registered")
+		}
+		if err := tx.Create(pk).Error; err != nil {
+			return status.Error(codes.Internal, "failed to save passkey")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.recordAudit(ctx, auditEvent{
+		OrgID:      getStringValue(user.OrgID),
+		Action:     auditPasskeyAdded,
+		TargetType: "passkey",
+		TargetID:   pk.ID,
+		Metadata:   map[string]string{"name": pk.Name},
+	})
+	return passkeyToProto(pk), nil
+}
+
+// ListPasskeys lists the caller's passkeys
+func (s *UserService) ListPasskeys(ctx context.Context, req *userpb.ListPasskeysRequest) (*userpb.ListPasskeysResponse, error) {
+	userID := getStringFromContext(ctx, "user_id")
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	var keys []models.Passkey
+	if err := s.db.Where("user_id = ?", userID).Order("created_at").Find(&keys).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to list passkeys")
+	}
+	resp := &userpb.ListPasskeysResponse{Passkeys: make([]*userpb.Passkey, 0, len(keys))}
+	for i := range keys {
+		resp.Passkeys = append(resp.Passkeys, passkeyToProto(&keys[i]))
+	}
+	return resp, nil
+}
+
+// DeletePasskey removes one of the caller's passkeys. Admins whose
+// organization requires passkeys keep at least one.
+func (s *UserService) DeletePasskey(ctx context.Context, req *userpb.DeletePasskeyRequest) (*userpb.DeletePasskeyResponse, error) {
+	userID := getStringFromContext(ctx, "user_id")
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	if authenticatedByAPIKey(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "api keys cannot manage passkeys")
+	}
+	if req.PasskeyId == "" {
+		return nil, status.Error(codes.InvalidArgument, "passkey_id is required")
+	}
+
+	var pk models.Passkey
+	if err := s.db.First(&pk, "id = ? AND user_id = ?", req.PasskeyId, userID).Error; err != nil {
+		return nil, status.Error(codes.NotFound, "passkey not found")
+	}
+	var user models.User
+	if err := s.db.First(&user, "id = ?", userID).Error; err != nil {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if s.passkeysRequired(&user) && len(s.userCredentialIDs(userID)) <= 1 {
+		return nil, status.Error(codes.FailedPrecondition, "your organization requires admins to keep a passkey; register another one first")
+	}
+	if err := s.db.Delete(&pk).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to delete passkey")
+	}
+	s.recordAudit(ctx, auditEvent{
+		OrgID:      getStringValue(user.OrgID),
+		Action:     auditPasskeyRemoved,
+		TargetType: "passkey",
+		TargetID:   pk.ID,
+		Metadata:   map[string]string{"name": pk.Name},
+	})
+	return &userpb.DeletePasskeyResponse{Message: "passkey removed"}, nil
+}
+
+// BeginPasskeyLogin starts a passkey sign-in. With an email, the user's
+// passkeys are listed so security keys without stored accounts can be used;
+// unknown emails get the same response as no email, so accounts cannot be
+// probed.
+func (s *UserService) BeginPasskeyLogin(ctx context.Context, req *userpb.BeginPasskeyLoginRequest) (*userpb.PasskeyLoginOptions, error) {
+	if s.passkeys == nil {
+		return nil, errPasskeysDisabled
+	}
+	var allow []string
+	if email := strings.ToLower(strings.TrimSpace(req.Email)); email != "" {
+		if err := s.checkLoginThrottle(ctx, email, loginClientIP(ctx)); err != nil {
+			return nil, err
+		}
+		var user models.User
+		if err := s.db.Select("id").Where("LOWER(email) = ?", email).First(&user).Error; err == nil {
+			allow = s.userCredentialIDs(user.ID)
+		}
+	}
+
+	c, err := s.startPasskeyCeremony(nil, models.PasskeyLogin)
+	if err != nil {
+		return nil, err
+	}
+	return &userpb.PasskeyLoginOptions{
+		SessionId:          c.ID,
+		Challenge:          c.Challenge,
+		RpId:               s.passkeys.ID,
+		AllowCredentialIds: allow,
+		TimeoutMs:          passkeyCeremonyTimeout.Milliseconds(),
+		UserVerification:   passkeyUserVerification,
+	}, nil
+}
+
+// FinishPasskeyLogin verifies a passkey assertion and signs the user in
+func (s *UserService) FinishPasskeyLogin(ctx context.Context, req *userpb.FinishPasskeyLoginRequest) (*userpb.LoginResponse, error) {
+	if s.passkeys == nil {
+		return nil, errPasskeysDisabled
+	}
+	credentialID, err := webauthn.Decode(req.CredentialId)
+	if err != nil || len(credentialID) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "credential_id must be base64url encoded")
+	}
+	var assertion webauthn.Assertion
+	var err1, err2, err3 error
+	assertion.ClientDataJSON, err1 = webauthn.Decode(req.ClientDataJson)
+	assertion.AuthenticatorData, err2 = webauthn.Decode(req.AuthenticatorData)
+	assertion.Signature, err3 = webauthn.Decode(req.Signature)
+	if err1 != nil || err2 != nil || err3 != nil {
+		return nil, status.Error(codes.InvalidArgument, "client_data_json, authenticator_data and signature must be base64url encoded")
+	}
+
+	c, err := s.claimPasskeyCeremony(req.SessionId, models.PasskeyLogin, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var pk models.Passkey
+	if err := s.db.First(&pk, "credential_id = ?", webauthn.Encode(credentialID)).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errInvalidPasskey
+		}
+		return nil, status.Error(codes.Internal, "failed to find passkey")
+	}
+	if req.UserHandle != "" {
+		handle, err := webauthn.Decode(req.UserHandle)
+		if err != nil || string(handle) != pk.UserID {
+			return nil, errInvalidPasskey
+		}
+	}
+
+	var user models.User
+	if err := s.db.First(&user, "id = ?", pk.UserID).Error; err != nil {
+		return nil, errInvalidPasskey
+	}
+	email := strings.ToLower(user.Email)
+	if !user.IsActive {
+		s.recordLogin(ctx, email, &user, models.LoginDeactivated)
+		return nil, status.Error(codes.PermissionDenied, "account is deactivated")
+	}
+	if user.IsServiceAccount {
+		s.recordLogin(ctx, email, &user, models.LoginServiceAccount)
+		return nil, status.Error(codes.PermissionDenied, "service accounts authenticate with api keys")
+	}
+	if user.SuspendedAt != nil {
+		s.recordLogin(ctx, email, &user, models.LoginSuspended)
+		return nil, errAccountSuspended
+	}
+	if accountLocked(&user, time.Now()) {
+		s.recordLogin(ctx, email, &user, models.LoginLocked)
+		return nil, accountLockedError(&user)
+	}
+
+	count, err := s.passkeys.VerifyAssertion(c.Challenge, pk.PublicKey, uint32(pk.SignCount), assertion, true)
+	if err != nil {
+		if errors.Is(err, webauthn.ErrSignCount) {
+			log.Printf("warning: passkey %s for user %s reported a stale signature counter; it may have been cloned", pk.ID, user.ID)
+		}
+		s.recordLogin(ctx, email, &user, models.LoginInvalidPasskey)
+		s.recordAudit(ctx, auditEvent{
+			OrgID:      getStringValue(user.OrgID),
+			ActorID:    user.ID,
+			ActorEmail: user.Email,
+			Action:     auditLoginFailed,
+			TargetType: "user",
+			TargetID:   user.ID,
+			Metadata:   map[string]string{"method": "passkey", "passkey_id": pk.ID},
+		})
+		return nil, errInvalidPasskey
+	}
+
+	// the counter check and update must not race a replayed assertion
+	now := time.Now()
+	res := s.db.Model(&models.Passkey{}).
+		Where("id = ? AND sign_count = ?", pk.ID, pk.SignCount).
+		Updates(map[string]interface{}{"sign_count": int64(count), "last_used_at": &now})
+	if res.Error != nil {
+		return nil, status.Error(codes.Internal, "failed to update passkey")
+	}
+	if res.RowsAffected == 0 {
+		return nil, errInvalidPasskey
+	}
+
+	return s.completeLogin(ctx, &user, email)
+}
+
+func passkeyToProto(p *models.Passkey) *userpb.Passkey {
+	pb := &userpb.Passkey{
+		PasskeyId:      p.ID,
+		Name:           p.Name,
+		CredentialId:   p.CredentialID,
+		Transports:     splitList(p.Transports),
+		BackupEligible: p.BackupEligible,
+		CreatedAt:      timestamppb.New(p.CreatedAt),
+	}
+	if p.LastUsedAt != nil {
+		pb.LastUsedAt = timestamppb.New(*p.LastUsedAt)
+	}
+	return pb
+}
@@ -0,0 +1,274 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	htmltemplate "html/template"
+	"log"
+	"net/url"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/pkg/auth"
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
+	"github.com/chanduchitikam/task-management-system/pkg/mailer"
+	userpb "github.com/chanduchitikam/task-management-system/proto/user"
+	"github.com/chanduchitikam/task-management-system/services/user/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	passwordResetTTL = time.Hour
+
+	// requests allowed per email and per client address within the window
+	passwordResetWindow      = time.Hour
+	maxPasswordResetsByEmail = 3
+	maxPasswordResetsByIP    = 20
+
+	maxPasswordResetSendAttempts = 3
+	passwordResetSendBackoff     = 10 * time.Second
+
+	forgotPasswordMessage = "If an account exists for that email, a password reset link has been sent."
+)
+
+//go:embed templates/password_reset.html templates/password_reset.txt
+var passwordResetTemplates embed.FS
+
+var (
+	passwordResetHTMLTemplate = htmltemplate.Must(htmltemplate.ParseFS(passwordResetTemplates, "templates/password_reset.html"))
+	passwordResetTextTemplate = texttemplate.Must(texttemplate.ParseFS(passwordResetTemplates, "templates/password_reset.txt"))
+)
+
+type passwordResetEmailData struct {
+	Subject      string
+	OrgName      string
+	LogoURL      string
+	PrimaryColor string
+	SupportEmail string
+	Email        string
+	Token        string
+	ResetURL     string
+	ExpiresAt    string
+}
+
+func passwordResetEmailKey(email string) string { return "password_reset:email:" + email }
+func passwordResetIPKey(ip string) string       { return "password_reset:ip:" + ip }
+
+// SetPasswordResetURL sets the page linked from reset emails, with the token
+// appended as ?token=
+func (s *UserService) SetPasswordResetURL(u string) {
+	s.resetURL = u
+}
+
+// passwordResetAllowed records a reset request and reports whether it is
+// within the per-email and per-address limits. Without Redis requests are
+// not limited.
+func (s *UserService) passwordResetAllowed(ctx context.Context, email, ip string) bool {
+	if s.cache == nil {
+		return true
+	}
+	now := time.Now()
+	check := func(key string, limit int64) bool {
+		w, err := s.cache.SlidingWindowGet(ctx, key, passwordResetWindow, now)
+		if err != nil {
+			log.Printf("warning: password reset throttle unavailable: %v", err)
+			return true
+		}
+		if w.Count >= limit {
+			return false
+		}
+		if err := s.cache.SlidingWindowRecord(ctx, key, passwordResetWindow, now); err != nil {
+			log.Printf("warning: failed to record password reset request: %v", err)
+		}
+		return true
+	}
+	if !check(passwordResetEmailKey(email), maxPasswordResetsByEmail) {
+		return false
+	}
+	return ip == "" || check(passwordResetIPKey(ip), maxPasswordResetsByIP)
+}
+
+// ForgotPassword emails a single-use reset link. The response is the same
+// whether or not the email belongs to an account that can be reset.
+func (s *UserService) ForgotPassword(ctx context.Context, req *userpb.ForgotPasswordRequest) (*userpb.ForgotPasswordResponse, error) {
+	email := strings.ToLower(strings.TrimSpace(req.Email))
+	if email == "" {
+		return nil, status.Error(codes.InvalidArgument, "email is required")
+	}
+	if s.mailer == nil {
+		return nil, status.Error(codes.Unavailable, "password reset by email is not configured; use your security questions or contact your administrator")
+	}
+	resp := &userpb.ForgotPasswordResponse{Message: forgotPasswordMessage}
+
+	ip := loginClientIP(ctx)
+	if !s.passwordResetAllowed(ctx, email, ip) {
+		return resp, nil
+	}
+
+	var user models.User
+	if err := s.db.Where("LOWER(email) = ?", email).First(&user).Error; err != nil {
+		return resp, nil
+	}
+	// directory passwords are changed in the directory, and super admins
+	// cannot reset their password without signing in
+	if !user.IsActive || user.SuspendedAt != nil || user.IsServiceAccount ||
+		user.ExternalSource == ldapSource || authz.IsPlatformAdmin(user.Role) {
+		return resp, nil
+	}
+
+	token, err := generateSecureToken(32)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to generate token")
+	}
+	now := time.Now()
+	reset := &models.PasswordResetToken{
+		UserID:      user.ID,
+		TokenHash:   hashString(token),
+		ExpiresAt:   now.Add(passwordResetTTL),
+		RequestedIP: ip,
+	}
+	// only the newest link works; older ones are dropped
+	s.db.Where("user_id = ?", user.ID).Delete(&models.PasswordResetToken{})
+	if err := s.db.Create(reset).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to create password reset")
+	}
+	s.recordAudit(ctx, auditEvent{
+		OrgID:      getStringValue(user.OrgID),
+		ActorID:    user.ID,
+		ActorEmail: user.Email,
+		Action:     auditPasswordResetRequested,
+		TargetType: "user",
+		TargetID:   user.ID,
+	})
+
+	go s.sendPasswordResetEmail(&user, reset, token, 1)
+	return resp, nil
+}
+
+// sendPasswordResetEmail delivers a reset link, retrying a few times while
+// the link is still the user's current, unused one
+func (s *UserService) sendPasswordResetEmail(user *models.User, reset *models.PasswordResetToken, token string, attempt int) {
+	var current models.PasswordResetToken
+	if err := s.db.First(&current, "id = ?", reset.ID).Error; err != nil ||
+		current.UsedAt != nil || time.Now().After(current.ExpiresAt) {
+		return
+	}
+
+	msg, err := s.renderPasswordResetEmail(user, reset, token)
+	if err != nil {
+		log.Printf("failed to render password reset email for user %s: %v", user.ID, err)
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), inviteSendTimeout)
+	err = s.mailer.Send(ctx, msg)
+	cancel()
+	if err == nil {
+		return
+	}
+
+	log.Printf("password reset email for user %s attempt %d failed: %v", user.ID, attempt, err)
+	if attempt >= maxPasswordResetSendAttempts {
+		return
+	}
+	time.AfterFunc(passwordResetSendBackoff<<(attempt-1), func() {
+		s.sendPasswordResetEmail(user, reset, token, attempt+1)
+	})
+}
+
+func (s *UserService) renderPasswordResetEmail(user *models.User, reset *models.PasswordResetToken, token string) (mailer.Message, error) {
+	branding := s.orgBranding(getStringValue(user.OrgID))
+	data := passwordResetEmailData{
+		Subject:      "Reset your TaskFlow password",
+		OrgName:      branding.DisplayName,
+		LogoURL:      branding.LogoURL,
+		PrimaryColor: branding.PrimaryColor,
+		SupportEmail: branding.SupportEmail,
+		Email:        user.Email,
+		Token:        token,
+		ExpiresAt:    reset.ExpiresAt.UTC().Format("Jan 2, 2006 15:04 MST"),
+	}
+	if s.resetURL != "" {
+		if u, err := url.Parse(s.resetURL); err == nil {
+			q := u.Query()
+			q.Set("token", token)
+			u.RawQuery = q.Encode()
+			data.ResetURL = u.String()
+		}
+	}
+
+	var html, text bytes.Buffer
+	if err := passwordResetHTMLTemplate.Execute(&html, data); err != nil {
+		return mailer.Message{}, err
+	}
+	if err := passwordResetTextTemplate.Execute(&text, data); err != nil {
+		return mailer.Message{}, err
+	}
+	return mailer.Message{To: user.Email, Subject: data.Subject, Text: text.String(), HTML: html.String()}, nil
+}
+
+// ResetPasswordWithToken sets a new password from an emailed reset link. The
+// link works once, and every existing session is signed out.
+func (s *UserService) ResetPasswordWithToken(ctx context.Context, req *userpb.ResetPasswordWithTokenRequest) (*userpb.ResetPasswordWithTokenResponse, error) {
+	if req.Token == "" || req.NewPassword == "" {
+		return nil, status.Error(codes.InvalidArgument, "token and new_password are required")
+	}
+
+	var reset models.PasswordResetToken
+	if err := s.db.First(&reset, "token_hash = ?", hashString(req.Token)).Error; err != nil {
+		return nil, status.Error(codes.NotFound, "invalid or expired reset token")
+	}
+	now := time.Now()
+	if reset.UsedAt != nil || now.After(reset.ExpiresAt) {
+		return nil, status.Error(codes.FailedPrecondition, "reset token already used or expired")
+	}
+
+	var user models.User
+	if err := s.db.First(&user, "id = ?", reset.UserID).Error; err != nil {
+		return nil, status.Error(codes.NotFound, "invalid or expired reset token")
+	}
+	if !user.IsActive || user.SuspendedAt != nil || user.ExternalSource == ldapSource || authz.IsPlatformAdmin(user.Role) {
+		return nil, status.Error(codes.FailedPrecondition, "this account's password cannot be reset by email")
+	}
+
+	hashedPassword, err := auth.HashPassword(req.NewPassword)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to hash password")
+	}
+
+	// claim the token so concurrent requests cannot both use it
+	claim := s.db.Model(&models.PasswordResetToken{}).Where("id = ? AND used_at IS NULL", reset.ID).Update("used_at", now)
+	if claim.Error != nil {
+		return nil, status.Error(codes.Internal, "failed to reset password")
+	}
+	if claim.RowsAffected == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "reset token already used or expired")
+	}
+
+	updates := map[string]interface{}{
+		"password":              hashedPassword,
+		"must_change_password":  false,
+		"failed_login_attempts": 0,
+		"locked_until":          nil,
+	}
+	if err := s.db.Model(&user).Updates(updates).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to update password")
+	}
+	s.revokeSessions(ctx, user.ID)
+	s.resetLoginThrottle(ctx, strings.ToLower(user.Email))
+	s.recordAudit(ctx, auditEvent{
+		OrgID:      getStringValue(user.OrgID),
+		ActorID:    user.ID,
+		ActorEmail: user.Email,
+		Action:     auditPasswordReset,
+		TargetType: "user",
+		TargetID:   user.ID,
+		Metadata:   map[string]string{"method": "email"},
+	})
+
+	return &userpb.ResetPasswordWithTokenResponse{
+		Message: "Password reset successfully. Sign in with your new password.",
+	}, nil
+}
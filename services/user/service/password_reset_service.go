@@ -4,10 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"gorm.io/gorm"
 
 	"github.com/chanduchitikam/task-management-system/pkg/auth"
 	userpb "github.com/chanduchitikam/task-management-system/proto/user"
@@ -59,15 +60,19 @@ func (s *UserService) SetSecurityQuestions(ctx context.Context, req *userpb.SetS
 		}
 	}
 
-	// Store as JSON
+	// Store as JSON, sealed when encryption is enabled
 	securityJSON, err := json.Marshal(securityQA)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to store security questions")
 	}
+	sealedQA, err := s.secrets.Seal(string(securityJSON), securityQuestionsColumn.Binding(user.ID))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to store security questions")
+	}
 
 	// Build updates map
 	updates := map[string]interface{}{
-		"security_questions": string(securityJSON),
+		"security_questions": sealedQA,
 	}
 
 	// If user has temp password (must_change_password=true), set new permanent password
@@ -109,8 +114,12 @@ func (s *UserService) ResetPassword(ctx context.Context, req *userpb.ResetPasswo
 		return nil, status.Error(codes.NotFound, "user not found")
 	}
 
+	if user.ExternalSource == ldapSource {
+		return nil, status.Error(codes.FailedPrecondition, "password is managed by your organization's directory")
+	}
+
 	// Super admin cannot reset via this method (security requirement)
-	if user.Role == "super_admin" {
+	if authz.IsPlatformAdmin(user.Role) {
 		return nil, status.Error(codes.PermissionDenied, "super admin password reset not allowed via this method")
 	}
 
@@ -129,6 +138,15 @@ func (s *UserService) ResetPassword(ctx context.Context, req *userpb.ResetPasswo
 	if err := s.db.Model(&user).Update("password", hashedPassword).Error; err != nil {
 		return nil, status.Error(codes.Internal, "failed to update password")
 	}
+	s.recordAudit(ctx, auditEvent{
+		OrgID:      getStringValue(user.OrgID),
+		ActorID:    user.ID,
+		ActorEmail: user.Email,
+		Action:     auditPasswordReset,
+		TargetType: "user",
+		TargetID:   user.ID,
+		Metadata:   map[string]string{"method": "old_password"},
+	})
 
 	return &userpb.ResetPasswordResponse{
 		Message: "Password reset successfully",
@@ -153,14 +171,27 @@ func (s *UserService) ResetPasswordWithQuestions(ctx context.Context, req *userp
 		return nil, status.Error(codes.NotFound, "user not found")
 	}
 
+	if user.ExternalSource == ldapSource {
+		return nil, status.Error(codes.FailedPrecondition, "password is managed by your organization's directory")
+	}
+
+	// Failed answers count towards the login lockout
+	if accountLocked(&user, time.Now()) {
+		return nil, accountLockedError(&user)
+	}
+
 	// Check if user has security questions set
 	if user.SecurityQuestions == "" {
 		return nil, status.Error(codes.FailedPrecondition, "no security questions set for this user")
 	}
 
 	// Parse stored security questions
+	plainQA, err := s.secrets.Open(user.SecurityQuestions, securityQuestionsColumn.Binding(user.ID))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to read security questions")
+	}
 	var storedQA []SecurityQuestionAnswer
-	if err := json.Unmarshal([]byte(user.SecurityQuestions), &storedQA); err != nil {
+	if err := json.Unmarshal([]byte(plainQA), &storedQA); err != nil {
 		return nil, status.Error(codes.Internal, "failed to parse security questions")
 	}
 
@@ -178,8 +209,7 @@ func (s *UserService) ResetPasswordWithQuestions(ctx context.Context, req *userp
 
 		// Verify answer
 		if err := auth.CheckPassword(answer, stored.AnswerHash); err != nil {
-			// Increment failed attempts
-			s.db.Model(&user).Update("failed_login_attempts", gorm.Expr("failed_login_attempts + ?", 1))
+			s.recordFailedLogin(ctx, &user)
 			return nil, status.Error(codes.Unauthenticated, "incorrect security answer")
 		}
 	}
@@ -194,12 +224,22 @@ func (s *UserService) ResetPasswordWithQuestions(ctx context.Context, req *userp
 	updates := map[string]interface{}{
 		"password":              hashedPassword,
 		"failed_login_attempts": 0,
+		"locked_until":          nil,
 		"must_change_password":  false,
 	}
 
 	if err := s.db.Model(&user).Updates(updates).Error; err != nil {
 		return nil, status.Error(codes.Internal, "failed to update password")
 	}
+	s.recordAudit(ctx, auditEvent{
+		OrgID:      getStringValue(user.OrgID),
+		ActorID:    user.ID,
+		ActorEmail: user.Email,
+		Action:     auditPasswordReset,
+		TargetType: "user",
+		TargetID:   user.ID,
+		Metadata:   map[string]string{"method": "security_questions"},
+	})
 
 	return &userpb.ResetPasswordWithQuestionsResponse{
 		Message: "Password reset successfully using security questions",
@@ -211,6 +251,9 @@ func (s *UserService) AdminResetPassword(ctx context.Context, req *userpb.AdminR
 	if req.OrgId == "" || req.UserId == "" {
 		return nil, status.Error(codes.InvalidArgument, "org_id and user_id are required")
 	}
+	if !callerCan(ctx, authz.MemberManage, req.OrgId) {
+		return nil, status.Error(codes.PermissionDenied, "access denied")
+	}
 
 	// Get user
 	var user models.User
@@ -223,8 +266,12 @@ func (s *UserService) AdminResetPassword(ctx context.Context, req *userpb.AdminR
 		return nil, status.Error(codes.PermissionDenied, "user does not belong to this organization")
 	}
 
+	if user.ExternalSource == ldapSource {
+		return nil, status.Error(codes.FailedPrecondition, "password is managed by your organization's directory")
+	}
+
 	// Cannot reset super admin password
-	if user.Role == "super_admin" {
+	if authz.IsPlatformAdmin(user.Role) {
 		return nil, status.Error(codes.PermissionDenied, "cannot reset super admin password")
 	}
 
@@ -245,11 +292,19 @@ func (s *UserService) AdminResetPassword(ctx context.Context, req *userpb.AdminR
 		"password":              hashedPassword,
 		"must_change_password":  true,
 		"failed_login_attempts": 0,
+		"locked_until":          nil,
 	}
 
 	if err := s.db.Model(&user).Updates(updates).Error; err != nil {
 		return nil, status.Error(codes.Internal, "failed to reset password")
 	}
+	s.recordAudit(ctx, auditEvent{
+		OrgID:      req.OrgId,
+		Action:     auditPasswordReset,
+		TargetType: "user",
+		TargetID:   user.ID,
+		Metadata:   map[string]string{"method": "admin"},
+	})
 
 	return &userpb.AdminResetPasswordResponse{
 		NewTempPassword: tempPassword,
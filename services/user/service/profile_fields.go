@@ -0,0 +1,308 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
+	userpb "github.com/chanduchitikam/task-management-system/proto/user"
+	"github.com/chanduchitikam/task-management-system/services/user/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/datatypes"
+)
+
+// Profile field types and visibilities
+const (
+	profileFieldText  = "text"
+	profileFieldEmail = "email"
+	profileFieldPhone = "phone"
+	profileFieldURL   = "url"
+	profileFieldSlack = "slack"
+
+	profileVisibleToMembers = "members"
+	profileVisibleToAdmins  = "admins"
+)
+
+const (
+	maxProfileFields        = 25
+	maxProfileLabelLen      = 80
+	maxProfileAttributeLen  = 200
+	profileFieldsSettingKey = "profile_fields"
+)
+
+var (
+	profileFieldKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,39}$`)
+	phonePattern           = regexp.MustCompile(`^\+?[0-9][0-9 ().-]{3,29}$`)
+	slackHandlePattern     = regexp.MustCompile(`^@?[A-Za-z0-9._-]{1,80}$`)
+)
+
+// The field definitions are kept under "profile_fields" in the
+// organization's settings. Values live on the user keyed by field key, so a
+// user in several orgs shares a value between fields with the same key.
+
+// profileField is one stored field definition
+type profileField struct {
+	Key        string `json:"key"`
+	Label      string `json:"label"`
+	Type       string `json:"type"`
+	Visibility string `json:"visibility"`
+}
+
+// profileFields returns the fields orgID defines
+func (s *UserService) profileFields(orgID string) ([]profileField, error) {
+	var org models.Organization
+	if err := s.db.Select("id", "settings").First(&org, "id = ?", orgID).Error; err != nil {
+		return nil, status.Error(codes.NotFound, "organization not found")
+	}
+	var settings struct {
+		ProfileFields []profileField `json:"profile_fields"`
+	}
+	if len(org.Settings) > 0 {
+		_ = json.Unmarshal(org.Settings, &settings)
+	}
+	return settings.ProfileFields, nil
+}
+
+// normalizeProfileFields validates a replacement field list
+func normalizeProfileFields(in []*userpb.ProfileField) ([]profileField, error) {
+	if len(in) > maxProfileFields {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d profile fields", maxProfileFields)
+	}
+	seen := make(map[string]bool, len(in))
+	out := make([]profileField, 0, len(in))
+	for _, f := range in {
+		key := strings.ToLower(strings.TrimSpace(f.Key))
+		if !profileFieldKeyPattern.MatchString(key) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid profile field key %q", f.Key)
+		}
+		if seen[key] {
+			return nil, status.Errorf(codes.InvalidArgument, "duplicate profile field %q", key)
+		}
+		seen[key] = true
+
+		label := strings.TrimSpace(f.Label)
+		if label == "" || utf8.RuneCountInString(label) > maxProfileLabelLen {
+			return nil, status.Errorf(codes.InvalidArgument, "profile field %q needs a label of at most %d characters", key, maxProfileLabelLen)
+		}
+		typ := f.Type
+		if typ == "" {
+			typ = profileFieldText
+		}
+		switch typ {
+		case profileFieldText, profileFieldEmail, profileFieldPhone, profileFieldURL, profileFieldSlack:
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "profile field %q has unknown type %q", key, f.Type)
+		}
+		visibility := f.Visibility
+		if visibility == "" {
+			visibility = profileVisibleToMembers
+		}
+		if visibility != profileVisibleToMembers && visibility != profileVisibleToAdmins {
+			return nil, status.Errorf(codes.InvalidArgument, "profile field %q visibility must be members or admins", key)
+		}
+		out = append(out, profileField{Key: key, Label: label, Type: typ, Visibility: visibility})
+	}
+	return out, nil
+}
+
+// validateProfileValue checks one attribute value against its field's type
+func validateProfileValue(f profileField, value string) error {
+	if utf8.RuneCountInString(value) > maxProfileAttributeLen {
+		return status.Errorf(codes.InvalidArgument, "%s must be at most %d characters", f.Label, maxProfileAttributeLen)
+	}
+	valid := true
+	switch f.Type {
+	case profileFieldEmail:
+		addr, err := mail.ParseAddress(value)
+		valid = err == nil && addr.Address == value
+	case profileFieldPhone:
+		valid = phonePattern.MatchString(value)
+	case profileFieldURL:
+		u, err := url.Parse(value)
+		valid = err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+	case profileFieldSlack:
+		valid = slackHandlePattern.MatchString(value)
+	}
+	if !valid {
+		return status.Errorf(codes.InvalidArgument, "%s is not a valid %s", f.Label, f.Type)
+	}
+	return nil
+}
+
+func profileFieldsToProto(orgID string, fields []profileField) *userpb.ProfileFieldSchema {
+	pb := &userpb.ProfileFieldSchema{OrgId: orgID, Fields: make([]*userpb.ProfileField, len(fields))}
+	for i, f := range fields {
+		pb.Fields[i] = &userpb.ProfileField{Key: f.Key, Label: f.Label, Type: f.Type, Visibility: f.Visibility}
+	}
+	return pb
+}
+
+// decodeProfileAttributes reads a user's stored attribute values
+func decodeProfileAttributes(raw datatypes.JSON) map[string]string {
+	values := map[string]string{}
+	if len(raw) > 0 {
+		_ = json.Unmarshal(raw, &values)
+	}
+	return values
+}
+
+// visibleProfileAttributes returns the user's values for fields, leaving out
+// admin-only fields unless showAdminOnly is set
+func visibleProfileAttributes(user *models.User, fields []profileField, showAdminOnly bool) map[string]string {
+	if len(fields) == 0 || len(user.ProfileAttributes) == 0 {
+		return nil
+	}
+	values := decodeProfileAttributes(user.ProfileAttributes)
+	out := map[string]string{}
+	for _, f := range fields {
+		if f.Visibility == profileVisibleToAdmins && !showAdminOnly {
+			continue
+		}
+		if v, ok := values[f.Key]; ok && v != "" {
+			out[f.Key] = v
+		}
+	}
+	return out
+}
+
+// GetProfileFields returns the extra profile fields an organization defines
+func (s *UserService) GetProfileFields(ctx context.Context, req *userpb.GetProfileFieldsRequest) (*userpb.ProfileFieldSchema, error) {
+	if req.OrgId == "" {
+		return nil, status.Error(codes.InvalidArgument, "org_id is required")
+	}
+	if !callerCan(ctx, authz.MemberView, req.OrgId) {
+		return nil, status.Error(codes.PermissionDenied, "access denied")
+	}
+	fields, err := s.profileFields(req.OrgId)
+	if err != nil {
+		return nil, err
+	}
+	return profileFieldsToProto(req.OrgId, fields), nil
+}
+
+// UpdateProfileFields replaces an organization's extra profile fields.
+// Members keep their values for removed fields, hidden until the field is
+// defined again.
+func (s *UserService) UpdateProfileFields(ctx context.Context, req *userpb.UpdateProfileFieldsRequest) (*userpb.ProfileFieldSchema, error) {
+	if req.OrgId == "" {
+		return nil, status.Error(codes.InvalidArgument, "org_id is required")
+	}
+	if !callerCan(ctx, authz.OrgManage, req.OrgId) {
+		return nil, status.Error(codes.PermissionDenied, "access denied")
+	}
+	fields, err := normalizeProfileFields(req.Fields)
+	if err != nil {
+		return nil, err
+	}
+
+	var org models.Organization
+	if err := s.db.Select("id", "settings").First(&org, "id = ?", req.OrgId).Error; err != nil {
+		return nil, status.Error(codes.NotFound, "organization not found")
+	}
+	settings := map[string]json.RawMessage{}
+	if len(org.Settings) > 0 {
+		_ = json.Unmarshal(org.Settings, &settings)
+	}
+	if len(fields) == 0 {
+		delete(settings, profileFieldsSettingKey)
+	} else {
+		raw, _ := json.Marshal(fields)
+		settings[profileFieldsSettingKey] = raw
+	}
+	encoded, err := json.Marshal(settings)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to encode settings")
+	}
+	if err := s.db.Model(&org).Update("settings", datatypes.JSON(encoded)).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to update profile fields")
+	}
+
+	keys := make([]string, len(fields))
+	for i, f := range fields {
+		keys[i] = f.Key
+	}
+	s.recordAudit(ctx, auditEvent{
+		OrgID:      req.OrgId,
+		Action:     auditProfileFieldsUpdated,
+		TargetType: "organization",
+		TargetID:   req.OrgId,
+		Metadata:   map[string]string{"fields": strings.Join(keys, ",")},
+	})
+	return profileFieldsToProto(req.OrgId, fields), nil
+}
+
+// UpdateProfileAttributes sets a member's values for an organization's
+// profile fields. Members edit their own; member admins edit anyone's.
+func (s *UserService) UpdateProfileAttributes(ctx context.Context, req *userpb.UpdateProfileAttributesRequest) (*userpb.UpdateProfileAttributesResponse, error) {
+	if req.OrgId == "" || req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "org_id and user_id are required")
+	}
+	callerID := getStringFromContext(ctx, "user_id")
+	isAdmin := callerCan(ctx, authz.MemberManage, req.OrgId)
+	if callerID != req.UserId && !isAdmin {
+		return nil, status.Error(codes.PermissionDenied, "access denied")
+	}
+
+	var user models.User
+	if err := s.db.First(&user, "id = ?", req.UserId).Error; err != nil {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if _, ok := s.orgRole(&user, req.OrgId); !ok {
+		return nil, status.Error(codes.NotFound, "user does not belong to this organization")
+	}
+	fields, err := s.profileFields(req.OrgId)
+	if err != nil {
+		return nil, err
+	}
+	byKey := make(map[string]profileField, len(fields))
+	for _, f := range fields {
+		byKey[f.Key] = f
+	}
+
+	values := decodeProfileAttributes(user.ProfileAttributes)
+	changed := make([]string, 0, len(req.Attributes))
+	for key, value := range req.Attributes {
+		f, ok := byKey[key]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown profile field %q", key)
+		}
+		value = strings.TrimSpace(value)
+		if value == "" {
+			delete(values, key)
+		} else {
+			if err := validateProfileValue(f, value); err != nil {
+				return nil, err
+			}
+			values[key] = value
+		}
+		changed = append(changed, key)
+	}
+	sort.Strings(changed)
+
+	encoded, err := json.Marshal(values)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to encode profile")
+	}
+	user.ProfileAttributes = datatypes.JSON(encoded)
+	if err := s.db.Model(&user).Update("profile_attributes", user.ProfileAttributes).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to update profile")
+	}
+	s.recordAudit(ctx, auditEvent{
+		OrgID:      req.OrgId,
+		Action:     auditProfileUpdated,
+		TargetType: "user",
+		TargetID:   user.ID,
+		Metadata:   map[string]string{"fields": strings.Join(changed, ",")},
+	})
+
+	return &userpb.UpdateProfileAttributesResponse{
+		Attributes: visibleProfileAttributes(&user, fields, true),
+	}, nil
+}
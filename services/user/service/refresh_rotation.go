@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/pkg/auth"
+	"github.com/chanduchitikam/task-management-system/pkg/cache"
+	notificationpb "github.com/chanduchitikam/task-management-system/proto/notification"
+	"github.com/chanduchitikam/task-management-system/services/user/models"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// A refresh token presented again this soon after it was rotated is taken
+// for a client refreshing twice at once, not a stolen copy
+const refreshReuseGrace = 10 * time.Second
+
+var (
+	errSessionRevoked   = status.Error(codes.Unauthenticated, "session has been revoked")
+	errRefreshTokenUsed = status.Error(codes.Unauthenticated, "refresh token has already been used")
+)
+
+// Each sign-in starts a family of single-use refresh tokens: refreshing
+// replaces the family's current token with a new one. Presenting a replaced
+// token again means it was copied, so the family is revoked. Every token is
+// stored, so tokens can be revoked one session at a time. Redis, when there
+// is one, tells a client refreshing twice at once from a reused token.
+
+// issueRefreshToken starts a refresh token family for a new session
+func (s *UserService) issueRefreshToken(ctx context.Context, userID, orgID string) (string, error) {
+	familyID := uuid.New().String()
+	token, tokenID, err := s.jwtManager.GenerateSessionRefreshToken(userID, orgID, familyID)
+	if err != nil {
+		return "", err
+	}
+	if err := s.recordRefreshToken(ctx, s.db, userID, orgID, familyID, tokenID, token, time.Now()); err != nil {
+		return "", err
+	}
+	if s.cache != nil {
+		if err := s.cache.StartRefreshFamily(ctx, familyID, tokenID, s.jwtManager.RefreshTokenDuration()); err != nil {
+			log.Printf("warning: failed to record refresh token family for user %s: %v", userID, err)
+		}
+	}
+	return token, nil
+}
+
+// rotateRefreshToken returns the successor of the refresh token presented,
+// whose claims are given, invalidating it
+func (s *UserService) rotateRefreshToken(ctx context.Context, user *models.User, claims *auth.Claims, presented, orgID string) (string, error) {
+	if claims.FamilyID == "" || claims.ID == "" {
+		return s.rotateLegacyRefreshToken(ctx, user, claims, presented, orgID)
+	}
+
+	rec, err := s.findRefreshToken(claims.ID)
+	if err != nil {
+		return "", status.Error(codes.Internal, "failed to check refresh token")
+	}
+	if rec != nil && rec.RevokedAt != nil {
+		if rec.RevokedReason != models.RefreshRevokedRotated {
+			return "", errSessionRevoked
+		}
+		// Redis decides between a concurrent refresh and a reuse below
+		if s.cache == nil {
+			if time.Since(*rec.RevokedAt) > refreshReuseGrace {
+				s.refreshTokenReused(ctx, user, claims.FamilyID)
+				return "", errSessionRevoked
+			}
+			return "", errRefreshTokenUsed
+		}
+	}
+
+	token, tokenID, err := s.jwtManager.GenerateSessionRefreshToken(user.ID, orgID, claims.FamilyID)
+	if err != nil {
+		return "", status.Error(codes.Internal, "failed to generate refresh token")
+	}
+	if s.cache != nil {
+		result, err := s.cache.RotateRefreshToken(ctx, claims.FamilyID, claims.ID, tokenID, refreshReuseGrace, s.jwtManager.RefreshTokenDuration())
+		switch {
+		case err != nil:
+			log.Printf("warning: failed to rotate refresh token for user %s: %v", user.ID, err)
+		case result == cache.RefreshConcurrent:
+			return "", errRefreshTokenUsed
+		case result == cache.RefreshReused:
+			s.refreshTokenReused(ctx, user, claims.FamilyID)
+			return "", errSessionRevoked
+		case result != cache.RefreshRotated:
+			return "", errSessionRevoked
+		}
+	}
+
+	if rec == nil {
+		// tokens issued before they were stored join the store when rotated
+		started := time.Now()
+		if claims.IssuedAt != nil {
+			started = claims.IssuedAt.Time
+		}
+		err = s.recordRefreshToken(ctx, s.db, user.ID, orgID, claims.FamilyID, tokenID, token, started)
+	} else {
+		var replaced bool
+		if replaced, err = s.replaceRefreshToken(ctx, rec, orgID, tokenID, token); err == nil && !replaced {
+			return "", errRefreshTokenUsed
+		}
+	}
+	if err != nil {
+		return "", status.Error(codes.Internal, "failed to store refresh token")
+	}
+	return token, nil
+}
+
+// rotateLegacyRefreshToken starts a family from a refresh token issued
+// before rotation. The presented token is stored as the family's first,
+// already rotated member, so presenting it again is caught as reuse.
+func (s *UserService) rotateLegacyRefreshToken(ctx context.Context, user *models.User, claims *auth.Claims, presented, orgID string) (string, error) {
+	rec, err := s.findRefreshTokenByHash(presented)
+	if err != nil {
+		return "", status.Error(codes.Internal, "failed to check refresh token")
+	}
+	if rec != nil {
+		return "", s.legacyRefreshTokenReused(ctx, user, rec)
+	}
+
+	familyID := uuid.New().String()
+	token, tokenID, err := s.jwtManager.GenerateSessionRefreshToken(user.ID, orgID, familyID)
+	if err != nil {
+		return "", status.Error(codes.Internal, "failed to generate refresh token")
+	}
+	now := time.Now()
+	started, expires := now, now.Add(s.jwtManager.RefreshTokenDuration())
+	if claims.IssuedAt != nil {
+		started = claims.IssuedAt.Time
+	}
+	if claims.ExpiresAt != nil {
+		expires = claims.ExpiresAt.Time
+	}
+	legacy := &models.RefreshToken{
+		ID:               uuid.New().String(),
+		UserID:           user.ID,
+		FamilyID:         familyID,
+		TokenHash:        hashString(presented),
+		SessionStartedAt: started,
+		ExpiresAt:        expires,
+		RevokedAt:        &now,
+		RevokedReason:    models.RefreshRevokedRotated,
+		ReplacedBy:       &tokenID,
+	}
+	if orgID != "" {
+		legacy.OrgID = &orgID
+	}
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(legacy).Error; err != nil {
+			return err
+		}
+		return s.recordRefreshToken(ctx, tx, user.ID, orgID, familyID, tokenID, token, started)
+	})
+	if err != nil {
+		// token hashes are unique, so a concurrent first use fails here
+		if rec, findErr := s.findRefreshTokenByHash(presented); findErr == nil && rec != nil {
+			return "", s.legacyRefreshTokenReused(ctx, user, rec)
+		}
+		return "", status.Error(codes.Internal, "failed to store refresh token")
+	}
+	if s.cache != nil {
+		if err := s.cache.StartRefreshFamily(ctx, familyID, tokenID, s.jwtManager.RefreshTokenDuration()); err != nil {
+			log.Printf("warning: failed to record refresh token family for user %s: %v", user.ID, err)
+		}
+	}
+	return token, nil
+}
+
+// legacyRefreshTokenReused returns why a refresh token issued before rotation
+// and already stored as rec can't be used again, revoking the user's
+// sessions if it was copied
+func (s *UserService) legacyRefreshTokenReused(ctx context.Context, user *models.User, rec *models.RefreshToken) error {
+	if rec.RevokedAt == nil || rec.RevokedReason != models.RefreshRevokedRotated {
+		return errSessionRevoked
+	}
+	if time.Since(*rec.RevokedAt) <= refreshReuseGrace {
+		return errRefreshTokenUsed
+	}
+	s.refreshTokenReused(ctx, user, rec.FamilyID)
+	return errSessionRevoked
+}
+
+// refreshTokenReused ends the user's sessions after a rotated refresh token
+// was presented again, and tells them. Access tokens don't name their
+// family, so every session is revoked, not just the reused one.
+func (s *UserService) refreshTokenReused(ctx context.Context, user *models.User, familyID string) {
+	ip := loginClientIP(ctx)
+	log.Printf("warning: rotated refresh token reused for user %s (family %s) from %s; revoking sessions", user.ID, familyID, ip)
+	s.revokeSessions(ctx, user.ID)
+	s.recordAudit(ctx, auditEvent{
+		OrgID:      getStringValue(user.OrgID),
+		Action:     auditRefreshTokenReused,
+		TargetType: "user",
+		TargetID:   user.ID,
+		Metadata:   map[string]string{"family_id": familyID, "ip": ip, "user_agent": clientUserAgent(ctx)},
+	})
+	if s.cache == nil {
+		return
+	}
+
+	event := &notificationpb.NotificationEvent{
+		NotificationId: uuid.New().String(),
+		UserId:         user.ID,
+		Type:           notificationpb.NotificationType_NOTIFICATION_TYPE_SESSION_REVOKED,
+		Title:          "You were signed out for your security",
+		Message:        "A sign-in session's credentials were used twice, which can mean they were copied from your device. You have been signed out everywhere; sign in again, and change your password if you didn't expect this.",
+		CreatedAt:      timestamppb.Now(),
+		Metadata:       map[string]string{"ip_address": ip},
+	}
+	if err := s.queueNotification(ctx, event); err != nil {
+		log.Printf("failed to send session revoked notice to user %s: %v", user.ID, err)
+	}
+}
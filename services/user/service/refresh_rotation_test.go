@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/pkg/auth"
+	"github.com/chanduchitikam/task-management-system/services/user/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func setupRefreshTest(t *testing.T) (*UserService, *models.User) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.User{}, &models.RefreshToken{}, &models.AuditLog{}))
+
+	user := &models.User{ID: uuid.New().String(), Email: "ada@example.com", Username: "ada", Password: "x", IsActive: true}
+	require.NoError(t, db.Create(user).Error)
+	return NewUserService(db, auth.NewJWTManager("test-secret", time.Hour, 24*time.Hour)), user
+}
+
+// refresh presents token to rotateRefreshToken the way RefreshToken does
+func refresh(s *UserService, user *models.User, token string) (string, error) {
+	claims, err := s.jwtManager.ValidateRefreshToken(token)
+	if err != nil {
+		return "", err
+	}
+	return s.rotateRefreshToken(context.Background(), user, claims, token, claims.OrgID)
+}
+
+// pastGrace backdates every rotation so presenting a rotated token again
+// counts as reuse rather than a concurrent refresh
+func pastGrace(t *testing.T, s *UserService) {
+	require.NoError(t, s.db.Model(&models.RefreshToken{}).Where("revoked_at IS NOT NULL").
+		Update("revoked_at", time.Now().Add(-2*refreshReuseGrace)).Error)
+}
+
+func TestRotateRefreshToken(t *testing.T) {
+	tests := []struct {
+		name  string
+		issue func(t *testing.T, s *UserService, user *models.User) string
+	}{
+		{"session token", func(t *testing.T, s *UserService, user *models.User) string {
+			token, err := s.issueRefreshToken(context.Background(), user.ID, "")
+			require.NoError(t, err)
+			return token
+		}},
+		{"legacy token without a family", func(t *testing.T, s *UserService, user *models.User) string {
+			token, err := s.jwtManager.GenerateRefreshToken(user.ID)
+			require.NoError(t, err)
+			return token
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Run("rotates into a session token", func(t *testing.T) {
+				s, user := setupRefreshTest(t)
+				next, err := refresh(s, user, tt.issue(t, s, user))
+				require.NoError(t, err)
+				claims, err := s.jwtManager.ValidateRefreshToken(next)
+				require.NoError(t, err)
+				assert.NotEmpty(t, claims.FamilyID)
+				assert.NotEmpty(t, claims.ID)
+
+				_, err = refresh(s, user, next)
+				assert.NoError(t, err, "the successor is valid")
+			})
+
+			t.Run("presented again at once", func(t *testing.T) {
+				s, user := setupRefreshTest(t)
+				token := tt.issue(t, s, user)
+				next, err := refresh(s, user, token)
+				require.NoError(t, err)
+
+				_, err = refresh(s, user, token)
+				assert.Equal(t, errRefreshTokenUsed, err)
+				_, err = refresh(s, user, next)
+				assert.NoError(t, err, "a concurrent refresh leaves the session valid")
+			})
+
+			t.Run("replayed", func(t *testing.T) {
+				s, user := setupRefreshTest(t)
+				token := tt.issue(t, s, user)
+				next, err := refresh(s, user, token)
+				require.NoError(t, err)
+				pastGrace(t, s)
+
+				_, err = refresh(s, user, token)
+				assert.Equal(t, errSessionRevoked, err)
+				_, err = refresh(s, user, next)
+				assert.Equal(t, errSessionRevoked, err, "reuse revokes the successor")
+
+				var valid int64
+				require.NoError(t, s.db.Model(&models.RefreshToken{}).Where("revoked_at IS NULL").Count(&valid).Error)
+				assert.Zero(t, valid)
+			})
+
+			t.Run("after the sessions were revoked", func(t *testing.T) {
+				s, user := setupRefreshTest(t)
+				token := tt.issue(t, s, user)
+				next, err := refresh(s, user, token)
+				require.NoError(t, err)
+				_, err = s.revokeRefreshTokens(user.ID, "", models.RefreshRevokedSessions)
+				require.NoError(t, err)
+
+				_, err = refresh(s, user, next)
+				assert.Equal(t, errSessionRevoked, err)
+			})
+		})
+	}
+}
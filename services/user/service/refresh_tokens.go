@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	userpb "github.com/chanduchitikam/task-management-system/proto/user"
+	"github.com/chanduchitikam/task-management-system/services/user/models"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+const (
+	// refreshTokenRetention keeps expired and revoked tokens for auditing
+	refreshTokenRetention       = 90 * 24 * time.Hour
+	refreshTokenCleanupInterval = 24 * time.Hour
+)
+
+// recordRefreshToken stores a newly issued refresh token of familyID, a
+// session which began at started
+func (s *UserService) recordRefreshToken(ctx context.Context, tx *gorm.DB, userID, orgID, familyID, tokenID, token string, started time.Time) error {
+	rec := &models.RefreshToken{
+		ID:               tokenID,
+		UserID:           userID,
+		FamilyID:         familyID,
+		TokenHash:        hashString(token),
+		DeviceID:         deviceFingerprint(ctx),
+		UserAgent:        clientUserAgent(ctx),
+		IPAddress:        loginClientIP(ctx),
+		SessionStartedAt: started,
+		ExpiresAt:        time.Now().Add(s.jwtManager.RefreshTokenDuration()),
+	}
+	if orgID != "" {
+		rec.OrgID = &orgID
+	}
+	return tx.Create(rec).Error
+}
+
+// findRefreshToken loads the stored refresh token tokenID. It returns nil
+// for tokens issued before they were stored.
+func (s *UserService) findRefreshToken(tokenID string) (*models.RefreshToken, error) {
+	var rec models.RefreshToken
+	err := s.db.Where("id = ?", tokenID).Limit(1).Find(&rec).Error
+	if err != nil || rec.ID == "" {
+		return nil, err
+	}
+	return &rec, nil
+}
+
+// findRefreshTokenByHash loads the stored refresh token token, or nil
+func (s *UserService) findRefreshTokenByHash(token string) (*models.RefreshToken, error) {
+	var rec models.RefreshToken
+	err := s.db.Where("token_hash = ?", hashString(token)).Limit(1).Find(&rec).Error
+	if err != nil || rec.ID == "" {
+		return nil, err
+	}
+	return &rec, nil
+}
+
+// replaceRefreshToken marks old as rotated into the token newID and stores
+// that one. It reports false if another request rotated old first.
+func (s *UserService) replaceRefreshToken(ctx context.Context, old *models.RefreshToken, orgID, newID, token string) (bool, error) {
+	replaced := false
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.RefreshToken{}).
+			Where("id = ? AND revoked_at IS NULL", old.ID).
+			Updates(map[string]interface{}{
+				"revoked_at":     time.Now(),
+				"revoked_reason": models.RefreshRevokedRotated,
+				"replaced_by":    newID,
+			})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		replaced = true
+		return s.recordRefreshToken(ctx, tx, old.UserID, orgID, old.FamilyID, newID, token, old.SessionStartedAt)
+	})
+	return replaced, err
+}
+
+// revokeRefreshTokens revokes the user's valid refresh tokens, only those of
+// the session familyID if it is set. It returns how many were revoked.
+func (s *UserService) revokeRefreshTokens(userID, familyID, reason string) (int64, error) {
+	query := s.db.Model(&models.RefreshToken{}).Where("user_id = ? AND revoked_at IS NULL", userID)
+	if familyID != "" {
+		query = query.Where("family_id = ?", familyID)
+	}
+	result := query.Updates(map[string]interface{}{"revoked_at": time.Now(), "revoked_reason": reason})
+	return result.RowsAffected, result.Error
+}
+
+// ListSessions lists the caller's sessions whose refresh token is still
+// valid, most recently refreshed first
+func (s *UserService) ListSessions(ctx context.Context, req *userpb.ListSessionsRequest) (*userpb.ListSessionsResponse, error) {
+	userID := getStringFromContext(ctx, "user_id")
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	var tokens []models.RefreshToken
+	if err := s.db.Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userID, time.Now()).
+		Order("created_at DESC").Find(&tokens).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to list sessions")
+	}
+	resp := &userpb.ListSessionsResponse{Sessions: make([]*userpb.Session, 0, len(tokens))}
+	for i := range tokens {
+		resp.Sessions = append(resp.Sessions, sessionToProto(&tokens[i]))
+	}
+	return resp, nil
+}
+
+// RevokeSession signs one of the caller's sessions out by revoking its
+// refresh token. Access tokens it already holds stay valid until they
+// expire.
+func (s *UserService) RevokeSession(ctx context.Context, req *userpb.RevokeSessionRequest) (*userpb.RevokeSessionResponse, error) {
+	userID := getStringFromContext(ctx, "user_id")
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	if authenticatedByAPIKey(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "api keys cannot manage sessions")
+	}
+	if req.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_id is required")
+	}
+
+	if _, err := uuid.Parse(req.SessionId); err != nil {
+		return nil, status.Error(codes.NotFound, "session not found")
+	}
+
+	revoked, err := s.revokeRefreshTokens(userID, req.SessionId, models.RefreshRevokedSignedOut)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to revoke session")
+	}
+	if revoked == 0 {
+		return nil, status.Error(codes.NotFound, "session not found")
+	}
+	s.recordAudit(ctx, auditEvent{
+		OrgID:      getStringFromContext(ctx, "org_id"),
+		Action:     auditSessionRevoked,
+		TargetType: "session",
+		TargetID:   req.SessionId,
+		Metadata:   map[string]string{"ip": loginClientIP(ctx)},
+	})
+	return &userpb.RevokeSessionResponse{Message: "session revoked"}, nil
+}
+
+// RunRefreshTokenCleanupWorker deletes refresh tokens that expired longer
+// ago than the retention period until ctx is cancelled
+func (s *UserService) RunRefreshTokenCleanupWorker(ctx context.Context) {
+	ticker := time.NewTicker(refreshTokenCleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		result := s.db.Where("expires_at < ?", time.Now().Add(-refreshTokenRetention)).Delete(&models.RefreshToken{})
+		if result.Error != nil {
+			log.Printf("failed to clean up refresh tokens: %v", result.Error)
+		} else if result.RowsAffected > 0 {
+			log.Printf("deleted %d refresh tokens past retention", result.RowsAffected)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func sessionToProto(t *models.RefreshToken) *userpb.Session {
+	return &userpb.Session{
+		SessionId:       t.FamilyID,
+		OrgId:           getStringValue(t.OrgID),
+		DeviceId:        t.DeviceID,
+		UserAgent:       t.UserAgent,
+		IpAddress:       t.IPAddress,
+		SignedInAt:      timestamppb.New(t.SessionStartedAt),
+		LastRefreshedAt: timestamppb.New(t.CreatedAt),
+		ExpiresAt:       timestamppb.New(t.ExpiresAt),
+	}
+}
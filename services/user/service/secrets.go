@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/chanduchitikam/task-management-system/pkg/encryption"
+	"github.com/chanduchitikam/task-management-system/services/user/models"
+)
+
+// Columns sealed with the service's keyring
+var (
+	securityQuestionsColumn = encryption.Column{Table: "users", Column: "security_questions", Scope: "id"}
+	ldapBindPasswordColumn  = encryption.Column{Table: "ldap_configs", Column: "bind_password", Scope: "org_id"}
+)
+
+// SetEncryption enables sealing of security questions and directory bind
+// passwords. Without a keyring they are stored in plaintext.
+func (s *UserService) SetEncryption(k *encryption.Keyring) {
+	s.secrets = k
+}
+
+// ResealSecrets seals sensitive values stored before encryption was enabled
+// or under a previous key
+func (s *UserService) ResealSecrets(ctx context.Context) {
+	for _, c := range []encryption.Column{securityQuestionsColumn, ldapBindPasswordColumn} {
+		n, err := s.secrets.Reseal(ctx, s.db, c)
+		if err != nil {
+			log.Printf("failed to reseal %s.%s: %v", c.Table, c.Column, err)
+			continue
+		}
+		if n > 0 {
+			log.Printf("resealed %d %s.%s values", n, c.Table, c.Column)
+		}
+	}
+}
+
+// directoryConfig returns a copy of cfg with its bind password opened, for
+// connecting to the directory
+func (s *UserService) directoryConfig(cfg *models.LDAPConfig) (*models.LDAPConfig, error) {
+	plain, err := s.secrets.Open(cfg.BindPassword, ldapBindPasswordColumn.Binding(cfg.OrgID))
+	if err != nil {
+		return nil, fmt.Errorf("bind password: %w", err)
+	}
+	out := *cfg
+	out.BindPassword = plain
+	return &out, nil
+}
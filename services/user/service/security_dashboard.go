@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
+	userpb "github.com/chanduchitikam/task-management-system/proto/user"
+	"github.com/chanduchitikam/task-management-system/services/user/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultStaleAfterDays = 90
+	maxStaleAfterDays     = 3650
+)
+
+// GetSecurityDashboard gathers the members and invites of an organization
+// that need an admin's attention
+func (s *UserService) GetSecurityDashboard(ctx context.Context, req *userpb.GetSecurityDashboardRequest) (*userpb.SecurityDashboard, error) {
+	if req.OrgId == "" {
+		return nil, status.Error(codes.InvalidArgument, "org_id is required")
+	}
+	if !callerCan(ctx, authz.MemberManage, req.OrgId) {
+		return nil, status.Error(codes.PermissionDenied, "access denied")
+	}
+	staleDays := req.StaleAfterDays
+	if staleDays <= 0 {
+		staleDays = defaultStaleAfterDays
+	}
+	if staleDays > maxStaleAfterDays {
+		return nil, status.Errorf(codes.InvalidArgument, "stale_after_days must be at most %d", maxStaleAfterDays)
+	}
+
+	now := time.Now()
+	var invites []models.Invite
+	if err := s.db.Where("org_id = ? AND used_at IS NULL AND revoked_at IS NULL AND expires_at > ?", req.OrgId, now).
+		Order("created_at DESC").Find(&invites).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to list invites")
+	}
+	var users []models.User
+	if err := s.db.Scopes(s.inOrg(req.OrgId)).Where("is_service_account = ?", false).Order("email").Find(&users).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to fetch members")
+	}
+	var withPasskey []string
+	s.db.Model(&models.Passkey{}).Distinct("user_id").
+		Where("user_id IN (?)", s.db.Model(&models.User{}).Select("id").Scopes(s.inOrg(req.OrgId))).
+		Pluck("user_id", &withPasskey)
+	hasPasskey := make(map[string]bool, len(withPasskey))
+	for _, id := range withPasskey {
+		hasPasskey[id] = true
+	}
+	roles := s.membershipRoles(req.OrgId)
+	fields, err := s.profileFields(req.OrgId)
+	if err != nil {
+		return nil, err
+	}
+
+	dash := &userpb.SecurityDashboard{
+		OrgId:          req.OrgId,
+		PendingInvites: make([]*userpb.Invite, 0, len(invites)),
+		StaleAfterDays: staleDays,
+		MemberCount:    int32(len(users)),
+		GeneratedAt:    timestamppb.New(now),
+	}
+	for i := range invites {
+		dash.PendingInvites = append(dash.PendingInvites, inviteToProto(&invites[i]))
+	}
+	staleBefore := now.AddDate(0, 0, -int(staleDays))
+	for i := range users {
+		user := &users[i]
+		member := memberToProto(user, req.OrgId, roles, fields)
+		if accountLocked(user, now) {
+			dash.LockedAccounts = append(dash.LockedAccounts, member)
+		}
+		// the remaining lists only concern accounts that can sign in
+		if !user.IsActive || user.SuspendedAt != nil {
+			continue
+		}
+		if !hasPasskey[user.ID] {
+			dash.WithoutMfa = append(dash.WithoutMfa, member)
+		}
+		switch {
+		case !user.HasLoggedIn:
+			dash.NeverLoggedIn = append(dash.NeverLoggedIn, member)
+		case user.LastLogin == nil || user.LastLogin.Before(staleBefore):
+			dash.StaleAccounts = append(dash.StaleAccounts, member)
+		}
+	}
+	return dash, nil
+}
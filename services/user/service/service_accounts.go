@@ -0,0 +1,283 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/pkg/auth"
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
+	userpb "github.com/chanduchitikam/task-management-system/proto/user"
+	"github.com/chanduchitikam/task-management-system/services/user/models"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Service accounts always get the member role; their keys' scopes narrow
+	// that further
+	serviceAccountRole         = "member"
+	serviceAccountEmailDomain  = "service-accounts.invalid"
+	maxRotationGracePeriodMins = 24 * 60
+)
+
+var nonSlugChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// authorizeServiceAccountAdmin checks the caller may manage service accounts
+// in orgID. Keys (including a service account's own) never can.
+func authorizeServiceAccountAdmin(ctx context.Context, orgID string) error {
+	if orgID == "" {
+		return status.Error(codes.InvalidArgument, "org_id is required")
+	}
+	if authenticatedByAPIKey(ctx) {
+		return status.Error(codes.PermissionDenied, "api keys cannot manage service accounts")
+	}
+	if !callerCan(ctx, authz.OrgManage, orgID) {
+		return status.Error(codes.PermissionDenied, "access denied")
+	}
+	return nil
+}
+
+func (s *UserService) findServiceAccount(orgID, id string) (*models.User, error) {
+	if id == "" {
+		return nil, status.Error(codes.InvalidArgument, "service_account_id is required")
+	}
+	var account models.User
+	if err := s.db.First(&account, "id = ? AND org_id = ? AND is_service_account = ?", id, orgID, true).Error; err != nil {
+		return nil, status.Error(codes.NotFound, "service account not found")
+	}
+	return &account, nil
+}
+
+// CreateServiceAccount creates a non-human member of the organization and
+// issues its first API key
+func (s *UserService) CreateServiceAccount(ctx context.Context, req *userpb.CreateServiceAccountRequest) (*userpb.CreateServiceAccountResponse, error) {
+	if err := authorizeServiceAccountAdmin(ctx, req.OrgId); err != nil {
+		return nil, err
+	}
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	scopes, err := normalizeAPIKeyScopes(req.Scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	// the password is random and never shown; Login refuses service accounts
+	secret, err := generateSecureToken(32)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to create service account")
+	}
+	hashed, err := auth.HashPassword(secret)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to create service account")
+	}
+
+	id := uuid.New().String()
+	slug := strings.Trim(nonSlugChars.ReplaceAllString(strings.ToLower(name), "-"), "-")
+	if slug == "" {
+		slug = "bot"
+	}
+	orgID := req.OrgId
+	account := &models.User{
+		ID:               id,
+		Email:            fmt.Sprintf("sa-%s@%s", id, serviceAccountEmailDomain),
+		Username:         fmt.Sprintf("sa-%s-%s", slug, id[:8]),
+		Password:         hashed,
+		FullName:         name,
+		Role:             serviceAccountRole,
+		OrgID:            &orgID,
+		IsActive:         true,
+		IsServiceAccount: true,
+	}
+	if err := s.db.Create(account).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to create service account")
+	}
+
+	key, plain, err := s.issueAPIKey(account, name, scopes, req.ExpiresInDays)
+	if err != nil {
+		s.db.Delete(account)
+		return nil, err
+	}
+	s.recordAudit(ctx, auditEvent{
+		OrgID:      orgID,
+		Action:     auditServiceAccountCreated,
+		TargetType: "service_account",
+		TargetID:   account.ID,
+		Metadata:   map[string]string{"name": name, "scopes": key.Scopes},
+	})
+
+	return &userpb.CreateServiceAccountResponse{
+		ServiceAccount: serviceAccountToProto(account, []models.APIKey{*key}),
+		Key:            plain,
+	}, nil
+}
+
+// ListServiceAccounts returns the organization's service accounts with their keys
+func (s *UserService) ListServiceAccounts(ctx context.Context, req *userpb.ListServiceAccountsRequest) (*userpb.ListServiceAccountsResponse, error) {
+	if err := authorizeServiceAccountAdmin(ctx, req.OrgId); err != nil {
+		return nil, err
+	}
+
+	var accounts []models.User
+	if err := s.db.Where("org_id = ? AND is_service_account = ?", req.OrgId, true).Order("created_at").Find(&accounts).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to list service accounts")
+	}
+	ids := make([]string, len(accounts))
+	for i := range accounts {
+		ids[i] = accounts[i].ID
+	}
+
+	keysByOwner := make(map[string][]models.APIKey)
+	if len(ids) > 0 {
+		var keys []models.APIKey
+		if err := s.db.Where("user_id IN ?", ids).Order("created_at DESC").Find(&keys).Error; err != nil {
+			return nil, status.Error(codes.Internal, "failed to list service account keys")
+		}
+		for _, k := range keys {
+			keysByOwner[k.UserID] = append(keysByOwner[k.UserID], k)
+		}
+	}
+
+	resp := &userpb.ListServiceAccountsResponse{ServiceAccounts: make([]*userpb.ServiceAccount, len(accounts))}
+	for i := range accounts {
+		resp.ServiceAccounts[i] = serviceAccountToProto(&accounts[i], keysByOwner[accounts[i].ID])
+	}
+	return resp, nil
+}
+
+// RotateServiceAccountKey issues a new key and retires the account's current
+// keys, either immediately or after a grace period so deployments can switch
+// over without downtime
+func (s *UserService) RotateServiceAccountKey(ctx context.Context, req *userpb.RotateServiceAccountKeyRequest) (*userpb.RotateServiceAccountKeyResponse, error) {
+	if err := authorizeServiceAccountAdmin(ctx, req.OrgId); err != nil {
+		return nil, err
+	}
+	if req.GracePeriodMinutes < 0 || req.GracePeriodMinutes > maxRotationGracePeriodMins {
+		return nil, status.Errorf(codes.InvalidArgument, "grace_period_minutes must be between 0 and %d", maxRotationGracePeriodMins)
+	}
+	account, err := s.findServiceAccount(req.OrgId, req.ServiceAccountId)
+	if err != nil {
+		return nil, err
+	}
+	if !account.IsActive {
+		return nil, status.Error(codes.FailedPrecondition, "service account is deactivated")
+	}
+
+	now := time.Now()
+	var current []models.APIKey
+	if err := s.db.Where("user_id = ? AND revoked_at IS NULL AND (expires_at IS NULL OR expires_at > ?)", account.ID, now).
+		Order("created_at DESC").Find(&current).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to load service account keys")
+	}
+
+	requested := req.Scopes
+	if len(requested) == 0 && len(current) > 0 {
+		requested = splitList(current[0].Scopes)
+	}
+	scopes, err := normalizeAPIKeyScopes(requested)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(current))
+	for i := range current {
+		ids[i] = current[i].ID
+	}
+	retireOld := func(updates map[string]interface{}) error {
+		if len(ids) == 0 {
+			return nil
+		}
+		if err := s.db.Model(&models.APIKey{}).Where("id IN ?", ids).Updates(updates).Error; err != nil {
+			return status.Error(codes.Internal, "failed to retire old keys")
+		}
+		return nil
+	}
+
+	// without a grace period, revoke before issuing so the old keys do not
+	// count against the key limit
+	if req.GracePeriodMinutes == 0 {
+		if err := retireOld(map[string]interface{}{"revoked_at": now}); err != nil {
+			return nil, err
+		}
+	}
+	key, plain, err := s.issueAPIKey(account, account.FullName, scopes, req.ExpiresInDays)
+	if err != nil {
+		return nil, err
+	}
+	if req.GracePeriodMinutes > 0 {
+		graceEnd := now.Add(time.Duration(req.GracePeriodMinutes) * time.Minute)
+		if err := retireOld(map[string]interface{}{"expires_at": graceEnd}); err != nil {
+			return nil, err
+		}
+	}
+
+	s.recordAudit(ctx, auditEvent{
+		OrgID:      req.OrgId,
+		Action:     auditServiceAccountRotated,
+		TargetType: "service_account",
+		TargetID:   account.ID,
+		Metadata: map[string]string{
+			"api_key_id":   key.ID,
+			"retired_keys": fmt.Sprint(len(ids)),
+			"grace_period": fmt.Sprintf("%dm", req.GracePeriodMinutes),
+		},
+	})
+
+	return &userpb.RotateServiceAccountKeyResponse{
+		ApiKey: apiKeyToProto(key),
+		Key:    plain,
+	}, nil
+}
+
+// DeleteServiceAccount deactivates the account and revokes all of its keys.
+// The account row is kept so tasks it created still resolve.
+func (s *UserService) DeleteServiceAccount(ctx context.Context, req *userpb.DeleteServiceAccountRequest) (*userpb.DeleteServiceAccountResponse, error) {
+	if err := authorizeServiceAccountAdmin(ctx, req.OrgId); err != nil {
+		return nil, err
+	}
+	account, err := s.findServiceAccount(req.OrgId, req.ServiceAccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if err := s.db.Model(&models.APIKey{}).Where("user_id = ? AND revoked_at IS NULL", account.ID).Update("revoked_at", now).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to revoke service account keys")
+	}
+	if err := s.db.Model(account).Updates(map[string]interface{}{"is_active": false, "deactivated_at": now}).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to deactivate service account")
+	}
+	s.recordAudit(ctx, auditEvent{
+		OrgID:      req.OrgId,
+		Action:     auditServiceAccountDeleted,
+		TargetType: "service_account",
+		TargetID:   account.ID,
+		Metadata:   map[string]string{"name": account.FullName},
+	})
+
+	return &userpb.DeleteServiceAccountResponse{
+		Message: fmt.Sprintf("Service account %s deleted", account.FullName),
+	}, nil
+}
+
+func serviceAccountToProto(u *models.User, keys []models.APIKey) *userpb.ServiceAccount {
+	pb := &userpb.ServiceAccount{
+		Id:        u.ID,
+		OrgId:     getStringValue(u.OrgID),
+		Name:      u.FullName,
+		Role:      u.Role,
+		IsActive:  u.IsActive,
+		CreatedAt: timestamppb.New(u.CreatedAt),
+		ApiKeys:   make([]*userpb.APIKey, len(keys)),
+	}
+	for i := range keys {
+		pb.ApiKeys[i] = apiKeyToProto(&keys[i])
+	}
+	return pb
+}
@@ -0,0 +1,448 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
+	userpb "github.com/chanduchitikam/task-management-system/proto/user"
+	"github.com/chanduchitikam/task-management-system/services/user/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultSuperAdminApprovals = 1
+	superAdminChangeTTL        = 72 * time.Hour
+	maxSuperAdminReasonLen     = 500
+	maxSuperAdminChangesListed = 100
+)
+
+// SetSuperAdminApprovals sets how many other super admins must approve a
+// grant or revocation. Fewer are required when fewer exist, so a sole super
+// admin can still appoint a second one.
+func (s *UserService) SetSuperAdminApprovals(n int) {
+	if n < 1 {
+		n = defaultSuperAdminApprovals
+	}
+	s.superAdminApprovals = n
+}
+
+// currentSuperAdmin loads the caller and checks they are still an active
+// super admin; the role in their token may be out of date
+func (s *UserService) currentSuperAdmin(ctx context.Context) (*models.User, error) {
+	if authenticatedByAPIKey(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "api keys cannot manage super admins")
+	}
+	if !authz.IsPlatformAdmin(getStringFromContext(ctx, "role")) {
+		return nil, status.Error(codes.PermissionDenied, "super admin access required")
+	}
+	var caller models.User
+	if err := s.db.First(&caller, "id = ?", getStringFromContext(ctx, "user_id")).Error; err != nil {
+		return nil, status.Error(codes.PermissionDenied, "super admin access required")
+	}
+	if caller.Role != authz.RoleSuperAdmin || !caller.IsActive || caller.SuspendedAt != nil {
+		return nil, status.Error(codes.PermissionDenied, "super admin access required")
+	}
+	return &caller, nil
+}
+
+// requiredSuperAdminApprovals is the configured quorum, capped at the number
+// of active super admins other than the requester and the target
+func (s *UserService) requiredSuperAdminApprovals(requesterID, targetID string) (int, error) {
+	var others int64
+	if err := s.db.Model(&models.User{}).
+		Where("role = ? AND is_active = ? AND suspended_at IS NULL AND id NOT IN ?", authz.RoleSuperAdmin, true, []string{requesterID, targetID}).
+		Count(&others).Error; err != nil {
+		return 0, err
+	}
+	quorum := s.superAdminApprovals
+	if quorum < 1 {
+		quorum = defaultSuperAdminApprovals
+	}
+	if int(others) < quorum {
+		return int(others), nil
+	}
+	return quorum, nil
+}
+
+// ListSuperAdmins returns every super admin
+func (s *UserService) ListSuperAdmins(ctx context.Context, req *userpb.ListSuperAdminsRequest) (*userpb.ListSuperAdminsResponse, error) {
+	if _, err := s.currentSuperAdmin(ctx); err != nil {
+		return nil, err
+	}
+	var users []models.User
+	if err := s.db.Where("role = ?", authz.RoleSuperAdmin).Order("created_at").Find(&users).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to list super admins")
+	}
+	resp := &userpb.ListSuperAdminsResponse{Users: make([]*userpb.User, len(users))}
+	for i := range users {
+		resp.Users[i] = s.modelToProto(&users[i])
+	}
+	return resp, nil
+}
+
+// GrantSuperAdmin proposes making a user super admin
+func (s *UserService) GrantSuperAdmin(ctx context.Context, req *userpb.GrantSuperAdminRequest) (*userpb.SuperAdminChangeResponse, error) {
+	caller, err := s.currentSuperAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	target, err := s.superAdminTarget(req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if target.Role == authz.RoleSuperAdmin {
+		return nil, status.Error(codes.AlreadyExists, "user is already a super admin")
+	}
+	if !target.IsActive || target.SuspendedAt != nil || target.IsServiceAccount {
+		return nil, status.Error(codes.FailedPrecondition, "only active, unsuspended user accounts can become super admins")
+	}
+	return s.proposeSuperAdminChange(ctx, caller, target, models.SuperAdminGrant, req.Reason)
+}
+
+// RevokeSuperAdmin proposes removing a user's super admin role
+func (s *UserService) RevokeSuperAdmin(ctx context.Context, req *userpb.RevokeSuperAdminRequest) (*userpb.SuperAdminChangeResponse, error) {
+	caller, err := s.currentSuperAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	target, err := s.superAdminTarget(req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if target.Role != authz.RoleSuperAdmin {
+		return nil, status.Error(codes.FailedPrecondition, "user is not a super admin")
+	}
+	if err := s.ensureAnotherSuperAdmin(s.db, target.ID); err != nil {
+		return nil, err
+	}
+	return s.proposeSuperAdminChange(ctx, caller, target, models.SuperAdminRevoke, req.Reason)
+}
+
+func (s *UserService) superAdminTarget(userID string) (*models.User, error) {
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	var target models.User
+	if err := s.db.First(&target, "id = ?", userID).Error; err != nil {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	return &target, nil
+}
+
+// ensureAnotherSuperAdmin refuses to leave the platform without an active
+// super admin
+func (s *UserService) ensureAnotherSuperAdmin(db *gorm.DB, userID string) error {
+	var others int64
+	if err := db.Model(&models.User{}).
+		Where("role = ? AND is_active = ? AND suspended_at IS NULL AND id <> ?", authz.RoleSuperAdmin, true, userID).
+		Count(&others).Error; err != nil {
+		return status.Error(codes.Internal, "failed to count super admins")
+	}
+	if others == 0 {
+		return status.Error(codes.FailedPrecondition, "cannot revoke the last active super admin")
+	}
+	return nil
+}
+
+func (s *UserService) proposeSuperAdminChange(ctx context.Context, caller, target *models.User, action, reason string) (*userpb.SuperAdminChangeResponse, error) {
+	reason = strings.TrimSpace(reason)
+	if len(reason) > maxSuperAdminReasonLen {
+		return nil, status.Errorf(codes.InvalidArgument, "reason must be at most %d characters", maxSuperAdminReasonLen)
+	}
+
+	s.expireSuperAdminChanges()
+	var pending models.SuperAdminChange
+	if err := s.db.Where("user_id = ? AND status = ?", target.ID, models.SuperAdminChangePending).First(&pending).Error; err == nil {
+		return nil, status.Errorf(codes.AlreadyExists, "a %s for this user is already awaiting approval", pending.Action)
+	}
+
+	required, err := s.requiredSuperAdminApprovals(caller.ID, target.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to count super admins")
+	}
+	change := &models.SuperAdminChange{
+		Action:            action,
+		UserID:            target.ID,
+		UserEmail:         target.Email,
+		RequestedBy:       caller.ID,
+		Reason:            reason,
+		Status:            models.SuperAdminChangePending,
+		RequiredApprovals: required,
+		ExpiresAt:         time.Now().Add(superAdminChangeTTL),
+	}
+	if action == models.SuperAdminGrant {
+		change.PreviousRole = target.Role
+	}
+	if err := s.db.Create(change).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to record super admin change")
+	}
+	s.recordAudit(ctx, auditEvent{
+		Action:     auditSuperAdminRequested,
+		TargetType: "user",
+		TargetID:   target.ID,
+		Metadata:   map[string]string{"action": action, "change_id": change.ID, "required_approvals": fmt.Sprint(required)},
+	})
+
+	if required == 0 {
+		if err := s.applySuperAdminChange(ctx, change, caller.ID); err != nil {
+			return nil, err
+		}
+		return &userpb.SuperAdminChangeResponse{Change: superAdminChangeToProto(change), Message: "change applied"}, nil
+	}
+	return &userpb.SuperAdminChangeResponse{
+		Change:  superAdminChangeToProto(change),
+		Message: fmt.Sprintf("change awaiting approval by %d other super admin(s)", required),
+	}, nil
+}
+
+// ListSuperAdminChanges returns proposed changes, newest first
+func (s *UserService) ListSuperAdminChanges(ctx context.Context, req *userpb.ListSuperAdminChangesRequest) (*userpb.ListSuperAdminChangesResponse, error) {
+	if _, err := s.currentSuperAdmin(ctx); err != nil {
+		return nil, err
+	}
+	s.expireSuperAdminChanges()
+
+	query := s.db.Order("created_at DESC").Limit(maxSuperAdminChangesListed)
+	if req.Status != "" {
+		query = query.Where("status = ?", req.Status)
+	}
+	var changes []models.SuperAdminChange
+	if err := query.Find(&changes).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to list super admin changes")
+	}
+	resp := &userpb.ListSuperAdminChangesResponse{Changes: make([]*userpb.SuperAdminChange, len(changes))}
+	for i := range changes {
+		resp.Changes[i] = superAdminChangeToProto(&changes[i])
+	}
+	return resp, nil
+}
+
+// ApproveSuperAdminChange records the caller's approval and applies the
+// change once it has enough
+func (s *UserService) ApproveSuperAdminChange(ctx context.Context, req *userpb.ApproveSuperAdminChangeRequest) (*userpb.SuperAdminChangeResponse, error) {
+	caller, err := s.currentSuperAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	change, err := s.pendingSuperAdminChange(req.ChangeId)
+	if err != nil {
+		return nil, err
+	}
+	if change.RequestedBy == caller.ID {
+		return nil, status.Error(codes.FailedPrecondition, "a change must be approved by someone other than its requester")
+	}
+	if change.UserID == caller.ID {
+		return nil, status.Error(codes.FailedPrecondition, "you cannot approve a change to your own role")
+	}
+	approvals := splitList(change.ApprovedBy)
+	for _, id := range approvals {
+		if id == caller.ID {
+			return nil, status.Error(codes.AlreadyExists, "you have already approved this change")
+		}
+	}
+	approvals = append(approvals, caller.ID)
+
+	// guard against a concurrent approval overwriting this one
+	result := s.db.Model(&models.SuperAdminChange{}).
+		Where("id = ? AND status = ? AND approved_by = ?", change.ID, models.SuperAdminChangePending, change.ApprovedBy).
+		Update("approved_by", strings.Join(approvals, ","))
+	if result.Error != nil {
+		return nil, status.Error(codes.Internal, "failed to record approval")
+	}
+	if result.RowsAffected == 0 {
+		return nil, status.Error(codes.Aborted, "change was updated concurrently; retry")
+	}
+	change.ApprovedBy = strings.Join(approvals, ",")
+	s.recordAudit(ctx, auditEvent{
+		Action:     auditSuperAdminApproved,
+		TargetType: "user",
+		TargetID:   change.UserID,
+		Metadata:   map[string]string{"action": change.Action, "change_id": change.ID},
+	})
+
+	if len(approvals) < change.RequiredApprovals {
+		return &userpb.SuperAdminChangeResponse{
+			Change:  superAdminChangeToProto(change),
+			Message: fmt.Sprintf("approval recorded; %d more required", change.RequiredApprovals-len(approvals)),
+		}, nil
+	}
+	if err := s.applySuperAdminChange(ctx, change, caller.ID); err != nil {
+		return nil, err
+	}
+	return &userpb.SuperAdminChangeResponse{Change: superAdminChangeToProto(change), Message: "change applied"}, nil
+}
+
+// RejectSuperAdminChange rejects a pending change. Its requester may use it
+// to withdraw the change.
+func (s *UserService) RejectSuperAdminChange(ctx context.Context, req *userpb.RejectSuperAdminChangeRequest) (*userpb.SuperAdminChangeResponse, error) {
+	caller, err := s.currentSuperAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	change, err := s.pendingSuperAdminChange(req.ChangeId)
+	if err != nil {
+		return nil, err
+	}
+	reason := strings.TrimSpace(req.Reason)
+	if len(reason) > maxSuperAdminReasonLen {
+		return nil, status.Errorf(codes.InvalidArgument, "reason must be at most %d characters", maxSuperAdminReasonLen)
+	}
+
+	now := time.Now()
+	result := s.db.Model(&models.SuperAdminChange{}).
+		Where("id = ? AND status = ?", change.ID, models.SuperAdminChangePending).
+		Updates(map[string]interface{}{
+			"status":          models.SuperAdminChangeRejected,
+			"decided_by":      caller.ID,
+			"decision_reason": reason,
+			"decided_at":      now,
+		})
+	if result.Error != nil {
+		return nil, status.Error(codes.Internal, "failed to reject change")
+	}
+	if result.RowsAffected == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "change is no longer pending")
+	}
+	change.Status, change.DecidedBy, change.DecisionReason, change.DecidedAt = models.SuperAdminChangeRejected, caller.ID, reason, &now
+	s.recordAudit(ctx, auditEvent{
+		Action:     auditSuperAdminRejected,
+		TargetType: "user",
+		TargetID:   change.UserID,
+		Metadata:   map[string]string{"action": change.Action, "change_id": change.ID},
+	})
+	return &userpb.SuperAdminChangeResponse{Change: superAdminChangeToProto(change), Message: "change rejected"}, nil
+}
+
+func (s *UserService) pendingSuperAdminChange(id string) (*models.SuperAdminChange, error) {
+	if id == "" {
+		return nil, status.Error(codes.InvalidArgument, "change_id is required")
+	}
+	s.expireSuperAdminChanges()
+	var change models.SuperAdminChange
+	if err := s.db.First(&change, "id = ?", id).Error; err != nil {
+		return nil, status.Error(codes.NotFound, "super admin change not found")
+	}
+	if change.Status != models.SuperAdminChangePending {
+		return nil, status.Errorf(codes.FailedPrecondition, "change is %s", change.Status)
+	}
+	return &change, nil
+}
+
+// expireSuperAdminChanges marks pending changes past their deadline expired
+func (s *UserService) expireSuperAdminChanges() {
+	s.db.Model(&models.SuperAdminChange{}).
+		Where("status = ? AND expires_at < ?", models.SuperAdminChangePending, time.Now()).
+		Update("status", models.SuperAdminChangeExpired)
+}
+
+// applySuperAdminChange changes the target's role. Eligibility is checked
+// again because it may have changed while the change awaited approval.
+func (s *UserService) applySuperAdminChange(ctx context.Context, change *models.SuperAdminChange, deciderID string) error {
+	now := time.Now()
+	var newRole string
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var target models.User
+		if err := tx.First(&target, "id = ?", change.UserID).Error; err != nil {
+			return status.Error(codes.NotFound, "user not found")
+		}
+		switch change.Action {
+		case models.SuperAdminGrant:
+			if target.Role == authz.RoleSuperAdmin {
+				return status.Error(codes.AlreadyExists, "user is already a super admin")
+			}
+			if !target.IsActive || target.SuspendedAt != nil || target.IsServiceAccount {
+				return status.Error(codes.FailedPrecondition, "user is no longer eligible to become a super admin")
+			}
+			newRole = authz.RoleSuperAdmin
+		case models.SuperAdminRevoke:
+			if target.Role != authz.RoleSuperAdmin {
+				return status.Error(codes.FailedPrecondition, "user is no longer a super admin")
+			}
+			if err := s.ensureAnotherSuperAdmin(tx, target.ID); err != nil {
+				return err
+			}
+			newRole = s.roleBeforeSuperAdmin(tx, &target)
+		default:
+			return status.Errorf(codes.Internal, "unknown super admin change %q", change.Action)
+		}
+
+		if err := tx.Model(&target).Update("role", newRole).Error; err != nil {
+			return status.Error(codes.Internal, "failed to update role")
+		}
+		result := tx.Model(&models.SuperAdminChange{}).
+			Where("id = ? AND status = ?", change.ID, models.SuperAdminChangePending).
+			Updates(map[string]interface{}{
+				"status":     models.SuperAdminChangeApplied,
+				"decided_by": deciderID,
+				"decided_at": now,
+			})
+		if result.Error != nil {
+			return status.Error(codes.Internal, "failed to update change")
+		}
+		if result.RowsAffected == 0 {
+			return status.Error(codes.FailedPrecondition, "change is no longer pending")
+		}
+		return nil
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		return status.Error(codes.Internal, "failed to apply change")
+	}
+
+	change.Status, change.DecidedBy, change.DecidedAt = models.SuperAdminChangeApplied, deciderID, &now
+	// the old role lives on in the target's tokens
+	s.revokeSessions(ctx, change.UserID)
+	action := auditSuperAdminGranted
+	if change.Action == models.SuperAdminRevoke {
+		action = auditSuperAdminRevoked
+	}
+	s.recordAudit(ctx, auditEvent{
+		Action:     action,
+		TargetType: "user",
+		TargetID:   change.UserID,
+		Metadata:   map[string]string{"change_id": change.ID, "role": newRole, "approved_by": change.ApprovedBy},
+	})
+	return nil
+}
+
+// roleBeforeSuperAdmin is the role recorded when the user was granted super
+// admin, or the default role for their membership
+func (s *UserService) roleBeforeSuperAdmin(tx *gorm.DB, user *models.User) string {
+	var grant models.SuperAdminChange
+	err := tx.Where("user_id = ? AND action = ? AND status = ?", user.ID, models.SuperAdminGrant, models.SuperAdminChangeApplied).
+		Order("decided_at DESC").First(&grant).Error
+	if err == nil && authz.Assignable(grant.PreviousRole) {
+		return grant.PreviousRole
+	}
+	return authz.RoleMember
+}
+
+func superAdminChangeToProto(c *models.SuperAdminChange) *userpb.SuperAdminChange {
+	pb := &userpb.SuperAdminChange{
+		Id:                c.ID,
+		Action:            c.Action,
+		UserId:            c.UserID,
+		UserEmail:         c.UserEmail,
+		RequestedBy:       c.RequestedBy,
+		Reason:            c.Reason,
+		Status:            c.Status,
+		ApprovedBy:        splitList(c.ApprovedBy),
+		RequiredApprovals: int32(c.RequiredApprovals),
+		DecidedBy:         c.DecidedBy,
+		DecisionReason:    c.DecisionReason,
+		CreatedAt:         timestamppb.New(c.CreatedAt),
+		ExpiresAt:         timestamppb.New(c.ExpiresAt),
+	}
+	if c.DecidedAt != nil {
+		pb.DecidedAt = timestamppb.New(*c.DecidedAt)
+	}
+	return pb
+}
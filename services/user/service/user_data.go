@@ -0,0 +1,510 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
+	"github.com/chanduchitikam/task-management-system/pkg/cache"
+	notificationpb "github.com/chanduchitikam/task-management-system/proto/notification"
+	taskpb "github.com/chanduchitikam/task-management-system/proto/task"
+	userpb "github.com/chanduchitikam/task-management-system/proto/user"
+	"github.com/chanduchitikam/task-management-system/services/user/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+const (
+	eraseModeAnonymize = "anonymize"
+	eraseModeDelete    = "delete"
+
+	erasurePollInterval = 30 * time.Second
+	erasureClaimLease   = 10 * time.Minute
+	erasureBaseBackoff  = time.Minute
+	erasureMaxBackoff   = time.Hour
+	maxErasureAttempts  = 8
+	erasureCallTimeout  = 30 * time.Second
+)
+
+// erasureDueStatuses are the statuses the worker starts once next_attempt_at
+// has passed
+var erasureDueStatuses = []string{models.ErasurePending, models.ErasureScheduled}
+
+// SetDataClients enables GDPR export and erasure, which gather and erase
+// data held by the task and notification services
+func (s *UserService) SetDataClients(tasks taskpb.TaskServiceClient, notifications notificationpb.NotificationServiceClient) {
+	s.taskClient = tasks
+	s.notificationClient = notifications
+}
+
+// userProfileExport is the user service's part of a data export
+type userProfileExport struct {
+	ID                string          `json:"id"`
+	Email             string          `json:"email"`
+	Username          string          `json:"username"`
+	FullName          string          `json:"full_name"`
+	Role              string          `json:"role"`
+	OrgID             *string         `json:"org_id,omitempty"`
+	IsActive          bool            `json:"is_active"`
+	IsServiceAccount  bool            `json:"is_service_account"`
+	ExternalSource    string          `json:"external_source,omitempty"`
+	LastLogin         *time.Time      `json:"last_login,omitempty"`
+	SuspendedAt       *time.Time      `json:"suspended_at,omitempty"`
+	ProfileAttributes json.RawMessage `json:"profile_attributes,omitempty"`
+	CreatedAt         time.Time       `json:"created_at"`
+	UpdatedAt         time.Time       `json:"updated_at"`
+}
+
+// ExportUserData bundles everything the platform stores about a user. Users
+// export themselves; org admins may export their members.
+func (s *UserService) ExportUserData(ctx context.Context, req *userpb.ExportUserDataRequest) (*userpb.ExportUserDataResponse, error) {
+	if authenticatedByAPIKey(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "api keys cannot export user data")
+	}
+	format := req.Format
+	if format == "" {
+		format = "json"
+	}
+	if format != "json" && format != "zip" {
+		return nil, status.Error(codes.InvalidArgument, "format must be json or zip")
+	}
+	if s.taskClient == nil || s.notificationClient == nil {
+		return nil, status.Error(codes.Unavailable, "data export is not configured")
+	}
+
+	var user *models.User
+	if req.UserId == "" {
+		callerID := getStringFromContext(ctx, "user_id")
+		if callerID == "" {
+			return nil, status.Error(codes.Unauthenticated, "authentication required")
+		}
+		var self models.User
+		if err := s.db.First(&self, "id = ?", callerID).Error; err != nil {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		user = &self
+	} else {
+		member, err := s.findManagedMember(ctx, req.OrgId, req.UserId)
+		if err != nil {
+			return nil, err
+		}
+		user = member
+	}
+
+	var keys []models.APIKey
+	if err := s.db.Where("user_id = ?", user.ID).Order("created_at").Find(&keys).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to export api keys")
+	}
+	var passkeys []models.Passkey
+	if err := s.db.Where("user_id = ?", user.ID).Order("created_at").Find(&passkeys).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to export passkeys")
+	}
+	var logins []models.LoginEvent
+	if err := s.db.Where("user_id = ?", user.ID).Order("created_at").Find(&logins).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to export login history")
+	}
+	var events []models.AuditLog
+	if err := s.db.Where("actor_id = ? OR target_id = ?", user.ID, user.ID).Order("created_at").Find(&events).Error; err != nil {
+		return nil, status.Error(codes.Internal, "failed to export audit events")
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, erasureCallTimeout)
+	defer cancel()
+	tasks, err := s.taskClient.ExportUserData(callCtx, &taskpb.ExportUserDataRequest{UserId: user.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to export task data: %v", err)
+	}
+	notifications, err := s.notificationClient.ExportUserData(callCtx, &notificationpb.ExportUserDataRequest{UserId: user.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to export notification data: %v", err)
+	}
+
+	profile := userProfileExport{
+		ID:                user.ID,
+		Email:             user.Email,
+		Username:          user.Username,
+		FullName:          user.FullName,
+		Role:              user.Role,
+		OrgID:             user.OrgID,
+		IsActive:          user.IsActive,
+		IsServiceAccount:  user.IsServiceAccount,
+		ExternalSource:    user.ExternalSource,
+		LastLogin:         user.LastLogin,
+		SuspendedAt:       user.SuspendedAt,
+		ProfileAttributes: json.RawMessage(user.ProfileAttributes),
+		CreatedAt:         user.CreatedAt,
+		UpdatedAt:         user.UpdatedAt,
+	}
+	now := time.Now().UTC()
+	sections := []struct {
+		name string
+		data interface{}
+	}{
+		{"profile", profile},
+		{"api_keys", keys},
+		{"passkeys", passkeys},
+		{"login_history", logins},
+		{"audit_events", events},
+		{"tasks", json.RawMessage(tasks.Data)},
+		{"notifications", json.RawMessage(notifications.Data)},
+	}
+
+	base := fmt.Sprintf("taskflow-export-%s-%s", user.Username, now.Format("20060102"))
+	resp := &userpb.ExportUserDataResponse{}
+	if format == "zip" {
+		var buf bytes.Buffer
+		zw := zip.NewWriter(&buf)
+		for _, sec := range sections {
+			w, err := zw.CreateHeader(&zip.FileHeader{Name: sec.name + ".json", Method: zip.Deflate, Modified: now})
+			if err != nil {
+				return nil, status.Error(codes.Internal, "failed to build export")
+			}
+			enc := json.NewEncoder(w)
+			enc.SetIndent("", "  ")
+			if err := enc.Encode(sec.data); err != nil {
+				return nil, status.Error(codes.Internal, "failed to build export")
+			}
+		}
+		if err := zw.Close(); err != nil {
+			return nil, status.Error(codes.Internal, "failed to build export")
+		}
+		resp.Filename, resp.ContentType, resp.Data = base+".zip", "application/zip", buf.Bytes()
+	} else {
+		doc := map[string]interface{}{"exported_at": now}
+		for _, sec := range sections {
+			doc[sec.name] = sec.data
+		}
+		data, err := json.MarshalIndent(doc, "", "  ")
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to build export")
+		}
+		resp.Filename, resp.ContentType, resp.Data = base+".json", "application/json", data
+	}
+
+	s.recordAudit(ctx, auditEvent{
+		OrgID:      getStringValue(user.OrgID),
+		Action:     auditDataExported,
+		TargetType: "user",
+		TargetID:   user.ID,
+		Metadata:   map[string]string{"format": format},
+	})
+	return resp, nil
+}
+
+// RequestDataErasure deactivates the user immediately and queues erasure of
+// their data in every service. Progress is reported by GetDataErasureRequest.
+func (s *UserService) RequestDataErasure(ctx context.Context, req *userpb.RequestDataErasureRequest) (*userpb.RequestDataErasureResponse, error) {
+	if authenticatedByAPIKey(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "api keys cannot erase users")
+	}
+	mode := req.Mode
+	if mode == "" {
+		mode = eraseModeAnonymize
+	}
+	if mode != eraseModeAnonymize && mode != eraseModeDelete {
+		return nil, status.Error(codes.InvalidArgument, "mode must be anonymize or delete")
+	}
+	if s.taskClient == nil || s.notificationClient == nil {
+		return nil, status.Error(codes.Unavailable, "data erasure is not configured")
+	}
+
+	user, err := s.findManagedMember(ctx, req.OrgId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	callerID := getStringFromContext(ctx, "user_id")
+	if user.ID == callerID {
+		return nil, status.Error(codes.FailedPrecondition, "you cannot erase your own account here")
+	}
+	if !authz.CanGrant(getStringFromContext(ctx, "role"), user.Role) {
+		return nil, status.Error(codes.PermissionDenied, "not allowed to erase this user")
+	}
+
+	var existing models.DataErasureRequest
+	err = s.db.Where("user_id = ? AND status IN ?", user.ID, []string{models.ErasurePending, models.ErasureRunning}).First(&existing).Error
+	if err == nil {
+		return &userpb.RequestDataErasureResponse{Request: erasureToProto(&existing)}, nil
+	}
+
+	now := time.Now()
+	erasure := &models.DataErasureRequest{
+		UserID:        user.ID,
+		OrgID:         user.OrgID,
+		RequestedBy:   callerID,
+		Mode:          mode,
+		Status:        models.ErasurePending,
+		NextAttemptAt: now,
+	}
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		// an admin erasure supersedes the user's own scheduled deletion
+		if err := tx.Model(&models.DataErasureRequest{}).
+			Where("user_id = ? AND status = ?", user.ID, models.ErasureScheduled).
+			Update("status", models.ErasureCancelled).Error; err != nil {
+			return err
+		}
+		if err := tx.Create(erasure).Error; err != nil {
+			return err
+		}
+		return tx.Model(user).Updates(map[string]interface{}{"is_active": false, "deactivated_at": now}).Error
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to schedule erasure")
+	}
+	s.revokeSessions(ctx, user.ID)
+	s.recordAudit(ctx, auditEvent{
+		OrgID:      req.OrgId,
+		Action:     auditErasureRequested,
+		TargetType: "user",
+		TargetID:   user.ID,
+		Metadata:   map[string]string{"mode": mode, "request_id": erasure.ID},
+	})
+
+	go s.processErasure(context.Background(), erasure.ID)
+
+	return &userpb.RequestDataErasureResponse{Request: erasureToProto(erasure)}, nil
+}
+
+// GetDataErasureRequest reports the progress of an erasure
+func (s *UserService) GetDataErasureRequest(ctx context.Context, req *userpb.GetDataErasureRequestRequest) (*userpb.GetDataErasureRequestResponse, error) {
+	if req.OrgId == "" || req.RequestId == "" {
+		return nil, status.Error(codes.InvalidArgument, "org_id and request_id are required")
+	}
+	if !callerCan(ctx, authz.MemberManage, req.OrgId) {
+		return nil, status.Error(codes.PermissionDenied, "access denied")
+	}
+
+	var erasure models.DataErasureRequest
+	if err := s.db.First(&erasure, "id = ? AND org_id = ?", req.RequestId, req.OrgId).Error; err != nil {
+		return nil, status.Error(codes.NotFound, "erasure request not found")
+	}
+	return &userpb.GetDataErasureRequestResponse{Request: erasureToProto(&erasure)}, nil
+}
+
+// RunErasureWorker retries pending erasures and starts scheduled ones whose
+// grace period has ended, until ctx is cancelled
+func (s *UserService) RunErasureWorker(ctx context.Context) {
+	ticker := time.NewTicker(erasurePollInterval)
+	defer ticker.Stop()
+
+	log.Println("data erasure worker started")
+	for {
+		var due []models.DataErasureRequest
+		now := time.Now()
+		err := s.db.Select("id").
+			Where("(status IN ? AND next_attempt_at <= ?) OR (status = ? AND claimed_at < ?)",
+				erasureDueStatuses, now, models.ErasureRunning, now.Add(-erasureClaimLease)).
+			Limit(10).Find(&due).Error
+		if err != nil {
+			log.Printf("failed to load erasure requests: %v", err)
+		}
+		for _, r := range due {
+			s.processErasure(ctx, r.ID)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// processErasure claims one erasure and runs its remaining steps. Every step
+// is idempotent, so a retry after a partial failure is safe.
+func (s *UserService) processErasure(ctx context.Context, id string) {
+	now := time.Now()
+	claim := s.db.Model(&models.DataErasureRequest{}).
+		Where("id = ? AND ((status IN ? AND next_attempt_at <= ?) OR (status = ? AND claimed_at < ?))",
+			id, erasureDueStatuses, now, models.ErasureRunning, now.Add(-erasureClaimLease)).
+		Updates(map[string]interface{}{
+			"status":     models.ErasureRunning,
+			"claimed_at": now,
+			"attempts":   gorm.Expr("attempts + ?", 1),
+		})
+	if claim.Error != nil || claim.RowsAffected == 0 {
+		return
+	}
+
+	var r models.DataErasureRequest
+	if err := s.db.First(&r, "id = ?", id).Error; err != nil {
+		log.Printf("failed to load erasure request %s: %v", id, err)
+		return
+	}
+	// scheduled deletions leave the account usable until they start
+	if err := s.db.Model(&models.User{}).Where("id = ? AND is_active = ?", r.UserID, true).
+		Updates(map[string]interface{}{"is_active": false, "deactivated_at": now}).Error; err != nil {
+		log.Printf("failed to deactivate user %s for erasure %s: %v", r.UserID, r.ID, err)
+	}
+
+	if err := s.runErasureSteps(ctx, &r); err != nil {
+		updates := map[string]interface{}{"last_error": err.Error(), "claimed_at": nil}
+		if r.Attempts >= maxErasureAttempts {
+			updates["status"] = models.ErasureFailed
+			log.Printf("erasure %s for user %s failed permanently: %v", r.ID, r.UserID, err)
+		} else {
+			updates["status"] = models.ErasurePending
+			updates["next_attempt_at"] = time.Now().Add(erasureBackoff(r.Attempts))
+		}
+		if err := s.db.Model(&r).Updates(updates).Error; err != nil {
+			log.Printf("failed to record erasure %s failure: %v", r.ID, err)
+		}
+		return
+	}
+
+	done := time.Now()
+	if err := s.db.Model(&r).Updates(map[string]interface{}{
+		"status":       models.ErasureCompleted,
+		"completed_at": done,
+		"last_error":   "",
+		"claimed_at":   nil,
+	}).Error; err != nil {
+		log.Printf("failed to complete erasure %s: %v", r.ID, err)
+	}
+}
+
+func erasureBackoff(attempts int) time.Duration {
+	d := erasureBaseBackoff
+	for i := 1; i < attempts && d < erasureMaxBackoff; i++ {
+		d *= 2
+	}
+	if d > erasureMaxBackoff {
+		d = erasureMaxBackoff
+	}
+	return d
+}
+
+func (s *UserService) runErasureSteps(ctx context.Context, r *models.DataErasureRequest) error {
+	if r.TasksErasedAt == nil {
+		callCtx, cancel := context.WithTimeout(ctx, erasureCallTimeout)
+		_, err := s.taskClient.EraseUserData(callCtx, &taskpb.EraseUserDataRequest{UserId: r.UserID, Mode: r.Mode})
+		cancel()
+		if err != nil {
+			return fmt.Errorf("task service: %w", err)
+		}
+		if err := s.db.Model(r).UpdateColumn("tasks_erased_at", time.Now()).Error; err != nil {
+			return err
+		}
+	}
+	if r.NotificationsErasedAt == nil {
+		callCtx, cancel := context.WithTimeout(ctx, erasureCallTimeout)
+		_, err := s.notificationClient.EraseUserData(callCtx, &notificationpb.EraseUserDataRequest{UserId: r.UserID, Mode: r.Mode})
+		cancel()
+		if err != nil {
+			return fmt.Errorf("notification service: %w", err)
+		}
+		if err := s.db.Model(r).UpdateColumn("notifications_erased_at", time.Now()).Error; err != nil {
+			return err
+		}
+	}
+	return s.eraseUserRecord(ctx, r)
+}
+
+// eraseUserRecord removes the user's own account data last, once the other
+// services no longer reference them. Audit entries are kept: the log is
+// append-only and serves as the record of the erasure itself.
+func (s *UserService) eraseUserRecord(ctx context.Context, r *models.DataErasureRequest) error {
+	var user models.User
+	err := s.db.First(&user, "id = ?", r.UserID).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", user.ID).Delete(&models.APIKey{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("user_id = ? OR LOWER(email) = LOWER(?)", user.ID, user.Email).Delete(&models.LoginEvent{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("LOWER(email) = LOWER(?)", user.Email).Delete(&models.Invite{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("user_id = ?", user.ID).Delete(&models.OrganizationMembership{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("user_id = ?", user.ID).Delete(&models.PasswordResetToken{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("user_id = ?", user.ID).Delete(&models.Passkey{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("user_id = ?", user.ID).Delete(&models.PasskeyChallenge{}).Error; err != nil {
+			return err
+		}
+		if r.Mode == eraseModeDelete {
+			return tx.Delete(&user).Error
+		}
+
+		secret, err := generateSecureToken(32)
+		if err != nil {
+			return err
+		}
+		return tx.Model(&user).Updates(map[string]interface{}{
+			"email":              fmt.Sprintf("erased-%s@erased.invalid", user.ID),
+			"username":           "erased-" + user.ID,
+			"full_name":          "Deleted user",
+			"password":           hashString(secret),
+			"security_questions": "",
+			"external_source":    "",
+			"external_id":        "",
+			"suspension_reason":  "",
+			"profile_attributes": nil,
+			"is_active":          false,
+			"last_login":         nil,
+		}).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	s.revokeSessions(ctx, user.ID)
+	s.publishMembershipEvent(ctx, cache.MembershipRemoved, getStringValue(user.OrgID), user.ID)
+	s.recordAudit(ctx, auditEvent{
+		OrgID:      getStringValue(user.OrgID),
+		ActorID:    r.RequestedBy,
+		Action:     auditUserErased,
+		TargetType: "user",
+		TargetID:   user.ID,
+		Metadata:   map[string]string{"mode": r.Mode, "request_id": r.ID},
+	})
+	return nil
+}
+
+func erasureToProto(r *models.DataErasureRequest) *userpb.DataErasureRequest {
+	pb := &userpb.DataErasureRequest{
+		Id:        r.ID,
+		UserId:    r.UserID,
+		OrgId:     getStringValue(r.OrgID),
+		Mode:      r.Mode,
+		Status:    r.Status,
+		Attempts:  int32(r.Attempts),
+		LastError: r.LastError,
+		CreatedAt: timestamppb.New(r.CreatedAt),
+	}
+	if r.Status == models.ErasureScheduled {
+		pb.ScheduledFor = timestamppb.New(r.NextAttemptAt)
+	}
+	if r.CompletedAt != nil {
+		pb.CompletedAt = timestamppb.New(*r.CompletedAt)
+	}
+	if r.TasksErasedAt != nil {
+		pb.CompletedSteps = append(pb.CompletedSteps, "tasks")
+	}
+	if r.NotificationsErasedAt != nil {
+		pb.CompletedSteps = append(pb.CompletedSteps, "notifications")
+	}
+	if r.Status == models.ErasureCompleted {
+		pb.CompletedSteps = append(pb.CompletedSteps, "users")
+	}
+	return pb
+}
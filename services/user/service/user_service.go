@@ -7,12 +7,22 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"log"
 	"strings"
 	"time"
 
 	"github.com/chanduchitikam/task-management-system/pkg/auth"
+	"github.com/chanduchitikam/task-management-system/pkg/authz"
+	"github.com/chanduchitikam/task-management-system/pkg/cache"
+	"github.com/chanduchitikam/task-management-system/pkg/encryption"
+	"github.com/chanduchitikam/task-management-system/pkg/mailer"
+	"github.com/chanduchitikam/task-management-system/pkg/webauthn"
+	notificationpb "github.com/chanduchitikam/task-management-system/proto/notification"
+	organizationpb "github.com/chanduchitikam/task-management-system/proto/organization"
+	taskpb "github.com/chanduchitikam/task-management-system/proto/task"
 	userpb "github.com/chanduchitikam/task-management-system/proto/user"
 	"github.com/chanduchitikam/task-management-system/services/user/models"
+	"github.com/golang-jwt/jwt/v5"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
@@ -43,12 +53,38 @@ func getStringFromContext(ctx context.Context, key string) string {
 	return ""
 }
 
+// callerCan reports whether the caller holds perm for orgID
+func callerCan(ctx context.Context, perm authz.Permission, orgID string) bool {
+	return authz.CanInOrg(getStringFromContext(ctx, "role"), getStringFromContext(ctx, "org_id"), perm, orgID)
+}
+
 // // // UserService implements the UserService gRPC service
 type UserService struct {
 	userpb.UnimplementedUserServiceServer
 	db         *gorm.DB
 	jwtManager *auth.JWTManager
 	orgService *OrganizationService
+	cache      *cache.RedisClient
+	orgClient  organizationpb.OrganizationServiceClient
+
+	taskClient         taskpb.TaskServiceClient
+	notificationClient notificationpb.NotificationServiceClient
+
+	mailer          mailer.Sender
+	inviteAcceptURL string
+	resetURL        string
+	inviteMail      chan inviteDelivery
+	mailerStartedAt time.Time
+
+	returnInviteTokens bool
+
+	superAdminApprovals int
+
+	deletionGrace time.Duration
+
+	secrets *encryption.Keyring
+
+	passkeys *webauthn.RelyingParty
 }
 
 // // // NewUserService creates a new UserService instance
@@ -60,6 +96,25 @@ func NewUserService(db *gorm.DB, jwtManager *auth.JWTManager) *UserService {
 	}
 }
 
+// SetCache enables Redis-backed features such as membership change events.
+// The service works without it; those features are then skipped.
+func (s *UserService) SetCache(c *cache.RedisClient) {
+	s.cache = c
+}
+
+// publishMembershipEvent tells other services (notably the gateway's
+// WebSocket hub) that a user joined or left an org. Errors are logged only so
+// the membership change itself is not rolled back.
+func (s *UserService) publishMembershipEvent(ctx context.Context, eventType, orgID, userID string) {
+	if s.cache == nil || orgID == "" || userID == "" {
+		return
+	}
+	ev := cache.MembershipEvent{Type: eventType, OrgID: orgID, UserID: userID}
+	if err := s.cache.PublishMembershipEvent(ctx, ev, s.jwtManager.AccessTokenDuration()); err != nil {
+		log.Printf("warning: failed to publish membership event for user %s in org %s: %v", userID, orgID, err)
+	}
+}
+
 // // // Register creates a new user account
 func (s *UserService) Register(ctx context.Context, req *userpb.RegisterRequest) (*userpb.RegisterResponse, error) {
 	// Validate input
@@ -81,9 +136,9 @@ func (s *UserService) Register(ctx context.Context, req *userpb.RegisterRequest)
 	}
 
 	// Default role
-	role := "member"
+	role := authz.RoleMember
 	if req.Role == userpb.UserRole_USER_ROLE_ADMIN {
-		role = "admin"
+		role = authz.RoleAdmin
 	}
 
 	// Determine organization by email domain
@@ -111,7 +166,7 @@ func (s *UserService) Register(ctx context.Context, req *userpb.RegisterRequest)
 					}
 				} else {
 					// make user admin of new org
-					role = "admin"
+					role = authz.RoleAdmin
 				}
 			} else {
 				// Lookup failed for other reasons
@@ -154,7 +209,7 @@ func (s *UserService) Register(ctx context.Context, req *userpb.RegisterRequest)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to generate access token")
 	}
-	refreshToken, err := s.jwtManager.GenerateRefreshToken(user.ID)
+	refreshToken, err := s.issueRefreshToken(ctx, user.ID, "")
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to generate refresh token")
 	}
@@ -175,39 +230,112 @@ func (s *UserService) Login(ctx context.Context, req *userpb.LoginRequest) (*use
 		return nil, status.Error(codes.InvalidArgument, "email and password are required")
 	}
 
+	normalizedEmail := strings.ToLower(req.Email)
+	ip := loginClientIP(ctx)
+	if err := s.checkLoginThrottle(ctx, normalizedEmail, ip); err != nil {
+		s.recordLogin(ctx, normalizedEmail, nil, models.LoginThrottled)
+		return nil, err
+	}
+
 	// 	// 	// Find user (case-insensitive on email)
 	var user models.User
-	normalizedEmail := strings.ToLower(req.Email)
 	if err := s.db.Where("LOWER(email) = ?", normalizedEmail).First(&user).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, status.Error(codes.NotFound, "invalid email or password")
+			s.recordLogin(ctx, normalizedEmail, nil, models.LoginUnknownEmail)
+			return nil, s.loginFailed(ctx, normalizedEmail, ip, codes.NotFound, "invalid email or password")
 		}
 		return nil, status.Error(codes.Internal, "failed to find user")
 	}
 
-	// Check if account is locked due to failed attempts 5 attempts = lock
-	if user.FailedLoginAttempts >= 5 {
-		return nil, status.Error(codes.PermissionDenied, "account locked due to too many failed login attempts. Contact your administrator.")
+	if !user.IsActive {
+		s.recordLogin(ctx, normalizedEmail, &user, models.LoginDeactivated)
+		return nil, status.Error(codes.PermissionDenied, "account is deactivated")
+	}
+
+	if user.IsServiceAccount {
+		s.recordLogin(ctx, normalizedEmail, &user, models.LoginServiceAccount)
+		return nil, status.Error(codes.PermissionDenied, "service accounts authenticate with api keys")
+	}
+	if user.SuspendedAt != nil {
+		s.recordLogin(ctx, normalizedEmail, &user, models.LoginSuspended)
+		return nil, errAccountSuspended
+	}
+
+	// Locked after too many failed attempts; the lock expires on its own
+	if accountLocked(&user, time.Now()) {
+		s.recordLogin(ctx, normalizedEmail, &user, models.LoginLocked)
+		return nil, accountLockedError(&user)
 	}
 
-	// 	// 	// Check password
-	if err := auth.CheckPassword(req.Password, user.Password); err != nil {
-		// Increment failed login attempts
-		s.db.Model(&user).Update("failed_login_attempts", gorm.Expr("failed_login_attempts + ?", 1))
-		return nil, status.Error(codes.Unauthenticated, "invalid email or password")
+	// 	// 	// Check password (directory users against their directory)
+	checkPassword := func() error { return auth.CheckPassword(req.Password, user.Password) }
+	if user.ExternalSource == ldapSource {
+		checkPassword = func() error { return s.ldapAuthenticate(&user, req.Password) }
+	}
+	if err := checkPassword(); err != nil {
+		if errors.Is(err, errDirectoryUnavailable) {
+			s.recordLogin(ctx, normalizedEmail, &user, models.LoginDirectoryDown)
+			return nil, status.Error(codes.Unavailable, "unable to reach your organization's directory")
+		}
+		s.recordLogin(ctx, normalizedEmail, &user, models.LoginInvalidCredentials)
+		s.recordAudit(ctx, auditEvent{
+			OrgID:      getStringValue(user.OrgID),
+			ActorID:    user.ID,
+			ActorEmail: user.Email,
+			Action:     auditLoginFailed,
+			TargetType: "user",
+			TargetID:   user.ID,
+		})
+		s.recordFailedLogin(ctx, &user)
+		return nil, s.loginFailed(ctx, normalizedEmail, ip, codes.Unauthenticated, "invalid email or password")
+	}
+	// Admins of orgs requiring passkeys may use their password only until
+	// they have registered one
+	mustRegisterPasskey := false
+	if s.passkeysRequired(&user) {
+		if s.hasPasskey(user.ID) {
+			s.recordLogin(ctx, normalizedEmail, &user, models.LoginPasskeyRequired)
+			return nil, errPasskeyRequired
+		}
+		mustRegisterPasskey = true
+	}
+	s.resetLoginThrottle(ctx, normalizedEmail)
+
+	resp, err := s.completeLogin(ctx, &user, normalizedEmail)
+	if err != nil {
+		return nil, err
 	}
+	resp.MustRegisterPasskey = mustRegisterPasskey
+	return resp, nil
+}
 
+// completeLogin records a successful sign-in and issues the user's tokens
+func (s *UserService) completeLogin(ctx context.Context, user *models.User, normalizedEmail string) (*userpb.LoginResponse, error) {
 	// Successful login - update login tracking
 	now := time.Now()
 	updates := map[string]interface{}{
 		"has_logged_in":         true,
 		"last_login":            &now,
 		"failed_login_attempts": 0,
+		"locked_until":          nil,
+		"lockout_count":         0,
 	}
-	if err := s.db.Model(&user).Updates(updates).Error; err != nil {
+	if err := s.db.Model(user).Updates(updates).Error; err != nil {
 		// Log error but don't fail login
 		fmt.Printf("Failed to update login tracking: %v\n", err)
 	}
+	s.recordAudit(ctx, auditEvent{
+		OrgID:      getStringValue(user.OrgID),
+		ActorID:    user.ID,
+		ActorEmail: user.Email,
+		Action:     auditLogin,
+		TargetType: "user",
+		TargetID:   user.ID,
+	})
+	if s.recordLogin(ctx, normalizedEmail, user, "") {
+		s.alertNewDevice(ctx, user)
+	}
+	deletionCancelled := s.cancelAccountDeletion(ctx, user)
 
 	// Check if user needs to set security questions (one-time for all users)
 	mustSetSecurityQuestions := user.SecurityQuestions == "" || user.SecurityQuestions == "null"
@@ -224,7 +352,7 @@ func (s *UserService) Login(ctx context.Context, req *userpb.LoginRequest) (*use
 		return nil, status.Error(codes.Internal, "failed to generate access token")
 	}
 
-	refreshToken, err := s.jwtManager.GenerateRefreshToken(user.ID)
+	refreshToken, err := s.issueRefreshToken(ctx, user.ID, "")
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to generate refresh token")
 	}
@@ -232,10 +360,70 @@ func (s *UserService) Login(ctx context.Context, req *userpb.LoginRequest) (*use
 	return &userpb.LoginResponse{
 		AccessToken:              accessToken,
 		RefreshToken:             refreshToken,
-		User:                     s.modelToProto(&user),
+		User:                     s.modelToProto(user),
 		ExpiresIn:                86400, // 24 hours in seconds
 		MustChangePassword:       user.MustChangePassword,
 		MustSetSecurityQuestions: mustSetSecurityQuestions,
+		AccountDeletionCancelled: deletionCancelled,
+	}, nil
+}
+
+// RefreshToken exchanges a valid refresh token for a new token pair. Role and
+// org come from the current user record and memberships so changes since
+// login take effect; a session switched to another org stays there while the
+// user still belongs to it.
+func (s *UserService) RefreshToken(ctx context.Context, req *userpb.RefreshTokenRequest) (*userpb.RefreshTokenResponse, error) {
+	if req.RefreshToken == "" {
+		return nil, status.Error(codes.InvalidArgument, "refresh_token is required")
+	}
+
+	claims, err := s.jwtManager.ValidateRefreshToken(req.RefreshToken)
+	if err != nil {
+		if errors.Is(err, auth.ErrExpiredToken) || errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, status.Error(codes.Unauthenticated, "refresh token has expired")
+		}
+		return nil, status.Error(codes.Unauthenticated, "invalid refresh token")
+	}
+
+	var user models.User
+	if err := s.db.Where("id = ?", claims.UserID).First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.Unauthenticated, "invalid refresh token")
+		}
+		return nil, status.Error(codes.Internal, "failed to find user")
+	}
+	if !user.IsActive {
+		return nil, status.Error(codes.PermissionDenied, "account is deactivated")
+	}
+	if user.SuspendedAt != nil {
+		return nil, errAccountSuspended
+	}
+	if claims.IssuedAt != nil && s.sessionRevoked(ctx, user.ID, claims.IssuedAt.Time) {
+		return nil, status.Error(codes.Unauthenticated, "session has been revoked")
+	}
+	if accountLocked(&user, time.Now()) {
+		return nil, accountLockedError(&user)
+	}
+
+	tokenOrgID, tokenRole := s.sessionScope(&user, claims.OrgID)
+	accessToken, err := s.jwtManager.GenerateAccessToken(user.ID, user.Email, tokenRole, tokenOrgID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to generate access token")
+	}
+	refreshOrgID := ""
+	if tokenOrgID != getStringValue(user.OrgID) {
+		refreshOrgID = tokenOrgID
+	}
+	refreshToken, err := s.rotateRefreshToken(ctx, &user, claims, req.RefreshToken, refreshOrgID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &userpb.RefreshTokenResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		User:         s.modelToProto(&user),
+		ExpiresIn:    int64(s.jwtManager.AccessTokenDuration().Seconds()),
 	}, nil
 }
 
@@ -258,21 +446,20 @@ func (s *UserService) GetUser(ctx context.Context, req *userpb.GetUserRequest) (
 
 	var user models.User
 	var err error
-	// Global admin (seeded) allowed to fetch any user
-	isGlobalAdmin := roleStr == "admin" && callerOrg == "" && strings.ToLower(ctx.Value("email").(string)) == "admin@taskflow.com"
-	if isGlobalAdmin {
+	// Platform admins may fetch any user
+	if authz.IsPlatformAdmin(roleStr) {
 		err = s.db.Where("id = ?", req.UserId).First(&user).Error
 	} else {
 		// Org admin or member: scope by org
 		// Org admins can fetch any user in their org; members only their own record
-		if roleStr == "admin" && callerOrg != "" {
-			err = s.db.Where("id = ? AND org_id = ?", req.UserId, callerOrg).First(&user).Error
+		if authz.Can(roleStr, authz.MemberManage) && callerOrg != "" {
+			err = s.db.Scopes(s.inOrg(callerOrg)).Where("id = ?", req.UserId).First(&user).Error
 		} else {
 			// member
 			if callerID != req.UserId {
 				return nil, status.Error(codes.PermissionDenied, "forbidden")
 			}
-			err = s.db.Where("id = ? AND org_id = ?", req.UserId, callerOrg).First(&user).Error
+			err = s.db.Scopes(s.inOrg(callerOrg)).Where("id = ?", req.UserId).First(&user).Error
 		}
 	}
 
@@ -307,11 +494,10 @@ func (s *UserService) UpdateUser(ctx context.Context, req *userpb.UpdateUserRequ
 
 	var user models.User
 	var err error
-	isGlobalAdmin := roleStr == "admin" && callerOrg == "" && strings.ToLower(ctx.Value("email").(string)) == "admin@taskflow.com"
-	if isGlobalAdmin {
+	if authz.IsPlatformAdmin(roleStr) {
 		err = s.db.Where("id = ?", req.UserId).First(&user).Error
 	} else {
-		if roleStr == "admin" && callerOrg != "" {
+		if authz.Can(roleStr, authz.MemberManage) && callerOrg != "" {
 			// org admin may update users in same org
 			err = s.db.Where("id = ? AND org_id = ?", req.UserId, callerOrg).First(&user).Error
 		} else {
@@ -319,7 +505,7 @@ func (s *UserService) UpdateUser(ctx context.Context, req *userpb.UpdateUserRequ
 			if callerID != req.UserId {
 				return nil, status.Error(codes.PermissionDenied, "forbidden")
 			}
-			err = s.db.Where("id = ? AND org_id = ?", req.UserId, callerOrg).First(&user).Error
+			err = s.db.Scopes(s.inOrg(callerOrg)).Where("id = ?", req.UserId).First(&user).Error
 		}
 	}
 
@@ -340,15 +526,36 @@ func (s *UserService) UpdateUser(ctx context.Context, req *userpb.UpdateUserRequ
 	if req.FullName != "" {
 		user.FullName = req.FullName
 	}
-	if req.Role == userpb.UserRole_USER_ROLE_ADMIN {
-		user.Role = "admin"
+	previousRole := user.Role
+	// USER_ROLE_ADMIN covers every admin role, so only promote non-admins
+	if req.Role == userpb.UserRole_USER_ROLE_ADMIN && !authz.Can(user.Role, authz.MemberManage) {
+		user.Role = authz.RoleAdmin
 	} else if req.Role == userpb.UserRole_USER_ROLE_MEMBER {
-		user.Role = "member"
+		user.Role = authz.RoleMember
+	}
+	if previousRole == authz.RoleSuperAdmin && user.Role != previousRole {
+		return nil, status.Error(codes.FailedPrecondition, "super admin roles are changed through RevokeSuperAdmin")
+	}
+	// members cannot change roles (including their own), and admins cannot
+	// grant more than they hold. This is the home org role, so only that
+	// org's admins may change it; other orgs use UpdateOrganizationMemberRole.
+	homeAdmin := authz.IsPlatformAdmin(roleStr) || getStringValue(user.OrgID) == callerOrg
+	if user.Role != previousRole && (!homeAdmin || !authz.Can(roleStr, authz.MemberManage) || !authz.CanGrant(roleStr, user.Role)) {
+		return nil, status.Error(codes.PermissionDenied, "not allowed to change roles")
 	}
 
 	if err := s.db.Save(&user).Error; err != nil {
 		return nil, status.Error(codes.Internal, "failed to update user")
 	}
+	if user.Role != previousRole {
+		s.recordAudit(ctx, auditEvent{
+			OrgID:      getStringValue(user.OrgID),
+			Action:     auditRoleChanged,
+			TargetType: "user",
+			TargetID:   user.ID,
+			Metadata:   map[string]string{"from": previousRole, "to": user.Role},
+		})
+	}
 
 	return &userpb.UpdateUserResponse{
 		User:    s.modelToProto(&user),
@@ -365,7 +572,6 @@ func (s *UserService) DeleteUser(ctx context.Context, req *userpb.DeleteUserRequ
 	// Require authentication context
 	roleVal := ctx.Value("role")
 	orgVal := ctx.Value("org_id")
-	emailVal := ctx.Value("email")
 	callerIDVal := ctx.Value("user_id")
 	if roleVal == nil || orgVal == nil || callerIDVal == nil {
 		return nil, status.Error(codes.Unauthenticated, "missing authentication context")
@@ -374,19 +580,23 @@ func (s *UserService) DeleteUser(ctx context.Context, req *userpb.DeleteUserRequ
 	callerOrg, _ := orgVal.(string)
 	callerID, _ := callerIDVal.(string)
 
-	isGlobalAdmin := roleStr == "admin" && callerOrg == "" && strings.ToLower(emailVal.(string)) == "admin@taskflow.com"
+	// deleting a super admin would bypass the revocation approval
+	var target models.User
+	if err := s.db.Select("id", "role").First(&target, "id = ?", req.UserId).Error; err == nil && target.Role == authz.RoleSuperAdmin {
+		return nil, status.Error(codes.FailedPrecondition, "revoke the super admin role before deleting this user")
+	}
 
 	var result *gorm.DB
-	if isGlobalAdmin {
+	if authz.IsPlatformAdmin(roleStr) {
 		result = s.db.Where("id = ?", req.UserId).Delete(&models.User{})
-	} else if roleStr == "admin" && callerOrg != "" {
+	} else if authz.Can(roleStr, authz.MemberManage) && callerOrg != "" {
 		result = s.db.Where("id = ? AND org_id = ?", req.UserId, callerOrg).Delete(&models.User{})
 	} else {
 		// member may delete only themselves
 		if callerID != req.UserId {
 			return nil, status.Error(codes.PermissionDenied, "forbidden")
 		}
-		result = s.db.Where("id = ? AND org_id = ?", req.UserId, callerOrg).Delete(&models.User{})
+		result = s.db.Scopes(s.inOrg(callerOrg)).Where("id = ?", req.UserId).Delete(&models.User{})
 	}
 
 	if result.Error != nil {
@@ -396,6 +606,13 @@ func (s *UserService) DeleteUser(ctx context.Context, req *userpb.DeleteUserRequ
 	if result.RowsAffected == 0 {
 		return nil, status.Error(codes.NotFound, "user not found")
 	}
+	s.db.Where("user_id = ?", req.UserId).Delete(&models.OrganizationMembership{})
+	s.recordAudit(ctx, auditEvent{
+		OrgID:      callerOrg,
+		Action:     auditUserDeleted,
+		TargetType: "user",
+		TargetID:   req.UserId,
+	})
 
 	return &userpb.DeleteUserResponse{
 		Message: "User deleted successfully",
@@ -419,21 +636,18 @@ func (s *UserService) ListUsers(ctx context.Context, req *userpb.ListUsersReques
 	// Require authentication context and scope by org unless global admin
 	roleVal := ctx.Value("role")
 	orgVal := ctx.Value("org_id")
-	emailVal := ctx.Value("email")
 	if roleVal == nil || orgVal == nil {
 		return nil, status.Error(codes.Unauthenticated, "missing authentication context")
 	}
 	roleStr, _ := roleVal.(string)
 	callerOrg, _ := orgVal.(string)
-	emailStr, _ := emailVal.(string)
 
 	var users []models.User
 	query := s.db.Model(&models.User{})
 
-	isGlobalAdmin := roleStr == "admin" && callerOrg == "" && strings.ToLower(emailStr) == "admin@taskflow.com"
-	if !isGlobalAdmin {
+	if !authz.IsPlatformAdmin(roleStr) {
 		// only list users in caller's org
-		query = query.Where("org_id = ?", callerOrg)
+		query = query.Scopes(s.inOrg(callerOrg))
 	}
 
 	// 	// 	// Apply role filter
@@ -479,9 +693,15 @@ func (s *UserService) ValidateToken(ctx context.Context, req *userpb.ValidateTok
 			Message: fmt.Sprintf("invalid token: %v", err),
 		}, nil
 	}
+	if claims.IssuedAt != nil && s.sessionRevoked(ctx, claims.UserID, claims.IssuedAt.Time) {
+		return &userpb.ValidateTokenResponse{
+			Valid:   false,
+			Message: "invalid token: session has been revoked",
+		}, nil
+	}
 
 	role := userpb.UserRole_USER_ROLE_MEMBER
-	if claims.Role == "admin" {
+	if authz.Can(claims.Role, authz.MemberManage) {
 		role = userpb.UserRole_USER_ROLE_ADMIN
 	}
 
@@ -496,7 +716,7 @@ func (s *UserService) ValidateToken(ctx context.Context, req *userpb.ValidateTok
 // // // Helper function to convert model to proto
 func (s *UserService) modelToProto(user *models.User) *userpb.User {
 	role := userpb.UserRole_USER_ROLE_MEMBER
-	if user.Role == "admin" {
+	if authz.Can(user.Role, authz.MemberManage) {
 		role = userpb.UserRole_USER_ROLE_ADMIN
 	}
 
@@ -543,14 +763,40 @@ func (s *UserService) InviteUser(ctx context.Context, req *userpb.InviteRequest)
 	callerOrg, _ := orgVal.(string)
 	callerID, _ := userIDVal.(string)
 
-	if roleStr != "admin" || callerOrg != req.OrgId {
+	if !authz.CanInOrg(roleStr, callerOrg, authz.MemberInvite, req.OrgId) {
 		return nil, status.Error(codes.PermissionDenied, "only organization admins may invite users for this org")
 	}
+	role := req.Role
+	if role == "" {
+		role = authz.RoleMember
+	}
+	guest := authz.IsGuest(role)
+	if !(authz.Assignable(role) || guest) || !authz.CanGrant(roleStr, role) {
+		return nil, status.Errorf(codes.PermissionDenied, "not allowed to grant role %q", role)
+	}
+	if guest {
+		// guests come from outside, so the email domain allowlist does not apply
+		if err := s.validateGuestScope(req.OrgId, req.ScopeType, req.ScopeId, req.AccessDays); err != nil {
+			return nil, err
+		}
+	} else {
+		if req.ScopeType != "" || req.ScopeId != "" {
+			return nil, status.Error(codes.InvalidArgument, "scope_type and scope_id apply to guest invites only")
+		}
+		if err := s.validateInviteEmailDomain(req.Email, req.OrgId); err != nil {
+			return nil, err
+		}
+	}
 
-	// Ensure no existing user with email
+	// Existing accounts may be invited into further organizations
 	var existing models.User
 	if err := s.db.Where("LOWER(email) = ?", strings.ToLower(req.Email)).First(&existing).Error; err == nil {
-		return nil, status.Error(codes.AlreadyExists, "user with this email already exists")
+		if existing.IsServiceAccount {
+			return nil, status.Error(codes.FailedPrecondition, "service accounts cannot be invited")
+		}
+		if _, ok := s.orgRole(&existing, req.OrgId); ok {
+			return nil, status.Error(codes.AlreadyExists, "user is already a member of this organization")
+		}
 	}
 
 	// generate token and store only hash
@@ -560,32 +806,47 @@ func (s *UserService) InviteUser(ctx context.Context, req *userpb.InviteRequest)
 	}
 	tokenHash := hashString(token)
 
-	expires := time.Now().Add(72 * time.Hour)
-	if req.ExpiresHours > 0 {
-		expires = time.Now().Add(time.Duration(req.ExpiresHours) * time.Hour)
-	}
-
 	invite := &models.Invite{
 		Email:     strings.ToLower(req.Email),
 		OrgID:     req.OrgId,
-		Role:      req.Role,
+		Role:      role,
 		TokenHash: tokenHash,
-		ExpiresAt: expires,
+		ExpiresAt: inviteExpiry(req.ExpiresHours),
 		CreatedBy: callerID,
 	}
+	if guest {
+		invite.ScopeType = req.ScopeType
+		invite.ScopeID = &req.ScopeId
+		invite.AccessDays = int(req.AccessDays)
+	}
 
 	if err := s.db.Create(invite).Error; err != nil {
 		return nil, status.Error(codes.Internal, "failed to create invite")
 	}
-
-	// Note: in production we should email the token; do not return it via API.
-	return &userpb.InviteResponse{InviteId: invite.ID, Message: "invite created; deliver token to user via secure channel"}, nil
+	s.recordAudit(ctx, auditEvent{
+		OrgID:      invite.OrgID,
+		Action:     auditInviteCreated,
+		TargetType: "invite",
+		TargetID:   invite.ID,
+		Metadata:   map[string]string{"email": invite.Email, "role": invite.Role},
+	})
+
+	// The token is only returned via the API in development
+	resp := &userpb.InviteResponse{InviteId: invite.ID, Message: "invite created; deliver token to user via secure channel"}
+	if s.returnInviteTokens {
+		resp.Token = token
+	} else if s.QueueInviteEmail(invite, token) {
+		resp.Message = "invite queued for email delivery"
+	}
+	return resp, nil
 }
 
-// AcceptInvite accepts an invite token and creates a user
+// AcceptInvite accepts an invite token and creates a user. If the invited
+// email already has an account, the password must match it and the org is
+// added to that account instead.
 func (s *UserService) AcceptInvite(ctx context.Context, req *userpb.AcceptInviteRequest) (*userpb.AcceptInviteResponse, error) {
-	if req == nil || req.Token == "" || req.Password == "" || req.Username == "" {
-		return nil, status.Error(codes.InvalidArgument, "token, password and username are required")
+	if req == nil || req.Token == "" || req.Password == "" {
+		return nil, status.Error(codes.InvalidArgument, "token and password are required")
 	}
 
 	tokenHash := hashString(req.Token)
@@ -597,14 +858,24 @@ func (s *UserService) AcceptInvite(ctx context.Context, req *userpb.AcceptInvite
 		return nil, status.Error(codes.Internal, "failed to lookup invite")
 	}
 
-	if invite.UsedAt != nil || invite.ExpiresAt.Before(time.Now()) {
-		return nil, status.Error(codes.FailedPrecondition, "invite already used or expired")
+	if invite.Status(time.Now()) != models.InvitePending {
+		return nil, status.Error(codes.FailedPrecondition, "invite already used, revoked or expired")
+	}
+	guest := authz.IsGuest(invite.Role)
+	if !authz.Assignable(invite.Role) && !guest {
+		return nil, status.Error(codes.FailedPrecondition, "invite grants a role that cannot be assigned directly")
+	}
+	// the allowlist may have been tightened since the invite was sent
+	if err := s.validateInviteEmailDomain(invite.Email, invite.OrgID); err != nil && !guest {
+		return nil, status.Error(codes.FailedPrecondition, "this invite's email domain is no longer allowed by the organization")
 	}
 
-	// ensure email not already used
 	var existing models.User
 	if err := s.db.Where("LOWER(email) = ?", strings.ToLower(invite.Email)).First(&existing).Error; err == nil {
-		return nil, status.Error(codes.AlreadyExists, "user with this email already exists")
+		return s.acceptInviteAsMember(ctx, &invite, &existing, req.Password)
+	}
+	if req.Username == "" {
+		return nil, status.Error(codes.InvalidArgument, "username is required")
 	}
 
 	hashedPass, err := auth.HashPassword(req.Password)
@@ -624,8 +895,17 @@ func (s *UserService) AcceptInvite(ctx context.Context, req *userpb.AcceptInvite
 		newUser.OrgID = &invite.OrgID
 	}
 
-	if err := s.db.Create(newUser).Error; err != nil {
-		return nil, status.Error(codes.Internal, "failed to create user")
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(newUser).Error; err != nil {
+			return status.Error(codes.Internal, "failed to create user")
+		}
+		if guest {
+			return grantGuestAccess(tx, &invite, newUser.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	now := time.Now()
@@ -633,10 +913,69 @@ func (s *UserService) AcceptInvite(ctx context.Context, req *userpb.AcceptInvite
 	if err := s.db.Save(&invite).Error; err != nil {
 		// log only; user created
 	}
+	s.recordAudit(ctx, auditEvent{
+		OrgID:      invite.OrgID,
+		ActorID:    newUser.ID,
+		ActorEmail: newUser.Email,
+		Action:     auditInviteAccepted,
+		TargetType: "invite",
+		TargetID:   invite.ID,
+		Metadata:   map[string]string{"user_id": newUser.ID, "role": newUser.Role},
+	})
 
 	return &userpb.AcceptInviteResponse{User: s.modelToProto(newUser), Message: "user created from invite"}, nil
 }
 
+// acceptInviteAsMember adds the invite's org to an existing account once the
+// account's password is confirmed
+func (s *UserService) acceptInviteAsMember(ctx context.Context, invite *models.Invite, user *models.User, password string) (*userpb.AcceptInviteResponse, error) {
+	if !user.IsActive || user.SuspendedAt != nil || user.IsServiceAccount {
+		return nil, status.Error(codes.FailedPrecondition, "this account cannot accept invites")
+	}
+	checkPassword := func() error { return auth.CheckPassword(password, user.Password) }
+	if user.ExternalSource == ldapSource {
+		checkPassword = func() error { return s.ldapAuthenticate(user, password) }
+	}
+	if err := checkPassword(); err != nil {
+		if errors.Is(err, errDirectoryUnavailable) {
+			return nil, status.Error(codes.Unavailable, "unable to reach your organization's directory")
+		}
+		return nil, status.Error(codes.Unauthenticated, "an account with this email exists; enter its password to join")
+	}
+
+	// claim the invite first so two accepts cannot both add the membership
+	now := time.Now()
+	claim := s.db.Model(&models.Invite{}).Where("id = ? AND used_at IS NULL", invite.ID).Update("used_at", now)
+	if claim.Error != nil {
+		return nil, status.Error(codes.Internal, "failed to accept invite")
+	}
+	if claim.RowsAffected == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "invite already used, revoked or expired")
+	}
+	if err := s.addMembership(ctx, user, invite.OrgID, invite.Role, invite.CreatedBy); err != nil {
+		s.db.Model(&models.Invite{}).Where("id = ?", invite.ID).Update("used_at", nil)
+		return nil, err
+	}
+	if authz.IsGuest(invite.Role) {
+		if err := grantGuestAccess(s.db, invite, user.ID); err != nil {
+			s.removeMembership(invite.OrgID, user.ID)
+			s.db.Model(&models.Invite{}).Where("id = ?", invite.ID).Update("used_at", nil)
+			return nil, err
+		}
+	}
+	s.recordAudit(ctx, auditEvent{
+		OrgID:      invite.OrgID,
+		ActorID:    user.ID,
+		ActorEmail: user.Email,
+		Action:     auditInviteAccepted,
+		TargetType: "invite",
+		TargetID:   invite.ID,
+		Metadata:   map[string]string{"user_id": user.ID, "role": invite.Role, "existing_account": "true"},
+	})
+
+	return &userpb.AcceptInviteResponse{User: s.modelToProto(user), Message: "organization added to your account"}, nil
+}
+
 // ListInvites lists invites for an organization
 func (s *UserService) ListInvites(ctx context.Context, req *userpb.ListInvitesRequest) (*userpb.ListInvitesResponse, error) {
 	if req == nil || req.OrgId == "" {
@@ -646,17 +985,14 @@ func (s *UserService) ListInvites(ctx context.Context, req *userpb.ListInvitesRe
 	// permission check: caller must be org admin or global admin
 	roleVal := ctx.Value("role")
 	orgVal := ctx.Value("org_id")
-	emailVal := ctx.Value("email")
 	if roleVal == nil || orgVal == nil {
 		return nil, status.Error(codes.Unauthenticated, "missing authentication context")
 	}
 	roleStr, _ := roleVal.(string)
 	callerOrg, _ := orgVal.(string)
-	emailStr, _ := emailVal.(string)
 
-	isOrgAdmin := roleStr == "admin" && callerOrg == req.OrgId
-	isGlobalAdmin := roleStr == "admin" && callerOrg == "" && strings.ToLower(emailStr) == "admin@taskflow.com"
-	if !isOrgAdmin && !isGlobalAdmin {
+	isOrgAdmin := authz.CanInOrg(roleStr, callerOrg, authz.MemberInvite, req.OrgId)
+	if !isOrgAdmin && !authz.IsPlatformAdmin(roleStr) {
 		return nil, status.Error(codes.PermissionDenied, "forbidden")
 	}
 
@@ -676,26 +1012,13 @@ func (s *UserService) ListInvites(ctx context.Context, req *userpb.ListInvitesRe
 	}
 
 	var invites []models.Invite
-	if err := s.db.Where("org_id = ?", req.OrgId).Offset(int(offset)).Limit(int(pageSize)).Find(&invites).Error; err != nil {
+	if err := s.db.Where("org_id = ?", req.OrgId).Order("created_at DESC").Offset(int(offset)).Limit(int(pageSize)).Find(&invites).Error; err != nil {
 		return nil, status.Error(codes.Internal, "failed to list invites")
 	}
 
 	protoInvites := make([]*userpb.Invite, 0, len(invites))
-	for _, iv := range invites {
-		var usedAt *timestamppb.Timestamp
-		if iv.UsedAt != nil {
-			usedAt = timestamppb.New(*iv.UsedAt)
-		}
-		protoInvites = append(protoInvites, &userpb.Invite{
-			InviteId:  iv.ID,
-			Email:     iv.Email,
-			OrgId:     iv.OrgID,
-			Role:      iv.Role,
-			ExpiresAt: timestamppb.New(iv.ExpiresAt),
-			UsedAt:    usedAt,
-			CreatedBy: iv.CreatedBy,
-			CreatedAt: timestamppb.New(iv.CreatedAt),
-		})
+	for i := range invites {
+		protoInvites = append(protoInvites, inviteToProto(&invites[i]))
 	}
 
 	return &userpb.ListInvitesResponse{
@@ -751,7 +1074,7 @@ func (s *UserService) RegisterOrganization(ctx context.Context, req *userpb.Regi
 func (s *UserService) ListAllOrganizations(ctx context.Context, req *userpb.ListAllOrganizationsRequest) (*userpb.ListAllOrganizationsResponse, error) {
 	// Check if user is super admin
 	role := getStringFromContext(ctx, "role")
-	if role != "super_admin" {
+	if !authz.IsPlatformAdmin(role) {
 		return nil, status.Error(codes.PermissionDenied, "super admin access required")
 	}
 
@@ -764,7 +1087,7 @@ func (s *UserService) ListAllOrganizations(ctx context.Context, req *userpb.List
 	for _, org := range orgs {
 		// Count members
 		var memberCount int64
-		s.db.Model(&models.User{}).Where("org_id = ?", org.ID).Count(&memberCount)
+		s.db.Model(&models.User{}).Scopes(s.inOrg(org.ID)).Count(&memberCount)
 
 		description := ""
 		if org.Description != nil {
@@ -789,7 +1112,7 @@ func (s *UserService) ListAllOrganizations(ctx context.Context, req *userpb.List
 func (s *UserService) GetPlatformAnalytics(ctx context.Context, req *userpb.GetPlatformAnalyticsRequest) (*userpb.GetPlatformAnalyticsResponse, error) {
 	// Get role from context/metadata
 	role := getStringFromContext(ctx, "role")
-	if role != "super_admin" {
+	if !authz.IsPlatformAdmin(role) {
 		return nil, status.Error(codes.PermissionDenied, "super admin access required")
 	}
 
@@ -809,7 +1132,7 @@ func (s *UserService) GetPlatformAnalytics(ctx context.Context, req *userpb.GetP
 // ListAllUsers returns all users (super admin only)
 func (s *UserService) ListAllUsers(ctx context.Context, req *userpb.ListAllUsersRequest) (*userpb.ListAllUsersResponse, error) {
 	role := getStringFromContext(ctx, "role")
-	if role != "super_admin" {
+	if !authz.IsPlatformAdmin(role) {
 		return nil, status.Error(codes.PermissionDenied, "super admin access required")
 	}
 
@@ -843,7 +1166,7 @@ func (s *UserService) ListAllUsers(ctx context.Context, req *userpb.ListAllUsers
 // DeleteOrganization deletes an organization (super admin only)
 func (s *UserService) DeleteOrganization(ctx context.Context, req *userpb.DeleteOrganizationRequest) (*userpb.DeleteOrganizationResponse, error) {
 	role := getStringFromContext(ctx, "role")
-	if role != "super_admin" {
+	if !authz.IsPlatformAdmin(role) {
 		return nil, status.Error(codes.PermissionDenied, "super admin access required")
 	}
 
@@ -851,9 +1174,23 @@ func (s *UserService) DeleteOrganization(ctx context.Context, req *userpb.Delete
 		return nil, status.Error(codes.InvalidArgument, "org_id required")
 	}
 
+	var memberIDs []string
+	s.db.Model(&models.User{}).Scopes(s.inOrg(req.OrgId)).Pluck("id", &memberIDs)
+
 	if err := s.orgService.DeleteOrganization(req.OrgId); err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	s.db.Where("org_id = ?", req.OrgId).Delete(&models.OrganizationMembership{})
+	for _, id := range memberIDs {
+		s.publishMembershipEvent(ctx, cache.MembershipRemoved, req.OrgId, id)
+	}
+	s.recordAudit(ctx, auditEvent{
+		OrgID:      req.OrgId,
+		Action:     auditOrgDeleted,
+		TargetType: "organization",
+		TargetID:   req.OrgId,
+		Metadata:   map[string]string{"members": fmt.Sprint(len(memberIDs))},
+	})
 
 	return &userpb.DeleteOrganizationResponse{
 		Message: "Organization deleted successfully",
@@ -862,41 +1199,24 @@ func (s *UserService) DeleteOrganization(ctx context.Context, req *userpb.Delete
 
 // ListOrganizationMembers returns members of an organization
 func (s *UserService) ListOrganizationMembers(ctx context.Context, req *userpb.ListOrganizationMembersRequest) (*userpb.ListOrganizationMembersResponse, error) {
-	role := getStringFromContext(ctx, "role")
-	orgID := getStringFromContext(ctx, "org_id")
-
-	// Allow org admin or super admin
-	isOrgAdmin := role == "org_admin" && orgID == req.OrgId
-	isSuperAdmin := role == "super_admin"
-
-	if !isOrgAdmin && !isSuperAdmin {
+	if !callerCan(ctx, authz.MemberManage, req.OrgId) {
 		return nil, status.Error(codes.PermissionDenied, "access denied")
 	}
 
 	// Fetch users directly from DB
 	var users []models.User
-	if err := s.db.Where("org_id = ?", req.OrgId).Find(&users).Error; err != nil {
+	if err := s.db.Scopes(s.inOrg(req.OrgId)).Where("is_service_account = ?", false).Find(&users).Error; err != nil {
 		return nil, status.Error(codes.Internal, "failed to fetch members")
 	}
+	roles := s.membershipRoles(req.OrgId)
+	fields, err := s.profileFields(req.OrgId)
+	if err != nil {
+		return nil, err
+	}
 
 	protoMembers := make([]*userpb.OrganizationMember, 0, len(users))
-	for _, user := range users {
-		member := &userpb.OrganizationMember{
-			Id:                   user.ID,
-			Email:                user.Email,
-			Username:             user.Username,
-			FullName:             user.FullName,
-			Role:                 user.Role,
-			CreatedAt:            timestamppb.New(user.CreatedAt),
-			HasLoggedIn:          user.HasLoggedIn,
-			MustChangePassword:   user.MustChangePassword,
-			FailedLoginAttempts:  int32(user.FailedLoginAttempts),
-			HasSecurityQuestions: user.SecurityQuestions != "",
-		}
-		if user.LastLogin != nil {
-			member.LastLogin = timestamppb.New(*user.LastLogin)
-		}
-		protoMembers = append(protoMembers, member)
+	for i := range users {
+		protoMembers = append(protoMembers, memberToProto(&users[i], req.OrgId, roles, fields))
 	}
 
 	return &userpb.ListOrganizationMembersResponse{
@@ -904,21 +1224,62 @@ func (s *UserService) ListOrganizationMembers(ctx context.Context, req *userpb.L
 	}, nil
 }
 
+// memberToProto describes user as a member of orgID; roles holds the
+// per-org roles of members from other organizations
+func memberToProto(user *models.User, orgID string, roles map[string]string, fields []profileField) *userpb.OrganizationMember {
+	role := user.Role
+	if r, ok := roles[user.ID]; ok && getStringValue(user.OrgID) != orgID && user.Role != authz.RoleSuperAdmin {
+		role = r
+	}
+	member := &userpb.OrganizationMember{
+		Id:                   user.ID,
+		Email:                user.Email,
+		Username:             user.Username,
+		FullName:             user.FullName,
+		Role:                 role,
+		CreatedAt:            timestamppb.New(user.CreatedAt),
+		HasLoggedIn:          user.HasLoggedIn,
+		MustChangePassword:   user.MustChangePassword,
+		FailedLoginAttempts:  int32(user.FailedLoginAttempts),
+		HasSecurityQuestions: user.SecurityQuestions != "",
+		ProfileAttributes:    visibleProfileAttributes(user, fields, true),
+	}
+	if user.LastLogin != nil {
+		member.LastLogin = timestamppb.New(*user.LastLogin)
+	}
+	if accountLocked(user, time.Now()) {
+		member.LockedUntil = timestamppb.New(*user.LockedUntil)
+	}
+	if user.SuspendedAt != nil {
+		member.SuspendedAt = timestamppb.New(*user.SuspendedAt)
+		member.SuspensionReason = user.SuspensionReason
+	}
+	return member
+}
+
 // RemoveOrganizationMember removes a member from organization
 func (s *UserService) RemoveOrganizationMember(ctx context.Context, req *userpb.RemoveOrganizationMemberRequest) (*userpb.RemoveOrganizationMemberResponse, error) {
-	role := getStringFromContext(ctx, "role")
-	orgID := getStringFromContext(ctx, "org_id")
-
-	isOrgAdmin := role == "org_admin" && orgID == req.OrgId
-	isSuperAdmin := role == "super_admin"
-
-	if !isOrgAdmin && !isSuperAdmin {
+	if !callerCan(ctx, authz.MemberManage, req.OrgId) {
 		return nil, status.Error(codes.PermissionDenied, "access denied")
 	}
 
-	if err := s.orgService.RemoveOrganizationMember(req.OrgId, req.UserId); err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+	// members who joined from another org only lose this membership
+	removed, err := s.removeMembership(req.OrgId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if !removed {
+		if err := s.orgService.RemoveOrganizationMember(req.OrgId, req.UserId); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
 	}
+	s.publishMembershipEvent(ctx, cache.MembershipRemoved, req.OrgId, req.UserId)
+	s.recordAudit(ctx, auditEvent{
+		OrgID:      req.OrgId,
+		Action:     auditMemberRemoved,
+		TargetType: "user",
+		TargetID:   req.UserId,
+	})
 
 	return &userpb.RemoveOrganizationMemberResponse{
 		Message: "Member removed successfully",